@@ -0,0 +1,127 @@
+// Package apitypes holds the JSON bodies of the rocha REST API, shared by the server
+// and the Go client so both sides always agree on the wire format.
+//
+// Types in this package are part of the stable API: fields may be added,
+// but existing fields are not removed or renamed.
+package apitypes
+
+import "time"
+
+// Session states
+const (
+	StateExited  = "exited"  // Claude has exited
+	StateIdle    = "idle"    // Claude finished and is waiting for a new prompt
+	StateWaiting = "waiting" // Claude is waiting for user input (e.g. permissions)
+	StateWorking = "working" // Claude is working
+)
+
+// Event types streamed by GET /api/v1/events
+const (
+	EventArchive      = "archive"       // Session was archived
+	EventCIFailure    = "ci_failure"    // CI failed on the branch of an idle session
+	EventError        = "error"         // Rocha failed to process a session event
+	EventEscalation   = "escalation"    // Session waited for input longer than its escalation threshold
+	EventHandoff      = "handoff"       // User noted where they left off when detaching
+	EventRule         = "rule"          // Session matched a workflow rule with the notify action
+	EventStateChange  = "state_change"  // Session state changed (working, idle, waiting, exited)
+	EventStatusChange = "status_change" // Implementation status changed (set by the user)
+	EventTimer        = "timer"         // Session timer elapsed
+	EventTokenBudget  = "token_budget"  // Session used more tokens than its budget
+)
+
+// Session is a session as returned by the API
+type Session struct {
+	Archived    bool      `json:"archived"`
+	Branch      string    `json:"branch,omitempty"`
+	CI          *CIStatus `json:"ci,omitempty"`
+	Comment     string    `json:"comment,omitempty"`
+	DisplayName string    `json:"display_name"`
+	External    bool      `json:"external"` // Runs in an existing directory without a rocha-managed worktree
+	Flagged     bool      `json:"flagged"`
+	LastUpdated time.Time `json:"last_updated"`
+	Model       string    `json:"model,omitempty"`
+	Name        string    `json:"name"`
+	Priority    string    `json:"priority,omitempty"`
+	Repo        string    `json:"repo,omitempty"` // owner/repo when known
+	State       string    `json:"state"`
+	Status      string    `json:"status,omitempty"` // Implementation status (empty when unset)
+	Subdir      string    `json:"subdir,omitempty"`
+	Tags        []string  `json:"tags,omitempty"` // Freeform labels, lowercase and sorted
+	WorkingDir  string    `json:"working_dir,omitempty"`
+}
+
+// CIStatus is the CI result of a session
+type CIStatus struct {
+	Commit     string    `json:"commit,omitempty"`
+	ReportedAt time.Time `json:"reported_at"`
+	State      string    `json:"state"`
+	URL        string    `json:"url,omitempty"`
+}
+
+// CreateSessionRequest is the body of POST /api/v1/sessions, mirroring 'rocha sessions add --start'
+type CreateSessionRequest struct {
+	AgentArgs                       string `json:"agent_args"`
+	AllowDangerouslySkipPermissions bool   `json:"allow_dangerously_skip_permissions"`
+	BranchName                      string `json:"branch_name"` // Branch for the worktree (defaults to one derived from Name)
+	DisplayName                     string `json:"display_name"`
+	Model                           string `json:"model"`
+	Name                            string `json:"name"`
+	Path                            string `json:"path"`   // Use an existing directory as-is instead of a worktree
+	Prompt                          string `json:"prompt"` // Prompt sent to Claude when it starts
+	RepoSource                      string `json:"repo_source"`
+	Subdir                          string `json:"subdir"`
+}
+
+// DeleteSessionResponse reports what deleting a session did with its worktree
+type DeleteSessionResponse struct {
+	WorktreeError   string `json:"worktree_error,omitempty"`
+	WorktreeKept    bool   `json:"worktree_kept"`
+	WorktreeRemoved bool   `json:"worktree_removed"`
+}
+
+// SendTextRequest is the body of POST /api/v1/sessions/{name}/send
+type SendTextRequest struct {
+	Confirmed bool   `json:"confirmed,omitempty"` // Send text matching the prompt review patterns
+	Text      string `json:"text"`
+}
+
+// SendTextResponse reports whether text was sent or queued behind the concurrency limit
+type SendTextResponse struct {
+	QueuedPromptID uint `json:"queued_prompt_id,omitempty"`
+	Sent           bool `json:"sent"`
+}
+
+// SetStatusRequest is the body of PUT /api/v1/sessions/{name}/status; an empty status clears it
+type SetStatusRequest struct {
+	Status string `json:"status"`
+}
+
+// CIReportRequest is the body of POST /api/v1/ci, sent by a CI job when it starts and finishes
+type CIReportRequest struct {
+	Branch string `json:"branch"`
+	Commit string `json:"commit"`
+	Repo   string `json:"repo"`  // owner/repo, empty to match the branch in any repository
+	State  string `json:"state"` // success, failure, or pending (and the usual synonyms)
+	URL    string `json:"url"`
+}
+
+// CIReportResponse lists the sessions the CI result was stored on
+type CIReportResponse struct {
+	Sessions []string `json:"sessions"`
+}
+
+// Event is the data of an event streamed by GET /api/v1/events, shaped like the webhook payload
+type Event struct {
+	Error     string    `json:"error,omitempty"`
+	Event     string    `json:"event"`
+	Message   string    `json:"message,omitempty"`
+	Session   string    `json:"session"`
+	State     string    `json:"state,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ErrorResponse is the body of every failed request
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
@@ -0,0 +1,203 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/renato0307/rocha/apitypes"
+)
+
+// Errors the API answers with; compare with errors.Is
+var (
+	ErrConfirmationRequired = errors.New("confirmation required")
+	ErrInvalidInput         = errors.New("invalid input")
+	ErrSessionExists        = errors.New("session already exists")
+	ErrSessionNotFound      = errors.New("session not found")
+	ErrUnauthorized         = errors.New("missing or invalid API token")
+)
+
+// Options configures a Client
+type Options struct {
+	BaseURL    string       // Address of 'rocha scheduler --api-addr', such as http://localhost:7878
+	HTTPClient *http.Client // Client sending the requests (default http.DefaultClient)
+	Token      string       // API token (default: the one in ROCHA_HOME/api-token)
+}
+
+// Client talks to the rocha REST API
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	token      string
+}
+
+// Error is a request the API failed; it unwraps to the error matching its status, if any
+type Error struct {
+	Message    string
+	StatusCode int
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// Unwrap returns the error matching the status of the response
+func (e *Error) Unwrap() error {
+	switch e.StatusCode {
+	case http.StatusBadRequest:
+		return ErrInvalidInput
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusNotFound:
+		return ErrSessionNotFound
+	case http.StatusConflict:
+		return ErrSessionExists
+	case http.StatusPreconditionRequired:
+		return ErrConfirmationRequired
+	default:
+		return nil
+	}
+}
+
+// New returns a Client of the API served at opts.BaseURL
+func New(opts Options) (*Client, error) {
+	if opts.BaseURL == "" {
+		return nil, fmt.Errorf("%w: base URL is required", ErrInvalidInput)
+	}
+
+	token := opts.Token
+	if token == "" {
+		path, err := defaultTokenPath()
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read API token: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+
+	httpClient := opts.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &Client{
+		baseURL:    strings.TrimSuffix(opts.BaseURL, "/"),
+		httpClient: httpClient,
+		token:      token,
+	}, nil
+}
+
+// ListSessions returns sessions in list order
+func (c *Client) ListSessions(ctx context.Context, opts ListOptions) ([]Session, error) {
+	path := "/api/v1/sessions"
+	if opts.IncludeArchived {
+		path += "?archived=true"
+	}
+
+	var sessions []Session
+	if err := c.do(ctx, http.MethodGet, path, nil, &sessions); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// GetSession returns a session by name, or ErrSessionNotFound
+func (c *Client) GetSession(ctx context.Context, name string) (*Session, error) {
+	var session Session
+	if err := c.do(ctx, http.MethodGet, "/api/v1/sessions/"+url.PathEscape(name), nil, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// CreateSession creates a session and starts Claude in a new tmux session
+func (c *Client) CreateSession(ctx context.Context, req CreateSessionRequest) (*Session, error) {
+	var session Session
+	if err := c.do(ctx, http.MethodPost, "/api/v1/sessions", req, &session); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// do sends a request with body encoded as JSON (none when nil) and decodes the response into out
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := c.newRequest(ctx, method, path, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call rocha API: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return responseError(resp)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+	return nil
+}
+
+// newRequest creates an authenticated request to path
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	return req, nil
+}
+
+// responseError reads the error of a failed response, falling back to its status
+func responseError(resp *http.Response) error {
+	var body apitypes.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil || body.Error == "" {
+		body.Error = resp.Status
+	}
+	return &Error{Message: body.Error, StatusCode: resp.StatusCode}
+}
+
+// defaultTokenPath returns ROCHA_HOME/api-token (ROCHA_HOME defaults to ~/.rocha),
+// where 'rocha scheduler --api-addr' stores the token
+func defaultTokenPath() (string, error) {
+	home := os.Getenv("ROCHA_HOME")
+	if home == "" || strings.HasPrefix(home, "~/") {
+		userHome, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find the home directory: %w", err)
+		}
+		if home == "" {
+			home = filepath.Join(userHome, ".rocha")
+		} else {
+			home = filepath.Join(userHome, home[2:])
+		}
+	}
+	return filepath.Join(home, "api-token"), nil
+}
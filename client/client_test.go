@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/apitypes"
+)
+
+// newTestClient returns a Client of an API served by handler, authenticated with "secret"
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	c, err := New(Options{BaseURL: server.URL, Token: "secret"})
+	require.NoError(t, err)
+	return c
+}
+
+func TestListSessions(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      ListOptions
+		wantQuery string
+	}{
+		{name: "active sessions", opts: ListOptions{}, wantQuery: ""},
+		{name: "including archived", opts: ListOptions{IncludeArchived: true}, wantQuery: "archived=true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+				assert.Equal(t, "/api/v1/sessions", r.URL.Path)
+				assert.Equal(t, tt.wantQuery, r.URL.RawQuery)
+				json.NewEncoder(w).Encode([]apitypes.Session{{Name: "s1", State: apitypes.StateIdle}})
+			})
+
+			sessions, err := c.ListSessions(context.Background(), tt.opts)
+
+			require.NoError(t, err)
+			assert.Equal(t, []Session{{Name: "s1", State: apitypes.StateIdle}}, sessions)
+		})
+	}
+}
+
+func TestCreateSession(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		var req apitypes.CreateSessionRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "fix-login", req.Name)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(apitypes.Session{Name: req.Name, State: apitypes.StateWorking})
+	})
+
+	session, err := c.CreateSession(context.Background(), CreateSessionRequest{Name: "fix-login", RepoSource: "https://github.com/owner/repo"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "fix-login", session.Name)
+}
+
+func TestGetSession_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr error
+	}{
+		{name: "unknown session", status: http.StatusNotFound, wantErr: ErrSessionNotFound},
+		{name: "wrong token", status: http.StatusUnauthorized, wantErr: ErrUnauthorized},
+		{name: "server error", status: http.StatusInternalServerError, wantErr: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/sessions/my session", r.URL.Path)
+				w.WriteHeader(tt.status)
+				json.NewEncoder(w).Encode(apitypes.ErrorResponse{Error: "failed on the server"})
+			})
+
+			_, err := c.GetSession(context.Background(), "my session")
+
+			require.Error(t, err)
+			assert.Equal(t, "failed on the server", err.Error())
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestEvents_ResumesAfterLastEvent(t *testing.T) {
+	var connections atomic.Int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/event-stream")
+		switch connections.Add(1) {
+		case 1:
+			assert.Empty(t, r.Header.Get("Last-Event-ID"))
+			fmt.Fprint(w, "id: 7\nevent: state_change\ndata: {\"event\":\"state_change\",\"session\":\"s1\",\"state\":\"working\"}\n\n")
+		default:
+			// The stream dropped after event 7, so the client resumes after it
+			assert.Equal(t, "7", r.Header.Get("Last-Event-ID"))
+			fmt.Fprint(w, "id: 8\nevent: archive\ndata: {\"event\":\"archive\",\"session\":\"s1\"}\n\n")
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	events, err := c.Events(ctx)
+	require.NoError(t, err)
+
+	first := <-events
+	assert.Equal(t, Event{Event: apitypes.EventStateChange, Session: "s1", State: apitypes.StateWorking}, first)
+	second := <-events
+	assert.Equal(t, Event{Event: apitypes.EventArchive, Session: "s1"}, second)
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestEvents_ReportsRejectedToken(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		json.NewEncoder(w).Encode(apitypes.ErrorResponse{Error: "missing or invalid API token"})
+	})
+
+	_, err := c.Events(context.Background())
+
+	assert.ErrorIs(t, err, ErrUnauthorized)
+}
+
+func TestNew_ReadsTokenFromRochaHome(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("ROCHA_HOME", home)
+	require.NoError(t, os.WriteFile(filepath.Join(home, "api-token"), []byte("from-file\n"), 0600))
+
+	c, err := New(Options{BaseURL: "http://localhost:7878/"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "from-file", c.token)
+	assert.Equal(t, "http://localhost:7878", c.baseURL)
+}
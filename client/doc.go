@@ -0,0 +1,14 @@
+// Package client is the public Go API for integrating with rocha.
+//
+// It talks to the REST API served by 'rocha scheduler --api-addr', so other Go
+// tools can list sessions, create sessions, and follow session events without
+// exec-ing the CLI, from the same machine or another one. The request and
+// response bodies are the types of the apitypes package.
+//
+//	c, err := client.New(client.Options{BaseURL: "http://localhost:7878"})
+//	if err != nil {
+//		return err
+//	}
+//
+//	sessions, err := c.ListSessions(ctx, client.ListOptions{})
+package client
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// eventReconnectDelay is how long Events waits before reconnecting a dropped stream
+const eventReconnectDelay = time.Second
+
+// Events streams the events stored after it is called, as the API server records them,
+// until ctx is cancelled, then closes the channel. A dropped stream is reconnected
+// from the last event received, so no event is missed.
+func (c *Client) Events(ctx context.Context) (<-chan Event, error) {
+	// The first connection is opened here so a wrong address or token is reported to the caller
+	body, err := c.openEvents(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+
+		lastID := ""
+		for {
+			if body != nil {
+				lastID = readEvents(ctx, body, lastID, events)
+				body.Close()
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(eventReconnectDelay):
+			}
+
+			// A failed reconnect is tried again after the delay
+			body, _ = c.openEvents(ctx, lastID)
+		}
+	}()
+
+	return events, nil
+}
+
+// openEvents connects to the event stream, resuming after lastID when set
+func (c *Client) openEvents(ctx context.Context, lastID string) (io.ReadCloser, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/api/v1/events", nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if lastID != "" {
+		req.Header.Set("Last-Event-ID", lastID)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open event stream: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, responseError(resp)
+	}
+	return resp.Body, nil
+}
+
+// readEvents sends the server-sent events of stream to events until the stream ends or
+// ctx is cancelled, and returns the ID of the last one sent (lastID when none was)
+func readEvents(ctx context.Context, stream io.Reader, lastID string, events chan<- Event) string {
+	scanner := bufio.NewScanner(stream)
+	var id, data string
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "id:"):
+			id = strings.TrimSpace(strings.TrimPrefix(line, "id:"))
+		case strings.HasPrefix(line, "data:"):
+			data = strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		case line == "" && data != "":
+			var event Event
+			if err := json.Unmarshal([]byte(data), &event); err == nil {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return lastID
+				}
+				if id != "" {
+					lastID = id
+				}
+			}
+			id, data = "", ""
+		}
+	}
+	return lastID
+}
@@ -0,0 +1,15 @@
+package client
+
+import "github.com/renato0307/rocha/apitypes"
+
+// Types shared with the API server
+type (
+	CreateSessionRequest = apitypes.CreateSessionRequest // Session to create
+	Event                = apitypes.Event                // Change to a session, streamed by Events
+	Session              = apitypes.Session              // Rocha session
+)
+
+// ListOptions filters ListSessions
+type ListOptions struct {
+	IncludeArchived bool
+}
@@ -63,7 +63,8 @@ func main() {
 
 	// Execute the selected command
 	if err := ctx.Run(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		code := cmd.ReportError(ctx, os.Stderr, err)
+		cli.Close() // os.Exit skips deferred calls; export the traces of the failed command
+		os.Exit(code)
 	}
 }
@@ -0,0 +1,108 @@
+package badges
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// commandTimeout bounds a run of the badge command, so a hung script cannot pile up runs
+const commandTimeout = 30 * time.Second
+
+// sessionInput is what the badge command reads about a session, one JSON array on stdin
+type sessionInput struct {
+	Branch       string   `json:"branch,omitempty"`
+	DisplayName  string   `json:"display_name,omitempty"`
+	Name         string   `json:"name"`
+	Repo         string   `json:"repo,omitempty"` // owner/repo
+	RepoPath     string   `json:"repo_path,omitempty"`
+	State        string   `json:"state"`
+	Status       string   `json:"status,omitempty"`
+	Tags         []string `json:"tags,omitempty"`
+	WorktreePath string   `json:"worktree_path,omitempty"`
+}
+
+// badgeOutput is one badge the badge command prints, one JSON object per line
+type badgeOutput struct {
+	Color   string `json:"color"`
+	Session string `json:"session"`
+	Text    string `json:"text"`
+}
+
+// CommandProvider implements ports.BadgeProvider with a shell command that reads the
+// sessions as JSON on stdin and prints a JSON line per badge, such as
+// {"session": "api", "text": "tests: red", "color": "196"}
+type CommandProvider struct {
+	command string
+}
+
+// NewCommandProvider creates a provider running command with sh -c
+func NewCommandProvider(command string) *CommandProvider {
+	return &CommandProvider{command: command}
+}
+
+// Badges runs the command and returns the badges it printed, by session name.
+// Badges of sessions that were not given are dropped.
+func (p *CommandProvider) Badges(ctx context.Context, sessions []domain.Session) (map[string][]domain.Badge, error) {
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+
+	input := make([]sessionInput, 0, len(sessions))
+	known := make(map[string]bool, len(sessions))
+	for _, session := range sessions {
+		known[session.Name] = true
+		entry := sessionInput{
+			Branch:       session.BranchName,
+			DisplayName:  session.DisplayName,
+			Name:         session.Name,
+			Repo:         session.RepoInfo,
+			RepoPath:     session.RepoPath,
+			State:        string(session.State),
+			Tags:         session.Tags,
+			WorktreePath: session.WorktreePath,
+		}
+		if session.Status != nil {
+			entry.Status = *session.Status
+		}
+		input = append(input, entry)
+	}
+	data, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal sessions: %w", err)
+	}
+
+	var stderr strings.Builder
+	cmd := exec.CommandContext(ctx, "sh", "-c", p.command)
+	cmd.Stdin = strings.NewReader(string(data))
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("badge command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	badges := make(map[string][]domain.Badge)
+	scanner := bufio.NewScanner(strings.NewReader(string(output)))
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		var badge badgeOutput
+		if err := json.Unmarshal([]byte(text), &badge); err != nil {
+			return nil, fmt.Errorf("badge command printed an invalid line %d: %w", line, err)
+		}
+		if !known[badge.Session] {
+			logging.Logger.Debug("Ignoring badge of unknown session", "session", badge.Session)
+			continue
+		}
+		badges[badge.Session] = append(badges[badge.Session], domain.Badge{Color: badge.Color, Text: badge.Text})
+	}
+	return badges, nil
+}
@@ -0,0 +1,58 @@
+package badges
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestCommandProvider_Badges(t *testing.T) {
+	review := "review"
+	sessions := []domain.Session{
+		{BranchName: "fix-login", Name: "api", RepoInfo: "acme/api", State: domain.StateIdle, Status: &review},
+		{Name: "web", State: domain.StateWorking},
+	}
+
+	tests := []struct {
+		name    string
+		command string
+		want    map[string][]domain.Badge
+		wantErr string
+	}{
+		{
+			name:    "parses a line per badge",
+			command: `printf '{"session":"api","text":"tests: red","color":"196"}\n\n{"session":"api","text":"deploy: pending"}\n'`,
+			want:    map[string][]domain.Badge{"api": {{Color: "196", Text: "tests: red"}, {Text: "deploy: pending"}}},
+		},
+		{
+			name:    "reads the sessions on stdin",
+			command: `grep -q '"branch":"fix-login"' && echo '{"session":"api","text":"ok"}'`,
+			want:    map[string][]domain.Badge{"api": {{Text: "ok"}}},
+		},
+		{
+			name:    "drops unknown sessions",
+			command: `echo '{"session":"gone","text":"tests: red"}'`,
+			want:    map[string][]domain.Badge{},
+		},
+		{name: "invalid line", command: "echo 'tests: red'", wantErr: "invalid line 1"},
+		{name: "failing command", command: "echo 'no token' >&2; exit 1", wantErr: "no token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			badges, err := NewCommandProvider(tt.command).Badges(context.Background(), sessions)
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, badges)
+		})
+	}
+}
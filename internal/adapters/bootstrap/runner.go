@@ -0,0 +1,127 @@
+package bootstrap
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// Runner implements ports.BootstrapRunner with the local filesystem and sh
+type Runner struct{}
+
+// NewRunner creates a new bootstrap runner
+func NewRunner() *Runner {
+	return &Runner{}
+}
+
+// CommandOutput runs command with sh -c and returns its stdout; stderr goes to the log
+func (r *Runner) CommandOutput(ctx context.Context, dir, command string) ([]byte, error) {
+	logging.Logger.Debug("Running command for output", "dir", dir, "command", command)
+
+	var stderr strings.Builder
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	if stderr.Len() > 0 {
+		logging.Logger.Debug("Command wrote to stderr", "command", command, "stderr", stderr.String())
+	}
+	return output, nil
+}
+
+// CopyPath copies a file or directory tree, keeping file permissions
+func (r *Runner) CopyPath(src, dst string) error {
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return copyFile(src, dst, info.Mode())
+	}
+
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		fileInfo, err := d.Info()
+		if err != nil {
+			return err
+		}
+		return copyFile(path, target, fileInfo.Mode())
+	})
+}
+
+// DetectEnvTools returns the tools found in PATH whose config files exist in dir
+func (r *Runner) DetectEnvTools(dir string) []domain.EnvTool {
+	var tools []domain.EnvTool
+	for _, tool := range domain.EnvTools {
+		if _, err := exec.LookPath(string(tool)); err != nil {
+			continue
+		}
+		for _, file := range tool.Files() {
+			if _, err := os.Stat(filepath.Join(dir, file)); err == nil {
+				tools = append(tools, tool)
+				break
+			}
+		}
+	}
+	return tools
+}
+
+// RunCommand runs command with sh -c so steps can use pipes and &&
+func (r *Runner) RunCommand(ctx context.Context, dir, command string, output io.Writer) error {
+	logging.Logger.Debug("Running bootstrap command", "dir", dir, "command", command)
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Dir = dir
+	cmd.Stdout = output
+	cmd.Stderr = output
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("%w: %w", err, ctx.Err())
+		}
+		return err
+	}
+	return nil
+}
+
+func copyFile(src, dst string, mode fs.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+
+	sourceFile, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer sourceFile.Close()
+
+	destFile, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode.Perm())
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(destFile, sourceFile); err != nil {
+		destFile.Close()
+		return err
+	}
+	return destFile.Close()
+}
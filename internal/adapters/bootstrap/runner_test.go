@@ -0,0 +1,49 @@
+package bootstrap
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyPath(t *testing.T) {
+	src := t.TempDir()
+	dst := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(src, ".env"), []byte("TOKEN=1\n"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "config", "local"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(src, "config", "local", "app.yaml"), []byte("debug: true\n"), 0644))
+
+	runner := NewRunner()
+	require.NoError(t, runner.CopyPath(filepath.Join(src, ".env"), filepath.Join(dst, ".env")))
+	require.NoError(t, runner.CopyPath(filepath.Join(src, "config"), filepath.Join(dst, "config")))
+
+	data, err := os.ReadFile(filepath.Join(dst, ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, "TOKEN=1\n", string(data))
+	info, err := os.Stat(filepath.Join(dst, ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm(), "file permissions should be kept")
+
+	data, err = os.ReadFile(filepath.Join(dst, "config", "local", "app.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "debug: true\n", string(data))
+
+	assert.Error(t, runner.CopyPath(filepath.Join(src, "missing"), filepath.Join(dst, "missing")))
+}
+
+func TestRunCommand(t *testing.T) {
+	dir := t.TempDir()
+	runner := NewRunner()
+
+	var output bytes.Buffer
+	require.NoError(t, runner.RunCommand(context.Background(), dir, "pwd && echo oops >&2", &output))
+	assert.Contains(t, output.String(), filepath.Base(dir))
+	assert.Contains(t, output.String(), "oops")
+
+	assert.Error(t, runner.RunCommand(context.Background(), dir, "exit 3", &output))
+}
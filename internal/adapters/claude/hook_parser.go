@@ -80,7 +80,7 @@ func (p *HookParser) GetHookEvents(filter ports.HookFilter) ([]domain.HookEvent,
 	return allEvents, nil
 }
 
-// buildSessionMap creates a map from worktree path to session name
+// buildSessionMap creates a map from the directory Claude runs in (see domain.Session.StartDir) to session name
 func (p *HookParser) buildSessionMap() (map[string]string, error) {
 	ctx := context.Background()
 	sessions, err := p.sessionReader.List(ctx, true) // include archived sessions
@@ -90,8 +90,8 @@ func (p *HookParser) buildSessionMap() (map[string]string, error) {
 
 	sessionMap := make(map[string]string)
 	for _, session := range sessions {
-		if session.WorktreePath != "" {
-			sessionMap[session.WorktreePath] = session.Name
+		if startDir := session.StartDir(); startDir != "" {
+			sessionMap[startDir] = session.Name
 		}
 	}
 
@@ -83,14 +83,52 @@ func (p *SessionParser) GetTodayUsage() ([]ports.TokenUsage, error) {
 	return allUsage, nil
 }
 
+// GetSessionUsage implements TokenUsageReader.GetSessionUsage
+// Conversations are found like transcripts: in the project directory of workingDir,
+// counting only the lines recorded there, plus the conversationID file wherever it is.
+func (p *SessionParser) GetSessionUsage(claudeDir, workingDir, conversationID string) (ports.TokenTotals, error) {
+	var totals ports.TokenTotals
+	projectsDir := filepath.Join(claudeDir, "projects")
+
+	files, err := filepath.Glob(filepath.Join(projectsDir, projectDirPattern.ReplaceAllString(workingDir, "-"), "*.jsonl"))
+	if err != nil {
+		return totals, err
+	}
+	if conversationID != "" {
+		recorded, err := filepath.Glob(filepath.Join(projectsDir, "*", conversationID+".jsonl"))
+		if err != nil {
+			return totals, err
+		}
+		files = append(files, recorded...)
+	}
+
+	seen := make(map[string]bool)
+	for _, file := range files {
+		if seen[file] {
+			continue
+		}
+		seen[file] = true
+
+		if err := p.sumJSONLFile(file, workingDir, conversationID, &totals); err != nil {
+			logging.Logger.Debug("Failed to parse JSONL file", "file", file, "error", err)
+		}
+	}
+
+	logging.Logger.Debug("Parsed session token usage", "files", len(seen), "input", totals.InputTokens, "output", totals.OutputTokens)
+	return totals, nil
+}
+
 // jsonlEntry represents a single entry in the JSONL file
 type jsonlEntry struct {
+	Cwd       string        `json:"cwd"`
 	Message   *jsonlMessage `json:"message"`
+	SessionID string        `json:"sessionId"`
 	Timestamp string        `json:"timestamp"`
 	Type      string        `json:"type"`
 }
 
 type jsonlMessage struct {
+	Model string      `json:"model"`
 	Usage *jsonlUsage `json:"usage"`
 }
 
@@ -152,6 +190,7 @@ func (p *SessionParser) parseJSONLFile(filePath string, todayStart time.Time) ([
 			CacheCreation: entry.Message.Usage.CacheCreationInputTokens,
 			CacheRead:     entry.Message.Usage.CacheReadInputTokens,
 			InputTokens:   entry.Message.Usage.InputTokens,
+			Model:         entry.Message.Model,
 			OutputTokens:  entry.Message.Usage.OutputTokens,
 			Timestamp:     timestamp,
 		})
@@ -163,3 +202,37 @@ func (p *SessionParser) parseJSONLFile(filePath string, todayStart time.Time) ([
 
 	return usage, nil
 }
+
+// sumJSONLFile adds the assistant token usage of a JSONL file to totals.
+// Lines from other working directories are skipped unless they belong to conversationID.
+func (p *SessionParser) sumJSONLFile(filePath, workingDir, conversationID string, totals *ports.TokenTotals) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	buf := make([]byte, 0, 1024*1024) // 1MB buffer
+	scanner.Buffer(buf, 10*1024*1024) // 10MB max line size
+
+	for scanner.Scan() {
+		var entry jsonlEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		if entry.Type != "assistant" || entry.Message == nil || entry.Message.Usage == nil {
+			continue
+		}
+		if entry.Cwd != workingDir && (conversationID == "" || entry.SessionID != conversationID) {
+			continue
+		}
+
+		totals.CacheCreation += entry.Message.Usage.CacheCreationInputTokens
+		totals.CacheRead += entry.Message.Usage.CacheReadInputTokens
+		totals.InputTokens += entry.Message.Usage.InputTokens
+		totals.OutputTokens += entry.Message.Usage.OutputTokens
+	}
+
+	return scanner.Err()
+}
@@ -269,3 +269,34 @@ func TestNewSessionParser_DefaultDirectory(t *testing.T) {
 	// Just verify it doesn't panic and creates a parser
 	assert.NotNil(t, parser)
 }
+
+func TestGetSessionUsage_SumsConversationsOfWorkingDir(t *testing.T) {
+	claudeDir := t.TempDir()
+	workingDir := "/home/dev/.rocha/worktrees/api-cart"
+	projectDir := filepath.Join(claudeDir, "projects", "-home-dev--rocha-worktrees-api-cart")
+	otherDir := filepath.Join(claudeDir, "projects", "-home-dev-other")
+	require.NoError(t, os.MkdirAll(projectDir, 0755))
+	require.NoError(t, os.MkdirAll(otherDir, 0755))
+
+	content := `{"type":"user","cwd":"/home/dev/.rocha/worktrees/api-cart","message":{"content":"hi"}}
+{"type":"assistant","cwd":"/home/dev/.rocha/worktrees/api-cart","sessionId":"c1","message":{"usage":{"input_tokens":100,"output_tokens":50,"cache_read_input_tokens":5}}}
+{"type":"assistant","cwd":"/home/dev/.rocha/worktrees/api-cart/sub","sessionId":"c2","message":{"usage":{"input_tokens":7,"output_tokens":7}}}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "c1.jsonl"), []byte(content), 0644))
+	recorded := `{"type":"assistant","cwd":"/home/dev/other","sessionId":"c3","message":{"usage":{"input_tokens":20,"output_tokens":10}}}
+`
+	require.NoError(t, os.WriteFile(filepath.Join(otherDir, "c3.jsonl"), []byte(recorded), 0644))
+
+	parser := NewSessionParserWithDir(t.TempDir())
+
+	totals, err := parser.GetSessionUsage(claudeDir, workingDir, "")
+	require.NoError(t, err)
+	assert.Equal(t, 100, totals.InputTokens)
+	assert.Equal(t, 50, totals.OutputTokens)
+	assert.Equal(t, 5, totals.CacheRead)
+
+	totals, err = parser.GetSessionUsage(claudeDir, workingDir, "c3")
+	require.NoError(t, err)
+	assert.Equal(t, 120, totals.InputTokens, "the recorded conversation counts wherever it was started")
+	assert.Equal(t, 60, totals.OutputTokens)
+}
@@ -0,0 +1,193 @@
+package claude
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// toolSummaryLength caps the tool input shown next to a tool call
+const toolSummaryLength = 120
+
+// projectDirPattern matches the characters Claude replaces when naming a project directory
+var projectDirPattern = regexp.MustCompile(`[^A-Za-z0-9]`)
+
+// TranscriptReader reads Claude conversation JSONL files into transcript entries
+type TranscriptReader struct{}
+
+// Verify interface compliance at compile time
+var _ ports.TranscriptReader = (*TranscriptReader)(nil)
+
+// NewTranscriptReader creates a new TranscriptReader
+func NewTranscriptReader() *TranscriptReader {
+	return &TranscriptReader{}
+}
+
+// ReadTranscript implements TranscriptReader.ReadTranscript
+func (r *TranscriptReader) ReadTranscript(claudeDir, workingDir, conversationID string) ([]domain.TranscriptEntry, error) {
+	projectsDir := filepath.Join(claudeDir, "projects")
+
+	// Claude stores conversations under projects/<working dir with non-alphanumerics as '-'>
+	files, err := filepath.Glob(filepath.Join(projectsDir, projectDirPattern.ReplaceAllString(workingDir, "-"), "*.jsonl"))
+	if err != nil {
+		return nil, err
+	}
+	if conversationID != "" {
+		recorded, err := filepath.Glob(filepath.Join(projectsDir, "*", conversationID+".jsonl"))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, recorded...)
+	}
+
+	var entries []domain.TranscriptEntry
+	seen := make(map[string]bool)
+	for _, file := range files {
+		if seen[file] {
+			continue
+		}
+		seen[file] = true
+
+		fileEntries, err := parseTranscriptFile(file, workingDir, conversationID)
+		if err != nil {
+			logging.Logger.Debug("Failed to parse conversation file", "file", file, "error", err)
+			continue
+		}
+		entries = append(entries, fileEntries...)
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Timestamp.Before(entries[j].Timestamp)
+	})
+	logging.Logger.Debug("Read transcript", "files", len(seen), "entries", len(entries))
+	return entries, nil
+}
+
+// transcriptLine is the subset of a conversation JSONL line needed for transcripts
+type transcriptLine struct {
+	Cwd         string `json:"cwd"`
+	IsMeta      bool   `json:"isMeta"`
+	IsSidechain bool   `json:"isSidechain"`
+	Message     *struct {
+		Content json.RawMessage `json:"content"`
+	} `json:"message"`
+	SessionID string `json:"sessionId"`
+	Timestamp string `json:"timestamp"`
+	Type      string `json:"type"`
+}
+
+// contentBlock is one block of a message's content array
+type contentBlock struct {
+	Input json.RawMessage `json:"input"`
+	Name  string          `json:"name"`
+	Text  string          `json:"text"`
+	Type  string          `json:"type"`
+}
+
+// parseTranscriptFile reads the user, assistant, and tool call entries of one conversation file.
+// Lines from other working directories are skipped unless they belong to conversationID.
+func parseTranscriptFile(filePath, workingDir, conversationID string) ([]domain.TranscriptEntry, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []domain.TranscriptEntry
+	scanner := bufio.NewScanner(file)
+	buf := make([]byte, 0, 1024*1024) // 1MB buffer
+	scanner.Buffer(buf, 10*1024*1024) // 10MB max line size
+
+	for scanner.Scan() {
+		var line transcriptLine
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+		if line.Message == nil || line.IsMeta || line.IsSidechain || (line.Type != "user" && line.Type != "assistant") {
+			continue
+		}
+		if line.Cwd != workingDir && (conversationID == "" || line.SessionID != conversationID) {
+			continue
+		}
+
+		timestamp, err := time.Parse(time.RFC3339, line.Timestamp)
+		if err != nil {
+			continue
+		}
+
+		for _, entry := range messageEntries(line.Type, line.Message.Content) {
+			entry.ConversationID = line.SessionID
+			entry.Timestamp = timestamp
+			entries = append(entries, entry)
+		}
+	}
+
+	return entries, scanner.Err()
+}
+
+// messageEntries converts message content (a string or an array of blocks) into entries.
+// Tool results and thinking blocks are left out to keep the transcript readable.
+func messageEntries(messageType string, content json.RawMessage) []domain.TranscriptEntry {
+	role := domain.TranscriptUser
+	if messageType == "assistant" {
+		role = domain.TranscriptAssistant
+	}
+
+	var text string
+	if err := json.Unmarshal(content, &text); err == nil {
+		if text = strings.TrimSpace(text); text == "" {
+			return nil
+		}
+		return []domain.TranscriptEntry{{Role: role, Text: text}}
+	}
+
+	var blocks []contentBlock
+	if err := json.Unmarshal(content, &blocks); err != nil {
+		return nil
+	}
+
+	var entries []domain.TranscriptEntry
+	for _, block := range blocks {
+		switch block.Type {
+		case "text":
+			if text := strings.TrimSpace(block.Text); text != "" {
+				entries = append(entries, domain.TranscriptEntry{Role: role, Text: text})
+			}
+		case "tool_use":
+			entries = append(entries, domain.TranscriptEntry{Role: domain.TranscriptTool, Text: summarizeToolUse(block)})
+		}
+	}
+	return entries
+}
+
+// summarizeToolUse describes a tool call on one line, e.g. "Bash: go test ./..."
+func summarizeToolUse(block contentBlock) string {
+	var input map[string]any
+	_ = json.Unmarshal(block.Input, &input)
+
+	var summary string
+	for _, key := range []string{"command", "file_path", "pattern", "url", "description", "prompt"} {
+		if value, ok := input[key].(string); ok && value != "" {
+			summary = value
+			break
+		}
+	}
+	if summary == "" {
+		return block.Name
+	}
+
+	summary = strings.Join(strings.Fields(summary), " ")
+	if len([]rune(summary)) > toolSummaryLength {
+		summary = string([]rune(summary)[:toolSummaryLength-1]) + "…"
+	}
+	return block.Name + ": " + summary
+}
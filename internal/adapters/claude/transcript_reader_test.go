@@ -0,0 +1,61 @@
+package claude
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func writeConversation(t *testing.T, dir, name, content string) {
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+}
+
+func TestReadTranscript_ParsesConversation(t *testing.T) {
+	claudeDir := t.TempDir()
+	workingDir := "/home/me/.rocha/worktrees/feature"
+	projectDir := filepath.Join(claudeDir, "projects", "-home-me--rocha-worktrees-feature")
+
+	writeConversation(t, projectDir, "c1.jsonl", `{"type":"user","cwd":"/home/me/.rocha/worktrees/feature","sessionId":"c1","timestamp":"2026-01-02T10:00:00Z","message":{"role":"user","content":"Fix the login bug"}}
+{"type":"assistant","cwd":"/home/me/.rocha/worktrees/feature","sessionId":"c1","timestamp":"2026-01-02T10:00:05Z","message":{"content":[{"type":"thinking","thinking":"hmm"},{"type":"text","text":"Looking at it."},{"type":"tool_use","name":"Bash","input":{"command":"go test ./..."}}]}}
+{"type":"user","cwd":"/home/me/.rocha/worktrees/feature","sessionId":"c1","timestamp":"2026-01-02T10:00:09Z","message":{"content":[{"type":"tool_result","content":"ok"}]}}
+{"type":"user","cwd":"/home/me/.rocha/worktrees/feature","sessionId":"c1","timestamp":"2026-01-02T10:00:10Z","isMeta":true,"message":{"content":"meta"}}
+{"type":"summary","summary":"Login fix"}
+`)
+	// Same project directory, different working directory (name collision)
+	writeConversation(t, projectDir, "other.jsonl", `{"type":"user","cwd":"/home/me/.rocha/worktrees-feature","sessionId":"other","timestamp":"2026-01-02T09:00:00Z","message":{"content":"not ours"}}
+`)
+
+	entries, err := NewTranscriptReader().ReadTranscript(claudeDir, workingDir, "")
+
+	require.NoError(t, err)
+	require.Len(t, entries, 3)
+	assert.Equal(t, domain.TranscriptEntry{ConversationID: "c1", Role: domain.TranscriptUser, Text: "Fix the login bug", Timestamp: entries[0].Timestamp}, entries[0])
+	assert.Equal(t, "Looking at it.", entries[1].Text)
+	assert.Equal(t, domain.TranscriptTool, entries[2].Role)
+	assert.Equal(t, "Bash: go test ./...", entries[2].Text)
+}
+
+func TestReadTranscript_IncludesRecordedConversationElsewhere(t *testing.T) {
+	claudeDir := t.TempDir()
+	writeConversation(t, filepath.Join(claudeDir, "projects", "-somewhere-else"), "c2.jsonl", `{"type":"user","cwd":"/somewhere/else","sessionId":"c2","timestamp":"2026-01-02T10:00:00Z","message":{"content":"hello"}}
+`)
+
+	entries, err := NewTranscriptReader().ReadTranscript(claudeDir, "/repo/dir", "c2")
+
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "hello", entries[0].Text)
+}
+
+func TestReadTranscript_NoConversations(t *testing.T) {
+	entries, err := NewTranscriptReader().ReadTranscript(t.TempDir(), "/repo/dir", "")
+
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
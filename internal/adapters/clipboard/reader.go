@@ -0,0 +1,41 @@
+package clipboard
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// Reader implements ports.ClipboardReader
+type Reader struct{}
+
+// NewReader creates a new clipboard reader
+func NewReader() *Reader {
+	return &Reader{}
+}
+
+// Paste returns the output of the first clipboard tool available on this platform.
+// Platform-specific candidates are in reader_*.go files with build tags.
+func (r *Reader) Paste() (string, error) {
+	for _, candidate := range platformPasteCommands() {
+		path, err := exec.LookPath(candidate.name)
+		if err != nil {
+			continue
+		}
+
+		logging.Logger.Debug("Reading from clipboard", "tool", candidate.name)
+
+		var stderr strings.Builder
+		cmd := exec.Command(path, candidate.args...)
+		cmd.Stderr = &stderr
+		output, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("%s failed: %w: %s", candidate.name, err, strings.TrimSpace(stderr.String()))
+		}
+		return string(output), nil
+	}
+
+	return "", fmt.Errorf("no clipboard tool found (tried %s)", strings.Join(commandNames(platformPasteCommands()), ", "))
+}
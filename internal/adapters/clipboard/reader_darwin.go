@@ -0,0 +1,9 @@
+//go:build darwin
+
+package clipboard
+
+func platformPasteCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "pbpaste"},
+	}
+}
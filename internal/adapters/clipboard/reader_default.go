@@ -0,0 +1,11 @@
+//go:build !linux && !darwin && !windows
+
+package clipboard
+
+func platformPasteCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "wl-paste", args: []string{"--no-newline"}},
+		{name: "xclip", args: []string{"-selection", "clipboard", "-out"}},
+		{name: "xsel", args: []string{"--clipboard", "--output"}},
+	}
+}
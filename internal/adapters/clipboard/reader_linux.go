@@ -0,0 +1,12 @@
+//go:build linux
+
+package clipboard
+
+// platformPasteCommands prefers Wayland, then X11 tools
+func platformPasteCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "wl-paste", args: []string{"--no-newline"}},
+		{name: "xclip", args: []string{"-selection", "clipboard", "-out"}},
+		{name: "xsel", args: []string{"--clipboard", "--output"}},
+	}
+}
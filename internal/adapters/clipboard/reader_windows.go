@@ -0,0 +1,9 @@
+//go:build windows
+
+package clipboard
+
+func platformPasteCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "powershell.exe", args: []string{"-NoProfile", "-Command", "Get-Clipboard -Raw"}},
+	}
+}
@@ -0,0 +1,71 @@
+package clipboard
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// copyTimeout bounds a clipboard tool, so one that never exits cannot hang the caller
+const copyTimeout = 5 * time.Second
+
+// clipboardCommand is a clipboard tool and the arguments that make it copy from stdin or paste to stdout
+type clipboardCommand struct {
+	args []string
+	name string
+}
+
+// Writer implements ports.ClipboardWriter
+type Writer struct{}
+
+// NewWriter creates a new clipboard writer
+func NewWriter() *Writer {
+	return &Writer{}
+}
+
+// Copy pipes text into the first clipboard tool available on this platform.
+// Platform-specific candidates are in writer_*.go files with build tags.
+// xclip and wl-copy fork a child that keeps serving the clipboard with the output of
+// the tool, so the output goes to the null device instead of a pipe that would stay open.
+func (w *Writer) Copy(text string) error {
+	return copyWith(platformCommands(), text)
+}
+
+// copyWith pipes text into the first of commands that is installed
+func copyWith(commands []clipboardCommand, text string) error {
+	for _, candidate := range commands {
+		path, err := exec.LookPath(candidate.name)
+		if err != nil {
+			continue
+		}
+
+		logging.Logger.Debug("Copying to clipboard", "tool", candidate.name, "bytes", len(text))
+
+		ctx, cancel := context.WithTimeout(context.Background(), copyTimeout)
+		defer cancel()
+
+		cmd := exec.CommandContext(ctx, path, candidate.args...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err != nil {
+			if ctx.Err() == context.DeadlineExceeded {
+				return fmt.Errorf("%s did not exit within %s", candidate.name, copyTimeout)
+			}
+			return fmt.Errorf("%s failed: %w", candidate.name, err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("no clipboard tool found (tried %s)", strings.Join(commandNames(commands), ", "))
+}
+
+func commandNames(commands []clipboardCommand) []string {
+	names := make([]string, 0, len(commands))
+	for _, c := range commands {
+		names = append(names, c.name)
+	}
+	return names
+}
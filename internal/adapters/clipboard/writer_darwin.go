@@ -0,0 +1,9 @@
+//go:build darwin
+
+package clipboard
+
+func platformCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "pbcopy"},
+	}
+}
@@ -0,0 +1,11 @@
+//go:build !linux && !darwin && !windows
+
+package clipboard
+
+func platformCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "wl-copy"},
+		{name: "xclip", args: []string{"-selection", "clipboard"}},
+		{name: "xsel", args: []string{"--clipboard", "--input"}},
+	}
+}
@@ -0,0 +1,12 @@
+//go:build linux
+
+package clipboard
+
+// platformCommands prefers Wayland, then X11 tools
+func platformCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "wl-copy"},
+		{name: "xclip", args: []string{"-selection", "clipboard"}},
+		{name: "xsel", args: []string{"--clipboard", "--input"}},
+	}
+}
@@ -0,0 +1,65 @@
+package clipboard
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyWith(t *testing.T) {
+	dir := t.TempDir()
+	copied := filepath.Join(dir, "clipboard")
+
+	tests := []struct {
+		name     string
+		commands []clipboardCommand
+		wantErr  string
+	}{
+		{
+			name: "skips tools that are not installed",
+			commands: []clipboardCommand{
+				{name: "rocha-missing-clipboard-tool"},
+				{name: "sh", args: []string{"-c", "cat > " + copied}},
+			},
+		},
+		{
+			name: "returns once a tool that forks a server exits",
+			commands: []clipboardCommand{
+				// Like xclip and wl-copy: the child keeps running with the output of the tool
+				{name: "sh", args: []string{"-c", "cat > " + copied + "; sleep 30 &"}},
+			},
+		},
+		{
+			name:     "reports a failing tool",
+			commands: []clipboardCommand{{name: "sh", args: []string{"-c", "exit 3"}}},
+			wantErr:  "sh failed",
+		},
+		{
+			name:     "reports when no tool is installed",
+			commands: []clipboardCommand{{name: "rocha-missing-clipboard-tool"}},
+			wantErr:  "no clipboard tool found (tried rocha-missing-clipboard-tool)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = os.Remove(copied)
+			start := time.Now()
+			err := copyWith(tt.commands, "feature/login")
+			assert.Less(t, time.Since(start), copyTimeout)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			content, err := os.ReadFile(copied)
+			require.NoError(t, err)
+			assert.Equal(t, "feature/login", string(content))
+		})
+	}
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package clipboard
+
+func platformCommands() []clipboardCommand {
+	return []clipboardCommand{
+		{name: "clip.exe"},
+	}
+}
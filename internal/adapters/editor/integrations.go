@@ -0,0 +1,105 @@
+package editor
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// lookPath is swapped in tests to control which launchers are installed
+var lookPath = exec.LookPath
+
+var vscodeLaunchers = []string{
+	"code",
+	"code-insiders",
+}
+
+// jetbrainsLaunchers are the shell scripts installed by the IDEs or JetBrains Toolbox
+var jetbrainsLaunchers = []string{
+	"idea",
+	"goland",
+	"pycharm",
+	"webstorm",
+	"rustrover",
+	"clion",
+	"rider",
+	"phpstorm",
+	"rubymine",
+}
+
+var zedLaunchers = []string{
+	"zed",
+	"zeditor", // Name used by some Linux packages
+}
+
+// findIntegrationEditor returns the command and arguments for a non-default integration
+func findIntegrationEditor(target domain.EditorTarget) (string, []string, error) {
+	args := append([]string{target.Path}, target.Files...)
+
+	switch target.Integration {
+	case domain.EditorIntegrationVSCode:
+		// --reuse-window targets the focused window; from a Remote-SSH terminal
+		// the CLI talks to the local VS Code through $VSCODE_IPC_HOOK_CLI
+		launcher := findLauncher(vscodeLaunchers)
+		if launcher == "" {
+			return "", nil, fmt.Errorf("VS Code CLI not found: install the 'code' command in PATH")
+		}
+		return launcher, append([]string{"--reuse-window"}, args...), nil
+
+	case domain.EditorIntegrationJetBrains:
+		// Over SSH there is no local IDE to launch, so hand over a Gateway link instead
+		if sshConnection := os.Getenv("SSH_CONNECTION"); sshConnection != "" {
+			hostname, _ := os.Hostname()
+			link := gatewayLink(sshConnection, hostname, os.Getenv("USER"), target.Path)
+			return "", nil, fmt.Errorf("cannot launch a JetBrains IDE over SSH, open this link with JetBrains Gateway: %s", link)
+		}
+		launcher := findLauncher(jetbrainsLaunchers)
+		if launcher == "" {
+			return "", nil, fmt.Errorf("no JetBrains IDE launcher found (%s): enable shell scripts in JetBrains Toolbox",
+				strings.Join(jetbrainsLaunchers, ", "))
+		}
+		return launcher, args, nil
+
+	case domain.EditorIntegrationZed:
+		launcher := findLauncher(zedLaunchers)
+		if launcher == "" {
+			return "", nil, fmt.Errorf("zed CLI not found: install it from the zed menu (Install CLI)")
+		}
+		return launcher, args, nil
+
+	default:
+		return "", nil, fmt.Errorf("unsupported editor integration: %s", target.Integration)
+	}
+}
+
+// findLauncher returns the first launcher found in PATH
+func findLauncher(launchers []string) string {
+	for _, launcher := range launchers {
+		if _, err := lookPath(launcher); err == nil {
+			return launcher
+		}
+	}
+	return ""
+}
+
+// gatewayLink builds a JetBrains Gateway link to open path on this host
+// sshConnection is $SSH_CONNECTION: "client_ip client_port server_ip server_port"
+func gatewayLink(sshConnection, host, user, path string) string {
+	port := "22"
+	if fields := strings.Fields(sshConnection); len(fields) == 4 {
+		port = fields[3]
+	}
+
+	params := url.Values{}
+	params.Set("deploy", "false")
+	params.Set("host", host)
+	params.Set("port", port)
+	params.Set("projectPath", path)
+	params.Set("type", "ssh")
+	params.Set("user", user)
+	return "jetbrains-gateway://connect#" + params.Encode()
+}
@@ -0,0 +1,128 @@
+package editor
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// stubLookPath makes only the given launchers resolvable for the duration of the test
+func stubLookPath(t *testing.T, installed ...string) {
+	t.Helper()
+	original := lookPath
+	t.Cleanup(func() { lookPath = original })
+
+	lookPath = func(file string) (string, error) {
+		for _, name := range installed {
+			if name == file {
+				return "/usr/bin/" + file, nil
+			}
+		}
+		return "", errors.New("not found")
+	}
+}
+
+func TestFindIntegrationEditor(t *testing.T) {
+	files := []string{"/work/wt/main.go", "/work/wt/README.md"}
+
+	tests := []struct {
+		name        string
+		installed   []string
+		integration domain.EditorIntegration
+		wantEditor  string
+		wantArgs    []string
+		wantErr     string
+	}{
+		{
+			name:        "vscode reuses the current window",
+			installed:   []string{"code"},
+			integration: domain.EditorIntegrationVSCode,
+			wantEditor:  "code",
+			wantArgs:    []string{"--reuse-window", "/work/wt", "/work/wt/main.go", "/work/wt/README.md"},
+		},
+		{
+			name:        "vscode insiders fallback",
+			installed:   []string{"code-insiders"},
+			integration: domain.EditorIntegrationVSCode,
+			wantEditor:  "code-insiders",
+			wantArgs:    []string{"--reuse-window", "/work/wt", "/work/wt/main.go", "/work/wt/README.md"},
+		},
+		{
+			name:        "vscode missing",
+			integration: domain.EditorIntegrationVSCode,
+			wantErr:     "VS Code CLI not found",
+		},
+		{
+			name:        "jetbrains picks the first installed launcher",
+			installed:   []string{"goland", "pycharm"},
+			integration: domain.EditorIntegrationJetBrains,
+			wantEditor:  "goland",
+			wantArgs:    []string{"/work/wt", "/work/wt/main.go", "/work/wt/README.md"},
+		},
+		{
+			name:        "jetbrains missing",
+			integration: domain.EditorIntegrationJetBrains,
+			wantErr:     "no JetBrains IDE launcher found",
+		},
+		{
+			name:        "zed alternate binary name",
+			installed:   []string{"zeditor"},
+			integration: domain.EditorIntegrationZed,
+			wantEditor:  "zeditor",
+			wantArgs:    []string{"/work/wt", "/work/wt/main.go", "/work/wt/README.md"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("SSH_CONNECTION", "")
+			stubLookPath(t, tt.installed...)
+
+			editor, args, err := findIntegrationEditor(domain.EditorTarget{
+				Files:       files,
+				Integration: tt.integration,
+				Path:        "/work/wt",
+			})
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantEditor, editor)
+			assert.Equal(t, tt.wantArgs, args)
+		})
+	}
+}
+
+func TestFindIntegrationEditor_JetBrainsOverSSHReturnsGatewayLink(t *testing.T) {
+	t.Setenv("SSH_CONNECTION", "10.0.0.2 51234 10.0.0.1 2222")
+	stubLookPath(t, "idea")
+
+	_, _, err := findIntegrationEditor(domain.EditorTarget{
+		Integration: domain.EditorIntegrationJetBrains,
+		Path:        "/work/wt",
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "jetbrains-gateway://connect#")
+	assert.Contains(t, err.Error(), "port=2222")
+}
+
+func TestGatewayLink(t *testing.T) {
+	link := gatewayLink("10.0.0.2 51234 10.0.0.1 2222", "devbox", "alice", "/home/alice/wt/my repo")
+
+	assert.Equal(t,
+		"jetbrains-gateway://connect#deploy=false&host=devbox&port=2222&projectPath=%2Fhome%2Falice%2Fwt%2Fmy+repo&type=ssh&user=alice",
+		link)
+}
+
+func TestGatewayLink_DefaultsPort(t *testing.T) {
+	link := gatewayLink("", "devbox", "alice", "/wt")
+
+	assert.Contains(t, link, "port=22&")
+}
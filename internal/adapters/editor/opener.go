@@ -5,6 +5,7 @@ import (
 	"os"
 	"os/exec"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 )
 
@@ -16,10 +17,10 @@ func NewOpener() *Opener {
 	return &Opener{}
 }
 
-// Open opens the specified directory in an editor
-// Priority: cliEditor → $ROCHA_EDITOR → $VISUAL → $EDITOR → platform defaults
-func (o *Opener) Open(path string, cliEditor string) error {
-	return openInEditorImpl(path, cliEditor)
+// Open opens the target in the editor selected by its integration
+// Default integration priority: cliEditor → $ROCHA_EDITOR → $VISUAL → $EDITOR → platform defaults
+func (o *Opener) Open(target domain.EditorTarget, cliEditor string) error {
+	return openInEditorImpl(target, cliEditor)
 }
 
 // Package-level function for backwards compatibility
@@ -27,24 +28,38 @@ func (o *Opener) Open(path string, cliEditor string) error {
 // OpenInEditor opens the specified directory in an editor
 // Priority: cliEditor → $ROCHA_EDITOR → $VISUAL → $EDITOR → platform defaults
 func OpenInEditor(path string, cliEditor string) error {
-	return openInEditorImpl(path, cliEditor)
+	return openInEditorImpl(domain.EditorTarget{Path: path}, cliEditor)
 }
 
-func openInEditorImpl(path string, cliEditor string) error {
-	if path == "" {
+func openInEditorImpl(target domain.EditorTarget, cliEditor string) error {
+	if target.Path == "" {
 		return fmt.Errorf("no path provided")
 	}
 
-	if _, err := os.Stat(path); err != nil {
+	if _, err := os.Stat(target.Path); err != nil {
 		return fmt.Errorf("path does not exist: %w", err)
 	}
 
-	editor, args := findEditor(path, cliEditor)
-	if editor == "" {
-		return fmt.Errorf("no suitable editor found. Set --editor flag, $ROCHA_EDITOR, $VISUAL, or $EDITOR")
+	var editor string
+	var args []string
+	if target.Integration == domain.EditorIntegrationDefault {
+		editor, args = findEditor(target.Path, target.Files, cliEditor)
+		if editor == "" {
+			return fmt.Errorf("no suitable editor found. Set --editor flag, $ROCHA_EDITOR, $VISUAL, or $EDITOR")
+		}
+	} else {
+		var err error
+		editor, args, err = findIntegrationEditor(target)
+		if err != nil {
+			return err
+		}
 	}
 
-	logging.Logger.Info("Opening editor", "editor", editor, "path", path)
+	logging.Logger.Info("Opening editor",
+		"editor", editor,
+		"integration", target.Integration,
+		"path", target.Path,
+		"files", len(target.Files))
 
 	cmd := exec.Command(editor, args...)
 	cmd.Stdout = nil
@@ -63,27 +78,29 @@ func openInEditorImpl(path string, cliEditor string) error {
 	return nil
 }
 
-func findEditor(path string, cliEditor string) (string, []string) {
+func findEditor(path string, files []string, cliEditor string) (string, []string) {
+	args := append([]string{path}, files...)
+
 	// 1. CLI flag takes precedence
 	if cliEditor != "" {
-		return cliEditor, []string{path}
+		return cliEditor, args
 	}
 
 	// 2. Check ROCHA_EDITOR
 	if editor := os.Getenv("ROCHA_EDITOR"); editor != "" {
-		return editor, []string{path}
+		return editor, args
 	}
 
 	// 3. Check VISUAL
 	if editor := os.Getenv("VISUAL"); editor != "" {
-		return editor, []string{path}
+		return editor, args
 	}
 
 	// 4. Check EDITOR
 	if editor := os.Getenv("EDITOR"); editor != "" {
-		return editor, []string{path}
+		return editor, args
 	}
 
 	// 5. Platform-specific defaults
-	return findPlatformEditor(path)
+	return findPlatformEditor(path, files)
 }
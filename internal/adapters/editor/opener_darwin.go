@@ -13,10 +13,10 @@ var defaultEditors = []string{
 	"cursor",
 }
 
-func findPlatformEditor(path string) (string, []string) {
+func findPlatformEditor(path string, files []string) (string, []string) {
 	for _, editor := range defaultEditors {
 		if _, err := exec.LookPath(editor); err == nil {
-			return editor, []string{path}
+			return editor, append([]string{path}, files...)
 		}
 	}
 
@@ -27,7 +27,7 @@ func findPlatformEditor(path string) (string, []string) {
 
 	for _, app := range apps {
 		if _, err := os.Stat(app); err == nil {
-			return app, []string{path}
+			return app, append([]string{path}, files...)
 		}
 	}
 
@@ -4,7 +4,7 @@ package editor
 
 import "os"
 
-func findPlatformEditor(path string) (string, []string) {
+func findPlatformEditor(path string, _ []string) (string, []string) {
 	shell := "/bin/sh"
 	if s := os.Getenv("SHELL"); s != "" {
 		shell = s
@@ -16,10 +16,10 @@ var defaultEditors = []string{
 	"zed",
 }
 
-func findPlatformEditor(path string) (string, []string) {
+func findPlatformEditor(path string, files []string) (string, []string) {
 	for _, editor := range defaultEditors {
 		if _, err := exec.LookPath(editor); err == nil {
-			return editor, []string{path}
+			return editor, append([]string{path}, files...)
 		}
 	}
 
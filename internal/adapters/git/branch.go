@@ -0,0 +1,88 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// listBranches returns the local branches of the worktree's repository and the remote
+// branches without a local branch of the same name, most recently committed first
+func listBranches(ctx context.Context, worktreePath string) ([]domain.Branch, error) {
+	output, err := gitOutput(ctx, worktreePath, "for-each-ref", "--sort=-committerdate",
+		"--format=%(refname)%1f%(committerdate:unix)%1f%(worktreepath)", "refs/heads", "refs/remotes")
+	if err != nil {
+		return nil, fmt.Errorf("git for-each-ref failed: %w", err)
+	}
+
+	var local, remote []domain.Branch
+	localNames := make(map[string]bool)
+	for _, line := range splitNonEmptyLines(output) {
+		fields := strings.SplitN(line, "\x1f", 3)
+		if len(fields) != 3 {
+			continue
+		}
+
+		branch := domain.Branch{Worktree: strings.TrimSpace(fields[2])}
+		if seconds, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+			branch.UpdatedAt = time.Unix(seconds, 0)
+		}
+
+		if name, ok := strings.CutPrefix(fields[0], "refs/heads/"); ok {
+			branch.Name = name
+			localNames[name] = true
+			local = append(local, branch)
+			continue
+		}
+
+		// refs/remotes/<remote>/<branch>; <remote>/HEAD points at the default branch
+		remoteName, name, ok := strings.Cut(strings.TrimPrefix(fields[0], "refs/remotes/"), "/")
+		if !ok || name == "HEAD" {
+			continue
+		}
+		branch.Name, branch.Remote = name, remoteName
+		remote = append(remote, branch)
+	}
+
+	branches := local
+	for _, branch := range remote {
+		if !localNames[branch.Name] {
+			branches = append(branches, branch)
+		}
+	}
+	return branches, nil
+}
+
+// switchBranch checks out a branch in the worktree. A branch only on a remote is
+// checked out as a new local branch tracking it.
+func switchBranch(ctx context.Context, worktreePath, branch string) error {
+	logging.Logger.Info("Switching worktree branch", "path", worktreePath, "branch", branch)
+
+	cmd := exec.CommandContext(ctx, "git", "switch", branch)
+	cmd.Dir = worktreePath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git switch failed", "error", err, "output", string(output))
+		return fmt.Errorf("failed to switch to %s: %w\nOutput: %s", branch, err, string(output))
+	}
+	return nil
+}
+
+// renameBranch renames a local branch. The worktree that has it checked out keeps it
+// checked out under the new name; the upstream branch is left alone.
+func renameBranch(ctx context.Context, worktreePath, oldBranch, newBranch string) error {
+	logging.Logger.Info("Renaming branch", "path", worktreePath, "from", oldBranch, "to", newBranch)
+
+	cmd := exec.CommandContext(ctx, "git", "branch", "-m", oldBranch, newBranch)
+	cmd.Dir = worktreePath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git branch rename failed", "error", err, "output", string(output))
+		return fmt.Errorf("failed to rename branch %s to %s: %w\nOutput: %s", oldBranch, newBranch, err, string(output))
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package git
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListBranches(t *testing.T) {
+	origin, clone, baseBranch := setupCloneWithFeatureBranch(t)
+	runGitIn(t, origin, "branch", "remote-only")
+	runGitIn(t, clone, "fetch", "origin")
+
+	branches, err := listBranches(context.Background(), clone)
+	require.NoError(t, err)
+
+	names := make(map[string]string)
+	for _, branch := range branches {
+		names[branch.DisplayName()] = branch.Worktree
+		assert.False(t, branch.UpdatedAt.IsZero())
+	}
+	assert.Contains(t, names, "feature")
+	assert.Contains(t, names, baseBranch)
+	assert.Contains(t, names, "origin/remote-only")
+	assert.NotContains(t, names, "origin/"+baseBranch, "remote branches with a local branch are left out")
+	assert.NotContains(t, names, "origin/HEAD")
+	assert.NotEmpty(t, names["feature"], "the checked out branch has a worktree")
+	assert.Empty(t, names[baseBranch])
+}
+
+func TestSwitchBranch(t *testing.T) {
+	origin, clone, baseBranch := setupCloneWithFeatureBranch(t)
+	runGitIn(t, origin, "branch", "remote-only")
+	runGitIn(t, clone, "fetch", "origin")
+	ctx := context.Background()
+
+	require.NoError(t, switchBranch(ctx, clone, baseBranch))
+	assert.Equal(t, baseBranch, getBranchName(clone))
+
+	require.NoError(t, switchBranch(ctx, clone, "remote-only"))
+	assert.Equal(t, "remote-only", getBranchName(clone), "a remote branch is checked out as a tracking branch")
+
+	assert.Error(t, switchBranch(ctx, clone, "missing"))
+}
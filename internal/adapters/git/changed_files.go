@@ -0,0 +1,70 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// emptyTreeHash is git's well-known empty tree, used as the diff base before the first commit
+const emptyTreeHash = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+
+// listChangedFiles returns the files changed on the branch: committed since it forked
+// from origin's default branch, uncommitted, and untracked. Deleted files are skipped.
+// Paths are absolute and limited to the given directory.
+func listChangedFiles(ctx context.Context, path string) ([]string, error) {
+	base := "HEAD"
+	mergeBaseCmd := exec.CommandContext(ctx, "git", "merge-base", "HEAD", "origin/"+getDefaultBranch(ctx, path))
+	mergeBaseCmd.Dir = path
+	if output, err := mergeBaseCmd.Output(); err == nil {
+		base = strings.TrimSpace(string(output))
+	} else {
+		logging.Logger.Debug("No merge base with origin, listing uncommitted changes only", "path", path, "error", err)
+
+		// A repository without commits has no HEAD to diff against
+		headCmd := exec.CommandContext(ctx, "git", "rev-parse", "--verify", "--quiet", "HEAD")
+		headCmd.Dir = path
+		if err := headCmd.Run(); err != nil {
+			base = emptyTreeHash
+		}
+	}
+
+	diffCmd := exec.CommandContext(ctx, "git", "diff", "--name-only", "--relative", "-z", base)
+	diffCmd.Dir = path
+	diffOutput, err := diffCmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff failed: %w", err)
+	}
+
+	untrackedCmd := exec.CommandContext(ctx, "git", "ls-files", "--others", "--exclude-standard", "-z")
+	untrackedCmd.Dir = path
+	untrackedOutput, err := untrackedCmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git ls-files failed: %w", err)
+	}
+
+	seen := make(map[string]bool)
+	var files []string
+	for _, name := range strings.Split(string(diffOutput)+string(untrackedOutput), "\x00") {
+		if name == "" || seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		file := filepath.Join(path, name)
+		if _, err := os.Stat(file); err != nil {
+			continue
+		}
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	logging.Logger.Debug("Changed files listed", "path", path, "base", base, "count", len(files))
+	return files, nil
+}
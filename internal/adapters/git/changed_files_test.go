@@ -0,0 +1,44 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListChangedFiles(t *testing.T) {
+	_, clone, _ := setupCloneWithFeatureBranch(t)
+	runGitIn(t, clone, "remote", "set-head", "origin", "--auto")
+
+	// Uncommitted, untracked, and deleted changes on top of the committed README change
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "notes.txt"), []byte("notes"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(clone, "pkg"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "pkg", "new.go"), []byte("package pkg"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "gone.txt"), []byte("gone"), 0644))
+	runGitIn(t, clone, "add", "gone.txt")
+	runGitIn(t, clone, "commit", "-m", "Add file")
+	require.NoError(t, os.Remove(filepath.Join(clone, "gone.txt")))
+
+	files, err := listChangedFiles(context.Background(), clone)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		filepath.Join(clone, "README.md"),
+		filepath.Join(clone, "notes.txt"),
+		filepath.Join(clone, "pkg", "new.go"),
+	}, files)
+}
+
+func TestListChangedFiles_NoRemote(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "scratch.txt"), []byte("x"), 0644))
+
+	files, err := listChangedFiles(context.Background(), repoPath)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(repoPath, "scratch.txt")}, files)
+}
@@ -0,0 +1,154 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// commitCheckpoint commits everything in the worktree, untracked files included, to branch
+// without touching the checkout, its index, or HEAD. It returns false when the worktree matches
+// the last checkpoint, or HEAD before the first one. Checkpoints follow each other and also
+// have HEAD as a parent, so the commits of the agent stay reachable from the branch.
+func commitCheckpoint(ctx context.Context, worktreePath, branch, message string) (bool, error) {
+	head, err := gitOutput(ctx, worktreePath, "rev-parse", "--verify", "HEAD")
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	head = strings.TrimSpace(head)
+
+	// Stage into a copy of the index, so what the agent staged is left as is
+	index, cleanup, err := copyIndex(ctx, worktreePath)
+	if err != nil {
+		return false, err
+	}
+	defer cleanup()
+	env := append(os.Environ(), "GIT_INDEX_FILE="+index)
+
+	if _, err := gitOutputEnv(ctx, worktreePath, env, "add", "--all"); err != nil {
+		return false, fmt.Errorf("failed to stage checkpoint: %w", err)
+	}
+	tree, err := gitOutputEnv(ctx, worktreePath, env, "write-tree")
+	if err != nil {
+		return false, fmt.Errorf("failed to write checkpoint tree: %w", err)
+	}
+	tree = strings.TrimSpace(tree)
+
+	ref := "refs/heads/" + branch
+	parents := []string{head}
+	last, err := gitOutput(ctx, worktreePath, "rev-parse", "--verify", "--quiet", ref)
+	if last = strings.TrimSpace(last); err == nil && last != "" {
+		if last != head {
+			parents = []string{last, head}
+		}
+		if sameTree(ctx, worktreePath, last, tree) {
+			return false, nil
+		}
+	} else if sameTree(ctx, worktreePath, head, tree) {
+		return false, nil
+	}
+
+	args := []string{"commit-tree", tree, "-m", message}
+	for _, parent := range parents {
+		args = append(args, "-p", parent)
+	}
+	commit, err := gitOutput(ctx, worktreePath, args...)
+	if err != nil {
+		return false, fmt.Errorf("failed to commit checkpoint: %w", err)
+	}
+
+	if _, err := gitOutput(ctx, worktreePath, "update-ref", "-m", message, ref, strings.TrimSpace(commit)); err != nil {
+		return false, fmt.Errorf("failed to update %s: %w", branch, err)
+	}
+	logging.Logger.Info("Committed checkpoint", "path", worktreePath, "branch", branch)
+	return true, nil
+}
+
+// stashCheckpoint stores the tracked changes of the worktree as a stash entry without
+// removing them from the checkout. It returns false when there are no changes, or when
+// they match the newest stash.
+func stashCheckpoint(ctx context.Context, worktreePath, message string) (bool, error) {
+	commit, err := gitOutput(ctx, worktreePath, "stash", "create", message)
+	if err != nil {
+		return false, fmt.Errorf("git stash create failed: %w", err)
+	}
+	commit = strings.TrimSpace(commit)
+	if commit == "" {
+		return false, nil
+	}
+
+	if tree, err := gitOutput(ctx, worktreePath, "rev-parse", commit+"^{tree}"); err == nil &&
+		sameTree(ctx, worktreePath, "refs/stash", strings.TrimSpace(tree)) {
+		return false, nil
+	}
+
+	// Subjects start like those of git stash push, so the stash shows up under its branch
+	branch, err := stashBranch(ctx, worktreePath)
+	if err != nil {
+		return false, err
+	}
+	subject := fmt.Sprintf("On %s: %s", branch, message)
+	if _, err := gitOutput(ctx, worktreePath, "stash", "store", "-m", subject, commit); err != nil {
+		return false, fmt.Errorf("git stash store failed: %w", err)
+	}
+	logging.Logger.Info("Stored checkpoint stash", "path", worktreePath)
+	return true, nil
+}
+
+// copyIndex copies the index of a worktree to a temporary file and returns its path,
+// with a function that removes it
+func copyIndex(ctx context.Context, worktreePath string) (string, func(), error) {
+	indexPath, err := gitOutput(ctx, worktreePath, "rev-parse", "--git-path", "index")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to find index: %w", err)
+	}
+	indexPath = strings.TrimSpace(indexPath)
+	if !filepath.IsAbs(indexPath) {
+		indexPath = filepath.Join(worktreePath, indexPath)
+	}
+
+	tmp, err := os.CreateTemp("", "rocha-checkpoint-index-*")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create checkpoint index: %w", err)
+	}
+	cleanup := func() { _ = os.Remove(tmp.Name()) }
+	defer tmp.Close()
+
+	src, err := os.Open(indexPath)
+	if errors.Is(err, os.ErrNotExist) {
+		cleanup() // Git starts a new index where none exists
+		return tmp.Name(), cleanup, nil
+	}
+	if err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to read index: %w", err)
+	}
+	defer src.Close()
+	if _, err := io.Copy(tmp, src); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("failed to copy index: %w", err)
+	}
+	return tmp.Name(), cleanup, nil
+}
+
+// sameTree reports whether the tree of rev is tree
+func sameTree(ctx context.Context, worktreePath, rev, tree string) bool {
+	revTree, err := gitOutput(ctx, worktreePath, "rev-parse", "--verify", "--quiet", rev+"^{tree}")
+	return err == nil && strings.TrimSpace(revTree) == tree
+}
+
+// gitOutputEnv runs a git command in dir with env and returns its stdout
+func gitOutputEnv(ctx context.Context, dir string, env []string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	cmd.Env = env
+	output, err := cmd.Output()
+	return string(output), err
+}
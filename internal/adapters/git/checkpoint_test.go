@@ -0,0 +1,79 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitCheckpoint(t *testing.T) {
+	_, clone, _ := setupCloneWithFeatureBranch(t)
+	ctx := context.Background()
+	branch := "rocha/checkpoints/feature"
+
+	committed, err := commitCheckpoint(ctx, clone, branch, "checkpoint")
+	require.NoError(t, err)
+	assert.False(t, committed, "a clean worktree matches HEAD")
+
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "README.md"), []byte("# WIP"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "draft.txt"), []byte("draft"), 0644))
+	runGitIn(t, clone, "add", "README.md")
+
+	committed, err = commitCheckpoint(ctx, clone, branch, "checkpoint")
+	require.NoError(t, err)
+	assert.True(t, committed)
+
+	// The checkout is left as is: the staged file stays staged and the draft untracked
+	status, err := gitOutput(ctx, clone, "status", "--porcelain")
+	require.NoError(t, err)
+	assert.Equal(t, "M  README.md\n?? draft.txt\n", status)
+
+	files, err := gitOutput(ctx, clone, "ls-tree", "--name-only", branch)
+	require.NoError(t, err)
+	assert.Contains(t, strings.Fields(files), "draft.txt")
+
+	committed, err = commitCheckpoint(ctx, clone, branch, "checkpoint")
+	require.NoError(t, err)
+	assert.False(t, committed, "nothing changed since the last checkpoint")
+
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "draft.txt"), []byte("more"), 0644))
+	committed, err = commitCheckpoint(ctx, clone, branch, "checkpoint")
+	require.NoError(t, err)
+	assert.True(t, committed)
+
+	count, err := gitOutput(ctx, clone, "rev-list", "--count", "HEAD.."+branch)
+	require.NoError(t, err)
+	assert.Equal(t, "2", strings.TrimSpace(count))
+}
+
+func TestStashCheckpoint(t *testing.T) {
+	_, clone, _ := setupCloneWithFeatureBranch(t)
+	ctx := context.Background()
+
+	stored, err := stashCheckpoint(ctx, clone, "rocha checkpoint")
+	require.NoError(t, err)
+	assert.False(t, stored, "a clean worktree has nothing to store")
+
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "README.md"), []byte("# WIP"), 0644))
+
+	stored, err = stashCheckpoint(ctx, clone, "rocha checkpoint")
+	require.NoError(t, err)
+	assert.True(t, stored)
+	content, err := os.ReadFile(filepath.Join(clone, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# WIP", string(content), "the changes stay in the checkout")
+
+	stored, err = stashCheckpoint(ctx, clone, "rocha checkpoint")
+	require.NoError(t, err)
+	assert.False(t, stored, "the changes match the newest stash")
+
+	stashes, err := listStashes(ctx, clone)
+	require.NoError(t, err)
+	require.Len(t, stashes, 1)
+	assert.Equal(t, "rocha checkpoint", stashes[0].Message)
+}
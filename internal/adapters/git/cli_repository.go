@@ -2,6 +2,7 @@ package git
 
 import (
 	"context"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/ports"
@@ -25,6 +26,11 @@ func (r *CLIRepository) IsGitRepo(path string) (bool, string) {
 	return isGitRepo(path)
 }
 
+// GetDefaultBranch implements RepoInspector.GetDefaultBranch
+func (r *CLIRepository) GetDefaultBranch(repoPath string) string {
+	return getDefaultBranch(context.Background(), repoPath)
+}
+
 // GetMainRepoPath implements RepoInspector.GetMainRepoPath
 func (r *CLIRepository) GetMainRepoPath(path string) (string, error) {
 	return getMainRepoPath(path)
@@ -45,6 +51,11 @@ func (r *CLIRepository) GetRemoteURL(repoPath string) string {
 	return getRemoteURL(repoPath)
 }
 
+// GetHeadCommit implements RepoInspector.GetHeadCommit
+func (r *CLIRepository) GetHeadCommit(path string) (string, error) {
+	return getHeadCommit(path)
+}
+
 // WorktreeManager methods
 
 // CreateWorktree implements WorktreeManager.CreateWorktree
@@ -57,21 +68,41 @@ func (r *CLIRepository) RemoveWorktree(repoPath, worktreePath string) error {
 	return removeWorktree(repoPath, worktreePath)
 }
 
+// DeleteBranch implements WorktreeManager.DeleteBranch
+func (r *CLIRepository) DeleteBranch(repoPath, branchName string) error {
+	return deleteBranch(repoPath, branchName)
+}
+
 // ListWorktrees implements WorktreeManager.ListWorktrees
 func (r *CLIRepository) ListWorktrees(repoPath string) ([]string, error) {
 	return listWorktrees(repoPath)
 }
 
+// MoveWorktree implements WorktreeManager.MoveWorktree
+func (r *CLIRepository) MoveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error {
+	return moveWorktree(ctx, repoPath, worktreePath, newPath)
+}
+
 // GetWorktreeForBranch implements WorktreeManager.GetWorktreeForBranch
 func (r *CLIRepository) GetWorktreeForBranch(repoPath, branchName string) (string, error) {
 	return getWorktreeForBranch(repoPath, branchName)
 }
 
+// GetWorktreeStatus implements WorktreeManager.GetWorktreeStatus
+func (r *CLIRepository) GetWorktreeStatus(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error) {
+	return getWorktreeStatus(ctx, worktreePath)
+}
+
 // RepairWorktrees implements WorktreeManager.RepairWorktrees
 func (r *CLIRepository) RepairWorktrees(mainRepoPath string, worktreePaths []string) error {
 	return repairWorktrees(mainRepoPath, worktreePaths)
 }
 
+// SetWorktreeIdentity implements WorktreeManager.SetWorktreeIdentity
+func (r *CLIRepository) SetWorktreeIdentity(ctx context.Context, worktreePath string, identity domain.GitIdentity) error {
+	return setWorktreeIdentity(ctx, worktreePath, identity)
+}
+
 // BuildWorktreePath implements WorktreeManager.BuildWorktreePath
 func (r *CLIRepository) BuildWorktreePath(base, repoInfo, sessionName string) string {
 	return buildWorktreePath(base, repoInfo, sessionName)
@@ -80,8 +111,8 @@ func (r *CLIRepository) BuildWorktreePath(base, repoInfo, sessionName string) st
 // RepoCloner methods
 
 // GetOrCloneRepository implements RepoCloner.GetOrCloneRepository
-func (r *CLIRepository) GetOrCloneRepository(source, worktreeBase string) (string, *domain.RepoSource, error) {
-	localPath, rs, err := getOrCloneRepository(source, worktreeBase)
+func (r *CLIRepository) GetOrCloneRepository(source, worktreeBase string, credentials domain.GitCredentials) (string, *domain.RepoSource, error) {
+	localPath, rs, err := getOrCloneRepository(source, worktreeBase, credentials)
 	if err != nil {
 		return "", nil, err
 	}
@@ -100,6 +131,74 @@ func (r *CLIRepository) SanitizeBranchName(name string) (string, error) {
 	return sanitizeBranchName(name)
 }
 
+// BranchSyncer methods
+
+// AbortRebase implements BranchSyncer.AbortRebase
+func (r *CLIRepository) AbortRebase(ctx context.Context, worktreePath string) error {
+	return abortRebase(ctx, worktreePath)
+}
+
+// FetchBase implements BranchSyncer.FetchBase
+func (r *CLIRepository) FetchBase(ctx context.Context, worktreePath, baseBranch string) error {
+	return fetchBase(ctx, worktreePath, baseBranch)
+}
+
+// FetchRemote implements BranchSyncer.FetchRemote
+func (r *CLIRepository) FetchRemote(ctx context.Context, repoPath string) error {
+	return fetchRemote(ctx, repoPath)
+}
+
+// RebaseOntoBase implements BranchSyncer.RebaseOntoBase
+func (r *CLIRepository) RebaseOntoBase(ctx context.Context, worktreePath, baseBranch string) (*domain.RebaseResult, error) {
+	return rebaseOntoBase(ctx, worktreePath, baseBranch)
+}
+
+// BranchSwitcher methods
+
+// ListBranches implements BranchSwitcher.ListBranches
+func (r *CLIRepository) ListBranches(ctx context.Context, worktreePath string) ([]domain.Branch, error) {
+	return listBranches(ctx, worktreePath)
+}
+
+// RenameBranch implements BranchSwitcher.RenameBranch
+func (r *CLIRepository) RenameBranch(ctx context.Context, worktreePath, oldBranch, newBranch string) error {
+	return renameBranch(ctx, worktreePath, oldBranch, newBranch)
+}
+
+// SwitchBranch implements BranchSwitcher.SwitchBranch
+func (r *CLIRepository) SwitchBranch(ctx context.Context, worktreePath, branch string) error {
+	return switchBranch(ctx, worktreePath, branch)
+}
+
+// CheckpointWriter methods
+
+// CommitCheckpoint implements CheckpointWriter.CommitCheckpoint
+func (r *CLIRepository) CommitCheckpoint(ctx context.Context, worktreePath, branch, message string) (bool, error) {
+	return commitCheckpoint(ctx, worktreePath, branch, message)
+}
+
+// StashCheckpoint implements CheckpointWriter.StashCheckpoint
+func (r *CLIRepository) StashCheckpoint(ctx context.Context, worktreePath, message string) (bool, error) {
+	return stashCheckpoint(ctx, worktreePath, message)
+}
+
+// StashManager methods
+
+// ListStashes implements StashManager.ListStashes
+func (r *CLIRepository) ListStashes(ctx context.Context, worktreePath string) ([]domain.Stash, error) {
+	return listStashes(ctx, worktreePath)
+}
+
+// PopStash implements StashManager.PopStash
+func (r *CLIRepository) PopStash(ctx context.Context, worktreePath, ref string) error {
+	return popStash(ctx, worktreePath, ref)
+}
+
+// StashChanges implements StashManager.StashChanges
+func (r *CLIRepository) StashChanges(ctx context.Context, worktreePath, message string) (bool, error) {
+	return stashChanges(ctx, worktreePath, message)
+}
+
 // RepoSourceParser methods
 
 // IsGitURL implements RepoSourceParser.IsGitURL
@@ -119,8 +218,28 @@ func (r *CLIRepository) ParseRepoSource(source string) (*domain.RepoSource, erro
 // GitStatsProvider methods
 
 // FetchGitStats implements GitStatsProvider.FetchGitStats
-func (r *CLIRepository) FetchGitStats(ctx context.Context, worktreePath string) (*domain.GitStats, error) {
-	return fetchGitStats(ctx, worktreePath)
+func (r *CLIRepository) FetchGitStats(ctx context.Context, worktreePath, baseBranch string) (*domain.GitStats, error) {
+	return fetchGitStats(ctx, worktreePath, baseBranch)
+}
+
+// BranchDiff implements GitStatsProvider.BranchDiff
+func (r *CLIRepository) BranchDiff(ctx context.Context, path, baseBranch string) (string, error) {
+	return branchDiff(ctx, path, baseBranch)
+}
+
+// Diff implements GitStatsProvider.Diff
+func (r *CLIRepository) Diff(ctx context.Context, path, since string) (string, error) {
+	return diffSince(ctx, path, since)
+}
+
+// ListChangedFiles implements GitStatsProvider.ListChangedFiles
+func (r *CLIRepository) ListChangedFiles(ctx context.Context, path string) ([]string, error) {
+	return listChangedFiles(ctx, path)
+}
+
+// ListCommits implements GitStatsProvider.ListCommits
+func (r *CLIRepository) ListCommits(ctx context.Context, path, baseBranch string, since time.Time) ([]domain.Commit, error) {
+	return listCommits(ctx, path, baseBranch, since)
 }
 
 // PRInfoProvider methods
@@ -0,0 +1,48 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// listCommits returns the commits made on the branch checked out in path since the
+// given time, newest first. Commits already on origin/<baseBranch> are left out, so
+// upstream work brought in by a rebase is not counted; an empty baseBranch uses the
+// default branch of origin. Merge commits are skipped.
+func listCommits(ctx context.Context, path, baseBranch string, since time.Time) ([]domain.Commit, error) {
+	if baseBranch == "" {
+		baseBranch = getDefaultBranch(ctx, path)
+	}
+
+	args := []string{"log", "--no-merges", "--since=" + since.Format(time.RFC3339), "--format=%h%x1f%cI%x1f%s", "HEAD"}
+	baseRef := "origin/" + baseBranch
+	if _, err := gitOutput(ctx, path, "rev-parse", "--verify", "--quiet", baseRef); err == nil {
+		args = append(args, "--not", baseRef)
+	} else {
+		logging.Logger.Debug("Base branch not found, listing all recent commits", "path", path, "base", baseRef)
+	}
+
+	output, err := gitOutput(ctx, path, args...)
+	if err != nil {
+		return nil, fmt.Errorf("git log failed: %w", err)
+	}
+
+	var commits []domain.Commit
+	for _, line := range splitNonEmptyLines(output) {
+		fields := strings.SplitN(line, "\x1f", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		committedAt, err := time.Parse(time.RFC3339, fields[1])
+		if err != nil {
+			continue
+		}
+		commits = append(commits, domain.Commit{CommittedAt: committedAt, Hash: fields[0], Subject: fields[2]})
+	}
+	return commits, nil
+}
@@ -0,0 +1,39 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListCommits_LeavesOutBaseBranch(t *testing.T) {
+	origin, clone, baseBranch := setupCloneWithFeatureBranch(t)
+
+	// Upstream work brought into the branch is not the session's
+	require.NoError(t, os.WriteFile(filepath.Join(origin, "other.txt"), []byte("other"), 0644))
+	runGitIn(t, origin, "add", "other.txt")
+	runGitIn(t, origin, "commit", "-m", "Unrelated change")
+	_, err := rebaseOntoBase(context.Background(), clone, baseBranch)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "notes.txt"), []byte("notes"), 0644))
+	runGitIn(t, clone, "add", "notes.txt")
+	runGitIn(t, clone, "commit", "-m", "Add notes")
+
+	commits, err := listCommits(context.Background(), clone, baseBranch, time.Now().Add(-time.Hour))
+
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "Add notes", commits[0].Subject)
+	assert.Equal(t, "Feature change", commits[1].Subject)
+	assert.NotEmpty(t, commits[0].Hash)
+
+	commits, err = listCommits(context.Background(), clone, baseBranch, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+}
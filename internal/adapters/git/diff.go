@@ -0,0 +1,74 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// getHeadCommit returns the full hash of the commit checked out in path
+func getHeadCommit(path string) (string, error) {
+	output, err := gitOutput(context.Background(), path, "rev-parse", "--verify", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve HEAD: %w", err)
+	}
+	return strings.TrimSpace(output), nil
+}
+
+// branchDiff returns the patch of everything on the branch checked out in path since it
+// forked from origin/baseBranch (empty uses origin's default branch), uncommitted changes
+// and untracked files included. Without a merge base, only uncommitted changes are diffed.
+func branchDiff(ctx context.Context, path, baseBranch string) (string, error) {
+	if baseBranch == "" {
+		baseBranch = getDefaultBranch(ctx, path)
+	}
+
+	base, err := gitOutput(ctx, path, "merge-base", "HEAD", "origin/"+baseBranch)
+	if err != nil {
+		logging.Logger.Debug("No merge base with origin, diffing uncommitted changes only", "path", path, "base", baseBranch, "error", err)
+
+		// A repository without commits diffs against the empty tree
+		base, _ = gitOutput(ctx, path, "rev-parse", "--verify", "--quiet", "HEAD")
+	}
+	return diffSince(ctx, path, strings.TrimSpace(base))
+}
+
+// diffSince returns the patch of everything that changed in path since the given commit:
+// commits made since, uncommitted changes, and untracked files. An empty commit diffs
+// against the empty tree. The index of the worktree is left untouched.
+func diffSince(ctx context.Context, path, commit string) (string, error) {
+	if commit == "" {
+		commit = emptyTreeHash
+	}
+
+	// A throwaway index stages untracked files for the diff
+	indexDir, err := os.MkdirTemp("", "rocha-diff-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary index: %w", err)
+	}
+	defer os.RemoveAll(indexDir)
+	env := append(os.Environ(), "GIT_INDEX_FILE="+filepath.Join(indexDir, "index"))
+
+	addCmd := exec.CommandContext(ctx, "git", "add", "--all")
+	addCmd.Dir = path
+	addCmd.Env = env
+	if output, err := addCmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("git add failed: %w\nOutput: %s", err, string(output))
+	}
+
+	diffCmd := exec.CommandContext(ctx, "git", "diff", "--cached", "--binary", commit)
+	diffCmd.Dir = path
+	diffCmd.Env = env
+	output, err := diffCmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git diff failed: %w", err)
+	}
+
+	logging.Logger.Debug("Diff captured", "path", path, "since", commit, "bytes", len(output))
+	return string(output), nil
+}
@@ -0,0 +1,63 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffSince(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	start, err := getHeadCommit(repoPath)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "committed.txt"), []byte("committed\n"), 0644))
+	runGitIn(t, repoPath, "add", "committed.txt")
+	runGitIn(t, repoPath, "commit", "-m", "Add file")
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "README.md"), []byte("# Changed\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "untracked.txt"), []byte("new\n"), 0644))
+
+	diff, err := diffSince(context.Background(), repoPath, start)
+
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+++ b/committed.txt")
+	assert.Contains(t, diff, "+# Changed")
+	assert.Contains(t, diff, "+++ b/untracked.txt")
+
+	status, err := gitOutput(context.Background(), repoPath, "status", "--porcelain")
+	require.NoError(t, err)
+	assert.Contains(t, status, "?? untracked.txt", "the worktree index is left untouched")
+}
+
+func TestBranchDiff(t *testing.T) {
+	origin, clone, baseBranch := setupCloneWithFeatureBranch(t)
+
+	// A change on the base branch after the fork is not part of the branch
+	require.NoError(t, os.WriteFile(filepath.Join(origin, "other.txt"), []byte("other\n"), 0644))
+	runGitIn(t, origin, "add", "other.txt")
+	runGitIn(t, origin, "commit", "-m", "Unrelated change")
+	runGitIn(t, clone, "fetch", "origin")
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "untracked.txt"), []byte("new\n"), 0644))
+
+	diff, err := branchDiff(context.Background(), clone, baseBranch)
+
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+# Feature")
+	assert.Contains(t, diff, "+++ b/untracked.txt")
+	assert.NotContains(t, diff, "other.txt")
+}
+
+func TestBranchDiff_NoRemote(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "scratch.txt"), []byte("x\n"), 0644))
+
+	diff, err := branchDiff(context.Background(), repoPath, "")
+
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+++ b/scratch.txt")
+	assert.NotContains(t, diff, "README.md", "committed files are not diffed without a merge base")
+}
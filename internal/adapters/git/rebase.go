@@ -0,0 +1,124 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// defaultBaseBranch is used when the remote HEAD cannot be determined
+const defaultBaseBranch = "main"
+
+// getDefaultBranch returns the default branch of the origin remote
+// Falls back to "main" when origin/HEAD is not set
+func getDefaultBranch(ctx context.Context, path string) string {
+	cmd := exec.CommandContext(ctx, "git", "symbolic-ref", "--short", "refs/remotes/origin/HEAD")
+	cmd.Dir = path
+
+	output, err := cmd.Output()
+	if err != nil {
+		logging.Logger.Debug("Failed to resolve origin/HEAD, using default base branch", "error", err)
+		return defaultBaseBranch
+	}
+
+	branch := strings.TrimPrefix(strings.TrimSpace(string(output)), "origin/")
+	if branch == "" {
+		return defaultBaseBranch
+	}
+	return branch
+}
+
+// fetchBase updates origin/<baseBranch> without touching the worktree
+// If baseBranch is empty, the default branch of origin is fetched.
+func fetchBase(ctx context.Context, worktreePath, baseBranch string) error {
+	if baseBranch == "" {
+		baseBranch = getDefaultBranch(ctx, worktreePath)
+	}
+
+	fetchCmd := exec.CommandContext(ctx, "git", "fetch", "origin", baseBranch)
+	fetchCmd.Dir = worktreePath
+	if output, err := fetchCmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git fetch failed", "error", err, "output", string(output))
+		return fmt.Errorf("failed to fetch origin/%s: %w\nOutput: %s", baseBranch, err, string(output))
+	}
+	return nil
+}
+
+// rebaseOntoBase fetches origin and rebases the current branch onto origin/<baseBranch>
+// If baseBranch is empty, the default branch of origin is used.
+// When the rebase stops on conflicts, it is left in progress and the conflicted
+// files are returned so the caller can resolve or abort it.
+func rebaseOntoBase(ctx context.Context, worktreePath, baseBranch string) (*domain.RebaseResult, error) {
+	if baseBranch == "" {
+		baseBranch = getDefaultBranch(ctx, worktreePath)
+	}
+	baseRef := "origin/" + baseBranch
+
+	logging.Logger.Info("Rebasing onto base branch", "path", worktreePath, "base", baseRef)
+
+	if err := fetchBase(ctx, worktreePath, baseBranch); err != nil {
+		return nil, err
+	}
+
+	result := &domain.RebaseResult{BaseBranch: baseRef}
+
+	rebaseCmd := exec.CommandContext(ctx, "git", "rebase", "--autostash", baseRef)
+	rebaseCmd.Dir = worktreePath
+	output, err := rebaseCmd.CombinedOutput()
+	if err == nil {
+		logging.Logger.Info("Rebase completed successfully", "path", worktreePath, "base", baseRef)
+		return result, nil
+	}
+
+	conflicts, conflictErr := getConflictedFiles(ctx, worktreePath)
+	if conflictErr != nil || len(conflicts) == 0 {
+		// Not a conflict - nothing useful to resolve, so don't leave a rebase in progress
+		logging.Logger.Error("Git rebase failed", "error", err, "output", string(output))
+		if abortErr := abortRebase(ctx, worktreePath); abortErr != nil {
+			logging.Logger.Debug("No rebase to abort after failure", "error", abortErr)
+		}
+		return nil, fmt.Errorf("failed to rebase onto %s: %w\nOutput: %s", baseRef, err, string(output))
+	}
+
+	logging.Logger.Warn("Rebase stopped on conflicts", "path", worktreePath, "conflicts", len(conflicts))
+	result.Conflicts = conflicts
+	return result, nil
+}
+
+// getConflictedFiles returns the files with unresolved merge conflicts
+func getConflictedFiles(ctx context.Context, path string) ([]string, error) {
+	cmd := exec.CommandContext(ctx, "git", "diff", "--name-only", "--diff-filter=U")
+	cmd.Dir = path
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git diff failed: %w", err)
+	}
+
+	var files []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// abortRebase aborts a rebase in progress, restoring the branch to its previous state
+func abortRebase(ctx context.Context, worktreePath string) error {
+	logging.Logger.Info("Aborting rebase", "path", worktreePath)
+
+	cmd := exec.CommandContext(ctx, "git", "rebase", "--abort")
+	cmd.Dir = worktreePath
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git rebase abort failed", "error", err, "output", string(output))
+		return fmt.Errorf("failed to abort rebase: %w\nOutput: %s", err, string(output))
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package git
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// runGitIn runs a git command in dir and fails the test on error
+func runGitIn(t *testing.T, dir string, args ...string) {
+	t.Helper()
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, "git %v failed: %s", args, out)
+}
+
+// setupCloneWithFeatureBranch clones a test repo and commits a README change on a feature branch
+// Returns the origin path, the clone path and the base branch name
+func setupCloneWithFeatureBranch(t *testing.T) (string, string, string) {
+	t.Helper()
+	origin := setupTestRepo(t)
+	baseBranch := getBranchName(origin)
+
+	clone := filepath.Join(t.TempDir(), "clone")
+	runGitIn(t, origin, "clone", origin, clone)
+	runGitIn(t, clone, "config", "user.email", "test@test.com")
+	runGitIn(t, clone, "config", "user.name", "Test")
+	runGitIn(t, clone, "checkout", "-b", "feature")
+
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "README.md"), []byte("# Feature"), 0644))
+	runGitIn(t, clone, "commit", "-am", "Feature change")
+
+	return origin, clone, baseBranch
+}
+
+func TestRebaseOntoBase_NoConflicts(t *testing.T) {
+	origin, clone, baseBranch := setupCloneWithFeatureBranch(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(origin, "other.txt"), []byte("other"), 0644))
+	runGitIn(t, origin, "add", "other.txt")
+	runGitIn(t, origin, "commit", "-m", "Unrelated change")
+
+	result, err := rebaseOntoBase(context.Background(), clone, baseBranch)
+
+	require.NoError(t, err)
+	assert.False(t, result.HasConflicts())
+	assert.Equal(t, "origin/"+baseBranch, result.BaseBranch)
+	assert.FileExists(t, filepath.Join(clone, "other.txt"))
+}
+
+func TestRebaseOntoBase_Conflicts(t *testing.T) {
+	origin, clone, baseBranch := setupCloneWithFeatureBranch(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(origin, "README.md"), []byte("# Upstream"), 0644))
+	runGitIn(t, origin, "commit", "-am", "Conflicting change")
+
+	result, err := rebaseOntoBase(context.Background(), clone, baseBranch)
+
+	require.NoError(t, err)
+	assert.True(t, result.HasConflicts())
+	assert.Equal(t, []string{"README.md"}, result.Conflicts)
+
+	// Aborting restores the feature commit
+	require.NoError(t, abortRebase(context.Background(), clone))
+	content, err := os.ReadFile(filepath.Join(clone, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Feature", string(content))
+}
+
+func TestAbortRebase_NoRebaseInProgress(t *testing.T) {
+	repoPath := setupTestRepo(t)
+
+	err := abortRebase(context.Background(), repoPath)
+
+	assert.Error(t, err)
+}
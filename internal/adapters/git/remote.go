@@ -0,0 +1,103 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// remoteCheckTimeout bounds the pre-flight check, so an unreachable host fails fast
+const remoteCheckTimeout = 20 * time.Second
+
+// remoteFetchTimeout bounds a background fetch, so a hung remote cannot hold up the next one
+const remoteFetchTimeout = 2 * time.Minute
+
+// checkRemoteAccess lists the heads of url without prompting for credentials, and explains
+// what to fix when that fails. Cloning would otherwise hang on a prompt the TUI hides,
+// or fail with git's raw output.
+func checkRemoteAccess(ctx context.Context, url string, credentials domain.GitCredentials) error {
+	ctx, cancel := context.WithTimeout(ctx, remoteCheckTimeout)
+	defer cancel()
+
+	args := append(credentials.Args(), "ls-remote", "--heads", url)
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = nonInteractiveGitEnv(credentials)
+
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		output = append(output, []byte("\nconnection timed out")...)
+	}
+
+	logging.Logger.Warn("Remote repository is not accessible", "url", url, "error", err, "output", string(output))
+	return domain.DiagnoseRemoteAccess(url, string(output), os.Getenv("SSH_AUTH_SOCK") != "")
+}
+
+// fetchRemote fetches every branch of origin into a repository, pruning the ones deleted
+// there. It runs in the background, so it fails instead of prompting for credentials.
+func fetchRemote(ctx context.Context, repoPath string) error {
+	ctx, cancel := context.WithTimeout(ctx, remoteFetchTimeout)
+	defer cancel()
+
+	// Run the SSH command set for the repository (see applyCredentials) in batch mode
+	var credentials domain.GitCredentials
+	configCmd := exec.Command("git", "config", "--get", "core.sshCommand")
+	configCmd.Dir = repoPath
+	if output, err := configCmd.Output(); err == nil {
+		credentials.SSHCommand = strings.TrimSpace(string(output))
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "fetch", "--prune", "--quiet", "origin")
+	cmd.Dir = repoPath
+	cmd.Env = nonInteractiveGitEnv(credentials)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to fetch origin in %s: %w\nOutput: %s", repoPath, err, string(output))
+	}
+	return nil
+}
+
+// nonInteractiveGitEnv is the environment of git commands that must fail instead of
+// asking for a username or password. The SSH command is only replaced when the
+// credentials set one, which then also stops it from asking for a passphrase or
+// host key confirmation; otherwise the user's own SSH setup is left alone.
+func nonInteractiveGitEnv(credentials domain.GitCredentials) []string {
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	if credentials.SSHCommand == "" {
+		return env
+	}
+
+	// GIT_SSH_COMMAND wins over core.sshCommand, so the per-repository command goes here too
+	return append(env, "GIT_SSH_COMMAND="+credentials.SSHCommand+" -o BatchMode=yes")
+}
+
+// applyCredentials writes the credentials to the local config of a clone, so the
+// fetches and pushes of its worktrees use them
+func applyCredentials(repoPath string, credentials domain.GitCredentials) error {
+	if credentials.IsZero() {
+		return nil
+	}
+
+	// Start over so changed settings replace the old values instead of adding to them
+	for _, key := range []string{"credential.helper", "core.sshCommand"} {
+		cmd := exec.Command("git", "config", "--local", "--unset-all", key)
+		cmd.Dir = repoPath
+		_ = cmd.Run() // Exits with 5 when the key is not set
+	}
+
+	for _, entry := range credentials.Config() {
+		cmd := exec.Command("git", "config", "--local", "--add", entry[0], entry[1])
+		cmd.Dir = repoPath
+		if output, err := cmd.CombinedOutput(); err != nil {
+			return fmt.Errorf("failed to set %s: %w\nOutput: %s", entry[0], err, string(output))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package git
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestCheckRemoteAccess(t *testing.T) {
+	origin := setupTestRepo(t)
+
+	require.NoError(t, checkRemoteAccess(context.Background(), origin, domain.GitCredentials{}))
+
+	err := checkRemoteAccess(context.Background(), filepath.Join(t.TempDir(), "missing"), domain.GitCredentials{})
+	var remoteErr *domain.RemoteAccessError
+	require.ErrorAs(t, err, &remoteErr)
+	assert.Equal(t, domain.RemoteAccessNotFound, remoteErr.Kind)
+}
+
+func TestApplyCredentials(t *testing.T) {
+	repo := setupTestRepo(t)
+
+	require.NoError(t, applyCredentials(repo, domain.GitCredentials{Helper: "store", SSHCommand: "ssh -i old"}))
+	require.NoError(t, applyCredentials(repo, domain.GitCredentials{Helper: "cache"}))
+
+	helpers, err := exec.Command("git", "-C", repo, "config", "--local", "--get-all", "credential.helper").Output()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"", "cache"}, strings.Split(strings.TrimSuffix(string(helpers), "\n"), "\n"))
+
+	// Settings that no longer set an SSH command remove the old one
+	err = exec.Command("git", "-C", repo, "config", "--local", "--get", "core.sshCommand").Run()
+	assert.Error(t, err)
+}
+
+func TestNonInteractiveGitEnv(t *testing.T) {
+	t.Setenv("GIT_SSH_COMMAND", "ssh -F /home/me/.ssh/work")
+
+	tests := []struct {
+		name        string
+		credentials domain.GitCredentials
+		want        string
+	}{
+		{
+			name: "keeps the user's SSH command without per-repository credentials",
+			want: "ssh -F /home/me/.ssh/work",
+		},
+		{
+			name:        "uses the per-repository SSH command in batch mode",
+			credentials: domain.GitCredentials{SSHCommand: "ssh -i ~/.ssh/deploy"},
+			want:        "ssh -i ~/.ssh/deploy -o BatchMode=yes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := exec.Command("git")
+			cmd.Env = nonInteractiveGitEnv(tt.credentials)
+			assert.Contains(t, cmd.Environ(), "GIT_TERMINAL_PROMPT=0")
+			assert.Contains(t, cmd.Environ(), "GIT_SSH_COMMAND="+tt.want)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -9,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 )
 
@@ -142,7 +144,7 @@ func parseRepoSource(source string) (*repoSource, error) {
 // cloneRepository clones git repo to target path
 // If branch is specified, clones only that branch (--single-branch)
 // If branch is empty, clones all branches (for shared main repository)
-func cloneRepository(url, targetPath, branch string) error {
+func cloneRepository(url, targetPath, branch string, credentials domain.GitCredentials) error {
 	logging.Logger.Info("Cloning repository", "url", url, "target", targetPath, "branch", branch)
 
 	// Ensure parent directory exists
@@ -153,7 +155,7 @@ func cloneRepository(url, targetPath, branch string) error {
 	}
 
 	// Build git clone command
-	args := []string{"clone"}
+	args := append(credentials.Args(), "clone")
 
 	// IMPORTANT: Only use --single-branch if branch is specified
 	// This allows the main repository to support multiple branches dynamically
@@ -230,8 +232,9 @@ func getCurrentBranch(repoPath string) (string, error) {
 // getOrCloneRepository ensures repo exists locally
 // Local path: validate and return
 // Remote URL: clone to {worktreeBase}/{owner}/{repo}/{config.MainRepoDir}
+// The credentials are set up in the clone; a remote is checked for access before cloning it
 // Returns: localPath, repoSource, error
-func getOrCloneRepository(source, worktreeBase string) (string, *repoSource, error) {
+func getOrCloneRepository(source, worktreeBase string, credentials domain.GitCredentials) (string, *repoSource, error) {
 	logging.Logger.Debug("Getting or cloning repository", "source", source, "worktree_base", worktreeBase)
 
 	// Parse the source
@@ -305,6 +308,11 @@ func getOrCloneRepository(source, worktreeBase string) (string, *repoSource, err
 			return "", nil, fmt.Errorf("main repository directory exists with different remote URL.\nExisting: %s\nRequested: %s", existingURL, repoSource.path)
 		}
 
+		// Credentials may have been set up or changed since the clone
+		if err := applyCredentials(repoRoot, credentials); err != nil {
+			logging.Logger.Warn("Failed to set up repository credentials", "error", err, "path", repoRoot)
+		}
+
 		// CRITICAL FIX: Checkout the requested branch before returning
 		if repoSource.branch != "" {
 			if err := checkoutBranch(repoRoot, repoSource.branch); err != nil {
@@ -321,13 +329,22 @@ func getOrCloneRepository(source, worktreeBase string) (string, *repoSource, err
 		return repoRoot, repoSource, nil
 	}
 
+	// Fail with remediation hints rather than git's raw output, or a hidden credential prompt
+	if err := checkRemoteAccess(context.Background(), repoSource.path, credentials); err != nil {
+		return "", nil, err
+	}
+
 	// Clone repository (with all branches for shared main repository)
 	// NOTE: Pass empty string for branch to clone all branches
-	if err := cloneRepository(repoSource.path, targetPath, ""); err != nil {
+	if err := cloneRepository(repoSource.path, targetPath, "", credentials); err != nil {
 		// Cleanup on failure
 		os.RemoveAll(targetPath)
 		return "", nil, err
 	}
+	if err := applyCredentials(targetPath, credentials); err != nil {
+		os.RemoveAll(targetPath)
+		return "", nil, err
+	}
 
 	// If branch was specified, checkout that branch after cloning
 	if repoSource.branch != "" {
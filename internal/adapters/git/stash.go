@@ -0,0 +1,106 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// stashChanges stashes the uncommitted changes of the worktree, untracked files included
+// Returns false when there was nothing to stash.
+func stashChanges(ctx context.Context, worktreePath, message string) (bool, error) {
+	logging.Logger.Info("Stashing worktree changes", "path", worktreePath)
+
+	status, err := gitOutput(ctx, worktreePath, "status", "--porcelain")
+	if err != nil {
+		return false, fmt.Errorf("git status failed: %w", err)
+	}
+	if strings.TrimSpace(status) == "" {
+		return false, nil
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "stash", "push", "--include-untracked", "-m", message)
+	cmd.Dir = worktreePath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git stash failed", "error", err, "output", string(output))
+		return false, fmt.Errorf("failed to stash changes: %w\nOutput: %s", err, string(output))
+	}
+	return true, nil
+}
+
+// listStashes returns the stashes made on the branch checked out in the worktree, newest first
+// Worktrees share the stash list of their repository, so stashes of other branches are left out.
+func listStashes(ctx context.Context, worktreePath string) ([]domain.Stash, error) {
+	branch, err := stashBranch(ctx, worktreePath)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := gitOutput(ctx, worktreePath, "stash", "list", "--format=%gd%x1f%gs%x1f%ct")
+	if err != nil {
+		return nil, fmt.Errorf("git stash list failed: %w", err)
+	}
+
+	var stashes []domain.Stash
+	for _, line := range splitNonEmptyLines(output) {
+		fields := strings.SplitN(line, "\x1f", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		message, ok := stashMessageOnBranch(fields[1], branch)
+		if !ok {
+			continue
+		}
+		stash := domain.Stash{Message: message, Ref: fields[0]}
+		if seconds, err := strconv.ParseInt(strings.TrimSpace(fields[2]), 10, 64); err == nil {
+			stash.CreatedAt = time.Unix(seconds, 0)
+		}
+		stashes = append(stashes, stash)
+	}
+	return stashes, nil
+}
+
+// stashMessageOnBranch returns the message of a stash subject made on branch
+// Subjects read "On <branch>: <message>" for stashes with a message and
+// "WIP on <branch>: <commit>" for those without.
+func stashMessageOnBranch(subject, branch string) (string, bool) {
+	for _, prefix := range []string{"On " + branch + ": ", "WIP on " + branch + ": "} {
+		if message, ok := strings.CutPrefix(subject, prefix); ok {
+			return message, true
+		}
+	}
+	return "", false
+}
+
+// stashBranch returns the branch checked out in the worktree as git names it in stash subjects
+func stashBranch(ctx context.Context, worktreePath string) (string, error) {
+	branch, err := gitOutput(ctx, worktreePath, "rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return "", fmt.Errorf("failed to get branch: %w", err)
+	}
+	branch = strings.TrimSpace(branch)
+	if branch == "HEAD" {
+		branch = "(no branch)" // How git names a detached HEAD in stash subjects
+	}
+	return branch, nil
+}
+
+// popStash applies a stash to the worktree and drops it
+// When applying conflicts, git keeps the stash so no work is lost.
+func popStash(ctx context.Context, worktreePath, ref string) error {
+	logging.Logger.Info("Popping stash", "path", worktreePath, "ref", ref)
+
+	cmd := exec.CommandContext(ctx, "git", "stash", "pop", ref)
+	cmd.Dir = worktreePath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git stash pop failed", "error", err, "output", string(output))
+		return fmt.Errorf("failed to pop %s: %w\nOutput: %s", ref, err, string(output))
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStashChanges_RoundTrip(t *testing.T) {
+	_, clone, _ := setupCloneWithFeatureBranch(t)
+	ctx := context.Background()
+
+	stashed, err := stashChanges(ctx, clone, "nothing here")
+	require.NoError(t, err)
+	assert.False(t, stashed, "a clean worktree has nothing to stash")
+
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "README.md"), []byte("# WIP"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "draft.txt"), []byte("draft"), 0644))
+
+	stashed, err = stashChanges(ctx, clone, "rocha: feature")
+	require.NoError(t, err)
+	assert.True(t, stashed)
+	assert.NoFileExists(t, filepath.Join(clone, "draft.txt"), "untracked files are stashed too")
+
+	stashes, err := listStashes(ctx, clone)
+	require.NoError(t, err)
+	require.Len(t, stashes, 1)
+	assert.Equal(t, "rocha: feature", stashes[0].Message)
+	assert.Equal(t, "stash@{0}", stashes[0].Ref)
+	assert.False(t, stashes[0].CreatedAt.IsZero())
+
+	require.NoError(t, popStash(ctx, clone, stashes[0].Ref))
+	assert.FileExists(t, filepath.Join(clone, "draft.txt"))
+
+	stashes, err = listStashes(ctx, clone)
+	require.NoError(t, err)
+	assert.Empty(t, stashes)
+}
+
+func TestListStashes_LeavesOutOtherBranches(t *testing.T) {
+	_, clone, baseBranch := setupCloneWithFeatureBranch(t)
+	ctx := context.Background()
+
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "README.md"), []byte("# WIP"), 0644))
+	runGitIn(t, clone, "stash", "push", "-m", "feature work")
+	runGitIn(t, clone, "checkout", baseBranch)
+	require.NoError(t, os.WriteFile(filepath.Join(clone, "README.md"), []byte("# Base WIP"), 0644))
+	runGitIn(t, clone, "stash", "push")
+
+	stashes, err := listStashes(ctx, clone)
+	require.NoError(t, err)
+	require.Len(t, stashes, 1)
+	assert.Equal(t, "stash@{0}", stashes[0].Ref)
+
+	runGitIn(t, clone, "checkout", "feature")
+	stashes, err = listStashes(ctx, clone)
+	require.NoError(t, err)
+	require.Len(t, stashes, 1)
+	assert.Equal(t, "feature work", stashes[0].Message)
+	assert.Equal(t, "stash@{1}", stashes[0].Ref)
+}
@@ -15,9 +15,11 @@ import (
 )
 
 // fetchGitStats fetches all git statistics for the given worktree path
+// Base branch counts compare against origin/<baseBranch> as of the last fetch;
+// an empty baseBranch uses the default branch of origin.
 // Uses errgroup for concurrent fetching with context cancellation
-func fetchGitStats(ctx context.Context, worktreePath string) (*domain.GitStats, error) {
-	logging.Logger.Debug("Fetching git stats", "path", worktreePath)
+func fetchGitStats(ctx context.Context, worktreePath, baseBranch string) (*domain.GitStats, error) {
+	logging.Logger.Debug("Fetching git stats", "path", worktreePath, "base", baseBranch)
 
 	stats := &domain.GitStats{
 		FetchedAt: time.Now(),
@@ -53,6 +55,42 @@ func fetchGitStats(ctx context.Context, worktreePath string) (*domain.GitStats,
 		return nil
 	})
 
+	// Fetch ahead/behind the base branch
+	g.Go(func() error {
+		if baseBranch == "" {
+			baseBranch = getDefaultBranch(ctx, worktreePath)
+		}
+		baseRef := "origin/" + baseBranch
+		ahead, behind, err := countAheadBehind(ctx, worktreePath, baseRef)
+		if err != nil {
+			logging.Logger.Debug("Failed to get ahead/behind base", "base", baseRef, "error", err)
+			// Non-fatal - continue with other stats
+			return nil
+		}
+		stats.BaseAhead = ahead
+		stats.BaseBehind = behind
+		stats.BaseRef = baseRef
+
+		syncedAt, err := getMergeBaseTime(ctx, worktreePath, baseRef)
+		if err != nil {
+			logging.Logger.Debug("Failed to get merge base time", "base", baseRef, "error", err)
+			return nil
+		}
+		stats.BaseSyncedAt = syncedAt
+		return nil
+	})
+
+	// Fetch stash count
+	g.Go(func() error {
+		stashes, err := listStashes(ctx, worktreePath)
+		if err != nil {
+			logging.Logger.Debug("Failed to list stashes", "error", err)
+			// Non-fatal - continue with other stats
+			return nil
+		}
+		stats.Stashes = len(stashes)
+		return nil
+	})
 
 	// Wait for all fetches to complete
 	if err := g.Wait(); err != nil {
@@ -63,21 +101,29 @@ func fetchGitStats(ctx context.Context, worktreePath string) (*domain.GitStats,
 	logging.Logger.Debug("Git stats fetched successfully",
 		"ahead", stats.Ahead,
 		"behind", stats.Behind,
+		"baseAhead", stats.BaseAhead,
+		"baseBehind", stats.BaseBehind,
 		"changedFiles", stats.ChangedFiles,
 		"additions", stats.Additions,
-		"deletions", stats.Deletions)
+		"deletions", stats.Deletions,
+		"stashes", stats.Stashes)
 
 	return stats, nil
 }
 
 // getAheadBehind returns how many commits ahead and behind the tracking branch
 func getAheadBehind(ctx context.Context, path string) (ahead int, behind int, err error) {
-	cmd := exec.CommandContext(ctx, "git", "rev-list", "--left-right", "--count", "HEAD...@{upstream}")
+	return countAheadBehind(ctx, path, "@{upstream}")
+}
+
+// countAheadBehind returns how many commits HEAD is ahead and behind the given ref
+func countAheadBehind(ctx context.Context, path, ref string) (ahead int, behind int, err error) {
+	cmd := exec.CommandContext(ctx, "git", "rev-list", "--left-right", "--count", "HEAD..."+ref)
 	cmd.Dir = path
 
 	output, err := cmd.Output()
 	if err != nil {
-		// No tracking branch or other error
+		// No tracking branch, unknown ref, or other error
 		return 0, 0, fmt.Errorf("git rev-list failed: %w", err)
 	}
 
@@ -100,6 +146,31 @@ func getAheadBehind(ctx context.Context, path string) (ahead int, behind int, er
 	return ahead, behind, nil
 }
 
+// getMergeBaseTime returns the commit time of the merge base between HEAD and the given ref
+func getMergeBaseTime(ctx context.Context, path, ref string) (time.Time, error) {
+	mergeBaseCmd := exec.CommandContext(ctx, "git", "merge-base", "HEAD", ref)
+	mergeBaseCmd.Dir = path
+
+	mergeBase, err := mergeBaseCmd.Output()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("git merge-base failed: %w", err)
+	}
+
+	showCmd := exec.CommandContext(ctx, "git", "show", "-s", "--format=%ct", strings.TrimSpace(string(mergeBase)))
+	showCmd.Dir = path
+
+	output, err := showCmd.Output()
+	if err != nil {
+		return time.Time{}, fmt.Errorf("git show failed: %w", err)
+	}
+
+	seconds, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse commit time: %w", err)
+	}
+	return time.Unix(seconds, 0), nil
+}
+
 // getFileStats returns lines added, deleted, and number of changed files in working directory
 func getFileStats(ctx context.Context, path string) (additions, deletions, fileCount int, err error) {
 	// Get additions/deletions from git diff
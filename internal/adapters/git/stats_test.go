@@ -0,0 +1,44 @@
+package git
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFetchGitStats_BaseAheadBehindAfterFetch(t *testing.T) {
+	origin, clone, baseBranch := setupCloneWithFeatureBranch(t)
+
+	require.NoError(t, os.WriteFile(filepath.Join(origin, "other.txt"), []byte("other"), 0644))
+	runGitIn(t, origin, "add", "other.txt")
+	runGitIn(t, origin, "commit", "-m", "Unrelated change")
+
+	// Before fetching, the clone does not know about the new base commit
+	stats, err := fetchGitStats(context.Background(), clone, baseBranch)
+	require.NoError(t, err)
+	assert.Equal(t, "origin/"+baseBranch, stats.BaseRef)
+	assert.Equal(t, 1, stats.BaseAhead)
+	assert.Equal(t, 0, stats.BaseBehind)
+	assert.False(t, stats.BaseSyncedAt.IsZero())
+
+	require.NoError(t, fetchBase(context.Background(), clone, baseBranch))
+
+	stats, err = fetchGitStats(context.Background(), clone, baseBranch)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.BaseAhead)
+	assert.Equal(t, 1, stats.BaseBehind)
+}
+
+func TestFetchGitStats_UnknownBaseLeavesBaseEmpty(t *testing.T) {
+	_, clone, _ := setupCloneWithFeatureBranch(t)
+
+	stats, err := fetchGitStats(context.Background(), clone, "does-not-exist")
+
+	require.NoError(t, err)
+	assert.Empty(t, stats.BaseRef)
+	assert.Zero(t, stats.BaseBehind)
+}
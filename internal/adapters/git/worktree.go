@@ -1,6 +1,7 @@
 package git
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
@@ -9,6 +10,7 @@ import (
 	"unicode"
 
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 )
 
@@ -93,8 +95,8 @@ func branchExists(repoPath, branchName string) bool {
 
 // createWorktree creates a new git worktree at the specified path
 // If the branch exists, it checks it out; if not, it creates a new branch
-// It ensures the worktree is created from the latest origin/main by fetching,
-// checking out main, and resetting to origin/main before creating the worktree
+// It ensures the worktree is created from the latest default branch of origin by fetching,
+// checking it out, and resetting it to its origin counterpart before creating the worktree
 func createWorktree(repoPath, worktreePath, branchName string) error {
 	logging.Logger.Info("Creating worktree", "repo_path", repoPath, "worktree_path", worktreePath, "branch_name", branchName)
 
@@ -117,26 +119,27 @@ func createWorktree(repoPath, worktreePath, branchName string) error {
 		logging.Logger.Debug("Git fetch origin succeeded")
 	}
 
-	// Checkout main branch to ensure worktree is created from main
-	logging.Logger.Info("Checking out main branch", "repo_path", repoPath)
-	checkoutCmd := exec.Command("git", "checkout", "main")
+	// Checkout the default branch to ensure worktree is created from it
+	baseBranch := getDefaultBranch(context.Background(), repoPath)
+	logging.Logger.Info("Checking out base branch", "repo_path", repoPath, "base", baseBranch)
+	checkoutCmd := exec.Command("git", "checkout", baseBranch)
 	checkoutCmd.Dir = repoPath
 
 	if output, err := checkoutCmd.CombinedOutput(); err != nil {
-		logging.Logger.Warn("Git checkout main failed (continuing anyway)", "error", err, "output", string(output))
+		logging.Logger.Warn("Git checkout of base branch failed (continuing anyway)", "error", err, "output", string(output))
 	} else {
-		logging.Logger.Debug("Git checkout main succeeded")
+		logging.Logger.Debug("Git checkout of base branch succeeded")
 	}
 
-	// Reset to origin/main to get latest state
-	logging.Logger.Info("Resetting to origin/main", "repo_path", repoPath)
-	resetCmd := exec.Command("git", "reset", "--hard", "origin/main")
+	// Reset to origin/<base> to get latest state
+	logging.Logger.Info("Resetting to origin base branch", "repo_path", repoPath, "base", baseBranch)
+	resetCmd := exec.Command("git", "reset", "--hard", "origin/"+baseBranch)
 	resetCmd.Dir = repoPath
 
 	if output, err := resetCmd.CombinedOutput(); err != nil {
-		logging.Logger.Warn("Git reset to origin/main failed (continuing anyway)", "error", err, "output", string(output))
+		logging.Logger.Warn("Git reset to origin base branch failed (continuing anyway)", "error", err, "output", string(output))
 	} else {
-		logging.Logger.Debug("Git reset to origin/main succeeded")
+		logging.Logger.Debug("Git reset to origin base branch succeeded")
 	}
 
 	// Validate branch name before creating worktree
@@ -196,6 +199,123 @@ func removeWorktree(repoPath, worktreePath string) error {
 	return nil
 }
 
+// moveWorktree moves a worktree directory with git worktree move, so the repository
+// keeps track of it at its new path
+func moveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error {
+	logging.Logger.Info("Moving worktree", "repo_path", repoPath, "from", worktreePath, "to", newPath)
+
+	cmd := exec.CommandContext(ctx, "git", "worktree", "move", worktreePath, newPath)
+	cmd.Dir = repoPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git worktree move failed", "error", err, "output", string(output))
+		return fmt.Errorf("failed to move worktree: %w\nOutput: %s", err, string(output))
+	}
+	return nil
+}
+
+// deleteBranch force-deletes a local branch, such as the branch of a removed temporary worktree
+func deleteBranch(repoPath, branchName string) error {
+	logging.Logger.Info("Deleting branch", "repo_path", repoPath, "branch", branchName)
+
+	cmd := exec.Command("git", "branch", "-D", branchName)
+	cmd.Dir = repoPath
+	if output, err := cmd.CombinedOutput(); err != nil {
+		logging.Logger.Error("Git branch delete failed", "error", err, "output", string(output))
+		return fmt.Errorf("failed to delete branch: %w\nOutput: %s", err, string(output))
+	}
+	return nil
+}
+
+// setWorktreeIdentity makes commits in a worktree use identity, leaving the main checkout and
+// other worktrees alone. The settings go to the worktree's own config file, which git reads
+// once extensions.worktreeConfig is on in the repository.
+func setWorktreeIdentity(ctx context.Context, worktreePath string, identity domain.GitIdentity) error {
+	logging.Logger.Info("Setting worktree git identity", "path", worktreePath, "identity", identity.String())
+
+	settings := [][2]string{{"extensions.worktreeConfig", "true"}}
+	if identity.Name != "" {
+		settings = append(settings, [2]string{"user.name", identity.Name})
+	}
+	if identity.Email != "" {
+		settings = append(settings, [2]string{"user.email", identity.Email})
+	}
+	if identity.SigningKey != "" {
+		signingKey := identity.SigningKey
+		if identity.SSHSigning() {
+			signingKey = config.ExpandPath(signingKey)
+			settings = append(settings, [2]string{"gpg.format", "ssh"})
+		}
+		settings = append(settings,
+			[2]string{"user.signingkey", signingKey},
+			[2]string{"commit.gpgsign", "true"},
+			[2]string{"tag.gpgsign", "true"})
+	}
+
+	for i, setting := range settings {
+		args := []string{"config", setting[0], setting[1]}
+		if i > 0 {
+			args = []string{"config", "--worktree", setting[0], setting[1]}
+		}
+		cmd := exec.CommandContext(ctx, "git", args...)
+		cmd.Dir = worktreePath
+		if output, err := cmd.CombinedOutput(); err != nil {
+			logging.Logger.Error("Git config failed", "key", setting[0], "error", err, "output", string(output))
+			return fmt.Errorf("failed to set %s: %w\nOutput: %s", setting[0], err, string(output))
+		}
+	}
+	return nil
+}
+
+// getWorktreeStatus reports uncommitted changes and unpushed commits in a worktree.
+// Commits only count as unpushed when the repository has a remote to push to.
+func getWorktreeStatus(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error) {
+	status := &domain.WorktreeStatus{}
+	if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+		return status, nil
+	}
+
+	output, err := gitOutput(ctx, worktreePath, "status", "--porcelain")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktree status: %w", err)
+	}
+	status.UncommittedFiles = splitNonEmptyLines(output)
+
+	remotes, err := gitOutput(ctx, worktreePath, "remote")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list remotes: %w", err)
+	}
+	if strings.TrimSpace(remotes) == "" {
+		return status, nil
+	}
+
+	output, err = gitOutput(ctx, worktreePath, "log", "--format=%h %s", "HEAD", "--not", "--remotes")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list unpushed commits: %w", err)
+	}
+	status.UnpushedCommits = splitNonEmptyLines(output)
+
+	return status, nil
+}
+
+// gitOutput runs a git command in dir and returns its stdout
+func gitOutput(ctx context.Context, dir string, args ...string) (string, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	return string(output), err
+}
+
+// splitNonEmptyLines splits output into lines, dropping empty ones
+func splitNonEmptyLines(output string) []string {
+	var lines []string
+	for _, line := range strings.Split(output, "\n") {
+		if strings.TrimSpace(line) != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
 // worktreeInfo holds parsed information about a git worktree
 type worktreeInfo struct {
 	branch string
@@ -1,13 +1,17 @@
 package git
 
 import (
+	"context"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
 )
 
 // setupTestRepo creates a git repo with initial commit for testing
@@ -95,3 +99,100 @@ func TestGetWorktreeForBranch_SkipsMainDirectory(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Empty(t, result, "should skip worktree at .main path")
 }
+
+func TestGetWorktreeStatus_CleanWithoutRemote(t *testing.T) {
+	repoPath := setupTestRepo(t)
+
+	status, err := getWorktreeStatus(context.Background(), repoPath)
+
+	require.NoError(t, err)
+	assert.False(t, status.HasLocalWork(), "commits cannot be unpushed without a remote")
+}
+
+func TestGetWorktreeStatus_UncommittedFiles(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "notes.md"), []byte("draft"), 0644))
+
+	status, err := getWorktreeStatus(context.Background(), repoPath)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"?? notes.md"}, status.UncommittedFiles)
+}
+
+func TestGetWorktreeStatus_UnpushedCommits(t *testing.T) {
+	remotePath := setupTestRepo(t)
+	clonePath := filepath.Join(t.TempDir(), "clone")
+	require.NoError(t, exec.Command("git", "clone", remotePath, clonePath).Run())
+
+	cmd := exec.Command("git", "-c", "user.name=Test", "-c", "user.email=test@test.com", "commit", "--allow-empty", "-m", "Local only")
+	cmd.Dir = clonePath
+	require.NoError(t, cmd.Run())
+
+	status, err := getWorktreeStatus(context.Background(), clonePath)
+
+	require.NoError(t, err)
+	require.Len(t, status.UnpushedCommits, 1)
+	assert.Contains(t, status.UnpushedCommits[0], "Local only")
+}
+
+func TestGetWorktreeStatus_MissingPath(t *testing.T) {
+	status, err := getWorktreeStatus(context.Background(), filepath.Join(t.TempDir(), "gone"))
+
+	require.NoError(t, err)
+	assert.False(t, status.HasLocalWork())
+}
+
+func TestDeleteBranch(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	runGitIn(t, repoPath, "branch", "temporary")
+
+	require.NoError(t, deleteBranch(repoPath, "temporary"))
+
+	_, err := gitOutput(context.Background(), repoPath, "rev-parse", "--verify", "refs/heads/temporary")
+	assert.Error(t, err, "the branch is gone")
+	assert.Error(t, deleteBranch(repoPath, "temporary"))
+}
+
+func TestMoveWorktreeAndRenameBranch(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	base := t.TempDir()
+	oldPath := filepath.Join(base, "old")
+	newPath := filepath.Join(base, "new")
+	runGitIn(t, repoPath, "worktree", "add", oldPath, "-b", "old-branch")
+	ctx := context.Background()
+
+	require.NoError(t, renameBranch(ctx, oldPath, "old-branch", "new-branch"))
+	require.NoError(t, moveWorktree(ctx, repoPath, oldPath, newPath))
+
+	assert.NoDirExists(t, oldPath)
+	assert.Equal(t, "new-branch", getBranchName(newPath), "the worktree keeps the renamed branch checked out")
+	worktree, err := getWorktreeForBranch(repoPath, "new-branch")
+	require.NoError(t, err)
+	assert.Equal(t, newPath, worktree, "git tracks the worktree at its new path")
+}
+
+func TestSetWorktreeIdentity_OnlyAppliesToWorktree(t *testing.T) {
+	repoPath := setupTestRepo(t)
+	worktreePath := filepath.Join(t.TempDir(), "client-worktree")
+	require.NoError(t, createWorktree(repoPath, worktreePath, "client-branch"))
+
+	err := setWorktreeIdentity(context.Background(), worktreePath, domain.GitIdentity{
+		Email:      "jane@client.com",
+		Name:       "Jane Contractor",
+		SigningKey: "/keys/client.pub",
+	})
+	require.NoError(t, err)
+
+	configValue := func(dir, key string) string {
+		out, _ := gitOutput(context.Background(), dir, "config", "--get", key)
+		return strings.TrimSpace(out)
+	}
+	assert.Equal(t, "jane@client.com", configValue(worktreePath, "user.email"))
+	assert.Equal(t, "Jane Contractor", configValue(worktreePath, "user.name"))
+	assert.Equal(t, "/keys/client.pub", configValue(worktreePath, "user.signingkey"))
+	assert.Equal(t, "ssh", configValue(worktreePath, "gpg.format"))
+	assert.Equal(t, "true", configValue(worktreePath, "commit.gpgsign"))
+
+	assert.Equal(t, "test@test.com", configValue(repoPath, "user.email"), "main checkout keeps its identity")
+	assert.Empty(t, configValue(repoPath, "commit.gpgsign"))
+}
@@ -0,0 +1,91 @@
+package instance
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// FileLock implements ports.InstanceLock with an advisory lock on a file holding the owner as JSON
+type FileLock struct {
+	file *os.File // Open while the lock is held
+	path string
+}
+
+// NewFileLock creates a lock on path, created on first use
+func NewFileLock(path string) *FileLock {
+	return &FileLock{path: path}
+}
+
+// TryLock implements ports.InstanceLock.TryLock
+func (l *FileLock) TryLock(owner domain.InstanceOwner) (bool, *domain.InstanceOwner, error) {
+	if l.file != nil {
+		return true, nil, nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0755); err != nil {
+		return false, nil, fmt.Errorf("failed to create rocha home: %w", err)
+	}
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return false, nil, fmt.Errorf("failed to open instance lock: %w", err)
+	}
+
+	locked, err := tryLock(f)
+	if err != nil || !locked {
+		f.Close()
+		if err != nil {
+			return false, nil, err
+		}
+		return false, readOwner(l.path), nil
+	}
+
+	data, err := json.Marshal(owner)
+	if err != nil {
+		unlock(f)
+		f.Close()
+		return false, nil, fmt.Errorf("failed to encode instance owner: %w", err)
+	}
+	if err := f.Truncate(0); err != nil {
+		unlock(f)
+		f.Close()
+		return false, nil, fmt.Errorf("failed to write instance lock: %w", err)
+	}
+	if _, err := f.WriteAt(append(data, '\n'), 0); err != nil {
+		unlock(f)
+		f.Close()
+		return false, nil, fmt.Errorf("failed to write instance lock: %w", err)
+	}
+
+	l.file = f
+	return true, nil, nil
+}
+
+// Unlock implements ports.InstanceLock.Unlock
+// The file is kept: removing it would let a process lock a new file while another waits on the old one.
+func (l *FileLock) Unlock() error {
+	if l.file == nil {
+		return nil
+	}
+	_ = l.file.Truncate(0)
+	unlock(l.file)
+	err := l.file.Close()
+	l.file = nil
+	return err
+}
+
+// readOwner returns the owner recorded in the lock file, or nil while it is being written
+func readOwner(path string) *domain.InstanceOwner {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var owner domain.InstanceOwner
+	if err := json.Unmarshal(data, &owner); err != nil {
+		return nil
+	}
+	return &owner
+}
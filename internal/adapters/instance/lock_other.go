@@ -0,0 +1,19 @@
+//go:build (!unix && !windows) || aix
+
+package instance
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// tryLock fails where file locks are not available, so a second scheduler is refused
+// instead of running the background work twice
+func tryLock(f *os.File) (bool, error) {
+	return false, fmt.Errorf("%w: file locks are not available", ports.ErrInstanceLockUnsupported)
+}
+
+// unlock is a no-op where file locks are not available
+func unlock(f *os.File) {}
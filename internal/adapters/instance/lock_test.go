@@ -0,0 +1,38 @@
+//go:build (unix && !aix) || windows
+
+package instance
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestFileLock(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "instance.lock")
+	owner := domain.InstanceOwner{Command: "ui", PID: 4242, StartedAt: time.Now().UTC().Truncate(time.Second), TmuxPane: "%3"}
+
+	first := NewFileLock(path)
+	locked, _, err := first.TryLock(owner)
+	require.NoError(t, err)
+	require.True(t, locked)
+
+	second := NewFileLock(path)
+	locked, holder, err := second.TryLock(domain.InstanceOwner{Command: "ui", PID: 5000})
+	require.NoError(t, err)
+	assert.False(t, locked, "the lock is held by the first instance")
+	require.NotNil(t, holder)
+	assert.Equal(t, owner, *holder)
+
+	// The follower takes over once the primary exits
+	require.NoError(t, first.Unlock())
+	locked, _, err = second.TryLock(domain.InstanceOwner{Command: "ui", PID: 5000})
+	require.NoError(t, err)
+	assert.True(t, locked)
+	require.NoError(t, second.Unlock())
+}
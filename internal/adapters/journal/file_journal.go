@@ -0,0 +1,293 @@
+package journal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// Files of the journal directory. Hooks only ever append to journalFile, holding the lock on
+// appendLockFile; a drain renames it to incomingFile under that same lock, so no hook is left
+// writing to the claimed file, and moves its entries to pendingFile. pendingFile, like deadFile,
+// is only written while holding the lock on lockFile, which keeps drains from overlapping.
+const (
+	appendLockFile = "journal.lock"
+	deadFile       = "dead.jsonl"
+	incomingFile   = "incoming.jsonl"
+	journalFile    = "journal.jsonl"
+	lockFile       = "drain.lock"
+	pendingFile    = "pending.jsonl"
+)
+
+// lockTimeout bounds how long appends and dead-letter maintenance wait for a lock
+const lockTimeout = 5 * time.Second
+
+// FileJournal implements ports.HookJournal with JSON lines files in a directory
+type FileJournal struct {
+	dir string
+}
+
+// NewFileJournal creates a journal stored in dir, created on first use
+func NewFileJournal(dir string) *FileJournal {
+	return &FileJournal{dir: dir}
+}
+
+// Append implements ports.HookJournal.Append
+// The append lock is only held for the write, never while a drain applies entries.
+func (j *FileJournal) Append(entry domain.HookJournalEntry) error {
+	if err := os.MkdirAll(j.dir, 0700); err != nil {
+		return fmt.Errorf("failed to create journal directory: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+	unlock, err := lock(ctx, j.path(appendLockFile))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return appendEntries(j.path(journalFile), []domain.HookJournalEntry{entry})
+}
+
+// Drain implements ports.HookJournal.Drain
+func (j *FileJournal) Drain(ctx context.Context, process ports.HookJournalProcessor) error {
+	// Nothing to do most of the time - don't take the lock for it
+	if !exists(j.path(journalFile)) && !exists(j.path(incomingFile)) && !exists(j.path(pendingFile)) {
+		return nil
+	}
+
+	unlock, err := lock(ctx, j.path(lockFile))
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := j.claimIncoming(ctx); err != nil {
+		return err
+	}
+	pending, err := readEntries(j.path(pendingFile))
+	if err != nil {
+		return err
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+	sortByReceivedAt(pending)
+
+	keep, dead := process(pending)
+	if len(dead) > 0 {
+		if err := appendEntries(j.path(deadFile), dead); err != nil {
+			return fmt.Errorf("failed to move entries to the dead-letter queue: %w", err)
+		}
+	}
+	return writeEntries(j.path(pendingFile), keep)
+}
+
+// ListPending implements ports.HookJournal.ListPending
+// Reads without the lock, so a drain in progress may already have applied some entries.
+func (j *FileJournal) ListPending() ([]domain.HookJournalEntry, error) {
+	var entries []domain.HookJournalEntry
+	for _, name := range []string{pendingFile, incomingFile, journalFile} {
+		fileEntries, err := readEntries(j.path(name))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, fileEntries...)
+	}
+	sortByReceivedAt(entries)
+	return entries, nil
+}
+
+// ListDeadLetters implements ports.HookJournal.ListDeadLetters
+func (j *FileJournal) ListDeadLetters() ([]domain.HookJournalEntry, error) {
+	return readEntries(j.path(deadFile))
+}
+
+// RequeueDeadLetters implements ports.HookJournal.RequeueDeadLetters
+// Requeued entries get a fresh set of attempts, and are replayed in the order they were received
+// among the entries pending.
+func (j *FileJournal) RequeueDeadLetters() (int, error) {
+	return j.takeDeadLetters(func(dead []domain.HookJournalEntry) error {
+		for i := range dead {
+			dead[i].Attempts = 0
+		}
+		return appendEntries(j.path(pendingFile), dead)
+	})
+}
+
+// ClearDeadLetters implements ports.HookJournal.ClearDeadLetters
+func (j *FileJournal) ClearDeadLetters() (int, error) {
+	return j.takeDeadLetters(func([]domain.HookJournalEntry) error { return nil })
+}
+
+// takeDeadLetters hands the dead letters to handle and removes them once it succeeds
+func (j *FileJournal) takeDeadLetters(handle func(dead []domain.HookJournalEntry) error) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), lockTimeout)
+	defer cancel()
+
+	unlock, err := lock(ctx, j.path(lockFile))
+	if err != nil {
+		return 0, err
+	}
+	defer unlock()
+
+	dead, err := readEntries(j.path(deadFile))
+	if err != nil || len(dead) == 0 {
+		return 0, err
+	}
+	if err := handle(dead); err != nil {
+		return 0, err
+	}
+	if err := os.Remove(j.path(deadFile)); err != nil {
+		return 0, fmt.Errorf("failed to remove dead letters: %w", err)
+	}
+	return len(dead), nil
+}
+
+// claimIncoming moves the entries appended by hooks to the pending file
+// The journal is renamed under the append lock, so every append either landed before the
+// rename or goes to a new journal. A claim interrupted by a crash is completed by the next drain.
+func (j *FileJournal) claimIncoming(ctx context.Context) error {
+	if err := j.mergeIncoming(); err != nil {
+		return err
+	}
+	if !exists(j.path(journalFile)) {
+		return nil
+	}
+
+	unlock, err := lock(ctx, j.path(appendLockFile))
+	if err != nil {
+		return err
+	}
+	err = os.Rename(j.path(journalFile), j.path(incomingFile))
+	unlock()
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("failed to claim journal: %w", err)
+	}
+	return j.mergeIncoming()
+}
+
+// mergeIncoming appends the claimed journal to the pending entries and removes it
+func (j *FileJournal) mergeIncoming() error {
+	incoming, err := readEntries(j.path(incomingFile))
+	if err != nil {
+		return err
+	}
+	if len(incoming) > 0 {
+		if err := appendEntries(j.path(pendingFile), incoming); err != nil {
+			return fmt.Errorf("failed to save pending entries: %w", err)
+		}
+	}
+	if err := os.Remove(j.path(incomingFile)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove claimed journal: %w", err)
+	}
+	return nil
+}
+
+func (j *FileJournal) path(name string) string {
+	return filepath.Join(j.dir, name)
+}
+
+// appendEntries appends entries to a JSON lines file with a single synced write
+func appendEntries(path string, entries []domain.HookJournalEntry) error {
+	var buf bytes.Buffer
+	for _, entry := range entries {
+		line, err := json.Marshal(entry)
+		if err != nil {
+			return fmt.Errorf("failed to encode journal entry: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open journal: %w", err)
+	}
+	defer f.Close()
+
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		return fmt.Errorf("failed to write journal: %w", err)
+	}
+	return f.Sync()
+}
+
+// writeEntries replaces a JSON lines file with entries, removing it when there are none
+func writeEntries(path string, entries []domain.HookJournalEntry) error {
+	if len(entries) == 0 {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("failed to remove %s: %w", filepath.Base(path), err)
+		}
+		return nil
+	}
+
+	tmp := path + ".tmp"
+	if err := os.Remove(tmp); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove stale %s: %w", filepath.Base(tmp), err)
+	}
+	if err := appendEntries(tmp, entries); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", filepath.Base(path), err)
+	}
+	return nil
+}
+
+// readEntries reads a JSON lines file, returning no entries when it does not exist
+// Lines that cannot be decoded, such as one cut short by a crash, are skipped.
+func readEntries(path string) ([]domain.HookJournalEntry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open %s: %w", filepath.Base(path), err)
+	}
+	defer f.Close()
+
+	var entries []domain.HookJournalEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var entry domain.HookJournalEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			logging.Logger.Warn("Skipping unreadable journal entry", "file", filepath.Base(path), "error", err)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	return entries, nil
+}
+
+// sortByReceivedAt orders entries oldest first, keeping the file order of entries received together
+func sortByReceivedAt(entries []domain.HookJournalEntry) {
+	sort.SliceStable(entries, func(a, b int) bool {
+		return entries[a].ReceivedAt.Before(entries[b].ReceivedAt)
+	})
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,126 @@
+//go:build unix && !aix
+
+package journal
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func entryIDs(entries []domain.HookJournalEntry) []string {
+	ids := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		ids = append(ids, entry.ID)
+	}
+	return ids
+}
+
+func TestFileJournal_DrainKeepsAndDeadLetters(t *testing.T) {
+	j := NewFileJournal(filepath.Join(t.TempDir(), "journal"))
+	ctx := context.Background()
+
+	for _, id := range []string{"1", "2", "3"} {
+		require.NoError(t, j.Append(domain.HookJournalEntry{EventType: "stop", ID: id, SessionName: "api"}))
+	}
+
+	var seen []string
+	err := j.Drain(ctx, func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry) {
+		seen = entryIDs(pending)
+		return pending[2:], pending[1:2]
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, seen, "entries are drained oldest first")
+
+	// Kept entries come before the ones appended since
+	require.NoError(t, j.Append(domain.HookJournalEntry{EventType: "prompt", ID: "4", SessionName: "api"}))
+	pending, err := j.ListPending()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, entryIDs(pending))
+
+	dead, err := j.ListDeadLetters()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2"}, entryIDs(dead))
+
+	err = j.Drain(ctx, func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry) {
+		seen = entryIDs(pending)
+		return nil, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"3", "4"}, seen)
+
+	pending, err = j.ListPending()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func TestFileJournal_CompletesInterruptedClaim(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "journal")
+	j := NewFileJournal(dir)
+
+	// A drain that crashed after claiming the journal left it behind
+	require.NoError(t, j.Append(domain.HookJournalEntry{ID: "1"}))
+	require.NoError(t, os.Rename(filepath.Join(dir, journalFile), filepath.Join(dir, incomingFile)))
+	require.NoError(t, j.Append(domain.HookJournalEntry{ID: "2"}))
+
+	var seen []string
+	err := j.Drain(context.Background(), func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry) {
+		seen = entryIDs(pending)
+		return nil, nil
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, seen)
+}
+
+func TestFileJournal_RequeueDeadLetters(t *testing.T) {
+	j := NewFileJournal(filepath.Join(t.TempDir(), "journal"))
+
+	require.NoError(t, j.Append(domain.HookJournalEntry{ID: "1"}))
+	require.NoError(t, j.Drain(context.Background(), func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry) {
+		pending[0].Attempts = 10
+		return nil, pending
+	}))
+
+	n, err := j.RequeueDeadLetters()
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	pending, err := j.ListPending()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Zero(t, pending[0].Attempts, "requeued entries get a fresh set of attempts")
+
+	dead, err := j.ListDeadLetters()
+	require.NoError(t, err)
+	assert.Empty(t, dead)
+}
+
+func TestFileJournal_RequeuedEntriesReplayInReceivedOrder(t *testing.T) {
+	j := NewFileJournal(filepath.Join(t.TempDir(), "journal"))
+	ctx := context.Background()
+	received := time.Now()
+
+	require.NoError(t, j.Append(domain.HookJournalEntry{ID: "old", ReceivedAt: received}))
+	require.NoError(t, j.Drain(ctx, func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry) {
+		return nil, pending
+	}))
+	require.NoError(t, j.Append(domain.HookJournalEntry{ID: "new", ReceivedAt: received.Add(time.Second)}))
+
+	_, err := j.RequeueDeadLetters()
+	require.NoError(t, err)
+
+	var seen []string
+	require.NoError(t, j.Drain(ctx, func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry) {
+		seen = entryIDs(pending)
+		return nil, nil
+	}))
+	assert.Equal(t, []string{"old", "new"}, seen)
+}
@@ -0,0 +1,16 @@
+//go:build !unix || aix
+
+package journal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// lock fails where advisory file locks are not available, so hook events are applied
+// directly instead of being journaled and drained by processes that could race
+func lock(ctx context.Context, path string) (func(), error) {
+	return nil, fmt.Errorf("%w: file locks are not available", ports.ErrHookJournalLockUnsupported)
+}
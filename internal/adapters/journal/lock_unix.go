@@ -0,0 +1,46 @@
+//go:build unix && !aix
+
+package journal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// lockRetryInterval is how often a busy lock is retried
+const lockRetryInterval = 20 * time.Millisecond
+
+// lock takes an exclusive advisory lock on path, waiting for it until ctx is done
+// The lock is released by the returned function, or by the OS if the process dies.
+func lock(ctx context.Context, path string) (func(), error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open journal lock: %w", err)
+	}
+
+	for {
+		err := unix.Flock(int(f.Fd()), unix.LOCK_EX|unix.LOCK_NB)
+		if err == nil {
+			return func() {
+				_ = unix.Flock(int(f.Fd()), unix.LOCK_UN)
+				f.Close()
+			}, nil
+		}
+		if !errors.Is(err, unix.EWOULDBLOCK) {
+			f.Close()
+			return nil, fmt.Errorf("failed to lock journal: %w", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			f.Close()
+			return nil, fmt.Errorf("journal is being drained by another process: %w", ctx.Err())
+		case <-time.After(lockRetryInterval):
+		}
+	}
+}
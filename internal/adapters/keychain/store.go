@@ -0,0 +1,50 @@
+package keychain
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// Store implements ports.SecretStore using the OS keychain command line tools.
+// Platform-specific commands are in store_*.go files with build tags.
+type Store struct{}
+
+// Verify interface compliance at compile time
+var _ ports.SecretStore = (*Store)(nil)
+
+// NewStore creates a new keychain store
+func NewStore() *Store {
+	return &Store{}
+}
+
+// DeleteSecret implements SecretStore.DeleteSecret
+func (s *Store) DeleteSecret(service, account string) error {
+	return deleteSecret(service, account)
+}
+
+// GetSecret implements SecretStore.GetSecret
+func (s *Store) GetSecret(service, account string) (string, error) {
+	return getSecret(service, account)
+}
+
+// SetSecret implements SecretStore.SetSecret
+func (s *Store) SetSecret(service, account, secret string) error {
+	return setSecret(service, account, secret)
+}
+
+// run executes a keychain tool, feeding stdin if given, and returns its trimmed stdout
+func run(stdin string, name string, args ...string) (string, error) {
+	if _, err := exec.LookPath(name); err != nil {
+		return "", fmt.Errorf("keychain tool %s not found: %w", name, err)
+	}
+
+	cmd := exec.Command(name, args...)
+	if stdin != "" {
+		cmd.Stdin = strings.NewReader(stdin)
+	}
+	output, err := cmd.Output()
+	return strings.TrimSpace(string(output)), err
+}
@@ -0,0 +1,71 @@
+//go:build darwin
+
+package keychain
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// errSecItemNotFound is the exit code security(1) uses when no item matches
+const errSecItemNotFound = 44
+
+func deleteSecret(service, account string) error {
+	_, err := run("", "security", "delete-generic-password", "-s", service, "-a", account)
+	if isNotFound(err) {
+		return ports.ErrSecretNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete secret from keychain: %w", err)
+	}
+	return nil
+}
+
+func getSecret(service, account string) (string, error) {
+	secret, err := run("", "security", "find-generic-password", "-s", service, "-a", account, "-w")
+	if isNotFound(err) {
+		return "", ports.ErrSecretNotFound
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret from keychain: %w", err)
+	}
+	return secret, nil
+}
+
+func setSecret(service, account, secret string) error {
+	if strings.ContainsAny(service+account+secret, "\r\n") {
+		return errors.New("failed to store secret in keychain: line breaks are not allowed")
+	}
+
+	// The command is read from stdin by interactive mode, so the secret never shows in
+	// the process list; -U updates the item if it already exists
+	command := fmt.Sprintf("add-generic-password -U -s %s -a %s -w %s\n",
+		securityQuote(service), securityQuote(account), securityQuote(secret))
+	if _, err := run(command, "security", "-i"); err != nil {
+		return fmt.Errorf("failed to store secret in keychain: %w", err)
+	}
+
+	// Interactive mode exits cleanly even when a command fails, so read the item back
+	stored, err := getSecret(service, account)
+	if err != nil {
+		return err
+	}
+	if stored != secret {
+		return errors.New("failed to store secret in keychain: item was not updated")
+	}
+	return nil
+}
+
+// securityQuote quotes an argument of a security(1) interactive mode command
+func securityQuote(arg string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(arg) + `"`
+}
+
+func isNotFound(err error) bool {
+	var exitErr *exec.ExitError
+	return errors.As(err, &exitErr) && exitErr.ExitCode() == errSecItemNotFound
+}
@@ -0,0 +1,41 @@
+//go:build linux
+
+package keychain
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// Secrets are kept in the freedesktop Secret Service (GNOME Keyring, KWallet) via secret-tool
+
+func deleteSecret(service, account string) error {
+	if _, err := run("", "secret-tool", "clear", "service", service, "account", account); err != nil {
+		return fmt.Errorf("failed to delete secret from keychain: %w", err)
+	}
+	return nil
+}
+
+func getSecret(service, account string) (string, error) {
+	secret, err := run("", "secret-tool", "lookup", "service", service, "account", account)
+	var exitErr *exec.ExitError
+	if err != nil && !errors.As(err, &exitErr) {
+		return "", fmt.Errorf("failed to read secret from keychain: %w", err)
+	}
+	// secret-tool exits non-zero without output when nothing matches
+	if secret == "" {
+		return "", ports.ErrSecretNotFound
+	}
+	return secret, nil
+}
+
+func setSecret(service, account, secret string) error {
+	label := fmt.Sprintf("rocha %s (%s)", service, account)
+	if _, err := run(secret, "secret-tool", "store", "--label", label, "service", service, "account", account); err != nil {
+		return fmt.Errorf("failed to store secret in keychain: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+//go:build !linux && !darwin
+
+package keychain
+
+import "errors"
+
+var errUnsupported = errors.New("OS keychain is not supported on this platform")
+
+func deleteSecret(service, account string) error {
+	return errUnsupported
+}
+
+func getSecret(service, account string) (string, error) {
+	return "", errUnsupported
+}
+
+func setSecret(service, account, secret string) error {
+	return errUnsupported
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// GitRepository records how long the fetches of a ports.GitRepository take, as they happen
+type GitRepository struct {
+	ports.GitRepository
+	recorder ports.MetricsRecorder
+}
+
+// NewGitRepository wraps repo so its fetches are recorded with recorder
+func NewGitRepository(repo ports.GitRepository, recorder ports.MetricsRecorder) *GitRepository {
+	return &GitRepository{GitRepository: repo, recorder: recorder}
+}
+
+// FetchGitStats implements ports.GitStatsProvider.FetchGitStats
+func (r *GitRepository) FetchGitStats(ctx context.Context, worktreePath, baseBranch string) (*domain.GitStats, error) {
+	start := time.Now()
+	stats, err := r.GitRepository.FetchGitStats(ctx, worktreePath, baseBranch)
+	r.recorder.ObserveGitStatsFetch(time.Since(start))
+	return stats, err
+}
+
+// FetchRemote implements ports.BranchSyncer.FetchRemote
+func (r *GitRepository) FetchRemote(ctx context.Context, repoPath string) error {
+	start := time.Now()
+	err := r.GitRepository.FetchRemote(ctx, repoPath)
+	r.recorder.ObserveGitFetch(time.Since(start))
+	return err
+}
@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestGitRepository_RecordsFetches(t *testing.T) {
+	ctx := context.Background()
+	repo := mocks.NewMockGitRepository(t)
+	recorder := mocks.NewMockMetricsRecorder(t)
+
+	repo.EXPECT().FetchGitStats(ctx, "/wt/a", "main").Return(&domain.GitStats{}, nil)
+	repo.EXPECT().FetchRemote(ctx, "/repos/a").Return(errors.New("connection timed out"))
+	recorder.EXPECT().ObserveGitStatsFetch(mock.Anything).Once()
+	recorder.EXPECT().ObserveGitFetch(mock.Anything).Once()
+
+	wrapped := NewGitRepository(repo, recorder)
+	_, err := wrapped.FetchGitStats(ctx, "/wt/a", "main")
+	assert.NoError(t, err)
+	assert.Error(t, wrapped.FetchRemote(ctx, "/repos/a"), "failed fetches are timed too")
+}
@@ -0,0 +1,188 @@
+// Package metrics exposes rocha metrics in the Prometheus text exposition format
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// Histogram bucket upper bounds, in seconds
+var (
+	dbBuckets       = []float64{0.0005, 0.001, 0.0025, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1}
+	gitBuckets      = []float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+	gitFetchBuckets = []float64{0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120}
+)
+
+// histogram is a cumulative Prometheus histogram
+type histogram struct {
+	buckets []float64
+	counts  []uint64 // Observations per bucket (not cumulative)
+	count   uint64
+	sum     float64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]uint64, len(buckets))}
+}
+
+func (h *histogram) observe(seconds float64) {
+	if i, _ := slices.BinarySearch(h.buckets, seconds); i < len(h.buckets) {
+		h.counts[i]++
+	}
+	h.count++
+	h.sum += seconds
+}
+
+// Registry keeps latency histograms in memory and renders them with the collected values
+type Registry struct {
+	dbOperations map[string]*histogram
+	gitFetches   *histogram
+	gitStats     *histogram
+	mu           sync.Mutex
+}
+
+// Compile-time interface verification
+var _ ports.MetricsRecorder = (*Registry)(nil)
+
+// NewRegistry creates an empty Registry
+func NewRegistry() *Registry {
+	return &Registry{
+		dbOperations: make(map[string]*histogram),
+		gitFetches:   newHistogram(gitFetchBuckets),
+		gitStats:     newHistogram(gitBuckets),
+	}
+}
+
+// ObserveDBOperation implements MetricsRecorder.ObserveDBOperation
+func (r *Registry) ObserveDBOperation(operation string, duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	h, ok := r.dbOperations[operation]
+	if !ok {
+		h = newHistogram(dbBuckets)
+		r.dbOperations[operation] = h
+	}
+	h.observe(duration.Seconds())
+}
+
+// ObserveGitFetch implements MetricsRecorder.ObserveGitFetch
+func (r *Registry) ObserveGitFetch(duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gitFetches.observe(duration.Seconds())
+}
+
+// ObserveGitStatsFetch implements MetricsRecorder.ObserveGitStatsFetch
+func (r *Registry) ObserveGitStatsFetch(duration time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.gitStats.observe(duration.Seconds())
+}
+
+// Handler serves the metrics, calling collect on every scrape
+func (r *Registry) Handler(collect func(ctx context.Context) (*ports.MetricsSnapshot, error)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		snapshot, err := collect(req.Context())
+		if err != nil {
+			logging.Logger.Error("Failed to collect metrics", "error", err)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		WriteSnapshot(w, snapshot)
+		r.WriteHistograms(w)
+	})
+}
+
+// WriteSnapshot renders the collected session, transition, and token values
+func WriteSnapshot(w io.Writer, snapshot *ports.MetricsSnapshot) {
+	writeHeader(w, "rocha_sessions", "gauge", "Sessions by state, excluding archived sessions.")
+	for _, state := range sortedKeys(snapshot.SessionsByState) {
+		fmt.Fprintf(w, "rocha_sessions{state=%q} %d\n", state, snapshot.SessionsByState[state])
+	}
+
+	writeHeader(w, "rocha_session_state_transitions_total", "counter", "Session state transitions seen since the metrics started being collected.")
+	transitions := make([]ports.StateTransition, 0, len(snapshot.Transitions))
+	for transition := range snapshot.Transitions {
+		transitions = append(transitions, transition)
+	}
+	slices.SortFunc(transitions, func(a, b ports.StateTransition) int {
+		return strings.Compare(string(a.From)+"/"+string(a.To), string(b.From)+"/"+string(b.To))
+	})
+	for _, t := range transitions {
+		from := string(t.From)
+		if from == "" {
+			from = "none" // First recorded state of a session
+		}
+		fmt.Fprintf(w, "rocha_session_state_transitions_total{from=%q,to=%q} %d\n", from, t.To, snapshot.Transitions[t])
+	}
+
+	writeHeader(w, "rocha_tokens_today", "gauge", "Claude tokens used today, by type.")
+	fmt.Fprintf(w, "rocha_tokens_today{type=\"cache_creation\"} %d\n", snapshot.TodayTokens.CacheCreation)
+	fmt.Fprintf(w, "rocha_tokens_today{type=\"cache_read\"} %d\n", snapshot.TodayTokens.CacheRead)
+	fmt.Fprintf(w, "rocha_tokens_today{type=\"input\"} %d\n", snapshot.TodayTokens.InputTokens)
+	fmt.Fprintf(w, "rocha_tokens_today{type=\"output\"} %d\n", snapshot.TodayTokens.OutputTokens)
+}
+
+// WriteHistograms renders the recorded latency histograms
+func (r *Registry) WriteHistograms(w io.Writer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	name := "rocha_db_operation_duration_seconds"
+	writeHeader(w, name, "histogram", "Duration of database operations.")
+	for _, operation := range sortedKeys(r.dbOperations) {
+		writeHistogram(w, name, fmt.Sprintf("operation=%q,", operation), r.dbOperations[operation])
+	}
+
+	name = "rocha_git_fetch_duration_seconds"
+	writeHeader(w, name, "histogram", "Duration of fetches of session repositories from their remote.")
+	writeHistogram(w, name, "", r.gitFetches)
+
+	name = "rocha_git_stats_fetch_duration_seconds"
+	writeHeader(w, name, "histogram", "Duration of git stats fetches for session worktrees.")
+	writeHistogram(w, name, "", r.gitStats)
+}
+
+func writeHeader(w io.Writer, name, metricType, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// writeHistogram renders the bucket, sum, and count series of a histogram
+// labels is empty or a label list ending with a comma
+func writeHistogram(w io.Writer, name string, labels string, h *histogram) {
+	var cumulative uint64
+	for i, bound := range h.buckets {
+		cumulative += h.counts[i]
+		fmt.Fprintf(w, "%s_bucket{%sle=%q} %d\n", name, labels, strconv.FormatFloat(bound, 'g', -1, 64), cumulative)
+	}
+	fmt.Fprintf(w, "%s_bucket{%sle=\"+Inf\"} %d\n", name, labels, h.count)
+
+	labels = strings.TrimSuffix(labels, ",")
+	if labels != "" {
+		labels = "{" + labels + "}"
+	}
+	fmt.Fprintf(w, "%s_sum%s %s\n", name, labels, strconv.FormatFloat(h.sum, 'g', -1, 64))
+	fmt.Fprintf(w, "%s_count%s %d\n", name, labels, h.count)
+}
+
+func sortedKeys[K ~string, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,69 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+func TestHandler(t *testing.T) {
+	registry := NewRegistry()
+	registry.ObserveDBOperation("query", 2*time.Millisecond)
+	registry.ObserveDBOperation("query", 2*time.Second)
+	registry.ObserveGitStatsFetch(50 * time.Millisecond)
+	registry.ObserveGitFetch(3 * time.Second)
+
+	snapshot := &ports.MetricsSnapshot{
+		SessionsByState: map[domain.SessionState]int{domain.StateIdle: 1, domain.StateWorking: 2},
+		TodayTokens:     ports.TokenTotals{InputTokens: 7},
+		Transitions: map[ports.StateTransition]int{
+			{To: domain.StateWorking}:                         3,
+			{From: domain.StateWorking, To: domain.StateIdle}: 1,
+		},
+	}
+	handler := registry.Handler(func(ctx context.Context) (*ports.MetricsSnapshot, error) { return snapshot, nil })
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	body := rec.Body.String()
+	assert.Equal(t, http.StatusOK, rec.Code)
+	for _, line := range []string{
+		"# TYPE rocha_sessions gauge",
+		`rocha_sessions{state="working"} 2`,
+		`rocha_session_state_transitions_total{from="none",to="working"} 3`,
+		`rocha_session_state_transitions_total{from="working",to="idle"} 1`,
+		`rocha_tokens_today{type="input"} 7`,
+		`rocha_db_operation_duration_seconds_bucket{operation="query",le="0.001"} 0`,
+		`rocha_db_operation_duration_seconds_bucket{operation="query",le="0.0025"} 1`,
+		`rocha_db_operation_duration_seconds_bucket{operation="query",le="1"} 1`,
+		`rocha_db_operation_duration_seconds_bucket{operation="query",le="+Inf"} 2`,
+		`rocha_db_operation_duration_seconds_count{operation="query"} 2`,
+		`rocha_git_stats_fetch_duration_seconds_bucket{le="0.05"} 1`,
+		"rocha_git_stats_fetch_duration_seconds_count 1",
+		`rocha_git_fetch_duration_seconds_bucket{le="2.5"} 0`,
+		`rocha_git_fetch_duration_seconds_bucket{le="5"} 1`,
+		"rocha_git_fetch_duration_seconds_count 1",
+	} {
+		assert.Contains(t, body, line+"\n")
+	}
+}
+
+func TestHandler_CollectError(t *testing.T) {
+	handler := NewRegistry().Handler(func(ctx context.Context) (*ports.MetricsSnapshot, error) {
+		return nil, errors.New("database is locked")
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+}
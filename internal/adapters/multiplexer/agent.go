@@ -0,0 +1,121 @@
+// Package multiplexer holds the helpers shared by the terminal multiplexer adapters (tmux, zellij, screen)
+package multiplexer
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// Shell returns the user's shell, started in every new session
+func Shell() string {
+	if shell := os.Getenv("SHELL"); shell != "" {
+		return shell
+	}
+	return "bash"
+}
+
+// PromptArg returns the start-claude argument passing initialPrompt as the first prompt
+// Returns an empty string when there is no prompt
+func PromptArg(initialPrompt string) string {
+	if initialPrompt == "" {
+		return ""
+	}
+
+	// Shell escape the prompt using $'...' syntax for proper escaping of special chars
+	escapedPrompt := strings.ReplaceAll(initialPrompt, "\\", "\\\\")
+	escapedPrompt = strings.ReplaceAll(escapedPrompt, "'", "\\'")
+	escapedPrompt = strings.ReplaceAll(escapedPrompt, "\n", "\\n")
+	logging.Logger.Debug("Initial prompt will be sent to Claude", "prompt_length", len(initialPrompt))
+	return fmt.Sprintf(" $'%s'", escapedPrompt)
+}
+
+// ResumeArg returns the start-claude argument resuming an earlier Claude conversation
+func ResumeArg(claudeSessionID string) string {
+	return " --resume " + domain.ShellQuote(claudeSessionID)
+}
+
+// AgentStartCommand returns the shell command typed into a new session to start Claude with rocha hooks
+// startArgs is appended verbatim to the start-claude command and must already be shell escaped
+func AgentStartCommand(name string, worktreePath string, claudeDir string, startArgs string) string {
+	rochaBin, err := os.Executable()
+	if err != nil {
+		rochaBin = "rocha"
+		logging.Logger.Warn("Could not get rocha executable path, using PATH", "error", err)
+	}
+	logging.Logger.Debug("Rocha binary path", "path", rochaBin)
+
+	// Set session name in environment and start claude with hooks
+	envVars := fmt.Sprintf("ROCHA_SESSION_NAME=%s", name)
+
+	// Add CLAUDE_CONFIG_DIR if specified
+	if claudeDir != "" {
+		envVars += fmt.Sprintf(" CLAUDE_CONFIG_DIR=%q", claudeDir)
+		logging.Logger.Info("Setting CLAUDE_CONFIG_DIR for session", "claude_dir", claudeDir)
+	}
+
+	// Add debug environment variables if set
+	if debugEnabled := os.Getenv("ROCHA_DEBUG"); debugEnabled == "1" {
+		envVars += " ROCHA_DEBUG=1"
+		if debugFile := os.Getenv("ROCHA_DEBUG_FILE"); debugFile != "" {
+			envVars += fmt.Sprintf(" ROCHA_DEBUG_FILE=%q", debugFile)
+		}
+		if maxLogFiles := os.Getenv("ROCHA_MAX_LOG_FILES"); maxLogFiles != "" {
+			envVars += fmt.Sprintf(" ROCHA_MAX_LOG_FILES=%s", maxLogFiles)
+		}
+	}
+
+	// Add execution ID if set
+	if execID := os.Getenv("ROCHA_EXECUTION_ID"); execID != "" {
+		envVars += fmt.Sprintf(" ROCHA_EXECUTION_ID=%s", execID)
+	}
+
+	if worktreePath != "" {
+		logging.Logger.Info("Starting Claude in worktree directory", "path", worktreePath)
+		return fmt.Sprintf("cd %q && clear && %s %s start-claude%s", worktreePath, envVars, rochaBin, startArgs)
+	}
+	return fmt.Sprintf("clear && %s %s start-claude%s", envVars, rochaBin, startArgs)
+}
+
+// CleanEnv returns the current environment without the variables starting with the given prefixes
+// Multiplexers refuse to attach from inside themselves while their variables are set
+func CleanEnv(prefixes ...string) []string {
+	var env []string
+	for _, e := range os.Environ() {
+		nested := false
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(e, prefix) {
+				nested = true
+				break
+			}
+		}
+		if !nested {
+			env = append(env, e)
+		}
+	}
+	return env
+}
+
+// CommandError marks failures caused by a missing multiplexer binary with ports.ErrTmuxUnavailable
+func CommandError(err error) error {
+	if errors.Is(err, exec.ErrNotFound) {
+		return fmt.Errorf("%w: %v", ports.ErrTmuxUnavailable, err)
+	}
+	return err
+}
+
+// LastLines returns the last n lines of content, ignoring trailing blank lines
+// Returns the whole content when n <= 0
+func LastLines(content string, n int) string {
+	lines := strings.Split(strings.TrimRight(content, "\n "), "\n")
+	if n > 0 && len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
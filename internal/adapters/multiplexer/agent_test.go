@@ -0,0 +1,25 @@
+package multiplexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumeArg(t *testing.T) {
+	tests := []struct {
+		name            string
+		claudeSessionID string
+		want            string
+	}{
+		{name: "plain id", claudeSessionID: "0b9e2c1a-7f3d-4c2e-9a51-3e8d6f1b2c4a", want: " --resume 0b9e2c1a-7f3d-4c2e-9a51-3e8d6f1b2c4a"},
+		{name: "shell expansions stay literal", claudeSessionID: "$(id)`id`$HOME", want: " --resume '$(id)`id`$HOME'"},
+		{name: "single quote", claudeSessionID: "it's", want: ` --resume 'it'\''s'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ResumeArg(tt.claudeSessionID))
+		})
+	}
+}
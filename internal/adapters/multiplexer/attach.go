@@ -0,0 +1,117 @@
+package multiplexer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+
+	"github.com/creack/pty"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// attachmentState tracks the state of an attached session
+type attachmentState struct {
+	ptmx     *os.File
+	attachCh chan struct{}
+	mu       sync.Mutex
+}
+
+// Attachments runs multiplexer attach commands in a PTY and detaches them on Ctrl+Q
+type Attachments struct {
+	attachedSessions map[string]*attachmentState
+	mu               sync.Mutex
+}
+
+// NewAttachments creates a new Attachments instance
+func NewAttachments() *Attachments {
+	return &Attachments{
+		attachedSessions: make(map[string]*attachmentState),
+	}
+}
+
+// Attach starts cmd attached to the session. Returns a channel that will be closed when detached.
+func (a *Attachments) Attach(sessionName string, cmd *exec.Cmd) (chan struct{}, error) {
+	a.mu.Lock()
+	state, exists := a.attachedSessions[sessionName]
+	if !exists {
+		state = &attachmentState{}
+		a.attachedSessions[sessionName] = state
+	}
+	a.mu.Unlock()
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if state.attachCh != nil {
+		return nil, ports.ErrTmuxAlreadyAttached
+	}
+
+	// Start the attach command with PTY
+	ptmx, err := pty.Start(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach to session: %w", err)
+	}
+
+	state.ptmx = ptmx
+	state.attachCh = make(chan struct{})
+
+	// Copy session output to stdout
+	go func() {
+		io.Copy(os.Stdout, ptmx)
+	}()
+
+	// Read stdin and forward to the session, watch for Ctrl+Q (ASCII 17) to detach
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			n, err := os.Stdin.Read(buf)
+			if err != nil {
+				break
+			}
+
+			// Check for Ctrl+Q (ASCII 17)
+			for i := 0; i < n; i++ {
+				if buf[i] == 17 { // Ctrl+Q
+					a.Detach(sessionName)
+					return
+				}
+			}
+
+			// Forward to the session
+			ptmx.Write(buf[:n])
+		}
+	}()
+
+	return state.attachCh, nil
+}
+
+// Detach detaches from the session
+func (a *Attachments) Detach(sessionName string) error {
+	a.mu.Lock()
+	state, exists := a.attachedSessions[sessionName]
+	a.mu.Unlock()
+
+	if !exists {
+		return ports.ErrTmuxNotAttached
+	}
+
+	state.mu.Lock()
+	defer state.mu.Unlock()
+
+	if state.attachCh == nil {
+		return ports.ErrTmuxNotAttached
+	}
+
+	if state.ptmx != nil {
+		state.ptmx.Close()
+		state.ptmx = nil
+	}
+
+	close(state.attachCh)
+	state.attachCh = nil
+
+	return nil
+}
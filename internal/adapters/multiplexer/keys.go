@@ -0,0 +1,42 @@
+package multiplexer
+
+import "strings"
+
+// namedKeys maps the tmux key names used by rocha to the bytes a terminal sends for them
+var namedKeys = map[string]string{
+	"BSpace": "\x7f",
+	"Enter":  "\r",
+	"Escape": "\x1b",
+	"Space":  " ",
+	"Tab":    "\t",
+}
+
+// KeyText converts tmux send-keys arguments to the text typed into the session
+// Like tmux, an argument naming a key ("Enter", "C-m", "Escape") sends that key
+// and any other argument is typed literally
+func KeyText(keys ...string) string {
+	var text strings.Builder
+	for _, key := range keys {
+		if bytes, ok := KeyBytes(key); ok {
+			text.WriteString(bytes)
+		} else {
+			text.WriteString(key)
+		}
+	}
+	return text.String()
+}
+
+// KeyBytes returns the bytes sent for a tmux key name, or false when key is plain text
+func KeyBytes(key string) (string, bool) {
+	if bytes, ok := namedKeys[key]; ok {
+		return bytes, true
+	}
+
+	// Control keys: C-a through C-z
+	if len(key) == 3 && strings.HasPrefix(key, "C-") {
+		if c := key[2] | 0x20; c >= 'a' && c <= 'z' {
+			return string(rune(c - 'a' + 1)), true
+		}
+	}
+	return "", false
+}
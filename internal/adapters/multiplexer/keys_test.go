@@ -0,0 +1,35 @@
+package multiplexer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyText(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []string
+		want string
+	}{
+		{name: "text and enter", keys: []string{"hello world", "Enter"}, want: "hello world\r"},
+		{name: "carriage return", keys: []string{"C-m"}, want: "\r"},
+		{name: "interrupt", keys: []string{"C-c"}, want: "\x03"},
+		{name: "escape then text", keys: []string{"Escape", "/exit"}, want: "\x1b/exit"},
+		{name: "key names inside text are typed", keys: []string{"press Enter"}, want: "press Enter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, KeyText(tt.keys...))
+		})
+	}
+}
+
+func TestLastLines(t *testing.T) {
+	content := "one\ntwo\nthree\n\n\n"
+
+	assert.Equal(t, "two\nthree\n", LastLines(content, 2))
+	assert.Equal(t, "one\ntwo\nthree\n", LastLines(content, 10))
+	assert.Equal(t, "one\ntwo\nthree\n", LastLines(content, 0))
+}
@@ -0,0 +1,41 @@
+//go:build linux
+
+package process
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// userHZ is the clock tick of the times in /proc, fixed at 100 by the kernel ABI
+const userHZ = 100
+
+// preciseCPUTime reads the CPU time of a process from /proc/<pid>/stat
+// ps prints it on Linux in whole seconds, too coarse between two polls.
+func preciseCPUTime(pid int) (time.Duration, bool) {
+	data, err := os.ReadFile("/proc/" + strconv.Itoa(pid) + "/stat")
+	if err != nil {
+		return 0, false
+	}
+
+	// The command name may hold spaces and parentheses; the fields follow its last ')'
+	end := strings.LastIndexByte(string(data), ')')
+	if end < 0 {
+		return 0, false
+	}
+	fields := strings.Fields(string(data[end+1:]))
+	if len(fields) < 13 {
+		return 0, false
+	}
+	utime, err := strconv.ParseInt(fields[11], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	stime, err := strconv.ParseInt(fields[12], 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return time.Duration(utime+stime) * time.Second / userHZ, true
+}
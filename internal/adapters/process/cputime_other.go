@@ -0,0 +1,10 @@
+//go:build !linux
+
+package process
+
+import "time"
+
+// preciseCPUTime is unavailable here; the ps time column already has hundredths
+func preciseCPUTime(pid int) (time.Duration, bool) {
+	return 0, false
+}
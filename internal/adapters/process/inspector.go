@@ -2,53 +2,57 @@ package process
 
 import (
 	"fmt"
-	"os/exec"
 	"regexp"
-	"strings"
+	"sync"
+	"time"
 
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 )
 
-// OSProcessInspector implements ProcessInspector using OS commands (ps, pgrep, tmux)
-type OSProcessInspector struct{}
+// paneRefreshInterval is how often the pane processes are listed again while a sampled
+// session has no pane, or its pane exited; a new session shows its usage within it
+const paneRefreshInterval = 10 * time.Second
+
+// OSProcessInspector implements ProcessInspector using the process table (ps) and the
+// pane processes reported by the terminal multiplexer
+type OSProcessInspector struct {
+	cpuSamples    map[int]cpuSample // CPU time of each agent at the previous sample, by PID
+	mu            sync.Mutex
+	panePIDs      map[string]int // Pane process of each session, as last listed
+	panes         ports.TmuxPaneController
+	panesListedAt time.Time
+}
+
+// cpuSample is the CPU time a process had used when it was sampled
+type cpuSample struct {
+	cpuTime   time.Duration
+	sampledAt time.Time
+}
 
 // Compile-time interface verification
 var _ ports.ProcessInspector = (*OSProcessInspector)(nil)
 
 // NewOSProcessInspector creates a new OS process inspector
-func NewOSProcessInspector() *OSProcessInspector {
-	return &OSProcessInspector{}
+// panes reports the pane process of each session, which the agent runs under
+func NewOSProcessInspector(panes ports.TmuxPaneController) *OSProcessInspector {
+	return &OSProcessInspector{
+		cpuSamples: make(map[int]cpuSample),
+		panes:      panes,
+	}
 }
 
 // GetClaudeSettings extracts --settings JSON from a running Claude process
-// by finding the tmux pane PID, locating the Claude child process,
-// and parsing its command-line arguments
+// by locating the Claude process of the session and parsing its command-line arguments
 func (i *OSProcessInspector) GetClaudeSettings(sessionName string) (string, error) {
-	// 1. Get tmux pane PID
-	panePID, err := i.getTmuxPanePID(sessionName)
+	proc, err := i.agentProcess(sessionName)
 	if err != nil {
-		return "", fmt.Errorf("failed to get tmux pane PID: %w", err)
+		return "", err
 	}
 
-	logging.Logger.Debug("Found tmux pane", "pane_pid", panePID, "session", sessionName)
-
-	// 2. Find Claude process under pane
-	claudePID, err := i.getClaudeProcessPID(panePID)
-	if err != nil {
-		return "", fmt.Errorf("no running Claude process found: %w", err)
-	}
-
-	logging.Logger.Debug("Found Claude process", "claude_pid", claudePID, "session", sessionName)
-
-	// 3. Extract command line
-	commandLine, err := i.getProcessCommandLine(claudePID)
-	if err != nil {
-		return "", fmt.Errorf("failed to get process command line: %w", err)
-	}
+	logging.Logger.Debug("Found Claude process", "claude_pid", proc.pid, "session", sessionName)
 
-	// 4. Extract --settings JSON
-	settingsJSON, err := i.extractSettingsFromCommandLine(commandLine)
+	settingsJSON, err := i.extractSettingsFromCommandLine(proc.command)
 	if err != nil {
 		return "", fmt.Errorf("failed to extract settings: %w", err)
 	}
@@ -56,45 +60,65 @@ func (i *OSProcessInspector) GetClaudeSettings(sessionName string) (string, erro
 	return settingsJSON, nil
 }
 
-func (i *OSProcessInspector) getTmuxPanePID(sessionName string) (string, error) {
-	logging.Logger.Debug("Getting tmux pane PID", "session", sessionName)
-	cmd := exec.Command("tmux", "list-panes", "-t", sessionName, "-F", "#{pane_pid}")
-	output, err := cmd.Output()
+// agentProcess finds the Claude process running under the pane of a session
+// The panes are listed again rather than cached, since the process found may be signalled.
+func (i *OSProcessInspector) agentProcess(sessionName string) (*processInfo, error) {
+	i.mu.Lock()
+	panePIDs, err := i.listPanePIDs(time.Now())
+	i.mu.Unlock()
 	if err != nil {
-		return "", fmt.Errorf("session not running or not found: %w", err)
+		return nil, fmt.Errorf("failed to get pane PID: %w", err)
 	}
-
-	panePID := strings.TrimSpace(string(output))
-	if panePID == "" {
-		return "", fmt.Errorf("no pane PID found")
+	panePID, ok := panePIDs[sessionName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ports.ErrTmuxSessionNotFound, sessionName)
 	}
 
-	return panePID, nil
-}
+	logging.Logger.Debug("Found pane", "pane_pid", panePID, "session", sessionName)
 
-func (i *OSProcessInspector) getClaudeProcessPID(panePID string) (string, error) {
-	cmd := exec.Command("pgrep", "-P", panePID, "-f", "claude")
-	output, err := cmd.Output()
+	procs, err := listProcesses()
 	if err != nil {
-		return "", fmt.Errorf("claude process not found: %w", err)
+		return nil, err
 	}
-
-	claudePID := strings.TrimSpace(string(output))
-	if claudePID == "" {
-		return "", fmt.Errorf("no claude PID found")
+	proc := findAgentProcess(procs, panePID)
+	if proc == nil {
+		return nil, fmt.Errorf("no running Claude process found under pane %d", panePID)
 	}
-
-	return claudePID, nil
+	return proc, nil
 }
 
-func (i *OSProcessInspector) getProcessCommandLine(pid string) (string, error) {
-	cmd := exec.Command("ps", "-p", pid, "-o", "command=")
-	output, err := cmd.Output()
+// listPanePIDs lists the pane process of each session and caches it; callers hold mu
+func (i *OSProcessInspector) listPanePIDs(now time.Time) (map[string]int, error) {
+	panePIDs, err := i.panes.PanePIDs()
 	if err != nil {
-		return "", fmt.Errorf("failed to read process: %w", err)
+		return nil, err
+	}
+	i.panePIDs = panePIDs
+	i.panesListedAt = now
+	return panePIDs, nil
+}
+
+// cachedPanePIDs returns the cached pane processes, listing them again when there are none
+// yet, or when a session of sessionNames has no live pane and paneRefreshInterval elapsed.
+// Callers hold mu.
+func (i *OSProcessInspector) cachedPanePIDs(sessionNames []string, procs []processInfo, now time.Time) (map[string]int, error) {
+	if i.panePIDs == nil {
+		return i.listPanePIDs(now)
+	}
+	if now.Sub(i.panesListedAt) < paneRefreshInterval {
+		return i.panePIDs, nil
 	}
 
-	return string(output), nil
+	alive := make(map[int]bool, len(procs))
+	for _, proc := range procs {
+		alive[proc.pid] = true
+	}
+	for _, name := range sessionNames {
+		if panePID, ok := i.panePIDs[name]; !ok || !alive[panePID] {
+			return i.listPanePIDs(now)
+		}
+	}
+	return i.panePIDs, nil
 }
 
 func (i *OSProcessInspector) extractSettingsFromCommandLine(commandLine string) (string, error) {
@@ -0,0 +1,214 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// processInfo is a row of the process table
+type processInfo struct {
+	command string
+	cpuTime time.Duration // CPU time used since the process started
+	pid     int
+	ppid    int
+	rssKB   int64
+}
+
+// GetResourceUsage samples CPU and memory of the Claude process of each session
+// Uses a single ps call regardless of the number of sessions; the pane processes are
+// cached (see cachedPanePIDs). CPU usage is measured between consecutive samples.
+// Multiplexers that do not report pane processes leave every session out.
+func (i *OSProcessInspector) GetResourceUsage(sessionNames []string) (map[string]*domain.ResourceUsage, error) {
+	procs, err := listProcesses()
+	if err != nil {
+		return nil, err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	now := time.Now()
+	panePIDs, err := i.cachedPanePIDs(sessionNames, procs, now)
+	if errors.Is(err, ports.ErrMultiplexerUnsupported) {
+		return make(map[string]*domain.ResourceUsage), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pane PIDs: %w", err)
+	}
+
+	agents := make(map[string]processInfo)
+	for _, name := range sessionNames {
+		panePID, ok := panePIDs[name]
+		if !ok {
+			continue
+		}
+		proc := findAgentProcess(procs, panePID)
+		if proc == nil {
+			continue
+		}
+		agent := *proc
+		if cpuTime, ok := preciseCPUTime(agent.pid); ok {
+			agent.cpuTime = cpuTime
+		}
+		agents[name] = agent
+	}
+
+	usage := i.measure(agents, now)
+	logging.Logger.Debug("Sampled agent resource usage", "sessions", len(sessionNames), "running", len(usage))
+	return usage, nil
+}
+
+// measure turns the agent process of each session into its usage, with the CPU usage
+// since the previous sample of the same process (0 on its first sample).
+// Samples of processes no longer running are dropped. Callers hold mu.
+func (i *OSProcessInspector) measure(agents map[string]processInfo, now time.Time) map[string]*domain.ResourceUsage {
+	usage := make(map[string]*domain.ResourceUsage, len(agents))
+	samples := make(map[int]cpuSample, len(agents))
+	for name, agent := range agents {
+		var cpuPercent float64
+		if prev, ok := i.cpuSamples[agent.pid]; ok {
+			wall := now.Sub(prev.sampledAt)
+			if wall > 0 && agent.cpuTime >= prev.cpuTime {
+				cpuPercent = float64(agent.cpuTime-prev.cpuTime) / float64(wall) * 100
+			}
+		}
+		samples[agent.pid] = cpuSample{cpuTime: agent.cpuTime, sampledAt: now}
+
+		usage[name] = &domain.ResourceUsage{
+			CPUPercent:  cpuPercent,
+			MemoryBytes: agent.rssKB * 1024,
+			PID:         agent.pid,
+			SampledAt:   now,
+		}
+	}
+	i.cpuSamples = samples
+	return usage
+}
+
+// KillAgentProcess terminates the Claude process of a session with SIGTERM
+func (i *OSProcessInspector) KillAgentProcess(sessionName string) error {
+	agent, err := i.agentProcess(sessionName)
+	if err != nil {
+		return err
+	}
+
+	logging.Logger.Info("Killing agent process", "session", sessionName, "pid", agent.pid)
+
+	proc, err := os.FindProcess(agent.pid)
+	if err != nil {
+		return fmt.Errorf("failed to find process %d: %w", agent.pid, err)
+	}
+	if err := proc.Signal(syscall.SIGTERM); err != nil {
+		return fmt.Errorf("failed to terminate process %d: %w", agent.pid, err)
+	}
+
+	return nil
+}
+
+// listProcesses reads the whole process table
+func listProcesses() ([]processInfo, error) {
+	output, err := exec.Command("ps", "-A", "-o", "pid=,ppid=,time=,rss=,command=").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list processes: %w", err)
+	}
+	return parseProcessTable(string(output)), nil
+}
+
+// parseProcessTable parses `ps -o pid=,ppid=,time=,rss=,command=` output
+func parseProcessTable(output string) []processInfo {
+	var procs []processInfo
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 5 {
+			continue
+		}
+
+		pid, err := strconv.Atoi(fields[0])
+		if err != nil {
+			continue
+		}
+		ppid, err := strconv.Atoi(fields[1])
+		if err != nil {
+			continue
+		}
+		cpuTime, err := parseCPUTime(fields[2])
+		if err != nil {
+			continue
+		}
+		rss, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		procs = append(procs, processInfo{
+			command: strings.Join(fields[4:], " "),
+			cpuTime: cpuTime,
+			pid:     pid,
+			ppid:    ppid,
+			rssKB:   rss,
+		})
+	}
+	return procs
+}
+
+// parseCPUTime parses the ps time column, [[dd-]hh:]mm:ss[.cc]
+// Linux prints 00:01:05, macOS and the BSDs 1:05.42.
+func parseCPUTime(value string) (time.Duration, error) {
+	var days int
+	if before, after, ok := strings.Cut(value, "-"); ok {
+		d, err := strconv.Atoi(before)
+		if err != nil {
+			return 0, fmt.Errorf("invalid CPU time %q: %w", value, err)
+		}
+		days, value = d, after
+	}
+
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("invalid CPU time %q", value)
+	}
+	seconds, err := strconv.ParseFloat(parts[len(parts)-1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid CPU time %q: %w", value, err)
+	}
+	total := time.Duration(days)*24*time.Hour + time.Duration(seconds*float64(time.Second))
+	units := []time.Duration{time.Minute, time.Hour}
+	for idx, part := range parts[:len(parts)-1] {
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return 0, fmt.Errorf("invalid CPU time %q: %w", value, err)
+		}
+		total += time.Duration(n) * units[len(parts)-2-idx]
+	}
+	return total, nil
+}
+
+// findAgentProcess returns the Claude process closest to the pane process among its
+// descendants: the pane shell starts it directly under tmux, one level deeper under screen
+func findAgentProcess(procs []processInfo, panePID int) *processInfo {
+	parents := map[int]bool{panePID: true}
+	for len(parents) > 0 {
+		children := make(map[int]bool)
+		for idx := range procs {
+			if !parents[procs[idx].ppid] {
+				continue
+			}
+			if strings.Contains(procs[idx].command, "claude") {
+				return &procs[idx]
+			}
+			children[procs[idx].pid] = true
+		}
+		parents = children
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package process
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/ports"
+	"github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestParseProcessTable(t *testing.T) {
+	output := `    1     0 00:00:03  1024 /sbin/init
+  100     1 00:00:00  2048 -zsh
+  200   100 01:02:05 512000 node /usr/local/bin/claude --settings {"hooks":{}}
+  bad line
+`
+
+	procs := parseProcessTable(output)
+
+	require.Len(t, procs, 3)
+	assert.Equal(t, 200, procs[2].pid)
+	assert.Equal(t, 100, procs[2].ppid)
+	assert.Equal(t, time.Hour+2*time.Minute+5*time.Second, procs[2].cpuTime)
+	assert.Equal(t, int64(512000), procs[2].rssKB)
+	assert.Contains(t, procs[2].command, "claude --settings")
+}
+
+func TestParseCPUTime(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "00:01:05", want: time.Minute + 5*time.Second},
+		{value: "2-03:00:00", want: 51 * time.Hour},
+		{value: "1:05.42", want: time.Minute + 5420*time.Millisecond},
+		{value: "12:00:01.50", want: 12*time.Hour + 1500*time.Millisecond},
+		{value: "42", wantErr: true},
+		{value: "x:01", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parseCPUTime(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestMeasure_CPUBetweenSamples(t *testing.T) {
+	inspector := NewOSProcessInspector(nil)
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	first := inspector.measure(map[string]processInfo{
+		"busy": {cpuTime: time.Hour, pid: 10, rssKB: 2},
+		"idle": {cpuTime: time.Minute, pid: 20},
+	}, start)
+	assert.Zero(t, first["busy"].CPUPercent, "the first sample has nothing to compare with")
+	assert.Equal(t, int64(2048), first["busy"].MemoryBytes)
+
+	second := inspector.measure(map[string]processInfo{
+		"busy": {cpuTime: time.Hour + 3*time.Second, pid: 10},
+		"idle": {cpuTime: time.Minute, pid: 20},
+	}, start.Add(2*time.Second))
+	assert.InDelta(t, 150.0, second["busy"].CPUPercent, 0.001, "lifetime usage does not count")
+	assert.Zero(t, second["idle"].CPUPercent)
+
+	// The agent restarted under a new PID; its old sample is dropped
+	third := inspector.measure(map[string]processInfo{
+		"busy": {cpuTime: time.Second, pid: 11},
+	}, start.Add(4*time.Second))
+	assert.Zero(t, third["busy"].CPUPercent)
+	assert.NotContains(t, inspector.cpuSamples, 10)
+	assert.NotContains(t, inspector.cpuSamples, 20)
+}
+
+func TestCachedPanePIDs(t *testing.T) {
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	procs := []processInfo{{pid: 100}, {pid: 200}}
+
+	tests := []struct {
+		name       string
+		sessions   []string
+		elapsed    time.Duration
+		wantListed bool
+	}{
+		{name: "every pane alive", sessions: []string{"a", "b"}, elapsed: time.Minute},
+		{name: "new session within the interval", sessions: []string{"c"}, elapsed: time.Second},
+		{name: "new session after the interval", sessions: []string{"c"}, elapsed: paneRefreshInterval, wantListed: true},
+		{name: "pane exited after the interval", sessions: []string{"gone"}, elapsed: paneRefreshInterval, wantListed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panes := mocks.NewMockSessionManager(t)
+			panes.EXPECT().PanePIDs().Return(map[string]int{"a": 100, "b": 200, "gone": 300}, nil).Once()
+			inspector := NewOSProcessInspector(panes)
+
+			_, err := inspector.cachedPanePIDs(tt.sessions, procs, start)
+			require.NoError(t, err)
+
+			if tt.wantListed {
+				panes.EXPECT().PanePIDs().Return(map[string]int{"a": 100, "c": 400}, nil).Once()
+			}
+			_, err = inspector.cachedPanePIDs(tt.sessions, procs, start.Add(tt.elapsed))
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestFindAgentProcess(t *testing.T) {
+	procs := []processInfo{
+		{command: "vim notes.md", pid: 10, ppid: 100},
+		{command: "node /usr/local/bin/claude", pid: 11, ppid: 100},
+		{command: "node /usr/local/bin/claude", pid: 20, ppid: 200},
+	}
+
+	proc := findAgentProcess(procs, 100)
+	require.NotNil(t, proc)
+	assert.Equal(t, 11, proc.pid)
+
+	assert.Nil(t, findAgentProcess(procs, 300))
+}
+
+func TestFindAgentProcess_SearchesDescendants(t *testing.T) {
+	// screen reports the session process, which starts the window shell, which starts the agent
+	procs := []processInfo{
+		{command: "node /usr/local/bin/claude mcp", pid: 32, ppid: 31},
+		{command: "node /usr/local/bin/claude", pid: 31, ppid: 30},
+		{command: "-bash", pid: 30, ppid: 4242},
+	}
+
+	proc := findAgentProcess(procs, 4242)
+	require.NotNil(t, proc)
+	assert.Equal(t, 31, proc.pid, "the agent closest to the pane wins over its children")
+}
+
+func TestOSProcessInspector_PanePIDErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		panePIDs map[string]int
+		panesErr error
+		wantErr  error
+	}{
+		{
+			name:     "multiplexer without pane processes",
+			panesErr: fmt.Errorf("%w: zellij", ports.ErrMultiplexerUnsupported),
+			wantErr:  ports.ErrMultiplexerUnsupported,
+		},
+		{
+			name:     "session not running",
+			panePIDs: map[string]int{"other": 100},
+			wantErr:  ports.ErrTmuxSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			panes := mocks.NewMockSessionManager(t)
+			panes.EXPECT().PanePIDs().Return(tt.panePIDs, tt.panesErr)
+			inspector := NewOSProcessInspector(panes)
+
+			_, err := inspector.GetClaudeSettings("demo")
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.ErrorIs(t, inspector.KillAgentProcess("demo"), tt.wantErr)
+		})
+	}
+}
+
+func TestGetResourceUsage_UnsupportedMultiplexer(t *testing.T) {
+	panes := mocks.NewMockSessionManager(t)
+	panes.EXPECT().PanePIDs().Return(nil, ports.ErrMultiplexerUnsupported)
+
+	usage, err := NewOSProcessInspector(panes).GetResourceUsage([]string{"demo"})
+	require.NoError(t, err)
+	assert.Empty(t, usage)
+}
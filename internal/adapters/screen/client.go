@@ -0,0 +1,362 @@
+// Package screen hosts rocha sessions in GNU screen instead of tmux
+package screen
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/adapters/multiplexer"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// Client manages rocha sessions as GNU screen sessions
+// screen matches -S names by prefix, so commands target the full "pid.name" session ID
+type Client struct {
+	attachments *multiplexer.Attachments
+}
+
+// Compile-time interface verification
+var _ ports.SessionManager = (*Client)(nil)
+
+// NewClient creates a new screen Client
+func NewClient() *Client {
+	return &Client{
+		attachments: multiplexer.NewAttachments(),
+	}
+}
+
+// createBaseSession creates a detached screen session running the user's shell
+// statusPosition is ignored: screen has no status bar by default
+func (c *Client) createBaseSession(name string, worktreePath string) error {
+	if c.SessionExists(name) {
+		return ports.ErrTmuxSessionExists
+	}
+
+	cmd := exec.Command("screen", "-dmS", name, multiplexer.Shell())
+	cmd.Dir = worktreePath
+	cmd.Env = multiplexer.CleanEnv("STY=", "WINDOW=")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to create screen session: %w (output: %s)", multiplexer.CommandError(err), string(output))
+	}
+
+	// Wait for session to be ready
+	timeout := time.After(2 * time.Second)
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for session %s to be created", name)
+		case <-ticker.C:
+			if c.SessionExists(name) {
+				// Ctrl+Q returns to the session list, like in tmux
+				if err := c.command(name, "bindkey", "^Q", "detach"); err != nil {
+					logging.Logger.Warn("Failed to bind Ctrl+Q key", "error", err)
+				}
+				return nil
+			}
+		}
+	}
+}
+
+// CreateSession creates a new screen session and starts Claude in it
+func (c *Client) CreateSession(name string, worktreePath string, claudeDir string, statusPosition string, initialPrompt string) (*ports.TmuxSession, error) {
+	logging.Logger.Info("Creating new screen session", "name", name, "worktree_path", worktreePath, "claude_dir", claudeDir, "has_initial_prompt", initialPrompt != "")
+
+	if err := c.createBaseSession(name, worktreePath); err != nil {
+		return nil, err
+	}
+
+	c.startClaude(name, worktreePath, claudeDir, multiplexer.PromptArg(initialPrompt))
+	return &ports.TmuxSession{Name: name, CreatedAt: time.Now()}, nil
+}
+
+// ResumeSession creates a new screen session that resumes an earlier Claude conversation
+func (c *Client) ResumeSession(name string, worktreePath string, claudeDir string, statusPosition string, claudeSessionID string) (*ports.TmuxSession, error) {
+	logging.Logger.Info("Creating screen session resuming Claude conversation", "name", name, "worktree_path", worktreePath, "claude_session_id", claudeSessionID)
+
+	if err := c.createBaseSession(name, worktreePath); err != nil {
+		return nil, err
+	}
+
+	c.startClaude(name, worktreePath, claudeDir, multiplexer.ResumeArg(claudeSessionID))
+	return &ports.TmuxSession{Name: name, CreatedAt: time.Now()}, nil
+}
+
+// startClaude types the rocha start-claude command into a freshly created session
+func (c *Client) startClaude(name string, worktreePath string, claudeDir string, startArgs string) {
+	startCmd := multiplexer.AgentStartCommand(name, worktreePath, claudeDir, startArgs)
+	logging.Logger.Debug("Sending start command to session", "command", startCmd)
+	if err := c.SendKeys(name, startCmd, "Enter"); err != nil {
+		logging.Logger.Error("Failed to send start command", "error", err)
+	} else {
+		logging.Logger.Info("Session created and Claude started", "name", name)
+	}
+}
+
+// CreateShellSession creates a plain shell session without rocha start-claude
+func (c *Client) CreateShellSession(name string, worktreePath string, statusPosition string) (*ports.TmuxSession, error) {
+	logging.Logger.Info("Creating shell screen session", "name", name, "worktree_path", worktreePath)
+
+	if err := c.createBaseSession(name, worktreePath); err != nil {
+		return nil, err
+	}
+	return &ports.TmuxSession{Name: name, CreatedAt: time.Now()}, nil
+}
+
+// SessionExists checks if the screen session is running
+func (c *Client) SessionExists(name string) bool {
+	_, err := c.sessionID(name)
+	return err == nil
+}
+
+// ListSessions returns all running screen sessions
+func (c *Client) ListSessions() ([]*ports.TmuxSession, error) {
+	ids, err := c.listSessionIDs()
+	if err != nil {
+		return []*ports.TmuxSession{}, err
+	}
+
+	var sessions []*ports.TmuxSession
+	for name := range ids {
+		sessions = append(sessions, &ports.TmuxSession{Name: name, CreatedAt: time.Now()})
+	}
+	return sessions, nil
+}
+
+// listSessionIDs maps the name of every running screen session to its "pid.name" ID
+func (c *Client) listSessionIDs() (map[string]string, error) {
+	// screen -ls exits with 1 even when sessions exist, so only a missing binary is an error
+	output, err := exec.Command("screen", "-ls").Output()
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); !ok {
+			return nil, multiplexer.CommandError(err)
+		}
+	}
+	return parseSessionList(string(output)), nil
+}
+
+// parseSessionList extracts session IDs from "screen -ls" output
+// Session lines look like "\t12345.name\t(10/16/26 10:00:00)\t(Detached)"
+func parseSessionList(output string) map[string]string {
+	ids := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.HasPrefix(line, "\t") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.Contains(line, "(Dead") {
+			continue
+		}
+		if _, name, ok := strings.Cut(fields[0], "."); ok && name != "" {
+			ids[name] = fields[0]
+		}
+	}
+	return ids
+}
+
+// sessionID returns the "pid.name" ID of a running session
+func (c *Client) sessionID(name string) (string, error) {
+	ids, err := c.listSessionIDs()
+	if err != nil {
+		return "", err
+	}
+	id, ok := ids[name]
+	if !ok {
+		return "", fmt.Errorf("%w: %s", ports.ErrTmuxSessionNotFound, name)
+	}
+	return id, nil
+}
+
+// command runs a screen command (see "COMMANDS" in screen(1)) in the first window of the session
+func (c *Client) command(sessionName string, args ...string) error {
+	id, err := c.sessionID(sessionName)
+	if err != nil {
+		return err
+	}
+
+	cmd := exec.Command("screen", append([]string{"-S", id, "-p", "0", "-X"}, args...)...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("screen %s failed: %w (output: %s)", args[0], multiplexer.CommandError(err), strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+// KillSession terminates the screen session
+func (c *Client) KillSession(name string) error {
+	return c.command(name, "quit")
+}
+
+// RequestAgentExit asks Claude to quit on its own by interrupting any running
+// turn and typing /exit. It does not wait for the agent to stop.
+func (c *Client) RequestAgentExit(name string) error {
+	for _, keys := range [][]string{{"Escape"}, {"/exit"}, {"C-m"}} {
+		if err := c.SendKeys(name, keys...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenameSession renames a screen session
+func (c *Client) RenameSession(oldName, newName string) error {
+	if oldName == "" || newName == "" {
+		return fmt.Errorf("session names cannot be empty")
+	}
+	if !c.SessionExists(oldName) {
+		return fmt.Errorf("session %s not found", oldName)
+	}
+	if c.SessionExists(newName) {
+		return fmt.Errorf("session %s already exists", newName)
+	}
+
+	if err := c.command(oldName, "sessionname", newName); err != nil {
+		return fmt.Errorf("failed to rename session: %w", err)
+	}
+	return nil
+}
+
+// Attach attaches to the screen session. Returns a channel that will be closed when detached.
+func (c *Client) Attach(sessionName string) (chan struct{}, error) {
+	return c.attachments.Attach(sessionName, c.GetAttachCommand(sessionName))
+}
+
+// Detach detaches from the screen session
+func (c *Client) Detach(sessionName string) error {
+	return c.attachments.Detach(sessionName)
+}
+
+// GetAttachCommand returns an exec.Cmd configured for attaching to a session
+// It attaches in multi-display mode so the session can also stay open elsewhere
+func (c *Client) GetAttachCommand(sessionName string) *exec.Cmd {
+	target := sessionName
+	if id, err := c.sessionID(sessionName); err == nil {
+		target = id
+	}
+
+	cmd := exec.Command("screen", "-x", target)
+	cmd.Env = multiplexer.CleanEnv("STY=", "WINDOW=")
+	return cmd
+}
+
+// GetGuestAttachCommand is not supported: screen cannot run a guest server that enforces the access of a share
+func (c *Client) GetGuestAttachCommand(socket string, readOnly bool) (*exec.Cmd, error) {
+	return nil, fmt.Errorf("%w: screen cannot share sessions", ports.ErrMultiplexerUnsupported)
+}
+
+// StartGuestServer is not supported: screen cannot restrict what another account may do in a session
+func (c *Client) StartGuestServer(id, sessionName, guest string, readOnly bool, until time.Time) (string, error) {
+	return "", fmt.Errorf("%w: screen cannot share sessions", ports.ErrMultiplexerUnsupported)
+}
+
+// StopGuestServer does nothing, as screen never starts guest servers
+func (c *Client) StopGuestServer(socket string) error {
+	return nil
+}
+
+// OpenInWindow opens the session in a new window of the current screen session.
+// The window runs a nested attach that closes when the user detaches.
+func (c *Client) OpenInWindow(sessionName string) error {
+	current := os.Getenv("STY")
+	if current == "" {
+		return fmt.Errorf("not running inside screen")
+	}
+
+	attach := c.GetAttachCommand(sessionName)
+	args := []string{"-S", current, "-X", "screen", "-t", sessionName, "env", "-u", "STY", "-u", "WINDOW"}
+	args = append(args, attach.Args...)
+	if output, err := exec.Command("screen", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to open session in new window: %w (output: %s)", err, string(output))
+	}
+	return nil
+}
+
+// RunInWindow runs a shell command in a new window of the screen session.
+// The window closes when the command exits.
+func (c *Client) RunInWindow(sessionName, windowName, dir, command string) error {
+	logging.Logger.Info("Running command in screen window", "session", sessionName, "window", windowName, "dir", dir)
+
+	if err := c.command(sessionName, "chdir", dir); err != nil {
+		return err
+	}
+	return c.command(sessionName, "screen", "-t", windowName, "sh", "-c", command)
+}
+
+// SwitchClient is not supported: screen displays cannot be moved to another session
+func (c *Client) SwitchClient(sessionName string) error {
+	return fmt.Errorf("%w: screen cannot switch displays between sessions", ports.ErrMultiplexerUnsupported)
+}
+
+// SendKeys types keys into the first window of the screen session
+func (c *Client) SendKeys(sessionName string, keys ...string) error {
+	return c.command(sessionName, "stuff", escapeStuff(multiplexer.KeyText(keys...)))
+}
+
+// escapeStuff protects text from screen's command parser, which expands
+// backslash escapes, ^X control sequences, and $VARIABLES in "stuff" arguments
+func escapeStuff(text string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `^`, `\^`, `$`, `\$`)
+	return replacer.Replace(text)
+}
+
+// CapturePane returns the content of the session's first window, including its scrollback
+// A negative startLine keeps only the last -startLine lines
+func (c *Client) CapturePane(sessionName string, startLine int) (string, error) {
+	dump, err := os.CreateTemp("", "rocha-screen-*.txt")
+	if err != nil {
+		return "", fmt.Errorf("failed to create hardcopy file: %w", err)
+	}
+	dump.Close()
+	defer os.Remove(dump.Name())
+
+	if err := c.command(sessionName, "hardcopy", "-h", dump.Name()); err != nil {
+		return "", err
+	}
+	content, err := os.ReadFile(dump.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read hardcopy: %w", err)
+	}
+	return multiplexer.LastLines(string(content), -startLine), nil
+}
+
+// PanePIDs returns the process of every screen session, which its windows run under
+func (c *Client) PanePIDs() (map[string]int, error) {
+	ids, err := c.listSessionIDs()
+	if err != nil {
+		return nil, err
+	}
+	return sessionPIDs(ids), nil
+}
+
+// sessionPIDs extracts the process ID from the "pid.name" ID of every session
+func sessionPIDs(ids map[string]string) map[string]int {
+	pids := make(map[string]int)
+	for name, id := range ids {
+		pidText, _, _ := strings.Cut(id, ".")
+		if pid, err := strconv.Atoi(pidText); err == nil {
+			pids[name] = pid
+		}
+	}
+	return pids
+}
+
+// SourceFile is not supported: screen reads its configuration when a session starts
+func (c *Client) SourceFile(configPath string) error {
+	return fmt.Errorf("%w: screen cannot reload configuration files", ports.ErrMultiplexerUnsupported)
+}
+
+// BindKey is not supported: screen key bindings are set per session when it is created
+func (c *Client) BindKey(table, key, command string) error {
+	return fmt.Errorf("%w: screen cannot bind keys globally", ports.ErrMultiplexerUnsupported)
+}
+
+// SetOption is not supported: screen has no per-session options
+func (c *Client) SetOption(sessionName, option, value string) error {
+	return fmt.Errorf("%w: screen has no session options", ports.ErrMultiplexerUnsupported)
+}
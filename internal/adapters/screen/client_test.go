@@ -0,0 +1,34 @@
+package screen
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSessionList(t *testing.T) {
+	output := "There are screens on:\n" +
+		"\t4242.rocha-demo\t(10/16/26 10:00:00)\t(Detached)\n" +
+		"\t4243.rocha-demo-shell\t(10/16/26 10:00:01)\t(Attached)\n" +
+		"\t4100.crashed\t(10/15/26 09:00:00)\t(Dead ???)\n" +
+		"3 Sockets in /run/screen/S-dev.\n"
+
+	assert.Equal(t, map[string]string{
+		"rocha-demo":       "4242.rocha-demo",
+		"rocha-demo-shell": "4243.rocha-demo-shell",
+	}, parseSessionList(output))
+}
+
+func TestSessionPIDs(t *testing.T) {
+	ids := map[string]string{
+		"rocha-demo":       "4242.rocha-demo",
+		"rocha-demo-shell": "4243.rocha-demo-shell",
+		"odd":              "odd",
+	}
+
+	assert.Equal(t, map[string]int{"rocha-demo": 4242, "rocha-demo-shell": 4243}, sessionPIDs(ids))
+}
+
+func TestEscapeStuff(t *testing.T) {
+	assert.Equal(t, `echo \$HOME \^C C:\\tmp`+"\r", escapeStuff("echo $HOME ^C C:\\tmp\r"))
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// AddAttachment implements AttachmentRepository.AddAttachment
+func (r *SQLiteRepository) AddAttachment(ctx context.Context, attachment domain.SessionAttachment) error {
+	model := SessionAttachmentModel{
+		AddedAt:     attachment.AddedAt.UTC(),
+		MediaType:   attachment.MediaType,
+		Name:        attachment.Name,
+		Path:        attachment.Path,
+		SessionName: attachment.SessionName,
+		Size:        attachment.Size,
+	}
+
+	err := withRetry(func() error {
+		return r.db.WithContext(ctx).Clauses(clause.OnConflict{UpdateAll: true}).Create(&model).Error
+	}, 3)
+	if err != nil {
+		return fmt.Errorf("failed to add attachment: %w", err)
+	}
+	return nil
+}
+
+// ListAttachments implements AttachmentRepository.ListAttachments
+func (r *SQLiteRepository) ListAttachments(ctx context.Context, sessionName string) ([]domain.SessionAttachment, error) {
+	var models []SessionAttachmentModel
+	if err := r.db.WithContext(ctx).
+		Where("session_name = ?", sessionName).
+		Order("added_at ASC, path ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list attachments: %w", err)
+	}
+
+	attachments := make([]domain.SessionAttachment, 0, len(models))
+	for _, m := range models {
+		attachments = append(attachments, sessionAttachmentModelToDomain(m))
+	}
+	return attachments, nil
+}
+
+// RemoveAttachment implements AttachmentRepository.RemoveAttachment
+func (r *SQLiteRepository) RemoveAttachment(ctx context.Context, sessionName, path string) error {
+	return withRetry(func() error {
+		result := r.db.WithContext(ctx).
+			Where("session_name = ? AND path = ?", sessionName, path).
+			Delete(&SessionAttachmentModel{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to remove attachment: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrAttachmentNotFound
+		}
+		return nil
+	}, 3)
+}
@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestAttachments(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	now := time.Now()
+	require.NoError(t, repo.AddAttachment(ctx, domain.SessionAttachment{AddedAt: now, MediaType: "image/png", Name: "bug.png", Path: "/tmp/bug.png", SessionName: "s1", Size: 10}))
+	require.NoError(t, repo.AddAttachment(ctx, domain.SessionAttachment{AddedAt: now.Add(time.Second), MediaType: "application/pdf", Name: "mock.pdf", Path: "/tmp/mock.pdf", SessionName: "s1", Size: 20}))
+	// Attaching the same file again refreshes its metadata
+	require.NoError(t, repo.AddAttachment(ctx, domain.SessionAttachment{AddedAt: now, MediaType: "image/png", Name: "bug.png", Path: "/tmp/bug.png", SessionName: "s1", Size: 30}))
+
+	attachments, err := repo.ListAttachments(ctx, "s1")
+	require.NoError(t, err)
+	require.Len(t, attachments, 2)
+	assert.Equal(t, "bug.png", attachments[0].Name)
+	assert.Equal(t, int64(30), attachments[0].Size)
+	assert.Equal(t, "application/pdf", attachments[1].MediaType)
+
+	require.NoError(t, repo.RemoveAttachment(ctx, "s1", "/tmp/bug.png"))
+	assert.ErrorIs(t, repo.RemoveAttachment(ctx, "s1", "/tmp/bug.png"), domain.ErrAttachmentNotFound)
+
+	// Attachments follow renames and go away with their session
+	require.NoError(t, repo.Rename(ctx, domain.SessionRename{DisplayName: "s2", NewName: "s2", OldName: "s1"}))
+	attachments, err = repo.ListAttachments(ctx, "s2")
+	require.NoError(t, err)
+	assert.Len(t, attachments, 1)
+
+	require.NoError(t, repo.Delete(ctx, "s2"))
+	attachments, err = repo.ListAttachments(ctx, "s2")
+	require.NoError(t, err)
+	assert.Empty(t, attachments)
+}
@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// AddEvent implements EventRepository.AddEvent
+func (r *SQLiteRepository) AddEvent(ctx context.Context, event domain.Event) error {
+	model := EventModel{
+		Error:       event.Error,
+		Message:     event.Message,
+		OccurredAt:  event.Timestamp.UTC(),
+		SessionName: event.SessionName,
+		State:       string(event.State),
+		Status:      event.Status,
+		Type:        string(event.Type),
+	}
+
+	if err := withRetry(func() error {
+		return r.db.WithContext(ctx).Create(&model).Error
+	}, 3); err != nil {
+		return fmt.Errorf("failed to add event: %w", err)
+	}
+	return nil
+}
+
+// PruneEvents implements EventRepository.PruneEvents
+func (r *SQLiteRepository) PruneEvents(ctx context.Context, before time.Time) error {
+	if err := withRetry(func() error {
+		return r.db.WithContext(ctx).
+			Where("occurred_at < ?", before.UTC()).
+			Delete(&EventModel{}).Error
+	}, 3); err != nil {
+		return fmt.Errorf("failed to prune events: %w", err)
+	}
+	return nil
+}
+
+// ListEvents implements EventRepository.ListEvents
+func (r *SQLiteRepository) ListEvents(ctx context.Context, eventType domain.EventType, since time.Time) ([]domain.Event, error) {
+	var models []EventModel
+	if err := r.db.WithContext(ctx).
+		Where("type = ? AND occurred_at >= ?", string(eventType), since.UTC()).
+		Order("occurred_at ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	events := make([]domain.Event, 0, len(models))
+	for _, m := range models {
+		events = append(events, eventModelToDomain(m))
+	}
+	return events, nil
+}
+
+// ListLatestEventsBefore implements EventRepository.ListLatestEventsBefore
+func (r *SQLiteRepository) ListLatestEventsBefore(ctx context.Context, eventType domain.EventType, before time.Time) ([]domain.Event, error) {
+	latest := r.db.Model(&EventModel{}).
+		Select("MAX(id)").
+		Where("type = ? AND occurred_at < ?", string(eventType), before.UTC()).
+		Group("session_name")
+
+	var models []EventModel
+	if err := r.db.WithContext(ctx).
+		Where("id IN (?)", latest).
+		Order("session_name ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list latest events: %w", err)
+	}
+
+	events := make([]domain.Event, 0, len(models))
+	for _, m := range models {
+		events = append(events, eventModelToDomain(m))
+	}
+	return events, nil
+}
+
+// LatestSessionEvent implements EventRepository.LatestSessionEvent
+func (r *SQLiteRepository) LatestSessionEvent(ctx context.Context, sessionName string, eventType domain.EventType) (*domain.Event, error) {
+	var models []EventModel
+	if err := r.db.WithContext(ctx).
+		Where("session_name = ? AND type = ?", sessionName, string(eventType)).
+		Order("occurred_at DESC").
+		Limit(1).
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to get latest session event: %w", err)
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	event := eventModelToDomain(models[0])
+	return &event, nil
+}
+
+// ListSessionEvents implements EventRepository.ListSessionEvents
+func (r *SQLiteRepository) ListSessionEvents(ctx context.Context, sessionName string, limit int) ([]domain.Event, error) {
+	var models []EventModel
+	if err := r.db.WithContext(ctx).
+		Where("session_name = ?", sessionName).
+		Order("occurred_at DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list session events: %w", err)
+	}
+
+	events := make([]domain.Event, 0, len(models))
+	for _, m := range models {
+		events = append(events, eventModelToDomain(m))
+	}
+	return events, nil
+}
+
+// LatestEventID implements EventFeed.LatestEventID
+func (r *SQLiteRepository) LatestEventID(ctx context.Context) (uint, error) {
+	var id uint
+	if err := r.db.WithContext(ctx).Model(&EventModel{}).Select("COALESCE(MAX(id), 0)").Scan(&id).Error; err != nil {
+		return 0, fmt.Errorf("failed to get latest event: %w", err)
+	}
+	return id, nil
+}
+
+// ListEventsAfter implements EventFeed.ListEventsAfter
+func (r *SQLiteRepository) ListEventsAfter(ctx context.Context, afterID uint, limit int) ([]domain.Event, error) {
+	var models []EventModel
+	if err := r.db.WithContext(ctx).
+		Where("id > ?", afterID).
+		Order("id ASC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	events := make([]domain.Event, 0, len(models))
+	for _, m := range models {
+		events = append(events, eventModelToDomain(m))
+	}
+	return events, nil
+}
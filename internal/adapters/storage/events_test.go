@@ -0,0 +1,141 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestListSessionEvents_NewestFirstForOneSession(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	start := time.Now().Add(-time.Hour)
+	for i, state := range []domain.SessionState{domain.StateWorking, domain.StateIdle, domain.StateWaiting} {
+		require.NoError(t, repo.AddEvent(ctx, domain.Event{
+			SessionName: "s1",
+			State:       state,
+			Timestamp:   start.Add(time.Duration(i) * time.Minute),
+			Type:        domain.EventStateChange,
+		}))
+	}
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{
+		SessionName: "s2",
+		State:       domain.StateWorking,
+		Timestamp:   start.Add(10 * time.Minute),
+		Type:        domain.EventStateChange,
+	}))
+
+	events, err := repo.ListSessionEvents(ctx, "s1", 2)
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, domain.StateWaiting, events[0].State)
+	assert.Equal(t, domain.StateIdle, events[1].State)
+}
+
+func TestListLatestEventsBefore_OnePerSession(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	since := time.Now().Add(-time.Hour)
+	add := func(session string, state domain.SessionState, at time.Time) {
+		require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: session, State: state, Timestamp: at, Type: domain.EventStateChange}))
+	}
+	add("s1", domain.StateWorking, since.Add(-3*time.Hour))
+	add("s1", domain.StateWaiting, since.Add(-2*time.Hour))
+	add("s1", domain.StateIdle, since.Add(time.Minute)) // Within the period
+	add("s2", domain.StateWorking, since.Add(-time.Minute))
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s3", Status: "review", Timestamp: since.Add(-time.Minute), Type: domain.EventStatusChange}))
+
+	events, err := repo.ListLatestEventsBefore(ctx, domain.EventStateChange, since)
+
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "s1", events[0].SessionName)
+	assert.Equal(t, domain.StateWaiting, events[0].State)
+	assert.Equal(t, "s2", events[1].SessionName)
+	assert.Equal(t, domain.StateWorking, events[1].State)
+
+	statusEvents, err := repo.ListEvents(ctx, domain.EventStatusChange, since.Add(-time.Hour))
+	require.NoError(t, err)
+	require.Len(t, statusEvents, 1)
+	assert.Equal(t, "review", statusEvents[0].Status)
+}
+
+func TestLatestSessionEvent_KeepsMessage(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	start := time.Now().Add(-time.Hour)
+	for i, note := range []string{"halfway through the parser", "tests left to fix"} {
+		require.NoError(t, repo.AddEvent(ctx, domain.Event{
+			Message:     note,
+			SessionName: "s1",
+			Timestamp:   start.Add(time.Duration(i) * time.Minute),
+			Type:        domain.EventHandoff,
+		}))
+	}
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s1", State: domain.StateIdle, Timestamp: start.Add(5 * time.Minute), Type: domain.EventStateChange}))
+
+	event, err := repo.LatestSessionEvent(ctx, "s1", domain.EventHandoff)
+	require.NoError(t, err)
+	require.NotNil(t, event)
+	assert.Equal(t, "tests left to fix", event.Message)
+
+	event, err = repo.LatestSessionEvent(ctx, "s2", domain.EventHandoff)
+	require.NoError(t, err)
+	assert.Nil(t, event)
+}
+
+func TestListEventsAfter_InStorageOrder(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	latest, err := repo.LatestEventID(ctx)
+	require.NoError(t, err)
+	assert.Zero(t, latest)
+
+	// Stored order wins over timestamps, which may come from clocks of other processes
+	now := time.Now()
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s1", State: domain.StateWorking, Timestamp: now, Type: domain.EventStateChange}))
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s2", Status: "review", Timestamp: now.Add(-time.Minute), Type: domain.EventStatusChange}))
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s1", Message: "left off at tests", Timestamp: now, Type: domain.EventHandoff}))
+
+	latest, err = repo.LatestEventID(ctx)
+	require.NoError(t, err)
+
+	events, err := repo.ListEventsAfter(ctx, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, domain.EventStateChange, events[0].Type)
+	assert.Equal(t, domain.EventStatusChange, events[1].Type)
+
+	events, err = repo.ListEventsAfter(ctx, events[1].ID, 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, "left off at tests", events[0].Message)
+	assert.Equal(t, latest, events[0].ID)
+}
+
+func TestPruneEvents_DiscardsOlderEvents(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	cutoff := time.Now().Add(-time.Hour)
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s1", State: domain.StateWorking, Timestamp: cutoff.Add(-time.Minute), Type: domain.EventStateChange}))
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s1", State: domain.StateIdle, Timestamp: cutoff.Add(time.Minute), Type: domain.EventStateChange}))
+
+	require.NoError(t, repo.PruneEvents(ctx, cutoff))
+
+	events, err := repo.ListSessionEvents(ctx, "s1", 10)
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	assert.Equal(t, domain.StateIdle, events[0].State)
+}
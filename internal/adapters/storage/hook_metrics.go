@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// AddHookMetric implements HookMetricsRepository.AddHookMetric
+func (r *SQLiteRepository) AddHookMetric(ctx context.Context, metric domain.HookMetric) error {
+	model := HookMetricModel{
+		EventType:        metric.EventType,
+		HandleDurationUs: metric.HandleDuration.Microseconds(),
+		HandledAt:        metric.HandledAt.UTC(),
+		InvokedAt:        metric.InvokedAt.UTC(),
+		SessionName:      metric.SessionName,
+		State:            string(metric.State),
+	}
+
+	if err := withRetry(func() error {
+		return r.db.WithContext(ctx).Create(&model).Error
+	}, 3); err != nil {
+		return fmt.Errorf("failed to add hook metric: %w", err)
+	}
+	return nil
+}
+
+// PruneHookMetrics implements HookMetricsRepository.PruneHookMetrics
+func (r *SQLiteRepository) PruneHookMetrics(ctx context.Context, keep int) error {
+	if err := withRetry(func() error {
+		return r.db.WithContext(ctx).
+			Where("id <= (SELECT COALESCE(MAX(id), 0) FROM hook_metrics) - ?", keep).
+			Delete(&HookMetricModel{}).Error
+	}, 3); err != nil {
+		return fmt.Errorf("failed to prune hook metrics: %w", err)
+	}
+	return nil
+}
+
+// ListHookMetrics implements HookMetricsRepository.ListHookMetrics
+func (r *SQLiteRepository) ListHookMetrics(ctx context.Context, limit int) ([]domain.HookMetric, error) {
+	var models []HookMetricModel
+	if err := r.db.WithContext(ctx).Order("id DESC").Limit(limit).Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list hook metrics: %w", err)
+	}
+
+	metrics := make([]domain.HookMetric, 0, len(models))
+	for _, m := range models {
+		metrics = append(metrics, hookMetricModelToDomain(m))
+	}
+	return metrics, nil
+}
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestPruneHookMetrics_KeepsMostRecent(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	start := time.Now().Add(-time.Hour)
+	for i, event := range []string{"start", "prompt", "stop", "notification"} {
+		at := start.Add(time.Duration(i) * time.Minute)
+		require.NoError(t, repo.AddHookMetric(ctx, domain.HookMetric{EventType: event, HandledAt: at, InvokedAt: at, SessionName: "s1"}))
+	}
+
+	require.NoError(t, repo.PruneHookMetrics(ctx, 2))
+
+	metrics, err := repo.ListHookMetrics(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, metrics, 2)
+	assert.Equal(t, "notification", metrics[0].EventType)
+	assert.Equal(t, "stop", metrics[1].EventType)
+}
@@ -1,49 +1,268 @@
 package storage
 
 import (
+	"encoding/json"
+	"time"
+
 	"github.com/renato0307/rocha/internal/domain"
 )
 
 // sessionModelToDomain converts a SessionModel (GORM) to domain.Session
-func sessionModelToDomain(m SessionModel, isFlagged bool, status *string, comment string, isArchived bool, allowSkipPerms bool, prInfo *domain.PRInfo) domain.Session {
+func sessionModelToDomain(m SessionModel, isFlagged bool, status *string, comment string, note string, tags []string, archive SessionArchiveModel, agentCLIFlags SessionAgentCLIFlagsModel, prInfo *domain.PRInfo) domain.Session {
 	return domain.Session{
-		AllowDangerouslySkipPermissions: allowSkipPerms,
+		AgentArgs:                       decodeAgentArgs(agentCLIFlags.Args),
+		AgentModel:                      agentCLIFlags.Model,
+		AllowDangerouslySkipPermissions: agentCLIFlags.AllowDangerouslySkipPermissions,
+		ArchivedAt:                      archive.ArchivedAt,
+		BaseBranch:                      m.BaseBranch,
 		BranchName:                      m.BranchName,
 		ClaudeDir:                       m.ClaudeDir,
+		ClaudeSessionID:                 m.ClaudeSessionID,
 		Comment:                         comment,
 		DisplayName:                     m.DisplayName,
+		Editor:                          domain.EditorIntegration(m.Editor),
 		ExecutionID:                     m.ExecutionID,
 		GitStats:                        nil, // Not persisted, populated at runtime
 		InitialPrompt:                   m.InitialPrompt,
-		IsArchived:                      isArchived,
+		IsArchived:                      archive.IsArchived,
+		IsExternal:                      m.IsExternal,
 		IsFlagged:                       isFlagged,
+		KeepWorktree:                    m.KeepWorktree,
 		LastUpdated:                     m.LastUpdated,
 		Name:                            m.Name,
+		Note:                            note,
 		PRInfo:                          prInfo,
+		Priority:                        domain.Priority(m.Priority),
 		RepoInfo:                        m.RepoInfo,
 		RepoPath:                        m.RepoPath,
 		RepoSource:                      m.RepoSource,
-		ShellSession:                    nil, // Set separately if nested session exists
+		ShellSession:                    nil, // Set separately from the shell_sessions table
 		State:                           domain.SessionState(m.State),
 		Status:                          status,
+		Subdir:                          m.Subdir,
+		Tags:                            tags,
 		WorktreePath:                    m.WorktreePath,
 	}
 }
 
+// shellSessionModelToDomain converts a ShellSessionModel (GORM) to domain.ShellSession
+func shellSessionModelToDomain(m ShellSessionModel) *domain.ShellSession {
+	return &domain.ShellSession{
+		Name:       m.Name,
+		ParentName: m.ParentName,
+	}
+}
+
 // domainToSessionModel converts a domain.Session to SessionModel (GORM)
 func domainToSessionModel(s domain.Session) SessionModel {
 	return SessionModel{
-		BranchName:    s.BranchName,
-		ClaudeDir:     s.ClaudeDir,
-		DisplayName:   s.DisplayName,
-		ExecutionID:   s.ExecutionID,
-		InitialPrompt: s.InitialPrompt,
-		LastUpdated:   s.LastUpdated,
-		Name:          s.Name,
-		RepoInfo:      s.RepoInfo,
-		RepoPath:      s.RepoPath,
-		RepoSource:    s.RepoSource,
-		State:         string(s.State),
-		WorktreePath:  s.WorktreePath,
+		BaseBranch:      s.BaseBranch,
+		BranchName:      s.BranchName,
+		ClaudeDir:       s.ClaudeDir,
+		ClaudeSessionID: s.ClaudeSessionID,
+		DisplayName:     s.DisplayName,
+		Editor:          string(s.Editor),
+		ExecutionID:     s.ExecutionID,
+		InitialPrompt:   s.InitialPrompt,
+		IsExternal:      s.IsExternal,
+		KeepWorktree:    s.KeepWorktree,
+		LastUpdated:     s.LastUpdated,
+		Name:            s.Name,
+		Priority:        string(s.Priority),
+		RepoInfo:        s.RepoInfo,
+		RepoPath:        s.RepoPath,
+		RepoSource:      s.RepoSource,
+		State:           string(s.State),
+		Subdir:          s.Subdir,
+		WorktreePath:    s.WorktreePath,
+	}
+}
+
+// domainToAgentCLIFlagsModel converts the agent CLI flags of a domain.Session to their GORM row.
+// It reports false when every flag has its default, since such sessions have no row.
+func domainToAgentCLIFlagsModel(s domain.Session) (SessionAgentCLIFlagsModel, bool) {
+	flags := SessionAgentCLIFlagsModel{
+		AllowDangerouslySkipPermissions: s.AllowDangerouslySkipPermissions,
+		Args:                            encodeAgentArgs(s.AgentArgs),
+		Model:                           s.AgentModel,
+		SessionName:                     s.Name,
+	}
+	return flags, flags.AllowDangerouslySkipPermissions || flags.Model != "" || flags.Args != ""
+}
+
+// encodeAgentArgs stores agent CLI args as a JSON array, or empty when there are none
+func encodeAgentArgs(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	data, _ := json.Marshal(args) // Marshalling strings cannot fail
+	return string(data)
+}
+
+// decodeAgentArgs reads agent CLI args stored by encodeAgentArgs
+func decodeAgentArgs(stored string) []string {
+	if stored == "" {
+		return nil
+	}
+	var args []string
+	if err := json.Unmarshal([]byte(stored), &args); err != nil {
+		return nil
+	}
+	return args
+}
+
+// scheduledPromptModelToDomain converts a ScheduledPromptModel (GORM) to domain.ScheduledPrompt
+func scheduledPromptModelToDomain(m ScheduledPromptModel) domain.ScheduledPrompt {
+	return domain.ScheduledPrompt{
+		Confirmed:   m.Confirmed,
+		CreatedAt:   m.CreatedAt,
+		ID:          m.ID,
+		SendAt:      m.SendAt,
+		SessionName: m.SessionName,
+		Text:        m.Text,
+	}
+}
+
+// sessionShareModelToDomain converts a SessionShareModel (GORM) to domain.SessionShare
+func sessionShareModelToDomain(m SessionShareModel) domain.SessionShare {
+	return domain.SessionShare{
+		Access:      domain.ShareAccess(m.Access),
+		CreatedAt:   m.CreatedAt,
+		ExpiresAt:   m.ExpiresAt,
+		Guest:       m.Guest,
+		RevokedAt:   m.RevokedAt,
+		SessionName: m.SessionName,
+		Socket:      m.Socket,
+		Token:       m.Token,
+	}
+}
+
+// sessionAttachmentModelToDomain converts a SessionAttachmentModel (GORM) to domain.SessionAttachment
+func sessionAttachmentModelToDomain(m SessionAttachmentModel) domain.SessionAttachment {
+	return domain.SessionAttachment{
+		AddedAt:     m.AddedAt,
+		MediaType:   m.MediaType,
+		Name:        m.Name,
+		Path:        m.Path,
+		SessionName: m.SessionName,
+		Size:        m.Size,
+	}
+}
+
+// promptHistoryModelToDomain converts a PromptHistoryModel (GORM) to domain.SentPrompt
+func promptHistoryModelToDomain(m PromptHistoryModel) domain.SentPrompt {
+	return domain.SentPrompt{
+		ID:          m.ID,
+		SentAt:      m.SentAt,
+		SessionName: m.SessionName,
+		Text:        m.Text,
+	}
+}
+
+// sessionCheckpointModelToDomain converts a SessionCheckpointModel (GORM) to domain.CheckpointPolicy
+func sessionCheckpointModelToDomain(m SessionCheckpointModel) *domain.CheckpointPolicy {
+	return &domain.CheckpointPolicy{
+		Interval: time.Duration(m.IntervalSeconds) * time.Second,
+		LastAt:   m.LastAt,
+		Target:   domain.CheckpointTarget(m.Target),
+	}
+}
+
+// sessionCIStatusModelToDomain converts a SessionCIStatusModel (GORM) to domain.CIStatus
+func sessionCIStatusModelToDomain(m SessionCIStatusModel) *domain.CIStatus {
+	return &domain.CIStatus{
+		Commit:     m.Commit,
+		Notified:   m.Notified,
+		ReportedAt: m.ReportedAt,
+		State:      domain.CIState(m.State),
+		URL:        m.URL,
+	}
+}
+
+// sessionTimerModelToDomain converts a SessionTimerModel (GORM) to domain.SessionTimer
+func sessionTimerModelToDomain(m SessionTimerModel) *domain.SessionTimer {
+	return &domain.SessionTimer{
+		DueAt:    m.DueAt,
+		Label:    m.Label,
+		Notified: m.Notified,
+	}
+}
+
+// sessionTokenBudgetModelToDomain converts a SessionTokenBudgetModel (GORM) to domain.SessionTokenBudget
+func sessionTokenBudgetModelToDomain(m SessionTokenBudgetModel) *domain.SessionTokenBudget {
+	return &domain.SessionTokenBudget{
+		Exceeded: m.Exceeded,
+		Limit:    m.LimitTokens,
+		Used:     m.UsedTokens,
+		WrapUp:   m.WrapUp,
+	}
+}
+
+// repoBookmarkModelToDomain converts a RepoBookmarkModel (GORM) to domain.RepoBookmark
+func repoBookmarkModelToDomain(m RepoBookmarkModel) domain.RepoBookmark {
+	return domain.RepoBookmark{
+		CreatedAt:  m.CreatedAt,
+		LastUsedAt: m.LastUsedAt,
+		Name:       m.Name,
+		Source:     m.Source,
+		UseCount:   m.UseCount,
+	}
+}
+
+// workspaceModelToDomain converts a WorkspaceModel (GORM) and its member session names to domain.Workspace
+func workspaceModelToDomain(m WorkspaceModel, sessions []string) domain.Workspace {
+	return domain.Workspace{
+		CreatedAt: m.CreatedAt,
+		Name:      m.Name,
+		Sessions:  sessions,
+	}
+}
+
+// toolUseModelToDomain converts a ToolUseModel (GORM) to domain.ToolUse
+func toolUseModelToDomain(m ToolUseModel) domain.ToolUse {
+	return domain.ToolUse{
+		Failed:      m.Failed,
+		ID:          m.ID,
+		Input:       m.Input,
+		OccurredAt:  m.OccurredAt,
+		SessionName: m.SessionName,
+		Summary:     m.Summary,
+		ToolName:    m.ToolName,
+	}
+}
+
+// hookMetricModelToDomain converts a HookMetricModel (GORM) to domain.HookMetric
+func hookMetricModelToDomain(m HookMetricModel) domain.HookMetric {
+	return domain.HookMetric{
+		EventType:      m.EventType,
+		HandleDuration: time.Duration(m.HandleDurationUs) * time.Microsecond,
+		HandledAt:      m.HandledAt,
+		InvokedAt:      m.InvokedAt,
+		SessionName:    m.SessionName,
+		State:          domain.SessionState(m.State),
+	}
+}
+
+// eventModelToDomain converts an EventModel (GORM) to domain.Event
+func eventModelToDomain(m EventModel) domain.Event {
+	return domain.Event{
+		Error:       m.Error,
+		ID:          m.ID,
+		Message:     m.Message,
+		SessionName: m.SessionName,
+		State:       domain.SessionState(m.State),
+		Status:      m.Status,
+		Timestamp:   m.OccurredAt,
+		Type:        domain.EventType(m.Type),
+	}
+}
+
+// ticketSyncOutboxModelToDomain converts a TicketSyncOutboxModel (GORM) to domain.TicketSyncRequest
+func ticketSyncOutboxModelToDomain(m TicketSyncOutboxModel) domain.TicketSyncRequest {
+	return domain.TicketSyncRequest{
+		Attempts:    m.Attempts,
+		QueuedAt:    m.QueuedAt,
+		Revision:    m.Revision,
+		SessionName: m.SessionName,
 	}
 }
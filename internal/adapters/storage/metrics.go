@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// metricsStartKey stores when a statement started in the gorm statement settings
+const metricsStartKey = "rocha:metrics_start"
+
+// SetMetricsRecorder records the duration of every database operation from now on
+func (r *SQLiteRepository) SetMetricsRecorder(recorder ports.MetricsRecorder) error {
+	start := func(db *gorm.DB) {
+		db.InstanceSet(metricsStartKey, time.Now())
+	}
+	finish := func(operation string) func(*gorm.DB) {
+		return func(db *gorm.DB) {
+			if started, ok := db.InstanceGet(metricsStartKey); ok {
+				recorder.ObserveDBOperation(operation, time.Since(started.(time.Time)))
+			}
+		}
+	}
+
+	callbacks := r.db.Callback()
+	err := errors.Join(
+		callbacks.Create().Before("gorm:create").Register("rocha:metrics_start_create", start),
+		callbacks.Create().After("gorm:create").Register("rocha:metrics_finish_create", finish("create")),
+		callbacks.Delete().Before("gorm:delete").Register("rocha:metrics_start_delete", start),
+		callbacks.Delete().After("gorm:delete").Register("rocha:metrics_finish_delete", finish("delete")),
+		callbacks.Query().Before("gorm:query").Register("rocha:metrics_start_query", start),
+		callbacks.Query().After("gorm:query").Register("rocha:metrics_finish_query", finish("query")),
+		callbacks.Raw().Before("gorm:raw").Register("rocha:metrics_start_raw", start),
+		callbacks.Raw().After("gorm:raw").Register("rocha:metrics_finish_raw", finish("raw")),
+		callbacks.Row().Before("gorm:row").Register("rocha:metrics_start_row", start),
+		callbacks.Row().After("gorm:row").Register("rocha:metrics_finish_row", finish("row")),
+		callbacks.Update().Before("gorm:update").Register("rocha:metrics_start_update", start),
+		callbacks.Update().After("gorm:update").Register("rocha:metrics_finish_update", finish("update")),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register metrics callbacks: %w", err)
+	}
+	return nil
+}
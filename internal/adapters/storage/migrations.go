@@ -0,0 +1,817 @@
+package storage
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// migration is a numbered schema change. Each migration is applied once, in version order,
+// in its own transaction; down reverts up so tests can walk the schema back.
+type migration struct {
+	down           func(tx *gorm.DB) error
+	name           string
+	rebuildsTables bool // Runs with foreign keys off, so dropping a rebuilt table cascades to nothing
+	up             func(tx *gorm.DB) error
+	version        int
+}
+
+// migrations lists every schema change. Add new ones at the end with the next version;
+// never edit, renumber, or remove a migration that has shipped.
+var migrations = []migration{
+	{version: 1, name: "baseline", up: baselineUp, down: baselineDown},
+	{version: 2, name: "repo_bookmarks", up: repoBookmarksUp, down: repoBookmarksDown},
+	{version: 3, name: "shell_sessions", up: shellSessionsUp, down: shellSessionsDown},
+	{version: 4, name: "session_subdir", up: sessionSubdirUp, down: sessionSubdirDown},
+	{version: 5, name: "session_checkpoints", up: sessionCheckpointsUp, down: sessionCheckpointsDown},
+	{version: 6, name: "session_ci_statuses", up: sessionCIStatusesUp, down: sessionCIStatusesDown},
+	{version: 7, name: "scheduled_prompt_claims", up: scheduledPromptClaimsUp, down: scheduledPromptClaimsDown},
+	{version: 8, name: "ticket_sync_outbox", up: ticketSyncOutboxUp, down: ticketSyncOutboxDown},
+	{version: 9, name: "share_guest_servers", up: shareGuestServersUp, down: shareGuestServersDown},
+	{version: 10, name: "scheduled_prompt_confirmations", up: scheduledPromptConfirmationsUp, down: scheduledPromptConfirmationsDown},
+	{version: 11, name: "session_paused_state", up: sessionPausedStateUp, down: sessionPausedStateDown, rebuildsTables: true},
+}
+
+// SchemaMigrationModel records an applied migration
+type SchemaMigrationModel struct {
+	AppliedAt time.Time `gorm:"not null"`
+	Name      string    `gorm:"not null"`
+	Version   int       `gorm:"primaryKey;autoIncrement:false"`
+}
+
+// TableName returns the table name for GORM
+func (SchemaMigrationModel) TableName() string {
+	return "schema_migrations"
+}
+
+// migrateUp applies the migrations the database lacks
+func migrateUp(db *gorm.DB) error {
+	if err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version INTEGER PRIMARY KEY,
+			name TEXT NOT NULL,
+			applied_at DATETIME NOT NULL
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create schema_migrations table: %w", err)
+	}
+
+	current, err := schemaVersion(db)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.version <= current {
+			continue
+		}
+		if err := applyMigration(db, m); err != nil {
+			return fmt.Errorf("failed to apply migration %d (%s): %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+// migrateDown reverts the applied migrations newer than target, newest first
+func migrateDown(db *gorm.DB, target int) error {
+	current, err := schemaVersion(db)
+	if err != nil {
+		return err
+	}
+
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.version <= target || m.version > current {
+			continue
+		}
+		if err := revertMigration(db, m); err != nil {
+			return fmt.Errorf("failed to revert migration %d (%s): %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+// schemaVersion returns the version of the newest applied migration, 0 when none is
+func schemaVersion(db *gorm.DB) (int, error) {
+	var version int
+	if err := db.Model(&SchemaMigrationModel{}).Select("COALESCE(MAX(version), 0)").Scan(&version).Error; err != nil {
+		return 0, fmt.Errorf("failed to read schema version: %w", err)
+	}
+	return version, nil
+}
+
+// applyMigration runs a migration and records it, unless another rocha process
+// applied it since the version was read
+func applyMigration(db *gorm.DB, m migration) error {
+	return withMigrationConn(db, m, func(conn *gorm.DB) error {
+		return conn.Transaction(func(tx *gorm.DB) error {
+			if err := lockSchema(tx); err != nil {
+				return err
+			}
+
+			var applied int64
+			if err := tx.Model(&SchemaMigrationModel{}).Where("version = ?", m.version).Count(&applied).Error; err != nil {
+				return err
+			}
+			if applied > 0 {
+				return nil
+			}
+
+			logging.Logger.Info("Applying schema migration", "version", m.version, "name", m.name)
+			if err := m.up(tx); err != nil {
+				return err
+			}
+			if err := checkForeignKeys(tx, m); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaMigrationModel{AppliedAt: time.Now().UTC(), Name: m.name, Version: m.version}).Error
+		})
+	})
+}
+
+// revertMigration runs the down of a migration and forgets it was applied
+func revertMigration(db *gorm.DB, m migration) error {
+	return withMigrationConn(db, m, func(conn *gorm.DB) error {
+		return conn.Transaction(func(tx *gorm.DB) error {
+			if err := lockSchema(tx); err != nil {
+				return err
+			}
+
+			logging.Logger.Info("Reverting schema migration", "version", m.version, "name", m.name)
+			if err := m.down(tx); err != nil {
+				return err
+			}
+			if err := checkForeignKeys(tx, m); err != nil {
+				return err
+			}
+			return tx.Where("version = ?", m.version).Delete(&SchemaMigrationModel{}).Error
+		})
+	})
+}
+
+// withMigrationConn runs fn with db, or for a migration that rebuilds tables, on one connection
+// with foreign keys off: SQLite ignores the pragma inside a transaction, and dropping a table
+// with them on would delete the rows that refer to it
+func withMigrationConn(db *gorm.DB, m migration, fn func(conn *gorm.DB) error) error {
+	if !m.rebuildsTables {
+		return fn(db)
+	}
+
+	return db.Connection(func(conn *gorm.DB) error {
+		conn = conn.Session(&gorm.Session{NewDB: true}) // Queries on conn must not pile up on one statement
+		var enabled int
+		if err := conn.Raw(`PRAGMA foreign_keys`).Scan(&enabled).Error; err != nil {
+			return fmt.Errorf("failed to read foreign_keys: %w", err)
+		}
+		if err := conn.Exec(`PRAGMA foreign_keys=OFF`).Error; err != nil {
+			return fmt.Errorf("failed to turn foreign keys off: %w", err)
+		}
+		err := fn(conn)
+		if enabled == 1 {
+			if restoreErr := conn.Exec(`PRAGMA foreign_keys=ON`).Error; restoreErr != nil && err == nil {
+				err = fmt.Errorf("failed to turn foreign keys back on: %w", restoreErr)
+			}
+		}
+		return err
+	})
+}
+
+// checkForeignKeys fails if a migration that rebuilt tables left rows pointing nowhere,
+// since the foreign keys were not enforced while it ran
+func checkForeignKeys(tx *gorm.DB, m migration) error {
+	if !m.rebuildsTables {
+		return nil
+	}
+
+	rows, err := tx.Raw(`PRAGMA foreign_key_check`).Rows()
+	if err != nil {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	defer rows.Close()
+	if rows.Next() {
+		return fmt.Errorf("migration %d (%s) broke foreign keys", m.version, m.name)
+	}
+	return rows.Err()
+}
+
+// lockSchema takes the database write lock at the start of a migration transaction,
+// so concurrent rocha processes wait for each other (up to busy_timeout) instead of
+// applying the same migration twice
+func lockSchema(tx *gorm.DB) error {
+	if err := tx.Exec(`UPDATE schema_migrations SET version = version WHERE 0`).Error; err != nil {
+		return fmt.Errorf("failed to lock schema: %w", err)
+	}
+	return nil
+}
+
+// addColumnIfMissing adds a column to a table unless the table has it already
+func addColumnIfMissing(tx *gorm.DB, table, column, definition string) error {
+	if tx.Migrator().HasColumn(table, column) {
+		return nil
+	}
+	if err := tx.Exec(`ALTER TABLE ` + table + ` ADD COLUMN ` + column + ` ` + definition).Error; err != nil {
+		return fmt.Errorf("failed to add %s to %s table: %w", column, table, err)
+	}
+	return nil
+}
+
+// baselineTables are the tables of the baseline schema, in creation order
+var baselineTables = []string{
+	"sessions", "session_flags", "session_statuses", "session_comments", "session_notes",
+	"session_tags", "session_archives", "session_agent_cli_flags", "session_pr_info",
+	"session_timers", "session_token_budgets", "scheduled_prompts", "prompt_history",
+	"session_attachments", "session_shares", "workspaces", "workspace_sessions",
+	"tool_uses", "hook_metrics", "events",
+}
+
+// baselineColumn is a column of the sessions table as the baseline created it
+type baselineColumn struct {
+	definition string
+	name       string
+}
+
+// baselineSessionColumns is a frozen snapshot of the sessions table of the baseline schema.
+// Columns added later belong to their own migration, never here.
+var baselineSessionColumns = []baselineColumn{
+	{name: "base_branch", definition: "TEXT DEFAULT ''"},
+	{name: "branch_name", definition: "TEXT DEFAULT ''"},
+	{name: "claude_dir", definition: "TEXT DEFAULT ''"},
+	{name: "claude_session_id", definition: "TEXT DEFAULT ''"},
+	{name: "created_at", definition: "DATETIME"},
+	{name: "display_name", definition: "TEXT NOT NULL DEFAULT ''"},
+	{name: "editor", definition: "TEXT DEFAULT ''"},
+	{name: "execution_id", definition: "TEXT NOT NULL"},
+	{name: "initial_prompt", definition: "TEXT DEFAULT ''"},
+	{name: "is_external", definition: "NUMERIC NOT NULL DEFAULT false"},
+	{name: "keep_worktree", definition: "NUMERIC NOT NULL DEFAULT false"},
+	{name: "last_updated", definition: "DATETIME NOT NULL"},
+	{name: "position", definition: "INTEGER NOT NULL DEFAULT 0"},
+	{name: "priority", definition: "TEXT NOT NULL DEFAULT ''"},
+	{name: "repo_info", definition: "TEXT DEFAULT ''"},
+	{name: "repo_path", definition: "TEXT DEFAULT ''"},
+	{name: "repo_source", definition: "TEXT DEFAULT ''"},
+	{name: "state", definition: "TEXT NOT NULL DEFAULT 'idle' CONSTRAINT chk_sessions_state CHECK (state IN ('waiting','working','idle','exited'))"},
+	{name: "updated_at", definition: "DATETIME"},
+	{name: "worktree_path", definition: "TEXT DEFAULT ''"},
+}
+
+// baselineSessionIndexes index the sessions table of the baseline schema
+var baselineSessionIndexes = []string{
+	`CREATE INDEX IF NOT EXISTS idx_execution_id ON sessions(execution_id)`,
+	`CREATE INDEX IF NOT EXISTS idx_last_updated ON sessions(last_updated)`,
+	`CREATE INDEX IF NOT EXISTS idx_position ON sessions(position)`,
+}
+
+// baselineStatements create the extension tables of the baseline schema and their indexes
+var baselineStatements = []string{
+	`
+		CREATE TABLE IF NOT EXISTS session_flags (
+			session_name TEXT PRIMARY KEY,
+			is_flagged INTEGER NOT NULL DEFAULT 0,
+			flagged_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_statuses (
+			session_name TEXT PRIMARY KEY,
+			status TEXT NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_comments (
+			session_name TEXT PRIMARY KEY,
+			comment TEXT NOT NULL DEFAULT '',
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_notes (
+			session_name TEXT PRIMARY KEY,
+			note TEXT NOT NULL DEFAULT '',
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_tags (
+			session_name TEXT NOT NULL,
+			tag TEXT NOT NULL,
+			created_at DATETIME,
+			PRIMARY KEY (session_name, tag),
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_archives (
+			session_name TEXT PRIMARY KEY,
+			is_archived INTEGER NOT NULL DEFAULT 0,
+			archived_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_agent_cli_flags (
+			session_name TEXT PRIMARY KEY,
+			allow_dangerously_skip_permissions INTEGER NOT NULL DEFAULT 0,
+			args TEXT NOT NULL DEFAULT '',
+			created_at DATETIME,
+			model TEXT NOT NULL DEFAULT '',
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_pr_info (
+			session_name TEXT PRIMARY KEY,
+			number INTEGER NOT NULL DEFAULT 0,
+			state TEXT NOT NULL DEFAULT '',
+			url TEXT NOT NULL DEFAULT '',
+			checked_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_timers (
+			session_name TEXT PRIMARY KEY,
+			due_at DATETIME NOT NULL,
+			label TEXT NOT NULL DEFAULT '',
+			notified INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS session_token_budgets (
+			session_name TEXT PRIMARY KEY,
+			limit_tokens INTEGER NOT NULL,
+			used_tokens INTEGER NOT NULL DEFAULT 0,
+			wrap_up INTEGER NOT NULL DEFAULT 0,
+			exceeded INTEGER NOT NULL DEFAULT 0,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS scheduled_prompts (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			session_name TEXT NOT NULL,
+			send_at DATETIME NOT NULL,
+			text TEXT NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`CREATE INDEX IF NOT EXISTS idx_send_at ON scheduled_prompts(send_at)`,
+	`CREATE INDEX IF NOT EXISTS idx_scheduled_session ON scheduled_prompts(session_name)`,
+	`
+		CREATE TABLE IF NOT EXISTS prompt_history (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			session_name TEXT NOT NULL,
+			text TEXT NOT NULL,
+			sent_at DATETIME NOT NULL,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`CREATE INDEX IF NOT EXISTS idx_prompt_history_session ON prompt_history(session_name)`,
+	`
+		CREATE TABLE IF NOT EXISTS session_attachments (
+			session_name TEXT NOT NULL,
+			path TEXT NOT NULL,
+			name TEXT NOT NULL,
+			media_type TEXT NOT NULL DEFAULT '',
+			size INTEGER NOT NULL DEFAULT 0,
+			added_at DATETIME NOT NULL,
+			PRIMARY KEY (session_name, path),
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`CREATE INDEX IF NOT EXISTS idx_attachments_session ON session_attachments(session_name)`,
+	`
+		CREATE TABLE IF NOT EXISTS session_shares (
+			token TEXT PRIMARY KEY,
+			session_name TEXT NOT NULL,
+			access TEXT NOT NULL,
+			expires_at DATETIME NOT NULL,
+			revoked_at DATETIME,
+			created_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`CREATE INDEX IF NOT EXISTS idx_shares_session ON session_shares(session_name)`,
+	`
+		CREATE TABLE IF NOT EXISTS workspaces (
+			name TEXT PRIMARY KEY,
+			created_at DATETIME
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS workspace_sessions (
+			workspace_name TEXT NOT NULL,
+			session_name TEXT NOT NULL,
+			created_at DATETIME,
+			PRIMARY KEY (workspace_name, session_name),
+			FOREIGN KEY (workspace_name) REFERENCES workspaces(name) ON UPDATE CASCADE ON DELETE CASCADE,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`CREATE INDEX IF NOT EXISTS idx_workspace_sessions_session ON workspace_sessions(session_name)`,
+	`
+		CREATE TABLE IF NOT EXISTS tool_uses (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			session_name TEXT NOT NULL,
+			tool_name TEXT NOT NULL,
+			summary TEXT NOT NULL DEFAULT '',
+			input TEXT NOT NULL DEFAULT '',
+			failed INTEGER NOT NULL DEFAULT 0,
+			occurred_at DATETIME NOT NULL,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`,
+	`CREATE INDEX IF NOT EXISTS idx_tool_uses_session ON tool_uses(session_name)`,
+	`CREATE INDEX IF NOT EXISTS idx_tool_uses_occurred_at ON tool_uses(occurred_at)`,
+	`
+		CREATE TABLE IF NOT EXISTS hook_metrics (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			session_name TEXT NOT NULL,
+			event_type TEXT NOT NULL,
+			state TEXT NOT NULL DEFAULT '',
+			invoked_at DATETIME NOT NULL,
+			handled_at DATETIME NOT NULL,
+			handle_duration_us INTEGER NOT NULL DEFAULT 0
+		)
+	`,
+	`
+		CREATE TABLE IF NOT EXISTS events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			session_name TEXT NOT NULL,
+			type TEXT NOT NULL,
+			state TEXT NOT NULL DEFAULT '',
+			status TEXT NOT NULL DEFAULT '',
+			error TEXT NOT NULL DEFAULT '',
+			message TEXT NOT NULL DEFAULT '',
+			occurred_at DATETIME NOT NULL
+		)
+	`,
+	`CREATE INDEX IF NOT EXISTS idx_events_occurred_at ON events(occurred_at)`,
+}
+
+// baselineUp creates the schema rocha had before versioned migrations.
+// Databases created back then already have most of it, so every step is idempotent
+// and the columns added over time are backfilled when missing.
+func baselineUp(tx *gorm.DB) error {
+	if err := createBaselineSessions(tx); err != nil {
+		return err
+	}
+
+	for _, statement := range baselineStatements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return err
+		}
+	}
+
+	// Status changes are recorded since activity reports; older databases lack the column
+	if err := addColumnIfMissing(tx, "events", "status", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	// Messages (handoff notes, timer labels) are stored since handoff notes; older databases lack the column
+	if err := addColumnIfMissing(tx, "events", "message", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+
+	// Model and extra args are stored since per-session agent configuration; older databases lack them
+	for _, column := range []string{"args", "model"} {
+		if err := addColumnIfMissing(tx, "session_agent_cli_flags", column, "TEXT NOT NULL DEFAULT ''"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createBaselineSessions creates the sessions table from its frozen snapshot. Tables created
+// before versioning get the snapshot columns they lack, the way the model added them back then.
+func createBaselineSessions(tx *gorm.DB) error {
+	columns := []string{"name TEXT PRIMARY KEY"}
+	for _, column := range baselineSessionColumns {
+		columns = append(columns, column.name+" "+column.definition)
+	}
+	if err := tx.Exec(`CREATE TABLE IF NOT EXISTS sessions (` + strings.Join(columns, ", ") + `)`).Error; err != nil {
+		return fmt.Errorf("failed to create sessions table: %w", err)
+	}
+
+	for _, column := range baselineSessionColumns {
+		if err := addColumnIfMissing(tx, "sessions", column.name, column.definition); err != nil {
+			return err
+		}
+	}
+	for _, statement := range baselineSessionIndexes {
+		if err := tx.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to index sessions table: %w", err)
+		}
+	}
+	return nil
+}
+
+// baselineDown drops every table of the baseline schema
+func baselineDown(tx *gorm.DB) error {
+	for i := len(baselineTables) - 1; i >= 0; i-- {
+		if err := tx.Migrator().DropTable(baselineTables[i]); err != nil {
+			return fmt.Errorf("failed to drop %s table: %w", baselineTables[i], err)
+		}
+	}
+	return nil
+}
+
+// repoBookmarksUp creates the table of repository sources saved for session creation
+func repoBookmarksUp(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE TABLE IF NOT EXISTS repo_bookmarks (
+			name TEXT PRIMARY KEY,
+			source TEXT NOT NULL UNIQUE,
+			use_count INTEGER NOT NULL DEFAULT 0,
+			last_used_at DATETIME,
+			created_at DATETIME
+		)
+	`).Error
+}
+
+// repoBookmarksDown drops the repository bookmarks table
+func repoBookmarksDown(tx *gorm.DB) error {
+	return tx.Migrator().DropTable("repo_bookmarks")
+}
+
+// shellSessionsUp moves shell sessions out of the sessions table into references to their
+// parent, keeping the first shell of each parent. Only databases created before this migration
+// have shell rows, marked by the parent_name column; the baseline never creates it.
+func shellSessionsUp(tx *gorm.DB) error {
+	if err := tx.Exec(`
+		CREATE TABLE IF NOT EXISTS shell_sessions (
+			name TEXT PRIMARY KEY,
+			parent_name TEXT NOT NULL UNIQUE,
+			created_at DATETIME,
+			FOREIGN KEY (parent_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`).Error; err != nil {
+		return fmt.Errorf("failed to create shell_sessions table: %w", err)
+	}
+	if !tx.Migrator().HasColumn("sessions", "parent_name") {
+		return nil
+	}
+
+	statements := []string{
+		`
+			INSERT OR IGNORE INTO shell_sessions (name, parent_name, created_at)
+			SELECT name, parent_name, created_at FROM sessions
+			WHERE parent_name IS NOT NULL AND parent_name IN (SELECT name FROM sessions)
+			ORDER BY name
+		`,
+		`DELETE FROM session_agent_cli_flags WHERE session_name IN (SELECT name FROM sessions WHERE parent_name IS NOT NULL)`,
+		`DELETE FROM sessions WHERE parent_name IS NOT NULL`,
+		`DROP INDEX IF EXISTS idx_parent`,
+	}
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to move shell sessions: %w", err)
+		}
+	}
+
+	if err := tx.Exec(`ALTER TABLE sessions DROP COLUMN parent_name`).Error; err != nil {
+		return fmt.Errorf("failed to drop parent_name from sessions table: %w", err)
+	}
+	return nil
+}
+
+// shellSessionsDown puts shell sessions back into the sessions table, copying the paths of their parent
+func shellSessionsDown(tx *gorm.DB) error {
+	if err := addColumnIfMissing(tx, "sessions", "parent_name", "TEXT DEFAULT NULL"); err != nil {
+		return err
+	}
+
+	statements := []string{
+		`CREATE INDEX IF NOT EXISTS idx_parent ON sessions(parent_name)`,
+		`
+			INSERT INTO sessions (name, parent_name, branch_name, display_name, execution_id, last_updated,
+				position, repo_info, repo_path, state, worktree_path, created_at, updated_at)
+			SELECT sh.name, sh.parent_name, p.branch_name, '', p.execution_id, COALESCE(sh.created_at, p.last_updated),
+				0, p.repo_info, p.repo_path, 'idle', p.worktree_path, sh.created_at, sh.created_at
+			FROM shell_sessions sh JOIN sessions p ON p.name = sh.parent_name
+		`,
+		`DROP TABLE IF EXISTS shell_sessions`,
+	}
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to restore shell sessions: %w", err)
+		}
+	}
+	return nil
+}
+
+// sessionSubdirUp adds the subdirectory sessions of monorepos are scoped to
+func sessionSubdirUp(tx *gorm.DB) error {
+	return addColumnIfMissing(tx, "sessions", "subdir", "TEXT NOT NULL DEFAULT ''")
+}
+
+// sessionSubdirDown drops the subdirectory scope of sessions
+func sessionSubdirDown(tx *gorm.DB) error {
+	if !tx.Migrator().HasColumn("sessions", "subdir") {
+		return nil
+	}
+	if err := tx.Exec(`ALTER TABLE sessions DROP COLUMN subdir`).Error; err != nil {
+		return fmt.Errorf("failed to drop subdir from sessions table: %w", err)
+	}
+	return nil
+}
+
+// sessionCheckpointsUp creates the table of session checkpoint policies
+func sessionCheckpointsUp(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE TABLE IF NOT EXISTS session_checkpoints (
+			session_name TEXT PRIMARY KEY,
+			interval_seconds INTEGER NOT NULL DEFAULT 0,
+			target TEXT NOT NULL DEFAULT 'branch',
+			last_at DATETIME,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`).Error
+}
+
+// sessionCheckpointsDown drops the session checkpoint policies table
+func sessionCheckpointsDown(tx *gorm.DB) error {
+	return tx.Migrator().DropTable("session_checkpoints")
+}
+
+// sessionCIStatusesUp creates the table of CI results reported for session branches
+func sessionCIStatusesUp(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE TABLE IF NOT EXISTS session_ci_statuses (
+			session_name TEXT PRIMARY KEY,
+			state TEXT NOT NULL,
+			url TEXT NOT NULL DEFAULT '',
+			"commit" TEXT NOT NULL DEFAULT '',
+			notified BOOLEAN NOT NULL DEFAULT 0,
+			reported_at DATETIME NOT NULL,
+			created_at DATETIME,
+			updated_at DATETIME,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`).Error
+}
+
+// sessionCIStatusesDown drops the session CI results table
+func sessionCIStatusesDown(tx *gorm.DB) error {
+	return tx.Migrator().DropTable("session_ci_statuses")
+}
+
+// scheduledPromptClaimsUp adds the claim dispatchers take on a prompt before sending it
+func scheduledPromptClaimsUp(tx *gorm.DB) error {
+	return addColumnIfMissing(tx, "scheduled_prompts", "claimed_at", "DATETIME DEFAULT NULL")
+}
+
+// scheduledPromptClaimsDown drops the claims on scheduled prompts
+func scheduledPromptClaimsDown(tx *gorm.DB) error {
+	if !tx.Migrator().HasColumn("scheduled_prompts", "claimed_at") {
+		return nil
+	}
+	if err := tx.Exec(`ALTER TABLE scheduled_prompts DROP COLUMN claimed_at`).Error; err != nil {
+		return fmt.Errorf("failed to drop claimed_at from scheduled_prompts table: %w", err)
+	}
+	return nil
+}
+
+// ticketSyncOutboxUp creates the table of status changes waiting to be synced to tickets
+func ticketSyncOutboxUp(tx *gorm.DB) error {
+	return tx.Exec(`
+		CREATE TABLE IF NOT EXISTS ticket_sync_outbox (
+			session_name TEXT PRIMARY KEY,
+			queued_at DATETIME NOT NULL,
+			revision INTEGER NOT NULL DEFAULT 1,
+			attempts INTEGER NOT NULL DEFAULT 0,
+			claimed_at DATETIME DEFAULT NULL,
+			FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
+		)
+	`).Error
+}
+
+// ticketSyncOutboxDown drops the ticket sync outbox table
+func ticketSyncOutboxDown(tx *gorm.DB) error {
+	return tx.Migrator().DropTable("ticket_sync_outbox")
+}
+
+// shareGuestServersUp adds the teammate account and the tmux server of each share
+func shareGuestServersUp(tx *gorm.DB) error {
+	if err := addColumnIfMissing(tx, "session_shares", "guest", "TEXT NOT NULL DEFAULT ''"); err != nil {
+		return err
+	}
+	return addColumnIfMissing(tx, "session_shares", "socket", "TEXT NOT NULL DEFAULT ''")
+}
+
+// shareGuestServersDown drops the teammate account and the tmux server of shares
+func shareGuestServersDown(tx *gorm.DB) error {
+	for _, column := range []string{"guest", "socket"} {
+		if !tx.Migrator().HasColumn("session_shares", column) {
+			continue
+		}
+		if err := tx.Exec(`ALTER TABLE session_shares DROP COLUMN ` + column).Error; err != nil {
+			return fmt.Errorf("failed to drop %s from session_shares table: %w", column, err)
+		}
+	}
+	return nil
+}
+
+// scheduledPromptConfirmationsUp records whether the text of each prompt was confirmed
+// against the prompt review patterns when it was queued
+func scheduledPromptConfirmationsUp(tx *gorm.DB) error {
+	return addColumnIfMissing(tx, "scheduled_prompts", "confirmed", "BOOLEAN NOT NULL DEFAULT 0")
+}
+
+// scheduledPromptConfirmationsDown drops the confirmations of scheduled prompts
+func scheduledPromptConfirmationsDown(tx *gorm.DB) error {
+	if !tx.Migrator().HasColumn("scheduled_prompts", "confirmed") {
+		return nil
+	}
+	if err := tx.Exec(`ALTER TABLE scheduled_prompts DROP COLUMN confirmed`).Error; err != nil {
+		return fmt.Errorf("failed to drop confirmed from scheduled_prompts table: %w", err)
+	}
+	return nil
+}
+
+// sessionStatesBeforePause are the states the sessions table accepted before pausing existed
+const sessionStatesBeforePause = "state IN ('waiting','working','idle','exited')"
+
+// sessionStatesWithPause are the states the sessions table accepts since pausing
+const sessionStatesWithPause = "state IN ('waiting','working','idle','exited','paused')"
+
+// sessionPausedStateUp lets the sessions table store the paused state
+func sessionPausedStateUp(tx *gorm.DB) error {
+	return rebuildSessionsTable(tx, sessionStatesBeforePause, sessionStatesWithPause)
+}
+
+// sessionPausedStateDown makes the sessions table reject the paused state again,
+// marking paused sessions idle first
+func sessionPausedStateDown(tx *gorm.DB) error {
+	if err := tx.Exec(`UPDATE sessions SET state = 'idle' WHERE state = 'paused'`).Error; err != nil {
+		return fmt.Errorf("failed to unpause sessions: %w", err)
+	}
+	return rebuildSessionsTable(tx, sessionStatesWithPause, sessionStatesBeforePause)
+}
+
+// createSessionsTable matches the start of the statement that created the sessions table
+var createSessionsTable = regexp.MustCompile("^CREATE TABLE [`\"]?sessions[`\"]? ")
+
+// rebuildSessionsTable recreates the sessions table with the check on its state changed from
+// one condition to another, since SQLite cannot alter a check. Columns, rows, and indexes are
+// kept as they are; a table without the check is left alone.
+func rebuildSessionsTable(tx *gorm.DB, fromCheck, toCheck string) error {
+	var createSQL string
+	if err := tx.Raw(`SELECT sql FROM sqlite_master WHERE type = 'table' AND name = 'sessions'`).Scan(&createSQL).Error; err != nil {
+		return fmt.Errorf("failed to read sessions table: %w", err)
+	}
+	if !strings.Contains(createSQL, fromCheck) {
+		return nil
+	}
+	if !createSessionsTable.MatchString(createSQL) {
+		return fmt.Errorf("unexpected sessions table definition: %s", createSQL)
+	}
+
+	var indexes []string
+	if err := tx.Table("sqlite_master").Where("type = 'index' AND tbl_name = 'sessions' AND sql IS NOT NULL").Pluck("sql", &indexes).Error; err != nil {
+		return fmt.Errorf("failed to read sessions indexes: %w", err)
+	}
+
+	rebuilt := createSessionsTable.ReplaceAllString(strings.Replace(createSQL, fromCheck, toCheck, 1), "CREATE TABLE sessions_rebuilt ")
+	statements := append([]string{
+		rebuilt,
+		`INSERT INTO sessions_rebuilt SELECT * FROM sessions`,
+		`DROP TABLE sessions`,
+		`ALTER TABLE sessions_rebuilt RENAME TO sessions`,
+	}, indexes...)
+	for _, statement := range statements {
+		if err := tx.Exec(statement).Error; err != nil {
+			return fmt.Errorf("failed to rebuild sessions table: %w", err)
+		}
+	}
+	return nil
+}
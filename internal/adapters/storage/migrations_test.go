@@ -0,0 +1,212 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func latestMigration() int {
+	return migrations[len(migrations)-1].version
+}
+
+func TestMigrations_NumberedInOrder(t *testing.T) {
+	for i, m := range migrations {
+		assert.Equal(t, i+1, m.version, "migration %s", m.name)
+		assert.NotNil(t, m.up, "migration %d lacks up", m.version)
+		assert.NotNil(t, m.down, "migration %d lacks down", m.version)
+	}
+}
+
+func TestMigrateUp_RecordsAppliedMigrationsOnce(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+	newTestRepository(t, dbPath)
+	repo := newTestRepository(t, dbPath)
+
+	version, err := schemaVersion(repo.db)
+	require.NoError(t, err)
+	assert.Equal(t, latestMigration(), version)
+
+	var applied int64
+	require.NoError(t, repo.db.Model(&SchemaMigrationModel{}).Count(&applied).Error)
+	assert.Equal(t, int64(len(migrations)), applied, "reopening the database applies nothing again")
+}
+
+func TestMigrateDown_RoundTrips(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	require.NoError(t, migrateDown(repo.db, 0))
+
+	version, err := schemaVersion(repo.db)
+	require.NoError(t, err)
+	assert.Equal(t, 0, version)
+	for _, table := range baselineTables {
+		assert.False(t, repo.db.Migrator().HasTable(table), "%s survives the down migrations", table)
+	}
+
+	require.NoError(t, migrateUp(repo.db))
+
+	version, err = schemaVersion(repo.db)
+	require.NoError(t, err)
+	assert.Equal(t, latestMigration(), version)
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+}
+
+func TestMigrateUp_UpgradesDatabaseFromBeforeVersioning(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{Logger: newGormLogger()})
+	require.NoError(t, err)
+	require.NoError(t, db.Exec(`
+		CREATE TABLE events (
+			id INTEGER PRIMARY KEY AUTOINCREMENT,
+			session_name TEXT NOT NULL,
+			type TEXT NOT NULL,
+			state TEXT NOT NULL DEFAULT '',
+			error TEXT NOT NULL DEFAULT '',
+			occurred_at DATETIME NOT NULL
+		)
+	`).Error)
+	require.NoError(t, db.Exec(`INSERT INTO events (session_name, type, occurred_at) VALUES ('s1', 'created', ?)`, time.Now().UTC()).Error)
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+
+	repo := newTestRepository(t, dbPath)
+
+	assert.True(t, repo.db.Migrator().HasColumn("events", "status"))
+	assert.True(t, repo.db.Migrator().HasColumn("events", "message"))
+	var events int64
+	require.NoError(t, repo.db.Table("events").Count(&events).Error)
+	assert.Equal(t, int64(1), events, "existing rows are kept")
+}
+
+func TestBaselineUp_DoesNotFollowTheModel(t *testing.T) {
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "state.db")), &gorm.Config{Logger: newGormLogger()})
+	require.NoError(t, err)
+
+	require.NoError(t, db.Transaction(baselineUp))
+
+	for _, column := range baselineSessionColumns {
+		assert.True(t, db.Migrator().HasColumn("sessions", column.name), "%s is in the baseline", column.name)
+	}
+	assert.False(t, db.Migrator().HasColumn("sessions", "subdir"), "subdir belongs to its own migration")
+	assert.False(t, db.Migrator().HasColumn("sessions", "parent_name"), "shell rows are handled by the shell_sessions migration")
+}
+
+func TestMigrateUp_ConcurrentProcessesApplyOnce(t *testing.T) {
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			repo, err := NewSQLiteRepository(dbPath)
+			if err == nil {
+				repo.Close()
+			}
+			errs[i] = err
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+	repo := newTestRepository(t, dbPath)
+	var applied int64
+	require.NoError(t, repo.db.Model(&SchemaMigrationModel{}).Count(&applied).Error)
+	assert.Equal(t, int64(len(migrations)), applied)
+}
+
+func TestShellSessionsMigration_MovesShellRowsOfDatabasesFromBeforeVersioning(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+	db, err := gorm.Open(sqlite.Open(dbPath), &gorm.Config{Logger: newGormLogger()})
+	require.NoError(t, err)
+	now := time.Now().UTC()
+	for _, statement := range []string{
+		`CREATE TABLE sessions (name TEXT PRIMARY KEY, parent_name TEXT DEFAULT NULL, execution_id TEXT NOT NULL, last_updated DATETIME NOT NULL, state TEXT NOT NULL DEFAULT 'idle', worktree_path TEXT DEFAULT '', created_at DATETIME)`,
+		`INSERT INTO sessions (name, execution_id, last_updated, worktree_path) VALUES ('s1', 'exec', ?, '/wt/s1')`,
+		`INSERT INTO sessions (name, parent_name, execution_id, last_updated, worktree_path) VALUES ('s1-shell', 's1', 'exec', ?, '/wt/s1')`,
+	} {
+		require.NoError(t, db.Exec(statement, now).Error)
+	}
+	sqlDB, err := db.DB()
+	require.NoError(t, err)
+	require.NoError(t, sqlDB.Close())
+
+	repo := newTestRepository(t, dbPath)
+
+	assert.False(t, repo.db.Migrator().HasColumn("sessions", "parent_name"))
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s1"}, state.OrderedNames)
+	assert.Equal(t, &domain.ShellSession{Name: "s1-shell", ParentName: "s1"}, state.Sessions["s1"].ShellSession)
+}
+
+func TestShellSessionsMigration_MovesShellRows(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, migrateDown(repo.db, 2))
+
+	now := time.Now().UTC()
+	for _, statement := range []string{
+		`INSERT INTO sessions (name, execution_id, last_updated, state, worktree_path) VALUES ('s1', 'exec', ?, 'idle', '/wt/s1')`,
+		`INSERT INTO sessions (name, parent_name, execution_id, last_updated, state, worktree_path) VALUES ('s1-shell', 's1', 'exec', ?, 'idle', '/wt/s1')`,
+	} {
+		require.NoError(t, repo.db.Exec(statement, now).Error)
+	}
+
+	require.NoError(t, migrateUp(repo.db))
+
+	assert.False(t, repo.db.Migrator().HasColumn("sessions", "parent_name"))
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s1"}, state.OrderedNames, "the shell is no longer a session")
+	assert.Equal(t, &domain.ShellSession{Name: "s1-shell", ParentName: "s1"}, state.Sessions["s1"].ShellSession)
+
+	require.NoError(t, migrateDown(repo.db, 2))
+
+	var parent string
+	require.NoError(t, repo.db.Raw(`SELECT parent_name FROM sessions WHERE name = 's1-shell'`).Scan(&parent).Error)
+	assert.Equal(t, "s1", parent, "down puts the shell row back")
+}
+
+func TestSessionPausedStateMigration_KeepsRowsAndAcceptsPaused(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle, Subdir: "api"}))
+	require.NoError(t, repo.ToggleFlag(ctx, "s1"))
+
+	require.NoError(t, migrateDown(repo.db, 10))
+	assert.Error(t, repo.UpdateState(ctx, "s1", domain.StatePaused, "exec"), "the paused state is rejected before the migration")
+
+	require.NoError(t, migrateUp(repo.db))
+
+	require.NoError(t, repo.UpdateState(ctx, "s1", domain.StatePaused, "exec"))
+	session, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StatePaused, session.State)
+	assert.Equal(t, "api", session.Subdir)
+	assert.True(t, session.IsFlagged, "rebuilding the sessions table keeps the rows that refer to it")
+	assert.True(t, repo.db.Migrator().HasIndex("sessions", "idx_position"))
+
+	require.NoError(t, migrateDown(repo.db, 10))
+	session, err = repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateIdle, session.State, "down marks paused sessions idle")
+	assert.True(t, session.IsFlagged)
+}
@@ -4,28 +4,45 @@ import "time"
 
 // SessionModel is the GORM model for sessions table
 type SessionModel struct {
-	BranchName    string    `gorm:"default:''"`
-	ClaudeDir     string    `gorm:"default:''"`
-	CreatedAt     time.Time
-	DisplayName   string    `gorm:"not null;default:''"`
-	ExecutionID   string    `gorm:"not null;index:idx_execution_id"`
-	GitStats      any       `gorm:"-" json:"-"`
-	InitialPrompt string    `gorm:"default:''"`
-	LastUpdated   time.Time `gorm:"not null;index:idx_last_updated"`
-	Name          string    `gorm:"primaryKey"`
-	ParentName    *string   `gorm:"index:idx_parent;default:null"`
-	Position      int       `gorm:"not null;default:0;index:idx_position"`
-	RepoInfo      string    `gorm:"default:''"`
-	RepoPath      string    `gorm:"default:''"`
-	RepoSource    string    `gorm:"default:''"`
-	State         string    `gorm:"not null;default:'idle';check:state IN ('waiting','working','idle','exited')"`
-	UpdatedAt     time.Time
-	WorktreePath  string    `gorm:"default:''"`
+	BaseBranch      string `gorm:"default:''"`
+	BranchName      string `gorm:"default:''"`
+	ClaudeDir       string `gorm:"default:''"`
+	ClaudeSessionID string `gorm:"default:''"`
+	CreatedAt       time.Time
+	DisplayName     string    `gorm:"not null;default:''"`
+	Editor          string    `gorm:"default:''"`
+	ExecutionID     string    `gorm:"not null;index:idx_execution_id"`
+	GitStats        any       `gorm:"-" json:"-"`
+	InitialPrompt   string    `gorm:"default:''"`
+	IsExternal      bool      `gorm:"not null;default:false"`
+	KeepWorktree    bool      `gorm:"not null;default:false"` // Opted out of the worktree retention
+	LastUpdated     time.Time `gorm:"not null;index:idx_last_updated"`
+	Name            string    `gorm:"primaryKey"`
+	Position        int       `gorm:"not null;default:0;index:idx_position"`
+	Priority        string    `gorm:"not null;default:''"`
+	RepoInfo        string    `gorm:"default:''"`
+	RepoPath        string    `gorm:"default:''"`
+	RepoSource      string    `gorm:"default:''"`
+	State           string    `gorm:"not null;default:'idle';check:state IN ('waiting','working','idle','exited','paused')"`
+	Subdir          string    `gorm:"not null;default:''"` // Directory inside the checkout the session is scoped to
+	UpdatedAt       time.Time
+	WorktreePath    string `gorm:"default:''"`
 }
 
 // TableName specifies the table name for GORM
 func (SessionModel) TableName() string { return "sessions" }
 
+// ShellSessionModel is the GORM model for the shell session opened next to a session.
+// It only refers to its parent, whose paths it works in.
+type ShellSessionModel struct {
+	CreatedAt  time.Time
+	Name       string `gorm:"primaryKey"`
+	ParentName string `gorm:"not null;uniqueIndex"`
+}
+
+// TableName specifies the table name for GORM
+func (ShellSessionModel) TableName() string { return "shell_sessions" }
+
 // SessionFlagModel is the GORM model for session flags
 type SessionFlagModel struct {
 	CreatedAt   time.Time
@@ -60,6 +77,27 @@ type SessionCommentModel struct {
 // TableName specifies the table name for GORM
 func (SessionCommentModel) TableName() string { return "session_comments" }
 
+// SessionNoteModel is the GORM model for session markdown notes
+type SessionNoteModel struct {
+	CreatedAt   time.Time
+	Note        string `gorm:"not null;default:''"`
+	SessionName string `gorm:"primaryKey"`
+	UpdatedAt   time.Time
+}
+
+// TableName specifies the table name for GORM
+func (SessionNoteModel) TableName() string { return "session_notes" }
+
+// SessionTagModel is the GORM model for session tags (one row per tag)
+type SessionTagModel struct {
+	CreatedAt   time.Time
+	SessionName string `gorm:"primaryKey;index:idx_tags_session"`
+	Tag         string `gorm:"primaryKey"`
+}
+
+// TableName specifies the table name for GORM
+func (SessionTagModel) TableName() string { return "session_tags" }
+
 // SessionArchiveModel is the GORM model for session archive status
 type SessionArchiveModel struct {
 	ArchivedAt  *time.Time `gorm:"default:null"`
@@ -75,7 +113,9 @@ func (SessionArchiveModel) TableName() string { return "session_archives" }
 // SessionAgentCLIFlagsModel is the GORM model for agent CLI flags
 type SessionAgentCLIFlagsModel struct {
 	AllowDangerouslySkipPermissions bool   `gorm:"not null;default:false"`
+	Args                            string `gorm:"not null;default:''"` // JSON array of extra agent CLI args
 	CreatedAt                       time.Time
+	Model                           string `gorm:"not null;default:''"`
 	SessionName                     string `gorm:"primaryKey"`
 	UpdatedAt                       time.Time
 }
@@ -83,6 +123,61 @@ type SessionAgentCLIFlagsModel struct {
 // TableName specifies the table name for GORM
 func (SessionAgentCLIFlagsModel) TableName() string { return "session_agent_cli_flags" }
 
+// SessionCheckpointModel is the GORM model for the checkpoint policy of a session
+type SessionCheckpointModel struct {
+	CreatedAt       time.Time
+	IntervalSeconds int        `gorm:"not null;default:0"` // 0 checkpoints each time the agent goes idle
+	LastAt          *time.Time `gorm:"default:null"`
+	SessionName     string     `gorm:"primaryKey"`
+	Target          string     `gorm:"not null;default:'branch'"`
+	UpdatedAt       time.Time
+}
+
+// TableName specifies the table name for GORM
+func (SessionCheckpointModel) TableName() string { return "session_checkpoints" }
+
+// SessionCIStatusModel is the GORM model for the last CI result reported for the branch of a session
+type SessionCIStatusModel struct {
+	Commit      string `gorm:"not null;default:''"`
+	CreatedAt   time.Time
+	Notified    bool      `gorm:"not null;default:false"`
+	ReportedAt  time.Time `gorm:"not null"`
+	SessionName string    `gorm:"primaryKey"`
+	State       string    `gorm:"not null"`
+	UpdatedAt   time.Time
+	URL         string `gorm:"not null;default:''"`
+}
+
+// TableName specifies the table name for GORM
+func (SessionCIStatusModel) TableName() string { return "session_ci_statuses" }
+
+// SessionTimerModel is the GORM model for session countdown timers
+type SessionTimerModel struct {
+	CreatedAt   time.Time
+	DueAt       time.Time `gorm:"not null"`
+	Label       string    `gorm:"not null;default:''"`
+	Notified    bool      `gorm:"not null;default:false"`
+	SessionName string    `gorm:"primaryKey"`
+	UpdatedAt   time.Time
+}
+
+// TableName specifies the table name for GORM
+func (SessionTimerModel) TableName() string { return "session_timers" }
+
+// SessionTokenBudgetModel is the GORM model for session token budgets
+type SessionTokenBudgetModel struct {
+	CreatedAt   time.Time
+	Exceeded    bool   `gorm:"not null;default:false"`
+	LimitTokens int    `gorm:"not null"`
+	SessionName string `gorm:"primaryKey"`
+	UpdatedAt   time.Time
+	UsedTokens  int  `gorm:"not null;default:0"`
+	WrapUp      bool `gorm:"not null;default:false"`
+}
+
+// TableName specifies the table name for GORM
+func (SessionTokenBudgetModel) TableName() string { return "session_token_budgets" }
+
 // SessionPRInfoModel is the GORM model for PR info
 type SessionPRInfoModel struct {
 	CheckedAt   time.Time
@@ -96,3 +191,143 @@ type SessionPRInfoModel struct {
 
 // TableName specifies the table name for GORM
 func (SessionPRInfoModel) TableName() string { return "session_pr_info" }
+
+// PromptHistoryModel is the GORM model for text sent to sessions
+type PromptHistoryModel struct {
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	SentAt      time.Time `gorm:"not null"`
+	SessionName string    `gorm:"not null;index:idx_prompt_history_session"`
+	Text        string    `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (PromptHistoryModel) TableName() string { return "prompt_history" }
+
+// ScheduledPromptModel is the GORM model for prompts queued for delivery
+type ScheduledPromptModel struct {
+	ClaimedAt   *time.Time `gorm:"default:null"` // Set while a dispatcher sends the prompt
+	Confirmed   bool       `gorm:"not null;default:false"`
+	CreatedAt   time.Time
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	SendAt      time.Time `gorm:"not null;index:idx_send_at"`
+	SessionName string    `gorm:"not null;index:idx_scheduled_session"`
+	Text        string    `gorm:"not null"`
+	UpdatedAt   time.Time
+}
+
+// TableName specifies the table name for GORM
+func (ScheduledPromptModel) TableName() string { return "scheduled_prompts" }
+
+// TicketSyncOutboxModel is the GORM model for status changes waiting to be synced to tickets
+type TicketSyncOutboxModel struct {
+	Attempts    int        `gorm:"not null;default:0"`
+	ClaimedAt   *time.Time `gorm:"default:null"` // Set while a drain syncs the ticket
+	QueuedAt    time.Time  `gorm:"not null"`
+	Revision    int        `gorm:"not null;default:1"`
+	SessionName string     `gorm:"primaryKey"`
+}
+
+// TableName specifies the table name for GORM
+func (TicketSyncOutboxModel) TableName() string { return "ticket_sync_outbox" }
+
+// SessionAttachmentModel is the GORM model for files attached to sessions
+type SessionAttachmentModel struct {
+	AddedAt     time.Time `gorm:"not null"`
+	MediaType   string    `gorm:"not null;default:''"`
+	Name        string    `gorm:"not null"`
+	Path        string    `gorm:"primaryKey"`
+	SessionName string    `gorm:"primaryKey;index:idx_attachments_session"`
+	Size        int64     `gorm:"not null;default:0"`
+}
+
+// TableName specifies the table name for GORM
+func (SessionAttachmentModel) TableName() string { return "session_attachments" }
+
+// SessionShareModel is the GORM model for links that let teammates attach to sessions
+type SessionShareModel struct {
+	Access      string `gorm:"not null"`
+	CreatedAt   time.Time
+	ExpiresAt   time.Time `gorm:"not null"`
+	Guest       string    `gorm:"not null;default:''"`
+	RevokedAt   *time.Time
+	SessionName string `gorm:"not null;index:idx_shares_session"`
+	Socket      string `gorm:"not null;default:''"`
+	Token       string `gorm:"primaryKey"`
+}
+
+// TableName specifies the table name for GORM
+func (SessionShareModel) TableName() string { return "session_shares" }
+
+// RepoBookmarkModel is the GORM model for repository sources saved for session creation
+type RepoBookmarkModel struct {
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	Name       string `gorm:"primaryKey"`
+	Source     string `gorm:"not null;uniqueIndex"`
+	UseCount   int    `gorm:"not null;default:0"`
+}
+
+// TableName specifies the table name for GORM
+func (RepoBookmarkModel) TableName() string { return "repo_bookmarks" }
+
+// WorkspaceModel is the GORM model for named sets of sessions
+type WorkspaceModel struct {
+	CreatedAt time.Time
+	Name      string `gorm:"primaryKey"`
+}
+
+// TableName specifies the table name for GORM
+func (WorkspaceModel) TableName() string { return "workspaces" }
+
+// WorkspaceSessionModel is the GORM model for workspace membership (one row per session)
+type WorkspaceSessionModel struct {
+	CreatedAt     time.Time
+	SessionName   string `gorm:"primaryKey;index:idx_workspace_sessions_session"`
+	WorkspaceName string `gorm:"primaryKey"`
+}
+
+// TableName specifies the table name for GORM
+func (WorkspaceSessionModel) TableName() string { return "workspace_sessions" }
+
+// ToolUseModel is the GORM model for tool calls audited in sessions that skip permission prompts
+type ToolUseModel struct {
+	Failed      bool      `gorm:"not null;default:false"`
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	Input       string    `gorm:"not null;default:''"`
+	OccurredAt  time.Time `gorm:"not null;index"`
+	SessionName string    `gorm:"not null;index:idx_tool_uses_session"`
+	Summary     string    `gorm:"not null;default:''"`
+	ToolName    string    `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (ToolUseModel) TableName() string { return "tool_uses" }
+
+// HookMetricModel is the GORM model for hook processing metrics
+type HookMetricModel struct {
+	EventType        string    `gorm:"not null"`
+	HandleDurationUs int64     `gorm:"not null;default:0"`
+	HandledAt        time.Time `gorm:"not null"`
+	ID               uint      `gorm:"primaryKey;autoIncrement"`
+	InvokedAt        time.Time `gorm:"not null"`
+	SessionName      string    `gorm:"not null"`
+	State            string    `gorm:"not null;default:''"`
+}
+
+// TableName specifies the table name for GORM
+func (HookMetricModel) TableName() string { return "hook_metrics" }
+
+// EventModel is the GORM model for recorded session events
+type EventModel struct {
+	Error       string    `gorm:"not null;default:''"`
+	ID          uint      `gorm:"primaryKey;autoIncrement"`
+	Message     string    `gorm:"not null;default:''"`
+	OccurredAt  time.Time `gorm:"not null;index"`
+	SessionName string    `gorm:"not null;index"`
+	State       string    `gorm:"not null;default:''"`
+	Status      string    `gorm:"not null;default:''"`
+	Type        string    `gorm:"not null"`
+}
+
+// TableName specifies the table name for GORM
+func (EventModel) TableName() string { return "events" }
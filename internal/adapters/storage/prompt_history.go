@@ -0,0 +1,55 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// promptHistoryLimit is how many sent prompts are kept per session; older rows are discarded on insert
+const promptHistoryLimit = 200
+
+// AddSentPrompt implements PromptHistoryRepository.AddSentPrompt
+func (r *SQLiteRepository) AddSentPrompt(ctx context.Context, prompt domain.SentPrompt) error {
+	model := PromptHistoryModel{
+		SentAt:      prompt.SentAt.UTC(),
+		SessionName: prompt.SessionName,
+		Text:        prompt.Text,
+	}
+
+	if err := withRetry(func() error {
+		return r.db.WithContext(ctx).Create(&model).Error
+	}, 3); err != nil {
+		return fmt.Errorf("failed to add sent prompt: %w", err)
+	}
+
+	return withRetry(func() error {
+		keep := r.db.Model(&PromptHistoryModel{}).
+			Select("id").
+			Where("session_name = ?", prompt.SessionName).
+			Order("id DESC").
+			Limit(promptHistoryLimit)
+		return r.db.WithContext(ctx).
+			Where("session_name = ? AND id NOT IN (?)", prompt.SessionName, keep).
+			Delete(&PromptHistoryModel{}).Error
+	}, 3)
+}
+
+// ListSentPrompts implements PromptHistoryRepository.ListSentPrompts
+func (r *SQLiteRepository) ListSentPrompts(ctx context.Context, sessionName string, limit int) ([]domain.SentPrompt, error) {
+	var models []PromptHistoryModel
+	if err := r.db.WithContext(ctx).
+		Where("session_name = ?", sessionName).
+		Order("id DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list sent prompts: %w", err)
+	}
+
+	prompts := make([]domain.SentPrompt, 0, len(models))
+	for _, m := range models {
+		prompts = append(prompts, promptHistoryModelToDomain(m))
+	}
+	return prompts, nil
+}
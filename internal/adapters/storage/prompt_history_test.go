@@ -0,0 +1,39 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestAddSentPrompt_KeepsNewestPerSession(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	for _, name := range []string{"s1", "s2"} {
+		require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: name, State: domain.StateIdle}))
+	}
+
+	require.NoError(t, repo.AddSentPrompt(ctx, domain.SentPrompt{SentAt: time.Now(), SessionName: "s2", Text: "other"}))
+	for i := range promptHistoryLimit + 5 {
+		require.NoError(t, repo.AddSentPrompt(ctx, domain.SentPrompt{SentAt: time.Now(), SessionName: "s1", Text: fmt.Sprintf("prompt %d", i)}))
+	}
+
+	prompts, err := repo.ListSentPrompts(ctx, "s1", promptHistoryLimit+10)
+	require.NoError(t, err)
+	require.Len(t, prompts, promptHistoryLimit)
+	assert.Equal(t, fmt.Sprintf("prompt %d", promptHistoryLimit+4), prompts[0].Text)
+	assert.Equal(t, "prompt 5", prompts[len(prompts)-1].Text)
+
+	// Pruning one session leaves the others alone
+	prompts, err = repo.ListSentPrompts(ctx, "s2", 10)
+	require.NoError(t, err)
+	require.Len(t, prompts, 1)
+	assert.Equal(t, "other", prompts[0].Text)
+}
@@ -0,0 +1,79 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// AddRepoBookmark implements RepoBookmarkRepository.AddRepoBookmark
+func (r *SQLiteRepository) AddRepoBookmark(ctx context.Context, bookmark domain.RepoBookmark) error {
+	model := RepoBookmarkModel{
+		LastUsedAt: bookmark.LastUsedAt,
+		Name:       bookmark.Name,
+		Source:     bookmark.Source,
+		UseCount:   bookmark.UseCount,
+	}
+	return withRetry(func() error {
+		if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+			if isUniqueViolation(err) {
+				return fmt.Errorf("%w: %s (%s)", domain.ErrRepoBookmarkExists, bookmark.Name, bookmark.Source)
+			}
+			return fmt.Errorf("failed to add repository bookmark: %w", err)
+		}
+		return nil
+	}, 3)
+}
+
+// DeleteRepoBookmark implements RepoBookmarkRepository.DeleteRepoBookmark
+func (r *SQLiteRepository) DeleteRepoBookmark(ctx context.Context, name string) error {
+	return withRetry(func() error {
+		result := r.db.WithContext(ctx).Where("name = ?", name).Delete(&RepoBookmarkModel{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete repository bookmark: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", domain.ErrRepoBookmarkNotFound, name)
+		}
+		return nil
+	}, 3)
+}
+
+// ListRepoBookmarks implements RepoBookmarkRepository.ListRepoBookmarks
+func (r *SQLiteRepository) ListRepoBookmarks(ctx context.Context) ([]domain.RepoBookmark, error) {
+	var models []RepoBookmarkModel
+	if err := r.db.WithContext(ctx).
+		Order("use_count DESC").
+		Order("last_used_at IS NULL, last_used_at DESC").
+		Order("name ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list repository bookmarks: %w", err)
+	}
+
+	bookmarks := make([]domain.RepoBookmark, 0, len(models))
+	for _, m := range models {
+		bookmarks = append(bookmarks, repoBookmarkModelToDomain(m))
+	}
+	return bookmarks, nil
+}
+
+// MarkRepoBookmarkUsed implements RepoBookmarkRepository.MarkRepoBookmarkUsed
+func (r *SQLiteRepository) MarkRepoBookmarkUsed(ctx context.Context, name string, usedAt time.Time) error {
+	return withRetry(func() error {
+		result := r.db.WithContext(ctx).Model(&RepoBookmarkModel{}).Where("name = ?", name).Updates(map[string]any{
+			"last_used_at": usedAt.UTC(),
+			"use_count":    gorm.Expr("use_count + 1"),
+		})
+		if result.Error != nil {
+			return fmt.Errorf("failed to mark repository bookmark used: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", domain.ErrRepoBookmarkNotFound, name)
+		}
+		return nil
+	}, 3)
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestRepoBookmarks(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	require.NoError(t, repo.AddRepoBookmark(ctx, domain.RepoBookmark{Name: "api", Source: "git@github.com:acme/api.git"}))
+	require.NoError(t, repo.AddRepoBookmark(ctx, domain.RepoBookmark{Name: "web", Source: "https://github.com/acme/web"}))
+	require.NoError(t, repo.AddRepoBookmark(ctx, domain.RepoBookmark{Name: "docs", Source: "https://github.com/acme/docs"}))
+	assert.ErrorIs(t, repo.AddRepoBookmark(ctx, domain.RepoBookmark{Name: "api", Source: "https://github.com/acme/other"}), domain.ErrRepoBookmarkExists)
+	assert.ErrorIs(t, repo.AddRepoBookmark(ctx, domain.RepoBookmark{Name: "api2", Source: "git@github.com:acme/api.git"}), domain.ErrRepoBookmarkExists)
+
+	now := time.Now()
+	require.NoError(t, repo.MarkRepoBookmarkUsed(ctx, "web", now.Add(-time.Hour)))
+	require.NoError(t, repo.MarkRepoBookmarkUsed(ctx, "web", now))
+	require.NoError(t, repo.MarkRepoBookmarkUsed(ctx, "docs", now))
+	assert.ErrorIs(t, repo.MarkRepoBookmarkUsed(ctx, "missing", now), domain.ErrRepoBookmarkNotFound)
+
+	bookmarks, err := repo.ListRepoBookmarks(ctx)
+	require.NoError(t, err)
+	require.Len(t, bookmarks, 3)
+	assert.Equal(t, "web", bookmarks[0].Name, "most used first")
+	assert.Equal(t, 2, bookmarks[0].UseCount)
+	assert.Equal(t, "docs", bookmarks[1].Name)
+	assert.Equal(t, "api", bookmarks[2].Name, "never used last")
+	assert.Nil(t, bookmarks[2].LastUsedAt)
+
+	require.NoError(t, repo.DeleteRepoBookmark(ctx, "api"))
+	assert.ErrorIs(t, repo.DeleteRepoBookmark(ctx, "api"), domain.ErrRepoBookmarkNotFound)
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// scheduledPromptClaimTimeout is how long a claim holds before the prompt is dispatched again,
+// in case the process that claimed it died while sending
+const scheduledPromptClaimTimeout = 5 * time.Minute
+
+// AddScheduledPrompt implements ScheduledPromptRepository.AddScheduledPrompt
+func (r *SQLiteRepository) AddScheduledPrompt(ctx context.Context, prompt domain.ScheduledPrompt) (*domain.ScheduledPrompt, error) {
+	// Times are stored in UTC so lexical comparisons in SQLite stay correct
+	model := ScheduledPromptModel{
+		Confirmed:   prompt.Confirmed,
+		SendAt:      prompt.SendAt.UTC(),
+		SessionName: prompt.SessionName,
+		Text:        prompt.Text,
+	}
+
+	err := withRetry(func() error {
+		return r.db.WithContext(ctx).Create(&model).Error
+	}, 3)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add scheduled prompt: %w", err)
+	}
+
+	result := scheduledPromptModelToDomain(model)
+	return &result, nil
+}
+
+// ClaimScheduledPrompt implements ScheduledPromptRepository.ClaimScheduledPrompt
+func (r *SQLiteRepository) ClaimScheduledPrompt(ctx context.Context, id uint, now time.Time) (bool, error) {
+	var claimed bool
+	err := withRetry(func() error {
+		result := r.db.WithContext(ctx).Model(&ScheduledPromptModel{}).
+			Where("id = ? AND (claimed_at IS NULL OR claimed_at <= ?)", id, now.Add(-scheduledPromptClaimTimeout).UTC()).
+			Update("claimed_at", now.UTC())
+		if result.Error != nil {
+			return fmt.Errorf("failed to claim scheduled prompt: %w", result.Error)
+		}
+		claimed = result.RowsAffected == 1
+		return nil
+	}, 3)
+	return claimed, err
+}
+
+// DeleteScheduledPrompt implements ScheduledPromptRepository.DeleteScheduledPrompt
+func (r *SQLiteRepository) DeleteScheduledPrompt(ctx context.Context, id uint) error {
+	return withRetry(func() error {
+		result := r.db.WithContext(ctx).Delete(&ScheduledPromptModel{}, id)
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete scheduled prompt: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %d", domain.ErrScheduledPromptNotFound, id)
+		}
+		return nil
+	}, 3)
+}
+
+// ListDuePrompts implements ScheduledPromptRepository.ListDuePrompts
+func (r *SQLiteRepository) ListDuePrompts(ctx context.Context, now time.Time) ([]domain.ScheduledPrompt, error) {
+	var models []ScheduledPromptModel
+	if err := r.db.WithContext(ctx).
+		Where("send_at <= ?", now.UTC()).
+		Where("claimed_at IS NULL OR claimed_at <= ?", now.Add(-scheduledPromptClaimTimeout).UTC()).
+		Order("send_at ASC, id ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list due prompts: %w", err)
+	}
+
+	return scheduledPromptModelsToDomain(models), nil
+}
+
+// ListScheduledPrompts implements ScheduledPromptRepository.ListScheduledPrompts
+func (r *SQLiteRepository) ListScheduledPrompts(ctx context.Context, sessionName string) ([]domain.ScheduledPrompt, error) {
+	query := r.db.WithContext(ctx).Order("send_at ASC, id ASC")
+	if sessionName != "" {
+		query = query.Where("session_name = ?", sessionName)
+	}
+
+	var models []ScheduledPromptModel
+	if err := query.Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list scheduled prompts: %w", err)
+	}
+
+	return scheduledPromptModelsToDomain(models), nil
+}
+
+// ReleaseScheduledPrompt implements ScheduledPromptRepository.ReleaseScheduledPrompt
+func (r *SQLiteRepository) ReleaseScheduledPrompt(ctx context.Context, id uint) error {
+	return withRetry(func() error {
+		if err := r.db.WithContext(ctx).Model(&ScheduledPromptModel{}).
+			Where("id = ?", id).
+			Update("claimed_at", nil).Error; err != nil {
+			return fmt.Errorf("failed to release scheduled prompt: %w", err)
+		}
+		return nil
+	}, 3)
+}
+
+func scheduledPromptModelsToDomain(models []ScheduledPromptModel) []domain.ScheduledPrompt {
+	prompts := make([]domain.ScheduledPrompt, 0, len(models))
+	for _, m := range models {
+		prompts = append(prompts, scheduledPromptModelToDomain(m))
+	}
+	return prompts
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestClaimScheduledPrompt(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	now := time.Now()
+	prompt, err := repo.AddScheduledPrompt(ctx, domain.ScheduledPrompt{SendAt: now.Add(-time.Minute), SessionName: "s1", Text: "go"})
+	require.NoError(t, err)
+
+	claimed, err := repo.ClaimScheduledPrompt(ctx, prompt.ID, now)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = repo.ClaimScheduledPrompt(ctx, prompt.ID, now)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a claimed prompt cannot be claimed again")
+
+	due, err := repo.ListDuePrompts(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, due, "claimed prompts are not due")
+
+	due, err = repo.ListDuePrompts(ctx, now.Add(scheduledPromptClaimTimeout))
+	require.NoError(t, err)
+	assert.Len(t, due, 1, "abandoned claims time out")
+
+	require.NoError(t, repo.ReleaseScheduledPrompt(ctx, prompt.ID))
+	claimed, err = repo.ClaimScheduledPrompt(ctx, prompt.ID, now)
+	require.NoError(t, err)
+	assert.True(t, claimed, "released prompts can be claimed again")
+}
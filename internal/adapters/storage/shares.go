@@ -0,0 +1,81 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// AddShare implements ShareRepository.AddShare
+func (r *SQLiteRepository) AddShare(ctx context.Context, share domain.SessionShare) error {
+	// Times are stored in UTC so lexical comparisons in SQLite stay correct
+	model := SessionShareModel{
+		Access:      string(share.Access),
+		ExpiresAt:   share.ExpiresAt.UTC(),
+		Guest:       share.Guest,
+		SessionName: share.SessionName,
+		Socket:      share.Socket,
+		Token:       share.Token,
+	}
+
+	err := withRetry(func() error {
+		return r.db.WithContext(ctx).Create(&model).Error
+	}, 3)
+	if err != nil {
+		return fmt.Errorf("failed to add share: %w", err)
+	}
+	return nil
+}
+
+// GetShare implements ShareRepository.GetShare
+func (r *SQLiteRepository) GetShare(ctx context.Context, token string) (*domain.SessionShare, error) {
+	var model SessionShareModel
+	if err := r.db.WithContext(ctx).Where("token = ?", token).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrShareNotFound
+		}
+		return nil, fmt.Errorf("failed to get share: %w", err)
+	}
+
+	share := sessionShareModelToDomain(model)
+	return &share, nil
+}
+
+// ListShares implements ShareRepository.ListShares
+func (r *SQLiteRepository) ListShares(ctx context.Context, sessionName string) ([]domain.SessionShare, error) {
+	var models []SessionShareModel
+	if err := r.db.WithContext(ctx).
+		Where("session_name = ?", sessionName).
+		Order("created_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list shares: %w", err)
+	}
+
+	shares := make([]domain.SessionShare, 0, len(models))
+	for _, m := range models {
+		shares = append(shares, sessionShareModelToDomain(m))
+	}
+	return shares, nil
+}
+
+// RevokeShare implements ShareRepository.RevokeShare
+func (r *SQLiteRepository) RevokeShare(ctx context.Context, token string, revokedAt time.Time) error {
+	return withRetry(func() error {
+		result := r.db.WithContext(ctx).
+			Model(&SessionShareModel{}).
+			Where("token = ?", token).
+			Update("revoked_at", revokedAt.UTC())
+		if result.Error != nil {
+			return fmt.Errorf("failed to revoke share: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return domain.ErrShareNotFound
+		}
+		return nil
+	}, 3)
+}
@@ -6,12 +6,13 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/mattn/go-sqlite3"
 	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"gorm.io/gorm/logger"
 
 	"github.com/renato0307/rocha/internal/domain"
@@ -21,11 +22,20 @@ import (
 
 // SQLiteRepository implements ports.SessionRepository using GORM
 type SQLiteRepository struct {
-	db *gorm.DB
+	db          *gorm.DB
+	stateCaches map[bool]*stateCache // Previous LoadState result, keyed by includeArchived
+	stateMu     sync.Mutex
 }
 
 // Verify interface compliance at compile time
-var _ ports.SessionRepository = (*SQLiteRepository)(nil)
+var (
+	_ ports.EventFeed                 = (*SQLiteRepository)(nil)
+	_ ports.HookMetricsRepository     = (*SQLiteRepository)(nil)
+	_ ports.PromptHistoryRepository   = (*SQLiteRepository)(nil)
+	_ ports.ScheduledPromptRepository = (*SQLiteRepository)(nil)
+	_ ports.SessionRepository         = (*SQLiteRepository)(nil)
+	_ ports.WorkspaceRepository       = (*SQLiteRepository)(nil)
+)
 
 // gormLogger wraps the rocha logger for GORM
 type gormLogger struct {
@@ -123,103 +133,8 @@ func NewSQLiteRepository(dbPath string) (*SQLiteRepository, error) {
 	db.Exec("PRAGMA synchronous=NORMAL")
 	db.Exec("PRAGMA foreign_keys=ON")
 
-	// Auto-migrate Session table
-	if err := db.AutoMigrate(&SessionModel{}); err != nil {
-		if !strings.Contains(err.Error(), "already exists") {
-			return nil, fmt.Errorf("failed to migrate Session schema: %w", err)
-		}
-	}
-
-	// Manually create extension tables
-	migrator := db.Migrator()
-
-	if !migrator.HasTable(&SessionFlagModel{}) {
-		if err := db.Exec(`
-			CREATE TABLE IF NOT EXISTS session_flags (
-				session_name TEXT PRIMARY KEY,
-				is_flagged INTEGER NOT NULL DEFAULT 0,
-				flagged_at DATETIME,
-				created_at DATETIME,
-				updated_at DATETIME,
-				FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
-			)
-		`).Error; err != nil {
-			return nil, fmt.Errorf("failed to create session_flags table: %w", err)
-		}
-	}
-
-	if !migrator.HasTable(&SessionStatusModel{}) {
-		if err := db.Exec(`
-			CREATE TABLE IF NOT EXISTS session_statuses (
-				session_name TEXT PRIMARY KEY,
-				status TEXT NOT NULL,
-				created_at DATETIME,
-				updated_at DATETIME,
-				FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
-			)
-		`).Error; err != nil {
-			return nil, fmt.Errorf("failed to create session_statuses table: %w", err)
-		}
-	}
-
-	if !migrator.HasTable(&SessionCommentModel{}) {
-		if err := db.Exec(`
-			CREATE TABLE IF NOT EXISTS session_comments (
-				session_name TEXT PRIMARY KEY,
-				comment TEXT NOT NULL DEFAULT '',
-				created_at DATETIME,
-				updated_at DATETIME,
-				FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
-			)
-		`).Error; err != nil {
-			return nil, fmt.Errorf("failed to create session_comments table: %w", err)
-		}
-	}
-
-	if !migrator.HasTable(&SessionArchiveModel{}) {
-		if err := db.Exec(`
-			CREATE TABLE IF NOT EXISTS session_archives (
-				session_name TEXT PRIMARY KEY,
-				is_archived INTEGER NOT NULL DEFAULT 0,
-				archived_at DATETIME,
-				created_at DATETIME,
-				updated_at DATETIME,
-				FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
-			)
-		`).Error; err != nil {
-			return nil, fmt.Errorf("failed to create session_archives table: %w", err)
-		}
-	}
-
-	if !migrator.HasTable(&SessionAgentCLIFlagsModel{}) {
-		if err := db.Exec(`
-			CREATE TABLE IF NOT EXISTS session_agent_cli_flags (
-				session_name TEXT PRIMARY KEY,
-				allow_dangerously_skip_permissions INTEGER NOT NULL DEFAULT 0,
-				created_at DATETIME,
-				updated_at DATETIME,
-				FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
-			)
-		`).Error; err != nil {
-			return nil, fmt.Errorf("failed to create session_agent_cli_flags table: %w", err)
-		}
-	}
-
-	if !migrator.HasTable(&SessionPRInfoModel{}) {
-		if err := db.Exec(`
-			CREATE TABLE IF NOT EXISTS session_pr_info (
-				session_name TEXT PRIMARY KEY,
-				number INTEGER NOT NULL DEFAULT 0,
-				state TEXT NOT NULL DEFAULT '',
-				url TEXT NOT NULL DEFAULT '',
-				checked_at DATETIME,
-				created_at DATETIME,
-				updated_at DATETIME,
-				FOREIGN KEY (session_name) REFERENCES sessions(name) ON UPDATE CASCADE ON DELETE CASCADE
-			)
-		`).Error; err != nil {
-			return nil, fmt.Errorf("failed to create session_pr_info table: %w", err)
-		}
+	if err := migrateUp(db); err != nil {
+		return nil, err
 	}
 
 	// Configure connection pool
@@ -231,7 +146,7 @@ func NewSQLiteRepository(dbPath string) (*SQLiteRepository, error) {
 	sqlDB.SetMaxIdleConns(5)
 	sqlDB.SetConnMaxLifetime(0)
 
-	return &SQLiteRepository{db: db}, nil
+	return &SQLiteRepository{db: db, stateCaches: make(map[bool]*stateCache)}, nil
 }
 
 // NewSQLiteRepositoryForPath creates a new SQLiteRepository for a specific ROCHA_HOME path
@@ -252,14 +167,20 @@ func (r *SQLiteRepository) Close() error {
 // Get implements SessionReader.Get
 func (r *SQLiteRepository) Get(ctx context.Context, name string) (*domain.Session, error) {
 	var session SessionModel
-	var nestedSession SessionModel
+	var shellSession ShellSessionModel
 	var flag SessionFlagModel
 	var status SessionStatusModel
 	var comment SessionCommentModel
+	var note SessionNoteModel
+	var tags []SessionTagModel
+	var workspaces []WorkspaceSessionModel
 	var archive SessionArchiveModel
 	var agentCLIFlags SessionAgentCLIFlagsModel
-	var nestedAgentCLIFlags SessionAgentCLIFlagsModel
 	var prInfo SessionPRInfoModel
+	var timer SessionTimerModel
+	var tokenBudget SessionTokenBudgetModel
+	var checkpoint SessionCheckpointModel
+	var ciStatus SessionCIStatusModel
 
 	err := withRetry(func() error {
 		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
@@ -271,15 +192,17 @@ func (r *SQLiteRepository) Get(ctx context.Context, name string) (*domain.Sessio
 			tx.Where("session_name = ?", name).First(&flag)
 			tx.Where("session_name = ?", name).First(&status)
 			tx.Where("session_name = ?", name).First(&comment)
+			tx.Where("session_name = ?", name).First(&note)
+			tx.Where("session_name = ?", name).Order("tag ASC").Find(&tags)
+			tx.Where("session_name = ?", name).Order("workspace_name ASC").Find(&workspaces)
 			tx.Where("session_name = ?", name).First(&archive)
 			tx.Where("session_name = ?", name).First(&agentCLIFlags)
 			tx.Where("session_name = ?", name).First(&prInfo)
-
-			// Load nested session
-			err := tx.Where("parent_name = ?", name).First(&nestedSession).Error
-			if err == nil && nestedSession.Name != "" {
-				tx.Where("session_name = ?", nestedSession.Name).First(&nestedAgentCLIFlags)
-			}
+			tx.Where("session_name = ?", name).First(&timer)
+			tx.Where("session_name = ?", name).First(&tokenBudget)
+			tx.Where("session_name = ?", name).First(&checkpoint)
+			tx.Where("session_name = ?", name).First(&ciStatus)
+			tx.Where("parent_name = ?", name).First(&shellSession)
 
 			return nil
 		})
@@ -287,7 +210,7 @@ func (r *SQLiteRepository) Get(ctx context.Context, name string) (*domain.Sessio
 
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("session %s not found", name)
+			return nil, fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 		}
 		return nil, err
 	}
@@ -307,12 +230,30 @@ func (r *SQLiteRepository) Get(ctx context.Context, name string) (*domain.Sessio
 		}
 	}
 
-	result := sessionModelToDomain(session, flag.IsFlagged, statusPtr, comment.Comment, archive.IsArchived, agentCLIFlags.AllowDangerouslySkipPermissions, prInfoPtr)
+	var tagNames []string
+	for _, t := range tags {
+		tagNames = append(tagNames, t.Tag)
+	}
 
-	// Add nested session if found
-	if nestedSession.Name != "" {
-		nested := sessionModelToDomain(nestedSession, false, nil, "", false, nestedAgentCLIFlags.AllowDangerouslySkipPermissions, nil)
-		result.ShellSession = &nested
+	result := sessionModelToDomain(session, flag.IsFlagged, statusPtr, comment.Comment, note.Note, tagNames, archive, agentCLIFlags, prInfoPtr)
+	for _, w := range workspaces {
+		result.Workspaces = append(result.Workspaces, w.WorkspaceName)
+	}
+	if timer.SessionName != "" {
+		result.Timer = sessionTimerModelToDomain(timer)
+	}
+	if tokenBudget.SessionName != "" {
+		result.TokenBudget = sessionTokenBudgetModelToDomain(tokenBudget)
+	}
+	if checkpoint.SessionName != "" {
+		result.Checkpoint = sessionCheckpointModelToDomain(checkpoint)
+	}
+	if ciStatus.SessionName != "" {
+		result.CI = sessionCIStatusModelToDomain(ciStatus)
+	}
+
+	if shellSession.Name != "" {
+		result.ShellSession = shellSessionModelToDomain(shellSession)
 	}
 
 	return &result, nil
@@ -321,17 +262,24 @@ func (r *SQLiteRepository) Get(ctx context.Context, name string) (*domain.Sessio
 // List implements SessionReader.List
 func (r *SQLiteRepository) List(ctx context.Context, includeArchived bool) ([]domain.Session, error) {
 	var sessions []SessionModel
-	var allSessions []SessionModel
+	var shellSessions []ShellSessionModel
 	var flags []SessionFlagModel
 	var statuses []SessionStatusModel
 	var comments []SessionCommentModel
+	var notes []SessionNoteModel
+	var tags []SessionTagModel
+	var workspaces []WorkspaceSessionModel
 	var archives []SessionArchiveModel
 	var agentCLIFlags []SessionAgentCLIFlagsModel
 	var prInfos []SessionPRInfoModel
+	var timers []SessionTimerModel
+	var tokenBudgets []SessionTokenBudgetModel
+	var checkpoints []SessionCheckpointModel
+	var ciStatuses []SessionCIStatusModel
 
 	err := withRetry(func() error {
 		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-			query := tx.Where("parent_name IS NULL")
+			query := tx.Model(&SessionModel{})
 			if !includeArchived {
 				query = query.Where("name NOT IN (SELECT session_name FROM session_archives WHERE is_archived = 1)")
 			}
@@ -339,13 +287,20 @@ func (r *SQLiteRepository) List(ctx context.Context, includeArchived bool) ([]do
 				return err
 			}
 
-			tx.Find(&allSessions)
+			tx.Find(&shellSessions)
 			tx.Find(&flags)
 			tx.Find(&statuses)
 			tx.Find(&comments)
+			tx.Find(&notes)
+			tx.Order("tag ASC").Find(&tags)
+			tx.Order("workspace_name ASC").Find(&workspaces)
 			tx.Find(&archives)
 			tx.Find(&agentCLIFlags)
 			tx.Find(&prInfos)
+			tx.Find(&timers)
+			tx.Find(&tokenBudgets)
+			tx.Find(&checkpoints)
+			tx.Find(&ciStatuses)
 
 			return nil
 		})
@@ -356,11 +311,9 @@ func (r *SQLiteRepository) List(ctx context.Context, includeArchived bool) ([]do
 	}
 
 	// Build lookup maps
-	nestedMap := make(map[string]SessionModel)
-	for _, s := range allSessions {
-		if s.ParentName != nil {
-			nestedMap[*s.ParentName] = s
-		}
+	shellMap := make(map[string]*domain.ShellSession)
+	for _, shell := range shellSessions {
+		shellMap[shell.ParentName] = shellSessionModelToDomain(shell)
 	}
 
 	flagMap := make(map[string]bool)
@@ -379,14 +332,29 @@ func (r *SQLiteRepository) List(ctx context.Context, includeArchived bool) ([]do
 		commentMap[c.SessionName] = c.Comment
 	}
 
-	archiveMap := make(map[string]bool)
+	noteMap := make(map[string]string)
+	for _, n := range notes {
+		noteMap[n.SessionName] = n.Note
+	}
+
+	tagMap := make(map[string][]string)
+	for _, t := range tags {
+		tagMap[t.SessionName] = append(tagMap[t.SessionName], t.Tag)
+	}
+
+	workspaceMap := make(map[string][]string)
+	for _, w := range workspaces {
+		workspaceMap[w.SessionName] = append(workspaceMap[w.SessionName], w.WorkspaceName)
+	}
+
+	archiveMap := make(map[string]SessionArchiveModel)
 	for _, a := range archives {
-		archiveMap[a.SessionName] = a.IsArchived
+		archiveMap[a.SessionName] = a
 	}
 
-	cliMap := make(map[string]bool)
+	cliMap := make(map[string]SessionAgentCLIFlagsModel)
 	for _, f := range agentCLIFlags {
-		cliMap[f.SessionName] = f.AllowDangerouslySkipPermissions
+		cliMap[f.SessionName] = f
 	}
 
 	prInfoMap := make(map[string]*domain.PRInfo)
@@ -399,20 +367,75 @@ func (r *SQLiteRepository) List(ctx context.Context, includeArchived bool) ([]do
 		}
 	}
 
+	timerMap := make(map[string]*domain.SessionTimer)
+	for _, t := range timers {
+		timerMap[t.SessionName] = sessionTimerModelToDomain(t)
+	}
+
+	tokenBudgetMap := make(map[string]*domain.SessionTokenBudget)
+	for _, b := range tokenBudgets {
+		tokenBudgetMap[b.SessionName] = sessionTokenBudgetModelToDomain(b)
+	}
+
+	checkpointMap := make(map[string]*domain.CheckpointPolicy)
+	for _, c := range checkpoints {
+		checkpointMap[c.SessionName] = sessionCheckpointModelToDomain(c)
+	}
+
+	ciStatusMap := make(map[string]*domain.CIStatus)
+	for _, c := range ciStatuses {
+		ciStatusMap[c.SessionName] = sessionCIStatusModelToDomain(c)
+	}
+
 	// Convert to domain
 	result := make([]domain.Session, len(sessions))
 	for i, sess := range sessions {
-		result[i] = sessionModelToDomain(sess, flagMap[sess.Name], statusMap[sess.Name], commentMap[sess.Name], archiveMap[sess.Name], cliMap[sess.Name], prInfoMap[sess.Name])
-
-		if nested, ok := nestedMap[sess.Name]; ok {
-			nestedDomain := sessionModelToDomain(nested, false, nil, "", false, cliMap[nested.Name], nil)
-			result[i].ShellSession = &nestedDomain
-		}
+		result[i] = sessionModelToDomain(sess, flagMap[sess.Name], statusMap[sess.Name], commentMap[sess.Name], noteMap[sess.Name], tagMap[sess.Name], archiveMap[sess.Name], cliMap[sess.Name], prInfoMap[sess.Name])
+		result[i].Workspaces = workspaceMap[sess.Name]
+		result[i].Timer = timerMap[sess.Name]
+		result[i].TokenBudget = tokenBudgetMap[sess.Name]
+		result[i].Checkpoint = checkpointMap[sess.Name]
+		result[i].CI = ciStatusMap[sess.Name]
+		result[i].ShellSession = shellMap[sess.Name]
 	}
 
 	return result, nil
 }
 
+// ListSummaries implements SessionReader.ListSummaries
+// Unlike List it reads only the sessions and flags tables, so it stays cheap for frequent polls.
+func (r *SQLiteRepository) ListSummaries(ctx context.Context) ([]domain.SessionSummary, error) {
+	var rows []struct {
+		DisplayName string
+		IsFlagged   bool
+		Name        string
+		State       string
+	}
+
+	err := withRetry(func() error {
+		return r.db.WithContext(ctx).Table("sessions").
+			Select("sessions.name, sessions.display_name, sessions.state, COALESCE(session_flags.is_flagged, 0) AS is_flagged").
+			Joins("LEFT JOIN session_flags ON session_flags.session_name = sessions.name").
+			Where("sessions.name NOT IN (SELECT session_name FROM session_archives WHERE is_archived = 1)").
+			Order("sessions.position ASC").
+			Scan(&rows).Error
+	}, 3)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session summaries: %w", err)
+	}
+
+	summaries := make([]domain.SessionSummary, len(rows))
+	for i, row := range rows {
+		summaries[i] = domain.SessionSummary{
+			DisplayName: row.DisplayName,
+			IsFlagged:   row.IsFlagged,
+			Name:        row.Name,
+			State:       domain.SessionState(row.State),
+		}
+	}
+	return summaries, nil
+}
+
 // Add implements SessionWriter.Add
 func (r *SQLiteRepository) Add(ctx context.Context, session domain.Session) error {
 	return withRetry(func() error {
@@ -425,33 +448,21 @@ func (r *SQLiteRepository) Add(ctx context.Context, session domain.Session) erro
 			model.Position = minPosition - 1
 
 			if err := tx.Create(&model).Error; err != nil {
+				if isUniqueViolation(err) {
+					return fmt.Errorf("%w: %s", domain.ErrSessionExists, session.Name)
+				}
 				return fmt.Errorf("failed to create session: %w", err)
 			}
 
-			// Add nested session if exists
 			if session.ShellSession != nil {
-				nested := domainToSessionModel(*session.ShellSession)
-				nested.ParentName = &session.Name
-				if err := tx.Create(&nested).Error; err != nil {
-					return fmt.Errorf("failed to create nested session: %w", err)
-				}
-
-				if session.ShellSession.AllowDangerouslySkipPermissions {
-					if err := tx.Create(&SessionAgentCLIFlagsModel{
-						SessionName:                     session.ShellSession.Name,
-						AllowDangerouslySkipPermissions: true,
-					}).Error; err != nil {
-						return fmt.Errorf("failed to create nested session agent CLI flags: %w", err)
-					}
+				if err := tx.Create(&ShellSessionModel{Name: session.ShellSession.Name, ParentName: session.Name}).Error; err != nil {
+					return fmt.Errorf("failed to create shell session: %w", err)
 				}
 			}
 
-			// Save agent CLI flags if enabled
-			if session.AllowDangerouslySkipPermissions {
-				if err := tx.Create(&SessionAgentCLIFlagsModel{
-					SessionName:                     session.Name,
-					AllowDangerouslySkipPermissions: true,
-				}).Error; err != nil {
+			// Save agent CLI flags if any is set
+			if flags, ok := domainToAgentCLIFlagsModel(session); ok {
+				if err := tx.Create(&flags).Error; err != nil {
 					return fmt.Errorf("failed to create session agent CLI flags: %w", err)
 				}
 			}
@@ -470,9 +481,10 @@ func (r *SQLiteRepository) Delete(ctx context.Context, name string) error {
 				return result.Error
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", name)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 			}
-			return nil
+			// Connections without foreign keys do not cascade to the shell session
+			return tx.Where("parent_name = ?", name).Delete(&ShellSessionModel{}).Error
 		})
 	}, 3)
 }
@@ -480,19 +492,41 @@ func (r *SQLiteRepository) Delete(ctx context.Context, name string) error {
 // LinkShellSession implements SessionWriter.LinkShellSession
 func (r *SQLiteRepository) LinkShellSession(ctx context.Context, parentName, shellSessionName string) error {
 	return withRetry(func() error {
-		result := r.db.WithContext(ctx).Model(&SessionModel{}).
-			Where("name = ?", shellSessionName).
-			Update("parent_name", parentName)
-		if result.Error != nil {
-			return fmt.Errorf("failed to link shell session: %w", result.Error)
-		}
-		if result.RowsAffected == 0 {
-			return fmt.Errorf("shell session %s not found", shellSessionName)
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var parent int64
+			if err := tx.Model(&SessionModel{}).Where("name = ?", parentName).Count(&parent).Error; err != nil {
+				return fmt.Errorf("failed to find session %s: %w", parentName, err)
+			}
+			if parent == 0 {
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, parentName)
+			}
+
+			if err := saveShellSession(tx, parentName, shellSessionName); err != nil {
+				return fmt.Errorf("failed to link shell session: %w", err)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// UnlinkShellSession implements SessionWriter.UnlinkShellSession
+func (r *SQLiteRepository) UnlinkShellSession(ctx context.Context, parentName string) error {
+	return withRetry(func() error {
+		if err := r.db.WithContext(ctx).Where("parent_name = ?", parentName).Delete(&ShellSessionModel{}).Error; err != nil {
+			return fmt.Errorf("failed to unlink shell session: %w", err)
 		}
 		return nil
 	}, 3)
 }
 
+// saveShellSession records the shell session of a parent, replacing the one it had
+func saveShellSession(tx *gorm.DB, parentName, shellSessionName string) error {
+	return tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "parent_name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"name"}),
+	}).Create(&ShellSessionModel{Name: shellSessionName, ParentName: parentName}).Error
+}
+
 // SwapPositions implements SessionWriter.SwapPositions
 func (r *SQLiteRepository) SwapPositions(ctx context.Context, name1, name2 string) error {
 	return withRetry(func() error {
@@ -533,7 +567,7 @@ func (r *SQLiteRepository) UpdateState(ctx context.Context, name string, state d
 				return result.Error
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", name)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 			}
 			return nil
 		})
@@ -552,13 +586,53 @@ func (r *SQLiteRepository) UpdateExecutionID(ctx context.Context, name, executio
 				return result.Error
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", name)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// UpdateClaudeSessionID implements SessionStateUpdater.UpdateClaudeSessionID
+func (r *SQLiteRepository) UpdateClaudeSessionID(ctx context.Context, name, claudeSessionID string) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			updates := map[string]any{
+				"claude_session_id": claudeSessionID,
+			}
+			result := tx.Model(&SessionModel{}).Where("name = ?", name).Updates(updates)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 			}
 			return nil
 		})
 	}, 3)
 }
 
+// UpdateBranchName implements SessionStateUpdater.UpdateBranchName
+// The PR info belongs to the previous branch, so it is cleared in the same transaction.
+func (r *SQLiteRepository) UpdateBranchName(ctx context.Context, name, branchName string) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			updates := map[string]any{
+				"branch_name":  branchName,
+				"last_updated": time.Now().UTC(),
+			}
+			result := tx.Model(&SessionModel{}).Where("name = ?", name).Updates(updates)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
+			}
+			return tx.Where("session_name = ?", name).Delete(&SessionPRInfoModel{}).Error
+		})
+	}, 3)
+}
+
 // UpdateClaudeDir implements SessionStateUpdater.UpdateClaudeDir
 func (r *SQLiteRepository) UpdateClaudeDir(ctx context.Context, name, claudeDir string) error {
 	return withRetry(func() error {
@@ -572,7 +646,27 @@ func (r *SQLiteRepository) UpdateClaudeDir(ctx context.Context, name, claudeDir
 				return result.Error
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", name)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// UpdateEditor implements SessionStateUpdater.UpdateEditor
+func (r *SQLiteRepository) UpdateEditor(ctx context.Context, name string, editor domain.EditorIntegration) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			updates := map[string]any{
+				"editor":       string(editor),
+				"last_updated": time.Now().UTC(),
+			}
+			result := tx.Model(&SessionModel{}).Where("name = ?", name).Updates(updates)
+			if result.Error != nil {
+				return result.Error
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 			}
 			return nil
 		})
@@ -592,7 +686,7 @@ func (r *SQLiteRepository) UpdateRepoSource(ctx context.Context, name, repoSourc
 				return result.Error
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", name)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 			}
 			return nil
 		})
@@ -601,6 +695,28 @@ func (r *SQLiteRepository) UpdateRepoSource(ctx context.Context, name, repoSourc
 
 // UpdateSkipPermissions implements SessionStateUpdater.UpdateSkipPermissions
 func (r *SQLiteRepository) UpdateSkipPermissions(ctx context.Context, name string, skip bool) error {
+	return r.updateAgentCLIFlags(ctx, name, func(flags *SessionAgentCLIFlagsModel) {
+		flags.AllowDangerouslySkipPermissions = skip
+	})
+}
+
+// UpdateAgentModel implements SessionStateUpdater.UpdateAgentModel
+func (r *SQLiteRepository) UpdateAgentModel(ctx context.Context, name string, model string) error {
+	return r.updateAgentCLIFlags(ctx, name, func(flags *SessionAgentCLIFlagsModel) {
+		flags.Model = model
+	})
+}
+
+// UpdateAgentArgs implements SessionStateUpdater.UpdateAgentArgs
+func (r *SQLiteRepository) UpdateAgentArgs(ctx context.Context, name string, args []string) error {
+	return r.updateAgentCLIFlags(ctx, name, func(flags *SessionAgentCLIFlagsModel) {
+		flags.Args = encodeAgentArgs(args)
+	})
+}
+
+// updateAgentCLIFlags changes the agent CLI flags of a session, keeping the flags update leaves
+// alone. The row is removed once every flag is back to its default.
+func (r *SQLiteRepository) updateAgentCLIFlags(ctx context.Context, name string, update func(*SessionAgentCLIFlagsModel)) error {
 	return withRetry(func() error {
 		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 			// Update timestamp
@@ -609,17 +725,46 @@ func (r *SQLiteRepository) UpdateSkipPermissions(ctx context.Context, name strin
 				return result.Error
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", name)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 			}
 
-			if skip {
-				return tx.Save(&SessionAgentCLIFlagsModel{
-					SessionName:                     name,
-					AllowDangerouslySkipPermissions: true,
-				}).Error
+			flags := SessionAgentCLIFlagsModel{SessionName: name}
+			if err := tx.Where("session_name = ?", name).First(&flags).Error; err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return err
 			}
-			tx.Where("session_name = ?", name).Delete(&SessionAgentCLIFlagsModel{})
-			return nil
+			update(&flags)
+
+			if flags.AllowDangerouslySkipPermissions || flags.Model != "" || flags.Args != "" {
+				return tx.Save(&flags).Error
+			}
+			return tx.Where("session_name = ?", name).Delete(&SessionAgentCLIFlagsModel{}).Error
+		})
+	}, 3)
+}
+
+// SetFlag implements SessionMetadataUpdater.SetFlag
+func (r *SQLiteRepository) SetFlag(ctx context.Context, name string, flagged bool) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			var flag SessionFlagModel
+			err := tx.Where("session_name = ?", name).First(&flag).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				flag = SessionFlagModel{SessionName: name}
+			case err != nil:
+				return fmt.Errorf("failed to load flag: %w", err)
+			case flag.IsFlagged == flagged:
+				return nil // Keeps when it was flagged
+			}
+
+			flag.IsFlagged = flagged
+			flag.FlaggedAt = nil
+			if flagged {
+				now := time.Now().UTC()
+				flag.FlaggedAt = &now
+			}
+
+			return tx.Save(&flag).Error
 		})
 	}, 3)
 }
@@ -657,43 +802,70 @@ func (r *SQLiteRepository) ToggleFlag(ctx context.Context, name string) error {
 }
 
 // Rename implements SessionMetadataUpdater.Rename
-func (r *SQLiteRepository) Rename(ctx context.Context, oldName, newName, newDisplayName string) error {
+func (r *SQLiteRepository) Rename(ctx context.Context, rename domain.SessionRename) error {
 	return withRetry(func() error {
 		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
 			// Update session name and display name, preserving position
-			result := tx.Model(&SessionModel{}).
-				Where("name = ?", oldName).
-				Updates(map[string]any{
-					"name":         newName,
-					"display_name": newDisplayName,
-					"last_updated": time.Now().UTC(),
-				})
+			updates := map[string]any{
+				"name":         rename.NewName,
+				"display_name": rename.DisplayName,
+				"last_updated": time.Now().UTC(),
+			}
+			if rename.BranchName != "" {
+				updates["branch_name"] = rename.BranchName
+			}
+			if rename.WorktreePath != "" {
+				updates["worktree_path"] = rename.WorktreePath
+			}
+			result := tx.Model(&SessionModel{}).Where("name = ?", rename.OldName).Updates(updates)
 			if result.Error != nil {
+				if isUniqueViolation(result.Error) {
+					return fmt.Errorf("%w: %s", domain.ErrSessionExists, rename.NewName)
+				}
 				return fmt.Errorf("failed to rename session: %w", result.Error)
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", oldName)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, rename.OldName)
+			}
+			if err := renameSessionReferences(tx, rename.OldName, rename.NewName); err != nil {
+				return err
 			}
 
-			// Update parent_name references for shell sessions
-			tx.Model(&SessionModel{}).
-				Where("parent_name = ?", oldName).
-				Update("parent_name", newName)
-
-			// Update related tables
-			tx.Model(&SessionAgentCLIFlagsModel{}).
-				Where("session_name = ?", oldName).
-				Update("session_name", newName)
-
-			tx.Model(&SessionArchiveModel{}).
-				Where("session_name = ?", oldName).
-				Update("session_name", newName)
-
+			// The shell session follows its parent's name; its paths are the parent's already
+			newShell := domain.ShellSessionName(rename.NewName)
+			result = tx.Model(&ShellSessionModel{}).Where("parent_name IN ?", []string{rename.OldName, rename.NewName}).
+				Updates(map[string]any{"name": newShell, "parent_name": rename.NewName})
+			if result.Error != nil {
+				if isUniqueViolation(result.Error) {
+					return fmt.Errorf("%w: %s", domain.ErrSessionExists, newShell)
+				}
+				return fmt.Errorf("failed to rename shell session: %w", result.Error)
+			}
 			return nil
 		})
 	}, 3)
 }
 
+// sessionNameTables are the tables that refer to sessions by name. Most cascade renames
+// through their foreign key, but events and hook metrics have none, and connections that
+// did not turn foreign keys on do not cascade, so renames update them all.
+var sessionNameTables = []string{
+	"session_flags", "session_statuses", "session_comments", "session_notes", "session_tags",
+	"session_archives", "session_agent_cli_flags", "session_pr_info", "session_timers",
+	"session_token_budgets", "session_checkpoints", "session_ci_statuses", "scheduled_prompts",
+	"prompt_history", "session_attachments", "session_shares", "workspace_sessions", "tool_uses", "hook_metrics", "events",
+}
+
+// renameSessionReferences points every row referring to oldName at newName
+func renameSessionReferences(tx *gorm.DB, oldName, newName string) error {
+	for _, table := range sessionNameTables {
+		if err := tx.Table(table).Where("session_name = ?", oldName).Update("session_name", newName).Error; err != nil {
+			return fmt.Errorf("failed to rename session in %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
 // ToggleArchive implements SessionMetadataUpdater.ToggleArchive
 func (r *SQLiteRepository) ToggleArchive(ctx context.Context, name string) error {
 	return withRetry(func() error {
@@ -730,18 +902,14 @@ func (r *SQLiteRepository) ToggleArchive(ctx context.Context, name string) error
 func (r *SQLiteRepository) UpdateStatus(ctx context.Context, name string, status *string) error {
 	return withRetry(func() error {
 		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-			// Check session exists and is not nested
+			// Check session exists
 			var session SessionModel
 			if err := tx.Where("name = ?", name).First(&session).Error; err != nil {
 				if errors.Is(err, gorm.ErrRecordNotFound) {
-					return fmt.Errorf("session %s not found", name)
+					return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 				}
 				return err
 			}
-			if session.ParentName != nil {
-				return fmt.Errorf("cannot set status on nested session %s", name)
-			}
-
 			if status == nil || *status == "" {
 				tx.Where("session_name = ?", name).Delete(&SessionStatusModel{})
 				return nil
@@ -769,7 +937,62 @@ func (r *SQLiteRepository) UpdateDisplayName(ctx context.Context, name, displayN
 				return fmt.Errorf("failed to update display name: %w", result.Error)
 			}
 			if result.RowsAffected == 0 {
-				return fmt.Errorf("session %s not found", name)
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// UpdatePriority implements SessionMetadataUpdater.UpdatePriority
+// Like flags, priority is metadata: it doesn't bump last_updated
+func (r *SQLiteRepository) UpdatePriority(ctx context.Context, name string, priority domain.Priority) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&SessionModel{}).
+				Where("name = ?", name).
+				Update("priority", string(priority))
+			if result.Error != nil {
+				return fmt.Errorf("failed to update priority: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// UpdateKeepWorktree implements SessionStateUpdater.UpdateKeepWorktree
+func (r *SQLiteRepository) UpdateKeepWorktree(ctx context.Context, name string, keep bool) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&SessionModel{}).
+				Where("name = ?", name).
+				Update("keep_worktree", keep)
+			if result.Error != nil {
+				return fmt.Errorf("failed to update keep worktree: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// UpdateSubdir implements SessionStateUpdater.UpdateSubdir
+func (r *SQLiteRepository) UpdateSubdir(ctx context.Context, name, subdir string) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			result := tx.Model(&SessionModel{}).
+				Where("name = ?", name).
+				Update("subdir", subdir)
+			if result.Error != nil {
+				return fmt.Errorf("failed to update subdir: %w", result.Error)
+			}
+			if result.RowsAffected == 0 {
+				return fmt.Errorf("%w: %s", domain.ErrSessionNotFound, name)
 			}
 			return nil
 		})
@@ -804,6 +1027,277 @@ func (r *SQLiteRepository) UpdateComment(ctx context.Context, name, comment stri
 	}, 3)
 }
 
+// UpdateNote implements SessionMetadataUpdater.UpdateNote
+func (r *SQLiteRepository) UpdateNote(ctx context.Context, name, note string) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if note == "" {
+				if err := tx.Where("session_name = ?", name).Delete(&SessionNoteModel{}).Error; err != nil {
+					return fmt.Errorf("failed to delete note: %w", err)
+				}
+				return nil
+			}
+
+			var existing SessionNoteModel
+			err := tx.Where("session_name = ?", name).First(&existing).Error
+
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return tx.Create(&SessionNoteModel{
+					Note:        note,
+					SessionName: name,
+				}).Error
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load note: %w", err)
+			}
+
+			existing.Note = note
+			return tx.Save(&existing).Error
+		})
+	}, 3)
+}
+
+// UpdateTimer implements SessionMetadataUpdater.UpdateTimer
+func (r *SQLiteRepository) UpdateTimer(ctx context.Context, name string, timer *domain.SessionTimer) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if timer == nil {
+				tx.Where("session_name = ?", name).Delete(&SessionTimerModel{})
+				return nil
+			}
+
+			var existing SessionTimerModel
+			err := tx.Where("session_name = ?", name).First(&existing).Error
+
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return tx.Create(&SessionTimerModel{
+					DueAt:       timer.DueAt.UTC(),
+					Label:       timer.Label,
+					Notified:    timer.Notified,
+					SessionName: name,
+				}).Error
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load timer: %w", err)
+			}
+
+			existing.DueAt = timer.DueAt.UTC()
+			existing.Label = timer.Label
+			existing.Notified = timer.Notified
+			return tx.Save(&existing).Error
+		})
+	}, 3)
+}
+
+// MarkTimerNotified implements SessionMetadataUpdater.MarkTimerNotified
+// Only the caller that flips the flag gets true, so concurrent TUIs announce a timer once.
+// Due times are stored in UTC, so the given one matches however it was loaded.
+func (r *SQLiteRepository) MarkTimerNotified(ctx context.Context, name string, dueAt time.Time) (bool, error) {
+	var marked bool
+	err := withRetry(func() error {
+		result := r.db.WithContext(ctx).Model(&SessionTimerModel{}).
+			Where("session_name = ? AND due_at = ? AND notified = ?", name, dueAt.UTC(), false).
+			Update("notified", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		marked = result.RowsAffected == 1
+		return nil
+	}, 3)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark timer notified: %w", err)
+	}
+	return marked, nil
+}
+
+// UpdateCheckpoint implements SessionMetadataUpdater.UpdateCheckpoint
+// A changed policy keeps the time of the last checkpoint.
+func (r *SQLiteRepository) UpdateCheckpoint(ctx context.Context, name string, policy *domain.CheckpointPolicy) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if policy == nil {
+				return tx.Where("session_name = ?", name).Delete(&SessionCheckpointModel{}).Error
+			}
+
+			var existing SessionCheckpointModel
+			err := tx.Where("session_name = ?", name).First(&existing).Error
+
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return tx.Create(&SessionCheckpointModel{
+					IntervalSeconds: int(policy.Interval / time.Second),
+					SessionName:     name,
+					Target:          string(policy.Target),
+				}).Error
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load checkpoint policy: %w", err)
+			}
+
+			existing.IntervalSeconds = int(policy.Interval / time.Second)
+			existing.Target = string(policy.Target)
+			return tx.Save(&existing).Error
+		})
+	}, 3)
+}
+
+// MarkCheckpoint implements SessionMetadataUpdater.MarkCheckpoint
+func (r *SQLiteRepository) MarkCheckpoint(ctx context.Context, name string, at time.Time) error {
+	return withRetry(func() error {
+		at := at.UTC()
+		if err := r.db.WithContext(ctx).Model(&SessionCheckpointModel{}).
+			Where("session_name = ?", name).
+			Update("last_at", &at).Error; err != nil {
+			return fmt.Errorf("failed to mark checkpoint: %w", err)
+		}
+		return nil
+	}, 3)
+}
+
+// UpdateCIStatus implements SessionMetadataUpdater.UpdateCIStatus
+func (r *SQLiteRepository) UpdateCIStatus(ctx context.Context, name string, status *domain.CIStatus) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if status == nil {
+				return tx.Where("session_name = ?", name).Delete(&SessionCIStatusModel{}).Error
+			}
+
+			var existing SessionCIStatusModel
+			err := tx.Where("session_name = ?", name).First(&existing).Error
+
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return tx.Create(&SessionCIStatusModel{
+					Commit:      status.Commit,
+					Notified:    status.Notified,
+					ReportedAt:  status.ReportedAt.UTC(),
+					SessionName: name,
+					State:       string(status.State),
+					URL:         status.URL,
+				}).Error
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load CI status: %w", err)
+			}
+
+			existing.Commit = status.Commit
+			existing.Notified = status.Notified
+			existing.ReportedAt = status.ReportedAt.UTC()
+			existing.State = string(status.State)
+			existing.URL = status.URL
+			return tx.Save(&existing).Error
+		})
+	}, 3)
+}
+
+// MarkCINotified implements SessionMetadataUpdater.MarkCINotified
+// Only the caller that flips the flag gets true, so concurrent TUIs announce a CI failure once.
+func (r *SQLiteRepository) MarkCINotified(ctx context.Context, name string, reportedAt time.Time) (bool, error) {
+	var marked bool
+	err := withRetry(func() error {
+		result := r.db.WithContext(ctx).Model(&SessionCIStatusModel{}).
+			Where("session_name = ? AND reported_at = ? AND notified = ?", name, reportedAt.UTC(), false).
+			Update("notified", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		marked = result.RowsAffected == 1
+		return nil
+	}, 3)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark CI failure notified: %w", err)
+	}
+	return marked, nil
+}
+
+// UpdateTokenBudget implements SessionMetadataUpdater.UpdateTokenBudget
+// Setting a budget starts it unenforced, so a raised limit is enforced again once reached.
+func (r *SQLiteRepository) UpdateTokenBudget(ctx context.Context, name string, budget *domain.SessionTokenBudget) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if budget == nil {
+				tx.Where("session_name = ?", name).Delete(&SessionTokenBudgetModel{})
+				return nil
+			}
+
+			var existing SessionTokenBudgetModel
+			err := tx.Where("session_name = ?", name).First(&existing).Error
+
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return tx.Create(&SessionTokenBudgetModel{
+					Exceeded:    budget.Exceeded,
+					LimitTokens: budget.Limit,
+					SessionName: name,
+					UsedTokens:  budget.Used,
+					WrapUp:      budget.WrapUp,
+				}).Error
+			}
+			if err != nil {
+				return fmt.Errorf("failed to load token budget: %w", err)
+			}
+
+			existing.Exceeded = budget.Exceeded
+			existing.LimitTokens = budget.Limit
+			existing.UsedTokens = budget.Used
+			existing.WrapUp = budget.WrapUp
+			return tx.Save(&existing).Error
+		})
+	}, 3)
+}
+
+// UpdateTokenBudgetUsage implements SessionMetadataUpdater.UpdateTokenBudgetUsage
+// Sessions without a budget are left alone.
+func (r *SQLiteRepository) UpdateTokenBudgetUsage(ctx context.Context, name string, used int) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Model(&SessionTokenBudgetModel{}).
+			Where("session_name = ?", name).
+			Update("used_tokens", used).Error
+	}, 3)
+}
+
+// MarkTokenBudgetExceeded implements SessionMetadataUpdater.MarkTokenBudgetExceeded
+// Only the caller that flips the flag gets true, so a budget is enforced once even when
+// several TUIs and the scheduler check it.
+func (r *SQLiteRepository) MarkTokenBudgetExceeded(ctx context.Context, name string, limit int) (bool, error) {
+	var marked bool
+	err := withRetry(func() error {
+		result := r.db.WithContext(ctx).Model(&SessionTokenBudgetModel{}).
+			Where("session_name = ? AND limit_tokens = ? AND exceeded = ?", name, limit, false).
+			Update("exceeded", true)
+		if result.Error != nil {
+			return result.Error
+		}
+		marked = result.RowsAffected == 1
+		return nil
+	}, 3)
+	if err != nil {
+		return false, fmt.Errorf("failed to mark token budget exceeded: %w", err)
+	}
+	return marked, nil
+}
+
+// UpdateTags implements SessionMetadataUpdater.UpdateTags
+// The given tags replace the current ones; an empty list clears them.
+func (r *SQLiteRepository) UpdateTags(ctx context.Context, name string, tags []string) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Where("session_name = ?", name).Delete(&SessionTagModel{}).Error; err != nil {
+				return fmt.Errorf("failed to clear tags: %w", err)
+			}
+			if len(tags) == 0 {
+				return nil
+			}
+
+			models := make([]SessionTagModel, len(tags))
+			for i, tag := range tags {
+				models[i] = SessionTagModel{SessionName: name, Tag: tag}
+			}
+			if err := tx.Create(&models).Error; err != nil {
+				return fmt.Errorf("failed to save tags: %w", err)
+			}
+			return nil
+		})
+	}, 3)
+}
+
 // UpdatePRInfo implements SessionMetadataUpdater.UpdatePRInfo
 func (r *SQLiteRepository) UpdatePRInfo(ctx context.Context, name string, prInfo *domain.PRInfo) error {
 	return withRetry(func() error {
@@ -825,36 +1319,28 @@ func (r *SQLiteRepository) UpdatePRInfo(ctx context.Context, name string, prInfo
 }
 
 // LoadState implements SessionStateLoader.LoadState
+// Only sessions whose version changed since the previous call are fetched in full;
+// the rest are reused from the cache of that call.
 func (r *SQLiteRepository) LoadState(ctx context.Context, includeArchived bool) (*domain.SessionCollection, error) {
-	var sessions []SessionModel
-	var flags []SessionFlagModel
-	var comments []SessionCommentModel
-	var statuses []SessionStatusModel
-	var archives []SessionArchiveModel
-	var agentCLIFlags []SessionAgentCLIFlagsModel
-	var prInfos []SessionPRInfoModel
+	r.stateMu.Lock()
+	cache := r.stateCaches[includeArchived]
+	r.stateMu.Unlock()
+
+	var versions []sessionVersion
+	var loaded map[string]domain.Session
 
 	err := withRetry(func() error {
 		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-			query := tx.Where("parent_name IS NULL")
-			if !includeArchived {
-				query = query.Where("name NOT IN (SELECT session_name FROM session_archives WHERE is_archived = 1)")
-			}
-			if err := query.Order("position ASC, name ASC").Find(&sessions).Error; err != nil {
-				return fmt.Errorf("failed to load sessions: %w", err)
+			var err error
+			versions, err = loadSessionVersions(tx, includeArchived)
+			if err != nil {
+				return err
 			}
 
-			tx.Find(&flags)
-			tx.Find(&comments)
-			tx.Find(&statuses)
-			tx.Find(&archives)
-			tx.Find(&agentCLIFlags)
-			tx.Find(&prInfos)
-
 			// Normalize positions if needed
 			needsNormalization := false
 			positionSet := make(map[int]bool)
-			for i, sess := range sessions {
+			for i, sess := range versions {
 				if positionSet[sess.Position] || sess.Position != i {
 					needsNormalization = true
 					break
@@ -863,15 +1349,23 @@ func (r *SQLiteRepository) LoadState(ctx context.Context, includeArchived bool)
 			}
 
 			if needsNormalization {
-				for i, sess := range sessions {
+				for i, sess := range versions {
 					if sess.Position != i {
 						tx.Model(&SessionModel{}).Where("name = ?", sess.Name).Update("position", i)
-						sessions[i].Position = i
+						versions[i].Position = i
 					}
 				}
 			}
 
-			return nil
+			// Fetch full rows only for new or changed sessions
+			var changed []string
+			for _, sess := range versions {
+				if cache == nil || cache.versions[sess.Name] != sess.Version {
+					changed = append(changed, sess.Name)
+				}
+			}
+			loaded, err = loadFullSessions(tx, changed)
+			return err
 		})
 	}, 3)
 
@@ -879,64 +1373,35 @@ func (r *SQLiteRepository) LoadState(ctx context.Context, includeArchived bool)
 		return nil, err
 	}
 
-	// Build lookup maps
-	flagMap := make(map[string]bool)
-	for _, f := range flags {
-		flagMap[f.SessionName] = f.IsFlagged
-	}
-
-	statusMap := make(map[string]*string)
-	for _, s := range statuses {
-		statusCopy := s.Status
-		statusMap[s.SessionName] = &statusCopy
-	}
-
-	commentMap := make(map[string]string)
-	for _, c := range comments {
-		commentMap[c.SessionName] = c.Comment
-	}
-
-	archiveMap := make(map[string]bool)
-	for _, a := range archives {
-		archiveMap[a.SessionName] = a.IsArchived
-	}
-
-	cliMap := make(map[string]bool)
-	for _, f := range agentCLIFlags {
-		cliMap[f.SessionName] = f.AllowDangerouslySkipPermissions
-	}
-
-	prInfoMap := make(map[string]*domain.PRInfo)
-	for _, p := range prInfos {
-		prInfoMap[p.SessionName] = &domain.PRInfo{
-			CheckedAt: p.CheckedAt,
-			Number:    p.Number,
-			State:     p.State,
-			URL:       p.URL,
-		}
-	}
-
 	// Build result
 	collection := &domain.SessionCollection{
-		OrderedNames: make([]string, len(sessions)),
-		Sessions:     make(map[string]domain.Session),
+		OrderedNames: make([]string, 0, len(versions)),
+		Sessions:     make(map[string]domain.Session, len(versions)),
+	}
+	next := &stateCache{
+		sessions: make(map[string]domain.Session, len(versions)),
+		versions: make(map[string]string, len(versions)),
 	}
 
-	for i, sess := range sessions {
-		collection.OrderedNames[i] = sess.Name
-
-		domainSess := sessionModelToDomain(sess, flagMap[sess.Name], statusMap[sess.Name], commentMap[sess.Name], archiveMap[sess.Name], cliMap[sess.Name], prInfoMap[sess.Name])
-
-		// Load nested session
-		var nestedSession SessionModel
-		if err := r.db.Where("parent_name = ?", sess.Name).First(&nestedSession).Error; err == nil {
-			nested := sessionModelToDomain(nestedSession, false, nil, "", false, cliMap[nestedSession.Name], nil)
-			domainSess.ShellSession = &nested
+	for _, sess := range versions {
+		domainSess, ok := loaded[sess.Name]
+		if !ok && cache != nil {
+			domainSess, ok = cache.sessions[sess.Name]
 		}
-
+		if !ok {
+			continue // Deleted between the version query and the full fetch
+		}
+		collection.OrderedNames = append(collection.OrderedNames, sess.Name)
 		collection.Sessions[sess.Name] = domainSess
+		next.sessions[sess.Name] = domainSess
+		next.versions[sess.Name] = sess.Version
 	}
 
+	r.stateMu.Lock()
+	r.stateCaches[includeArchived] = next
+	r.stateMu.Unlock()
+
+	logging.Logger.Debug("Session state loaded", "sessions", len(versions), "fetched", len(loaded))
 	return collection, nil
 }
 
@@ -981,46 +1446,27 @@ func (r *SQLiteRepository) SaveState(ctx context.Context, state *domain.SessionC
 				delete(existingNames, session.Name)
 
 				// Handle agent CLI flags
-				if session.AllowDangerouslySkipPermissions {
-					tx.Save(&SessionAgentCLIFlagsModel{
-						SessionName:                     session.Name,
-						AllowDangerouslySkipPermissions: true,
-					})
+				if flags, ok := domainToAgentCLIFlagsModel(session); ok {
+					tx.Save(&flags)
 				} else {
 					tx.Where("session_name = ?", session.Name).Delete(&SessionAgentCLIFlagsModel{})
 				}
 
-				// Handle nested session
 				if session.ShellSession != nil {
-					nested := domainToSessionModel(*session.ShellSession)
-					nested.ParentName = &session.Name
-
-					var existingNested SessionModel
-					if err := tx.Where("name = ?", nested.Name).First(&existingNested).Error; err == nil {
-						nested.Position = existingNested.Position
-					}
-
-					if err := tx.Save(&nested).Error; err != nil {
-						return fmt.Errorf("failed to save nested session for %s: %w", session.Name, err)
-					}
-					delete(existingNames, session.ShellSession.Name)
-
-					if session.ShellSession.AllowDangerouslySkipPermissions {
-						tx.Save(&SessionAgentCLIFlagsModel{
-							SessionName:                     session.ShellSession.Name,
-							AllowDangerouslySkipPermissions: true,
-						})
-					} else {
-						tx.Where("session_name = ?", session.ShellSession.Name).Delete(&SessionAgentCLIFlagsModel{})
+					if err := saveShellSession(tx, session.Name, session.ShellSession.Name); err != nil {
+						return fmt.Errorf("failed to save shell session for %s: %w", session.Name, err)
 					}
+				} else {
+					tx.Where("parent_name = ?", session.Name).Delete(&ShellSessionModel{})
 				}
 			}
 
-			// Delete removed sessions
+			// Delete removed sessions; connections without foreign keys do not cascade to their shells
 			for name := range existingNames {
 				if err := tx.Where("name = ?", name).Delete(&SessionModel{}).Error; err != nil {
 					return fmt.Errorf("failed to delete session %s: %w", name, err)
 				}
+				tx.Where("parent_name = ?", name).Delete(&ShellSessionModel{})
 			}
 
 			return nil
@@ -1028,6 +1474,15 @@ func (r *SQLiteRepository) SaveState(ctx context.Context, state *domain.SessionC
 	}, 3)
 }
 
+// isUniqueViolation reports whether err is a SQLite unique or primary key constraint failure
+func isUniqueViolation(err error) bool {
+	var sqliteErr sqlite3.Error
+	if !errors.As(err, &sqliteErr) {
+		return false
+	}
+	return sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique || sqliteErr.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+}
+
 // withRetry retries operations on SQLITE_BUSY with exponential backoff
 func withRetry(fn func() error, maxRetries int) error {
 	for i := 0; i < maxRetries; i++ {
@@ -0,0 +1,201 @@
+package storage
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// loadStateChunkSize caps the names in one IN (...) query, below SQLite's variable limit
+const loadStateChunkSize = 500
+
+// sessionVersionSelect selects one row per session with a version string that
+// changes whenever the session, one of its metadata rows, or its shell session is written.
+// GORM sets updated_at on every save; a deleted row empties its part so removals
+// (e.g. a cleared status) change the version too. Tags and workspace membership have
+// no updated_at, so the lists themselves are part of the version.
+const sessionVersionSelect = `s.name AS name, s.position AS position, s.updated_at
+	|| '|' || COALESCE((SELECT updated_at FROM session_flags WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_statuses WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_comments WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_notes WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT GROUP_CONCAT(tag) FROM session_tags WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT GROUP_CONCAT(workspace_name) FROM workspace_sessions WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_archives WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_agent_cli_flags WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_pr_info WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_timers WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_token_budgets WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_checkpoints WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT updated_at FROM session_ci_statuses WHERE session_name = s.name), '')
+	|| '|' || COALESCE((SELECT name FROM shell_sessions WHERE parent_name = s.name), '') AS version`
+
+// sessionVersion is a row of the lightweight changed-rows query
+type sessionVersion struct {
+	Name     string
+	Position int
+	Version  string
+}
+
+// stateCache holds the sessions returned by the previous LoadState call,
+// so unchanged sessions are not fetched again on the next poll
+type stateCache struct {
+	sessions map[string]domain.Session
+	versions map[string]string
+}
+
+// loadSessionVersions returns the version of every session in list order
+func loadSessionVersions(tx *gorm.DB, includeArchived bool) ([]sessionVersion, error) {
+	query := tx.Table("sessions AS s").Select(sessionVersionSelect)
+	if !includeArchived {
+		query = query.Where("s.name NOT IN (SELECT session_name FROM session_archives WHERE is_archived = 1)")
+	}
+
+	var versions []sessionVersion
+	if err := query.Order("s.position ASC, s.name ASC").Scan(&versions).Error; err != nil {
+		return nil, fmt.Errorf("failed to load session versions: %w", err)
+	}
+	return versions, nil
+}
+
+// loadFullSessions fetches the full rows of the named sessions, in chunks
+func loadFullSessions(tx *gorm.DB, names []string) (map[string]domain.Session, error) {
+	loaded := make(map[string]domain.Session, len(names))
+	for start := 0; start < len(names); start += loadStateChunkSize {
+		chunk := names[start:min(start+loadStateChunkSize, len(names))]
+		if err := loadSessionChunk(tx, chunk, loaded); err != nil {
+			return nil, err
+		}
+	}
+	return loaded, nil
+}
+
+func loadSessionChunk(tx *gorm.DB, names []string, loaded map[string]domain.Session) error {
+	var sessions []SessionModel
+	var shellSessions []ShellSessionModel
+	var flags []SessionFlagModel
+	var comments []SessionCommentModel
+	var notes []SessionNoteModel
+	var tags []SessionTagModel
+	var workspaces []WorkspaceSessionModel
+	var statuses []SessionStatusModel
+	var archives []SessionArchiveModel
+	var agentCLIFlags []SessionAgentCLIFlagsModel
+	var prInfos []SessionPRInfoModel
+	var timers []SessionTimerModel
+	var tokenBudgets []SessionTokenBudgetModel
+	var checkpoints []SessionCheckpointModel
+	var ciStatuses []SessionCIStatusModel
+
+	if err := tx.Where("name IN ?", names).Find(&sessions).Error; err != nil {
+		return fmt.Errorf("failed to load sessions: %w", err)
+	}
+	if err := tx.Where("parent_name IN ?", names).Find(&shellSessions).Error; err != nil {
+		return fmt.Errorf("failed to load shell sessions: %w", err)
+	}
+
+	tx.Where("session_name IN ?", names).Find(&flags)
+	tx.Where("session_name IN ?", names).Find(&comments)
+	tx.Where("session_name IN ?", names).Find(&notes)
+	tx.Where("session_name IN ?", names).Order("tag ASC").Find(&tags)
+	tx.Where("session_name IN ?", names).Order("workspace_name ASC").Find(&workspaces)
+	tx.Where("session_name IN ?", names).Find(&statuses)
+	tx.Where("session_name IN ?", names).Find(&archives)
+	tx.Where("session_name IN ?", names).Find(&agentCLIFlags)
+	tx.Where("session_name IN ?", names).Find(&prInfos)
+	tx.Where("session_name IN ?", names).Find(&timers)
+	tx.Where("session_name IN ?", names).Find(&tokenBudgets)
+	tx.Where("session_name IN ?", names).Find(&checkpoints)
+	tx.Where("session_name IN ?", names).Find(&ciStatuses)
+
+	// Build lookup maps
+	flagMap := make(map[string]bool)
+	for _, f := range flags {
+		flagMap[f.SessionName] = f.IsFlagged
+	}
+
+	statusMap := make(map[string]*string)
+	for _, s := range statuses {
+		statusCopy := s.Status
+		statusMap[s.SessionName] = &statusCopy
+	}
+
+	commentMap := make(map[string]string)
+	for _, c := range comments {
+		commentMap[c.SessionName] = c.Comment
+	}
+
+	noteMap := make(map[string]string)
+	for _, n := range notes {
+		noteMap[n.SessionName] = n.Note
+	}
+
+	tagMap := make(map[string][]string)
+	for _, t := range tags {
+		tagMap[t.SessionName] = append(tagMap[t.SessionName], t.Tag)
+	}
+
+	workspaceMap := make(map[string][]string)
+	for _, w := range workspaces {
+		workspaceMap[w.SessionName] = append(workspaceMap[w.SessionName], w.WorkspaceName)
+	}
+
+	archiveMap := make(map[string]SessionArchiveModel)
+	for _, a := range archives {
+		archiveMap[a.SessionName] = a
+	}
+
+	cliMap := make(map[string]SessionAgentCLIFlagsModel)
+	for _, f := range agentCLIFlags {
+		cliMap[f.SessionName] = f
+	}
+
+	prInfoMap := make(map[string]*domain.PRInfo)
+	for _, p := range prInfos {
+		prInfoMap[p.SessionName] = &domain.PRInfo{
+			CheckedAt: p.CheckedAt,
+			Number:    p.Number,
+			State:     p.State,
+			URL:       p.URL,
+		}
+	}
+
+	timerMap := make(map[string]*domain.SessionTimer)
+	for _, t := range timers {
+		timerMap[t.SessionName] = sessionTimerModelToDomain(t)
+	}
+
+	tokenBudgetMap := make(map[string]*domain.SessionTokenBudget)
+	for _, b := range tokenBudgets {
+		tokenBudgetMap[b.SessionName] = sessionTokenBudgetModelToDomain(b)
+	}
+
+	checkpointMap := make(map[string]*domain.CheckpointPolicy)
+	for _, c := range checkpoints {
+		checkpointMap[c.SessionName] = sessionCheckpointModelToDomain(c)
+	}
+
+	ciStatusMap := make(map[string]*domain.CIStatus)
+	for _, c := range ciStatuses {
+		ciStatusMap[c.SessionName] = sessionCIStatusModelToDomain(c)
+	}
+
+	shellMap := make(map[string]*domain.ShellSession)
+	for _, shell := range shellSessions {
+		shellMap[shell.ParentName] = shellSessionModelToDomain(shell)
+	}
+
+	for _, sess := range sessions {
+		domainSess := sessionModelToDomain(sess, flagMap[sess.Name], statusMap[sess.Name], commentMap[sess.Name], noteMap[sess.Name], tagMap[sess.Name], archiveMap[sess.Name], cliMap[sess.Name], prInfoMap[sess.Name])
+		domainSess.ShellSession = shellMap[sess.Name]
+		domainSess.Workspaces = workspaceMap[sess.Name]
+		domainSess.Timer = timerMap[sess.Name]
+		domainSess.TokenBudget = tokenBudgetMap[sess.Name]
+		domainSess.Checkpoint = checkpointMap[sess.Name]
+		domainSess.CI = ciStatusMap[sess.Name]
+		loaded[sess.Name] = domainSess
+	}
+	return nil
+}
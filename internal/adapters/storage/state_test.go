@@ -0,0 +1,358 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func newTestRepository(t *testing.T, dbPath string) *SQLiteRepository {
+	repo, err := NewSQLiteRepository(dbPath)
+	require.NoError(t, err)
+	t.Cleanup(func() { repo.Close() })
+	return repo
+}
+
+func TestLoadState_PicksUpChangesBetweenPolls(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+	repo := newTestRepository(t, dbPath)
+
+	for _, name := range []string{"s1", "s2"} {
+		require.NoError(t, repo.Add(ctx, domain.Session{
+			ExecutionID:  "exec",
+			LastUpdated:  time.Now(),
+			Name:         name,
+			ShellSession: &domain.ShellSession{Name: name + "-shell"},
+			State:        domain.StateIdle,
+		}))
+	}
+
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Len(t, state.Sessions, 2)
+	assert.Equal(t, "s1-shell", state.Sessions["s1"].ShellSession.Name)
+
+	// Write through a second repository, as the hooks of another process would
+	other := newTestRepository(t, dbPath)
+
+	review := "review"
+	require.NoError(t, other.UpdateStatus(ctx, "s1", &review))
+	require.NoError(t, other.UpdateComment(ctx, "s2", "looking good"))
+	require.NoError(t, other.UpdateTags(ctx, "s1", []string{"backend", "urgent"}))
+
+	state, err = repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	require.NotNil(t, state.Sessions["s1"].Status)
+	assert.Equal(t, "review", *state.Sessions["s1"].Status)
+	assert.Equal(t, "looking good", state.Sessions["s2"].Comment)
+	assert.Equal(t, []string{"backend", "urgent"}, state.Sessions["s1"].Tags)
+
+	// Removals change the version too
+	require.NoError(t, other.UpdateStatus(ctx, "s1", nil))
+	require.NoError(t, other.UpdateTags(ctx, "s1", []string{"backend"}))
+	require.NoError(t, other.Delete(ctx, "s2"))
+
+	state, err = repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Nil(t, state.Sessions["s1"].Status)
+	assert.Equal(t, []string{"backend"}, state.Sessions["s1"].Tags)
+	assert.Equal(t, []string{"s1"}, state.OrderedNames)
+	assert.NotContains(t, state.Sessions, "s2")
+}
+
+func TestUpdateState_StoresEveryState(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	for _, state := range []domain.SessionState{domain.StateWorking, domain.StateWaiting, domain.StatePaused, domain.StateIdle, domain.StateExited} {
+		require.NoError(t, repo.UpdateState(ctx, "s1", state, "exec"), "state %s", state)
+		session, err := repo.Get(ctx, "s1")
+		require.NoError(t, err)
+		assert.Equal(t, state, session.State)
+	}
+}
+
+func TestUpdateTags_ReplacesAndClears(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	require.NoError(t, repo.UpdateTags(ctx, "s1", []string{"client-x", "backend"}))
+	session, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backend", "client-x"}, session.Tags)
+
+	require.NoError(t, repo.Rename(ctx, domain.SessionRename{DisplayName: "s2", NewName: "s2", OldName: "s1"}))
+	sessions, err := repo.List(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, []string{"backend", "client-x"}, sessions[0].Tags)
+
+	require.NoError(t, repo.UpdateTags(ctx, "s2", nil))
+	session, err = repo.Get(ctx, "s2")
+	require.NoError(t, err)
+	assert.Empty(t, session.Tags)
+}
+
+func TestRename_MovesShellSessionAndHistory(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{
+		BranchName:   "old-branch",
+		ExecutionID:  "exec",
+		LastUpdated:  time.Now(),
+		Name:         "s1",
+		ShellSession: &domain.ShellSession{Name: "s1-shell"},
+		State:        domain.StateIdle,
+		WorktreePath: "/wt/s1",
+	}))
+	require.NoError(t, repo.AddEvent(ctx, domain.Event{SessionName: "s1", Timestamp: time.Now(), Type: domain.EventStateChange}))
+
+	require.NoError(t, repo.Rename(ctx, domain.SessionRename{
+		BranchName:   "new-branch",
+		DisplayName:  "Renamed",
+		NewName:      "s2",
+		OldName:      "s1",
+		WorktreePath: "/wt/s2",
+	}))
+
+	session, err := repo.Get(ctx, "s2")
+	require.NoError(t, err)
+	assert.Equal(t, "Renamed", session.DisplayName)
+	assert.Equal(t, "new-branch", session.BranchName)
+	assert.Equal(t, "/wt/s2", session.WorktreePath)
+	require.NotNil(t, session.ShellSession)
+	assert.Equal(t, &domain.ShellSession{Name: "s2-shell", ParentName: "s2"}, session.ShellSession)
+
+	events, err := repo.ListSessionEvents(ctx, "s2", 10)
+	require.NoError(t, err)
+	assert.Len(t, events, 1, "the event history follows the new name")
+}
+
+func TestRename_NameTaken(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	for _, name := range []string{"s1", "s2"} {
+		require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: name, State: domain.StateIdle}))
+	}
+
+	err := repo.Rename(ctx, domain.SessionRename{DisplayName: "s2", NewName: "s2", OldName: "s1"})
+
+	assert.ErrorIs(t, err, domain.ErrSessionExists)
+}
+
+func TestUpdateAgentCLIFlags_KeepsOtherFlags(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{
+		AgentArgs:   []string{"--add-dir", "../shared dir"},
+		AgentModel:  "opus",
+		ExecutionID: "exec",
+		LastUpdated: time.Now(),
+		Name:        "s1",
+		State:       domain.StateIdle,
+	}))
+
+	require.NoError(t, repo.UpdateSkipPermissions(ctx, "s1", true))
+	require.NoError(t, repo.UpdateAgentModel(ctx, "s1", "haiku"))
+	session, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.True(t, session.AllowDangerouslySkipPermissions)
+	assert.Equal(t, "haiku", session.AgentModel)
+	assert.Equal(t, []string{"--add-dir", "../shared dir"}, session.AgentArgs)
+
+	require.NoError(t, repo.UpdateSkipPermissions(ctx, "s1", false))
+	require.NoError(t, repo.UpdateAgentModel(ctx, "s1", ""))
+	require.NoError(t, repo.UpdateAgentArgs(ctx, "s1", nil))
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.False(t, state.Sessions["s1"].AllowDangerouslySkipPermissions)
+	assert.Empty(t, state.Sessions["s1"].AgentModel)
+	assert.Empty(t, state.Sessions["s1"].AgentArgs)
+
+	var rows int64
+	require.NoError(t, repo.db.Model(&SessionAgentCLIFlagsModel{}).Count(&rows).Error)
+	assert.Zero(t, rows, "defaults leave no row behind")
+
+	require.ErrorIs(t, repo.UpdateAgentModel(ctx, "missing", "opus"), domain.ErrSessionNotFound)
+}
+
+func TestUpdateTimer_MarksNotifiedOnce(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+	repo := newTestRepository(t, dbPath)
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Nil(t, state.Sessions["s1"].Timer)
+
+	timer, err := domain.NewSessionTimer(20*time.Minute, "check CI", time.Now())
+	require.NoError(t, err)
+	require.NoError(t, repo.UpdateTimer(ctx, "s1", timer))
+
+	state, err = repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	loaded := state.Sessions["s1"].Timer
+	require.NotNil(t, loaded)
+	assert.True(t, timer.DueAt.Equal(loaded.DueAt))
+	assert.Equal(t, "check CI", loaded.Label)
+	assert.False(t, loaded.Notified)
+
+	// A second process (another TUI) loses the race to announce the timer
+	other := newTestRepository(t, dbPath)
+	marked, err := repo.MarkTimerNotified(ctx, "s1", loaded.DueAt)
+	require.NoError(t, err)
+	assert.True(t, marked)
+	marked, err = other.MarkTimerNotified(ctx, "s1", loaded.DueAt)
+	require.NoError(t, err)
+	assert.False(t, marked)
+
+	session, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	require.NotNil(t, session.Timer)
+	assert.True(t, session.Timer.Notified)
+
+	require.NoError(t, repo.UpdateTimer(ctx, "s1", nil))
+	state, err = repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Nil(t, state.Sessions["s1"].Timer)
+}
+
+func TestUpdateCIStatus_MarksNotifiedOnce(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+	repo := newTestRepository(t, dbPath)
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	reportedAt := time.Now().Truncate(time.Second)
+	status := &domain.CIStatus{Commit: "abc123", ReportedAt: reportedAt, State: domain.CIStateFailure, URL: "https://ci/1"}
+	require.NoError(t, repo.UpdateCIStatus(ctx, "s1", status))
+
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	loaded := state.Sessions["s1"].CI
+	require.NotNil(t, loaded)
+	assert.Equal(t, domain.CIStateFailure, loaded.State)
+	assert.Equal(t, "abc123", loaded.Commit)
+	assert.Equal(t, "https://ci/1", loaded.URL)
+	assert.True(t, reportedAt.Equal(loaded.ReportedAt))
+	assert.False(t, loaded.Notified)
+
+	// A second process (another TUI) loses the race to announce the failure
+	other := newTestRepository(t, dbPath)
+	marked, err := repo.MarkCINotified(ctx, "s1", loaded.ReportedAt)
+	require.NoError(t, err)
+	assert.True(t, marked)
+	marked, err = other.MarkCINotified(ctx, "s1", loaded.ReportedAt)
+	require.NoError(t, err)
+	assert.False(t, marked)
+
+	sessions, err := repo.List(ctx, false)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.NotNil(t, sessions[0].CI)
+	assert.True(t, sessions[0].CI.Notified)
+
+	require.NoError(t, repo.UpdateCIStatus(ctx, "s1", nil))
+	session, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.Nil(t, session.CI)
+}
+
+func TestUpdateTokenBudget_MarksExceededOncePerLimit(t *testing.T) {
+	ctx := context.Background()
+	dbPath := filepath.Join(t.TempDir(), "state.db")
+	repo := newTestRepository(t, dbPath)
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	budget, err := domain.NewSessionTokenBudget(100_000, true)
+	require.NoError(t, err)
+	require.NoError(t, repo.UpdateTokenBudget(ctx, "s1", budget))
+	require.NoError(t, repo.UpdateTokenBudgetUsage(ctx, "s1", 120_000))
+
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, &domain.SessionTokenBudget{Limit: 100_000, Used: 120_000, WrapUp: true}, state.Sessions["s1"].TokenBudget)
+
+	// A second process (the scheduler) loses the race to enforce the budget
+	other := newTestRepository(t, dbPath)
+	marked, err := repo.MarkTokenBudgetExceeded(ctx, "s1", 100_000)
+	require.NoError(t, err)
+	assert.True(t, marked)
+	marked, err = other.MarkTokenBudgetExceeded(ctx, "s1", 100_000)
+	require.NoError(t, err)
+	assert.False(t, marked)
+
+	session, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	require.NotNil(t, session.TokenBudget)
+	assert.True(t, session.TokenBudget.Exceeded)
+
+	// Raising the limit starts the budget over
+	require.NoError(t, repo.UpdateTokenBudget(ctx, "s1", &domain.SessionTokenBudget{Limit: 200_000, Used: 120_000}))
+	session, err = repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.False(t, session.TokenBudget.Exceeded)
+
+	require.NoError(t, repo.UpdateTokenBudget(ctx, "s1", nil))
+	state, err = repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Nil(t, state.Sessions["s1"].TokenBudget)
+}
+
+func TestListSummaries(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+
+	for _, session := range []domain.Session{
+		{DisplayName: "Old", Name: "old", State: domain.StateIdle},
+		{Name: "web", State: domain.StateWorking},
+		{DisplayName: "API", Name: "api", ShellSession: &domain.ShellSession{Name: "api-shell"}, State: domain.StateWaiting},
+	} {
+		session.ExecutionID = "exec"
+		session.LastUpdated = time.Now()
+		require.NoError(t, repo.Add(ctx, session))
+	}
+	require.NoError(t, repo.ToggleFlag(ctx, "web"))
+	require.NoError(t, repo.ToggleArchive(ctx, "old"))
+
+	summaries, err := repo.ListSummaries(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SessionSummary{
+		{DisplayName: "API", Name: "api", State: domain.StateWaiting},
+		{IsFlagged: true, Name: "web", State: domain.StateWorking},
+	}, summaries, "newest first, without shell or archived sessions")
+}
+
+func TestSetFlag(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	flagged := func() bool {
+		session, err := repo.Get(ctx, "s1")
+		require.NoError(t, err)
+		return session.IsFlagged
+	}
+
+	require.NoError(t, repo.SetFlag(ctx, "s1", false))
+	assert.False(t, flagged(), "unflagging an unflagged session keeps it unflagged")
+
+	require.NoError(t, repo.SetFlag(ctx, "s1", true))
+	assert.True(t, flagged())
+	require.NoError(t, repo.SetFlag(ctx, "s1", true))
+	assert.True(t, flagged(), "setting the same value twice does not flip it")
+
+	require.NoError(t, repo.SetFlag(ctx, "s1", false))
+	assert.False(t, flagged())
+}
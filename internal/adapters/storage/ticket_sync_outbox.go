@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// ticketSyncClaimTimeout is how long a claim holds before the request is synced again,
+// in case the process that claimed it died while syncing
+const ticketSyncClaimTimeout = 5 * time.Minute
+
+// ClaimTicketSync implements TicketSyncOutbox.ClaimTicketSync
+func (r *SQLiteRepository) ClaimTicketSync(ctx context.Context, sessionName string, now time.Time) (bool, error) {
+	var claimed bool
+	err := withRetry(func() error {
+		result := r.db.WithContext(ctx).Model(&TicketSyncOutboxModel{}).
+			Where("session_name = ? AND (claimed_at IS NULL OR claimed_at <= ?)", sessionName, now.Add(-ticketSyncClaimTimeout).UTC()).
+			Update("claimed_at", now.UTC())
+		if result.Error != nil {
+			return fmt.Errorf("failed to claim ticket sync: %w", result.Error)
+		}
+		claimed = result.RowsAffected == 1
+		return nil
+	}, 3)
+	return claimed, err
+}
+
+// CompleteTicketSync implements TicketSyncOutbox.CompleteTicketSync
+func (r *SQLiteRepository) CompleteTicketSync(ctx context.Context, sessionName string, revision int) error {
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Where("session_name = ? AND revision = ?", sessionName, revision).
+				Delete(&TicketSyncOutboxModel{}).Error; err != nil {
+				return fmt.Errorf("failed to complete ticket sync: %w", err)
+			}
+			if err := tx.Model(&TicketSyncOutboxModel{}).
+				Where("session_name = ?", sessionName).
+				Update("claimed_at", nil).Error; err != nil {
+				return fmt.Errorf("failed to release ticket sync: %w", err)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// FailTicketSync implements TicketSyncOutbox.FailTicketSync
+func (r *SQLiteRepository) FailTicketSync(ctx context.Context, sessionName string) error {
+	return withRetry(func() error {
+		if err := r.db.WithContext(ctx).Model(&TicketSyncOutboxModel{}).
+			Where("session_name = ?", sessionName).
+			Updates(map[string]any{"attempts": gorm.Expr("attempts + 1"), "claimed_at": nil}).Error; err != nil {
+			return fmt.Errorf("failed to record ticket sync failure: %w", err)
+		}
+		return nil
+	}, 3)
+}
+
+// ListTicketSyncs implements TicketSyncOutbox.ListTicketSyncs
+func (r *SQLiteRepository) ListTicketSyncs(ctx context.Context, now time.Time) ([]domain.TicketSyncRequest, error) {
+	var models []TicketSyncOutboxModel
+	if err := r.db.WithContext(ctx).
+		Where("claimed_at IS NULL OR claimed_at <= ?", now.Add(-ticketSyncClaimTimeout).UTC()).
+		Order("queued_at ASC, session_name ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list ticket syncs: %w", err)
+	}
+
+	requests := make([]domain.TicketSyncRequest, 0, len(models))
+	for _, m := range models {
+		requests = append(requests, ticketSyncOutboxModelToDomain(m))
+	}
+	return requests, nil
+}
+
+// QueueTicketSync implements TicketSyncOutbox.QueueTicketSync
+func (r *SQLiteRepository) QueueTicketSync(ctx context.Context, sessionName string, queuedAt time.Time) error {
+	// Times are stored in UTC so lexical comparisons in SQLite stay correct
+	model := TicketSyncOutboxModel{QueuedAt: queuedAt.UTC(), Revision: 1, SessionName: sessionName}
+
+	err := withRetry(func() error {
+		return r.db.WithContext(ctx).Clauses(clause.OnConflict{
+			Columns: []clause.Column{{Name: "session_name"}},
+			DoUpdates: clause.Assignments(map[string]any{
+				"attempts":  0,
+				"queued_at": queuedAt.UTC(),
+				"revision":  gorm.Expr("ticket_sync_outbox.revision + 1"),
+			}),
+		}).Create(&model).Error
+	}, 3)
+	if err != nil {
+		return fmt.Errorf("failed to queue ticket sync: %w", err)
+	}
+	return nil
+}
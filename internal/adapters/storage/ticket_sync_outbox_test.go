@@ -0,0 +1,52 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestTicketSyncOutbox_KeepsChangesQueuedDuringSync(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: "s1", State: domain.StateIdle}))
+
+	now := time.Now()
+	require.NoError(t, repo.QueueTicketSync(ctx, "s1", now))
+	requests, err := repo.ListTicketSyncs(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+
+	claimed, err := repo.ClaimTicketSync(ctx, "s1", now)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+	claimed, err = repo.ClaimTicketSync(ctx, "s1", now)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a claimed request cannot be claimed again")
+
+	// Another change comes in while the first one syncs
+	require.NoError(t, repo.QueueTicketSync(ctx, "s1", now.Add(time.Second)))
+	require.NoError(t, repo.CompleteTicketSync(ctx, "s1", requests[0].Revision))
+
+	requests, err = repo.ListTicketSyncs(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, requests, 1, "the newer change is still queued")
+	assert.Equal(t, 2, requests[0].Revision)
+
+	require.NoError(t, repo.FailTicketSync(ctx, "s1"))
+	requests, err = repo.ListTicketSyncs(ctx, now)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	assert.Equal(t, 1, requests[0].Attempts)
+
+	require.NoError(t, repo.CompleteTicketSync(ctx, "s1", requests[0].Revision))
+	requests, err = repo.ListTicketSyncs(ctx, now)
+	require.NoError(t, err)
+	assert.Empty(t, requests)
+}
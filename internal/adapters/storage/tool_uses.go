@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// toolUseRetention is how long audited tool uses are kept; older rows are discarded on insert
+const toolUseRetention = 30 * 24 * time.Hour
+
+// AddToolUse implements ToolUseRepository.AddToolUse
+func (r *SQLiteRepository) AddToolUse(ctx context.Context, toolUse domain.ToolUse) error {
+	model := ToolUseModel{
+		Failed:      toolUse.Failed,
+		Input:       toolUse.Input,
+		OccurredAt:  toolUse.OccurredAt.UTC(),
+		SessionName: toolUse.SessionName,
+		Summary:     toolUse.Summary,
+		ToolName:    toolUse.ToolName,
+	}
+
+	if err := withRetry(func() error {
+		return r.db.WithContext(ctx).Create(&model).Error
+	}, 3); err != nil {
+		return fmt.Errorf("failed to add tool use: %w", err)
+	}
+
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).
+			Where("occurred_at < ?", model.OccurredAt.Add(-toolUseRetention)).
+			Delete(&ToolUseModel{}).Error
+	}, 3)
+}
+
+// ListToolUses implements ToolUseRepository.ListToolUses
+func (r *SQLiteRepository) ListToolUses(ctx context.Context, sessionName string, limit int) ([]domain.ToolUse, error) {
+	var models []ToolUseModel
+	if err := r.db.WithContext(ctx).
+		Where("session_name = ?", sessionName).
+		Order("id DESC").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list tool uses: %w", err)
+	}
+
+	toolUses := make([]domain.ToolUse, 0, len(models))
+	for _, m := range models {
+		toolUses = append(toolUses, toolUseModelToDomain(m))
+	}
+	return toolUses, nil
+}
@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// tracingSpanKey stores the span of a statement in the gorm statement settings
+const tracingSpanKey = "rocha:tracing_span"
+
+// SetTracer traces every database operation from now on, as a child of the span
+// of the context the operation runs with
+func (r *SQLiteRepository) SetTracer(tracer ports.Tracer) error {
+	start := func(operation string) func(*gorm.DB) {
+		return func(db *gorm.DB) {
+			ctx, span := tracer.Start(db.Statement.Context, "db."+operation)
+			db.Statement.Context = ctx
+			db.InstanceSet(tracingSpanKey, span)
+		}
+	}
+	finish := func(db *gorm.DB) {
+		value, ok := db.InstanceGet(tracingSpanKey)
+		if !ok {
+			return
+		}
+		span := value.(ports.Span)
+		if db.Statement.Table != "" {
+			span.SetAttribute("db.table", db.Statement.Table)
+		}
+		span.SetAttribute("db.rows_affected", db.RowsAffected)
+		err := db.Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			err = nil // A lookup that finds nothing did not fail
+		}
+		span.End(err)
+	}
+
+	callbacks := r.db.Callback()
+	err := errors.Join(
+		callbacks.Create().Before("gorm:create").Register("rocha:tracing_start_create", start("create")),
+		callbacks.Create().After("gorm:create").Register("rocha:tracing_finish_create", finish),
+		callbacks.Delete().Before("gorm:delete").Register("rocha:tracing_start_delete", start("delete")),
+		callbacks.Delete().After("gorm:delete").Register("rocha:tracing_finish_delete", finish),
+		callbacks.Query().Before("gorm:query").Register("rocha:tracing_start_query", start("query")),
+		callbacks.Query().After("gorm:query").Register("rocha:tracing_finish_query", finish),
+		callbacks.Raw().Before("gorm:raw").Register("rocha:tracing_start_raw", start("raw")),
+		callbacks.Raw().After("gorm:raw").Register("rocha:tracing_finish_raw", finish),
+		callbacks.Row().Before("gorm:row").Register("rocha:tracing_start_row", start("row")),
+		callbacks.Row().After("gorm:row").Register("rocha:tracing_finish_row", finish),
+		callbacks.Update().Before("gorm:update").Register("rocha:tracing_start_update", start("update")),
+		callbacks.Update().After("gorm:update").Register("rocha:tracing_finish_update", finish),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register tracing callbacks: %w", err)
+	}
+	return nil
+}
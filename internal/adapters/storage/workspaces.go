@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// AddWorkspaceSessions implements WorkspaceRepository.AddWorkspaceSessions
+func (r *SQLiteRepository) AddWorkspaceSessions(ctx context.Context, workspace string, sessionNames []string) error {
+	if len(sessionNames) == 0 {
+		return nil
+	}
+
+	models := make([]WorkspaceSessionModel, len(sessionNames))
+	for i, name := range sessionNames {
+		models[i] = WorkspaceSessionModel{SessionName: name, WorkspaceName: workspace}
+	}
+
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := workspaceExists(tx, workspace); err != nil {
+				return err
+			}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&models).Error; err != nil {
+				return fmt.Errorf("failed to add sessions to workspace: %w", err)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// CreateWorkspace implements WorkspaceRepository.CreateWorkspace
+func (r *SQLiteRepository) CreateWorkspace(ctx context.Context, name string) error {
+	return withRetry(func() error {
+		if err := r.db.WithContext(ctx).Create(&WorkspaceModel{Name: name}).Error; err != nil {
+			if isUniqueViolation(err) {
+				return fmt.Errorf("%w: %s", domain.ErrWorkspaceExists, name)
+			}
+			return fmt.Errorf("failed to create workspace: %w", err)
+		}
+		return nil
+	}, 3)
+}
+
+// DeleteWorkspace implements WorkspaceRepository.DeleteWorkspace
+// Membership rows are removed by the ON DELETE CASCADE foreign key
+func (r *SQLiteRepository) DeleteWorkspace(ctx context.Context, name string) error {
+	return withRetry(func() error {
+		result := r.db.WithContext(ctx).Where("name = ?", name).Delete(&WorkspaceModel{})
+		if result.Error != nil {
+			return fmt.Errorf("failed to delete workspace: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return fmt.Errorf("%w: %s", domain.ErrWorkspaceNotFound, name)
+		}
+		return nil
+	}, 3)
+}
+
+// GetWorkspace implements WorkspaceRepository.GetWorkspace
+func (r *SQLiteRepository) GetWorkspace(ctx context.Context, name string) (*domain.Workspace, error) {
+	var model WorkspaceModel
+	if err := r.db.WithContext(ctx).Where("name = ?", name).First(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("%w: %s", domain.ErrWorkspaceNotFound, name)
+		}
+		return nil, fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	var members []WorkspaceSessionModel
+	if err := r.db.WithContext(ctx).
+		Where("workspace_name = ?", name).
+		Order("session_name ASC").
+		Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("failed to list workspace sessions: %w", err)
+	}
+
+	sessions := make([]string, 0, len(members))
+	for _, m := range members {
+		sessions = append(sessions, m.SessionName)
+	}
+
+	workspace := workspaceModelToDomain(model, sessions)
+	return &workspace, nil
+}
+
+// ListWorkspaces implements WorkspaceRepository.ListWorkspaces
+func (r *SQLiteRepository) ListWorkspaces(ctx context.Context) ([]domain.Workspace, error) {
+	var models []WorkspaceModel
+	if err := r.db.WithContext(ctx).Order("name ASC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	var members []WorkspaceSessionModel
+	if err := r.db.WithContext(ctx).Order("session_name ASC").Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("failed to list workspace sessions: %w", err)
+	}
+
+	sessionMap := make(map[string][]string)
+	for _, m := range members {
+		sessionMap[m.WorkspaceName] = append(sessionMap[m.WorkspaceName], m.SessionName)
+	}
+
+	workspaces := make([]domain.Workspace, 0, len(models))
+	for _, m := range models {
+		workspaces = append(workspaces, workspaceModelToDomain(m, sessionMap[m.Name]))
+	}
+	return workspaces, nil
+}
+
+// RemoveWorkspaceSessions implements WorkspaceRepository.RemoveWorkspaceSessions
+func (r *SQLiteRepository) RemoveWorkspaceSessions(ctx context.Context, workspace string, sessionNames []string) error {
+	if len(sessionNames) == 0 {
+		return nil
+	}
+
+	return withRetry(func() error {
+		return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := workspaceExists(tx, workspace); err != nil {
+				return err
+			}
+			err := tx.Where("workspace_name = ? AND session_name IN ?", workspace, sessionNames).
+				Delete(&WorkspaceSessionModel{}).Error
+			if err != nil {
+				return fmt.Errorf("failed to remove sessions from workspace: %w", err)
+			}
+			return nil
+		})
+	}, 3)
+}
+
+// workspaceExists returns domain.ErrWorkspaceNotFound if the workspace is missing
+func workspaceExists(tx *gorm.DB, name string) error {
+	var count int64
+	if err := tx.Model(&WorkspaceModel{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		return fmt.Errorf("failed to check workspace: %w", err)
+	}
+	if count == 0 {
+		return fmt.Errorf("%w: %s", domain.ErrWorkspaceNotFound, name)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestWorkspaces_Membership(t *testing.T) {
+	ctx := context.Background()
+	repo := newTestRepository(t, filepath.Join(t.TempDir(), "state.db"))
+	for _, name := range []string{"s1", "s2", "s3"} {
+		require.NoError(t, repo.Add(ctx, domain.Session{ExecutionID: "exec", LastUpdated: time.Now(), Name: name, State: domain.StateIdle}))
+	}
+
+	require.NoError(t, repo.CreateWorkspace(ctx, "release-1.4"))
+	require.NoError(t, repo.CreateWorkspace(ctx, "client-acme"))
+	assert.ErrorIs(t, repo.CreateWorkspace(ctx, "release-1.4"), domain.ErrWorkspaceExists)
+
+	require.NoError(t, repo.AddWorkspaceSessions(ctx, "release-1.4", []string{"s2", "s1"}))
+	require.NoError(t, repo.AddWorkspaceSessions(ctx, "release-1.4", []string{"s1"}), "adding a member again is a no-op")
+	require.NoError(t, repo.AddWorkspaceSessions(ctx, "client-acme", []string{"s1"}))
+	assert.ErrorIs(t, repo.AddWorkspaceSessions(ctx, "missing", []string{"s1"}), domain.ErrWorkspaceNotFound)
+
+	workspaces, err := repo.ListWorkspaces(ctx)
+	require.NoError(t, err)
+	require.Len(t, workspaces, 2)
+	assert.Equal(t, "client-acme", workspaces[0].Name)
+	assert.Equal(t, []string{"s1"}, workspaces[0].Sessions)
+	assert.Equal(t, []string{"s1", "s2"}, workspaces[1].Sessions)
+
+	// Membership is loaded with the sessions
+	session, err := repo.Get(ctx, "s1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"client-acme", "release-1.4"}, session.Workspaces)
+	state, err := repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"release-1.4"}, state.Sessions["s2"].Workspaces)
+	sessions, err := repo.List(ctx, false)
+	require.NoError(t, err)
+	for _, sess := range sessions {
+		if sess.Name == "s2" {
+			assert.Equal(t, []string{"release-1.4"}, sess.Workspaces)
+		}
+	}
+
+	require.NoError(t, repo.RemoveWorkspaceSessions(ctx, "release-1.4", []string{"s2"}))
+	state, err = repo.LoadState(ctx, false)
+	require.NoError(t, err)
+	assert.Empty(t, state.Sessions["s2"].Workspaces, "membership changes refresh the cached state")
+
+	// Renaming a session keeps its membership, deleting it drops it
+	require.NoError(t, repo.Rename(ctx, domain.SessionRename{DisplayName: "s1-renamed", NewName: "s1-renamed", OldName: "s1"}))
+	workspace, err := repo.GetWorkspace(ctx, "release-1.4")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s1-renamed"}, workspace.Sessions)
+	require.NoError(t, repo.Delete(ctx, "s1-renamed"))
+	workspace, err = repo.GetWorkspace(ctx, "release-1.4")
+	require.NoError(t, err)
+	assert.Empty(t, workspace.Sessions)
+
+	// Deleting a workspace leaves its sessions alone
+	require.NoError(t, repo.AddWorkspaceSessions(ctx, "client-acme", []string{"s3"}))
+	require.NoError(t, repo.DeleteWorkspace(ctx, "client-acme"))
+	_, err = repo.GetWorkspace(ctx, "client-acme")
+	assert.ErrorIs(t, err, domain.ErrWorkspaceNotFound)
+	assert.ErrorIs(t, repo.DeleteWorkspace(ctx, "client-acme"), domain.ErrWorkspaceNotFound)
+	_, err = repo.Get(ctx, "s3")
+	require.NoError(t, err)
+}
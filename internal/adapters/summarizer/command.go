@@ -0,0 +1,50 @@
+package summarizer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// DefaultCommand asks Claude, in print mode, to summarize the diff it reads from stdin
+const DefaultCommand = `claude -p "Summarize the diff on stdin in one paragraph of plain prose for a teammate taking over this work: what changed and why it matters. Reply with the paragraph only, without headings, lists, or code."`
+
+// CommandSummarizer implements ports.DiffSummarizer with a shell command that reads the
+// diff on stdin and prints the summary
+type CommandSummarizer struct {
+	command string
+}
+
+// NewCommandSummarizer creates a summarizer running command with sh -c (empty uses DefaultCommand)
+func NewCommandSummarizer(command string) *CommandSummarizer {
+	if strings.TrimSpace(command) == "" {
+		command = DefaultCommand
+	}
+	return &CommandSummarizer{command: command}
+}
+
+// Summarize runs the command in dir with diff on stdin and returns what it printed, trimmed.
+// The command fails when it prints nothing.
+func (s *CommandSummarizer) Summarize(ctx context.Context, dir, diff string) (string, error) {
+	logging.Logger.Debug("Summarizing diff", "dir", dir, "command", s.command, "bytes", len(diff))
+
+	var stderr strings.Builder
+	cmd := exec.CommandContext(ctx, "sh", "-c", s.command)
+	cmd.Dir = dir
+	cmd.Stdin = strings.NewReader(diff)
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("summary command failed: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	summary := strings.TrimSpace(string(output))
+	if summary == "" {
+		return "", errors.New("summary command printed nothing")
+	}
+	return summary, nil
+}
@@ -0,0 +1,47 @@
+package summarizer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommandSummarizer_Summarize(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		want    string
+		wantErr string
+	}{
+		{name: "reads the diff on stdin", command: "grep -c '^+'", want: "2"},
+		{name: "trims the output", command: "printf '\\n  Adds a file.  \\n\\n'", want: "Adds a file."},
+		{name: "runs in the directory", command: "basename \"$PWD\"", want: "work"},
+		{name: "failing command", command: "echo 'not logged in' >&2; exit 1", wantErr: "not logged in"},
+		{name: "empty output", command: "true", wantErr: "printed nothing"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "work")
+			require.NoError(t, os.Mkdir(dir, 0755))
+
+			summary, err := NewCommandSummarizer(tt.command).Summarize(context.Background(), dir, "+one\n+two\n")
+
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, summary)
+		})
+	}
+}
+
+func TestNewCommandSummarizer_Default(t *testing.T) {
+	assert.Equal(t, DefaultCommand, NewCommandSummarizer(" ").command)
+}
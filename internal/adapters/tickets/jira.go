@@ -0,0 +1,89 @@
+package tickets
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// JiraClient implements ports.TicketTracker using the Jira Cloud REST API
+type JiraClient struct {
+	auth       string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// Verify interface compliance at compile time
+var _ ports.TicketTracker = (*JiraClient)(nil)
+
+// NewJiraClient creates a Jira client for a site such as https://acme.atlassian.net.
+// Jira Cloud authenticates with the account email and an API token.
+func NewJiraClient(baseURL, email, apiToken string) *JiraClient {
+	return &JiraClient{
+		auth:       "Basic " + base64.StdEncoding.EncodeToString([]byte(email+":"+apiToken)),
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// AddComment implements TicketTracker.AddComment
+func (c *JiraClient) AddComment(ctx context.Context, key, body string) error {
+	logging.Logger.Debug("Adding Jira comment", "key", key)
+	return doJSON(ctx, c.httpClient, http.MethodPost, c.issueURL(key, "comment"), c.headers(),
+		map[string]string{"body": body}, nil)
+}
+
+// TicketTitle implements TicketTracker.TicketTitle, returning the issue summary
+func (c *JiraClient) TicketTitle(ctx context.Context, key string) (string, error) {
+	var issue struct {
+		Fields struct {
+			Summary string `json:"summary"`
+		} `json:"fields"`
+	}
+	issueURL := fmt.Sprintf("%s/rest/api/2/issue/%s?fields=summary", c.baseURL, url.PathEscape(key))
+	if err := doJSON(ctx, c.httpClient, http.MethodGet, issueURL, c.headers(), nil, &issue); err != nil {
+		return "", fmt.Errorf("failed to get %s: %w", key, err)
+	}
+	return issue.Fields.Summary, nil
+}
+
+// TransitionTicket implements TicketTracker.TransitionTicket.
+// Jira moves issues through workflow transitions, so this picks the transition
+// whose name or target status matches state.
+func (c *JiraClient) TransitionTicket(ctx context.Context, key, state string) error {
+	var transitions struct {
+		Transitions []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+			To   struct {
+				Name string `json:"name"`
+			} `json:"to"`
+		} `json:"transitions"`
+	}
+	if err := doJSON(ctx, c.httpClient, http.MethodGet, c.issueURL(key, "transitions"), c.headers(), nil, &transitions); err != nil {
+		return fmt.Errorf("failed to list transitions for %s: %w", key, err)
+	}
+
+	for _, t := range transitions.Transitions {
+		if strings.EqualFold(t.To.Name, state) || strings.EqualFold(t.Name, state) {
+			logging.Logger.Debug("Transitioning Jira issue", "key", key, "transition", t.Name)
+			body := map[string]any{"transition": map[string]string{"id": t.ID}}
+			return doJSON(ctx, c.httpClient, http.MethodPost, c.issueURL(key, "transitions"), c.headers(), body, nil)
+		}
+	}
+	return fmt.Errorf("no transition to '%s' available for %s", state, key)
+}
+
+func (c *JiraClient) headers() map[string]string {
+	return map[string]string{"Authorization": c.auth}
+}
+
+func (c *JiraClient) issueURL(key, resource string) string {
+	return fmt.Sprintf("%s/rest/api/2/issue/%s/%s", c.baseURL, url.PathEscape(key), resource)
+}
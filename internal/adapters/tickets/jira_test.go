@@ -0,0 +1,106 @@
+package tickets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJiraTransitionTicket_PicksMatchingTransition(t *testing.T) {
+	var postedID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/ENG-1/transitions", r.URL.Path)
+		user, pass, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "me@acme.com", user)
+		assert.Equal(t, "token", pass)
+
+		if r.Method == http.MethodGet {
+			w.Write([]byte(`{"transitions": [
+				{"id": "11", "name": "Start", "to": {"name": "In Progress"}},
+				{"id": "21", "name": "Ask for review", "to": {"name": "In Review"}}
+			]}`))
+			return
+		}
+
+		var body struct {
+			Transition struct {
+				ID string `json:"id"`
+			} `json:"transition"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		postedID = body.Transition.ID
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL+"/", "me@acme.com", "token")
+	err := client.TransitionTicket(context.Background(), "ENG-1", "in review")
+
+	require.NoError(t, err)
+	assert.Equal(t, "21", postedID)
+}
+
+func TestJiraTransitionTicket_UnknownState(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"transitions": [{"id": "11", "name": "Start", "to": {"name": "In Progress"}}]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "me@acme.com", "token")
+	err := client.TransitionTicket(context.Background(), "ENG-1", "Done")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "no transition to 'Done'")
+}
+
+func TestJiraAddComment(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/rest/api/2/issue/ENG-1/comment", r.URL.Path)
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "me@acme.com", "token")
+	err := client.AddComment(context.Background(), "ENG-1", "PR ready")
+
+	require.NoError(t, err)
+	assert.Equal(t, "PR ready", body["body"])
+}
+
+func TestJiraAddComment_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"errorMessages": ["bad token"]}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "me@acme.com", "token")
+	err := client.AddComment(context.Background(), "ENG-1", "PR ready")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+}
+
+func TestJiraTicketTitle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/rest/api/2/issue/ENG-1", r.URL.Path)
+		assert.Equal(t, "summary", r.URL.Query().Get("fields"))
+		w.Write([]byte(`{"key": "ENG-1", "fields": {"summary": "Users cannot log in"}}`))
+	}))
+	defer server.Close()
+
+	client := NewJiraClient(server.URL, "me@acme.com", "token")
+	title, err := client.TicketTitle(context.Background(), "ENG-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Users cannot log in", title)
+}
@@ -0,0 +1,138 @@
+package tickets
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// DefaultLinearURL is the Linear GraphQL endpoint
+const DefaultLinearURL = "https://api.linear.app/graphql"
+
+// LinearClient implements ports.TicketTracker using the Linear GraphQL API
+type LinearClient struct {
+	apiKey     string
+	apiURL     string
+	httpClient *http.Client
+}
+
+// Verify interface compliance at compile time
+var _ ports.TicketTracker = (*LinearClient)(nil)
+
+// NewLinearClient creates a Linear client authenticated with a personal API key.
+// An empty apiURL uses DefaultLinearURL.
+func NewLinearClient(apiURL, apiKey string) *LinearClient {
+	if apiURL == "" {
+		apiURL = DefaultLinearURL
+	}
+	return &LinearClient{
+		apiKey:     apiKey,
+		apiURL:     apiURL,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+}
+
+// AddComment implements TicketTracker.AddComment
+func (c *LinearClient) AddComment(ctx context.Context, key, body string) error {
+	logging.Logger.Debug("Adding Linear comment", "key", key)
+	var result struct {
+		CommentCreate struct {
+			Success bool `json:"success"`
+		} `json:"commentCreate"`
+	}
+	err := c.query(ctx, `mutation($issueId: String!, $body: String!) {
+		commentCreate(input: {issueId: $issueId, body: $body}) { success }
+	}`, map[string]any{"issueId": key, "body": body}, &result)
+	if err != nil {
+		return err
+	}
+	if !result.CommentCreate.Success {
+		return fmt.Errorf("linear did not create comment on %s", key)
+	}
+	return nil
+}
+
+// TicketTitle implements TicketTracker.TicketTitle
+func (c *LinearClient) TicketTitle(ctx context.Context, key string) (string, error) {
+	var result struct {
+		Issue struct {
+			Title string `json:"title"`
+		} `json:"issue"`
+	}
+	err := c.query(ctx, `query($id: String!) { issue(id: $id) { title } }`, map[string]any{"id": key}, &result)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up %s: %w", key, err)
+	}
+	return result.Issue.Title, nil
+}
+
+// TransitionTicket implements TicketTracker.TransitionTicket.
+// Workflow states belong to the issue's team, so the state is looked up by name there.
+func (c *LinearClient) TransitionTicket(ctx context.Context, key, state string) error {
+	var issue struct {
+		Issue struct {
+			Team struct {
+				States struct {
+					Nodes []struct {
+						ID   string `json:"id"`
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"states"`
+			} `json:"team"`
+		} `json:"issue"`
+	}
+	err := c.query(ctx, `query($id: String!) {
+		issue(id: $id) { team { states { nodes { id name } } } }
+	}`, map[string]any{"id": key}, &issue)
+	if err != nil {
+		return fmt.Errorf("failed to look up %s: %w", key, err)
+	}
+
+	for _, s := range issue.Issue.Team.States.Nodes {
+		if !strings.EqualFold(s.Name, state) {
+			continue
+		}
+		logging.Logger.Debug("Transitioning Linear issue", "key", key, "state", s.Name)
+		var result struct {
+			IssueUpdate struct {
+				Success bool `json:"success"`
+			} `json:"issueUpdate"`
+		}
+		err := c.query(ctx, `mutation($id: String!, $stateId: String!) {
+			issueUpdate(id: $id, input: {stateId: $stateId}) { success }
+		}`, map[string]any{"id": key, "stateId": s.ID}, &result)
+		if err != nil {
+			return err
+		}
+		if !result.IssueUpdate.Success {
+			return fmt.Errorf("linear did not update %s", key)
+		}
+		return nil
+	}
+	return fmt.Errorf("no state named '%s' in the team of %s", state, key)
+}
+
+// query runs a GraphQL request and decodes its data into out
+func (c *LinearClient) query(ctx context.Context, query string, variables map[string]any, out any) error {
+	var resp struct {
+		Data   any `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	resp.Data = out
+
+	body := map[string]any{"query": query, "variables": variables}
+	headers := map[string]string{"Authorization": c.apiKey}
+	if err := doJSON(ctx, c.httpClient, http.MethodPost, c.apiURL, headers, body, &resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		return fmt.Errorf("linear API error: %s", resp.Errors[0].Message)
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package tickets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// graphQLRequest is the body the Linear client POSTs
+type graphQLRequest struct {
+	Query     string         `json:"query"`
+	Variables map[string]any `json:"variables"`
+}
+
+func TestLinearTransitionTicket(t *testing.T) {
+	var updatedStateID any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "lin_api_key", r.Header.Get("Authorization"))
+
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		if strings.Contains(req.Query, "issueUpdate") {
+			updatedStateID = req.Variables["stateId"]
+			w.Write([]byte(`{"data": {"issueUpdate": {"success": true}}}`))
+			return
+		}
+		assert.Equal(t, "ENG-7", req.Variables["id"])
+		w.Write([]byte(`{"data": {"issue": {"team": {"states": {"nodes": [
+			{"id": "s1", "name": "In Progress"},
+			{"id": "s2", "name": "In Review"}
+		]}}}}}`))
+	}))
+	defer server.Close()
+
+	client := NewLinearClient(server.URL, "lin_api_key")
+	err := client.TransitionTicket(context.Background(), "ENG-7", "In Review")
+
+	require.NoError(t, err)
+	assert.Equal(t, "s2", updatedStateID)
+}
+
+func TestLinearAddComment_GraphQLError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"errors": [{"message": "Entity not found"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewLinearClient(server.URL, "lin_api_key")
+	err := client.AddComment(context.Background(), "ENG-7", "PR ready")
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Entity not found")
+}
+
+func TestLinearTicketTitle(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req graphQLRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		assert.Equal(t, "ENG-7", req.Variables["id"])
+		w.Write([]byte(`{"data": {"issue": {"title": "Users cannot log in"}}}`))
+	}))
+	defer server.Close()
+
+	client := NewLinearClient(server.URL, "lin_api_key")
+	title, err := client.TicketTitle(context.Background(), "ENG-7")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Users cannot log in", title)
+}
@@ -2,34 +2,24 @@ package tmux
 
 import (
 	"fmt"
-	"io"
-	"os"
 	"os/exec"
+	"slices"
+	"strconv"
 	"strings"
-	"sync"
 	"time"
 
-	"github.com/creack/pty"
-
+	"github.com/renato0307/rocha/internal/adapters/multiplexer"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 )
 
-// attachmentState tracks the state of an attached session
-type attachmentState struct {
-	ptmx     *os.File
-	attachCh chan struct{}
-	mu       sync.Mutex
-}
-
 // DefaultClient is the default implementation of the Client interface
 type DefaultClient struct {
-	attachedSessions map[string]*attachmentState
-	mu               sync.Mutex
+	attachments *multiplexer.Attachments
 }
 
 // Compile-time interface verification
-var _ ports.TmuxClient = (*DefaultClient)(nil)
+var _ ports.SessionManager = (*DefaultClient)(nil)
 
 // Local error aliases for backward compatibility within this package
 var (
@@ -37,12 +27,13 @@ var (
 	ErrNotAttached     = ports.ErrTmuxNotAttached
 	ErrSessionExists   = ports.ErrTmuxSessionExists
 	ErrSessionNotFound = ports.ErrTmuxSessionNotFound
+	ErrUnavailable     = ports.ErrTmuxUnavailable
 )
 
 // NewClient creates a new DefaultClient instance
 func NewClient() *DefaultClient {
 	return &DefaultClient{
-		attachedSessions: make(map[string]*attachmentState),
+		attachments: multiplexer.NewAttachments(),
 	}
 }
 
@@ -53,10 +44,7 @@ func (c *DefaultClient) createBaseSession(name string, worktreePath string, stat
 		return ErrSessionExists
 	}
 
-	shell := os.Getenv("SHELL")
-	if shell == "" {
-		shell = "bash"
-	}
+	shell := multiplexer.Shell()
 
 	var cmd *exec.Cmd
 	if worktreePath != "" {
@@ -66,10 +54,10 @@ func (c *DefaultClient) createBaseSession(name string, worktreePath string, stat
 	}
 
 	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to create tmux session: %w", err)
+		return fmt.Errorf("failed to create tmux session: %w", tmuxError(err))
 	}
 
-	if err := c.BindKey("root", "C-q", "detach-client"); err != nil {
+	if err := c.bindDetachKey(); err != nil {
 		logging.Logger.Warn("Failed to bind Ctrl+Q key", "error", err)
 	}
 
@@ -102,6 +90,36 @@ func (c *DefaultClient) createBaseSession(name string, worktreePath string, stat
 	}
 }
 
+// returnSessionOption is the session option holding the session to return to on Ctrl+Q
+// It is set by SwitchClient so Ctrl+Q switches back instead of detaching the client
+const returnSessionOption = "@rocha_return_session"
+
+// linkedWindowOption is the session option holding the window OpenInWindow linked into it
+// Ctrl+Q in that window unlinks it, leaving the session it belongs to running
+const linkedWindowOption = "@rocha_linked_window"
+
+// bindDetachKey binds Ctrl+Q to return to the session list
+// Unlinks a window opened by OpenInWindow, switches back to the recorded return session
+// if set, otherwise detaches the client
+func (c *DefaultClient) bindDetachKey() error {
+	script := `current=$(tmux display-message -p "#{session_name}")
+linked=$(tmux show-options -v -t "$current" ` + linkedWindowOption + ` 2>/dev/null)
+ret=$(tmux show-options -v -t "$current" ` + returnSessionOption + ` 2>/dev/null)
+if [ -n "$linked" ] && [ "$linked" = "$(tmux display-message -p "#{window_id}")" ]; then
+    tmux set-option -u -t "$current" ` + linkedWindowOption + `
+    tmux unlink-window -t "$current:$linked"
+elif [ -n "$ret" ] && tmux has-session -t "$ret" 2>/dev/null; then
+    tmux set-option -u -t "$current" ` + returnSessionOption + `
+    tmux switch-client -t "$ret"
+else
+    tmux detach-client
+fi
+`
+
+	command := fmt.Sprintf("run-shell '%s'", script)
+	return c.BindKey("root", "C-q", command)
+}
+
 // bindSwapKey binds Ctrl+] to swap between Claude and shell sessions
 func (c *DefaultClient) bindSwapKey() error {
 	// Construct the bash script that will swap between sessions
@@ -141,68 +159,41 @@ func (c *DefaultClient) CreateSession(name string, worktreePath string, claudeDi
 		return nil, err
 	}
 
-	// Start Claude in the session
-	rochaBin, err := os.Executable()
-	if err != nil {
-		rochaBin = "rocha"
-		logging.Logger.Warn("Could not get rocha executable path, using PATH", "error", err)
-	}
-	logging.Logger.Debug("Rocha binary path", "path", rochaBin)
+	c.startClaude(name, worktreePath, claudeDir, multiplexer.PromptArg(initialPrompt))
 
-	// Set session name in environment and start claude with hooks
-	envVars := fmt.Sprintf("ROCHA_SESSION_NAME=%s", name)
+	return &ports.TmuxSession{
+		Name:      name,
+		CreatedAt: time.Now(),
+	}, nil
+}
 
-	// Add CLAUDE_CONFIG_DIR if specified
-	if claudeDir != "" {
-		envVars += fmt.Sprintf(" CLAUDE_CONFIG_DIR=%q", claudeDir)
-		logging.Logger.Info("Setting CLAUDE_CONFIG_DIR for session", "claude_dir", claudeDir)
-	}
+// ResumeSession creates a new tmux session that resumes an earlier Claude conversation
+// claudeSessionID is the conversation ID recorded from Claude's SessionStart hook
+func (c *DefaultClient) ResumeSession(name string, worktreePath string, claudeDir string, statusPosition string, claudeSessionID string) (*ports.TmuxSession, error) {
+	logging.Logger.Info("Creating tmux session resuming Claude conversation", "name", name, "worktree_path", worktreePath, "claude_session_id", claudeSessionID)
 
-	// Add debug environment variables if set
-	if debugEnabled := os.Getenv("ROCHA_DEBUG"); debugEnabled == "1" {
-		envVars += " ROCHA_DEBUG=1"
-		if debugFile := os.Getenv("ROCHA_DEBUG_FILE"); debugFile != "" {
-			envVars += fmt.Sprintf(" ROCHA_DEBUG_FILE=%q", debugFile)
-		}
-		if maxLogFiles := os.Getenv("ROCHA_MAX_LOG_FILES"); maxLogFiles != "" {
-			envVars += fmt.Sprintf(" ROCHA_MAX_LOG_FILES=%s", maxLogFiles)
-		}
+	if err := c.createBaseSession(name, worktreePath, statusPosition); err != nil {
+		return nil, err
 	}
 
-	// Add execution ID if set
-	if execID := os.Getenv("ROCHA_EXECUTION_ID"); execID != "" {
-		envVars += fmt.Sprintf(" ROCHA_EXECUTION_ID=%s", execID)
-	}
+	c.startClaude(name, worktreePath, claudeDir, multiplexer.ResumeArg(claudeSessionID))
 
-	// Build start-claude command, optionally with initial prompt as positional argument
-	var promptArg string
-	if initialPrompt != "" {
-		// Shell escape the prompt using $'...' syntax for proper escaping of special chars
-		escapedPrompt := strings.ReplaceAll(initialPrompt, "\\", "\\\\")
-		escapedPrompt = strings.ReplaceAll(escapedPrompt, "'", "\\'")
-		escapedPrompt = strings.ReplaceAll(escapedPrompt, "\n", "\\n")
-		promptArg = fmt.Sprintf(" $'%s'", escapedPrompt)
-		logging.Logger.Debug("Initial prompt will be sent to Claude", "prompt_length", len(initialPrompt))
-	}
+	return &ports.TmuxSession{
+		Name:      name,
+		CreatedAt: time.Now(),
+	}, nil
+}
 
-	var startCmd string
-	if worktreePath != "" {
-		logging.Logger.Info("Starting Claude in worktree directory", "path", worktreePath)
-		startCmd = fmt.Sprintf("cd %q && clear && %s %s start-claude%s", worktreePath, envVars, rochaBin, promptArg)
-	} else {
-		startCmd = fmt.Sprintf("clear && %s %s start-claude%s", envVars, rochaBin, promptArg)
-	}
+// startClaude sends the rocha start-claude command to a freshly created session
+// startArgs is appended verbatim to the start-claude command and must already be shell escaped
+func (c *DefaultClient) startClaude(name string, worktreePath string, claudeDir string, startArgs string) {
+	startCmd := multiplexer.AgentStartCommand(name, worktreePath, claudeDir, startArgs)
 	logging.Logger.Debug("Sending start command to session", "command", startCmd)
 	if err := c.SendKeys(name, startCmd, "Enter"); err != nil {
 		logging.Logger.Error("Failed to send start command", "error", err)
 	} else {
 		logging.Logger.Info("Session created and Claude started", "name", name)
 	}
-
-	return &ports.TmuxSession{
-		Name:      name,
-		CreatedAt: time.Now(),
-	}, nil
 }
 
 // CreateShellSession creates a plain shell session without rocha start-claude
@@ -225,7 +216,7 @@ func (c *DefaultClient) SessionExists(name string) bool {
 
 // ListSessions returns all active tmux sessions
 func (c *DefaultClient) ListSessions() ([]*ports.TmuxSession, error) {
-	cmd := exec.Command("tmux", "ls", "-F", "#{session_name}")
+	cmd := exec.Command("tmux", "ls", "-F", "#{session_name}\t#{session_created}\t#{session_attached}")
 	output, err := cmd.Output()
 	if err != nil {
 		// Check if it's because there are no sessions (exit code 1)
@@ -236,28 +227,49 @@ func (c *DefaultClient) ListSessions() ([]*ports.TmuxSession, error) {
 			}
 		}
 		// Actual error
-		return []*ports.TmuxSession{}, err
+		return []*ports.TmuxSession{}, tmuxError(err)
 	}
 
+	return parseSessionList(string(output)), nil
+}
+
+// parseSessionList parses "tmux ls" output formatted as name, creation time (Unix seconds),
+// and number of attached clients, separated by tabs
+func parseSessionList(output string) []*ports.TmuxSession {
 	var sessions []*ports.TmuxSession
-	lines := splitLines(string(output))
-	for _, line := range lines {
-		line = strings.TrimSpace(line)
-		if line != "" {
-			sessions = append(sessions, &ports.TmuxSession{
-				Name:      line,
-				CreatedAt: time.Now(), // We don't track creation time for existing sessions
-			})
+	for _, line := range splitLines(output) {
+		fields := strings.Split(strings.TrimSpace(line), "\t")
+		if fields[0] == "" {
+			continue
 		}
-	}
 
-	return sessions, nil
+		session := &ports.TmuxSession{Name: fields[0], CreatedAt: time.Now()}
+		if len(fields) == 3 {
+			if created, err := strconv.ParseInt(fields[1], 10, 64); err == nil {
+				session.CreatedAt = time.Unix(created, 0)
+			}
+			session.Attached = fields[2] != "" && fields[2] != "0"
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions
 }
 
 // KillSession terminates the tmux session
 func (c *DefaultClient) KillSession(name string) error {
 	cmd := exec.Command("tmux", "kill-session", "-t", name)
-	return cmd.Run()
+	return tmuxError(cmd.Run())
+}
+
+// RequestAgentExit asks Claude to quit on its own by interrupting any running
+// turn and typing /exit. It does not wait for the agent to stop.
+func (c *DefaultClient) RequestAgentExit(name string) error {
+	for _, keys := range [][]string{{"Escape"}, {"/exit"}, {"C-m"}} {
+		if err := c.SendKeys(name, keys...); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // RenameSession renames a tmux session
@@ -287,106 +299,90 @@ func (c *DefaultClient) RenameSession(oldName, newName string) error {
 
 // Attach attaches to the tmux session. Returns a channel that will be closed when detached.
 func (c *DefaultClient) Attach(sessionName string) (chan struct{}, error) {
-	c.mu.Lock()
-	state, exists := c.attachedSessions[sessionName]
-	if !exists {
-		state = &attachmentState{}
-		c.attachedSessions[sessionName] = state
-	}
-	c.mu.Unlock()
-
-	state.mu.Lock()
-	defer state.mu.Unlock()
+	return c.attachments.Attach(sessionName, exec.Command("tmux", "attach-session", "-t", sessionName))
+}
 
-	if state.attachCh != nil {
-		return nil, ErrAlreadyAttached
-	}
+// Detach detaches from the tmux session
+func (c *DefaultClient) Detach(sessionName string) error {
+	return c.attachments.Detach(sessionName)
+}
 
-	// Start tmux attach command with PTY
+// GetAttachCommand returns an exec.Cmd configured for attaching to a session.
+// This is useful for integration with frameworks like Bubble Tea's tea.ExecProcess.
+// It unsets TMUX and TMUX_PANE environment variables to allow attaching from within tmux.
+func (c *DefaultClient) GetAttachCommand(sessionName string) *exec.Cmd {
 	cmd := exec.Command("tmux", "attach-session", "-t", sessionName)
-	ptmx, err := pty.Start(cmd)
-	if err != nil {
-		return nil, fmt.Errorf("failed to attach to session: %w", err)
-	}
-
-	state.ptmx = ptmx
-	state.attachCh = make(chan struct{})
-
-	// Copy tmux output to stdout
-	go func() {
-		io.Copy(os.Stdout, ptmx)
-	}()
-
-	// Read stdin and forward to tmux, watch for Ctrl+Q (ASCII 17) to detach
-	go func() {
-		buf := make([]byte, 1024)
-		for {
-			n, err := os.Stdin.Read(buf)
-			if err != nil {
-				break
-			}
-
-			// Check for Ctrl+Q (ASCII 17)
-			for i := 0; i < n; i++ {
-				if buf[i] == 17 { // Ctrl+Q
-					c.Detach(sessionName)
-					return
-				}
-			}
 
-			// Forward to tmux
-			ptmx.Write(buf[:n])
-		}
-	}()
+	// Copy current environment and remove TMUX variables to allow nested attach
+	cmd.Env = multiplexer.CleanEnv("TMUX=", "TMUX_PANE=")
 
-	return state.attachCh, nil
+	return cmd
 }
 
-// Detach detaches from the tmux session
-func (c *DefaultClient) Detach(sessionName string) error {
-	c.mu.Lock()
-	state, exists := c.attachedSessions[sessionName]
-	c.mu.Unlock()
-
-	if !exists {
-		return ErrNotAttached
+// OpenInWindow shows the window of the session in the current tmux session.
+// The window is linked rather than attached to, so tmux is not nested; Ctrl+Q unlinks it.
+func (c *DefaultClient) OpenInWindow(sessionName string) error {
+	current, err := exec.Command("tmux", "display-message", "-p", "#{session_name}").Output()
+	if err != nil {
+		return fmt.Errorf("failed to get current tmux session: %w", tmuxError(err))
+	}
+	currentName := strings.TrimSpace(string(current))
+	window, err := exec.Command("tmux", "display-message", "-p", "-t", sessionName+":", "#{window_id}").Output()
+	if err != nil {
+		return fmt.Errorf("failed to get window of session %s: %w", sessionName, tmuxError(err))
+	}
+	linked, err := exec.Command("tmux", "list-windows", "-t", currentName+":", "-F", "#{window_id}").Output()
+	if err != nil {
+		return fmt.Errorf("failed to list windows of current tmux session: %w", tmuxError(err))
 	}
 
-	state.mu.Lock()
-	defer state.mu.Unlock()
-
-	if state.attachCh == nil {
-		return ErrNotAttached
+	windowID := strings.TrimSpace(string(window))
+	args := openWindowArgs(currentName, windowID, strings.Fields(string(linked)))
+	if output, err := exec.Command("tmux", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to open session in new window: %w (output: %s)", tmuxError(err), string(output))
+	}
+	if err := c.SetOption(currentName, linkedWindowOption, windowID); err != nil {
+		logging.Logger.Warn("Failed to record linked window, Ctrl+Q will detach", "error", err)
 	}
+	return nil
+}
 
-	if state.ptmx != nil {
-		state.ptmx.Close()
-		state.ptmx = nil
+// openWindowArgs returns the tmux arguments that show window in the current session:
+// selecting it when it is already linked there, linking it after the last window otherwise
+func openWindowArgs(current, window string, linked []string) []string {
+	if slices.Contains(linked, window) {
+		return []string{"select-window", "-t", current + ":" + window}
 	}
+	return []string{"link-window", "-s", window, "-t", current + ":"}
+}
 
-	close(state.attachCh)
-	state.attachCh = nil
+// RunInWindow runs a shell command in a new background window of the session.
+// The window closes when the command exits.
+func (c *DefaultClient) RunInWindow(sessionName, windowName, dir, command string) error {
+	logging.Logger.Info("Running command in tmux window", "session", sessionName, "window", windowName, "dir", dir)
 
+	cmd := exec.Command("tmux", "new-window", "-d", "-t", sessionName+":", "-n", windowName, "-c", dir, command)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to open window: %w (output: %s)", tmuxError(err), string(output))
+	}
 	return nil
 }
 
-// GetAttachCommand returns an exec.Cmd configured for attaching to a session.
-// This is useful for integration with frameworks like Bubble Tea's tea.ExecProcess.
-// It unsets TMUX and TMUX_PANE environment variables to allow attaching from within tmux.
-func (c *DefaultClient) GetAttachCommand(sessionName string) *exec.Cmd {
-	cmd := exec.Command("tmux", "attach-session", "-t", sessionName)
-
-	// Copy current environment and remove TMUX variables to allow nested attach
-	env := os.Environ()
-	var cleanEnv []string
-	for _, e := range env {
-		if !strings.HasPrefix(e, "TMUX=") && !strings.HasPrefix(e, "TMUX_PANE=") {
-			cleanEnv = append(cleanEnv, e)
-		}
+// SwitchClient switches the current tmux client to the session.
+// The current session is recorded so Ctrl+Q in the target switches back to it.
+func (c *DefaultClient) SwitchClient(sessionName string) error {
+	current, err := exec.Command("tmux", "display-message", "-p", "#{session_name}").Output()
+	if err != nil {
+		logging.Logger.Warn("Failed to get current tmux session, Ctrl+Q will detach", "error", err)
+	} else if err := c.SetOption(sessionName, returnSessionOption, strings.TrimSpace(string(current))); err != nil {
+		logging.Logger.Warn("Failed to record return session", "error", err)
 	}
-	cmd.Env = cleanEnv
 
-	return cmd
+	cmd := exec.Command("tmux", "switch-client", "-t", sessionName)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to switch client: %w (output: %s)", err, string(output))
+	}
+	return nil
 }
 
 // SendKeys sends keystrokes to the specified tmux session
@@ -394,7 +390,7 @@ func (c *DefaultClient) SendKeys(sessionName string, keys ...string) error {
 	args := []string{"send-keys", "-t", sessionName}
 	args = append(args, keys...)
 	cmd := exec.Command("tmux", args...)
-	return cmd.Run()
+	return tmuxError(cmd.Run())
 }
 
 // CapturePane captures the content of the tmux pane
@@ -402,11 +398,39 @@ func (c *DefaultClient) CapturePane(sessionName string, startLine int) (string,
 	cmd := exec.Command("tmux", "capture-pane", "-p", "-t", sessionName, "-S", fmt.Sprintf("%d", startLine))
 	output, err := cmd.Output()
 	if err != nil {
-		return "", err
+		return "", tmuxError(err)
 	}
 	return string(output), nil
 }
 
+// PanePIDs returns the process of the first pane of every tmux session
+func (c *DefaultClient) PanePIDs() (map[string]int, error) {
+	output, err := exec.Command("tmux", "list-panes", "-a", "-F", "#{session_name}\t#{pane_pid}").Output()
+	if err != nil {
+		return nil, tmuxError(err)
+	}
+	return parsePanePIDs(string(output)), nil
+}
+
+// parsePanePIDs parses "list-panes -a" output with tab-separated session names and pane PIDs
+func parsePanePIDs(output string) map[string]int {
+	pids := make(map[string]int)
+	for _, line := range splitLines(output) {
+		name, pidText, ok := strings.Cut(line, "\t")
+		if !ok {
+			continue
+		}
+		pid, err := strconv.Atoi(pidText)
+		if err != nil {
+			continue
+		}
+		if _, exists := pids[name]; !exists {
+			pids[name] = pid
+		}
+	}
+	return pids
+}
+
 // SourceFile sources a tmux configuration file
 func (c *DefaultClient) SourceFile(configPath string) error {
 	cmd := exec.Command("tmux", "source-file", configPath)
@@ -428,6 +452,11 @@ func (c *DefaultClient) SetOption(sessionName, option, value string) error {
 	return nil
 }
 
+// tmuxError marks failures caused by a missing tmux binary with ErrUnavailable
+func tmuxError(err error) error {
+	return multiplexer.CommandError(err)
+}
+
 // splitLines splits a string into lines
 func splitLines(s string) []string {
 	var lines []string
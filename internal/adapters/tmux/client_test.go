@@ -0,0 +1,51 @@
+package tmux
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSessionList(t *testing.T) {
+	sessions := parseSessionList("api\t1760000000\t1\napi-shell\t1760000060\t0\n\n")
+
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "api", sessions[0].Name)
+	assert.True(t, sessions[0].Attached)
+	assert.Equal(t, time.Unix(1760000000, 0), sessions[0].CreatedAt)
+	assert.Equal(t, "api-shell", sessions[1].Name)
+	assert.False(t, sessions[1].Attached)
+}
+
+func TestParsePanePIDs(t *testing.T) {
+	output := "api\t4242\napi\t4300\nmy docs\t4250\nbroken\tx\n\n"
+
+	assert.Equal(t, map[string]int{"api": 4242, "my docs": 4250}, parsePanePIDs(output))
+}
+
+func TestOpenWindowArgs(t *testing.T) {
+	tests := []struct {
+		name   string
+		linked []string
+		want   []string
+	}{
+		{
+			name:   "not linked yet",
+			linked: []string{"@1", "@2"},
+			want:   []string{"link-window", "-s", "@7", "-t", "main:"},
+		},
+		{
+			name:   "already linked",
+			linked: []string{"@1", "@7"},
+			want:   []string{"select-window", "-t", "main:@7"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, openWindowArgs("main", "@7", tt.linked))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package tmux
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/adapters/multiplexer"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// guestSessionName is the only session of a guest server
+const guestSessionName = "share"
+
+// StartGuestServer starts a tmux server of its own that a teammate attaches to sessionName through.
+// Its only pane is a client of the session, read-only when readOnly, and guest is the one other
+// account tmux lets in, with the same access; an empty guest keeps it to the sharer's account.
+// The server stops itself at until. Returns the socket of the server.
+func (c *DefaultClient) StartGuestServer(id, sessionName, guest string, readOnly bool, until time.Time) (string, error) {
+	mainSocket, err := c.serverSocket()
+	if err != nil {
+		return "", err
+	}
+
+	socket := filepath.Join(os.TempDir(), "rocha-share-"+id+".sock")
+	attach := []string{"env", "-u", "TMUX", "tmux", "-S", mainSocket, "attach-session", "-t", "=" + sessionName}
+	if readOnly {
+		attach = append(attach, "-r")
+	}
+	seconds := int(math.Ceil(time.Until(until).Seconds()))
+	// The sleeper stops the server when the share expires, even if no one revokes it
+	script := fmt.Sprintf("(sleep %d; tmux -S %s kill-server; rm -f %s) >/dev/null 2>&1 & exec %s",
+		seconds, domain.ShellQuote(socket), domain.ShellQuote(socket), domain.ShellJoin(attach...))
+
+	// Given as separate arguments, the command runs without the user's default shell
+	cmd := exec.Command("tmux", "-S", socket, "new-session", "-d", "-s", guestSessionName, "sh", "-c", script)
+	cmd.Env = multiplexer.CleanEnv("TMUX=", "TMUX_PANE=")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("failed to start share server: %w: %s", tmuxError(err), strings.TrimSpace(string(output)))
+	}
+
+	if err := c.configureGuestServer(socket, guest, readOnly); err != nil {
+		if stopErr := c.StopGuestServer(socket); stopErr != nil {
+			logging.Logger.Warn("Failed to stop share server", "socket", socket, "error", stopErr)
+		}
+		return "", err
+	}
+	return socket, nil
+}
+
+// configureGuestServer hides the status bar of a guest server and lets guest in
+func (c *DefaultClient) configureGuestServer(socket, guest string, readOnly bool) error {
+	commands := [][]string{{"set-option", "-g", "status", "off"}}
+	if guest != "" {
+		access := "-w"
+		if readOnly {
+			access = "-r"
+		}
+		commands = append(commands, []string{"server-access", "-a", guest}, []string{"server-access", access, guest})
+
+		// tmux checks the account itself; the socket only has to be reachable
+		if err := os.Chmod(socket, 0o666); err != nil {
+			return fmt.Errorf("failed to open share server socket: %w", err)
+		}
+	}
+
+	for _, args := range commands {
+		output, err := exec.Command("tmux", append([]string{"-S", socket}, args...)...).CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("failed to configure share server (%s): %w: %s", args[0], err, strings.TrimSpace(string(output)))
+		}
+	}
+	return nil
+}
+
+// StopGuestServer stops a guest server, detaching the teammate. A server already gone is not an error.
+func (c *DefaultClient) StopGuestServer(socket string) error {
+	if _, err := os.Stat(socket); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	// kill-server fails when the server already stopped; the socket is removed either way
+	if output, err := exec.Command("tmux", "-S", socket, "kill-server").CombinedOutput(); err != nil {
+		logging.Logger.Debug("Share server was not running", "socket", socket, "output", strings.TrimSpace(string(output)))
+	}
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove share server socket: %w", err)
+	}
+	return nil
+}
+
+// GetGuestAttachCommand returns an exec.Cmd that attaches a teammate to the guest server at socket.
+// The server enforces the access of the share; read-only only spares the teammate the refusals.
+func (c *DefaultClient) GetGuestAttachCommand(socket string, readOnly bool) (*exec.Cmd, error) {
+	cmd := exec.Command("tmux", "-S", socket, "attach-session", "-t", "="+guestSessionName)
+	if readOnly {
+		cmd.Args = append(cmd.Args, "-r")
+	}
+	cmd.Env = multiplexer.CleanEnv("TMUX=", "TMUX_PANE=")
+	return cmd, nil
+}
+
+// serverSocket returns the socket of the tmux server sessions run in
+func (c *DefaultClient) serverSocket() (string, error) {
+	output, err := exec.Command("tmux", "display-message", "-p", "#{socket_path}").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to find tmux server socket: %w", tmuxError(err))
+	}
+	return strings.TrimSpace(string(output)), nil
+}
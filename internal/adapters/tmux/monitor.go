@@ -9,7 +9,7 @@ import (
 
 // Monitor watches a tmux session for Claude prompts
 type Monitor struct {
-	client       ports.TmuxClient
+	client       ports.SessionManager
 	isWaiting    bool
 	lastContent  string
 	notifyCh     chan string
@@ -18,7 +18,7 @@ type Monitor struct {
 }
 
 // NewMonitor creates a new session monitor
-func NewMonitor(client ports.TmuxClient, sessionName string, notifyCh chan string) *Monitor {
+func NewMonitor(client ports.SessionManager, sessionName string, notifyCh chan string) *Monitor {
 	return &Monitor{
 		client:      client,
 		sessionName: sessionName,
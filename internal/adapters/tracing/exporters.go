@@ -0,0 +1,62 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// NewOTLPExporter creates an exporter posting to an OTLP/HTTP traces endpoint, such as an
+// OpenTelemetry Collector or Jaeger. The OTEL_EXPORTER_OTLP_* variables configure it;
+// a non-empty endpoint (e.g. http://localhost:4318/v1/traces) or headers take precedence.
+func NewOTLPExporter(ctx context.Context, endpoint string, headers map[string]string) (sdktrace.SpanExporter, error) {
+	var opts []otlptracehttp.Option
+	if endpoint != "" {
+		opts = append(opts, otlptracehttp.WithEndpointURL(endpoint))
+	}
+	if len(headers) > 0 {
+		opts = append(opts, otlptracehttp.WithHeaders(headers))
+	}
+
+	exporter, err := otlptracehttp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+	}
+	return exporter, nil
+}
+
+// fileExporter writes spans to a file it closes on shutdown
+type fileExporter struct {
+	*stdouttrace.Exporter
+	file *os.File
+}
+
+// NewFileExporter creates an exporter appending one JSON span per line to path
+func NewFileExporter(path string) (sdktrace.SpanExporter, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create trace directory: %w", err)
+	}
+	// Several rocha processes may append at once; each span is written with one call
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trace file: %w", err)
+	}
+
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to create file trace exporter: %w", err)
+	}
+	return &fileExporter{Exporter: exporter, file: file}, nil
+}
+
+// Shutdown implements sdktrace.SpanExporter.Shutdown
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	return errors.Join(e.Exporter.Shutdown(ctx), e.file.Close())
+}
@@ -0,0 +1,191 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// GitRepository traces the git operations of a ports.GitRepository that take a context,
+// as children of the span of that context. Operations without a context are traced by
+// the services calling them.
+type GitRepository struct {
+	ports.GitRepository
+	tracer ports.Tracer
+}
+
+// NewGitRepository wraps repo so its operations are traced with tracer
+func NewGitRepository(repo ports.GitRepository, tracer ports.Tracer) *GitRepository {
+	return &GitRepository{GitRepository: repo, tracer: tracer}
+}
+
+// start begins the span of a git operation on path
+func (r *GitRepository) start(ctx context.Context, operation, path string) (context.Context, ports.Span) {
+	ctx, span := r.tracer.Start(ctx, "git."+operation)
+	span.SetAttribute("git.path", path)
+	return ctx, span
+}
+
+// AbortRebase implements ports.BranchSyncer.AbortRebase
+func (r *GitRepository) AbortRebase(ctx context.Context, worktreePath string) error {
+	ctx, span := r.start(ctx, "abort_rebase", worktreePath)
+	err := r.GitRepository.AbortRebase(ctx, worktreePath)
+	span.End(err)
+	return err
+}
+
+// BranchDiff implements ports.GitStatsProvider.BranchDiff
+func (r *GitRepository) BranchDiff(ctx context.Context, path, baseBranch string) (string, error) {
+	ctx, span := r.start(ctx, "branch_diff", path)
+	patch, err := r.GitRepository.BranchDiff(ctx, path, baseBranch)
+	span.End(err)
+	return patch, err
+}
+
+// Diff implements ports.GitStatsProvider.Diff
+func (r *GitRepository) Diff(ctx context.Context, path, since string) (string, error) {
+	ctx, span := r.start(ctx, "diff", path)
+	patch, err := r.GitRepository.Diff(ctx, path, since)
+	span.End(err)
+	return patch, err
+}
+
+// FetchAllPRs implements ports.PRInfoProvider.FetchAllPRs
+func (r *GitRepository) FetchAllPRs(ctx context.Context, repoPath string) (map[string]*domain.PRInfo, error) {
+	ctx, span := r.start(ctx, "fetch_all_prs", repoPath)
+	prs, err := r.GitRepository.FetchAllPRs(ctx, repoPath)
+	span.End(err)
+	return prs, err
+}
+
+// FetchBase implements ports.BranchSyncer.FetchBase
+func (r *GitRepository) FetchBase(ctx context.Context, worktreePath, baseBranch string) error {
+	ctx, span := r.start(ctx, "fetch_base", worktreePath)
+	err := r.GitRepository.FetchBase(ctx, worktreePath, baseBranch)
+	span.End(err)
+	return err
+}
+
+// FetchGitStats implements ports.GitStatsProvider.FetchGitStats
+func (r *GitRepository) FetchGitStats(ctx context.Context, worktreePath, baseBranch string) (*domain.GitStats, error) {
+	ctx, span := r.start(ctx, "fetch_stats", worktreePath)
+	stats, err := r.GitRepository.FetchGitStats(ctx, worktreePath, baseBranch)
+	span.End(err)
+	return stats, err
+}
+
+// FetchPRInfo implements ports.PRInfoProvider.FetchPRInfo
+func (r *GitRepository) FetchPRInfo(ctx context.Context, worktreePath, branchName string) (*domain.PRInfo, error) {
+	ctx, span := r.start(ctx, "fetch_pr_info", worktreePath)
+	pr, err := r.GitRepository.FetchPRInfo(ctx, worktreePath, branchName)
+	span.End(err)
+	return pr, err
+}
+
+// FetchRemote implements ports.BranchSyncer.FetchRemote
+func (r *GitRepository) FetchRemote(ctx context.Context, repoPath string) error {
+	ctx, span := r.start(ctx, "fetch_remote", repoPath)
+	err := r.GitRepository.FetchRemote(ctx, repoPath)
+	span.End(err)
+	return err
+}
+
+// GetWorktreeStatus implements ports.WorktreeManager.GetWorktreeStatus
+func (r *GitRepository) GetWorktreeStatus(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error) {
+	ctx, span := r.start(ctx, "worktree_status", worktreePath)
+	status, err := r.GitRepository.GetWorktreeStatus(ctx, worktreePath)
+	span.End(err)
+	return status, err
+}
+
+// ListBranches implements ports.BranchSwitcher.ListBranches
+func (r *GitRepository) ListBranches(ctx context.Context, worktreePath string) ([]domain.Branch, error) {
+	ctx, span := r.start(ctx, "list_branches", worktreePath)
+	branches, err := r.GitRepository.ListBranches(ctx, worktreePath)
+	span.End(err)
+	return branches, err
+}
+
+// ListChangedFiles implements ports.GitStatsProvider.ListChangedFiles
+func (r *GitRepository) ListChangedFiles(ctx context.Context, path string) ([]string, error) {
+	ctx, span := r.start(ctx, "list_changed_files", path)
+	files, err := r.GitRepository.ListChangedFiles(ctx, path)
+	span.End(err)
+	return files, err
+}
+
+// ListCommits implements ports.GitStatsProvider.ListCommits
+func (r *GitRepository) ListCommits(ctx context.Context, path, baseBranch string, since time.Time) ([]domain.Commit, error) {
+	ctx, span := r.start(ctx, "list_commits", path)
+	commits, err := r.GitRepository.ListCommits(ctx, path, baseBranch, since)
+	span.End(err)
+	return commits, err
+}
+
+// ListStashes implements ports.StashManager.ListStashes
+func (r *GitRepository) ListStashes(ctx context.Context, worktreePath string) ([]domain.Stash, error) {
+	ctx, span := r.start(ctx, "list_stashes", worktreePath)
+	stashes, err := r.GitRepository.ListStashes(ctx, worktreePath)
+	span.End(err)
+	return stashes, err
+}
+
+// MoveWorktree implements ports.WorktreeManager.MoveWorktree
+func (r *GitRepository) MoveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error {
+	ctx, span := r.start(ctx, "move_worktree", worktreePath)
+	err := r.GitRepository.MoveWorktree(ctx, repoPath, worktreePath, newPath)
+	span.End(err)
+	return err
+}
+
+// PopStash implements ports.StashManager.PopStash
+func (r *GitRepository) PopStash(ctx context.Context, worktreePath, ref string) error {
+	ctx, span := r.start(ctx, "pop_stash", worktreePath)
+	err := r.GitRepository.PopStash(ctx, worktreePath, ref)
+	span.End(err)
+	return err
+}
+
+// RebaseOntoBase implements ports.BranchSyncer.RebaseOntoBase
+func (r *GitRepository) RebaseOntoBase(ctx context.Context, worktreePath, baseBranch string) (*domain.RebaseResult, error) {
+	ctx, span := r.start(ctx, "rebase", worktreePath)
+	result, err := r.GitRepository.RebaseOntoBase(ctx, worktreePath, baseBranch)
+	span.End(err)
+	return result, err
+}
+
+// RenameBranch implements ports.BranchSwitcher.RenameBranch
+func (r *GitRepository) RenameBranch(ctx context.Context, worktreePath, oldBranch, newBranch string) error {
+	ctx, span := r.start(ctx, "rename_branch", worktreePath)
+	span.SetAttribute("git.branch", newBranch)
+	err := r.GitRepository.RenameBranch(ctx, worktreePath, oldBranch, newBranch)
+	span.End(err)
+	return err
+}
+
+// SetWorktreeIdentity implements ports.WorktreeManager.SetWorktreeIdentity
+func (r *GitRepository) SetWorktreeIdentity(ctx context.Context, worktreePath string, identity domain.GitIdentity) error {
+	ctx, span := r.start(ctx, "set_identity", worktreePath)
+	err := r.GitRepository.SetWorktreeIdentity(ctx, worktreePath, identity)
+	span.End(err)
+	return err
+}
+
+// StashChanges implements ports.StashManager.StashChanges
+func (r *GitRepository) StashChanges(ctx context.Context, worktreePath, message string) (bool, error) {
+	ctx, span := r.start(ctx, "stash", worktreePath)
+	stashed, err := r.GitRepository.StashChanges(ctx, worktreePath, message)
+	span.End(err)
+	return stashed, err
+}
+
+// SwitchBranch implements ports.BranchSwitcher.SwitchBranch
+func (r *GitRepository) SwitchBranch(ctx context.Context, worktreePath, branch string) error {
+	ctx, span := r.start(ctx, "switch_branch", worktreePath)
+	span.SetAttribute("git.branch", branch)
+	err := r.GitRepository.SwitchBranch(ctx, worktreePath, branch)
+	span.End(err)
+	return err
+}
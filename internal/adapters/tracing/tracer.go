@@ -0,0 +1,139 @@
+// Package tracing records OpenTelemetry spans with the OpenTelemetry SDK
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+const (
+	serviceName     = "rocha"         // Service name of the spans unless OTEL_SERVICE_NAME is set
+	shutdownTimeout = 5 * time.Second // How long Close waits for the last spans to be exported
+)
+
+// Tracer implements ports.Tracer with an OpenTelemetry tracer provider exporting
+// finished spans in batches
+type Tracer struct {
+	parent   trace.SpanContext // Remote parent of root spans, from TRACEPARENT; invalid when unset
+	provider *sdktrace.TracerProvider
+	tracer   trace.Tracer
+}
+
+var _ ports.Tracer = (*Tracer)(nil)
+
+// NewTracer creates a tracer exporting to exporter until Close is called.
+// OTEL_SERVICE_NAME and OTEL_RESOURCE_ATTRIBUTES describe the resource, and a W3C
+// TRACEPARENT in the environment makes root spans children of the trace that ran rocha.
+// The W3C propagator is installed globally so incoming API requests continue their trace.
+func NewTracer(exporter sdktrace.SpanExporter) *Tracer {
+	res, err := resource.New(context.Background(),
+		resource.WithAttributes(attribute.String("service.name", serviceName)),
+		resource.WithFromEnv(), // After the default name, so OTEL_SERVICE_NAME wins
+		resource.WithTelemetrySDK(),
+	)
+	if err != nil {
+		logging.Logger.Warn("Failed to read the tracing resource from the environment", "error", err)
+	}
+
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	otel.SetTextMapPropagator(propagator)
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	return &Tracer{
+		parent:   trace.SpanContextFromContext(propagator.Extract(context.Background(), environmentCarrier{})),
+		provider: provider,
+		tracer:   provider.Tracer(serviceName),
+	}
+}
+
+// Start implements ports.Tracer.Start
+func (t *Tracer) Start(ctx context.Context, name string) (context.Context, ports.Span) {
+	if !trace.SpanContextFromContext(ctx).IsValid() && t.parent.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, t.parent)
+	}
+	ctx, span := t.tracer.Start(ctx, name)
+	return ctx, &Span{span: span}
+}
+
+// Close exports the spans still pending and shuts the exporter down
+func (t *Tracer) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := t.provider.Shutdown(ctx); err != nil {
+		return fmt.Errorf("failed to shut down tracing: %w", err)
+	}
+	return nil
+}
+
+// Span implements ports.Span
+type Span struct {
+	span trace.Span
+}
+
+var _ ports.Span = (*Span)(nil)
+
+// SetAttribute implements ports.Span.SetAttribute
+func (s *Span) SetAttribute(key string, value any) {
+	s.span.SetAttributes(attributeOf(key, value))
+}
+
+// End implements ports.Span.End; only the first call has an effect
+func (s *Span) End(err error) {
+	if err != nil && s.span.IsRecording() {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
+
+// attributeOf converts a span annotation, formatting values of other types as strings
+func attributeOf(key string, value any) attribute.KeyValue {
+	switch v := value.(type) {
+	case string:
+		return attribute.String(key, v)
+	case bool:
+		return attribute.Bool(key, v)
+	case int:
+		return attribute.Int(key, v)
+	case int64:
+		return attribute.Int64(key, v)
+	case float64:
+		return attribute.Float64(key, v)
+	default:
+		return attribute.String(key, fmt.Sprint(v))
+	}
+}
+
+// environmentCarrier reads propagation fields from environment variables named after
+// them in upper case, such as TRACEPARENT, as CI systems and other tracers set them
+type environmentCarrier struct{}
+
+// Get implements propagation.TextMapCarrier.Get
+func (environmentCarrier) Get(key string) string {
+	return os.Getenv(strings.ToUpper(key))
+}
+
+// Set implements propagation.TextMapCarrier.Set; the environment is only read
+func (environmentCarrier) Set(string, string) {}
+
+// Keys implements propagation.TextMapCarrier.Keys
+func (environmentCarrier) Keys() []string {
+	return nil
+}
@@ -0,0 +1,154 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer_NestsSpansAndExportsOnClose(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := NewTracer(exporter)
+	defer tracer.Close()
+
+	ctx, root := tracer.Start(context.Background(), "session.create")
+	root.SetAttribute("session.name", "api")
+	_, child := tracer.Start(ctx, "git.create_worktree")
+	child.End(errors.New("branch exists"))
+	child.End(nil) // Ignored
+	root.End(nil)
+	require.NoError(t, tracer.provider.ForceFlush(context.Background()))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	gitSpan, sessionSpan := spans[0], spans[1]
+	assert.Equal(t, "git.create_worktree", gitSpan.Name)
+	assert.Equal(t, "session.create", sessionSpan.Name)
+	assert.Equal(t, sessionSpan.SpanContext.TraceID(), gitSpan.SpanContext.TraceID())
+	assert.Equal(t, sessionSpan.SpanContext.SpanID(), gitSpan.Parent.SpanID())
+	assert.False(t, sessionSpan.Parent.IsValid())
+	assert.Equal(t, codes.Error, gitSpan.Status.Code)
+	assert.Equal(t, "branch exists", gitSpan.Status.Description)
+	assert.Equal(t, codes.Unset, sessionSpan.Status.Code)
+	assert.Equal(t, []attribute.KeyValue{attribute.String("session.name", "api")}, sessionSpan.Attributes)
+
+	serviceName, ok := sessionSpan.Resource.Set().Value("service.name")
+	require.True(t, ok)
+	assert.Equal(t, "rocha", serviceName.AsString())
+}
+
+func TestTracer_RootSpansStartNewTraces(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := NewTracer(exporter)
+	defer tracer.Close()
+
+	_, first := tracer.Start(context.Background(), "a")
+	_, second := tracer.Start(context.Background(), "b")
+	first.End(nil)
+	second.End(nil)
+	require.NoError(t, tracer.provider.ForceFlush(context.Background()))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+	assert.NotEqual(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+}
+
+func TestTracer_Environment(t *testing.T) {
+	t.Setenv("OTEL_SERVICE_NAME", "rocha-ci")
+	t.Setenv("TRACEPARENT", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	exporter := tracetest.NewInMemoryExporter()
+	tracer := NewTracer(exporter)
+	defer tracer.Close()
+
+	_, span := tracer.Start(context.Background(), "session.create")
+	span.End(nil)
+	require.NoError(t, tracer.provider.ForceFlush(context.Background()))
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", spans[0].SpanContext.TraceID().String())
+	assert.Equal(t, "00f067aa0ba902b7", spans[0].Parent.SpanID().String())
+	serviceName, _ := spans[0].Resource.Set().Value("service.name")
+	assert.Equal(t, "rocha-ci", serviceName.AsString())
+}
+
+func TestAttributeOf(t *testing.T) {
+	assert.Equal(t, attribute.String("k", "x"), attributeOf("k", "x"))
+	assert.Equal(t, attribute.Bool("k", true), attributeOf("k", true))
+	assert.Equal(t, attribute.Int("k", 42), attributeOf("k", 42))
+	assert.Equal(t, attribute.Int64("k", 7), attributeOf("k", int64(7)))
+	assert.Equal(t, attribute.Float64("k", 1.5), attributeOf("k", 1.5))
+	assert.Equal(t, attribute.String("k", "[a b]"), attributeOf("k", []string{"a", "b"}))
+}
+
+func TestFileExporter_AppendsSpanLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces", "traces.jsonl")
+	exporter, err := NewFileExporter(path)
+	require.NoError(t, err)
+	tracer := NewTracer(exporter)
+
+	_, span := tracer.Start(context.Background(), "tmux.create_session")
+	span.End(nil)
+	require.NoError(t, tracer.Close())
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 1, "one span per line")
+	var decoded struct{ Name string }
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &decoded))
+	assert.Equal(t, "tmux.create_session", decoded.Name)
+}
+
+func TestOTLPExporter_Export(t *testing.T) {
+	var path, authorization string
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		authorization = r.Header.Get("Authorization")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	exporter, err := NewOTLPExporter(context.Background(), server.URL+"/v1/traces", map[string]string{"Authorization": "Bearer token"})
+	require.NoError(t, err)
+	tracer := NewTracer(exporter)
+	_, span := tracer.Start(context.Background(), "tmux.create_session")
+	span.End(nil)
+	require.NoError(t, tracer.Close())
+
+	assert.Equal(t, "/v1/traces", path)
+	assert.Equal(t, "Bearer token", authorization)
+	assert.Contains(t, string(body), "tmux.create_session")
+}
+
+func TestOTLPExporter_EndpointFromEnvironment(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+	}))
+	defer server.Close()
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", server.URL+"/custom/traces")
+
+	exporter, err := NewOTLPExporter(context.Background(), "", nil)
+	require.NoError(t, err)
+	tracer := NewTracer(exporter)
+	_, span := tracer.Start(context.Background(), "session.create")
+	span.End(nil)
+	require.NoError(t, tracer.Close())
+
+	assert.Equal(t, "/custom/traces", path)
+}
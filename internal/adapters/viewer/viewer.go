@@ -0,0 +1,62 @@
+package viewer
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// viewerCommand is a tool that opens a file, given as its last argument, in the system viewer
+type viewerCommand struct {
+	args []string
+	name string
+}
+
+// Viewer implements ports.FileViewer
+type Viewer struct{}
+
+// NewViewer creates a new file viewer
+func NewViewer() *Viewer {
+	return &Viewer{}
+}
+
+// OpenFile opens path with the first viewer tool available on this platform.
+// Platform-specific candidates are in viewer_*.go files with build tags.
+func (v *Viewer) OpenFile(path string) error {
+	if _, err := os.Stat(path); err != nil {
+		return fmt.Errorf("file does not exist: %w", err)
+	}
+
+	for _, candidate := range platformCommands() {
+		toolPath, err := exec.LookPath(candidate.name)
+		if err != nil {
+			continue
+		}
+
+		logging.Logger.Info("Opening file in system viewer", "tool", candidate.name, "path", path)
+
+		cmd := exec.Command(toolPath, append(candidate.args, path)...)
+		if err := cmd.Start(); err != nil {
+			return fmt.Errorf("failed to start %s: %w", candidate.name, err)
+		}
+		go func() {
+			if err := cmd.Wait(); err != nil {
+				logging.Logger.Warn("System viewer exited with error", "tool", candidate.name, "error", err)
+			}
+		}()
+		return nil
+	}
+
+	return fmt.Errorf("no file viewer found (tried %s)", strings.Join(commandNames(platformCommands()), ", "))
+}
+
+func commandNames(commands []viewerCommand) []string {
+	names := make([]string, 0, len(commands))
+	for _, c := range commands {
+		names = append(names, c.name)
+	}
+	return names
+}
@@ -0,0 +1,9 @@
+//go:build darwin
+
+package viewer
+
+func platformCommands() []viewerCommand {
+	return []viewerCommand{
+		{name: "open"},
+	}
+}
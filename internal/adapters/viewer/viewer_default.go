@@ -0,0 +1,9 @@
+//go:build !linux && !darwin && !windows
+
+package viewer
+
+func platformCommands() []viewerCommand {
+	return []viewerCommand{
+		{name: "xdg-open"},
+	}
+}
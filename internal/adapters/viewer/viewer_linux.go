@@ -0,0 +1,10 @@
+//go:build linux
+
+package viewer
+
+func platformCommands() []viewerCommand {
+	return []viewerCommand{
+		{name: "xdg-open"},
+		{name: "gio", args: []string{"open"}},
+	}
+}
@@ -0,0 +1,9 @@
+//go:build windows
+
+package viewer
+
+func platformCommands() []viewerCommand {
+	return []viewerCommand{
+		{name: "rundll32.exe", args: []string{"url.dll,FileProtocolHandler"}},
+	}
+}
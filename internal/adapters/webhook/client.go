@@ -0,0 +1,100 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// requestTimeout bounds how long a webhook call can delay hooks and the TUI
+const requestTimeout = 3 * time.Second
+
+// payload is the JSON body POSTed to the webhook URL
+type payload struct {
+	Error     string    `json:"error,omitempty"`
+	Event     string    `json:"event"`
+	Message   string    `json:"message,omitempty"`
+	Session   string    `json:"session"`
+	State     string    `json:"state,omitempty"`
+	Status    string    `json:"status,omitempty"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Client implements ports.EventPublisher by POSTing events as JSON to a URL
+type Client struct {
+	events     map[domain.EventType]bool // Events to send (empty = all)
+	headers    map[string]string
+	httpClient *http.Client
+	url        string
+}
+
+// Verify interface compliance at compile time
+var _ ports.EventPublisher = (*Client)(nil)
+
+// NewClient creates a new webhook Client
+// An empty url disables the webhook; an empty events list sends all events
+func NewClient(url string, headers map[string]string, events []string) *Client {
+	eventSet := make(map[domain.EventType]bool, len(events))
+	for _, e := range events {
+		eventSet[domain.EventType(e)] = true
+	}
+	return &Client{
+		events:     eventSet,
+		headers:    headers,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		url:        url,
+	}
+}
+
+// Publish implements EventPublisher.Publish
+func (c *Client) Publish(ctx context.Context, event domain.Event) error {
+	if c.url == "" {
+		return nil
+	}
+	if len(c.events) > 0 && !c.events[event.Type] {
+		logging.Logger.Debug("Webhook event filtered out", "event", event.Type)
+		return nil
+	}
+
+	body, err := json.Marshal(payload{
+		Error:     event.Error,
+		Event:     string(event.Type),
+		Message:   event.Message,
+		Session:   event.SessionName,
+		State:     string(event.State),
+		Status:    event.Status,
+		Timestamp: event.Timestamp,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range c.headers {
+		req.Header.Set(name, value)
+	}
+
+	logging.Logger.Debug("Sending webhook", "event", event.Type, "session", event.SessionName)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestPublish_PostsJSONPayload(t *testing.T) {
+	var received payload
+	var authHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeader = r.Header.Get("Authorization")
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, map[string]string{"Authorization": "Bearer token"}, nil)
+	event := domain.Event{
+		SessionName: "my-session",
+		State:       domain.StateWaiting,
+		Timestamp:   time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Type:        domain.EventStateChange,
+	}
+
+	err := client.Publish(context.Background(), event)
+
+	require.NoError(t, err)
+	assert.Equal(t, "Bearer token", authHeader)
+	assert.Equal(t, "state_change", received.Event)
+	assert.Equal(t, "my-session", received.Session)
+	assert.Equal(t, "waiting", received.State)
+	assert.True(t, event.Timestamp.Equal(received.Timestamp))
+}
+
+func TestPublish_FiltersEvents(t *testing.T) {
+	called := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, nil, []string{"archive"})
+
+	err := client.Publish(context.Background(), domain.Event{Type: domain.EventStateChange})
+
+	require.NoError(t, err)
+	assert.False(t, called)
+}
+
+func TestPublish_EmptyURLIsNoop(t *testing.T) {
+	client := NewClient("", nil, nil)
+
+	err := client.Publish(context.Background(), domain.Event{Type: domain.EventArchive})
+
+	assert.NoError(t, err)
+}
+
+func TestPublish_ErrorStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, nil, nil)
+
+	err := client.Publish(context.Background(), domain.Event{Type: domain.EventError})
+
+	assert.ErrorContains(t, err, "500")
+}
@@ -0,0 +1,322 @@
+// Package zellij hosts rocha sessions in zellij instead of tmux
+package zellij
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/adapters/multiplexer"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// nestedEnv lists the variables zellij sets inside its sessions
+// They are removed before attaching so sessions can be opened from inside zellij
+var nestedEnv = []string{"ZELLIJ=", "ZELLIJ_PANE_ID=", "ZELLIJ_SESSION_NAME="}
+
+// Client manages rocha sessions as zellij sessions (zellij 0.40 or later)
+type Client struct {
+	attachments *multiplexer.Attachments
+}
+
+// Compile-time interface verification
+var _ ports.SessionManager = (*Client)(nil)
+
+// NewClient creates a new zellij Client
+func NewClient() *Client {
+	return &Client{
+		attachments: multiplexer.NewAttachments(),
+	}
+}
+
+// createBaseSession creates a detached zellij session running the user's default shell
+// statusPosition is ignored: zellij's layout decides where its bars go
+func (c *Client) createBaseSession(name string, worktreePath string) error {
+	if c.SessionExists(name) {
+		return ports.ErrTmuxSessionExists
+	}
+
+	cmd := exec.Command("zellij", "attach", "--create-background", name)
+	cmd.Dir = worktreePath
+	cmd.Env = multiplexer.CleanEnv(nestedEnv...)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to create zellij session: %w (output: %s)", multiplexer.CommandError(err), string(output))
+	}
+
+	// Wait for session to be ready
+	timeout := time.After(2 * time.Second)
+	ticker := time.NewTicker(50 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-timeout:
+			return fmt.Errorf("timeout waiting for session %s to be created", name)
+		case <-ticker.C:
+			if c.SessionExists(name) {
+				return nil
+			}
+		}
+	}
+}
+
+// CreateSession creates a new zellij session and starts Claude in it
+func (c *Client) CreateSession(name string, worktreePath string, claudeDir string, statusPosition string, initialPrompt string) (*ports.TmuxSession, error) {
+	logging.Logger.Info("Creating new zellij session", "name", name, "worktree_path", worktreePath, "claude_dir", claudeDir, "has_initial_prompt", initialPrompt != "")
+
+	if err := c.createBaseSession(name, worktreePath); err != nil {
+		return nil, err
+	}
+
+	c.startClaude(name, worktreePath, claudeDir, multiplexer.PromptArg(initialPrompt))
+	return &ports.TmuxSession{Name: name, CreatedAt: time.Now()}, nil
+}
+
+// ResumeSession creates a new zellij session that resumes an earlier Claude conversation
+func (c *Client) ResumeSession(name string, worktreePath string, claudeDir string, statusPosition string, claudeSessionID string) (*ports.TmuxSession, error) {
+	logging.Logger.Info("Creating zellij session resuming Claude conversation", "name", name, "worktree_path", worktreePath, "claude_session_id", claudeSessionID)
+
+	if err := c.createBaseSession(name, worktreePath); err != nil {
+		return nil, err
+	}
+
+	c.startClaude(name, worktreePath, claudeDir, multiplexer.ResumeArg(claudeSessionID))
+	return &ports.TmuxSession{Name: name, CreatedAt: time.Now()}, nil
+}
+
+// startClaude types the rocha start-claude command into a freshly created session
+func (c *Client) startClaude(name string, worktreePath string, claudeDir string, startArgs string) {
+	startCmd := multiplexer.AgentStartCommand(name, worktreePath, claudeDir, startArgs)
+	logging.Logger.Debug("Sending start command to session", "command", startCmd)
+	if err := c.SendKeys(name, startCmd, "Enter"); err != nil {
+		logging.Logger.Error("Failed to send start command", "error", err)
+	} else {
+		logging.Logger.Info("Session created and Claude started", "name", name)
+	}
+}
+
+// CreateShellSession creates a plain shell session without rocha start-claude
+func (c *Client) CreateShellSession(name string, worktreePath string, statusPosition string) (*ports.TmuxSession, error) {
+	logging.Logger.Info("Creating shell zellij session", "name", name, "worktree_path", worktreePath)
+
+	if err := c.createBaseSession(name, worktreePath); err != nil {
+		return nil, err
+	}
+	return &ports.TmuxSession{Name: name, CreatedAt: time.Now()}, nil
+}
+
+// SessionExists checks if the zellij session is running
+func (c *Client) SessionExists(name string) bool {
+	sessions, err := c.ListSessions()
+	if err != nil {
+		return false
+	}
+	return slices.ContainsFunc(sessions, func(s *ports.TmuxSession) bool { return s.Name == name })
+}
+
+// ListSessions returns all running zellij sessions
+// Exited sessions kept by zellij for resurrection are not listed
+func (c *Client) ListSessions() ([]*ports.TmuxSession, error) {
+	output, err := exec.Command("zellij", "list-sessions", "--no-formatting").Output()
+	if err != nil {
+		// zellij exits with 1 when there are no sessions
+		if exitErr, ok := err.(*exec.ExitError); ok && exitErr.ExitCode() == 1 {
+			return []*ports.TmuxSession{}, nil
+		}
+		return []*ports.TmuxSession{}, multiplexer.CommandError(err)
+	}
+
+	var sessions []*ports.TmuxSession
+	for _, name := range parseSessionList(string(output)) {
+		sessions = append(sessions, &ports.TmuxSession{Name: name, CreatedAt: time.Now()})
+	}
+	return sessions, nil
+}
+
+// parseSessionList extracts the running session names from "zellij list-sessions --no-formatting"
+// Lines look like "name [Created 2m ago] (current)" or "name [Created 1h ago] (EXITED - attach to resurrect)"
+func parseSessionList(output string) []string {
+	var names []string
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.Contains(line, "(EXITED") {
+			continue
+		}
+		names = append(names, fields[0])
+	}
+	return names
+}
+
+// KillSession terminates the zellij session and forgets it so it cannot be resurrected
+func (c *Client) KillSession(name string) error {
+	if err := exec.Command("zellij", "kill-session", name).Run(); err != nil {
+		return multiplexer.CommandError(err)
+	}
+	if err := exec.Command("zellij", "delete-session", name).Run(); err != nil {
+		logging.Logger.Debug("Failed to delete killed zellij session", "name", name, "error", err)
+	}
+	return nil
+}
+
+// RequestAgentExit asks Claude to quit on its own by interrupting any running
+// turn and typing /exit. It does not wait for the agent to stop.
+func (c *Client) RequestAgentExit(name string) error {
+	for _, keys := range [][]string{{"Escape"}, {"/exit"}, {"C-m"}} {
+		if err := c.SendKeys(name, keys...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RenameSession renames a zellij session
+func (c *Client) RenameSession(oldName, newName string) error {
+	if oldName == "" || newName == "" {
+		return fmt.Errorf("session names cannot be empty")
+	}
+	if !c.SessionExists(oldName) {
+		return fmt.Errorf("session %s not found", oldName)
+	}
+	if c.SessionExists(newName) {
+		return fmt.Errorf("session %s already exists", newName)
+	}
+
+	if output, err := c.action(oldName, "rename-session", newName).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to rename session: %w (output: %s)", err, string(output))
+	}
+	return nil
+}
+
+// Attach attaches to the zellij session. Returns a channel that will be closed when detached.
+func (c *Client) Attach(sessionName string) (chan struct{}, error) {
+	return c.attachments.Attach(sessionName, c.GetAttachCommand(sessionName))
+}
+
+// Detach detaches from the zellij session
+func (c *Client) Detach(sessionName string) error {
+	return c.attachments.Detach(sessionName)
+}
+
+// GetAttachCommand returns an exec.Cmd configured for attaching to a session
+func (c *Client) GetAttachCommand(sessionName string) *exec.Cmd {
+	cmd := exec.Command("zellij", "attach", sessionName)
+	cmd.Env = multiplexer.CleanEnv(nestedEnv...)
+	return cmd
+}
+
+// GetGuestAttachCommand is not supported: zellij cannot run a guest server that enforces the access of a share
+func (c *Client) GetGuestAttachCommand(socket string, readOnly bool) (*exec.Cmd, error) {
+	return nil, fmt.Errorf("%w: zellij cannot share sessions", ports.ErrMultiplexerUnsupported)
+}
+
+// StartGuestServer is not supported: zellij cannot restrict what another account may do in a session
+func (c *Client) StartGuestServer(id, sessionName, guest string, readOnly bool, until time.Time) (string, error) {
+	return "", fmt.Errorf("%w: zellij cannot share sessions", ports.ErrMultiplexerUnsupported)
+}
+
+// StopGuestServer does nothing, as zellij never starts guest servers
+func (c *Client) StopGuestServer(socket string) error {
+	return nil
+}
+
+// OpenInWindow opens the session in a new pane of the current zellij session.
+// The pane runs a nested attach and closes when the user detaches.
+func (c *Client) OpenInWindow(sessionName string) error {
+	args := []string{"run", "--name", sessionName, "--close-on-exit", "--", "env"}
+	for _, variable := range nestedEnv {
+		args = append(args, "-u", strings.TrimSuffix(variable, "="))
+	}
+	args = append(args, "zellij", "attach", sessionName)
+
+	if output, err := exec.Command("zellij", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to open session in new pane: %w (output: %s)", err, string(output))
+	}
+	return nil
+}
+
+// RunInWindow runs a shell command in a new pane of the zellij session.
+// The pane closes when the command exits.
+func (c *Client) RunInWindow(sessionName, windowName, dir, command string) error {
+	logging.Logger.Info("Running command in zellij pane", "session", sessionName, "pane", windowName, "dir", dir)
+
+	args := []string{"--session", sessionName, "run", "--name", windowName, "--cwd", dir, "--close-on-exit", "--", "sh", "-c", command}
+	if output, err := exec.Command("zellij", args...).CombinedOutput(); err != nil {
+		return fmt.Errorf("failed to open pane: %w (output: %s)", multiplexer.CommandError(err), string(output))
+	}
+	return nil
+}
+
+// SwitchClient is not supported: zellij clients cannot be moved to another session from the CLI
+func (c *Client) SwitchClient(sessionName string) error {
+	return fmt.Errorf("%w: zellij cannot switch clients between sessions", ports.ErrMultiplexerUnsupported)
+}
+
+// SendKeys types keys into the focused pane of the zellij session
+func (c *Client) SendKeys(sessionName string, keys ...string) error {
+	for _, key := range keys {
+		var cmd *exec.Cmd
+		if bytes, ok := multiplexer.KeyBytes(key); ok {
+			args := []string{"write"}
+			for _, b := range []byte(bytes) {
+				args = append(args, strconv.Itoa(int(b)))
+			}
+			cmd = c.action(sessionName, args...)
+		} else {
+			cmd = c.action(sessionName, "write-chars", key)
+		}
+		if err := cmd.Run(); err != nil {
+			return multiplexer.CommandError(err)
+		}
+	}
+	return nil
+}
+
+// CapturePane returns the content of the focused pane, including its scrollback
+// A negative startLine keeps only the last -startLine lines
+func (c *Client) CapturePane(sessionName string, startLine int) (string, error) {
+	dump, err := os.CreateTemp("", "rocha-zellij-*.txt")
+	if err != nil {
+		return "", fmt.Errorf("failed to create screen dump file: %w", err)
+	}
+	dump.Close()
+	defer os.Remove(dump.Name())
+
+	if err := c.action(sessionName, "dump-screen", "--full", dump.Name()).Run(); err != nil {
+		return "", multiplexer.CommandError(err)
+	}
+	content, err := os.ReadFile(dump.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read screen dump: %w", err)
+	}
+	return multiplexer.LastLines(string(content), -startLine), nil
+}
+
+// PanePIDs is not supported: zellij does not report the processes of its panes
+func (c *Client) PanePIDs() (map[string]int, error) {
+	return nil, fmt.Errorf("%w: zellij does not report pane processes", ports.ErrMultiplexerUnsupported)
+}
+
+// SourceFile is not supported: zellij reads its configuration when it starts
+func (c *Client) SourceFile(configPath string) error {
+	return fmt.Errorf("%w: zellij cannot reload configuration files", ports.ErrMultiplexerUnsupported)
+}
+
+// BindKey is not supported: zellij key bindings live in its configuration file
+func (c *Client) BindKey(table, key, command string) error {
+	return fmt.Errorf("%w: zellij cannot bind keys at runtime", ports.ErrMultiplexerUnsupported)
+}
+
+// SetOption is not supported: zellij has no per-session options
+func (c *Client) SetOption(sessionName, option, value string) error {
+	return fmt.Errorf("%w: zellij has no session options", ports.ErrMultiplexerUnsupported)
+}
+
+// action returns a "zellij action" command targeting the session
+func (c *Client) action(sessionName string, args ...string) *exec.Cmd {
+	return exec.Command("zellij", append([]string{"--session", sessionName, "action"}, args...)...)
+}
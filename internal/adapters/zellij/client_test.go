@@ -0,0 +1,24 @@
+package zellij
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+func TestParseSessionList(t *testing.T) {
+	output := `rocha-demo [Created 2m 10s ago] (current)
+rocha-demo-shell [Created 2m 9s ago]
+old-session [Created 3days ago] (EXITED - attach to resurrect)
+
+`
+
+	assert.Equal(t, []string{"rocha-demo", "rocha-demo-shell"}, parseSessionList(output))
+}
+
+func TestPanePIDs_Unsupported(t *testing.T) {
+	_, err := NewClient().PanePIDs()
+	assert.ErrorIs(t, err, ports.ErrMultiplexerUnsupported)
+}
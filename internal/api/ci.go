@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/renato0307/rocha/apitypes"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// reportCI serves POST /api/v1/ci, storing a CI result on the sessions working on its branch
+func (s *Server) reportCI(w http.ResponseWriter, r *http.Request) {
+	var req apitypes.CIReportRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	state, err := domain.ParseCIState(req.State)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	logging.Logger.Info("Reporting CI result via API", "branch", req.Branch, "repo", req.Repo, "state", state)
+	matched, err := s.ciService.Report(r.Context(), domain.CIReport{
+		Branch: req.Branch,
+		Commit: req.Commit,
+		Repo:   req.Repo,
+		State:  state,
+		URL:    req.URL,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, apitypes.CIReportResponse{Sessions: matched})
+}
+
+// ciStatusToResponse converts a CI result to its API representation, nil when there is none
+func ciStatusToResponse(status *domain.CIStatus) *apitypes.CIStatus {
+	if status == nil {
+		return nil
+	}
+	return &apitypes.CIStatus{
+		Commit:     status.Commit,
+		ReportedAt: status.ReportedAt,
+		State:      string(status.State),
+		URL:        status.URL,
+	}
+}
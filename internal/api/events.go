@@ -0,0 +1,99 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/renato0307/rocha/apitypes"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// eventBatchSize caps the events read from the database per poll
+const eventBatchSize = 100
+
+// streamEvents serves GET /api/v1/events as server-sent events. The stream starts with the
+// events stored after the connection opens; clients reconnecting with Last-Event-ID
+// (browsers' EventSource does it itself) get the events they missed first.
+func (s *Server) streamEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeJSON(w, http.StatusInternalServerError, apitypes.ErrorResponse{Error: "streaming is not supported"})
+		return
+	}
+
+	ctx := r.Context()
+	lastID, err := s.resumeEventID(r)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	logging.Logger.Info("API event stream opened", "after_id", lastID, "remote", r.RemoteAddr)
+	ticker := time.NewTicker(s.eventPollInterval)
+	defer ticker.Stop()
+
+	for {
+		events, err := s.eventFeed.ListEventsAfter(ctx, lastID, eventBatchSize)
+		if err != nil {
+			logging.Logger.Warn("Failed to read events for the API stream", "error", err)
+		}
+		for _, event := range events {
+			if err := writeEvent(w, event); err != nil {
+				logging.Logger.Debug("API event stream closed", "error", err)
+				return
+			}
+			lastID = event.ID
+		}
+		flusher.Flush()
+
+		select {
+		case <-ctx.Done():
+			logging.Logger.Info("API event stream closed", "last_id", lastID)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// resumeEventID returns the ID the stream continues after: the Last-Event-ID header
+// when the client reconnects, or else the newest stored event
+func (s *Server) resumeEventID(r *http.Request) (uint, error) {
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		id, err := strconv.ParseUint(header, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: Last-Event-ID must be an event ID", domain.ErrInvalidInput)
+		}
+		return uint(id), nil
+	}
+	return s.eventFeed.LatestEventID(r.Context())
+}
+
+// writeEvent writes an event in the server-sent events format, named after its type
+func writeEvent(w io.Writer, event domain.Event) error {
+	data, err := json.Marshal(apitypes.Event{
+		Error:     event.Error,
+		Event:     string(event.Type),
+		Message:   event.Message,
+		Session:   event.SessionName,
+		State:     string(event.State),
+		Status:    event.Status,
+		Timestamp: event.Timestamp,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+	return err
+}
@@ -0,0 +1,132 @@
+// Package api serves a REST API mirroring the rocha CLI, so browser extensions,
+// mobile shortcuts, and scripts on other machines can drive rocha over HTTP
+package api
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+
+	"github.com/renato0307/rocha/apitypes"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/services/actions"
+)
+
+// DefaultEventPollInterval is how often the event stream checks for new events
+const DefaultEventPollInterval = time.Second
+
+// Server serves the REST API; every request must carry the token as a bearer token
+type Server struct {
+	actionsService    *actions.Service
+	ciService         *services.CIService
+	eventFeed         ports.EventFeed
+	eventPollInterval time.Duration
+	schedulerService  *services.SchedulerService
+	sessionService    *services.SessionService
+	settingsService   *services.SettingsService
+	token             string
+}
+
+// NewServer creates a new Server
+func NewServer(
+	sessionService *services.SessionService,
+	actionsService *actions.Service,
+	schedulerService *services.SchedulerService,
+	settingsService *services.SettingsService,
+	ciService *services.CIService,
+	eventFeed ports.EventFeed,
+	token string,
+) *Server {
+	return &Server{
+		actionsService:    actionsService,
+		ciService:         ciService,
+		eventFeed:         eventFeed,
+		eventPollInterval: DefaultEventPollInterval,
+		schedulerService:  schedulerService,
+		sessionService:    sessionService,
+		settingsService:   settingsService,
+		token:             token,
+	}
+}
+
+// Handler returns the HTTP handler serving the API under /api/v1
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/v1/sessions", s.listSessions)
+	mux.HandleFunc("POST /api/v1/sessions", s.createSession)
+	mux.HandleFunc("GET /api/v1/sessions/{name}", s.getSession)
+	mux.HandleFunc("DELETE /api/v1/sessions/{name}", s.deleteSession)
+	mux.HandleFunc("POST /api/v1/sessions/{name}/send", s.sendText)
+	mux.HandleFunc("PUT /api/v1/sessions/{name}/status", s.setStatus)
+	mux.HandleFunc("POST /api/v1/ci", s.reportCI)
+	mux.HandleFunc("GET /api/v1/events", s.streamEvents)
+	return s.authenticate(mux)
+}
+
+// authenticate rejects requests without the API token
+func (s *Server) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(token), []byte(s.token)) != 1 {
+			logging.Logger.Warn("Rejected API request without a valid token", "method", r.Method, "path", r.URL.Path, "remote", r.RemoteAddr)
+			w.Header().Set("WWW-Authenticate", `Bearer realm="rocha"`)
+			writeJSON(w, http.StatusUnauthorized, apitypes.ErrorResponse{Error: "missing or invalid API token"})
+			return
+		}
+
+		logging.Logger.Debug("API request", "method", r.Method, "path", r.URL.Path)
+		// Continue the trace of the caller (traceparent header) when tracing is enabled
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// writeJSON writes body as JSON with the given status
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logging.Logger.Warn("Failed to write API response", "error", err)
+	}
+}
+
+// writeError writes err with the status matching its domain error
+func writeError(w http.ResponseWriter, err error) {
+	writeJSON(w, errorStatus(err), apitypes.ErrorResponse{Error: err.Error()})
+}
+
+// errorStatus maps domain errors to HTTP statuses; anything else is a server error
+func errorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrInvalidInput):
+		return http.StatusBadRequest
+	case errors.Is(err, domain.ErrSessionNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrSessionExists):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrConfirmationRequired):
+		return http.StatusPreconditionRequired
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// decodeJSON reads a JSON request body into v, rejecting unknown fields
+func decodeJSON(r *http.Request, v any) error {
+	decoder := json.NewDecoder(r.Body)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("%w: invalid JSON body: %v", domain.ErrInvalidInput, err)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// fakeEventFeed serves events from a slice, like the events table ordered by ID
+type fakeEventFeed struct {
+	events []domain.Event
+}
+
+func (f *fakeEventFeed) LatestEventID(ctx context.Context) (uint, error) {
+	if len(f.events) == 0 {
+		return 0, nil
+	}
+	return f.events[len(f.events)-1].ID, nil
+}
+
+func (f *fakeEventFeed) ListEventsAfter(ctx context.Context, afterID uint, limit int) ([]domain.Event, error) {
+	var events []domain.Event
+	for _, event := range f.events {
+		if event.ID > afterID && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func newTestServer(feed *fakeEventFeed) *Server {
+	server := NewServer(nil, nil, nil, nil, nil, feed, "secret")
+	server.eventPollInterval = 10 * time.Millisecond
+	return server
+}
+
+func TestAuthenticate(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantStatus    int
+	}{
+		{name: "missing token", authorization: "", wantStatus: http.StatusUnauthorized},
+		{name: "wrong token", authorization: "Bearer nope", wantStatus: http.StatusUnauthorized},
+		{name: "not a bearer token", authorization: "secret", wantStatus: http.StatusUnauthorized},
+		{name: "valid token", authorization: "Bearer secret", wantStatus: http.StatusNotFound},
+	}
+
+	handler := newTestServer(&fakeEventFeed{}).Handler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/unknown", nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			rec := httptest.NewRecorder()
+
+			handler.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{name: "invalid input", err: fmt.Errorf("%w: bad", domain.ErrInvalidInput), want: http.StatusBadRequest},
+		{name: "not found", err: fmt.Errorf("get: %w", domain.ErrSessionNotFound), want: http.StatusNotFound},
+		{name: "exists", err: domain.ErrSessionExists, want: http.StatusConflict},
+		{name: "confirmation required", err: fmt.Errorf("%w: rm -rf", domain.ErrConfirmationRequired), want: http.StatusPreconditionRequired},
+		{name: "anything else", err: errors.New("disk full"), want: http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, errorStatus(tt.err))
+		})
+	}
+}
+
+func TestWriteEvent(t *testing.T) {
+	var out strings.Builder
+	event := domain.Event{
+		ID:          7,
+		SessionName: "s1",
+		State:       domain.StateWaiting,
+		Timestamp:   time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Type:        domain.EventStateChange,
+	}
+
+	require.NoError(t, writeEvent(&out, event))
+
+	assert.Equal(t,
+		"id: 7\nevent: state_change\n"+
+			`data: {"event":"state_change","session":"s1","state":"waiting","timestamp":"2026-01-02T03:04:05Z"}`+"\n\n",
+		out.String())
+}
+
+func TestStreamEvents_ResumesAfterLastEventID(t *testing.T) {
+	feed := &fakeEventFeed{events: []domain.Event{
+		{ID: 1, SessionName: "s1", Type: domain.EventArchive},
+		{ID: 2, SessionName: "s1", Type: domain.EventStateChange},
+		{ID: 3, SessionName: "s2", Type: domain.EventArchive},
+	}}
+	httpServer := httptest.NewServer(newTestServer(feed).Handler())
+	defer httpServer.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, httpServer.URL+"/api/v1/events", nil)
+	require.NoError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Last-Event-ID", "1")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+	var ids []string
+	scanner := bufio.NewScanner(resp.Body)
+	for len(ids) < 2 && scanner.Scan() {
+		if id, ok := strings.CutPrefix(scanner.Text(), "id: "); ok {
+			ids = append(ids, id)
+		}
+	}
+	assert.Equal(t, []string{"2", "3"}, ids)
+}
+
+func TestStreamEvents_RejectsMalformedLastEventID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/events", nil)
+	req.Header.Set("Authorization", "Bearer secret")
+	req.Header.Set("Last-Event-ID", "latest")
+	rec := httptest.NewRecorder()
+
+	newTestServer(&fakeEventFeed{}).Handler().ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestReportCI(t *testing.T) {
+	tests := []struct {
+		name       string
+		body       string
+		wantStatus int
+		wantBody   string
+	}{
+		{name: "stored", body: `{"branch":"feature","state":"failed","url":"https://ci/1"}`, wantStatus: http.StatusOK, wantBody: `{"sessions":["s1"]}`},
+		{name: "no session", body: `{"branch":"main","state":"success"}`, wantStatus: http.StatusNotFound},
+		{name: "unknown state", body: `{"branch":"feature","state":"green"}`, wantStatus: http.StatusBadRequest},
+		{name: "unknown field", body: `{"branch":"feature","state":"success","sha":"abc"}`, wantStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionRepo := portsmocks.NewMockSessionRepository(t)
+			sessionRepo.EXPECT().List(mock.Anything, false).Return([]domain.Session{{BranchName: "feature", Name: "s1"}}, nil).Maybe()
+			sessionRepo.EXPECT().UpdateCIStatus(mock.Anything, "s1", mock.MatchedBy(func(status *domain.CIStatus) bool {
+				return status.State == domain.CIStateFailure && status.URL == "https://ci/1"
+			})).Return(nil).Maybe()
+			server := newTestServer(&fakeEventFeed{})
+			server.ciService = services.NewCIService(sessionRepo, portsmocks.NewMockSoundPlayer(t), portsmocks.NewMockEventPublisher(t))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/ci", strings.NewReader(tt.body))
+			req.Header.Set("Authorization", "Bearer secret")
+			rec := httptest.NewRecorder()
+
+			server.Handler().ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+			if tt.wantBody != "" {
+				assert.JSONEq(t, tt.wantBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestLoadOrCreateToken(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-token")
+
+	token, err := LoadOrCreateToken(path)
+	require.NoError(t, err)
+	assert.Len(t, token, 64)
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	again, err := LoadOrCreateToken(path)
+	require.NoError(t, err)
+	assert.Equal(t, token, again, "the stored token is reused")
+}
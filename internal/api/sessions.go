@@ -0,0 +1,213 @@
+package api
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/renato0307/rocha/apitypes"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/services/actions"
+)
+
+// deleteShutdownTimeout is how long a deleted session's agent gets to exit, as with 'rocha sessions del'
+const deleteShutdownTimeout = 10 * time.Second
+
+// listSessions serves GET /api/v1/sessions, with ?archived=true to include archived sessions
+func (s *Server) listSessions(w http.ResponseWriter, r *http.Request) {
+	includeArchived, _ := strconv.ParseBool(r.URL.Query().Get("archived"))
+
+	sessions, err := s.sessionService.ListSessions(r.Context(), includeArchived)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := make([]apitypes.Session, 0, len(sessions))
+	for _, session := range sessions {
+		response = append(response, sessionToResponse(session))
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// getSession serves GET /api/v1/sessions/{name}
+func (s *Server) getSession(w http.ResponseWriter, r *http.Request) {
+	session, err := s.sessionService.GetSession(r.Context(), r.PathValue("name"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, sessionToResponse(*session))
+}
+
+// createSession serves POST /api/v1/sessions, creating the worktree and tmux session and starting Claude
+func (s *Server) createSession(w http.ResponseWriter, r *http.Request) {
+	var req apitypes.CreateSessionRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	params, err := s.createSessionParams(req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	logging.Logger.Info("Creating session via API", "name", params.SessionName, "repo_source", params.RepoSource)
+	result, err := s.sessionService.CreateSession(r.Context(), params)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, sessionToResponse(*result.Session))
+}
+
+// createSessionParams validates a create request the way 'rocha sessions add --start' validates its flags;
+// without a name, the session is named after its branch or linked issue
+func (s *Server) createSessionParams(req apitypes.CreateSessionRequest) (services.CreateSessionParams, error) {
+	if req.Name == "" && req.BranchName == "" {
+		return services.CreateSessionParams{}, fmt.Errorf("%w: name or branch_name is required", domain.ErrInvalidInput)
+	}
+	if req.Path != "" && (req.RepoSource != "" || req.BranchName != "") {
+		return services.CreateSessionParams{}, fmt.Errorf("%w: path cannot be combined with repo_source or branch_name", domain.ErrInvalidInput)
+	}
+	if err := domain.ValidateAgentModel(req.Model); err != nil {
+		return services.CreateSessionParams{}, err
+	}
+	agentArgs, err := domain.ParseAgentArgs(req.AgentArgs)
+	if err != nil {
+		return services.CreateSessionParams{}, err
+	}
+	if err := domain.ValidateAgentArgs(agentArgs); err != nil {
+		return services.CreateSessionParams{}, err
+	}
+
+	return services.CreateSessionParams{
+		AgentArgs:                       agentArgs,
+		AgentModel:                      req.Model,
+		AllowDangerouslySkipPermissions: req.AllowDangerouslySkipPermissions,
+		AutoDisplayName:                 req.Name == "",
+		BranchNameOverride:              req.BranchName,
+		DirectoryPath:                   req.Path,
+		DisplayName:                     req.DisplayName,
+		InitialPrompt:                   req.Prompt,
+		RepoSource:                      req.RepoSource,
+		SessionName:                     domain.SanitizeSessionName(cmp.Or(req.Name, req.BranchName)),
+		Subdir:                          req.Subdir,
+		TmuxStatusPosition:              s.settingsService.GetTmuxStatusPosition(),
+	}, nil
+}
+
+// deleteSession serves DELETE /api/v1/sessions/{name}, removing the worktree unless it has
+// work that only exists locally; ?discard_local_work=true removes it anyway
+func (s *Server) deleteSession(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+	discard, _ := strconv.ParseBool(r.URL.Query().Get("discard_local_work"))
+
+	logging.Logger.Info("Deleting session via API", "session", name, "discard_local_work", discard)
+	outcome, err := s.actionsService.Kill(r.Context(), name, actions.KillOptions{
+		ShutdownTimeout: deleteShutdownTimeout,
+		Worktree:        actions.WorktreeRemoval{Discard: discard, Remove: true},
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	response := apitypes.DeleteSessionResponse{WorktreeKept: outcome.WorktreeKept, WorktreeRemoved: outcome.WorktreeRemoved}
+	if outcome.WorktreeErr != nil {
+		response.WorktreeError = outcome.WorktreeErr.Error()
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+// sendText serves POST /api/v1/sessions/{name}/send, queueing the text when the concurrency limit is reached
+func (s *Server) sendText(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	var req apitypes.SendTextRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+	if req.Text == "" {
+		writeError(w, fmt.Errorf("%w: text is required", domain.ErrInvalidInput))
+		return
+	}
+
+	queued, err := s.schedulerService.SendOrQueue(r.Context(), name, req.Text, req.Confirmed, time.Now())
+	if errors.Is(err, domain.ErrConfirmationRequired) {
+		writeError(w, fmt.Errorf("%w, send again with confirmed set", err))
+		return
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if queued != nil {
+		writeJSON(w, http.StatusAccepted, apitypes.SendTextResponse{QueuedPromptID: queued.ID})
+		return
+	}
+	writeJSON(w, http.StatusOK, apitypes.SendTextResponse{Sent: true})
+}
+
+// setStatus serves PUT /api/v1/sessions/{name}/status
+func (s *Server) setStatus(w http.ResponseWriter, r *http.Request) {
+	name := r.PathValue("name")
+
+	var req apitypes.SetStatusRequest
+	if err := decodeJSON(r, &req); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	session, err := s.sessionService.GetSession(r.Context(), name)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	var status *string
+	if req.Status != "" {
+		status = &req.Status
+	}
+	if err := s.sessionService.UpdateStatus(r.Context(), name, status); err != nil {
+		writeError(w, err)
+		return
+	}
+
+	session.Status = status
+	writeJSON(w, http.StatusOK, sessionToResponse(*session))
+}
+
+// sessionToResponse converts a session to its API representation
+func sessionToResponse(session domain.Session) apitypes.Session {
+	response := apitypes.Session{
+		Archived:    session.IsArchived,
+		Branch:      session.BranchName,
+		CI:          ciStatusToResponse(session.CI),
+		Comment:     session.Comment,
+		DisplayName: session.DisplayName,
+		External:    session.IsExternal,
+		Flagged:     session.IsFlagged,
+		LastUpdated: session.LastUpdated,
+		Model:       session.AgentModel,
+		Name:        session.Name,
+		Priority:    string(session.Priority),
+		Repo:        session.RepoInfo,
+		State:       string(session.State),
+		Subdir:      session.Subdir,
+		Tags:        session.Tags,
+		WorkingDir:  session.WorkingDir(),
+	}
+	if session.Status != nil {
+		response.Status = *session.Status
+	}
+	return response
+}
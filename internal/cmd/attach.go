@@ -107,12 +107,12 @@ func (a *AttachCmd) Run(cli *CLI) error {
 
 			// Check for duplicate branch (if in git repo)
 			if branchName != "" && existingSession.BranchName == branchName && existingSession.RepoPath == repoPath {
-				return fmt.Errorf("session '%s' already exists for branch '%s' in repo '%s'", existingName, branchName, repoPath)
+				return fmt.Errorf("%w for branch '%s' in repo '%s': %s", domain.ErrSessionExists, branchName, repoPath, existingName)
 			}
 
 			// Check for duplicate worktree path
 			if worktreePath != "" && existingSession.WorktreePath == worktreePath {
-				return fmt.Errorf("session '%s' already exists for worktree path '%s'", existingName, worktreePath)
+				return fmt.Errorf("%w for worktree path '%s': %s", domain.ErrSessionExists, worktreePath, existingName)
 			}
 		}
 	}
@@ -0,0 +1,268 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// Completion scripts call "rocha __complete -- <words>" with the words typed after "rocha",
+// the last one being the word under the cursor (empty when starting a new word)
+const bashCompletionScript = `# rocha bash completion
+# Load with: source <(rocha completion bash)
+_rocha_complete() {
+    local IFS=$'\n'
+    COMPREPLY=($(rocha __complete -- "${COMP_WORDS[@]:1:COMP_CWORD}" 2>/dev/null))
+}
+complete -o default -F _rocha_complete rocha
+`
+
+const zshCompletionScript = `#compdef rocha
+# rocha zsh completion
+# Load with: source <(rocha completion zsh)
+_rocha() {
+    local -a candidates
+    candidates=("${(@f)$(rocha __complete -- "${(@)words[2,CURRENT]}" 2>/dev/null)}")
+    compadd -a candidates
+}
+compdef _rocha rocha
+`
+
+const fishCompletionScript = `# rocha fish completion
+# Load with: rocha completion fish | source
+function __rocha_complete
+    set -l tokens (commandline -opc)
+    set -e tokens[1]
+    rocha __complete -- $tokens (commandline -ct) 2>/dev/null
+end
+complete -c rocha -f -a '(__rocha_complete)'
+`
+
+// CompletionCmd prints the shell completion script
+type CompletionCmd struct {
+	Shell string `arg:"" help:"Shell to generate the completion script for" enum:"bash,zsh,fish"`
+}
+
+// Run executes the completion command
+func (c *CompletionCmd) Run() error {
+	switch c.Shell {
+	case "bash":
+		fmt.Print(bashCompletionScript)
+	case "zsh":
+		fmt.Print(zshCompletionScript)
+	case "fish":
+		fmt.Print(fishCompletionScript)
+	}
+	return nil
+}
+
+// CompleteCmd prints completion candidates, one per line (called by the completion scripts)
+type CompleteCmd struct {
+	Words []string `arg:"" optional:"" passthrough:"" help:"Words typed after 'rocha', ending with the word being completed"`
+}
+
+// Run executes the __complete command
+func (c *CompleteCmd) Run(cli *CLI, kctx *kong.Context) error {
+	for _, candidate := range completionCandidates(kctx.Model.Node, c.Words, func(predictor string) []string {
+		return predictValues(cli, predictor)
+	}) {
+		fmt.Println(candidate)
+	}
+	return nil
+}
+
+// completionCandidates walks the command tree along the typed words and returns the
+// candidates for the last word: subcommands, flags, enum values, or values from predict
+// for arguments and flags tagged with predictor:"session", "repo", "status", "priority", "preset", "repo_bookmark", or "workspace"
+func completionCandidates(root *kong.Node, words []string, predict func(predictor string) []string) []string {
+	// Bash splits "--flag=value" into "--flag", "=", "value"
+	current := ""
+	if len(words) > 0 {
+		current = words[len(words)-1]
+		words = words[:len(words)-1]
+	}
+	if current == "=" {
+		current = ""
+	}
+	words = slices.DeleteFunc(slices.Clone(words), func(word string) bool { return word == "=" })
+
+	node := root
+	positional := 0
+	var pendingFlag *kong.Flag // Flag waiting for its value in the next word
+	for _, word := range words {
+		switch {
+		case pendingFlag != nil:
+			pendingFlag = nil
+		case word == "--":
+			// Only positional arguments follow
+		case strings.HasPrefix(word, "-"):
+			if flag := findCompletionFlag(node, word); flag != nil && !flag.IsBool() && !strings.Contains(word, "=") {
+				pendingFlag = flag
+			}
+		default:
+			if child := findCompletionChild(node, word); child != nil {
+				node = child
+				positional = 0
+				continue
+			}
+			positional++
+		}
+	}
+
+	var values []string
+	prefix := ""
+	switch {
+	case pendingFlag != nil:
+		values = completionValues(pendingFlag.Value, predict)
+	case strings.HasPrefix(current, "--") && strings.Contains(current, "="):
+		name, _, _ := strings.Cut(current, "=")
+		if flag := findCompletionFlag(node, name); flag != nil {
+			prefix = name + "="
+			values = completionValues(flag.Value, predict)
+		}
+	case strings.HasPrefix(current, "-"):
+		for _, group := range node.AllFlags(true) {
+			for _, flag := range group {
+				values = append(values, "--"+flag.Name)
+			}
+		}
+	default:
+		for _, child := range node.Children {
+			if !child.Hidden {
+				values = append(values, child.Name)
+			}
+		}
+		if len(values) == 0 && positional < len(node.Positional) {
+			values = completionValues(node.Positional[positional], predict)
+		}
+	}
+
+	var candidates []string
+	for _, value := range values {
+		if candidate := prefix + value; strings.HasPrefix(candidate, current) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	return candidates
+}
+
+// findCompletionChild returns the subcommand named word (or one of its aliases)
+func findCompletionChild(node *kong.Node, word string) *kong.Node {
+	for _, child := range node.Children {
+		if child.Name == word || slices.Contains(child.Aliases, word) {
+			return child
+		}
+	}
+	return nil
+}
+
+// findCompletionFlag returns the flag of node or its parents matching "--name", "--name=value", or "-x"
+func findCompletionFlag(node *kong.Node, word string) *kong.Flag {
+	name, _, _ := strings.Cut(word, "=")
+	for _, group := range node.AllFlags(false) {
+		for _, flag := range group {
+			if name == "--"+flag.Name || (flag.Short != 0 && name == "-"+string(flag.Short)) {
+				return flag
+			}
+		}
+	}
+	return nil
+}
+
+// completionValues returns the allowed values of a flag or argument
+func completionValues(value *kong.Value, predict func(predictor string) []string) []string {
+	if value.Enum != "" {
+		return value.EnumSlice()
+	}
+	if predictor := value.Tag.Get("predictor"); predictor != "" {
+		return predict(predictor)
+	}
+	return nil
+}
+
+// predictValues queries the database and settings for dynamic completion values
+// Errors are logged and produce no candidates: completion must never print errors
+func predictValues(cli *CLI, predictor string) []string {
+	ctx := context.Background()
+
+	switch predictor {
+	case "repo", "session":
+		sessions, err := cli.Container.SessionService.ListSessions(ctx, true)
+		if err != nil {
+			logging.Logger.Debug("Failed to list sessions for completion", "error", err)
+			return nil
+		}
+		var values []string
+		for _, session := range sessions {
+			value := session.Name
+			if predictor == "repo" {
+				value = session.RepoInfo
+			}
+			if value != "" && !slices.Contains(values, value) {
+				values = append(values, value)
+			}
+		}
+		slices.Sort(values)
+		return values
+
+	case "preset":
+		presets, err := config.BuiltinPresets()
+		if err != nil {
+			logging.Logger.Debug("Failed to list presets for completion", "error", err)
+			return nil
+		}
+		values := make([]string, 0, len(presets))
+		for _, preset := range presets {
+			values = append(values, preset.Name)
+		}
+		return values
+
+	case "priority":
+		values := []string{domain.PriorityNone.String()}
+		for _, priority := range domain.Priorities {
+			values = append(values, string(priority))
+		}
+		return values
+
+	case "status":
+		statuses, err := cli.Container.SettingsService.GetAvailableStatuses()
+		if err != nil {
+			logging.Logger.Debug("Failed to get statuses for completion", "error", err)
+			return nil
+		}
+		return statuses
+
+	case "repo_bookmark":
+		bookmarks, err := cli.Container.RepoBookmarkService.ListBookmarks(ctx)
+		if err != nil {
+			logging.Logger.Debug("Failed to list repository bookmarks for completion", "error", err)
+			return nil
+		}
+		values := make([]string, 0, len(bookmarks))
+		for _, bookmark := range bookmarks {
+			values = append(values, bookmark.Name)
+		}
+		return values
+
+	case "workspace":
+		workspaces, err := cli.Container.WorkspaceService.ListWorkspaces(ctx)
+		if err != nil {
+			logging.Logger.Debug("Failed to list workspaces for completion", "error", err)
+			return nil
+		}
+		values := make([]string, 0, len(workspaces))
+		for _, workspace := range workspaces {
+			values = append(values, workspace.Name)
+		}
+		return values
+	}
+
+	return nil
+}
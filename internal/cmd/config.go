@@ -0,0 +1,275 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ui"
+)
+
+// ConfigCmd reads and writes settings.json, so scripts and dotfile managers don't edit the JSON by hand
+type ConfigCmd struct {
+	ApplyPreset  ConfigApplyPresetCmd  `cmd:"apply-preset" help:"Replace the key bindings and theme with a preset (vim, emacs, minimal, or a shared file)"`
+	ExportPreset ConfigExportPresetCmd `cmd:"export-preset" help:"Save the current key bindings and theme as a preset file to share"`
+	Get          ConfigGetCmd          `cmd:"get" help:"Print the effective value of a setting"`
+	List         ConfigListCmd         `cmd:"list" help:"List the effective value of every setting and where it comes from" default:"1"`
+	Presets      ConfigPresetsCmd      `cmd:"presets" help:"List the built-in key binding and theme presets"`
+	Set          ConfigSetCmd          `cmd:"set" help:"Set a setting, checking the value against its type"`
+	Unset        ConfigUnsetCmd        `cmd:"unset" help:"Remove a setting so the profile below it or the default applies"`
+}
+
+// ConfigScopeFlags selects the profile and repository a config command works on
+type ConfigScopeFlags struct {
+	Profile string `help:"Profile to read or write (reads default to ROCHA_PROFILE, writes to the top level)"`
+	Repo    string `help:"Repository (owner/repo) of a per-repository setting: checks, git_identities, guardrails, ticket_sync, worktree_bootstrap"`
+}
+
+// scope returns the settings scope of the flags
+func (f ConfigScopeFlags) scope() config.SettingScope {
+	return config.SettingScope{Profile: f.Profile, Repo: f.Repo}
+}
+
+// describe names where a write goes, for confirmation messages
+func (f ConfigScopeFlags) describe() string {
+	where := config.GetSettingsPath()
+	if f.Profile != "" {
+		where = fmt.Sprintf("profile %s of %s", f.Profile, where)
+	}
+	if f.Repo != "" {
+		where = fmt.Sprintf("%s for %s", where, f.Repo)
+	}
+	return where
+}
+
+// ConfigGetCmd prints the effective value of a setting
+type ConfigGetCmd struct {
+	ConfigScopeFlags `embed:""`
+
+	Format string `help:"Output format: table (value only) or json (with its source)" enum:"table,json" default:"table"`
+	Key    string `arg:"" help:"Setting, with dots for nested values (e.g. editor, tracing.exporter, keys.archive)"`
+}
+
+// Run executes the get command
+func (c *ConfigGetCmd) Run(cli *CLI) error {
+	value, err := config.GetSetting(c.Key, c.scope())
+	if err != nil {
+		return err
+	}
+
+	if c.Format == "json" {
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	fmt.Println(formatSettingValue(value.Value))
+	return nil
+}
+
+// ConfigListCmd lists the effective value of every setting and its source
+type ConfigListCmd struct {
+	ConfigScopeFlags `embed:""`
+
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+}
+
+// Run executes the list command
+func (c *ConfigListCmd) Run(cli *CLI) error {
+	values, err := config.ListSettings(c.scope())
+	if err != nil {
+		return err
+	}
+
+	if c.Format == "json" {
+		data, err := json.MarshalIndent(values, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("Settings file: %s\n", config.GetSettingsPath())
+	if profile := c.scope().Profile; profile != "" {
+		fmt.Printf("Profile: %s\n", profile)
+	} else if profile := config.ActiveProfile(); profile != "" {
+		fmt.Printf("Profile: %s (%s)\n", profile, config.ProfileEnvVar)
+	}
+	fmt.Println()
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "KEY\tVALUE\tSOURCE")
+	for _, value := range values {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", value.Key, truncateLine(formatSettingValue(value.Value), 80), value.Source)
+	}
+	return w.Flush()
+}
+
+// ConfigSetCmd sets a setting
+type ConfigSetCmd struct {
+	ConfigScopeFlags `embed:""`
+
+	Key   string `arg:"" help:"Setting, with dots for nested values (e.g. editor, tracing.exporter, keys.archive)"`
+	Value string `arg:"" help:"Value; lists and objects as JSON, lists of strings may be comma-separated"`
+}
+
+// Run executes the set command
+func (c *ConfigSetCmd) Run(cli *CLI) error {
+	if name, ok := strings.CutPrefix(c.Key, "keys."); ok && !ui.IsValidKeyName(name) {
+		return fmt.Errorf("%w: unknown key '%s'. Valid keys: %s",
+			domain.ErrInvalidInput, name, strings.Join(ui.GetValidKeyNames(), ", "))
+	}
+
+	if c.Key == "language" {
+		if _, err := i18n.ParseLanguage(c.Value); err != nil {
+			return err
+		}
+	}
+
+	logging.Logger.Info("Setting config value", "key", c.Key, "profile", c.Profile, "repo", c.Repo)
+	if err := config.SetSetting(c.Key, c.Value, c.scope()); err != nil {
+		return err
+	}
+	fmt.Printf("Set %s in %s\n", c.Key, c.describe())
+	return nil
+}
+
+// ConfigUnsetCmd removes a setting
+type ConfigUnsetCmd struct {
+	ConfigScopeFlags `embed:""`
+
+	Key string `arg:"" help:"Setting, with dots for nested values (e.g. editor, tracing.exporter, keys.archive)"`
+}
+
+// Run executes the unset command
+func (c *ConfigUnsetCmd) Run(cli *CLI) error {
+	logging.Logger.Info("Unsetting config value", "key", c.Key, "profile", c.Profile, "repo", c.Repo)
+	removed, err := config.UnsetSetting(c.Key, c.scope())
+	if err != nil {
+		return err
+	}
+	if !removed {
+		fmt.Printf("%s is not set in %s\n", c.Key, c.describe())
+		return nil
+	}
+	fmt.Printf("Unset %s in %s\n", c.Key, c.describe())
+	return nil
+}
+
+// ConfigPresetsCmd lists the built-in presets
+type ConfigPresetsCmd struct {
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+}
+
+// Run executes the presets command
+func (c *ConfigPresetsCmd) Run(cli *CLI) error {
+	presets, err := config.BuiltinPresets()
+	if err != nil {
+		return err
+	}
+
+	if c.Format == "json" {
+		data, err := json.MarshalIndent(presets, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tKEYS\tTHEME\tDESCRIPTION")
+	for _, preset := range presets {
+		theme := "no"
+		if preset.Theme != nil {
+			theme = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", preset.Name, len(preset.Keys), theme, preset.Description)
+	}
+	return w.Flush()
+}
+
+// ConfigApplyPresetCmd replaces the key bindings and theme with a preset
+type ConfigApplyPresetCmd struct {
+	Profile string `help:"Profile to write the preset to (default: the top level)"`
+	Preset  string `arg:"" help:"Built-in preset name (see rocha config presets) or preset file (.json)" predictor:"preset"`
+}
+
+// Run executes the apply-preset command
+func (c *ConfigApplyPresetCmd) Run(cli *CLI) error {
+	preset, err := config.LoadPreset(c.Preset)
+	if err != nil {
+		return err
+	}
+	if err := ui.ValidateKeyBindings(preset.Keys); err != nil {
+		return fmt.Errorf("%w: preset %s: %v", domain.ErrInvalidInput, preset.Name, err)
+	}
+
+	scope := ConfigScopeFlags{Profile: c.Profile}
+	logging.Logger.Info("Applying preset", "preset", preset.Name, "profile", c.Profile)
+	if err := config.ApplyPreset(preset, scope.scope()); err != nil {
+		return err
+	}
+	fmt.Printf("Applied preset %s to %s (%d key bindings", preset.Name, scope.describe(), len(preset.Keys))
+	if preset.Theme != nil {
+		fmt.Print(" and a theme")
+	}
+	fmt.Println("); restart the TUI to use it")
+	return nil
+}
+
+// ConfigExportPresetCmd saves the current key bindings and theme as a preset file
+type ConfigExportPresetCmd struct {
+	Description string `help:"Description shown by rocha config presets and when applying it"`
+	Name        string `help:"Preset name (default: the file name)"`
+	Output      string `arg:"" help:"Preset file to write (.json)" type:"path"`
+	Profile     string `help:"Profile to read from (default: ROCHA_PROFILE)"`
+}
+
+// Run executes the export-preset command
+func (c *ConfigExportPresetCmd) Run(cli *CLI) error {
+	name := c.Name
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(c.Output), filepath.Ext(c.Output))
+	}
+
+	preset, err := config.CurrentPreset(name, c.Description, config.SettingScope{Profile: c.Profile})
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(preset, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	if err := os.WriteFile(c.Output, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write preset: %w", err)
+	}
+	fmt.Printf("Saved preset %s to %s (apply it with: rocha config apply-preset %s)\n", name, c.Output, c.Output)
+	return nil
+}
+
+// formatSettingValue shows strings as they are and other values as JSON ("-" when not set)
+func formatSettingValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "-"
+	case string:
+		return v
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprintf("%v", v)
+		}
+		return string(data)
+	}
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// DoctorCmd looks for sessions out of step with tmux and cleans them up
+type DoctorCmd struct {
+	Fix    bool   `help:"Clean up the problems found"`
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+}
+
+// orphanShellJSON is the JSON form of an orphan shell session
+type orphanShellJSON struct {
+	Name    string `json:"name"`
+	Parent  string `json:"parent"`
+	Running bool   `json:"running"`
+}
+
+// doctorReportJSON is the JSON form of the doctor report
+type doctorReportJSON struct {
+	Fixed        bool              `json:"fixed"`
+	OrphanShells []orphanShellJSON `json:"orphan_shells"`
+}
+
+// Run executes the doctor command
+func (d *DoctorCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing doctor command", "fix", d.Fix)
+
+	ctx := context.Background()
+	shellService := cli.Container.ShellService
+
+	var orphans []domain.OrphanShell
+	var err error
+	if d.Fix {
+		orphans, err = shellService.CleanOrphanShells(ctx)
+	} else {
+		orphans, err = shellService.FindOrphanShells(ctx)
+	}
+	if err != nil && orphans == nil {
+		return fmt.Errorf("failed to check shell sessions: %w", err)
+	}
+
+	if d.Format == "json" {
+		report := doctorReportJSON{Fixed: d.Fix, OrphanShells: []orphanShellJSON{}}
+		for _, orphan := range orphans {
+			report.OrphanShells = append(report.OrphanShells, orphanShellJSON{Name: orphan.Name, Parent: orphan.ParentName, Running: orphan.Running})
+		}
+		encoded, jsonErr := json.MarshalIndent(report, "", "  ")
+		if jsonErr != nil {
+			return fmt.Errorf("failed to encode report: %w", jsonErr)
+		}
+		fmt.Println(string(encoded))
+		return err
+	}
+
+	if len(orphans) == 0 {
+		fmt.Println("✓ No orphan shell sessions")
+		return err
+	}
+
+	for _, orphan := range orphans {
+		fmt.Printf("⚠ %s\n", describeOrphanShell(orphan, d.Fix))
+	}
+	if d.Fix {
+		fmt.Printf("Cleaned up %d orphan shell session(s)\n", len(orphans))
+	} else {
+		fmt.Println("Run 'rocha doctor --fix' to clean them up; the TUI also does every minute")
+	}
+	return err
+}
+
+// describeOrphanShell explains what is wrong with an orphan shell, or what was done about it
+func describeOrphanShell(orphan domain.OrphanShell, fixed bool) string {
+	switch {
+	case orphan.Running && fixed:
+		return fmt.Sprintf("%s: killed, session '%s' was not running", orphan.Name, orphan.ParentName)
+	case orphan.Running:
+		return fmt.Sprintf("%s: running although session '%s' is not", orphan.Name, orphan.ParentName)
+	case fixed:
+		return fmt.Sprintf("%s: forgotten, it was no longer running", orphan.Name)
+	default:
+		return fmt.Sprintf("%s: recorded for session '%s' although it no longer runs", orphan.Name, orphan.ParentName)
+	}
+}
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/alecthomas/kong"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// Exit codes returned by the CLI so scripts can branch on the failure type.
+// Code 80 is reserved for usage errors reported by the argument parser.
+const (
+	ExitError           = 1 // Unclassified failure
+	ExitNotFound        = 3 // Session, tmux session, scheduled prompt, or workspace does not exist, or a setting is not set
+	ExitConflict        = 4 // Session or workspace already exists, worktree has uncommitted changes, or another TUI runs
+	ExitTmuxUnavailable = 5 // tmux binary is missing
+	ExitInvalidInput    = 6 // Arguments are valid syntax but not acceptable, or a guardrail blocks them
+)
+
+// errorCategory maps a set of sentinel errors to a machine-readable category and exit code
+type errorCategory struct {
+	code    int
+	name    string
+	targets []error
+}
+
+// errorCategories is checked in order; the first category matching the error wins
+var errorCategories = []errorCategory{
+	{code: ExitNotFound, name: "not_found", targets: []error{domain.ErrSessionNotFound, domain.ErrAttachmentNotFound, domain.ErrScheduledPromptNotFound, domain.ErrShareNotFound, domain.ErrStashNotFound, domain.ErrTranscriptNotFound, domain.ErrWorkspaceNotFound, domain.ErrRepoBookmarkNotFound, ports.ErrTmuxSessionNotFound, config.ErrSettingNotSet}},
+	{code: ExitConflict, name: "conflict", targets: []error{domain.ErrSessionExists, domain.ErrWorkspaceExists, domain.ErrInstanceRunning, domain.ErrRepoBookmarkExists, domain.ErrWorktreeDirty, ports.ErrTmuxSessionExists}},
+	{code: ExitTmuxUnavailable, name: "tmux_unavailable", targets: []error{ports.ErrTmuxUnavailable}},
+	{code: ExitInvalidInput, name: "invalid_input", targets: []error{domain.ErrInvalidInput, domain.ErrGuardrail, domain.ErrConfirmationRequired}},
+}
+
+// errorEnvelope is the JSON shape of errors printed with --format json
+type errorEnvelope struct {
+	Error errorBody `json:"error"`
+}
+
+type errorBody struct {
+	Category string `json:"category"`
+	Code     int    `json:"code"`
+	Message  string `json:"message"`
+}
+
+// classifyError returns the category name and exit code for err
+func classifyError(err error) (string, int) {
+	for _, category := range errorCategories {
+		for _, target := range category.targets {
+			if errors.Is(err, target) {
+				return category.name, category.code
+			}
+		}
+	}
+	return "error", ExitError
+}
+
+// ReportError prints a command failure to w and returns the exit code to use.
+// When the selected command was run with --format json, the error is printed as a JSON envelope.
+func ReportError(ctx *kong.Context, w io.Writer, err error) int {
+	category, code := classifyError(err)
+
+	if !wantsJSONErrors(ctx) {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		for _, hint := range remediationHints(err) {
+			fmt.Fprintf(w, "Hint: %s\n", hint)
+		}
+		return code
+	}
+
+	data, marshalErr := json.Marshal(errorEnvelope{Error: errorBody{
+		Category: category,
+		Code:     code,
+		Message:  err.Error(),
+	}})
+	if marshalErr != nil {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		return code
+	}
+	fmt.Fprintln(w, string(data))
+	return code
+}
+
+// remediationHints returns the steps that may fix err, for errors that know them
+// (such as a repository that could not be reached)
+func remediationHints(err error) []string {
+	var hinted interface{ RemediationHints() []string }
+	if errors.As(err, &hinted) {
+		return hinted.RemediationHints()
+	}
+	return nil
+}
+
+// wantsJSONErrors reports whether the selected command has a --format flag set to json
+func wantsJSONErrors(ctx *kong.Context) bool {
+	if ctx == nil {
+		return false
+	}
+	for _, flag := range ctx.Flags() {
+		if flag.Name == "format" {
+			if value, ok := ctx.FlagValue(flag).(string); ok && value == "json" {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -2,52 +2,124 @@ package cmd
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
+	"text/template"
+	"time"
 
+	adapterbadges "github.com/renato0307/rocha/internal/adapters/badges"
+	adapterbootstrap "github.com/renato0307/rocha/internal/adapters/bootstrap"
 	adapterclaude "github.com/renato0307/rocha/internal/adapters/claude"
+	adapterclipboard "github.com/renato0307/rocha/internal/adapters/clipboard"
 	adaptereditor "github.com/renato0307/rocha/internal/adapters/editor"
 	adaptergit "github.com/renato0307/rocha/internal/adapters/git"
+	adapterinstance "github.com/renato0307/rocha/internal/adapters/instance"
+	adapterjournal "github.com/renato0307/rocha/internal/adapters/journal"
+	adapterkeychain "github.com/renato0307/rocha/internal/adapters/keychain"
+	adaptermetrics "github.com/renato0307/rocha/internal/adapters/metrics"
 	adapterprocess "github.com/renato0307/rocha/internal/adapters/process"
+	adapterscreen "github.com/renato0307/rocha/internal/adapters/screen"
 	adaptersound "github.com/renato0307/rocha/internal/adapters/sound"
 	adapterstorage "github.com/renato0307/rocha/internal/adapters/storage"
+	adaptersummarizer "github.com/renato0307/rocha/internal/adapters/summarizer"
+	adaptertickets "github.com/renato0307/rocha/internal/adapters/tickets"
 	adaptertmux "github.com/renato0307/rocha/internal/adapters/tmux"
+	adaptertracing "github.com/renato0307/rocha/internal/adapters/tracing"
+	adapterviewer "github.com/renato0307/rocha/internal/adapters/viewer"
+	adapterwebhook "github.com/renato0307/rocha/internal/adapters/webhook"
+	adapterzellij "github.com/renato0307/rocha/internal/adapters/zellij"
+	"github.com/renato0307/rocha/internal/api"
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/services/actions"
 )
 
 // Container holds all dependencies for the application
 type Container struct {
 	// Services
-	GitService          *services.GitService
-	HookStatsService    *services.HookStatsService
-	MigrationService    *services.MigrationService
-	NotificationService *services.NotificationService
-	SessionService      *services.SessionService
-	SettingsService     *services.SettingsService
-	ShellService        *services.ShellService
-	TokenStatsService   *services.TokenStatsService
+	ActionsService           *actions.Service
+	ActivityStatsService     *services.ActivityStatsService
+	AttachmentService        *services.AttachmentService
+	BadgeService             *services.BadgeService
+	CheckService             *services.CheckService
+	CheckpointService        *services.CheckpointService
+	CIService                *services.CIService
+	ClipboardService         *services.ClipboardService
+	DebugMetricsService      *services.DebugMetricsService
+	DiffSummaryService       *services.DiffSummaryService
+	EscalationService        *services.EscalationService
+	GitService               *services.GitService
+	HistoryPruneService      *services.HistoryPruneService
+	HookJournalService       *services.HookJournalService
+	HookStatsService         *services.HookStatsService
+	InstanceService          *services.InstanceService
+	MetricsService           *services.MetricsService
+	MigrationService         *services.MigrationService
+	NotificationService      *services.NotificationService
+	OneShotService           *services.OneShotService
+	PauseService             *services.PauseService
+	RemoteFetchService       *services.RemoteFetchService
+	RepoBookmarkService      *services.RepoBookmarkService
+	ReportService            *services.ReportService
+	RuleService              *services.RuleService
+	SchedulerService         *services.SchedulerService
+	SessionService           *services.SessionService
+	SettingsService          *services.SettingsService
+	ShareService             *services.ShareService
+	ShellService             *services.ShellService
+	TicketSyncService        *services.TicketSyncService
+	TimerService             *services.TimerService
+	TokenBudgetService       *services.TokenBudgetService
+	TokenStatsService        *services.TokenStatsService
+	ToolAuditService         *services.ToolAuditService
+	TranscriptService        *services.TranscriptService
+	WorkspaceService         *services.WorkspaceService
+	WorktreeBootstrapService *services.WorktreeBootstrapService
+	WorktreeGCService        *services.WorktreeGCService
 
-	// Internal - for cleanup only
-	sessionRepo ports.SessionRepository
+	// Internal
+	metricsRegistry *adaptermetrics.Registry
+	sessionRepo     *adapterstorage.SQLiteRepository // For cleanup and database metrics
+	tracer          *adaptertracing.Tracer           // Nil unless tracing is configured
 }
 
 // NewContainer creates a new Container with all dependencies wired
-func NewContainer() (*Container, error) {
+// settings may be nil when no settings file exists
+func NewContainer(settings *config.Settings) (*Container, error) {
 	// Create adapters
 	sessionRepo, err := adapterstorage.NewSQLiteRepository(config.GetDBPath())
 	if err != nil {
 		return nil, err
 	}
 
-	// Create default tmux client if not provided
-	tmuxClient := adaptertmux.NewClient()
+	sessionManager := newSessionManager(settings)
 	editorOpener := adaptereditor.NewOpener()
-	gitRepo := adaptergit.NewCLIRepository()
-	processInspector := adapterprocess.NewOSProcessInspector()
+	clipboardReader := adapterclipboard.NewReader()
+	clipboardWriter := adapterclipboard.NewWriter()
+	var gitRepo ports.GitRepository = adaptergit.NewCLIRepository()
+	processInspector := adapterprocess.NewOSProcessInspector(sessionManager)
 	soundPlayer := adaptersound.NewPlayer()
+	eventPublisher := newEventPublisher(settings)
+
+	// Time git fetches as they happen, for the metrics endpoint
+	metricsRegistry := adaptermetrics.NewRegistry()
+	gitRepo = adaptermetrics.NewGitRepository(gitRepo, metricsRegistry)
+
+	// Trace database statements and git operations when tracing is configured
+	tracer := newTracer(settings)
+	if tracer != nil {
+		if err := sessionRepo.SetTracer(tracer); err != nil {
+			logging.Logger.Warn("Failed to trace database operations", "error", err)
+		}
+		gitRepo = adaptertracing.NewGitRepository(gitRepo, tracer)
+	}
 
 	// Create ClaudeDir resolver
 	claudeDirResolver := NewClaudeDirResolverAdapter(sessionRepo)
@@ -58,40 +130,565 @@ func NewContainer() (*Container, error) {
 	}
 
 	// Create services
+	activityStatsService := services.NewActivityStatsService(sessionRepo)
+	clipboardService := services.NewClipboardService(sessionRepo, clipboardWriter, clipboardReader)
+	debugMetricsService := services.NewDebugMetricsService(sessionRepo, hookMetricsEnabled(settings))
+	escalationService := services.NewEscalationService(newEscalationPolicy(settings), soundPlayer, eventPublisher)
 	gitService := services.NewGitService(gitRepo)
-	migrationService := services.NewMigrationService(gitRepo, tmuxClient, repoFactory)
-	notificationService := services.NewNotificationService(sessionRepo, sessionRepo, soundPlayer)
-	sessionService := services.NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	migrationService := services.NewMigrationService(gitRepo, sessionManager, repoFactory)
+	notificationService := services.NewNotificationService(sessionRepo, sessionRepo, soundPlayer, eventPublisher, sessionRepo)
+	hookJournalService := services.NewHookJournalService(adapterjournal.NewFileJournal(config.GetJournalPath()), notificationService)
+	schedulerService := services.NewSchedulerService(sessionRepo, sessionRepo, sessionRepo, sessionManager, newConcurrencyLimit(settings))
+	schedulerService.SetPromptReview(newPromptReview(settings))
+	pauseService := services.NewPauseService(sessionRepo, sessionRepo, sessionRepo, sessionManager, schedulerService,
+		multiPublisher{eventRecorder{sessionRepo}, eventPublisher})
+	attachmentService := services.NewAttachmentService(sessionRepo, sessionRepo, adapterviewer.NewViewer(), schedulerService)
+	ticketSyncService := services.NewTicketSyncService(sessionRepo, sessionRepo, adapterkeychain.NewStore(), newTicketSyncRules(settings), newTicketTracker)
+	worktreeBootstrapService := services.NewWorktreeBootstrapService(adapterbootstrap.NewRunner(), newBootstrapSteps(settings))
+	worktreeBootstrapService.SetEnvTools(settings != nil && settings.EnvTools != nil && *settings.EnvTools)
+	sessionService := services.NewSessionService(sessionRepo, gitRepo, sessionManager, claudeDirResolver, processInspector,
+		multiPublisher{eventRecorder{sessionRepo}, eventPublisher, ticketSyncService}, worktreeBootstrapService)
+	sessionService.SetGitCredentials(newGitCredentials(settings))
+	sessionService.SetGitIdentities(newGitIdentities(settings))
+	sessionService.SetGuardrails(newGuardrails(settings))
+	sessionService.SetAutoNaming(newDisplayNameTemplate(settings), ticketSyncService)
+	sessionService.SetWorktreePaths(newWorktreePaths(settings))
+	if tracer != nil {
+		sessionService.SetTracer(tracer)
+	}
+	checkpointService := services.NewCheckpointService(sessionRepo, gitRepo)
+	ciService := services.NewCIService(sessionRepo, soundPlayer, eventPublisher)
+	actionsService := actions.NewService(sessionService, gitService, checkpointService)
+	repoBookmarkService := services.NewRepoBookmarkService(sessionRepo, gitRepo)
+	ruleService := services.NewRuleService(newRules(settings), sessionService, soundPlayer, eventPublisher)
 	settingsService := services.NewSettingsService(sessionRepo)
-	shellService := services.NewShellService(sessionRepo, sessionRepo, tmuxClient, editorOpener)
+	shareService := services.NewShareService(sessionRepo, sessionRepo, sessionManager)
+	shellService := services.NewShellService(sessionRepo, sessionRepo, sessionManager, editorOpener, gitRepo, newEditorIntegration(settings))
+	shellService.SetAutoCleanup(settings != nil && settings.CleanOrphanShells != nil && *settings.CleanOrphanShells)
+	timerService := services.NewTimerService(sessionRepo, soundPlayer, eventPublisher)
+	toolAuditService := services.NewToolAuditService(sessionRepo, sessionRepo)
+	workspaceService := services.NewWorkspaceService(sessionRepo, sessionRepo)
+	worktreeGCService := services.NewWorktreeGCService(sessionRepo, gitRepo, newWorktreeRetention(settings))
 
-	// Create token stats service
+	// Create token stats and budget services
 	sessionParser := adapterclaude.NewSessionParser()
 	tokenStatsService := services.NewTokenStatsService(sessionParser)
+	tokenBudgetService := services.NewTokenBudgetService(sessionRepo, sessionParser, schedulerService, soundPlayer, eventPublisher, tokenBudgetWrapUpPrompt(settings))
+	transcriptService := services.NewTranscriptService(sessionRepo, adapterclaude.NewTranscriptReader(), config.GetTranscriptsPath())
+	reportService := services.NewReportService(sessionRepo, sessionRepo, gitRepo, config.GetReportsPath())
+	oneShotService := services.NewOneShotService(sessionService, transcriptService, hookJournalService, sessionRepo, gitRepo)
 
 	// Create hook stats service
 	hookParser := adapterclaude.NewHookParser(sessionRepo)
 	hookStatsService := services.NewHookStatsService(hookParser)
 
+	// Create metrics service; database latencies are only recorded once the endpoint is enabled
+	metricsService := services.NewMetricsService(sessionRepo, sessionRepo, tokenStatsService)
+
 	return &Container{
-		GitService:          gitService,
-		HookStatsService:    hookStatsService,
-		MigrationService:    migrationService,
-		NotificationService: notificationService,
-		SessionService:      sessionService,
-		SettingsService:     settingsService,
-		ShellService:        shellService,
-		TokenStatsService:   tokenStatsService,
-		sessionRepo:         sessionRepo,
+		ActionsService:           actionsService,
+		ActivityStatsService:     activityStatsService,
+		AttachmentService:        attachmentService,
+		BadgeService:             newBadgeService(settings),
+		CheckService:             services.NewCheckService(sessionManager, checkCommands(settings), config.GetChecksPath()),
+		CheckpointService:        checkpointService,
+		CIService:                ciService,
+		ClipboardService:         clipboardService,
+		DebugMetricsService:      debugMetricsService,
+		DiffSummaryService:       services.NewDiffSummaryService(sessionRepo, gitRepo, adaptersummarizer.NewCommandSummarizer(summaryCommand(settings))),
+		EscalationService:        escalationService,
+		GitService:               gitService,
+		HistoryPruneService:      services.NewHistoryPruneService(sessionRepo, sessionRepo),
+		HookJournalService:       hookJournalService,
+		HookStatsService:         hookStatsService,
+		InstanceService:          services.NewInstanceService(adapterinstance.NewFileLock(config.GetInstanceLockPath())),
+		MetricsService:           metricsService,
+		MigrationService:         migrationService,
+		NotificationService:      notificationService,
+		OneShotService:           oneShotService,
+		PauseService:             pauseService,
+		RemoteFetchService:       services.NewRemoteFetchService(sessionRepo, gitRepo, newBackgroundFetchInterval(settings)),
+		RepoBookmarkService:      repoBookmarkService,
+		ReportService:            reportService,
+		RuleService:              ruleService,
+		SchedulerService:         schedulerService,
+		SessionService:           sessionService,
+		SettingsService:          settingsService,
+		ShareService:             shareService,
+		ShellService:             shellService,
+		TicketSyncService:        ticketSyncService,
+		TimerService:             timerService,
+		TokenBudgetService:       tokenBudgetService,
+		TokenStatsService:        tokenStatsService,
+		ToolAuditService:         toolAuditService,
+		TranscriptService:        transcriptService,
+		WorkspaceService:         workspaceService,
+		WorktreeBootstrapService: worktreeBootstrapService,
+		WorktreeGCService:        worktreeGCService,
+		metricsRegistry:          metricsRegistry,
+		sessionRepo:              sessionRepo,
+		tracer:                   tracer,
 	}, nil
 }
 
-// Close closes all resources held by the container
+// newEventPublisher creates the webhook publisher from settings
+// Without webhook settings the publisher is a no-op
+func newEventPublisher(settings *config.Settings) ports.EventPublisher {
+	if settings == nil || settings.Webhook == nil {
+		return adapterwebhook.NewClient("", nil, nil)
+	}
+	logging.Logger.Debug("Webhook configured", "events", settings.Webhook.Events)
+	return adapterwebhook.NewClient(settings.Webhook.URL, settings.Webhook.Headers, settings.Webhook.Events)
+}
+
+// eventRecorder stores published events, so status changes and archives show in
+// activity reports and the detail pane like the state changes recorded by hooks
+type eventRecorder struct {
+	eventRepo ports.EventRepository
+}
+
+// Publish implements ports.EventPublisher
+func (r eventRecorder) Publish(ctx context.Context, event domain.Event) error {
+	return r.eventRepo.AddEvent(ctx, event)
+}
+
+// multiPublisher sends each event to every publisher, joining their errors
+type multiPublisher []ports.EventPublisher
+
+// Publish implements ports.EventPublisher
+func (m multiPublisher) Publish(ctx context.Context, event domain.Event) error {
+	var errs []error
+	for _, publisher := range m {
+		if err := publisher.Publish(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// newTicketSyncRules reads the per-repository ticket sync rules from settings
+// Rules with an unknown provider or an invalid key pattern are skipped
+func newTicketSyncRules(settings *config.Settings) map[string]domain.TicketSyncRule {
+	rules := make(map[string]domain.TicketSyncRule)
+	if settings == nil {
+		return rules
+	}
+	for repo, cfg := range settings.TicketSync {
+		provider := domain.TicketProvider(cfg.Provider)
+		if provider != domain.TicketProviderJira && provider != domain.TicketProviderLinear {
+			logging.Logger.Warn("Ignoring ticket sync with unknown provider", "repo", repo, "provider", cfg.Provider)
+			continue
+		}
+
+		rule := domain.TicketSyncRule{
+			Account:   cfg.Account,
+			BaseURL:   cfg.BaseURL,
+			Provider:  provider,
+			StatusMap: cfg.StatusMap,
+		}
+		if rule.Account == "" {
+			rule.Account = services.DefaultTicketAccount
+		}
+		if cfg.KeyPattern != "" {
+			pattern, err := regexp.Compile(cfg.KeyPattern)
+			if err != nil {
+				logging.Logger.Warn("Ignoring ticket sync with invalid key pattern", "repo", repo, "error", err)
+				continue
+			}
+			rule.KeyPattern = pattern
+		}
+		rules[repo] = rule
+	}
+	if len(rules) > 0 {
+		logging.Logger.Debug("Ticket sync configured", "repos", len(rules))
+	}
+	return rules
+}
+
+// newBootstrapSteps reads the per-repository worktree bootstrap steps from settings
+// A repository with an invalid step is skipped entirely, so steps never run out of order
+func newBootstrapSteps(settings *config.Settings) map[string][]domain.BootstrapStep {
+	steps := make(map[string][]domain.BootstrapStep)
+	if settings == nil {
+		return steps
+	}
+	for repo, cfg := range settings.WorktreeBootstrap {
+		repoSteps := make([]domain.BootstrapStep, 0, len(cfg))
+		for _, stepCfg := range cfg {
+			step := domain.BootstrapStep{Copy: stepCfg.Copy, Run: stepCfg.Run}
+			if err := step.Validate(); err != nil {
+				logging.Logger.Warn("Ignoring worktree bootstrap with invalid step", "repo", repo, "error", err)
+				repoSteps = nil
+				break
+			}
+			repoSteps = append(repoSteps, step)
+		}
+		if len(repoSteps) > 0 {
+			steps[repo] = repoSteps
+		}
+	}
+	if len(steps) > 0 {
+		logging.Logger.Debug("Worktree bootstrap configured", "repos", len(steps))
+	}
+	return steps
+}
+
+// newGitCredentials reads the per-repository git credentials from settings
+func newGitCredentials(settings *config.Settings) map[string]domain.GitCredentials {
+	credentials := make(map[string]domain.GitCredentials)
+	if settings == nil {
+		return credentials
+	}
+	for repo, cfg := range settings.GitCredentials {
+		repoCredentials := domain.GitCredentials{Helper: cfg.CredentialHelper, SSHCommand: cfg.SSHCommand}
+		if !repoCredentials.IsZero() {
+			credentials[repo] = repoCredentials
+		}
+	}
+	return credentials
+}
+
+// newGitIdentities reads the per-repository git identities from settings, skipping invalid ones
+func newGitIdentities(settings *config.Settings) map[string]domain.GitIdentity {
+	identities := make(map[string]domain.GitIdentity)
+	if settings == nil {
+		return identities
+	}
+	for repo, cfg := range settings.GitIdentities {
+		identity := domain.GitIdentity{Email: cfg.Email, Name: cfg.Name, SigningKey: cfg.SigningKey}
+		if err := identity.Validate(); err != nil {
+			logging.Logger.Warn("Ignoring invalid git identity", "repo", repo, "error", err)
+			continue
+		}
+		if !identity.IsZero() {
+			identities[repo] = identity
+		}
+	}
+	return identities
+}
+
+// newGuardrails reads the per-repository guardrails from settings, skipping invalid ones
+func newGuardrails(settings *config.Settings) map[string]domain.Guardrails {
+	guardrails := make(map[string]domain.Guardrails)
+	if settings == nil {
+		return guardrails
+	}
+	for repo, cfg := range settings.Guardrails {
+		repoGuardrails := domain.Guardrails{ProtectedBranches: cfg.ProtectedBranches}
+		for _, dir := range cfg.BlockedPaths {
+			repoGuardrails.BlockedPaths = append(repoGuardrails.BlockedPaths, config.ExpandPath(dir))
+		}
+		if err := repoGuardrails.Validate(); err != nil {
+			logging.Logger.Warn("Ignoring invalid guardrails", "repo", repo, "error", err)
+			continue
+		}
+		if !repoGuardrails.IsZero() {
+			guardrails[repo] = repoGuardrails
+		}
+	}
+	return guardrails
+}
+
+// newPromptReview reads the patterns prompts to skip-permissions sessions are reviewed against
+// Returns nil, turning the review off, unless it is enabled; invalid patterns fall back to the defaults.
+func newPromptReview(settings *config.Settings) *domain.PromptReview {
+	if settings == nil || settings.PromptReview == nil || !settings.PromptReview.Enabled {
+		return nil
+	}
+
+	review, err := domain.NewPromptReview(settings.PromptReview.Patterns)
+	if err != nil {
+		logging.Logger.Warn("Ignoring invalid prompt review patterns, using the defaults", "error", err)
+		review, _ = domain.NewPromptReview(nil)
+	}
+	return review
+}
+
+// newWorktreePaths reads the per-repository worktree path templates from settings, skipping invalid ones
+func newWorktreePaths(settings *config.Settings) map[string]*template.Template {
+	templates := make(map[string]*template.Template)
+	if settings == nil {
+		return templates
+	}
+	for repo, text := range settings.WorktreePaths {
+		tmpl, err := domain.ParseWorktreePathTemplate(text)
+		if err != nil {
+			logging.Logger.Warn("Ignoring invalid worktree path template", "repo", repo, "error", err)
+			continue
+		}
+		templates[repo] = tmpl
+	}
+	return templates
+}
+
+// newDisplayNameTemplate reads the template naming sessions after their branch or issue
+// Unset or invalid settings use the default template
+func newDisplayNameTemplate(settings *config.Settings) *template.Template {
+	var text string
+	if settings != nil {
+		text = settings.DisplayNameTemplate
+	}
+	tmpl, err := domain.ParseDisplayNameTemplate(text)
+	if err != nil {
+		logging.Logger.Warn("Ignoring invalid display name template", "error", err)
+		tmpl, _ = domain.ParseDisplayNameTemplate("")
+	}
+	return tmpl
+}
+
+// newEditorIntegration reads the default editor integration from settings
+func newEditorIntegration(settings *config.Settings) domain.EditorIntegration {
+	if settings == nil {
+		return domain.EditorIntegrationDefault
+	}
+	integration, err := domain.ParseEditorIntegration(settings.EditorIntegration)
+	if err != nil {
+		logging.Logger.Warn("Ignoring invalid editor integration", "error", err)
+		return domain.EditorIntegrationDefault
+	}
+	return integration
+}
+
+// newSessionManager creates the terminal multiplexer adapter selected in settings
+func newSessionManager(settings *config.Settings) ports.SessionManager {
+	switch multiplexerName(settings) {
+	case config.MultiplexerScreen:
+		return adapterscreen.NewClient()
+	case config.MultiplexerZellij:
+		return adapterzellij.NewClient()
+	default:
+		return adaptertmux.NewClient()
+	}
+}
+
+// multiplexerName returns the multiplexer selected in settings
+// Unset and unknown multiplexers fall back to tmux
+func multiplexerName(settings *config.Settings) string {
+	if settings == nil || settings.Multiplexer == "" {
+		return config.MultiplexerTmux
+	}
+	switch settings.Multiplexer {
+	case config.MultiplexerScreen, config.MultiplexerTmux, config.MultiplexerZellij:
+		return settings.Multiplexer
+	}
+	logging.Logger.Warn("Ignoring unknown multiplexer, using tmux", "multiplexer", settings.Multiplexer)
+	return config.MultiplexerTmux
+}
+
+// newTicketTracker creates the tracker client for a ticket sync rule
+func newTicketTracker(rule domain.TicketSyncRule, token string) ports.TicketTracker {
+	if rule.Provider == domain.TicketProviderJira {
+		return adaptertickets.NewJiraClient(rule.BaseURL, rule.Account, token)
+	}
+	return adaptertickets.NewLinearClient(rule.BaseURL, token)
+}
+
+// hookMetricsEnabled reports whether hooks record their timings, set with ROCHA_HOOK_METRICS=1
+// or hook_metrics in settings
+func hookMetricsEnabled(settings *config.Settings) bool {
+	if os.Getenv("ROCHA_HOOK_METRICS") == "1" {
+		return true
+	}
+	return settings != nil && settings.HookMetrics != nil && *settings.HookMetrics
+}
+
+// newConcurrencyLimit reads the working session limits from settings
+// Without settings the limit is unlimited
+func newConcurrencyLimit(settings *config.Settings) domain.ConcurrencyLimit {
+	var limit domain.ConcurrencyLimit
+	if settings == nil {
+		return limit
+	}
+	if settings.MaxWorkingSessions != nil {
+		limit.Global = *settings.MaxWorkingSessions
+	}
+	if settings.MaxWorkingSessionsPerRepo != nil {
+		limit.PerRepo = *settings.MaxWorkingSessionsPerRepo
+	}
+	if !limit.IsUnlimited() {
+		logging.Logger.Debug("Concurrency limit configured", "global", limit.Global, "per_repo", limit.PerRepo)
+	}
+	return limit
+}
+
+// tokenBudgetWrapUpPrompt reads the prompt sent to agents that exceed their token budget
+// Empty uses the default prompt
+func tokenBudgetWrapUpPrompt(settings *config.Settings) string {
+	if settings == nil {
+		return ""
+	}
+	return settings.TokenBudgetWrapUpPrompt
+}
+
+// checkCommands returns the checks commands of repositories from settings
+func checkCommands(settings *config.Settings) map[string]string {
+	if settings == nil {
+		return nil
+	}
+	return settings.Checks
+}
+
+// summaryCommand returns the command that summarizes session diffs from settings
+// Empty uses the default command
+func summaryCommand(settings *config.Settings) string {
+	if settings == nil {
+		return ""
+	}
+	return settings.SummaryCommand
+}
+
+// newBadgeService creates the service running the badge command from settings
+// Without a command, sessions get no custom badges.
+func newBadgeService(settings *config.Settings) *services.BadgeService {
+	if settings == nil || settings.Badges == nil || settings.Badges.Command == "" {
+		return services.NewBadgeService(nil, 0)
+	}
+
+	interval := services.DefaultBadgeInterval
+	if settings.Badges.IntervalSeconds > 0 {
+		interval = time.Duration(settings.Badges.IntervalSeconds) * time.Second
+	}
+	logging.Logger.Debug("Badge command configured", "command", settings.Badges.Command, "interval", interval)
+	return services.NewBadgeService(adapterbadges.NewCommandProvider(settings.Badges.Command), interval)
+}
+
+// newBackgroundFetchInterval reads how often session repositories are fetched in the background
+// Unset, zero, or negative settings turn background fetches off.
+func newBackgroundFetchInterval(settings *config.Settings) time.Duration {
+	if settings == nil || settings.BackgroundFetchMinutes == nil || *settings.BackgroundFetchMinutes <= 0 {
+		return 0
+	}
+	logging.Logger.Debug("Background fetch configured", "minutes", *settings.BackgroundFetchMinutes)
+	return time.Duration(*settings.BackgroundFetchMinutes) * time.Minute
+}
+
+// newWorktreeRetention reads how long archived sessions keep their worktrees from settings
+// Unset or invalid settings keep worktrees forever
+func newWorktreeRetention(settings *config.Settings) domain.WorktreeRetention {
+	if settings == nil || settings.WorktreeRetentionDays == nil {
+		return domain.WorktreeRetention{}
+	}
+	retention, err := domain.NewWorktreeRetention(*settings.WorktreeRetentionDays)
+	if err != nil {
+		logging.Logger.Warn("Ignoring invalid worktree retention", "error", err)
+		return domain.WorktreeRetention{}
+	}
+	if retention.IsEnabled() {
+		logging.Logger.Debug("Worktree retention configured", "days", *settings.WorktreeRetentionDays)
+	}
+	return retention
+}
+
+// newRules reads the workflow rules from settings
+// Invalid rules are skipped; rocha rules test reports them
+func newRules(settings *config.Settings) []domain.Rule {
+	if settings == nil {
+		return nil
+	}
+	rules := make([]domain.Rule, 0, len(settings.Rules))
+	for _, cfg := range settings.Rules {
+		rule, err := domain.NewRule(cfg.Name, cfg.When, cfg.Then)
+		if err != nil {
+			logging.Logger.Warn("Ignoring invalid rule", "error", err)
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) > 0 {
+		logging.Logger.Debug("Workflow rules configured", "rules", len(rules))
+	}
+	return rules
+}
+
+// newTracer creates the OpenTelemetry tracer with the exporter selected in settings
+// An OTLP endpoint in the standard OTEL_EXPORTER_OTLP_* variables enables the otlp exporter
+// without settings, and OTEL_SDK_DISABLED=true turns tracing off.
+// Returns nil when tracing is not configured or its exporter cannot be created.
+func newTracer(settings *config.Settings) *adaptertracing.Tracer {
+	if os.Getenv("OTEL_SDK_DISABLED") == "true" {
+		return nil
+	}
+	cfg := &config.TracingSettings{}
+	switch {
+	case settings != nil && settings.Tracing != nil:
+		cfg = settings.Tracing
+	case os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") == "" && os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT") == "":
+		return nil
+	}
+
+	switch cfg.Exporter {
+	case config.TracingExporterFile:
+		path := config.GetTracesPath()
+		if cfg.File != "" {
+			path = config.ExpandPath(cfg.File)
+		}
+		logging.Logger.Debug("Tracing configured", "exporter", cfg.Exporter, "file", path)
+		exporter, err := adaptertracing.NewFileExporter(path)
+		if err != nil {
+			logging.Logger.Warn("Tracing disabled", "error", err)
+			return nil
+		}
+		return adaptertracing.NewTracer(exporter)
+	case config.TracingExporterOTLP, "":
+		logging.Logger.Debug("Tracing configured", "exporter", config.TracingExporterOTLP, "endpoint", cfg.Endpoint)
+		exporter, err := adaptertracing.NewOTLPExporter(context.Background(), cfg.Endpoint, cfg.Headers)
+		if err != nil {
+			logging.Logger.Warn("Tracing disabled", "error", err)
+			return nil
+		}
+		return adaptertracing.NewTracer(exporter)
+	}
+	logging.Logger.Warn("Ignoring tracing with unknown exporter", "exporter", cfg.Exporter)
+	return nil
+}
+
+// newEscalationPolicy reads the waiting escalation thresholds from settings
+// Without settings sessions never escalate
+func newEscalationPolicy(settings *config.Settings) domain.EscalationPolicy {
+	var policy domain.EscalationPolicy
+	if settings == nil || settings.WaitingEscalation == nil {
+		return policy
+	}
+	cfg := settings.WaitingEscalation
+	policy.After = time.Duration(cfg.Minutes) * time.Minute
+	policy.Bell = cfg.Bell == nil || *cfg.Bell
+	policy.Webhook = cfg.Webhook
+	policy.StatusAfter = make(map[string]time.Duration, len(cfg.StatusMinutes))
+	for status, minutes := range cfg.StatusMinutes {
+		policy.StatusAfter[status] = time.Duration(minutes) * time.Minute
+	}
+	if policy.IsEnabled() {
+		logging.Logger.Debug("Waiting escalation configured", "minutes", cfg.Minutes, "statuses", len(cfg.StatusMinutes))
+	}
+	return policy
+}
+
+// MetricsHandler starts recording database latencies and returns the Prometheus metrics handler
+// The values it serves are the ones of the last MetricsService.Refresh
+func (c *Container) MetricsHandler() (http.Handler, error) {
+	if err := c.sessionRepo.SetMetricsRecorder(c.metricsRegistry); err != nil {
+		return nil, err
+	}
+	return c.metricsRegistry.Handler(c.MetricsService.Collect), nil
+}
+
+// APIServer returns the REST API server, authenticating requests with token
+func (c *Container) APIServer(token string) *api.Server {
+	return api.NewServer(c.SessionService, c.ActionsService, c.SchedulerService, c.SettingsService, c.CIService, c.sessionRepo, token)
+}
+
+// Close exports the pending traces and closes all resources held by the container
 func (c *Container) Close() error {
+	var errs []error
+	if c.tracer != nil {
+		if err := c.tracer.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("failed to export traces: %w", err))
+		}
+	}
 	if c.sessionRepo != nil {
-		return c.sessionRepo.Close()
+		errs = append(errs, c.sessionRepo.Close())
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 // ClaudeDirResolverAdapter implements application.ClaudeDirResolver
@@ -17,7 +17,7 @@ type HooksCmd struct {
 	Format      string `help:"Output format (table or json)" default:"table" enum:"table,json" short:"f"`
 	From        string `help:"Start time (RFC3339 or relative)"`
 	Limit       int    `help:"Maximum number of results" default:"100" short:"l"`
-	SessionName string `arg:"" optional:"" help:"Rocha session name"`
+	SessionName string `arg:"" optional:"" help:"Rocha session name" predictor:"session"`
 	To          string `help:"End time (RFC3339 or relative)"`
 }
 
@@ -104,7 +104,7 @@ func (h *HooksCmd) renderTable(events []domain.HookEvent) {
 		fmt.Printf("%-16s %-17s %-20s %s\n",
 			sessionName,
 			eventType,
-			e.Timestamp.Format("2006-01-02 15:04:05"),
+			formatTime(e.Timestamp),
 			hookName)
 	}
 }
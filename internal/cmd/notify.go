@@ -3,5 +3,6 @@ package cmd
 // NotifyCmd is the container for notification subcommands
 type NotifyCmd struct {
 	Handle   NotifyHandleCmd   `cmd:"handle" help:"Handle notification event from Claude hooks" default:"withargs"`
+	Journal  NotifyJournalCmd  `cmd:"journal" help:"Show, drain, or retry hook events not yet applied to the state database"`
 	ShowLogs NotifyShowLogsCmd `cmd:"show-logs" help:"Display hook execution logs"`
 }
@@ -2,23 +2,31 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 )
 
+// hookDrainTimeout bounds how long a hook waits for another process draining the hook journal
+const hookDrainTimeout = 5 * time.Second
+
 // NotifyHandleCmd handles notification events from Claude hooks
 // NOTE: Field order matters for Kong positional args - SessionName must come before EventType
 type NotifyHandleCmd struct {
 	SessionName string `arg:"" help:"Name of the session triggering the notification"`
-	EventType   string `arg:"" help:"Type of event: stop, prompt, working, start, notification, end, permission-request, tool-complete, tool-failure, subagent-start, subagent-stop, pre-compact, setup" default:"stop"`
+	EventType   string `arg:"" help:"Type of event: stop, prompt, working, start, notification, end, permission-request, tool-complete, tool-audit, tool-failure, subagent-start, subagent-stop, pre-compact, setup" default:"stop"`
 	ExecutionID string `help:"Execution ID from parent rocha TUI" optional:""`
 }
 
 // Run executes the notification handler
 func (n *NotifyHandleCmd) Run(cli *CLI) error {
+	invokedAt := time.Now()
+
 	// Always initialize hook-specific logging for easier debugging
 	hookLogFile, err := logging.InitHookLogger(n.SessionName, n.EventType)
 	if err != nil {
@@ -54,18 +62,48 @@ func (n *NotifyHandleCmd) Run(cli *CLI) error {
 		logging.Logger.Debug("Skipping sound for event type", "event", n.EventType)
 	}
 
-	// Handle event using the service
-	_, err = cli.Container.NotificationService.HandleEvent(
-		context.Background(),
+	// Journal the event and apply it; if the database is busy, a later drain retries it
+	handleStart := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), hookDrainTimeout)
+	state, err := cli.Container.HookJournalService.Record(
+		ctx,
 		n.SessionName,
 		n.EventType,
 		executionID,
 	)
+	cancel()
 	if err != nil {
-		logging.Logger.Error("Failed to handle notification event", "error", err)
-		return nil // Don't fail notification on state errors
+		// Not fatal: the event stays in the journal, and the rest of the hook still runs
+		logging.Logger.Error("Failed to drain hook journal", "error", err)
+	}
+
+	switch n.EventType {
+	case "start":
+		// Remember the Claude conversation so an exited session can be resumed later
+		if input := readHookInput(os.Stdin); input.SessionID != "" {
+			if err := cli.Container.NotificationService.RecordClaudeSessionID(context.Background(), n.SessionName, input.SessionID); err != nil {
+				logging.Logger.Warn("Failed to record Claude session ID", "error", err)
+			}
+		}
+	case "tool-audit", "tool-failure":
+		// Keep the audit trail of sessions that skip permission prompts
+		input := readHookInput(os.Stdin)
+		if err := cli.Container.ToolAuditService.RecordToolUse(context.Background(), n.SessionName, input.ToolName, input.ToolInput, n.EventType == "tool-failure"); err != nil {
+			logging.Logger.Warn("Failed to audit tool use", "error", err)
+		}
 	}
 
+	// Record timings for the debug screen (diagnoses state detection latency)
+	handledAt := time.Now()
+	cli.Container.DebugMetricsService.RecordHookEvent(context.Background(), domain.HookMetric{
+		EventType:      n.EventType,
+		HandleDuration: handledAt.Sub(handleStart),
+		HandledAt:      handledAt,
+		InvokedAt:      invokedAt,
+		SessionName:    n.SessionName,
+		State:          state,
+	})
+
 	// Future: Add OS native notifications here
 	// For example:
 	// - Linux: notify-send
@@ -74,3 +112,25 @@ func (n *NotifyHandleCmd) Run(cli *CLI) error {
 
 	return nil
 }
+
+// hookInput is the subset of the JSON payload Claude writes to hook stdin that rocha uses
+type hookInput struct {
+	SessionID string          `json:"session_id"`
+	ToolInput json.RawMessage `json:"tool_input"` // Only for tool hooks
+	ToolName  string          `json:"tool_name"`  // Only for tool hooks
+}
+
+// readHookInput decodes the hook payload
+// Returns an empty input when stdin is a terminal or does not hold a hook payload
+func readHookInput(stdin *os.File) hookInput {
+	if stat, err := stdin.Stat(); err != nil || stat.Mode()&os.ModeCharDevice != 0 {
+		return hookInput{}
+	}
+
+	var input hookInput
+	if err := json.NewDecoder(io.LimitReader(stdin, 1<<20)).Decode(&input); err != nil {
+		logging.Logger.Debug("No hook payload on stdin", "error", err)
+		return hookInput{}
+	}
+	return input
+}
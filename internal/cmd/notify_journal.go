@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// NotifyJournalCmd shows the hook events waiting in the hook journal and those given up on
+type NotifyJournalCmd struct {
+	Clear   bool `help:"Discard the hook events given up on"`
+	Drain   bool `help:"Apply the pending hook events now"`
+	Requeue bool `help:"Retry the hook events given up on"`
+}
+
+// Run executes the journal command
+func (n *NotifyJournalCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing notify journal command", "clear", n.Clear, "drain", n.Drain, "requeue", n.Requeue)
+
+	if n.Clear && n.Requeue {
+		return fmt.Errorf("give --clear or --requeue, not both: %w", domain.ErrInvalidInput)
+	}
+
+	service := cli.Container.HookJournalService
+	switch {
+	case n.Clear:
+		cleared, err := service.ClearDeadLetters()
+		if err != nil {
+			return fmt.Errorf("failed to clear dead letters: %w", err)
+		}
+		fmt.Printf("Discarded %d hook event(s)\n", cleared)
+		return nil
+	case n.Requeue:
+		requeued, err := service.RequeueDeadLetters()
+		if err != nil {
+			return fmt.Errorf("failed to requeue dead letters: %w", err)
+		}
+		fmt.Printf("Requeued %d hook event(s)\n", requeued)
+	}
+
+	if n.Drain || n.Requeue {
+		ctx, cancel := context.WithTimeout(context.Background(), hookDrainTimeout)
+		defer cancel()
+		applied, err := service.Drain(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to drain hook journal: %w", err)
+		}
+		fmt.Printf("Applied %d hook event(s)\n", applied)
+	}
+
+	pending, err := service.ListPending()
+	if err != nil {
+		return fmt.Errorf("failed to list pending hook events: %w", err)
+	}
+	dead, err := service.ListDeadLetters()
+	if err != nil {
+		return fmt.Errorf("failed to list dead letters: %w", err)
+	}
+
+	fmt.Printf("Pending: %d\n", len(pending))
+	printJournalEntries(pending)
+	fmt.Printf("Given up on: %d\n", len(dead))
+	printJournalEntries(dead)
+	return nil
+}
+
+// printJournalEntries prints one line per journal entry, with its last error if any
+func printJournalEntries(entries []domain.HookJournalEntry) {
+	for _, entry := range entries {
+		line := fmt.Sprintf("  %s  %-20s %-18s", formatTime(entry.ReceivedAt), entry.SessionName, entry.EventType)
+		if entry.Attempts > 0 {
+			line += fmt.Sprintf(" %d attempt(s): %s", entry.Attempts, entry.LastError)
+		}
+		fmt.Println(line)
+	}
+}
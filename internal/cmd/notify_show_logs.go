@@ -15,7 +15,7 @@ import (
 
 // NotifyShowLogsCmd displays hook execution logs
 type NotifyShowLogsCmd struct {
-	Session string `arg:"" optional:"" help:"Filter by session name"`
+	Session string `arg:"" optional:"" help:"Filter by session name" predictor:"session"`
 	Since   string `help:"Show logs since duration (e.g., '1h', '30m')" default:"1h"`
 	Format  string `help:"Output format (table or json)" default:"table" enum:"table,json"`
 }
@@ -118,7 +118,7 @@ func (s *NotifyShowLogsCmd) outputTable(entries []hookLogEntry) error {
 	fmt.Println(strings.Repeat("-", 100))
 
 	for _, e := range entries {
-		timestamp := e.Timestamp.Format("2006-01-02 15:04:05")
+		timestamp := formatTime(e.Timestamp)
 		session := truncate(e.Session, 15)
 		event := truncate(e.Event, 20)
 		level := truncate(e.Level, 7)
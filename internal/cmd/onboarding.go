@@ -0,0 +1,146 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+const (
+	demoSessionName   = "rocha-demo"
+	demoSessionPrompt = "Hi! Briefly introduce yourself and explain how you can help in this directory."
+)
+
+// errOnboardingAborted is returned when the user cancels the onboarding wizard
+var errOnboardingAborted = errors.New("onboarding aborted")
+
+// needsOnboarding reports whether this is a first run: no settings file and no sessions
+func needsOnboarding(cli *CLI) bool {
+	if config.SettingsExist() {
+		return false
+	}
+
+	// The wizard is interactive - never block scripts or pipes
+	if stat, err := os.Stdin.Stat(); err != nil || stat.Mode()&os.ModeCharDevice == 0 {
+		return false
+	}
+
+	st, err := cli.Container.SessionService.LoadState(context.Background(), true)
+	if err != nil {
+		logging.Logger.Warn("Failed to load state for onboarding check", "error", err)
+		return false
+	}
+
+	return len(st.Sessions) == 0
+}
+
+// runOnboarding guides the user through the first-run setup and writes settings.json
+// Returns the settings that were saved
+func runOnboarding(cli *CLI) (*config.Settings, error) {
+	logging.Logger.Info("Starting onboarding wizard")
+
+	fmt.Println("Welcome to rocha! Let's get you set up.")
+	fmt.Println()
+	if err := checkOnboardingDependencies(); err != nil {
+		return nil, err
+	}
+
+	editor := defaultOnboardingEditor()
+	tmuxStatusPosition := config.DefaultTmuxStatusPosition
+	showTimestamps := false
+	skipPermissions := false
+	createDemo := true
+
+	form := huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title("Editor").
+				Description("Command used to open sessions in your editor").
+				Value(&editor),
+			huh.NewSelect[string]().
+				Title("Tmux status bar position").
+				Options(
+					huh.NewOption("Bottom", "bottom"),
+					huh.NewOption("Top", "top"),
+				).
+				Value(&tmuxStatusPosition),
+			huh.NewConfirm().
+				Title("Show timestamps in the session list?").
+				Value(&showTimestamps),
+			huh.NewConfirm().
+				Title("Skip Claude permission prompts by default? (DANGEROUS)").
+				Description("Can be changed per session when creating it").
+				Value(&skipPermissions),
+		),
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title("Create a demo session?").
+				Description(fmt.Sprintf("Starts '%s' in the current directory with a short introduction prompt", demoSessionName)).
+				Value(&createDemo),
+		),
+	)
+
+	if err := form.Run(); err != nil {
+		if errors.Is(err, huh.ErrUserAborted) {
+			return nil, errOnboardingAborted
+		}
+		return nil, fmt.Errorf("onboarding form failed: %w", err)
+	}
+
+	settings := &config.Settings{
+		AllowDangerouslySkipPermissions: &skipPermissions,
+		Editor:                          strings.TrimSpace(editor),
+		ShowTimestamps:                  &showTimestamps,
+		TmuxStatusPosition:              tmuxStatusPosition,
+	}
+
+	if err := config.SaveSettings(settings); err != nil {
+		return nil, err
+	}
+	fmt.Printf("✓ Settings written to %s\n", config.GetSettingsPath())
+
+	if createDemo {
+		params := services.CreateSessionParams{
+			AllowDangerouslySkipPermissions: skipPermissions,
+			InitialPrompt:                   demoSessionPrompt,
+			SessionName:                     demoSessionName,
+			TmuxStatusPosition:              tmuxStatusPosition,
+		}
+		if _, err := cli.Container.SessionService.CreateSession(context.Background(), params); err != nil {
+			// The demo session is a nicety - don't block startup on it
+			logging.Logger.Warn("Failed to create demo session", "error", err)
+			fmt.Printf("✗ Failed to create demo session: %v\n", err)
+		} else {
+			fmt.Printf("✓ Created session '%s'\n", demoSessionName)
+		}
+	}
+
+	fmt.Println("Tip: run 'rocha setup' to add rocha to your PATH and tmux status bar")
+	logging.Logger.Info("Onboarding completed", "demo_session", createDemo)
+
+	return settings, nil
+}
+
+// checkOnboardingDependencies prints the dependency check and fails on missing required binaries
+func checkOnboardingDependencies() error {
+	return checkDependencies(os.Stdout, requiredDependencies(config.MultiplexerTmux), exec.LookPath)
+}
+
+// defaultOnboardingEditor suggests an editor from the environment, falling back to VS Code
+func defaultOnboardingEditor() string {
+	for _, env := range []string{"ROCHA_EDITOR", "VISUAL", "EDITOR"} {
+		if value := os.Getenv(env); value != "" {
+			return value
+		}
+	}
+	return "code"
+}
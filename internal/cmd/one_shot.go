@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/google/uuid"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// One-shot output files written to --output
+const (
+	oneShotDiffFile       = "changes.patch"
+	oneShotTranscriptFile = "transcript.md"
+)
+
+// runOneShot runs --prompt in a temporary session of --repo, then prints the agent's diff
+// (or saves it and the transcript to --output) and tears the session down.
+// Progress goes to stderr so the printed diff can be piped, e.g. into git apply.
+func (r *RunCmd) runOneShot(cli *CLI) error {
+	if r.Prompt == "" || r.Repo == "" {
+		return fmt.Errorf("%w: one-shot runs need both --repo and --prompt", domain.ErrInvalidInput)
+	}
+
+	repoSource := r.Repo
+	if _, err := os.Stat(config.ExpandPath(repoSource)); err == nil {
+		if abs, err := filepath.Abs(config.ExpandPath(repoSource)); err == nil {
+			repoSource = abs
+		}
+	}
+
+	skipPermissions := r.SkipPermissions
+	if !skipPermissions && cli.settings != nil && cli.settings.AllowDangerouslySkipPermissions != nil {
+		skipPermissions = *cli.settings.AllowDangerouslySkipPermissions
+	}
+
+	// Hooks of the agent report to this run, like to a TUI
+	os.Setenv("ROCHA_EXECUTION_ID", uuid.New().String())
+
+	// Ctrl+C stops waiting, and the session is still torn down
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "Running prompt in a temporary session of %s (timeout %s)...\n", repoSource, r.Timeout)
+	result, err := cli.Container.OneShotService.Run(ctx, services.OneShotParams{
+		AllowDangerouslySkipPermissions: skipPermissions,
+		BootstrapOutput:                 os.Stderr,
+		Prompt:                          r.Prompt,
+		RepoSource:                      repoSource,
+		Timeout:                         r.Timeout,
+		TmuxStatusPosition:              cli.Container.SettingsService.GetTmuxStatusPosition(),
+		TranscriptFormat:                domain.TranscriptMarkdown,
+	})
+	if result == nil {
+		return fmt.Errorf("one-shot run failed: %w", err)
+	}
+	if err != nil {
+		// Captured, but the session could not be fully cleaned up
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	if r.Output != "" {
+		if err := saveOneShotResult(r.Output, result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Print(result.Diff)
+	}
+
+	switch {
+	case result.TimedOut:
+		return fmt.Errorf("the agent did not finish within %s (changes so far were captured)", r.Timeout)
+	case result.State == domain.StateWaiting:
+		fmt.Fprintln(os.Stderr, "The agent stopped to wait for input (e.g. a permission prompt)")
+	case result.State == domain.StateExited:
+		fmt.Fprintln(os.Stderr, "The agent exited")
+	}
+	if result.Diff == "" {
+		fmt.Fprintln(os.Stderr, "The agent made no changes")
+	}
+	logging.Logger.Info("One-shot run done", "session", result.SessionName, "state", result.State)
+	return nil
+}
+
+// saveOneShotResult writes the diff and transcript of a one-shot run to dir
+func saveOneShotResult(dir string, result *services.OneShotResult) error {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	diffPath := filepath.Join(dir, oneShotDiffFile)
+	if err := os.WriteFile(diffPath, []byte(result.Diff), 0644); err != nil {
+		return fmt.Errorf("failed to write diff: %w", err)
+	}
+	fmt.Printf("Diff written to %s\n", diffPath)
+
+	if len(result.Transcript) == 0 {
+		fmt.Fprintln(os.Stderr, "No transcript was recorded")
+		return nil
+	}
+	transcriptPath := filepath.Join(dir, oneShotTranscriptFile)
+	if err := os.WriteFile(transcriptPath, result.Transcript, 0644); err != nil {
+		return fmt.Errorf("failed to write transcript: %w", err)
+	}
+	fmt.Printf("Transcript written to %s\n", transcriptPath)
+	return nil
+}
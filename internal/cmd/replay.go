@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ui"
+)
+
+// replayMaxPause caps the pauses between frames, so idle stretches of a recording don't stall playback
+const replayMaxPause = 2 * time.Second
+
+// ReplayCmd plays back a TUI recording made with rocha run --record
+type ReplayCmd struct {
+	File     string  `arg:"" help:"Recording made with rocha run --record" type:"existingfile"`
+	Messages bool    `help:"List the recorded messages instead of playing the frames"`
+	Speed    float64 `help:"Playback speed multiplier" default:"1"`
+	Step     bool    `help:"Wait for enter before each frame"`
+}
+
+// Run executes the replay command
+func (r *ReplayCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing replay command", "file", r.File, "messages", r.Messages)
+
+	if r.Speed <= 0 {
+		return fmt.Errorf("%w: speed must be greater than 0", domain.ErrInvalidInput)
+	}
+
+	f, err := os.Open(r.File)
+	if err != nil {
+		return fmt.Errorf("failed to open recording: %w", err)
+	}
+	defer f.Close()
+
+	entries, err := ui.ReadRecording(f)
+	if err != nil {
+		return err
+	}
+
+	if r.Messages {
+		return r.printMessages(entries)
+	}
+	return r.play(entries)
+}
+
+// printMessages lists every recorded message, marking the ones that rendered a frame
+func (r *ReplayCmd) printMessages(entries []ui.RecordingEntry) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "AT\tFRAME\tMESSAGE")
+	for _, entry := range entries {
+		frame := ""
+		if entry.Frame != "" {
+			frame = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", entry.At.Round(time.Millisecond), frame, entry.Describe())
+	}
+	return w.Flush()
+}
+
+// play draws the recorded frames in order, at the recorded pace or one per enter in step mode
+func (r *ReplayCmd) play(entries []ui.RecordingEntry) error {
+	total := 0
+	for _, entry := range entries {
+		if entry.Frame != "" {
+			total++
+		}
+	}
+	if total == 0 {
+		fmt.Println("The recording has no frames")
+		return nil
+	}
+
+	stdin := bufio.NewReader(os.Stdin)
+	var last time.Duration
+	shown := 0
+	for _, entry := range entries {
+		if entry.Frame == "" {
+			continue
+		}
+
+		if r.Step && shown > 0 {
+			if _, err := stdin.ReadString('\n'); err != nil {
+				return nil
+			}
+		} else {
+			time.Sleep(min(time.Duration(float64(entry.At-last)/r.Speed), replayMaxPause))
+		}
+		last = entry.At
+		shown++
+
+		// Clear the screen and draw the frame with what produced it below
+		fmt.Print("\x1b[H\x1b[2J")
+		fmt.Println(entry.Frame)
+		fmt.Printf("\n[%d/%d] %s after %s\n", shown, total, entry.At.Round(time.Millisecond), entry.Describe())
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// ReportCmd summarizes the sessions worked on over a period, for standup notes
+type ReportCmd struct {
+	Format string `help:"Output format: md or json" enum:"md,json" default:"md"`
+	Output string `help:"Write the report to this file instead of stdout" short:"o" type:"path"`
+	Since  string `help:"Start of the period: today, yesterday, a date (2006-01-02), or an age such as 12h or 7d" default:"yesterday"`
+}
+
+// Run executes the report command
+func (r *ReportCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing report command", "since", r.Since, "format", r.Format, "output", r.Output)
+
+	now := time.Now()
+	since, err := domain.ParseReportSince(r.Since, now)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.Container.ReportService.ExportReport(context.Background(), since, now, domain.ReportFormat(r.Format))
+	if err != nil {
+		return fmt.Errorf("failed to build report: %w", err)
+	}
+
+	if r.Output != "" {
+		if err := os.WriteFile(r.Output, data, 0644); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+		fmt.Printf("Report written to %s\n", r.Output)
+		return nil
+	}
+
+	fmt.Print(string(data))
+	return nil
+}
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// ReposCmd manages the repository bookmarks offered when creating sessions
+type ReposCmd struct {
+	Add  ReposAddCmd  `cmd:"add" help:"Bookmark a repository source"`
+	List ReposListCmd `cmd:"list" help:"List repository bookmarks, most used first" default:"1"`
+	Rm   ReposRmCmd   `cmd:"rm" help:"Remove a repository bookmark"`
+}
+
+// ReposListCmd lists repository bookmarks
+type ReposListCmd struct {
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+}
+
+// Run executes the list command
+func (r *ReposListCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing repos list command")
+
+	bookmarks, err := cli.Container.RepoBookmarkService.ListBookmarks(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to list repository bookmarks: %w", err)
+	}
+
+	if r.Format == "json" {
+		data, err := json.MarshalIndent(bookmarks, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(bookmarks) == 0 {
+		fmt.Println("No repository bookmarks. Add one with: rocha repos add <source> [--name <name>]")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tSOURCE\tUSES\tLAST USED")
+	for _, bookmark := range bookmarks {
+		lastUsed := "-"
+		if bookmark.LastUsedAt != nil {
+			lastUsed = formatTime(*bookmark.LastUsedAt)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%s\n", bookmark.Name, bookmark.Source, bookmark.UseCount, lastUsed)
+	}
+	return tw.Flush()
+}
+
+// ReposAddCmd bookmarks a repository source
+type ReposAddCmd struct {
+	Name   string `help:"Bookmark name (letters, digits, '-', '_', '.'); defaults to the repository name" short:"n"`
+	Source string `arg:"" help:"Git URL, optionally with #branch"`
+}
+
+// Run executes the add command
+func (r *ReposAddCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing repos add command", "name", r.Name, "source", r.Source)
+
+	bookmark, err := cli.Container.RepoBookmarkService.AddBookmark(context.Background(), r.Name, r.Source)
+	if err != nil {
+		return fmt.Errorf("failed to add repository bookmark: %w", err)
+	}
+
+	fmt.Printf("Bookmarked %s as '%s'\n", bookmark.Source, bookmark.Name)
+	return nil
+}
+
+// ReposRmCmd removes a repository bookmark
+type ReposRmCmd struct {
+	Name string `arg:"" help:"Bookmark name" predictor:"repo_bookmark"`
+}
+
+// Run executes the rm command
+func (r *ReposRmCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing repos rm command", "name", r.Name)
+
+	if err := cli.Container.RepoBookmarkService.DeleteBookmark(context.Background(), r.Name); err != nil {
+		return fmt.Errorf("failed to remove repository bookmark: %w", err)
+	}
+
+	fmt.Printf("Repository bookmark '%s' removed\n", r.Name)
+	return nil
+}
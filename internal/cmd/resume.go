@@ -0,0 +1,152 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// ResumeCmd recreates tmux sessions that are gone, e.g. after a reboot
+type ResumeCmd struct {
+	All    bool   `help:"Resume every non-archived session"`
+	Filter string `help:"Only sessions matching a filter query, e.g. 'state:idle tag:backend repo:rocha older:7d flagged' (other words match the name or branch)"`
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+}
+
+// resumeResultJSON is the JSON form of one resume result
+type resumeResultJSON struct {
+	Error   string `json:"error,omitempty"`
+	Name    string `json:"name"`
+	Outcome string `json:"outcome"`
+}
+
+// AfterApply validates that either --all or --filter is provided, but not both
+func (r *ResumeCmd) AfterApply() error {
+	if r.All && r.Filter != "" {
+		return fmt.Errorf("cannot specify both --all and --filter")
+	}
+	if !r.All && r.Filter == "" {
+		return fmt.Errorf("must specify either --all or --filter")
+	}
+	return nil
+}
+
+// Run executes the resume command
+func (r *ResumeCmd) Run(cli *CLI) error {
+	logging.Logger.Info("Executing resume command", "all", r.All, "filter", r.Filter)
+
+	filter, text, err := domain.ParseSessionFilter(r.Filter)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	sessions, err := cli.Container.SessionService.ListSessions(ctx, false)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+	sessions = matchResumeText(filter.Apply(sessions, time.Now()), text)
+
+	if len(sessions) == 0 {
+		if r.Format == "json" {
+			fmt.Println("[]")
+			return nil
+		}
+		fmt.Println("No sessions to resume")
+		return nil
+	}
+
+	tmuxStatusPosition := cli.Container.SettingsService.GetTmuxStatusPosition()
+	results := cli.Container.SessionService.ResumeSessions(ctx, sessions, tmuxStatusPosition)
+
+	if r.Format == "json" {
+		if err := printResumeJSON(results); err != nil {
+			return err
+		}
+	} else {
+		printResumeReport(results)
+	}
+
+	if failed := countResumeOutcome(results, services.ResumeOutcomeFailed); failed > 0 {
+		return fmt.Errorf("failed to resume %d of %d sessions", failed, len(results))
+	}
+	return nil
+}
+
+// matchResumeText keeps sessions whose name, display name, or branch contains every word of text
+func matchResumeText(sessions []domain.Session, text string) []domain.Session {
+	words := strings.Fields(strings.ToLower(text))
+	if len(words) == 0 {
+		return sessions
+	}
+
+	matched := make([]domain.Session, 0, len(sessions))
+	for _, sess := range sessions {
+		haystack := strings.ToLower(sess.Name + " " + sess.DisplayName + " " + sess.BranchName)
+		matchesAll := true
+		for _, word := range words {
+			if !strings.Contains(haystack, word) {
+				matchesAll = false
+				break
+			}
+		}
+		if matchesAll {
+			matched = append(matched, sess)
+		}
+	}
+	return matched
+}
+
+func printResumeReport(results []services.ResumeResult) {
+	for _, result := range results {
+		switch result.Outcome {
+		case services.ResumeOutcomeResumed:
+			fmt.Printf("  ✓ %s: resumed conversation\n", result.Name)
+		case services.ResumeOutcomeFresh:
+			fmt.Printf("  ✓ %s: started fresh (no conversation recorded)\n", result.Name)
+		case services.ResumeOutcomeRunning:
+			fmt.Printf("  · %s: already running\n", result.Name)
+		default:
+			fmt.Printf("  ✗ %s: %v\n", result.Name, result.Err)
+		}
+	}
+
+	fmt.Printf("\nResumed %d, started fresh %d, already running %d, failed %d\n",
+		countResumeOutcome(results, services.ResumeOutcomeResumed),
+		countResumeOutcome(results, services.ResumeOutcomeFresh),
+		countResumeOutcome(results, services.ResumeOutcomeRunning),
+		countResumeOutcome(results, services.ResumeOutcomeFailed))
+}
+
+func printResumeJSON(results []services.ResumeResult) error {
+	output := make([]resumeResultJSON, len(results))
+	for i, result := range results {
+		output[i] = resumeResultJSON{Name: result.Name, Outcome: string(result.Outcome)}
+		if result.Err != nil {
+			output[i].Error = result.Err.Error()
+		}
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func countResumeOutcome(results []services.ResumeResult, outcome services.ResumeOutcome) int {
+	count := 0
+	for _, result := range results {
+		if result.Outcome == outcome {
+			count++
+		}
+	}
+	return count
+}
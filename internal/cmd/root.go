@@ -2,10 +2,13 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
+	"slices"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/alecthomas/kong"
@@ -14,7 +17,9 @@ import (
 
 	"github.com/renato0307/rocha/internal/config"
 	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/theme"
 	"github.com/renato0307/rocha/internal/ui"
 )
 
@@ -24,18 +29,33 @@ type CLI struct {
 	Debug       bool             `help:"Enable debug logging to file" short:"d"`
 	DebugFile   string           `help:"Custom path for debug log file (disables automatic cleanup)"`
 	MaxLogFiles int              `help:"Maximum number of log files to keep (0 = unlimited)" default:"1000"`
+	TimeFormat  string           `help:"Layout of absolute timestamps: eu, iso, rfc3339, seconds, short, us, or a Go layout (overrides time_format)"`
+	TimeZone    string           `help:"Time zone of timestamps: local, utc, or a name such as Europe/Lisbon (overrides time_zone)"`
 
 	Run         RunCmd         `cmd:"" help:"Start the rocha TUI (default)" default:"1"`
 	Setup       SetupCmd       `cmd:"setup" help:"Configure tmux status bar integration automatically"`
 	Stats       StatsCmd       `cmd:"stats" help:"Show token usage statistics"`
 	Hooks       HooksCmd       `cmd:"hooks" help:"View Claude Code hook events"`
 	Status      StatusCmd      `cmd:"status" help:"Show session state counts for tmux status bar" hidden:""`
+	StatusLine  StatusLineCmd  `cmd:"status-line" help:"Summarize sessions in one line for the tmux status bar"`
 	Attach      AttachCmd      `cmd:"attach" help:"Attach to tmux session (creates if needed)"`
 	StartClaude StartClaudeCmd `cmd:"start-claude" help:"Start Claude Code with hooks configured" hidden:""`
 	PlaySound   PlaySoundCmd   `cmd:"play-sound" help:"Play notification sound (cross-platform)" hidden:""`
 	Notify      NotifyCmd      `cmd:"notify" help:"Handle notification event from Claude hooks" hidden:""`
 	Sessions    SessionsCmd    `cmd:"sessions" help:"Manage sessions (list, view, add, del)"`
+	Doctor      DoctorCmd      `cmd:"doctor" help:"Find shell sessions out of step with their session, and clean them up with --fix"`
+	Resume      ResumeCmd      `cmd:"resume" help:"Recreate tmux sessions that are gone (e.g. after a reboot), resuming Claude conversations"`
+	Repos       ReposCmd       `cmd:"repos" help:"Bookmark repositories offered when creating sessions (add, list, rm)"`
+	Replay      ReplayCmd      `cmd:"replay" help:"Play back a TUI recording made with rocha run --record, for bug reports"`
+	Report      ReportCmd      `cmd:"report" help:"Summarize the sessions worked on over a period, for standup notes"`
+	Rules       RulesCmd       `cmd:"rules" help:"Test the workflow rules set in settings.json"`
+	Scheduler   SchedulerCmd   `cmd:"scheduler" help:"Deliver scheduled prompts and save daily reports while the TUI is not running"`
 	Settings    SettingsCmd    `cmd:"settings" help:"Manage settings (meta)"`
+	Config      ConfigCmd      `cmd:"config" help:"Get, set, or unset settings, per profile or repository"`
+	Tickets     TicketsCmd     `cmd:"tickets" help:"Sync session statuses to Jira or Linear tickets"`
+	Workspaces  WorkspacesCmd  `cmd:"workspaces" help:"Group sessions into named workspaces"`
+	Completion  CompletionCmd  `cmd:"completion" help:"Print the shell completion script (bash, zsh, fish)"`
+	Complete    CompleteCmd    `cmd:"" name:"__complete" help:"Print completion candidates for the completion scripts" hidden:""`
 
 	// Internal fields (not flags)
 	Container *Container       `kong:"-"`
@@ -70,8 +90,26 @@ func (c *CLI) AfterApply() error {
 				}
 			}
 		}
+
+		// Apply time format settings
+		if c.TimeFormat == "" {
+			c.TimeFormat = c.settings.TimeFormat
+		}
+		if c.TimeZone == "" {
+			c.TimeZone = c.settings.TimeZone
+		}
 	}
 
+	// Timestamps in the TUI and in command output share one layout and time zone.
+	// An invalid one falls back to the default, so rocha config can still fix it.
+	format, err := domain.ParseTimeFormat(c.TimeFormat, c.TimeZone)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: ignoring time format: %v\n", err)
+		format = domain.TimeFormat{}
+	}
+	timeFormat = format
+	ui.SetTimeFormat(format)
+
 	// Initialize logging first and get the log file path
 	logFilePath, err := logging.Initialize(c.Debug, c.DebugFile, c.MaxLogFiles)
 	if err != nil {
@@ -93,7 +131,7 @@ func (c *CLI) AfterApply() error {
 
 	// Create container AFTER logging is initialized
 	// This fixes the nil pointer panic when GORM's logger calls logging.Logger.Debug()
-	container, err := NewContainer()
+	container, err := NewContainer(c.settings)
 	if err != nil {
 		return fmt.Errorf("failed to initialize container: %w", err)
 	}
@@ -112,15 +150,22 @@ func (c *CLI) Close() error {
 
 // RunCmd starts the TUI application
 type RunCmd struct {
+	Accessible                 bool   `help:"Accessibility mode: text labels instead of colored icons and symbols, no colors, one line per session" default:"false"`
+	AttachMode                 string `help:"How to open sessions when rocha runs inside tmux (attach, switch, window)" default:"attach" enum:"attach,switch,window"`
 	Dev                        bool   `help:"Enable development mode (shows version info in dialogs)"`
 	Editor                     string `help:"Editor to open sessions in (overrides $ROCHA_EDITOR, $VISUAL, $EDITOR)" default:"code"`
 	ErrorClearDelay            int    `help:"Seconds before error messages auto-clear" default:"10"`
+	HandoffPrompt              bool   `help:"Ask where you left off after detaching from a session (shown in the detail pane)"`
+	NoOnboarding               bool   `help:"Skip the first-run onboarding wizard"`
+	OtherInstance              string `help:"What to do when another rocha TUI or scheduler already runs the background work: follow it (show sessions without polling writes), switch to its tmux pane, or exit" default:"follow" enum:"follow,switch,exit"`
+	Record                     string `help:"Record every message the TUI receives and the frames it renders to this file, for bug reports (see rocha replay)" type:"path"`
 	ShowPRNumber               bool   `help:"Show PR number in git stats (fetched on detach)" default:"true"`
-	ShowTimestamps             bool   `help:"Show relative timestamps for last state changes" default:"false"`
+	ShowTimestamps             bool   `help:"Show timestamps for last state changes" default:"false"`
 	ShowTokenChart             bool   `help:"Show token usage chart by default" default:"false"`
 	StatusColors               string `help:"Comma-separated ANSI color codes for statuses (e.g., '141,33,214,226,46')" default:"141,33,214,226,46"`
 	StatusIcons                string `help:"Comma-separated status icons (optional, colors are used for display)" default:""`
 	Statuses                   string `help:"Comma-separated status names (e.g., 'spec,plan,implement,review,done')" default:"spec,plan,implement,review,done"`
+	TimestampMode              string `help:"How shown timestamps look: relative (5m ago), absolute, or hybrid (both)" default:"relative" enum:"relative,absolute,hybrid"`
 	TimestampRecentColor       string `help:"ANSI color code for recent timestamps" default:"241"`
 	TimestampRecentMinutes     int    `help:"Minutes threshold for recent timestamps (gray color)" default:"5"`
 	TimestampStaleColor        string `help:"ANSI color code for stale timestamps (matches waiting state ◐)" default:"1"`
@@ -130,10 +175,34 @@ type RunCmd struct {
 	TipsEnabled                bool   `help:"Enable rotating tips display" default:"true"`
 	TipsShowIntervalSeconds    int    `help:"Seconds between tips" default:"2"`
 	TmuxStatusPosition         string `help:"Tmux status bar position (top or bottom)" default:"bottom" enum:"top,bottom"`
+
+	// One-shot mode runs a prompt without the TUI (see runOneShot)
+	Output          string        `help:"One-shot: save the diff and transcript in this directory instead of printing the diff" type:"path"`
+	Prompt          string        `help:"One-shot: run this prompt in a temporary session of --repo and exit instead of starting the TUI"`
+	Repo            string        `help:"One-shot: repository (path or URL) to run --prompt in"`
+	SkipPermissions bool          `help:"One-shot: skip permission prompts in Claude (DANGEROUS)" name:"allow-dangerously-skip-permissions"`
+	Timeout         time.Duration `help:"One-shot: time the agent gets to finish the prompt" default:"30m"`
 }
 
 // Run executes the TUI
 func (r *RunCmd) Run(cli *CLI) error {
+	// Scripts run a single prompt without the TUI
+	if r.Prompt != "" || r.Repo != "" {
+		return r.runOneShot(cli)
+	}
+
+	// First run (no settings, no sessions): guide the user instead of showing an empty list
+	if !r.NoOnboarding && needsOnboarding(cli) {
+		settings, err := runOnboarding(cli)
+		if err != nil {
+			if errors.Is(err, errOnboardingAborted) {
+				return nil
+			}
+			return err
+		}
+		cli.settings = settings
+	}
+
 	// Apply RunCmd-specific settings with proper precedence
 	// Only apply if flag is at default value and env var is not set
 
@@ -147,6 +216,13 @@ func (r *RunCmd) Run(cli *CLI) error {
 			}
 		}
 
+		// Apply AttachMode setting
+		if r.AttachMode == config.AttachModeAttach {
+			if cli.settings.AttachMode != "" {
+				r.AttachMode = cli.settings.AttachMode
+			}
+		}
+
 		// Apply ErrorClearDelay setting
 		if r.ErrorClearDelay == 10 {
 			if cli.settings.ErrorClearDelay != nil {
@@ -179,6 +255,15 @@ func (r *RunCmd) Run(cli *CLI) error {
 			}
 		}
 
+		// Apply TimestampMode setting
+		if r.TimestampMode == "relative" {
+			if _, hasEnv := os.LookupEnv("ROCHA_TIMESTAMP_MODE"); !hasEnv {
+				if cli.settings.TimestampMode != "" {
+					r.TimestampMode = cli.settings.TimestampMode
+				}
+			}
+		}
+
 		// Apply TmuxStatusPosition setting
 		if r.TmuxStatusPosition == config.DefaultTmuxStatusPosition {
 			if _, hasEnv := os.LookupEnv("ROCHA_TMUX_STATUS_POSITION"); !hasEnv {
@@ -212,6 +297,20 @@ func (r *RunCmd) Run(cli *CLI) error {
 			}
 		}
 
+		// Apply Accessible setting
+		if !r.Accessible {
+			if cli.settings.Accessible != nil && *cli.settings.Accessible {
+				r.Accessible = true
+			}
+		}
+
+		// Apply HandoffPrompt setting
+		if !r.HandoffPrompt {
+			if cli.settings.HandoffPrompt != nil && *cli.settings.HandoffPrompt {
+				r.HandoffPrompt = true
+			}
+		}
+
 		// Apply ShowPRNumber setting (default is true, so check for explicit false)
 		if r.ShowPRNumber {
 			if cli.settings.ShowPRNumber != nil && !*cli.settings.ShowPRNumber {
@@ -220,6 +319,20 @@ func (r *RunCmd) Run(cli *CLI) error {
 		}
 	}
 
+	// Only one TUI (or the scheduler) polls and writes state; the others follow it
+	primary, err := cli.Container.InstanceService.Start("ui")
+	if err != nil {
+		logging.Logger.Warn("Failed to check for other rocha instances", "error", err)
+		primary = true
+	}
+	defer cli.Container.InstanceService.Stop()
+	if !primary {
+		handled, err := r.handleOtherInstance(cli, cli.Container.InstanceService.Holder())
+		if handled || err != nil {
+			return err
+		}
+	}
+
 	logging.Logger.Info("Starting rocha TUI")
 
 	// Generate new execution ID for this TUI run
@@ -249,8 +362,11 @@ func (r *RunCmd) Run(cli *CLI) error {
 		}
 		logging.Logger.Info("Syncing with running tmux sessions", "count", len(runningNames))
 
-		// Update execution ID for running sessions
+		// Update execution ID for running sessions, unless another TUI owns them
 		for _, sessionName := range runningNames {
+			if !primary {
+				break
+			}
 			if _, exists := st.Sessions[sessionName]; exists {
 				if err := cli.Container.SessionService.UpdateExecutionID(context.Background(), sessionName, executionID); err != nil {
 					logging.Logger.Error("Failed to update execution ID", "error", err, "session", sessionName)
@@ -270,6 +386,16 @@ func (r *RunCmd) Run(cli *CLI) error {
 	logging.Logger.Debug("Allow dangerously skip permissions default from settings",
 		"value", allowDangerouslySkipPermissionsDefault)
 
+	// Language of the TUI, set before the key map looks up its help text and tips
+	if cli.settings != nil {
+		language, err := i18n.ParseLanguage(cli.settings.Language)
+		if err != nil {
+			return fmt.Errorf("invalid language in settings.json: %w", err)
+		}
+		i18n.SetLanguage(language)
+		logging.Logger.Debug("UI language set", "language", language)
+	}
+
 	// Validate key bindings if configured
 	var keysConfig config.KeyBindingsConfig
 	if cli.settings != nil && cli.settings.Keys != nil {
@@ -280,6 +406,37 @@ func (r *RunCmd) Run(cli *CLI) error {
 		logging.Logger.Debug("Custom key bindings loaded and validated")
 	}
 
+	// Parse sort presets if configured
+	sortConfig, err := newSortConfig(cli.settings)
+	if err != nil {
+		return fmt.Errorf("invalid sort presets in settings.json: %w", err)
+	}
+	viewConfig, err := newViewConfig(cli.settings, sortConfig)
+	if err != nil {
+		return fmt.Errorf("invalid views in settings.json: %w", err)
+	}
+
+	// Accessibility mode: colors would only carry meaning the text labels already give
+	if r.Accessible {
+		theme.DisableColors()
+	}
+
+	// Colors of the theme in settings.json, such as one applied from a preset
+	if cli.settings != nil && cli.settings.Theme != nil {
+		if err := cli.settings.Theme.Validate(); err != nil {
+			return fmt.Errorf("invalid theme in settings.json: %w", err)
+		}
+		theme.ApplyPalette(newPalette(cli.settings.Theme))
+	}
+
+	timestampMode, err := ui.ParseTimestampMode(r.TimestampMode)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp_mode in settings.json: %w", err)
+	}
+	if !r.ShowTimestamps {
+		timestampMode = ui.TimestampHidden
+	}
+
 	// Set terminal to raw mode for proper input handling
 	logging.Logger.Debug("Initializing Bubble Tea program")
 	errorClearDelay := time.Duration(r.ErrorClearDelay) * time.Second
@@ -291,30 +448,97 @@ func (r *RunCmd) Run(cli *CLI) error {
 		r.TimestampWarningColor,
 		r.TimestampStaleColor,
 	)
+	if cli.settings != nil && cli.settings.WaitingEscalation != nil && cli.settings.WaitingEscalation.Color != "" {
+		timestampConfig.EscalatedColor = cli.settings.WaitingEscalation.Color
+	}
 	tipsConfig := ui.TipsConfig{
 		DisplayDurationSeconds: r.TipsDisplayDurationSeconds,
 		Enabled:                r.TipsEnabled,
 		ShowIntervalSeconds:    r.TipsShowIntervalSeconds,
 	}
+	tipsFile := config.GetTipsPath()
+	if cli.settings != nil {
+		tipsConfig.Custom = cli.settings.TipsCustom
+		tipsConfig.Disabled = cli.settings.TipsDisabled
+		if cli.settings.TipsFile != "" {
+			tipsFile = cli.settings.TipsFile
+		}
+	}
+	if fileTips, err := config.LoadTipsFile(tipsFile); err != nil {
+		logging.Logger.Warn("Failed to load tips file", "path", tipsFile, "error", err)
+	} else {
+		tipsConfig.Custom = append(slices.Clone(tipsConfig.Custom), fileTips...)
+	}
+	uiModel := ui.NewModel(
+		r.AttachMode,
+		r.Editor,
+		errorClearDelay,
+		statusConfig,
+		timestampConfig,
+		r.Dev,
+		timestampMode,
+		r.ShowTokenChart,
+		r.ShowPRNumber,
+		r.Accessible,
+		r.HandoffPrompt,
+		r.TmuxStatusPosition,
+		allowDangerouslySkipPermissionsDefault,
+		tipsConfig,
+		sortConfig,
+		viewConfig,
+		keysConfig,
+		cli.Container.ActionsService,
+		cli.Container.ActivityStatsService,
+		cli.Container.AttachmentService,
+		cli.Container.BadgeService,
+		cli.Container.CheckService,
+		cli.Container.CheckpointService,
+		cli.Container.CIService,
+		cli.Container.ClipboardService,
+		cli.Container.DebugMetricsService,
+		cli.Container.DiffSummaryService,
+		cli.Container.EscalationService,
+		cli.Container.GitService,
+		cli.Container.HistoryPruneService,
+		cli.Container.HookJournalService,
+		cli.Container.InstanceService,
+		cli.Container.MigrationService,
+		cli.Container.PauseService,
+		cli.Container.RemoteFetchService,
+		cli.Container.RepoBookmarkService,
+		cli.Container.RuleService,
+		cli.Container.SchedulerService,
+		cli.Container.SessionService,
+		cli.Container.ShellService,
+		cli.Container.TicketSyncService,
+		cli.Container.TimerService,
+		cli.Container.TokenBudgetService,
+		cli.Container.TokenStatsService,
+		cli.Container.ToolAuditService,
+		cli.Container.WorkspaceService,
+		cli.Container.WorktreeGCService,
+	)
+	var model tea.Model = uiModel
+
+	// Record the session for a bug report when asked
+	if r.Record != "" {
+		f, err := os.OpenFile(r.Record, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+		if err != nil {
+			return fmt.Errorf("failed to create recording: %w", err)
+		}
+		defer f.Close()
+		recorder := ui.NewRecorder(model, f)
+		defer func() {
+			if err := recorder.Err(); err != nil {
+				logging.Logger.Warn("Recording stopped early", "file", r.Record, "error", err)
+			}
+		}()
+		model = recorder
+		logging.Logger.Info("Recording TUI session", "file", r.Record)
+	}
+
 	p := tea.NewProgram(
-		ui.NewModel(
-			r.Editor,
-			errorClearDelay,
-			statusConfig,
-			timestampConfig,
-			r.Dev,
-			r.ShowTimestamps,
-			r.ShowTokenChart,
-			r.ShowPRNumber,
-			r.TmuxStatusPosition,
-			allowDangerouslySkipPermissionsDefault,
-			tipsConfig,
-			keysConfig,
-			cli.Container.GitService,
-			cli.Container.SessionService,
-			cli.Container.ShellService,
-			cli.Container.TokenStatsService,
-		),
+		model,
 		tea.WithAltScreen(),       // Use alternate screen buffer
 		tea.WithMouseCellMotion(), // Enable mouse support
 	)
@@ -326,5 +550,126 @@ func (r *RunCmd) Run(cli *CLI) error {
 	}
 
 	logging.Logger.Info("TUI program exited normally")
+
+	if profile, ok := uiModel.ProfileSwitch(); ok {
+		return restartWithProfile(cli, profile)
+	}
+	return nil
+}
+
+// restartWithProfile replaces this process with rocha run again with the settings profile
+// ("" = none), since settings are applied once at startup
+func restartWithProfile(cli *CLI, profile string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to find the rocha executable: %w", err)
+	}
+
+	env := slices.DeleteFunc(os.Environ(), func(kv string) bool {
+		return strings.HasPrefix(kv, config.ProfileEnvVar+"=")
+	})
+	if profile != "" {
+		env = append(env, config.ProfileEnvVar+"="+profile)
+	}
+
+	// Exec skips deferred calls; release the instance lock and flush traces first
+	cli.Container.InstanceService.Stop()
+	cli.Close()
+
+	logging.Logger.Info("Restarting with settings profile", "profile", profile)
+	if err := syscall.Exec(executable, os.Args, env); err != nil {
+		return fmt.Errorf("failed to restart rocha: %w", err)
+	}
 	return nil
 }
+
+// handleOtherInstance applies --other-instance when another rocha process is primary.
+// It reports true when this TUI must not start.
+func (r *RunCmd) handleOtherInstance(cli *CLI, holder *domain.InstanceOwner) (bool, error) {
+	switch r.OtherInstance {
+	case "exit":
+		return true, fmt.Errorf("%w: %s", domain.ErrInstanceRunning, holder)
+	case "switch":
+		if os.Getenv("TMUX") == "" || holder == nil || holder.TmuxPane == "" {
+			logging.Logger.Info("Cannot switch to the other rocha instance, following it", "holder", holder)
+			return false, nil
+		}
+		// A pane ID targets its session, window, and pane
+		if err := cli.Container.ShellService.SwitchClient(holder.TmuxPane); err != nil {
+			return true, fmt.Errorf("failed to switch to %s: %w", holder, err)
+		}
+		return true, nil
+	}
+	return false, nil
+}
+
+// newPalette converts the theme of settings to the colors the TUI styles use
+func newPalette(settings *config.ThemeSettings) theme.Palette {
+	return theme.Palette{
+		Accent:    theme.Color(settings.Accent),
+		Exited:    theme.Color(settings.Exited),
+		Idle:      theme.Color(settings.Idle),
+		Muted:     theme.Color(settings.Muted),
+		Paused:    theme.Color(settings.Paused),
+		Primary:   theme.Color(settings.Primary),
+		Secondary: theme.Color(settings.Secondary),
+		Waiting:   theme.Color(settings.Waiting),
+		Working:   theme.Color(settings.Working),
+	}
+}
+
+// newSortConfig parses the sort presets from settings
+// Without settings the list keeps its manual order
+func newSortConfig(settings *config.Settings) (ui.SortConfig, error) {
+	var sortConfig ui.SortConfig
+	if settings == nil {
+		return sortConfig, nil
+	}
+
+	for _, preset := range settings.SortPresets {
+		if preset.Name == "" {
+			return sortConfig, fmt.Errorf("sort preset %q has no name: %w", preset.Sort, domain.ErrInvalidInput)
+		}
+		sessionSort, err := domain.ParseSessionSort(preset.Sort)
+		if err != nil {
+			return sortConfig, fmt.Errorf("sort preset '%s': %w", preset.Name, err)
+		}
+		sortConfig.Presets = append(sortConfig.Presets, domain.SortPreset{Name: preset.Name, Sort: sessionSort})
+	}
+
+	if settings.SortPreset != "" {
+		isPreset := func(preset domain.SortPreset) bool { return preset.Name == settings.SortPreset }
+		if !slices.ContainsFunc(sortConfig.Presets, isPreset) {
+			return sortConfig, fmt.Errorf("sort_preset '%s' does not match any sort_presets name: %w", settings.SortPreset, domain.ErrInvalidInput)
+		}
+		sortConfig.Active = settings.SortPreset
+	}
+
+	logging.Logger.Debug("Sort presets loaded", "count", len(sortConfig.Presets), "active", sortConfig.Active)
+	return sortConfig, nil
+}
+
+// newViewConfig parses the views from settings, checking the sort presets they use exist
+func newViewConfig(settings *config.Settings, sortConfig ui.SortConfig) (ui.ViewConfig, error) {
+	var viewConfig ui.ViewConfig
+	if settings == nil {
+		return viewConfig, nil
+	}
+
+	for _, view := range settings.Views {
+		viewConfig.Views = append(viewConfig.Views, domain.View{Filter: view.Filter, Name: view.Name, SortPreset: view.SortPreset})
+	}
+	if err := domain.ValidateViews(viewConfig.Views, sortConfig.Presets); err != nil {
+		return viewConfig, err
+	}
+
+	if settings.DefaultView != "" {
+		if domain.FindView(viewConfig.Views, settings.DefaultView) < 0 {
+			return viewConfig, fmt.Errorf("default_view '%s' does not match any views name: %w", settings.DefaultView, domain.ErrInvalidInput)
+		}
+		viewConfig.Default = settings.DefaultView
+	}
+
+	logging.Logger.Debug("Views loaded", "count", len(viewConfig.Views), "default", viewConfig.Default)
+	return viewConfig, nil
+}
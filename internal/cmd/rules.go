@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// RulesCmd manages the workflow rules set in settings.json
+type RulesCmd struct {
+	Test RulesTestCmd `cmd:"test" help:"Dry-run the rules against the current sessions, changing nothing"`
+}
+
+// RulesTestCmd shows which sessions each rule would act on now
+type RulesTestCmd struct{}
+
+// Run executes the rules test command
+func (r *RulesTestCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing rules test command")
+
+	if cli.settings == nil || len(cli.settings.Rules) == 0 {
+		fmt.Println("No rules configured (set \"rules\" in settings.json)")
+		return nil
+	}
+
+	// Report every invalid rule, not only the first, since the others are skipped silently
+	var errs []error
+	for _, cfg := range cli.settings.Rules {
+		if _, err := domain.NewRule(cfg.Name, cfg.When, cfg.Then); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return err
+	}
+
+	state, err := cli.Container.SessionService.LoadState(context.Background(), false)
+	if err != nil {
+		return fmt.Errorf("failed to load sessions: %w", err)
+	}
+
+	matches := cli.Container.RuleService.Match(state, time.Now())
+	if len(matches) == 0 {
+		fmt.Printf("No session matches the %d rule(s)\n", len(cli.settings.Rules))
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "RULE\tACTION\tSESSION\tSTATE\tSTATUS")
+	for _, match := range matches {
+		status := "-"
+		if match.Session.Status != nil {
+			status = *match.Session.Status
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", match.Rule.Name, match.Rule.Action, match.Session.Name, match.Session.State, status)
+	}
+	return tw.Flush()
+}
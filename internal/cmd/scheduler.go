@@ -0,0 +1,315 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/renato0307/rocha/internal/api"
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// SchedulerCmd runs a foreground loop delivering scheduled prompts, enforcing token budgets,
+// applying journaled hook events and workflow rules, removing worktrees past the archive retention, pruning old history,
+// fetching session repositories when background_fetch_minutes is set, saving due checkpoints of session worktrees, and optionally saving a daily activity report and serving metrics or the REST API.
+// Useful when the TUI is not running (e.g. in a spare tmux window); while a TUI runs, it waits for it to exit
+type SchedulerCmd struct {
+	APIAddr     string        `help:"Serve the REST API on this address (e.g. localhost:7878), authenticated with the token in ROCHA_HOME/api-token" name:"api-addr"`
+	Interval    time.Duration `help:"How often to check for due prompts" default:"30s"`
+	MetricsAddr string        `help:"Serve Prometheus metrics on this address (e.g. localhost:9464)" name:"metrics-addr"`
+	ReportAt    string        `help:"Save an activity report to ROCHA_HOME/reports every day at this time (HH:MM)" name:"report-at"`
+	ReportSince string        `help:"Period covered by saved reports: today, yesterday, or an age such as 24h" name:"report-since" default:"24h"`
+}
+
+// Run executes the scheduler command
+func (s *SchedulerCmd) Run(cli *CLI) error {
+	if s.Interval <= 0 {
+		return fmt.Errorf("interval must be positive")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if s.MetricsAddr != "" {
+		if err := s.serveMetrics(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	if s.APIAddr != "" {
+		if err := s.serveAPI(ctx, cli); err != nil {
+			return err
+		}
+	}
+
+	var nextReport time.Time
+	if s.ReportAt != "" {
+		if _, err := time.Parse("15:04", s.ReportAt); err != nil {
+			return fmt.Errorf("report time %q must be HH:MM: %w", s.ReportAt, domain.ErrInvalidInput)
+		}
+		if _, err := domain.ParseReportSince(s.ReportSince, time.Now()); err != nil {
+			return err
+		}
+		next, err := services.ResolveSendTime(s.ReportAt, 0, time.Now())
+		if err != nil {
+			return err
+		}
+		nextReport = next
+		fmt.Printf("Saving activity reports daily at %s (next at %s)\n", s.ReportAt, formatTime(nextReport))
+	}
+
+	// Another scheduler or a TUI may already run the background work; wait for it to exit.
+	// Without the lock that could not be told, so refuse to run rather than act twice.
+	instances := cli.Container.InstanceService
+	if _, err := instances.Start("scheduler"); err != nil {
+		return fmt.Errorf("failed to check for other rocha instances: %w", err)
+	}
+	defer instances.Stop()
+
+	logging.Logger.Info("Starting prompt scheduler", "interval", s.Interval)
+	fmt.Printf("Delivering scheduled prompts every %s (Ctrl+C to stop)\n", s.Interval)
+
+	ticker := time.NewTicker(s.Interval)
+	defer ticker.Stop()
+
+	var lastWorktreeGC, lastHistoryPrune time.Time
+	following := false
+
+	for {
+		if instances.TakeOver() {
+			if following {
+				fmt.Printf("%s other rocha instance exited, taking over\n", time.Now().Format("15:04:05"))
+				following = false
+			}
+			s.runRound(ctx, cli, &lastWorktreeGC, &lastHistoryPrune, &nextReport)
+		} else if !following {
+			fmt.Printf("%s %s runs the background work, waiting for it to exit\n", time.Now().Format("15:04:05"), instances.Holder())
+			following = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// runRound applies journaled hook events, budgets, and rules, delivers due prompts,
+// and saves checkpoints, removes worktrees, prunes history, fetches repositories, and saves the report when they are due
+func (s *SchedulerCmd) runRound(ctx context.Context, cli *CLI, lastWorktreeGC, lastHistoryPrune, nextReport *time.Time) {
+	// Apply hook events that could not be written while the database was busy
+	if _, err := cli.Container.HookJournalService.Drain(ctx); err != nil {
+		logging.Logger.Error("Failed to drain hook journal", "error", err)
+	}
+
+	// Sync the tickets of status changes queued since the last round
+	if _, err := cli.Container.TicketSyncService.Drain(ctx); err != nil {
+		logging.Logger.Error("Failed to drain ticket syncs", "error", err)
+	}
+
+	// Budgets first, so a wrap-up prompt they queue goes out in the same round
+	enforced, err := cli.Container.TokenBudgetService.Check(ctx)
+	if err != nil {
+		logging.Logger.Error("Failed to check token budgets", "error", err)
+	}
+	for _, name := range enforced {
+		fmt.Printf("%s session '%s' exceeded its token budget\n", time.Now().Format("15:04:05"), name)
+	}
+
+	applied, err := cli.Container.RuleService.Run(ctx, time.Now())
+	if err != nil {
+		logging.Logger.Error("Failed to apply rules", "error", err)
+	}
+	for _, match := range applied {
+		fmt.Printf("%s rule '%s' applied %s to '%s'\n", time.Now().Format("15:04:05"), match.Rule.Name, match.Rule.Action, match.Session.Name)
+	}
+
+	result, err := cli.Container.SchedulerService.DispatchDue(ctx, time.Now())
+	if err != nil {
+		logging.Logger.Error("Failed to dispatch scheduled prompts", "error", err)
+	} else {
+		for _, prompt := range result.Sent {
+			fmt.Printf("%s sent prompt #%d to '%s'\n", time.Now().Format("15:04:05"), prompt.ID, prompt.SessionName)
+		}
+	}
+
+	s.saveCheckpoints(ctx, cli, time.Now())
+
+	if now := time.Now(); now.Sub(*lastWorktreeGC) >= services.WorktreeGCInterval {
+		s.collectWorktrees(ctx, cli, now)
+		*lastWorktreeGC = now
+	}
+
+	if now := time.Now(); now.Sub(*lastHistoryPrune) >= services.HistoryPruneInterval {
+		if err := cli.Container.HistoryPruneService.Prune(ctx, now); err != nil {
+			logging.Logger.Error("Failed to prune history", "error", err)
+		}
+		*lastHistoryPrune = now
+	}
+
+	if now := time.Now(); cli.Container.RemoteFetchService.Due(now) {
+		s.fetchRemotes(ctx, cli, now)
+	}
+
+	if now := time.Now(); !nextReport.IsZero() && !now.Before(*nextReport) {
+		s.saveReport(ctx, cli, now)
+		if next, err := services.ResolveSendTime(s.ReportAt, 0, now); err == nil {
+			*nextReport = next
+		}
+	}
+}
+
+// saveReport saves the activity report of the period set with --report-since
+// Failures are logged so the scheduler keeps delivering prompts
+func (s *SchedulerCmd) saveReport(ctx context.Context, cli *CLI, now time.Time) {
+	since, err := domain.ParseReportSince(s.ReportSince, now)
+	if err != nil {
+		logging.Logger.Error("Invalid report period", "error", err)
+		return
+	}
+
+	path, err := cli.Container.ReportService.SaveReport(ctx, since, now)
+	if err != nil {
+		logging.Logger.Error("Failed to save activity report", "error", err)
+		return
+	}
+	fmt.Printf("%s saved activity report to %s\n", now.Format("15:04:05"), path)
+}
+
+// saveCheckpoints saves the worktrees of sessions whose checkpoint is due
+// Failures are logged so the scheduler keeps delivering prompts
+func (s *SchedulerCmd) saveCheckpoints(ctx context.Context, cli *CLI, now time.Time) {
+	state, err := cli.Container.SessionService.LoadState(ctx, false)
+	if err != nil {
+		logging.Logger.Error("Failed to load sessions for checkpoints", "error", err)
+		return
+	}
+	due := cli.Container.CheckpointService.Due(state, now)
+	if len(due) == 0 {
+		return
+	}
+	if err := cli.Container.CheckpointService.Run(ctx, due); err != nil {
+		logging.Logger.Error("Failed to checkpoint some sessions", "error", err)
+	}
+	fmt.Printf("%s checkpointed %d sessions\n", now.Format("15:04:05"), len(due))
+}
+
+// collectWorktrees removes the clean, pushed worktrees of sessions archived longer than the retention
+// Failures are logged so the scheduler keeps delivering prompts
+func (s *SchedulerCmd) collectWorktrees(ctx context.Context, cli *CLI, now time.Time) {
+	candidates, err := cli.Container.WorktreeGCService.Run(ctx, now)
+	if err != nil {
+		logging.Logger.Error("Failed to remove worktrees past retention", "error", err)
+		return
+	}
+	for _, candidate := range candidates {
+		if candidate.Removed {
+			fmt.Printf("%s removed worktree of archived session '%s'\n", now.Format("15:04:05"), candidate.Session.Name)
+		}
+	}
+}
+
+// fetchRemotes fetches origin in the repositories of active sessions
+// Failures are logged so the scheduler keeps delivering prompts
+func (s *SchedulerCmd) fetchRemotes(ctx context.Context, cli *CLI, now time.Time) {
+	fetched, err := cli.Container.RemoteFetchService.Run(ctx, now)
+	if err != nil {
+		logging.Logger.Error("Failed to fetch some session repositories", "error", err)
+	}
+	if len(fetched) > 0 {
+		fmt.Printf("%s fetched %d repositories\n", now.Format("15:04:05"), len(fetched))
+	}
+}
+
+// serveMetrics exposes /metrics until ctx is done
+func (s *SchedulerCmd) serveMetrics(ctx context.Context, cli *CLI) error {
+	handler, err := cli.Container.MetricsHandler()
+	if err != nil {
+		return fmt.Errorf("failed to enable metrics: %w", err)
+	}
+
+	// Scrapes read the values of the last refresh, so refresh them every interval
+	metrics := cli.Container.MetricsService
+	if err := metrics.Refresh(ctx); err != nil {
+		return fmt.Errorf("failed to collect metrics: %w", err)
+	}
+	go func() {
+		ticker := time.NewTicker(s.Interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := metrics.Refresh(ctx); err != nil {
+					logging.Logger.Warn("Failed to refresh metrics", "error", err)
+				}
+			}
+		}
+	}()
+
+	listener, err := net.Listen("tcp", s.MetricsAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.MetricsAddr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", handler)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logging.Logger.Error("Metrics server stopped", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	logging.Logger.Info("Serving metrics", "addr", listener.Addr().String())
+	fmt.Printf("Serving Prometheus metrics on http://%s/metrics\n", listener.Addr())
+	return nil
+}
+
+// serveAPI exposes the REST API until ctx is done
+func (s *SchedulerCmd) serveAPI(ctx context.Context, cli *CLI) error {
+	tokenPath := config.GetAPITokenPath()
+	token, err := api.LoadOrCreateToken(tokenPath)
+	if err != nil {
+		return fmt.Errorf("failed to enable the REST API: %w", err)
+	}
+
+	listener, err := net.Listen("tcp", s.APIAddr)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.APIAddr, err)
+	}
+
+	// No WriteTimeout: the event stream stays open for as long as the client listens
+	server := &http.Server{Handler: cli.Container.APIServer(token).Handler(), ReadHeaderTimeout: 5 * time.Second}
+	server.BaseContext = func(net.Listener) context.Context { return ctx }
+
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logging.Logger.Error("API server stopped", "error", err)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		server.Close()
+	}()
+
+	logging.Logger.Info("Serving REST API", "addr", listener.Addr().String())
+	fmt.Printf("Serving the REST API on http://%s/api/v1 (bearer token in %s)\n", listener.Addr(), tokenPath)
+	return nil
+}
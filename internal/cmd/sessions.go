@@ -2,19 +2,40 @@ package cmd
 
 // SessionsCmd manages sessions
 type SessionsCmd struct {
-	Add       SessionsAddCmd       `cmd:"add" help:"Add a new session"`
-	Archive   SessionsArchiveCmd   `cmd:"archive" help:"Archive or unarchive a session"`
-	Capture   SessionsCaptureCmd   `cmd:"capture" help:"Capture session pane content"`
-	Comment   SessionsCommentCmd   `cmd:"comment" help:"Add, edit, or clear session comment"`
-	Del       SessionsDelCmd       `cmd:"del" help:"Delete a session"`
-	Duplicate SessionsDuplicateCmd `cmd:"duplicate" help:"Create session from existing repository"`
-	Flag      SessionsFlagCmd      `cmd:"flag" help:"Toggle session flag"`
-	List      SessionsListCmd      `cmd:"list" help:"List all sessions" default:"1"`
-	Move      SessionsMoveCmd      `cmd:"move" aliases:"mv" help:"Move sessions between ROCHA_HOME directories"`
-	OpenPR    SessionsOpenPRCmd    `cmd:"open-pr" help:"Open PR in browser for a session"`
-	Rename    SessionsRenameCmd    `cmd:"rename" help:"Update session display name"`
-	Set                SessionSetCmd                `cmd:"set" help:"Set session configuration"`
-	Status             SessionsStatusCmd            `cmd:"status" help:"Set or clear implementation status"`
-	View               SessionsViewCmd              `cmd:"view" help:"View a specific session"`
-	ViewAgentSettings  SessionsViewAgentSettingsCmd `cmd:"view-agent-settings" help:"Inspect agent settings from running process"`
+	Add               SessionsAddCmd               `cmd:"add" help:"Add a new session"`
+	Archive           SessionsArchiveCmd           `cmd:"archive" help:"Archive or unarchive a session"`
+	Attach            SessionsAttachCmd            `cmd:"attach" help:"Attach files (screenshots, mockups) to a session, open them, or send them to the agent"`
+	Audit             SessionsAuditCmd             `cmd:"audit" help:"Review the tools run by a session that skips permission prompts"`
+	Budget            SessionsBudgetCmd            `cmd:"budget" help:"Set, show, or clear a token budget that flags the session when exceeded"`
+	CancelSend        SessionsCancelSendCmd        `cmd:"cancel-send" help:"Cancel a scheduled prompt"`
+	Capture           SessionsCaptureCmd           `cmd:"capture" help:"Capture session pane content"`
+	Checkpoint        SessionsCheckpointCmd        `cmd:"checkpoint" help:"Save a checkpoint of a session worktree now, or on a schedule"`
+	Comment           SessionsCommentCmd           `cmd:"comment" help:"Add, edit, or clear session comment"`
+	Del               SessionsDelCmd               `cmd:"del" help:"Delete a session"`
+	Duplicate         SessionsDuplicateCmd         `cmd:"duplicate" help:"Create session from existing repository"`
+	Edit              SessionsEditCmd              `cmd:"edit" help:"Open a session in its editor, optionally with the files changed on its branch, or edit its metadata as YAML"`
+	GC                SessionsGCCmd                `cmd:"gc" help:"Remove clean, pushed worktrees of sessions archived longer than the retention period"`
+	Flag              SessionsFlagCmd              `cmd:"flag" help:"Toggle session flag"`
+	Join              SessionsJoinCmd              `cmd:"join" help:"Attach to a session shared by a teammate"`
+	Kill              SessionsKillCmd              `cmd:"kill" help:"Kill a session, letting the agent exit first"`
+	List              SessionsListCmd              `cmd:"list" help:"List all sessions" default:"1"`
+	Move              SessionsMoveCmd              `cmd:"move" aliases:"mv" help:"Move sessions between ROCHA_HOME directories"`
+	Note              SessionsNoteCmd              `cmd:"note" help:"Set or clear session markdown note"`
+	OpenPR            SessionsOpenPRCmd            `cmd:"open-pr" help:"Open PR in browser for a session"`
+	Pause             SessionsPauseCmd             `cmd:"pause" help:"Pause a session's agent so it stops consuming tokens, or resume a paused one"`
+	Priority          SessionsPriorityCmd          `cmd:"priority" help:"Set, cycle, or clear session priority (P0-P3)"`
+	Prompts           SessionsPromptsCmd           `cmd:"prompts" help:"List the text recently sent to a session"`
+	Rename            SessionsRenameCmd            `cmd:"rename" help:"Update session display name"`
+	Restart           SessionsRestartCmd           `cmd:"restart" help:"Restart an exited session (resume conversation, fresh, or shell only)"`
+	Send              SessionsSendCmd              `cmd:"send" help:"Send text to a session now or at a scheduled time"`
+	Set               SessionSetCmd                `cmd:"set" help:"Set session configuration"`
+	Share             SessionsShareCmd             `cmd:"share" help:"Share a session with a teammate for pairing"`
+	Stash             SessionsStashCmd             `cmd:"stash" help:"Stash, list, or pop the uncommitted changes of a session worktree"`
+	Status            SessionsStatusCmd            `cmd:"status" help:"Set or clear implementation status"`
+	SwitchBranch      SessionsSwitchBranchCmd      `cmd:"switch-branch" help:"Switch the branch checked out in a session worktree"`
+	Tag               SessionsTagCmd               `cmd:"tag" help:"Add, remove, or clear session tags"`
+	Timer             SessionsTimerCmd             `cmd:"timer" help:"Set, show, or clear a countdown timer that alerts when it elapses"`
+	Transcript        SessionsTranscriptCmd        `cmd:"transcript" help:"Export the agent conversation of a session as markdown or JSON"`
+	View              SessionsViewCmd              `cmd:"view" help:"View a specific session"`
+	ViewAgentSettings SessionsViewAgentSettingsCmd `cmd:"view-agent-settings" help:"Inspect agent settings from running process"`
 }
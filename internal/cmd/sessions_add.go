@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/renato0307/rocha/internal/config"
 	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
@@ -14,16 +18,24 @@ import (
 
 // SessionsAddCmd adds a new session
 type SessionsAddCmd struct {
+	AgentArgs                       string `help:"Extra arguments for Claude, as a shell-like line such as --agent-args='--add-dir ../shared'" default:""`
 	AllowDangerouslySkipPermissions bool   `help:"Skip permission prompts in Claude (DANGEROUS)"`
 	BranchName                      string `help:"Branch name" default:""`
 	DisplayName                     string `help:"Display name for the session" default:""`
+	GitEmail                        string `help:"Author and committer email for commits in the new worktree (overrides git_identities)" default:""`
+	GitName                         string `help:"Author and committer name for commits in the new worktree (overrides git_identities)" default:""`
+	GitSigningKey                   string `help:"GPG key ID or SSH public key file to sign commits in the new worktree with (overrides git_identities)" default:""`
 	InitialPrompt                   string `help:"Initial prompt to send to Claude on session start" name:"prompt" short:"p" default:""`
-	Name                            string `arg:"" help:"Name of the session to add"`
+	Model                           string `help:"Model Claude runs with: opus, sonnet, haiku, or a model ID (default: Claude's own)" default:""`
+	Name                            string `arg:"" optional:"" help:"Name of the session to add (default: named after --branch-name or its linked issue)"`
+	OnConflict                      string `help:"When the name is taken: fail, suffix (add -2, -3, ...), or reuse the existing session" enum:"fail,suffix,reuse" default:"fail"`
+	Path                            string `help:"Use an existing directory as-is, without creating a branch or worktree" default:""`
 	RepoInfo                        string `help:"Repository info" default:""`
 	RepoPath                        string `help:"Repository path" default:""`
-	RepoSource                      string `help:"Repository source URL (creates worktree)" default:""`
-	StartClaude                     bool   `help:"Create tmux session and start Claude" name:"start-claude"`
+	RepoSource                      string `help:"Repository source URL or bookmark name (creates worktree)" default:""`
+	Start                           bool   `help:"Create the worktree and tmux session, and start Claude" aliases:"start-claude"`
 	State                           string `help:"Initial state" enum:"idle,working,waiting,exited" default:"idle"`
+	Subdir                          string `help:"Directory inside the checkout to start in, such as packages/api in a monorepo" default:""`
 	WorktreePath                    string `help:"Worktree path" default:""`
 }
 
@@ -31,29 +43,130 @@ type SessionsAddCmd struct {
 func (s *SessionsAddCmd) Run(cli *CLI) error {
 	ctx := context.Background()
 
-	// If --start-claude is provided, use SessionService.CreateSession()
-	// which creates the tmux session and starts Claude with the prompt
-	if s.StartClaude {
-		return s.runWithStartClaude(ctx, cli)
+	if s.Path != "" && (s.RepoSource != "" || s.WorktreePath != "" || s.BranchName != "") {
+		return fmt.Errorf("%w: --path cannot be combined with --repo-source, --worktree-path, or --branch-name", domain.ErrInvalidInput)
 	}
 
-	// Otherwise, just add metadata to the database (existing behavior)
-	return s.runMetadataOnly(ctx, cli)
+	if !s.Start && !s.gitIdentity().IsZero() {
+		return fmt.Errorf("%w: --git-email, --git-name, and --git-signing-key need --start and --repo-source", domain.ErrInvalidInput)
+	}
+
+	agentArgs, err := s.agentArgs()
+	if err != nil {
+		return err
+	}
+
+	if s.Start && (s.RepoInfo != "" || s.RepoPath != "" || s.WorktreePath != "") {
+		return fmt.Errorf("%w: --repo-info, --repo-path, and --worktree-path only apply without --start; use --repo-source or --path", domain.ErrInvalidInput)
+	}
+
+	// Without a name, the session is named after its branch and displayed under a name
+	// generated from the branch or its linked issue
+	autoName := s.Name == ""
+	if autoName && s.BranchName == "" {
+		return fmt.Errorf("%w: a session name or --branch-name is required", domain.ErrInvalidInput)
+	}
+
+	// Sessions started here get a tmux-compatible name; metadata is stored under the name given
+	name := s.Name
+	if s.Start || autoName {
+		name = domain.SanitizeSessionName(cmp.Or(s.Name, s.BranchName))
+	}
+	name, reused, err := s.resolveName(ctx, cli, name)
+	if err != nil || reused {
+		return err
+	}
+
+	// If --start is provided, use SessionService.CreateSession()
+	// which creates the worktree and tmux session and starts Claude with the prompt
+	if s.Start {
+		err = s.runWithStart(ctx, cli, name, autoName, agentArgs)
+	} else {
+		// Otherwise, just add metadata to the database (existing behavior)
+		err = s.runMetadataOnly(ctx, cli, name, autoName, agentArgs)
+	}
+	if errors.Is(err, domain.ErrSessionExists) {
+		return fmt.Errorf("%w (use --on-conflict suffix or --on-conflict reuse)", err)
+	}
+	return err
+}
+
+// resolveName applies --on-conflict before anything is created: it returns the name to
+// create the session under, or reused when the existing session is kept instead
+func (s *SessionsAddCmd) resolveName(ctx context.Context, cli *CLI, name string) (string, bool, error) {
+	switch s.OnConflict {
+	case "suffix":
+		available, err := cli.Container.SessionService.AvailableName(ctx, name)
+		if err != nil {
+			return "", false, err
+		}
+		if available != name {
+			fmt.Printf("Session '%s' already exists; using '%s'\n", name, available)
+		}
+		return available, false, nil
+	case "reuse":
+		existing, err := cli.Container.SessionService.GetSession(ctx, name)
+		if errors.Is(err, domain.ErrSessionNotFound) {
+			return name, false, nil
+		}
+		if err != nil {
+			return "", false, fmt.Errorf("failed to check session name: %w", err)
+		}
+		fmt.Printf("Session '%s' already exists; reusing it\n", existing.Name)
+		if dir := existing.WorkingDir(); dir != "" {
+			fmt.Printf("Directory: %s\n", dir)
+		}
+		if existing.IsArchived {
+			fmt.Printf("The session is archived; unarchive it with 'rocha sessions archive %s'\n", existing.Name)
+		}
+		return "", true, nil
+	default:
+		return name, false, nil
+	}
+}
+
+// gitIdentity returns the git identity given with the flags
+func (s *SessionsAddCmd) gitIdentity() domain.GitIdentity {
+	return domain.GitIdentity{Email: s.GitEmail, Name: s.GitName, SigningKey: s.GitSigningKey}
 }
 
-// runWithStartClaude creates a tmux session and starts Claude
-func (s *SessionsAddCmd) runWithStartClaude(ctx context.Context, cli *CLI) error {
+// agentArgs parses and checks the model and extra Claude args given with the flags
+func (s *SessionsAddCmd) agentArgs() ([]string, error) {
+	if err := domain.ValidateAgentModel(s.Model); err != nil {
+		return nil, err
+	}
+	args, err := domain.ParseAgentArgs(s.AgentArgs)
+	if err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateAgentArgs(args); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
+
+// runWithStart creates the worktree and tmux session and starts Claude
+func (s *SessionsAddCmd) runWithStart(ctx context.Context, cli *CLI, name string, autoName bool, agentArgs []string) error {
+	repoSource := cli.Container.RepoBookmarkService.ResolveSource(ctx, s.RepoSource)
 	logging.Logger.Info("Creating session with tmux and Claude",
-		"name", s.Name,
+		"name", name,
 		"has_prompt", s.InitialPrompt != "",
-		"repo_source", s.RepoSource)
+		"repo_source", repoSource)
 
 	params := services.CreateSessionParams{
+		AgentArgs:                       agentArgs,
+		AgentModel:                      s.Model,
 		AllowDangerouslySkipPermissions: s.AllowDangerouslySkipPermissions,
+		AutoDisplayName:                 autoName,
+		BootstrapOutput:                 os.Stdout,
 		BranchNameOverride:              s.BranchName,
+		DirectoryPath:                   s.Path,
+		DisplayName:                     s.DisplayName,
+		GitIdentity:                     s.gitIdentity(),
 		InitialPrompt:                   s.InitialPrompt,
-		RepoSource:                      s.RepoSource,
-		SessionName:                     s.Name,
+		RepoSource:                      repoSource,
+		SessionName:                     name,
+		Subdir:                          s.Subdir,
 		TmuxStatusPosition:              cli.Container.SettingsService.GetTmuxStatusPosition(),
 	}
 
@@ -62,10 +175,25 @@ func (s *SessionsAddCmd) runWithStartClaude(ctx context.Context, cli *CLI) error
 		return fmt.Errorf("failed to create session: %w", err)
 	}
 
+	if repoSource != "" && s.Path == "" {
+		if err := cli.Container.RepoBookmarkService.RecordUse(ctx, repoSource); err != nil {
+			logging.Logger.Warn("Failed to record repository bookmark use", "error", err)
+		}
+	}
+
 	fmt.Printf("Session '%s' created successfully\n", result.Session.Name)
+	if autoName && s.DisplayName == "" {
+		fmt.Printf("Display name: %s\n", result.Session.DisplayName)
+	}
 	if result.WorktreePath != "" {
 		fmt.Printf("Worktree: %s\n", result.WorktreePath)
 	}
+	if result.Session.IsExternal {
+		fmt.Printf("Directory: %s (external, no worktree)\n", result.Session.RepoPath)
+	}
+	if result.Session.Subdir != "" {
+		fmt.Printf("Starts in: %s\n", result.Session.StartDir())
+	}
 	if s.InitialPrompt != "" {
 		fmt.Printf("Initial prompt sent to Claude\n")
 	}
@@ -73,24 +201,43 @@ func (s *SessionsAddCmd) runWithStartClaude(ctx context.Context, cli *CLI) error
 }
 
 // runMetadataOnly adds session metadata to the database without creating tmux session
-func (s *SessionsAddCmd) runMetadataOnly(ctx context.Context, cli *CLI) error {
+func (s *SessionsAddCmd) runMetadataOnly(ctx context.Context, cli *CLI, name string, autoName bool, agentArgs []string) error {
 	displayName := s.DisplayName
+	if displayName == "" && autoName {
+		displayName = cli.Container.SessionService.SuggestDisplayName(ctx, s.RepoInfo, s.BranchName)
+	}
 	if displayName == "" {
-		displayName = s.Name
+		displayName = name
+	}
+
+	subdir, err := domain.NormalizeSubdir(s.Subdir)
+	if err != nil {
+		return err
+	}
+
+	repoPath := s.RepoPath
+	if s.Path != "" {
+		if repoPath, err = config.ResolveDirectory(s.Path); err != nil {
+			return err
+		}
 	}
 
 	session := domain.Session{
+		AgentArgs:                       agentArgs,
+		AgentModel:                      s.Model,
 		AllowDangerouslySkipPermissions: s.AllowDangerouslySkipPermissions,
 		BranchName:                      s.BranchName,
 		DisplayName:                     displayName,
 		ExecutionID:                     uuid.New().String(),
 		InitialPrompt:                   s.InitialPrompt,
+		IsExternal:                      s.Path != "",
 		LastUpdated:                     time.Now().UTC(),
-		Name:                            s.Name,
+		Name:                            name,
 		RepoInfo:                        s.RepoInfo,
-		RepoPath:                        s.RepoPath,
+		RepoPath:                        repoPath,
 		RepoSource:                      s.RepoSource,
 		State:                           domain.SessionState(s.State),
+		Subdir:                          subdir,
 		WorktreePath:                    s.WorktreePath,
 	}
 
@@ -98,7 +245,7 @@ func (s *SessionsAddCmd) runMetadataOnly(ctx context.Context, cli *CLI) error {
 		return fmt.Errorf("failed to add session: %w", err)
 	}
 
-	fmt.Printf("Session '%s' added successfully\n", s.Name)
+	fmt.Printf("Session '%s' added successfully\n", name)
 	if s.InitialPrompt != "" {
 		fmt.Printf("Initial prompt stored (will be sent when session starts via UI)\n")
 	}
@@ -5,14 +5,18 @@ import (
 	"fmt"
 
 	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services/actions"
 )
 
 // SessionsArchiveCmd archives or unarchives a session
 type SessionsArchiveCmd struct {
+	DiscardLocalWork   bool   `help:"Remove the worktree even if it has uncommitted changes or unpushed commits"`
 	Force              bool   `help:"Skip confirmation prompt" short:"f"`
-	Name               string `arg:"" help:"Name of the session to archive/unarchive"`
+	Name               string `arg:"" help:"Name of the session to archive/unarchive" predictor:"session"`
 	RemoveWorktree     bool   `help:"Remove associated git worktree" short:"w"`
 	SkipWorktreePrompt bool   `help:"Don't prompt about worktree removal" short:"s"`
+	Transcript         bool   `help:"Save the agent transcript to ROCHA_HOME/transcripts before archiving"`
 }
 
 // Run executes the archive command
@@ -50,18 +54,38 @@ func (s *SessionsArchiveCmd) archiveSession(cli *CLI, session *domain.Session) e
 	}
 
 	ctx := context.Background()
-	if err := cli.Container.SessionService.ArchiveSession(ctx, s.Name, removeWorktree); err != nil {
-		return fmt.Errorf("failed to archive session: %w", err)
+	if s.Transcript {
+		s.saveTranscript(ctx, cli)
+	}
+
+	var worktree actions.WorktreeRemoval
+	if removeWorktree {
+		worktree = confirmWorktreeRemoval(ctx, cli, session, s.DiscardLocalWork, !s.Force)
 	}
 
-	if removeWorktree && session.WorktreePath != "" {
-		fmt.Printf("Removed worktree at '%s'\n", session.WorktreePath)
+	outcome, err := cli.Container.ActionsService.Archive(ctx, s.Name, worktree)
+	if err != nil {
+		return fmt.Errorf("failed to archive session: %w", err)
 	}
 
+	printWorktreeOutcome(outcome)
+
 	fmt.Printf("Session '%s' archived successfully\n", s.Name)
 	return nil
 }
 
+// saveTranscript keeps a markdown copy of the conversation next to the archive
+// A missing transcript only produces a warning so archiving still goes ahead
+func (s *SessionsArchiveCmd) saveTranscript(ctx context.Context, cli *CLI) {
+	path, err := cli.Container.TranscriptService.SaveTranscript(ctx, s.Name, domain.TranscriptMarkdown)
+	if err != nil {
+		logging.Logger.Warn("Failed to save transcript", "session", s.Name, "error", err)
+		fmt.Printf("Warning: transcript not saved: %v\n", err)
+		return
+	}
+	fmt.Printf("Transcript saved to %s\n", path)
+}
+
 func (s *SessionsArchiveCmd) unarchiveSession(cli *CLI) error {
 	if err := cli.Container.SessionService.ToggleArchive(context.Background(), s.Name); err != nil {
 		return fmt.Errorf("failed to unarchive session: %w", err)
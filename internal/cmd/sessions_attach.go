@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsAttachCmd attaches files to a session, or lists, opens, removes, or sends them to the agent
+type SessionsAttachCmd struct {
+	Name   string   `arg:"" help:"Session name" predictor:"session"`
+	Files  []string `arg:"" optional:"" help:"Files to attach; with --remove or --send, the attachments to act on (path or file name)"`
+	Open   string   `help:"Open an attachment (path or file name) in the system viewer" xor:"mode"`
+	Remove bool     `help:"Remove the given attachments (the files are kept)" short:"r" xor:"mode"`
+	Send   bool     `help:"Ask the agent to look at the given attachments, or all of them" xor:"mode"`
+}
+
+// Run executes the attach command
+func (s *SessionsAttachCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions attach command", "name", s.Name, "files", s.Files, "open", s.Open, "remove", s.Remove, "send", s.Send)
+
+	ctx := context.Background()
+	service := cli.Container.AttachmentService
+
+	switch {
+	case s.Open != "":
+		if err := service.Open(ctx, s.Name, s.Open); err != nil {
+			return fmt.Errorf("failed to open attachment: %w", err)
+		}
+
+	case s.Remove:
+		if len(s.Files) == 0 {
+			return fmt.Errorf("give at least one attachment to remove: %w", domain.ErrInvalidInput)
+		}
+		for _, ref := range s.Files {
+			attachment, err := service.Remove(ctx, s.Name, ref)
+			if err != nil {
+				return fmt.Errorf("failed to remove attachment: %w", err)
+			}
+			fmt.Printf("Removed '%s' from session '%s'\n", attachment.Name, s.Name)
+		}
+
+	case s.Send:
+		handOff, err := service.SendToAgent(ctx, s.Name, s.Files, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to send attachments: %w", err)
+		}
+		if handOff.Queued != nil {
+			fmt.Printf("Concurrency limit reached: queued prompt #%d with %d attachment(s) for session '%s'\n", handOff.Queued.ID, len(handOff.Sent), s.Name)
+		} else {
+			fmt.Printf("Sent %d attachment(s) to session '%s'\n", len(handOff.Sent), s.Name)
+		}
+		for _, attachment := range handOff.Skipped {
+			fmt.Printf("Skipped '%s' (%s): missing, or not readable by the agent\n", attachment.Name, attachment.MediaType)
+		}
+
+	case len(s.Files) > 0:
+		attachments, err := service.Attach(ctx, s.Name, s.Files, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to attach files: %w", err)
+		}
+		for _, attachment := range attachments {
+			fmt.Printf("Attached '%s' (%s) to session '%s'\n", attachment.Name, attachment.MediaType, s.Name)
+		}
+
+	default:
+		attachments, err := service.List(ctx, s.Name)
+		if err != nil {
+			return fmt.Errorf("failed to list attachments: %w", err)
+		}
+		if len(attachments) == 0 {
+			fmt.Printf("No attachments for session '%s'\n", s.Name)
+			return nil
+		}
+		return printAttachments(attachments)
+	}
+	return nil
+}
+
+// printAttachments writes the attachments as a table, flagging files that no longer exist
+func printAttachments(attachments []domain.SessionAttachment) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tSIZE\tADDED\tPATH")
+	for _, attachment := range attachments {
+		path := attachment.Path
+		if _, err := os.Stat(path); err != nil {
+			path += " (missing)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			attachment.Name,
+			attachment.MediaType,
+			formatFileSize(attachment.Size),
+			formatTime(attachment.AddedAt),
+			path)
+	}
+	return w.Flush()
+}
+
+// formatFileSize renders a byte count with a binary unit
+func formatFileSize(bytes int64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+	value, suffix := float64(bytes)/unit, "KB"
+	for _, next := range []string{"MB", "GB"} {
+		if value < unit {
+			break
+		}
+		value, suffix = value/unit, next
+	}
+	return fmt.Sprintf("%.1f%s", value, suffix)
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// auditSummaryWidth caps the summary column of the audit table
+const auditSummaryWidth = 100
+
+// SessionsAuditCmd lists the tools run by a session that skips permission prompts
+type SessionsAuditCmd struct {
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+	Limit  int    `help:"Maximum number of tool uses to show (newest first)" default:"200" short:"n"`
+	Name   string `arg:"" help:"Session name" predictor:"session"`
+}
+
+// Run executes the audit command
+func (s *SessionsAuditCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions audit command", "name", s.Name, "limit", s.Limit)
+
+	ctx := context.Background()
+	session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	toolUses, err := cli.Container.ToolAuditService.ListToolUses(ctx, s.Name, s.Limit)
+	if err != nil {
+		return fmt.Errorf("failed to list tool uses: %w", err)
+	}
+
+	if s.Format == "json" {
+		data, err := json.MarshalIndent(toolUses, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(toolUses) == 0 {
+		if !session.AllowDangerouslySkipPermissions {
+			fmt.Printf("Session '%s' asks for permissions, so its tools are not audited\n", s.Name)
+		} else {
+			fmt.Printf("No tool uses recorded for session '%s'\n", s.Name)
+		}
+		return nil
+	}
+	return s.printTable(toolUses)
+}
+
+func (s *SessionsAuditCmd) printTable(toolUses []domain.ToolUse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tTOOL\tRESULT\tSUMMARY")
+	for _, toolUse := range toolUses {
+		result := "ok"
+		if toolUse.Failed {
+			result = "failed"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			formatTime(toolUse.OccurredAt),
+			toolUse.ToolName,
+			result,
+			truncateLine(toolUse.Summary, auditSummaryWidth))
+	}
+	return w.Flush()
+}
+
+// truncateLine keeps text on one line and within the column width
+func truncateLine(text string, width int) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > width {
+		return string(runes[:width-1]) + "…"
+	}
+	return text
+}
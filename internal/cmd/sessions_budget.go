@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsBudgetCmd sets, shows, or clears the token budget of a session
+type SessionsBudgetCmd struct {
+	Clear  bool   `help:"Remove the budget"`
+	Name   string `arg:"" help:"Session name" predictor:"session"`
+	Tokens string `arg:"" optional:"" help:"Input and output tokens the session may use, e.g. 200k or 1.5M (omit to show the current budget)"`
+	WrapUp bool   `help:"Ask the agent to wrap up and summarize when the budget is exceeded" name:"wrap-up"`
+}
+
+// Run executes the budget command
+func (s *SessionsBudgetCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions budget command", "name", s.Name, "tokens", s.Tokens, "clear", s.Clear)
+
+	ctx := context.Background()
+
+	if s.Clear {
+		if s.Tokens != "" {
+			return fmt.Errorf("give a token count or --clear, not both: %w", domain.ErrInvalidInput)
+		}
+		if err := cli.Container.TokenBudgetService.ClearBudget(ctx, s.Name); err != nil {
+			return fmt.Errorf("failed to clear token budget: %w", err)
+		}
+		fmt.Printf("Token budget cleared for session '%s'\n", s.Name)
+		return nil
+	}
+
+	if s.Tokens == "" {
+		session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+		if err != nil {
+			return fmt.Errorf("session not found: %w", err)
+		}
+		fmt.Println(describeTokenBudget(session.TokenBudget))
+		return nil
+	}
+
+	limit, err := domain.ParseTokenCount(s.Tokens)
+	if err != nil {
+		return err
+	}
+	budget, err := cli.Container.TokenBudgetService.SetBudget(ctx, s.Name, limit, s.WrapUp)
+	if err != nil {
+		return fmt.Errorf("failed to set token budget: %w", err)
+	}
+
+	fmt.Printf("Token budget for session '%s': %s\n", s.Name, describeTokenBudget(budget))
+	if budget.Reached() {
+		fmt.Println("The budget is already used up; it is enforced at the next check of the TUI or scheduler")
+	}
+	return nil
+}
+
+// describeTokenBudget summarizes a session token budget for the sessions view and budget commands
+func describeTokenBudget(budget *domain.SessionTokenBudget) string {
+	if budget == nil {
+		return "No token budget"
+	}
+
+	description := fmt.Sprintf("%s of %s tokens used", domain.FormatTokenCount(budget.Used), domain.FormatTokenCount(budget.Limit))
+	if budget.Exceeded {
+		description += " (exceeded)"
+	}
+	if budget.WrapUp {
+		description += ", asks the agent to wrap up"
+	}
+	return description
+}
@@ -5,12 +5,13 @@ import (
 	"fmt"
 
 	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
 )
 
 // SessionsCaptureCmd captures the content of a session's tmux pane
 type SessionsCaptureCmd struct {
 	Lines int    `help:"Number of lines to capture" default:"50" short:"n"`
-	Name  string `arg:"" help:"Session name"`
+	Name  string `arg:"" help:"Session name" predictor:"session"`
 }
 
 // Run executes the capture command
@@ -26,7 +27,7 @@ func (s *SessionsCaptureCmd) Run(cli *CLI) error {
 
 	// Check if tmux session is running
 	if !cli.Container.SessionService.SessionExists(s.Name) {
-		return fmt.Errorf("tmux session '%s' is not running", s.Name)
+		return fmt.Errorf("%w: '%s' is not running", ports.ErrTmuxSessionNotFound, s.Name)
 	}
 
 	content, err := cli.Container.ShellService.CapturePane(s.Name, s.Lines)
@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsCheckpointCmd saves a checkpoint of a session worktree, or schedules checkpoints
+type SessionsCheckpointCmd struct {
+	Every  string `help:"Save checkpoints on a schedule: idle (each time the agent goes idle) or an interval such as 15m" placeholder:"WHEN"`
+	Name   string `arg:"" help:"Session name" predictor:"session"`
+	Off    bool   `help:"Stop saving scheduled checkpoints"`
+	Show   bool   `help:"Show the checkpoint schedule instead of saving a checkpoint"`
+	Target string `help:"Where checkpoints go: branch (rocha/checkpoints/<name>, untracked files included) or stash (tracked files only)" placeholder:"TARGET"`
+}
+
+// Run executes the checkpoint command
+func (s *SessionsCheckpointCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions checkpoint command", "name", s.Name, "every", s.Every, "target", s.Target, "off", s.Off)
+
+	ctx := context.Background()
+	service := cli.Container.CheckpointService
+
+	if s.Off {
+		if s.Every != "" || s.Target != "" {
+			return fmt.Errorf("give --every or --off, not both: %w", domain.ErrInvalidInput)
+		}
+		if err := service.SetPolicy(ctx, s.Name, nil); err != nil {
+			return fmt.Errorf("failed to turn off checkpoints: %w", err)
+		}
+		fmt.Printf("Checkpoints turned off for session '%s'\n", s.Name)
+		return nil
+	}
+
+	if s.Every != "" {
+		policy, err := domain.ParseCheckpointPolicy(s.Every, s.Target)
+		if err != nil {
+			return err
+		}
+		if err := service.SetPolicy(ctx, s.Name, policy); err != nil {
+			return fmt.Errorf("failed to schedule checkpoints: %w", err)
+		}
+		fmt.Printf("Checkpoints of session '%s' saved %s\n", s.Name, policy)
+		return nil
+	}
+
+	session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+	if err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+	if s.Show {
+		fmt.Println(describeCheckpoint(session.Checkpoint))
+		return nil
+	}
+
+	// A checkpoint right away goes where the scheduled ones go, or to --target
+	if s.Target != "" {
+		policy, err := domain.ParseCheckpointPolicy("idle", s.Target)
+		if err != nil {
+			return err
+		}
+		session.Checkpoint = policy
+	}
+	saved, err := service.Save(ctx, *session)
+	if err != nil {
+		return fmt.Errorf("failed to save checkpoint: %w", err)
+	}
+	if !saved {
+		fmt.Printf("Nothing new to checkpoint in session '%s'\n", s.Name)
+		return nil
+	}
+	if session.Checkpoint != nil && session.Checkpoint.Target == domain.CheckpointTargetStash {
+		fmt.Printf("Checkpoint of session '%s' stored as a stash\n", s.Name)
+		return nil
+	}
+	fmt.Printf("Checkpoint of session '%s' committed to %s\n", s.Name, domain.CheckpointBranchName(s.Name))
+	return nil
+}
+
+// describeCheckpoint summarizes a checkpoint policy for the sessions view and checkpoint commands
+func describeCheckpoint(policy *domain.CheckpointPolicy) string {
+	if policy == nil {
+		return "No scheduled checkpoints"
+	}
+
+	description := "Saved " + policy.String()
+	if policy.LastAt != nil {
+		description += fmt.Sprintf(" (last at %s)", formatTime(*policy.LastAt))
+	}
+	return description
+}
@@ -10,7 +10,7 @@ import (
 // SessionsCommentCmd adds, edits, or clears a session comment
 type SessionsCommentCmd struct {
 	Comment string `help:"Comment text (empty clears)" required:""`
-	Name    string `arg:"" help:"Session name"`
+	Name    string `arg:"" help:"Session name" predictor:"session"`
 }
 
 // Run executes the comment command
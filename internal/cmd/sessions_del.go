@@ -3,18 +3,21 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
-	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/services/actions"
 )
 
 // SessionsDelCmd deletes a session
 type SessionsDelCmd struct {
-	Force              bool   `help:"Force deletion without confirmation" short:"f"`
-	Name               string `arg:"" help:"Name of the session to delete"`
-	SkipKillTmux       bool   `help:"Skip killing tmux session" short:"k"`
-	SkipRemoveWorktree bool   `help:"Skip removing associated git worktree" short:"w"`
+	DiscardLocalWork   bool          `help:"Remove the worktree even if it has uncommitted changes or unpushed commits"`
+	Force              bool          `help:"Force deletion without confirmation" short:"f"`
+	Name               string        `arg:"" help:"Name of the session to delete" predictor:"session"`
+	ShutdownTimeout    time.Duration `help:"How long to wait for the agent to exit before killing tmux (0 kills immediately)" default:"10s"`
+	SkipKillTmux       bool          `help:"Skip killing tmux session" short:"k"`
+	SkipRemoveWorktree bool          `help:"Skip removing associated git worktree" short:"w"`
 }
 
 // Run executes the del command
@@ -36,7 +39,12 @@ func (s *SessionsDelCmd) Run(cli *CLI) error {
 		}
 	}
 
-	return s.deleteSession(ctx, cli, killTmux, removeWorktree)
+	var worktree actions.WorktreeRemoval
+	if removeWorktree {
+		worktree = confirmWorktreeRemoval(ctx, cli, session, s.DiscardLocalWork, !s.Force)
+	}
+
+	return s.deleteSession(ctx, cli, killTmux, worktree)
 }
 
 func (s *SessionsDelCmd) validateSession(ctx context.Context, cli *CLI) (*domain.Session, error) {
@@ -71,17 +79,19 @@ func (s *SessionsDelCmd) confirmDeletion(session *domain.Session, killTmux, remo
 	return true
 }
 
-func (s *SessionsDelCmd) deleteSession(ctx context.Context, cli *CLI, killTmux, removeWorktree bool) error {
+func (s *SessionsDelCmd) deleteSession(ctx context.Context, cli *CLI, killTmux bool, worktree actions.WorktreeRemoval) error {
 	logging.Logger.Info("Deleting session", "session", s.Name)
-	err := cli.Container.SessionService.DeleteSession(ctx, s.Name, services.DeleteSessionOptions{
-		KillTmux:       killTmux,
-		RemoveWorktree: removeWorktree,
+	outcome, err := cli.Container.ActionsService.Kill(ctx, s.Name, actions.KillOptions{
+		KeepTmux:        !killTmux,
+		ShutdownTimeout: s.ShutdownTimeout,
+		Worktree:        worktree,
 	})
 	if err != nil {
 		logging.Logger.Error("Failed to delete session", "session", s.Name, "error", err)
 		return fmt.Errorf("failed to delete session: %w", err)
 	}
 
+	printWorktreeOutcome(outcome)
 	logging.Logger.Info("Session deleted successfully via CLI", "session", s.Name)
 	fmt.Printf("Session '%s' deleted successfully\n", s.Name)
 	return nil
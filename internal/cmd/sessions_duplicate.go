@@ -3,6 +3,7 @@ package cmd
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
@@ -11,7 +12,7 @@ import (
 // SessionsDuplicateCmd creates a new session from an existing repository
 type SessionsDuplicateCmd struct {
 	Branch  string `help:"Branch for new session"`
-	Name    string `arg:"" help:"Source session name"`
+	Name    string `arg:"" help:"Source session name" predictor:"session"`
 	NewName string `help:"New session name" required:"" name:"new-name"`
 }
 
@@ -34,11 +35,15 @@ func (s *SessionsDuplicateCmd) Run(cli *CLI) error {
 
 	// Create new session from source repo
 	params := services.CreateSessionParams{
+		AgentArgs:                       sourceSession.AgentArgs,
+		AgentModel:                      sourceSession.AgentModel,
 		AllowDangerouslySkipPermissions: sourceSession.AllowDangerouslySkipPermissions,
+		BootstrapOutput:                 os.Stdout,
 		BranchNameOverride:              s.Branch,
 		ClaudeDirOverride:               sourceSession.ClaudeDir,
 		RepoSource:                      sourceSession.RepoSource,
 		SessionName:                     s.NewName,
+		Subdir:                          sourceSession.Subdir,
 		TmuxStatusPosition:              cli.Container.SettingsService.GetTmuxStatusPosition(),
 	}
 
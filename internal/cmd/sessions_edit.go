@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsEditCmd opens a session in an editor, or its metadata as YAML
+type SessionsEditCmd struct {
+	Changed  bool   `help:"Also open the files changed on the session branch" short:"c"`
+	Editor   string `help:"Editor for the default integration (overrides $ROCHA_EDITOR, $VISUAL, $EDITOR); with --metadata, the terminal editor (overrides $VISUAL, $EDITOR)"`
+	Metadata bool   `help:"Edit the display name, comment, status, priority, tags, and flag as YAML, and apply the changes on save" short:"m"`
+	Name     string `arg:"" help:"Session name" predictor:"session"`
+}
+
+// Run executes the edit command
+func (s *SessionsEditCmd) Run(cli *CLI) error {
+	ctx := context.Background()
+
+	if s.Metadata {
+		return s.editMetadata(ctx, cli)
+	}
+
+	editor := s.Editor
+	if editor == "" && cli.settings != nil {
+		editor = cli.settings.Editor
+	}
+
+	logging.Logger.Info("Opening session in editor", "name", s.Name, "changed", s.Changed, "editor", editor)
+
+	if err := cli.Container.ShellService.OpenSessionInEditor(ctx, s.Name, editor, s.Changed); err != nil {
+		return fmt.Errorf("failed to open editor: %w", err)
+	}
+
+	return nil
+}
+
+// sessionMetadataDocument is the YAML edited by sessions edit --metadata, with its fields
+// in the order the user sees them
+type sessionMetadataDocument struct {
+	DisplayName string   `yaml:"display_name"`
+	Comment     string   `yaml:"comment"`
+	Status      string   `yaml:"status"`
+	Priority    string   `yaml:"priority"`
+	Tags        []string `yaml:"tags"`
+	Flagged     bool     `yaml:"flagged"`
+}
+
+// metadataHeader explains the YAML document to the user, like the comments of git commit
+const metadataHeader = `# Edit the metadata of session '%s' and save to apply the changes.
+# Empty status or priority clears it; priority is P0, P1, P2, or P3.
+# Exit without saving to cancel.
+`
+
+// editMetadata dumps the session metadata as YAML into the editor and applies what changed
+func (s *SessionsEditCmd) editMetadata(ctx context.Context, cli *CLI) error {
+	session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+	if err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+
+	metadata := session.Metadata()
+	doc := sessionMetadataDocument{
+		Comment:     metadata.Comment,
+		DisplayName: metadata.DisplayName,
+		Flagged:     metadata.Flagged,
+		Priority:    string(metadata.Priority),
+		Tags:        metadata.Tags,
+	}
+	if metadata.Status != nil {
+		doc.Status = *metadata.Status
+	}
+	body, err := yaml.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode metadata: %w", err)
+	}
+	original := append([]byte(fmt.Sprintf(metadataHeader, s.Name)), body...)
+
+	file, err := os.CreateTemp("", "rocha-"+s.Name+"-*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to create metadata file: %w", err)
+	}
+	path := file.Name()
+	_, err = file.Write(original)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(path)
+		return fmt.Errorf("failed to write metadata file: %w", err)
+	}
+
+	logging.Logger.Info("Editing session metadata", "name", s.Name, "path", path)
+	if err := runTerminalEditor(s.Editor, path); err != nil {
+		_ = os.Remove(path)
+		return err
+	}
+
+	edited, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read metadata file: %w", err)
+	}
+	if bytes.Equal(edited, original) {
+		_ = os.Remove(path)
+		fmt.Println("Edit cancelled, no changes made")
+		return nil
+	}
+
+	// Keep the file when the edit cannot be applied, so the changes are not lost
+	var updated sessionMetadataDocument
+	decoder := yaml.NewDecoder(bytes.NewReader(edited))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&updated); errors.Is(err, io.EOF) {
+		_ = os.Remove(path)
+		fmt.Println("Edit cancelled, the file is empty")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("%w: invalid metadata (your edit is saved in %s): %v", domain.ErrInvalidInput, path, err)
+	}
+	changes, err := cli.Container.SessionService.UpdateMetadata(ctx, s.Name, domain.SessionMetadata{
+		Comment:     updated.Comment,
+		DisplayName: updated.DisplayName,
+		Flagged:     updated.Flagged,
+		Priority:    domain.Priority(updated.Priority),
+		Status:      &updated.Status,
+		Tags:        updated.Tags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update metadata (your edit is saved in %s): %w", path, err)
+	}
+	_ = os.Remove(path)
+
+	if len(changes) == 0 {
+		fmt.Printf("Session '%s' unchanged\n", s.Name)
+		return nil
+	}
+	fmt.Printf("Session '%s' edited: %s\n", s.Name, strings.Join(changes, ", "))
+	return nil
+}
+
+// runTerminalEditor opens path in editor, or $VISUAL, $EDITOR, or vi, and waits for it to exit.
+// The editor may include arguments, such as "code --wait".
+func runTerminalEditor(editor, path string) error {
+	for _, env := range []string{"VISUAL", "EDITOR"} {
+		if editor == "" {
+			editor = os.Getenv(env)
+		}
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+
+	args := strings.Fields(editor)
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("editor %q failed: %w", editor, err)
+	}
+	return nil
+}
@@ -9,7 +9,7 @@ import (
 
 // SessionsFlagCmd toggles the flag state of a session
 type SessionsFlagCmd struct {
-	Name string `arg:"" help:"Session name"`
+	Name string `arg:"" help:"Session name" predictor:"session"`
 }
 
 // Run executes the flag command
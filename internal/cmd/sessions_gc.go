@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsGCCmd removes the worktrees of sessions archived longer than the retention period
+type SessionsGCCmd struct {
+	Days   int  `help:"Remove worktrees archived at least this many days (default: worktree_retention_days setting)"`
+	DryRun bool `help:"Only show the report, without removing anything"`
+	Force  bool `help:"Skip confirmation prompt" short:"f"`
+}
+
+// Run executes the gc command
+func (s *SessionsGCCmd) Run(cli *CLI) error {
+	retention := cli.Container.WorktreeGCService.Retention()
+	if s.Days != 0 {
+		var err error
+		if retention, err = domain.NewWorktreeRetention(s.Days); err != nil {
+			return err
+		}
+	}
+	if !retention.IsEnabled() {
+		fmt.Println("Worktree retention is disabled; set worktree_retention_days in settings or pass --days")
+		return nil
+	}
+
+	ctx := context.Background()
+	candidates, err := cli.Container.WorktreeGCService.Plan(ctx, retention, time.Now())
+	if err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		fmt.Println("No worktrees of archived sessions are past retention")
+		return nil
+	}
+
+	removable := 0
+	for _, candidate := range candidates {
+		if candidate.Removable() {
+			removable++
+		}
+	}
+	if err := printWorktreeGCReport(candidates); err != nil {
+		return err
+	}
+	if s.DryRun || removable == 0 {
+		return nil
+	}
+
+	if !s.Force {
+		fmt.Printf("\nRemove %d worktree(s)? (y/N): ", removable)
+		var response string
+		fmt.Scanln(&response)
+		if response != "y" && response != "Y" {
+			fmt.Println("Cancelled")
+			return nil
+		}
+	}
+
+	logging.Logger.Info("Removing worktrees past retention", "count", removable)
+	removed := 0
+	for _, candidate := range cli.Container.WorktreeGCService.Remove(candidates) {
+		switch {
+		case candidate.Removed:
+			removed++
+		case candidate.Err != nil:
+			fmt.Printf("Warning: %s: %v\n", candidate.Session.Name, candidate.Err)
+		}
+	}
+	fmt.Printf("Removed %d worktree(s)\n", removed)
+	return nil
+}
+
+// printWorktreeGCReport lists the worktrees past retention and what happens to each
+func printWorktreeGCReport(candidates []domain.WorktreeGCCandidate) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SESSION\tARCHIVED\tWORKTREE\tACTION")
+	for _, candidate := range candidates {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+			candidate.Session.Name,
+			localTime(*candidate.Session.ArchivedAt).Format("2006-01-02"),
+			candidate.Session.WorktreePath,
+			candidate.Reason())
+	}
+	return w.Flush()
+}
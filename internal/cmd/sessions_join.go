@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsJoinCmd attaches to a session shared with `rocha sessions share`
+// The client is detached as soon as the share expires or is revoked
+type SessionsJoinCmd struct {
+	Token string `arg:"" help:"Share token from 'rocha sessions share'"`
+}
+
+// Run executes the join command
+func (s *SessionsJoinCmd) Run(cli *CLI) error {
+	logging.Logger.Info("Executing sessions join command")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	share, err := cli.Container.ShareService.ValidateShare(ctx, s.Token, time.Now())
+	if err != nil {
+		return err
+	}
+
+	attachCmd, err := cli.Container.ShareService.GetJoinCommand(share)
+	if err != nil {
+		return err
+	}
+	attachCmd.Stdin = os.Stdin
+	attachCmd.Stdout = os.Stdout
+	attachCmd.Stderr = os.Stderr
+
+	fmt.Printf("Joining session '%s' (%s, until %s)\n", share.SessionName, share.Access, formatTime(share.ExpiresAt))
+	if err := attachCmd.Start(); err != nil {
+		return fmt.Errorf("failed to attach to session: %w", err)
+	}
+
+	ended := make(chan error, 1)
+	go func() {
+		err := cli.Container.ShareService.WaitUntilInactive(ctx, s.Token)
+		if errors.Is(err, domain.ErrShareNotFound) {
+			// SIGTERM lets the tmux client restore the terminal before exiting
+			attachCmd.Process.Signal(syscall.SIGTERM)
+		}
+		ended <- err
+	}()
+
+	waitErr := attachCmd.Wait()
+	cancel()
+
+	if err := <-ended; errors.Is(err, domain.ErrShareNotFound) {
+		return fmt.Errorf("disconnected: %w", err)
+	}
+	if waitErr != nil {
+		return fmt.Errorf("tmux attach failed: %w", waitErr)
+	}
+	return nil
+}
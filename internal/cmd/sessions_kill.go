@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services/actions"
+)
+
+// SessionsKillCmd kills a session's tmux sessions, giving the agent time to exit first
+type SessionsKillCmd struct {
+	Force   bool          `help:"Kill immediately without asking the agent to exit" short:"f"`
+	Name    string        `arg:"" help:"Name of the session to kill" predictor:"session"`
+	Timeout time.Duration `help:"How long to wait for the agent to exit before killing" default:"10s"`
+}
+
+// Run executes the kill command
+func (s *SessionsKillCmd) Run(cli *CLI) error {
+	logging.Logger.Info("Executing sessions kill command", "session", s.Name, "force", s.Force, "timeout", s.Timeout)
+
+	ctx := context.Background()
+	session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+	if err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+
+	timeout := s.Timeout
+	if s.Force {
+		timeout = 0
+	}
+
+	if timeout > 0 && session.State != domain.StateExited {
+		fmt.Printf("Asking agent in '%s' to exit (up to %s)...\n", s.Name, timeout)
+	}
+	if _, err := cli.Container.ActionsService.Kill(ctx, s.Name, actions.KillOptions{ShutdownTimeout: timeout}); err != nil {
+		return fmt.Errorf("failed to kill session: %w", err)
+	}
+
+	fmt.Printf("Session '%s' killed\n", s.Name)
+	return nil
+}
@@ -5,23 +5,55 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/services/actions"
 )
 
 // SessionsListCmd lists all sessions
 type SessionsListCmd struct {
-	Format       string `help:"Output format: table or json" enum:"table,json" default:"table"`
-	ShowArchived bool   `help:"Show archived sessions" short:"a"`
+	Apply            string   `help:"Apply an action to every matching session: archive, kill, or set-status" enum:",archive,kill,set-status" default:""`
+	DiscardLocalWork bool     `help:"With --apply kill, remove worktrees even if they have uncommitted changes or unpushed commits"`
+	Flagged          bool     `help:"Only flagged sessions"`
+	Force            bool     `help:"Skip confirmation prompt for --apply" short:"f"`
+	Format           string   `help:"Output format: table or json" enum:"table,json" default:"table"`
+	OlderThan        string   `help:"Only sessions not updated for this long (e.g. 12h, 7d)"`
+	Repo             string   `help:"Only sessions whose repository contains this text" predictor:"repo"`
+	ShowArchived     bool     `help:"Show archived sessions" short:"a"`
+	State            []string `help:"Only sessions in these states (comma-separated: working, idle, waiting, paused, exited)" sep:","`
+	Status           []string `help:"Only sessions with these implementation statuses (comma-separated)" sep:"," predictor:"status"`
+	Tag              []string `help:"Only sessions with any of these tags (comma-separated)" sep:","`
+	To               string   `help:"Status to set with --apply set-status ('clear' clears)"`
+	Workspace        string   `help:"Only sessions in this workspace" predictor:"workspace"`
 }
 
 // Run executes the list command
 func (s *SessionsListCmd) Run(cli *CLI) error {
-	sessions, err := cli.Container.SessionService.ListSessions(context.Background(), s.ShowArchived)
+	logging.Logger.Debug("Executing sessions list command", "apply", s.Apply, "state", s.State, "status", s.Status, "tag", s.Tag, "repo", s.Repo, "workspace", s.Workspace, "flagged", s.Flagged, "olderThan", s.OlderThan)
+
+	filter, err := s.buildFilter()
+	if err != nil {
+		return err
+	}
+	if s.Apply == "set-status" && s.To == "" {
+		return fmt.Errorf("--to is required with --apply set-status: %w", domain.ErrInvalidInput)
+	}
+
+	ctx := context.Background()
+	sessions, err := cli.Container.SessionService.ListSessions(ctx, s.ShowArchived)
 	if err != nil {
 		return fmt.Errorf("failed to list sessions: %w", err)
 	}
+	sessions = filter.Apply(sessions, time.Now())
+
+	if s.Apply != "" {
+		return s.applyAction(ctx, cli, sessions)
+	}
 
 	if s.Format == "json" {
 		return s.printJSON(sessions)
@@ -29,6 +61,110 @@ func (s *SessionsListCmd) Run(cli *CLI) error {
 	return s.printTable(sessions)
 }
 
+// buildFilter converts the filter flags into a session filter
+func (s *SessionsListCmd) buildFilter() (domain.SessionFilter, error) {
+	filter := domain.SessionFilter{
+		FlaggedOnly: s.Flagged,
+		Repo:        s.Repo,
+		Statuses:    s.Status,
+		Tags:        s.Tag,
+		Workspace:   s.Workspace,
+	}
+
+	for _, state := range s.State {
+		switch sessionState := domain.SessionState(state); sessionState {
+		case domain.StateWorking, domain.StateIdle, domain.StateWaiting, domain.StatePaused, domain.StateExited:
+			filter.States = append(filter.States, sessionState)
+		default:
+			return filter, fmt.Errorf("invalid state '%s' (use working, idle, waiting, paused, or exited): %w", state, domain.ErrInvalidInput)
+		}
+	}
+
+	if s.OlderThan != "" {
+		age, err := domain.ParseAge(s.OlderThan)
+		if err != nil {
+			return filter, err
+		}
+		filter.OlderThan = age
+	}
+
+	return filter, nil
+}
+
+// applyAction runs the --apply action on every matching session
+// Failures are reported per session and do not stop the remaining sessions
+func (s *SessionsListCmd) applyAction(ctx context.Context, cli *CLI, sessions []domain.Session) error {
+	if len(sessions) == 0 {
+		fmt.Println("No sessions match the filter")
+		return nil
+	}
+
+	if !s.Force && !s.confirmApply(sessions) {
+		return nil
+	}
+
+	var failed int
+	for _, sess := range sessions {
+		if err := s.applyToSession(ctx, cli, sess); err != nil {
+			logging.Logger.Error("Failed to apply action", "action", s.Apply, "session", sess.Name, "error", err)
+			fmt.Printf("  ✗ %s: %v\n", sess.Name, err)
+			failed++
+			continue
+		}
+		fmt.Printf("  ✓ %s\n", sess.Name)
+	}
+
+	fmt.Printf("\nApplied '%s' to %d of %d sessions\n", s.Apply, len(sessions)-failed, len(sessions))
+	if failed > 0 {
+		return fmt.Errorf("failed to apply '%s' to %d sessions", s.Apply, failed)
+	}
+	return nil
+}
+
+func (s *SessionsListCmd) applyToSession(ctx context.Context, cli *CLI, sess domain.Session) error {
+	switch s.Apply {
+	case "archive":
+		if sess.IsArchived {
+			return nil
+		}
+		_, err := cli.Container.ActionsService.Archive(ctx, sess.Name, actions.WorktreeRemoval{})
+		return err
+	case "kill":
+		outcome, err := cli.Container.ActionsService.Kill(ctx, sess.Name, actions.KillOptions{
+			ShutdownTimeout: services.DefaultShutdownTimeout,
+			Worktree:        confirmWorktreeRemoval(ctx, cli, &sess, s.DiscardLocalWork, false),
+		})
+		printWorktreeOutcome(outcome)
+		return err
+	case "set-status":
+		var statusPtr *string
+		if s.To != "clear" {
+			statusPtr = &s.To
+		}
+		return cli.Container.SessionService.UpdateStatus(ctx, sess.Name, statusPtr)
+	default:
+		return fmt.Errorf("unknown action '%s': %w", s.Apply, domain.ErrInvalidInput)
+	}
+}
+
+func (s *SessionsListCmd) confirmApply(sessions []domain.Session) bool {
+	fmt.Printf("This will apply '%s' to %d sessions:\n", s.Apply, len(sessions))
+	for _, sess := range sessions {
+		fmt.Printf("  - %s\n", sess.Name)
+	}
+	if s.Apply == "kill" {
+		fmt.Println("Their tmux sessions and worktrees will be removed. Worktrees with local work are kept unless --discard-local-work is given.")
+	}
+	fmt.Print("\nContinue? (y/N): ")
+	var response string
+	fmt.Scanln(&response)
+	if response != "y" && response != "Y" {
+		fmt.Println("Cancelled")
+		return false
+	}
+	return true
+}
+
 func (s *SessionsListCmd) printJSON(sessions []domain.Session) error {
 	data, err := json.MarshalIndent(sessions, "", "  ")
 	if err != nil {
@@ -40,20 +176,21 @@ func (s *SessionsListCmd) printJSON(sessions []domain.Session) error {
 
 func (s *SessionsListCmd) printTable(sessions []domain.Session) error {
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintln(w, "NAME\tDISPLAY NAME\tSTATE\tBRANCH\tREPO\tARCHIVED\tLAST UPDATED")
+	fmt.Fprintln(w, "NAME\tDISPLAY NAME\tSTATE\tBRANCH\tREPO\tTAGS\tARCHIVED\tLAST UPDATED")
 	for _, sess := range sessions {
 		archived := ""
 		if sess.IsArchived {
 			archived = "✓"
 		}
-		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
 			sess.Name,
 			sess.DisplayName,
 			sess.State,
 			sess.BranchName,
 			sess.RepoInfo,
+			strings.Join(sess.Tags, ","),
 			archived,
-			sess.LastUpdated.Format("2006-01-02 15:04:05"))
+			formatTime(sess.LastUpdated))
 	}
 	w.Flush()
 
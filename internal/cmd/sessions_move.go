@@ -7,16 +7,18 @@ import (
 	"strings"
 
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
 )
 
 // SessionsMoveCmd moves sessions between ROCHA_HOME directories
 type SessionsMoveCmd struct {
-	Force bool   `help:"Skip confirmation prompt" short:"f"`
-	From  string `help:"Source ROCHA_HOME path" required:"true"`
-	Repo  string `help:"Repository identifier (owner/repo format)" short:"r" required:"true"`
-	To    string `help:"Destination ROCHA_HOME path" required:"true"`
+	Force            bool   `help:"Skip confirmation prompt" short:"f"`
+	From             string `help:"Source ROCHA_HOME path" required:"true"`
+	RenameOnConflict bool   `help:"Move sessions whose name or worktree is taken at the destination as <name>-2, <name>-3, ..."`
+	Repo             string `help:"Repository identifier (owner/repo format)" short:"r" required:"true"`
+	To               string `help:"Destination ROCHA_HOME path" required:"true"`
 }
 
 // Run executes the move command
@@ -39,23 +41,30 @@ func (s *SessionsMoveCmd) Run(cli *CLI) error {
 
 	ctx := context.Background()
 
-	// Get session count for confirmation
-	sourceSessions, err := cli.Container.MigrationService.GetSessionsForRepo(
-		ctx,
-		sourceHome,
-		s.Repo,
-	)
+	// Preview the move: it is refused when anything collides at the destination,
+	// unless colliding sessions may move under a new name
+	plan, err := cli.Container.MigrationService.PlanRepositoryMove(ctx, sourceHome, destHome, s.Repo)
 	if err != nil {
-		logging.Logger.Error("Failed to get sessions for repo", "repo", s.Repo, "error", err)
-		return fmt.Errorf("failed to get sessions for repository %s: %w", s.Repo, err)
+		logging.Logger.Error("Failed to plan repository move", "repo", s.Repo, "error", err)
+		return fmt.Errorf("failed to plan move of repository %s: %w", s.Repo, err)
 	}
 
-	if len(sourceSessions) == 0 {
-		return fmt.Errorf("no sessions found for repository: %s", s.Repo)
+	if plan.MainConflict != "" {
+		return fmt.Errorf("%w: %s", domain.ErrSessionExists, plan.MainConflict)
+	}
+	var renames map[string]string
+	if conflicts := plan.Conflicts(); len(conflicts) > 0 {
+		if !s.RenameOnConflict {
+			for _, conflict := range conflicts {
+				fmt.Fprintf(os.Stderr, "  %s: %s\n", conflict.Session.Name, conflict.Conflict)
+			}
+			return fmt.Errorf("%w: %d session(s) collide at %s; use --rename-on-conflict or resolve them interactively in the TUI (press M)", domain.ErrSessionExists, len(conflicts), destHome)
+		}
+		renames = plan.SuggestRenames()
 	}
 
 	if !s.Force {
-		if !s.confirmMove(sourceHome, destHome, len(sourceSessions)) {
+		if !s.confirmMove(sourceHome, destHome, plan, renames) {
 			return nil
 		}
 	}
@@ -65,6 +74,8 @@ func (s *SessionsMoveCmd) Run(cli *CLI) error {
 
 	result, err := cli.Container.MigrationService.MoveRepositoryBetweenHomes(ctx, services.MoveRepositoryBetweenHomesParams{
 		DestRochaHome:   destHome,
+		Progress:        os.Stdout,
+		Renames:         renames,
 		RepoInfo:        s.Repo,
 		SourceRochaHome: sourceHome,
 	})
@@ -80,7 +91,7 @@ func (s *SessionsMoveCmd) Run(cli *CLI) error {
 
 func (s *SessionsMoveCmd) validateRepoFormat() error {
 	if !strings.Contains(s.Repo, "/") {
-		return fmt.Errorf("invalid repo format '%s': must be in owner/repo format", s.Repo)
+		return fmt.Errorf("%w: repo '%s' must be in owner/repo format", domain.ErrInvalidInput, s.Repo)
 	}
 	return nil
 }
@@ -110,14 +121,31 @@ func (s *SessionsMoveCmd) createDestPath(destHome string) error {
 	return nil
 }
 
-func (s *SessionsMoveCmd) confirmMove(sourceHome, destHome string, sessionCount int) bool {
+func (s *SessionsMoveCmd) confirmMove(sourceHome, destHome string, plan *services.MovePlan, renames map[string]string) bool {
 	logging.Logger.Debug("Prompting user for confirmation", "repo", s.Repo)
 	fmt.Println("WARNING: This operation will:")
 	fmt.Println("  - Kill tmux sessions for all sessions in the specified repository")
 	fmt.Println("  - Move main repository directory and all worktrees to the new ROCHA_HOME location")
 	fmt.Println("  - Repair git worktree references")
 	fmt.Printf("  - Move sessions from %s to %s\n", sourceHome, destHome)
-	fmt.Printf("\nRepository to move: %s (%d session(s))\n", s.Repo, sessionCount)
+	fmt.Printf("\nRepository to move: %s (%d session(s))\n", s.Repo, len(plan.Sessions))
+	if plan.ReuseDestMain {
+		fmt.Printf("  main: reusing %s\n", plan.DestMainPath)
+	} else if plan.SourceMainPath != "" {
+		fmt.Printf("  main: %s -> %s\n", plan.SourceMainPath, plan.DestMainPath)
+	}
+	for _, planned := range plan.Sessions {
+		name, destWorktree := planned.Session.Name, planned.DestWorktree
+		if newName, ok := renames[name]; ok {
+			name = fmt.Sprintf("%s (renamed to %s)", name, newName)
+			destWorktree = services.RenamedWorktreePath(destWorktree, newName)
+		}
+		if destWorktree != "" {
+			fmt.Printf("  %s: %s -> %s\n", name, planned.SourceWorktree, destWorktree)
+		} else {
+			fmt.Printf("  %s\n", name)
+		}
+	}
 	fmt.Print("\nContinue? (y/N): ")
 	var response string
 	fmt.Scanln(&response)
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsNoteCmd sets or clears a session's markdown note, or sets it to a summary of the session diff
+type SessionsNoteCmd struct {
+	File      string `help:"Read the note from a markdown file ('-' for stdin)" xor:"source"`
+	Name      string `arg:"" help:"Session name" predictor:"session"`
+	Note      string `help:"Note text in markdown (empty clears)" xor:"source"`
+	Summarize bool   `help:"Replace the note with a summary of the session diff, written by summary_command (default: claude -p)" xor:"source"`
+}
+
+// Run executes the note command
+func (s *SessionsNoteCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions note command", "name", s.Name, "file", s.File, "summarize", s.Summarize)
+
+	ctx := context.Background()
+
+	// Validate session exists
+	if _, err := cli.Container.SessionService.GetSession(ctx, s.Name); err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+
+	if s.Summarize {
+		summary, err := cli.Container.DiffSummaryService.SummarizeDiff(ctx, s.Name)
+		if err != nil {
+			return err
+		}
+		fmt.Println(summary)
+		fmt.Printf("\nNote updated for session '%s'\n", s.Name)
+		return nil
+	}
+
+	note := s.Note
+	if s.File != "" {
+		content, err := s.readFile()
+		if err != nil {
+			return err
+		}
+		note = content
+	}
+	note = strings.TrimSpace(note)
+
+	if err := cli.Container.SessionService.UpdateNote(ctx, s.Name, note); err != nil {
+		return fmt.Errorf("failed to update note: %w", err)
+	}
+
+	if note == "" {
+		fmt.Printf("Note cleared for session '%s'\n", s.Name)
+	} else {
+		fmt.Printf("Note updated for session '%s'\n", s.Name)
+	}
+	return nil
+}
+
+// readFile reads the note content from the file or stdin
+func (s *SessionsNoteCmd) readFile() (string, error) {
+	if s.File == "-" {
+		content, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", fmt.Errorf("failed to read note from stdin: %w", err)
+		}
+		return string(content), nil
+	}
+
+	content, err := os.ReadFile(s.File)
+	if err != nil {
+		return "", fmt.Errorf("failed to read note file: %w", err)
+	}
+	return string(content), nil
+}
@@ -9,7 +9,7 @@ import (
 )
 
 type SessionsOpenPRCmd struct {
-	Name string `arg:"" help:"Session name"`
+	Name string `arg:"" help:"Session name" predictor:"session"`
 }
 
 func (s *SessionsOpenPRCmd) Run(cli *CLI) error {
@@ -22,17 +22,18 @@ func (s *SessionsOpenPRCmd) Run(cli *CLI) error {
 	}
 
 	// Validate worktree/branch exists
-	if session.WorktreePath == "" {
+	workingDir := session.WorkingDir()
+	if workingDir == "" {
 		return fmt.Errorf("session '%s' has no worktree", s.Name)
 	}
 	if session.BranchName == "" {
 		return fmt.Errorf("session '%s' has no branch", s.Name)
 	}
 
-	logging.Logger.Debug("Opening PR for session", "name", s.Name, "path", session.WorktreePath)
+	logging.Logger.Debug("Opening PR for session", "name", s.Name, "path", workingDir)
 
 	// Fetch PR info to get the number
-	prInfo, err := cli.Container.GitService.FetchPRInfo(ctx, session.WorktreePath, session.BranchName)
+	prInfo, err := cli.Container.GitService.FetchPRInfo(ctx, workingDir, session.BranchName)
 	if err != nil {
 		return fmt.Errorf("failed to get PR info: %w", err)
 	}
@@ -41,7 +42,7 @@ func (s *SessionsOpenPRCmd) Run(cli *CLI) error {
 	}
 
 	// Open PR in browser
-	if err := cli.Container.GitService.OpenPRInBrowser(session.WorktreePath); err != nil {
+	if err := cli.Container.GitService.OpenPRInBrowser(workingDir); err != nil {
 		return fmt.Errorf("failed to open PR: %w", err)
 	}
 
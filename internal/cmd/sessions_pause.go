@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// SessionsPauseCmd pauses or resumes the agent of a session
+type SessionsPauseCmd struct {
+	Force      bool   `help:"When resuming, send a prompt matching the prompt review patterns without confirmation" short:"f"`
+	LastPrompt bool   `help:"When resuming, resend the last prompt sent to the session instead of \"continue\""`
+	Name       string `arg:"" help:"Name of the session to pause/resume" predictor:"session"`
+	Prompt     string `help:"When resuming, send this prompt instead of \"continue\"" short:"p"`
+}
+
+// Run executes the pause command
+func (s *SessionsPauseCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions pause command", "name", s.Name)
+
+	ctx := context.Background()
+	session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+	if err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+
+	if session.State != domain.StatePaused {
+		if err := cli.Container.PauseService.Pause(ctx, s.Name); err != nil {
+			return fmt.Errorf("failed to pause session: %w", err)
+		}
+		fmt.Printf("Session '%s' paused\n", s.Name)
+		return nil
+	}
+
+	queued, err := cli.Container.PauseService.Resume(ctx, s.Name, services.ResumeAgentOptions{
+		Confirmed:        s.Force,
+		Prompt:           s.Prompt,
+		ResendLastPrompt: s.LastPrompt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to resume session: %w", err)
+	}
+	if queued != nil {
+		fmt.Printf("Concurrency limit reached: queued prompt #%d to resume session '%s'\n", queued.ID, s.Name)
+		return nil
+	}
+	fmt.Printf("Session '%s' resumed\n", s.Name)
+	return nil
+}
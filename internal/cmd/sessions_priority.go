@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsPriorityCmd sets, cycles, or clears the priority of a session
+type SessionsPriorityCmd struct {
+	Name     string `arg:"" help:"Session name" predictor:"session"`
+	Priority string `arg:"" optional:"" help:"Priority: P0, P1, P2, P3, or none (omit to cycle to the next one)" predictor:"priority"`
+}
+
+// Run executes the priority command
+func (s *SessionsPriorityCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions priority command", "name", s.Name, "priority", s.Priority)
+
+	ctx := context.Background()
+
+	// Validate session exists
+	if _, err := cli.Container.SessionService.GetSession(ctx, s.Name); err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+
+	var priority domain.Priority
+	if s.Priority == "" {
+		next, err := cli.Container.SessionService.CyclePriority(ctx, s.Name)
+		if err != nil {
+			return fmt.Errorf("failed to cycle priority: %w", err)
+		}
+		priority = next
+	} else {
+		parsed, err := domain.ParsePriority(s.Priority)
+		if err != nil {
+			return err
+		}
+		if err := cli.Container.SessionService.UpdatePriority(ctx, s.Name, parsed); err != nil {
+			return fmt.Errorf("failed to update priority: %w", err)
+		}
+		priority = parsed
+	}
+
+	if priority == domain.PriorityNone {
+		fmt.Printf("Priority cleared for session '%s'\n", s.Name)
+	} else {
+		fmt.Printf("Priority set to '%s' for session '%s'\n", priority, s.Name)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// promptTextWidth caps the text column of the prompts table
+const promptTextWidth = 100
+
+// SessionsPromptsCmd lists the prompt history of a session
+type SessionsPromptsCmd struct {
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+	Limit  int    `help:"Maximum number of prompts to show (newest first)" default:"20" short:"n"`
+	Name   string `arg:"" help:"Session name" predictor:"session"`
+}
+
+// Run executes the prompts command
+func (s *SessionsPromptsCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions prompts command", "name", s.Name, "limit", s.Limit)
+
+	prompts, err := cli.Container.SchedulerService.ListPromptHistory(context.Background(), s.Name, s.Limit)
+	if err != nil {
+		return fmt.Errorf("failed to list prompts: %w", err)
+	}
+
+	if s.Format == "json" {
+		data, err := json.MarshalIndent(prompts, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(prompts) == 0 {
+		fmt.Printf("No prompts sent to session '%s' yet\n", s.Name)
+		return nil
+	}
+	return s.printTable(prompts)
+}
+
+func (s *SessionsPromptsCmd) printTable(prompts []domain.SentPrompt) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TIME\tTEXT")
+	for _, prompt := range prompts {
+		fmt.Fprintf(w, "%s\t%s\n",
+			formatTime(prompt.SentAt),
+			truncateLine(prompt.Text, promptTextWidth))
+	}
+	return w.Flush()
+}
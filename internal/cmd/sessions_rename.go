@@ -4,30 +4,73 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
 )
 
-// SessionsRenameCmd updates the display name of a session
+// SessionsRenameCmd renames a session or updates its display name
 type SessionsRenameCmd struct {
-	DisplayName string `help:"New display name" required:"" name:"display-name"`
-	Name        string `arg:"" help:"Session name"`
+	Branch       string `help:"Also rename the session branch to this" default:""`
+	DisplayName  string `help:"New display name" name:"display-name"`
+	MoveWorktree bool   `help:"Also move the worktree directory to match the session name (stop the session first)" name:"move-worktree"`
+	Name         string `arg:"" help:"Session name" predictor:"session"`
+	To           string `help:"New session name; the tmux session, shell session, and stored history follow it" default:""`
 }
 
 // Run executes the rename command
 func (s *SessionsRenameCmd) Run(cli *CLI) error {
-	logging.Logger.Debug("Executing sessions rename command", "name", s.Name, "displayName", s.DisplayName)
+	logging.Logger.Debug("Executing sessions rename command", "name", s.Name, "to", s.To, "displayName", s.DisplayName,
+		"branch", s.Branch, "moveWorktree", s.MoveWorktree)
 
 	ctx := context.Background()
 
 	// Validate session exists
-	if _, err := cli.Container.SessionService.GetSession(ctx, s.Name); err != nil {
+	session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+	if err != nil {
 		return fmt.Errorf("session not found: %w", err)
 	}
 
-	if err := cli.Container.SessionService.UpdateDisplayName(ctx, s.Name, s.DisplayName); err != nil {
-		return fmt.Errorf("failed to update display name: %w", err)
+	if s.To == "" && s.Branch == "" && !s.MoveWorktree {
+		if s.DisplayName == "" {
+			return fmt.Errorf("%w: give --to, --display-name, --branch, or --move-worktree", domain.ErrInvalidInput)
+		}
+		if err := cli.Container.SessionService.UpdateDisplayName(ctx, s.Name, s.DisplayName); err != nil {
+			return fmt.Errorf("failed to update display name: %w", err)
+		}
+		fmt.Printf("Session '%s' display name updated to '%s'\n", s.Name, s.DisplayName)
+		return nil
 	}
 
-	fmt.Printf("Session '%s' display name updated to '%s'\n", s.Name, s.DisplayName)
+	newName := s.Name
+	if s.To != "" {
+		newName = domain.SanitizeSessionName(s.To)
+	}
+	displayName := s.DisplayName
+	if displayName == "" && s.To != "" && session.DisplayName == session.Name {
+		// The display name only defaulted to the old name
+		displayName = s.To
+	}
+
+	err = cli.Container.SessionService.RenameSession(ctx, s.Name, newName, services.RenameOptions{
+		BranchName:   s.Branch,
+		DisplayName:  displayName,
+		MoveWorktree: s.MoveWorktree,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to rename session: %w", err)
+	}
+
+	renamed, err := cli.Container.SessionService.GetSession(ctx, newName)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Session '%s' renamed to '%s'\n", s.Name, renamed.Name)
+	if s.Branch != "" {
+		fmt.Printf("Branch: %s\n", renamed.BranchName)
+	}
+	if s.MoveWorktree {
+		fmt.Printf("Worktree: %s\n", renamed.WorktreePath)
+	}
 	return nil
 }
@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsRestartCmd restarts a session whose Claude process exited or whose tmux session is gone
+type SessionsRestartCmd struct {
+	Mode string `help:"How to restart: resume the recorded Claude conversation, start a fresh one, or open a shell only" enum:"resume,fresh,shell" default:"resume"`
+	Name string `arg:"" help:"Session name" predictor:"session"`
+}
+
+// Run executes the restart command
+func (s *SessionsRestartCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions restart command", "name", s.Name, "mode", s.Mode)
+
+	mode := domain.RestartMode(s.Mode)
+	tmuxStatusPosition := cli.Container.SettingsService.GetTmuxStatusPosition()
+	if err := cli.Container.SessionService.RestartSession(context.Background(), s.Name, mode, tmuxStatusPosition); err != nil {
+		return fmt.Errorf("failed to restart session: %w", err)
+	}
+
+	fmt.Printf("Session '%s' restarted (%s)\n", s.Name, mode)
+	return nil
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// SessionsSendCmd sends text to a session now or schedules it for later
+type SessionsSendCmd struct {
+	At    string        `help:"Send at a time of day (HH:MM, next occurrence) or RFC3339 timestamp"`
+	Force bool          `help:"Send text matching the prompt review patterns without confirmation" short:"f"`
+	In    time.Duration `help:"Send after a delay (e.g. 30m, 2h)"`
+	Name  string        `arg:"" help:"Session name" predictor:"session"`
+	Text  string        `arg:"" help:"Text to send"`
+}
+
+// Run executes the send command
+func (s *SessionsSendCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions send command", "name", s.Name, "at", s.At, "in", s.In)
+
+	ctx := context.Background()
+
+	confirmed, err := s.confirmReview(ctx, cli)
+	if err != nil {
+		return err
+	}
+
+	if s.At == "" && s.In == 0 {
+		queued, err := cli.Container.SchedulerService.SendOrQueue(ctx, s.Name, s.Text, confirmed, time.Now())
+		if err != nil {
+			return fmt.Errorf("failed to send text: %w", err)
+		}
+		if queued != nil {
+			fmt.Printf("Concurrency limit reached: queued prompt #%d for session '%s'\n", queued.ID, s.Name)
+			fmt.Println("It is delivered once a working session finishes, while the rocha TUI or 'rocha scheduler' is running")
+			return nil
+		}
+		fmt.Printf("Text sent to session '%s'\n", s.Name)
+		return nil
+	}
+
+	sendAt, err := services.ResolveSendTime(s.At, s.In, time.Now())
+	if err != nil {
+		return err
+	}
+
+	prompt, err := cli.Container.SchedulerService.Schedule(ctx, s.Name, s.Text, confirmed, sendAt)
+	if err != nil {
+		return fmt.Errorf("failed to schedule text: %w", err)
+	}
+
+	fmt.Printf("Scheduled prompt #%d for session '%s' at %s\n", prompt.ID, s.Name, formatTime(sendAt))
+	fmt.Println("Prompts are delivered while the rocha TUI or 'rocha scheduler' is running")
+	return nil
+}
+
+// confirmReview asks before sending text that matches the prompt review patterns to a
+// session running with skip-permissions, and fails when the answer is not yes.
+// Returns whether the text was confirmed, which the scheduler requires for such text.
+func (s *SessionsSendCmd) confirmReview(ctx context.Context, cli *CLI) (bool, error) {
+	if s.Force {
+		return true, nil
+	}
+	matches, err := cli.Container.SchedulerService.ReviewPrompt(ctx, s.Name, s.Text)
+	if err != nil {
+		return false, fmt.Errorf("failed to review text: %w", err)
+	}
+	if len(matches) == 0 {
+		return false, nil
+	}
+
+	fmt.Printf("Session '%s' runs with skip-permissions and the text contains: %s\n", s.Name, strings.Join(matches, ", "))
+	fmt.Print("Send it anyway? (y/N): ")
+	var response string
+	fmt.Scanln(&response)
+	if response != "y" && response != "Y" {
+		return false, fmt.Errorf("%w: text not sent, pass --force to send it without asking", domain.ErrConfirmationRequired)
+	}
+	return true, nil
+}
+
+// SessionsCancelSendCmd cancels a scheduled prompt
+type SessionsCancelSendCmd struct {
+	ID uint `arg:"" help:"Scheduled prompt ID (see 'rocha sessions view')"`
+}
+
+// Run executes the cancel-send command
+func (s *SessionsCancelSendCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions cancel-send command", "id", s.ID)
+
+	if err := cli.Container.SchedulerService.Cancel(context.Background(), s.ID); err != nil {
+		return fmt.Errorf("failed to cancel scheduled prompt: %w", err)
+	}
+
+	fmt.Printf("Scheduled prompt #%d cancelled\n", s.ID)
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
 )
@@ -13,9 +14,9 @@ import (
 type SessionSetCmd struct {
 	All      bool   `help:"Apply to all sessions" short:"a"`
 	KillTmux bool   `help:"Kill tmux sessions to apply changes immediately" short:"k"`
-	Name     string `arg:"" optional:"" help:"Name of the session (omit when using --all)"`
+	Name     string `arg:"" optional:"" help:"Name of the session (omit when using --all)" predictor:"session"`
 	Value    string `help:"Value to set (empty string to clear)" required:""`
-	Variable string `help:"Variable to set" short:"v" enum:"claudedir,allow-dangerously-skip-permissions" required:""`
+	Variable string `help:"Variable to set" short:"v" enum:"claudedir,allow-dangerously-skip-permissions,editor,model,agent-args,keep-worktree,subdir" required:""`
 }
 
 // AfterApply validates that either Name or All is provided, but not both
@@ -88,6 +89,34 @@ func (s *SessionSetCmd) createUpdater(cli *CLI) (sessionUpdater, error) {
 			return cli.Container.SettingsService.SetClaudeDir(ctx, name, s.Value)
 		}, nil
 
+	case "editor":
+		if _, err := domain.ParseEditorIntegration(s.Value); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, name string) error {
+			return cli.Container.SettingsService.SetEditor(ctx, name, s.Value)
+		}, nil
+
+	case "model":
+		if err := domain.ValidateAgentModel(strings.TrimSpace(s.Value)); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, name string) error {
+			return cli.Container.SettingsService.SetAgentModel(ctx, name, s.Value)
+		}, nil
+
+	case "agent-args":
+		args, err := domain.ParseAgentArgs(s.Value)
+		if err != nil {
+			return nil, err
+		}
+		if err := domain.ValidateAgentArgs(args); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, name string) error {
+			return cli.Container.SettingsService.SetAgentArgs(ctx, name, s.Value)
+		}, nil
+
 	case "allow-dangerously-skip-permissions":
 		skipPermissions, err := parseBoolValue(s.Value)
 		if err != nil {
@@ -98,12 +127,35 @@ func (s *SessionSetCmd) createUpdater(cli *CLI) (sessionUpdater, error) {
 			return cli.Container.SettingsService.SetSkipPermissions(ctx, name, skipPermissions)
 		}, nil
 
+	case "keep-worktree":
+		keep, err := parseBoolValue(s.Value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for keep-worktree: %w (use: true/false, yes/no, 1/0)", err)
+		}
+		return func(ctx context.Context, name string) error {
+			return cli.Container.SettingsService.SetKeepWorktree(ctx, name, keep)
+		}, nil
+
+	case "subdir":
+		if _, err := domain.NormalizeSubdir(s.Value); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context, name string) error {
+			return cli.Container.SettingsService.SetSubdir(ctx, name, s.Value)
+		}, nil
+
 	default:
 		return nil, fmt.Errorf("unknown variable type: %s", s.Variable)
 	}
 }
 
 func (s *SessionSetCmd) handleTmuxSessions(sessionService *services.SessionService, sessionNames, failedSessions []string) {
+	// The editor integration is read when opening the editor and keep-worktree by the worktree
+	// retention, running agents are unaffected
+	if s.Variable == "editor" || s.Variable == "keep-worktree" {
+		return
+	}
+
 	successfulSessions := filterSuccessfulSessions(sessionNames, failedSessions)
 
 	if s.KillTmux {
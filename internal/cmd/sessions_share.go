@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsShareCmd creates, lists, or revokes links that let a teammate attach to a session
+type SessionsShareCmd struct {
+	Expires   string `help:"How long the share stays valid (e.g. 30m, 2h, 1d)" default:"1h"`
+	Host      string `help:"Host for the SSH command (default: this machine's hostname)"`
+	List      bool   `help:"List active shares instead of creating one"`
+	Name      string `arg:"" help:"Name of the session to share" predictor:"session"`
+	ReadWrite bool   `help:"Let the teammate type into the session (default: read-only)"`
+	Revoke    string `help:"Revoke a share by token, or 'all' to revoke every share of the session"`
+	User      string `help:"Teammate's account, the only one let in besides yours (required for read-only shares; also the SSH login)"`
+}
+
+// Run executes the share command
+func (s *SessionsShareCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions share command", "name", s.Name, "list", s.List, "readWrite", s.ReadWrite, "expires", s.Expires)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	switch {
+	case s.Revoke == "all":
+		count, err := cli.Container.ShareService.RevokeAllShares(ctx, s.Name, now)
+		if err != nil {
+			return fmt.Errorf("failed to revoke shares: %w", err)
+		}
+		fmt.Printf("Revoked %d shares of session '%s'\n", count, s.Name)
+		return nil
+	case s.Revoke != "":
+		if err := cli.Container.ShareService.RevokeShare(ctx, s.Name, s.Revoke, now); err != nil {
+			return fmt.Errorf("failed to revoke share: %w", err)
+		}
+		fmt.Printf("Share revoked for session '%s'\n", s.Name)
+		return nil
+	case s.List:
+		return s.printShares(ctx, cli, now)
+	}
+
+	ttl, err := domain.ParseAge(s.Expires)
+	if err != nil {
+		return err
+	}
+	access := domain.ShareReadOnly
+	if s.ReadWrite {
+		access = domain.ShareReadWrite
+	}
+
+	share, err := cli.Container.ShareService.CreateShare(ctx, s.Name, s.User, access, ttl, now)
+	if err != nil {
+		return fmt.Errorf("failed to create share: %w", err)
+	}
+	attachCmd, err := cli.Container.ShareService.GetJoinCommand(share)
+	if err != nil {
+		return err
+	}
+	attach := domain.ShellJoin(attachCmd.Args...)
+
+	account := "your account"
+	if share.Guest != "" {
+		account = fmt.Sprintf("the account '%s'", share.Guest)
+	}
+	fmt.Printf("Session '%s' shared %s with %s until %s\n\n", s.Name, share.Access, account, formatTime(share.ExpiresAt))
+	fmt.Printf("On this machine:\n  %s\n\n", attach)
+	fmt.Printf("Over SSH:\n  ssh -t %s %s\n\n", domain.ShellQuote(s.sshTarget()), domain.ShellQuote(attach))
+	fmt.Printf("From an account that can read this rocha state, leaving when the share ends:\n  %s\n\n", s.joinCommand(share.Token))
+	fmt.Printf("Revoke with: rocha sessions share %s --revoke %s\n", domain.ShellQuote(s.Name), share.Token)
+	return nil
+}
+
+func (s *SessionsShareCmd) printShares(ctx context.Context, cli *CLI, now time.Time) error {
+	shares, err := cli.Container.ShareService.ListActiveShares(ctx, s.Name, now)
+	if err != nil {
+		return fmt.Errorf("failed to list shares: %w", err)
+	}
+	if len(shares) == 0 {
+		fmt.Printf("No active shares for session '%s'\n", s.Name)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "TOKEN\tACCESS\tEXPIRES")
+	for _, share := range shares {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", share.Token, share.Access, formatTime(share.ExpiresAt))
+	}
+	return w.Flush()
+}
+
+// joinCommand builds the rocha command that joins the share, keeping a custom ROCHA_HOME so it finds the share
+func (s *SessionsShareCmd) joinCommand(token string) string {
+	joinCmd := domain.ShellJoin("rocha", "sessions", "join", token)
+	if rochaHome := os.Getenv("ROCHA_HOME"); rochaHome != "" {
+		joinCmd = "ROCHA_HOME=" + domain.ShellQuote(rochaHome) + " " + joinCmd
+	}
+	return joinCmd
+}
+
+// sshTarget is the host the teammate logs in to, as the teammate's account when known;
+// otherwise ssh picks their own login
+func (s *SessionsShareCmd) sshTarget() string {
+	host := s.Host
+	if host == "" {
+		host = "localhost"
+		if name, err := os.Hostname(); err == nil {
+			host = name
+		}
+	}
+	if s.User != "" {
+		return s.User + "@" + host
+	}
+	return host
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsStashCmd stashes, lists, or pops the uncommitted changes of a session worktree
+type SessionsStashCmd struct {
+	List bool   `help:"List the stashes of the session branch" short:"l"`
+	Name string `arg:"" help:"Session name" predictor:"session"`
+	Pop  bool   `help:"Apply the latest stash of the session branch and drop it" short:"p"`
+}
+
+// Run executes the stash command
+func (s *SessionsStashCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions stash command", "name", s.Name, "list", s.List, "pop", s.Pop)
+
+	if s.List && s.Pop {
+		return fmt.Errorf("give --list or --pop, not both: %w", domain.ErrInvalidInput)
+	}
+
+	ctx := context.Background()
+
+	session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+	if err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+	workingDir := session.WorkingDir()
+	if workingDir == "" {
+		return fmt.Errorf("session '%s' has no worktree", s.Name)
+	}
+
+	switch {
+	case s.List:
+		stashes, err := cli.Container.GitService.ListStashes(ctx, workingDir)
+		if err != nil {
+			return fmt.Errorf("failed to list stashes: %w", err)
+		}
+		if len(stashes) == 0 {
+			fmt.Printf("No stashes for session '%s'\n", s.Name)
+			return nil
+		}
+		for _, stash := range stashes {
+			fmt.Printf("%s  %s  %s\n", stash.Ref, formatTime(stash.CreatedAt), stash.Message)
+		}
+
+	case s.Pop:
+		stash, err := cli.Container.GitService.PopStash(ctx, workingDir)
+		if err != nil {
+			return fmt.Errorf("failed to pop stash: %w", err)
+		}
+		fmt.Printf("Popped stash '%s' into session '%s'\n", stash.Message, s.Name)
+
+	default:
+		stashed, err := cli.Container.GitService.StashChanges(ctx, workingDir, s.Name)
+		if err != nil {
+			return fmt.Errorf("failed to stash changes: %w", err)
+		}
+		if !stashed {
+			fmt.Printf("No changes to stash in session '%s'\n", s.Name)
+			return nil
+		}
+		fmt.Printf("Stashed changes of session '%s' (restore with: rocha sessions stash %s --pop)\n", s.Name, s.Name)
+	}
+	return nil
+}
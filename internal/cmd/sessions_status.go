@@ -10,8 +10,8 @@ import (
 // SessionsStatusCmd sets or clears the implementation status of a session
 type SessionsStatusCmd struct {
 	List   bool   `help:"List available statuses" short:"l" xor:"action"`
-	Name   string `arg:"" optional:"" help:"Session name"`
-	Status string `help:"Status (empty or 'clear' clears)" xor:"action"`
+	Name   string `arg:"" optional:"" help:"Session name" predictor:"session"`
+	Status string `help:"Status (empty or 'clear' clears)" xor:"action" predictor:"status"`
 }
 
 // AfterApply validates that Name is provided when not listing
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsSwitchBranchCmd lists the branches of a session worktree or switches to one of them
+type SessionsSwitchBranchCmd struct {
+	List      bool   `help:"List the branches the session can switch to" short:"l"`
+	Name      string `arg:"" help:"Session name" predictor:"session"`
+	Branch    string `arg:"" optional:"" help:"Branch to switch to (a remote-only branch is checked out as a local tracking branch)"`
+	TellAgent bool   `help:"Tell the session's agent that the branch changed" name:"tell-agent"`
+}
+
+// Run executes the switch-branch command
+func (s *SessionsSwitchBranchCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions switch-branch command", "name", s.Name, "branch", s.Branch, "list", s.List)
+
+	if s.List == (s.Branch != "") {
+		return fmt.Errorf("give a branch or --list: %w", domain.ErrInvalidInput)
+	}
+
+	ctx := context.Background()
+
+	if s.List {
+		session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+		if err != nil {
+			return fmt.Errorf("session not found: %w", err)
+		}
+		if session.IsExternal || session.WorktreePath == "" {
+			return fmt.Errorf("session '%s' has no worktree: %w", s.Name, domain.ErrInvalidInput)
+		}
+		branches, err := cli.Container.GitService.ListBranches(ctx, session.WorktreePath)
+		if err != nil {
+			return fmt.Errorf("failed to list branches: %w", err)
+		}
+		for _, branch := range branches {
+			marker := " "
+			if branch.Worktree == session.WorktreePath {
+				marker = "*"
+			}
+			fmt.Printf("%s %-40s %s", marker, branch.DisplayName(), formatTime(branch.UpdatedAt))
+			if branch.Worktree != "" && branch.Worktree != session.WorktreePath {
+				fmt.Printf("  (checked out in %s)", branch.Worktree)
+			}
+			fmt.Println()
+		}
+		return nil
+	}
+
+	previous, err := cli.Container.SessionService.SwitchBranch(ctx, s.Name, s.Branch)
+	if err != nil {
+		return fmt.Errorf("failed to switch branch: %w", err)
+	}
+	fmt.Printf("Switched session '%s' from '%s' to '%s'\n", s.Name, previous, s.Branch)
+
+	if s.TellAgent {
+		if _, err := cli.Container.SchedulerService.Schedule(ctx, s.Name, domain.BranchSwitchPrompt(previous, s.Branch), false, time.Now()); err != nil {
+			return fmt.Errorf("failed to tell the agent: %w", err)
+		}
+		fmt.Println("The agent will be told about the switch")
+	}
+	return nil
+}
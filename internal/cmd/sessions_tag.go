@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsTagCmd adds, removes, or clears the tags of a session
+type SessionsTagCmd struct {
+	Clear  bool     `help:"Remove all tags" xor:"mode"`
+	Name   string   `arg:"" help:"Session name" predictor:"session"`
+	Remove bool     `help:"Remove the given tags instead of adding them" short:"r" xor:"mode"`
+	Tags   []string `arg:"" optional:"" help:"Tags to add (letters, digits, '-', '_', '.')"`
+}
+
+// Run executes the tag command
+func (s *SessionsTagCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions tag command", "name", s.Name, "tags", s.Tags, "remove", s.Remove, "clear", s.Clear)
+
+	if !s.Clear && len(s.Tags) == 0 {
+		return fmt.Errorf("give at least one tag, or --clear: %w", domain.ErrInvalidInput)
+	}
+
+	ctx := context.Background()
+	var tags []string
+	var err error
+	switch {
+	case s.Clear:
+		tags, err = cli.Container.SessionService.SetTags(ctx, s.Name, nil)
+	case s.Remove:
+		tags, err = cli.Container.SessionService.RemoveTags(ctx, s.Name, s.Tags)
+	default:
+		tags, err = cli.Container.SessionService.AddTags(ctx, s.Name, s.Tags)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		fmt.Printf("Session '%s' has no tags\n", s.Name)
+	} else {
+		fmt.Printf("Tags for session '%s': %s\n", s.Name, strings.Join(tags, ", "))
+	}
+	return nil
+}
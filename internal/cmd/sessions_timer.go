@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsTimerCmd sets, shows, or clears the countdown timer of a session
+type SessionsTimerCmd struct {
+	Clear    bool   `help:"Remove the timer"`
+	Name     string `arg:"" help:"Session name" predictor:"session"`
+	Duration string `arg:"" optional:"" help:"Time until the timer elapses, e.g. 20m or 1h30m (omit to show the current timer)"`
+	Label    string `help:"Reminder shown when the timer elapses" short:"l"`
+}
+
+// Run executes the timer command
+func (s *SessionsTimerCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions timer command", "name", s.Name, "duration", s.Duration, "clear", s.Clear)
+
+	ctx := context.Background()
+
+	if s.Clear {
+		if s.Duration != "" {
+			return fmt.Errorf("give a duration or --clear, not both: %w", domain.ErrInvalidInput)
+		}
+		if err := cli.Container.TimerService.ClearTimer(ctx, s.Name); err != nil {
+			return fmt.Errorf("failed to clear timer: %w", err)
+		}
+		fmt.Printf("Timer cleared for session '%s'\n", s.Name)
+		return nil
+	}
+
+	if s.Duration == "" {
+		session, err := cli.Container.SessionService.GetSession(ctx, s.Name)
+		if err != nil {
+			return fmt.Errorf("session not found: %w", err)
+		}
+		fmt.Println(describeTimer(session.Timer, time.Now()))
+		return nil
+	}
+
+	d, err := time.ParseDuration(s.Duration)
+	if err != nil {
+		return fmt.Errorf("invalid duration %q (use e.g. 20m or 1h30m): %w", s.Duration, domain.ErrInvalidInput)
+	}
+	timer, err := cli.Container.TimerService.SetTimer(ctx, s.Name, d, s.Label)
+	if err != nil {
+		return fmt.Errorf("failed to set timer: %w", err)
+	}
+
+	fmt.Printf("Timer for session '%s' elapses at %s\n", s.Name, localTime(timer.DueAt).Format("15:04:05"))
+	return nil
+}
+
+// describeTimer summarizes a session timer for the sessions view and timer commands
+func describeTimer(timer *domain.SessionTimer, now time.Time) string {
+	if timer == nil {
+		return "No timer"
+	}
+
+	description := fmt.Sprintf("Elapses at %s (in %s)", formatTime(timer.DueAt), domain.FormatTimerRemaining(timer.Remaining(now)))
+	if timer.Elapsed(now) {
+		description = fmt.Sprintf("Elapsed at %s", formatTime(timer.DueAt))
+	}
+	if timer.Label != "" {
+		description += ": " + timer.Label
+	}
+	return description
+}
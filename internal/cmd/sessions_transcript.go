@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// SessionsTranscriptCmd exports the Claude conversation of a session
+type SessionsTranscriptCmd struct {
+	Format string `help:"Output format: md or json" enum:"md,json" default:"md"`
+	Name   string `arg:"" help:"Session name" predictor:"session"`
+	Output string `help:"Write the transcript to this file instead of stdout" short:"o" type:"path"`
+	Save   bool   `help:"Save the transcript in ROCHA_HOME/transcripts so it survives archiving"`
+}
+
+// Run executes the transcript command
+func (s *SessionsTranscriptCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing sessions transcript command", "name", s.Name, "format", s.Format, "output", s.Output, "save", s.Save)
+
+	ctx := context.Background()
+	format := domain.TranscriptFormat(s.Format)
+
+	if s.Save {
+		path, err := cli.Container.TranscriptService.SaveTranscript(ctx, s.Name, format)
+		if err != nil {
+			return fmt.Errorf("failed to save transcript: %w", err)
+		}
+		fmt.Printf("Transcript saved to %s\n", path)
+		return nil
+	}
+
+	data, err := cli.Container.TranscriptService.ExportTranscript(ctx, s.Name, format)
+	if err != nil {
+		return fmt.Errorf("failed to export transcript: %w", err)
+	}
+
+	if s.Output != "" {
+		if err := os.WriteFile(s.Output, data, 0644); err != nil {
+			return fmt.Errorf("failed to write transcript: %w", err)
+		}
+		fmt.Printf("Transcript written to %s\n", s.Output)
+		return nil
+	}
+
+	fmt.Print(string(data))
+	return nil
+}
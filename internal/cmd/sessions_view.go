@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 )
@@ -11,7 +13,7 @@ import (
 // SessionsViewCmd views a specific session
 type SessionsViewCmd struct {
 	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
-	Name   string `arg:"" help:"Name of the session to view"`
+	Name   string `arg:"" help:"Name of the session to view" predictor:"session"`
 }
 
 // Run executes the view command
@@ -21,6 +23,12 @@ func (s *SessionsViewCmd) Run(cli *CLI) error {
 		return fmt.Errorf("failed to get session: %w", err)
 	}
 
+	prompts, err := cli.Container.SchedulerService.ListPending(context.Background(), s.Name)
+	if err != nil {
+		return fmt.Errorf("failed to list scheduled prompts: %w", err)
+	}
+	session.ScheduledPrompts = prompts
+
 	if s.Format == "json" {
 		return s.printJSON(session)
 	}
@@ -41,30 +49,96 @@ func (s *SessionsViewCmd) printTable(session *domain.Session) error {
 	fmt.Printf("Display Name: %s\n", session.DisplayName)
 	fmt.Printf("State: %s\n", session.State)
 	fmt.Printf("Execution ID: %s\n", session.ExecutionID)
-	fmt.Printf("Archived: %t\n", session.IsArchived)
+	if session.ArchivedAt != nil {
+		fmt.Printf("Archived: %t (since %s)\n", session.IsArchived, formatTime(*session.ArchivedAt))
+	} else {
+		fmt.Printf("Archived: %t\n", session.IsArchived)
+	}
 	fmt.Printf("Flagged: %t\n", session.IsFlagged)
-	fmt.Printf("Last Updated: %s\n", session.LastUpdated.Format("2006-01-02 15:04:05"))
+	fmt.Printf("Priority: %s\n", session.Priority)
+	fmt.Printf("Last Updated: %s\n", formatTime(session.LastUpdated))
 	fmt.Printf("Repo Path: %s\n", session.RepoPath)
 	fmt.Printf("Repo Info: %s\n", session.RepoInfo)
 	fmt.Printf("Branch Name: %s\n", session.BranchName)
+	if session.BaseBranch != "" {
+		fmt.Printf("Base Branch: %s\n", session.BaseBranch)
+	} else {
+		fmt.Printf("Base Branch: <default>\n")
+	}
 	fmt.Printf("Worktree Path: %s\n", session.WorktreePath)
+	fmt.Printf("External: %t\n", session.IsExternal)
+	if session.Subdir != "" {
+		fmt.Printf("Subdir: %s\n", session.Subdir)
+	}
+	if session.KeepWorktree {
+		fmt.Printf("Keep Worktree: %t\n", session.KeepWorktree)
+	}
 	if session.ClaudeDir != "" {
 		fmt.Printf("Claude Dir: %s\n", session.ClaudeDir)
 	} else {
 		fmt.Printf("Claude Dir: <default>\n")
 	}
 	fmt.Printf("Allow Dangerously Skip Permissions: %t\n", session.AllowDangerouslySkipPermissions)
+	if session.AgentModel != "" {
+		fmt.Printf("Model: %s\n", session.AgentModel)
+	} else {
+		fmt.Printf("Model: <default>\n")
+	}
+	if len(session.AgentArgs) > 0 {
+		fmt.Printf("Agent Args: %s\n", domain.FormatAgentArgs(session.AgentArgs))
+	}
+	if session.ClaudeSessionID != "" {
+		fmt.Printf("Claude Session ID: %s\n", session.ClaudeSessionID)
+	}
 	if session.InitialPrompt != "" {
 		fmt.Printf("Initial Prompt: %s\n", session.InitialPrompt)
 	}
+	if session.Comment != "" {
+		fmt.Printf("Comment: %s\n", session.Comment)
+	}
+	if len(session.Tags) > 0 {
+		fmt.Printf("Tags: %s\n", strings.Join(session.Tags, ", "))
+	}
+	if session.Checkpoint != nil {
+		fmt.Printf("Checkpoints: %s\n", describeCheckpoint(session.Checkpoint))
+	}
+	if session.CI != nil {
+		fmt.Printf("CI: %s\n", describeCI(session.CI))
+	}
+	if session.Timer != nil {
+		fmt.Printf("Timer: %s\n", describeTimer(session.Timer, time.Now()))
+	}
+	if session.TokenBudget != nil {
+		fmt.Printf("Token budget: %s\n", describeTokenBudget(session.TokenBudget))
+	}
+	if session.Note != "" {
+		fmt.Printf("\nNote:\n%s\n", session.Note)
+	}
+
+	if len(session.ScheduledPrompts) > 0 {
+		fmt.Printf("\nScheduled Prompts:\n")
+		for _, prompt := range session.ScheduledPrompts {
+			fmt.Printf("  #%d at %s: %s\n", prompt.ID, formatTime(prompt.SendAt), prompt.Text)
+		}
+	}
 
 	if session.ShellSession != nil {
 		fmt.Printf("\nShell Session:\n")
 		fmt.Printf("  Name: %s\n", session.ShellSession.Name)
-		fmt.Printf("  Display Name: %s\n", session.ShellSession.DisplayName)
-		fmt.Printf("  State: %s\n", session.ShellSession.State)
-		fmt.Printf("  Last Updated: %s\n", session.ShellSession.LastUpdated.Format("2006-01-02 15:04:05"))
+		fmt.Printf("  Directory: %s\n", session.StartDir())
 	}
 
 	return nil
 }
+
+// describeCI summarizes the last CI result of a session, such as "failure at 14:02 on abc1234 (https://...)"
+func describeCI(status *domain.CIStatus) string {
+	description := fmt.Sprintf("%s at %s", status.State, formatTime(status.ReportedAt))
+	if status.Commit != "" {
+		description += " on " + status.Commit[:min(7, len(status.Commit))]
+	}
+	if status.URL != "" {
+		description += fmt.Sprintf(" (%s)", status.URL)
+	}
+	return description
+}
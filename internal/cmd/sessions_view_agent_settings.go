@@ -10,7 +10,7 @@ import (
 
 // SessionsViewAgentSettingsCmd displays actual agent settings from running process
 type SessionsViewAgentSettingsCmd struct {
-	Name string `arg:"" help:"Name of the session"`
+	Name string `arg:"" help:"Name of the session" predictor:"session"`
 }
 
 // Run executes the view-agent-settings command
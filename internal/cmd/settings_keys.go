@@ -8,6 +8,7 @@ import (
 	"text/tabwriter"
 
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ui"
 )
@@ -99,14 +100,14 @@ func (s *SettingsKeysListCmd) outputTable(names []string, defaults map[string][]
 func (s *SettingsKeysSetCmd) Run(cli *CLI) error {
 	// Validate key name
 	if !ui.IsValidKeyName(s.Key) {
-		return fmt.Errorf("unknown key '%s'. Valid keys: %s",
-			s.Key, strings.Join(ui.GetValidKeyNames(), ", "))
+		return fmt.Errorf("%w: unknown key '%s'. Valid keys: %s",
+			domain.ErrInvalidInput, s.Key, strings.Join(ui.GetValidKeyNames(), ", "))
 	}
 
 	// Parse value (comma-separated for multiple keys)
 	values := parseKeyValues(s.Value)
 	if len(values) == 0 {
-		return fmt.Errorf("value cannot be empty")
+		return fmt.Errorf("%w: value cannot be empty", domain.ErrInvalidInput)
 	}
 
 	logging.Logger.Debug("Setting key binding", "key", s.Key, "values", values)
@@ -130,8 +131,8 @@ func (s *SettingsKeysSetCmd) Run(cli *CLI) error {
 		return fmt.Errorf("conflict: %w", err)
 	}
 
-	// Save settings
-	if err := config.SaveSettings(settings); err != nil {
+	// Save only the binding, so the other settings and profiles stay as written
+	if err := config.SetSetting("keys."+s.Key, strings.Join(values, ","), config.SettingScope{}); err != nil {
 		return fmt.Errorf("failed to save settings: %w", err)
 	}
 
@@ -2,10 +2,13 @@ package cmd
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
+
+	"github.com/renato0307/rocha/internal/config"
 )
 
 // SetupCmd configures tmux automatically
@@ -18,8 +21,10 @@ const (
 
 // Run executes the setup command
 func (s *SetupCmd) Run(cli *CLI) error {
+	multiplexer := multiplexerName(cli.settings)
+
 	// Verify required dependencies
-	if err := s.verifyDependencies(); err != nil {
+	if err := s.verifyDependencies(multiplexer); err != nil {
 		return err
 	}
 
@@ -40,9 +45,11 @@ func (s *SetupCmd) Run(cli *CLI) error {
 		return err
 	}
 
-	// Setup tmux configuration
-	if err := s.setupTmux(cli, homeDir); err != nil {
-		return err
+	// Setup tmux configuration (other multiplexers keep their own)
+	if multiplexer == config.MultiplexerTmux {
+		if err := s.setupTmux(cli, homeDir); err != nil {
+			return err
+		}
 	}
 
 	fmt.Println("\n✓ Setup complete!")
@@ -180,47 +187,76 @@ func (s *SetupCmd) setupTmux(cli *CLI, homeDir string) error {
 	return nil
 }
 
+// dependency describes an external binary rocha relies on
+type dependency struct {
+	command     string
+	installInfo string
+	name        string
+}
+
+// multiplexerDependencies lists the binary of each supported terminal multiplexer
+var multiplexerDependencies = map[string]dependency{
+	config.MultiplexerScreen: {
+		name:        "screen",
+		command:     "screen",
+		installInfo: "Install with: apt install screen (Ubuntu/Debian), brew install screen (macOS), or pacman -S screen (Arch)",
+	},
+	config.MultiplexerTmux: {
+		name:        "tmux",
+		command:     "tmux",
+		installInfo: "Install with: apt install tmux (Ubuntu/Debian), brew install tmux (macOS), or pacman -S tmux (Arch)",
+	},
+	config.MultiplexerZellij: {
+		name:        "zellij",
+		command:     "zellij",
+		installInfo: "Install with: brew install zellij (macOS), pacman -S zellij (Arch), or cargo install --locked zellij",
+	},
+}
+
+// dependencies lists the external binaries checked by setup and onboarding, besides the multiplexer
+var dependencies = []dependency{
+	{
+		name:        "git",
+		command:     "git",
+		installInfo: "Install with: apt install git (Ubuntu/Debian), brew install git (macOS), or pacman -S git (Arch)",
+	},
+	{
+		name:        "Claude Code CLI",
+		command:     "claude",
+		installInfo: "Install from: https://claude.ai/download",
+	},
+}
+
 // verifyDependencies checks if required binaries are installed
-func (s *SetupCmd) verifyDependencies() error {
-	dependencies := []struct {
-		name        string
-		command     string
-		installInfo string
-	}{
-		{
-			name:        "tmux",
-			command:     "tmux",
-			installInfo: "Install with: apt install tmux (Ubuntu/Debian), brew install tmux (macOS), or pacman -S tmux (Arch)",
-		},
-		{
-			name:        "git",
-			command:     "git",
-			installInfo: "Install with: apt install git (Ubuntu/Debian), brew install git (macOS), or pacman -S git (Arch)",
-		},
-		{
-			name:        "Claude Code CLI",
-			command:     "claude",
-			installInfo: "Install from: https://claude.ai/download",
-		},
-	}
+func (s *SetupCmd) verifyDependencies(multiplexer string) error {
+	return checkDependencies(os.Stdout, requiredDependencies(multiplexer), exec.LookPath)
+}
 
+// checkDependencies prints whether each dependency is installed, looking binaries up
+// with lookPath, and fails listing the missing ones
+func checkDependencies(out io.Writer, deps []dependency, lookPath func(string) (string, error)) error {
 	var missing []string
-	fmt.Println("Checking dependencies...")
-
-	for _, dep := range dependencies {
-		if _, err := exec.LookPath(dep.command); err != nil {
-			missing = append(missing, fmt.Sprintf("  ✗ %s not found\n    %s", dep.name, dep.installInfo))
-			fmt.Printf("✗ %s not found\n", dep.name)
-		} else {
-			fmt.Printf("✓ %s found\n", dep.name)
+	fmt.Fprintln(out, "Checking dependencies...")
+
+	for _, dep := range deps {
+		if _, err := lookPath(dep.command); err == nil {
+			fmt.Fprintf(out, "✓ %s found\n", dep.name)
+			continue
 		}
+
+		missing = append(missing, fmt.Sprintf("  ✗ %s not found\n    %s", dep.name, dep.installInfo))
+		fmt.Fprintf(out, "✗ %s not found\n", dep.name)
 	}
 
+	fmt.Fprintln(out)
 	if len(missing) > 0 {
-		fmt.Println()
 		return fmt.Errorf("missing required dependencies:\n%s", strings.Join(missing, "\n"))
 	}
 
-	fmt.Println()
 	return nil
 }
+
+// requiredDependencies returns the binaries rocha needs with the given multiplexer
+func requiredDependencies(multiplexer string) []dependency {
+	return append([]dependency{multiplexerDependencies[multiplexer]}, dependencies...)
+}
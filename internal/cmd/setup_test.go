@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"bytes"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckDependencies(t *testing.T) {
+	deps := []dependency{
+		{name: "tmux", command: "tmux", installInfo: "install tmux"},
+		{name: "git", command: "git", installInfo: "install git"},
+		{name: "Claude Code CLI", command: "claude", installInfo: "install claude"},
+	}
+
+	tests := []struct {
+		name       string
+		installed  []string
+		wantErr    string
+		wantOutput []string
+	}{
+		{
+			name:       "all installed",
+			installed:  []string{"tmux", "git", "claude"},
+			wantOutput: []string{"✓ tmux found", "✓ git found", "✓ Claude Code CLI found"},
+		},
+		{
+			name:       "git missing fails",
+			installed:  []string{"tmux", "claude"},
+			wantErr:    "install git",
+			wantOutput: []string{"✓ tmux found", "✗ git not found"},
+		},
+		{
+			name:       "several missing fail together",
+			installed:  []string{"git"},
+			wantErr:    "missing required dependencies",
+			wantOutput: []string{"✗ tmux not found", "✗ Claude Code CLI not found"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookPath := func(command string) (string, error) {
+				for _, installed := range tt.installed {
+					if installed == command {
+						return "/usr/bin/" + command, nil
+					}
+				}
+				return "", exec.ErrNotFound
+			}
+			var out bytes.Buffer
+
+			err := checkDependencies(&out, deps, lookPath)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			for _, line := range tt.wantOutput {
+				assert.Contains(t, out.String(), line)
+			}
+		})
+	}
+}
@@ -8,12 +8,14 @@ import (
 	"os/exec"
 	"syscall"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 )
 
 // StartClaudeCmd starts Claude Code with hooks configured
 type StartClaudeCmd struct {
-	Args []string `arg:"" optional:"" help:"Additional arguments to pass to claude"`
+	Args   []string `arg:"" optional:"" help:"Additional arguments to pass to claude"`
+	Resume string   `help:"Claude conversation ID to resume" optional:""`
 }
 
 // Run executes Claude with hooks configuration
@@ -28,6 +30,8 @@ func (s *StartClaudeCmd) Run(cli *CLI) error {
 	}
 
 	// Load current state to get ExecutionID, ClaudeDir, and agent CLI flags for this session
+	var agentArgs []string
+	var agentModel string
 	var claudeDir string
 	var executionID string
 	var allowDangerouslySkipPermissions bool
@@ -46,6 +50,8 @@ func (s *StartClaudeCmd) Run(cli *CLI) error {
 			claudeDir = session.ClaudeDir
 			executionID = session.ExecutionID
 			allowDangerouslySkipPermissions = session.AllowDangerouslySkipPermissions
+			agentArgs = session.AgentArgs
+			agentModel = session.AgentModel
 			logging.Logger.Info("Using execution ID from session", "execution_id", executionID)
 			if allowDangerouslySkipPermissions {
 				logging.Logger.Warn("DANGEROUS MODE ENABLED: Claude will skip permission prompts",
@@ -67,7 +73,31 @@ func (s *StartClaudeCmd) Run(cli *CLI) error {
 	logging.Logger.Info("Starting Claude with hooks",
 		"session", sessionName,
 		"execution_id", executionID,
-		"allow_dangerously_skip_permissions", allowDangerouslySkipPermissions)
+		"allow_dangerously_skip_permissions", allowDangerouslySkipPermissions,
+		"model", agentModel,
+		"agent_args", agentArgs)
+
+	// Sessions that skip permission prompts report every tool they run, for the audit trail
+	toolCompleteHook := map[string]interface{}{
+		"matcher": "Bash|Write|Edit",
+		"hooks": []map[string]interface{}{
+			{
+				"type":    "command",
+				"command": fmt.Sprintf("%s notify handle %s tool-complete --execution-id=%s", rochaBin, sessionName, executionID),
+			},
+		},
+	}
+	if allowDangerouslySkipPermissions {
+		toolCompleteHook = map[string]interface{}{
+			"matcher": "*",
+			"hooks": []map[string]interface{}{
+				{
+					"type":    "command",
+					"command": fmt.Sprintf("%s notify handle %s tool-audit --execution-id=%s", rochaBin, sessionName, executionID),
+				},
+			},
+		}
+	}
 
 	// Build the hooks configuration with multiple event types
 	hooks := map[string]interface{}{
@@ -150,15 +180,7 @@ func (s *StartClaudeCmd) Run(cli *CLI) error {
 						},
 					},
 				},
-				{
-					"matcher": "Bash|Write|Edit",
-					"hooks": []map[string]interface{}{
-						{
-							"type":    "command",
-							"command": fmt.Sprintf("%s notify handle %s tool-complete --execution-id=%s", rochaBin, sessionName, executionID),
-						},
-					},
-				},
+				toolCompleteHook,
 			},
 			// PostToolUseFailure: When a tool fails and Claude continues
 			"PostToolUseFailure": []map[string]interface{}{
@@ -239,6 +261,18 @@ func (s *StartClaudeCmd) Run(cli *CLI) error {
 			"session", sessionName)
 	}
 
+	// Run with the model and extra args chosen for this session
+	if agentModel != "" {
+		args = append(args, "--model", agentModel)
+	}
+	args = append(args, agentArgs...)
+
+	// Resume an earlier conversation when restarting an exited session
+	if s.Resume != "" {
+		args = append(args, "--resume", s.Resume)
+		logging.Logger.Info("Resuming Claude conversation", "session", sessionName, "claude_session_id", s.Resume)
+	}
+
 	args = append(args, s.Args...)
 
 	// Find claude executable
@@ -251,6 +285,11 @@ func (s *StartClaudeCmd) Run(cli *CLI) error {
 	// This ensures claude receives all signals properly and behaves as if run directly
 	env := os.Environ()
 
+	// Load the direnv and mise environment of the worktree so the agent's builds and tests use it
+	if dir, err := os.Getwd(); err == nil {
+		env = domain.ApplyEnv(env, cli.Container.WorktreeBootstrapService.LoadEnv(ctx, dir))
+	}
+
 	// Set CLAUDE_CONFIG_DIR if configured for this session
 	if claudeDir != "" {
 		env = append(env, fmt.Sprintf("CLAUDE_CONFIG_DIR=%s", claudeDir))
@@ -1,21 +1,31 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/ports"
 	"github.com/renato0307/rocha/internal/ui"
 )
 
-// StatsCmd shows token usage statistics
+// StatsCmd shows token usage and session activity statistics
 type StatsCmd struct {
-	Format string `help:"Output format (table or chart)" default:"table" enum:"table,chart"`
+	ByModel bool   `help:"Stack the hours per model with --format chart"`
+	Cache   bool   `help:"Include cache tokens with --format chart"`
+	Days    int    `help:"Days of activity to show with --format heatmap" default:"7"`
+	Format  string `help:"Output format (table, chart, or heatmap)" default:"table" enum:"table,chart,heatmap"`
+	State   string `help:"Only count transitions into this state with --format heatmap (working, idle, waiting, exited)" enum:",working,idle,waiting,exited" default:""`
 }
 
 // Run executes the stats command
 func (s *StatsCmd) Run(cli *CLI) error {
+	if s.Format == "heatmap" {
+		return s.renderHeatmap(cli)
+	}
+
 	// Get today's hourly usage
 	hourly, err := cli.Container.TokenStatsService.GetTodayHourlyUsage()
 	if err != nil {
@@ -82,7 +92,29 @@ func (s *StatsCmd) renderChart(hourly []ports.HourlyTokenUsage, totals ports.Tok
 		return
 	}
 
-	fmt.Println(ui.RenderTokenChart(hourly, totals))
+	fmt.Println(ui.RenderTokenChart(hourly, totals, ui.TokenChartOptions{ByModel: s.ByModel, ShowCache: s.Cache}))
+}
+
+// renderHeatmap displays session state transitions per hour as a heatmap
+func (s *StatsCmd) renderHeatmap(cli *CLI) error {
+	heatmap, err := cli.Container.ActivityStatsService.GetActivityHeatmap(context.Background(), s.Days, domain.SessionState(s.State), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to get session activity: %w", err)
+	}
+
+	title := fmt.Sprintf("Session Activity - last %d days", s.Days)
+	if s.State != "" {
+		title += fmt.Sprintf(" (transitions to %s)", s.State)
+	}
+	fmt.Printf("%s\n\n", title)
+
+	if heatmap.Total == 0 {
+		fmt.Println("No session activity yet.")
+		return nil
+	}
+
+	fmt.Println(ui.RenderActivityHeatmap(heatmap))
+	return nil
 }
 
 // formatNumber formats a number with comma separators
@@ -12,10 +12,16 @@ type StatusCmd struct{}
 
 // Run executes the status command
 func (s *StatusCmd) Run(cli *CLI) error {
+	waitingLabel, idleLabel, workingLabel := domain.SymbolWaiting, domain.SymbolIdle, domain.SymbolWorking
+	// Accessibility mode names the states instead of showing their icons
+	if cli.settings != nil && cli.settings.Accessible != nil && *cli.settings.Accessible {
+		waitingLabel, idleLabel, workingLabel = string(domain.StateWaiting), string(domain.StateIdle), string(domain.StateWorking)
+	}
+
 	st, err := cli.Container.SessionService.LoadState(context.Background(), false)
 	if err != nil {
 		// No state
-		fmt.Printf("%s:? %s:? %s:?", domain.SymbolWaiting, domain.SymbolIdle, domain.SymbolWorking)
+		fmt.Printf("%s:? %s:? %s:?", waitingLabel, idleLabel, workingLabel)
 		return nil
 	}
 
@@ -34,7 +40,7 @@ func (s *StatusCmd) Run(cli *CLI) error {
 	}
 
 	// If no sessions at all, show zeros (not unknown)
-	fmt.Printf("%s:%d %s:%d %s:%d", domain.SymbolWaiting, waiting, domain.SymbolIdle, idle, domain.SymbolWorking, working)
+	fmt.Printf("%s:%d %s:%d %s:%d", waitingLabel, waiting, idleLabel, idle, workingLabel, working)
 
 	return nil
 }
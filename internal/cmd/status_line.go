@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// StatusLineCmd prints a compact summary of the sessions for the tmux status bar
+type StatusLineCmd struct {
+	MaxNames int `help:"Session names listed per group before the rest is counted (0 lists none)" default:"3"`
+}
+
+// Run executes the status-line command
+func (s *StatusLineCmd) Run(cli *CLI) error {
+	if s.MaxNames < 0 {
+		return fmt.Errorf("max names must not be negative: %w", domain.ErrInvalidInput)
+	}
+
+	// tmux runs this every status-interval, so it reads the session states only
+	summaries, err := cli.Container.SessionService.ListSummaries(context.Background())
+	if err != nil {
+		// No state
+		fmt.Print("rocha:?")
+		return nil
+	}
+
+	fmt.Print(domain.FormatStatusLine(summaries, domain.StatusLineOptions{
+		Accessible: cli.settings != nil && cli.settings.Accessible != nil && *cli.settings.Accessible,
+		MaxNames:   s.MaxNames,
+	}))
+	return nil
+}
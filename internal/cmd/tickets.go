@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+
+	"golang.org/x/term"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// TicketsCmd manages the Jira/Linear ticket status sync
+type TicketsCmd struct {
+	Login  TicketsLoginCmd  `cmd:"login" help:"Store a Jira or Linear API token in the OS keychain"`
+	Logout TicketsLogoutCmd `cmd:"logout" help:"Remove a stored API token from the OS keychain"`
+	Sync   TicketsSyncCmd   `cmd:"sync" help:"Sync a session's status to its linked ticket now"`
+}
+
+// TicketsLoginCmd stores a tracker API token
+type TicketsLoginCmd struct {
+	Account  string `help:"Keychain account (must match 'account' in ticket_sync settings; Jira: your account email)" default:"default"`
+	Provider string `arg:"" help:"Ticket provider: jira or linear" enum:"jira,linear"`
+}
+
+// Run executes the login command
+func (t *TicketsLoginCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing tickets login command", "provider", t.Provider, "account", t.Account)
+
+	token, err := readToken(t.Provider)
+	if err != nil {
+		return err
+	}
+
+	if err := cli.Container.TicketSyncService.StoreToken(domain.TicketProvider(t.Provider), t.Account, token); err != nil {
+		return fmt.Errorf("failed to store token: %w", err)
+	}
+
+	fmt.Printf("Stored %s token for account '%s'\n", t.Provider, t.Account)
+	return nil
+}
+
+// readToken reads an API token from stdin, without echoing it when stdin is a terminal
+func readToken(provider string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		token, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && token == "" {
+			return "", fmt.Errorf("failed to read token from stdin: %w", err)
+		}
+		return token, nil
+	}
+
+	fmt.Printf("Paste the %s API token and press Enter: ", provider)
+	token, err := term.ReadPassword(fd)
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf("failed to read token from terminal: %w", err)
+	}
+	return string(token), nil
+}
+
+// TicketsLogoutCmd removes a tracker API token
+type TicketsLogoutCmd struct {
+	Account  string `help:"Keychain account the token was stored under" default:"default"`
+	Provider string `arg:"" help:"Ticket provider: jira or linear" enum:"jira,linear"`
+}
+
+// Run executes the logout command
+func (t *TicketsLogoutCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing tickets logout command", "provider", t.Provider, "account", t.Account)
+
+	if err := cli.Container.TicketSyncService.DeleteToken(domain.TicketProvider(t.Provider), t.Account); err != nil {
+		return fmt.Errorf("failed to remove token: %w", err)
+	}
+
+	fmt.Printf("Removed %s token for account '%s'\n", t.Provider, t.Account)
+	return nil
+}
+
+// TicketsSyncCmd syncs one session to its linked ticket
+type TicketsSyncCmd struct {
+	Name string `arg:"" help:"Session name" predictor:"session"`
+}
+
+// Run executes the sync command
+func (t *TicketsSyncCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing tickets sync command", "name", t.Name)
+
+	result, err := cli.Container.TicketSyncService.SyncSession(context.Background(), t.Name)
+	if err != nil {
+		return fmt.Errorf("failed to sync ticket: %w", err)
+	}
+
+	printTicketSyncResult(t.Name, result)
+	return nil
+}
+
+func printTicketSyncResult(name string, result *services.TicketSyncResult) {
+	switch {
+	case result.Skipped != "":
+		fmt.Printf("Nothing to sync for session '%s': %s\n", name, result.Skipped)
+	case result.State != "":
+		fmt.Printf("Moved %s to '%s' and commented with the status of session '%s'\n", result.Key, result.State, name)
+	default:
+		fmt.Printf("Commented on %s with the status of session '%s'\n", result.Key, name)
+	}
+}
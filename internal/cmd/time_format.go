@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// timeFormat is the layout and time zone of timestamps in command output, set from
+// --time-format, --time-zone, and settings.json in CLI.AfterApply
+var timeFormat domain.TimeFormat
+
+// formatTime renders t in the configured layout and time zone; zero times render empty
+func formatTime(t time.Time) string {
+	return timeFormat.Format(t)
+}
+
+// localTime returns t in the configured time zone, for output with its own layout
+func localTime(t time.Time) time.Time {
+	return timeFormat.In(t)
+}
@@ -0,0 +1,277 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// WorkspacesCmd manages named sets of sessions
+type WorkspacesCmd struct {
+	Add     WorkspacesAddCmd     `cmd:"add" help:"Add sessions to a workspace"`
+	Archive WorkspacesArchiveCmd `cmd:"archive" help:"Archive every session in a workspace"`
+	Create  WorkspacesCreateCmd  `cmd:"create" help:"Create a workspace, optionally with sessions"`
+	Delete  WorkspacesDeleteCmd  `cmd:"delete" help:"Delete a workspace (its sessions are kept)"`
+	List    WorkspacesListCmd    `cmd:"list" help:"List workspaces" default:"1"`
+	Remove  WorkspacesRemoveCmd  `cmd:"remove" help:"Remove sessions from a workspace (the sessions are kept)"`
+	Report  WorkspacesReportCmd  `cmd:"report" help:"Show a status report of the sessions in a workspace"`
+}
+
+// WorkspacesListCmd lists workspaces
+type WorkspacesListCmd struct {
+	Format string `help:"Output format: table or json" enum:"table,json" default:"table"`
+}
+
+// Run executes the list command
+func (w *WorkspacesListCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing workspaces list command")
+
+	workspaces, err := cli.Container.WorkspaceService.ListWorkspaces(context.Background())
+	if err != nil {
+		return fmt.Errorf("failed to list workspaces: %w", err)
+	}
+
+	if w.Format == "json" {
+		data, err := json.MarshalIndent(workspaces, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	if len(workspaces) == 0 {
+		fmt.Println("No workspaces. Create one with: rocha workspaces create <name> [sessions...]")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tSESSIONS\tCREATED")
+	for _, workspace := range workspaces {
+		fmt.Fprintf(tw, "%s\t%d\t%s\n", workspace.Name, len(workspace.Sessions), formatTime(workspace.CreatedAt))
+	}
+	return tw.Flush()
+}
+
+// WorkspacesCreateCmd creates a workspace
+type WorkspacesCreateCmd struct {
+	Name     string   `arg:"" help:"Workspace name (letters, digits, '-', '_', '.')"`
+	Sessions []string `arg:"" optional:"" help:"Sessions to add" predictor:"session"`
+}
+
+// Run executes the create command
+func (w *WorkspacesCreateCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing workspaces create command", "name", w.Name, "sessions", w.Sessions)
+
+	workspace, err := cli.Container.WorkspaceService.CreateWorkspace(context.Background(), w.Name, w.Sessions)
+	if err != nil {
+		return fmt.Errorf("failed to create workspace: %w", err)
+	}
+
+	fmt.Printf("Workspace '%s' created with %d sessions\n", workspace.Name, len(workspace.Sessions))
+	return nil
+}
+
+// WorkspacesDeleteCmd deletes a workspace
+type WorkspacesDeleteCmd struct {
+	Name string `arg:"" help:"Workspace name" predictor:"workspace"`
+}
+
+// Run executes the delete command
+func (w *WorkspacesDeleteCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing workspaces delete command", "name", w.Name)
+
+	if err := cli.Container.WorkspaceService.DeleteWorkspace(context.Background(), w.Name); err != nil {
+		return fmt.Errorf("failed to delete workspace: %w", err)
+	}
+
+	fmt.Printf("Workspace '%s' deleted (its sessions are kept)\n", w.Name)
+	return nil
+}
+
+// WorkspacesAddCmd adds sessions to a workspace
+type WorkspacesAddCmd struct {
+	Name     string   `arg:"" help:"Workspace name" predictor:"workspace"`
+	Sessions []string `arg:"" help:"Sessions to add" predictor:"session"`
+}
+
+// Run executes the add command
+func (w *WorkspacesAddCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing workspaces add command", "name", w.Name, "sessions", w.Sessions)
+
+	workspace, err := cli.Container.WorkspaceService.AddSessions(context.Background(), w.Name, w.Sessions)
+	if err != nil {
+		return fmt.Errorf("failed to add sessions: %w", err)
+	}
+
+	fmt.Printf("Workspace '%s' has %d sessions\n", workspace.Name, len(workspace.Sessions))
+	return nil
+}
+
+// WorkspacesRemoveCmd removes sessions from a workspace
+type WorkspacesRemoveCmd struct {
+	Name     string   `arg:"" help:"Workspace name" predictor:"workspace"`
+	Sessions []string `arg:"" help:"Sessions to remove" predictor:"session"`
+}
+
+// Run executes the remove command
+func (w *WorkspacesRemoveCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing workspaces remove command", "name", w.Name, "sessions", w.Sessions)
+
+	workspace, err := cli.Container.WorkspaceService.RemoveSessions(context.Background(), w.Name, w.Sessions)
+	if err != nil {
+		return fmt.Errorf("failed to remove sessions: %w", err)
+	}
+
+	fmt.Printf("Workspace '%s' has %d sessions\n", workspace.Name, len(workspace.Sessions))
+	return nil
+}
+
+// WorkspacesArchiveCmd archives every session in a workspace
+type WorkspacesArchiveCmd struct {
+	Force bool   `help:"Skip confirmation prompt" short:"f"`
+	Name  string `arg:"" help:"Workspace name" predictor:"workspace"`
+}
+
+// Run executes the archive command, like `rocha sessions list --workspace <name> --apply archive`
+func (w *WorkspacesArchiveCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing workspaces archive command", "name", w.Name)
+
+	workspace, err := cli.Container.WorkspaceService.GetWorkspace(context.Background(), w.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get workspace: %w", err)
+	}
+
+	list := SessionsListCmd{Apply: "archive", Force: w.Force, Workspace: workspace.Name}
+	return list.Run(cli)
+}
+
+// WorkspacesReportCmd prints a status report of a workspace
+type WorkspacesReportCmd struct {
+	Format string `help:"Output format: text, markdown, or json" enum:"text,markdown,json" default:"text"`
+	Name   string `arg:"" help:"Workspace name" predictor:"workspace"`
+}
+
+// Run executes the report command
+func (w *WorkspacesReportCmd) Run(cli *CLI) error {
+	logging.Logger.Debug("Executing workspaces report command", "name", w.Name, "format", w.Format)
+
+	report, err := cli.Container.WorkspaceService.Report(context.Background(), w.Name)
+	if err != nil {
+		return fmt.Errorf("failed to build report: %w", err)
+	}
+
+	switch w.Format {
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case "markdown":
+		w.printMarkdown(report)
+		return nil
+	default:
+		return w.printText(report)
+	}
+}
+
+func (w *WorkspacesReportCmd) printText(report *services.WorkspaceReport) error {
+	fmt.Printf("Workspace: %s\n", report.Workspace.Name)
+	fmt.Printf("Sessions:  %s\n", reportSummary(report))
+	fmt.Printf("Statuses:  %s\n\n", reportStatuses(report))
+
+	if len(report.Sessions) == 0 {
+		fmt.Println("No active sessions")
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "NAME\tSTATE\tSTATUS\tBRANCH\tPR\tLAST UPDATED")
+	for _, sess := range report.Sessions {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			sess.Name,
+			sess.State,
+			sessionStatus(sess),
+			sess.BranchName,
+			prLabel(sess.PRInfo),
+			formatTime(sess.LastUpdated))
+	}
+	return tw.Flush()
+}
+
+func (w *WorkspacesReportCmd) printMarkdown(report *services.WorkspaceReport) {
+	fmt.Printf("## Workspace %s\n\n", report.Workspace.Name)
+	fmt.Printf("%s\n\n", reportSummary(report))
+	fmt.Printf("Statuses: %s\n", reportStatuses(report))
+
+	if len(report.Sessions) == 0 {
+		return
+	}
+
+	fmt.Println("\n| Session | State | Status | Branch | PR |")
+	fmt.Println("|---------|-------|--------|--------|----|")
+	for _, sess := range report.Sessions {
+		pr := prLabel(sess.PRInfo)
+		if sess.PRInfo != nil && sess.PRInfo.URL != "" {
+			pr = fmt.Sprintf("[%s](%s)", pr, sess.PRInfo.URL)
+		}
+		fmt.Printf("| %s | %s | %s | %s | %s |\n", sess.DisplayName, sess.State, sessionStatus(sess), sess.BranchName, pr)
+	}
+}
+
+// reportSummary renders the session counts of a report, e.g. "4 active (1 working, 2 waiting, 1 idle, 0 paused, 0 exited), 1 archived"
+func reportSummary(report *services.WorkspaceReport) string {
+	states := []domain.SessionState{domain.StateWorking, domain.StateWaiting, domain.StateIdle, domain.StatePaused, domain.StateExited}
+	counts := make([]string, len(states))
+	for i, state := range states {
+		counts[i] = fmt.Sprintf("%d %s", report.ByState[state], state)
+	}
+	return fmt.Sprintf("%d active (%s), %d archived", len(report.Sessions), strings.Join(counts, ", "), report.Archived)
+}
+
+// reportStatuses renders the status counts of a report by name, with sessions without status last
+func reportStatuses(report *services.WorkspaceReport) string {
+	statuses := make([]string, 0, len(report.ByStatus))
+	for status := range report.ByStatus {
+		if status != "" {
+			statuses = append(statuses, status)
+		}
+	}
+	slices.Sort(statuses)
+
+	parts := make([]string, 0, len(report.ByStatus))
+	for _, status := range statuses {
+		parts = append(parts, fmt.Sprintf("%s %d", status, report.ByStatus[status]))
+	}
+	if count := report.ByStatus[""]; count > 0 {
+		parts = append(parts, fmt.Sprintf("(none) %d", count))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
+
+func sessionStatus(sess domain.Session) string {
+	if sess.Status == nil {
+		return ""
+	}
+	return *sess.Status
+}
+
+func prLabel(prInfo *domain.PRInfo) string {
+	if prInfo == nil || prInfo.Number == 0 {
+		return ""
+	}
+	return fmt.Sprintf("#%d %s", prInfo.Number, strings.ToLower(prInfo.State))
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/services/actions"
+)
+
+// worktreeLossLimit is how many files and commits are listed before summarizing the rest
+const worktreeLossLimit = 10
+
+// confirmWorktreeRemoval asks before losing work when removing a session's worktree.
+// Clean worktrees may always be removed. A worktree with uncommitted changes or unpushed
+// commits is only removed with discard set or after the user types the session name;
+// with interactive unset the action keeps it (see actions.Service) instead of prompting.
+func confirmWorktreeRemoval(ctx context.Context, cli *CLI, session *domain.Session, discard, interactive bool) actions.WorktreeRemoval {
+	removal := actions.WorktreeRemoval{Discard: discard, Remove: true}
+	if discard || session.WorktreePath == "" {
+		return removal
+	}
+
+	check := cli.Container.ActionsService.CheckWorktree(ctx, session)
+	if !check.NeedsDiscardConfirmation() {
+		return removal
+	}
+	if check.Err != nil {
+		fmt.Printf("Could not check worktree at '%s' for local changes: %v\n", session.WorktreePath, check.Err)
+	} else {
+		fmt.Printf("Worktree at '%s' has work that only exists locally:\n%s\n", session.WorktreePath, check.Status.Describe(worktreeLossLimit))
+	}
+
+	if !interactive {
+		return removal
+	}
+
+	fmt.Printf("Type the session name '%s' to remove the worktree anyway: ", session.Name)
+	var response string
+	fmt.Scanln(&response)
+	if strings.TrimSpace(response) != session.Name {
+		fmt.Println("Keeping worktree")
+		return actions.WorktreeRemoval{}
+	}
+	removal.Discard = true
+	return removal
+}
+
+// printWorktreeOutcome reports what an action did with the session's worktree
+func printWorktreeOutcome(outcome actions.Outcome) {
+	switch {
+	case outcome.WorktreeErr != nil:
+		fmt.Printf("⚠ Warning: %v\n", outcome.WorktreeErr)
+	case outcome.WorktreeKept:
+		fmt.Printf("Keeping worktree at '%s' (use --discard-local-work to remove it anyway)\n", outcome.WorktreePath)
+	case outcome.WorktreeRemoved:
+		fmt.Printf("Removed worktree at '%s'\n", outcome.WorktreePath)
+	}
+}
@@ -0,0 +1,45 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GetLastFilterPath returns $ROCHA_HOME/last_filter
+func GetLastFilterPath() string {
+	return filepath.Join(GetRochaHome(), "last_filter")
+}
+
+// LoadLastFilter loads the session list filter applied when the TUI last ran
+// Returns an empty filter if the file doesn't exist (not an error)
+func LoadLastFilter() (string, error) {
+	data, err := os.ReadFile(GetLastFilterPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read last filter: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SaveLastFilter saves the session list filter, removing the file when the filter is empty
+func SaveLastFilter(filter string) error {
+	path := GetLastFilterPath()
+	if filter == "" {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove last filter: %w", err)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create rocha home: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(filter+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write last filter: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GetListDensityPath returns $ROCHA_HOME/list_density
+func GetListDensityPath() string {
+	return filepath.Join(GetRochaHome(), "list_density")
+}
+
+// LoadListDensity loads the session list density chosen when the TUI last ran
+// Returns an empty density if the file doesn't exist (not an error)
+func LoadListDensity() (string, error) {
+	data, err := os.ReadFile(GetListDensityPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read list density: %w", err)
+	}
+	return strings.TrimSpace(string(data)), nil
+}
+
+// SaveListDensity saves the session list density
+func SaveListDensity(density string) error {
+	path := GetListDensityPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create rocha home: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(density+"\n"), 0644); err != nil {
+		return fmt.Errorf("failed to write list density: %w", err)
+	}
+	return nil
+}
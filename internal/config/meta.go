@@ -54,21 +54,78 @@ func generateExampleValue(t reflect.Type, fieldName string) any {
 			}
 		}
 
+		// Handle ThemeSettings pointer
+		if elemType.Name() == "ThemeSettings" {
+			return map[string]any{
+				"accent":  "223",
+				"primary": "214",
+				"waiting": "167",
+				"working": "#98971a",
+			}
+		}
+
+		// Handle BadgeSettings pointer
+		if elemType.Name() == "BadgeSettings" {
+			return map[string]any{
+				"command":          "~/bin/ci-badges",
+				"interval_seconds": 60,
+			}
+		}
+
+		// Handle TracingSettings pointer
+		if elemType.Name() == "TracingSettings" {
+			return map[string]any{
+				"endpoint": DefaultOTLPTracesEndpoint,
+				"exporter": TracingExporterOTLP,
+			}
+		}
+
+		// Handle WaitingEscalationSettings pointer
+		if elemType.Name() == "WaitingEscalationSettings" {
+			return map[string]any{
+				"bell":           true,
+				"minutes":        15,
+				"status_minutes": map[string]int{"review": 5},
+				"webhook":        true,
+			}
+		}
+
+		// Handle WebhookSettings pointer
+		if elemType.Name() == "WebhookSettings" {
+			return map[string]any{
+				"events":  []string{"state_change", "archive", "error"},
+				"headers": map[string]string{"Authorization": "Bearer <token>"},
+				"url":     "https://hooks.example.com/rocha",
+			}
+		}
+
 		switch elemType.Kind() {
 		case reflect.Bool:
 			// Return boolean value directly (not pointer)
-			if fieldName == "debug" || fieldName == "show_timestamps" {
+			if fieldName == "clean_orphan_shells" || fieldName == "debug" || fieldName == "handoff_prompt" || fieldName == "hook_metrics" || fieldName == "show_timestamps" {
 				return true
 			}
 			return false
 		case reflect.Int:
 			// Return int value directly (not pointer)
+			if fieldName == "background_fetch_minutes" {
+				return 15
+			}
 			if fieldName == "error_clear_delay" {
 				return 10
 			}
 			if fieldName == "max_log_files" {
 				return 1000
 			}
+			if fieldName == "max_working_sessions" {
+				return 4
+			}
+			if fieldName == "max_working_sessions_per_repo" {
+				return 2
+			}
+			if fieldName == "worktree_retention_days" {
+				return 30
+			}
 			return 10
 		}
 	}
@@ -78,10 +135,32 @@ func generateExampleValue(t reflect.Type, fieldName string) any {
 	case reflect.String:
 		// Generate contextual examples based on field name
 		switch fieldName {
+		case "attach_mode":
+			return "switch"
 		case "db_path":
 			return "~/.rocha/state.db"
+		case "default_view":
+			return "Needs review"
 		case "editor":
 			return "code"
+		case "editor_integration":
+			return "vscode"
+		case "language":
+			return "pt-PT"
+		case "multiplexer":
+			return "zellij"
+		case "sort_preset":
+			return "attention"
+		case "summary_command":
+			return "llm -s 'Summarize this diff in one paragraph'"
+		case "time_format":
+			return "2006-01-02 15:04:05"
+		case "time_zone":
+			return "Europe/Lisbon"
+		case "timestamp_mode":
+			return "hybrid"
+		case "tips_file":
+			return "~/src/team/rocha-tips.txt"
 		case "tmux_status_position":
 			return "bottom"
 		case "worktree_path":
@@ -90,6 +169,24 @@ func generateExampleValue(t reflect.Type, fieldName string) any {
 			return "example"
 		}
 	case reflect.Slice:
+		if fieldName == "rules" {
+			return []map[string]string{
+				{"name": "review ready", "when": "state:waiting status:review", "then": "notify"},
+				{"name": "clean up", "when": "state:exited older:1h", "then": "archive"},
+			}
+		}
+		if fieldName == "views" {
+			return []map[string]string{
+				{"name": "Needs review", "filter": "status:review", "sort_preset": "attention"},
+				{"name": "Client X", "filter": "tag:client-x"},
+			}
+		}
+		if fieldName == "sort_presets" {
+			return []map[string]string{
+				{"name": "attention", "sort": "flagged, state, -updated"},
+				{"name": "by repo", "sort": "repo, status"},
+			}
+		}
 		// Check if it's StringArray type
 		if t.Name() == "StringArray" || (t.Elem().Kind() == reflect.String) {
 			switch fieldName {
@@ -97,10 +194,68 @@ func generateExampleValue(t reflect.Type, fieldName string) any {
 				return []string{"141", "33", "214"}
 			case "statuses":
 				return []string{"spec", "plan", "implement"}
+			case "tips_custom":
+				return []string{"Run `make lint` before asking for a review"}
+			case "tips_disabled":
+				return []string{"filter", "timestamps"}
 			default:
 				return []string{"example1", "example2"}
 			}
 		}
+	case reflect.Map:
+		if fieldName == "ticket_sync" {
+			return map[string]any{
+				"owner/repo": map[string]any{
+					"account":    "me@example.com",
+					"base_url":   "https://example.atlassian.net",
+					"provider":   "jira",
+					"status_map": map[string]string{"implement": "In Progress", "review": "In Review"},
+				},
+			}
+		}
+		if fieldName == "checks" {
+			return map[string]string{"*": "make test", "owner/repo": "go vet ./... && go test ./..."}
+		}
+		if fieldName == "git_credentials" {
+			return map[string]any{
+				"owner/repo": map[string]string{
+					"credential_helper": "!gh auth git-credential",
+					"ssh_command":       "ssh -i ~/.ssh/client_ed25519 -o IdentitiesOnly=yes",
+				},
+			}
+		}
+		if fieldName == "git_identities" {
+			return map[string]any{
+				"owner/repo": map[string]string{
+					"email":       "me@client.com",
+					"name":        "My Name",
+					"signing_key": "~/.ssh/client_ed25519.pub",
+				},
+			}
+		}
+		if fieldName == "guardrails" {
+			return map[string]any{
+				"*":          map[string][]string{"protected_branches": {"main", "master"}},
+				"owner/repo": map[string][]string{"blocked_paths": {"~/src/repo"}, "protected_branches": {"release/*"}},
+			}
+		}
+		if fieldName == "profiles" {
+			return map[string]any{
+				"work": map[string]any{"editor": "cursor", "max_working_sessions": 2},
+			}
+		}
+		if fieldName == "worktree_paths" {
+			return map[string]string{"owner/repo": "../{{.RepoDir}}-{{.Branch}}"}
+		}
+		if fieldName == "worktree_bootstrap" {
+			return map[string]any{
+				"owner/repo": []map[string]string{
+					{"copy": ".env"},
+					{"run": "make deps"},
+					{"run": "direnv allow"},
+				},
+			}
+		}
 	}
 
 	return nil
@@ -1,8 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+
+	"github.com/renato0307/rocha/internal/domain"
 )
 
 // MainRepoDir is the directory name used for the main repository clone
@@ -31,11 +35,71 @@ func GetWorktreePath() string {
 	return filepath.Join(GetRochaHome(), "worktrees")
 }
 
+// GetTranscriptsPath returns $ROCHA_HOME/transcripts
+func GetTranscriptsPath() string {
+	return filepath.Join(GetRochaHome(), "transcripts")
+}
+
+// GetChecksPath returns $ROCHA_HOME/checks, where the output of session checks is captured
+func GetChecksPath() string {
+	return filepath.Join(GetRochaHome(), "checks")
+}
+
+// GetReportsPath returns $ROCHA_HOME/reports
+func GetReportsPath() string {
+	return filepath.Join(GetRochaHome(), "reports")
+}
+
+// GetJournalPath returns $ROCHA_HOME/journal
+func GetJournalPath() string {
+	return filepath.Join(GetRochaHome(), "journal")
+}
+
+// GetTracesPath returns $ROCHA_HOME/traces.jsonl, where the file trace exporter writes
+func GetTracesPath() string {
+	return filepath.Join(GetRochaHome(), "traces.jsonl")
+}
+
+// GetAPITokenPath returns $ROCHA_HOME/api-token, the bearer token of the REST API
+func GetAPITokenPath() string {
+	return filepath.Join(GetRochaHome(), "api-token")
+}
+
+// GetInstanceLockPath returns $ROCHA_HOME/instance.lock, held by the process running background work
+func GetInstanceLockPath() string {
+	return filepath.Join(GetRochaHome(), "instance.lock")
+}
+
 // GetSettingsPath returns $ROCHA_HOME/settings.json
 func GetSettingsPath() string {
 	return filepath.Join(GetRochaHome(), "settings.json")
 }
 
+// FindRochaHomes returns other ROCHA_HOME directories: the default ~/.rocha and
+// siblings of the current home named .rocha* that hold a state database
+func FindRochaHomes() []string {
+	current := filepath.Clean(GetRochaHome())
+	var candidates []string
+	if homeDir, err := os.UserHomeDir(); err == nil {
+		candidates = append(candidates, filepath.Join(homeDir, ".rocha"))
+	}
+	if matches, err := filepath.Glob(filepath.Join(filepath.Dir(current), ".rocha*")); err == nil {
+		candidates = append(candidates, matches...)
+	}
+
+	var homes []string
+	for _, candidate := range candidates {
+		candidate = filepath.Clean(candidate)
+		if candidate == current || slices.Contains(homes, candidate) {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(candidate, "state.db")); err == nil {
+			homes = append(homes, candidate)
+		}
+	}
+	return homes
+}
+
 // ExpandPath expands ~ to home directory
 func ExpandPath(path string) string {
 	if len(path) > 0 && path[0] == '~' {
@@ -49,3 +113,16 @@ func ExpandPath(path string) string {
 	}
 	return path
 }
+
+// ResolveDirectory expands ~ in path and makes it absolute
+// Returns domain.ErrInvalidInput if it is not an existing directory.
+func ResolveDirectory(path string) (string, error) {
+	absPath, err := filepath.Abs(ExpandPath(path))
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid directory %q: %v", domain.ErrInvalidInput, path, err)
+	}
+	if info, err := os.Stat(absPath); err != nil || !info.IsDir() {
+		return "", fmt.Errorf("%w: %s is not a directory", domain.ErrInvalidInput, absPath)
+	}
+	return absPath, nil
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestResolveDirectory(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	require.NoError(t, os.Mkdir(filepath.Join(home, "src"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(home, "notes.md"), nil, 0644))
+
+	tests := []struct {
+		name    string
+		path    string
+		want    string
+		wantErr error
+	}{
+		{name: "home relative", path: "~/src", want: filepath.Join(home, "src")},
+		{name: "absolute", path: filepath.Join(home, "src"), want: filepath.Join(home, "src")},
+		{name: "missing", path: "~/missing", wantErr: domain.ErrInvalidInput},
+		{name: "file", path: "~/notes.md", wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveDirectory(tt.path)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package config
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+//go:embed presets/*.json
+var builtinPresetFiles embed.FS
+
+// Preset bundles key bindings and a theme that are applied together, either one shipped
+// with rocha or a file shared by another user
+type Preset struct {
+	Description string            `json:"description,omitempty"`
+	Keys        KeyBindingsConfig `json:"keys,omitempty"`
+	Name        string            `json:"name"`
+	Theme       *ThemeSettings    `json:"theme,omitempty"`
+}
+
+// BuiltinPresets returns the presets shipped with rocha, sorted by name
+func BuiltinPresets() ([]Preset, error) {
+	entries, err := builtinPresetFiles.ReadDir("presets")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read built-in presets: %w", err)
+	}
+
+	presets := make([]Preset, 0, len(entries))
+	for _, entry := range entries {
+		data, err := builtinPresetFiles.ReadFile(path.Join("presets", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read built-in preset %s: %w", entry.Name(), err)
+		}
+		preset, err := parsePreset(data)
+		if err != nil {
+			return nil, fmt.Errorf("built-in preset %s: %w", entry.Name(), err)
+		}
+		presets = append(presets, *preset)
+	}
+	return presets, nil
+}
+
+// LoadPreset returns the built-in preset with the given name, or reads the preset file at
+// that path (a .json file, or anything with a path separator)
+func LoadPreset(nameOrPath string) (*Preset, error) {
+	if !strings.HasSuffix(nameOrPath, ".json") && !strings.ContainsRune(nameOrPath, os.PathSeparator) {
+		presets, err := BuiltinPresets()
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(presets))
+		for i, preset := range presets {
+			if preset.Name == nameOrPath {
+				return &preset, nil
+			}
+			names[i] = preset.Name
+		}
+		return nil, fmt.Errorf("%w: unknown preset '%s' (built-in: %s, or a .json file)",
+			domain.ErrInvalidInput, nameOrPath, strings.Join(names, ", "))
+	}
+
+	data, err := os.ReadFile(ExpandPath(nameOrPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read preset: %w", err)
+	}
+	return parsePreset(data)
+}
+
+// parsePreset decodes a preset file and checks its theme
+func parsePreset(data []byte) (*Preset, error) {
+	var preset Preset
+	if err := json.Unmarshal(data, &preset); err != nil {
+		return nil, fmt.Errorf("%w: invalid preset: %v", domain.ErrInvalidInput, err)
+	}
+	if preset.Name == "" {
+		return nil, fmt.Errorf("%w: preset has no name", domain.ErrInvalidInput)
+	}
+	if err := preset.Theme.Validate(); err != nil {
+		return nil, err
+	}
+	return &preset, nil
+}
+
+// ApplyPreset replaces the key bindings and theme at the top level of settings.json, or in the
+// profile of scope, with those of the preset. Bindings it leaves out go back to their defaults.
+func ApplyPreset(preset *Preset, scope SettingScope) error {
+	doc, err := loadSettingsDocument()
+	if err != nil {
+		return err
+	}
+	target := doc
+	if scope.Profile != "" {
+		target = childObject(childObject(doc, profilesKey), scope.Profile)
+	}
+
+	for key, value := range map[string]any{"keys": preset.Keys, "theme": preset.Theme} {
+		object, err := jsonObject(value)
+		if err != nil {
+			return err
+		}
+		if len(object) == 0 {
+			delete(target, key)
+			continue
+		}
+		target[key] = object
+	}
+	return saveSettingsDocument(doc)
+}
+
+// CurrentPreset returns the key bindings and theme in effect for scope as a preset with
+// the given name, to share with other users
+func CurrentPreset(name, description string, scope SettingScope) (*Preset, error) {
+	preset := &Preset{Description: description, Name: name}
+	for key, target := range map[string]any{"keys": &preset.Keys, "theme": &preset.Theme} {
+		value, err := GetSetting(key, scope)
+		if errors.Is(err, ErrSettingNotSet) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		data, err := json.Marshal(value.Value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode %s: %w", key, err)
+		}
+		if err := json.Unmarshal(data, target); err != nil {
+			return nil, fmt.Errorf("invalid %s in settings.json: %w", key, err)
+		}
+	}
+	return preset, nil
+}
+
+// jsonObject converts a value to the JSON object settings.json stores (nil when empty)
+func jsonObject(value any) (map[string]any, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode preset: %w", err)
+	}
+	var object map[string]any
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("failed to encode preset: %w", err)
+	}
+	return object, nil
+}
@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestBuiltinPresets(t *testing.T) {
+	presets, err := BuiltinPresets()
+	require.NoError(t, err)
+
+	var names []string
+	for _, preset := range presets {
+		names = append(names, preset.Name)
+		assert.NotEmpty(t, preset.Description, preset.Name)
+	}
+	assert.Equal(t, []string{"emacs", "minimal", "vim"}, names)
+}
+
+func TestLoadPreset(t *testing.T) {
+	preset, err := LoadPreset("vim")
+	require.NoError(t, err)
+	assert.Equal(t, KeyBindingValue{"/"}, preset.Keys["filter"])
+
+	_, err = LoadPreset("nano")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	path := filepath.Join(t.TempDir(), "bad.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"name": "bad", "theme": {"working": "300"}}`), 0644))
+	_, err = LoadPreset(path)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestApplyPreset_ReplacesKeysAndTheme(t *testing.T) {
+	t.Setenv("ROCHA_HOME", t.TempDir())
+	t.Setenv(ProfileEnvVar, "")
+
+	require.NoError(t, SetSetting("keys.archive", "X", SettingScope{}))
+	require.NoError(t, SetSetting("editor", "vim", SettingScope{}))
+
+	emacs, err := LoadPreset("emacs")
+	require.NoError(t, err)
+	require.NoError(t, ApplyPreset(emacs, SettingScope{}))
+
+	settings, err := LoadSettings()
+	require.NoError(t, err)
+	assert.Equal(t, emacs.Keys, settings.Keys, "bindings the preset leaves out go back to their defaults")
+	assert.Equal(t, "135", settings.Theme.Primary)
+	assert.Equal(t, "vim", settings.Editor, "other settings are kept")
+
+	// Sharing the result gives the same preset back
+	current, err := CurrentPreset("mine", "", SettingScope{})
+	require.NoError(t, err)
+	assert.Equal(t, emacs.Keys, current.Keys)
+	assert.Equal(t, emacs.Theme, current.Theme)
+
+	// A preset without keys removes the custom bindings
+	minimal, err := LoadPreset("minimal")
+	require.NoError(t, err)
+	require.NoError(t, ApplyPreset(minimal, SettingScope{}))
+	settings, err = LoadSettings()
+	require.NoError(t, err)
+	assert.Empty(t, settings.Keys)
+	assert.Equal(t, "252", settings.Theme.Primary)
+}
+
+func TestThemeSettings_Validate(t *testing.T) {
+	assert.NoError(t, (&ThemeSettings{Primary: "214", Working: "#98971a"}).Validate())
+	assert.NoError(t, (*ThemeSettings)(nil).Validate())
+	assert.ErrorIs(t, (&ThemeSettings{Idle: "yellow"}).Validate(), domain.ErrInvalidInput)
+	assert.ErrorIs(t, (&ThemeSettings{Idle: "256"}).Validate(), domain.ErrInvalidInput)
+}
@@ -0,0 +1,65 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// MaxRecentActions is the number of recently used palette actions kept between runs
+const MaxRecentActions = 5
+
+// GetRecentActionsPath returns $ROCHA_HOME/recent_actions.json
+func GetRecentActionsPath() string {
+	return filepath.Join(GetRochaHome(), "recent_actions.json")
+}
+
+// LoadRecentActions loads the recently used command palette actions (most recent first)
+// Returns an empty list if the file doesn't exist (not an error)
+func LoadRecentActions() ([]string, error) {
+	data, err := os.ReadFile(GetRecentActionsPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("failed to read recent actions: %w", err)
+	}
+
+	var actions []string
+	if err := json.Unmarshal(data, &actions); err != nil {
+		return nil, fmt.Errorf("invalid recent_actions.json: %w", err)
+	}
+
+	return actions, nil
+}
+
+// SaveRecentActions saves the recently used command palette actions
+func SaveRecentActions(actions []string) error {
+	path := GetRecentActionsPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create rocha home: %w", err)
+	}
+
+	data, err := json.Marshal(actions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal recent actions: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write recent actions: %w", err)
+	}
+
+	return nil
+}
+
+// PushRecentAction moves name to the front of actions, dropping duplicates and old entries
+func PushRecentAction(actions []string, name string) []string {
+	result := []string{name}
+	for _, action := range actions {
+		if action != name && len(result) < MaxRecentActions {
+			result = append(result, action)
+		}
+	}
+	return result
+}
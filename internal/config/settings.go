@@ -4,7 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
 )
 
 // KeyBindingValue supports "a" or ["up", "k"] in JSON
@@ -87,23 +92,219 @@ func (k KeyBindingsConfig) Validate(validNames []string) error {
 // DefaultTmuxStatusPosition is the default tmux status bar position
 const DefaultTmuxStatusPosition = "bottom"
 
+// Attach modes control how sessions are opened when rocha runs inside tmux
+const (
+	AttachModeAttach = "attach" // Nested tmux attach (default, works inside and outside tmux)
+	AttachModeSwitch = "switch" // Switch the current tmux client to the session
+	AttachModeWindow = "window" // Open the session in a new tmux window
+)
+
+// Trace exporters
+const (
+	TracingExporterFile = "file" // Append JSON spans to a file
+	TracingExporterOTLP = "otlp" // Export to an OTLP/HTTP endpoint (default)
+)
+
+// DefaultOTLPTracesEndpoint is the traces endpoint of a local OpenTelemetry Collector or Jaeger
+const DefaultOTLPTracesEndpoint = "http://localhost:4318/v1/traces"
+
+// Terminal multiplexers that can host sessions
+const (
+	MultiplexerScreen = "screen"
+	MultiplexerTmux   = "tmux" // Default
+	MultiplexerZellij = "zellij"
+)
+
 // Settings represents the structure of ~/.rocha/settings.json
 type Settings struct {
-	AllowDangerouslySkipPermissions *bool             `json:"allow_dangerously_skip_permissions,omitempty"`
-	Debug                           *bool             `json:"debug,omitempty"`
-	Editor                          string            `json:"editor,omitempty"`
-	ErrorClearDelay                 *int              `json:"error_clear_delay,omitempty"`
-	Keys                            KeyBindingsConfig `json:"keys,omitempty"`
-	MaxLogFiles                     *int              `json:"max_log_files,omitempty"`
-	ShowPRNumber                    *bool             `json:"show_pr_number,omitempty"`
-	ShowTimestamps                  *bool             `json:"show_timestamps,omitempty"`
-	ShowTokenChart                  *bool             `json:"show_token_chart,omitempty"`
-	StatusColors                    StringArray       `json:"status_colors,omitempty"`
-	Statuses                        StringArray       `json:"statuses,omitempty"`
-	TipsDisplayDurationSeconds      *int              `json:"tips_display_duration_seconds,omitempty"`
-	TipsEnabled                     *bool             `json:"tips_enabled,omitempty"`
-	TipsShowIntervalSeconds         *int              `json:"tips_show_interval_seconds,omitempty"`
-	TmuxStatusPosition              string            `json:"tmux_status_position,omitempty"`
+	Accessible                      *bool                              `json:"accessible,omitempty"` // Text labels instead of colored icons, no colors, one line per session
+	AllowDangerouslySkipPermissions *bool                              `json:"allow_dangerously_skip_permissions,omitempty"`
+	AttachMode                      string                             `json:"attach_mode,omitempty"`
+	BackgroundFetchMinutes          *int                               `json:"background_fetch_minutes,omitempty"` // Minutes between background git fetches of session repositories (0 = off, the default)
+	Badges                          *BadgeSettings                     `json:"badges,omitempty"`                   // Script adding custom badges to sessions in the TUI list
+	Checks                          map[string]string                  `json:"checks,omitempty"`                   // Per repository (owner/repo), or "*" for all: command run by the run checks action, such as make test
+	CleanOrphanShells               *bool                              `json:"clean_orphan_shells,omitempty"`      // Kill shells of stopped sessions every minute while the TUI runs (default false)
+	Debug                           *bool                              `json:"debug,omitempty"`
+	DefaultView                     string                             `json:"default_view,omitempty"`          // View the TUI starts in, by name
+	DisplayNameTemplate             string                             `json:"display_name_template,omitempty"` // Go template naming sessions created from just a branch
+	Editor                          string                             `json:"editor,omitempty"`
+	EditorIntegration               string                             `json:"editor_integration,omitempty"` // Default for sessions without one: default, vscode, jetbrains, zed
+	EnvTools                        *bool                              `json:"env_tools,omitempty"`          // Trust and load direnv (.envrc) and mise (mise.toml) config in worktrees (default false)
+	ErrorClearDelay                 *int                               `json:"error_clear_delay,omitempty"`
+	GitCredentials                  map[string]GitCredentialsSettings  `json:"git_credentials,omitempty"` // Per repository (owner/repo)
+	GitIdentities                   map[string]GitIdentitySettings     `json:"git_identities,omitempty"`  // Per repository (owner/repo)
+	Guardrails                      map[string]GuardrailSettings       `json:"guardrails,omitempty"`      // Per repository (owner/repo), or "*" for all
+	HandoffPrompt                   *bool                              `json:"handoff_prompt,omitempty"`  // Ask where you left off after detaching from a session
+	HookMetrics                     *bool                              `json:"hook_metrics,omitempty"`    // Record how long hook events take for the debug screen (default false; ROCHA_HOOK_METRICS=1 also turns it on)
+	Keys                            KeyBindingsConfig                  `json:"keys,omitempty"`
+	Language                        string                             `json:"language,omitempty"` // Language of the TUI: en (default) or pt-PT
+	MaxLogFiles                     *int                               `json:"max_log_files,omitempty"`
+	MaxWorkingSessions              *int                               `json:"max_working_sessions,omitempty"`          // Global cap on working sessions (0 = unlimited)
+	MaxWorkingSessionsPerRepo       *int                               `json:"max_working_sessions_per_repo,omitempty"` // Cap on working sessions per repository (0 = unlimited)
+	Multiplexer                     string                             `json:"multiplexer,omitempty"`                   // Terminal multiplexer hosting sessions: tmux (default), zellij, screen
+	Profiles                        map[string]json.RawMessage         `json:"profiles,omitempty"`                      // Named overrides of these settings, applied with ROCHA_PROFILE
+	PromptReview                    *PromptReviewSettings              `json:"prompt_review,omitempty"`                 // Confirm dangerous prompts to skip-permissions sessions before sending them
+	Rules                           []RuleSettings                     `json:"rules,omitempty"`                         // Workflow automations applied while the TUI or scheduler runs
+	ShowPRNumber                    *bool                              `json:"show_pr_number,omitempty"`
+	ShowTimestamps                  *bool                              `json:"show_timestamps,omitempty"`
+	ShowTokenChart                  *bool                              `json:"show_token_chart,omitempty"`
+	SortPreset                      string                             `json:"sort_preset,omitempty"`  // Sort preset active at startup (empty = manual order)
+	SortPresets                     []SortPresetSettings               `json:"sort_presets,omitempty"` // Named sorts cycled in the TUI
+	StatusColors                    StringArray                        `json:"status_colors,omitempty"`
+	Statuses                        StringArray                        `json:"statuses,omitempty"`
+	SummaryCommand                  string                             `json:"summary_command,omitempty"` // Shell command turning the diff on stdin into a session description (default: claude -p)
+	TicketSync                      map[string]TicketSyncSettings      `json:"ticket_sync,omitempty"`     // Per repository (owner/repo)
+	TimeFormat                      string                             `json:"time_format,omitempty"`     // Absolute timestamps: eu, iso (default), rfc3339, seconds, short, us, or a Go layout
+	TimeZone                        string                             `json:"time_zone,omitempty"`       // Time zone of timestamps: local (default), utc, or a name such as Europe/Lisbon
+	TimestampMode                   string                             `json:"timestamp_mode,omitempty"`  // How shown timestamps look in the TUI: relative (default), absolute, or hybrid
+	TipsCustom                      []string                           `json:"tips_custom,omitempty"`     // Tips of your own, shown along the built-in ones; keys in backticks are highlighted
+	TipsDisabled                    StringArray                        `json:"tips_disabled,omitempty"`   // Built-in tips not to show, by the name of their key binding
+	TipsDisplayDurationSeconds      *int                               `json:"tips_display_duration_seconds,omitempty"`
+	TipsEnabled                     *bool                              `json:"tips_enabled,omitempty"`
+	TipsFile                        string                             `json:"tips_file,omitempty"` // File with more tips of your own, one per line (default: ~/.rocha/tips.txt)
+	TipsShowIntervalSeconds         *int                               `json:"tips_show_interval_seconds,omitempty"`
+	TmuxStatusPosition              string                             `json:"tmux_status_position,omitempty"`
+	TokenBudgetWrapUpPrompt         string                             `json:"token_budget_wrap_up_prompt,omitempty"` // Sent to agents that exceed a token budget with wrap-up on
+	Theme                           *ThemeSettings                     `json:"theme,omitempty"`                       // Colors of the TUI, usually set with rocha config apply-preset
+	Tracing                         *TracingSettings                   `json:"tracing,omitempty"`                     // OpenTelemetry traces of session lifecycle, git, tmux, and database operations
+	Views                           []ViewSettings                     `json:"views,omitempty"`                       // Named filters and sorts of the session list
+	WaitingEscalation               *WaitingEscalationSettings         `json:"waiting_escalation,omitempty"`          // Alerts for sessions left waiting for input
+	Webhook                         *WebhookSettings                   `json:"webhook,omitempty"`
+	WorktreeBootstrap               map[string][]BootstrapStepSettings `json:"worktree_bootstrap,omitempty"`      // Per repository (owner/repo)
+	WorktreePaths                   map[string]string                  `json:"worktree_paths,omitempty"`          // Per repository (owner/repo): template of where new worktrees go, such as ../{{.RepoDir}}-{{.Branch}}
+	WorktreeRetentionDays           *int                               `json:"worktree_retention_days,omitempty"` // Days archived sessions keep clean, pushed worktrees (0 = forever)
+}
+
+// BadgeSettings configures the command printing custom badges of sessions, such as "tests: red".
+// It reads the sessions as a JSON array on stdin and prints one JSON object per badge:
+// {"session": "<name>", "text": "tests: red", "color": "196"}
+type BadgeSettings struct {
+	Command         string `json:"command"`                    // Shell command run with sh -c
+	IntervalSeconds int    `json:"interval_seconds,omitempty"` // Seconds between runs (default 30)
+}
+
+// BootstrapStepSettings is one step run in a new worktree before the agent starts; set copy or run
+type BootstrapStepSettings struct {
+	Copy string `json:"copy,omitempty"` // File or directory copied from the main checkout (relative path)
+	Run  string `json:"run,omitempty"`  // Shell command run in the worktree
+}
+
+// GitCredentialsSettings is how git authenticates to the remote of a repository rocha clones
+type GitCredentialsSettings struct {
+	CredentialHelper string `json:"credential_helper,omitempty"` // credential.helper for HTTPS remotes
+	SSHCommand       string `json:"ssh_command,omitempty"`       // core.sshCommand for SSH remotes, such as "ssh -i ~/.ssh/client_ed25519"
+}
+
+// GitIdentitySettings is the identity commits in new worktrees of a repository use
+type GitIdentitySettings struct {
+	Email      string `json:"email,omitempty"`
+	Name       string `json:"name,omitempty"`
+	SigningKey string `json:"signing_key,omitempty"` // GPG key ID, or SSH public key file (*.pub); turns on commit signing
+}
+
+// GuardrailSettings restrict the branches and directories agent sessions of a repository may use
+type GuardrailSettings struct {
+	BlockedPaths      []string `json:"blocked_paths,omitempty"`      // Directories worktrees and sessions may not be in, nor under
+	ProtectedBranches []string `json:"protected_branches,omitempty"` // Branch patterns sessions may not use, such as main or release/*
+}
+
+// PromptReviewSettings holds back prompts matching dangerous patterns, such as rm -rf or
+// git push --force, until confirmed; only prompts to skip-permissions sessions are reviewed
+type PromptReviewSettings struct {
+	Enabled  bool     `json:"enabled"`
+	Patterns []string `json:"patterns,omitempty"` // Regular expressions (default: recursive rm, force push, DROP TABLE)
+}
+
+// RuleSettings is a workflow automation: when a session matches a filter, do an action
+type RuleSettings struct {
+	Name string `json:"name"`
+	Then string `json:"then"` // Action: notify, flag, or archive
+	When string `json:"when"` // Session filter such as "state:waiting status:review" or "state:exited older:1h"
+}
+
+// SortPresetSettings names a session list sort expression such as "flagged, state, -updated"
+type SortPresetSettings struct {
+	Name string `json:"name"`
+	Sort string `json:"sort"` // Comma-separated keys: flagged, name, priority, repo, state, status, updated ("-" reverses)
+}
+
+// TicketSyncSettings configures status sync to Jira or Linear for one repository
+type TicketSyncSettings struct {
+	Account    string            `json:"account,omitempty"`     // Keychain account of the API token (Jira: account email)
+	BaseURL    string            `json:"base_url,omitempty"`    // Jira site URL or Linear API URL
+	KeyPattern string            `json:"key_pattern,omitempty"` // Regexp finding the ticket key in branch or display name
+	Provider   string            `json:"provider"`              // jira or linear
+	StatusMap  map[string]string `json:"status_map,omitempty"`  // Session status -> ticket state
+}
+
+// ThemeSettings overrides the main colors of the TUI; colors are ANSI codes (0-255) or #rrggbb
+type ThemeSettings struct {
+	Accent    string `json:"accent,omitempty"` // Shortcut keys and hints
+	Exited    string `json:"exited,omitempty"`
+	Idle      string `json:"idle,omitempty"`
+	Muted     string `json:"muted,omitempty"` // Branches and secondary text
+	Paused    string `json:"paused,omitempty"`
+	Primary   string `json:"primary,omitempty"`   // App name and titles
+	Secondary string `json:"secondary,omitempty"` // Subtitles
+	Waiting   string `json:"waiting,omitempty"`
+	Working   string `json:"working,omitempty"`
+}
+
+// themeColorPattern matches the colors a theme accepts: ANSI codes or #rrggbb
+var themeColorPattern = regexp.MustCompile(`^(\d{1,3}|#[0-9a-fA-F]{6})$`)
+
+// Validate checks the theme colors; key names are checked by the caller against the TUI's
+func (t *ThemeSettings) Validate() error {
+	if t == nil {
+		return nil
+	}
+	colors := map[string]string{
+		"accent": t.Accent, "exited": t.Exited, "idle": t.Idle, "muted": t.Muted, "paused": t.Paused,
+		"primary": t.Primary, "secondary": t.Secondary, "waiting": t.Waiting, "working": t.Working,
+	}
+	for name, color := range colors {
+		if color == "" {
+			continue
+		}
+		if !themeColorPattern.MatchString(color) {
+			return fmt.Errorf("%w: theme color %s %q must be an ANSI code (0-255) or #rrggbb", domain.ErrInvalidInput, name, color)
+		}
+		if n, err := strconv.Atoi(color); err == nil && n > 255 {
+			return fmt.Errorf("%w: theme color %s %q must be an ANSI code (0-255) or #rrggbb", domain.ErrInvalidInput, name, color)
+		}
+	}
+	return nil
+}
+
+// TracingSettings exports OpenTelemetry traces
+type TracingSettings struct {
+	Endpoint string            `json:"endpoint,omitempty"` // OTLP/HTTP traces URL (default from OTEL_EXPORTER_OTLP_*, then http://localhost:4318/v1/traces)
+	Exporter string            `json:"exporter,omitempty"` // otlp (default) or file
+	File     string            `json:"file,omitempty"`     // File the file exporter appends to (default $ROCHA_HOME/traces.jsonl)
+	Headers  map[string]string `json:"headers,omitempty"`  // Extra HTTP headers for the otlp exporter (e.g. an API key)
+}
+
+// ViewSettings is a named filter and sort of the session list
+type ViewSettings struct {
+	Filter     string `json:"filter,omitempty"` // Filter as typed in the list, such as "status:review" or "tag:client-x"
+	Name       string `json:"name"`
+	SortPreset string `json:"sort_preset,omitempty"` // Name of one of sort_presets (empty = manual order)
+}
+
+// WaitingEscalationSettings escalates sessions left waiting for input longer than a threshold
+type WaitingEscalationSettings struct {
+	Bell          *bool          `json:"bell,omitempty"`           // Play the bell when a session escalates (default true)
+	Color         string         `json:"color,omitempty"`          // Timestamp color of escalated sessions (default 196)
+	Minutes       int            `json:"minutes,omitempty"`        // Threshold for statuses without their own (0 = never)
+	StatusMinutes map[string]int `json:"status_minutes,omitempty"` // Thresholds per implementation status
+	Webhook       bool           `json:"webhook,omitempty"`        // Send an escalation event to the webhook
+}
+
+// WebhookSettings configures the webhook that receives session events
+type WebhookSettings struct {
+	Events  StringArray       `json:"events,omitempty"`  // Events to send: state_change, status_change, archive, error, escalation, handoff, rule, timer, token_budget (empty = all)
+	Headers map[string]string `json:"headers,omitempty"` // Extra HTTP headers (e.g. Authorization)
+	URL     string            `json:"url"`
 }
 
 // StringArray supports both JSON arrays and comma-separated strings
@@ -142,7 +343,8 @@ func parseCommaSeparated(s string) []string {
 	return result
 }
 
-// LoadSettings loads settings from $ROCHA_HOME/settings.json (or ~/.rocha/settings.json if not set)
+// LoadSettings loads settings from $ROCHA_HOME/settings.json (or ~/.rocha/settings.json if not set),
+// with the profile selected by ROCHA_PROFILE applied on top.
 // Returns empty Settings if file doesn't exist (not an error)
 func LoadSettings() (*Settings, error) {
 	path := GetSettingsPath()
@@ -154,6 +356,13 @@ func LoadSettings() (*Settings, error) {
 		return nil, fmt.Errorf("failed to read settings file: %w", err)
 	}
 
+	// A profile without settings of its own leaves the top-level settings as they are
+	if profile := ActiveProfile(); profile != "" {
+		if data, err = applyProfile(data, profile); err != nil {
+			return nil, err
+		}
+	}
+
 	var settings Settings
 	if err := json.Unmarshal(data, &settings); err != nil {
 		return nil, fmt.Errorf("invalid settings.json: %w", err)
@@ -167,9 +376,19 @@ func LoadSettings() (*Settings, error) {
 	return &settings, nil
 }
 
+// SettingsExist reports whether $ROCHA_HOME/settings.json exists
+func SettingsExist() bool {
+	_, err := os.Stat(GetSettingsPath())
+	return err == nil
+}
+
 // SaveSettings saves settings to $ROCHA_HOME/settings.json
 func SaveSettings(settings *Settings) error {
 	path := GetSettingsPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create settings directory: %w", err)
+	}
+
 	data, err := json.MarshalIndent(settings, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal settings: %w", err)
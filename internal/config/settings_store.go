@@ -0,0 +1,519 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// ProfileEnvVar selects the settings profile applied on top of the top-level settings
+const ProfileEnvVar = "ROCHA_PROFILE"
+
+// profilesKey is the settings.json key holding the profiles
+const profilesKey = "profiles"
+
+// Sources of setting values
+const (
+	SettingSourceDefault  = "default"       // Not set, rocha uses its default
+	SettingSourceSettings = "settings.json" // Top level of settings.json
+)
+
+// ErrSettingNotSet is returned when reading a setting that has no value in settings.json
+var ErrSettingNotSet = errors.New("setting not set")
+
+// repoScopedSettings are the settings keyed by repository (owner/repo)
+var repoScopedSettings = []string{"checks", "git_credentials", "git_identities", "guardrails", "ticket_sync", "worktree_bootstrap", "worktree_paths"}
+
+// SettingScope selects where a setting is read from or written to
+type SettingScope struct {
+	Profile string // Profile overlay; reads default to ROCHA_PROFILE, writes to the top level
+	Repo    string // Repository (owner/repo) of a per-repository setting such as git_identities
+}
+
+// SettingValue is the effective value of a setting and where it comes from
+type SettingValue struct {
+	Key    string `json:"key"`
+	Source string `json:"source"`          // default, settings.json, or profile <name>
+	Value  any    `json:"value,omitempty"` // nil when not set
+}
+
+// ActiveProfile returns the settings profile selected with ROCHA_PROFILE ("" = none)
+func ActiveProfile() string {
+	return strings.TrimSpace(os.Getenv(ProfileEnvVar))
+}
+
+// ListProfiles returns the names of the profiles in settings.json, sorted
+func ListProfiles() ([]string, error) {
+	doc, err := loadSettingsDocument()
+	if err != nil {
+		return nil, err
+	}
+	profiles, _ := doc[profilesKey].(map[string]any)
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// profileSource is the source of values set in a profile
+func profileSource(profile string) string {
+	return "profile " + profile
+}
+
+// GetSetting returns the effective value of a setting in the profile of scope (or the active one)
+// Returns ErrSettingNotSet if neither the profile nor the top level sets it.
+func GetSetting(key string, scope SettingScope) (SettingValue, error) {
+	path, err := settingPath(key, scope.Repo)
+	if err != nil {
+		return SettingValue{}, err
+	}
+	if _, err := settingType(path); err != nil {
+		return SettingValue{}, err
+	}
+	doc, err := loadSettingsDocument()
+	if err != nil {
+		return SettingValue{}, err
+	}
+
+	profile := readProfile(scope)
+	value := SettingValue{Key: key, Source: SettingSourceDefault}
+	if v, ok := lookupSetting(profileOverlay(doc, profile), path); ok {
+		value.Value, value.Source = v, profileSource(profile)
+	} else if v, ok := lookupSetting(doc, path); ok {
+		value.Value, value.Source = v, SettingSourceSettings
+	} else {
+		return value, fmt.Errorf("%w: %s", ErrSettingNotSet, key)
+	}
+
+	// Objects combine the top level with the profile, like LoadSettings does
+	if _, isObject := value.Value.(map[string]any); isObject {
+		value.Value, _ = lookupSetting(mergeSettings(doc, profileOverlay(doc, profile)), path)
+	}
+	return value, nil
+}
+
+// ListSettings returns the effective value of every setting in the profile of scope (or the
+// active one), nested objects flattened into dotted keys. With a repository, only its
+// per-repository settings are listed.
+func ListSettings(scope SettingScope) ([]SettingValue, error) {
+	doc, err := loadSettingsDocument()
+	if err != nil {
+		return nil, err
+	}
+	profile := readProfile(scope)
+	overlay := profileOverlay(doc, profile)
+	effective := mergeSettings(doc, overlay)
+	delete(effective, profilesKey)
+
+	var values []SettingValue
+	add := func(key string, path []string, value any) {
+		source := SettingSourceSettings
+		if _, ok := lookupSetting(overlay, path); ok {
+			source = profileSource(profile)
+		}
+		values = append(values, SettingValue{Key: key, Source: source, Value: value})
+	}
+
+	if scope.Repo != "" {
+		for _, name := range repoScopedSettings {
+			entry, ok := lookupSetting(effective, []string{name, scope.Repo})
+			if !ok {
+				values = append(values, SettingValue{Key: name, Source: SettingSourceDefault})
+				continue
+			}
+			flattenSettings(entry, name, []string{name, scope.Repo}, add)
+		}
+		return values, nil
+	}
+
+	for _, name := range settingNames() {
+		value, ok := effective[name]
+		if !ok {
+			values = append(values, SettingValue{Key: name, Source: SettingSourceDefault})
+			continue
+		}
+		flattenSettings(value, name, []string{name}, add)
+	}
+	return values, nil
+}
+
+// SetSetting parses value as the type of the setting and writes it to the top level of
+// settings.json, or to the profile of scope. Lists and objects are given as JSON;
+// lists of strings may also be comma-separated.
+func SetSetting(key, value string, scope SettingScope) error {
+	path, err := settingPath(key, scope.Repo)
+	if err != nil {
+		return err
+	}
+	t, err := settingType(path)
+	if err != nil {
+		return err
+	}
+	parsed, err := parseSettingValue(t, value)
+	if err != nil {
+		return fmt.Errorf("invalid value for %s: %w", key, err)
+	}
+
+	doc, err := loadSettingsDocument()
+	if err != nil {
+		return err
+	}
+	target := doc
+	if scope.Profile != "" {
+		target = childObject(childObject(doc, profilesKey), scope.Profile)
+	}
+	setSetting(target, path, parsed)
+	return saveSettingsDocument(doc)
+}
+
+// UnsetSetting removes a setting from the top level of settings.json, or from the profile
+// of scope, so the next level (or the default) applies. It reports whether it was set.
+func UnsetSetting(key string, scope SettingScope) (bool, error) {
+	path, err := settingPath(key, scope.Repo)
+	if err != nil {
+		return false, err
+	}
+	if _, err := settingType(path); err != nil {
+		return false, err
+	}
+
+	doc, err := loadSettingsDocument()
+	if err != nil {
+		return false, err
+	}
+	var removed bool
+	if scope.Profile != "" {
+		removed = unsetSetting(doc, append([]string{profilesKey, scope.Profile}, path...))
+	} else {
+		removed = unsetSetting(doc, path)
+	}
+	if !removed {
+		return false, nil
+	}
+	return true, saveSettingsDocument(doc)
+}
+
+// readProfile is the profile values are read from: the one of scope, or the active one
+func readProfile(scope SettingScope) string {
+	if scope.Profile != "" {
+		return scope.Profile
+	}
+	return ActiveProfile()
+}
+
+// settingPath splits a dotted key into its path in settings.json. With a repository,
+// the key names a per-repository setting and the repository is its second element.
+func settingPath(key, repo string) ([]string, error) {
+	path := strings.Split(key, ".")
+	if slices.Contains(path, "") {
+		return nil, fmt.Errorf("invalid setting key %q: %w", key, domain.ErrInvalidInput)
+	}
+	if path[0] == profilesKey {
+		return nil, fmt.Errorf("profiles are set with --profile: %w", domain.ErrInvalidInput)
+	}
+	if repo == "" {
+		return path, nil
+	}
+	if !slices.Contains(repoScopedSettings, path[0]) {
+		return nil, fmt.Errorf("%s is not a per-repository setting (use one of %s): %w",
+			path[0], strings.Join(repoScopedSettings, ", "), domain.ErrInvalidInput)
+	}
+	return append([]string{path[0], repo}, path[1:]...), nil
+}
+
+// settingType returns the Go type of the setting at path, walking Settings by JSON names
+func settingType(path []string) (reflect.Type, error) {
+	t := reflect.TypeOf(Settings{})
+	for i, segment := range path {
+		for t.Kind() == reflect.Ptr {
+			t = t.Elem()
+		}
+		switch t.Kind() {
+		case reflect.Struct:
+			field, ok := jsonField(t, segment)
+			if !ok {
+				return nil, fmt.Errorf("unknown setting %q: %w", strings.Join(path[:i+1], "."), domain.ErrInvalidInput)
+			}
+			t = field.Type
+		case reflect.Map:
+			t = t.Elem()
+		default:
+			return nil, fmt.Errorf("%s is a list and is set as a whole: %w", strings.Join(path[:i], "."), domain.ErrInvalidInput)
+		}
+	}
+	return t, nil
+}
+
+// jsonField finds the field of a struct with the given JSON name
+func jsonField(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if jsonName := strings.Split(field.Tag.Get("json"), ",")[0]; jsonName == name {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// settingNames returns the top-level settings in settings.json order, without profiles
+func settingNames() []string {
+	t := reflect.TypeOf(Settings{})
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		name := strings.Split(t.Field(i).Tag.Get("json"), ",")[0]
+		if name != "" && name != profilesKey {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseSettingValue converts a command-line value to the JSON value of a setting of type t
+// and checks that the settings file would accept it
+func parseSettingValue(t reflect.Type, value string) (any, error) {
+	base := t
+	for base.Kind() == reflect.Ptr {
+		base = base.Elem()
+	}
+
+	var parsed any
+	switch base.Kind() {
+	case reflect.String:
+		parsed = value
+	case reflect.Bool:
+		b, err := parseSettingBool(value)
+		if err != nil {
+			return nil, err
+		}
+		parsed = b
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a whole number: %w", value, domain.ErrInvalidInput)
+		}
+		parsed = n
+	default:
+		if base.Kind() == reflect.Slice && base.Elem().Kind() == reflect.String && !strings.HasPrefix(strings.TrimSpace(value), "[") {
+			items := []any{}
+			for _, item := range parseCommaSeparated(value) {
+				items = append(items, item)
+			}
+			parsed = items
+			break
+		}
+		if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+			return nil, fmt.Errorf("expected JSON such as %s: %w", settingJSONHint(base), domain.ErrInvalidInput)
+		}
+	}
+
+	data, err := json.Marshal(parsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+	if err := json.Unmarshal(data, reflect.New(t).Interface()); err != nil {
+		return nil, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+	}
+	return parsed, nil
+}
+
+// settingJSONHint shows the JSON shape of a list or object setting
+func settingJSONHint(t reflect.Type) string {
+	if t.Kind() == reflect.Slice {
+		return `[{"...": "..."}]`
+	}
+	return `{"...": "..."}`
+}
+
+// parseSettingBool parses true/false, yes/no, on/off, and 1/0
+func parseSettingBool(value string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "on", "1":
+		return true, nil
+	case "false", "no", "off", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("%q is not a boolean (use true/false, yes/no, on/off, 1/0): %w", value, domain.ErrInvalidInput)
+	}
+}
+
+// loadSettingsDocument reads settings.json as JSON objects, keeping numbers as written
+// Returns an empty document if the file doesn't exist
+func loadSettingsDocument() (map[string]any, error) {
+	data, err := os.ReadFile(GetSettingsPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]any{}, nil
+		}
+		return nil, fmt.Errorf("failed to read settings file: %w", err)
+	}
+	return decodeSettingsDocument(data)
+}
+
+// decodeSettingsDocument parses settings.json contents as JSON objects
+func decodeSettingsDocument(data []byte) (map[string]any, error) {
+	doc := map[string]any{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("invalid settings.json: %w", err)
+	}
+	return doc, nil
+}
+
+// saveSettingsDocument checks the top level and every profile against Settings, then writes settings.json
+func saveSettingsDocument(doc map[string]any) error {
+	if err := validateSettingsObject(doc); err != nil {
+		return err
+	}
+	if profiles, ok := doc[profilesKey].(map[string]any); ok {
+		for name, overlay := range profiles {
+			if err := validateSettingsObject(overlay); err != nil {
+				return fmt.Errorf("profile %s: %w", name, err)
+			}
+		}
+	}
+
+	path := GetSettingsPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create settings directory: %w", err)
+	}
+	data, err := json.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal settings: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write settings file: %w", err)
+	}
+	return nil
+}
+
+// validateSettingsObject checks that a JSON object decodes as Settings
+func validateSettingsObject(object any) error {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return fmt.Errorf("failed to marshal settings: %w", err)
+	}
+	var settings Settings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+	}
+	return nil
+}
+
+// applyProfile returns settings.json contents with a profile merged over the top level
+func applyProfile(data []byte, profile string) ([]byte, error) {
+	doc, err := decodeSettingsDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(mergeSettings(doc, profileOverlay(doc, profile)))
+}
+
+// profileOverlay returns the settings of a profile, or nil when it has none
+func profileOverlay(doc map[string]any, profile string) map[string]any {
+	if profile == "" {
+		return nil
+	}
+	profiles, _ := doc[profilesKey].(map[string]any)
+	overlay, _ := profiles[profile].(map[string]any)
+	return overlay
+}
+
+// mergeSettings returns base with overlay on top: objects merge key by key,
+// while lists and other values replace the ones in base
+func mergeSettings(base, overlay map[string]any) map[string]any {
+	merged := make(map[string]any, len(base)+len(overlay))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overlay {
+		baseObject, baseIsObject := merged[key].(map[string]any)
+		overlayObject, overlayIsObject := value.(map[string]any)
+		if baseIsObject && overlayIsObject {
+			merged[key] = mergeSettings(baseObject, overlayObject)
+			continue
+		}
+		merged[key] = value
+	}
+	return merged
+}
+
+// lookupSetting returns the value at path in a JSON object
+func lookupSetting(object map[string]any, path []string) (any, bool) {
+	var current any = object
+	for _, segment := range path {
+		asObject, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = asObject[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, true
+}
+
+// childObject returns the object under key, creating it (or replacing a non-object) if needed
+func childObject(object map[string]any, key string) map[string]any {
+	child, ok := object[key].(map[string]any)
+	if !ok {
+		child = map[string]any{}
+		object[key] = child
+	}
+	return child
+}
+
+// setSetting sets the value at path, creating the objects along it
+func setSetting(object map[string]any, path []string, value any) {
+	for _, segment := range path[:len(path)-1] {
+		object = childObject(object, segment)
+	}
+	object[path[len(path)-1]] = value
+}
+
+// unsetSetting removes the value at path and the objects it leaves empty
+func unsetSetting(object map[string]any, path []string) bool {
+	if len(path) == 1 {
+		_, ok := object[path[0]]
+		delete(object, path[0])
+		return ok
+	}
+	child, ok := object[path[0]].(map[string]any)
+	if !ok || !unsetSetting(child, path[1:]) {
+		return false
+	}
+	if len(child) == 0 {
+		delete(object, path[0])
+	}
+	return true
+}
+
+// flattenSettings calls add for every value under value, joining object keys with dots
+func flattenSettings(value any, key string, path []string, add func(key string, path []string, value any)) {
+	object, ok := value.(map[string]any)
+	if !ok || len(object) == 0 {
+		add(key, path, value)
+		return
+	}
+	keys := make([]string, 0, len(object))
+	for k := range object {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		flattenSettings(object[k], key+"."+k, append(slices.Clone(path), k), add)
+	}
+}
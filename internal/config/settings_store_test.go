@@ -0,0 +1,104 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestSetSetting_TypedValues(t *testing.T) {
+	t.Setenv("ROCHA_HOME", t.TempDir())
+	t.Setenv(ProfileEnvVar, "")
+
+	require.NoError(t, SetSetting("max_working_sessions", "4", SettingScope{}))
+	require.NoError(t, SetSetting("show_timestamps", "yes", SettingScope{}))
+	require.NoError(t, SetSetting("statuses", "spec, plan, review", SettingScope{}))
+	require.NoError(t, SetSetting("tracing.exporter", "file", SettingScope{}))
+	require.NoError(t, SetSetting("git_identities.email", "jane@client.com", SettingScope{Repo: "client/app.web"}))
+
+	settings, err := LoadSettings()
+	require.NoError(t, err)
+	assert.Equal(t, 4, *settings.MaxWorkingSessions)
+	assert.True(t, *settings.ShowTimestamps)
+	assert.Equal(t, StringArray{"spec", "plan", "review"}, settings.Statuses)
+	assert.Equal(t, "file", settings.Tracing.Exporter)
+	assert.Equal(t, "jane@client.com", settings.GitIdentities["client/app.web"].Email)
+
+	for key, value := range map[string]string{
+		"max_working_sessions": "four",
+		"show_timestamps":      "maybe",
+		"rules":                "notify",
+		"no_such_setting":      "x",
+		"tracing.no_such":      "x",
+		"profiles":             "{}",
+	} {
+		assert.ErrorIs(t, SetSetting(key, value, SettingScope{}), domain.ErrInvalidInput, key)
+	}
+	assert.ErrorIs(t, SetSetting("editor", "vim", SettingScope{Repo: "client/app"}), domain.ErrInvalidInput, "editor is not per repository")
+}
+
+func TestSettingProfiles(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("ROCHA_HOME", home)
+	t.Setenv(ProfileEnvVar, "")
+
+	require.NoError(t, SetSetting("editor", "vim", SettingScope{}))
+	require.NoError(t, SetSetting("keys.archive", "A", SettingScope{}))
+	require.NoError(t, SetSetting("editor", "cursor", SettingScope{Profile: "work"}))
+	require.NoError(t, SetSetting("keys.help", "H,?", SettingScope{Profile: "work"}))
+
+	value, err := GetSetting("editor", SettingScope{})
+	require.NoError(t, err)
+	assert.Equal(t, SettingValue{Key: "editor", Source: SettingSourceSettings, Value: "vim"}, value)
+
+	value, err = GetSetting("editor", SettingScope{Profile: "work"})
+	require.NoError(t, err)
+	assert.Equal(t, SettingValue{Key: "editor", Source: "profile work", Value: "cursor"}, value)
+
+	require.NoError(t, SetSetting("editor", "nano", SettingScope{Profile: "home"}))
+	profiles, err := ListProfiles()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"home", "work"}, profiles)
+	_, err = UnsetSetting("editor", SettingScope{Profile: "home"})
+	require.NoError(t, err)
+
+	_, err = GetSetting("attach_mode", SettingScope{})
+	assert.ErrorIs(t, err, ErrSettingNotSet)
+
+	t.Run("ROCHA_PROFILE applies the profile over the top level", func(t *testing.T) {
+		t.Setenv(ProfileEnvVar, "work")
+		settings, err := LoadSettings()
+		require.NoError(t, err)
+		assert.Equal(t, "cursor", settings.Editor)
+		assert.Equal(t, KeyBindingValue{"A"}, settings.Keys["archive"], "objects merge key by key")
+		assert.Equal(t, KeyBindingValue{"H", "?"}, settings.Keys["help"])
+
+		values, err := ListSettings(SettingScope{})
+		require.NoError(t, err)
+		assert.Contains(t, values, SettingValue{Key: "editor", Source: "profile work", Value: "cursor"})
+		assert.Contains(t, values, SettingValue{Key: "keys.archive", Source: SettingSourceSettings, Value: []any{"A"}})
+		assert.Contains(t, values, SettingValue{Key: "attach_mode", Source: SettingSourceDefault})
+	})
+
+	t.Run("unset removes the emptied profile", func(t *testing.T) {
+		removed, err := UnsetSetting("editor", SettingScope{Profile: "work"})
+		require.NoError(t, err)
+		assert.True(t, removed)
+		removed, err = UnsetSetting("keys.help", SettingScope{Profile: "work"})
+		require.NoError(t, err)
+		assert.True(t, removed)
+		removed, err = UnsetSetting("keys.help", SettingScope{Profile: "work"})
+		require.NoError(t, err)
+		assert.False(t, removed)
+
+		data, err := os.ReadFile(filepath.Join(home, "settings.json"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "profiles")
+		assert.Contains(t, string(data), `"editor": "vim"`)
+	})
+}
@@ -1,8 +1,12 @@
 package config
 
+// DefaultEscalatedColor is the timestamp color of escalated sessions (bright red)
+const DefaultEscalatedColor = "196"
+
 // TimestampColorConfig holds configuration for timestamp display colors.
 // Colors change based on how recently the session state was updated.
 type TimestampColorConfig struct {
+	EscalatedColor string // Color for sessions waiting longer than their escalation threshold
 	RecentColor    string // Color for recent updates (< RecentMinutes)
 	RecentMinutes  int    // Threshold in minutes for recent color
 	StaleColor     string // Color for very old updates (>= WarningMinutes)
@@ -18,5 +22,6 @@ func NewTimestampColorConfig(recentMin, warningMin int, recentColor, warningColo
 		RecentColor:    recentColor,
 		WarningColor:   warningColor,
 		StaleColor:     staleColor,
+		EscalatedColor: DefaultEscalatedColor,
 	}
 }
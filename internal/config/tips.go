@@ -0,0 +1,86 @@
+package config
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// GetTipsPath returns $ROCHA_HOME/tips.txt, the default file of tips of your own
+func GetTipsPath() string {
+	return filepath.Join(GetRochaHome(), "tips.txt")
+}
+
+// GetShownTipsPath returns $ROCHA_HOME/shown_tips.json
+func GetShownTipsPath() string {
+	return filepath.Join(GetRochaHome(), "shown_tips.json")
+}
+
+// LoadTipsFile reads the tips of a tips file, one per line. Blank lines and lines
+// starting with # are skipped. Returns an empty list if the file doesn't exist (not an error)
+func LoadTipsFile(path string) ([]string, error) {
+	file, err := os.Open(ExpandPath(path))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []string{}, nil
+		}
+		return nil, fmt.Errorf("failed to read tips file: %w", err)
+	}
+	defer file.Close()
+
+	tips := []string{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		tips = append(tips, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read tips file: %w", err)
+	}
+	return tips, nil
+}
+
+// LoadShownTips loads when each tip was last shown, by tip
+// Returns an empty map if the file doesn't exist (not an error)
+func LoadShownTips() (map[string]time.Time, error) {
+	data, err := os.ReadFile(GetShownTipsPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return map[string]time.Time{}, nil
+		}
+		return nil, fmt.Errorf("failed to read shown tips: %w", err)
+	}
+
+	shown := map[string]time.Time{}
+	if err := json.Unmarshal(data, &shown); err != nil {
+		return nil, fmt.Errorf("invalid shown_tips.json: %w", err)
+	}
+
+	return shown, nil
+}
+
+// SaveShownTips saves when each tip was last shown
+func SaveShownTips(shown map[string]time.Time) error {
+	path := GetShownTipsPath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create rocha home: %w", err)
+	}
+
+	data, err := json.Marshal(shown)
+	if err != nil {
+		return fmt.Errorf("failed to marshal shown tips: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write shown tips: %w", err)
+	}
+
+	return nil
+}
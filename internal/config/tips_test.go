@@ -0,0 +1,24 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTipsFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tips.txt")
+	content := "# Team onboarding\n\nAsk in #platform before touching prod\n  Run `make lint` first  \n"
+	require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+
+	tips, err := LoadTipsFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Ask in #platform before touching prod", "Run `make lint` first"}, tips)
+
+	tips, err = LoadTipsFile(filepath.Join(t.TempDir(), "missing.txt"))
+	require.NoError(t, err)
+	assert.Empty(t, tips)
+}
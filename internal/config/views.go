@@ -0,0 +1,47 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// viewsKey is the settings.json key of the session list views
+const viewsKey = "views"
+
+// SaveView writes a view to the top level of settings.json, replacing the view with the
+// same name, so views saved in the TUI can be edited like the ones written by hand
+func SaveView(view ViewSettings) error {
+	if strings.TrimSpace(view.Name) == "" {
+		return fmt.Errorf("%w: a view needs a name", domain.ErrInvalidInput)
+	}
+
+	data, err := json.Marshal(view)
+	if err != nil {
+		return fmt.Errorf("failed to marshal view: %w", err)
+	}
+	var entry map[string]any
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return fmt.Errorf("failed to marshal view: %w", err)
+	}
+
+	doc, err := loadSettingsDocument()
+	if err != nil {
+		return err
+	}
+	views, _ := doc[viewsKey].([]any)
+	replaced := false
+	for i, existing := range views {
+		if object, ok := existing.(map[string]any); ok && object["name"] == view.Name {
+			views[i] = entry
+			replaced = true
+		}
+	}
+	if !replaced {
+		views = append(views, entry)
+	}
+	doc[viewsKey] = views
+	return saveSettingsDocument(doc)
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestSaveView(t *testing.T) {
+	t.Setenv("ROCHA_HOME", t.TempDir())
+	t.Setenv(ProfileEnvVar, "")
+	require.NoError(t, SetSetting("editor", "vim", SettingScope{}))
+
+	require.NoError(t, SaveView(ViewSettings{Filter: "status:review", Name: "Needs review"}))
+	require.NoError(t, SaveView(ViewSettings{Filter: "tag:client-x", Name: "Client X"}))
+	require.NoError(t, SaveView(ViewSettings{Filter: "status:review state:waiting", Name: "Needs review", SortPreset: "attention"}))
+
+	settings, err := LoadSettings()
+	require.NoError(t, err)
+	assert.Equal(t, "vim", settings.Editor, "other settings are kept")
+	assert.Equal(t, []ViewSettings{
+		{Filter: "status:review state:waiting", Name: "Needs review", SortPreset: "attention"},
+		{Filter: "tag:client-x", Name: "Client X"},
+	}, settings.Views, "saving a view with the same name replaces it in place")
+
+	assert.ErrorIs(t, SaveView(ViewSettings{Filter: "state:waiting"}), domain.ErrInvalidInput)
+}
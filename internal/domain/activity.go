@@ -0,0 +1,108 @@
+package domain
+
+import "time"
+
+// ActivityHeatmap counts session state transitions per day and hour of day
+type ActivityHeatmap struct {
+	Counts [][24]int   // Counts[day][hour], same order as Days
+	Days   []time.Time // Midnight of each day in local time, oldest first
+	Max    int         // Highest count of any cell (0 when there is no activity)
+	Total  int         // Transitions counted in the heatmap
+}
+
+// NewActivityHeatmap buckets state change events into the given number of days ending on now's day.
+// Other event types and events outside the range are ignored; hours use now's location.
+func NewActivityHeatmap(events []Event, now time.Time, days int) ActivityHeatmap {
+	if days < 1 {
+		days = 1
+	}
+
+	loc := now.Location()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+
+	heatmap := ActivityHeatmap{
+		Counts: make([][24]int, days),
+		Days:   make([]time.Time, days),
+	}
+	index := make(map[time.Time]int, days)
+	for i := range days {
+		day := today.AddDate(0, 0, i-days+1)
+		heatmap.Days[i] = day
+		index[day] = i
+	}
+
+	for _, event := range events {
+		if event.Type != EventStateChange {
+			continue
+		}
+		t := event.Timestamp.In(loc)
+		day, ok := index[time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)]
+		if !ok {
+			continue
+		}
+		heatmap.Counts[day][t.Hour()]++
+		heatmap.Total++
+		if heatmap.Counts[day][t.Hour()] > heatmap.Max {
+			heatmap.Max = heatmap.Counts[day][t.Hour()]
+		}
+	}
+
+	return heatmap
+}
+
+// Level scales a cell count to 0 (no activity) up to levels-1 (busiest cell)
+func (h ActivityHeatmap) Level(count, levels int) int {
+	if count <= 0 || h.Max == 0 || levels < 2 {
+		return 0
+	}
+	level := 1 + (count-1)*(levels-1)/h.Max
+	if level > levels-1 {
+		level = levels - 1
+	}
+	return level
+}
+
+// ActivitySparkline is the share of time, from 0 to 1, a session spent working in
+// consecutive periods of equal length, oldest first
+type ActivitySparkline []float64
+
+// NewActivitySparkline splits since..until into the given number of periods and computes the share
+// of each the session spent working, from its state at since (initial, empty when unknown) and its
+// state change events, oldest first
+func NewActivitySparkline(initial SessionState, events []Event, since, until time.Time, buckets int) ActivitySparkline {
+	if buckets < 1 || !until.After(since) {
+		return nil
+	}
+
+	step := until.Sub(since) / time.Duration(buckets)
+	sparkline := make(ActivitySparkline, buckets)
+	state := initial
+	for i := range sparkline {
+		start := since.Add(time.Duration(i) * step)
+		end := start.Add(step)
+		if i == buckets-1 {
+			end = until
+		}
+		sparkline[i] = float64(StateDurations(state, events, start, end)[StateWorking]) / float64(end.Sub(start))
+
+		// The next period starts in the state the last transition of this one left
+		for _, event := range events {
+			if event.Type == EventStateChange && !event.Timestamp.After(end) {
+				state = event.State
+			}
+		}
+	}
+	return sparkline
+}
+
+// Busy returns the share of the whole sparkline the session spent working
+func (s ActivitySparkline) Busy() float64 {
+	if len(s) == 0 {
+		return 0
+	}
+	var total float64
+	for _, share := range s {
+		total += share
+	}
+	return total / float64(len(s))
+}
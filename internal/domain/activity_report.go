@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ReportFormat selects how an activity report is exported
+type ReportFormat string
+
+const (
+	ReportJSON     ReportFormat = "json"
+	ReportMarkdown ReportFormat = "md"
+)
+
+// reportStates are the states broken down in activity reports, in display order
+var reportStates = []SessionState{StateWorking, StateWaiting, StateIdle}
+
+// Commit is a commit made on a session branch
+type Commit struct {
+	CommittedAt time.Time `json:"committed_at"`
+	Hash        string    `json:"hash"`
+	Subject     string    `json:"subject"`
+}
+
+// StatusChange is an implementation status set on a session
+type StatusChange struct {
+	At     time.Time `json:"at"`
+	Status string    `json:"status"` // Empty when the status was cleared
+}
+
+// SessionActivity is what happened to one session during a report period
+type SessionActivity struct {
+	Branch        string                 `json:"branch,omitempty"`
+	Commits       []Commit               `json:"commits,omitempty"`
+	DisplayName   string                 `json:"display_name"`
+	Name          string                 `json:"session"`
+	StateSeconds  map[SessionState]int64 `json:"state_seconds"` // Time spent in each state during the period
+	Status        string                 `json:"status,omitempty"`
+	StatusChanges []StatusChange         `json:"status_changes,omitempty"`
+}
+
+// StateTime returns the time the session spent in state during the period
+func (a SessionActivity) StateTime(state SessionState) time.Duration {
+	return time.Duration(a.StateSeconds[state]) * time.Second
+}
+
+// ActivityReport summarizes the sessions worked on during a period, such as for standup notes
+type ActivityReport struct {
+	Sessions []SessionActivity `json:"sessions"` // Most working time first
+	Since    time.Time         `json:"since"`
+	Until    time.Time         `json:"until"`
+}
+
+// Markdown renders the report with a summary line and one section per session
+func (r ActivityReport) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Rocha report: %s to %s\n\n", r.Since.Local().Format("Mon 2006-01-02 15:04"), r.Until.Local().Format("Mon 2006-01-02 15:04"))
+
+	if len(r.Sessions) == 0 {
+		b.WriteString("No session activity.\n")
+		return b.String()
+	}
+
+	var commits, statusChanges int
+	totals := make(map[SessionState]time.Duration)
+	for _, session := range r.Sessions {
+		commits += len(session.Commits)
+		statusChanges += len(session.StatusChanges)
+		for _, state := range reportStates {
+			totals[state] += session.StateTime(state)
+		}
+	}
+	fmt.Fprintf(&b, "%s worked on, %s working, %s waiting, %s, %s\n",
+		countNoun(len(r.Sessions), "session"), FormatReportDuration(totals[StateWorking]), FormatReportDuration(totals[StateWaiting]),
+		countNoun(commits, "commit"), countNoun(statusChanges, "status change"))
+
+	for _, session := range r.Sessions {
+		fmt.Fprintf(&b, "\n## %s", session.DisplayName)
+		if session.Branch != "" {
+			fmt.Fprintf(&b, " (`%s`)", session.Branch)
+		}
+		b.WriteString("\n\n")
+
+		times := make([]string, 0, len(reportStates))
+		for _, state := range reportStates {
+			if d := session.StateTime(state); d >= time.Minute/2 { // Shown to the minute
+				times = append(times, fmt.Sprintf("%s %s", FormatReportDuration(d), state))
+			}
+		}
+		if len(times) > 0 {
+			fmt.Fprintf(&b, "- Time: %s\n", strings.Join(times, ", "))
+		}
+
+		if len(session.StatusChanges) > 0 {
+			changes := make([]string, 0, len(session.StatusChanges))
+			for _, change := range session.StatusChanges {
+				status := change.Status
+				if status == "" {
+					status = "cleared"
+				}
+				changes = append(changes, fmt.Sprintf("%s (%s)", status, change.At.Local().Format("15:04")))
+			}
+			fmt.Fprintf(&b, "- Status: %s\n", strings.Join(changes, " → "))
+		} else if session.Status != "" {
+			fmt.Fprintf(&b, "- Status: %s\n", session.Status)
+		}
+
+		if len(session.Commits) > 0 {
+			fmt.Fprintf(&b, "- Commits:\n")
+			for _, commit := range session.Commits {
+				fmt.Fprintf(&b, "  - `%s` %s\n", commit.Hash, commit.Subject)
+			}
+		}
+	}
+
+	return b.String()
+}
+
+// StateDurations sums the time spent in each state between since and until from
+// state change events, oldest first. initial is the state at since; when it is
+// unknown (empty), time is counted from the first event.
+func StateDurations(initial SessionState, events []Event, since, until time.Time) map[SessionState]time.Duration {
+	durations := make(map[SessionState]time.Duration)
+	state, from := initial, since
+	for _, event := range events {
+		if event.Type != EventStateChange || event.Timestamp.Before(since) {
+			continue
+		}
+		if event.Timestamp.After(until) {
+			break
+		}
+		if state != "" {
+			durations[state] += event.Timestamp.Sub(from)
+		}
+		state, from = event.State, event.Timestamp
+	}
+	if state != "" && until.After(from) {
+		durations[state] += until.Sub(from)
+	}
+	return durations
+}
+
+// ParseReportSince resolves when a report period starts: "today" and "yesterday" start
+// at midnight, a date (2006-01-02) at its midnight, and an age such as "12h" or "7d"
+// that long before now.
+func ParseReportSince(value string, now time.Time) (time.Time, error) {
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "today":
+		return midnight, nil
+	case "yesterday":
+		return midnight.AddDate(0, 0, -1), nil
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		if date.After(now) {
+			return time.Time{}, fmt.Errorf("report start %s is in the future: %w", value, ErrInvalidInput)
+		}
+		return date, nil
+	}
+
+	age, err := ParseAge(value)
+	if err != nil || age == 0 {
+		return time.Time{}, fmt.Errorf("invalid report start %q (use today, yesterday, a date such as 2026-01-31, or an age such as 12h or 7d): %w", value, ErrInvalidInput)
+	}
+	return now.Add(-age), nil
+}
+
+// countNoun formats a count with a noun, pluralized with "s" when it is not 1
+func countNoun(count int, noun string) string {
+	if count == 1 {
+		return "1 " + noun
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
+
+// FormatReportDuration formats a time spent in a state to the minute, such as "2h05m" or "35m"
+func FormatReportDuration(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d >= time.Hour {
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStateDurations(t *testing.T) {
+	since := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	until := since.Add(10 * time.Hour)
+	events := []Event{
+		{State: StateWaiting, Timestamp: since.Add(2 * time.Hour), Type: EventStateChange},
+		{Status: "review", Timestamp: since.Add(3 * time.Hour), Type: EventStatusChange},
+		{State: StateWorking, Timestamp: since.Add(4 * time.Hour), Type: EventStateChange},
+		{State: StateIdle, Timestamp: since.Add(7 * time.Hour), Type: EventStateChange},
+	}
+
+	t.Run("known initial state", func(t *testing.T) {
+		durations := StateDurations(StateWorking, events, since, until)
+		assert.Equal(t, map[SessionState]time.Duration{
+			StateIdle:    3 * time.Hour,
+			StateWaiting: 2 * time.Hour,
+			StateWorking: 5 * time.Hour,
+		}, durations)
+	})
+
+	t.Run("unknown initial state counts from the first event", func(t *testing.T) {
+		durations := StateDurations("", events, since, until)
+		assert.Equal(t, map[SessionState]time.Duration{
+			StateIdle:    3 * time.Hour,
+			StateWaiting: 2 * time.Hour,
+			StateWorking: 3 * time.Hour,
+		}, durations)
+	})
+}
+
+func TestParseReportSince(t *testing.T) {
+	now := time.Date(2026, 10, 16, 9, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Time
+	}{
+		{value: "today", want: time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{value: "yesterday", want: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{value: "2026-10-01", want: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{value: "12h", want: now.Add(-12 * time.Hour)},
+		{value: "7d", want: now.AddDate(0, 0, -7)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseReportSince(tt.value, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, value := range []string{"", "soon", "0h", "2026-12-01"} {
+		_, err := ParseReportSince(value, now)
+		assert.ErrorIs(t, err, ErrInvalidInput, value)
+	}
+}
+
+func TestActivityReport_Markdown(t *testing.T) {
+	since := time.Date(2026, 10, 15, 0, 0, 0, 0, time.Local)
+	report := ActivityReport{
+		Sessions: []SessionActivity{{
+			Branch:        "feature/cart",
+			Commits:       []Commit{{Hash: "abc1234", Subject: "Add cart endpoint"}},
+			DisplayName:   "api-cart",
+			Name:          "api-cart",
+			StateSeconds:  map[SessionState]int64{StateWorking: 7500, StateWaiting: 600},
+			StatusChanges: []StatusChange{{At: since.Add(14 * time.Hour), Status: "review"}},
+		}},
+		Since: since,
+		Until: since.Add(24 * time.Hour),
+	}
+
+	markdown := report.Markdown()
+	assert.Contains(t, markdown, "1 session worked on, 2h05m working, 10m waiting, 1 commit, 1 status change")
+	assert.Contains(t, markdown, "## api-cart (`feature/cart`)")
+	assert.Contains(t, markdown, "- Time: 2h05m working, 10m waiting\n")
+	assert.Contains(t, markdown, "- Status: review (14:00)\n")
+	assert.Contains(t, markdown, "  - `abc1234` Add cart endpoint\n")
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewActivityHeatmap(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 30, 0, 0, time.UTC)
+	events := []Event{
+		{Type: EventStateChange, State: StateWorking, Timestamp: time.Date(2026, 3, 10, 9, 5, 0, 0, time.UTC)},
+		{Type: EventStateChange, State: StateIdle, Timestamp: time.Date(2026, 3, 10, 9, 45, 0, 0, time.UTC)},
+		{Type: EventStateChange, State: StateWaiting, Timestamp: time.Date(2026, 3, 8, 23, 59, 0, 0, time.UTC)},
+		{Type: EventStateChange, State: StateWorking, Timestamp: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)}, // Outside range
+		{Type: EventError, Timestamp: time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC)},                            // Not a transition
+	}
+
+	heatmap := NewActivityHeatmap(events, now, 3)
+
+	require.Len(t, heatmap.Days, 3)
+	assert.Equal(t, time.Date(2026, 3, 8, 0, 0, 0, 0, time.UTC), heatmap.Days[0])
+	assert.Equal(t, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC), heatmap.Days[2])
+	assert.Equal(t, 1, heatmap.Counts[0][23])
+	assert.Equal(t, 2, heatmap.Counts[2][9])
+	assert.Equal(t, 2, heatmap.Max)
+	assert.Equal(t, 3, heatmap.Total)
+}
+
+func TestNewActivityHeatmap_UsesLocalHours(t *testing.T) {
+	loc := time.FixedZone("UTC+2", 2*60*60)
+	now := time.Date(2026, 3, 10, 12, 0, 0, 0, loc)
+	events := []Event{
+		{Type: EventStateChange, Timestamp: time.Date(2026, 3, 9, 23, 30, 0, 0, time.UTC)}, // 01:30 on the 10th locally
+	}
+
+	heatmap := NewActivityHeatmap(events, now, 1)
+
+	assert.Equal(t, 1, heatmap.Counts[0][1])
+}
+
+func TestActivityHeatmap_Level(t *testing.T) {
+	heatmap := ActivityHeatmap{Max: 8}
+
+	assert.Equal(t, 0, heatmap.Level(0, 5))
+	assert.Equal(t, 1, heatmap.Level(1, 5))
+	assert.Equal(t, 2, heatmap.Level(4, 5))
+	assert.Equal(t, 4, heatmap.Level(8, 5))
+	assert.Equal(t, 0, ActivityHeatmap{}.Level(3, 5))
+}
+
+func TestNewActivitySparkline(t *testing.T) {
+	since := time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)
+	until := since.Add(time.Hour)
+	at := func(minutes int) time.Time { return since.Add(time.Duration(minutes) * time.Minute) }
+
+	tests := []struct {
+		name    string
+		initial SessionState
+		events  []Event
+		want    ActivitySparkline
+	}{
+		{
+			name:    "working since before the window",
+			initial: StateWorking,
+			want:    ActivitySparkline{1, 1, 1, 1},
+		},
+		{
+			name:    "no events and idle",
+			initial: StateIdle,
+			want:    ActivitySparkline{0, 0, 0, 0},
+		},
+		{
+			name: "worked two thirds of the second period and all of the third",
+			events: []Event{
+				{Type: EventStateChange, State: StateWorking, Timestamp: at(20)},
+				{Type: EventStateChange, State: StateWaiting, Timestamp: at(45)},
+				{Type: EventHandoff, Timestamp: at(50)}, // Not a transition
+			},
+			want: ActivitySparkline{0, 2.0 / 3, 1, 0},
+		},
+		{
+			name:    "stopped working at a period boundary",
+			initial: StateWorking,
+			events:  []Event{{Type: EventStateChange, State: StateIdle, Timestamp: at(30)}},
+			want:    ActivitySparkline{1, 1, 0, 0},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sparkline := NewActivitySparkline(tt.initial, tt.events, since, until, 4)
+
+			require.Len(t, sparkline, 4)
+			assert.InDeltaSlice(t, tt.want, sparkline, 0.001)
+		})
+	}
+}
+
+func TestActivitySparkline_Busy(t *testing.T) {
+	assert.InDelta(t, 0.5, ActivitySparkline{1, 0, 0.5, 0.5}.Busy(), 0.001)
+	assert.Zero(t, ActivitySparkline(nil).Busy())
+}
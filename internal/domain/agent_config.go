@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// AgentModelAliases lists the model aliases offered for sessions in display order.
+// Any other model name the agent CLI accepts (e.g. a full model ID) can be used too.
+var AgentModelAliases = []string{"opus", "sonnet", "haiku"}
+
+// modelDateSuffix matches the release date ending full model IDs, such as -20250805
+var modelDateSuffix = regexp.MustCompile(`-\d{8}$`)
+
+// ShortModelName shortens a model ID for display, such as claude-opus-4-1-20250805 to opus-4-1
+func ShortModelName(model string) string {
+	return modelDateSuffix.ReplaceAllString(strings.TrimPrefix(model, "claude-"), "")
+}
+
+// reservedAgentArgs are agent CLI flags rocha sets itself, so they cannot be passed as extra args
+var reservedAgentArgs = map[string]string{
+	"--allow-dangerously-skip-permissions": "use the allow-dangerously-skip-permissions setting",
+	"--model":                              "use the session model",
+	"--resume":                             "rocha resumes the conversation when restarting",
+	"--settings":                           "rocha passes its hooks with it",
+}
+
+// ValidateAgentModel checks a session model; empty keeps the agent's default model
+func ValidateAgentModel(model string) error {
+	if model == "" {
+		return nil
+	}
+	if strings.ContainsAny(model, " \t\n") || strings.HasPrefix(model, "-") {
+		return fmt.Errorf("invalid model %q (use an alias like %s or a model ID): %w", model, strings.Join(AgentModelAliases, ", "), ErrInvalidInput)
+	}
+	return nil
+}
+
+// ValidateAgentArgs checks the extra agent CLI args of a session, rejecting the flags rocha sets itself
+func ValidateAgentArgs(args []string) error {
+	for _, arg := range args {
+		flag, _, _ := strings.Cut(arg, "=")
+		if reason, reserved := reservedAgentArgs[flag]; reserved {
+			return fmt.Errorf("%s cannot be passed as an extra arg (%s): %w", flag, reason, ErrInvalidInput)
+		}
+	}
+	return nil
+}
+
+// ParseAgentArgs splits a line of agent CLI args the way a shell would, honoring single
+// and double quotes and backslash escapes, such as `--add-dir ../shared --append-system-prompt "Be brief"`
+func ParseAgentArgs(line string) ([]string, error) {
+	var args []string
+	var current strings.Builder
+	inArg := false
+	var quote rune
+
+	runes := []rune(line)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == '\'':
+			current.WriteRune(r)
+		case r == '\\' && i+1 < len(runes):
+			i++
+			current.WriteRune(runes[i])
+			inArg = true
+		case quote == '"':
+			current.WriteRune(r)
+		case r == '\'' || r == '"':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, current.String())
+				current.Reset()
+				inArg = false
+			}
+		default:
+			current.WriteRune(r)
+			inArg = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c quote in agent args: %w", quote, ErrInvalidInput)
+	}
+	if inArg {
+		args = append(args, current.String())
+	}
+	return args, nil
+}
+
+// FormatAgentArgs joins agent CLI args into a line ParseAgentArgs reads back,
+// single-quoting the args that need it
+func FormatAgentArgs(args []string) string {
+	formatted := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && !strings.ContainsAny(arg, " \t\n'\"\\") {
+			formatted[i] = arg
+			continue
+		}
+		formatted[i] = "'" + strings.ReplaceAll(arg, "'", `'\''`) + "'"
+	}
+	return strings.Join(formatted, " ")
+}
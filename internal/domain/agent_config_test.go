@@ -0,0 +1,81 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAgentArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		line string
+		want []string
+	}{
+		{name: "empty", line: "  ", want: nil},
+		{name: "plain words", line: "--add-dir  ../shared --verbose", want: []string{"--add-dir", "../shared", "--verbose"}},
+		{name: "double quotes", line: `--append-system-prompt "Be brief"`, want: []string{"--append-system-prompt", "Be brief"}},
+		{name: "single quotes keep backslashes", line: `'a\b' c`, want: []string{`a\b`, "c"}},
+		{name: "escaped space", line: `my\ dir`, want: []string{"my dir"}},
+		{name: "empty quoted arg", line: `--x ''`, want: []string{"--x", ""}},
+		{name: "quotes join a word", line: `--name="two words"`, want: []string{"--name=two words"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAgentArgs(tt.line)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseAgentArgs_UnterminatedQuote(t *testing.T) {
+	_, err := ParseAgentArgs(`--append-system-prompt "Be brief`)
+
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestFormatAgentArgs_RoundTrips(t *testing.T) {
+	args := []string{"--add-dir", "../my dir", "it's", "", `back\slash`}
+
+	line := FormatAgentArgs(args)
+	got, err := ParseAgentArgs(line)
+
+	require.NoError(t, err)
+	assert.Equal(t, args, got)
+	assert.Equal(t, `--add-dir '../my dir' 'it'\''s' '' 'back\slash'`, line)
+}
+
+func TestValidateAgentModel(t *testing.T) {
+	assert.NoError(t, ValidateAgentModel(""))
+	assert.NoError(t, ValidateAgentModel("sonnet"))
+	assert.NoError(t, ValidateAgentModel("claude-opus-4-1"))
+	assert.ErrorIs(t, ValidateAgentModel("two words"), ErrInvalidInput)
+	assert.ErrorIs(t, ValidateAgentModel("--model"), ErrInvalidInput)
+}
+
+func TestValidateAgentArgs(t *testing.T) {
+	assert.NoError(t, ValidateAgentArgs([]string{"--add-dir", "../shared"}))
+	assert.ErrorIs(t, ValidateAgentArgs([]string{"--model", "opus"}), ErrInvalidInput)
+	assert.ErrorIs(t, ValidateAgentArgs([]string{"--settings={}"}), ErrInvalidInput)
+}
+
+func TestShortModelName(t *testing.T) {
+	tests := []struct {
+		model string
+		want  string
+	}{
+		{model: "claude-opus-4-1-20250805", want: "opus-4-1"},
+		{model: "claude-sonnet-4-5", want: "sonnet-4-5"},
+		{model: "<synthetic>", want: "<synthetic>"},
+		{model: "unknown", want: "unknown"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.model, func(t *testing.T) {
+			assert.Equal(t, tt.want, ShortModelName(tt.model))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// AgentErrorKind identifies an error that stops the agent until the user acts
+type AgentErrorKind string
+
+// Agent error kinds
+const (
+	AgentErrorAuth      AgentErrorKind = "auth"       // Logged out, expired token, or invalid API key
+	AgentErrorRateLimit AgentErrorKind = "rate_limit" // Usage limit reached or requests rate limited
+)
+
+// AgentError is an error the agent printed that leaves the session stuck,
+// such as a usage limit: no hook fires, so the session would stay "working"
+type AgentError struct {
+	Kind AgentErrorKind
+	Line string // Output line the error was detected on
+}
+
+// agentErrorPatterns match agent output lines; the first matching kind wins
+var agentErrorPatterns = []struct {
+	kind    AgentErrorKind
+	pattern *regexp.Regexp
+}{
+	{kind: AgentErrorRateLimit, pattern: regexp.MustCompile(`(?i)usage limit reached|limit will reset|rate_limit_error|rate limit(ed)? (exceeded|reached)|API Error: 429`)},
+	{kind: AgentErrorAuth, pattern: regexp.MustCompile(`(?i)authentication_error|invalid api key|oauth token (has )?expired|please run /login|API Error: 401`)},
+}
+
+// DetectAgentError returns the last usage limit or authentication error in agent output,
+// or nil when there is none
+func DetectAgentError(output string) *AgentError {
+	lines := strings.Split(output, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		line := strings.TrimSpace(lines[i])
+		for _, p := range agentErrorPatterns {
+			if p.pattern.MatchString(line) {
+				return &AgentError{Kind: p.kind, Line: line}
+			}
+		}
+	}
+	return nil
+}
+
+// Label returns the short text shown as the session badge
+func (e *AgentError) Label() string {
+	switch e.Kind {
+	case AgentErrorAuth:
+		return "auth error"
+	case AgentErrorRateLimit:
+		return "rate limited"
+	default:
+		return string(e.Kind)
+	}
+}
+
+// Guidance tells the user how to get the session going again
+func (e *AgentError) Guidance() string {
+	switch e.Kind {
+	case AgentErrorAuth:
+		return "Attach and run /login (or fix ANTHROPIC_API_KEY), then ask the agent to continue"
+	case AgentErrorRateLimit:
+		return "Wait until the limit resets, then ask the agent to continue (a scheduled prompt can do it)"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectAgentError(t *testing.T) {
+	tests := []struct {
+		name   string
+		output string
+		want   *AgentError
+	}{
+		{
+			name:   "usage limit",
+			output: "● Working on it\n  ⎿  Claude usage limit reached. Your limit will reset at 3pm (Europe/Lisbon).\n\n> ",
+			want:   &AgentError{Kind: AgentErrorRateLimit, Line: "⎿  Claude usage limit reached. Your limit will reset at 3pm (Europe/Lisbon)."},
+		},
+		{
+			name:   "rate limited API error",
+			output: `API Error: 429 {"type":"error","error":{"type":"rate_limit_error"}}`,
+			want:   &AgentError{Kind: AgentErrorRateLimit, Line: `API Error: 429 {"type":"error","error":{"type":"rate_limit_error"}}`},
+		},
+		{
+			name:   "expired login",
+			output: "API Error: 401 OAuth token has expired. Please run /login",
+			want:   &AgentError{Kind: AgentErrorAuth, Line: "API Error: 401 OAuth token has expired. Please run /login"},
+		},
+		{
+			name:   "invalid API key",
+			output: "Invalid API key · Please run /login",
+			want:   &AgentError{Kind: AgentErrorAuth, Line: "Invalid API key · Please run /login"},
+		},
+		{
+			name:   "latest error wins",
+			output: "Claude usage limit reached.\nInvalid API key · Please run /login\n",
+			want:   &AgentError{Kind: AgentErrorAuth, Line: "Invalid API key · Please run /login"},
+		},
+		{
+			name:   "no error",
+			output: "● Reading the rate limiter middleware\n> ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, DetectAgentError(tt.output))
+		})
+	}
+}
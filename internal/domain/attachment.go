@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// agentImageTypes are the image types Claude can look at when given their path
+var agentImageTypes = []string{"image/gif", "image/jpeg", "image/png", "image/webp"}
+
+// SessionAttachment is a file kept with a session, such as a screenshot of a bug or a design mockup.
+// Only its path and metadata are stored; the file itself stays where it is.
+type SessionAttachment struct {
+	AddedAt     time.Time
+	MediaType   string // MIME type detected when the file was attached, e.g. image/png
+	Name        string // Base name of the file
+	Path        string // Absolute path
+	SessionName string
+	Size        int64 // Bytes when the file was attached
+}
+
+// IsImage reports whether the attachment is an image the agent can look at
+func (a SessionAttachment) IsImage() bool {
+	return slices.Contains(agentImageTypes, a.MediaType)
+}
+
+// IsAgentReadable reports whether the agent can read the attachment when given its path:
+// supported images, PDFs, and text files
+func (a SessionAttachment) IsAgentReadable() bool {
+	return a.IsImage() || a.MediaType == "application/pdf" || strings.HasPrefix(a.MediaType, "text/")
+}
+
+// AttachmentPrompt asks a session's agent to look at attached files.
+// Paths are quoted so the prompt stays on one line and paths with spaces stay whole.
+func AttachmentPrompt(attachments []SessionAttachment) string {
+	paths := make([]string, 0, len(attachments))
+	for _, attachment := range attachments {
+		paths = append(paths, fmt.Sprintf("%q", attachment.Path))
+	}
+	return "Take a look at these files attached to this session: " + strings.Join(paths, ", ")
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionAttachment_IsAgentReadable(t *testing.T) {
+	tests := []struct {
+		mediaType string
+		image     bool
+		readable  bool
+	}{
+		{mediaType: "image/png", image: true, readable: true},
+		{mediaType: "image/webp", image: true, readable: true},
+		{mediaType: "image/svg+xml", image: false, readable: false},
+		{mediaType: "application/pdf", image: false, readable: true},
+		{mediaType: "text/markdown", image: false, readable: true},
+		{mediaType: "application/octet-stream", image: false, readable: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.mediaType, func(t *testing.T) {
+			attachment := SessionAttachment{MediaType: tt.mediaType}
+			assert.Equal(t, tt.image, attachment.IsImage())
+			assert.Equal(t, tt.readable, attachment.IsAgentReadable())
+		})
+	}
+}
+
+func TestAttachmentPrompt(t *testing.T) {
+	prompt := AttachmentPrompt([]SessionAttachment{
+		{Path: "/tmp/bug.png"},
+		{Path: "/home/me/Design Mockups/login.pdf"},
+	})
+
+	assert.Equal(t, `Take a look at these files attached to this session: "/tmp/bug.png", "/home/me/Design Mockups/login.pdf"`, prompt)
+}
@@ -0,0 +1,37 @@
+package domain
+
+import "strings"
+
+// Limits of the badges a badge command adds to one session, so a noisy script cannot
+// push the built-in indicators off the row
+const (
+	MaxBadgeLength      = 24 // Characters; longer text is cut with an ellipsis
+	MaxBadgesPerSession = 3
+)
+
+// Badge is a short label a badge command adds to a session in the TUI list,
+// such as "tests: red" or "deploy: pending"
+type Badge struct {
+	Color string // ANSI color number or hex color (empty uses the default text color)
+	Text  string
+}
+
+// LimitBadges drops badges without text and keeps the first MaxBadgesPerSession,
+// cutting text longer than MaxBadgeLength
+func LimitBadges(badges []Badge) []Badge {
+	var limited []Badge
+	for _, badge := range badges {
+		badge.Text = strings.Join(strings.Fields(badge.Text), " ")
+		if badge.Text == "" {
+			continue
+		}
+		if runes := []rune(badge.Text); len(runes) > MaxBadgeLength {
+			badge.Text = string(runes[:MaxBadgeLength-1]) + "…"
+		}
+		limited = append(limited, badge)
+		if len(limited) == MaxBadgesPerSession {
+			break
+		}
+	}
+	return limited
+}
@@ -0,0 +1,32 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitBadges(t *testing.T) {
+	tests := []struct {
+		name   string
+		badges []Badge
+		want   []Badge
+	}{
+		{name: "none", badges: nil, want: nil},
+		{name: "kept as is", badges: []Badge{{Color: "1", Text: "tests: red"}}, want: []Badge{{Color: "1", Text: "tests: red"}}},
+		{name: "empty text dropped", badges: []Badge{{Text: "  "}, {Text: "deploy: pending"}}, want: []Badge{{Text: "deploy: pending"}}},
+		{name: "whitespace collapsed", badges: []Badge{{Text: "tests:\n red"}}, want: []Badge{{Text: "tests: red"}}},
+		{name: "long text cut", badges: []Badge{{Text: "coverage dropped by twelve percent"}}, want: []Badge{{Text: "coverage dropped by twe…"}}},
+		{
+			name:   "at most three",
+			badges: []Badge{{Text: "a"}, {Text: "b"}, {Text: "c"}, {Text: "d"}},
+			want:   []Badge{{Text: "a"}, {Text: "b"}, {Text: "c"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, LimitBadges(tt.badges))
+		})
+	}
+}
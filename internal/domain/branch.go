@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// Branch is a branch a session worktree can check out
+type Branch struct {
+	Name      string    // Local branch name; for a branch only on a remote, the name it is checked out as
+	Remote    string    // Remote of a branch that has no local branch yet ("" = local)
+	UpdatedAt time.Time // Last commit
+	Worktree  string    // Worktree the branch is checked out in ("" = none)
+}
+
+// DisplayName returns the branch name, prefixed with its remote for remote-only branches
+func (b Branch) DisplayName() string {
+	if b.Remote != "" {
+		return b.Remote + "/" + b.Name
+	}
+	return b.Name
+}
+
+// BranchSwitchPrompt tells a session's agent that its worktree now has another branch checked out
+func BranchSwitchPrompt(from, to string) string {
+	return fmt.Sprintf("The branch checked out in this directory was switched from %s to %s, so files may have changed. "+
+		"Re-read any file before relying on what you saw earlier.", from, to)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import "time"
+
+// CheckState is the outcome of a run of the repository checks
+type CheckState string
+
+// Check states
+const (
+	CheckStateFailed  CheckState = "failed"
+	CheckStatePassed  CheckState = "passed"
+	CheckStateRunning CheckState = "running"
+)
+
+// CheckRun is a run of the checks command of a repository (such as make test) in a session's
+// working directory
+type CheckRun struct {
+	Command    string
+	ExitCode   int        // Exit code of the command, once finished
+	FinishedAt *time.Time // nil while the checks run
+	OutputPath string     // File the output of the command is captured to
+	StartedAt  time.Time
+}
+
+// State returns whether the checks still run, passed, or failed
+func (r CheckRun) State() CheckState {
+	switch {
+	case r.FinishedAt == nil:
+		return CheckStateRunning
+	case r.ExitCode == 0:
+		return CheckStatePassed
+	default:
+		return CheckStateFailed
+	}
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CheckpointTarget selects where the checkpoints of a session are saved
+type CheckpointTarget string
+
+// Checkpoint targets
+const (
+	CheckpointTargetBranch CheckpointTarget = "branch" // Commits on the checkpoint branch of the session, untracked files included
+	CheckpointTargetStash  CheckpointTarget = "stash"  // Stash entries, tracked files only
+)
+
+// CheckpointBranchPrefix starts the name of the branch checkpoints are committed to
+const CheckpointBranchPrefix = "rocha/checkpoints/"
+
+// MinCheckpointInterval is the shortest interval between checkpoints
+const MinCheckpointInterval = time.Minute
+
+// CheckpointPolicy saves the worktree changes of a session on a schedule, so the work of the
+// agent survives an accidental worktree removal. The checkout itself is never touched.
+type CheckpointPolicy struct {
+	Interval time.Duration // Time between checkpoints; zero checkpoints each time the agent goes idle
+	LastAt   *time.Time    // Last checkpoint attempt, nil before the first
+	Target   CheckpointTarget
+}
+
+// ParseCheckpointPolicy parses when to checkpoint ("idle", or an interval such as 15m) and the target
+// (branch when empty)
+func ParseCheckpointPolicy(every, target string) (*CheckpointPolicy, error) {
+	policy := &CheckpointPolicy{Target: CheckpointTarget(strings.ToLower(strings.TrimSpace(target)))}
+	switch policy.Target {
+	case "":
+		policy.Target = CheckpointTargetBranch
+	case CheckpointTargetBranch, CheckpointTargetStash:
+	default:
+		return nil, fmt.Errorf("%w: unknown checkpoint target %q (use: branch, stash)", ErrInvalidInput, target)
+	}
+
+	every = strings.ToLower(strings.TrimSpace(every))
+	if every == "idle" {
+		return policy, nil
+	}
+	interval, err := time.ParseDuration(every)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid checkpoint schedule %q (use idle, or an interval such as 15m)", ErrInvalidInput, every)
+	}
+	if interval < MinCheckpointInterval {
+		return nil, fmt.Errorf("%w: checkpoint interval must be at least %s", ErrInvalidInput, MinCheckpointInterval)
+	}
+	policy.Interval = interval
+	return policy, nil
+}
+
+// Due reports whether a session with this policy needs a checkpoint at now. With an interval,
+// that is once the interval passed since the last one; otherwise it is when the agent went idle
+// after the last one (lastUpdated is when the session state last changed).
+func (p CheckpointPolicy) Due(state SessionState, lastUpdated, now time.Time) bool {
+	if p.Interval > 0 {
+		return p.LastAt == nil || now.Sub(*p.LastAt) >= p.Interval
+	}
+	return state == StateIdle && (p.LastAt == nil || lastUpdated.After(*p.LastAt))
+}
+
+// String describes the policy, such as "every 15m to branch" or "on idle to stash"
+func (p CheckpointPolicy) String() string {
+	if p.Interval > 0 {
+		return fmt.Sprintf("every %s to %s", FormatTimerRemaining(p.Interval), p.Target)
+	}
+	return fmt.Sprintf("on idle to %s", p.Target)
+}
+
+// CheckpointBranchName returns the branch the checkpoints of a session are committed to
+func CheckpointBranchName(sessionName string) string {
+	return CheckpointBranchPrefix + sessionName
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCheckpointPolicy(t *testing.T) {
+	tests := []struct {
+		name     string
+		every    string
+		target   string
+		expected CheckpointPolicy
+		wantErr  bool
+	}{
+		{name: "idle defaults to branch", every: "idle", expected: CheckpointPolicy{Target: CheckpointTargetBranch}},
+		{name: "interval to stash", every: "15m", target: "Stash", expected: CheckpointPolicy{Interval: 15 * time.Minute, Target: CheckpointTargetStash}},
+		{name: "interval too short", every: "30s", wantErr: true},
+		{name: "invalid schedule", every: "often", wantErr: true},
+		{name: "unknown target", every: "idle", target: "tag", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := ParseCheckpointPolicy(tt.every, tt.target)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, *policy)
+		})
+	}
+}
+
+func TestCheckpointPolicy_Due(t *testing.T) {
+	now := time.Date(2026, 5, 4, 12, 0, 0, 0, time.UTC)
+	ago := func(d time.Duration) *time.Time {
+		at := now.Add(-d)
+		return &at
+	}
+
+	tests := []struct {
+		name        string
+		policy      CheckpointPolicy
+		state       SessionState
+		lastUpdated time.Time
+		expected    bool
+	}{
+		{name: "interval never taken", policy: CheckpointPolicy{Interval: 10 * time.Minute}, state: StateWorking, expected: true},
+		{name: "interval not passed", policy: CheckpointPolicy{Interval: 10 * time.Minute, LastAt: ago(5 * time.Minute)}, state: StateWorking, expected: false},
+		{name: "interval passed", policy: CheckpointPolicy{Interval: 10 * time.Minute, LastAt: ago(10 * time.Minute)}, state: StateWorking, expected: true},
+		{name: "idle never taken", policy: CheckpointPolicy{}, state: StateIdle, lastUpdated: now.Add(-time.Hour), expected: true},
+		{name: "idle again since last", policy: CheckpointPolicy{LastAt: ago(time.Hour)}, state: StateIdle, lastUpdated: now.Add(-time.Minute), expected: true},
+		{name: "still idle since last", policy: CheckpointPolicy{LastAt: ago(time.Minute)}, state: StateIdle, lastUpdated: now.Add(-time.Hour), expected: false},
+		{name: "working", policy: CheckpointPolicy{}, state: StateWorking, lastUpdated: now, expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.policy.Due(tt.state, tt.lastUpdated, now))
+		})
+	}
+}
+
+func TestCheckpointPolicy_String(t *testing.T) {
+	assert.Equal(t, "every 15m to branch", CheckpointPolicy{Interval: 15 * time.Minute, Target: CheckpointTargetBranch}.String())
+	assert.Equal(t, "on idle to stash", CheckpointPolicy{Target: CheckpointTargetStash}.String())
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CIState is the outcome of a CI run
+type CIState string
+
+// CI states
+const (
+	CIStateFailure CIState = "failure"
+	CIStatePending CIState = "pending" // Queued or running
+	CIStateSuccess CIState = "success"
+)
+
+// ciStateAliases maps the words CI systems use for their outcomes to a CIState
+var ciStateAliases = map[string]CIState{
+	"cancelled":   CIStateFailure,
+	"canceled":    CIStateFailure,
+	"error":       CIStateFailure,
+	"failed":      CIStateFailure,
+	"failure":     CIStateFailure,
+	"in_progress": CIStatePending,
+	"pending":     CIStatePending,
+	"queued":      CIStatePending,
+	"running":     CIStatePending,
+	"passed":      CIStateSuccess,
+	"success":     CIStateSuccess,
+}
+
+// ParseCIState parses a CI outcome such as "success", "failed", or "running"
+func ParseCIState(value string) (CIState, error) {
+	state, ok := ciStateAliases[strings.ToLower(strings.TrimSpace(value))]
+	if !ok {
+		return "", fmt.Errorf("%w: unknown CI state %q (use success, failure, or pending)", ErrInvalidInput, value)
+	}
+	return state, nil
+}
+
+// CIStatus is the last CI result reported for the branch of a session
+type CIStatus struct {
+	Commit     string // Commit the run tested, empty when not reported
+	Notified   bool   // The failure was already announced
+	ReportedAt time.Time
+	State      CIState
+	URL        string // Link to the run, empty when not reported
+}
+
+// CIReport is a CI result for a branch, as sent by a CI system
+type CIReport struct {
+	Branch string
+	Commit string
+	Repo   string // owner/repo, empty to match the branch in any repository
+	State  CIState
+	URL    string
+}
+
+// Matches reports whether the report is about the branch of the session
+func (r CIReport) Matches(session Session) bool {
+	if session.BranchName == "" || session.BranchName != r.Branch {
+		return false
+	}
+	return r.Repo == "" || strings.EqualFold(r.Repo, session.RepoInfo)
+}
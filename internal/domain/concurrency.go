@@ -0,0 +1,46 @@
+package domain
+
+// ConcurrencyLimit caps how many sessions may be working at the same time (0 = unlimited)
+type ConcurrencyLimit struct {
+	Global  int // Across all sessions
+	PerRepo int // Across sessions working on the same repository
+}
+
+// IsUnlimited returns true if no limit is configured
+func (l ConcurrencyLimit) IsUnlimited() bool {
+	return l.Global <= 0 && l.PerRepo <= 0
+}
+
+// Allows reports whether target may be given new work while the other sessions keep working.
+// The target itself never counts towards the limit.
+func (l ConcurrencyLimit) Allows(sessions []Session, target Session) bool {
+	repoKey := target.RepoKey()
+
+	var global, perRepo int
+	for _, s := range sessions {
+		if s.Name == target.Name || s.State != StateWorking {
+			continue
+		}
+		global++
+		if repoKey != "" && s.RepoKey() == repoKey {
+			perRepo++
+		}
+	}
+
+	if l.Global > 0 && global >= l.Global {
+		return false
+	}
+	if l.PerRepo > 0 && repoKey != "" && perRepo >= l.PerRepo {
+		return false
+	}
+	return true
+}
+
+// RepoKey identifies the repository a session works on, for per-repo limits:
+// the owner/repo info, or the repository path for repos without a remote
+func (s *Session) RepoKey() string {
+	if s.RepoInfo != "" {
+		return s.RepoInfo
+	}
+	return s.RepoPath
+}
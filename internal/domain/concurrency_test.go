@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConcurrencyLimit_Allows(t *testing.T) {
+	sessions := []Session{
+		{Name: "a1", RepoInfo: "owner/a", State: StateWorking},
+		{Name: "a2", RepoInfo: "owner/a", State: StateIdle},
+		{Name: "b1", RepoInfo: "owner/b", State: StateWorking},
+		{Name: "local", RepoPath: "/tmp/local", State: StateWorking},
+	}
+
+	tests := []struct {
+		name   string
+		limit  ConcurrencyLimit
+		target Session
+		want   bool
+	}{
+		{name: "unlimited", limit: ConcurrencyLimit{}, target: sessions[1], want: true},
+		{name: "per repo reached", limit: ConcurrencyLimit{PerRepo: 1}, target: sessions[1], want: false},
+		{name: "per repo other repo", limit: ConcurrencyLimit{PerRepo: 1}, target: Session{Name: "c1", RepoInfo: "owner/c"}, want: true},
+		{name: "per repo uses path without remote", limit: ConcurrencyLimit{PerRepo: 1}, target: Session{Name: "local2", RepoPath: "/tmp/local"}, want: false},
+		{name: "target does not count itself", limit: ConcurrencyLimit{PerRepo: 1}, target: sessions[0], want: true},
+		{name: "global reached", limit: ConcurrencyLimit{Global: 3}, target: Session{Name: "c1", RepoInfo: "owner/c"}, want: false},
+		{name: "global not reached", limit: ConcurrencyLimit{Global: 4}, target: Session{Name: "c1", RepoInfo: "owner/c"}, want: true},
+		{name: "no repo ignores per repo", limit: ConcurrencyLimit{PerRepo: 1}, target: Session{Name: "bare"}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.limit.Allows(sessions, tt.target))
+		})
+	}
+}
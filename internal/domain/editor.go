@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// EditorIntegration selects how a session is opened in an editor
+type EditorIntegration string
+
+// Editor integrations
+const (
+	EditorIntegrationDefault   EditorIntegration = ""          // Generic launch: --editor, $ROCHA_EDITOR, $VISUAL, $EDITOR, platform default
+	EditorIntegrationJetBrains EditorIntegration = "jetbrains" // JetBrains IDE launcher, or a Gateway link over SSH
+	EditorIntegrationVSCode    EditorIntegration = "vscode"    // VS Code reusing the current window (works from Remote-SSH terminals)
+	EditorIntegrationZed       EditorIntegration = "zed"       // Zed
+)
+
+// EditorIntegrations lists the selectable integrations in display order
+var EditorIntegrations = []EditorIntegration{
+	EditorIntegrationDefault,
+	EditorIntegrationVSCode,
+	EditorIntegrationJetBrains,
+	EditorIntegrationZed,
+}
+
+// String returns the integration name, "default" for the generic launch
+func (e EditorIntegration) String() string {
+	if e == EditorIntegrationDefault {
+		return "default"
+	}
+	return string(e)
+}
+
+// ParseEditorIntegration parses an integration name; empty and "default" select the generic launch
+func ParseEditorIntegration(value string) (EditorIntegration, error) {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "default" {
+		return EditorIntegrationDefault, nil
+	}
+
+	for _, integration := range EditorIntegrations {
+		if string(integration) == normalized {
+			return integration, nil
+		}
+	}
+
+	names := make([]string, len(EditorIntegrations))
+	for i, integration := range EditorIntegrations {
+		names[i] = integration.String()
+	}
+	return EditorIntegrationDefault, fmt.Errorf("%w: unknown editor integration %q (use: %s)",
+		ErrInvalidInput, value, strings.Join(names, ", "))
+}
+
+// EditorTarget is what to open: a directory (or file) and optionally files inside it
+type EditorTarget struct {
+	Files       []string // Absolute paths opened as tabs next to Path (e.g., files changed in the diff)
+	Integration EditorIntegration
+	Path        string
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseEditorIntegration(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    EditorIntegration
+		wantErr bool
+	}{
+		{name: "empty", value: "", want: EditorIntegrationDefault},
+		{name: "default", value: "default", want: EditorIntegrationDefault},
+		{name: "vscode", value: "vscode", want: EditorIntegrationVSCode},
+		{name: "jetbrains", value: "jetbrains", want: EditorIntegrationJetBrains},
+		{name: "zed mixed case with spaces", value: " Zed ", want: EditorIntegrationZed},
+		{name: "unknown", value: "emacs", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEditorIntegration(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEditorIntegrationString(t *testing.T) {
+	assert.Equal(t, "default", EditorIntegrationDefault.String())
+	assert.Equal(t, "vscode", EditorIntegrationVSCode.String())
+}
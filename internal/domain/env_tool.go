@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// EnvTool is a tool that sets up the environment of a checkout from a file in it,
+// such as direnv with .envrc or mise with mise.toml
+type EnvTool string
+
+// Environment tools, in the order they are set up and loaded
+const (
+	EnvToolDirenv EnvTool = "direnv"
+	EnvToolMise   EnvTool = "mise"
+)
+
+// EnvTools lists the supported environment tools
+var EnvTools = []EnvTool{EnvToolDirenv, EnvToolMise}
+
+// Files returns the files, relative to the checkout, that mark it as using the tool
+func (t EnvTool) Files() []string {
+	switch t {
+	case EnvToolDirenv:
+		return []string{".envrc"}
+	case EnvToolMise:
+		return []string{"mise.toml", ".mise.toml", "mise.local.toml", ".config/mise.toml", ".tool-versions"}
+	default:
+		return nil
+	}
+}
+
+// SetupCommands returns the commands that trust the tool's config in a new worktree
+// and install what it needs; tools refuse to load config from a path they were not told to trust
+func (t EnvTool) SetupCommands() []string {
+	switch t {
+	case EnvToolDirenv:
+		return []string{"direnv allow"}
+	case EnvToolMise:
+		return []string{"mise trust --yes", "mise install --yes"}
+	default:
+		return nil
+	}
+}
+
+// ExportCommand returns the command printing the environment the tool sets up as JSON
+func (t EnvTool) ExportCommand() string {
+	switch t {
+	case EnvToolDirenv:
+		return "direnv export json"
+	case EnvToolMise:
+		return "mise env --json"
+	default:
+		return ""
+	}
+}
+
+// EnvChanges maps variable names to their new values; nil unsets the variable
+type EnvChanges map[string]*string
+
+// ParseEnvExport parses the JSON an environment tool prints, such as {"PATH":"...","OLD":null}.
+// Empty output (nothing to change) gives no changes.
+func ParseEnvExport(data []byte) (EnvChanges, error) {
+	if strings.TrimSpace(string(data)) == "" {
+		return EnvChanges{}, nil
+	}
+	var changes EnvChanges
+	if err := json.Unmarshal(data, &changes); err != nil {
+		return nil, fmt.Errorf("%w: invalid environment export: %v", ErrInvalidInput, err)
+	}
+	return changes, nil
+}
+
+// ApplyEnv returns env, a list of KEY=value entries, with the changes applied
+func ApplyEnv(env []string, changes EnvChanges) []string {
+	result := make([]string, 0, len(env)+len(changes))
+	for _, entry := range env {
+		name, _, _ := strings.Cut(entry, "=")
+		if _, changed := changes[name]; !changed {
+			result = append(result, entry)
+		}
+	}
+
+	// Sorted so the resulting environment does not depend on map order
+	names := make([]string, 0, len(changes))
+	for name := range changes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if value := changes[name]; value != nil {
+			result = append(result, name+"="+*value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvExport(t *testing.T) {
+	changes, err := ParseEnvExport([]byte(`{"GOFLAGS":"-mod=mod","DIRENV_DIFF":null}`))
+
+	require.NoError(t, err)
+	require.Contains(t, changes, "GOFLAGS")
+	assert.Equal(t, "-mod=mod", *changes["GOFLAGS"])
+	assert.Contains(t, changes, "DIRENV_DIFF")
+	assert.Nil(t, changes["DIRENV_DIFF"])
+
+	changes, err = ParseEnvExport([]byte("\n"))
+	require.NoError(t, err)
+	assert.Empty(t, changes, "no output means nothing to change")
+
+	_, err = ParseEnvExport([]byte("direnv: error .envrc is blocked"))
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestApplyEnv(t *testing.T) {
+	path := "/wt/bin:/usr/bin"
+	node := "20"
+	env := []string{"HOME=/home/me", "PATH=/usr/bin", "OLD=1"}
+
+	got := ApplyEnv(env, EnvChanges{"NODE_VERSION": &node, "OLD": nil, "PATH": &path})
+
+	assert.Equal(t, []string{"HOME=/home/me", "NODE_VERSION=20", "PATH=/wt/bin:/usr/bin"}, got)
+}
@@ -3,6 +3,21 @@ package domain
 import "errors"
 
 var (
-	ErrSessionExists   = errors.New("session already exists")
-	ErrSessionNotFound = errors.New("session not found")
+	ErrAttachmentNotFound      = errors.New("attachment not found")
+	ErrConfirmationRequired    = errors.New("confirmation required")
+	ErrGuardrail               = errors.New("blocked by guardrail")
+	ErrInstanceRunning         = errors.New("another rocha instance is running")
+	ErrInvalidInput            = errors.New("invalid input")
+	ErrRemoteUnreachable       = errors.New("remote repository is not accessible")
+	ErrRepoBookmarkExists      = errors.New("repository bookmark already exists")
+	ErrRepoBookmarkNotFound    = errors.New("repository bookmark not found")
+	ErrScheduledPromptNotFound = errors.New("scheduled prompt not found")
+	ErrSessionExists           = errors.New("session already exists")
+	ErrSessionNotFound         = errors.New("session not found")
+	ErrShareNotFound           = errors.New("share not found")
+	ErrStashNotFound           = errors.New("stash not found")
+	ErrTranscriptNotFound      = errors.New("transcript not found")
+	ErrWorkspaceExists         = errors.New("workspace already exists")
+	ErrWorkspaceNotFound       = errors.New("workspace not found")
+	ErrWorktreeDirty           = errors.New("worktree has uncommitted changes")
 )
@@ -0,0 +1,43 @@
+package domain
+
+import "time"
+
+// EscalationPolicy escalates sessions left waiting for input longer than a threshold
+type EscalationPolicy struct {
+	After       time.Duration            // Threshold for sessions whose status has no threshold of its own (0 = never)
+	Bell        bool                     // Play the bell when a session escalates
+	StatusAfter map[string]time.Duration // Thresholds per implementation status
+	Webhook     bool                     // Send an escalation event to the webhook
+}
+
+// IsEnabled returns true if any threshold is configured
+func (p EscalationPolicy) IsEnabled() bool {
+	if p.After > 0 {
+		return true
+	}
+	for _, after := range p.StatusAfter {
+		if after > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Threshold returns how long a session with the given status may wait before escalating (0 = never)
+func (p EscalationPolicy) Threshold(status *string) time.Duration {
+	if status != nil {
+		if after, ok := p.StatusAfter[*status]; ok {
+			return after
+		}
+	}
+	return p.After
+}
+
+// IsEscalated reports whether s has been waiting for input longer than its threshold at now
+func (p EscalationPolicy) IsEscalated(s Session, now time.Time) bool {
+	if s.State != StateWaiting || s.LastUpdated.IsZero() {
+		return false
+	}
+	threshold := p.Threshold(s.Status)
+	return threshold > 0 && now.Sub(s.LastUpdated) >= threshold
+}
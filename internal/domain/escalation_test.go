@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEscalationPolicy_IsEscalated(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	review := "review"
+	done := "done"
+	policy := EscalationPolicy{
+		After:       15 * time.Minute,
+		StatusAfter: map[string]time.Duration{review: 5 * time.Minute, done: 0},
+	}
+
+	tests := []struct {
+		name    string
+		session Session
+		want    bool
+	}{
+		{name: "waiting past default threshold", session: Session{State: StateWaiting, LastUpdated: now.Add(-20 * time.Minute)}, want: true},
+		{name: "waiting under default threshold", session: Session{State: StateWaiting, LastUpdated: now.Add(-10 * time.Minute)}},
+		{name: "status threshold overrides default", session: Session{State: StateWaiting, Status: &review, LastUpdated: now.Add(-6 * time.Minute)}, want: true},
+		{name: "zero status threshold never escalates", session: Session{State: StateWaiting, Status: &done, LastUpdated: now.Add(-time.Hour)}},
+		{name: "idle sessions never escalate", session: Session{State: StateIdle, LastUpdated: now.Add(-time.Hour)}},
+		{name: "unknown update time never escalates", session: Session{State: StateWaiting}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, policy.IsEscalated(tt.session, now))
+		})
+	}
+}
+
+func TestEscalationPolicy_IsEnabled(t *testing.T) {
+	assert.False(t, EscalationPolicy{}.IsEnabled())
+	assert.False(t, EscalationPolicy{StatusAfter: map[string]time.Duration{"done": 0}}.IsEnabled())
+	assert.True(t, EscalationPolicy{After: time.Minute}.IsEnabled())
+	assert.True(t, EscalationPolicy{StatusAfter: map[string]time.Duration{"review": time.Minute}}.IsEnabled())
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// EventType identifies the kind of session event announced to integrations
+type EventType string
+
+const (
+	EventArchive      EventType = "archive"       // Session was archived
+	EventCIFailure    EventType = "ci_failure"    // CI failed on the branch of an idle session
+	EventError        EventType = "error"         // Rocha failed to process a session event
+	EventHandoff      EventType = "handoff"       // User noted where they left off when detaching
+	EventEscalation   EventType = "escalation"    // Session waited for input longer than its escalation threshold
+	EventRule         EventType = "rule"          // Session matched a workflow rule with the notify action
+	EventStateChange  EventType = "state_change"  // Session state changed (working, idle, waiting, exited)
+	EventStatusChange EventType = "status_change" // Implementation status changed (set by the user)
+	EventTimer        EventType = "timer"         // Session timer elapsed
+	EventTokenBudget  EventType = "token_budget"  // Session used more tokens than its budget
+)
+
+// Event describes something that happened to a session
+type Event struct {
+	Error       string       // Error message (only for EventError)
+	ID          uint         // Storage ID, increasing in the order events were stored (0 until stored)
+	Message     string       // Handoff note, timer label, token usage, rule name, or CI run URL (only for EventHandoff, EventTimer, EventTokenBudget, EventRule, and EventCIFailure)
+	SessionName string       // Session the event refers to
+	State       SessionState // New state (only for EventStateChange, EventEscalation, and EventRule)
+	Status      string       // New implementation status, empty when cleared (only for EventStatusChange, EventEscalation, and EventRule)
+	Timestamp   time.Time    // When the event happened
+	Type        EventType
+}
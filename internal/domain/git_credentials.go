@@ -0,0 +1,35 @@
+package domain
+
+// GitCredentials are how git authenticates to the remote of one repository, set up in the
+// clone rocha makes of it instead of the user's global git config
+type GitCredentials struct {
+	Helper     string // credential.helper, such as "store --file ~/.git-credentials-client" or "!gh auth git-credential"
+	SSHCommand string // core.sshCommand, such as "ssh -i ~/.ssh/client_ed25519 -o IdentitiesOnly=yes"
+}
+
+// IsZero reports whether the credentials set nothing
+func (c GitCredentials) IsZero() bool {
+	return c == GitCredentials{}
+}
+
+// Config returns the git config keys and values the credentials set; an empty helper
+// entry comes first so git does not also try the helpers of the global config
+func (c GitCredentials) Config() [][2]string {
+	var config [][2]string
+	if c.Helper != "" {
+		config = append(config, [2]string{"credential.helper", ""}, [2]string{"credential.helper", c.Helper})
+	}
+	if c.SSHCommand != "" {
+		config = append(config, [2]string{"core.sshCommand", c.SSHCommand})
+	}
+	return config
+}
+
+// Args returns the credentials as "-c key=value" arguments placed before a git command
+func (c GitCredentials) Args() []string {
+	var args []string
+	for _, entry := range c.Config() {
+		args = append(args, "-c", entry[0]+"="+entry[1])
+	}
+	return args
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GitIdentity is the author and committer identity, and optionally the signing key,
+// that commits made in a session worktree use instead of the user's global git config
+type GitIdentity struct {
+	Email      string
+	Name       string
+	SigningKey string // GPG key ID, or an SSH public key or key file (*.pub)
+}
+
+// IsZero reports whether the identity sets nothing
+func (g GitIdentity) IsZero() bool {
+	return g == GitIdentity{}
+}
+
+// Merge returns the identity with the fields set in override replacing its own,
+// such as a per-session override of a per-repository identity
+func (g GitIdentity) Merge(override GitIdentity) GitIdentity {
+	if override.Email != "" {
+		g.Email = override.Email
+	}
+	if override.Name != "" {
+		g.Name = override.Name
+	}
+	if override.SigningKey != "" {
+		g.SigningKey = override.SigningKey
+	}
+	return g
+}
+
+// Validate checks that the fields fit on one git config line and that the email looks like one
+func (g GitIdentity) Validate() error {
+	fields := []struct{ name, value string }{{"email", g.Email}, {"name", g.Name}, {"signing key", g.SigningKey}}
+	for _, field := range fields {
+		if strings.ContainsAny(field.value, "\n\r") || field.value != strings.TrimSpace(field.value) {
+			return fmt.Errorf("git identity %s %q must be one line without surrounding spaces: %w", field.name, field.value, ErrInvalidInput)
+		}
+	}
+	if g.Email != "" && !strings.Contains(g.Email, "@") {
+		return fmt.Errorf("git identity email %q is not an email address: %w", g.Email, ErrInvalidInput)
+	}
+	return nil
+}
+
+// SSHSigning reports whether the signing key is an SSH key, which git signs with
+// only when gpg.format is ssh
+func (g GitIdentity) SSHSigning() bool {
+	key := g.SigningKey
+	return strings.HasPrefix(key, "ssh-") || strings.HasPrefix(key, "key::") || strings.HasSuffix(key, ".pub")
+}
+
+// String describes the identity as git shows an author, "Name <email>"
+func (g GitIdentity) String() string {
+	switch {
+	case g.Name != "" && g.Email != "":
+		return fmt.Sprintf("%s <%s>", g.Name, g.Email)
+	case g.Email != "":
+		return "<" + g.Email + ">"
+	default:
+		return g.Name
+	}
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGitIdentityMerge(t *testing.T) {
+	repo := GitIdentity{Email: "me@client.com", Name: "Me", SigningKey: "ABC123"}
+
+	assert.Equal(t, repo, repo.Merge(GitIdentity{}))
+	assert.Equal(t,
+		GitIdentity{Email: "me@other.com", Name: "Me", SigningKey: "ABC123"},
+		repo.Merge(GitIdentity{Email: "me@other.com"}))
+	assert.Equal(t, GitIdentity{Name: "Me"}, GitIdentity{}.Merge(GitIdentity{Name: "Me"}))
+}
+
+func TestGitIdentityValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity GitIdentity
+		wantErr  bool
+	}{
+		{name: "empty", identity: GitIdentity{}},
+		{name: "full", identity: GitIdentity{Email: "me@client.com", Name: "Jane Doe", SigningKey: "~/.ssh/client.pub"}},
+		{name: "email without at", identity: GitIdentity{Email: "me"}, wantErr: true},
+		{name: "multi-line name", identity: GitIdentity{Name: "Jane\nDoe"}, wantErr: true},
+		{name: "padded key", identity: GitIdentity{SigningKey: " ABC123"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.identity.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGitIdentitySSHSigning(t *testing.T) {
+	assert.True(t, GitIdentity{SigningKey: "~/.ssh/client.pub"}.SSHSigning())
+	assert.True(t, GitIdentity{SigningKey: "ssh-ed25519 AAAAC3Nza me@client"}.SSHSigning())
+	assert.True(t, GitIdentity{SigningKey: "key::ssh-ed25519 AAAAC3Nza"}.SSHSigning())
+	assert.False(t, GitIdentity{SigningKey: "3AA5C34371567BD2"}.SSHSigning())
+}
+
+func TestGitIdentityString(t *testing.T) {
+	assert.Equal(t, "Jane Doe <jane@client.com>", GitIdentity{Email: "jane@client.com", Name: "Jane Doe"}.String())
+	assert.Equal(t, "<jane@client.com>", GitIdentity{Email: "jane@client.com"}.String())
+	assert.Equal(t, "Jane Doe", GitIdentity{Name: "Jane Doe"}.String())
+}
@@ -6,9 +6,14 @@ import "time"
 type GitStats struct {
 	Additions    int       // Lines added in working directory
 	Ahead        int       // Commits ahead of tracking branch
+	BaseAhead    int       // Commits ahead of the base branch
+	BaseBehind   int       // Commits behind the base branch (as of the last fetch)
+	BaseRef      string    // Remote base branch compared against (e.g. origin/main), empty if unknown
+	BaseSyncedAt time.Time // Commit time of the merge base: when the branch last caught up with its base
 	Behind       int       // Commits behind tracking branch
 	ChangedFiles int       // Number of changed files in working directory
 	Deletions    int       // Lines deleted in working directory
 	Error        error     // Error during fetching (if any)
 	FetchedAt    time.Time // When these stats were fetched
+	Stashes      int       // Stashes made on the branch
 }
@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// GuardrailsAllRepos is the guardrails key applying to every repository
+const GuardrailsAllRepos = "*"
+
+// Guardrails restrict where agent sessions of a repository may work, so agents never
+// commit straight to a shared branch or write under a directory they must not touch
+type Guardrails struct {
+	BlockedPaths      []string // Absolute directories sessions may not run in, nor under
+	ProtectedBranches []string // Branch patterns, such as main or release/*
+}
+
+// IsZero returns true if no guardrail is set
+func (g Guardrails) IsZero() bool {
+	return len(g.BlockedPaths) == 0 && len(g.ProtectedBranches) == 0
+}
+
+// Merge returns the guardrails of both g and other
+func (g Guardrails) Merge(other Guardrails) Guardrails {
+	return Guardrails{
+		BlockedPaths:      append(append([]string(nil), g.BlockedPaths...), other.BlockedPaths...),
+		ProtectedBranches: append(append([]string(nil), g.ProtectedBranches...), other.ProtectedBranches...),
+	}
+}
+
+// Validate checks that branch patterns are valid and blocked paths are absolute
+func (g Guardrails) Validate() error {
+	for _, pattern := range g.ProtectedBranches {
+		if _, err := path.Match(pattern, ""); err != nil || strings.TrimSpace(pattern) == "" {
+			return fmt.Errorf("%w: invalid protected branch pattern %q", ErrInvalidInput, pattern)
+		}
+	}
+	for _, dir := range g.BlockedPaths {
+		if !filepath.IsAbs(dir) {
+			return fmt.Errorf("%w: blocked path %q must be absolute", ErrInvalidInput, dir)
+		}
+	}
+	return nil
+}
+
+// CheckBranch fails with ErrGuardrail if branch matches a protected branch pattern
+func (g Guardrails) CheckBranch(branch string) error {
+	for _, pattern := range g.ProtectedBranches {
+		if matched, _ := path.Match(pattern, branch); matched {
+			return fmt.Errorf("%w: branch '%s' is protected (%s), agent sessions must work on another branch", ErrGuardrail, branch, pattern)
+		}
+	}
+	return nil
+}
+
+// CheckPath fails with ErrGuardrail if dir is a blocked path or lies under one
+func (g Guardrails) CheckPath(dir string) error {
+	dir = filepath.Clean(dir)
+	for _, blocked := range g.BlockedPaths {
+		blocked = filepath.Clean(blocked)
+		if dir == blocked || strings.HasPrefix(dir, blocked+string(filepath.Separator)) {
+			return fmt.Errorf("%w: %s is under blocked path %s", ErrGuardrail, dir, blocked)
+		}
+	}
+	return nil
+}
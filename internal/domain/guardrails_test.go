@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardrails_CheckBranch(t *testing.T) {
+	guardrails := Guardrails{ProtectedBranches: []string{"main", "release/*"}}
+
+	tests := []struct {
+		branch  string
+		blocked bool
+	}{
+		{branch: "main", blocked: true},
+		{branch: "release/1.2", blocked: true},
+		{branch: "feature/main", blocked: false},
+		{branch: "release/1.2/hotfix", blocked: false},
+		{branch: "maintenance", blocked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			err := guardrails.CheckBranch(tt.branch)
+
+			if tt.blocked {
+				assert.ErrorIs(t, err, ErrGuardrail)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGuardrails_CheckPath(t *testing.T) {
+	guardrails := Guardrails{BlockedPaths: []string{"/src/app", "/etc/"}}
+
+	tests := []struct {
+		dir     string
+		blocked bool
+	}{
+		{dir: "/src/app", blocked: true},
+		{dir: "/src/app/.worktrees/login", blocked: true},
+		{dir: "/etc", blocked: true},
+		{dir: "/src/app-login", blocked: false},
+		{dir: "/src", blocked: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			err := guardrails.CheckPath(tt.dir)
+
+			if tt.blocked {
+				assert.ErrorIs(t, err, ErrGuardrail)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestGuardrails_Validate(t *testing.T) {
+	assert.NoError(t, Guardrails{BlockedPaths: []string{"/src"}, ProtectedBranches: []string{"release/*"}}.Validate())
+	assert.ErrorIs(t, Guardrails{ProtectedBranches: []string{"release/["}}.Validate(), ErrInvalidInput)
+	assert.ErrorIs(t, Guardrails{BlockedPaths: []string{"src"}}.Validate(), ErrInvalidInput)
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "time"
+
+// HookJournalEntry is a hook event waiting in the hook journal to be applied to the state database
+type HookJournalEntry struct {
+	Attempts    int       `json:"attempts,omitempty"` // Failed attempts to apply the event
+	EventType   string    `json:"event"`
+	ExecutionID string    `json:"execution_id"`
+	ID          string    `json:"id"`
+	LastError   string    `json:"last_error,omitempty"`
+	ReceivedAt  time.Time `json:"received_at"`
+	SessionName string    `json:"session"`
+}
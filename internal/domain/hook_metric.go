@@ -0,0 +1,13 @@
+package domain
+
+import "time"
+
+// HookMetric records how a hook invocation was processed, for diagnosing state detection latency
+type HookMetric struct {
+	EventType      string
+	HandleDuration time.Duration // Time spent handling the event, including the state write
+	HandledAt      time.Time     // When the state write finished
+	InvokedAt      time.Time     // When the hook process started handling the event
+	SessionName    string
+	State          SessionState // State written (empty for unknown events)
+}
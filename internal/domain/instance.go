@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// InstanceOwner identifies the rocha process that runs the background work of a ROCHA_HOME
+// (prompt dispatch, hook journal drains, rules, budgets, worktree cleanup). Other TUIs follow it.
+type InstanceOwner struct {
+	Command   string    `json:"command"` // "ui" or "scheduler"
+	PID       int       `json:"pid"`
+	StartedAt time.Time `json:"started_at"`
+	TmuxPane  string    `json:"tmux_pane,omitempty"` // $TMUX_PANE of the process, when it runs inside tmux
+}
+
+// String describes the owner, such as "rocha ui (pid 4242)"
+func (o InstanceOwner) String() string {
+	return fmt.Sprintf("rocha %s (pid %d)", o.Command, o.PID)
+}
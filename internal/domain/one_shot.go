@@ -0,0 +1,26 @@
+package domain
+
+// OneShotFinished reports whether the events of a one-shot session, newest first, show
+// that its agent is done with the prompt, and the state it ended in. The agent is done
+// once it worked and then stopped, idle or waiting for input nobody will give, or when
+// it exited. The idle state reported when the agent starts does not count.
+func OneShotFinished(events []Event) (SessionState, bool) {
+	worked := false
+	for i := len(events) - 1; i >= 0; i-- {
+		event := events[i]
+		if event.Type != EventStateChange {
+			continue
+		}
+		switch event.State {
+		case StateWorking:
+			worked = true
+		case StateExited:
+			return StateExited, true
+		case StateIdle, StateWaiting:
+			if worked {
+				return event.State, true
+			}
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOneShotFinished(t *testing.T) {
+	change := func(state SessionState) Event {
+		return Event{State: state, Type: EventStateChange}
+	}
+
+	tests := []struct {
+		name     string
+		events   []Event // Newest first
+		want     SessionState
+		finished bool
+	}{
+		{name: "no events"},
+		{name: "started only", events: []Event{change(StateIdle)}},
+		{name: "working", events: []Event{change(StateWorking), change(StateIdle)}},
+		{name: "worked then stopped", events: []Event{change(StateIdle), change(StateWorking), change(StateIdle)}, want: StateIdle, finished: true},
+		{name: "waiting for permission", events: []Event{change(StateWaiting), change(StateWorking)}, want: StateWaiting, finished: true},
+		{name: "exited before working", events: []Event{change(StateExited), change(StateIdle)}, want: StateExited, finished: true},
+		{name: "other events are ignored", events: []Event{{Type: EventTimer}, change(StateWorking)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, finished := OneShotFinished(tt.events)
+			assert.Equal(t, tt.want, state)
+			assert.Equal(t, tt.finished, finished)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Priority ranks how important a session is, independent of the attention flag
+type Priority string
+
+// Priority levels, most important first
+const (
+	PriorityNone Priority = ""
+	PriorityP0   Priority = "P0" // Drop everything
+	PriorityP1   Priority = "P1" // Next up
+	PriorityP2   Priority = "P2" // Normal
+	PriorityP3   Priority = "P3" // Whenever there is time
+)
+
+// Priorities lists the levels in cycling order
+var Priorities = []Priority{
+	PriorityP0,
+	PriorityP1,
+	PriorityP2,
+	PriorityP3,
+}
+
+// String returns the level, "none" when no priority is set
+func (p Priority) String() string {
+	if p == PriorityNone {
+		return "none"
+	}
+	return string(p)
+}
+
+// Next returns the level after p when cycling: none, P0, P1, P2, P3, none
+func (p Priority) Next() Priority {
+	i := slices.Index(Priorities, p)
+	if i == len(Priorities)-1 {
+		return PriorityNone
+	}
+	return Priorities[i+1] // i is -1 for none, which starts the cycle at P0
+}
+
+// rank orders levels for sorting, sessions without priority last
+func (p Priority) rank() int {
+	if i := slices.Index(Priorities, p); i >= 0 {
+		return i
+	}
+	return len(Priorities)
+}
+
+// ParsePriority parses a level such as "p1" or "P1"; empty, "none", and "clear" clear it
+func ParsePriority(value string) (Priority, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(value))
+	if normalized == "" || normalized == "NONE" || normalized == "CLEAR" {
+		return PriorityNone, nil
+	}
+	if slices.Contains(Priorities, Priority(normalized)) {
+		return Priority(normalized), nil
+	}
+	return PriorityNone, fmt.Errorf("%w: unknown priority %q (use P0, P1, P2, P3, or none)", ErrInvalidInput, value)
+}
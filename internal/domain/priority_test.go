@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePriority(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		want    Priority
+		wantErr bool
+	}{
+		{name: "empty clears", value: "", want: PriorityNone},
+		{name: "none clears", value: "none", want: PriorityNone},
+		{name: "clear clears", value: "clear", want: PriorityNone},
+		{name: "upper case", value: "P0", want: PriorityP0},
+		{name: "lower case with spaces", value: " p2 ", want: PriorityP2},
+		{name: "out of range", value: "P4", wantErr: true},
+		{name: "word", value: "high", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParsePriority(tt.value)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPriorityNext(t *testing.T) {
+	cycle := []Priority{PriorityNone, PriorityP0, PriorityP1, PriorityP2, PriorityP3, PriorityNone}
+	for i := 0; i < len(cycle)-1; i++ {
+		assert.Equal(t, cycle[i+1], cycle[i].Next(), "after %s", cycle[i])
+	}
+}
+
+func TestPriorityString(t *testing.T) {
+	assert.Equal(t, "none", PriorityNone.String())
+	assert.Equal(t, "P1", PriorityP1.String())
+}
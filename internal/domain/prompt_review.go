@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// DefaultPromptReviewPatterns catch recursive deletes, force pushes, and dropped tables
+var DefaultPromptReviewPatterns = []string{
+	`\brm\s+(-\w+\s+)*-\w*(r\w*f|f\w*r)`,
+	`\bgit\s+push\b.*\s(--force|-f)\b`,
+	`(?i)\bdrop\s+(table|database|schema)\b`,
+}
+
+// PromptReview holds back prompts matching dangerous patterns until they are confirmed.
+// Agents running with skip-permissions would otherwise carry them out without asking.
+type PromptReview struct {
+	patterns []*regexp.Regexp
+}
+
+// NewPromptReview compiles the patterns of a prompt review, DefaultPromptReviewPatterns if none is given
+func NewPromptReview(patterns []string) (*PromptReview, error) {
+	if len(patterns) == 0 {
+		patterns = DefaultPromptReviewPatterns
+	}
+
+	review := &PromptReview{patterns: make([]*regexp.Regexp, 0, len(patterns))}
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid prompt review pattern %q: %v", ErrInvalidInput, pattern, err)
+		}
+		review.patterns = append(review.patterns, re)
+	}
+	return review, nil
+}
+
+// Match returns the text matched by each pattern text matches, nil if it is safe to send
+func (r *PromptReview) Match(text string) []string {
+	if r == nil {
+		return nil
+	}
+
+	var matches []string
+	for _, re := range r.patterns {
+		if match := re.FindString(text); match != "" {
+			matches = append(matches, match)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPromptReview_Match(t *testing.T) {
+	review, err := NewPromptReview(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		text string
+		want []string
+	}{
+		{text: "clean up with rm -rf build/", want: []string{"rm -rf"}},
+		{text: "run rm -v -fr /tmp/out", want: []string{"rm -v -fr"}},
+		{text: "rm -r old", want: nil},
+		{text: "then git push --force origin main", want: []string{"git push --force"}},
+		{text: "git push -f", want: []string{"git push -f"}},
+		{text: "git push origin feature-fix", want: nil},
+		{text: "Drop Table users;", want: []string{"Drop Table"}},
+		{text: "add a drop-down to the table", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			assert.Equal(t, tt.want, review.Match(tt.text))
+		})
+	}
+}
+
+func TestNewPromptReview(t *testing.T) {
+	t.Run("custom patterns replace the defaults", func(t *testing.T) {
+		review, err := NewPromptReview([]string{`terraform destroy`})
+		require.NoError(t, err)
+
+		assert.Nil(t, review.Match("rm -rf /"))
+		assert.Equal(t, []string{"terraform destroy"}, review.Match("run terraform destroy now"))
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := NewPromptReview([]string{`(unclosed`})
+		assert.ErrorIs(t, err, ErrInvalidInput)
+	})
+
+	t.Run("nil review matches nothing", func(t *testing.T) {
+		var review *PromptReview
+		assert.Nil(t, review.Match("rm -rf /"))
+	})
+}
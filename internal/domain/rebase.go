@@ -0,0 +1,12 @@
+package domain
+
+// RebaseResult describes the outcome of rebasing a session branch onto its base branch
+type RebaseResult struct {
+	BaseBranch string   // Ref the branch was rebased onto (e.g. origin/main)
+	Conflicts  []string // Files with unresolved conflicts (empty when the rebase succeeded)
+}
+
+// HasConflicts returns true if the rebase stopped because of conflicts
+func (r *RebaseResult) HasConflicts() bool {
+	return len(r.Conflicts) > 0
+}
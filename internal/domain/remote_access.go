@@ -0,0 +1,124 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Remote access problem kinds, from git's output when listing a remote fails
+const (
+	RemoteAccessAuth     = "auth"      // HTTPS credentials missing or rejected
+	RemoteAccessHostKey  = "host_key"  // SSH host key unknown or changed
+	RemoteAccessNetwork  = "network"   // Host unreachable
+	RemoteAccessNotFound = "not_found" // No repository at the URL, or no access to it
+	RemoteAccessSSHKey   = "ssh_key"   // SSH key missing, not loaded in the agent, or rejected
+	RemoteAccessUnknown  = "unknown"
+)
+
+// RemoteAccessError explains why a repository could not be reached before cloning it,
+// with hints on how to fix it
+type RemoteAccessError struct {
+	Detail string   // Last line git printed
+	Hints  []string // Remediation steps, most likely first
+	Kind   string
+	URL    string
+}
+
+func (e *RemoteAccessError) Error() string {
+	reason := map[string]string{
+		RemoteAccessAuth:     "authentication failed",
+		RemoteAccessHostKey:  "SSH host key verification failed",
+		RemoteAccessNetwork:  "host unreachable",
+		RemoteAccessNotFound: "repository not found or no access",
+		RemoteAccessSSHKey:   "SSH key rejected or not loaded",
+	}[e.Kind]
+	if reason == "" {
+		reason = e.Detail
+	}
+	return fmt.Sprintf("cannot access %s: %s", e.URL, reason)
+}
+
+// Unwrap makes the error match ErrRemoteUnreachable
+func (e *RemoteAccessError) Unwrap() error {
+	return ErrRemoteUnreachable
+}
+
+// RemediationHints returns the steps that may fix the error
+func (e *RemoteAccessError) RemediationHints() []string {
+	return e.Hints
+}
+
+// IsSSHURL reports whether a git URL is reached over SSH (git@host:owner/repo or ssh://)
+func IsSSHURL(url string) bool {
+	return strings.HasPrefix(url, "ssh://") || (strings.Contains(url, "@") && !strings.Contains(url, "://"))
+}
+
+// DiagnoseRemoteAccess classifies the output of a failed git ls-remote of url.
+// sshAgent tells whether an SSH agent is running (SSH_AUTH_SOCK is set).
+func DiagnoseRemoteAccess(url, output string, sshAgent bool) *RemoteAccessError {
+	e := &RemoteAccessError{Detail: lastLine(output), Kind: RemoteAccessUnknown, URL: url}
+	text := strings.ToLower(output)
+	ssh := IsSSHURL(url)
+
+	switch {
+	case strings.Contains(text, "host key verification failed"),
+		strings.Contains(text, "remote host identification has changed"):
+		e.Kind = RemoteAccessHostKey
+		e.Hints = []string{fmt.Sprintf("Connect once with 'ssh -T %s' to check and accept the host key", sshTarget(url))}
+	case strings.Contains(text, "permission denied (publickey"),
+		strings.Contains(text, "no such identity"),
+		strings.Contains(text, "sign_and_send_pubkey"):
+		e.Kind = RemoteAccessSSHKey
+		if !sshAgent {
+			e.Hints = append(e.Hints, "No SSH agent is running: start one with 'eval \"$(ssh-agent)\"'")
+		}
+		e.Hints = append(e.Hints,
+			"Load your key with 'ssh-add' (or 'ssh-add ~/.ssh/<key>') and check it is added to your account",
+			fmt.Sprintf("Test with 'ssh -T %s'", sshTarget(url)),
+			"For a key only this repository uses, set ssh_command in git_credentials in settings.json")
+	case strings.Contains(text, "could not read username"),
+		strings.Contains(text, "could not read password"),
+		strings.Contains(text, "terminal prompts disabled"),
+		strings.Contains(text, "authentication failed"),
+		strings.Contains(text, "invalid username or password"),
+		strings.Contains(text, "returned error: 401"),
+		strings.Contains(text, "returned error: 403"):
+		e.Kind = RemoteAccessAuth
+		e.Hints = []string{
+			"Set up a credential helper, such as 'gh auth setup-git' or 'git config --global credential.helper store'",
+			"Or clone over SSH (git@host:owner/repo.git) with a key loaded in your SSH agent",
+			"For a helper only this repository uses, set credential_helper in git_credentials in settings.json",
+		}
+	case strings.Contains(text, "repository not found"),
+		strings.Contains(text, "does not appear to be a git repository"),
+		strings.Contains(text, "returned error: 404"):
+		e.Kind = RemoteAccessNotFound
+		e.Hints = []string{"Check the URL; private repositories also answer 'not found' when the credentials used have no access"}
+		if ssh {
+			e.Hints = append(e.Hints, "Check which account your SSH key belongs to with 'ssh -T "+sshTarget(url)+"'")
+		}
+	case strings.Contains(text, "could not resolve host"),
+		strings.Contains(text, "connection timed out"),
+		strings.Contains(text, "operation timed out"),
+		strings.Contains(text, "connection refused"),
+		strings.Contains(text, "network is unreachable"):
+		e.Kind = RemoteAccessNetwork
+		e.Hints = []string{"Check your network connection, proxy, or VPN"}
+	}
+	return e
+}
+
+// sshTarget returns the user@host an SSH git URL connects to
+func sshTarget(url string) string {
+	target := strings.TrimPrefix(url, "ssh://")
+	if i := strings.IndexAny(target, ":/"); i >= 0 {
+		target = target[:i]
+	}
+	return target
+}
+
+// lastLine returns the last non-empty line of output
+func lastLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiagnoseRemoteAccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		url       string
+		output    string
+		sshAgent  bool
+		wantKind  string
+		wantHints int
+	}{
+		{
+			name:      "ssh key not loaded, no agent",
+			url:       "git@github.com:acme/app.git",
+			output:    "git@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository.",
+			wantKind:  RemoteAccessSSHKey,
+			wantHints: 4,
+		},
+		{
+			name:      "ssh key rejected with agent",
+			url:       "git@github.com:acme/app.git",
+			output:    "git@github.com: Permission denied (publickey).",
+			sshAgent:  true,
+			wantKind:  RemoteAccessSSHKey,
+			wantHints: 3,
+		},
+		{
+			name:      "unknown host key",
+			url:       "ssh://git@gitlab.example.com/acme/app.git",
+			output:    "Host key verification failed.\nfatal: Could not read from remote repository.",
+			wantKind:  RemoteAccessHostKey,
+			wantHints: 1,
+		},
+		{
+			name:      "https without credentials",
+			url:       "https://github.com/acme/app",
+			output:    "fatal: could not read Username for 'https://github.com': terminal prompts disabled",
+			wantKind:  RemoteAccessAuth,
+			wantHints: 3,
+		},
+		{
+			name:      "private repository over https",
+			url:       "https://github.com/acme/secret",
+			output:    "remote: Repository not found.\nfatal: repository 'https://github.com/acme/secret/' not found",
+			wantKind:  RemoteAccessNotFound,
+			wantHints: 1,
+		},
+		{
+			name:      "offline",
+			url:       "https://github.com/acme/app",
+			output:    "fatal: unable to access 'https://github.com/acme/app/': Could not resolve host: github.com",
+			wantKind:  RemoteAccessNetwork,
+			wantHints: 1,
+		},
+		{
+			name:     "unrecognized output",
+			url:      "https://github.com/acme/app",
+			output:   "fatal: something else\n",
+			wantKind: RemoteAccessUnknown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DiagnoseRemoteAccess(tt.url, tt.output, tt.sshAgent)
+
+			assert.Equal(t, tt.wantKind, err.Kind)
+			assert.Len(t, err.Hints, tt.wantHints)
+			assert.ErrorIs(t, err, ErrRemoteUnreachable)
+			assert.Contains(t, err.Error(), tt.url)
+		})
+	}
+}
+
+func TestDiagnoseRemoteAccess_UnknownKeepsGitMessage(t *testing.T) {
+	err := DiagnoseRemoteAccess("https://example.com/app", "warning: x\nfatal: something else\n", false)
+
+	assert.Equal(t, "fatal: something else", err.Detail)
+	assert.Equal(t, "cannot access https://example.com/app: fatal: something else", err.Error())
+}
+
+func TestGitCredentials_Args(t *testing.T) {
+	credentials := GitCredentials{Helper: "store --file ~/.client-credentials", SSHCommand: "ssh -i ~/.ssh/client"}
+
+	assert.Equal(t, []string{
+		"-c", "credential.helper=",
+		"-c", "credential.helper=store --file ~/.client-credentials",
+		"-c", "core.sshCommand=ssh -i ~/.ssh/client",
+	}, credentials.Args())
+	assert.Empty(t, GitCredentials{}.Args())
+	assert.True(t, GitCredentials{}.IsZero())
+}
@@ -0,0 +1,70 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxRepoBookmarkNameLength caps a bookmark name so it fits in the session form
+const MaxRepoBookmarkNameLength = 40
+
+// RepoBookmark is a repository source saved under a friendly name for session creation.
+// Sources used to create sessions are bookmarked automatically.
+type RepoBookmark struct {
+	CreatedAt  time.Time
+	LastUsedAt *time.Time // nil until a session is created from it
+	Name       string
+	Source     string // Git URL, optionally with #branch
+	UseCount   int
+}
+
+// NormalizeRepoBookmarkName lowercases and validates a bookmark name.
+// Names follow the tag rules: letters, digits, '-', '_', and '.'.
+func NormalizeRepoBookmarkName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", fmt.Errorf("bookmark name is required: %w", ErrInvalidInput)
+	}
+	if len(name) > MaxRepoBookmarkNameLength {
+		return "", fmt.Errorf("bookmark name %q is longer than %d characters: %w", name, MaxRepoBookmarkNameLength, ErrInvalidInput)
+	}
+	if strings.IndexFunc(name, func(r rune) bool { return !isTagRune(r) }) >= 0 {
+		return "", fmt.Errorf("bookmark name %q may only contain letters, digits, '-', '_', and '.': %w", name, ErrInvalidInput)
+	}
+	return name, nil
+}
+
+// RepoBookmarkName derives a bookmark name from a source, such as "api" for
+// git@github.com:acme/api.git#main; it returns "" when nothing usable is left
+func RepoBookmarkName(source string) string {
+	source, _, _ = strings.Cut(source, "#")
+	name := strings.Map(func(r rune) rune {
+		if isTagRune(r) {
+			return r
+		}
+		return '-'
+	}, strings.ToLower(repoNameFromPath(source)))
+	name = strings.Trim(name, "-")
+	if len(name) > MaxRepoBookmarkNameLength {
+		name = name[:MaxRepoBookmarkNameLength]
+	}
+	return name
+}
+
+// ResolveRepoBookmark returns the source of the bookmark named by value, keeping a
+// #branch suffix ("api#main"), or value unchanged when no bookmark has that name
+func ResolveRepoBookmark(bookmarks []RepoBookmark, value string) string {
+	name, branch, hasBranch := strings.Cut(strings.TrimSpace(value), "#")
+	for _, bookmark := range bookmarks {
+		if !strings.EqualFold(bookmark.Name, name) {
+			continue
+		}
+		if hasBranch {
+			source, _, _ := strings.Cut(bookmark.Source, "#")
+			return source + "#" + branch
+		}
+		return bookmark.Source
+	}
+	return value
+}
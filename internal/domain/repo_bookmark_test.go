@@ -0,0 +1,37 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepoBookmarkName(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{source: "git@github.com:acme/api.git", want: "api"},
+		{source: "https://github.com/acme/Web_App#main", want: "web_app"},
+		{source: "https://gitlab.com/acme/my repo/", want: "my-repo"},
+		{source: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			assert.Equal(t, tt.want, RepoBookmarkName(tt.source))
+		})
+	}
+}
+
+func TestResolveRepoBookmark(t *testing.T) {
+	bookmarks := []RepoBookmark{
+		{Name: "api", Source: "git@github.com:acme/api.git"},
+		{Name: "web", Source: "https://github.com/acme/web#develop"},
+	}
+
+	assert.Equal(t, "git@github.com:acme/api.git", ResolveRepoBookmark(bookmarks, "api"))
+	assert.Equal(t, "git@github.com:acme/api.git#fix/login", ResolveRepoBookmark(bookmarks, "API#fix/login"))
+	assert.Equal(t, "https://github.com/acme/web#main", ResolveRepoBookmark(bookmarks, "web#main"))
+	assert.Equal(t, "https://github.com/acme/other", ResolveRepoBookmark(bookmarks, "https://github.com/acme/other"))
+}
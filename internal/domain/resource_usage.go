@@ -0,0 +1,22 @@
+package domain
+
+import "time"
+
+// Runaway thresholds for agent processes
+const (
+	RunawayCPUPercent  = 90.0                   // Sustained CPU usage considered runaway
+	RunawayMemoryBytes = 4 * 1024 * 1024 * 1024 // Resident memory considered runaway (4 GiB)
+)
+
+// ResourceUsage represents CPU and memory usage of a session's agent process
+type ResourceUsage struct {
+	CPUPercent  float64   // CPU usage since the previous sample (100 = one full core)
+	MemoryBytes int64     // Resident set size
+	PID         int       // Agent process ID
+	SampledAt   time.Time // When the sample was taken
+}
+
+// IsRunaway returns true if the process exceeds the CPU or memory thresholds
+func (r *ResourceUsage) IsRunaway() bool {
+	return r.CPUPercent >= RunawayCPUPercent || r.MemoryBytes >= RunawayMemoryBytes
+}
@@ -0,0 +1,15 @@
+package domain
+
+// RestartMode selects how an exited session is brought back
+type RestartMode string
+
+const (
+	RestartFresh  RestartMode = "fresh"  // Start a new Claude conversation
+	RestartResume RestartMode = "resume" // Resume the recorded Claude conversation
+	RestartShell  RestartMode = "shell"  // Plain shell in the working directory, no Claude
+)
+
+// CanResume returns true if the session has a recorded Claude conversation to resume
+func (s *Session) CanResume() bool {
+	return s.ClaudeSessionID != ""
+}
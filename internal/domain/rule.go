@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RuleAction is what a workflow rule does to the sessions it matches
+type RuleAction string
+
+const (
+	RuleActionArchive RuleAction = "archive" // Archive the session, keeping its worktree
+	RuleActionFlag    RuleAction = "flag"    // Flag the session
+	RuleActionNotify  RuleAction = "notify"  // Play the bell and send a rule event to the webhook
+)
+
+// Rule applies an action to the sessions matching a filter, such as notifying when a
+// session waits for input in review, or archiving sessions exited for over an hour
+type Rule struct {
+	Action RuleAction
+	Name   string
+	When   SessionFilter
+}
+
+// RuleMatch is a session matched by a rule
+type RuleMatch struct {
+	Rule    Rule
+	Session Session
+}
+
+// NewRule creates a rule from a filter query such as "state:exited older:1h" (see
+// ParseSessionFilter) and an action. The query must select sessions by filter tokens
+// only, so a typo cannot make a rule apply to every session.
+func NewRule(name, when, action string) (Rule, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return Rule{}, fmt.Errorf("rule name cannot be empty: %w", ErrInvalidInput)
+	}
+
+	ruleAction := RuleAction(strings.ToLower(strings.TrimSpace(action)))
+	switch ruleAction {
+	case RuleActionArchive, RuleActionFlag, RuleActionNotify:
+	default:
+		return Rule{}, fmt.Errorf("rule '%s' has unknown action %q (use archive, flag, or notify): %w", name, action, ErrInvalidInput)
+	}
+
+	filter, text, err := ParseSessionFilter(when)
+	if err != nil {
+		return Rule{}, fmt.Errorf("rule '%s': %w", name, err)
+	}
+	if text != "" {
+		return Rule{}, fmt.Errorf("rule '%s' has unknown condition %q: %w", name, text, ErrInvalidInput)
+	}
+	if filter.IsEmpty() {
+		return Rule{}, fmt.Errorf("rule '%s' has no condition: %w", name, ErrInvalidInput)
+	}
+
+	return Rule{Action: ruleAction, Name: name, When: filter}, nil
+}
+
+// Matches reports whether the rule applies to the session at time now.
+// Sessions already flagged are not matched by flag rules.
+func (r Rule) Matches(s Session, now time.Time) bool {
+	if r.Action == RuleActionFlag && s.IsFlagged {
+		return false
+	}
+	return r.When.Matches(s, now)
+}
+
+// MatchRules returns the sessions of the collection matched by each rule, rule by rule
+// and in session order
+func MatchRules(rules []Rule, state *SessionCollection, now time.Time) []RuleMatch {
+	var matches []RuleMatch
+	for _, rule := range rules {
+		for _, name := range state.OrderedNames {
+			session, ok := state.Sessions[name]
+			if ok && rule.Matches(session, now) {
+				matches = append(matches, RuleMatch{Rule: rule, Session: session})
+			}
+		}
+	}
+	return matches
+}
@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRule(t *testing.T) {
+	rule, err := NewRule("review ready", "state:waiting status:review", "Notify")
+	require.NoError(t, err)
+	assert.Equal(t, Rule{
+		Action: RuleActionNotify,
+		Name:   "review ready",
+		When:   SessionFilter{States: []SessionState{StateWaiting}, Statuses: []string{"review"}},
+	}, rule)
+
+	tests := []struct {
+		name   string
+		rule   string
+		when   string
+		action string
+	}{
+		{name: "empty name", rule: " ", when: "state:exited", action: "archive"},
+		{name: "unknown action", rule: "r", when: "state:exited", action: "delete"},
+		{name: "no condition", rule: "r", when: "", action: "archive"},
+		{name: "free text", rule: "r", when: "state:exited sate:idle", action: "archive"},
+		{name: "invalid age", rule: "r", when: "older:soon", action: "archive"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewRule(tt.rule, tt.when, tt.action)
+			assert.ErrorIs(t, err, ErrInvalidInput)
+		})
+	}
+}
+
+func TestMatchRules(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	review := "review"
+	state := &SessionCollection{
+		OrderedNames: []string{"api", "web", "docs"},
+		Sessions: map[string]Session{
+			"api":  {Name: "api", State: StateWaiting, Status: &review, LastUpdated: now.Add(-time.Minute)},
+			"docs": {Name: "docs", State: StateExited, LastUpdated: now.Add(-2 * time.Hour)},
+			"web":  {Name: "web", IsFlagged: true, State: StateExited, LastUpdated: now.Add(-30 * time.Minute)},
+		},
+	}
+	notify, err := NewRule("review ready", "state:waiting status:review", "notify")
+	require.NoError(t, err)
+	archive, err := NewRule("clean up", "state:exited older:1h", "archive")
+	require.NoError(t, err)
+	flag, err := NewRule("exited", "state:exited", "flag")
+	require.NoError(t, err)
+
+	matches := MatchRules([]Rule{notify, archive, flag}, state, now)
+
+	require.Len(t, matches, 3)
+	assert.Equal(t, "api", matches[0].Session.Name)
+	assert.Equal(t, notify, matches[0].Rule)
+	assert.Equal(t, "docs", matches[1].Session.Name, "exited for over an hour")
+	assert.Equal(t, archive, matches[1].Rule)
+	assert.Equal(t, "docs", matches[2].Session.Name, "web is already flagged")
+	assert.Equal(t, flag, matches[2].Rule)
+}
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// ScheduledPrompt is text queued to be sent to a session at a given time
+type ScheduledPrompt struct {
+	Confirmed   bool // Text matching the prompt review patterns was confirmed when it was queued
+	CreatedAt   time.Time
+	ID          uint
+	SendAt      time.Time
+	SessionName string
+	Text        string
+}
+
+// IsDue returns true if the prompt should be sent at the given time
+func (p *ScheduledPrompt) IsDue(now time.Time) bool {
+	return !p.SendAt.After(now)
+}
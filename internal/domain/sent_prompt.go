@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+// SentPrompt is text that was typed into a session, kept as its prompt history
+type SentPrompt struct {
+	ID          uint
+	SentAt      time.Time
+	SessionName string
+	Text        string
+}
@@ -1,6 +1,8 @@
 package domain
 
 import (
+	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 	"unicode"
@@ -12,6 +14,7 @@ type SessionState string
 const (
 	StateExited  SessionState = "exited"
 	StateIdle    SessionState = "idle"
+	StatePaused  SessionState = "paused" // Interrupted on purpose; hooks leave it until a prompt resumes it
 	StateWaiting SessionState = "waiting"
 	StateWorking SessionState = "working"
 )
@@ -20,40 +23,97 @@ const (
 const (
 	SymbolExited  = "■" // Gray - Claude has exited
 	SymbolIdle    = "○" // Yellow - finished/idle
+	SymbolPaused  = "‖" // Blue - paused by the user
 	SymbolWaiting = "◐" // Red - waiting for user input/prompt
 	SymbolWorking = "●" // Green - actively working
 )
 
 // Session represents a rocha session (domain entity)
 type Session struct {
+	Activity                        ActivitySparkline // Not persisted, share of recent time spent working, built from events at runtime
+	AgentArgs                       []string          // Extra agent CLI args passed at launch (see ValidateAgentArgs)
+	AgentError                      *AgentError       // Not persisted, detected in the agent output at runtime
+	AgentModel                      string            // Model the agent runs with, such as sonnet (empty uses the agent default)
 	AllowDangerouslySkipPermissions bool
+	ArchivedAt                      *time.Time // When the session was archived, nil when it is not
+	Badges                          []Badge    // Not persisted, printed by the badge command at runtime
+	BaseBranch                      string     // Branch the session was created from (empty uses the origin default branch)
 	BranchName                      string
+	Checkpoint                      *CheckpointPolicy // Saves the worktree changes on a schedule, nil when off
+	Checks                          *CheckRun         // Not persisted, last run of the repository checks, read from its output at runtime
+	CI                              *CIStatus         // Last CI result reported for the branch, nil when none
 	ClaudeDir                       string
+	ClaudeSessionID                 string // Claude conversation ID reported by the SessionStart hook (used to resume)
 	Comment                         string
 	DisplayName                     string
+	Editor                          EditorIntegration // Empty uses the editor_integration setting
 	ExecutionID                     string
 	GitStats                        *GitStats
 	InitialPrompt                   string
 	IsArchived                      bool
+	IsEscalated                     bool // Not persisted, set while waiting for input longer than the escalation threshold
+	IsExternal                      bool // Runs in an existing directory as-is (no branch or worktree managed by rocha)
 	IsFlagged                       bool
+	IsThrottled                     bool // Not persisted, set while due prompts are held back by the concurrency limit
+	KeepWorktree                    bool // Opted out of removing the worktree after the archive retention
 	LastUpdated                     time.Time
 	Name                            string
+	Note                            string // Multi-line markdown note (Comment stays the short list indicator)
 	PRInfo                          *PRInfo
+	Priority                        Priority // Importance (P0-P3), separate from the attention flag
 	RepoInfo                        string
 	RepoPath                        string
 	RepoSource                      string
-	ShellSession                    *Session
+	ResourceUsage                   *ResourceUsage    // Not persisted, sampled at runtime
+	ScheduledPrompts                []ScheduledPrompt // Pending sends, loaded on demand by the scheduler
+	ShellSession                    *ShellSession     // Plain shell opened next to the session, nil until first opened
 	State                           SessionState
 	Status                          *string
+	Subdir                          string              // Directory inside the checkout the session is scoped to (e.g., packages/api in a monorepo)
+	Tags                            []string            // Freeform labels, normalized and sorted (see NormalizeTags)
+	Timer                           *SessionTimer       // Countdown reminder, nil when none is set
+	TokenBudget                     *SessionTokenBudget // Cap on agent tokens, nil when none is set
+	Workspaces                      []string            // Names of the workspaces the session belongs to, sorted
 	WorktreePath                    string
 }
 
+// WorkingDir returns the directory the agent runs in:
+// the worktree, or the directory itself for external sessions
+func (s *Session) WorkingDir() string {
+	if s.IsExternal {
+		return s.RepoPath
+	}
+	return s.WorktreePath
+}
+
+// StartDir returns the directory the agent, its shell, and the editor start in:
+// the scoped subdirectory of the working directory, or the working directory itself
+func (s *Session) StartDir() string {
+	dir := s.WorkingDir()
+	if dir == "" || s.Subdir == "" {
+		return dir
+	}
+	return filepath.Join(dir, filepath.FromSlash(s.Subdir))
+}
+
 // SessionCollection represents a collection of sessions with ordering
 type SessionCollection struct {
 	OrderedNames []string
 	Sessions     map[string]Session
 }
 
+// NextSessionName returns name when taken reports it free, or else the first free "<name>-N" from N=2
+func NextSessionName(name string, taken func(string) bool) string {
+	if !taken(name) {
+		return name
+	}
+	for i := 2; ; i++ {
+		if candidate := fmt.Sprintf("%s-%d", name, i); !taken(candidate) {
+			return candidate
+		}
+	}
+}
+
 // SanitizeSessionName converts a display name to a tmux-compatible session name.
 // - Alphanumeric, underscores, hyphens, and periods are kept
 // - Spaces, parentheses, and slashes become underscores (consecutive ones collapsed)
@@ -85,3 +145,26 @@ func SanitizeSessionName(displayName string) string {
 	str := result.String()
 	return strings.TrimRight(str, "_")
 }
+
+// ShellSessionName returns the name of the shell session opened next to a session
+func ShellSessionName(name string) string {
+	return name + "-shell"
+}
+
+// ShellSession is a plain shell opened next to a session. It only refers to its parent:
+// the working directory, branch, and repository are the parent's, read when it is opened,
+// so renames and worktree moves of the parent carry over to it.
+type ShellSession struct {
+	Name       string
+	ParentName string
+}
+
+// SessionRename describes a rename stored in one transaction: the session and its
+// shell session get their new names, and the branch and worktree path change with them
+type SessionRename struct {
+	BranchName   string // New branch (empty keeps it)
+	DisplayName  string
+	NewName      string
+	OldName      string
+	WorktreePath string // New worktree path (empty keeps it)
+}
@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// DraftSource tells what kind of text a session draft was built from
+type DraftSource string
+
+const (
+	DraftFromGitURL   DraftSource = "git_url"
+	DraftFromIssueURL DraftSource = "issue_url"
+	DraftFromText     DraftSource = "text"
+)
+
+// draftNameMaxLength caps session names derived from plain text
+const draftNameMaxLength = 40
+
+// gitHosts are the hosts whose https URLs with an owner/repo path are repositories
+var gitHosts = map[string]bool{"bitbucket.org": true, "github.com": true, "gitlab.com": true}
+
+// issuePathPattern matches the issue, pull request, and merge request paths of
+// GitHub (/owner/repo/issues/12) and GitLab (/group/repo/-/issues/12)
+var issuePathPattern = regexp.MustCompile(`^(/.+?/[^/]+?)(?:/-)?/(issues|pull|merge_requests)/([0-9]+)`)
+
+// SessionDraft pre-fills the new session form, such as from the clipboard
+type SessionDraft struct {
+	InitialPrompt string
+	RepoSource    string // Empty to use the repository of the current directory
+	SessionName   string
+	Source        DraftSource
+}
+
+// NewSessionDraft builds a session draft from text: a git URL becomes the repository,
+// an issue URL (GitHub, GitLab, Jira, Linear, ...) the task, and anything else the
+// task description.
+func NewSessionDraft(text string) (SessionDraft, error) {
+	text = strings.TrimSpace(text)
+	if text == "" {
+		return SessionDraft{}, fmt.Errorf("nothing to create a session from: %w", ErrInvalidInput)
+	}
+
+	if !strings.ContainsAny(text, " \t\n") {
+		if draft, ok := draftFromURL(text); ok {
+			return draft, nil
+		}
+	}
+
+	return SessionDraft{
+		InitialPrompt: text,
+		SessionName:   nameFromText(text),
+		Source:        DraftFromText,
+	}, nil
+}
+
+// draftFromURL classifies a single URL-like word as a repository or an issue
+func draftFromURL(text string) (SessionDraft, bool) {
+	if strings.HasPrefix(text, "git@") || strings.HasPrefix(text, "ssh://") || strings.HasPrefix(text, "git://") {
+		return SessionDraft{RepoSource: text, SessionName: repoNameFromPath(text), Source: DraftFromGitURL}, true
+	}
+
+	u, err := url.Parse(text)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return SessionDraft{}, false
+	}
+
+	if strings.HasSuffix(u.Path, ".git") {
+		return SessionDraft{RepoSource: text, SessionName: repoNameFromPath(u.Path), Source: DraftFromGitURL}, true
+	}
+
+	issue := SessionDraft{InitialPrompt: "Work on this issue: " + text, Source: DraftFromIssueURL}
+	if m := issuePathPattern.FindStringSubmatch(u.Path); m != nil {
+		kind := "issue"
+		if m[2] != "issues" {
+			kind = "pr"
+		}
+		issue.RepoSource = fmt.Sprintf("%s://%s%s", u.Scheme, u.Host, m[1])
+		issue.SessionName = fmt.Sprintf("%s-%s-%s", repoNameFromPath(m[1]), kind, m[3])
+		return issue, true
+	}
+
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	if gitHosts[strings.TrimPrefix(u.Host, "www.")] && len(segments) >= 2 {
+		source := fmt.Sprintf("%s://%s/%s/%s", u.Scheme, u.Host, segments[0], segments[1])
+		// GitHub links to a branch as /owner/repo/tree/branch
+		if len(segments) >= 4 && segments[2] == "tree" {
+			source += "#" + strings.Join(segments[3:], "/")
+		}
+		return SessionDraft{RepoSource: source, SessionName: segments[1], Source: DraftFromGitURL}, true
+	}
+
+	// Jira and Linear put the ticket key in the path (/browse/ABC-12, /team/issue/ABC-12/title)
+	if key := DefaultTicketKeyPattern.FindString(u.Path); key != "" {
+		issue.SessionName = key
+	} else if key := DefaultTicketKeyPattern.FindString(u.RawQuery); key != "" {
+		issue.SessionName = key
+	} else if len(segments) > 0 {
+		issue.SessionName = segments[len(segments)-1]
+	} else {
+		issue.SessionName = u.Host
+	}
+	return issue, true
+}
+
+// repoNameFromPath returns the repository name at the end of a repository path or URL
+func repoNameFromPath(path string) string {
+	path = strings.TrimSuffix(strings.TrimSuffix(path, "/"), ".git")
+	if idx := strings.LastIndexAny(path, "/:"); idx >= 0 {
+		path = path[idx+1:]
+	}
+	return path
+}
+
+// nameFromText derives a short session name from the first words of a task description
+func nameFromText(text string) string {
+	firstLine, _, _ := strings.Cut(text, "\n")
+	name := ""
+	for _, word := range strings.Fields(firstLine) {
+		if len(name)+len(word)+1 > draftNameMaxLength {
+			break
+		}
+		if name != "" {
+			name += " "
+		}
+		name += word
+	}
+	if name == "" {
+		// A single word longer than the limit
+		word := []rune(strings.TrimSpace(firstLine))
+		name = string(word[:min(len(word), draftNameMaxLength)])
+	}
+	return name
+}
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSessionDraft(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want SessionDraft
+	}{
+		{
+			name: "ssh git URL",
+			text: "git@github.com:owner/api.git",
+			want: SessionDraft{RepoSource: "git@github.com:owner/api.git", SessionName: "api", Source: DraftFromGitURL},
+		},
+		{
+			name: "https repository",
+			text: "https://github.com/owner/api\n",
+			want: SessionDraft{RepoSource: "https://github.com/owner/api", SessionName: "api", Source: DraftFromGitURL},
+		},
+		{
+			name: "https branch",
+			text: "https://github.com/owner/api/tree/feature/cart",
+			want: SessionDraft{RepoSource: "https://github.com/owner/api#feature/cart", SessionName: "api", Source: DraftFromGitURL},
+		},
+		{
+			name: "github issue",
+			text: "https://github.com/owner/api/issues/42",
+			want: SessionDraft{
+				InitialPrompt: "Work on this issue: https://github.com/owner/api/issues/42",
+				RepoSource:    "https://github.com/owner/api",
+				SessionName:   "api-issue-42",
+				Source:        DraftFromIssueURL,
+			},
+		},
+		{
+			name: "gitlab merge request",
+			text: "https://gitlab.example.com/team/web/-/merge_requests/7",
+			want: SessionDraft{
+				InitialPrompt: "Work on this issue: https://gitlab.example.com/team/web/-/merge_requests/7",
+				RepoSource:    "https://gitlab.example.com/team/web",
+				SessionName:   "web-pr-7",
+				Source:        DraftFromIssueURL,
+			},
+		},
+		{
+			name: "linear issue",
+			text: "https://linear.app/acme/issue/ENG-123/fix-login",
+			want: SessionDraft{
+				InitialPrompt: "Work on this issue: https://linear.app/acme/issue/ENG-123/fix-login",
+				SessionName:   "ENG-123",
+				Source:        DraftFromIssueURL,
+			},
+		},
+		{
+			name: "jira issue",
+			text: "https://acme.atlassian.net/browse/PROJ-7",
+			want: SessionDraft{
+				InitialPrompt: "Work on this issue: https://acme.atlassian.net/browse/PROJ-7",
+				SessionName:   "PROJ-7",
+				Source:        DraftFromIssueURL,
+			},
+		},
+		{
+			name: "plain text",
+			text: "  The checkout page crashes when the cart is empty and the user applies a coupon\nSteps: ...",
+			want: SessionDraft{
+				InitialPrompt: "The checkout page crashes when the cart is empty and the user applies a coupon\nSteps: ...",
+				SessionName:   "The checkout page crashes when the cart",
+				Source:        DraftFromText,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			draft, err := NewSessionDraft(tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, draft)
+		})
+	}
+
+	_, err := NewSessionDraft(" \n")
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SessionFilter selects sessions by state, status, tag, repository, workspace, flag, and age.
+// Unset fields match every session; set fields must all match.
+type SessionFilter struct {
+	FlaggedOnly   bool
+	OlderThan     time.Duration  // Not updated for at least this long (0 = any age)
+	Repo          string         // Case-insensitive substring of the repo info or path
+	States        []SessionState // Any of these states
+	Statuses      []string       // Any of these implementation statuses
+	Tags          []string       // Any of these tags
+	UnflaggedOnly bool           // Only sessions without a flag
+	Workspace     string         // Member of this workspace
+}
+
+// IsEmpty returns true if the filter matches every session
+func (f SessionFilter) IsEmpty() bool {
+	return !f.FlaggedOnly && f.OlderThan == 0 && f.Repo == "" && len(f.States) == 0 && len(f.Statuses) == 0 && len(f.Tags) == 0 && !f.UnflaggedOnly && f.Workspace == ""
+}
+
+// Matches reports whether the session passes every set criterion at time now
+func (f SessionFilter) Matches(s Session, now time.Time) bool {
+	if f.FlaggedOnly && !s.IsFlagged {
+		return false
+	}
+	if f.UnflaggedOnly && s.IsFlagged {
+		return false
+	}
+	if f.OlderThan > 0 && now.Sub(s.LastUpdated) < f.OlderThan {
+		return false
+	}
+	if f.Repo != "" {
+		repo := strings.ToLower(f.Repo)
+		if !strings.Contains(strings.ToLower(s.RepoInfo), repo) && !strings.Contains(strings.ToLower(s.RepoPath), repo) {
+			return false
+		}
+	}
+	if len(f.States) > 0 && !slices.Contains(f.States, s.State) {
+		return false
+	}
+	if len(f.Statuses) > 0 && (s.Status == nil || !slices.Contains(f.Statuses, *s.Status)) {
+		return false
+	}
+	if len(f.Tags) > 0 && !s.HasAnyTag(f.Tags) {
+		return false
+	}
+	if f.Workspace != "" && !s.InWorkspace(f.Workspace) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the sessions matching the filter, keeping their order
+func (f SessionFilter) Apply(sessions []Session, now time.Time) []Session {
+	matched := make([]Session, 0, len(sessions))
+	for _, s := range sessions {
+		if f.Matches(s, now) {
+			matched = append(matched, s)
+		}
+	}
+	return matched
+}
+
+// ParseSessionFilter parses a filter query such as "state:idle,exited tag:backend repo:rocha older:7d flag:yes".
+// Supported tokens are state:, status:, tag:, repo:, workspace:, older:, flag: (yes or no), and flagged;
+// lists are comma-separated.
+// Words that are not filter tokens are returned as free text for name or branch matching.
+func ParseSessionFilter(query string) (SessionFilter, string, error) {
+	var filter SessionFilter
+	var text []string
+
+	for _, token := range strings.Fields(query) {
+		key, value, hasValue := strings.Cut(token, ":")
+		if !hasValue {
+			if strings.EqualFold(token, "flagged") {
+				filter.FlaggedOnly, filter.UnflaggedOnly = true, false
+			} else {
+				text = append(text, token)
+			}
+			continue
+		}
+
+		switch strings.ToLower(key) {
+		case "state":
+			for _, state := range splitFilterList(value) {
+				filter.States = append(filter.States, SessionState(strings.ToLower(state)))
+			}
+		case "status":
+			filter.Statuses = append(filter.Statuses, splitFilterList(value)...)
+		case "tag":
+			filter.Tags = append(filter.Tags, splitFilterList(value)...)
+		case "repo":
+			filter.Repo = value
+		case "workspace":
+			filter.Workspace = value
+		case "flag":
+			switch strings.ToLower(value) {
+			case "yes", "true":
+				filter.FlaggedOnly, filter.UnflaggedOnly = true, false
+			case "no", "false":
+				filter.FlaggedOnly, filter.UnflaggedOnly = false, true
+			default:
+				return SessionFilter{}, "", fmt.Errorf("invalid flag %q (use flag:yes or flag:no): %w", value, ErrInvalidInput)
+			}
+		case "older":
+			age, err := ParseAge(value)
+			if err != nil {
+				return SessionFilter{}, "", err
+			}
+			filter.OlderThan = age
+		default:
+			text = append(text, token)
+		}
+	}
+
+	return filter, strings.Join(text, " "), nil
+}
+
+// ParseAge parses a duration that may also use days, such as "7d", "36h", or "1d12h"
+func ParseAge(value string) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid age %q (use e.g. 30m, 12h, 7d): %w", value, ErrInvalidInput)
+
+	var days time.Duration
+	rest := value
+	if before, after, found := strings.Cut(value, "d"); found {
+		n, err := strconv.Atoi(before)
+		if err != nil || n < 0 {
+			return 0, invalid
+		}
+		days = time.Duration(n) * 24 * time.Hour
+		if after == "" {
+			return days, nil
+		}
+		rest = after
+	}
+
+	d, err := time.ParseDuration(rest)
+	if err != nil || d < 0 {
+		return 0, invalid
+	}
+	return days + d, nil
+}
+
+// splitFilterList splits a comma-separated value, dropping empty items
+func splitFilterList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionFilter_Matches(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	review := "review"
+	session := Session{
+		IsFlagged:   true,
+		LastUpdated: now.Add(-3 * 24 * time.Hour),
+		Name:        "s1",
+		RepoInfo:    "renato0307/rocha",
+		RepoPath:    "/src/rocha",
+		State:       StateIdle,
+		Status:      &review,
+		Tags:        []string{"backend", "urgent"},
+		Workspaces:  []string{"release-1.4"},
+	}
+
+	tests := []struct {
+		name    string
+		filter  SessionFilter
+		session Session
+		want    bool
+	}{
+		{name: "empty filter matches", filter: SessionFilter{}, session: session, want: true},
+		{name: "state matches any", filter: SessionFilter{States: []SessionState{StateExited, StateIdle}}, session: session, want: true},
+		{name: "state mismatch", filter: SessionFilter{States: []SessionState{StateWorking}}, session: session, want: false},
+		{name: "status matches", filter: SessionFilter{Statuses: []string{"review"}}, session: session, want: true},
+		{name: "status mismatch", filter: SessionFilter{Statuses: []string{"done"}}, session: session, want: false},
+		{name: "status filter skips sessions without status", filter: SessionFilter{Statuses: []string{"review"}}, session: Session{Name: "bare"}, want: false},
+		{name: "tag matches any", filter: SessionFilter{Tags: []string{"client-x", "urgent"}}, session: session, want: true},
+		{name: "tag is case-insensitive", filter: SessionFilter{Tags: []string{"Backend"}}, session: session, want: true},
+		{name: "tag mismatch", filter: SessionFilter{Tags: []string{"frontend"}}, session: session, want: false},
+		{name: "repo substring is case-insensitive", filter: SessionFilter{Repo: "ROCHA"}, session: session, want: true},
+		{name: "repo matches path", filter: SessionFilter{Repo: "/src/"}, session: session, want: true},
+		{name: "repo mismatch", filter: SessionFilter{Repo: "other"}, session: session, want: false},
+		{name: "workspace member", filter: SessionFilter{Workspace: "Release-1.4"}, session: session, want: true},
+		{name: "workspace mismatch", filter: SessionFilter{Workspace: "client-acme"}, session: session, want: false},
+		{name: "flagged only", filter: SessionFilter{FlaggedOnly: true}, session: Session{Name: "bare"}, want: false},
+		{name: "unflagged only", filter: SessionFilter{UnflaggedOnly: true}, session: session, want: false},
+		{name: "unflagged only matches bare", filter: SessionFilter{UnflaggedOnly: true}, session: Session{Name: "bare"}, want: true},
+		{name: "older than reached", filter: SessionFilter{OlderThan: 48 * time.Hour}, session: session, want: true},
+		{name: "older than not reached", filter: SessionFilter{OlderThan: 7 * 24 * time.Hour}, session: session, want: false},
+		{name: "all criteria must match", filter: SessionFilter{FlaggedOnly: true, States: []SessionState{StateWorking}}, session: session, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Matches(tt.session, now))
+		})
+	}
+}
+
+func TestParseSessionFilter(t *testing.T) {
+	filter, text, err := ParseSessionFilter("login state:Idle,exited status:review tag:backend,urgent repo:rocha workspace:release-1.4 older:1d12h flagged fix")
+
+	require.NoError(t, err)
+	assert.Equal(t, SessionFilter{
+		FlaggedOnly: true,
+		OlderThan:   36 * time.Hour,
+		Repo:        "rocha",
+		States:      []SessionState{StateIdle, StateExited},
+		Statuses:    []string{"review"},
+		Tags:        []string{"backend", "urgent"},
+		Workspace:   "release-1.4",
+	}, filter)
+	assert.Equal(t, "login fix", text)
+}
+
+func TestParseSessionFilter_Flag(t *testing.T) {
+	filter, _, err := ParseSessionFilter("flag:yes")
+	require.NoError(t, err)
+	assert.Equal(t, SessionFilter{FlaggedOnly: true}, filter)
+
+	filter, _, err = ParseSessionFilter("flagged flag:No")
+	require.NoError(t, err)
+	assert.Equal(t, SessionFilter{UnflaggedOnly: true}, filter, "the last flag token wins")
+
+	_, _, err = ParseSessionFilter("flag:maybe")
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestParseSessionFilter_InvalidAge(t *testing.T) {
+	_, _, err := ParseSessionFilter("older:soon")
+
+	require.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestParseAge(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "30m", want: 30 * time.Minute},
+		{value: "7d", want: 7 * 24 * time.Hour},
+		{value: "1d6h", want: 30 * time.Hour},
+		{value: "d", wantErr: true},
+		{value: "-2h", wantErr: true},
+		{value: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseAge(tt.value)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package domain
+
+import "slices"
+
+// SessionMetadata holds the fields of a session that users edit by hand
+type SessionMetadata struct {
+	Comment     string
+	DisplayName string
+	Flagged     bool
+	Priority    Priority
+	Status      *string // nil when the session has no implementation status
+	Tags        []string
+}
+
+// Metadata returns the hand-edited fields of the session
+func (s *Session) Metadata() SessionMetadata {
+	return SessionMetadata{
+		Comment:     s.Comment,
+		DisplayName: s.DisplayName,
+		Flagged:     s.IsFlagged,
+		Priority:    s.Priority,
+		Status:      s.Status,
+		Tags:        slices.Clone(s.Tags),
+	}
+}
+
+// Metadata fields, as named in Changes
+const (
+	MetadataComment     = "comment"
+	MetadataDisplayName = "display_name"
+	MetadataFlagged     = "flagged"
+	MetadataPriority    = "priority"
+	MetadataStatus      = "status"
+	MetadataTags        = "tags"
+)
+
+// Changes lists the fields of m that differ from current, in the order they are listed above
+func (m SessionMetadata) Changes(current SessionMetadata) []string {
+	var changes []string
+	if m.Comment != current.Comment {
+		changes = append(changes, MetadataComment)
+	}
+	if m.DisplayName != current.DisplayName {
+		changes = append(changes, MetadataDisplayName)
+	}
+	if m.Flagged != current.Flagged {
+		changes = append(changes, MetadataFlagged)
+	}
+	if m.Priority != current.Priority {
+		changes = append(changes, MetadataPriority)
+	}
+	if statusValue(m.Status) != statusValue(current.Status) {
+		changes = append(changes, MetadataStatus)
+	}
+	if !slices.Equal(m.Tags, current.Tags) {
+		changes = append(changes, MetadataTags)
+	}
+	return changes
+}
+
+// statusValue returns the implementation status, empty when there is none
+func statusValue(status *string) string {
+	if status == nil {
+		return ""
+	}
+	return *status
+}
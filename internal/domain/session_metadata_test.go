@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionMetadata_Changes(t *testing.T) {
+	review := "review"
+	also := "review"
+	current := SessionMetadata{Comment: "ask design", DisplayName: "Cart API", Status: &review, Tags: []string{"backend"}}
+
+	tests := []struct {
+		name     string
+		metadata SessionMetadata
+		want     []string
+	}{
+		{name: "unchanged", metadata: SessionMetadata{Comment: "ask design", DisplayName: "Cart API", Status: &also, Tags: []string{"backend"}}},
+		{name: "cleared status and tags", metadata: SessionMetadata{Comment: "ask design", DisplayName: "Cart API"}, want: []string{MetadataStatus, MetadataTags}},
+		{name: "flag and priority", metadata: SessionMetadata{Comment: "ask design", DisplayName: "Cart API", Flagged: true, Priority: PriorityP0, Status: &review, Tags: []string{"backend"}}, want: []string{MetadataFlagged, MetadataPriority}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.metadata.Changes(current))
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultDisplayNameTemplate names sessions after their linked issue, or else their branch
+const DefaultDisplayNameTemplate = `{{if .IssueTitle}}{{.IssueKey}} {{.IssueTitle}}{{else}}{{.BranchTitle}}{{end}}`
+
+// DisplayNameFields are the values a display name template can use
+type DisplayNameFields struct {
+	Branch      string // Branch as given, such as feature/abc-12-fix-login
+	BranchTitle string // Branch without its prefixes, humanized: "ABC-12 Fix login"
+	IssueKey    string // Key of the linked issue, when ticket sync finds one
+	IssueTitle  string // Title of the linked issue, when the tracker returns one
+	Repo        string // Repository as owner/repo
+}
+
+// ParseDisplayNameTemplate parses a display name template; empty text uses DefaultDisplayNameTemplate
+func ParseDisplayNameTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		text = DefaultDisplayNameTemplate
+	}
+	tmpl, err := template.New("display_name").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid display name template: %v", ErrInvalidInput, err)
+	}
+	return tmpl, nil
+}
+
+// RenderDisplayName fills tmpl with fields, collapsing whitespace.
+// Falls back to the humanized branch when the template fails or renders nothing.
+func RenderDisplayName(tmpl *template.Template, fields DisplayNameFields) string {
+	if tmpl == nil {
+		return fields.BranchTitle
+	}
+	var out strings.Builder
+	if err := tmpl.Execute(&out, fields); err != nil {
+		return fields.BranchTitle
+	}
+	if name := strings.Join(strings.Fields(out.String()), " "); name != "" {
+		return name
+	}
+	return fields.BranchTitle
+}
+
+// HumanizeBranch turns a branch name into a display name: prefixes such as feature/ or
+// user/fix/ are dropped, a leading ticket key is upper-cased and kept, and the words
+// separated by dashes, underscores, or dots become a sentence.
+// "feature/abc-123-fix-login-bug" becomes "ABC-123 Fix login bug".
+func HumanizeBranch(branch string) string {
+	name := strings.TrimSpace(branch)
+	if i := strings.LastIndex(name, "/"); i >= 0 {
+		name = name[i+1:]
+	}
+
+	var key string
+	if loc := DefaultTicketKeyPattern.FindStringIndex(strings.ToUpper(name)); loc != nil && loc[0] == 0 {
+		key = strings.ToUpper(name[:loc[1]])
+		name = name[loc[1]:]
+	}
+
+	words := strings.FieldsFunc(name, func(r rune) bool {
+		return r == '-' || r == '_' || r == '.' || unicode.IsSpace(r)
+	})
+	title := capitalize(strings.Join(words, " "))
+
+	switch {
+	case key == "":
+		return title
+	case title == "":
+		return key
+	default:
+		return key + " " + title
+	}
+}
+
+// capitalize upper-cases the first letter of s
+func capitalize(s string) string {
+	r, size := utf8.DecodeRuneInString(s)
+	if r == utf8.RuneError {
+		return s
+	}
+	return string(unicode.ToUpper(r)) + s[size:]
+}
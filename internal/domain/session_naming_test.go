@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHumanizeBranch(t *testing.T) {
+	tests := []struct {
+		branch string
+		want   string
+	}{
+		{branch: "fix-login-bug", want: "Fix login bug"},
+		{branch: "feature/add_dark_mode", want: "Add dark mode"},
+		{branch: "renato/feat/cache.warmup", want: "Cache warmup"},
+		{branch: "feature/abc-123-fix-login-bug", want: "ABC-123 Fix login bug"},
+		{branch: "ENG-7", want: "ENG-7"},
+		{branch: "bugfix/", want: ""},
+		{branch: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			assert.Equal(t, tt.want, HumanizeBranch(tt.branch))
+		})
+	}
+}
+
+func TestRenderDisplayName(t *testing.T) {
+	fields := DisplayNameFields{
+		Branch:      "feature/eng-7-login",
+		BranchTitle: "ENG-7 Login",
+		IssueKey:    "ENG-7",
+		Repo:        "acme/app",
+	}
+
+	tests := []struct {
+		name       string
+		template   string
+		issueTitle string
+		want       string
+	}{
+		{name: "default without issue title", want: "ENG-7 Login"},
+		{name: "default with issue title", issueTitle: "Users cannot log in", want: "ENG-7 Users cannot log in"},
+		{name: "custom", template: "{{.Repo}}: {{.BranchTitle}}", want: "acme/app: ENG-7 Login"},
+		{name: "whitespace collapsed", template: "  {{.IssueKey}}\n {{.IssueTitle}} ", want: "ENG-7"},
+		{name: "empty render falls back to branch", template: "{{.IssueTitle}}", want: "ENG-7 Login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseDisplayNameTemplate(tt.template)
+			require.NoError(t, err)
+
+			f := fields
+			f.IssueTitle = tt.issueTitle
+			assert.Equal(t, tt.want, RenderDisplayName(tmpl, f))
+		})
+	}
+}
+
+func TestParseDisplayNameTemplate_Invalid(t *testing.T) {
+	_, err := ParseDisplayNameTemplate("{{.BranchTitle")
+
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
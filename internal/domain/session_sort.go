@@ -0,0 +1,157 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SortField is a session attribute the list can be sorted by
+type SortField string
+
+const (
+	SortByFlagged  SortField = "flagged"  // Flagged sessions first
+	SortByName     SortField = "name"     // Display name (or name), A to Z
+	SortByPriority SortField = "priority" // P0 to P3, sessions without priority last
+	SortByRepo     SortField = "repo"     // Repository, A to Z
+	SortByState    SortField = "state"    // waiting, working, idle, exited
+	SortByStatus   SortField = "status"   // Configured status order, sessions without status last
+	SortByUpdated  SortField = "updated"  // Least recently updated first
+)
+
+// SortCriterion is one key of a sort expression
+type SortCriterion struct {
+	Descending bool
+	Field      SortField
+}
+
+// SessionSort orders sessions by criteria applied in turn; later criteria break ties.
+// An empty sort keeps the manual order.
+type SessionSort struct {
+	Criteria    []SortCriterion
+	StatusOrder []string // Ranks statuses for the status key (unlisted statuses sort after, A to Z)
+}
+
+// SortPreset is a named sort the TUI can cycle through
+type SortPreset struct {
+	Name string
+	Sort SessionSort
+}
+
+// stateSortRank puts the states that need attention first
+var stateSortRank = map[SessionState]int{
+	StateWaiting: 0,
+	StateWorking: 1,
+	StateIdle:    2,
+	StatePaused:  3,
+	StateExited:  4,
+}
+
+// ParseSessionSort parses a sort expression such as "flagged, state, -updated".
+// Keys are comma-separated and applied in order; a leading "-" reverses a key.
+func ParseSessionSort(expr string) (SessionSort, error) {
+	var sort SessionSort
+	for _, part := range strings.Split(expr, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		criterion := SortCriterion{Field: SortField(strings.ToLower(strings.TrimPrefix(part, "-")))}
+		criterion.Descending = strings.HasPrefix(part, "-")
+		switch criterion.Field {
+		case SortByFlagged, SortByName, SortByPriority, SortByRepo, SortByState, SortByStatus, SortByUpdated:
+			sort.Criteria = append(sort.Criteria, criterion)
+		default:
+			return SessionSort{}, fmt.Errorf("invalid sort key %q (use flagged, name, priority, repo, state, status, or updated): %w", part, ErrInvalidInput)
+		}
+	}
+
+	if len(sort.Criteria) == 0 {
+		return SessionSort{}, fmt.Errorf("empty sort expression: %w", ErrInvalidInput)
+	}
+	return sort, nil
+}
+
+// Compare returns a negative number when a sorts before b, a positive number when
+// after, and zero when every criterion ties. Use a stable sort to keep the manual
+// order of ties.
+func (s SessionSort) Compare(a, b Session) int {
+	for _, criterion := range s.Criteria {
+		c := s.compareField(criterion.Field, a, b)
+		if criterion.Descending {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func (s SessionSort) compareField(field SortField, a, b Session) int {
+	switch field {
+	case SortByFlagged:
+		return compareBoolFirst(a.IsFlagged, b.IsFlagged)
+	case SortByName:
+		return cmp.Compare(strings.ToLower(sortName(a)), strings.ToLower(sortName(b)))
+	case SortByPriority:
+		return cmp.Compare(a.Priority.rank(), b.Priority.rank())
+	case SortByRepo:
+		return cmp.Compare(strings.ToLower(a.RepoInfo), strings.ToLower(b.RepoInfo))
+	case SortByState:
+		return cmp.Compare(stateRank(a.State), stateRank(b.State))
+	case SortByStatus:
+		return s.compareStatus(a.Status, b.Status)
+	case SortByUpdated:
+		return a.LastUpdated.Compare(b.LastUpdated)
+	default:
+		return 0
+	}
+}
+
+// compareStatus orders by position in StatusOrder, then by name; no status sorts last
+func (s SessionSort) compareStatus(a, b *string) int {
+	if a == nil || b == nil {
+		return compareBoolFirst(a != nil, b != nil)
+	}
+	rankA, rankB := s.statusRank(*a), s.statusRank(*b)
+	if c := cmp.Compare(rankA, rankB); c != 0 {
+		return c
+	}
+	return cmp.Compare(*a, *b)
+}
+
+func (s SessionSort) statusRank(status string) int {
+	if i := slices.Index(s.StatusOrder, status); i >= 0 {
+		return i
+	}
+	return len(s.StatusOrder)
+}
+
+// compareBoolFirst sorts true before false
+func compareBoolFirst(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return -1
+	default:
+		return 1
+	}
+}
+
+func stateRank(state SessionState) int {
+	if rank, ok := stateSortRank[state]; ok {
+		return rank
+	}
+	return len(stateSortRank)
+}
+
+func sortName(s Session) string {
+	if s.DisplayName != "" {
+		return s.DisplayName
+	}
+	return s.Name
+}
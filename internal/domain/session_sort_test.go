@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSessionSort(t *testing.T) {
+	tests := []struct {
+		name    string
+		expr    string
+		want    []SortCriterion
+		wantErr bool
+	}{
+		{
+			name: "keys in order with descending prefix",
+			expr: "flagged, state, -updated",
+			want: []SortCriterion{{Field: SortByFlagged}, {Field: SortByState}, {Field: SortByUpdated, Descending: true}},
+		},
+		{name: "keys are case-insensitive", expr: "Name", want: []SortCriterion{{Field: SortByName}}},
+		{name: "priority key", expr: "priority,-updated", want: []SortCriterion{{Field: SortByPriority}, {Field: SortByUpdated, Descending: true}}},
+		{name: "empty items are skipped", expr: "repo,,status,", want: []SortCriterion{{Field: SortByRepo}, {Field: SortByStatus}}},
+		{name: "unknown key", expr: "flagged,color", wantErr: true},
+		{name: "empty expression", expr: " , ", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := ParseSessionSort(tt.expr)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, sort.Criteria)
+		})
+	}
+}
+
+func TestSessionSort_Compare(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	plan, review := "plan", "review"
+
+	sessions := []Session{
+		{Name: "idle-old", State: StateIdle, LastUpdated: now.Add(-2 * time.Hour), Priority: PriorityP2},
+		{Name: "waiting", State: StateWaiting, LastUpdated: now.Add(-3 * time.Hour), Status: &review, Priority: PriorityP0},
+		{Name: "idle-new", State: StateIdle, LastUpdated: now.Add(-time.Hour), Status: &plan},
+		{Name: "flagged", State: StateExited, LastUpdated: now.Add(-5 * time.Hour), IsFlagged: true},
+	}
+
+	tests := []struct {
+		name  string
+		expr  string
+		order []string
+		want  []string
+	}{
+		{
+			name: "flagged, then waiting, then most recent",
+			expr: "flagged,state,-updated",
+			want: []string{"flagged", "waiting", "idle-new", "idle-old"},
+		},
+		{
+			name:  "status follows configured order with no status last",
+			expr:  "status",
+			order: []string{"plan", "review"},
+			want:  []string{"idle-new", "waiting", "idle-old", "flagged"},
+		},
+		{
+			name: "priority with no priority last",
+			expr: "priority",
+			want: []string{"waiting", "idle-old", "idle-new", "flagged"},
+		},
+		{
+			name: "ties keep manual order",
+			expr: "-flagged",
+			want: []string{"idle-old", "waiting", "idle-new", "flagged"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := ParseSessionSort(tt.expr)
+			require.NoError(t, err)
+			sort.StatusOrder = tt.order
+
+			sorted := append([]Session(nil), sessions...)
+			slices.SortStableFunc(sorted, sort.Compare)
+
+			var names []string
+			for _, s := range sorted {
+				names = append(names, s.Name)
+			}
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
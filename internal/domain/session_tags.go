@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MaxTagLength caps a tag so chips stay short in the session list
+const MaxTagLength = 24
+
+// NormalizeTags lowercases, validates, de-duplicates, and sorts tags.
+// Tags may contain letters, digits, '-', '_', and '.'; anything else is rejected.
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if len(tag) > MaxTagLength {
+			return nil, fmt.Errorf("tag %q is longer than %d characters: %w", tag, MaxTagLength, ErrInvalidInput)
+		}
+		if strings.IndexFunc(tag, func(r rune) bool { return !isTagRune(r) }) >= 0 {
+			return nil, fmt.Errorf("tag %q may only contain letters, digits, '-', '_', and '.': %w", tag, ErrInvalidInput)
+		}
+		normalized = append(normalized, tag)
+	}
+
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// HasAnyTag reports whether the session has at least one of the given tags
+func (s *Session) HasAnyTag(tags []string) bool {
+	return slices.ContainsFunc(tags, func(tag string) bool {
+		return slices.Contains(s.Tags, strings.ToLower(tag))
+	})
+}
+
+func isTagRune(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '_' || r == '.'
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -218,3 +219,52 @@ func TestSanitizeSessionName_ComplexExamples(t *testing.T) {
 		})
 	}
 }
+
+func TestNormalizeTags(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "lowercases and sorts", input: []string{"Urgent", "backend"}, want: []string{"backend", "urgent"}},
+		{name: "drops duplicates and blanks", input: []string{"api", " API ", ""}, want: []string{"api"}},
+		{name: "allows separators", input: []string{"client-x", "v1.2", "team_a"}, want: []string{"client-x", "team_a", "v1.2"}},
+		{name: "empty input", input: nil, want: []string{}},
+		{name: "rejects spaces", input: []string{"two words"}, wantErr: true},
+		{name: "rejects long tags", input: []string{"a-very-long-tag-that-goes-on"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeTags(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNextSessionName(t *testing.T) {
+	tests := []struct {
+		name  string
+		taken []string
+		want  string
+	}{
+		{name: "free name is kept", taken: []string{"web"}, want: "api"},
+		{name: "taken name gets -2", taken: []string{"api"}, want: "api-2"},
+		{name: "skips taken suffixes", taken: []string{"api", "api-2", "api-3"}, want: "api-4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NextSessionName("api", func(name string) bool {
+				return slices.Contains(tt.taken, name)
+			})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
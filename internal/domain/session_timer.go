@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxTimerLabelLength caps a timer label so it fits next to the session name
+const MaxTimerLabelLength = 60
+
+// SessionTimer is a countdown attached to a session, such as "check back in 20m"
+type SessionTimer struct {
+	DueAt    time.Time
+	Label    string // Optional reminder shown when the timer elapses
+	Notified bool   // The elapsed timer was already announced
+}
+
+// NewSessionTimer creates a timer that elapses after d
+func NewSessionTimer(d time.Duration, label string, now time.Time) (*SessionTimer, error) {
+	if d <= 0 {
+		return nil, fmt.Errorf("timer duration must be positive: %w", ErrInvalidInput)
+	}
+	label = strings.TrimSpace(label)
+	if len(label) > MaxTimerLabelLength {
+		return nil, fmt.Errorf("timer label is longer than %d characters: %w", MaxTimerLabelLength, ErrInvalidInput)
+	}
+	return &SessionTimer{DueAt: now.Add(d), Label: label}, nil
+}
+
+// Elapsed reports whether the timer is due at now
+func (t SessionTimer) Elapsed(now time.Time) bool {
+	return !now.Before(t.DueAt)
+}
+
+// Remaining returns how long until the timer elapses, rounded to the second (0 once elapsed)
+func (t SessionTimer) Remaining(now time.Time) time.Duration {
+	if t.Elapsed(now) {
+		return 0
+	}
+	return t.DueAt.Sub(now).Round(time.Second)
+}
+
+// FormatTimerRemaining formats a remaining duration compactly, such as "1h05m", "12m", or "40s"
+func FormatTimerRemaining(d time.Duration) string {
+	switch {
+	case d >= time.Hour:
+		return fmt.Sprintf("%dh%02dm", int(d.Hours()), int(d.Minutes())%60)
+	case d >= time.Minute:
+		// Round up, so a timer never shows 0m while it is still running
+		return fmt.Sprintf("%dm", int((d+time.Minute-1)/time.Minute))
+	default:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	}
+}
@@ -0,0 +1,52 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSessionTimer(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+
+	timer, err := NewSessionTimer(20*time.Minute, "  check CI ", now)
+	require.NoError(t, err)
+	assert.Equal(t, &SessionTimer{DueAt: now.Add(20 * time.Minute), Label: "check CI"}, timer)
+
+	_, err = NewSessionTimer(0, "", now)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	_, err = NewSessionTimer(time.Minute, strings.Repeat("x", MaxTimerLabelLength+1), now)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestSessionTimer_Remaining(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	timer := SessionTimer{DueAt: now.Add(90 * time.Second)}
+
+	assert.False(t, timer.Elapsed(now))
+	assert.Equal(t, 90*time.Second, timer.Remaining(now))
+	assert.True(t, timer.Elapsed(now.Add(90*time.Second)))
+	assert.Equal(t, time.Duration(0), timer.Remaining(now.Add(time.Hour)))
+}
+
+func TestFormatTimerRemaining(t *testing.T) {
+	tests := []struct {
+		remaining time.Duration
+		want      string
+	}{
+		{remaining: 40 * time.Second, want: "40s"},
+		{remaining: 61 * time.Second, want: "2m"},
+		{remaining: 20 * time.Minute, want: "20m"},
+		{remaining: 65 * time.Minute, want: "1h05m"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatTimerRemaining(tt.remaining))
+		})
+	}
+}
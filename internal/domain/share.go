@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+// ShareAccess is the level of control a share grants over a session
+type ShareAccess string
+
+const (
+	ShareReadOnly  ShareAccess = "read-only"
+	ShareReadWrite ShareAccess = "read-write"
+)
+
+// SessionShare lets a teammate attach to a session until it expires or is revoked.
+// The teammate attaches through a tmux server of its own, which only they may reach
+// and which enforces the access; revoking the share stops it.
+type SessionShare struct {
+	Access      ShareAccess
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+	Guest       string // Account of the teammate ("" = only the sharer's own account)
+	RevokedAt   *time.Time
+	SessionName string
+	Socket      string // Socket of the tmux server the teammate attaches to
+	Token       string
+}
+
+// IsActive returns true if the share can still be used at the given time
+func (s *SessionShare) IsActive(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionShareIsActive(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name  string
+		share SessionShare
+		want  bool
+	}{
+		{
+			name:  "before expiry",
+			share: SessionShare{ExpiresAt: now.Add(time.Hour)},
+			want:  true,
+		},
+		{
+			name:  "at expiry",
+			share: SessionShare{ExpiresAt: now},
+			want:  false,
+		},
+		{
+			name:  "revoked before expiry",
+			share: SessionShare{ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			want:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.share.IsActive(now))
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package domain
+
+import "sort"
+
+// OrphanShell is a shell session out of step with its parent: running although the
+// parent's tmux session is gone, or recorded although the shell no longer runs
+type OrphanShell struct {
+	Name       string
+	ParentName string
+	Running    bool // The shell runs without its parent; false when only the record is left
+}
+
+// FindOrphanShells returns the orphan shells of sessions, given the tmux sessions running.
+// Only shells recorded for a session count: a tmux session merely named like a shell may
+// belong to the user, so it is never reported.
+func FindOrphanShells(sessions []Session, running map[string]bool) []OrphanShell {
+	var orphans []OrphanShell
+	for _, session := range sessions {
+		if session.ShellSession == nil {
+			continue
+		}
+		name := session.ShellSession.Name
+
+		switch {
+		case running[name] && !running[session.Name]:
+			orphans = append(orphans, OrphanShell{Name: name, ParentName: session.Name, Running: true})
+		case !running[name]:
+			orphans = append(orphans, OrphanShell{Name: name, ParentName: session.Name})
+		}
+	}
+
+	sort.Slice(orphans, func(i, j int) bool { return orphans[i].Name < orphans[j].Name })
+	return orphans
+}
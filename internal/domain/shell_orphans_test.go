@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindOrphanShells(t *testing.T) {
+	linked := func(name string) Session {
+		return Session{Name: name, ShellSession: &ShellSession{Name: ShellSessionName(name), ParentName: name}}
+	}
+
+	tests := []struct {
+		name     string
+		sessions []Session
+		running  []string
+		want     []OrphanShell
+	}{
+		{
+			name:     "shell running next to its parent",
+			sessions: []Session{linked("api")},
+			running:  []string{"api", "api-shell"},
+		},
+		{
+			name:     "shell running after its parent was killed",
+			sessions: []Session{linked("api")},
+			running:  []string{"api-shell"},
+			want:     []OrphanShell{{Name: "api-shell", ParentName: "api", Running: true}},
+		},
+		{
+			name:     "unrecorded tmux session named like a shell",
+			sessions: []Session{{Name: "api"}},
+			running:  []string{"api-shell"},
+		},
+		{
+			name:     "recorded shell that no longer runs",
+			sessions: []Session{linked("api")},
+			running:  []string{"api"},
+			want:     []OrphanShell{{Name: "api-shell", ParentName: "api"}},
+		},
+		{
+			name:     "session that never opened a shell",
+			sessions: []Session{{Name: "api"}},
+			running:  []string{"api"},
+		},
+		{
+			name:     "sorted by shell name",
+			sessions: []Session{linked("web"), linked("api")},
+			want:     []OrphanShell{{Name: "api-shell", ParentName: "api"}, {Name: "web-shell", ParentName: "web"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			running := make(map[string]bool)
+			for _, name := range tt.running {
+				running[name] = true
+			}
+			assert.Equal(t, tt.want, FindOrphanShells(tt.sessions, running))
+		})
+	}
+}
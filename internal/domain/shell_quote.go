@@ -0,0 +1,27 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+)
+
+// shellSafe matches words a POSIX shell reads literally without quotes
+var shellSafe = regexp.MustCompile(`^[A-Za-z0-9_@%+=:,./-]+$`)
+
+// ShellQuote quotes s so a POSIX shell reads it as one word, whatever it contains.
+// Words the shell would read literally anyway are left as they are.
+func ShellQuote(s string) string {
+	if shellSafe.MatchString(s) {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// ShellJoin quotes each argument and joins them into one shell command line
+func ShellJoin(args ...string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = ShellQuote(arg)
+	}
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "plain word", input: "session", want: "session"},
+		{name: "path", input: "/tmp/rocha-share.sock", want: "/tmp/rocha-share.sock"},
+		{name: "empty", input: "", want: `''`},
+		{name: "spaces", input: "my session", want: `'my session'`},
+		{name: "single quote", input: "it's", want: `'it'\''s'`},
+		{name: "expansions stay literal", input: "$HOME `id` $(id)", want: "'$HOME `id` $(id)'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ShellQuote(tt.input))
+		})
+	}
+}
+
+func TestShellJoin_RoundTripsThroughShell(t *testing.T) {
+	args := []string{"plain", "a b", "it's", `"quoted"`, "$HOME", "back\\slash", "new\nline", ""}
+
+	// The joined line reaches the shell as one argument; evaluating it splits it back
+	output, err := exec.Command("sh", "-c", `eval "set -- $1"; for arg in "$@"; do printf '%s\0' "$arg"; done`, "sh", ShellJoin(args...)).Output()
+	require.NoError(t, err)
+
+	assert.Equal(t, args, strings.Split(strings.TrimSuffix(string(output), "\x00"), "\x00"))
+}
@@ -0,0 +1,10 @@
+package domain
+
+import "time"
+
+// Stash is a stash entry made on a session branch
+type Stash struct {
+	CreatedAt time.Time
+	Message   string // Stash subject without the "On <branch>: " prefix
+	Ref       string // Stash reference (e.g. stash@{0}), valid until the stash list changes
+}
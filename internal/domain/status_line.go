@@ -0,0 +1,95 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SymbolFlagged marks flagged sessions in the tmux status line
+const SymbolFlagged = "⚑"
+
+// SessionSummary is the part of a session the tmux status line shows
+type SessionSummary struct {
+	DisplayName string
+	IsFlagged   bool
+	Name        string
+	State       SessionState
+}
+
+// Label returns the display name of the session, or its name when it has none
+func (s SessionSummary) Label() string {
+	if s.DisplayName != "" {
+		return s.DisplayName
+	}
+	return s.Name
+}
+
+// StatusLineOptions configures FormatStatusLine
+type StatusLineOptions struct {
+	Accessible bool // Name states instead of showing their icons
+	MaxNames   int  // Names listed per group before the rest is counted as "+N" (0 lists none)
+}
+
+// FormatStatusLine summarizes sessions in one short line for the tmux status bar: the
+// count of sessions per state, then the sessions waiting for input and the flagged ones.
+// Exited sessions are only counted when there are some.
+func FormatStatusLine(sessions []SessionSummary, opts StatusLineOptions) string {
+	counts := make(map[SessionState]int)
+	var waiting, flagged []string
+	for _, session := range sessions {
+		counts[session.State]++
+		if session.State == StateWaiting {
+			waiting = append(waiting, session.Label())
+		}
+		if session.IsFlagged {
+			flagged = append(flagged, session.Label())
+		}
+	}
+
+	states := []struct {
+		state  SessionState
+		symbol string
+	}{
+		{StateWaiting, SymbolWaiting},
+		{StateWorking, SymbolWorking},
+		{StateIdle, SymbolIdle},
+		{StatePaused, SymbolPaused},
+		{StateExited, SymbolExited},
+	}
+	var parts []string
+	for _, s := range states {
+		if (s.state == StatePaused || s.state == StateExited) && counts[s.state] == 0 {
+			continue
+		}
+		if opts.Accessible {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[s.state], s.state))
+		} else {
+			parts = append(parts, fmt.Sprintf("%s%d", s.symbol, counts[s.state]))
+		}
+	}
+
+	separator, line := " · ", strings.Join(parts, " ")
+	waitingLabel, flaggedLabel := SymbolWaiting+" ", SymbolFlagged+" "
+	if opts.Accessible {
+		separator, line = "; ", strings.Join(parts, ", ")
+		waitingLabel, flaggedLabel = "waiting: ", "flagged: "
+	}
+	if names := statusLineNames(waiting, opts.MaxNames); names != "" {
+		line += separator + waitingLabel + names
+	}
+	if names := statusLineNames(flagged, opts.MaxNames); names != "" {
+		line += separator + flaggedLabel + names
+	}
+	return line
+}
+
+// statusLineNames lists up to max names, counting the rest as "+N"
+func statusLineNames(names []string, max int) string {
+	if len(names) == 0 || max <= 0 {
+		return ""
+	}
+	if len(names) <= max {
+		return strings.Join(names, ", ")
+	}
+	return fmt.Sprintf("%s +%d", strings.Join(names[:max], ", "), len(names)-max)
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatStatusLine(t *testing.T) {
+	sessions := []SessionSummary{
+		{DisplayName: "API", Name: "api", State: StateWaiting},
+		{Name: "web", State: StateWaiting},
+		{IsFlagged: true, Name: "docs", State: StateWorking},
+		{Name: "cli", State: StateWaiting},
+		{Name: "infra", State: StateIdle},
+	}
+
+	tests := []struct {
+		name     string
+		sessions []SessionSummary
+		opts     StatusLineOptions
+		want     string
+	}{
+		{
+			name: "no sessions",
+			opts: StatusLineOptions{MaxNames: 3},
+			want: "◐0 ●0 ○0",
+		},
+		{
+			name:     "counts and names",
+			sessions: sessions,
+			opts:     StatusLineOptions{MaxNames: 3},
+			want:     "◐3 ●1 ○1 · ◐ API, web, cli · ⚑ docs",
+		},
+		{
+			name:     "names beyond the limit are counted",
+			sessions: sessions,
+			opts:     StatusLineOptions{MaxNames: 1},
+			want:     "◐3 ●1 ○1 · ◐ API +2 · ⚑ docs",
+		},
+		{
+			name:     "counts only",
+			sessions: sessions,
+			want:     "◐3 ●1 ○1",
+		},
+		{
+			name:     "accessible with exited sessions",
+			sessions: append(sessions, SessionSummary{Name: "old", State: StateExited}),
+			opts:     StatusLineOptions{Accessible: true, MaxNames: 2},
+			want:     "3 waiting, 1 working, 1 idle, 1 exited; waiting: API, web +1; flagged: docs",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, FormatStatusLine(tt.sessions, tt.opts))
+		})
+	}
+}
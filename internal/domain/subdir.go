@@ -0,0 +1,29 @@
+package domain
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// NormalizeSubdir checks the subdirectory a session is scoped to (e.g., packages/api in a
+// monorepo) and returns it cleaned, with forward slashes. Empty scopes the session to the
+// whole checkout. It must stay inside the checkout, so absolute paths and ".." are refused.
+func NormalizeSubdir(subdir string) (string, error) {
+	trimmed := strings.TrimSpace(subdir)
+	if trimmed == "" {
+		return "", nil
+	}
+	if filepath.IsAbs(trimmed) || strings.HasPrefix(trimmed, "/") {
+		return "", fmt.Errorf("%w: subdirectory %q must be relative to the checkout", ErrInvalidInput, subdir)
+	}
+
+	cleaned := filepath.ToSlash(filepath.Clean(trimmed))
+	if cleaned == "." {
+		return "", nil
+	}
+	if cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+		return "", fmt.Errorf("%w: subdirectory %q is outside the checkout", ErrInvalidInput, subdir)
+	}
+	return cleaned, nil
+}
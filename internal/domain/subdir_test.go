@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeSubdir(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{name: "empty", input: "", expected: ""},
+		{name: "blank", input: "  ", expected: ""},
+		{name: "dot", input: ".", expected: ""},
+		{name: "relative", input: "packages/api", expected: "packages/api"},
+		{name: "cleaned", input: "./packages//api/", expected: "packages/api"},
+		{name: "inner parent stays inside", input: "packages/web/../api", expected: "packages/api"},
+		{name: "absolute", input: "/srv/api", wantErr: true},
+		{name: "parent", input: "..", wantErr: true},
+		{name: "escapes the checkout", input: "packages/../../api", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			subdir, err := NormalizeSubdir(tt.input)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, subdir)
+		})
+	}
+}
+
+func TestSession_StartDir(t *testing.T) {
+	tests := []struct {
+		name     string
+		session  Session
+		expected string
+	}{
+		{name: "whole worktree", session: Session{WorktreePath: "/wt/api"}, expected: "/wt/api"},
+		{name: "scoped worktree", session: Session{Subdir: "packages/api", WorktreePath: "/wt/mono"}, expected: "/wt/mono/packages/api"},
+		{name: "scoped external", session: Session{IsExternal: true, RepoPath: "/src/mono", Subdir: "services/web"}, expected: "/src/mono/services/web"},
+		{name: "no working directory", session: Session{Subdir: "packages/api"}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.session.StartDir())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"regexp"
+	"strings"
+	"time"
+)
+
+// TicketProvider identifies the issue tracker a repository's tickets live in
+type TicketProvider string
+
+const (
+	TicketProviderJira   TicketProvider = "jira"
+	TicketProviderLinear TicketProvider = "linear"
+)
+
+// DefaultTicketKeyPattern matches keys such as ABC-123, used by both Jira and Linear
+var DefaultTicketKeyPattern = regexp.MustCompile(`[A-Z][A-Z0-9]+-[0-9]+`)
+
+// TicketSyncRule describes how the sessions of one repository sync to tickets
+type TicketSyncRule struct {
+	Account    string         // Keychain account holding the API token
+	BaseURL    string         // Tracker URL (Jira site; empty uses the provider default)
+	KeyPattern *regexp.Regexp // Finds the ticket key in the branch or display name
+	Provider   TicketProvider
+	StatusMap  map[string]string // Session status -> ticket state name
+}
+
+// FindTicketKey returns the ticket key linked to a session, looking at the branch
+// name first and then the display name. Returns "" if neither contains a key.
+func (r TicketSyncRule) FindTicketKey(s Session) string {
+	pattern := r.KeyPattern
+	if pattern == nil {
+		pattern = DefaultTicketKeyPattern
+	}
+	for _, candidate := range []string{s.BranchName, strings.ToUpper(s.BranchName), s.DisplayName} {
+		if key := pattern.FindString(candidate); key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+// TicketState returns the ticket state mapped to a session status, if any
+func (r TicketSyncRule) TicketState(status string) (string, bool) {
+	state, ok := r.StatusMap[status]
+	return state, ok && state != ""
+}
+
+// TicketSyncRequest is a session whose status change is waiting to be synced to its ticket.
+// Later changes of the same session are folded into one request, so only the latest is synced.
+type TicketSyncRequest struct {
+	Attempts    int       // Syncs that failed so far
+	QueuedAt    time.Time // When the latest status change was queued
+	Revision    int       // Bumped by every change queued, to tell whether one came in during a sync
+	SessionName string
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTicketSyncRuleFindTicketKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    TicketSyncRule
+		session Session
+		want    string
+	}{
+		{
+			name:    "key in branch",
+			session: Session{BranchName: "feature/ENG-42-login"},
+			want:    "ENG-42",
+		},
+		{
+			name:    "lowercase key in branch",
+			session: Session{BranchName: "eng-42-login"},
+			want:    "ENG-42",
+		},
+		{
+			name:    "key in display name",
+			session: Session{BranchName: "login", DisplayName: "PROJ-7 login page"},
+			want:    "PROJ-7",
+		},
+		{
+			name:    "no key",
+			session: Session{BranchName: "login", DisplayName: "Login page"},
+			want:    "",
+		},
+		{
+			name:    "custom pattern",
+			rule:    TicketSyncRule{KeyPattern: regexp.MustCompile(`#[0-9]+`)},
+			session: Session{BranchName: "fix-#19"},
+			want:    "#19",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rule.FindTicketKey(tt.session))
+		})
+	}
+}
+
+func TestTicketSyncRuleTicketState(t *testing.T) {
+	rule := TicketSyncRule{StatusMap: map[string]string{"review": "In Review", "spec": ""}}
+
+	state, ok := rule.TicketState("review")
+	assert.True(t, ok)
+	assert.Equal(t, "In Review", state)
+
+	_, ok = rule.TicketState("spec")
+	assert.False(t, ok, "empty mapping means no transition")
+
+	_, ok = rule.TicketState("done")
+	assert.False(t, ok)
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DefaultTimeLayout is the layout of absolute timestamps when time_format is not set
+const DefaultTimeLayout = "2006-01-02 15:04"
+
+// TimeLayouts are the named layouts time_format accepts besides Go layouts
+var TimeLayouts = map[string]string{
+	"eu":      "02/01/2006 15:04",
+	"iso":     DefaultTimeLayout,
+	"rfc3339": time.RFC3339,
+	"seconds": "2006-01-02 15:04:05",
+	"short":   "Jan 2 15:04",
+	"us":      "01/02/2006 3:04 PM",
+}
+
+// TimeFormat is how absolute timestamps are shown in the TUI and CLI output.
+// The zero value uses DefaultTimeLayout in local time.
+type TimeFormat struct {
+	Layout   string         // Go layout, such as 2006-01-02 15:04
+	Location *time.Location // Nil for local time
+}
+
+// ParseTimeFormat builds a TimeFormat from a layout (a name in TimeLayouts or a Go layout)
+// and a time zone: local, utc, or an IANA name such as Europe/Lisbon; empty values use
+// the defaults
+func ParseTimeFormat(layout, zone string) (TimeFormat, error) {
+	var format TimeFormat
+
+	switch named, ok := TimeLayouts[strings.ToLower(layout)]; {
+	case layout == "":
+	case ok:
+		format.Layout = named
+	case time.Date(1999, 12, 31, 23, 58, 59, 0, time.UTC).Format(layout) == layout:
+		// A layout without any reference component renders as itself, whatever the time
+		return format, fmt.Errorf("%w: time format %q is neither a known name nor a Go layout such as 2006-01-02 15:04", ErrInvalidInput, layout)
+	default:
+		format.Layout = layout
+	}
+
+	switch strings.ToLower(zone) {
+	case "", "local":
+	case "utc":
+		format.Location = time.UTC
+	default:
+		location, err := time.LoadLocation(zone)
+		if err != nil {
+			return format, fmt.Errorf("%w: unknown time zone %q", ErrInvalidInput, zone)
+		}
+		format.Location = location
+	}
+	return format, nil
+}
+
+// In returns t in the time zone of the format
+func (f TimeFormat) In(t time.Time) time.Time {
+	if f.Location == nil {
+		return t.Local()
+	}
+	return t.In(f.Location)
+}
+
+// Format renders t in the layout and time zone of the format; zero times render empty
+func (f TimeFormat) Format(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	layout := f.Layout
+	if layout == "" {
+		layout = DefaultTimeLayout
+	}
+	return f.In(t).Format(layout)
+}
@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeFormat(t *testing.T) {
+	at := time.Date(2024, 1, 19, 14, 30, 5, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		layout  string
+		zone    string
+		want    string
+		wantErr bool
+	}{
+		{name: "defaults in utc", zone: "utc", want: "2024-01-19 14:30"},
+		{name: "named layout", layout: "us", zone: "UTC", want: "01/19/2024 2:30 PM"},
+		{name: "go layout", layout: "Mon 15:04:05", zone: "utc", want: "Fri 14:30:05"},
+		{name: "iana zone", layout: "seconds", zone: "Asia/Tokyo", want: "2024-01-19 23:30:05"},
+		{name: "layout without reference components", layout: "yyyy-mm-dd", wantErr: true},
+		{name: "unknown zone", zone: "Mars/Olympus", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := ParseTimeFormat(tt.layout, tt.zone)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, format.Format(at))
+		})
+	}
+}
+
+func TestTimeFormat_ZeroValue(t *testing.T) {
+	at := time.Date(2024, 1, 19, 14, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, at.Local().Format(DefaultTimeLayout), TimeFormat{}.Format(at))
+	assert.Empty(t, TimeFormat{}.Format(time.Time{}))
+}
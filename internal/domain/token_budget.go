@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// SessionTokenBudget caps the tokens a session's agent may use before rocha steps in.
+// Usage counts the input and output tokens of the Claude conversations in the session's
+// working directory, like the token chart.
+type SessionTokenBudget struct {
+	Exceeded bool // The exceeded budget was already enforced
+	Limit    int  // Tokens the session may use
+	Used     int  // Tokens used at the last check
+	WrapUp   bool // Ask the agent to wrap up and summarize once exceeded
+}
+
+// NewSessionTokenBudget creates a budget of limit tokens
+func NewSessionTokenBudget(limit int, wrapUp bool) (*SessionTokenBudget, error) {
+	if limit <= 0 {
+		return nil, fmt.Errorf("token budget must be positive: %w", ErrInvalidInput)
+	}
+	return &SessionTokenBudget{Limit: limit, WrapUp: wrapUp}, nil
+}
+
+// Reached reports whether the tokens used at the last check reached the limit
+func (b SessionTokenBudget) Reached() bool {
+	return b.Used >= b.Limit
+}
+
+// ParseTokenCount parses a token count with an optional k or M suffix, such as "200k" or "1.5M"
+func ParseTokenCount(value string) (int, error) {
+	invalid := fmt.Errorf("invalid token count %q (use e.g. 500000, 200k, or 1.5M): %w", value, ErrInvalidInput)
+
+	value = strings.TrimSpace(value)
+	multiplier := 1.0
+	switch {
+	case strings.HasSuffix(value, "k"), strings.HasSuffix(value, "K"):
+		multiplier = 1_000
+	case strings.HasSuffix(value, "m"), strings.HasSuffix(value, "M"):
+		multiplier = 1_000_000
+	}
+	if multiplier > 1 {
+		value = value[:len(value)-1]
+	}
+
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil || n <= 0 {
+		return 0, invalid
+	}
+	return int(n * multiplier), nil
+}
+
+// FormatTokenCount formats a token count with K/M suffixes
+func FormatTokenCount(count int) string {
+	if count >= 1_000_000 {
+		return fmt.Sprintf("%.1fM", float64(count)/1_000_000)
+	}
+	if count >= 1_000 {
+		return fmt.Sprintf("%.0fK", float64(count)/1_000)
+	}
+	return fmt.Sprintf("%d", count)
+}
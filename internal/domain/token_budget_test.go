@@ -0,0 +1,48 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTokenCount(t *testing.T) {
+	tests := []struct {
+		value string
+		want  int
+	}{
+		{value: "500000", want: 500_000},
+		{value: "200k", want: 200_000},
+		{value: "200K", want: 200_000},
+		{value: "1.5M", want: 1_500_000},
+		{value: " 2m ", want: 2_000_000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseTokenCount(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	for _, value := range []string{"", "k", "0", "-5k", "lots", "1.5G"} {
+		_, err := ParseTokenCount(value)
+		assert.ErrorIs(t, err, ErrInvalidInput, value)
+	}
+}
+
+func TestSessionTokenBudget(t *testing.T) {
+	_, err := NewSessionTokenBudget(0, false)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+
+	budget, err := NewSessionTokenBudget(200_000, true)
+	require.NoError(t, err)
+	assert.False(t, budget.Reached())
+
+	budget.Used = 200_000
+	assert.True(t, budget.Reached())
+	assert.Equal(t, "200K", FormatTokenCount(budget.Used))
+	assert.Equal(t, "1.5M", FormatTokenCount(1_520_000))
+}
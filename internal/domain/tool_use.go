@@ -0,0 +1,60 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+// MaxToolInputLength caps the raw tool input kept for a tool use
+const MaxToolInputLength = 4096
+
+// ToolUse is a tool call reported by the hooks of a session that skips permission prompts.
+// Those sessions run commands without asking, so rocha keeps an audit trail for review.
+type ToolUse struct {
+	Failed      bool // The tool reported a failure
+	ID          uint
+	Input       string // Raw tool input as JSON, truncated to MaxToolInputLength
+	OccurredAt  time.Time
+	SessionName string
+	Summary     string // Command, file path, or URL the tool acted on
+	ToolName    string
+}
+
+// NewToolUse builds a tool use from the tool name and input reported by a hook
+func NewToolUse(sessionName, toolName string, input json.RawMessage, failed bool, at time.Time) ToolUse {
+	raw := string(input)
+	if len(raw) > MaxToolInputLength {
+		raw = raw[:MaxToolInputLength] + "…"
+	}
+
+	return ToolUse{
+		Failed:      failed,
+		Input:       raw,
+		OccurredAt:  at,
+		SessionName: sessionName,
+		Summary:     summarizeToolInput(input),
+		ToolName:    toolName,
+	}
+}
+
+// summarizeToolInput picks the field that says what a tool acted on,
+// falling back to the compact input for tools without a known field
+func summarizeToolInput(input json.RawMessage) string {
+	var fields map[string]any
+	if err := json.Unmarshal(input, &fields); err != nil {
+		return strings.TrimSpace(string(input))
+	}
+
+	for _, key := range []string{"command", "file_path", "notebook_path", "url", "pattern", "query", "description"} {
+		if value, ok := fields[key].(string); ok && value != "" {
+			return value
+		}
+	}
+
+	compact, err := json.Marshal(fields)
+	if err != nil {
+		return ""
+	}
+	return string(compact)
+}
@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewToolUse(t *testing.T) {
+	at := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		toolName    string
+		input       string
+		wantSummary string
+	}{
+		{name: "bash command", toolName: "Bash", input: `{"command":"go test ./...","description":"Run tests"}`, wantSummary: "go test ./..."},
+		{name: "file edit", toolName: "Edit", input: `{"file_path":"/src/main.go","old_string":"a","new_string":"b"}`, wantSummary: "/src/main.go"},
+		{name: "web fetch", toolName: "WebFetch", input: `{"url":"https://example.com","prompt":"summarize"}`, wantSummary: "https://example.com"},
+		{name: "unknown tool falls back to compact input", toolName: "Custom", input: `{ "a": 1 }`, wantSummary: `{"a":1}`},
+		{name: "non-object input", toolName: "Custom", input: `"raw"`, wantSummary: `"raw"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			toolUse := NewToolUse("s1", tt.toolName, json.RawMessage(tt.input), false, at)
+
+			assert.Equal(t, tt.wantSummary, toolUse.Summary)
+			assert.Equal(t, tt.toolName, toolUse.ToolName)
+			assert.Equal(t, tt.input, toolUse.Input)
+			assert.Equal(t, at, toolUse.OccurredAt)
+		})
+	}
+}
+
+func TestNewToolUse_TruncatesLargeInput(t *testing.T) {
+	input := `{"content":"` + strings.Repeat("x", MaxToolInputLength) + `"}`
+
+	toolUse := NewToolUse("s1", "Write", json.RawMessage(input), false, time.Now())
+
+	assert.Len(t, toolUse.Input, MaxToolInputLength+len("…"))
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TranscriptFormat selects how a transcript is exported
+type TranscriptFormat string
+
+const (
+	TranscriptJSON     TranscriptFormat = "json"
+	TranscriptMarkdown TranscriptFormat = "md"
+)
+
+// TranscriptRole identifies who produced a transcript entry
+type TranscriptRole string
+
+const (
+	TranscriptAssistant TranscriptRole = "assistant"
+	TranscriptTool      TranscriptRole = "tool" // A tool call made by the assistant (summarized)
+	TranscriptUser      TranscriptRole = "user"
+)
+
+// TranscriptEntry is one message of a Claude conversation
+type TranscriptEntry struct {
+	ConversationID string         `json:"conversation_id"`
+	Role           TranscriptRole `json:"role"`
+	Text           string         `json:"text"`
+	Timestamp      time.Time      `json:"timestamp"`
+}
+
+// Transcript is the readable history of the Claude conversations of a session
+type Transcript struct {
+	Entries     []TranscriptEntry `json:"entries"`
+	SessionName string            `json:"session"`
+}
+
+// Markdown renders the transcript with one section per message.
+// A rule separates conversations when the session was restarted.
+func (t Transcript) Markdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Transcript: %s\n", t.SessionName)
+
+	var conversation string
+	for _, entry := range t.Entries {
+		if entry.ConversationID != conversation {
+			conversation = entry.ConversationID
+			fmt.Fprintf(&b, "\n---\n\n_Conversation %s_\n", conversation)
+		}
+
+		timestamp := entry.Timestamp.Local().Format("2006-01-02 15:04:05")
+		switch entry.Role {
+		case TranscriptTool:
+			fmt.Fprintf(&b, "\n> 🔧 %s · %s\n", entry.Text, timestamp)
+		case TranscriptUser:
+			fmt.Fprintf(&b, "\n## User · %s\n\n%s\n", timestamp, entry.Text)
+		default:
+			fmt.Fprintf(&b, "\n## Claude · %s\n\n%s\n", timestamp, entry.Text)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTranscriptMarkdown(t *testing.T) {
+	ts := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	transcript := Transcript{
+		Entries: []TranscriptEntry{
+			{ConversationID: "c1", Role: TranscriptUser, Text: "Fix the bug", Timestamp: ts},
+			{ConversationID: "c1", Role: TranscriptTool, Text: "Bash: go test ./...", Timestamp: ts},
+			{ConversationID: "c1", Role: TranscriptAssistant, Text: "Done.", Timestamp: ts},
+			{ConversationID: "c2", Role: TranscriptUser, Text: "Thanks", Timestamp: ts},
+		},
+		SessionName: "s1",
+	}
+
+	md := transcript.Markdown()
+
+	assert.True(t, strings.HasPrefix(md, "# Transcript: s1\n"))
+	assert.Contains(t, md, "_Conversation c1_")
+	assert.Contains(t, md, "_Conversation c2_")
+	assert.Contains(t, md, "\nFix the bug\n")
+	assert.Contains(t, md, "> 🔧 Bash: go test ./...")
+	assert.Contains(t, md, "\nDone.\n")
+	assert.Equal(t, 2, strings.Count(md, "## User"))
+	assert.Equal(t, 1, strings.Count(md, "## Claude"))
+}
@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// MaxViewShortcuts is how many views get a numbered shortcut (alt+1 to alt+9)
+const MaxViewShortcuts = 9
+
+// View is a named filter and sort of the session list, such as "Needs review"
+type View struct {
+	Filter     string // Filter text as typed in the list ("" lists every session)
+	Name       string
+	SortPreset string // Name of a sort preset ("" keeps the manual order)
+}
+
+// ValidateViews checks that views have unique names and use existing sort presets
+func ValidateViews(views []View, presets []SortPreset) error {
+	seen := make(map[string]bool, len(views))
+	for _, view := range views {
+		if strings.TrimSpace(view.Name) == "" {
+			return fmt.Errorf("%w: view with filter %q has no name", ErrInvalidInput, view.Filter)
+		}
+		if seen[view.Name] {
+			return fmt.Errorf("%w: view '%s' is defined twice", ErrInvalidInput, view.Name)
+		}
+		seen[view.Name] = true
+
+		isPreset := func(preset SortPreset) bool { return preset.Name == view.SortPreset }
+		if view.SortPreset != "" && !slices.ContainsFunc(presets, isPreset) {
+			return fmt.Errorf("%w: view '%s' uses sort preset '%s', which is not in sort_presets", ErrInvalidInput, view.Name, view.SortPreset)
+		}
+	}
+	return nil
+}
+
+// FindView returns the index of the view with the given name, or -1
+func FindView(views []View, name string) int {
+	return slices.IndexFunc(views, func(view View) bool { return view.Name == name })
+}
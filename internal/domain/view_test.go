@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateViews(t *testing.T) {
+	presets := []SortPreset{{Name: "attention"}}
+
+	tests := []struct {
+		name    string
+		views   []View
+		wantErr bool
+	}{
+		{name: "no views", views: nil},
+		{name: "filter and preset", views: []View{{Filter: "status:review", Name: "Needs review", SortPreset: "attention"}}},
+		{name: "manual order", views: []View{{Filter: "tag:client-x", Name: "Client X"}}},
+		{name: "no name", views: []View{{Filter: "state:waiting", Name: " "}}, wantErr: true},
+		{name: "duplicate name", views: []View{{Name: "Stale"}, {Name: "Stale"}}, wantErr: true},
+		{name: "unknown preset", views: []View{{Name: "Stale", SortPreset: "oldest"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateViews(tt.views, presets)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestFindView(t *testing.T) {
+	views := []View{{Name: "Needs review"}, {Name: "Stale"}}
+
+	assert.Equal(t, 1, FindView(views, "Stale"))
+	assert.Equal(t, -1, FindView(views, "stale"))
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// MaxWorkspaceNameLength caps a workspace name so it fits in the list header
+const MaxWorkspaceNameLength = 40
+
+// Workspace is a named set of sessions worked on together, such as a release or a client
+type Workspace struct {
+	CreatedAt time.Time
+	Name      string
+	Sessions  []string // Member session names, sorted
+}
+
+// NormalizeWorkspaceName lowercases and validates a workspace name.
+// Names follow the tag rules: letters, digits, '-', '_', and '.'.
+func NormalizeWorkspaceName(name string) (string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return "", fmt.Errorf("workspace name is required: %w", ErrInvalidInput)
+	}
+	if len(name) > MaxWorkspaceNameLength {
+		return "", fmt.Errorf("workspace name %q is longer than %d characters: %w", name, MaxWorkspaceNameLength, ErrInvalidInput)
+	}
+	if strings.IndexFunc(name, func(r rune) bool { return !isTagRune(r) }) >= 0 {
+		return "", fmt.Errorf("workspace name %q may only contain letters, digits, '-', '_', and '.': %w", name, ErrInvalidInput)
+	}
+	return name, nil
+}
+
+// InWorkspace reports whether the session belongs to the workspace
+func (s *Session) InWorkspace(workspace string) bool {
+	return slices.Contains(s.Workspaces, strings.ToLower(workspace))
+}
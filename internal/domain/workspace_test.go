@@ -0,0 +1,36 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeWorkspaceName(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{name: "lowercased and trimmed", input: "  Release-1.4 ", want: "release-1.4"},
+		{name: "underscores allowed", input: "client_acme", want: "client_acme"},
+		{name: "empty", input: "  ", wantErr: true},
+		{name: "spaces rejected", input: "client acme", wantErr: true},
+		{name: "too long", input: strings.Repeat("a", MaxWorkspaceNameLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NormalizeWorkspaceName(tt.input)
+			if tt.wantErr {
+				require.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// BootstrapStep prepares a new worktree before the agent starts, such as copying
+// an untracked .env from the main checkout or installing dependencies.
+// Exactly one of Copy or Run is set.
+type BootstrapStep struct {
+	Copy string // File or directory, relative to the repository, copied from the main checkout
+	Run  string // Shell command run in the worktree
+}
+
+// Validate checks that the step does exactly one thing and that copies stay inside the repository
+func (s BootstrapStep) Validate() error {
+	copyPath := strings.TrimSpace(s.Copy)
+	command := strings.TrimSpace(s.Run)
+
+	switch {
+	case copyPath == "" && command == "":
+		return fmt.Errorf("bootstrap step needs copy or run: %w", ErrInvalidInput)
+	case copyPath != "" && command != "":
+		return fmt.Errorf("bootstrap step cannot both copy and run: %w", ErrInvalidInput)
+	case copyPath != "" && !filepath.IsLocal(copyPath):
+		return fmt.Errorf("bootstrap copy path '%s' must be relative to the repository: %w", s.Copy, ErrInvalidInput)
+	}
+	return nil
+}
+
+// String describes the step for progress output
+func (s BootstrapStep) String() string {
+	if s.Copy != "" {
+		return "copy " + s.Copy
+	}
+	return "run " + s.Run
+}
@@ -0,0 +1,40 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBootstrapStepValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		step    BootstrapStep
+		wantErr bool
+	}{
+		{name: "copy", step: BootstrapStep{Copy: ".env"}},
+		{name: "copy nested path", step: BootstrapStep{Copy: "config/local.yaml"}},
+		{name: "run", step: BootstrapStep{Run: "make deps"}},
+		{name: "empty", step: BootstrapStep{}, wantErr: true},
+		{name: "blank run", step: BootstrapStep{Run: "  "}, wantErr: true},
+		{name: "copy and run", step: BootstrapStep{Copy: ".env", Run: "make deps"}, wantErr: true},
+		{name: "absolute copy path", step: BootstrapStep{Copy: "/etc/passwd"}, wantErr: true},
+		{name: "copy path escaping repository", step: BootstrapStep{Copy: "../secrets/.env"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.step.Validate()
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestBootstrapStepString(t *testing.T) {
+	assert.Equal(t, "copy .env", BootstrapStep{Copy: ".env"}.String())
+	assert.Equal(t, "run make deps", BootstrapStep{Run: "make deps"}.String())
+}
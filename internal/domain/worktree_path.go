@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// WorktreePathFields are the values a worktree path template can use
+type WorktreePathFields struct {
+	Branch   string // Branch with slashes replaced by dashes, such as feature-login
+	Owner    string // Repository owner, from owner/repo
+	Repo     string // Repository name, from owner/repo
+	RepoDir  string // Base name of the main checkout directory
+	RepoPath string // Absolute path of the main checkout
+	Session  string // Session name
+}
+
+// ParseWorktreePathTemplate parses a template of where the worktrees of a repository go,
+// such as "../{{.RepoDir}}-{{.Branch}}" for siblings of the main checkout
+func ParseWorktreePathTemplate(text string) (*template.Template, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("%w: empty worktree path template", ErrInvalidInput)
+	}
+	tmpl, err := template.New("worktree_path").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid worktree path template: %v", ErrInvalidInput, err)
+	}
+	return tmpl, nil
+}
+
+// NewWorktreePathFields builds the template fields of a session worktree
+func NewWorktreePathFields(repoInfo, repoPath, branch, sessionName string) WorktreePathFields {
+	owner, repo, _ := strings.Cut(repoInfo, "/")
+	return WorktreePathFields{
+		Branch:   strings.ReplaceAll(branch, "/", "-"),
+		Owner:    owner,
+		Repo:     repo,
+		RepoDir:  filepath.Base(repoPath),
+		RepoPath: repoPath,
+		Session:  sessionName,
+	}
+}
+
+// RenderWorktreePath fills tmpl with fields; a relative result is taken from the main checkout
+func RenderWorktreePath(tmpl *template.Template, fields WorktreePathFields) (string, error) {
+	var out strings.Builder
+	if err := tmpl.Execute(&out, fields); err != nil {
+		return "", fmt.Errorf("%w: worktree path template: %v", ErrInvalidInput, err)
+	}
+
+	path := strings.TrimSpace(out.String())
+	if path == "" {
+		return "", fmt.Errorf("%w: worktree path template rendered an empty path", ErrInvalidInput)
+	}
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(fields.RepoPath, path)
+	}
+	path = filepath.Clean(path)
+	if path == filepath.Clean(fields.RepoPath) {
+		return "", fmt.Errorf("%w: worktree path template points at the main checkout", ErrInvalidInput)
+	}
+	return path, nil
+}
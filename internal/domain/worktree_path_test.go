@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderWorktreePath(t *testing.T) {
+	fields := NewWorktreePathFields("acme/app", "/src/app", "feature/login", "login")
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+		wantErr  bool
+	}{
+		{name: "sibling of the checkout", template: "../{{.RepoDir}}-{{.Branch}}", want: "/src/app-feature-login"},
+		{name: "inside the checkout", template: ".worktrees/{{.Session}}", want: "/src/app/.worktrees/login"},
+		{name: "absolute", template: "/work/{{.Owner}}/{{.Repo}}/{{.Session}}", want: "/work/acme/app/login"},
+		{name: "main checkout is refused", template: ".", wantErr: true},
+		{name: "empty render is refused", template: "{{if false}}x{{end}}", wantErr: true},
+		{name: "unknown field is refused", template: "../{{.Nope}}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseWorktreePathTemplate(tt.template)
+			require.NoError(t, err)
+
+			path, err := RenderWorktreePath(tmpl, fields)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, path)
+		})
+	}
+}
+
+func TestParseWorktreePathTemplate_Invalid(t *testing.T) {
+	for _, text := range []string{"", "  ", "../{{.Branch"} {
+		_, err := ParseWorktreePathTemplate(text)
+		assert.ErrorIs(t, err, ErrInvalidInput, text)
+	}
+}
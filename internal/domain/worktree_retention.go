@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+)
+
+// WorktreeRetention removes the worktrees of sessions archived for too long
+type WorktreeRetention struct {
+	After time.Duration // Time a session stays archived before its worktree is removed (0 disables the policy)
+}
+
+// NewWorktreeRetention creates the policy for worktrees archived at least days days (0 disables it)
+func NewWorktreeRetention(days int) (WorktreeRetention, error) {
+	if days < 0 {
+		return WorktreeRetention{}, fmt.Errorf("invalid worktree retention %d days (use 0 to keep worktrees): %w", days, ErrInvalidInput)
+	}
+	return WorktreeRetention{After: time.Duration(days) * 24 * time.Hour}, nil
+}
+
+// IsEnabled returns true if worktrees of archived sessions are removed at all
+func (r WorktreeRetention) IsEnabled() bool {
+	return r.After > 0
+}
+
+// Expired reports whether the worktree of s is past retention at now: the session has been
+// archived for at least After, has a worktree managed by rocha, and did not opt out
+func (r WorktreeRetention) Expired(s Session, now time.Time) bool {
+	if !r.IsEnabled() || !s.IsArchived || s.ArchivedAt == nil || s.KeepWorktree {
+		return false
+	}
+	if s.IsExternal || s.WorktreePath == "" {
+		return false
+	}
+	return now.Sub(*s.ArchivedAt) >= r.After
+}
+
+// WorktreeGCCandidate is a worktree past retention and what the cleanup does with it
+type WorktreeGCCandidate struct {
+	Err     error // The worktree could not be checked, or removing it failed
+	Removed bool
+	Session Session
+	Status  *WorktreeStatus // nil when the check failed
+}
+
+// Removable returns true if the worktree is clean and pushed, so removing it loses nothing
+func (c WorktreeGCCandidate) Removable() bool {
+	return c.Err == nil && c.Status != nil && !c.Status.HasLocalWork()
+}
+
+// Reason explains what the cleanup does, or did, with the worktree
+func (c WorktreeGCCandidate) Reason() string {
+	switch {
+	case c.Removed:
+		return "removed"
+	case c.Err != nil:
+		return fmt.Sprintf("kept: %v", c.Err)
+	case c.Status == nil:
+		return "kept: status unknown"
+	case len(c.Status.UncommittedFiles) > 0 && len(c.Status.UnpushedCommits) > 0:
+		return "kept: uncommitted changes and unpushed commits"
+	case len(c.Status.UncommittedFiles) > 0:
+		return "kept: uncommitted changes"
+	case len(c.Status.UnpushedCommits) > 0:
+		return "kept: unpushed commits"
+	default:
+		return "remove: clean and pushed"
+	}
+}
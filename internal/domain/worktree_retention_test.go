@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewWorktreeRetention(t *testing.T) {
+	retention, err := NewWorktreeRetention(14)
+	require.NoError(t, err)
+	assert.Equal(t, 14*24*time.Hour, retention.After)
+	assert.True(t, retention.IsEnabled())
+
+	retention, err = NewWorktreeRetention(0)
+	require.NoError(t, err)
+	assert.False(t, retention.IsEnabled())
+
+	_, err = NewWorktreeRetention(-1)
+	assert.ErrorIs(t, err, ErrInvalidInput)
+}
+
+func TestWorktreeRetention_Expired(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	longAgo := now.Add(-10 * 24 * time.Hour)
+	recently := now.Add(-2 * 24 * time.Hour)
+	retention := WorktreeRetention{After: 7 * 24 * time.Hour}
+
+	tests := []struct {
+		name      string
+		retention WorktreeRetention
+		session   Session
+		want      bool
+	}{
+		{"archived past retention", retention, Session{IsArchived: true, ArchivedAt: &longAgo, WorktreePath: "/wt"}, true},
+		{"archived recently", retention, Session{IsArchived: true, ArchivedAt: &recently, WorktreePath: "/wt"}, false},
+		{"not archived", retention, Session{ArchivedAt: &longAgo, WorktreePath: "/wt"}, false},
+		{"archive time unknown", retention, Session{IsArchived: true, WorktreePath: "/wt"}, false},
+		{"kept", retention, Session{IsArchived: true, ArchivedAt: &longAgo, KeepWorktree: true, WorktreePath: "/wt"}, false},
+		{"external directory", retention, Session{IsArchived: true, ArchivedAt: &longAgo, IsExternal: true, WorktreePath: "/src"}, false},
+		{"no worktree", retention, Session{IsArchived: true, ArchivedAt: &longAgo}, false},
+		{"disabled", WorktreeRetention{}, Session{IsArchived: true, ArchivedAt: &longAgo, WorktreePath: "/wt"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.retention.Expired(tt.session, now))
+		})
+	}
+}
+
+func TestWorktreeGCCandidate_Reason(t *testing.T) {
+	tests := []struct {
+		name      string
+		candidate WorktreeGCCandidate
+		removable bool
+		want      string
+	}{
+		{"clean", WorktreeGCCandidate{Status: &WorktreeStatus{}}, true, "remove: clean and pushed"},
+		{"removed", WorktreeGCCandidate{Removed: true, Status: &WorktreeStatus{}}, true, "removed"},
+		{"uncommitted", WorktreeGCCandidate{Status: &WorktreeStatus{UncommittedFiles: []string{" M a.go"}}}, false, "kept: uncommitted changes"},
+		{"unpushed", WorktreeGCCandidate{Status: &WorktreeStatus{UnpushedCommits: []string{"abc fix"}}}, false, "kept: unpushed commits"},
+		{"both", WorktreeGCCandidate{Status: &WorktreeStatus{UncommittedFiles: []string{"?? b.go"}, UnpushedCommits: []string{"abc fix"}}}, false, "kept: uncommitted changes and unpushed commits"},
+		{"check failed", WorktreeGCCandidate{Err: errors.New("not a git repository")}, false, "kept: not a git repository"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.removable, tt.candidate.Removable())
+			assert.Equal(t, tt.want, tt.candidate.Reason())
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WorktreeStatus describes work in a worktree that would be lost if it were removed
+type WorktreeStatus struct {
+	UncommittedFiles []string // git status --porcelain lines (staged, unstaged, and untracked)
+	UnpushedCommits  []string // "<hash> <subject>" of commits not on any remote
+}
+
+// HasLocalWork returns true if the worktree has uncommitted changes or unpushed commits
+func (s *WorktreeStatus) HasLocalWork() bool {
+	return len(s.UncommittedFiles) > 0 || len(s.UnpushedCommits) > 0
+}
+
+// Describe lists the local work, showing at most limit entries of each kind
+func (s *WorktreeStatus) Describe(limit int) string {
+	var b strings.Builder
+	writeList := func(items []string, singular, plural string) {
+		if len(items) == 0 {
+			return
+		}
+		noun := plural
+		if len(items) == 1 {
+			noun = singular
+		}
+		fmt.Fprintf(&b, "%d %s:\n", len(items), noun)
+		for i, item := range items {
+			if i == limit {
+				fmt.Fprintf(&b, "  ...and %d more\n", len(items)-limit)
+				break
+			}
+			fmt.Fprintf(&b, "  %s\n", item)
+		}
+	}
+
+	writeList(s.UncommittedFiles, "uncommitted file", "uncommitted files")
+	writeList(s.UnpushedCommits, "unpushed commit", "unpushed commits")
+	return strings.TrimSuffix(b.String(), "\n")
+}
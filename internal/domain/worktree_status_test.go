@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorktreeStatusDescribe(t *testing.T) {
+	tests := []struct {
+		name   string
+		status WorktreeStatus
+		limit  int
+		want   string
+	}{
+		{
+			name:   "clean",
+			status: WorktreeStatus{},
+			limit:  5,
+			want:   "",
+		},
+		{
+			name:   "single file",
+			status: WorktreeStatus{UncommittedFiles: []string{" M main.go"}},
+			limit:  5,
+			want:   "1 uncommitted file:\n   M main.go",
+		},
+		{
+			name: "files and commits over limit",
+			status: WorktreeStatus{
+				UncommittedFiles: []string{"?? a.txt", "?? b.txt", "?? c.txt"},
+				UnpushedCommits:  []string{"abc1234 Fix login"},
+			},
+			limit: 2,
+			want:  "3 uncommitted files:\n  ?? a.txt\n  ?? b.txt\n  ...and 1 more\n1 unpushed commit:\n  abc1234 Fix login",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.status.Describe(tt.limit))
+			assert.Equal(t, tt.want != "", tt.status.HasLocalWork())
+		})
+	}
+}
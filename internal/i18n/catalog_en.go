@@ -0,0 +1,403 @@
+package i18n
+
+// english is the catalog every other language translates, and the fallback for
+// messages a catalog lacks. Key bindings have "key.<name>.help" and, when they
+// are worth a rotating tip, "key.<name>.tip" with a %s for the key.
+var english = map[string]string{
+	// Session list
+	"list.escalated":           "%d escalated",
+	"list.exited":              "%d exited",
+	"list.following":           "following rocha pid %d",
+	"list.handoff_placeholder": "where did you leave off? (enter to save, esc to skip)",
+	"list.hint_open":           "open Claude",
+	"list.hint_return":         "return here",
+	"list.idle":                "%d idle",
+	"list.paused":              "%d paused",
+	"list.shortcuts":           "shortcuts",
+	"list.sort":                "sort: %s",
+	"list.view":                "view: %s",
+	"list.waiting":             "%d waiting",
+	"list.working":             "%d working",
+	"list.workspace":           "workspace: %s",
+	"filter.label":             "filter:",
+	"filter.remove_last":       "remove last",
+	"tip.prefix":               "tip:",
+	"palette.empty":            "No matching actions",
+	"palette.placeholder":      "type to filter",
+	"palette.prompt":           "Filter: ",
+	"palette.selected_session": "selected session: %s",
+	"palette.title":            "Command Palette",
+
+	// Dialog titles
+	"dialog.archive":          "Archive Session",
+	"dialog.orphan_shells":    "Clean Up Orphan Shells",
+	"dialog.attachments":      "Session Attachments",
+	"dialog.checks":           "Checks: %s",
+	"dialog.comment":          "Edit Session Comment",
+	"dialog.debug":            "State Detection Debug",
+	"dialog.help":             "Help",
+	"dialog.move":             "Move Sessions",
+	"dialog.new":              "Create Session",
+	"dialog.new_from_clip":    "Create Session (from clipboard)",
+	"dialog.new_from_repo":    "Create Session (from same repo)",
+	"dialog.note":             "Edit Session Note",
+	"dialog.profile":          "Switch Profile",
+	"dialog.rebase_conflicts": "Rebase Conflicts",
+	"dialog.remove_worktree":  "Remove Worktree",
+	"dialog.rename":           "Rename Session",
+	"dialog.restart":          "Restart Session",
+	"dialog.send_text":        "Send Text to Claude",
+	"dialog.status":           "Set Status",
+	"dialog.switch_branch":    "Switch Branch",
+	"dialog.tags":             "Edit Session Tags",
+	"dialog.timer":            "Set Session Timer",
+	"dialog.tool_audit":       "Tool Audit: %s",
+	"dialog.views":            "Views",
+	"dialog.workspace":        "Switch Workspace",
+
+	// Forms
+	"form.cancel":     "Cancel",
+	"form.esc_cancel": "Press esc to cancel",
+	"form.no":         "No",
+	"form.ok":         "OK",
+	"form.session":    "Session: %s",
+	"form.yes":        "Yes",
+
+	// Create session form
+	"new_session.agent_args":                "Extra Claude args (optional)",
+	"new_session.agent_args_desc":           "Passed to claude on every start, e.g. --add-dir ../shared",
+	"new_session.bookmark":                  "Bookmark: %s",
+	"new_session.bookmarks":                 "Bookmarks: %s",
+	"new_session.branch":                    "Override branch name (optional)",
+	"new_session.branch_desc":               "Leave empty to use suggested name above. Must match git naming rules.",
+	"new_session.branch_invalid":            "invalid branch name: %v",
+	"new_session.branch_invalid_suggestion": "invalid branch name: %v (suggestion: %s)",
+	"new_session.claude_dir":                "Claude directory (optional)",
+	"new_session.claude_dir_desc":           "Leave empty to use default: %s",
+	"new_session.claude_dir_invalid":        "path must be absolute or start with ~",
+	"new_session.close":                     "Press enter or esc to close",
+	"new_session.conflict":                  "Session '%s' already exists",
+	"new_session.conflict_archived":         "It is archived; press esc to cancel",
+	"new_session.conflict_reuse":            "Open the existing '%s' instead",
+	"new_session.conflict_suffix":           "Create it as '%s'",
+	"new_session.creating":                  "Creating session...",
+	"new_session.detected_branch":           "Detected branch: %s",
+	"new_session.directory":                 "Use directory as-is (optional)",
+	"new_session.directory_desc":            "Run in an existing directory without creating a branch or worktree. Overrides repository and branch.",
+	"new_session.directory_missing":         "directory does not exist",
+	"new_session.failed":                    "Session not created: %s",
+	"new_session.matching_bookmarks":        "Matching bookmarks: %s",
+	"new_session.model":                     "Model (optional)",
+	"new_session.model_desc":                "%s, or a model ID. Empty uses Claude's default.",
+	"new_session.name":                      "Session name",
+	"new_session.name_required":             "session name required",
+	"new_session.name_taken":                "⚠ Session '%s' already exists",
+	"new_session.prompt":                    "Initial prompt (optional)",
+	"new_session.prompt_desc":               "Send this prompt to Claude when session starts. (%d/2000)",
+	"new_session.repo":                      "Repository (optional)",
+	"new_session.repo_desc":                 "Git remote URL or bookmark name. Leave empty for current directory.",
+	"new_session.repo_invalid":              "must be a git URL (e.g., https://github.com/owner/repo or git@github.com:owner/repo) or a bookmark name",
+	"new_session.repo_tip":                  "Tip: Add #branch-name to specify a remote branch (e.g., https://github.com/owner/repo#main)",
+	"new_session.skip_permissions":          "Skip permission prompts? (DANGEROUS)",
+	"new_session.skip_permissions_desc":     "Allows Claude to execute commands without asking. Use with caution!",
+	"new_session.subdir":                    "Subdirectory (optional)",
+	"new_session.subdir_desc":               "Start in a directory inside the checkout, e.g. packages/api in a monorepo",
+	"new_session.suggested_branch":          "Suggested branch name: %s",
+
+	// Worktree removal form
+	"remove_worktree.archive_desc":    "Archive will hide the session. Remove the worktree too?",
+	"remove_worktree.keep":            "Keep",
+	"remove_worktree.kill_desc":       "This will delete the working tree but preserve commits.",
+	"remove_worktree.local_work":      "⚠ This worktree has work that only exists locally:",
+	"remove_worktree.remove":          "Remove",
+	"remove_worktree.title":           "Remove worktree at %s?",
+	"remove_worktree.type_name":       "Type '%s' to remove the worktree and lose this work",
+	"remove_worktree.type_to_confirm": "type '%s' to confirm",
+	"remove_worktree.unknown":         "⚠ Could not check this worktree for uncommitted changes or unpushed commits.",
+
+	// Rebase conflicts form
+	"rebase_conflicts.abort":       "Abort rebase",
+	"rebase_conflicts.ask_claude":  "Ask Claude to resolve the conflicts",
+	"rebase_conflicts.files":       "Conflicted files:",
+	"rebase_conflicts.more":        "... and %d more",
+	"rebase_conflicts.open_editor": "Open in editor",
+	"rebase_conflicts.title":       "Rebase onto %s stopped with conflicts",
+
+	// Send text form
+	"send_text.dangerous":      "Send a dangerous prompt?",
+	"send_text.dangerous_desc": "%s runs with skip-permissions, so the agent will not ask before acting.\nThe text contains: %s",
+	"send_text.desc":           "Text will be sent to session: %s",
+	"send_text.history":        "Prompt history",
+	"send_text.history_desc":   "Prompts sent to %s, newest first · esc to go back",
+	"send_text.history_hint":   "↑/↓ recall previous prompts · ctrl+r browse history",
+	"send_text.send":           "Send",
+	"send_text.title":          "Send text to Claude",
+
+	// Attachments form
+	"attachments.action":        "What do you want to do with it?",
+	"attachments.add":           "+ Attach a file",
+	"attachments.file":          "File to attach",
+	"attachments.file_desc":     "Path of a screenshot, mockup, or any other file",
+	"attachments.file_required": "give the path of a file",
+	"attachments.open":          "Open in the system viewer",
+	"attachments.remove":        "Remove from the session (keeps the file)",
+	"attachments.send":          "Send to the agent",
+	"attachments.title":         "Attachments",
+
+	// Switch branch form
+	"switch_branch.checked_out": "(checked out in %s)",
+	"switch_branch.current":     "(current)",
+	"switch_branch.in_use":      "'%s' is already checked out",
+	"switch_branch.tell_agent":  "Tell the agent about the switch?",
+	"switch_branch.title":       "Switch branch",
+
+	// Comment form
+	"comment.desc":  "Comment for: %s (empty to delete)",
+	"comment.title": "Session comment",
+
+	// Move sessions form
+	"move.confirm":          "Move the sessions now?",
+	"move.confirm_desc":     "Their tmux sessions are killed first; open the destination home to restart them",
+	"move.dest":             "Destination ROCHA_HOME",
+	"move.dest_current":     "destination is the current ROCHA_HOME",
+	"move.dest_desc":        "Created if missing (tab completes known homes)",
+	"move.dest_required":    "destination cannot be empty",
+	"move.move":             "Move",
+	"move.name_invalid":     "name must contain letters or numbers",
+	"move.name_taken":       "'%s' is already taken at the destination",
+	"move.nothing_left":     "no sessions left to move",
+	"move.plan_conflict":    "(conflict)",
+	"move.plan_main":        "Main repository: %s (copied if a session stays here)",
+	"move.plan_main_reused": "Main repository: reusing the clone at the destination",
+	"move.plan_no_main":     "No main repository directory",
+	"move.plan_sessions":    "%d session(s) move from %s",
+	"move.rename_desc":      "New name at the destination (empty keeps the session here)",
+	"move.repo":             "Repository to move",
+	"move.repo_desc":        "All its sessions in %s",
+	"move.title":            "Move %s to %s",
+	"move.worktree_exists":  "%s already exists",
+
+	// Note form
+	"note.desc":  "Note for: %s (empty to delete, ctrl+e for editor)",
+	"note.title": "Session note (markdown)",
+
+	// Profile form
+	"profile.desc":  "rocha restarts with the settings of the chosen profile",
+	"profile.empty": "No profiles yet. Create one with: rocha config set <key> <value> --profile <name>",
+	"profile.none":  "No profile",
+	"profile.title": "Switch profile",
+
+	// Rename form
+	"rename.desc":  "Renaming: %s",
+	"rename.taken": "session %s already exists",
+	"rename.title": "New session name",
+
+	// Restart form
+	"restart.fresh":  "Start a fresh conversation",
+	"restart.resume": "Resume Claude conversation",
+	"restart.shell":  "Shell only (no Claude)",
+	"restart.title":  "Claude has exited in this session",
+
+	// Status form
+	"status.clear": "<clear>",
+	"status.title": "Set implementation status",
+
+	// Tags form
+	"tags.desc":  "Tags for: %s (space-separated, empty to clear)",
+	"tags.title": "Session tags",
+
+	// Timer form
+	"timer.desc":    "Time until %s alerts you (e.g. 20m or 1h30m, empty to clear)",
+	"timer.invalid": "use a duration such as 20m or 1h30m",
+	"timer.label":   "Reminder (optional)",
+	"timer.title":   "Timer",
+
+	// Views form
+	"views.desc":          "Applies the filter and sort preset of the view",
+	"views.empty":         "No views yet. Filter and sort the list, then save it as a view",
+	"views.name":          "View name",
+	"views.name_desc":     "A view with the same name is replaced",
+	"views.name_required": "give the view a name",
+	"views.save":          "+ Save the current filter and sort as a view",
+
+	// Workspace form
+	"workspace.all":    "All sessions",
+	"workspace.desc":   "Only the sessions of the chosen workspace are listed",
+	"workspace.empty":  "No workspaces yet. Create one with: rocha workspaces create <name> [sessions...]",
+	"workspace.option": "%s (%d sessions)",
+	"workspace.title":  "Switch workspace",
+
+	// Orphan shells cleanup
+	"orphan_shells.clean":   "Clean up",
+	"orphan_shells.confirm": "Clean up %d orphan shells?",
+	"orphan_shells.gone":    "%s (of %s) no longer runs and is forgotten",
+	"orphan_shells.in_use":  "Shells with a client attached, or opened in the last few minutes, are left running.",
+	"orphan_shells.none":    "No orphan shells: every shell runs next to its session",
+	"orphan_shells.running": "%s is killed, since %s is not running",
+
+	// Help screen
+	"help.empty":                       "No matching shortcuts",
+	"help.experimental":                "%s (experimental)",
+	"help.footer":                      "Type to search • ↑↓/PgUp/PgDn to select • esc to clear the search or close",
+	"help.group.application":           "Application",
+	"help.group.experimental":          "Experimental Features",
+	"help.group.indicators":            "State Indicators (read-only)",
+	"help.group.inside_session":        "Inside Session Shortcuts",
+	"help.group.navigation":            "Navigation",
+	"help.group.session_actions":       "Session Actions",
+	"help.group.session_management":    "Session Management",
+	"help.group.session_metadata":      "Session Metadata",
+	"help.indicator.comment":           "session has comment",
+	"help.indicator.exited":            "session has exited",
+	"help.indicator.flagged":           "session has flag set",
+	"help.indicator.idle":              "session is idle",
+	"help.indicator.no_worktree":       "session uses a directory as-is (no worktree)",
+	"help.indicator.paused":            "session is paused (the agent uses no tokens until resumed)",
+	"help.indicator.shell":             "shell session active",
+	"help.indicator.skips_permissions": "session skips permission prompts (tools are audited)",
+	"help.indicator.status":            "implementation status",
+	"help.indicator.throttled":         "queued prompts wait for the concurrency limit",
+	"help.indicator.waiting":           "session is waiting",
+	"help.indicator.working":           "session is working",
+	"help.inside.detach":               "quick return to list",
+	"help.inside.swap":                 "swap between claude and shell sessions",
+	"help.inside.tmux_detach":          "standard tmux detach (also works)",
+	"help.inside.tmux_detach_key":      "ctrl+b then d",
+	"help.not_applicable":              "(not for the selected session)",
+	"help.remap":                       "remap with keys.%s in settings.json",
+	"help.run":                         "try it on the selected session",
+	"help.search_placeholder":          "search shortcuts, categories, or settings",
+	"help.search_prompt":               "? ",
+	"help.setting":                     "edit %s in settings.json",
+
+	// Application keys
+	"key.clean_orphan_shells.help":  "clean up shells of stopped sessions",
+	"key.command_palette.help":      "command palette",
+	"key.command_palette.tip":       "press %s to open the command palette",
+	"key.debug_screen.help":         "state detection debug screen",
+	"key.detail_pane.help":          "toggle detail pane",
+	"key.detail_pane.tip":           "press %s to see everything about the selected session side by side",
+	"key.force_quit.help":           "force quit",
+	"key.help.help":                 "show keyboard shortcuts",
+	"key.help.tip":                  "press %s to see all shortcuts",
+	"key.note_pane.help":            "toggle note pane",
+	"key.note_pane.tip":             "press %s to read the selected session's note",
+	"key.open_settings.help":        "open settings in editor",
+	"key.open_settings.tip":         "press %s to edit settings.json in your editor",
+	"key.quit.help":                 "exit application",
+	"key.switch_profile.help":       "switch settings profile",
+	"key.switch_profile.tip":        "press %s to restart with another settings profile",
+	"key.timestamps.help":           "toggle timestamps",
+	"key.timestamps.tip":            "press %s to toggle timestamp display",
+	"key.token_chart.help":          "toggle token chart",
+	"key.token_chart.tip":           "press %s to toggle token usage chart",
+	"key.token_chart_by_model.help": "token chart per model",
+	"key.token_chart_by_model.tip":  "press %s to split the token chart per model",
+	"key.token_chart_cache.help":    "token chart cache tokens",
+	"key.token_chart_cache.tip":     "press %s to include cache tokens in the token chart",
+
+	// Navigation keys
+	"key.apply_view.help":         "apply view 1-9",
+	"key.clear_filter.help":       "clear filter (press twice within 500ms)",
+	"key.clear_filter.tip":        "press %s twice to clear the filter",
+	"key.cycle_density.help":      "cycle list density",
+	"key.cycle_density.tip":       "press %s to switch between compact, normal, and detailed rows",
+	"key.cycle_sort.help":         "cycle sort preset",
+	"key.cycle_sort.tip":          "press %s to cycle the sort presets from settings.json",
+	"key.down.help":               "select next session",
+	"key.filter.help":             "filter session list",
+	"key.filter.tip":              "press %s to filter sessions by name, branch, or tokens like state:idle and older:7d",
+	"key.move_down.help":          "move session down",
+	"key.move_up.help":            "move session up",
+	"key.move_up.tip":             "press %s to reorder sessions in the list",
+	"key.remove_filter_chip.help": "remove the last filter chip",
+	"key.remove_filter_chip.tip":  "press %s to drop the last chip of an applied filter like state:waiting repo:acme",
+	"key.switch_workspace.help":   "switch workspace",
+	"key.switch_workspace.tip":    "press %s to show only the sessions of one workspace",
+	"key.up.help":                 "select previous session",
+	"key.views.help":              "apply or save a named view",
+	"key.views.tip":               "press %s to switch between saved filter and sort combinations",
+
+	// Session management keys
+	"key.archive.help":            "archive session",
+	"key.archive.tip":             "press %s to archive a session (hidden from list)",
+	"key.kill.help":               "kill session and worktree",
+	"key.kill.tip":                "press %s to kill a session and optionally remove its worktree",
+	"key.kill_process.help":       "kill agent process (keep session)",
+	"key.kill_process.tip":        "press %s to stop a runaway agent process without killing its session",
+	"key.move_sessions.help":      "move repository sessions to another ROCHA_HOME",
+	"key.move_sessions.tip":       "press %s to move a repository's sessions to another ROCHA_HOME, resolving name collisions",
+	"key.new_from_clipboard.help": "create new session from clipboard",
+	"key.new_from_clipboard.tip":  "press %s to create a session from a git URL, issue URL, or task description in the clipboard",
+	"key.new_from_repo.help":      "create new session from same repo",
+	"key.new_from_repo.tip":       "press %s to create a new session based on the selected session",
+	"key.new_session.help":        "create new session",
+	"key.new_session.tip":         "press %s to create a new session",
+	"key.rename.help":             "rename session",
+	"key.rename.tip":              "press %s to rename a session",
+
+	// Session metadata keys
+	"key.attachments.help":    "attachments (open, send to agent)",
+	"key.attachments.tip":     "press %s to attach screenshots or mockups to a session and hand them to the agent",
+	"key.comment.help":        "add/edit comment",
+	"key.comment.tip":         "press %s to add a comment to a session",
+	"key.cycle_priority.help": "cycle priority",
+	"key.cycle_priority.tip":  "press %s to rank a session from P0 to P3, separate from the flag",
+	"key.cycle_status.help":   "cycle status",
+	"key.cycle_status.tip":    "press %s to cycle through implementation statuses",
+	"key.flag.help":           "toggle flag",
+	"key.flag.tip":            "press %s to flag a session for attention",
+	"key.handoff.help":        "note where you left off",
+	"key.handoff.tip":         "press %s to note where you left off; the detail pane shows it when you come back",
+	"key.note.help":           "add/edit markdown note",
+	"key.note.tip":            "press %s to write a markdown note for a session",
+	"key.send_text.help":      "send text (prompt)",
+	"key.send_text.tip":       "press %s to send text to a session (experimental)",
+	"key.set_status.help":     "choose status",
+	"key.set_status.tip":      "press %s to pick a specific status",
+	"key.summarize_diff.help": "summarize diff into note",
+	"key.summarize_diff.tip":  "press %s to have the agent describe a session's changes in its note, ready for a handoff or PR",
+	"key.tags.help":           "edit tags",
+	"key.tags.tip":            "press %s to tag a session, then filter with tag:name",
+	"key.timer.help":          "set/clear timer",
+	"key.timer.tip":           "press %s to get an alert when a session's timer elapses",
+
+	// Session action keys
+	"key.check_output.help":       "show output of the last checks",
+	"key.check_output.tip":        "press %s to read what the last checks of a session printed",
+	"key.copy_branch.help":        "copy branch name",
+	"key.copy_path.help":          "copy worktree path",
+	"key.copy_pr_url.help":        "copy PR URL",
+	"key.copy_summary.help":       "copy session summary",
+	"key.copy_summary.tip":        "press %s to copy a session summary to the clipboard",
+	"key.detach.help":             "detach from session (return to list)",
+	"key.detach.tip":              "press %s inside a session to return to the list",
+	"key.fetch_base.help":         "fetch base branch and show staleness",
+	"key.fetch_base.tip":          "press %s to fetch the base branch and see how far behind it a session is",
+	"key.open.help":               "attach to session",
+	"key.open_changed_files.help": "open changed files in editor",
+	"key.open_changed_files.tip":  "press %s to open the files changed on a session's branch in your editor",
+	"key.open_editor.help":        "open session in editor",
+	"key.open_editor.tip":         "press %s to open the session's folder in your editor",
+	"key.open_pr.help":            "open PR in browser",
+	"key.open_pr.tip":             "press %s to open the session's PR in browser",
+	"key.open_shell.help":         "open shell session",
+	"key.open_shell.tip":          "press %s to open a shell session alongside claude",
+	"key.pause.help":              "pause or resume the agent",
+	"key.pause.tip":               "press %s to interrupt a busy agent and pause it; press it again to resume with \"continue\"",
+	"key.quick_jump.help":         "quick jump to any session by its hint",
+	"key.quick_jump.tip":          "press %s, then the two letters shown next to a session, to attach to it",
+	"key.quick_open.help":         "quick open (0=10th)",
+	"key.quick_open.tip":          "press %s to quickly open sessions by their number",
+	"key.rebase.help":             "fetch and rebase onto base branch",
+	"key.rebase.tip":              "press %s to rebase a session onto the latest base branch",
+	"key.run_checks.help":         "run the repository checks",
+	"key.run_checks.tip":          "press %s to run the checks command of a session's repository, such as make test, in its worktree",
+	"key.stash.help":              "stash worktree changes",
+	"key.stash.tip":               "press %s to park a session's uncommitted changes in a stash",
+	"key.switch_branch.help":      "switch worktree branch",
+	"key.switch_branch.tip":       "press %s to check out another branch in a session's worktree",
+	"key.tool_audit.help":         "review tools run without permission prompts",
+	"key.tool_audit.tip":          "press %s to review what a session that skips permissions has run",
+	"key.unstash.help":            "pop latest stash",
+}
@@ -0,0 +1,401 @@
+package i18n
+
+// portuguese is the European Portuguese (pt-PT) catalog
+var portuguese = map[string]string{
+	// Session list
+	"list.escalated":           "%d em alerta",
+	"list.exited":              "%d sem agente",
+	"list.following":           "a seguir o rocha pid %d",
+	"list.handoff_placeholder": "onde ficou? (enter para guardar, esc para saltar)",
+	"list.hint_open":           "abrir o Claude",
+	"list.hint_return":         "voltar aqui",
+	"list.idle":                "%d em pausa",
+	"list.paused":              "%d suspensas",
+	"list.shortcuts":           "atalhos",
+	"list.sort":                "ordenação: %s",
+	"list.view":                "vista: %s",
+	"list.waiting":             "%d à espera",
+	"list.working":             "%d a trabalhar",
+	"list.workspace":           "área de trabalho: %s",
+	"filter.label":             "filtro:",
+	"filter.remove_last":       "remover o último",
+	"tip.prefix":               "dica:",
+	"palette.empty":            "Nenhuma ação corresponde",
+	"palette.placeholder":      "escreva para filtrar",
+	"palette.prompt":           "Filtro: ",
+	"palette.selected_session": "sessão selecionada: %s",
+	"palette.title":            "Paleta de Comandos",
+
+	// Dialog titles
+	"dialog.archive":          "Arquivar Sessão",
+	"dialog.orphan_shells":    "Limpar Shells Órfãs",
+	"dialog.attachments":      "Anexos da Sessão",
+	"dialog.checks":           "Verificações: %s",
+	"dialog.comment":          "Editar Comentário da Sessão",
+	"dialog.debug":            "Depuração da Deteção de Estado",
+	"dialog.help":             "Ajuda",
+	"dialog.move":             "Mover Sessões",
+	"dialog.new":              "Criar Sessão",
+	"dialog.new_from_clip":    "Criar Sessão (da área de transferência)",
+	"dialog.new_from_repo":    "Criar Sessão (do mesmo repositório)",
+	"dialog.note":             "Editar Nota da Sessão",
+	"dialog.profile":          "Mudar de Perfil",
+	"dialog.rebase_conflicts": "Conflitos do Rebase",
+	"dialog.remove_worktree":  "Remover Worktree",
+	"dialog.rename":           "Mudar o Nome da Sessão",
+	"dialog.restart":          "Reiniciar Sessão",
+	"dialog.send_text":        "Enviar Texto ao Claude",
+	"dialog.status":           "Definir Estado",
+	"dialog.switch_branch":    "Mudar de Ramo",
+	"dialog.tags":             "Editar Etiquetas da Sessão",
+	"dialog.timer":            "Definir Temporizador da Sessão",
+	"dialog.tool_audit":       "Auditoria de Ferramentas: %s",
+	"dialog.views":            "Vistas",
+	"dialog.workspace":        "Mudar de Área de Trabalho",
+
+	// Forms
+	"form.cancel":     "Cancelar",
+	"form.esc_cancel": "Prima esc para cancelar",
+	"form.no":         "Não",
+	"form.ok":         "OK",
+	"form.session":    "Sessão: %s",
+	"form.yes":        "Sim",
+
+	// Create session form
+	"new_session.agent_args":                "Argumentos extra do Claude (opcional)",
+	"new_session.agent_args_desc":           "Passados ao claude em cada arranque, p. ex. --add-dir ../shared",
+	"new_session.bookmark":                  "Marcador: %s",
+	"new_session.bookmarks":                 "Marcadores: %s",
+	"new_session.branch":                    "Substituir o nome do ramo (opcional)",
+	"new_session.branch_desc":               "Deixe vazio para usar o nome sugerido acima. Tem de seguir as regras de nomes do git.",
+	"new_session.branch_invalid":            "nome de ramo inválido: %v",
+	"new_session.branch_invalid_suggestion": "nome de ramo inválido: %v (sugestão: %s)",
+	"new_session.claude_dir":                "Pasta do Claude (opcional)",
+	"new_session.claude_dir_desc":           "Deixe vazio para usar a predefinida: %s",
+	"new_session.claude_dir_invalid":        "o caminho tem de ser absoluto ou começar por ~",
+	"new_session.close":                     "Prima enter ou esc para fechar",
+	"new_session.conflict":                  "A sessão '%s' já existe",
+	"new_session.conflict_archived":         "Está arquivada; prima esc para cancelar",
+	"new_session.conflict_reuse":            "Abrir antes a '%s' existente",
+	"new_session.conflict_suffix":           "Criá-la como '%s'",
+	"new_session.creating":                  "A criar a sessão...",
+	"new_session.detected_branch":           "Ramo detetado: %s",
+	"new_session.directory":                 "Usar uma pasta tal como está (opcional)",
+	"new_session.directory_desc":            "Executar numa pasta existente sem criar ramo nem worktree. Sobrepõe-se ao repositório e ao ramo.",
+	"new_session.directory_missing":         "a pasta não existe",
+	"new_session.failed":                    "Sessão não criada: %s",
+	"new_session.matching_bookmarks":        "Marcadores correspondentes: %s",
+	"new_session.model":                     "Modelo (opcional)",
+	"new_session.model_desc":                "%s, ou o ID de um modelo. Vazio usa o predefinido do Claude.",
+	"new_session.name":                      "Nome da sessão",
+	"new_session.name_required":             "o nome da sessão é obrigatório",
+	"new_session.name_taken":                "⚠ A sessão '%s' já existe",
+	"new_session.prompt":                    "Prompt inicial (opcional)",
+	"new_session.prompt_desc":               "Enviar este prompt ao Claude quando a sessão arrancar. (%d/2000)",
+	"new_session.repo":                      "Repositório (opcional)",
+	"new_session.repo_desc":                 "URL remoto git ou nome de um marcador. Deixe vazio para a pasta atual.",
+	"new_session.repo_invalid":              "tem de ser um URL git (p. ex., https://github.com/owner/repo ou git@github.com:owner/repo) ou o nome de um marcador",
+	"new_session.repo_tip":                  "Dica: acrescente #nome-do-ramo para indicar um ramo remoto (p. ex., https://github.com/owner/repo#main)",
+	"new_session.skip_permissions":          "Saltar os pedidos de permissão? (PERIGOSO)",
+	"new_session.skip_permissions_desc":     "Permite ao Claude executar comandos sem perguntar. Use com cuidado!",
+	"new_session.subdir":                    "Subpasta (opcional)",
+	"new_session.subdir_desc":               "Começar numa pasta dentro do checkout, p. ex. packages/api num monorepo",
+	"new_session.suggested_branch":          "Nome de ramo sugerido: %s",
+
+	// Worktree removal form
+	"remove_worktree.archive_desc":    "Arquivar esconde a sessão. Remover também a worktree?",
+	"remove_worktree.keep":            "Manter",
+	"remove_worktree.kill_desc":       "Isto apaga a worktree mas preserva os commits.",
+	"remove_worktree.local_work":      "⚠ Esta worktree tem trabalho que só existe localmente:",
+	"remove_worktree.remove":          "Remover",
+	"remove_worktree.title":           "Remover a worktree em %s?",
+	"remove_worktree.type_name":       "Escreva '%s' para remover a worktree e perder este trabalho",
+	"remove_worktree.type_to_confirm": "escreva '%s' para confirmar",
+	"remove_worktree.unknown":         "⚠ Não foi possível verificar se esta worktree tem alterações por confirmar ou commits por enviar.",
+
+	// Rebase conflicts form
+	"rebase_conflicts.abort":       "Abortar o rebase",
+	"rebase_conflicts.ask_claude":  "Pedir ao Claude que resolva os conflitos",
+	"rebase_conflicts.files":       "Ficheiros em conflito:",
+	"rebase_conflicts.more":        "... e mais %d",
+	"rebase_conflicts.open_editor": "Abrir no editor",
+	"rebase_conflicts.title":       "O rebase sobre %s parou com conflitos",
+
+	// Send text form
+	"send_text.dangerous":      "Enviar um prompt perigoso?",
+	"send_text.dangerous_desc": "%s corre com skip-permissions, por isso o agente não pergunta antes de agir.\nO texto contém: %s",
+	"send_text.desc":           "O texto será enviado para a sessão: %s",
+	"send_text.history":        "Histórico de prompts",
+	"send_text.history_desc":   "Prompts enviados para %s, os mais recentes primeiro · esc para voltar",
+	"send_text.history_hint":   "↑/↓ recuperar prompts anteriores · ctrl+r ver o histórico",
+	"send_text.send":           "Enviar",
+	"send_text.title":          "Enviar texto ao Claude",
+
+	// Attachments form
+	"attachments.action":        "O que quer fazer com ele?",
+	"attachments.add":           "+ Anexar um ficheiro",
+	"attachments.file":          "Ficheiro a anexar",
+	"attachments.file_desc":     "Caminho de uma captura de ecrã, maqueta ou qualquer outro ficheiro",
+	"attachments.file_required": "indique o caminho de um ficheiro",
+	"attachments.open":          "Abrir no visualizador do sistema",
+	"attachments.remove":        "Remover da sessão (mantém o ficheiro)",
+	"attachments.send":          "Enviar ao agente",
+	"attachments.title":         "Anexos",
+
+	// Switch branch form
+	"switch_branch.checked_out": "(em uso em %s)",
+	"switch_branch.current":     "(atual)",
+	"switch_branch.in_use":      "'%s' já está em uso",
+	"switch_branch.tell_agent":  "Avisar o agente da mudança?",
+	"switch_branch.title":       "Mudar de ramo",
+
+	// Comment form
+	"comment.desc":  "Comentário de: %s (vazio para apagar)",
+	"comment.title": "Comentário da sessão",
+
+	// Move sessions form
+	"move.confirm":          "Mover as sessões agora?",
+	"move.confirm_desc":     "As suas sessões tmux são terminadas primeiro; abra a home de destino para as reiniciar",
+	"move.dest":             "ROCHA_HOME de destino",
+	"move.dest_current":     "o destino é a ROCHA_HOME atual",
+	"move.dest_desc":        "Criada se não existir (tab completa as homes conhecidas)",
+	"move.dest_required":    "o destino não pode estar vazio",
+	"move.move":             "Mover",
+	"move.name_invalid":     "o nome tem de conter letras ou números",
+	"move.name_taken":       "'%s' já está em uso no destino",
+	"move.nothing_left":     "não restam sessões para mover",
+	"move.plan_conflict":    "(conflito)",
+	"move.plan_main":        "Repositório principal: %s (copiado se uma sessão ficar aqui)",
+	"move.plan_main_reused": "Repositório principal: reutiliza o clone no destino",
+	"move.plan_no_main":     "Sem pasta do repositório principal",
+	"move.plan_sessions":    "%d sessão(ões) saem de %s",
+	"move.rename_desc":      "Novo nome no destino (vazio mantém a sessão aqui)",
+	"move.repo":             "Repositório a mover",
+	"move.repo_desc":        "Todas as suas sessões em %s",
+	"move.title":            "Mover %s para %s",
+	"move.worktree_exists":  "%s já existe",
+
+	// Note form
+	"note.desc":  "Nota de: %s (vazio para apagar, ctrl+e para o editor)",
+	"note.title": "Nota da sessão (markdown)",
+
+	// Profile form
+	"profile.desc":  "O rocha reinicia com as definições do perfil escolhido",
+	"profile.empty": "Ainda não há perfis. Crie um com: rocha config set <chave> <valor> --profile <nome>",
+	"profile.none":  "Sem perfil",
+	"profile.title": "Mudar de perfil",
+
+	// Rename form
+	"rename.desc":  "A mudar o nome de: %s",
+	"rename.taken": "a sessão %s já existe",
+	"rename.title": "Novo nome da sessão",
+
+	// Restart form
+	"restart.fresh":  "Começar uma conversa nova",
+	"restart.resume": "Retomar a conversa do Claude",
+	"restart.shell":  "Só a shell (sem Claude)",
+	"restart.title":  "O Claude terminou nesta sessão",
+
+	// Status form
+	"status.clear": "<limpar>",
+	"status.title": "Definir o estado de implementação",
+
+	// Tags form
+	"tags.desc":  "Etiquetas de: %s (separadas por espaços, vazio para limpar)",
+	"tags.title": "Etiquetas da sessão",
+
+	// Timer form
+	"timer.desc":    "Tempo até %s o alertar (p. ex. 20m ou 1h30m, vazio para limpar)",
+	"timer.invalid": "use uma duração como 20m ou 1h30m",
+	"timer.label":   "Lembrete (opcional)",
+	"timer.title":   "Temporizador",
+
+	// Views form
+	"views.desc":          "Aplica o filtro e a ordenação da vista",
+	"views.empty":         "Ainda não há vistas. Filtre e ordene a lista e depois guarde-a como vista",
+	"views.name":          "Nome da vista",
+	"views.name_desc":     "Uma vista com o mesmo nome é substituída",
+	"views.name_required": "dê um nome à vista",
+	"views.save":          "+ Guardar o filtro e a ordenação atuais como vista",
+
+	// Workspace form
+	"workspace.all":    "Todas as sessões",
+	"workspace.desc":   "Só são listadas as sessões da área de trabalho escolhida",
+	"workspace.empty":  "Ainda não há áreas de trabalho. Crie uma com: rocha workspaces create <name> [sessions...]",
+	"workspace.option": "%s (%d sessões)",
+	"workspace.title":  "Mudar de área de trabalho",
+
+	// Orphan shells cleanup
+	"orphan_shells.clean":   "Limpar",
+	"orphan_shells.confirm": "Limpar %d shells órfãs?",
+	"orphan_shells.gone":    "%s (de %s) já não corre e é esquecida",
+	"orphan_shells.in_use":  "Shells com um cliente ligado, ou abertas nos últimos minutos, continuam a correr.",
+	"orphan_shells.none":    "Nenhuma shell órfã: todas as shells correm junto da sua sessão",
+	"orphan_shells.running": "%s é terminada, já que %s não está a correr",
+
+	// Help screen
+	"help.empty":                       "Nenhum atalho corresponde",
+	"help.experimental":                "%s (experimental)",
+	"help.footer":                      "Escreva para pesquisar • ↑↓/PgUp/PgDn para selecionar • esc para limpar a pesquisa ou fechar",
+	"help.group.application":           "Aplicação",
+	"help.group.experimental":          "Funcionalidades Experimentais",
+	"help.group.indicators":            "Indicadores de Estado (só de leitura)",
+	"help.group.inside_session":        "Atalhos Dentro da Sessão",
+	"help.group.navigation":            "Navegação",
+	"help.group.session_actions":       "Ações da Sessão",
+	"help.group.session_management":    "Gestão de Sessões",
+	"help.group.session_metadata":      "Metadados da Sessão",
+	"help.indicator.comment":           "a sessão tem um comentário",
+	"help.indicator.exited":            "a sessão terminou",
+	"help.indicator.flagged":           "a sessão está assinalada",
+	"help.indicator.idle":              "a sessão está em pausa",
+	"help.indicator.no_worktree":       "a sessão usa uma pasta tal como está (sem worktree)",
+	"help.indicator.paused":            "a sessão está suspensa (o agente não gasta tokens até ser retomada)",
+	"help.indicator.shell":             "sessão de shell ativa",
+	"help.indicator.skips_permissions": "a sessão ignora os pedidos de permissão (as ferramentas são auditadas)",
+	"help.indicator.status":            "estado de implementação",
+	"help.indicator.throttled":         "os prompts em fila esperam pelo limite de concorrência",
+	"help.indicator.waiting":           "a sessão está à espera",
+	"help.indicator.working":           "a sessão está a trabalhar",
+	"help.inside.detach":               "voltar rapidamente à lista",
+	"help.inside.swap":                 "alternar entre as sessões do claude e da shell",
+	"help.inside.tmux_detach":          "desligar padrão do tmux (também funciona)",
+	"help.inside.tmux_detach_key":      "ctrl+b e depois d",
+	"help.not_applicable":              "(não se aplica à sessão selecionada)",
+	"help.remap":                       "altere com keys.%s em settings.json",
+	"help.run":                         "experimentar na sessão selecionada",
+	"help.search_placeholder":          "pesquisar atalhos, categorias ou definições",
+	"help.search_prompt":               "? ",
+	"help.setting":                     "editar %s em settings.json",
+
+	// Application keys
+	"key.clean_orphan_shells.help":  "limpar shells de sessões paradas",
+	"key.command_palette.help":      "paleta de comandos",
+	"key.command_palette.tip":       "prima %s para abrir a paleta de comandos",
+	"key.debug_screen.help":         "ecrã de depuração da deteção de estado",
+	"key.detail_pane.help":          "mostrar/ocultar painel de detalhes",
+	"key.detail_pane.tip":           "prima %s para ver tudo sobre a sessão selecionada lado a lado",
+	"key.force_quit.help":           "forçar saída",
+	"key.help.help":                 "mostrar atalhos de teclado",
+	"key.help.tip":                  "prima %s para ver todos os atalhos",
+	"key.note_pane.help":            "mostrar/ocultar painel da nota",
+	"key.note_pane.tip":             "prima %s para ler a nota da sessão selecionada",
+	"key.open_settings.help":        "abrir definições no editor",
+	"key.open_settings.tip":         "prima %s para editar o settings.json no seu editor",
+	"key.quit.help":                 "sair da aplicação",
+	"key.switch_profile.help":       "mudar de perfil de definições",
+	"key.switch_profile.tip":        "prima %s para reiniciar com outro perfil de definições",
+	"key.timestamps.help":           "mostrar/ocultar horas",
+	"key.timestamps.tip":            "prima %s para mostrar ou ocultar as horas das alterações de estado",
+	"key.token_chart.help":          "mostrar/ocultar gráfico de tokens",
+	"key.token_chart.tip":           "prima %s para mostrar ou ocultar o gráfico de utilização de tokens",
+	"key.token_chart_by_model.help": "gráfico de tokens por modelo",
+	"key.token_chart_by_model.tip":  "prima %s para dividir o gráfico de tokens por modelo",
+	"key.token_chart_cache.help":    "tokens de cache no gráfico",
+	"key.token_chart_cache.tip":     "prima %s para incluir os tokens de cache no gráfico de tokens",
+
+	// Navigation keys
+	"key.apply_view.help":         "aplicar a vista 1-9",
+	"key.clear_filter.help":       "limpar filtro (premir duas vezes em 500ms)",
+	"key.clear_filter.tip":        "prima %s duas vezes para limpar o filtro",
+	"key.cycle_density.help":      "alternar densidade da lista",
+	"key.cycle_density.tip":       "prima %s para alternar entre linhas compactas, normais e detalhadas",
+	"key.cycle_sort.help":         "alternar ordenação predefinida",
+	"key.cycle_sort.tip":          "prima %s para percorrer as ordenações definidas no settings.json",
+	"key.down.help":               "selecionar a sessão seguinte",
+	"key.filter.help":             "filtrar a lista de sessões",
+	"key.filter.tip":              "prima %s para filtrar as sessões por nome, ramo ou termos como state:idle e older:7d",
+	"key.move_down.help":          "mover a sessão para baixo",
+	"key.move_up.help":            "mover a sessão para cima",
+	"key.move_up.tip":             "prima %s para reordenar as sessões na lista",
+	"key.remove_filter_chip.help": "remover o último termo do filtro",
+	"key.remove_filter_chip.tip":  "prima %s para retirar o último termo de um filtro aplicado como state:waiting repo:acme",
+	"key.switch_workspace.help":   "mudar de área de trabalho",
+	"key.switch_workspace.tip":    "prima %s para mostrar só as sessões de uma área de trabalho",
+	"key.up.help":                 "selecionar a sessão anterior",
+	"key.views.help":              "aplicar ou guardar uma vista",
+	"key.views.tip":               "prima %s para alternar entre combinações guardadas de filtro e ordenação",
+
+	// Session management keys
+	"key.archive.help":            "arquivar sessão",
+	"key.archive.tip":             "prima %s para arquivar uma sessão (deixa de aparecer na lista)",
+	"key.kill.help":               "terminar sessão e worktree",
+	"key.kill.tip":                "prima %s para terminar uma sessão e, se quiser, remover a sua worktree",
+	"key.kill_process.help":       "terminar o processo do agente (manter a sessão)",
+	"key.kill_process.tip":        "prima %s para parar um agente descontrolado sem terminar a sua sessão",
+	"key.move_sessions.help":      "mover as sessões do repositório para outro ROCHA_HOME",
+	"key.move_sessions.tip":       "prima %s para mover as sessões de um repositório para outro ROCHA_HOME, resolvendo nomes repetidos",
+	"key.new_from_clipboard.help": "criar sessão a partir da área de transferência",
+	"key.new_from_clipboard.tip":  "prima %s para criar uma sessão a partir de um URL git, URL de issue ou descrição de tarefa na área de transferência",
+	"key.new_from_repo.help":      "criar sessão do mesmo repositório",
+	"key.new_from_repo.tip":       "prima %s para criar uma sessão com base na sessão selecionada",
+	"key.new_session.help":        "criar sessão",
+	"key.new_session.tip":         "prima %s para criar uma sessão",
+	"key.rename.help":             "mudar o nome da sessão",
+	"key.rename.tip":              "prima %s para mudar o nome de uma sessão",
+
+	// Session metadata keys
+	"key.attachments.help":    "anexos (abrir, enviar ao agente)",
+	"key.attachments.tip":     "prima %s para anexar capturas de ecrã ou maquetas a uma sessão e entregá-las ao agente",
+	"key.comment.help":        "adicionar/editar comentário",
+	"key.comment.tip":         "prima %s para adicionar um comentário a uma sessão",
+	"key.cycle_priority.help": "alternar prioridade",
+	"key.cycle_priority.tip":  "prima %s para classificar uma sessão de P0 a P3, independentemente da marca",
+	"key.cycle_status.help":   "alternar estado",
+	"key.cycle_status.tip":    "prima %s para percorrer os estados de implementação",
+	"key.flag.help":           "assinalar/desassinalar",
+	"key.flag.tip":            "prima %s para assinalar uma sessão que precisa de atenção",
+	"key.handoff.help":        "anotar onde ficou",
+	"key.handoff.tip":         "prima %s para anotar onde ficou; o painel de detalhes mostra-o quando voltar",
+	"key.note.help":           "adicionar/editar nota em markdown",
+	"key.note.tip":            "prima %s para escrever uma nota em markdown para uma sessão",
+	"key.send_text.help":      "enviar texto (prompt)",
+	"key.send_text.tip":       "prima %s para enviar texto a uma sessão (experimental)",
+	"key.set_status.help":     "escolher estado",
+	"key.set_status.tip":      "prima %s para escolher um estado específico",
+	"key.summarize_diff.help": "resumir diff na nota",
+	"key.summarize_diff.tip":  "prima %s para o agente descrever as alterações de uma sessão na nota, pronta para passagem de testemunho ou PR",
+	"key.tags.help":           "editar etiquetas",
+	"key.tags.tip":            "prima %s para etiquetar uma sessão e depois filtrar com tag:nome",
+	"key.timer.help":          "definir/limpar temporizador",
+	"key.timer.tip":           "prima %s para receber um alerta quando o temporizador de uma sessão terminar",
+
+	// Session action keys
+	"key.check_output.help":       "mostrar o resultado das últimas verificações",
+	"key.check_output.tip":        "prima %s para ler o que as últimas verificações de uma sessão escreveram",
+	"key.copy_branch.help":        "copiar o nome do ramo",
+	"key.copy_path.help":          "copiar o caminho da worktree",
+	"key.copy_pr_url.help":        "copiar o URL do PR",
+	"key.copy_summary.help":       "copiar o resumo da sessão",
+	"key.copy_summary.tip":        "prima %s para copiar o resumo de uma sessão para a área de transferência",
+	"key.detach.help":             "sair da sessão (voltar à lista)",
+	"key.detach.tip":              "prima %s dentro de uma sessão para voltar à lista",
+	"key.fetch_base.help":         "obter o ramo base e mostrar o atraso",
+	"key.fetch_base.tip":          "prima %s para obter o ramo base e ver quão atrasada está uma sessão",
+	"key.open.help":               "entrar na sessão",
+	"key.open_changed_files.help": "abrir os ficheiros alterados no editor",
+	"key.open_changed_files.tip":  "prima %s para abrir no seu editor os ficheiros alterados no ramo de uma sessão",
+	"key.open_editor.help":        "abrir a sessão no editor",
+	"key.open_editor.tip":         "prima %s para abrir a pasta da sessão no seu editor",
+	"key.open_pr.help":            "abrir o PR no navegador",
+	"key.open_pr.tip":             "prima %s para abrir o PR da sessão no navegador",
+	"key.open_shell.help":         "abrir sessão de shell",
+	"key.open_shell.tip":          "prima %s para abrir uma sessão de shell ao lado do claude",
+	"key.pause.help":              "suspender ou retomar o agente",
+	"key.pause.tip":               "prima %s para interromper um agente ocupado e suspendê-lo; prima outra vez para o retomar com \"continue\"",
+	"key.quick_jump.help":         "salto rápido para qualquer sessão pela sua sugestão",
+	"key.quick_jump.tip":          "prima %s e depois as duas letras mostradas junto a uma sessão para a abrir",
+	"key.quick_open.help":         "abertura rápida (0=10.ª)",
+	"key.quick_open.tip":          "prima %s para abrir rapidamente as sessões pelo seu número",
+	"key.rebase.help":             "obter e fazer rebase sobre o ramo base",
+	"key.rebase.tip":              "prima %s para fazer rebase de uma sessão sobre o ramo base mais recente",
+	"key.run_checks.help":         "executar as verificações do repositório",
+	"key.run_checks.tip":          "prima %s para executar o comando de verificações do repositório de uma sessão, como make test, na sua worktree",
+	"key.stash.help":              "guardar as alterações da worktree num stash",
+	"key.stash.tip":               "prima %s para guardar num stash as alterações por confirmar de uma sessão",
+	"key.switch_branch.help":      "mudar o ramo da worktree",
+	"key.switch_branch.tip":       "prima %s para mudar para outro ramo na worktree de uma sessão",
+	"key.tool_audit.help":         "rever as ferramentas executadas sem pedidos de permissão",
+	"key.tool_audit.tip":          "prima %s para rever o que executou uma sessão que ignora permissões",
+	"key.unstash.help":            "repor o stash mais recente",
+}
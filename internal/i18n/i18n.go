@@ -0,0 +1,92 @@
+// Package i18n holds the message catalogs of the strings the TUI shows and picks the
+// catalog of the configured language.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// Language is a language the TUI can be shown in, as a BCP 47 tag
+type Language string
+
+// Supported languages
+const (
+	English    Language = "en" // Default
+	Portuguese Language = "pt-PT"
+)
+
+// Languages lists the supported languages
+var Languages = []Language{English, Portuguese}
+
+// catalogs maps each language to its messages by ID
+var catalogs = map[Language]map[string]string{
+	English:    english,
+	Portuguese: portuguese,
+}
+
+// current is the language messages are looked up in. It is set once at startup,
+// before the TUI renders anything.
+var current = English
+
+// ParseLanguage parses a language setting, ignoring case ("" = English, "pt" = pt-PT)
+func ParseLanguage(s string) (Language, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return English, nil
+	}
+	if strings.EqualFold(s, "pt") {
+		return Portuguese, nil
+	}
+	for _, language := range Languages {
+		if strings.EqualFold(s, string(language)) {
+			return language, nil
+		}
+	}
+	return "", fmt.Errorf("unsupported language %q (use %s): %w", s, languageNames(), domain.ErrInvalidInput)
+}
+
+// languageNames lists the supported languages for error messages
+func languageNames() string {
+	names := make([]string, len(Languages))
+	for i, language := range Languages {
+		names[i] = string(language)
+	}
+	return strings.Join(names, ", ")
+}
+
+// SetLanguage selects the language messages are looked up in
+func SetLanguage(language Language) {
+	current = language
+}
+
+// CurrentLanguage returns the language messages are looked up in
+func CurrentLanguage() Language {
+	return current
+}
+
+// Lookup returns the message with the given ID in the current language, falling back
+// to English when the current catalog lacks it
+func Lookup(id string) (string, bool) {
+	if message, ok := catalogs[current][id]; ok {
+		return message, true
+	}
+	message, ok := english[id]
+	return message, ok
+}
+
+// T returns the message with the given ID in the current language
+// Unknown IDs are returned as is, so a missing message shows up instead of an empty string.
+func T(id string) string {
+	if message, ok := Lookup(id); ok {
+		return message
+	}
+	return id
+}
+
+// Tf formats the message with the given ID in the current language with args
+func Tf(id string, args ...any) string {
+	return fmt.Sprintf(T(id), args...)
+}
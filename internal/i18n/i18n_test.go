@@ -0,0 +1,64 @@
+package i18n
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+var verbPattern = regexp.MustCompile(`%[a-z]`)
+
+func TestCatalogs_TranslateEveryMessage(t *testing.T) {
+	for language, catalog := range catalogs {
+		for id, message := range english {
+			translated, ok := catalog[id]
+			if !assert.True(t, ok, "%s lacks %s", language, id) {
+				continue
+			}
+			assert.Equal(t, verbPattern.FindAllString(message, -1), verbPattern.FindAllString(translated, -1),
+				"%s %s has different format verbs", language, id)
+		}
+		for id := range catalog {
+			assert.Contains(t, english, id, "%s has %s, which english lacks", language, id)
+		}
+	}
+}
+
+func TestParseLanguage(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Language
+	}{
+		{"", English},
+		{"en", English},
+		{"pt-PT", Portuguese},
+		{"PT-pt", Portuguese},
+		{"pt", Portuguese},
+	}
+	for _, tt := range tests {
+		language, err := ParseLanguage(tt.input)
+		require.NoError(t, err, tt.input)
+		assert.Equal(t, tt.expected, language, tt.input)
+	}
+
+	_, err := ParseLanguage("klingon")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestT(t *testing.T) {
+	defer SetLanguage(CurrentLanguage())
+
+	SetLanguage(Portuguese)
+	assert.Equal(t, "Ajuda", T("dialog.help"))
+	assert.Equal(t, "3 à espera", Tf("list.waiting", 3))
+	assert.Equal(t, "no.such.message", T("no.such.message"))
+
+	SetLanguage(English)
+	assert.Equal(t, "Help", T("dialog.help"))
+	_, ok := Lookup("no.such.message")
+	assert.False(t, ok)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// AttachmentRepository persists the files attached to sessions
+type AttachmentRepository interface {
+	// AddAttachment stores an attachment, replacing the session's attachment with the same path
+	AddAttachment(ctx context.Context, attachment domain.SessionAttachment) error
+	// ListAttachments returns the attachments of a session, oldest first
+	ListAttachments(ctx context.Context, sessionName string) ([]domain.SessionAttachment, error)
+	// RemoveAttachment removes an attachment, returning domain.ErrAttachmentNotFound if missing
+	RemoveAttachment(ctx context.Context, sessionName, path string) error
+}
@@ -0,0 +1,14 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// BadgeProvider supplies custom badges shown on sessions in the TUI list
+type BadgeProvider interface {
+	// Badges returns the badges of the given sessions, by session name.
+	// Sessions without badges may be left out.
+	Badges(ctx context.Context, sessions []domain.Session) (map[string][]domain.Badge, error)
+}
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"io"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// BootstrapRunner performs the steps that prepare a new worktree
+type BootstrapRunner interface {
+	// CommandOutput runs a shell command in dir and returns its stdout
+	CommandOutput(ctx context.Context, dir, command string) ([]byte, error)
+	// CopyPath copies a file or directory, creating missing parent directories
+	CopyPath(src, dst string) error
+	// DetectEnvTools returns the environment tools that are installed and configured in dir
+	DetectEnvTools(dir string) []domain.EnvTool
+	// RunCommand runs a shell command in dir, writing its stdout and stderr to output
+	RunCommand(ctx context.Context, dir, command string, output io.Writer) error
+}
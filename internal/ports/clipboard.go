@@ -0,0 +1,13 @@
+package ports
+
+// ClipboardWriter copies text to the system clipboard
+type ClipboardWriter interface {
+	// Copy replaces the clipboard contents with text
+	Copy(text string) error
+}
+
+// ClipboardReader reads text from the system clipboard
+type ClipboardReader interface {
+	// Paste returns the clipboard contents
+	Paste() (string, error)
+}
@@ -0,0 +1,9 @@
+package ports
+
+import "context"
+
+// DiffSummarizer turns the patch of a session into a short description in prose
+type DiffSummarizer interface {
+	// Summarize returns a one-paragraph summary of diff, taken in dir
+	Summarize(ctx context.Context, dir, diff string) (string, error)
+}
@@ -1,8 +1,10 @@
 package ports
 
+import "github.com/renato0307/rocha/internal/domain"
+
 // EditorOpener opens directories in an external editor
 type EditorOpener interface {
-	// Open opens the specified path in an editor
-	// cliEditor is the editor specified via CLI flag (takes precedence)
-	Open(path string, cliEditor string) error
+	// Open opens the target with its editor integration
+	// cliEditor is the editor specified via CLI flag (takes precedence for the default integration)
+	Open(target domain.EditorTarget, cliEditor string) error
 }
@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// EventFeed reads stored session events in the order they were stored, for streaming them
+type EventFeed interface {
+	// LatestEventID returns the ID of the newest stored event, 0 when there is none
+	LatestEventID(ctx context.Context) (uint, error)
+	// ListEventsAfter returns up to limit events stored after the event with afterID, oldest first
+	ListEventsAfter(ctx context.Context, afterID uint, limit int) ([]domain.Event, error)
+}
@@ -0,0 +1,12 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// EventPublisher announces session events to external integrations (e.g. webhooks)
+type EventPublisher interface {
+	Publish(ctx context.Context, event domain.Event) error
+}
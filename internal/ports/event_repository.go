@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// EventRepository stores session events for activity statistics
+type EventRepository interface {
+	// AddEvent stores an event
+	AddEvent(ctx context.Context, event domain.Event) error
+	// ListEvents returns events of the given type that happened at or after since, oldest first
+	ListEvents(ctx context.Context, eventType domain.EventType, since time.Time) ([]domain.Event, error)
+	// ListLatestEventsBefore returns the most recent event of the given type of each session that happened before before
+	ListLatestEventsBefore(ctx context.Context, eventType domain.EventType, before time.Time) ([]domain.Event, error)
+	// LatestSessionEvent returns the most recent event of the given type of a session, or nil if it has none
+	LatestSessionEvent(ctx context.Context, sessionName string, eventType domain.EventType) (*domain.Event, error)
+	// ListSessionEvents returns up to limit of the most recent events of a session, newest first
+	ListSessionEvents(ctx context.Context, sessionName string, limit int) ([]domain.Event, error)
+	// PruneEvents discards the events that happened before before
+	PruneEvents(ctx context.Context, before time.Time) error
+}
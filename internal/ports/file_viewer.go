@@ -0,0 +1,7 @@
+package ports
+
+// FileViewer opens files in the application the system associates with them
+type FileViewer interface {
+	// OpenFile opens path without waiting for the application to exit
+	OpenFile(path string) error
+}
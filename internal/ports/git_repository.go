@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 )
@@ -9,6 +10,8 @@ import (
 // RepoInspector queries repository information
 type RepoInspector interface {
 	GetBranchName(path string) string
+	GetDefaultBranch(repoPath string) string // Default branch of origin, "main" if unknown
+	GetHeadCommit(path string) (string, error)
 	GetMainRepoPath(path string) (string, error)
 	GetRemoteURL(repoPath string) string
 	GetRepoInfo(repoPath string) string
@@ -19,15 +22,47 @@ type RepoInspector interface {
 type WorktreeManager interface {
 	BuildWorktreePath(base, repoInfo, sessionName string) string
 	CreateWorktree(repoPath, worktreePath, branchName string) error
+	DeleteBranch(repoPath, branchName string) error // Force-deletes a local branch
 	GetWorktreeForBranch(repoPath, branchName string) (string, error)
+	GetWorktreeStatus(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error)
 	ListWorktrees(repoPath string) ([]string, error)
+	MoveWorktree(ctx context.Context, repoPath, worktreePath, newPath string) error
 	RemoveWorktree(repoPath, worktreePath string) error
 	RepairWorktrees(mainRepoPath string, worktreePaths []string) error
+	SetWorktreeIdentity(ctx context.Context, worktreePath string, identity domain.GitIdentity) error // Applies to this worktree only, not the main checkout
 }
 
 // RepoCloner handles repository cloning
 type RepoCloner interface {
-	GetOrCloneRepository(source, worktreeBase string) (string, *domain.RepoSource, error)
+	GetOrCloneRepository(source, worktreeBase string, credentials domain.GitCredentials) (string, *domain.RepoSource, error) // Checks access to a remote before cloning it
+}
+
+// BranchSyncer keeps session branches up to date with their base branch
+type BranchSyncer interface {
+	AbortRebase(ctx context.Context, worktreePath string) error
+	FetchBase(ctx context.Context, worktreePath, baseBranch string) error
+	FetchRemote(ctx context.Context, repoPath string) error // Fetches every branch of origin without prompting, pruning deleted ones
+	RebaseOntoBase(ctx context.Context, worktreePath, baseBranch string) (*domain.RebaseResult, error)
+}
+
+// StashManager parks and restores uncommitted worktree changes
+type StashManager interface {
+	ListStashes(ctx context.Context, worktreePath string) ([]domain.Stash, error) // Stashes of the checked out branch, newest first
+	PopStash(ctx context.Context, worktreePath, ref string) error
+	StashChanges(ctx context.Context, worktreePath, message string) (bool, error) // False when there was nothing to stash
+}
+
+// CheckpointWriter saves snapshots of worktree changes without touching the checkout
+type CheckpointWriter interface {
+	CommitCheckpoint(ctx context.Context, worktreePath, branch, message string) (bool, error) // False when nothing changed since the last checkpoint
+	StashCheckpoint(ctx context.Context, worktreePath, message string) (bool, error)          // Tracked changes only; false when there are none or they match the newest stash
+}
+
+// BranchSwitcher changes the branch checked out in a worktree
+type BranchSwitcher interface {
+	ListBranches(ctx context.Context, worktreePath string) ([]domain.Branch, error)    // Local branches, then remote-only ones, most recently committed first
+	RenameBranch(ctx context.Context, worktreePath, oldBranch, newBranch string) error // The worktree keeps the renamed branch checked out
+	SwitchBranch(ctx context.Context, worktreePath, branch string) error               // A remote-only branch is checked out as a new tracking branch
 }
 
 // BranchValidator validates and sanitizes branch names
@@ -44,7 +79,11 @@ type RepoSourceParser interface {
 
 // GitStatsProvider provides git statistics for UI
 type GitStatsProvider interface {
-	FetchGitStats(ctx context.Context, worktreePath string) (*domain.GitStats, error)
+	BranchDiff(ctx context.Context, path, baseBranch string) (string, error)                            // Patch of the branch since it forked from baseBranch, uncommitted and untracked files included
+	Diff(ctx context.Context, path, since string) (string, error)                                       // Patch of commits, uncommitted changes, and untracked files since a commit
+	FetchGitStats(ctx context.Context, worktreePath, baseBranch string) (*domain.GitStats, error)       // Empty baseBranch compares against origin's default branch
+	ListChangedFiles(ctx context.Context, path string) ([]string, error)                                // Absolute paths changed on the branch, including untracked
+	ListCommits(ctx context.Context, path, baseBranch string, since time.Time) ([]domain.Commit, error) // Commits on the branch since, newest first, leaving out those on the base branch
 }
 
 // PRInfoProvider provides PR information for UI
@@ -56,11 +95,15 @@ type PRInfoProvider interface {
 
 // GitRepository is the composite interface
 type GitRepository interface {
+	BranchSwitcher
+	BranchSyncer
 	BranchValidator
+	CheckpointWriter
 	GitStatsProvider
 	PRInfoProvider
 	RepoCloner
 	RepoInspector
 	RepoSourceParser
+	StashManager
 	WorktreeManager
 }
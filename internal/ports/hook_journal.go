@@ -0,0 +1,33 @@
+package ports
+
+import (
+	"context"
+	"errors"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// ErrHookJournalLockUnsupported is returned by the journal where the platform has no file locks
+var ErrHookJournalLockUnsupported = errors.New("hook journal lock is not supported on this platform")
+
+// HookJournalProcessor applies pending journal entries, oldest first. It returns the
+// entries to keep for a later drain and those to move to the dead-letter queue.
+type HookJournalProcessor func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry)
+
+// HookJournal is an append-only log of hook events that are drained into the state database.
+// Hooks append to it without touching the database, so a locked database loses no transitions.
+type HookJournal interface {
+	// Append adds an entry to the journal; safe to call from concurrent processes
+	Append(entry domain.HookJournalEntry) error
+	// ClearDeadLetters discards the entries given up on, returning how many there were
+	ClearDeadLetters() (int, error)
+	// Drain passes the pending entries to process while holding a lock that excludes
+	// other drains, waiting for it until ctx is done
+	Drain(ctx context.Context, process HookJournalProcessor) error
+	// ListDeadLetters returns the entries given up on, oldest first
+	ListDeadLetters() ([]domain.HookJournalEntry, error)
+	// ListPending returns the entries waiting to be applied, oldest first
+	ListPending() ([]domain.HookJournalEntry, error)
+	// RequeueDeadLetters moves the entries given up on back to the journal, returning how many there were
+	RequeueDeadLetters() (int, error)
+}
@@ -0,0 +1,17 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// HookMetricsRepository stores recent hook processing metrics
+type HookMetricsRepository interface {
+	// AddHookMetric stores a metric
+	AddHookMetric(ctx context.Context, metric domain.HookMetric) error
+	// ListHookMetrics returns up to limit metrics, most recent first
+	ListHookMetrics(ctx context.Context, limit int) ([]domain.HookMetric, error)
+	// PruneHookMetrics discards all but the keep most recent metrics
+	PruneHookMetrics(ctx context.Context, keep int) error
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"errors"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// ErrInstanceLockUnsupported is returned by TryLock where the platform has no file locks
+var ErrInstanceLockUnsupported = errors.New("instance lock is not supported on this platform")
+
+// InstanceLock elects the single rocha process that runs the background work of a ROCHA_HOME
+type InstanceLock interface {
+	// TryLock takes the lock without waiting and records owner in it. When another process
+	// holds the lock it returns false and the owner that process recorded (nil if unknown).
+	TryLock(owner domain.InstanceOwner) (bool, *domain.InstanceOwner, error)
+	// Unlock releases the lock; the OS releases it too if the process dies
+	Unlock() error
+}
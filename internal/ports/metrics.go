@@ -0,0 +1,31 @@
+package ports
+
+import (
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// MetricsRecorder records operation latencies for the metrics endpoint
+type MetricsRecorder interface {
+	// ObserveDBOperation records how long a database operation (create, query, update, delete, row, raw) took
+	ObserveDBOperation(operation string, duration time.Duration)
+	// ObserveGitFetch records how long fetching a repository from its remote took
+	ObserveGitFetch(duration time.Duration)
+	// ObserveGitStatsFetch records how long fetching git stats for a worktree took
+	ObserveGitStatsFetch(duration time.Duration)
+}
+
+// StateTransition is a session moving from one state to another
+// From is empty for the first recorded state of a session
+type StateTransition struct {
+	From domain.SessionState
+	To   domain.SessionState
+}
+
+// MetricsSnapshot holds the values the metrics endpoint reads at scrape time
+type MetricsSnapshot struct {
+	SessionsByState map[domain.SessionState]int
+	TodayTokens     TokenTotals
+	Transitions     map[StateTransition]int // Transitions seen since the metrics started being collected
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 	mock "github.com/stretchr/testify/mock"
@@ -38,104 +39,107 @@ func (_m *MockGitRepository) EXPECT() *MockGitRepository_Expecter {
 	return &MockGitRepository_Expecter{mock: &_m.Mock}
 }
 
-// BuildWorktreePath provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) BuildWorktreePath(base string, repoInfo string, sessionName string) string {
-	ret := _mock.Called(base, repoInfo, sessionName)
+// AbortRebase provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) AbortRebase(ctx context.Context, worktreePath string) error {
+	ret := _mock.Called(ctx, worktreePath)
 
 	if len(ret) == 0 {
-		panic("no return value specified for BuildWorktreePath")
+		panic("no return value specified for AbortRebase")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func(string, string, string) string); ok {
-		r0 = returnFunc(base, repoInfo, sessionName)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, worktreePath)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockGitRepository_BuildWorktreePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildWorktreePath'
-type MockGitRepository_BuildWorktreePath_Call struct {
+// MockGitRepository_AbortRebase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AbortRebase'
+type MockGitRepository_AbortRebase_Call struct {
 	*mock.Call
 }
 
-// BuildWorktreePath is a helper method to define mock.On call
-//   - base string
-//   - repoInfo string
-//   - sessionName string
-func (_e *MockGitRepository_Expecter) BuildWorktreePath(base interface{}, repoInfo interface{}, sessionName interface{}) *MockGitRepository_BuildWorktreePath_Call {
-	return &MockGitRepository_BuildWorktreePath_Call{Call: _e.mock.On("BuildWorktreePath", base, repoInfo, sessionName)}
+// AbortRebase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+func (_e *MockGitRepository_Expecter) AbortRebase(ctx interface{}, worktreePath interface{}) *MockGitRepository_AbortRebase_Call {
+	return &MockGitRepository_AbortRebase_Call{Call: _e.mock.On("AbortRebase", ctx, worktreePath)}
 }
 
-func (_c *MockGitRepository_BuildWorktreePath_Call) Run(run func(base string, repoInfo string, sessionName string)) *MockGitRepository_BuildWorktreePath_Call {
+func (_c *MockGitRepository_AbortRebase_Call) Run(run func(ctx context.Context, worktreePath string)) *MockGitRepository_AbortRebase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
 		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
-		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
 		run(
 			arg0,
 			arg1,
-			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_BuildWorktreePath_Call) Return(s string) *MockGitRepository_BuildWorktreePath_Call {
-	_c.Call.Return(s)
+func (_c *MockGitRepository_AbortRebase_Call) Return(err error) *MockGitRepository_AbortRebase_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGitRepository_BuildWorktreePath_Call) RunAndReturn(run func(base string, repoInfo string, sessionName string) string) *MockGitRepository_BuildWorktreePath_Call {
+func (_c *MockGitRepository_AbortRebase_Call) RunAndReturn(run func(ctx context.Context, worktreePath string) error) *MockGitRepository_AbortRebase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateWorktree provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) CreateWorktree(repoPath string, worktreePath string, branchName string) error {
-	ret := _mock.Called(repoPath, worktreePath, branchName)
+// BranchDiff provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) BranchDiff(ctx context.Context, path string, baseBranch string) (string, error) {
+	ret := _mock.Called(ctx, path, baseBranch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateWorktree")
+		panic("no return value specified for BranchDiff")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(string, string, string) error); ok {
-		r0 = returnFunc(repoPath, worktreePath, branchName)
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, path, baseBranch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, path, baseBranch)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(string)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, path, baseBranch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockGitRepository_CreateWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorktree'
-type MockGitRepository_CreateWorktree_Call struct {
+// MockGitRepository_BranchDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BranchDiff'
+type MockGitRepository_BranchDiff_Call struct {
 	*mock.Call
 }
 
-// CreateWorktree is a helper method to define mock.On call
-//   - repoPath string
-//   - worktreePath string
-//   - branchName string
-func (_e *MockGitRepository_Expecter) CreateWorktree(repoPath interface{}, worktreePath interface{}, branchName interface{}) *MockGitRepository_CreateWorktree_Call {
-	return &MockGitRepository_CreateWorktree_Call{Call: _e.mock.On("CreateWorktree", repoPath, worktreePath, branchName)}
+// BranchDiff is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - baseBranch string
+func (_e *MockGitRepository_Expecter) BranchDiff(ctx interface{}, path interface{}, baseBranch interface{}) *MockGitRepository_BranchDiff_Call {
+	return &MockGitRepository_BranchDiff_Call{Call: _e.mock.On("BranchDiff", ctx, path, baseBranch)}
 }
 
-func (_c *MockGitRepository_CreateWorktree_Call) Run(run func(repoPath string, worktreePath string, branchName string)) *MockGitRepository_CreateWorktree_Call {
+func (_c *MockGitRepository_BranchDiff_Call) Run(run func(ctx context.Context, path string, baseBranch string)) *MockGitRepository_BranchDiff_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
 		var arg1 string
 		if args[1] != nil {
@@ -154,125 +158,120 @@ func (_c *MockGitRepository_CreateWorktree_Call) Run(run func(repoPath string, w
 	return _c
 }
 
-func (_c *MockGitRepository_CreateWorktree_Call) Return(err error) *MockGitRepository_CreateWorktree_Call {
-	_c.Call.Return(err)
+func (_c *MockGitRepository_BranchDiff_Call) Return(s string, err error) *MockGitRepository_BranchDiff_Call {
+	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockGitRepository_CreateWorktree_Call) RunAndReturn(run func(repoPath string, worktreePath string, branchName string) error) *MockGitRepository_CreateWorktree_Call {
+func (_c *MockGitRepository_BranchDiff_Call) RunAndReturn(run func(ctx context.Context, path string, baseBranch string) (string, error)) *MockGitRepository_BranchDiff_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchAllPRs provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) FetchAllPRs(ctx context.Context, repoPath string) (map[string]*domain.PRInfo, error) {
-	ret := _mock.Called(ctx, repoPath)
+// BuildWorktreePath provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) BuildWorktreePath(base string, repoInfo string, sessionName string) string {
+	ret := _mock.Called(base, repoInfo, sessionName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FetchAllPRs")
+		panic("no return value specified for BuildWorktreePath")
 	}
 
-	var r0 map[string]*domain.PRInfo
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[string]*domain.PRInfo, error)); ok {
-		return returnFunc(ctx, repoPath)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[string]*domain.PRInfo); ok {
-		r0 = returnFunc(ctx, repoPath)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]*domain.PRInfo)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, repoPath)
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) string); ok {
+		r0 = returnFunc(base, repoInfo, sessionName)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Get(0).(string)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockGitRepository_FetchAllPRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchAllPRs'
-type MockGitRepository_FetchAllPRs_Call struct {
+// MockGitRepository_BuildWorktreePath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BuildWorktreePath'
+type MockGitRepository_BuildWorktreePath_Call struct {
 	*mock.Call
 }
 
-// FetchAllPRs is a helper method to define mock.On call
-//   - ctx context.Context
-//   - repoPath string
-func (_e *MockGitRepository_Expecter) FetchAllPRs(ctx interface{}, repoPath interface{}) *MockGitRepository_FetchAllPRs_Call {
-	return &MockGitRepository_FetchAllPRs_Call{Call: _e.mock.On("FetchAllPRs", ctx, repoPath)}
+// BuildWorktreePath is a helper method to define mock.On call
+//   - base string
+//   - repoInfo string
+//   - sessionName string
+func (_e *MockGitRepository_Expecter) BuildWorktreePath(base interface{}, repoInfo interface{}, sessionName interface{}) *MockGitRepository_BuildWorktreePath_Call {
+	return &MockGitRepository_BuildWorktreePath_Call{Call: _e.mock.On("BuildWorktreePath", base, repoInfo, sessionName)}
 }
 
-func (_c *MockGitRepository_FetchAllPRs_Call) Run(run func(ctx context.Context, repoPath string)) *MockGitRepository_FetchAllPRs_Call {
+func (_c *MockGitRepository_BuildWorktreePath_Call) Run(run func(base string, repoInfo string, sessionName string)) *MockGitRepository_BuildWorktreePath_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
+			arg0 = args[0].(string)
 		}
 		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_FetchAllPRs_Call) Return(stringToPRInfo map[string]*domain.PRInfo, err error) *MockGitRepository_FetchAllPRs_Call {
-	_c.Call.Return(stringToPRInfo, err)
+func (_c *MockGitRepository_BuildWorktreePath_Call) Return(s string) *MockGitRepository_BuildWorktreePath_Call {
+	_c.Call.Return(s)
 	return _c
 }
 
-func (_c *MockGitRepository_FetchAllPRs_Call) RunAndReturn(run func(ctx context.Context, repoPath string) (map[string]*domain.PRInfo, error)) *MockGitRepository_FetchAllPRs_Call {
+func (_c *MockGitRepository_BuildWorktreePath_Call) RunAndReturn(run func(base string, repoInfo string, sessionName string) string) *MockGitRepository_BuildWorktreePath_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchGitStats provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) FetchGitStats(ctx context.Context, worktreePath string) (*domain.GitStats, error) {
-	ret := _mock.Called(ctx, worktreePath)
+// CommitCheckpoint provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) CommitCheckpoint(ctx context.Context, worktreePath string, branch string, message string) (bool, error) {
+	ret := _mock.Called(ctx, worktreePath, branch, message)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FetchGitStats")
+		panic("no return value specified for CommitCheckpoint")
 	}
 
-	var r0 *domain.GitStats
+	var r0 bool
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.GitStats, error)); ok {
-		return returnFunc(ctx, worktreePath)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) (bool, error)); ok {
+		return returnFunc(ctx, worktreePath, branch, message)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.GitStats); ok {
-		r0 = returnFunc(ctx, worktreePath)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) bool); ok {
+		r0 = returnFunc(ctx, worktreePath, branch, message)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.GitStats)
-		}
+		r0 = ret.Get(0).(bool)
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = returnFunc(ctx, worktreePath)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath, branch, message)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockGitRepository_FetchGitStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchGitStats'
-type MockGitRepository_FetchGitStats_Call struct {
+// MockGitRepository_CommitCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CommitCheckpoint'
+type MockGitRepository_CommitCheckpoint_Call struct {
 	*mock.Call
 }
 
-// FetchGitStats is a helper method to define mock.On call
+// CommitCheckpoint is a helper method to define mock.On call
 //   - ctx context.Context
 //   - worktreePath string
-func (_e *MockGitRepository_Expecter) FetchGitStats(ctx interface{}, worktreePath interface{}) *MockGitRepository_FetchGitStats_Call {
-	return &MockGitRepository_FetchGitStats_Call{Call: _e.mock.On("FetchGitStats", ctx, worktreePath)}
+//   - branch string
+//   - message string
+func (_e *MockGitRepository_Expecter) CommitCheckpoint(ctx interface{}, worktreePath interface{}, branch interface{}, message interface{}) *MockGitRepository_CommitCheckpoint_Call {
+	return &MockGitRepository_CommitCheckpoint_Call{Call: _e.mock.On("CommitCheckpoint", ctx, worktreePath, branch, message)}
 }
 
-func (_c *MockGitRepository_FetchGitStats_Call) Run(run func(ctx context.Context, worktreePath string)) *MockGitRepository_FetchGitStats_Call {
+func (_c *MockGitRepository_CommitCheckpoint_Call) Run(run func(ctx context.Context, worktreePath string, branch string, message string)) *MockGitRepository_CommitCheckpoint_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -282,70 +281,69 @@ func (_c *MockGitRepository_FetchGitStats_Call) Run(run func(ctx context.Context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_FetchGitStats_Call) Return(gitStats *domain.GitStats, err error) *MockGitRepository_FetchGitStats_Call {
-	_c.Call.Return(gitStats, err)
+func (_c *MockGitRepository_CommitCheckpoint_Call) Return(b bool, err error) *MockGitRepository_CommitCheckpoint_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockGitRepository_FetchGitStats_Call) RunAndReturn(run func(ctx context.Context, worktreePath string) (*domain.GitStats, error)) *MockGitRepository_FetchGitStats_Call {
+func (_c *MockGitRepository_CommitCheckpoint_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, branch string, message string) (bool, error)) *MockGitRepository_CommitCheckpoint_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// FetchPRInfo provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) FetchPRInfo(ctx context.Context, worktreePath string, branchName string) (*domain.PRInfo, error) {
-	ret := _mock.Called(ctx, worktreePath, branchName)
+// CreateWorktree provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) CreateWorktree(repoPath string, worktreePath string, branchName string) error {
+	ret := _mock.Called(repoPath, worktreePath, branchName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for FetchPRInfo")
+		panic("no return value specified for CreateWorktree")
 	}
 
-	var r0 *domain.PRInfo
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.PRInfo, error)); ok {
-		return returnFunc(ctx, worktreePath, branchName)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.PRInfo); ok {
-		r0 = returnFunc(ctx, worktreePath, branchName)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.PRInfo)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = returnFunc(ctx, worktreePath, branchName)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = returnFunc(repoPath, worktreePath, branchName)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockGitRepository_FetchPRInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchPRInfo'
-type MockGitRepository_FetchPRInfo_Call struct {
+// MockGitRepository_CreateWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWorktree'
+type MockGitRepository_CreateWorktree_Call struct {
 	*mock.Call
 }
 
-// FetchPRInfo is a helper method to define mock.On call
-//   - ctx context.Context
+// CreateWorktree is a helper method to define mock.On call
+//   - repoPath string
 //   - worktreePath string
 //   - branchName string
-func (_e *MockGitRepository_Expecter) FetchPRInfo(ctx interface{}, worktreePath interface{}, branchName interface{}) *MockGitRepository_FetchPRInfo_Call {
-	return &MockGitRepository_FetchPRInfo_Call{Call: _e.mock.On("FetchPRInfo", ctx, worktreePath, branchName)}
+func (_e *MockGitRepository_Expecter) CreateWorktree(repoPath interface{}, worktreePath interface{}, branchName interface{}) *MockGitRepository_CreateWorktree_Call {
+	return &MockGitRepository_CreateWorktree_Call{Call: _e.mock.On("CreateWorktree", repoPath, worktreePath, branchName)}
 }
 
-func (_c *MockGitRepository_FetchPRInfo_Call) Run(run func(ctx context.Context, worktreePath string, branchName string)) *MockGitRepository_FetchPRInfo_Call {
+func (_c *MockGitRepository_CreateWorktree_Call) Run(run func(repoPath string, worktreePath string, branchName string)) *MockGitRepository_CreateWorktree_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 context.Context
+		var arg0 string
 		if args[0] != nil {
-			arg0 = args[0].(context.Context)
+			arg0 = args[0].(string)
 		}
 		var arg1 string
 		if args[1] != nil {
@@ -364,178 +362,190 @@ func (_c *MockGitRepository_FetchPRInfo_Call) Run(run func(ctx context.Context,
 	return _c
 }
 
-func (_c *MockGitRepository_FetchPRInfo_Call) Return(pRInfo *domain.PRInfo, err error) *MockGitRepository_FetchPRInfo_Call {
-	_c.Call.Return(pRInfo, err)
+func (_c *MockGitRepository_CreateWorktree_Call) Return(err error) *MockGitRepository_CreateWorktree_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGitRepository_FetchPRInfo_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, branchName string) (*domain.PRInfo, error)) *MockGitRepository_FetchPRInfo_Call {
+func (_c *MockGitRepository_CreateWorktree_Call) RunAndReturn(run func(repoPath string, worktreePath string, branchName string) error) *MockGitRepository_CreateWorktree_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetBranchName provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) GetBranchName(path string) string {
-	ret := _mock.Called(path)
+// DeleteBranch provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) DeleteBranch(repoPath string, branchName string) error {
+	ret := _mock.Called(repoPath, branchName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetBranchName")
+		panic("no return value specified for DeleteBranch")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(path)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = returnFunc(repoPath, branchName)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockGitRepository_GetBranchName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBranchName'
-type MockGitRepository_GetBranchName_Call struct {
+// MockGitRepository_DeleteBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteBranch'
+type MockGitRepository_DeleteBranch_Call struct {
 	*mock.Call
 }
 
-// GetBranchName is a helper method to define mock.On call
-//   - path string
-func (_e *MockGitRepository_Expecter) GetBranchName(path interface{}) *MockGitRepository_GetBranchName_Call {
-	return &MockGitRepository_GetBranchName_Call{Call: _e.mock.On("GetBranchName", path)}
+// DeleteBranch is a helper method to define mock.On call
+//   - repoPath string
+//   - branchName string
+func (_e *MockGitRepository_Expecter) DeleteBranch(repoPath interface{}, branchName interface{}) *MockGitRepository_DeleteBranch_Call {
+	return &MockGitRepository_DeleteBranch_Call{Call: _e.mock.On("DeleteBranch", repoPath, branchName)}
 }
 
-func (_c *MockGitRepository_GetBranchName_Call) Run(run func(path string)) *MockGitRepository_GetBranchName_Call {
+func (_c *MockGitRepository_DeleteBranch_Call) Run(run func(repoPath string, branchName string)) *MockGitRepository_DeleteBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 string
 		if args[0] != nil {
 			arg0 = args[0].(string)
 		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_GetBranchName_Call) Return(s string) *MockGitRepository_GetBranchName_Call {
-	_c.Call.Return(s)
+func (_c *MockGitRepository_DeleteBranch_Call) Return(err error) *MockGitRepository_DeleteBranch_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGitRepository_GetBranchName_Call) RunAndReturn(run func(path string) string) *MockGitRepository_GetBranchName_Call {
+func (_c *MockGitRepository_DeleteBranch_Call) RunAndReturn(run func(repoPath string, branchName string) error) *MockGitRepository_DeleteBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetMainRepoPath provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) GetMainRepoPath(path string) (string, error) {
-	ret := _mock.Called(path)
+// Diff provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) Diff(ctx context.Context, path string, since string) (string, error) {
+	ret := _mock.Called(ctx, path, since)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetMainRepoPath")
+		panic("no return value specified for Diff")
 	}
 
 	var r0 string
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
-		return returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, path, since)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(path)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, path, since)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(path)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, path, since)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockGitRepository_GetMainRepoPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMainRepoPath'
-type MockGitRepository_GetMainRepoPath_Call struct {
+// MockGitRepository_Diff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Diff'
+type MockGitRepository_Diff_Call struct {
 	*mock.Call
 }
 
-// GetMainRepoPath is a helper method to define mock.On call
+// Diff is a helper method to define mock.On call
+//   - ctx context.Context
 //   - path string
-func (_e *MockGitRepository_Expecter) GetMainRepoPath(path interface{}) *MockGitRepository_GetMainRepoPath_Call {
-	return &MockGitRepository_GetMainRepoPath_Call{Call: _e.mock.On("GetMainRepoPath", path)}
+//   - since string
+func (_e *MockGitRepository_Expecter) Diff(ctx interface{}, path interface{}, since interface{}) *MockGitRepository_Diff_Call {
+	return &MockGitRepository_Diff_Call{Call: _e.mock.On("Diff", ctx, path, since)}
 }
 
-func (_c *MockGitRepository_GetMainRepoPath_Call) Run(run func(path string)) *MockGitRepository_GetMainRepoPath_Call {
+func (_c *MockGitRepository_Diff_Call) Run(run func(ctx context.Context, path string, since string)) *MockGitRepository_Diff_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_GetMainRepoPath_Call) Return(s string, err error) *MockGitRepository_GetMainRepoPath_Call {
+func (_c *MockGitRepository_Diff_Call) Return(s string, err error) *MockGitRepository_Diff_Call {
 	_c.Call.Return(s, err)
 	return _c
 }
 
-func (_c *MockGitRepository_GetMainRepoPath_Call) RunAndReturn(run func(path string) (string, error)) *MockGitRepository_GetMainRepoPath_Call {
+func (_c *MockGitRepository_Diff_Call) RunAndReturn(run func(ctx context.Context, path string, since string) (string, error)) *MockGitRepository_Diff_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetOrCloneRepository provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) GetOrCloneRepository(source string, worktreeBase string) (string, *domain.RepoSource, error) {
-	ret := _mock.Called(source, worktreeBase)
+// FetchAllPRs provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) FetchAllPRs(ctx context.Context, repoPath string) (map[string]*domain.PRInfo, error) {
+	ret := _mock.Called(ctx, repoPath)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetOrCloneRepository")
+		panic("no return value specified for FetchAllPRs")
 	}
 
-	var r0 string
-	var r1 *domain.RepoSource
-	var r2 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (string, *domain.RepoSource, error)); ok {
-		return returnFunc(source, worktreeBase)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = returnFunc(source, worktreeBase)
-	} else {
-		r0 = ret.Get(0).(string)
+	var r0 map[string]*domain.PRInfo
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (map[string]*domain.PRInfo, error)); ok {
+		return returnFunc(ctx, repoPath)
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) *domain.RepoSource); ok {
-		r1 = returnFunc(source, worktreeBase)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) map[string]*domain.PRInfo); ok {
+		r0 = returnFunc(ctx, repoPath)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*domain.RepoSource)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*domain.PRInfo)
 		}
 	}
-	if returnFunc, ok := ret.Get(2).(func(string, string) error); ok {
-		r2 = returnFunc(source, worktreeBase)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, repoPath)
 	} else {
-		r2 = ret.Error(2)
+		r1 = ret.Error(1)
 	}
-	return r0, r1, r2
+	return r0, r1
 }
 
-// MockGitRepository_GetOrCloneRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrCloneRepository'
-type MockGitRepository_GetOrCloneRepository_Call struct {
+// MockGitRepository_FetchAllPRs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchAllPRs'
+type MockGitRepository_FetchAllPRs_Call struct {
 	*mock.Call
 }
 
-// GetOrCloneRepository is a helper method to define mock.On call
-//   - source string
-//   - worktreeBase string
-func (_e *MockGitRepository_Expecter) GetOrCloneRepository(source interface{}, worktreeBase interface{}) *MockGitRepository_GetOrCloneRepository_Call {
-	return &MockGitRepository_GetOrCloneRepository_Call{Call: _e.mock.On("GetOrCloneRepository", source, worktreeBase)}
+// FetchAllPRs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoPath string
+func (_e *MockGitRepository_Expecter) FetchAllPRs(ctx interface{}, repoPath interface{}) *MockGitRepository_FetchAllPRs_Call {
+	return &MockGitRepository_FetchAllPRs_Call{Call: _e.mock.On("FetchAllPRs", ctx, repoPath)}
 }
 
-func (_c *MockGitRepository_GetOrCloneRepository_Call) Run(run func(source string, worktreeBase string)) *MockGitRepository_GetOrCloneRepository_Call {
+func (_c *MockGitRepository_FetchAllPRs_Call) Run(run func(ctx context.Context, repoPath string)) *MockGitRepository_FetchAllPRs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
 		var arg1 string
 		if args[1] != nil {
@@ -549,230 +559,868 @@ func (_c *MockGitRepository_GetOrCloneRepository_Call) Run(run func(source strin
 	return _c
 }
 
-func (_c *MockGitRepository_GetOrCloneRepository_Call) Return(s string, repoSource *domain.RepoSource, err error) *MockGitRepository_GetOrCloneRepository_Call {
-	_c.Call.Return(s, repoSource, err)
+func (_c *MockGitRepository_FetchAllPRs_Call) Return(stringToPRInfo map[string]*domain.PRInfo, err error) *MockGitRepository_FetchAllPRs_Call {
+	_c.Call.Return(stringToPRInfo, err)
 	return _c
 }
 
-func (_c *MockGitRepository_GetOrCloneRepository_Call) RunAndReturn(run func(source string, worktreeBase string) (string, *domain.RepoSource, error)) *MockGitRepository_GetOrCloneRepository_Call {
+func (_c *MockGitRepository_FetchAllPRs_Call) RunAndReturn(run func(ctx context.Context, repoPath string) (map[string]*domain.PRInfo, error)) *MockGitRepository_FetchAllPRs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRemoteURL provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) GetRemoteURL(repoPath string) string {
-	ret := _mock.Called(repoPath)
+// FetchBase provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) FetchBase(ctx context.Context, worktreePath string, baseBranch string) error {
+	ret := _mock.Called(ctx, worktreePath, baseBranch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRemoteURL")
+		panic("no return value specified for FetchBase")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(repoPath)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, worktreePath, baseBranch)
 	} else {
-		r0 = ret.Get(0).(string)
+		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockGitRepository_GetRemoteURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRemoteURL'
-type MockGitRepository_GetRemoteURL_Call struct {
+// MockGitRepository_FetchBase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchBase'
+type MockGitRepository_FetchBase_Call struct {
 	*mock.Call
 }
 
-// GetRemoteURL is a helper method to define mock.On call
-//   - repoPath string
-func (_e *MockGitRepository_Expecter) GetRemoteURL(repoPath interface{}) *MockGitRepository_GetRemoteURL_Call {
-	return &MockGitRepository_GetRemoteURL_Call{Call: _e.mock.On("GetRemoteURL", repoPath)}
+// FetchBase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - baseBranch string
+func (_e *MockGitRepository_Expecter) FetchBase(ctx interface{}, worktreePath interface{}, baseBranch interface{}) *MockGitRepository_FetchBase_Call {
+	return &MockGitRepository_FetchBase_Call{Call: _e.mock.On("FetchBase", ctx, worktreePath, baseBranch)}
 }
 
-func (_c *MockGitRepository_GetRemoteURL_Call) Run(run func(repoPath string)) *MockGitRepository_GetRemoteURL_Call {
+func (_c *MockGitRepository_FetchBase_Call) Run(run func(ctx context.Context, worktreePath string, baseBranch string)) *MockGitRepository_FetchBase_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_GetRemoteURL_Call) Return(s string) *MockGitRepository_GetRemoteURL_Call {
-	_c.Call.Return(s)
+func (_c *MockGitRepository_FetchBase_Call) Return(err error) *MockGitRepository_FetchBase_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGitRepository_GetRemoteURL_Call) RunAndReturn(run func(repoPath string) string) *MockGitRepository_GetRemoteURL_Call {
+func (_c *MockGitRepository_FetchBase_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, baseBranch string) error) *MockGitRepository_FetchBase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRepoInfo provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) GetRepoInfo(repoPath string) string {
-	ret := _mock.Called(repoPath)
+// FetchGitStats provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) FetchGitStats(ctx context.Context, worktreePath string, baseBranch string) (*domain.GitStats, error) {
+	ret := _mock.Called(ctx, worktreePath, baseBranch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRepoInfo")
+		panic("no return value specified for FetchGitStats")
 	}
 
-	var r0 string
-	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
-		r0 = returnFunc(repoPath)
+	var r0 *domain.GitStats
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.GitStats, error)); ok {
+		return returnFunc(ctx, worktreePath, baseBranch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.GitStats); ok {
+		r0 = returnFunc(ctx, worktreePath, baseBranch)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.GitStats)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath, baseBranch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockGitRepository_GetRepoInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoInfo'
-type MockGitRepository_GetRepoInfo_Call struct {
+// MockGitRepository_FetchGitStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchGitStats'
+type MockGitRepository_FetchGitStats_Call struct {
 	*mock.Call
 }
 
-// GetRepoInfo is a helper method to define mock.On call
-//   - repoPath string
-func (_e *MockGitRepository_Expecter) GetRepoInfo(repoPath interface{}) *MockGitRepository_GetRepoInfo_Call {
-	return &MockGitRepository_GetRepoInfo_Call{Call: _e.mock.On("GetRepoInfo", repoPath)}
+// FetchGitStats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - baseBranch string
+func (_e *MockGitRepository_Expecter) FetchGitStats(ctx interface{}, worktreePath interface{}, baseBranch interface{}) *MockGitRepository_FetchGitStats_Call {
+	return &MockGitRepository_FetchGitStats_Call{Call: _e.mock.On("FetchGitStats", ctx, worktreePath, baseBranch)}
 }
 
-func (_c *MockGitRepository_GetRepoInfo_Call) Run(run func(repoPath string)) *MockGitRepository_GetRepoInfo_Call {
+func (_c *MockGitRepository_FetchGitStats_Call) Run(run func(ctx context.Context, worktreePath string, baseBranch string)) *MockGitRepository_FetchGitStats_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_GetRepoInfo_Call) Return(s string) *MockGitRepository_GetRepoInfo_Call {
-	_c.Call.Return(s)
+func (_c *MockGitRepository_FetchGitStats_Call) Return(gitStats *domain.GitStats, err error) *MockGitRepository_FetchGitStats_Call {
+	_c.Call.Return(gitStats, err)
 	return _c
 }
 
-func (_c *MockGitRepository_GetRepoInfo_Call) RunAndReturn(run func(repoPath string) string) *MockGitRepository_GetRepoInfo_Call {
+func (_c *MockGitRepository_FetchGitStats_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, baseBranch string) (*domain.GitStats, error)) *MockGitRepository_FetchGitStats_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetWorktreeForBranch provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) GetWorktreeForBranch(repoPath string, branchName string) (string, error) {
-	ret := _mock.Called(repoPath, branchName)
+// FetchPRInfo provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) FetchPRInfo(ctx context.Context, worktreePath string, branchName string) (*domain.PRInfo, error) {
+	ret := _mock.Called(ctx, worktreePath, branchName)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetWorktreeForBranch")
+		panic("no return value specified for FetchPRInfo")
 	}
 
-	var r0 string
+	var r0 *domain.PRInfo
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
-		return returnFunc(repoPath, branchName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.PRInfo, error)); ok {
+		return returnFunc(ctx, worktreePath, branchName)
 	}
-	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
-		r0 = returnFunc(repoPath, branchName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.PRInfo); ok {
+		r0 = returnFunc(ctx, worktreePath, branchName)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.PRInfo)
+		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = returnFunc(repoPath, branchName)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath, branchName)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockGitRepository_GetWorktreeForBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorktreeForBranch'
-type MockGitRepository_GetWorktreeForBranch_Call struct {
+// MockGitRepository_FetchPRInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchPRInfo'
+type MockGitRepository_FetchPRInfo_Call struct {
 	*mock.Call
 }
 
-// GetWorktreeForBranch is a helper method to define mock.On call
-//   - repoPath string
+// FetchPRInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
 //   - branchName string
-func (_e *MockGitRepository_Expecter) GetWorktreeForBranch(repoPath interface{}, branchName interface{}) *MockGitRepository_GetWorktreeForBranch_Call {
-	return &MockGitRepository_GetWorktreeForBranch_Call{Call: _e.mock.On("GetWorktreeForBranch", repoPath, branchName)}
+func (_e *MockGitRepository_Expecter) FetchPRInfo(ctx interface{}, worktreePath interface{}, branchName interface{}) *MockGitRepository_FetchPRInfo_Call {
+	return &MockGitRepository_FetchPRInfo_Call{Call: _e.mock.On("FetchPRInfo", ctx, worktreePath, branchName)}
 }
 
-func (_c *MockGitRepository_GetWorktreeForBranch_Call) Run(run func(repoPath string, branchName string)) *MockGitRepository_GetWorktreeForBranch_Call {
+func (_c *MockGitRepository_FetchPRInfo_Call) Run(run func(ctx context.Context, worktreePath string, branchName string)) *MockGitRepository_FetchPRInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
 		var arg1 string
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_GetWorktreeForBranch_Call) Return(s string, err error) *MockGitRepository_GetWorktreeForBranch_Call {
-	_c.Call.Return(s, err)
+func (_c *MockGitRepository_FetchPRInfo_Call) Return(pRInfo *domain.PRInfo, err error) *MockGitRepository_FetchPRInfo_Call {
+	_c.Call.Return(pRInfo, err)
 	return _c
 }
 
-func (_c *MockGitRepository_GetWorktreeForBranch_Call) RunAndReturn(run func(repoPath string, branchName string) (string, error)) *MockGitRepository_GetWorktreeForBranch_Call {
+func (_c *MockGitRepository_FetchPRInfo_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, branchName string) (*domain.PRInfo, error)) *MockGitRepository_FetchPRInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// IsGitRepo provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) IsGitRepo(path string) (bool, string) {
-	ret := _mock.Called(path)
+// FetchRemote provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) FetchRemote(ctx context.Context, repoPath string) error {
+	ret := _mock.Called(ctx, repoPath)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsGitRepo")
+		panic("no return value specified for FetchRemote")
 	}
 
-	var r0 bool
-	var r1 string
-	if returnFunc, ok := ret.Get(0).(func(string) (bool, string)); ok {
-		return returnFunc(path)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = returnFunc(path)
-	} else {
-		r0 = ret.Get(0).(bool)
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) string); ok {
-		r1 = returnFunc(path)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, repoPath)
 	} else {
-		r1 = ret.Get(1).(string)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockGitRepository_IsGitRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsGitRepo'
-type MockGitRepository_IsGitRepo_Call struct {
+// MockGitRepository_FetchRemote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FetchRemote'
+type MockGitRepository_FetchRemote_Call struct {
 	*mock.Call
 }
 
-// IsGitRepo is a helper method to define mock.On call
-//   - path string
-func (_e *MockGitRepository_Expecter) IsGitRepo(path interface{}) *MockGitRepository_IsGitRepo_Call {
-	return &MockGitRepository_IsGitRepo_Call{Call: _e.mock.On("IsGitRepo", path)}
+// FetchRemote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoPath string
+func (_e *MockGitRepository_Expecter) FetchRemote(ctx interface{}, repoPath interface{}) *MockGitRepository_FetchRemote_Call {
+	return &MockGitRepository_FetchRemote_Call{Call: _e.mock.On("FetchRemote", ctx, repoPath)}
 }
 
-func (_c *MockGitRepository_IsGitRepo_Call) Run(run func(path string)) *MockGitRepository_IsGitRepo_Call {
+func (_c *MockGitRepository_FetchRemote_Call) Run(run func(ctx context.Context, repoPath string)) *MockGitRepository_FetchRemote_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
 		}
-		run(
-			arg0,
-		)
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_FetchRemote_Call) Return(err error) *MockGitRepository_FetchRemote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitRepository_FetchRemote_Call) RunAndReturn(run func(ctx context.Context, repoPath string) error) *MockGitRepository_FetchRemote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBranchName provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetBranchName(path string) string {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBranchName")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockGitRepository_GetBranchName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBranchName'
+type MockGitRepository_GetBranchName_Call struct {
+	*mock.Call
+}
+
+// GetBranchName is a helper method to define mock.On call
+//   - path string
+func (_e *MockGitRepository_Expecter) GetBranchName(path interface{}) *MockGitRepository_GetBranchName_Call {
+	return &MockGitRepository_GetBranchName_Call{Call: _e.mock.On("GetBranchName", path)}
+}
+
+func (_c *MockGitRepository_GetBranchName_Call) Run(run func(path string)) *MockGitRepository_GetBranchName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetBranchName_Call) Return(s string) *MockGitRepository_GetBranchName_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockGitRepository_GetBranchName_Call) RunAndReturn(run func(path string) string) *MockGitRepository_GetBranchName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDefaultBranch provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetDefaultBranch(repoPath string) string {
+	ret := _mock.Called(repoPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDefaultBranch")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(repoPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockGitRepository_GetDefaultBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDefaultBranch'
+type MockGitRepository_GetDefaultBranch_Call struct {
+	*mock.Call
+}
+
+// GetDefaultBranch is a helper method to define mock.On call
+//   - repoPath string
+func (_e *MockGitRepository_Expecter) GetDefaultBranch(repoPath interface{}) *MockGitRepository_GetDefaultBranch_Call {
+	return &MockGitRepository_GetDefaultBranch_Call{Call: _e.mock.On("GetDefaultBranch", repoPath)}
+}
+
+func (_c *MockGitRepository_GetDefaultBranch_Call) Run(run func(repoPath string)) *MockGitRepository_GetDefaultBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetDefaultBranch_Call) Return(s string) *MockGitRepository_GetDefaultBranch_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockGitRepository_GetDefaultBranch_Call) RunAndReturn(run func(repoPath string) string) *MockGitRepository_GetDefaultBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetHeadCommit provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetHeadCommit(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetHeadCommit")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_GetHeadCommit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetHeadCommit'
+type MockGitRepository_GetHeadCommit_Call struct {
+	*mock.Call
+}
+
+// GetHeadCommit is a helper method to define mock.On call
+//   - path string
+func (_e *MockGitRepository_Expecter) GetHeadCommit(path interface{}) *MockGitRepository_GetHeadCommit_Call {
+	return &MockGitRepository_GetHeadCommit_Call{Call: _e.mock.On("GetHeadCommit", path)}
+}
+
+func (_c *MockGitRepository_GetHeadCommit_Call) Run(run func(path string)) *MockGitRepository_GetHeadCommit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetHeadCommit_Call) Return(s string, err error) *MockGitRepository_GetHeadCommit_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockGitRepository_GetHeadCommit_Call) RunAndReturn(run func(path string) (string, error)) *MockGitRepository_GetHeadCommit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetMainRepoPath provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetMainRepoPath(path string) (string, error) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetMainRepoPath")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_GetMainRepoPath_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetMainRepoPath'
+type MockGitRepository_GetMainRepoPath_Call struct {
+	*mock.Call
+}
+
+// GetMainRepoPath is a helper method to define mock.On call
+//   - path string
+func (_e *MockGitRepository_Expecter) GetMainRepoPath(path interface{}) *MockGitRepository_GetMainRepoPath_Call {
+	return &MockGitRepository_GetMainRepoPath_Call{Call: _e.mock.On("GetMainRepoPath", path)}
+}
+
+func (_c *MockGitRepository_GetMainRepoPath_Call) Run(run func(path string)) *MockGitRepository_GetMainRepoPath_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetMainRepoPath_Call) Return(s string, err error) *MockGitRepository_GetMainRepoPath_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockGitRepository_GetMainRepoPath_Call) RunAndReturn(run func(path string) (string, error)) *MockGitRepository_GetMainRepoPath_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOrCloneRepository provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetOrCloneRepository(source string, worktreeBase string, credentials domain.GitCredentials) (string, *domain.RepoSource, error) {
+	ret := _mock.Called(source, worktreeBase, credentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOrCloneRepository")
+	}
+
+	var r0 string
+	var r1 *domain.RepoSource
+	var r2 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, domain.GitCredentials) (string, *domain.RepoSource, error)); ok {
+		return returnFunc(source, worktreeBase, credentials)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, domain.GitCredentials) string); ok {
+		r0 = returnFunc(source, worktreeBase, credentials)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, domain.GitCredentials) *domain.RepoSource); ok {
+		r1 = returnFunc(source, worktreeBase, credentials)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*domain.RepoSource)
+		}
+	}
+	if returnFunc, ok := ret.Get(2).(func(string, string, domain.GitCredentials) error); ok {
+		r2 = returnFunc(source, worktreeBase, credentials)
+	} else {
+		r2 = ret.Error(2)
+	}
+	return r0, r1, r2
+}
+
+// MockGitRepository_GetOrCloneRepository_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOrCloneRepository'
+type MockGitRepository_GetOrCloneRepository_Call struct {
+	*mock.Call
+}
+
+// GetOrCloneRepository is a helper method to define mock.On call
+//   - source string
+//   - worktreeBase string
+//   - credentials domain.GitCredentials
+func (_e *MockGitRepository_Expecter) GetOrCloneRepository(source interface{}, worktreeBase interface{}, credentials interface{}) *MockGitRepository_GetOrCloneRepository_Call {
+	return &MockGitRepository_GetOrCloneRepository_Call{Call: _e.mock.On("GetOrCloneRepository", source, worktreeBase, credentials)}
+}
+
+func (_c *MockGitRepository_GetOrCloneRepository_Call) Run(run func(source string, worktreeBase string, credentials domain.GitCredentials)) *MockGitRepository_GetOrCloneRepository_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.GitCredentials
+		if args[2] != nil {
+			arg2 = args[2].(domain.GitCredentials)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetOrCloneRepository_Call) Return(s string, repoSource *domain.RepoSource, err error) *MockGitRepository_GetOrCloneRepository_Call {
+	_c.Call.Return(s, repoSource, err)
+	return _c
+}
+
+func (_c *MockGitRepository_GetOrCloneRepository_Call) RunAndReturn(run func(source string, worktreeBase string, credentials domain.GitCredentials) (string, *domain.RepoSource, error)) *MockGitRepository_GetOrCloneRepository_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRemoteURL provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetRemoteURL(repoPath string) string {
+	ret := _mock.Called(repoPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRemoteURL")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(repoPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockGitRepository_GetRemoteURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRemoteURL'
+type MockGitRepository_GetRemoteURL_Call struct {
+	*mock.Call
+}
+
+// GetRemoteURL is a helper method to define mock.On call
+//   - repoPath string
+func (_e *MockGitRepository_Expecter) GetRemoteURL(repoPath interface{}) *MockGitRepository_GetRemoteURL_Call {
+	return &MockGitRepository_GetRemoteURL_Call{Call: _e.mock.On("GetRemoteURL", repoPath)}
+}
+
+func (_c *MockGitRepository_GetRemoteURL_Call) Run(run func(repoPath string)) *MockGitRepository_GetRemoteURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetRemoteURL_Call) Return(s string) *MockGitRepository_GetRemoteURL_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockGitRepository_GetRemoteURL_Call) RunAndReturn(run func(repoPath string) string) *MockGitRepository_GetRemoteURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRepoInfo provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetRepoInfo(repoPath string) string {
+	ret := _mock.Called(repoPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRepoInfo")
+	}
+
+	var r0 string
+	if returnFunc, ok := ret.Get(0).(func(string) string); ok {
+		r0 = returnFunc(repoPath)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	return r0
+}
+
+// MockGitRepository_GetRepoInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRepoInfo'
+type MockGitRepository_GetRepoInfo_Call struct {
+	*mock.Call
+}
+
+// GetRepoInfo is a helper method to define mock.On call
+//   - repoPath string
+func (_e *MockGitRepository_Expecter) GetRepoInfo(repoPath interface{}) *MockGitRepository_GetRepoInfo_Call {
+	return &MockGitRepository_GetRepoInfo_Call{Call: _e.mock.On("GetRepoInfo", repoPath)}
+}
+
+func (_c *MockGitRepository_GetRepoInfo_Call) Run(run func(repoPath string)) *MockGitRepository_GetRepoInfo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetRepoInfo_Call) Return(s string) *MockGitRepository_GetRepoInfo_Call {
+	_c.Call.Return(s)
+	return _c
+}
+
+func (_c *MockGitRepository_GetRepoInfo_Call) RunAndReturn(run func(repoPath string) string) *MockGitRepository_GetRepoInfo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorktreeForBranch provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetWorktreeForBranch(repoPath string, branchName string) (string, error) {
+	ret := _mock.Called(repoPath, branchName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorktreeForBranch")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (string, error)); ok {
+		return returnFunc(repoPath, branchName)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = returnFunc(repoPath, branchName)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(repoPath, branchName)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_GetWorktreeForBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorktreeForBranch'
+type MockGitRepository_GetWorktreeForBranch_Call struct {
+	*mock.Call
+}
+
+// GetWorktreeForBranch is a helper method to define mock.On call
+//   - repoPath string
+//   - branchName string
+func (_e *MockGitRepository_Expecter) GetWorktreeForBranch(repoPath interface{}, branchName interface{}) *MockGitRepository_GetWorktreeForBranch_Call {
+	return &MockGitRepository_GetWorktreeForBranch_Call{Call: _e.mock.On("GetWorktreeForBranch", repoPath, branchName)}
+}
+
+func (_c *MockGitRepository_GetWorktreeForBranch_Call) Run(run func(repoPath string, branchName string)) *MockGitRepository_GetWorktreeForBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetWorktreeForBranch_Call) Return(s string, err error) *MockGitRepository_GetWorktreeForBranch_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *MockGitRepository_GetWorktreeForBranch_Call) RunAndReturn(run func(repoPath string, branchName string) (string, error)) *MockGitRepository_GetWorktreeForBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetWorktreeStatus provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) GetWorktreeStatus(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error) {
+	ret := _mock.Called(ctx, worktreePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetWorktreeStatus")
+	}
+
+	var r0 *domain.WorktreeStatus
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (*domain.WorktreeStatus, error)); ok {
+		return returnFunc(ctx, worktreePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) *domain.WorktreeStatus); ok {
+		r0 = returnFunc(ctx, worktreePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.WorktreeStatus)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_GetWorktreeStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetWorktreeStatus'
+type MockGitRepository_GetWorktreeStatus_Call struct {
+	*mock.Call
+}
+
+// GetWorktreeStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+func (_e *MockGitRepository_Expecter) GetWorktreeStatus(ctx interface{}, worktreePath interface{}) *MockGitRepository_GetWorktreeStatus_Call {
+	return &MockGitRepository_GetWorktreeStatus_Call{Call: _e.mock.On("GetWorktreeStatus", ctx, worktreePath)}
+}
+
+func (_c *MockGitRepository_GetWorktreeStatus_Call) Run(run func(ctx context.Context, worktreePath string)) *MockGitRepository_GetWorktreeStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_GetWorktreeStatus_Call) Return(worktreeStatus *domain.WorktreeStatus, err error) *MockGitRepository_GetWorktreeStatus_Call {
+	_c.Call.Return(worktreeStatus, err)
+	return _c
+}
+
+func (_c *MockGitRepository_GetWorktreeStatus_Call) RunAndReturn(run func(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error)) *MockGitRepository_GetWorktreeStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// IsGitRepo provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) IsGitRepo(path string) (bool, string) {
+	ret := _mock.Called(path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsGitRepo")
+	}
+
+	var r0 bool
+	var r1 string
+	if returnFunc, ok := ret.Get(0).(func(string) (bool, string)); ok {
+		return returnFunc(path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(path)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) string); ok {
+		r1 = returnFunc(path)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_IsGitRepo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsGitRepo'
+type MockGitRepository_IsGitRepo_Call struct {
+	*mock.Call
+}
+
+// IsGitRepo is a helper method to define mock.On call
+//   - path string
+func (_e *MockGitRepository_Expecter) IsGitRepo(path interface{}) *MockGitRepository_IsGitRepo_Call {
+	return &MockGitRepository_IsGitRepo_Call{Call: _e.mock.On("IsGitRepo", path)}
+}
+
+func (_c *MockGitRepository_IsGitRepo_Call) Run(run func(path string)) *MockGitRepository_IsGitRepo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
 	})
 	return _c
 }
@@ -792,48 +1440,332 @@ func (_mock *MockGitRepository) IsGitURL(source string) bool {
 	ret := _mock.Called(source)
 
 	if len(ret) == 0 {
-		panic("no return value specified for IsGitURL")
+		panic("no return value specified for IsGitURL")
+	}
+
+	var r0 bool
+	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = returnFunc(source)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	return r0
+}
+
+// MockGitRepository_IsGitURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsGitURL'
+type MockGitRepository_IsGitURL_Call struct {
+	*mock.Call
+}
+
+// IsGitURL is a helper method to define mock.On call
+//   - source string
+func (_e *MockGitRepository_Expecter) IsGitURL(source interface{}) *MockGitRepository_IsGitURL_Call {
+	return &MockGitRepository_IsGitURL_Call{Call: _e.mock.On("IsGitURL", source)}
+}
+
+func (_c *MockGitRepository_IsGitURL_Call) Run(run func(source string)) *MockGitRepository_IsGitURL_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_IsGitURL_Call) Return(b bool) *MockGitRepository_IsGitURL_Call {
+	_c.Call.Return(b)
+	return _c
+}
+
+func (_c *MockGitRepository_IsGitURL_Call) RunAndReturn(run func(source string) bool) *MockGitRepository_IsGitURL_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBranches provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) ListBranches(ctx context.Context, worktreePath string) ([]domain.Branch, error) {
+	ret := _mock.Called(ctx, worktreePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBranches")
+	}
+
+	var r0 []domain.Branch
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Branch, error)); ok {
+		return returnFunc(ctx, worktreePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Branch); ok {
+		r0 = returnFunc(ctx, worktreePath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Branch)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_ListBranches_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBranches'
+type MockGitRepository_ListBranches_Call struct {
+	*mock.Call
+}
+
+// ListBranches is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+func (_e *MockGitRepository_Expecter) ListBranches(ctx interface{}, worktreePath interface{}) *MockGitRepository_ListBranches_Call {
+	return &MockGitRepository_ListBranches_Call{Call: _e.mock.On("ListBranches", ctx, worktreePath)}
+}
+
+func (_c *MockGitRepository_ListBranches_Call) Run(run func(ctx context.Context, worktreePath string)) *MockGitRepository_ListBranches_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_ListBranches_Call) Return(branchs []domain.Branch, err error) *MockGitRepository_ListBranches_Call {
+	_c.Call.Return(branchs, err)
+	return _c
+}
+
+func (_c *MockGitRepository_ListBranches_Call) RunAndReturn(run func(ctx context.Context, worktreePath string) ([]domain.Branch, error)) *MockGitRepository_ListBranches_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListChangedFiles provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) ListChangedFiles(ctx context.Context, path string) ([]string, error) {
+	ret := _mock.Called(ctx, path)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListChangedFiles")
+	}
+
+	var r0 []string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return returnFunc(ctx, path)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = returnFunc(ctx, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_ListChangedFiles_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListChangedFiles'
+type MockGitRepository_ListChangedFiles_Call struct {
+	*mock.Call
+}
+
+// ListChangedFiles is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+func (_e *MockGitRepository_Expecter) ListChangedFiles(ctx interface{}, path interface{}) *MockGitRepository_ListChangedFiles_Call {
+	return &MockGitRepository_ListChangedFiles_Call{Call: _e.mock.On("ListChangedFiles", ctx, path)}
+}
+
+func (_c *MockGitRepository_ListChangedFiles_Call) Run(run func(ctx context.Context, path string)) *MockGitRepository_ListChangedFiles_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_ListChangedFiles_Call) Return(strings []string, err error) *MockGitRepository_ListChangedFiles_Call {
+	_c.Call.Return(strings, err)
+	return _c
+}
+
+func (_c *MockGitRepository_ListChangedFiles_Call) RunAndReturn(run func(ctx context.Context, path string) ([]string, error)) *MockGitRepository_ListChangedFiles_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCommits provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) ListCommits(ctx context.Context, path string, baseBranch string, since time.Time) ([]domain.Commit, error) {
+	ret := _mock.Called(ctx, path, baseBranch, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCommits")
+	}
+
+	var r0 []domain.Commit
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) ([]domain.Commit, error)); ok {
+		return returnFunc(ctx, path, baseBranch, since)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, time.Time) []domain.Commit); ok {
+		r0 = returnFunc(ctx, path, baseBranch, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Commit)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, path, baseBranch, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_ListCommits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCommits'
+type MockGitRepository_ListCommits_Call struct {
+	*mock.Call
+}
+
+// ListCommits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - path string
+//   - baseBranch string
+//   - since time.Time
+func (_e *MockGitRepository_Expecter) ListCommits(ctx interface{}, path interface{}, baseBranch interface{}, since interface{}) *MockGitRepository_ListCommits_Call {
+	return &MockGitRepository_ListCommits_Call{Call: _e.mock.On("ListCommits", ctx, path, baseBranch, since)}
+}
+
+func (_c *MockGitRepository_ListCommits_Call) Run(run func(ctx context.Context, path string, baseBranch string, since time.Time)) *MockGitRepository_ListCommits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 time.Time
+		if args[3] != nil {
+			arg3 = args[3].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_ListCommits_Call) Return(commits []domain.Commit, err error) *MockGitRepository_ListCommits_Call {
+	_c.Call.Return(commits, err)
+	return _c
+}
+
+func (_c *MockGitRepository_ListCommits_Call) RunAndReturn(run func(ctx context.Context, path string, baseBranch string, since time.Time) ([]domain.Commit, error)) *MockGitRepository_ListCommits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListStashes provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) ListStashes(ctx context.Context, worktreePath string) ([]domain.Stash, error) {
+	ret := _mock.Called(ctx, worktreePath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStashes")
 	}
 
-	var r0 bool
-	if returnFunc, ok := ret.Get(0).(func(string) bool); ok {
-		r0 = returnFunc(source)
+	var r0 []domain.Stash
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]domain.Stash, error)); ok {
+		return returnFunc(ctx, worktreePath)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []domain.Stash); ok {
+		r0 = returnFunc(ctx, worktreePath)
 	} else {
-		r0 = ret.Get(0).(bool)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Stash)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockGitRepository_IsGitURL_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'IsGitURL'
-type MockGitRepository_IsGitURL_Call struct {
+// MockGitRepository_ListStashes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStashes'
+type MockGitRepository_ListStashes_Call struct {
 	*mock.Call
 }
 
-// IsGitURL is a helper method to define mock.On call
-//   - source string
-func (_e *MockGitRepository_Expecter) IsGitURL(source interface{}) *MockGitRepository_IsGitURL_Call {
-	return &MockGitRepository_IsGitURL_Call{Call: _e.mock.On("IsGitURL", source)}
+// ListStashes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+func (_e *MockGitRepository_Expecter) ListStashes(ctx interface{}, worktreePath interface{}) *MockGitRepository_ListStashes_Call {
+	return &MockGitRepository_ListStashes_Call{Call: _e.mock.On("ListStashes", ctx, worktreePath)}
 }
 
-func (_c *MockGitRepository_IsGitURL_Call) Run(run func(source string)) *MockGitRepository_IsGitURL_Call {
+func (_c *MockGitRepository_ListStashes_Call) Run(run func(ctx context.Context, worktreePath string)) *MockGitRepository_ListStashes_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_IsGitURL_Call) Return(b bool) *MockGitRepository_IsGitURL_Call {
-	_c.Call.Return(b)
+func (_c *MockGitRepository_ListStashes_Call) Return(stashs []domain.Stash, err error) *MockGitRepository_ListStashes_Call {
+	_c.Call.Return(stashs, err)
 	return _c
 }
 
-func (_c *MockGitRepository_IsGitURL_Call) RunAndReturn(run func(source string) bool) *MockGitRepository_IsGitURL_Call {
+func (_c *MockGitRepository_ListStashes_Call) RunAndReturn(run func(ctx context.Context, worktreePath string) ([]domain.Stash, error)) *MockGitRepository_ListStashes_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -941,74 +1873,349 @@ func (_c *MockGitRepository_OpenPRInBrowser_Call) Run(run func(worktreePath stri
 	return _c
 }
 
-func (_c *MockGitRepository_OpenPRInBrowser_Call) Return(err error) *MockGitRepository_OpenPRInBrowser_Call {
-	_c.Call.Return(err)
+func (_c *MockGitRepository_OpenPRInBrowser_Call) Return(err error) *MockGitRepository_OpenPRInBrowser_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitRepository_OpenPRInBrowser_Call) RunAndReturn(run func(worktreePath string) error) *MockGitRepository_OpenPRInBrowser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ParseRepoSource provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) ParseRepoSource(source string) (*domain.RepoSource, error) {
+	ret := _mock.Called(source)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ParseRepoSource")
+	}
+
+	var r0 *domain.RepoSource
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string) (*domain.RepoSource, error)); ok {
+		return returnFunc(source)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string) *domain.RepoSource); ok {
+		r0 = returnFunc(source)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RepoSource)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
+		r1 = returnFunc(source)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_ParseRepoSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseRepoSource'
+type MockGitRepository_ParseRepoSource_Call struct {
+	*mock.Call
+}
+
+// ParseRepoSource is a helper method to define mock.On call
+//   - source string
+func (_e *MockGitRepository_Expecter) ParseRepoSource(source interface{}) *MockGitRepository_ParseRepoSource_Call {
+	return &MockGitRepository_ParseRepoSource_Call{Call: _e.mock.On("ParseRepoSource", source)}
+}
+
+func (_c *MockGitRepository_ParseRepoSource_Call) Run(run func(source string)) *MockGitRepository_ParseRepoSource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_ParseRepoSource_Call) Return(repoSource *domain.RepoSource, err error) *MockGitRepository_ParseRepoSource_Call {
+	_c.Call.Return(repoSource, err)
+	return _c
+}
+
+func (_c *MockGitRepository_ParseRepoSource_Call) RunAndReturn(run func(source string) (*domain.RepoSource, error)) *MockGitRepository_ParseRepoSource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveWorktree provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) MoveWorktree(ctx context.Context, repoPath string, worktreePath string, newPath string) error {
+	ret := _mock.Called(ctx, repoPath, worktreePath, newPath)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveWorktree")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, repoPath, worktreePath, newPath)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGitRepository_MoveWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveWorktree'
+type MockGitRepository_MoveWorktree_Call struct {
+	*mock.Call
+}
+
+// MoveWorktree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - repoPath string
+//   - worktreePath string
+//   - newPath string
+func (_e *MockGitRepository_Expecter) MoveWorktree(ctx interface{}, repoPath interface{}, worktreePath interface{}, newPath interface{}) *MockGitRepository_MoveWorktree_Call {
+	return &MockGitRepository_MoveWorktree_Call{Call: _e.mock.On("MoveWorktree", ctx, repoPath, worktreePath, newPath)}
+}
+
+func (_c *MockGitRepository_MoveWorktree_Call) Run(run func(ctx context.Context, repoPath string, worktreePath string, newPath string)) *MockGitRepository_MoveWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_MoveWorktree_Call) Return(err error) *MockGitRepository_MoveWorktree_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitRepository_MoveWorktree_Call) RunAndReturn(run func(ctx context.Context, repoPath string, worktreePath string, newPath string) error) *MockGitRepository_MoveWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PopStash provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) PopStash(ctx context.Context, worktreePath string, ref string) error {
+	ret := _mock.Called(ctx, worktreePath, ref)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PopStash")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, worktreePath, ref)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGitRepository_PopStash_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PopStash'
+type MockGitRepository_PopStash_Call struct {
+	*mock.Call
+}
+
+// PopStash is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - ref string
+func (_e *MockGitRepository_Expecter) PopStash(ctx interface{}, worktreePath interface{}, ref interface{}) *MockGitRepository_PopStash_Call {
+	return &MockGitRepository_PopStash_Call{Call: _e.mock.On("PopStash", ctx, worktreePath, ref)}
+}
+
+func (_c *MockGitRepository_PopStash_Call) Run(run func(ctx context.Context, worktreePath string, ref string)) *MockGitRepository_PopStash_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_PopStash_Call) Return(err error) *MockGitRepository_PopStash_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitRepository_PopStash_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, ref string) error) *MockGitRepository_PopStash_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RebaseOntoBase provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) RebaseOntoBase(ctx context.Context, worktreePath string, baseBranch string) (*domain.RebaseResult, error) {
+	ret := _mock.Called(ctx, worktreePath, baseBranch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RebaseOntoBase")
+	}
+
+	var r0 *domain.RebaseResult
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (*domain.RebaseResult, error)); ok {
+		return returnFunc(ctx, worktreePath, baseBranch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) *domain.RebaseResult); ok {
+		r0 = returnFunc(ctx, worktreePath, baseBranch)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RebaseResult)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath, baseBranch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_RebaseOntoBase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RebaseOntoBase'
+type MockGitRepository_RebaseOntoBase_Call struct {
+	*mock.Call
+}
+
+// RebaseOntoBase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - baseBranch string
+func (_e *MockGitRepository_Expecter) RebaseOntoBase(ctx interface{}, worktreePath interface{}, baseBranch interface{}) *MockGitRepository_RebaseOntoBase_Call {
+	return &MockGitRepository_RebaseOntoBase_Call{Call: _e.mock.On("RebaseOntoBase", ctx, worktreePath, baseBranch)}
+}
+
+func (_c *MockGitRepository_RebaseOntoBase_Call) Run(run func(ctx context.Context, worktreePath string, baseBranch string)) *MockGitRepository_RebaseOntoBase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_RebaseOntoBase_Call) Return(rebaseResult *domain.RebaseResult, err error) *MockGitRepository_RebaseOntoBase_Call {
+	_c.Call.Return(rebaseResult, err)
 	return _c
 }
 
-func (_c *MockGitRepository_OpenPRInBrowser_Call) RunAndReturn(run func(worktreePath string) error) *MockGitRepository_OpenPRInBrowser_Call {
+func (_c *MockGitRepository_RebaseOntoBase_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, baseBranch string) (*domain.RebaseResult, error)) *MockGitRepository_RebaseOntoBase_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ParseRepoSource provides a mock function for the type MockGitRepository
-func (_mock *MockGitRepository) ParseRepoSource(source string) (*domain.RepoSource, error) {
-	ret := _mock.Called(source)
+// RenameBranch provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) RenameBranch(ctx context.Context, worktreePath string, oldBranch string, newBranch string) error {
+	ret := _mock.Called(ctx, worktreePath, oldBranch, newBranch)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ParseRepoSource")
+		panic("no return value specified for RenameBranch")
 	}
 
-	var r0 *domain.RepoSource
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(string) (*domain.RepoSource, error)); ok {
-		return returnFunc(source)
-	}
-	if returnFunc, ok := ret.Get(0).(func(string) *domain.RepoSource); ok {
-		r0 = returnFunc(source)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*domain.RepoSource)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(string) error); ok {
-		r1 = returnFunc(source)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = returnFunc(ctx, worktreePath, oldBranch, newBranch)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockGitRepository_ParseRepoSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ParseRepoSource'
-type MockGitRepository_ParseRepoSource_Call struct {
+// MockGitRepository_RenameBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RenameBranch'
+type MockGitRepository_RenameBranch_Call struct {
 	*mock.Call
 }
 
-// ParseRepoSource is a helper method to define mock.On call
-//   - source string
-func (_e *MockGitRepository_Expecter) ParseRepoSource(source interface{}) *MockGitRepository_ParseRepoSource_Call {
-	return &MockGitRepository_ParseRepoSource_Call{Call: _e.mock.On("ParseRepoSource", source)}
+// RenameBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - oldBranch string
+//   - newBranch string
+func (_e *MockGitRepository_Expecter) RenameBranch(ctx interface{}, worktreePath interface{}, oldBranch interface{}, newBranch interface{}) *MockGitRepository_RenameBranch_Call {
+	return &MockGitRepository_RenameBranch_Call{Call: _e.mock.On("RenameBranch", ctx, worktreePath, oldBranch, newBranch)}
 }
 
-func (_c *MockGitRepository_ParseRepoSource_Call) Run(run func(source string)) *MockGitRepository_ParseRepoSource_Call {
+func (_c *MockGitRepository_RenameBranch_Call) Run(run func(ctx context.Context, worktreePath string, oldBranch string, newBranch string)) *MockGitRepository_RenameBranch_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 string
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(string)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
+			arg1,
+			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockGitRepository_ParseRepoSource_Call) Return(repoSource *domain.RepoSource, err error) *MockGitRepository_ParseRepoSource_Call {
-	_c.Call.Return(repoSource, err)
+func (_c *MockGitRepository_RenameBranch_Call) Return(err error) *MockGitRepository_RenameBranch_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockGitRepository_ParseRepoSource_Call) RunAndReturn(run func(source string) (*domain.RepoSource, error)) *MockGitRepository_ParseRepoSource_Call {
+func (_c *MockGitRepository_RenameBranch_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, oldBranch string, newBranch string) error) *MockGitRepository_RenameBranch_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -1187,6 +2394,276 @@ func (_c *MockGitRepository_SanitizeBranchName_Call) RunAndReturn(run func(name
 	return _c
 }
 
+// SetWorktreeIdentity provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) SetWorktreeIdentity(ctx context.Context, worktreePath string, identity domain.GitIdentity) error {
+	ret := _mock.Called(ctx, worktreePath, identity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetWorktreeIdentity")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.GitIdentity) error); ok {
+		r0 = returnFunc(ctx, worktreePath, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGitRepository_SetWorktreeIdentity_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetWorktreeIdentity'
+type MockGitRepository_SetWorktreeIdentity_Call struct {
+	*mock.Call
+}
+
+// SetWorktreeIdentity is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - identity domain.GitIdentity
+func (_e *MockGitRepository_Expecter) SetWorktreeIdentity(ctx interface{}, worktreePath interface{}, identity interface{}) *MockGitRepository_SetWorktreeIdentity_Call {
+	return &MockGitRepository_SetWorktreeIdentity_Call{Call: _e.mock.On("SetWorktreeIdentity", ctx, worktreePath, identity)}
+}
+
+func (_c *MockGitRepository_SetWorktreeIdentity_Call) Run(run func(ctx context.Context, worktreePath string, identity domain.GitIdentity)) *MockGitRepository_SetWorktreeIdentity_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.GitIdentity
+		if args[2] != nil {
+			arg2 = args[2].(domain.GitIdentity)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_SetWorktreeIdentity_Call) Return(err error) *MockGitRepository_SetWorktreeIdentity_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitRepository_SetWorktreeIdentity_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, identity domain.GitIdentity) error) *MockGitRepository_SetWorktreeIdentity_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StashCheckpoint provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) StashCheckpoint(ctx context.Context, worktreePath string, message string) (bool, error) {
+	ret := _mock.Called(ctx, worktreePath, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StashCheckpoint")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, worktreePath, message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, worktreePath, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_StashCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashCheckpoint'
+type MockGitRepository_StashCheckpoint_Call struct {
+	*mock.Call
+}
+
+// StashCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - message string
+func (_e *MockGitRepository_Expecter) StashCheckpoint(ctx interface{}, worktreePath interface{}, message interface{}) *MockGitRepository_StashCheckpoint_Call {
+	return &MockGitRepository_StashCheckpoint_Call{Call: _e.mock.On("StashCheckpoint", ctx, worktreePath, message)}
+}
+
+func (_c *MockGitRepository_StashCheckpoint_Call) Run(run func(ctx context.Context, worktreePath string, message string)) *MockGitRepository_StashCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_StashCheckpoint_Call) Return(b bool, err error) *MockGitRepository_StashCheckpoint_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockGitRepository_StashCheckpoint_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, message string) (bool, error)) *MockGitRepository_StashCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StashChanges provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) StashChanges(ctx context.Context, worktreePath string, message string) (bool, error) {
+	ret := _mock.Called(ctx, worktreePath, message)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StashChanges")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return returnFunc(ctx, worktreePath, message)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = returnFunc(ctx, worktreePath, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, worktreePath, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockGitRepository_StashChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StashChanges'
+type MockGitRepository_StashChanges_Call struct {
+	*mock.Call
+}
+
+// StashChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - message string
+func (_e *MockGitRepository_Expecter) StashChanges(ctx interface{}, worktreePath interface{}, message interface{}) *MockGitRepository_StashChanges_Call {
+	return &MockGitRepository_StashChanges_Call{Call: _e.mock.On("StashChanges", ctx, worktreePath, message)}
+}
+
+func (_c *MockGitRepository_StashChanges_Call) Run(run func(ctx context.Context, worktreePath string, message string)) *MockGitRepository_StashChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_StashChanges_Call) Return(b bool, err error) *MockGitRepository_StashChanges_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockGitRepository_StashChanges_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, message string) (bool, error)) *MockGitRepository_StashChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwitchBranch provides a mock function for the type MockGitRepository
+func (_mock *MockGitRepository) SwitchBranch(ctx context.Context, worktreePath string, branch string) error {
+	ret := _mock.Called(ctx, worktreePath, branch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SwitchBranch")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, worktreePath, branch)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockGitRepository_SwitchBranch_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwitchBranch'
+type MockGitRepository_SwitchBranch_Call struct {
+	*mock.Call
+}
+
+// SwitchBranch is a helper method to define mock.On call
+//   - ctx context.Context
+//   - worktreePath string
+//   - branch string
+func (_e *MockGitRepository_Expecter) SwitchBranch(ctx interface{}, worktreePath interface{}, branch interface{}) *MockGitRepository_SwitchBranch_Call {
+	return &MockGitRepository_SwitchBranch_Call{Call: _e.mock.On("SwitchBranch", ctx, worktreePath, branch)}
+}
+
+func (_c *MockGitRepository_SwitchBranch_Call) Run(run func(ctx context.Context, worktreePath string, branch string)) *MockGitRepository_SwitchBranch_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockGitRepository_SwitchBranch_Call) Return(err error) *MockGitRepository_SwitchBranch_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockGitRepository_SwitchBranch_Call) RunAndReturn(run func(ctx context.Context, worktreePath string, branch string) error) *MockGitRepository_SwitchBranch_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateBranchName provides a mock function for the type MockGitRepository
 func (_mock *MockGitRepository) ValidateBranchName(name string) error {
 	ret := _mock.Called(name)
@@ -5,6 +5,7 @@
 package mocks
 
 import (
+	"github.com/renato0307/rocha/internal/domain"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -94,3 +95,116 @@ func (_c *MockProcessInspector_GetClaudeSettings_Call) RunAndReturn(run func(ses
 	_c.Call.Return(run)
 	return _c
 }
+
+// GetResourceUsage provides a mock function for the type MockProcessInspector
+func (_mock *MockProcessInspector) GetResourceUsage(sessionNames []string) (map[string]*domain.ResourceUsage, error) {
+	ret := _mock.Called(sessionNames)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResourceUsage")
+	}
+
+	var r0 map[string]*domain.ResourceUsage
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func([]string) (map[string]*domain.ResourceUsage, error)); ok {
+		return returnFunc(sessionNames)
+	}
+	if returnFunc, ok := ret.Get(0).(func([]string) map[string]*domain.ResourceUsage); ok {
+		r0 = returnFunc(sessionNames)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*domain.ResourceUsage)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = returnFunc(sessionNames)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockProcessInspector_GetResourceUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResourceUsage'
+type MockProcessInspector_GetResourceUsage_Call struct {
+	*mock.Call
+}
+
+// GetResourceUsage is a helper method to define mock.On call
+//   - sessionNames []string
+func (_e *MockProcessInspector_Expecter) GetResourceUsage(sessionNames interface{}) *MockProcessInspector_GetResourceUsage_Call {
+	return &MockProcessInspector_GetResourceUsage_Call{Call: _e.mock.On("GetResourceUsage", sessionNames)}
+}
+
+func (_c *MockProcessInspector_GetResourceUsage_Call) Run(run func(sessionNames []string)) *MockProcessInspector_GetResourceUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 []string
+		if args[0] != nil {
+			arg0 = args[0].([]string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProcessInspector_GetResourceUsage_Call) Return(stringToResourceUsage map[string]*domain.ResourceUsage, err error) *MockProcessInspector_GetResourceUsage_Call {
+	_c.Call.Return(stringToResourceUsage, err)
+	return _c
+}
+
+func (_c *MockProcessInspector_GetResourceUsage_Call) RunAndReturn(run func(sessionNames []string) (map[string]*domain.ResourceUsage, error)) *MockProcessInspector_GetResourceUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// KillAgentProcess provides a mock function for the type MockProcessInspector
+func (_mock *MockProcessInspector) KillAgentProcess(sessionName string) error {
+	ret := _mock.Called(sessionName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for KillAgentProcess")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(sessionName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockProcessInspector_KillAgentProcess_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'KillAgentProcess'
+type MockProcessInspector_KillAgentProcess_Call struct {
+	*mock.Call
+}
+
+// KillAgentProcess is a helper method to define mock.On call
+//   - sessionName string
+func (_e *MockProcessInspector_Expecter) KillAgentProcess(sessionName interface{}) *MockProcessInspector_KillAgentProcess_Call {
+	return &MockProcessInspector_KillAgentProcess_Call{Call: _e.mock.On("KillAgentProcess", sessionName)}
+}
+
+func (_c *MockProcessInspector_KillAgentProcess_Call) Run(run func(sessionName string)) *MockProcessInspector_KillAgentProcess_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockProcessInspector_KillAgentProcess_Call) Return(err error) *MockProcessInspector_KillAgentProcess_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockProcessInspector_KillAgentProcess_Call) RunAndReturn(run func(sessionName string) error) *MockProcessInspector_KillAgentProcess_Call {
+	_c.Call.Return(run)
+	return _c
+}
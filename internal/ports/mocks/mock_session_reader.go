@@ -173,3 +173,65 @@ func (_c *MockSessionReader_List_Call) RunAndReturn(run func(ctx context.Context
 	_c.Call.Return(run)
 	return _c
 }
+
+// ListSummaries provides a mock function for the type MockSessionReader
+func (_mock *MockSessionReader) ListSummaries(ctx context.Context) ([]domain.SessionSummary, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSummaries")
+	}
+
+	var r0 []domain.SessionSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.SessionSummary, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.SessionSummary); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SessionSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionReader_ListSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSummaries'
+type MockSessionReader_ListSummaries_Call struct {
+	*mock.Call
+}
+
+// ListSummaries is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSessionReader_Expecter) ListSummaries(ctx interface{}) *MockSessionReader_ListSummaries_Call {
+	return &MockSessionReader_ListSummaries_Call{Call: _e.mock.On("ListSummaries", ctx)}
+}
+
+func (_c *MockSessionReader_ListSummaries_Call) Run(run func(ctx context.Context)) *MockSessionReader_ListSummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionReader_ListSummaries_Call) Return(sessionSummarys []domain.SessionSummary, err error) *MockSessionReader_ListSummaries_Call {
+	_c.Call.Return(sessionSummarys, err)
+	return _c
+}
+
+func (_c *MockSessionReader_ListSummaries_Call) RunAndReturn(run func(ctx context.Context) ([]domain.SessionSummary, error)) *MockSessionReader_ListSummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -6,6 +6,7 @@ package mocks
 
 import (
 	"context"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 	mock "github.com/stretchr/testify/mock"
@@ -395,6 +396,68 @@ func (_c *MockSessionRepository_List_Call) RunAndReturn(run func(ctx context.Con
 	return _c
 }
 
+// ListSummaries provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) ListSummaries(ctx context.Context) ([]domain.SessionSummary, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSummaries")
+	}
+
+	var r0 []domain.SessionSummary
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) ([]domain.SessionSummary, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) []domain.SessionSummary); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SessionSummary)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_ListSummaries_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSummaries'
+type MockSessionRepository_ListSummaries_Call struct {
+	*mock.Call
+}
+
+// ListSummaries is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockSessionRepository_Expecter) ListSummaries(ctx interface{}) *MockSessionRepository_ListSummaries_Call {
+	return &MockSessionRepository_ListSummaries_Call{Call: _e.mock.On("ListSummaries", ctx)}
+}
+
+func (_c *MockSessionRepository_ListSummaries_Call) Run(run func(ctx context.Context)) *MockSessionRepository_ListSummaries_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_ListSummaries_Call) Return(sessionSummarys []domain.SessionSummary, err error) *MockSessionRepository_ListSummaries_Call {
+	_c.Call.Return(sessionSummarys, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_ListSummaries_Call) RunAndReturn(run func(ctx context.Context) ([]domain.SessionSummary, error)) *MockSessionRepository_ListSummaries_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadState provides a mock function for the type MockSessionRepository
 func (_mock *MockSessionRepository) LoadState(ctx context.Context, includeArchived bool) (*domain.SessionCollection, error) {
 	ret := _mock.Called(ctx, includeArchived)
@@ -463,38 +526,46 @@ func (_c *MockSessionRepository_LoadState_Call) RunAndReturn(run func(ctx contex
 	return _c
 }
 
-// Rename provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) Rename(ctx context.Context, oldName string, newName string, newDisplayName string) error {
-	ret := _mock.Called(ctx, oldName, newName, newDisplayName)
+// MarkCINotified provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) MarkCINotified(ctx context.Context, name string, reportedAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, name, reportedAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Rename")
+		panic("no return value specified for MarkCINotified")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = returnFunc(ctx, oldName, newName, newDisplayName)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, name, reportedAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, name, reportedAt)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, name, reportedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSessionRepository_Rename_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rename'
-type MockSessionRepository_Rename_Call struct {
+// MockSessionRepository_MarkCINotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCINotified'
+type MockSessionRepository_MarkCINotified_Call struct {
 	*mock.Call
 }
 
-// Rename is a helper method to define mock.On call
+// MarkCINotified is a helper method to define mock.On call
 //   - ctx context.Context
-//   - oldName string
-//   - newName string
-//   - newDisplayName string
-func (_e *MockSessionRepository_Expecter) Rename(ctx interface{}, oldName interface{}, newName interface{}, newDisplayName interface{}) *MockSessionRepository_Rename_Call {
-	return &MockSessionRepository_Rename_Call{Call: _e.mock.On("Rename", ctx, oldName, newName, newDisplayName)}
+//   - name string
+//   - reportedAt time.Time
+func (_e *MockSessionRepository_Expecter) MarkCINotified(ctx interface{}, name interface{}, reportedAt interface{}) *MockSessionRepository_MarkCINotified_Call {
+	return &MockSessionRepository_MarkCINotified_Call{Call: _e.mock.On("MarkCINotified", ctx, name, reportedAt)}
 }
 
-func (_c *MockSessionRepository_Rename_Call) Run(run func(ctx context.Context, oldName string, newName string, newDisplayName string)) *MockSessionRepository_Rename_Call {
+func (_c *MockSessionRepository_MarkCINotified_Call) Run(run func(ctx context.Context, name string, reportedAt time.Time)) *MockSessionRepository_MarkCINotified_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -504,122 +575,132 @@ func (_c *MockSessionRepository_Rename_Call) Run(run func(ctx context.Context, o
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(string)
-		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSessionRepository_Rename_Call) Return(err error) *MockSessionRepository_Rename_Call {
-	_c.Call.Return(err)
+func (_c *MockSessionRepository_MarkCINotified_Call) Return(b bool, err error) *MockSessionRepository_MarkCINotified_Call {
+	_c.Call.Return(b, err)
 	return _c
 }
 
-func (_c *MockSessionRepository_Rename_Call) RunAndReturn(run func(ctx context.Context, oldName string, newName string, newDisplayName string) error) *MockSessionRepository_Rename_Call {
+func (_c *MockSessionRepository_MarkCINotified_Call) RunAndReturn(run func(ctx context.Context, name string, reportedAt time.Time) (bool, error)) *MockSessionRepository_MarkCINotified_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SaveState provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) SaveState(ctx context.Context, state *domain.SessionCollection) error {
-	ret := _mock.Called(ctx, state)
+// MarkCheckpoint provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) MarkCheckpoint(ctx context.Context, name string, at time.Time) error {
+	ret := _mock.Called(ctx, name, at)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SaveState")
+		panic("no return value specified for MarkCheckpoint")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SessionCollection) error); ok {
-		r0 = returnFunc(ctx, state)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = returnFunc(ctx, name, at)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_SaveState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveState'
-type MockSessionRepository_SaveState_Call struct {
+// MockSessionRepository_MarkCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkCheckpoint'
+type MockSessionRepository_MarkCheckpoint_Call struct {
 	*mock.Call
 }
 
-// SaveState is a helper method to define mock.On call
+// MarkCheckpoint is a helper method to define mock.On call
 //   - ctx context.Context
-//   - state *domain.SessionCollection
-func (_e *MockSessionRepository_Expecter) SaveState(ctx interface{}, state interface{}) *MockSessionRepository_SaveState_Call {
-	return &MockSessionRepository_SaveState_Call{Call: _e.mock.On("SaveState", ctx, state)}
+//   - name string
+//   - at time.Time
+func (_e *MockSessionRepository_Expecter) MarkCheckpoint(ctx interface{}, name interface{}, at interface{}) *MockSessionRepository_MarkCheckpoint_Call {
+	return &MockSessionRepository_MarkCheckpoint_Call{Call: _e.mock.On("MarkCheckpoint", ctx, name, at)}
 }
 
-func (_c *MockSessionRepository_SaveState_Call) Run(run func(ctx context.Context, state *domain.SessionCollection)) *MockSessionRepository_SaveState_Call {
+func (_c *MockSessionRepository_MarkCheckpoint_Call) Run(run func(ctx context.Context, name string, at time.Time)) *MockSessionRepository_MarkCheckpoint_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *domain.SessionCollection
+		var arg1 string
 		if args[1] != nil {
-			arg1 = args[1].(*domain.SessionCollection)
+			arg1 = args[1].(string)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSessionRepository_SaveState_Call) Return(err error) *MockSessionRepository_SaveState_Call {
+func (_c *MockSessionRepository_MarkCheckpoint_Call) Return(err error) *MockSessionRepository_MarkCheckpoint_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_SaveState_Call) RunAndReturn(run func(ctx context.Context, state *domain.SessionCollection) error) *MockSessionRepository_SaveState_Call {
+func (_c *MockSessionRepository_MarkCheckpoint_Call) RunAndReturn(run func(ctx context.Context, name string, at time.Time) error) *MockSessionRepository_MarkCheckpoint_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SwapPositions provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) SwapPositions(ctx context.Context, name1 string, name2 string) error {
-	ret := _mock.Called(ctx, name1, name2)
+// MarkTimerNotified provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) MarkTimerNotified(ctx context.Context, name string, dueAt time.Time) (bool, error) {
+	ret := _mock.Called(ctx, name, dueAt)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SwapPositions")
+		panic("no return value specified for MarkTimerNotified")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, name1, name2)
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) (bool, error)); ok {
+		return returnFunc(ctx, name, dueAt)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, time.Time) bool); ok {
+		r0 = returnFunc(ctx, name, dueAt)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(bool)
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = returnFunc(ctx, name, dueAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockSessionRepository_SwapPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwapPositions'
-type MockSessionRepository_SwapPositions_Call struct {
+// MockSessionRepository_MarkTimerNotified_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkTimerNotified'
+type MockSessionRepository_MarkTimerNotified_Call struct {
 	*mock.Call
 }
 
-// SwapPositions is a helper method to define mock.On call
+// MarkTimerNotified is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name1 string
-//   - name2 string
-func (_e *MockSessionRepository_Expecter) SwapPositions(ctx interface{}, name1 interface{}, name2 interface{}) *MockSessionRepository_SwapPositions_Call {
-	return &MockSessionRepository_SwapPositions_Call{Call: _e.mock.On("SwapPositions", ctx, name1, name2)}
+//   - name string
+//   - dueAt time.Time
+func (_e *MockSessionRepository_Expecter) MarkTimerNotified(ctx interface{}, name interface{}, dueAt interface{}) *MockSessionRepository_MarkTimerNotified_Call {
+	return &MockSessionRepository_MarkTimerNotified_Call{Call: _e.mock.On("MarkTimerNotified", ctx, name, dueAt)}
 }
 
-func (_c *MockSessionRepository_SwapPositions_Call) Run(run func(ctx context.Context, name1 string, name2 string)) *MockSessionRepository_SwapPositions_Call {
+func (_c *MockSessionRepository_MarkTimerNotified_Call) Run(run func(ctx context.Context, name string, dueAt time.Time)) *MockSessionRepository_MarkTimerNotified_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -629,9 +710,9 @@ func (_c *MockSessionRepository_SwapPositions_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 time.Time
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(time.Time)
 		}
 		run(
 			arg0,
@@ -642,46 +723,1349 @@ func (_c *MockSessionRepository_SwapPositions_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockSessionRepository_SwapPositions_Call) Return(err error) *MockSessionRepository_SwapPositions_Call {
+func (_c *MockSessionRepository_MarkTimerNotified_Call) Return(b bool, err error) *MockSessionRepository_MarkTimerNotified_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_MarkTimerNotified_Call) RunAndReturn(run func(ctx context.Context, name string, dueAt time.Time) (bool, error)) *MockSessionRepository_MarkTimerNotified_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkTokenBudgetExceeded provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) MarkTokenBudgetExceeded(ctx context.Context, name string, limit int) (bool, error) {
+	ret := _mock.Called(ctx, name, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkTokenBudgetExceeded")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) (bool, error)); ok {
+		return returnFunc(ctx, name, limit)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) bool); ok {
+		r0 = returnFunc(ctx, name, limit)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = returnFunc(ctx, name, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockSessionRepository_MarkTokenBudgetExceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkTokenBudgetExceeded'
+type MockSessionRepository_MarkTokenBudgetExceeded_Call struct {
+	*mock.Call
+}
+
+// MarkTokenBudgetExceeded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - limit int
+func (_e *MockSessionRepository_Expecter) MarkTokenBudgetExceeded(ctx interface{}, name interface{}, limit interface{}) *MockSessionRepository_MarkTokenBudgetExceeded_Call {
+	return &MockSessionRepository_MarkTokenBudgetExceeded_Call{Call: _e.mock.On("MarkTokenBudgetExceeded", ctx, name, limit)}
+}
+
+func (_c *MockSessionRepository_MarkTokenBudgetExceeded_Call) Run(run func(ctx context.Context, name string, limit int)) *MockSessionRepository_MarkTokenBudgetExceeded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 int
+		if args[2] != nil {
+			arg2 = args[2].(int)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_MarkTokenBudgetExceeded_Call) Return(b bool, err error) *MockSessionRepository_MarkTokenBudgetExceeded_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockSessionRepository_MarkTokenBudgetExceeded_Call) RunAndReturn(run func(ctx context.Context, name string, limit int) (bool, error)) *MockSessionRepository_MarkTokenBudgetExceeded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Rename provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) Rename(ctx context.Context, rename domain.SessionRename) error {
+	ret := _mock.Called(ctx, rename)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Rename")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, domain.SessionRename) error); ok {
+		r0 = returnFunc(ctx, rename)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_Rename_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Rename'
+type MockSessionRepository_Rename_Call struct {
+	*mock.Call
+}
+
+// Rename is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rename domain.SessionRename
+func (_e *MockSessionRepository_Expecter) Rename(ctx interface{}, rename interface{}) *MockSessionRepository_Rename_Call {
+	return &MockSessionRepository_Rename_Call{Call: _e.mock.On("Rename", ctx, rename)}
+}
+
+func (_c *MockSessionRepository_Rename_Call) Run(run func(ctx context.Context, rename domain.SessionRename)) *MockSessionRepository_Rename_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 domain.SessionRename
+		if args[1] != nil {
+			arg1 = args[1].(domain.SessionRename)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_Rename_Call) Return(err error) *MockSessionRepository_Rename_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_SwapPositions_Call) RunAndReturn(run func(ctx context.Context, name1 string, name2 string) error) *MockSessionRepository_SwapPositions_Call {
+func (_c *MockSessionRepository_Rename_Call) RunAndReturn(run func(ctx context.Context, rename domain.SessionRename) error) *MockSessionRepository_Rename_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ToggleArchive provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) ToggleArchive(ctx context.Context, name string) error {
-	ret := _mock.Called(ctx, name)
+// SaveState provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) SaveState(ctx context.Context, state *domain.SessionCollection) error {
+	ret := _mock.Called(ctx, state)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ToggleArchive")
+		panic("no return value specified for SaveState")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *domain.SessionCollection) error); ok {
+		r0 = returnFunc(ctx, state)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_ToggleArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleArchive'
-type MockSessionRepository_ToggleArchive_Call struct {
+// MockSessionRepository_SaveState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveState'
+type MockSessionRepository_SaveState_Call struct {
 	*mock.Call
 }
 
-// ToggleArchive is a helper method to define mock.On call
+// SaveState is a helper method to define mock.On call
 //   - ctx context.Context
-//   - name string
-func (_e *MockSessionRepository_Expecter) ToggleArchive(ctx interface{}, name interface{}) *MockSessionRepository_ToggleArchive_Call {
-	return &MockSessionRepository_ToggleArchive_Call{Call: _e.mock.On("ToggleArchive", ctx, name)}
+//   - state *domain.SessionCollection
+func (_e *MockSessionRepository_Expecter) SaveState(ctx interface{}, state interface{}) *MockSessionRepository_SaveState_Call {
+	return &MockSessionRepository_SaveState_Call{Call: _e.mock.On("SaveState", ctx, state)}
 }
 
-func (_c *MockSessionRepository_ToggleArchive_Call) Run(run func(ctx context.Context, name string)) *MockSessionRepository_ToggleArchive_Call {
+func (_c *MockSessionRepository_SaveState_Call) Run(run func(ctx context.Context, state *domain.SessionCollection)) *MockSessionRepository_SaveState_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *domain.SessionCollection
+		if args[1] != nil {
+			arg1 = args[1].(*domain.SessionCollection)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_SaveState_Call) Return(err error) *MockSessionRepository_SaveState_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_SaveState_Call) RunAndReturn(run func(ctx context.Context, state *domain.SessionCollection) error) *MockSessionRepository_SaveState_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetFlag provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) SetFlag(ctx context.Context, name string, flagged bool) error {
+	ret := _mock.Called(ctx, name, flagged)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetFlag")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, name, flagged)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_SetFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetFlag'
+type MockSessionRepository_SetFlag_Call struct {
+	*mock.Call
+}
+
+// SetFlag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - flagged bool
+func (_e *MockSessionRepository_Expecter) SetFlag(ctx interface{}, name interface{}, flagged interface{}) *MockSessionRepository_SetFlag_Call {
+	return &MockSessionRepository_SetFlag_Call{Call: _e.mock.On("SetFlag", ctx, name, flagged)}
+}
+
+func (_c *MockSessionRepository_SetFlag_Call) Run(run func(ctx context.Context, name string, flagged bool)) *MockSessionRepository_SetFlag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_SetFlag_Call) Return(err error) *MockSessionRepository_SetFlag_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_SetFlag_Call) RunAndReturn(run func(ctx context.Context, name string, flagged bool) error) *MockSessionRepository_SetFlag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SwapPositions provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) SwapPositions(ctx context.Context, name1 string, name2 string) error {
+	ret := _mock.Called(ctx, name1, name2)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SwapPositions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name1, name2)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_SwapPositions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SwapPositions'
+type MockSessionRepository_SwapPositions_Call struct {
+	*mock.Call
+}
+
+// SwapPositions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name1 string
+//   - name2 string
+func (_e *MockSessionRepository_Expecter) SwapPositions(ctx interface{}, name1 interface{}, name2 interface{}) *MockSessionRepository_SwapPositions_Call {
+	return &MockSessionRepository_SwapPositions_Call{Call: _e.mock.On("SwapPositions", ctx, name1, name2)}
+}
+
+func (_c *MockSessionRepository_SwapPositions_Call) Run(run func(ctx context.Context, name1 string, name2 string)) *MockSessionRepository_SwapPositions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_SwapPositions_Call) Return(err error) *MockSessionRepository_SwapPositions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_SwapPositions_Call) RunAndReturn(run func(ctx context.Context, name1 string, name2 string) error) *MockSessionRepository_SwapPositions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToggleArchive provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) ToggleArchive(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToggleArchive")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_ToggleArchive_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleArchive'
+type MockSessionRepository_ToggleArchive_Call struct {
+	*mock.Call
+}
+
+// ToggleArchive is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockSessionRepository_Expecter) ToggleArchive(ctx interface{}, name interface{}) *MockSessionRepository_ToggleArchive_Call {
+	return &MockSessionRepository_ToggleArchive_Call{Call: _e.mock.On("ToggleArchive", ctx, name)}
+}
+
+func (_c *MockSessionRepository_ToggleArchive_Call) Run(run func(ctx context.Context, name string)) *MockSessionRepository_ToggleArchive_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_ToggleArchive_Call) Return(err error) *MockSessionRepository_ToggleArchive_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_ToggleArchive_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockSessionRepository_ToggleArchive_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ToggleFlag provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) ToggleFlag(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ToggleFlag")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_ToggleFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleFlag'
+type MockSessionRepository_ToggleFlag_Call struct {
+	*mock.Call
+}
+
+// ToggleFlag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *MockSessionRepository_Expecter) ToggleFlag(ctx interface{}, name interface{}) *MockSessionRepository_ToggleFlag_Call {
+	return &MockSessionRepository_ToggleFlag_Call{Call: _e.mock.On("ToggleFlag", ctx, name)}
+}
+
+func (_c *MockSessionRepository_ToggleFlag_Call) Run(run func(ctx context.Context, name string)) *MockSessionRepository_ToggleFlag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_ToggleFlag_Call) Return(err error) *MockSessionRepository_ToggleFlag_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_ToggleFlag_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockSessionRepository_ToggleFlag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnlinkShellSession provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UnlinkShellSession(ctx context.Context, parentName string) error {
+	ret := _mock.Called(ctx, parentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkShellSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, parentName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UnlinkShellSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkShellSession'
+type MockSessionRepository_UnlinkShellSession_Call struct {
+	*mock.Call
+}
+
+// UnlinkShellSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentName string
+func (_e *MockSessionRepository_Expecter) UnlinkShellSession(ctx interface{}, parentName interface{}) *MockSessionRepository_UnlinkShellSession_Call {
+	return &MockSessionRepository_UnlinkShellSession_Call{Call: _e.mock.On("UnlinkShellSession", ctx, parentName)}
+}
+
+func (_c *MockSessionRepository_UnlinkShellSession_Call) Run(run func(ctx context.Context, parentName string)) *MockSessionRepository_UnlinkShellSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UnlinkShellSession_Call) Return(err error) *MockSessionRepository_UnlinkShellSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UnlinkShellSession_Call) RunAndReturn(run func(ctx context.Context, parentName string) error) *MockSessionRepository_UnlinkShellSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAgentArgs provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateAgentArgs(ctx context.Context, name string, args []string) error {
+	ret := _mock.Called(ctx, name, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentArgs")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, name, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateAgentArgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAgentArgs'
+type MockSessionRepository_UpdateAgentArgs_Call struct {
+	*mock.Call
+}
+
+// UpdateAgentArgs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - args []string
+func (_e *MockSessionRepository_Expecter) UpdateAgentArgs(ctx interface{}, name interface{}, args interface{}) *MockSessionRepository_UpdateAgentArgs_Call {
+	return &MockSessionRepository_UpdateAgentArgs_Call{Call: _e.mock.On("UpdateAgentArgs", ctx, name, args)}
+}
+
+func (_c *MockSessionRepository_UpdateAgentArgs_Call) Run(run func(ctx context.Context, name string, args []string)) *MockSessionRepository_UpdateAgentArgs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateAgentArgs_Call) Return(err error) *MockSessionRepository_UpdateAgentArgs_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateAgentArgs_Call) RunAndReturn(run func(ctx context.Context, name string, args []string) error) *MockSessionRepository_UpdateAgentArgs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAgentModel provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateAgentModel(ctx context.Context, name string, model string) error {
+	ret := _mock.Called(ctx, name, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentModel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateAgentModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAgentModel'
+type MockSessionRepository_UpdateAgentModel_Call struct {
+	*mock.Call
+}
+
+// UpdateAgentModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - model string
+func (_e *MockSessionRepository_Expecter) UpdateAgentModel(ctx interface{}, name interface{}, model interface{}) *MockSessionRepository_UpdateAgentModel_Call {
+	return &MockSessionRepository_UpdateAgentModel_Call{Call: _e.mock.On("UpdateAgentModel", ctx, name, model)}
+}
+
+func (_c *MockSessionRepository_UpdateAgentModel_Call) Run(run func(ctx context.Context, name string, model string)) *MockSessionRepository_UpdateAgentModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateAgentModel_Call) Return(err error) *MockSessionRepository_UpdateAgentModel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateAgentModel_Call) RunAndReturn(run func(ctx context.Context, name string, model string) error) *MockSessionRepository_UpdateAgentModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBranchName provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateBranchName(ctx context.Context, name string, branchName string) error {
+	ret := _mock.Called(ctx, name, branchName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBranchName")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, branchName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateBranchName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBranchName'
+type MockSessionRepository_UpdateBranchName_Call struct {
+	*mock.Call
+}
+
+// UpdateBranchName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - branchName string
+func (_e *MockSessionRepository_Expecter) UpdateBranchName(ctx interface{}, name interface{}, branchName interface{}) *MockSessionRepository_UpdateBranchName_Call {
+	return &MockSessionRepository_UpdateBranchName_Call{Call: _e.mock.On("UpdateBranchName", ctx, name, branchName)}
+}
+
+func (_c *MockSessionRepository_UpdateBranchName_Call) Run(run func(ctx context.Context, name string, branchName string)) *MockSessionRepository_UpdateBranchName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateBranchName_Call) Return(err error) *MockSessionRepository_UpdateBranchName_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateBranchName_Call) RunAndReturn(run func(ctx context.Context, name string, branchName string) error) *MockSessionRepository_UpdateBranchName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCIStatus provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateCIStatus(ctx context.Context, name string, status *domain.CIStatus) error {
+	ret := _mock.Called(ctx, name, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCIStatus")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.CIStatus) error); ok {
+		r0 = returnFunc(ctx, name, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateCIStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCIStatus'
+type MockSessionRepository_UpdateCIStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateCIStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - status *domain.CIStatus
+func (_e *MockSessionRepository_Expecter) UpdateCIStatus(ctx interface{}, name interface{}, status interface{}) *MockSessionRepository_UpdateCIStatus_Call {
+	return &MockSessionRepository_UpdateCIStatus_Call{Call: _e.mock.On("UpdateCIStatus", ctx, name, status)}
+}
+
+func (_c *MockSessionRepository_UpdateCIStatus_Call) Run(run func(ctx context.Context, name string, status *domain.CIStatus)) *MockSessionRepository_UpdateCIStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *domain.CIStatus
+		if args[2] != nil {
+			arg2 = args[2].(*domain.CIStatus)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateCIStatus_Call) Return(err error) *MockSessionRepository_UpdateCIStatus_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateCIStatus_Call) RunAndReturn(run func(ctx context.Context, name string, status *domain.CIStatus) error) *MockSessionRepository_UpdateCIStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCheckpoint provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateCheckpoint(ctx context.Context, name string, policy *domain.CheckpointPolicy) error {
+	ret := _mock.Called(ctx, name, policy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCheckpoint")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.CheckpointPolicy) error); ok {
+		r0 = returnFunc(ctx, name, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateCheckpoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCheckpoint'
+type MockSessionRepository_UpdateCheckpoint_Call struct {
+	*mock.Call
+}
+
+// UpdateCheckpoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - policy *domain.CheckpointPolicy
+func (_e *MockSessionRepository_Expecter) UpdateCheckpoint(ctx interface{}, name interface{}, policy interface{}) *MockSessionRepository_UpdateCheckpoint_Call {
+	return &MockSessionRepository_UpdateCheckpoint_Call{Call: _e.mock.On("UpdateCheckpoint", ctx, name, policy)}
+}
+
+func (_c *MockSessionRepository_UpdateCheckpoint_Call) Run(run func(ctx context.Context, name string, policy *domain.CheckpointPolicy)) *MockSessionRepository_UpdateCheckpoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 *domain.CheckpointPolicy
+		if args[2] != nil {
+			arg2 = args[2].(*domain.CheckpointPolicy)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateCheckpoint_Call) Return(err error) *MockSessionRepository_UpdateCheckpoint_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateCheckpoint_Call) RunAndReturn(run func(ctx context.Context, name string, policy *domain.CheckpointPolicy) error) *MockSessionRepository_UpdateCheckpoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateClaudeDir provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateClaudeDir(ctx context.Context, name string, claudeDir string) error {
+	ret := _mock.Called(ctx, name, claudeDir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClaudeDir")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, claudeDir)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateClaudeDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaudeDir'
+type MockSessionRepository_UpdateClaudeDir_Call struct {
+	*mock.Call
+}
+
+// UpdateClaudeDir is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - claudeDir string
+func (_e *MockSessionRepository_Expecter) UpdateClaudeDir(ctx interface{}, name interface{}, claudeDir interface{}) *MockSessionRepository_UpdateClaudeDir_Call {
+	return &MockSessionRepository_UpdateClaudeDir_Call{Call: _e.mock.On("UpdateClaudeDir", ctx, name, claudeDir)}
+}
+
+func (_c *MockSessionRepository_UpdateClaudeDir_Call) Run(run func(ctx context.Context, name string, claudeDir string)) *MockSessionRepository_UpdateClaudeDir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateClaudeDir_Call) Return(err error) *MockSessionRepository_UpdateClaudeDir_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateClaudeDir_Call) RunAndReturn(run func(ctx context.Context, name string, claudeDir string) error) *MockSessionRepository_UpdateClaudeDir_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateClaudeSessionID provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateClaudeSessionID(ctx context.Context, name string, claudeSessionID string) error {
+	ret := _mock.Called(ctx, name, claudeSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClaudeSessionID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, claudeSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateClaudeSessionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaudeSessionID'
+type MockSessionRepository_UpdateClaudeSessionID_Call struct {
+	*mock.Call
+}
+
+// UpdateClaudeSessionID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - claudeSessionID string
+func (_e *MockSessionRepository_Expecter) UpdateClaudeSessionID(ctx interface{}, name interface{}, claudeSessionID interface{}) *MockSessionRepository_UpdateClaudeSessionID_Call {
+	return &MockSessionRepository_UpdateClaudeSessionID_Call{Call: _e.mock.On("UpdateClaudeSessionID", ctx, name, claudeSessionID)}
+}
+
+func (_c *MockSessionRepository_UpdateClaudeSessionID_Call) Run(run func(ctx context.Context, name string, claudeSessionID string)) *MockSessionRepository_UpdateClaudeSessionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateClaudeSessionID_Call) Return(err error) *MockSessionRepository_UpdateClaudeSessionID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateClaudeSessionID_Call) RunAndReturn(run func(ctx context.Context, name string, claudeSessionID string) error) *MockSessionRepository_UpdateClaudeSessionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateComment provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateComment(ctx context.Context, name string, comment string) error {
+	ret := _mock.Called(ctx, name, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateComment")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
+type MockSessionRepository_UpdateComment_Call struct {
+	*mock.Call
+}
+
+// UpdateComment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - comment string
+func (_e *MockSessionRepository_Expecter) UpdateComment(ctx interface{}, name interface{}, comment interface{}) *MockSessionRepository_UpdateComment_Call {
+	return &MockSessionRepository_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, name, comment)}
+}
+
+func (_c *MockSessionRepository_UpdateComment_Call) Run(run func(ctx context.Context, name string, comment string)) *MockSessionRepository_UpdateComment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateComment_Call) Return(err error) *MockSessionRepository_UpdateComment_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateComment_Call) RunAndReturn(run func(ctx context.Context, name string, comment string) error) *MockSessionRepository_UpdateComment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateDisplayName provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateDisplayName(ctx context.Context, name string, displayName string) error {
+	ret := _mock.Called(ctx, name, displayName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateDisplayName")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, displayName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateDisplayName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisplayName'
+type MockSessionRepository_UpdateDisplayName_Call struct {
+	*mock.Call
+}
+
+// UpdateDisplayName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - displayName string
+func (_e *MockSessionRepository_Expecter) UpdateDisplayName(ctx interface{}, name interface{}, displayName interface{}) *MockSessionRepository_UpdateDisplayName_Call {
+	return &MockSessionRepository_UpdateDisplayName_Call{Call: _e.mock.On("UpdateDisplayName", ctx, name, displayName)}
+}
+
+func (_c *MockSessionRepository_UpdateDisplayName_Call) Run(run func(ctx context.Context, name string, displayName string)) *MockSessionRepository_UpdateDisplayName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateDisplayName_Call) Return(err error) *MockSessionRepository_UpdateDisplayName_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateDisplayName_Call) RunAndReturn(run func(ctx context.Context, name string, displayName string) error) *MockSessionRepository_UpdateDisplayName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEditor provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateEditor(ctx context.Context, name string, editor domain.EditorIntegration) error {
+	ret := _mock.Called(ctx, name, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEditor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.EditorIntegration) error); ok {
+		r0 = returnFunc(ctx, name, editor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateEditor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEditor'
+type MockSessionRepository_UpdateEditor_Call struct {
+	*mock.Call
+}
+
+// UpdateEditor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - editor domain.EditorIntegration
+func (_e *MockSessionRepository_Expecter) UpdateEditor(ctx interface{}, name interface{}, editor interface{}) *MockSessionRepository_UpdateEditor_Call {
+	return &MockSessionRepository_UpdateEditor_Call{Call: _e.mock.On("UpdateEditor", ctx, name, editor)}
+}
+
+func (_c *MockSessionRepository_UpdateEditor_Call) Run(run func(ctx context.Context, name string, editor domain.EditorIntegration)) *MockSessionRepository_UpdateEditor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.EditorIntegration
+		if args[2] != nil {
+			arg2 = args[2].(domain.EditorIntegration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateEditor_Call) Return(err error) *MockSessionRepository_UpdateEditor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateEditor_Call) RunAndReturn(run func(ctx context.Context, name string, editor domain.EditorIntegration) error) *MockSessionRepository_UpdateEditor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateExecutionID provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateExecutionID(ctx context.Context, name string, executionID string) error {
+	ret := _mock.Called(ctx, name, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateExecutionID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, executionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateExecutionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExecutionID'
+type MockSessionRepository_UpdateExecutionID_Call struct {
+	*mock.Call
+}
+
+// UpdateExecutionID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - executionID string
+func (_e *MockSessionRepository_Expecter) UpdateExecutionID(ctx interface{}, name interface{}, executionID interface{}) *MockSessionRepository_UpdateExecutionID_Call {
+	return &MockSessionRepository_UpdateExecutionID_Call{Call: _e.mock.On("UpdateExecutionID", ctx, name, executionID)}
+}
+
+func (_c *MockSessionRepository_UpdateExecutionID_Call) Run(run func(ctx context.Context, name string, executionID string)) *MockSessionRepository_UpdateExecutionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateExecutionID_Call) Return(err error) *MockSessionRepository_UpdateExecutionID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateExecutionID_Call) RunAndReturn(run func(ctx context.Context, name string, executionID string) error) *MockSessionRepository_UpdateExecutionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateKeepWorktree provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateKeepWorktree(ctx context.Context, name string, keep bool) error {
+	ret := _mock.Called(ctx, name, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKeepWorktree")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, name, keep)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateKeepWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateKeepWorktree'
+type MockSessionRepository_UpdateKeepWorktree_Call struct {
+	*mock.Call
+}
+
+// UpdateKeepWorktree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - keep bool
+func (_e *MockSessionRepository_Expecter) UpdateKeepWorktree(ctx interface{}, name interface{}, keep interface{}) *MockSessionRepository_UpdateKeepWorktree_Call {
+	return &MockSessionRepository_UpdateKeepWorktree_Call{Call: _e.mock.On("UpdateKeepWorktree", ctx, name, keep)}
+}
+
+func (_c *MockSessionRepository_UpdateKeepWorktree_Call) Run(run func(ctx context.Context, name string, keep bool)) *MockSessionRepository_UpdateKeepWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateKeepWorktree_Call) Return(err error) *MockSessionRepository_UpdateKeepWorktree_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateKeepWorktree_Call) RunAndReturn(run func(ctx context.Context, name string, keep bool) error) *MockSessionRepository_UpdateKeepWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNote provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateNote(ctx context.Context, name string, note string) error {
+	ret := _mock.Called(ctx, name, note)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNote")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, note)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdateNote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNote'
+type MockSessionRepository_UpdateNote_Call struct {
+	*mock.Call
+}
+
+// UpdateNote is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - note string
+func (_e *MockSessionRepository_Expecter) UpdateNote(ctx interface{}, name interface{}, note interface{}) *MockSessionRepository_UpdateNote_Call {
+	return &MockSessionRepository_UpdateNote_Call{Call: _e.mock.On("UpdateNote", ctx, name, note)}
+}
+
+func (_c *MockSessionRepository_UpdateNote_Call) Run(run func(ctx context.Context, name string, note string)) *MockSessionRepository_UpdateNote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateNote_Call) Return(err error) *MockSessionRepository_UpdateNote_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionRepository_UpdateNote_Call) RunAndReturn(run func(ctx context.Context, name string, note string) error) *MockSessionRepository_UpdateNote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePRInfo provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdatePRInfo(ctx context.Context, name string, prInfo *domain.PRInfo) error {
+	ret := _mock.Called(ctx, name, prInfo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePRInfo")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.PRInfo) error); ok {
+		r0 = returnFunc(ctx, name, prInfo)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionRepository_UpdatePRInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePRInfo'
+type MockSessionRepository_UpdatePRInfo_Call struct {
+	*mock.Call
+}
+
+// UpdatePRInfo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - prInfo *domain.PRInfo
+func (_e *MockSessionRepository_Expecter) UpdatePRInfo(ctx interface{}, name interface{}, prInfo interface{}) *MockSessionRepository_UpdatePRInfo_Call {
+	return &MockSessionRepository_UpdatePRInfo_Call{Call: _e.mock.On("UpdatePRInfo", ctx, name, prInfo)}
+}
+
+func (_c *MockSessionRepository_UpdatePRInfo_Call) Run(run func(ctx context.Context, name string, prInfo *domain.PRInfo)) *MockSessionRepository_UpdatePRInfo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -691,54 +2075,60 @@ func (_c *MockSessionRepository_ToggleArchive_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 *domain.PRInfo
+		if args[2] != nil {
+			arg2 = args[2].(*domain.PRInfo)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSessionRepository_ToggleArchive_Call) Return(err error) *MockSessionRepository_ToggleArchive_Call {
+func (_c *MockSessionRepository_UpdatePRInfo_Call) Return(err error) *MockSessionRepository_UpdatePRInfo_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_ToggleArchive_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockSessionRepository_ToggleArchive_Call {
+func (_c *MockSessionRepository_UpdatePRInfo_Call) RunAndReturn(run func(ctx context.Context, name string, prInfo *domain.PRInfo) error) *MockSessionRepository_UpdatePRInfo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ToggleFlag provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) ToggleFlag(ctx context.Context, name string) error {
-	ret := _mock.Called(ctx, name)
+// UpdatePriority provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdatePriority(ctx context.Context, name string, priority domain.Priority) error {
+	ret := _mock.Called(ctx, name, priority)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ToggleFlag")
+		panic("no return value specified for UpdatePriority")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = returnFunc(ctx, name)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.Priority) error); ok {
+		r0 = returnFunc(ctx, name, priority)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_ToggleFlag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ToggleFlag'
-type MockSessionRepository_ToggleFlag_Call struct {
+// MockSessionRepository_UpdatePriority_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePriority'
+type MockSessionRepository_UpdatePriority_Call struct {
 	*mock.Call
 }
 
-// ToggleFlag is a helper method to define mock.On call
+// UpdatePriority is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-func (_e *MockSessionRepository_Expecter) ToggleFlag(ctx interface{}, name interface{}) *MockSessionRepository_ToggleFlag_Call {
-	return &MockSessionRepository_ToggleFlag_Call{Call: _e.mock.On("ToggleFlag", ctx, name)}
+//   - priority domain.Priority
+func (_e *MockSessionRepository_Expecter) UpdatePriority(ctx interface{}, name interface{}, priority interface{}) *MockSessionRepository_UpdatePriority_Call {
+	return &MockSessionRepository_UpdatePriority_Call{Call: _e.mock.On("UpdatePriority", ctx, name, priority)}
 }
 
-func (_c *MockSessionRepository_ToggleFlag_Call) Run(run func(ctx context.Context, name string)) *MockSessionRepository_ToggleFlag_Call {
+func (_c *MockSessionRepository_UpdatePriority_Call) Run(run func(ctx context.Context, name string, priority domain.Priority)) *MockSessionRepository_UpdatePriority_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -748,55 +2138,60 @@ func (_c *MockSessionRepository_ToggleFlag_Call) Run(run func(ctx context.Contex
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
+		var arg2 domain.Priority
+		if args[2] != nil {
+			arg2 = args[2].(domain.Priority)
+		}
 		run(
 			arg0,
 			arg1,
+			arg2,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSessionRepository_ToggleFlag_Call) Return(err error) *MockSessionRepository_ToggleFlag_Call {
+func (_c *MockSessionRepository_UpdatePriority_Call) Return(err error) *MockSessionRepository_UpdatePriority_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_ToggleFlag_Call) RunAndReturn(run func(ctx context.Context, name string) error) *MockSessionRepository_ToggleFlag_Call {
+func (_c *MockSessionRepository_UpdatePriority_Call) RunAndReturn(run func(ctx context.Context, name string, priority domain.Priority) error) *MockSessionRepository_UpdatePriority_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateClaudeDir provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateClaudeDir(ctx context.Context, name string, claudeDir string) error {
-	ret := _mock.Called(ctx, name, claudeDir)
+// UpdateRepoSource provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateRepoSource(ctx context.Context, name string, repoSource string) error {
+	ret := _mock.Called(ctx, name, repoSource)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateClaudeDir")
+		panic("no return value specified for UpdateRepoSource")
 	}
 
 	var r0 error
 	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, name, claudeDir)
+		r0 = returnFunc(ctx, name, repoSource)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateClaudeDir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaudeDir'
-type MockSessionRepository_UpdateClaudeDir_Call struct {
+// MockSessionRepository_UpdateRepoSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRepoSource'
+type MockSessionRepository_UpdateRepoSource_Call struct {
 	*mock.Call
 }
 
-// UpdateClaudeDir is a helper method to define mock.On call
+// UpdateRepoSource is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - claudeDir string
-func (_e *MockSessionRepository_Expecter) UpdateClaudeDir(ctx interface{}, name interface{}, claudeDir interface{}) *MockSessionRepository_UpdateClaudeDir_Call {
-	return &MockSessionRepository_UpdateClaudeDir_Call{Call: _e.mock.On("UpdateClaudeDir", ctx, name, claudeDir)}
+//   - repoSource string
+func (_e *MockSessionRepository_Expecter) UpdateRepoSource(ctx interface{}, name interface{}, repoSource interface{}) *MockSessionRepository_UpdateRepoSource_Call {
+	return &MockSessionRepository_UpdateRepoSource_Call{Call: _e.mock.On("UpdateRepoSource", ctx, name, repoSource)}
 }
 
-func (_c *MockSessionRepository_UpdateClaudeDir_Call) Run(run func(ctx context.Context, name string, claudeDir string)) *MockSessionRepository_UpdateClaudeDir_Call {
+func (_c *MockSessionRepository_UpdateRepoSource_Call) Run(run func(ctx context.Context, name string, repoSource string)) *MockSessionRepository_UpdateRepoSource_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -819,47 +2214,47 @@ func (_c *MockSessionRepository_UpdateClaudeDir_Call) Run(run func(ctx context.C
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateClaudeDir_Call) Return(err error) *MockSessionRepository_UpdateClaudeDir_Call {
+func (_c *MockSessionRepository_UpdateRepoSource_Call) Return(err error) *MockSessionRepository_UpdateRepoSource_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateClaudeDir_Call) RunAndReturn(run func(ctx context.Context, name string, claudeDir string) error) *MockSessionRepository_UpdateClaudeDir_Call {
+func (_c *MockSessionRepository_UpdateRepoSource_Call) RunAndReturn(run func(ctx context.Context, name string, repoSource string) error) *MockSessionRepository_UpdateRepoSource_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateComment provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateComment(ctx context.Context, name string, comment string) error {
-	ret := _mock.Called(ctx, name, comment)
+// UpdateSkipPermissions provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateSkipPermissions(ctx context.Context, name string, skip bool) error {
+	ret := _mock.Called(ctx, name, skip)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateComment")
+		panic("no return value specified for UpdateSkipPermissions")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, name, comment)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, name, skip)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateComment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateComment'
-type MockSessionRepository_UpdateComment_Call struct {
+// MockSessionRepository_UpdateSkipPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSkipPermissions'
+type MockSessionRepository_UpdateSkipPermissions_Call struct {
 	*mock.Call
 }
 
-// UpdateComment is a helper method to define mock.On call
+// UpdateSkipPermissions is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - comment string
-func (_e *MockSessionRepository_Expecter) UpdateComment(ctx interface{}, name interface{}, comment interface{}) *MockSessionRepository_UpdateComment_Call {
-	return &MockSessionRepository_UpdateComment_Call{Call: _e.mock.On("UpdateComment", ctx, name, comment)}
+//   - skip bool
+func (_e *MockSessionRepository_Expecter) UpdateSkipPermissions(ctx interface{}, name interface{}, skip interface{}) *MockSessionRepository_UpdateSkipPermissions_Call {
+	return &MockSessionRepository_UpdateSkipPermissions_Call{Call: _e.mock.On("UpdateSkipPermissions", ctx, name, skip)}
 }
 
-func (_c *MockSessionRepository_UpdateComment_Call) Run(run func(ctx context.Context, name string, comment string)) *MockSessionRepository_UpdateComment_Call {
+func (_c *MockSessionRepository_UpdateSkipPermissions_Call) Run(run func(ctx context.Context, name string, skip bool)) *MockSessionRepository_UpdateSkipPermissions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -869,9 +2264,9 @@ func (_c *MockSessionRepository_UpdateComment_Call) Run(run func(ctx context.Con
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 bool
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(bool)
 		}
 		run(
 			arg0,
@@ -882,47 +2277,48 @@ func (_c *MockSessionRepository_UpdateComment_Call) Run(run func(ctx context.Con
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateComment_Call) Return(err error) *MockSessionRepository_UpdateComment_Call {
+func (_c *MockSessionRepository_UpdateSkipPermissions_Call) Return(err error) *MockSessionRepository_UpdateSkipPermissions_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateComment_Call) RunAndReturn(run func(ctx context.Context, name string, comment string) error) *MockSessionRepository_UpdateComment_Call {
+func (_c *MockSessionRepository_UpdateSkipPermissions_Call) RunAndReturn(run func(ctx context.Context, name string, skip bool) error) *MockSessionRepository_UpdateSkipPermissions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateDisplayName provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateDisplayName(ctx context.Context, name string, displayName string) error {
-	ret := _mock.Called(ctx, name, displayName)
+// UpdateState provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateState(ctx context.Context, name string, state domain.SessionState, executionID string) error {
+	ret := _mock.Called(ctx, name, state, executionID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateDisplayName")
+		panic("no return value specified for UpdateState")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, name, displayName)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.SessionState, string) error); ok {
+		r0 = returnFunc(ctx, name, state, executionID)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateDisplayName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateDisplayName'
-type MockSessionRepository_UpdateDisplayName_Call struct {
+// MockSessionRepository_UpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateState'
+type MockSessionRepository_UpdateState_Call struct {
 	*mock.Call
 }
 
-// UpdateDisplayName is a helper method to define mock.On call
+// UpdateState is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - displayName string
-func (_e *MockSessionRepository_Expecter) UpdateDisplayName(ctx interface{}, name interface{}, displayName interface{}) *MockSessionRepository_UpdateDisplayName_Call {
-	return &MockSessionRepository_UpdateDisplayName_Call{Call: _e.mock.On("UpdateDisplayName", ctx, name, displayName)}
+//   - state domain.SessionState
+//   - executionID string
+func (_e *MockSessionRepository_Expecter) UpdateState(ctx interface{}, name interface{}, state interface{}, executionID interface{}) *MockSessionRepository_UpdateState_Call {
+	return &MockSessionRepository_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, name, state, executionID)}
 }
 
-func (_c *MockSessionRepository_UpdateDisplayName_Call) Run(run func(ctx context.Context, name string, displayName string)) *MockSessionRepository_UpdateDisplayName_Call {
+func (_c *MockSessionRepository_UpdateState_Call) Run(run func(ctx context.Context, name string, state domain.SessionState, executionID string)) *MockSessionRepository_UpdateState_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -932,60 +2328,65 @@ func (_c *MockSessionRepository_UpdateDisplayName_Call) Run(run func(ctx context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 domain.SessionState
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(domain.SessionState)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
+			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateDisplayName_Call) Return(err error) *MockSessionRepository_UpdateDisplayName_Call {
+func (_c *MockSessionRepository_UpdateState_Call) Return(err error) *MockSessionRepository_UpdateState_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateDisplayName_Call) RunAndReturn(run func(ctx context.Context, name string, displayName string) error) *MockSessionRepository_UpdateDisplayName_Call {
+func (_c *MockSessionRepository_UpdateState_Call) RunAndReturn(run func(ctx context.Context, name string, state domain.SessionState, executionID string) error) *MockSessionRepository_UpdateState_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateExecutionID provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateExecutionID(ctx context.Context, name string, executionID string) error {
-	ret := _mock.Called(ctx, name, executionID)
+// UpdateStatus provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateStatus(ctx context.Context, name string, status *string) error {
+	ret := _mock.Called(ctx, name, status)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateExecutionID")
+		panic("no return value specified for UpdateStatus")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, name, executionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) error); ok {
+		r0 = returnFunc(ctx, name, status)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateExecutionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateExecutionID'
-type MockSessionRepository_UpdateExecutionID_Call struct {
+// MockSessionRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type MockSessionRepository_UpdateStatus_Call struct {
 	*mock.Call
 }
 
-// UpdateExecutionID is a helper method to define mock.On call
+// UpdateStatus is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - executionID string
-func (_e *MockSessionRepository_Expecter) UpdateExecutionID(ctx interface{}, name interface{}, executionID interface{}) *MockSessionRepository_UpdateExecutionID_Call {
-	return &MockSessionRepository_UpdateExecutionID_Call{Call: _e.mock.On("UpdateExecutionID", ctx, name, executionID)}
+//   - status *string
+func (_e *MockSessionRepository_Expecter) UpdateStatus(ctx interface{}, name interface{}, status interface{}) *MockSessionRepository_UpdateStatus_Call {
+	return &MockSessionRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, name, status)}
 }
 
-func (_c *MockSessionRepository_UpdateExecutionID_Call) Run(run func(ctx context.Context, name string, executionID string)) *MockSessionRepository_UpdateExecutionID_Call {
+func (_c *MockSessionRepository_UpdateStatus_Call) Run(run func(ctx context.Context, name string, status *string)) *MockSessionRepository_UpdateStatus_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -995,9 +2396,9 @@ func (_c *MockSessionRepository_UpdateExecutionID_Call) Run(run func(ctx context
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 *string
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].(*string)
 		}
 		run(
 			arg0,
@@ -1008,47 +2409,47 @@ func (_c *MockSessionRepository_UpdateExecutionID_Call) Run(run func(ctx context
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateExecutionID_Call) Return(err error) *MockSessionRepository_UpdateExecutionID_Call {
+func (_c *MockSessionRepository_UpdateStatus_Call) Return(err error) *MockSessionRepository_UpdateStatus_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateExecutionID_Call) RunAndReturn(run func(ctx context.Context, name string, executionID string) error) *MockSessionRepository_UpdateExecutionID_Call {
+func (_c *MockSessionRepository_UpdateStatus_Call) RunAndReturn(run func(ctx context.Context, name string, status *string) error) *MockSessionRepository_UpdateStatus_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdatePRInfo provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdatePRInfo(ctx context.Context, name string, prInfo *domain.PRInfo) error {
-	ret := _mock.Called(ctx, name, prInfo)
+// UpdateSubdir provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateSubdir(ctx context.Context, name string, subdir string) error {
+	ret := _mock.Called(ctx, name, subdir)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdatePRInfo")
+		panic("no return value specified for UpdateSubdir")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.PRInfo) error); ok {
-		r0 = returnFunc(ctx, name, prInfo)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, subdir)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdatePRInfo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePRInfo'
-type MockSessionRepository_UpdatePRInfo_Call struct {
+// MockSessionRepository_UpdateSubdir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubdir'
+type MockSessionRepository_UpdateSubdir_Call struct {
 	*mock.Call
 }
 
-// UpdatePRInfo is a helper method to define mock.On call
+// UpdateSubdir is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - prInfo *domain.PRInfo
-func (_e *MockSessionRepository_Expecter) UpdatePRInfo(ctx interface{}, name interface{}, prInfo interface{}) *MockSessionRepository_UpdatePRInfo_Call {
-	return &MockSessionRepository_UpdatePRInfo_Call{Call: _e.mock.On("UpdatePRInfo", ctx, name, prInfo)}
+//   - subdir string
+func (_e *MockSessionRepository_Expecter) UpdateSubdir(ctx interface{}, name interface{}, subdir interface{}) *MockSessionRepository_UpdateSubdir_Call {
+	return &MockSessionRepository_UpdateSubdir_Call{Call: _e.mock.On("UpdateSubdir", ctx, name, subdir)}
 }
 
-func (_c *MockSessionRepository_UpdatePRInfo_Call) Run(run func(ctx context.Context, name string, prInfo *domain.PRInfo)) *MockSessionRepository_UpdatePRInfo_Call {
+func (_c *MockSessionRepository_UpdateSubdir_Call) Run(run func(ctx context.Context, name string, subdir string)) *MockSessionRepository_UpdateSubdir_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1058,9 +2459,9 @@ func (_c *MockSessionRepository_UpdatePRInfo_Call) Run(run func(ctx context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *domain.PRInfo
+		var arg2 string
 		if args[2] != nil {
-			arg2 = args[2].(*domain.PRInfo)
+			arg2 = args[2].(string)
 		}
 		run(
 			arg0,
@@ -1071,47 +2472,47 @@ func (_c *MockSessionRepository_UpdatePRInfo_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdatePRInfo_Call) Return(err error) *MockSessionRepository_UpdatePRInfo_Call {
+func (_c *MockSessionRepository_UpdateSubdir_Call) Return(err error) *MockSessionRepository_UpdateSubdir_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdatePRInfo_Call) RunAndReturn(run func(ctx context.Context, name string, prInfo *domain.PRInfo) error) *MockSessionRepository_UpdatePRInfo_Call {
+func (_c *MockSessionRepository_UpdateSubdir_Call) RunAndReturn(run func(ctx context.Context, name string, subdir string) error) *MockSessionRepository_UpdateSubdir_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateRepoSource provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateRepoSource(ctx context.Context, name string, repoSource string) error {
-	ret := _mock.Called(ctx, name, repoSource)
+// UpdateTags provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateTags(ctx context.Context, name string, tags []string) error {
+	ret := _mock.Called(ctx, name, tags)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateRepoSource")
+		panic("no return value specified for UpdateTags")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
-		r0 = returnFunc(ctx, name, repoSource)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, name, tags)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateRepoSource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateRepoSource'
-type MockSessionRepository_UpdateRepoSource_Call struct {
+// MockSessionRepository_UpdateTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTags'
+type MockSessionRepository_UpdateTags_Call struct {
 	*mock.Call
 }
 
-// UpdateRepoSource is a helper method to define mock.On call
+// UpdateTags is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - repoSource string
-func (_e *MockSessionRepository_Expecter) UpdateRepoSource(ctx interface{}, name interface{}, repoSource interface{}) *MockSessionRepository_UpdateRepoSource_Call {
-	return &MockSessionRepository_UpdateRepoSource_Call{Call: _e.mock.On("UpdateRepoSource", ctx, name, repoSource)}
+//   - tags []string
+func (_e *MockSessionRepository_Expecter) UpdateTags(ctx interface{}, name interface{}, tags interface{}) *MockSessionRepository_UpdateTags_Call {
+	return &MockSessionRepository_UpdateTags_Call{Call: _e.mock.On("UpdateTags", ctx, name, tags)}
 }
 
-func (_c *MockSessionRepository_UpdateRepoSource_Call) Run(run func(ctx context.Context, name string, repoSource string)) *MockSessionRepository_UpdateRepoSource_Call {
+func (_c *MockSessionRepository_UpdateTags_Call) Run(run func(ctx context.Context, name string, tags []string)) *MockSessionRepository_UpdateTags_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1121,9 +2522,9 @@ func (_c *MockSessionRepository_UpdateRepoSource_Call) Run(run func(ctx context.
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 string
+		var arg2 []string
 		if args[2] != nil {
-			arg2 = args[2].(string)
+			arg2 = args[2].([]string)
 		}
 		run(
 			arg0,
@@ -1134,47 +2535,47 @@ func (_c *MockSessionRepository_UpdateRepoSource_Call) Run(run func(ctx context.
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateRepoSource_Call) Return(err error) *MockSessionRepository_UpdateRepoSource_Call {
+func (_c *MockSessionRepository_UpdateTags_Call) Return(err error) *MockSessionRepository_UpdateTags_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateRepoSource_Call) RunAndReturn(run func(ctx context.Context, name string, repoSource string) error) *MockSessionRepository_UpdateRepoSource_Call {
+func (_c *MockSessionRepository_UpdateTags_Call) RunAndReturn(run func(ctx context.Context, name string, tags []string) error) *MockSessionRepository_UpdateTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateSkipPermissions provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateSkipPermissions(ctx context.Context, name string, skip bool) error {
-	ret := _mock.Called(ctx, name, skip)
+// UpdateTimer provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateTimer(ctx context.Context, name string, timer *domain.SessionTimer) error {
+	ret := _mock.Called(ctx, name, timer)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateSkipPermissions")
+		panic("no return value specified for UpdateTimer")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
-		r0 = returnFunc(ctx, name, skip)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.SessionTimer) error); ok {
+		r0 = returnFunc(ctx, name, timer)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateSkipPermissions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSkipPermissions'
-type MockSessionRepository_UpdateSkipPermissions_Call struct {
+// MockSessionRepository_UpdateTimer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTimer'
+type MockSessionRepository_UpdateTimer_Call struct {
 	*mock.Call
 }
 
-// UpdateSkipPermissions is a helper method to define mock.On call
+// UpdateTimer is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - skip bool
-func (_e *MockSessionRepository_Expecter) UpdateSkipPermissions(ctx interface{}, name interface{}, skip interface{}) *MockSessionRepository_UpdateSkipPermissions_Call {
-	return &MockSessionRepository_UpdateSkipPermissions_Call{Call: _e.mock.On("UpdateSkipPermissions", ctx, name, skip)}
+//   - timer *domain.SessionTimer
+func (_e *MockSessionRepository_Expecter) UpdateTimer(ctx interface{}, name interface{}, timer interface{}) *MockSessionRepository_UpdateTimer_Call {
+	return &MockSessionRepository_UpdateTimer_Call{Call: _e.mock.On("UpdateTimer", ctx, name, timer)}
 }
 
-func (_c *MockSessionRepository_UpdateSkipPermissions_Call) Run(run func(ctx context.Context, name string, skip bool)) *MockSessionRepository_UpdateSkipPermissions_Call {
+func (_c *MockSessionRepository_UpdateTimer_Call) Run(run func(ctx context.Context, name string, timer *domain.SessionTimer)) *MockSessionRepository_UpdateTimer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1184,9 +2585,9 @@ func (_c *MockSessionRepository_UpdateSkipPermissions_Call) Run(run func(ctx con
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 bool
+		var arg2 *domain.SessionTimer
 		if args[2] != nil {
-			arg2 = args[2].(bool)
+			arg2 = args[2].(*domain.SessionTimer)
 		}
 		run(
 			arg0,
@@ -1197,48 +2598,47 @@ func (_c *MockSessionRepository_UpdateSkipPermissions_Call) Run(run func(ctx con
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateSkipPermissions_Call) Return(err error) *MockSessionRepository_UpdateSkipPermissions_Call {
+func (_c *MockSessionRepository_UpdateTimer_Call) Return(err error) *MockSessionRepository_UpdateTimer_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateSkipPermissions_Call) RunAndReturn(run func(ctx context.Context, name string, skip bool) error) *MockSessionRepository_UpdateSkipPermissions_Call {
+func (_c *MockSessionRepository_UpdateTimer_Call) RunAndReturn(run func(ctx context.Context, name string, timer *domain.SessionTimer) error) *MockSessionRepository_UpdateTimer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateState provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateState(ctx context.Context, name string, state domain.SessionState, executionID string) error {
-	ret := _mock.Called(ctx, name, state, executionID)
+// UpdateTokenBudget provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateTokenBudget(ctx context.Context, name string, budget *domain.SessionTokenBudget) error {
+	ret := _mock.Called(ctx, name, budget)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateState")
+		panic("no return value specified for UpdateTokenBudget")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.SessionState, string) error); ok {
-		r0 = returnFunc(ctx, name, state, executionID)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *domain.SessionTokenBudget) error); ok {
+		r0 = returnFunc(ctx, name, budget)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateState_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateState'
-type MockSessionRepository_UpdateState_Call struct {
+// MockSessionRepository_UpdateTokenBudget_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTokenBudget'
+type MockSessionRepository_UpdateTokenBudget_Call struct {
 	*mock.Call
 }
 
-// UpdateState is a helper method to define mock.On call
+// UpdateTokenBudget is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - state domain.SessionState
-//   - executionID string
-func (_e *MockSessionRepository_Expecter) UpdateState(ctx interface{}, name interface{}, state interface{}, executionID interface{}) *MockSessionRepository_UpdateState_Call {
-	return &MockSessionRepository_UpdateState_Call{Call: _e.mock.On("UpdateState", ctx, name, state, executionID)}
+//   - budget *domain.SessionTokenBudget
+func (_e *MockSessionRepository_Expecter) UpdateTokenBudget(ctx interface{}, name interface{}, budget interface{}) *MockSessionRepository_UpdateTokenBudget_Call {
+	return &MockSessionRepository_UpdateTokenBudget_Call{Call: _e.mock.On("UpdateTokenBudget", ctx, name, budget)}
 }
 
-func (_c *MockSessionRepository_UpdateState_Call) Run(run func(ctx context.Context, name string, state domain.SessionState, executionID string)) *MockSessionRepository_UpdateState_Call {
+func (_c *MockSessionRepository_UpdateTokenBudget_Call) Run(run func(ctx context.Context, name string, budget *domain.SessionTokenBudget)) *MockSessionRepository_UpdateTokenBudget_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1248,65 +2648,60 @@ func (_c *MockSessionRepository_UpdateState_Call) Run(run func(ctx context.Conte
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 domain.SessionState
+		var arg2 *domain.SessionTokenBudget
 		if args[2] != nil {
-			arg2 = args[2].(domain.SessionState)
-		}
-		var arg3 string
-		if args[3] != nil {
-			arg3 = args[3].(string)
+			arg2 = args[2].(*domain.SessionTokenBudget)
 		}
 		run(
 			arg0,
 			arg1,
 			arg2,
-			arg3,
 		)
 	})
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateState_Call) Return(err error) *MockSessionRepository_UpdateState_Call {
+func (_c *MockSessionRepository_UpdateTokenBudget_Call) Return(err error) *MockSessionRepository_UpdateTokenBudget_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateState_Call) RunAndReturn(run func(ctx context.Context, name string, state domain.SessionState, executionID string) error) *MockSessionRepository_UpdateState_Call {
+func (_c *MockSessionRepository_UpdateTokenBudget_Call) RunAndReturn(run func(ctx context.Context, name string, budget *domain.SessionTokenBudget) error) *MockSessionRepository_UpdateTokenBudget_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateStatus provides a mock function for the type MockSessionRepository
-func (_mock *MockSessionRepository) UpdateStatus(ctx context.Context, name string, status *string) error {
-	ret := _mock.Called(ctx, name, status)
+// UpdateTokenBudgetUsage provides a mock function for the type MockSessionRepository
+func (_mock *MockSessionRepository) UpdateTokenBudgetUsage(ctx context.Context, name string, used int) error {
+	ret := _mock.Called(ctx, name, used)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateStatus")
+		panic("no return value specified for UpdateTokenBudgetUsage")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, string, *string) error); ok {
-		r0 = returnFunc(ctx, name, status)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, int) error); ok {
+		r0 = returnFunc(ctx, name, used)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockSessionRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
-type MockSessionRepository_UpdateStatus_Call struct {
+// MockSessionRepository_UpdateTokenBudgetUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTokenBudgetUsage'
+type MockSessionRepository_UpdateTokenBudgetUsage_Call struct {
 	*mock.Call
 }
 
-// UpdateStatus is a helper method to define mock.On call
+// UpdateTokenBudgetUsage is a helper method to define mock.On call
 //   - ctx context.Context
 //   - name string
-//   - status *string
-func (_e *MockSessionRepository_Expecter) UpdateStatus(ctx interface{}, name interface{}, status interface{}) *MockSessionRepository_UpdateStatus_Call {
-	return &MockSessionRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, name, status)}
+//   - used int
+func (_e *MockSessionRepository_Expecter) UpdateTokenBudgetUsage(ctx interface{}, name interface{}, used interface{}) *MockSessionRepository_UpdateTokenBudgetUsage_Call {
+	return &MockSessionRepository_UpdateTokenBudgetUsage_Call{Call: _e.mock.On("UpdateTokenBudgetUsage", ctx, name, used)}
 }
 
-func (_c *MockSessionRepository_UpdateStatus_Call) Run(run func(ctx context.Context, name string, status *string)) *MockSessionRepository_UpdateStatus_Call {
+func (_c *MockSessionRepository_UpdateTokenBudgetUsage_Call) Run(run func(ctx context.Context, name string, used int)) *MockSessionRepository_UpdateTokenBudgetUsage_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
@@ -1316,9 +2711,9 @@ func (_c *MockSessionRepository_UpdateStatus_Call) Run(run func(ctx context.Cont
 		if args[1] != nil {
 			arg1 = args[1].(string)
 		}
-		var arg2 *string
+		var arg2 int
 		if args[2] != nil {
-			arg2 = args[2].(*string)
+			arg2 = args[2].(int)
 		}
 		run(
 			arg0,
@@ -1329,12 +2724,12 @@ func (_c *MockSessionRepository_UpdateStatus_Call) Run(run func(ctx context.Cont
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateStatus_Call) Return(err error) *MockSessionRepository_UpdateStatus_Call {
+func (_c *MockSessionRepository_UpdateTokenBudgetUsage_Call) Return(err error) *MockSessionRepository_UpdateTokenBudgetUsage_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockSessionRepository_UpdateStatus_Call) RunAndReturn(run func(ctx context.Context, name string, status *string) error) *MockSessionRepository_UpdateStatus_Call {
+func (_c *MockSessionRepository_UpdateTokenBudgetUsage_Call) RunAndReturn(run func(ctx context.Context, name string, used int) error) *MockSessionRepository_UpdateTokenBudgetUsage_Call {
 	_c.Call.Return(run)
 	return _c
 }
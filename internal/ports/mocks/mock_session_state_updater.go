@@ -38,6 +38,195 @@ func (_m *MockSessionStateUpdater) EXPECT() *MockSessionStateUpdater_Expecter {
 	return &MockSessionStateUpdater_Expecter{mock: &_m.Mock}
 }
 
+// UpdateAgentArgs provides a mock function for the type MockSessionStateUpdater
+func (_mock *MockSessionStateUpdater) UpdateAgentArgs(ctx context.Context, name string, args []string) error {
+	ret := _mock.Called(ctx, name, args)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentArgs")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = returnFunc(ctx, name, args)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStateUpdater_UpdateAgentArgs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAgentArgs'
+type MockSessionStateUpdater_UpdateAgentArgs_Call struct {
+	*mock.Call
+}
+
+// UpdateAgentArgs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - args []string
+func (_e *MockSessionStateUpdater_Expecter) UpdateAgentArgs(ctx interface{}, name interface{}, args interface{}) *MockSessionStateUpdater_UpdateAgentArgs_Call {
+	return &MockSessionStateUpdater_UpdateAgentArgs_Call{Call: _e.mock.On("UpdateAgentArgs", ctx, name, args)}
+}
+
+func (_c *MockSessionStateUpdater_UpdateAgentArgs_Call) Run(run func(ctx context.Context, name string, args []string)) *MockSessionStateUpdater_UpdateAgentArgs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 []string
+		if args[2] != nil {
+			arg2 = args[2].([]string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateAgentArgs_Call) Return(err error) *MockSessionStateUpdater_UpdateAgentArgs_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateAgentArgs_Call) RunAndReturn(run func(ctx context.Context, name string, args []string) error) *MockSessionStateUpdater_UpdateAgentArgs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateAgentModel provides a mock function for the type MockSessionStateUpdater
+func (_mock *MockSessionStateUpdater) UpdateAgentModel(ctx context.Context, name string, model string) error {
+	ret := _mock.Called(ctx, name, model)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAgentModel")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, model)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStateUpdater_UpdateAgentModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAgentModel'
+type MockSessionStateUpdater_UpdateAgentModel_Call struct {
+	*mock.Call
+}
+
+// UpdateAgentModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - model string
+func (_e *MockSessionStateUpdater_Expecter) UpdateAgentModel(ctx interface{}, name interface{}, model interface{}) *MockSessionStateUpdater_UpdateAgentModel_Call {
+	return &MockSessionStateUpdater_UpdateAgentModel_Call{Call: _e.mock.On("UpdateAgentModel", ctx, name, model)}
+}
+
+func (_c *MockSessionStateUpdater_UpdateAgentModel_Call) Run(run func(ctx context.Context, name string, model string)) *MockSessionStateUpdater_UpdateAgentModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateAgentModel_Call) Return(err error) *MockSessionStateUpdater_UpdateAgentModel_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateAgentModel_Call) RunAndReturn(run func(ctx context.Context, name string, model string) error) *MockSessionStateUpdater_UpdateAgentModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateBranchName provides a mock function for the type MockSessionStateUpdater
+func (_mock *MockSessionStateUpdater) UpdateBranchName(ctx context.Context, name string, branchName string) error {
+	ret := _mock.Called(ctx, name, branchName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateBranchName")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, branchName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStateUpdater_UpdateBranchName_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateBranchName'
+type MockSessionStateUpdater_UpdateBranchName_Call struct {
+	*mock.Call
+}
+
+// UpdateBranchName is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - branchName string
+func (_e *MockSessionStateUpdater_Expecter) UpdateBranchName(ctx interface{}, name interface{}, branchName interface{}) *MockSessionStateUpdater_UpdateBranchName_Call {
+	return &MockSessionStateUpdater_UpdateBranchName_Call{Call: _e.mock.On("UpdateBranchName", ctx, name, branchName)}
+}
+
+func (_c *MockSessionStateUpdater_UpdateBranchName_Call) Run(run func(ctx context.Context, name string, branchName string)) *MockSessionStateUpdater_UpdateBranchName_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateBranchName_Call) Return(err error) *MockSessionStateUpdater_UpdateBranchName_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateBranchName_Call) RunAndReturn(run func(ctx context.Context, name string, branchName string) error) *MockSessionStateUpdater_UpdateBranchName_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateClaudeDir provides a mock function for the type MockSessionStateUpdater
 func (_mock *MockSessionStateUpdater) UpdateClaudeDir(ctx context.Context, name string, claudeDir string) error {
 	ret := _mock.Called(ctx, name, claudeDir)
@@ -101,6 +290,132 @@ func (_c *MockSessionStateUpdater_UpdateClaudeDir_Call) RunAndReturn(run func(ct
 	return _c
 }
 
+// UpdateClaudeSessionID provides a mock function for the type MockSessionStateUpdater
+func (_mock *MockSessionStateUpdater) UpdateClaudeSessionID(ctx context.Context, name string, claudeSessionID string) error {
+	ret := _mock.Called(ctx, name, claudeSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateClaudeSessionID")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, claudeSessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStateUpdater_UpdateClaudeSessionID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateClaudeSessionID'
+type MockSessionStateUpdater_UpdateClaudeSessionID_Call struct {
+	*mock.Call
+}
+
+// UpdateClaudeSessionID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - claudeSessionID string
+func (_e *MockSessionStateUpdater_Expecter) UpdateClaudeSessionID(ctx interface{}, name interface{}, claudeSessionID interface{}) *MockSessionStateUpdater_UpdateClaudeSessionID_Call {
+	return &MockSessionStateUpdater_UpdateClaudeSessionID_Call{Call: _e.mock.On("UpdateClaudeSessionID", ctx, name, claudeSessionID)}
+}
+
+func (_c *MockSessionStateUpdater_UpdateClaudeSessionID_Call) Run(run func(ctx context.Context, name string, claudeSessionID string)) *MockSessionStateUpdater_UpdateClaudeSessionID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateClaudeSessionID_Call) Return(err error) *MockSessionStateUpdater_UpdateClaudeSessionID_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateClaudeSessionID_Call) RunAndReturn(run func(ctx context.Context, name string, claudeSessionID string) error) *MockSessionStateUpdater_UpdateClaudeSessionID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEditor provides a mock function for the type MockSessionStateUpdater
+func (_mock *MockSessionStateUpdater) UpdateEditor(ctx context.Context, name string, editor domain.EditorIntegration) error {
+	ret := _mock.Called(ctx, name, editor)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEditor")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, domain.EditorIntegration) error); ok {
+		r0 = returnFunc(ctx, name, editor)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStateUpdater_UpdateEditor_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEditor'
+type MockSessionStateUpdater_UpdateEditor_Call struct {
+	*mock.Call
+}
+
+// UpdateEditor is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - editor domain.EditorIntegration
+func (_e *MockSessionStateUpdater_Expecter) UpdateEditor(ctx interface{}, name interface{}, editor interface{}) *MockSessionStateUpdater_UpdateEditor_Call {
+	return &MockSessionStateUpdater_UpdateEditor_Call{Call: _e.mock.On("UpdateEditor", ctx, name, editor)}
+}
+
+func (_c *MockSessionStateUpdater_UpdateEditor_Call) Run(run func(ctx context.Context, name string, editor domain.EditorIntegration)) *MockSessionStateUpdater_UpdateEditor_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 domain.EditorIntegration
+		if args[2] != nil {
+			arg2 = args[2].(domain.EditorIntegration)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateEditor_Call) Return(err error) *MockSessionStateUpdater_UpdateEditor_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateEditor_Call) RunAndReturn(run func(ctx context.Context, name string, editor domain.EditorIntegration) error) *MockSessionStateUpdater_UpdateEditor_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateExecutionID provides a mock function for the type MockSessionStateUpdater
 func (_mock *MockSessionStateUpdater) UpdateExecutionID(ctx context.Context, name string, executionID string) error {
 	ret := _mock.Called(ctx, name, executionID)
@@ -164,6 +479,69 @@ func (_c *MockSessionStateUpdater_UpdateExecutionID_Call) RunAndReturn(run func(
 	return _c
 }
 
+// UpdateKeepWorktree provides a mock function for the type MockSessionStateUpdater
+func (_mock *MockSessionStateUpdater) UpdateKeepWorktree(ctx context.Context, name string, keep bool) error {
+	ret := _mock.Called(ctx, name, keep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateKeepWorktree")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = returnFunc(ctx, name, keep)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStateUpdater_UpdateKeepWorktree_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateKeepWorktree'
+type MockSessionStateUpdater_UpdateKeepWorktree_Call struct {
+	*mock.Call
+}
+
+// UpdateKeepWorktree is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - keep bool
+func (_e *MockSessionStateUpdater_Expecter) UpdateKeepWorktree(ctx interface{}, name interface{}, keep interface{}) *MockSessionStateUpdater_UpdateKeepWorktree_Call {
+	return &MockSessionStateUpdater_UpdateKeepWorktree_Call{Call: _e.mock.On("UpdateKeepWorktree", ctx, name, keep)}
+}
+
+func (_c *MockSessionStateUpdater_UpdateKeepWorktree_Call) Run(run func(ctx context.Context, name string, keep bool)) *MockSessionStateUpdater_UpdateKeepWorktree_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateKeepWorktree_Call) Return(err error) *MockSessionStateUpdater_UpdateKeepWorktree_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateKeepWorktree_Call) RunAndReturn(run func(ctx context.Context, name string, keep bool) error) *MockSessionStateUpdater_UpdateKeepWorktree_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateRepoSource provides a mock function for the type MockSessionStateUpdater
 func (_mock *MockSessionStateUpdater) UpdateRepoSource(ctx context.Context, name string, repoSource string) error {
 	ret := _mock.Called(ctx, name, repoSource)
@@ -358,3 +736,66 @@ func (_c *MockSessionStateUpdater_UpdateState_Call) RunAndReturn(run func(ctx co
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateSubdir provides a mock function for the type MockSessionStateUpdater
+func (_mock *MockSessionStateUpdater) UpdateSubdir(ctx context.Context, name string, subdir string) error {
+	ret := _mock.Called(ctx, name, subdir)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateSubdir")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = returnFunc(ctx, name, subdir)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionStateUpdater_UpdateSubdir_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateSubdir'
+type MockSessionStateUpdater_UpdateSubdir_Call struct {
+	*mock.Call
+}
+
+// UpdateSubdir is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - subdir string
+func (_e *MockSessionStateUpdater_Expecter) UpdateSubdir(ctx interface{}, name interface{}, subdir interface{}) *MockSessionStateUpdater_UpdateSubdir_Call {
+	return &MockSessionStateUpdater_UpdateSubdir_Call{Call: _e.mock.On("UpdateSubdir", ctx, name, subdir)}
+}
+
+func (_c *MockSessionStateUpdater_UpdateSubdir_Call) Run(run func(ctx context.Context, name string, subdir string)) *MockSessionStateUpdater_UpdateSubdir_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateSubdir_Call) Return(err error) *MockSessionStateUpdater_UpdateSubdir_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionStateUpdater_UpdateSubdir_Call) RunAndReturn(run func(ctx context.Context, name string, subdir string) error) *MockSessionStateUpdater_UpdateSubdir_Call {
+	_c.Call.Return(run)
+	return _c
+}
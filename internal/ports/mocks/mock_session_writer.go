@@ -277,3 +277,60 @@ func (_c *MockSessionWriter_SwapPositions_Call) RunAndReturn(run func(ctx contex
 	_c.Call.Return(run)
 	return _c
 }
+
+// UnlinkShellSession provides a mock function for the type MockSessionWriter
+func (_mock *MockSessionWriter) UnlinkShellSession(ctx context.Context, parentName string) error {
+	ret := _mock.Called(ctx, parentName)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnlinkShellSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, parentName)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockSessionWriter_UnlinkShellSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnlinkShellSession'
+type MockSessionWriter_UnlinkShellSession_Call struct {
+	*mock.Call
+}
+
+// UnlinkShellSession is a helper method to define mock.On call
+//   - ctx context.Context
+//   - parentName string
+func (_e *MockSessionWriter_Expecter) UnlinkShellSession(ctx interface{}, parentName interface{}) *MockSessionWriter_UnlinkShellSession_Call {
+	return &MockSessionWriter_UnlinkShellSession_Call{Call: _e.mock.On("UnlinkShellSession", ctx, parentName)}
+}
+
+func (_c *MockSessionWriter_UnlinkShellSession_Call) Run(run func(ctx context.Context, parentName string)) *MockSessionWriter_UnlinkShellSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockSessionWriter_UnlinkShellSession_Call) Return(err error) *MockSessionWriter_UnlinkShellSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockSessionWriter_UnlinkShellSession_Call) RunAndReturn(run func(ctx context.Context, parentName string) error) *MockSessionWriter_UnlinkShellSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
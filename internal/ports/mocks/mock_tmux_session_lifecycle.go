@@ -359,6 +359,212 @@ func (_c *MockTmuxSessionLifecycle_RenameSession_Call) RunAndReturn(run func(old
 	return _c
 }
 
+// RequestAgentExit provides a mock function for the type MockTmuxSessionLifecycle
+func (_mock *MockTmuxSessionLifecycle) RequestAgentExit(name string) error {
+	ret := _mock.Called(name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestAgentExit")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string) error); ok {
+		r0 = returnFunc(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTmuxSessionLifecycle_RequestAgentExit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestAgentExit'
+type MockTmuxSessionLifecycle_RequestAgentExit_Call struct {
+	*mock.Call
+}
+
+// RequestAgentExit is a helper method to define mock.On call
+//   - name string
+func (_e *MockTmuxSessionLifecycle_Expecter) RequestAgentExit(name interface{}) *MockTmuxSessionLifecycle_RequestAgentExit_Call {
+	return &MockTmuxSessionLifecycle_RequestAgentExit_Call{Call: _e.mock.On("RequestAgentExit", name)}
+}
+
+func (_c *MockTmuxSessionLifecycle_RequestAgentExit_Call) Run(run func(name string)) *MockTmuxSessionLifecycle_RequestAgentExit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTmuxSessionLifecycle_RequestAgentExit_Call) Return(err error) *MockTmuxSessionLifecycle_RequestAgentExit_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTmuxSessionLifecycle_RequestAgentExit_Call) RunAndReturn(run func(name string) error) *MockTmuxSessionLifecycle_RequestAgentExit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResumeSession provides a mock function for the type MockTmuxSessionLifecycle
+func (_mock *MockTmuxSessionLifecycle) ResumeSession(name string, worktreePath string, claudeDir string, statusPosition string, claudeSessionID string) (*ports.TmuxSession, error) {
+	ret := _mock.Called(name, worktreePath, claudeDir, statusPosition, claudeSessionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResumeSession")
+	}
+
+	var r0 *ports.TmuxSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string) (*ports.TmuxSession, error)); ok {
+		return returnFunc(name, worktreePath, claudeDir, statusPosition, claudeSessionID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string, string) *ports.TmuxSession); ok {
+		r0 = returnFunc(name, worktreePath, claudeDir, statusPosition, claudeSessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ports.TmuxSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string, string, string) error); ok {
+		r1 = returnFunc(name, worktreePath, claudeDir, statusPosition, claudeSessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTmuxSessionLifecycle_ResumeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResumeSession'
+type MockTmuxSessionLifecycle_ResumeSession_Call struct {
+	*mock.Call
+}
+
+// ResumeSession is a helper method to define mock.On call
+//   - name string
+//   - worktreePath string
+//   - claudeDir string
+//   - statusPosition string
+//   - claudeSessionID string
+func (_e *MockTmuxSessionLifecycle_Expecter) ResumeSession(name interface{}, worktreePath interface{}, claudeDir interface{}, statusPosition interface{}, claudeSessionID interface{}) *MockTmuxSessionLifecycle_ResumeSession_Call {
+	return &MockTmuxSessionLifecycle_ResumeSession_Call{Call: _e.mock.On("ResumeSession", name, worktreePath, claudeDir, statusPosition, claudeSessionID)}
+}
+
+func (_c *MockTmuxSessionLifecycle_ResumeSession_Call) Run(run func(name string, worktreePath string, claudeDir string, statusPosition string, claudeSessionID string)) *MockTmuxSessionLifecycle_ResumeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		var arg4 string
+		if args[4] != nil {
+			arg4 = args[4].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+			arg4,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTmuxSessionLifecycle_ResumeSession_Call) Return(tmuxSession *ports.TmuxSession, err error) *MockTmuxSessionLifecycle_ResumeSession_Call {
+	_c.Call.Return(tmuxSession, err)
+	return _c
+}
+
+func (_c *MockTmuxSessionLifecycle_ResumeSession_Call) RunAndReturn(run func(name string, worktreePath string, claudeDir string, statusPosition string, claudeSessionID string) (*ports.TmuxSession, error)) *MockTmuxSessionLifecycle_ResumeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RunInWindow provides a mock function for the type MockTmuxSessionLifecycle
+func (_mock *MockTmuxSessionLifecycle) RunInWindow(sessionName string, windowName string, dir string, command string) error {
+	ret := _mock.Called(sessionName, windowName, dir, command)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RunInWindow")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string, string) error); ok {
+		r0 = returnFunc(sessionName, windowName, dir, command)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockTmuxSessionLifecycle_RunInWindow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunInWindow'
+type MockTmuxSessionLifecycle_RunInWindow_Call struct {
+	*mock.Call
+}
+
+// RunInWindow is a helper method to define mock.On call
+//   - sessionName string
+//   - windowName string
+//   - dir string
+//   - command string
+func (_e *MockTmuxSessionLifecycle_Expecter) RunInWindow(sessionName interface{}, windowName interface{}, dir interface{}, command interface{}) *MockTmuxSessionLifecycle_RunInWindow_Call {
+	return &MockTmuxSessionLifecycle_RunInWindow_Call{Call: _e.mock.On("RunInWindow", sessionName, windowName, dir, command)}
+}
+
+func (_c *MockTmuxSessionLifecycle_RunInWindow_Call) Run(run func(sessionName string, windowName string, dir string, command string)) *MockTmuxSessionLifecycle_RunInWindow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 string
+		if args[3] != nil {
+			arg3 = args[3].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTmuxSessionLifecycle_RunInWindow_Call) Return(err error) *MockTmuxSessionLifecycle_RunInWindow_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockTmuxSessionLifecycle_RunInWindow_Call) RunAndReturn(run func(sessionName string, windowName string, dir string, command string) error) *MockTmuxSessionLifecycle_RunInWindow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SessionExists provides a mock function for the type MockTmuxSessionLifecycle
 func (_mock *MockTmuxSessionLifecycle) SessionExists(name string) bool {
 	ret := _mock.Called(name)
@@ -36,6 +36,78 @@ func (_m *MockTokenUsageReader) EXPECT() *MockTokenUsageReader_Expecter {
 	return &MockTokenUsageReader_Expecter{mock: &_m.Mock}
 }
 
+// GetSessionUsage provides a mock function for the type MockTokenUsageReader
+func (_mock *MockTokenUsageReader) GetSessionUsage(claudeDir string, workingDir string, conversationID string) (ports.TokenTotals, error) {
+	ret := _mock.Called(claudeDir, workingDir, conversationID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSessionUsage")
+	}
+
+	var r0 ports.TokenTotals
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) (ports.TokenTotals, error)); ok {
+		return returnFunc(claudeDir, workingDir, conversationID)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string, string) ports.TokenTotals); ok {
+		r0 = returnFunc(claudeDir, workingDir, conversationID)
+	} else {
+		r0 = ret.Get(0).(ports.TokenTotals)
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = returnFunc(claudeDir, workingDir, conversationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockTokenUsageReader_GetSessionUsage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSessionUsage'
+type MockTokenUsageReader_GetSessionUsage_Call struct {
+	*mock.Call
+}
+
+// GetSessionUsage is a helper method to define mock.On call
+//   - claudeDir string
+//   - workingDir string
+//   - conversationID string
+func (_e *MockTokenUsageReader_Expecter) GetSessionUsage(claudeDir interface{}, workingDir interface{}, conversationID interface{}) *MockTokenUsageReader_GetSessionUsage_Call {
+	return &MockTokenUsageReader_GetSessionUsage_Call{Call: _e.mock.On("GetSessionUsage", claudeDir, workingDir, conversationID)}
+}
+
+func (_c *MockTokenUsageReader_GetSessionUsage_Call) Run(run func(claudeDir string, workingDir string, conversationID string)) *MockTokenUsageReader_GetSessionUsage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockTokenUsageReader_GetSessionUsage_Call) Return(tokenTotals ports.TokenTotals, err error) *MockTokenUsageReader_GetSessionUsage_Call {
+	_c.Call.Return(tokenTotals, err)
+	return _c
+}
+
+func (_c *MockTokenUsageReader_GetSessionUsage_Call) RunAndReturn(run func(claudeDir string, workingDir string, conversationID string) (ports.TokenTotals, error)) *MockTokenUsageReader_GetSessionUsage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTodayUsage provides a mock function for the type MockTokenUsageReader
 func (_mock *MockTokenUsageReader) GetTodayUsage() ([]ports.TokenUsage, error) {
 	ret := _mock.Called()
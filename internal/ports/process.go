@@ -1,7 +1,14 @@
 package ports
 
+import "github.com/renato0307/rocha/internal/domain"
+
 // ProcessInspector provides methods to inspect running processes
 type ProcessInspector interface {
 	// GetClaudeSettings retrieves the --settings JSON from a running Claude process for a session
 	GetClaudeSettings(sessionName string) (string, error)
+	// GetResourceUsage samples CPU and memory of the Claude process of each session
+	// Sessions without a running Claude process are omitted from the result
+	GetResourceUsage(sessionNames []string) (map[string]*domain.ResourceUsage, error)
+	// KillAgentProcess terminates the Claude process of a session, leaving the tmux session running
+	KillAgentProcess(sessionName string) error
 }
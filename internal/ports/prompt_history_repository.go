@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// PromptHistoryRepository stores the text sent to each session
+type PromptHistoryRepository interface {
+	// AddSentPrompt stores a sent prompt, discarding the oldest ones past the per-session limit
+	AddSentPrompt(ctx context.Context, prompt domain.SentPrompt) error
+	// ListSentPrompts returns the most recent prompts sent to a session, newest first
+	ListSentPrompts(ctx context.Context, sessionName string, limit int) ([]domain.SentPrompt, error)
+}
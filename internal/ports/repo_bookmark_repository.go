@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// RepoBookmarkRepository persists repository sources saved for session creation
+type RepoBookmarkRepository interface {
+	// AddRepoBookmark stores a bookmark, returning domain.ErrRepoBookmarkExists if its name or source is taken
+	AddRepoBookmark(ctx context.Context, bookmark domain.RepoBookmark) error
+	// DeleteRepoBookmark removes a bookmark, returning domain.ErrRepoBookmarkNotFound if missing
+	DeleteRepoBookmark(ctx context.Context, name string) error
+	// ListRepoBookmarks returns all bookmarks, most used first, then most recently used
+	ListRepoBookmarks(ctx context.Context) ([]domain.RepoBookmark, error)
+	// MarkRepoBookmarkUsed counts a session created from a bookmark, returning domain.ErrRepoBookmarkNotFound if missing
+	MarkRepoBookmarkUsed(ctx context.Context, name string, usedAt time.Time) error
+}
@@ -0,0 +1,26 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// ScheduledPromptRepository persists prompts queued for delivery to sessions
+type ScheduledPromptRepository interface {
+	// AddScheduledPrompt stores a prompt and returns it with its assigned ID
+	AddScheduledPrompt(ctx context.Context, prompt domain.ScheduledPrompt) (*domain.ScheduledPrompt, error)
+	// ClaimScheduledPrompt marks a due prompt as being sent by the caller. It reports false when
+	// another dispatcher holds the claim, so a prompt is sent by one dispatcher only.
+	ClaimScheduledPrompt(ctx context.Context, id uint, now time.Time) (bool, error)
+	// DeleteScheduledPrompt removes a prompt, returning domain.ErrScheduledPromptNotFound if missing
+	DeleteScheduledPrompt(ctx context.Context, id uint) error
+	// ListDuePrompts returns unclaimed prompts whose send time is at or before now, oldest first.
+	// Claims held longer than a dispatch can take are treated as abandoned.
+	ListDuePrompts(ctx context.Context, now time.Time) ([]domain.ScheduledPrompt, error)
+	// ReleaseScheduledPrompt drops the claim on a prompt that stays queued
+	ReleaseScheduledPrompt(ctx context.Context, id uint) error
+	// ListScheduledPrompts returns pending prompts for a session, or all sessions if sessionName is empty
+	ListScheduledPrompts(ctx context.Context, sessionName string) ([]domain.ScheduledPrompt, error)
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 )
@@ -10,6 +11,7 @@ import (
 type SessionReader interface {
 	Get(ctx context.Context, name string) (*domain.Session, error)
 	List(ctx context.Context, includeArchived bool) ([]domain.Session, error)
+	ListSummaries(ctx context.Context) ([]domain.SessionSummary, error) // Active sessions by position, read in one query for the status bar
 }
 
 // SessionWriter creates, deletes, and reorders sessions
@@ -18,26 +20,47 @@ type SessionWriter interface {
 	Delete(ctx context.Context, name string) error
 	LinkShellSession(ctx context.Context, parentName, shellSessionName string) error
 	SwapPositions(ctx context.Context, name1, name2 string) error
+	UnlinkShellSession(ctx context.Context, parentName string) error // Forgets the shell session of a session
 }
 
 // SessionStateUpdater updates session state
 type SessionStateUpdater interface {
+	UpdateAgentArgs(ctx context.Context, name string, args []string) error // Empty clears the extra args
+	UpdateAgentModel(ctx context.Context, name, model string) error        // Empty uses the agent default
+	UpdateBranchName(ctx context.Context, name, branchName string) error   // Also clears the PR info of the previous branch
 	UpdateClaudeDir(ctx context.Context, name, claudeDir string) error
+	UpdateClaudeSessionID(ctx context.Context, name, claudeSessionID string) error
+	UpdateEditor(ctx context.Context, name string, editor domain.EditorIntegration) error
 	UpdateExecutionID(ctx context.Context, name, executionID string) error
+	UpdateKeepWorktree(ctx context.Context, name string, keep bool) error // Opts out of the worktree retention
 	UpdateRepoSource(ctx context.Context, name, repoSource string) error
 	UpdateSkipPermissions(ctx context.Context, name string, skip bool) error
+	UpdateSubdir(ctx context.Context, name, subdir string) error // Empty scopes the session to the whole checkout
 	UpdateState(ctx context.Context, name string, state domain.SessionState, executionID string) error
 }
 
 // SessionMetadataUpdater updates session metadata
 type SessionMetadataUpdater interface {
-	Rename(ctx context.Context, oldName, newName, newDisplayName string) error
+	MarkCINotified(ctx context.Context, name string, reportedAt time.Time) (bool, error) // false if already marked or a newer result came in
+	MarkCheckpoint(ctx context.Context, name string, at time.Time) error                 // Records the last checkpoint attempt
+	MarkTimerNotified(ctx context.Context, name string, dueAt time.Time) (bool, error)   // false if already marked or the timer changed
+	MarkTokenBudgetExceeded(ctx context.Context, name string, limit int) (bool, error)   // false if already marked or the budget changed
+	Rename(ctx context.Context, rename domain.SessionRename) error                       // Also renames the shell session and everything keyed by the name
+	SetFlag(ctx context.Context, name string, flagged bool) error
 	ToggleArchive(ctx context.Context, name string) error
 	ToggleFlag(ctx context.Context, name string) error
+	UpdateCIStatus(ctx context.Context, name string, status *domain.CIStatus) error           // nil clears the CI result
+	UpdateCheckpoint(ctx context.Context, name string, policy *domain.CheckpointPolicy) error // nil turns checkpoints off
 	UpdateComment(ctx context.Context, name, comment string) error
 	UpdateDisplayName(ctx context.Context, name, displayName string) error
+	UpdateNote(ctx context.Context, name, note string) error
 	UpdatePRInfo(ctx context.Context, name string, prInfo *domain.PRInfo) error
+	UpdatePriority(ctx context.Context, name string, priority domain.Priority) error
 	UpdateStatus(ctx context.Context, name string, status *string) error
+	UpdateTags(ctx context.Context, name string, tags []string) error
+	UpdateTimer(ctx context.Context, name string, timer *domain.SessionTimer) error              // nil clears the timer
+	UpdateTokenBudget(ctx context.Context, name string, budget *domain.SessionTokenBudget) error // nil clears the budget
+	UpdateTokenBudgetUsage(ctx context.Context, name string, used int) error
 }
 
 // SessionStateLoader loads full session state for UI
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// ShareRepository persists links that let teammates attach to sessions
+type ShareRepository interface {
+	// AddShare stores a new share
+	AddShare(ctx context.Context, share domain.SessionShare) error
+	// GetShare returns a share by token, or domain.ErrShareNotFound if missing
+	GetShare(ctx context.Context, token string) (*domain.SessionShare, error)
+	// ListShares returns all shares of a session, newest first
+	ListShares(ctx context.Context, sessionName string) ([]domain.SessionShare, error)
+	// RevokeShare marks a share as revoked, returning domain.ErrShareNotFound if missing
+	RevokeShare(ctx context.Context, token string, revokedAt time.Time) error
+}
@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// TicketSyncOutbox persists session status changes waiting to be synced to tickets,
+// so the sync runs in the background instead of the path that changed the status
+type TicketSyncOutbox interface {
+	// ClaimTicketSync marks the request of a session as being synced by the caller. It reports
+	// false when another drain holds the claim, so a change is synced by one drain only.
+	ClaimTicketSync(ctx context.Context, sessionName string, now time.Time) (bool, error)
+	// CompleteTicketSync removes the request of a session when no change was queued since
+	// revision; otherwise it only drops the claim, so the newer change is synced next
+	CompleteTicketSync(ctx context.Context, sessionName string, revision int) error
+	// FailTicketSync counts a failed sync of the request of a session and drops the claim
+	FailTicketSync(ctx context.Context, sessionName string) error
+	// ListTicketSyncs returns the unclaimed requests, oldest first.
+	// Claims held longer than a sync can take are treated as abandoned.
+	ListTicketSyncs(ctx context.Context, now time.Time) ([]domain.TicketSyncRequest, error)
+	// QueueTicketSync queues a sync of the session's ticket, folding it into a request
+	// already queued for the session
+	QueueTicketSync(ctx context.Context, sessionName string, queuedAt time.Time) error
+}
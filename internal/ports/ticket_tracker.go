@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrSecretNotFound is returned when no secret is stored for a service and account
+var ErrSecretNotFound = errors.New("secret not found in keychain")
+
+// TicketTracker updates tickets in an issue tracker such as Jira or Linear
+type TicketTracker interface {
+	// AddComment posts a plain text comment on a ticket
+	AddComment(ctx context.Context, key, body string) error
+	// TicketTitle returns the title (summary) of a ticket
+	TicketTitle(ctx context.Context, key string) (string, error)
+	// TransitionTicket moves a ticket to the state with the given name
+	TransitionTicket(ctx context.Context, key, state string) error
+}
+
+// SecretStore keeps credentials in the OS keychain
+type SecretStore interface {
+	DeleteSecret(service, account string) error
+	// GetSecret returns the stored secret, or ErrSecretNotFound
+	GetSecret(service, account string) (string, error)
+	SetSecret(service, account, secret string) error
+}
@@ -15,10 +15,15 @@ var (
 	ErrTmuxNotAttached     = errors.New("not attached to tmux session")
 	ErrTmuxSessionExists   = errors.New("tmux session already exists")
 	ErrTmuxSessionNotFound = errors.New("tmux session not found")
+	ErrTmuxUnavailable     = errors.New("tmux is not available")
+
+	// ErrMultiplexerUnsupported marks operations the configured multiplexer cannot perform
+	ErrMultiplexerUnsupported = errors.New("not supported by the terminal multiplexer")
 )
 
 // TmuxSession represents a tmux session
 type TmuxSession struct {
+	Attached  bool // A client is attached; multiplexers that cannot tell report false
 	CreatedAt time.Time
 	Name      string
 }
@@ -30,6 +35,9 @@ type TmuxSessionLifecycle interface {
 	KillSession(name string) error
 	ListSessions() ([]*TmuxSession, error)
 	RenameSession(oldName, newName string) error
+	RequestAgentExit(name string) error
+	ResumeSession(name, worktreePath, claudeDir, statusPosition, claudeSessionID string) (*TmuxSession, error)
+	RunInWindow(sessionName, windowName, dir, command string) error // Runs a shell command in a temporary window of the session, closed when it exits
 	SessionExists(name string) bool
 }
 
@@ -38,11 +46,22 @@ type TmuxSessionAttacher interface {
 	Attach(sessionName string) (chan struct{}, error)
 	Detach(sessionName string) error
 	GetAttachCommand(sessionName string) *exec.Cmd
+	GetGuestAttachCommand(socket string, readOnly bool) (*exec.Cmd, error) // Attaches a teammate to a guest server
+	OpenInWindow(sessionName string) error
+	// StartGuestServer starts a server of its own that guest attaches to the session through,
+	// with the access the server enforces, until it stops itself at until. Returns its socket.
+	StartGuestServer(id, sessionName, guest string, readOnly bool, until time.Time) (string, error)
+	StopGuestServer(socket string) error
+	SwitchClient(sessionName string) error
 }
 
 // TmuxPaneController handles tmux pane operations
+// Keys use tmux key names ("Enter", "C-m", "Escape"); other text is sent literally
 type TmuxPaneController interface {
 	CapturePane(sessionName string, startLine int) (string, error)
+	// PanePIDs maps every session to a process its agent runs under, such as its first pane;
+	// ErrMultiplexerUnsupported when the multiplexer cannot tell
+	PanePIDs() (map[string]int, error)
 	SendKeys(sessionName string, keys ...string) error
 }
 
@@ -53,8 +72,9 @@ type TmuxConfigurator interface {
 	SourceFile(configPath string) error
 }
 
-// TmuxClient is the composite tmux interface
-type TmuxClient interface {
+// SessionManager is the terminal multiplexer hosting rocha sessions
+// tmux is the default backend; zellij and screen implement the same interface
+type SessionManager interface {
 	TmuxConfigurator
 	TmuxPaneController
 	TmuxSessionAttacher
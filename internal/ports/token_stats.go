@@ -7,6 +7,7 @@ type TokenUsage struct {
 	CacheCreation int
 	CacheRead     int
 	InputTokens   int
+	Model         string // Model that produced the message, such as claude-opus-4-1-20250805
 	OutputTokens  int
 	Timestamp     time.Time
 }
@@ -17,6 +18,7 @@ type HourlyTokenUsage struct {
 	CacheRead     int
 	Hour          int // 0-23
 	InputTokens   int
+	Models        map[string]TokenTotals // Usage of the hour per model
 	OutputTokens  int
 }
 
@@ -32,4 +34,8 @@ type TokenTotals struct {
 type TokenUsageReader interface {
 	// GetTodayUsage returns all token usage entries for today
 	GetTodayUsage() ([]TokenUsage, error)
+
+	// GetSessionUsage returns the token totals of the conversations Claude recorded for a
+	// working directory in claudeDir, plus the conversationID one when set
+	GetSessionUsage(claudeDir, workingDir, conversationID string) (TokenTotals, error)
 }
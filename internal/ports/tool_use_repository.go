@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// ToolUseRepository stores the tool calls audited for sessions that skip permission prompts
+type ToolUseRepository interface {
+	// AddToolUse stores a tool use, discarding tool uses past the retention period
+	AddToolUse(ctx context.Context, toolUse domain.ToolUse) error
+	// ListToolUses returns the most recent tool uses of a session, newest first
+	ListToolUses(ctx context.Context, sessionName string, limit int) ([]domain.ToolUse, error)
+}
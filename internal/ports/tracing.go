@@ -0,0 +1,18 @@
+package ports
+
+import "context"
+
+// Tracer records timed spans of operations, such as OpenTelemetry traces
+type Tracer interface {
+	// Start begins a span that is a child of the span carried by ctx, if any,
+	// and returns a context carrying the new span
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
+
+// Span is an operation being traced
+type Span interface {
+	// SetAttribute annotates the span; values are strings, bools, ints, or floats
+	SetAttribute(key string, value any)
+	// End finishes the span, marking it failed when err is not nil
+	End(err error)
+}
@@ -0,0 +1,11 @@
+package ports
+
+import "github.com/renato0307/rocha/internal/domain"
+
+// TranscriptReader reads the conversation files Claude records for a working directory
+type TranscriptReader interface {
+	// ReadTranscript returns the entries of every conversation Claude recorded in workingDir,
+	// plus the conversationID one wherever it is stored, oldest first.
+	// claudeDir is the CLAUDE_CONFIG_DIR the session runs with.
+	ReadTranscript(claudeDir, workingDir, conversationID string) ([]domain.TranscriptEntry, error)
+}
@@ -0,0 +1,23 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// WorkspaceRepository persists named sets of sessions
+type WorkspaceRepository interface {
+	// AddWorkspaceSessions adds sessions to a workspace, ignoring sessions that already belong to it
+	AddWorkspaceSessions(ctx context.Context, workspace string, sessionNames []string) error
+	// CreateWorkspace stores an empty workspace, returning domain.ErrWorkspaceExists if the name is taken
+	CreateWorkspace(ctx context.Context, name string) error
+	// DeleteWorkspace removes a workspace and its membership, returning domain.ErrWorkspaceNotFound if missing
+	DeleteWorkspace(ctx context.Context, name string) error
+	// GetWorkspace returns a workspace with its sessions, or domain.ErrWorkspaceNotFound if missing
+	GetWorkspace(ctx context.Context, name string) (*domain.Workspace, error)
+	// ListWorkspaces returns all workspaces with their sessions, by name
+	ListWorkspaces(ctx context.Context) ([]domain.Workspace, error)
+	// RemoveWorkspaceSessions removes sessions from a workspace
+	RemoveWorkspaceSessions(ctx context.Context, workspace string, sessionNames []string) error
+}
@@ -0,0 +1,154 @@
+// Package actions runs the session lifecycle actions (kill, delete, archive) shared by the CLI
+// and the TUI, so both apply the same steps and the same worktree confirmation policy.
+package actions
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// WorktreeCheck reports what removing a session's worktree would lose
+type WorktreeCheck struct {
+	Err    error                  // The worktree could not be checked (treated as possible data loss)
+	Path   string                 // Empty when the session has no worktree
+	Status *domain.WorktreeStatus // nil when the check failed
+}
+
+// NeedsDiscardConfirmation reports whether removing the worktree requires the user to explicitly
+// accept losing local work: uncommitted changes, unpushed commits, or a status that is unknown
+func (c WorktreeCheck) NeedsDiscardConfirmation() bool {
+	return c.Path != "" && (c.Status == nil || c.Status.HasLocalWork())
+}
+
+// WorktreeRemoval is the user's decision about a session's worktree
+type WorktreeRemoval struct {
+	Discard bool // The user accepted losing local work (see WorktreeCheck.NeedsDiscardConfirmation)
+	Remove  bool // Remove the worktree
+}
+
+// Outcome reports what an action did with the session's worktree
+type Outcome struct {
+	WorktreeErr     error  // Removal failed (the action itself still completed)
+	WorktreeKept    bool   // Removal was requested, but the worktree has local work that was not discarded
+	WorktreePath    string // Worktree the removal decision applied to
+	WorktreeRemoved bool
+}
+
+// KillOptions configures Kill
+type KillOptions struct {
+	KeepTmux        bool          // Only forget the session, leaving its tmux sessions running
+	ShutdownTimeout time.Duration // Time the agent gets to exit before tmux is killed (0 kills right away)
+	Worktree        WorktreeRemoval
+}
+
+// Service runs session lifecycle actions
+type Service struct {
+	checkpointService *services.CheckpointService
+	gitService        *services.GitService
+	sessionService    *services.SessionService
+}
+
+// NewService creates a new actions Service
+func NewService(
+	sessionService *services.SessionService,
+	gitService *services.GitService,
+	checkpointService *services.CheckpointService,
+) *Service {
+	return &Service{
+		checkpointService: checkpointService,
+		gitService:        gitService,
+		sessionService:    sessionService,
+	}
+}
+
+// CheckWorktree looks for work that removing the session's worktree would lose
+func (s *Service) CheckWorktree(ctx context.Context, session *domain.Session) WorktreeCheck {
+	check := WorktreeCheck{Path: session.WorktreePath}
+	if check.Path == "" {
+		return check
+	}
+
+	check.Status, check.Err = s.gitService.GetWorktreeStatus(ctx, check.Path)
+	if check.Err != nil {
+		logging.Logger.Warn("Failed to check worktree for local work", "path", check.Path, "error", check.Err)
+	}
+	return check
+}
+
+// Kill asks the agent to exit, kills the session's tmux sessions, forgets the session,
+// and then removes its worktree if requested
+func (s *Service) Kill(ctx context.Context, name string, opts KillOptions) (Outcome, error) {
+	logging.Logger.Info("Killing session", "session", name, "keepTmux", opts.KeepTmux, "removeWorktree", opts.Worktree.Remove)
+
+	session, err := s.sessionService.GetSession(ctx, name)
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	if opts.KeepTmux {
+		err = s.sessionService.DeleteSession(ctx, name, services.DeleteSessionOptions{})
+	} else {
+		err = s.sessionService.KillSession(ctx, name, opts.ShutdownTimeout)
+	}
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	// The agent is gone, so nothing writes to the worktree anymore
+	return s.removeWorktree(ctx, session, opts.Worktree), nil
+}
+
+// Archive removes the session's worktree if requested and hides the session from the list
+// A failed worktree removal is reported in the outcome and does not stop the archive
+func (s *Service) Archive(ctx context.Context, name string, worktree WorktreeRemoval) (Outcome, error) {
+	logging.Logger.Info("Archiving session", "session", name, "removeWorktree", worktree.Remove)
+
+	session, err := s.sessionService.GetSession(ctx, name)
+	if err != nil {
+		return Outcome{}, err
+	}
+
+	outcome := s.removeWorktree(ctx, session, worktree)
+	if err := s.sessionService.ArchiveSession(ctx, name, false); err != nil {
+		return outcome, err
+	}
+	return outcome, nil
+}
+
+// removeWorktree applies the worktree confirmation policy: a worktree with local work
+// (or an unknown status) is only removed when the user accepted discarding it. Sessions with
+// checkpoints get a last one first, and keep their worktree when it cannot be saved.
+func (s *Service) removeWorktree(ctx context.Context, session *domain.Session, worktree WorktreeRemoval) Outcome {
+	if !worktree.Remove || session.WorktreePath == "" {
+		return Outcome{}
+	}
+
+	outcome := Outcome{WorktreePath: session.WorktreePath}
+	if !worktree.Discard && s.CheckWorktree(ctx, session).NeedsDiscardConfirmation() {
+		logging.Logger.Info("Keeping worktree with local work", "session", session.Name, "path", session.WorktreePath)
+		outcome.WorktreeKept = true
+		return outcome
+	}
+
+	if session.Checkpoint != nil {
+		if _, err := s.checkpointService.Save(ctx, *session); err != nil {
+			logging.Logger.Error("Failed to checkpoint worktree", "session", session.Name, "path", session.WorktreePath, "error", err)
+			outcome.WorktreeErr = fmt.Errorf("failed to checkpoint worktree before removing it: %w", err)
+			return outcome
+		}
+	}
+
+	logging.Logger.Info("Removing worktree", "session", session.Name, "path", session.WorktreePath)
+	if err := s.gitService.RemoveWorktree(session.RepoPath, session.WorktreePath); err != nil {
+		logging.Logger.Error("Failed to remove worktree", "session", session.Name, "path", session.WorktreePath, "error", err)
+		outcome.WorktreeErr = fmt.Errorf("failed to remove worktree: %w", err)
+		return outcome
+	}
+	outcome.WorktreeRemoved = true
+	return outcome
+}
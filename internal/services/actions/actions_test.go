@@ -0,0 +1,141 @@
+package actions
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+	"github.com/renato0307/rocha/internal/services"
+	servicesmocks "github.com/renato0307/rocha/internal/services/mocks"
+)
+
+// newTestService wires the actions service to mocked ports
+func newTestService(t *testing.T) (*Service, *portsmocks.MockSessionRepository, *portsmocks.MockGitRepository, *portsmocks.MockTmuxSessionLifecycle) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	sessionService := services.NewSessionService(sessionRepo, gitRepo, tmuxClient, servicesmocks.NewMockClaudeDirResolver(t),
+		portsmocks.NewMockProcessInspector(t), eventPublisher, servicesmocks.NewMockWorktreeBootstrapper(t))
+	return NewService(sessionService, services.NewGitService(gitRepo), services.NewCheckpointService(sessionRepo, gitRepo)), sessionRepo, gitRepo, tmuxClient
+}
+
+func TestKill_WorktreeConfirmationPolicy(t *testing.T) {
+	dirty := &domain.WorktreeStatus{UncommittedFiles: []string{" M main.go"}}
+
+	tests := []struct {
+		name        string
+		worktree    WorktreeRemoval
+		status      *domain.WorktreeStatus
+		statusErr   error
+		wantRemoved bool
+		wantKept    bool
+	}{
+		{name: "keep worktree", worktree: WorktreeRemoval{}},
+		{name: "remove clean worktree", worktree: WorktreeRemoval{Remove: true}, status: &domain.WorktreeStatus{}, wantRemoved: true},
+		{name: "keep worktree with local work", worktree: WorktreeRemoval{Remove: true}, status: dirty, wantKept: true},
+		{name: "keep worktree that cannot be checked", worktree: WorktreeRemoval{Remove: true}, statusErr: errors.New("git failed"), wantKept: true},
+		{name: "discard local work", worktree: WorktreeRemoval{Remove: true, Discard: true}, wantRemoved: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, sessionRepo, gitRepo, tmuxClient := newTestService(t)
+			session := &domain.Session{Name: "s1", RepoPath: "/repo", WorktreePath: "/worktrees/s1"}
+
+			sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(session, nil)
+			tmuxClient.EXPECT().KillSession("s1").Return(nil)
+			sessionRepo.EXPECT().Delete(mock.Anything, "s1").Return(nil)
+			if tt.status != nil || tt.statusErr != nil {
+				gitRepo.EXPECT().GetWorktreeStatus(mock.Anything, "/worktrees/s1").Return(tt.status, tt.statusErr)
+			}
+			if tt.wantRemoved {
+				gitRepo.EXPECT().RemoveWorktree("/repo", "/worktrees/s1").Return(nil)
+			}
+
+			outcome, err := service.Kill(context.Background(), "s1", KillOptions{Worktree: tt.worktree})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRemoved, outcome.WorktreeRemoved)
+			assert.Equal(t, tt.wantKept, outcome.WorktreeKept)
+		})
+	}
+}
+
+func TestKill_KeepTmuxOnlyDeletesSession(t *testing.T) {
+	service, sessionRepo, _, _ := newTestService(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1"}, nil)
+	sessionRepo.EXPECT().Delete(mock.Anything, "s1").Return(nil)
+
+	_, err := service.Kill(context.Background(), "s1", KillOptions{KeepTmux: true})
+
+	require.NoError(t, err)
+}
+
+func TestKill_UnknownSessionFails(t *testing.T) {
+	service, sessionRepo, _, _ := newTestService(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "missing").Return(nil, domain.ErrSessionNotFound)
+
+	_, err := service.Kill(context.Background(), "missing", KillOptions{})
+
+	assert.ErrorIs(t, err, domain.ErrSessionNotFound)
+}
+
+func TestArchive_WorktreeFailureDoesNotStopArchive(t *testing.T) {
+	service, sessionRepo, gitRepo, _ := newTestService(t)
+	session := &domain.Session{Name: "s1", RepoPath: "/repo", WorktreePath: "/worktrees/s1"}
+	sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(session, nil)
+	gitRepo.EXPECT().RemoveWorktree("/repo", "/worktrees/s1").Return(errors.New("locked"))
+	sessionRepo.EXPECT().ToggleArchive(mock.Anything, "s1").Return(nil)
+
+	outcome, err := service.Archive(context.Background(), "s1", WorktreeRemoval{Remove: true, Discard: true})
+
+	require.NoError(t, err)
+	assert.False(t, outcome.WorktreeRemoved)
+	assert.ErrorContains(t, outcome.WorktreeErr, "locked")
+}
+
+func TestArchive_CheckpointsBeforeRemovingWorktree(t *testing.T) {
+	tests := []struct {
+		name          string
+		checkpointErr error
+		wantRemoved   bool
+	}{
+		{name: "removes worktree after checkpoint", wantRemoved: true},
+		{name: "keeps worktree when checkpoint fails", checkpointErr: errors.New("disk full")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, sessionRepo, gitRepo, _ := newTestService(t)
+			session := &domain.Session{
+				Checkpoint:   &domain.CheckpointPolicy{Target: domain.CheckpointTargetBranch},
+				Name:         "s1",
+				RepoPath:     "/repo",
+				WorktreePath: "/worktrees/s1",
+			}
+			sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(session, nil)
+			gitRepo.EXPECT().CommitCheckpoint(mock.Anything, "/worktrees/s1", "rocha/checkpoints/s1", mock.Anything).Return(true, tt.checkpointErr)
+			if tt.wantRemoved {
+				gitRepo.EXPECT().RemoveWorktree("/repo", "/worktrees/s1").Return(nil)
+			}
+			sessionRepo.EXPECT().ToggleArchive(mock.Anything, "s1").Return(nil)
+
+			outcome, err := service.Archive(context.Background(), "s1", WorktreeRemoval{Remove: true, Discard: true})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRemoved, outcome.WorktreeRemoved)
+			if tt.checkpointErr != nil {
+				assert.ErrorContains(t, outcome.WorktreeErr, "disk full")
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// ActivityStatsService builds session activity statistics from recorded events
+type ActivityStatsService struct {
+	eventRepo ports.EventRepository
+}
+
+// NewActivityStatsService creates a new ActivityStatsService
+func NewActivityStatsService(eventRepo ports.EventRepository) *ActivityStatsService {
+	return &ActivityStatsService{
+		eventRepo: eventRepo,
+	}
+}
+
+// GetActivityHeatmap counts state transitions per hour over the given number of days ending today.
+// A non-empty state only counts transitions into that state.
+func (s *ActivityStatsService) GetActivityHeatmap(ctx context.Context, days int, state domain.SessionState, now time.Time) (domain.ActivityHeatmap, error) {
+	logging.Logger.Debug("Building activity heatmap", "days", days, "state", state)
+
+	if days < 1 {
+		return domain.ActivityHeatmap{}, fmt.Errorf("days must be at least 1: %w", domain.ErrInvalidInput)
+	}
+
+	since := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).AddDate(0, 0, 1-days)
+	events, err := s.eventRepo.ListEvents(ctx, domain.EventStateChange, since)
+	if err != nil {
+		return domain.ActivityHeatmap{}, fmt.Errorf("failed to list events: %w", err)
+	}
+
+	if state != "" {
+		filtered := events[:0]
+		for _, event := range events {
+			if event.State == state {
+				filtered = append(filtered, event)
+			}
+		}
+		events = filtered
+	}
+
+	return domain.NewActivityHeatmap(events, now, days), nil
+}
+
+// ActivitySparklineWindow is the period covered by the activity sparklines of the session list
+const ActivitySparklineWindow = time.Hour
+
+// ActivitySparklineBuckets is the number of bars of an activity sparkline
+const ActivitySparklineBuckets = 8
+
+// GetActivitySparklines builds the activity sparkline of the window ending at now for each
+// session that worked during it, by session name
+func (s *ActivityStatsService) GetActivitySparklines(ctx context.Context, window time.Duration, buckets int, now time.Time) (map[string]domain.ActivitySparkline, error) {
+	since := now.Add(-window)
+
+	initialEvents, err := s.eventRepo.ListLatestEventsBefore(ctx, domain.EventStateChange, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	initialStates := make(map[string]domain.SessionState, len(initialEvents))
+	for _, event := range initialEvents {
+		initialStates[event.SessionName] = event.State
+	}
+
+	events, err := s.eventRepo.ListEvents(ctx, domain.EventStateChange, since)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events: %w", err)
+	}
+	bySession := make(map[string][]domain.Event)
+	for _, event := range events {
+		bySession[event.SessionName] = append(bySession[event.SessionName], event)
+	}
+
+	// Sessions working since before the window have no events in it
+	names := make(map[string]bool, len(bySession))
+	for name, state := range initialStates {
+		names[name] = state == domain.StateWorking
+	}
+	for name := range bySession {
+		names[name] = true
+	}
+
+	sparklines := make(map[string]domain.ActivitySparkline)
+	for name, active := range names {
+		if !active {
+			continue
+		}
+		sparkline := domain.NewActivitySparkline(initialStates[name], bySession[name], since, now, buckets)
+		if sparkline.Busy() > 0 {
+			sparklines[name] = sparkline
+		}
+	}
+	return sparklines, nil
+}
+
+// LatestHandoff returns the most recent handoff note of a session, or nil if it has none
+func (s *ActivityStatsService) LatestHandoff(ctx context.Context, sessionName string) (*domain.Event, error) {
+	event, err := s.eventRepo.LatestSessionEvent(ctx, sessionName, domain.EventHandoff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get handoff note: %w", err)
+	}
+	return event, nil
+}
+
+// RecentSessionEvents returns up to limit of the most recent events of a session, newest first
+func (s *ActivityStatsService) RecentSessionEvents(ctx context.Context, sessionName string, limit int) ([]domain.Event, error) {
+	events, err := s.eventRepo.ListSessionEvents(ctx, sessionName, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list session events: %w", err)
+	}
+	return events, nil
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestGetActivityHeatmap(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	since := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+	events := []domain.Event{
+		{Type: domain.EventStateChange, State: domain.StateWorking, Timestamp: time.Date(2026, 3, 9, 10, 0, 0, 0, time.UTC)},
+		{Type: domain.EventStateChange, State: domain.StateWaiting, Timestamp: time.Date(2026, 3, 9, 10, 30, 0, 0, time.UTC)},
+		{Type: domain.EventStateChange, State: domain.StateWaiting, Timestamp: time.Date(2026, 3, 10, 14, 0, 0, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name      string
+		state     domain.SessionState
+		wantTotal int
+	}{
+		{name: "all transitions", state: "", wantTotal: 3},
+		{name: "only waiting", state: domain.StateWaiting, wantTotal: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := portsmocks.NewMockEventRepository(t)
+			eventRepo.EXPECT().ListEvents(context.Background(), domain.EventStateChange, since).
+				Return(append([]domain.Event(nil), events...), nil)
+
+			service := NewActivityStatsService(eventRepo)
+			heatmap, err := service.GetActivityHeatmap(context.Background(), 7, tt.state, now)
+
+			require.NoError(t, err)
+			assert.Len(t, heatmap.Days, 7)
+			assert.Equal(t, tt.wantTotal, heatmap.Total)
+		})
+	}
+}
+
+func TestGetActivityHeatmap_InvalidDays(t *testing.T) {
+	service := NewActivityStatsService(portsmocks.NewMockEventRepository(t))
+
+	_, err := service.GetActivityHeatmap(context.Background(), 0, "", time.Now())
+
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestGetActivityHeatmap_RepositoryError(t *testing.T) {
+	eventRepo := portsmocks.NewMockEventRepository(t)
+	eventRepo.EXPECT().ListEvents(context.Background(), domain.EventStateChange, time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)).
+		Return(nil, errors.New("database locked"))
+
+	service := NewActivityStatsService(eventRepo)
+	_, err := service.GetActivityHeatmap(context.Background(), 1, "", time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC))
+
+	require.Error(t, err)
+}
+
+func TestRecentSessionEvents(t *testing.T) {
+	events := []domain.Event{
+		{SessionName: "s1", Type: domain.EventStateChange, State: domain.StateWaiting},
+		{SessionName: "s1", Type: domain.EventError, Error: "hook failed"},
+	}
+	eventRepo := portsmocks.NewMockEventRepository(t)
+	eventRepo.EXPECT().ListSessionEvents(context.Background(), "s1", 5).Return(events, nil)
+
+	service := NewActivityStatsService(eventRepo)
+	got, err := service.RecentSessionEvents(context.Background(), "s1", 5)
+
+	require.NoError(t, err)
+	assert.Equal(t, events, got)
+}
+
+func TestGetActivitySparklines(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+	since := now.Add(-time.Hour)
+	eventRepo := portsmocks.NewMockEventRepository(t)
+	eventRepo.EXPECT().ListLatestEventsBefore(context.Background(), domain.EventStateChange, since).Return([]domain.Event{
+		{SessionName: "busy", Type: domain.EventStateChange, State: domain.StateWorking},
+		{SessionName: "stuck", Type: domain.EventStateChange, State: domain.StateWaiting},
+	}, nil)
+	eventRepo.EXPECT().ListEvents(context.Background(), domain.EventStateChange, since).Return([]domain.Event{
+		{SessionName: "recent", Type: domain.EventStateChange, State: domain.StateWorking, Timestamp: now.Add(-15 * time.Minute)},
+		{SessionName: "stuck", Type: domain.EventStateChange, State: domain.StateWaiting, Timestamp: now.Add(-50 * time.Minute)},
+	}, nil)
+
+	service := NewActivityStatsService(eventRepo)
+	sparklines, err := service.GetActivitySparklines(context.Background(), time.Hour, 4, now)
+
+	require.NoError(t, err)
+	assert.Len(t, sparklines, 2, "sessions that did not work are left out")
+	assert.InDeltaSlice(t, []float64{1, 1, 1, 1}, sparklines["busy"], 0.001)
+	assert.InDeltaSlice(t, []float64{0, 0, 0, 1}, sparklines["recent"], 0.001)
+}
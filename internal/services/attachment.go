@@ -0,0 +1,229 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// AttachmentService keeps files such as screenshots and design mockups with sessions,
+// opens them in the system viewer, and hands them to the agent
+type AttachmentService struct {
+	attachmentRepo   ports.AttachmentRepository
+	schedulerService *SchedulerService
+	sessionReader    ports.SessionReader
+	viewer           ports.FileViewer
+}
+
+// NewAttachmentService creates a new AttachmentService
+func NewAttachmentService(
+	attachmentRepo ports.AttachmentRepository,
+	sessionReader ports.SessionReader,
+	viewer ports.FileViewer,
+	schedulerService *SchedulerService,
+) *AttachmentService {
+	return &AttachmentService{
+		attachmentRepo:   attachmentRepo,
+		schedulerService: schedulerService,
+		sessionReader:    sessionReader,
+		viewer:           viewer,
+	}
+}
+
+// Attach records files as attachments of a session. Each file must exist; attaching a file
+// again refreshes its size and type.
+func (s *AttachmentService) Attach(ctx context.Context, sessionName string, paths []string, now time.Time) ([]domain.SessionAttachment, error) {
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("%w: no files to attach", domain.ErrInvalidInput)
+	}
+	if _, err := s.sessionReader.Get(ctx, sessionName); err != nil {
+		return nil, err
+	}
+
+	// Check every file before storing any of them
+	attachments := make([]domain.SessionAttachment, 0, len(paths))
+	for _, path := range paths {
+		attachment, err := newAttachment(sessionName, path, now)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, attachment)
+	}
+
+	for _, attachment := range attachments {
+		logging.Logger.Info("Attaching file to session", "session", sessionName, "path", attachment.Path, "media_type", attachment.MediaType)
+		if err := s.attachmentRepo.AddAttachment(ctx, attachment); err != nil {
+			return nil, err
+		}
+	}
+	return attachments, nil
+}
+
+// List returns the attachments of a session, oldest first
+func (s *AttachmentService) List(ctx context.Context, sessionName string) ([]domain.SessionAttachment, error) {
+	if _, err := s.sessionReader.Get(ctx, sessionName); err != nil {
+		return nil, err
+	}
+	return s.attachmentRepo.ListAttachments(ctx, sessionName)
+}
+
+// Remove forgets an attachment of a session; the file itself is left alone.
+// ref is the attachment's path or, when no other attachment has the same one, its file name.
+func (s *AttachmentService) Remove(ctx context.Context, sessionName, ref string) (*domain.SessionAttachment, error) {
+	attachment, err := s.find(ctx, sessionName, ref)
+	if err != nil {
+		return nil, err
+	}
+	logging.Logger.Info("Removing session attachment", "session", sessionName, "path", attachment.Path)
+	if err := s.attachmentRepo.RemoveAttachment(ctx, sessionName, attachment.Path); err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// Open opens an attachment of a session in the system viewer; ref is as for Remove
+func (s *AttachmentService) Open(ctx context.Context, sessionName, ref string) error {
+	attachment, err := s.find(ctx, sessionName, ref)
+	if err != nil {
+		return err
+	}
+	return s.viewer.OpenFile(attachment.Path)
+}
+
+// AttachmentHandOff reports which attachments were handed to the agent
+type AttachmentHandOff struct {
+	Queued  *domain.ScheduledPrompt    // Set when the concurrency limit queued the prompt
+	Sent    []domain.SessionAttachment // Attachments named in the prompt
+	Skipped []domain.SessionAttachment // Attachments the agent cannot read, or whose file is gone
+}
+
+// SendToAgent asks the session's agent to look at attachments, all of them when refs is empty.
+// Only existing files the agent can read are sent; the prompt is queued when the concurrency limit is reached.
+func (s *AttachmentService) SendToAgent(ctx context.Context, sessionName string, refs []string, now time.Time) (*AttachmentHandOff, error) {
+	var attachments []domain.SessionAttachment
+	if len(refs) == 0 {
+		var err error
+		if attachments, err = s.List(ctx, sessionName); err != nil {
+			return nil, err
+		}
+	} else {
+		for _, ref := range refs {
+			attachment, err := s.find(ctx, sessionName, ref)
+			if err != nil {
+				return nil, err
+			}
+			attachments = append(attachments, *attachment)
+		}
+	}
+
+	handOff := &AttachmentHandOff{}
+	for _, attachment := range attachments {
+		if _, err := os.Stat(attachment.Path); err == nil && attachment.IsAgentReadable() {
+			handOff.Sent = append(handOff.Sent, attachment)
+		} else {
+			handOff.Skipped = append(handOff.Skipped, attachment)
+		}
+	}
+	if len(handOff.Sent) == 0 {
+		return handOff, fmt.Errorf("%w: session '%s' has no attachments the agent can read (images, PDFs, or text files)", domain.ErrInvalidInput, sessionName)
+	}
+
+	logging.Logger.Info("Sending attachments to agent", "session", sessionName, "count", len(handOff.Sent), "skipped", len(handOff.Skipped))
+	queued, err := s.schedulerService.SendOrQueue(ctx, sessionName, domain.AttachmentPrompt(handOff.Sent), false, now)
+	if err != nil {
+		return nil, err
+	}
+	handOff.Queued = queued
+	return handOff, nil
+}
+
+// find returns the attachment of a session matching ref by path or, when unique, by file name
+func (s *AttachmentService) find(ctx context.Context, sessionName, ref string) (*domain.SessionAttachment, error) {
+	attachments, err := s.List(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	absRef, _ := filepath.Abs(config.ExpandPath(ref))
+	var byName []domain.SessionAttachment
+	for _, attachment := range attachments {
+		if attachment.Path == ref || attachment.Path == absRef {
+			return &attachment, nil
+		}
+		if attachment.Name == ref {
+			byName = append(byName, attachment)
+		}
+	}
+
+	switch len(byName) {
+	case 0:
+		return nil, fmt.Errorf("%w: %s", domain.ErrAttachmentNotFound, ref)
+	case 1:
+		return &byName[0], nil
+	default:
+		return nil, fmt.Errorf("%w: %d attachments are named %s; use the full path", domain.ErrInvalidInput, len(byName), ref)
+	}
+}
+
+// newAttachment describes the file at path as an attachment of a session
+func newAttachment(sessionName, path string, now time.Time) (domain.SessionAttachment, error) {
+	absPath, err := filepath.Abs(config.ExpandPath(path))
+	if err != nil {
+		return domain.SessionAttachment{}, fmt.Errorf("%w: invalid path %q: %v", domain.ErrInvalidInput, path, err)
+	}
+	info, err := os.Stat(absPath)
+	if err != nil {
+		return domain.SessionAttachment{}, fmt.Errorf("%w: %s does not exist", domain.ErrInvalidInput, absPath)
+	}
+	if !info.Mode().IsRegular() {
+		return domain.SessionAttachment{}, fmt.Errorf("%w: %s is not a file", domain.ErrInvalidInput, absPath)
+	}
+
+	return domain.SessionAttachment{
+		AddedAt:     now,
+		MediaType:   detectMediaType(absPath),
+		Name:        filepath.Base(absPath),
+		Path:        absPath,
+		SessionName: sessionName,
+		Size:        info.Size(),
+	}, nil
+}
+
+// detectMediaType returns the MIME type of a file from its extension or, failing that, its first bytes
+func detectMediaType(path string) string {
+	mediaType := mime.TypeByExtension(filepath.Ext(path))
+	if mediaType == "" {
+		mediaType = sniffMediaType(path)
+	}
+	if parsed, _, err := mime.ParseMediaType(mediaType); err == nil {
+		return parsed
+	}
+	return mediaType
+}
+
+// sniffMediaType detects the MIME type of a file from its content
+func sniffMediaType(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return "application/octet-stream"
+	}
+	defer file.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return "application/octet-stream"
+	}
+	return http.DetectContentType(head[:n])
+}
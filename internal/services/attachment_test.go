@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestAttachmentService_Attach(t *testing.T) {
+	dir := t.TempDir()
+	screenshot := filepath.Join(dir, "bug.png")
+	notes := filepath.Join(dir, "notes")
+	require.NoError(t, os.WriteFile(screenshot, []byte("\x89PNG\r\n\x1a\n"), 0644))
+	require.NoError(t, os.WriteFile(notes, []byte("plain text notes"), 0644))
+	now := time.Now()
+
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	sessionReader.EXPECT().Get(mock.Anything, "api").Return(&domain.Session{Name: "api"}, nil)
+	attachmentRepo := portsmocks.NewMockAttachmentRepository(t)
+	attachmentRepo.EXPECT().AddAttachment(mock.Anything, domain.SessionAttachment{
+		AddedAt: now, MediaType: "image/png", Name: "bug.png", Path: screenshot, SessionName: "api", Size: 8,
+	}).Return(nil)
+	attachmentRepo.EXPECT().AddAttachment(mock.Anything, domain.SessionAttachment{
+		AddedAt: now, MediaType: "text/plain", Name: "notes", Path: notes, SessionName: "api", Size: 16,
+	}).Return(nil)
+
+	service := NewAttachmentService(attachmentRepo, sessionReader, portsmocks.NewMockFileViewer(t), nil)
+
+	attachments, err := service.Attach(context.Background(), "api", []string{screenshot, notes}, now)
+	require.NoError(t, err)
+	assert.Len(t, attachments, 2)
+
+	// Nothing is stored when one of the files is missing
+	_, err = service.Attach(context.Background(), "api", []string{screenshot, filepath.Join(dir, "missing.png")}, now)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	_, err = service.Attach(context.Background(), "api", []string{dir}, now)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "directories cannot be attached")
+}
+
+func TestAttachmentService_OpenAndRemoveByName(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	sessionReader.EXPECT().Get(mock.Anything, "api").Return(&domain.Session{Name: "api"}, nil)
+	attachmentRepo := portsmocks.NewMockAttachmentRepository(t)
+	attachmentRepo.EXPECT().ListAttachments(mock.Anything, "api").Return([]domain.SessionAttachment{
+		{Name: "bug.png", Path: "/shots/bug.png"},
+		{Name: "login.png", Path: "/shots/v1/login.png"},
+		{Name: "login.png", Path: "/shots/v2/login.png"},
+	}, nil)
+	viewer := portsmocks.NewMockFileViewer(t)
+	viewer.EXPECT().OpenFile("/shots/bug.png").Return(nil).Once()
+	attachmentRepo.EXPECT().RemoveAttachment(mock.Anything, "api", "/shots/v2/login.png").Return(nil).Once()
+
+	service := NewAttachmentService(attachmentRepo, sessionReader, viewer, nil)
+
+	require.NoError(t, service.Open(context.Background(), "api", "bug.png"))
+	assert.ErrorIs(t, service.Open(context.Background(), "api", "login.png"), domain.ErrInvalidInput, "ambiguous name")
+	assert.ErrorIs(t, service.Open(context.Background(), "api", "other.png"), domain.ErrAttachmentNotFound)
+
+	removed, err := service.Remove(context.Background(), "api", "/shots/v2/login.png")
+	require.NoError(t, err)
+	assert.Equal(t, "/shots/v2/login.png", removed.Path)
+}
+
+func TestAttachmentService_SendToAgentSkipsUnreadableFiles(t *testing.T) {
+	dir := t.TempDir()
+	screenshot := filepath.Join(dir, "bug.png")
+	mockup := filepath.Join(dir, "mock.fig")
+	require.NoError(t, os.WriteFile(screenshot, []byte("\x89PNG\r\n\x1a\n"), 0644))
+	require.NoError(t, os.WriteFile(mockup, []byte("figma"), 0644))
+
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	sessionReader.EXPECT().Get(mock.Anything, "api").Return(&domain.Session{Name: "api"}, nil)
+	attachmentRepo := portsmocks.NewMockAttachmentRepository(t)
+	attachmentRepo.EXPECT().ListAttachments(mock.Anything, "api").Return([]domain.SessionAttachment{
+		{MediaType: "image/png", Name: "bug.png", Path: screenshot},
+		{MediaType: "application/octet-stream", Name: "mock.fig", Path: mockup},
+		{MediaType: "image/png", Name: "gone.png", Path: filepath.Join(dir, "gone.png")},
+	}, nil)
+	sessionManager := portsmocks.NewMockSessionManager(t)
+	sessionManager.EXPECT().SessionExists("api").Return(true).Once()
+	sessionManager.EXPECT().SendKeys("api", `Take a look at these files attached to this session: "`+screenshot+`"`).Return(nil).Once()
+	sessionManager.EXPECT().SendKeys("api", "C-m").Return(nil).Once()
+	historyRepo := portsmocks.NewMockPromptHistoryRepository(t)
+	historyRepo.EXPECT().AddSentPrompt(mock.Anything, mock.Anything).Return(nil).Once()
+	scheduler := NewSchedulerService(portsmocks.NewMockScheduledPromptRepository(t), historyRepo, sessionReader, sessionManager, domain.ConcurrencyLimit{})
+
+	service := NewAttachmentService(attachmentRepo, sessionReader, portsmocks.NewMockFileViewer(t), scheduler)
+
+	handOff, err := service.SendToAgent(context.Background(), "api", nil, time.Now())
+	require.NoError(t, err)
+	assert.Nil(t, handOff.Queued)
+	assert.Len(t, handOff.Sent, 1)
+	require.Len(t, handOff.Skipped, 2)
+	assert.Equal(t, "mock.fig", handOff.Skipped[0].Name)
+	assert.Equal(t, "gone.png", handOff.Skipped[1].Name)
+
+	_, err = service.SendToAgent(context.Background(), "api", []string{"mock.fig"}, time.Now())
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
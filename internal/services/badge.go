@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// DefaultBadgeInterval is how often badges are refreshed when badges.interval_seconds is not set
+const DefaultBadgeInterval = 30 * time.Second
+
+// BadgeService refreshes the custom badges a badge command adds to sessions in the TUI
+// list, such as "tests: red", at most once per interval
+type BadgeService struct {
+	interval time.Duration
+	lastRun  time.Time
+	mu       sync.Mutex
+	provider ports.BadgeProvider // nil turns badges off
+}
+
+// NewBadgeService creates a new BadgeService asking provider every interval (nil provider = off)
+func NewBadgeService(provider ports.BadgeProvider, interval time.Duration) *BadgeService {
+	return &BadgeService{
+		interval: interval,
+		provider: provider,
+	}
+}
+
+// Due returns true if badges are on and the last refresh is older than the interval
+func (s *BadgeService) Due(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.provider != nil && now.Sub(s.lastRun) >= s.interval
+}
+
+// Refresh returns the badges of the sessions, by session name, kept within the limits of
+// domain.LimitBadges. Sessions without badges are left out.
+func (s *BadgeService) Refresh(ctx context.Context, sessions []domain.Session, now time.Time) (map[string][]domain.Badge, error) {
+	s.mu.Lock()
+	s.lastRun = now
+	s.mu.Unlock()
+
+	if s.provider == nil {
+		return nil, nil
+	}
+
+	badges, err := s.provider.Badges(ctx, sessions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh badges: %w", err)
+	}
+
+	limited := make(map[string][]domain.Badge, len(badges))
+	for name, sessionBadges := range badges {
+		if kept := domain.LimitBadges(sessionBadges); len(kept) > 0 {
+			limited[name] = kept
+		}
+	}
+	logging.Logger.Debug("Badges refreshed", "sessions", len(limited))
+	return limited, nil
+}
@@ -0,0 +1,56 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestBadgeService_Refresh(t *testing.T) {
+	sessions := []domain.Session{{Name: "api"}, {Name: "web"}}
+
+	t.Run("limits the badges of each session", func(t *testing.T) {
+		provider := portsmocks.NewMockBadgeProvider(t)
+		provider.EXPECT().Badges(mock.Anything, sessions).Return(map[string][]domain.Badge{
+			"api": {{Color: "196", Text: "tests: red"}, {Text: "a"}, {Text: "b"}, {Text: "c"}},
+			"web": {{Text: " "}},
+		}, nil)
+
+		service := NewBadgeService(provider, time.Minute)
+		now := time.Now()
+		require.True(t, service.Due(now))
+
+		badges, err := service.Refresh(context.Background(), sessions, now)
+
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]domain.Badge{"api": {{Color: "196", Text: "tests: red"}, {Text: "a"}, {Text: "b"}}}, badges)
+		assert.False(t, service.Due(now.Add(30*time.Second)))
+		assert.True(t, service.Due(now.Add(time.Minute)))
+	})
+
+	t.Run("waits for the interval after a failure", func(t *testing.T) {
+		provider := portsmocks.NewMockBadgeProvider(t)
+		provider.EXPECT().Badges(mock.Anything, sessions).Return(nil, errors.New("no token"))
+
+		service := NewBadgeService(provider, time.Minute)
+		now := time.Now()
+
+		_, err := service.Refresh(context.Background(), sessions, now)
+
+		assert.ErrorContains(t, err, "no token")
+		assert.False(t, service.Due(now.Add(time.Second)))
+	})
+}
+
+func TestBadgeService_DisabledWithoutProvider(t *testing.T) {
+	service := NewBadgeService(nil, time.Minute)
+	assert.False(t, service.Due(time.Now()))
+}
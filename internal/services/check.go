@@ -0,0 +1,183 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// CheckOutputLimit caps how much of the output of a check run is read, keeping its end
+const CheckOutputLimit = 256 * 1024
+
+// checkWindowName names the temporary window the checks run in
+const checkWindowName = "checks"
+
+// Files of a check run, in the directory of the session under the checks directory
+const (
+	checkCommandFile = "command.sh"
+	checkExitFile    = "exit_code"
+	checkOutputFile  = "output.log"
+	checkScriptFile  = "run.sh"
+)
+
+// CheckService runs the checks command of a repository (such as make test) in the working
+// directory of a session, and reads back how the last run went
+type CheckService struct {
+	checksDir      string
+	commands       map[string]string // By repository (owner/repo), "*" for all
+	sessionManager ports.TmuxSessionLifecycle
+}
+
+// NewCheckService creates a new CheckService capturing runs under checksDir
+func NewCheckService(sessionManager ports.TmuxSessionLifecycle, commands map[string]string, checksDir string) *CheckService {
+	return &CheckService{
+		checksDir:      checksDir,
+		commands:       commands,
+		sessionManager: sessionManager,
+	}
+}
+
+// Command returns the checks command of the repository of a session, "" when none is configured
+func (s *CheckService) Command(session domain.Session) string {
+	if command, ok := s.commands[session.RepoInfo]; ok && session.RepoInfo != "" {
+		return command
+	}
+	return s.commands["*"]
+}
+
+// Run starts the checks of a session in a temporary window of its tmux session, replacing
+// the last run. The window closes when the checks finish; Last reads how they went.
+func (s *CheckService) Run(ctx context.Context, session domain.Session) (*domain.CheckRun, error) {
+	logging.Logger.Debug("Running session checks", "name", session.Name)
+
+	command := s.Command(session)
+	if command == "" {
+		return nil, fmt.Errorf("%w: no checks command for '%s' (set checks in settings.json)", domain.ErrInvalidInput, cmp.Or(session.RepoInfo, session.Name))
+	}
+	dir := session.WorkingDir()
+	if dir == "" {
+		return nil, fmt.Errorf("%w: session '%s' has no working directory to run checks in", domain.ErrInvalidInput, session.Name)
+	}
+	if !s.sessionManager.SessionExists(session.Name) {
+		return nil, fmt.Errorf("%w: session '%s' is not running", domain.ErrInvalidInput, session.Name)
+	}
+
+	runDir := filepath.Join(s.checksDir, session.Name)
+	if err := os.RemoveAll(runDir); err != nil {
+		return nil, fmt.Errorf("failed to clear last check run: %w", err)
+	}
+	if err := os.MkdirAll(runDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create check run directory: %w", err)
+	}
+
+	commandPath := filepath.Join(runDir, checkCommandFile)
+	exitPath := filepath.Join(runDir, checkExitFile)
+	outputPath := filepath.Join(runDir, checkOutputFile)
+	scriptPath := filepath.Join(runDir, checkScriptFile)
+
+	// The exit code is moved in place after tee finishes, so the output is complete once it exists
+	script := fmt.Sprintf("{ sh %s 2>&1; echo $? > %s.tmp; } | tee %s\nmv %s.tmp %s\n",
+		domain.ShellQuote(commandPath), domain.ShellQuote(exitPath), domain.ShellQuote(outputPath), domain.ShellQuote(exitPath), domain.ShellQuote(exitPath))
+	if err := os.WriteFile(commandPath, []byte(command+"\n"), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write checks command: %w", err)
+	}
+	if err := os.WriteFile(scriptPath, []byte(script), 0644); err != nil {
+		return nil, fmt.Errorf("failed to write checks script: %w", err)
+	}
+
+	startedAt := time.Now()
+	if err := s.sessionManager.RunInWindow(session.Name, checkWindowName, dir, "sh "+domain.ShellQuote(scriptPath)); err != nil {
+		return nil, fmt.Errorf("failed to start checks: %w", err)
+	}
+	logging.Logger.Info("Started session checks", "name", session.Name, "command", command)
+	return &domain.CheckRun{Command: command, OutputPath: outputPath, StartedAt: startedAt}, nil
+}
+
+// Last returns the last check run of a session, nil when the checks never ran
+func (s *CheckService) Last(name string) (*domain.CheckRun, error) {
+	runDir := filepath.Join(s.checksDir, name)
+	commandPath := filepath.Join(runDir, checkCommandFile)
+
+	command, err := os.ReadFile(commandPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checks command: %w", err)
+	}
+	info, err := os.Stat(commandPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checks command: %w", err)
+	}
+	run := &domain.CheckRun{
+		Command:    strings.TrimSpace(string(command)),
+		OutputPath: filepath.Join(runDir, checkOutputFile),
+		StartedAt:  info.ModTime(),
+	}
+
+	exitPath := filepath.Join(runDir, checkExitFile)
+	exitCode, err := os.ReadFile(exitPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return run, nil // Still running
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checks exit code: %w", err)
+	}
+	if info, err = os.Stat(exitPath); err != nil {
+		return nil, fmt.Errorf("failed to read checks exit code: %w", err)
+	}
+	run.ExitCode, err = strconv.Atoi(strings.TrimSpace(string(exitCode)))
+	if err != nil {
+		return nil, fmt.Errorf("invalid checks exit code %q: %w", strings.TrimSpace(string(exitCode)), err)
+	}
+	finishedAt := info.ModTime()
+	run.FinishedAt = &finishedAt
+	return run, nil
+}
+
+// LastRuns returns the last check run of each of the sessions that ran checks, by session name.
+// Runs that cannot be read are logged and left out.
+func (s *CheckService) LastRuns(names []string) map[string]*domain.CheckRun {
+	runs := make(map[string]*domain.CheckRun)
+	for _, name := range names {
+		run, err := s.Last(name)
+		if err != nil {
+			logging.Logger.Warn("Failed to read last check run", "session", name, "error", err)
+			continue
+		}
+		if run != nil {
+			runs[name] = run
+		}
+	}
+	return runs
+}
+
+// Output returns what a check run printed so far, keeping the last CheckOutputLimit bytes.
+// truncated reports whether the start was left out.
+func (s *CheckService) Output(run *domain.CheckRun) (output string, truncated bool, err error) {
+	data, err := os.ReadFile(run.OutputPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("failed to read checks output: %w", err)
+	}
+	if len(data) > CheckOutputLimit {
+		data = data[len(data)-CheckOutputLimit:]
+		if i := strings.IndexByte(string(data), '\n'); i >= 0 {
+			data = data[i+1:] // Start at a whole line
+		}
+		truncated = true
+	}
+	return string(data), truncated, nil
+}
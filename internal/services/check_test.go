@@ -0,0 +1,121 @@
+package services
+
+import (
+	"context"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestCheckService_Command(t *testing.T) {
+	commands := map[string]string{"*": "make test", "owner/go": "go test ./..."}
+	service := NewCheckService(portsmocks.NewMockTmuxSessionLifecycle(t), commands, t.TempDir())
+
+	assert.Equal(t, "go test ./...", service.Command(domain.Session{RepoInfo: "owner/go"}))
+	assert.Equal(t, "make test", service.Command(domain.Session{RepoInfo: "owner/other"}))
+	assert.Equal(t, "make test", service.Command(domain.Session{}))
+
+	service = NewCheckService(portsmocks.NewMockTmuxSessionLifecycle(t), map[string]string{"owner/go": "go test ./..."}, t.TempDir())
+	assert.Empty(t, service.Command(domain.Session{RepoInfo: "owner/other"}))
+}
+
+func TestCheckService_RunCapturesOutcome(t *testing.T) {
+	tests := []struct {
+		name      string
+		command   string
+		wantState domain.CheckState
+		wantCode  int
+	}{
+		{name: "passed", command: "echo all good", wantState: domain.CheckStatePassed},
+		{name: "failed", command: "echo broken; exit 3", wantState: domain.CheckStateFailed, wantCode: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			worktree := t.TempDir()
+			session := domain.Session{Name: "s1", RepoInfo: "owner/repo", WorktreePath: worktree}
+
+			// Run the window command right away, as the temporary window would
+			var windowCommand string
+			sessionManager := portsmocks.NewMockTmuxSessionLifecycle(t)
+			sessionManager.EXPECT().SessionExists("s1").Return(true)
+			sessionManager.EXPECT().RunInWindow("s1", "checks", worktree, mock.Anything).
+				Run(func(sessionName, windowName, dir, command string) { windowCommand = command }).
+				Return(nil)
+			service := NewCheckService(sessionManager, map[string]string{"*": tt.command}, t.TempDir())
+
+			run, err := service.Run(context.Background(), session)
+			require.NoError(t, err)
+			assert.Equal(t, domain.CheckStateRunning, run.State())
+
+			last, err := service.Last("s1")
+			require.NoError(t, err)
+			assert.Equal(t, domain.CheckStateRunning, last.State(), "no exit code until the window command finishes")
+
+			window := exec.Command("sh", "-c", windowCommand)
+			window.Dir = worktree
+			require.NoError(t, window.Run())
+
+			last, err = service.Last("s1")
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantState, last.State())
+			assert.Equal(t, tt.wantCode, last.ExitCode)
+			assert.Equal(t, tt.command, last.Command)
+
+			output, truncated, err := service.Output(last)
+			require.NoError(t, err)
+			assert.False(t, truncated)
+			assert.True(t, strings.HasPrefix(output, "all good") || strings.HasPrefix(output, "broken"))
+		})
+	}
+}
+
+func TestCheckService_RunErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		commands map[string]string
+		session  domain.Session
+		running  bool
+	}{
+		{name: "no command", session: domain.Session{Name: "s1", WorktreePath: "/tmp/s1"}},
+		{name: "no working directory", commands: map[string]string{"*": "make test"}, session: domain.Session{Name: "s1"}},
+		{name: "not running", commands: map[string]string{"*": "make test"}, session: domain.Session{Name: "s1", WorktreePath: "/tmp/s1"}, running: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionManager := portsmocks.NewMockTmuxSessionLifecycle(t)
+			if tt.running {
+				sessionManager.EXPECT().SessionExists("s1").Return(false)
+			}
+			service := NewCheckService(sessionManager, tt.commands, t.TempDir())
+
+			_, err := service.Run(context.Background(), tt.session)
+			assert.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestCheckService_LastRuns(t *testing.T) {
+	checksDir := t.TempDir()
+	worktree := t.TempDir()
+	sessionManager := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionManager.EXPECT().SessionExists("ran").Return(true)
+	sessionManager.EXPECT().RunInWindow("ran", "checks", worktree, mock.Anything).Return(nil)
+	service := NewCheckService(sessionManager, map[string]string{"*": "true"}, checksDir)
+
+	_, err := service.Run(context.Background(), domain.Session{Name: "ran", WorktreePath: worktree})
+	require.NoError(t, err)
+
+	runs := service.LastRuns([]string{"ran", "never"})
+	require.Len(t, runs, 1)
+	assert.Equal(t, filepath.Join(checksDir, "ran", "output.log"), runs["ran"].OutputPath)
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// CheckpointService saves the worktree changes of sessions on their checkpoint schedule,
+// so the work of an agent survives an accidental worktree removal
+type CheckpointService struct {
+	gitRepo     ports.GitRepository
+	sessionRepo ports.SessionRepository
+}
+
+// NewCheckpointService creates a new CheckpointService
+func NewCheckpointService(sessionRepo ports.SessionRepository, gitRepo ports.GitRepository) *CheckpointService {
+	return &CheckpointService{
+		gitRepo:     gitRepo,
+		sessionRepo: sessionRepo,
+	}
+}
+
+// SetPolicy turns checkpoints on for a session, or off when policy is nil
+func (s *CheckpointService) SetPolicy(ctx context.Context, name string, policy *domain.CheckpointPolicy) error {
+	logging.Logger.Debug("Setting checkpoint policy", "name", name, "policy", policy)
+
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if policy != nil && session.WorkingDir() == "" {
+		return fmt.Errorf("%w: session '%s' has no working directory to checkpoint", domain.ErrInvalidInput, name)
+	}
+	if err := s.sessionRepo.UpdateCheckpoint(ctx, name, policy); err != nil {
+		return fmt.Errorf("failed to save checkpoint policy: %w", err)
+	}
+	return nil
+}
+
+// Due returns the sessions of the collection whose checkpoint is due at now
+func (s *CheckpointService) Due(state *domain.SessionCollection, now time.Time) []domain.Session {
+	var due []domain.Session
+	for _, name := range state.OrderedNames {
+		session := state.Sessions[name]
+		if session.Checkpoint == nil || session.WorkingDir() == "" {
+			continue
+		}
+		if session.Checkpoint.Due(session.State, session.LastUpdated, now) {
+			due = append(due, session)
+		}
+	}
+	return due
+}
+
+// Save checkpoints the worktree changes of a session right away, to the target of its policy
+// (the checkpoint branch without one). It returns false when there was nothing new to save.
+func (s *CheckpointService) Save(ctx context.Context, session domain.Session) (bool, error) {
+	dir := session.WorkingDir()
+	if dir == "" {
+		return false, fmt.Errorf("%w: session '%s' has no working directory to checkpoint", domain.ErrInvalidInput, session.Name)
+	}
+
+	target := domain.CheckpointTargetBranch
+	if session.Checkpoint != nil {
+		target = session.Checkpoint.Target
+	}
+	message := fmt.Sprintf("rocha checkpoint of %s at %s", session.Name, time.Now().UTC().Format(time.RFC3339))
+
+	if target == domain.CheckpointTargetStash {
+		return s.gitRepo.StashCheckpoint(ctx, dir, message)
+	}
+	return s.gitRepo.CommitCheckpoint(ctx, dir, domain.CheckpointBranchName(session.Name), message)
+}
+
+// Run checkpoints the given sessions and records each attempt, so a worktree that fails is
+// tried again on its next schedule rather than on every poll
+func (s *CheckpointService) Run(ctx context.Context, sessions []domain.Session) error {
+	var errs []error
+	for _, session := range sessions {
+		saved, err := s.Save(ctx, session)
+		if err != nil {
+			logging.Logger.Warn("Failed to checkpoint session", "session", session.Name, "error", err)
+			errs = append(errs, fmt.Errorf("failed to checkpoint '%s': %w", session.Name, err))
+		} else if saved {
+			logging.Logger.Info("Checkpointed session", "session", session.Name)
+		}
+
+		if err := s.sessionRepo.MarkCheckpoint(ctx, session.Name, time.Now()); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
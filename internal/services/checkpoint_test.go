@@ -0,0 +1,66 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestCheckpointService_SetPolicy(t *testing.T) {
+	policy := &domain.CheckpointPolicy{Interval: 15 * time.Minute, Target: domain.CheckpointTargetBranch}
+
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1", WorktreePath: "/wt/s1"}, nil)
+	sessionRepo.EXPECT().Get(mock.Anything, "metadata").Return(&domain.Session{Name: "metadata"}, nil)
+	sessionRepo.EXPECT().UpdateCheckpoint(mock.Anything, "s1", policy).Return(nil)
+	service := NewCheckpointService(sessionRepo, portsmocks.NewMockGitRepository(t))
+
+	require.NoError(t, service.SetPolicy(context.Background(), "s1", policy))
+	assert.ErrorIs(t, service.SetPolicy(context.Background(), "metadata", policy), domain.ErrInvalidInput)
+}
+
+func TestCheckpointService_Due(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	state := &domain.SessionCollection{
+		OrderedNames: []string{"idle", "working", "no-dir", "off"},
+		Sessions: map[string]domain.Session{
+			"idle":    {Name: "idle", Checkpoint: &domain.CheckpointPolicy{}, LastUpdated: now, State: domain.StateIdle, WorktreePath: "/wt/idle"},
+			"working": {Name: "working", Checkpoint: &domain.CheckpointPolicy{}, LastUpdated: now, State: domain.StateWorking, WorktreePath: "/wt/working"},
+			"no-dir":  {Name: "no-dir", Checkpoint: &domain.CheckpointPolicy{}, LastUpdated: now, State: domain.StateIdle},
+			"off":     {Name: "off", LastUpdated: now, State: domain.StateIdle, WorktreePath: "/wt/off"},
+		},
+	}
+	service := NewCheckpointService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockGitRepository(t))
+
+	due := service.Due(state, now)
+	require.Len(t, due, 1)
+	assert.Equal(t, "idle", due[0].Name)
+}
+
+func TestCheckpointService_Run(t *testing.T) {
+	sessions := []domain.Session{
+		{Name: "branch", Checkpoint: &domain.CheckpointPolicy{Target: domain.CheckpointTargetBranch}, WorktreePath: "/wt/branch"},
+		{Name: "stash", Checkpoint: &domain.CheckpointPolicy{Target: domain.CheckpointTargetStash}, IsExternal: true, RepoPath: "/src/stash"},
+	}
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	gitRepo.EXPECT().CommitCheckpoint(mock.Anything, "/wt/branch", "rocha/checkpoints/branch", mock.Anything).Return(true, nil)
+	gitRepo.EXPECT().StashCheckpoint(mock.Anything, "/src/stash", mock.Anything).Return(false, errors.New("git failed"))
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	// Failed attempts are recorded too, so they wait for the next schedule
+	sessionRepo.EXPECT().MarkCheckpoint(mock.Anything, "branch", mock.Anything).Return(nil)
+	sessionRepo.EXPECT().MarkCheckpoint(mock.Anything, "stash", mock.Anything).Return(nil)
+	service := NewCheckpointService(sessionRepo, gitRepo)
+
+	err := service.Run(context.Background(), sessions)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to checkpoint 'stash'")
+}
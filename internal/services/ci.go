@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// ciFailureSound is the sound event played when CI fails on the branch of an idle session
+const ciFailureSound = "ci_failure"
+
+// CIService records the CI results reported for session branches and announces failures
+type CIService struct {
+	eventPublisher ports.EventPublisher
+	sessionRepo    ports.SessionRepository
+	soundPlayer    ports.SoundPlayer
+}
+
+// NewCIService creates a new CIService
+func NewCIService(sessionRepo ports.SessionRepository, soundPlayer ports.SoundPlayer, eventPublisher ports.EventPublisher) *CIService {
+	return &CIService{
+		eventPublisher: eventPublisher,
+		sessionRepo:    sessionRepo,
+		soundPlayer:    soundPlayer,
+	}
+}
+
+// Report stores a CI result on every active session working on its branch and returns their names.
+// A report that repeats the current result keeps it announced, so CI retrying a webhook does not ring twice.
+func (s *CIService) Report(ctx context.Context, report domain.CIReport) ([]string, error) {
+	logging.Logger.Debug("Reporting CI result", "branch", report.Branch, "repo", report.Repo, "state", report.State)
+
+	report.Branch = strings.TrimSpace(report.Branch)
+	if report.Branch == "" {
+		return nil, fmt.Errorf("%w: branch is required", domain.ErrInvalidInput)
+	}
+	if _, err := domain.ParseCIState(string(report.State)); err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.sessionRepo.List(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var matched []string
+	now := time.Now()
+	for _, session := range sessions {
+		if !report.Matches(session) {
+			continue
+		}
+		status := &domain.CIStatus{
+			Commit:     report.Commit,
+			ReportedAt: now,
+			State:      report.State,
+			URL:        report.URL,
+		}
+		if current := session.CI; current != nil && current.State == status.State &&
+			current.Commit == status.Commit && current.URL == status.URL {
+			status.Notified = current.Notified
+		}
+		if err := s.sessionRepo.UpdateCIStatus(ctx, session.Name, status); err != nil {
+			return matched, fmt.Errorf("failed to save CI result of '%s': %w", session.Name, err)
+		}
+		matched = append(matched, session.Name)
+	}
+
+	if len(matched) == 0 {
+		return nil, fmt.Errorf("%w: no session works on branch '%s'", domain.ErrSessionNotFound, report.Branch)
+	}
+	return matched, nil
+}
+
+// Failed returns the idle sessions of the collection whose CI failed and was not yet announced.
+// Failures on a busy session wait until the agent goes idle, since it may still fix them.
+func (s *CIService) Failed(state *domain.SessionCollection) []domain.Session {
+	var failed []domain.Session
+	for _, name := range state.OrderedNames {
+		session := state.Sessions[name]
+		if session.CI == nil || session.CI.Notified || session.CI.State != domain.CIStateFailure {
+			continue
+		}
+		if session.State == domain.StateIdle {
+			failed = append(failed, session)
+		}
+	}
+	return failed
+}
+
+// Alert plays the bell and sends a ci_failure event to the webhook for each failed session.
+// A failure is announced once, even when several TUIs are open.
+func (s *CIService) Alert(ctx context.Context, sessions []domain.Session) error {
+	var errs []error
+	var announced int
+	for _, session := range sessions {
+		marked, err := s.sessionRepo.MarkCINotified(ctx, session.Name, session.CI.ReportedAt)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !marked {
+			continue // Announced elsewhere, or a newer result came in since it was loaded
+		}
+		announced++
+
+		logging.Logger.Info("CI failed on idle session", "session", session.Name, "url", session.CI.URL)
+		event := domain.Event{Message: session.CI.URL, SessionName: session.Name, Timestamp: time.Now(), Type: domain.EventCIFailure}
+		if err := s.eventPublisher.Publish(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish CI failure of '%s': %w", session.Name, err))
+		}
+	}
+
+	if announced > 0 {
+		if err := s.soundPlayer.PlaySoundForEvent(ciFailureSound); err != nil {
+			errs = append(errs, fmt.Errorf("failed to play CI failure sound: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestCIService_Report(t *testing.T) {
+	sessions := []domain.Session{
+		{BranchName: "feature", Name: "match", RepoInfo: "renato0307/rocha"},
+		{BranchName: "feature", Name: "other-repo", RepoInfo: "someone/else"},
+		{BranchName: "main", Name: "other-branch", RepoInfo: "renato0307/rocha"},
+		{
+			BranchName: "feature", Name: "retried", RepoInfo: "renato0307/rocha",
+			CI: &domain.CIStatus{Commit: "abc", Notified: true, State: domain.CIStateFailure, URL: "https://ci/1"},
+		},
+	}
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().List(mock.Anything, false).Return(sessions, nil)
+	sessionRepo.EXPECT().UpdateCIStatus(mock.Anything, "match", mock.MatchedBy(func(status *domain.CIStatus) bool {
+		return status.State == domain.CIStateFailure && !status.Notified && status.URL == "https://ci/1"
+	})).Return(nil)
+	sessionRepo.EXPECT().UpdateCIStatus(mock.Anything, "retried", mock.MatchedBy(func(status *domain.CIStatus) bool {
+		return status.Notified // Same result again, already announced
+	})).Return(nil)
+	service := NewCIService(sessionRepo, portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t))
+
+	matched, err := service.Report(context.Background(), domain.CIReport{
+		Branch: "feature", Commit: "abc", Repo: "Renato0307/Rocha", State: domain.CIStateFailure, URL: "https://ci/1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"match", "retried"}, matched)
+}
+
+func TestCIService_ReportErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		report  domain.CIReport
+		list    bool
+		wantErr error
+	}{
+		{name: "missing branch", report: domain.CIReport{State: domain.CIStateSuccess}, wantErr: domain.ErrInvalidInput},
+		{name: "unknown state", report: domain.CIReport{Branch: "feature", State: "green"}, wantErr: domain.ErrInvalidInput},
+		{name: "no session", report: domain.CIReport{Branch: "nobody", State: domain.CIStateSuccess}, list: true, wantErr: domain.ErrSessionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionRepo := portsmocks.NewMockSessionRepository(t)
+			if tt.list {
+				sessionRepo.EXPECT().List(mock.Anything, false).Return([]domain.Session{{BranchName: "feature", Name: "s1"}}, nil)
+			}
+			service := NewCIService(sessionRepo, portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t))
+
+			_, err := service.Report(context.Background(), tt.report)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCIService_Failed(t *testing.T) {
+	state := &domain.SessionCollection{
+		OrderedNames: []string{"idle", "working", "announced", "passed", "none"},
+		Sessions: map[string]domain.Session{
+			"idle":      {Name: "idle", State: domain.StateIdle, CI: &domain.CIStatus{State: domain.CIStateFailure}},
+			"working":   {Name: "working", State: domain.StateWorking, CI: &domain.CIStatus{State: domain.CIStateFailure}},
+			"announced": {Name: "announced", State: domain.StateIdle, CI: &domain.CIStatus{State: domain.CIStateFailure, Notified: true}},
+			"passed":    {Name: "passed", State: domain.StateIdle, CI: &domain.CIStatus{State: domain.CIStateSuccess}},
+			"none":      {Name: "none", State: domain.StateIdle},
+		},
+	}
+	service := NewCIService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t))
+
+	failed := service.Failed(state)
+	require.Len(t, failed, 1)
+	assert.Equal(t, "idle", failed[0].Name)
+}
+
+func TestCIService_AlertAnnouncesEachFailureOnce(t *testing.T) {
+	reportedAt := time.Date(2026, 10, 17, 12, 0, 0, 0, time.UTC)
+	sessions := []domain.Session{
+		{Name: "mine", CI: &domain.CIStatus{ReportedAt: reportedAt, State: domain.CIStateFailure, URL: "https://ci/1"}},
+		{Name: "theirs", CI: &domain.CIStatus{ReportedAt: reportedAt, State: domain.CIStateFailure}},
+	}
+
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().MarkCINotified(mock.Anything, "mine", reportedAt).Return(true, nil)
+	sessionRepo.EXPECT().MarkCINotified(mock.Anything, "theirs", reportedAt).Return(false, nil) // Another TUI got there first
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	soundPlayer.EXPECT().PlaySoundForEvent("ci_failure").Return(nil).Once()
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Type == domain.EventCIFailure && event.SessionName == "mine" && event.Message == "https://ci/1"
+	})).Return(nil).Once()
+
+	service := NewCIService(sessionRepo, soundPlayer, eventPublisher)
+
+	require.NoError(t, service.Alert(context.Background(), sessions))
+}
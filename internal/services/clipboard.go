@@ -0,0 +1,122 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// CopyField selects which piece of session info is copied to the clipboard
+type CopyField string
+
+const (
+	CopyBranch  CopyField = "branch"
+	CopyPath    CopyField = "path"
+	CopyPRURL   CopyField = "pr_url"
+	CopySummary CopyField = "summary"
+)
+
+// ClipboardService copies session info to the system clipboard and drafts
+// sessions from its contents
+type ClipboardService struct {
+	clipboard       ports.ClipboardWriter
+	clipboardReader ports.ClipboardReader
+	sessionReader   ports.SessionReader
+}
+
+// NewClipboardService creates a new ClipboardService
+func NewClipboardService(sessionReader ports.SessionReader, clipboard ports.ClipboardWriter, clipboardReader ports.ClipboardReader) *ClipboardService {
+	return &ClipboardService{
+		clipboard:       clipboard,
+		clipboardReader: clipboardReader,
+		sessionReader:   sessionReader,
+	}
+}
+
+// CopySessionInfo copies the selected field of a session to the clipboard
+// Returns the copied text so callers can confirm what was copied
+func (s *ClipboardService) CopySessionInfo(ctx context.Context, sessionName string, field CopyField) (string, error) {
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return "", fmt.Errorf("failed to get session: %w", err)
+	}
+
+	text, err := sessionInfoText(session, field)
+	if err != nil {
+		return "", err
+	}
+
+	if err := s.clipboard.Copy(text); err != nil {
+		return "", fmt.Errorf("failed to copy to clipboard: %w", err)
+	}
+
+	logging.Logger.Info("Copied session info to clipboard", "session", sessionName, "field", field)
+	return text, nil
+}
+
+// DraftSessionFromClipboard builds a session draft from the clipboard contents:
+// a git URL, an issue URL, or a task description
+func (s *ClipboardService) DraftSessionFromClipboard() (domain.SessionDraft, error) {
+	text, err := s.clipboardReader.Paste()
+	if err != nil {
+		return domain.SessionDraft{}, fmt.Errorf("failed to read clipboard: %w", err)
+	}
+
+	draft, err := domain.NewSessionDraft(text)
+	if err != nil {
+		return domain.SessionDraft{}, fmt.Errorf("clipboard is empty: %w", domain.ErrInvalidInput)
+	}
+
+	logging.Logger.Info("Drafted session from clipboard", "source", draft.Source, "name", draft.SessionName, "repo_source", draft.RepoSource)
+	return draft, nil
+}
+
+// sessionInfoText extracts the text to copy for a field
+func sessionInfoText(session *domain.Session, field CopyField) (string, error) {
+	switch field {
+	case CopyBranch:
+		if session.BranchName == "" {
+			return "", fmt.Errorf("session '%s' has no branch: %w", session.Name, domain.ErrInvalidInput)
+		}
+		return session.BranchName, nil
+	case CopyPath:
+		if session.WorkingDir() == "" {
+			return "", fmt.Errorf("session '%s' has no worktree: %w", session.Name, domain.ErrInvalidInput)
+		}
+		return session.WorkingDir(), nil
+	case CopyPRURL:
+		if session.PRInfo == nil || session.PRInfo.URL == "" {
+			return "", fmt.Errorf("session '%s' has no PR: %w", session.Name, domain.ErrInvalidInput)
+		}
+		return session.PRInfo.URL, nil
+	case CopySummary:
+		return sessionSummary(session), nil
+	default:
+		return "", fmt.Errorf("unknown copy field '%s': %w", field, domain.ErrInvalidInput)
+	}
+}
+
+// sessionSummary formats a short multi-line summary for standups and PR descriptions
+// Empty fields are left out
+func sessionSummary(session *domain.Session) string {
+	name := session.DisplayName
+	if name == "" {
+		name = session.Name
+	}
+
+	lines := []string{name}
+	if session.BranchName != "" {
+		lines = append(lines, "Branch: "+session.BranchName)
+	}
+	if session.Status != nil && *session.Status != "" {
+		lines = append(lines, "Status: "+*session.Status)
+	}
+	if session.Comment != "" {
+		lines = append(lines, "Comment: "+session.Comment)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,116 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestCopySessionInfo(t *testing.T) {
+	status := "review"
+	fullSession := &domain.Session{
+		BranchName:   "feature/login",
+		Comment:      "waiting on design",
+		DisplayName:  "Login page",
+		Name:         "login",
+		PRInfo:       &domain.PRInfo{Number: 42, URL: "https://github.com/o/r/pull/42"},
+		Status:       &status,
+		WorktreePath: "/tmp/worktrees/login",
+	}
+	bareSession := &domain.Session{Name: "bare"}
+
+	tests := []struct {
+		name     string
+		session  *domain.Session
+		field    CopyField
+		wantText string
+		wantErr  error
+	}{
+		{name: "branch", session: fullSession, field: CopyBranch, wantText: "feature/login"},
+		{name: "path", session: fullSession, field: CopyPath, wantText: "/tmp/worktrees/login"},
+		{name: "path of external session", session: &domain.Session{Name: "ext", IsExternal: true, RepoPath: "/src/app"}, field: CopyPath, wantText: "/src/app"},
+		{name: "pr url", session: fullSession, field: CopyPRURL, wantText: "https://github.com/o/r/pull/42"},
+		{name: "summary", session: fullSession, field: CopySummary, wantText: "Login page\nBranch: feature/login\nStatus: review\nComment: waiting on design"},
+		{name: "summary skips empty fields", session: bareSession, field: CopySummary, wantText: "bare"},
+		{name: "no branch", session: bareSession, field: CopyBranch, wantErr: domain.ErrInvalidInput},
+		{name: "no worktree", session: bareSession, field: CopyPath, wantErr: domain.ErrInvalidInput},
+		{name: "no pr", session: bareSession, field: CopyPRURL, wantErr: domain.ErrInvalidInput},
+		{name: "unknown field", session: fullSession, field: CopyField("bogus"), wantErr: domain.ErrInvalidInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionReader := portsmocks.NewMockSessionReader(t)
+			clipboard := portsmocks.NewMockClipboardWriter(t)
+
+			sessionReader.EXPECT().Get(context.Background(), tt.session.Name).Return(tt.session, nil)
+			if tt.wantErr == nil {
+				clipboard.EXPECT().Copy(tt.wantText).Return(nil)
+			}
+
+			service := NewClipboardService(sessionReader, clipboard, portsmocks.NewMockClipboardReader(t))
+			text, err := service.CopySessionInfo(context.Background(), tt.session.Name, tt.field)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantText, text)
+		})
+	}
+}
+
+func TestCopySessionInfo_ClipboardError(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	clipboard := portsmocks.NewMockClipboardWriter(t)
+
+	sessionReader.EXPECT().Get(context.Background(), "s1").Return(&domain.Session{Name: "s1", BranchName: "main"}, nil)
+	clipboard.EXPECT().Copy("main").Return(errors.New("no clipboard tool found"))
+
+	service := NewClipboardService(sessionReader, clipboard, portsmocks.NewMockClipboardReader(t))
+	_, err := service.CopySessionInfo(context.Background(), "s1", CopyBranch)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to copy to clipboard")
+}
+
+func TestCopySessionInfo_SessionNotFound(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	clipboard := portsmocks.NewMockClipboardWriter(t)
+
+	sessionReader.EXPECT().Get(context.Background(), "missing").Return(nil, domain.ErrSessionNotFound)
+
+	service := NewClipboardService(sessionReader, clipboard, portsmocks.NewMockClipboardReader(t))
+	_, err := service.CopySessionInfo(context.Background(), "missing", CopySummary)
+
+	require.ErrorIs(t, err, domain.ErrSessionNotFound)
+}
+
+func TestDraftSessionFromClipboard(t *testing.T) {
+	clipboardReader := portsmocks.NewMockClipboardReader(t)
+	clipboardReader.EXPECT().Paste().Return("https://github.com/owner/api/issues/42\n", nil).Once()
+
+	service := NewClipboardService(portsmocks.NewMockSessionReader(t), portsmocks.NewMockClipboardWriter(t), clipboardReader)
+	draft, err := service.DraftSessionFromClipboard()
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.DraftFromIssueURL, draft.Source)
+	assert.Equal(t, "https://github.com/owner/api", draft.RepoSource)
+	assert.Equal(t, "api-issue-42", draft.SessionName)
+
+	clipboardReader.EXPECT().Paste().Return("  \n", nil).Once()
+	_, err = service.DraftSessionFromClipboard()
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	clipboardReader.EXPECT().Paste().Return("", errors.New("no clipboard tool found")).Once()
+	_, err = service.DraftSessionFromClipboard()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to read clipboard")
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// DebugMetricsService records and exposes state detection latency metrics
+type DebugMetricsService struct {
+	enabled     bool
+	metricsRepo ports.HookMetricsRepository
+}
+
+// NewDebugMetricsService creates a new DebugMetricsService
+// Hook events are only recorded when enabled, since every record is a write on the hook path
+func NewDebugMetricsService(metricsRepo ports.HookMetricsRepository, enabled bool) *DebugMetricsService {
+	return &DebugMetricsService{
+		enabled:     enabled,
+		metricsRepo: metricsRepo,
+	}
+}
+
+// Enabled reports whether hook events are recorded
+func (s *DebugMetricsService) Enabled() bool {
+	return s.enabled
+}
+
+// RecordHookEvent stores how a hook event was processed, when recording is enabled
+// Failures are logged but never fail the hook
+func (s *DebugMetricsService) RecordHookEvent(ctx context.Context, metric domain.HookMetric) {
+	if !s.enabled {
+		return
+	}
+	if err := s.metricsRepo.AddHookMetric(ctx, metric); err != nil {
+		logging.Logger.Warn("Failed to record hook metric", "error", err, "session", metric.SessionName)
+	}
+}
+
+// RecentHookEvents returns up to limit hook metrics, most recent first
+func (s *DebugMetricsService) RecentHookEvents(ctx context.Context, limit int) ([]domain.HookMetric, error) {
+	return s.metricsRepo.ListHookMetrics(ctx, limit)
+}
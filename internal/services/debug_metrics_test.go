@@ -0,0 +1,33 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestRecordHookEvent_OnlyWhenEnabled(t *testing.T) {
+	ctx := context.Background()
+	metric := domain.HookMetric{EventType: "stop", SessionName: "s1"}
+
+	tests := []struct {
+		name    string
+		enabled bool
+	}{
+		{name: "enabled records the event", enabled: true},
+		{name: "disabled skips the write", enabled: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metricsRepo := portsmocks.NewMockHookMetricsRepository(t)
+			if tt.enabled {
+				metricsRepo.EXPECT().AddHookMetric(ctx, metric).Return(nil)
+			}
+
+			NewDebugMetricsService(metricsRepo, tt.enabled).RecordHookEvent(ctx, metric)
+		})
+	}
+}
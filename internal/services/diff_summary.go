@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// maxSummaryDiffBytes caps the diff handed to the summarizer, so a large generated change
+// (lock files, fixtures) does not overflow what it can read at once
+const maxSummaryDiffBytes = 200 * 1024
+
+// DiffSummaryService describes what a session changed in a paragraph and keeps it as the
+// session note, for handoffs and PR descriptions
+type DiffSummaryService struct {
+	gitRepo     ports.GitStatsProvider
+	sessionRepo ports.SessionRepository
+	summarizer  ports.DiffSummarizer
+}
+
+// NewDiffSummaryService creates a new DiffSummaryService
+func NewDiffSummaryService(sessionRepo ports.SessionRepository, gitRepo ports.GitStatsProvider, summarizer ports.DiffSummarizer) *DiffSummaryService {
+	return &DiffSummaryService{
+		gitRepo:     gitRepo,
+		sessionRepo: sessionRepo,
+		summarizer:  summarizer,
+	}
+}
+
+// SummarizeDiff summarizes everything the session changed since its branch forked from the
+// base branch, uncommitted work included, and stores the summary as the session note,
+// replacing the previous one. Returns the summary.
+func (s *DiffSummaryService) SummarizeDiff(ctx context.Context, name string) (string, error) {
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return "", fmt.Errorf("failed to get session: %w", err)
+	}
+	dir := session.WorkingDir()
+	if dir == "" {
+		return "", fmt.Errorf("%w: session '%s' has no worktree", domain.ErrInvalidInput, name)
+	}
+
+	diff, err := s.gitRepo.BranchDiff(ctx, dir, session.BaseBranch)
+	if err != nil {
+		return "", fmt.Errorf("failed to get diff: %w", err)
+	}
+	if strings.TrimSpace(diff) == "" {
+		return "", fmt.Errorf("%w: session '%s' has no changes to summarize", domain.ErrInvalidInput, name)
+	}
+	if len(diff) > maxSummaryDiffBytes {
+		logging.Logger.Debug("Truncating diff to summarize", "session", name, "bytes", len(diff))
+		diff = diff[:maxSummaryDiffBytes] + "\n[diff truncated]\n"
+	}
+
+	summary, err := s.summarizer.Summarize(ctx, dir, diff)
+	if err != nil {
+		return "", fmt.Errorf("failed to summarize diff: %w", err)
+	}
+	if err := s.sessionRepo.UpdateNote(ctx, name, summary); err != nil {
+		return "", fmt.Errorf("failed to update note: %w", err)
+	}
+
+	logging.Logger.Info("Session diff summarized into its note", "session", name, "summary_length", len(summary))
+	return summary, nil
+}
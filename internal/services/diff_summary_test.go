@@ -0,0 +1,84 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestSummarizeDiff(t *testing.T) {
+	session := &domain.Session{BaseBranch: "develop", Name: "login", WorktreePath: "/tmp/worktrees/login"}
+
+	t.Run("stores the summary as the note", func(t *testing.T) {
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		gitRepo := portsmocks.NewMockGitStatsProvider(t)
+		summarizer := portsmocks.NewMockDiffSummarizer(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "login").Return(session, nil)
+		gitRepo.EXPECT().BranchDiff(mock.Anything, "/tmp/worktrees/login", "develop").Return("+login form\n", nil)
+		summarizer.EXPECT().Summarize(mock.Anything, "/tmp/worktrees/login", "+login form\n").Return("Adds the login form.", nil)
+		sessionRepo.EXPECT().UpdateNote(mock.Anything, "login", "Adds the login form.").Return(nil)
+
+		summary, err := NewDiffSummaryService(sessionRepo, gitRepo, summarizer).SummarizeDiff(context.Background(), "login")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Adds the login form.", summary)
+	})
+
+	t.Run("truncates large diffs", func(t *testing.T) {
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		gitRepo := portsmocks.NewMockGitStatsProvider(t)
+		summarizer := portsmocks.NewMockDiffSummarizer(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "login").Return(session, nil)
+		gitRepo.EXPECT().BranchDiff(mock.Anything, mock.Anything, mock.Anything).Return(strings.Repeat("+x\n", maxSummaryDiffBytes), nil)
+		summarizer.EXPECT().Summarize(mock.Anything, mock.Anything, mock.MatchedBy(func(diff string) bool {
+			return len(diff) < maxSummaryDiffBytes+100 && strings.HasSuffix(diff, "[diff truncated]\n")
+		})).Return("Adds x.", nil)
+		sessionRepo.EXPECT().UpdateNote(mock.Anything, "login", "Adds x.").Return(nil)
+
+		_, err := NewDiffSummaryService(sessionRepo, gitRepo, summarizer).SummarizeDiff(context.Background(), "login")
+
+		require.NoError(t, err)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		gitRepo := portsmocks.NewMockGitStatsProvider(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "login").Return(session, nil)
+		gitRepo.EXPECT().BranchDiff(mock.Anything, mock.Anything, mock.Anything).Return("", nil)
+
+		_, err := NewDiffSummaryService(sessionRepo, gitRepo, portsmocks.NewMockDiffSummarizer(t)).SummarizeDiff(context.Background(), "login")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("no worktree", func(t *testing.T) {
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "bare").Return(&domain.Session{Name: "bare"}, nil)
+
+		_, err := NewDiffSummaryService(sessionRepo, portsmocks.NewMockGitStatsProvider(t), portsmocks.NewMockDiffSummarizer(t)).SummarizeDiff(context.Background(), "bare")
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+
+	t.Run("summarizer fails and the note is kept", func(t *testing.T) {
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		gitRepo := portsmocks.NewMockGitStatsProvider(t)
+		summarizer := portsmocks.NewMockDiffSummarizer(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "login").Return(session, nil)
+		gitRepo.EXPECT().BranchDiff(mock.Anything, mock.Anything, mock.Anything).Return("+login form\n", nil)
+		summarizer.EXPECT().Summarize(mock.Anything, mock.Anything, mock.Anything).Return("", errors.New("claude: command not found"))
+
+		_, err := NewDiffSummaryService(sessionRepo, gitRepo, summarizer).SummarizeDiff(context.Background(), "login")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "command not found")
+	})
+}
@@ -1,15 +1,29 @@
 package services
 
-import "github.com/renato0307/rocha/internal/domain"
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
 
 // CreateSessionParams contains parameters for creating a new session
 type CreateSessionParams struct {
+	AgentArgs                       []string // Extra agent CLI args (see domain.ValidateAgentArgs)
+	AgentModel                      string   // Model alias or ID (empty uses the agent default)
 	AllowDangerouslySkipPermissions bool
+	AutoDisplayName                 bool      // Without DisplayName, name the session after its linked issue or branch
+	BootstrapOutput                 io.Writer // Receives worktree bootstrap progress (nil discards it)
 	BranchNameOverride              string
 	ClaudeDirOverride               string
+	DirectoryPath                   string             // Run in this directory as-is (no clone, branch, or worktree)
+	DisplayName                     string             // Defaults to SessionName
+	GitIdentity                     domain.GitIdentity // Overrides the repository's identity in the new worktree
 	InitialPrompt                   string
 	RepoSource                      string
 	SessionName                     string
+	Subdir                          string // Directory inside the checkout to start in (e.g., packages/api in a monorepo)
 	TmuxStatusPosition              string
 }
 
@@ -19,7 +33,78 @@ type CreateSessionResult struct {
 	WorktreePath string
 }
 
+// DispatchResult contains the outcome of delivering due scheduled prompts
+type DispatchResult struct {
+	Sent      []domain.ScheduledPrompt
+	Throttled []string // Sessions whose due prompts are held back by the concurrency limit
+}
+
+// OneShotParams contains parameters for a one-shot run
+type OneShotParams struct {
+	AllowDangerouslySkipPermissions bool
+	BootstrapOutput                 io.Writer // Receives worktree bootstrap progress (nil discards it)
+	Prompt                          string
+	RepoSource                      string
+	Timeout                         time.Duration // Time the agent gets to finish the prompt
+	TmuxStatusPosition              string
+	TranscriptFormat                domain.TranscriptFormat
+}
+
+// OneShotResult contains what a one-shot run captured before tearing its session down
+type OneShotResult struct {
+	Diff        string              // Patch of the agent's changes, commits included
+	SessionName string              // Temporary session the agent ran in
+	State       domain.SessionState // State the agent ended in (empty when it timed out)
+	TimedOut    bool
+	Transcript  []byte // Empty when Claude recorded no conversation
+}
+
+// RenameOptions selects what a rename changes besides the session name
+type RenameOptions struct {
+	BranchName   string // Renames the session branch to this (empty keeps it)
+	DisplayName  string // Empty keeps the current display name
+	MoveWorktree bool   // Moves the worktree directory to match the new name
+}
+
+// ResumeAgentOptions selects the prompt that resumes a paused session
+type ResumeAgentOptions struct {
+	Confirmed        bool   // The prompt was confirmed against the prompt review patterns
+	Prompt           string // Sent as is when set
+	ResendLastPrompt bool   // Without Prompt, resend the last prompt sent to the session
+}
+
+// ResumeOutcome describes what ResumeSessions did with one session
+type ResumeOutcome string
+
+const (
+	ResumeOutcomeFailed  ResumeOutcome = "failed"  // The tmux session could not be recreated
+	ResumeOutcomeFresh   ResumeOutcome = "fresh"   // Recreated with a new Claude conversation (none was recorded)
+	ResumeOutcomeResumed ResumeOutcome = "resumed" // Recreated resuming the recorded Claude conversation
+	ResumeOutcomeRunning ResumeOutcome = "running" // The tmux session was still running, left as is
+)
+
+// ResumeResult is the outcome of resuming one session
+type ResumeResult struct {
+	Err     error
+	Name    string
+	Outcome ResumeOutcome
+}
+
 // ClaudeDirResolver resolves the Claude configuration directory
 type ClaudeDirResolver interface {
 	Resolve(repoInfo, userOverride string) string
 }
+
+// WorktreeBootstrapper prepares a newly created worktree before the agent starts
+type WorktreeBootstrapper interface {
+	Bootstrap(ctx context.Context, repoInfo, repoPath, worktreePath string, output io.Writer) error
+}
+
+// WorkspaceReport summarizes the sessions of a workspace
+type WorkspaceReport struct {
+	Archived  int                         // Archived member sessions, not included in Sessions
+	ByState   map[domain.SessionState]int // Active sessions per state
+	ByStatus  map[string]int              // Active sessions per implementation status ("" = no status)
+	Sessions  []domain.Session            // Active member sessions, by name
+	Workspace domain.Workspace
+}
@@ -0,0 +1,93 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// escalationSound is the sound event played for escalated sessions (the bell)
+const escalationSound = "escalation"
+
+// EscalationService escalates sessions left waiting for input longer than the configured thresholds
+type EscalationService struct {
+	alerted        map[string]time.Time // Session name -> LastUpdated of the wait already alerted
+	eventPublisher ports.EventPublisher
+	policy         domain.EscalationPolicy
+	soundPlayer    ports.SoundPlayer
+}
+
+// NewEscalationService creates a new EscalationService
+func NewEscalationService(policy domain.EscalationPolicy, soundPlayer ports.SoundPlayer, eventPublisher ports.EventPublisher) *EscalationService {
+	return &EscalationService{
+		alerted:        make(map[string]time.Time),
+		eventPublisher: eventPublisher,
+		policy:         policy,
+		soundPlayer:    soundPlayer,
+	}
+}
+
+// IsEnabled returns true if any escalation threshold is configured
+func (s *EscalationService) IsEnabled() bool {
+	return s.policy.IsEnabled()
+}
+
+// Mark sets IsEscalated on the sessions of the collection and returns the sessions
+// that escalated since the previous call, so each wait is alerted only once
+func (s *EscalationService) Mark(state *domain.SessionCollection, now time.Time) []domain.Session {
+	if !s.policy.IsEnabled() {
+		return nil
+	}
+
+	var escalated []domain.Session
+	for name, session := range state.Sessions {
+		session.IsEscalated = s.policy.IsEscalated(session, now)
+		state.Sessions[name] = session
+
+		if !session.IsEscalated {
+			delete(s.alerted, name)
+			continue
+		}
+		if alertedAt, ok := s.alerted[name]; ok && alertedAt.Equal(session.LastUpdated) {
+			continue
+		}
+		s.alerted[name] = session.LastUpdated
+		escalated = append(escalated, session)
+	}
+	return escalated
+}
+
+// Alert plays the bell and sends the escalation event to the webhook, as configured
+func (s *EscalationService) Alert(ctx context.Context, sessions []domain.Session) error {
+	if len(sessions) == 0 {
+		return nil
+	}
+
+	var errs []error
+	if s.policy.Bell {
+		if err := s.soundPlayer.PlaySoundForEvent(escalationSound); err != nil {
+			errs = append(errs, fmt.Errorf("failed to play escalation sound: %w", err))
+		}
+	}
+
+	for _, session := range sessions {
+		logging.Logger.Info("Session escalated", "session", session.Name, "waiting_since", session.LastUpdated)
+		if !s.policy.Webhook {
+			continue
+		}
+
+		event := domain.Event{SessionName: session.Name, State: session.State, Timestamp: time.Now(), Type: domain.EventEscalation}
+		if session.Status != nil {
+			event.Status = *session.Status
+		}
+		if err := s.eventPublisher.Publish(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish escalation of '%s': %w", session.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,74 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestEscalationService_MarkAlertsEachWaitOnce(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	waitingSince := now.Add(-20 * time.Minute)
+	state := &domain.SessionCollection{Sessions: map[string]domain.Session{
+		"waiting": {Name: "waiting", State: domain.StateWaiting, LastUpdated: waitingSince},
+		"idle":    {Name: "idle", State: domain.StateIdle, LastUpdated: waitingSince},
+	}}
+	service := NewEscalationService(domain.EscalationPolicy{After: 15 * time.Minute}, portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t))
+
+	escalated := service.Mark(state, now)
+	require.Len(t, escalated, 1)
+	assert.Equal(t, "waiting", escalated[0].Name)
+	assert.True(t, state.Sessions["waiting"].IsEscalated)
+	assert.False(t, state.Sessions["idle"].IsEscalated)
+
+	// Still escalated on the next poll, but already alerted
+	assert.Empty(t, service.Mark(state, now.Add(2*time.Second)))
+	assert.True(t, state.Sessions["waiting"].IsEscalated)
+
+	// A new wait escalates again
+	state.Sessions["waiting"] = domain.Session{Name: "waiting", State: domain.StateWaiting, LastUpdated: now.Add(-16 * time.Minute)}
+	assert.Len(t, service.Mark(state, now), 1)
+}
+
+func TestEscalationService_Alert(t *testing.T) {
+	review := "review"
+	session := domain.Session{Name: "waiting", State: domain.StateWaiting, Status: &review}
+
+	tests := []struct {
+		name        string
+		policy      domain.EscalationPolicy
+		wantBell    bool
+		wantWebhook bool
+	}{
+		{name: "bell only", policy: domain.EscalationPolicy{Bell: true}, wantBell: true},
+		{name: "webhook only", policy: domain.EscalationPolicy{Webhook: true}, wantWebhook: true},
+		{name: "bell and webhook", policy: domain.EscalationPolicy{Bell: true, Webhook: true}, wantBell: true, wantWebhook: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			soundPlayer := portsmocks.NewMockSoundPlayer(t)
+			eventPublisher := portsmocks.NewMockEventPublisher(t)
+			if tt.wantBell {
+				soundPlayer.EXPECT().PlaySoundForEvent("escalation").Return(nil)
+			}
+			if tt.wantWebhook {
+				eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+					return event.Type == domain.EventEscalation && event.SessionName == "waiting" &&
+						event.State == domain.StateWaiting && event.Status == "review"
+				})).Return(nil)
+			}
+
+			service := NewEscalationService(tt.policy, soundPlayer, eventPublisher)
+
+			require.NoError(t, service.Alert(context.Background(), []domain.Session{session}))
+		})
+	}
+}
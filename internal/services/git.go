@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/ports"
@@ -60,9 +61,20 @@ func (s *GitService) RemoveWorktree(repoPath, worktreePath string) error {
 	return s.gitRepo.RemoveWorktree(repoPath, worktreePath)
 }
 
+// GetWorktreeStatus reports uncommitted changes and unpushed commits that removing the worktree would lose
+func (s *GitService) GetWorktreeStatus(ctx context.Context, worktreePath string) (*domain.WorktreeStatus, error) {
+	return s.gitRepo.GetWorktreeStatus(ctx, worktreePath)
+}
+
 // FetchGitStats fetches git statistics for a path
-func (s *GitService) FetchGitStats(ctx context.Context, worktreePath string) (*domain.GitStats, error) {
-	return s.gitRepo.FetchGitStats(ctx, worktreePath)
+// Base branch counts compare against origin/<baseBranch>, or origin's default branch if empty
+func (s *GitService) FetchGitStats(ctx context.Context, worktreePath, baseBranch string) (*domain.GitStats, error) {
+	return s.gitRepo.FetchGitStats(ctx, worktreePath, baseBranch)
+}
+
+// FetchBase fetches the latest base branch from origin so staleness can be measured
+func (s *GitService) FetchBase(ctx context.Context, worktreePath, baseBranch string) error {
+	return s.gitRepo.FetchBase(ctx, worktreePath, baseBranch)
 }
 
 // GetMainRepoPath gets the main repository path (handles worktrees correctly)
@@ -89,3 +101,47 @@ func (s *GitService) FetchPRInfo(ctx context.Context, worktreePath, branchName s
 func (s *GitService) OpenPRInBrowser(worktreePath string) error {
 	return s.gitRepo.OpenPRInBrowser(worktreePath)
 }
+
+// RebaseOntoBase fetches and rebases a worktree onto its base branch
+// An empty baseBranch rebases onto the remote's default branch
+func (s *GitService) RebaseOntoBase(ctx context.Context, worktreePath, baseBranch string) (*domain.RebaseResult, error) {
+	return s.gitRepo.RebaseOntoBase(ctx, worktreePath, baseBranch)
+}
+
+// AbortRebase aborts a rebase in progress in a worktree
+func (s *GitService) AbortRebase(ctx context.Context, worktreePath string) error {
+	return s.gitRepo.AbortRebase(ctx, worktreePath)
+}
+
+// StashChanges stashes the uncommitted changes of a session worktree, untracked files included
+// Returns false when there was nothing to stash
+func (s *GitService) StashChanges(ctx context.Context, worktreePath, sessionName string) (bool, error) {
+	return s.gitRepo.StashChanges(ctx, worktreePath, "rocha: "+sessionName)
+}
+
+// ListBranches lists the local and remote branches a worktree can switch to,
+// local branches first, most recently committed first
+func (s *GitService) ListBranches(ctx context.Context, worktreePath string) ([]domain.Branch, error) {
+	return s.gitRepo.ListBranches(ctx, worktreePath)
+}
+
+// ListStashes lists the stashes of the branch checked out in a worktree, newest first
+func (s *GitService) ListStashes(ctx context.Context, worktreePath string) ([]domain.Stash, error) {
+	return s.gitRepo.ListStashes(ctx, worktreePath)
+}
+
+// PopStash applies the newest stash of the worktree's branch and drops it
+// Returns domain.ErrStashNotFound when the branch has no stash
+func (s *GitService) PopStash(ctx context.Context, worktreePath string) (*domain.Stash, error) {
+	stashes, err := s.gitRepo.ListStashes(ctx, worktreePath)
+	if err != nil {
+		return nil, err
+	}
+	if len(stashes) == 0 {
+		return nil, fmt.Errorf("%w: no stash to pop on this branch", domain.ErrStashNotFound)
+	}
+	if err := s.gitRepo.PopStash(ctx, worktreePath, stashes[0].Ref); err != nil {
+		return nil, err
+	}
+	return &stashes[0], nil
+}
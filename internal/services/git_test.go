@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestGitService_PopStash(t *testing.T) {
+	t.Run("pops the newest stash of the branch", func(t *testing.T) {
+		gitRepo := portsmocks.NewMockGitRepository(t)
+		gitRepo.EXPECT().ListStashes(mock.Anything, "/wt").Return([]domain.Stash{
+			{Message: "rocha: api", Ref: "stash@{1}"},
+			{Message: "older", Ref: "stash@{3}"},
+		}, nil)
+		gitRepo.EXPECT().PopStash(mock.Anything, "/wt", "stash@{1}").Return(nil)
+
+		stash, err := NewGitService(gitRepo).PopStash(context.Background(), "/wt")
+
+		require.NoError(t, err)
+		assert.Equal(t, "rocha: api", stash.Message)
+	})
+
+	t.Run("no stash on the branch", func(t *testing.T) {
+		gitRepo := portsmocks.NewMockGitRepository(t)
+		gitRepo.EXPECT().ListStashes(mock.Anything, "/wt").Return(nil, nil)
+
+		_, err := NewGitService(gitRepo).PopStash(context.Background(), "/wt")
+
+		assert.ErrorIs(t, err, domain.ErrStashNotFound)
+	})
+}
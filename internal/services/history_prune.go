@@ -0,0 +1,50 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// HistoryPruneInterval is how often the TUI and the scheduler discard old history
+const HistoryPruneInterval = time.Hour
+
+// Retention of the history hooks write
+const (
+	eventRetention  = 90 * 24 * time.Hour // Events back activity stats and reports
+	hookMetricsKept = 500                 // Most recent hook metrics, for the debug screen
+)
+
+// HistoryPruneService discards old rows of the history hooks write, so hooks only insert
+// and the instance running the background work prunes every HistoryPruneInterval
+type HistoryPruneService struct {
+	eventRepo   ports.EventRepository
+	metricsRepo ports.HookMetricsRepository
+}
+
+// NewHistoryPruneService creates a new HistoryPruneService
+func NewHistoryPruneService(eventRepo ports.EventRepository, metricsRepo ports.HookMetricsRepository) *HistoryPruneService {
+	return &HistoryPruneService{
+		eventRepo:   eventRepo,
+		metricsRepo: metricsRepo,
+	}
+}
+
+// Prune discards events older than the retention and hook metrics beyond the most recent
+// ones kept for the debug screen. Both are attempted; their errors are joined.
+func (s *HistoryPruneService) Prune(ctx context.Context, now time.Time) error {
+	logging.Logger.Debug("Pruning history")
+
+	var errs []error
+	if err := s.eventRepo.PruneEvents(ctx, now.Add(-eventRetention)); err != nil {
+		errs = append(errs, fmt.Errorf("failed to prune events: %w", err))
+	}
+	if err := s.metricsRepo.PruneHookMetrics(ctx, hookMetricsKept); err != nil {
+		errs = append(errs, fmt.Errorf("failed to prune hook metrics: %w", err))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,45 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestHistoryPrune(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		eventsErr  error
+		metricsErr error
+		wantErr    bool
+	}{
+		{name: "prunes events and hook metrics"},
+		{name: "still prunes hook metrics when events fail", eventsErr: errors.New("database is locked"), wantErr: true},
+		{name: "reports failed hook metrics prune", metricsErr: errors.New("database is locked"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eventRepo := portsmocks.NewMockEventRepository(t)
+			metricsRepo := portsmocks.NewMockHookMetricsRepository(t)
+			eventRepo.EXPECT().PruneEvents(ctx, now.Add(-eventRetention)).Return(tt.eventsErr)
+			metricsRepo.EXPECT().PruneHookMetrics(ctx, hookMetricsKept).Return(tt.metricsErr)
+
+			err := NewHistoryPruneService(eventRepo, metricsRepo).Prune(ctx, now)
+
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// maxHookAttempts is how many drains may fail to apply a hook event before it is dead-lettered
+const maxHookAttempts = 10
+
+// HookJournalService applies hook events to session state through the hook journal.
+// Hooks record their event in the journal before applying it, and whatever cannot be
+// applied yet (e.g. while the database is locked) is retried by later drains from hooks,
+// the TUI, or the scheduler, in the order the events arrived.
+type HookJournalService struct {
+	journal             ports.HookJournal
+	notificationService *NotificationService
+}
+
+// NewHookJournalService creates a new HookJournalService
+func NewHookJournalService(journal ports.HookJournal, notificationService *NotificationService) *HookJournalService {
+	return &HookJournalService{
+		journal:             journal,
+		notificationService: notificationService,
+	}
+}
+
+// Record journals a hook event, then drains the journal so it is applied right away
+// when the database allows. Returns the state the event set, or an empty state when
+// it is left in the journal for a later drain.
+func (s *HookJournalService) Record(ctx context.Context, sessionName, eventType, executionID string) (domain.SessionState, error) {
+	entry := domain.HookJournalEntry{
+		EventType:   eventType,
+		ExecutionID: executionID,
+		ID:          uuid.NewString(),
+		ReceivedAt:  time.Now(),
+		SessionName: sessionName,
+	}
+	if err := s.journal.Append(entry); err != nil {
+		// Without the journal, applying the event directly is still better than losing it
+		logging.Logger.Error("Failed to journal hook event, applying it directly", "error", err)
+		return s.notificationService.HandleEvent(ctx, sessionName, eventType, executionID, entry.ReceivedAt)
+	}
+
+	states, err := s.drain(ctx)
+	return states[entry.ID], err
+}
+
+// Drain applies the journaled hook events that were not applied yet
+// Returns how many events were applied.
+func (s *HookJournalService) Drain(ctx context.Context) (int, error) {
+	states, err := s.drain(ctx)
+	return len(states), err
+}
+
+// ListPending returns the hook events waiting to be applied, oldest first
+func (s *HookJournalService) ListPending() ([]domain.HookJournalEntry, error) {
+	return s.journal.ListPending()
+}
+
+// ListDeadLetters returns the hook events given up on, oldest first
+func (s *HookJournalService) ListDeadLetters() ([]domain.HookJournalEntry, error) {
+	return s.journal.ListDeadLetters()
+}
+
+// RequeueDeadLetters moves the hook events given up on back to the journal for another round of attempts
+func (s *HookJournalService) RequeueDeadLetters() (int, error) {
+	return s.journal.RequeueDeadLetters()
+}
+
+// ClearDeadLetters discards the hook events given up on
+func (s *HookJournalService) ClearDeadLetters() (int, error) {
+	return s.journal.ClearDeadLetters()
+}
+
+// drain applies pending entries in order and returns the state each applied entry set, by entry ID.
+// Once an event of a session fails, its later events wait too, so transitions never apply out of order.
+func (s *HookJournalService) drain(ctx context.Context) (map[string]domain.SessionState, error) {
+	states := make(map[string]domain.SessionState)
+	err := s.journal.Drain(ctx, func(pending []domain.HookJournalEntry) (keep, dead []domain.HookJournalEntry) {
+		blocked := make(map[string]bool)
+		for _, entry := range pending {
+			// Running out of time is not a failed attempt
+			if blocked[entry.SessionName] || ctx.Err() != nil {
+				keep = append(keep, entry)
+				continue
+			}
+
+			state, err := s.notificationService.HandleEvent(ctx, entry.SessionName, entry.EventType, entry.ExecutionID, entry.ReceivedAt)
+			if err == nil {
+				states[entry.ID] = state
+				continue
+			}
+			if ctx.Err() != nil {
+				keep = append(keep, entry)
+				continue
+			}
+
+			if errors.Is(err, domain.ErrSessionNotFound) {
+				logging.Logger.Warn("Dropping hook event of unknown session", "session", entry.SessionName, "event", entry.EventType)
+				continue
+			}
+
+			entry.Attempts++
+			entry.LastError = err.Error()
+			if entry.Attempts >= maxHookAttempts {
+				logging.Logger.Error("Giving up on hook event", "session", entry.SessionName, "event", entry.EventType, "attempts", entry.Attempts, "error", err)
+				dead = append(dead, entry)
+				continue
+			}
+			logging.Logger.Warn("Hook event not applied, will retry", "session", entry.SessionName, "event", entry.EventType, "attempts", entry.Attempts, "error", err)
+			keep = append(keep, entry)
+			blocked[entry.SessionName] = true
+		}
+		return keep, dead
+	})
+	return states, err
+}
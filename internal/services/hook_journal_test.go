@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+// drainWith makes a journal mock drain pending and store what the service keeps and dead-letters
+func drainWith(journal *portsmocks.MockHookJournal, pending []domain.HookJournalEntry, keep, dead *[]domain.HookJournalEntry) {
+	journal.EXPECT().Drain(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, process ports.HookJournalProcessor) error {
+			*keep, *dead = process(pending)
+			return nil
+		})
+}
+
+func TestHookJournalService_Drain(t *testing.T) {
+	pending := []domain.HookJournalEntry{
+		{EventType: "prompt", ID: "1", SessionName: "api"},
+		{EventType: "stop", ID: "2", SessionName: "api"},
+		{Attempts: maxHookAttempts - 1, EventType: "prompt", ID: "3", SessionName: "web"},
+		{EventType: "stop", ID: "4", SessionName: "gone"},
+		{EventType: "stop", ID: "5", SessionName: "docs"},
+	}
+	locked := errors.New("database is locked")
+
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "api", domain.StateWorking, "").Return(locked)
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "web", domain.StateWorking, "").Return(locked)
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "gone", domain.StateIdle, "").Return(fmt.Errorf("%w: gone", domain.ErrSessionNotFound))
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "docs", domain.StateIdle, "").Return(nil)
+	notificationService := NewNotificationService(stateUpdater, newWorkingSessionReader(t), portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t), newMockEventRepository(t))
+
+	journal := portsmocks.NewMockHookJournal(t)
+	var keep, dead []domain.HookJournalEntry
+	drainWith(journal, pending, &keep, &dead)
+
+	applied, err := NewHookJournalService(journal, notificationService).Drain(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, applied)
+	require.Len(t, keep, 2, "a failed event holds back the later events of its session")
+	assert.Equal(t, "1", keep[0].ID)
+	assert.Equal(t, 1, keep[0].Attempts)
+	assert.Equal(t, "database is locked", keep[0].LastError)
+	assert.Equal(t, "2", keep[1].ID)
+	assert.Zero(t, keep[1].Attempts)
+	require.Len(t, dead, 1, "events of unknown sessions are dropped")
+	assert.Equal(t, "3", dead[0].ID)
+}
+
+func TestHookJournalService_Record(t *testing.T) {
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "api", domain.StateIdle, "exec-1").Return(nil)
+	notificationService := NewNotificationService(stateUpdater, newWorkingSessionReader(t), portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t), newMockEventRepository(t))
+
+	journal := portsmocks.NewMockHookJournal(t)
+	var recorded domain.HookJournalEntry
+	journal.EXPECT().Append(mock.Anything).RunAndReturn(func(entry domain.HookJournalEntry) error {
+		recorded = entry
+		return nil
+	})
+	journal.EXPECT().Drain(mock.Anything, mock.Anything).RunAndReturn(
+		func(_ context.Context, process ports.HookJournalProcessor) error {
+			keep, dead := process([]domain.HookJournalEntry{recorded})
+			assert.Empty(t, keep)
+			assert.Empty(t, dead)
+			return nil
+		})
+
+	state, err := NewHookJournalService(journal, notificationService).Record(context.Background(), "api", "stop", "exec-1")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateIdle, state)
+	assert.NotEmpty(t, recorded.ID)
+	assert.False(t, recorded.ReceivedAt.IsZero())
+}
+
+// newWorkingSessionReader returns a session reader mock that finds every session working,
+// so no event is skipped for a paused session
+func newWorkingSessionReader(t *testing.T) *portsmocks.MockSessionReader {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	sessionReader.EXPECT().Get(mock.Anything, mock.Anything).Return(&domain.Session{State: domain.StateWorking}, nil).Maybe()
+	return sessionReader
+}
@@ -0,0 +1,99 @@
+package services
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// InstanceService coordinates rocha processes sharing a ROCHA_HOME: only the primary one
+// (a TUI or the scheduler) runs background work, so two TUIs never poll-and-write twice.
+// Followers keep showing sessions and take over when the primary exits.
+type InstanceService struct {
+	holder  *domain.InstanceOwner // Primary process last seen while following (nil if unknown)
+	lock    ports.InstanceLock
+	owner   domain.InstanceOwner
+	primary bool
+}
+
+// NewInstanceService creates a new InstanceService
+func NewInstanceService(lock ports.InstanceLock) *InstanceService {
+	return &InstanceService{lock: lock}
+}
+
+// Start tries to make this process, running command ("ui" or "scheduler"), the primary instance.
+// It reports false when another process already is; Holder then tells which one.
+// Where instance locks are not supported a TUI is always primary, while the scheduler
+// fails, since it could not tell whether another one runs the background work.
+func (s *InstanceService) Start(command string) (bool, error) {
+	s.owner = domain.InstanceOwner{
+		Command:   command,
+		PID:       os.Getpid(),
+		StartedAt: time.Now().UTC(),
+		TmuxPane:  os.Getenv("TMUX_PANE"),
+	}
+
+	locked, holder, err := s.lock.TryLock(s.owner)
+	if errors.Is(err, ports.ErrInstanceLockUnsupported) && command != "scheduler" {
+		logging.Logger.Warn("Instance lock not supported, running the background work", "error", err)
+		s.primary = true
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	s.primary = locked
+	s.holder = holder
+	if !locked {
+		logging.Logger.Info("Another rocha instance runs the background work, following it", "holder", holder)
+	}
+	return locked, nil
+}
+
+// TakeOver makes a follower primary once the primary process exited, and reports whether
+// this process is primary. Callers check it before each round of background work.
+func (s *InstanceService) TakeOver() bool {
+	if s.primary {
+		return true
+	}
+
+	locked, holder, err := s.lock.TryLock(s.owner)
+	if err != nil {
+		logging.Logger.Warn("Failed to check the instance lock", "error", err)
+		return false
+	}
+	if holder != nil {
+		s.holder = holder
+	}
+	if locked {
+		logging.Logger.Info("Primary rocha instance exited, taking over background work")
+		s.holder = nil
+		s.primary = true
+	}
+	return s.primary
+}
+
+// IsPrimary reports whether this process runs background work, as of the last check
+func (s *InstanceService) IsPrimary() bool {
+	return s.primary
+}
+
+// Holder returns the primary process while following it (nil when primary or unknown)
+func (s *InstanceService) Holder() *domain.InstanceOwner {
+	return s.holder
+}
+
+// Stop releases the primary role so a follower can take over
+func (s *InstanceService) Stop() {
+	if !s.primary {
+		return
+	}
+	if err := s.lock.Unlock(); err != nil {
+		logging.Logger.Warn("Failed to release the instance lock", "error", err)
+	}
+	s.primary = false
+}
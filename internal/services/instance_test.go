@@ -0,0 +1,76 @@
+package services
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestInstanceService_Primary(t *testing.T) {
+	lock := portsmocks.NewMockInstanceLock(t)
+	lock.EXPECT().TryLock(mock.MatchedBy(func(owner domain.InstanceOwner) bool { return owner.Command == "ui" })).Return(true, nil, nil).Once()
+	lock.EXPECT().Unlock().Return(nil).Once()
+
+	service := NewInstanceService(lock)
+	primary, err := service.Start("ui")
+
+	require.NoError(t, err)
+	assert.True(t, primary)
+	assert.True(t, service.TakeOver(), "a primary does not check the lock again")
+	service.Stop()
+	assert.False(t, service.IsPrimary())
+}
+
+func TestInstanceService_FollowerTakesOver(t *testing.T) {
+	primary := &domain.InstanceOwner{Command: "ui", PID: 4242}
+	lock := portsmocks.NewMockInstanceLock(t)
+	lock.EXPECT().TryLock(mock.Anything).Return(false, primary, nil).Twice()
+	lock.EXPECT().TryLock(mock.Anything).Return(true, nil, nil).Once()
+
+	service := NewInstanceService(lock)
+	started, err := service.Start("ui")
+
+	require.NoError(t, err)
+	assert.False(t, started)
+	assert.False(t, service.TakeOver(), "the primary is still running")
+	assert.Equal(t, primary, service.Holder())
+	assert.True(t, service.TakeOver(), "the primary exited")
+	assert.Nil(t, service.Holder())
+}
+
+func TestInstanceService_LockUnsupported(t *testing.T) {
+	tests := []struct {
+		name        string
+		command     string
+		wantPrimary bool
+		wantErr     bool
+	}{
+		{name: "a TUI runs the background work", command: "ui", wantPrimary: true},
+		{name: "the scheduler refuses to run", command: "scheduler", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lock := portsmocks.NewMockInstanceLock(t)
+			lock.EXPECT().TryLock(mock.Anything).Return(false, nil, fmt.Errorf("%w: file locks are not available", ports.ErrInstanceLockUnsupported)).Once()
+
+			service := NewInstanceService(lock)
+			primary, err := service.Start(tt.command)
+
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ports.ErrInstanceLockUnsupported)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPrimary, primary)
+			assert.True(t, service.TakeOver(), "a primary does not check the lock again")
+		})
+	}
+}
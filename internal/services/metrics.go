@@ -0,0 +1,134 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sync"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// metricsEventBatchSize is how many stored events Refresh reads at a time
+const metricsEventBatchSize = 500
+
+// MetricsService keeps the values exposed on the metrics endpoint in memory.
+// Refresh updates them in the background; Collect only reads them, so scrapes
+// never touch the database, the transcripts, or git.
+type MetricsService struct {
+	eventFeed   ports.EventFeed
+	lastEventID uint                           // Newest stored event counted
+	lastState   map[string]domain.SessionState // State each session last changed to
+	mu          sync.Mutex
+	refreshed   bool
+	sessionRepo ports.SessionReader
+	snapshot    ports.MetricsSnapshot
+	tokenStats  *TokenStatsService
+}
+
+// NewMetricsService creates a new MetricsService
+func NewMetricsService(
+	sessionRepo ports.SessionReader,
+	eventFeed ports.EventFeed,
+	tokenStats *TokenStatsService,
+) *MetricsService {
+	return &MetricsService{
+		eventFeed:   eventFeed,
+		lastState:   make(map[string]domain.SessionState),
+		sessionRepo: sessionRepo,
+		snapshot:    ports.MetricsSnapshot{Transitions: make(map[ports.StateTransition]int)},
+		tokenStats:  tokenStats,
+	}
+}
+
+// Refresh counts the state changes stored since the last refresh and reads the
+// current sessions and token totals. Transitions are counted from the first refresh on,
+// starting from the state each session is in then.
+func (s *MetricsService) Refresh(ctx context.Context) error {
+	sessions, err := s.sessionRepo.List(ctx, false)
+	if err != nil {
+		return fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	sessionsByState := map[domain.SessionState]int{
+		domain.StateExited:  0,
+		domain.StateIdle:    0,
+		domain.StatePaused:  0,
+		domain.StateWaiting: 0,
+		domain.StateWorking: 0,
+	}
+	for _, sess := range sessions {
+		sessionsByState[sess.State]++
+	}
+
+	totals, err := s.tokenStats.GetTodayTotals()
+	if err != nil {
+		logging.Logger.Warn("Failed to read token totals for metrics", "error", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.refreshed {
+		latest, err := s.eventFeed.LatestEventID(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to read the latest event: %w", err)
+		}
+		s.lastEventID = latest
+		for _, sess := range sessions {
+			s.lastState[sess.Name] = sess.State
+		}
+		s.refreshed = true
+	}
+
+	if err := s.countTransitions(ctx); err != nil {
+		return err
+	}
+
+	s.snapshot.SessionsByState = sessionsByState
+	s.snapshot.TodayTokens = totals
+	return nil
+}
+
+// countTransitions adds the state changes stored after lastEventID to the transition counters
+// Must be called with mu held
+func (s *MetricsService) countTransitions(ctx context.Context) error {
+	for {
+		events, err := s.eventFeed.ListEventsAfter(ctx, s.lastEventID, metricsEventBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list events: %w", err)
+		}
+		for _, event := range events {
+			s.lastEventID = event.ID
+			if event.Type != domain.EventStateChange {
+				continue
+			}
+			from := s.lastState[event.SessionName]
+			if from == event.State {
+				continue
+			}
+			s.snapshot.Transitions[ports.StateTransition{From: from, To: event.State}]++
+			s.lastState[event.SessionName] = event.State
+		}
+		if len(events) < metricsEventBatchSize {
+			return nil
+		}
+	}
+}
+
+// Collect returns a copy of the values of the last refresh
+func (s *MetricsService) Collect(ctx context.Context) (*ports.MetricsSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.refreshed {
+		return nil, fmt.Errorf("metrics have not been collected yet")
+	}
+	return &ports.MetricsSnapshot{
+		SessionsByState: maps.Clone(s.snapshot.SessionsByState),
+		TodayTokens:     s.snapshot.TodayTokens,
+		Transitions:     maps.Clone(s.snapshot.Transitions),
+	}, nil
+}
@@ -0,0 +1,79 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestMetricsService_Refresh(t *testing.T) {
+	ctx := context.Background()
+	sessionRepo := portsmocks.NewMockSessionReader(t)
+	eventFeed := portsmocks.NewMockEventFeed(t)
+	tokenReader := portsmocks.NewMockTokenUsageReader(t)
+
+	sessionRepo.EXPECT().List(ctx, false).Return([]domain.Session{
+		{Name: "a", State: domain.StateWorking},
+		{Name: "b", State: domain.StateWorking},
+		{Name: "c", State: domain.StateWaiting},
+	}, nil)
+	tokenReader.EXPECT().GetTodayUsage().Return([]ports.TokenUsage{{InputTokens: 10, OutputTokens: 5}}, nil)
+
+	// Events stored before the first refresh are not counted
+	eventFeed.EXPECT().LatestEventID(ctx).Return(uint(40), nil).Once()
+	eventFeed.EXPECT().ListEventsAfter(ctx, uint(40), metricsEventBatchSize).Return(nil, nil).Once()
+
+	service := NewMetricsService(sessionRepo, eventFeed, NewTokenStatsService(tokenReader))
+	require.NoError(t, service.Refresh(ctx))
+
+	eventFeed.EXPECT().ListEventsAfter(ctx, uint(40), metricsEventBatchSize).Return([]domain.Event{
+		{ID: 41, SessionName: "a", State: domain.StateIdle, Type: domain.EventStateChange},
+		{ID: 42, SessionName: "a", State: domain.StateIdle, Type: domain.EventStateChange}, // Repeated state is not a transition
+		{ID: 43, SessionName: "a", Type: domain.EventHandoff},
+		{ID: 44, SessionName: "d", State: domain.StateWorking, Type: domain.EventStateChange},
+		{ID: 45, SessionName: "a", State: domain.StateWorking, Type: domain.EventStateChange},
+	}, nil).Once()
+	require.NoError(t, service.Refresh(ctx))
+
+	eventFeed.EXPECT().ListEventsAfter(ctx, uint(45), metricsEventBatchSize).Return([]domain.Event{
+		{ID: 46, SessionName: "c", State: domain.StateWorking, Type: domain.EventStateChange},
+	}, nil).Once()
+	require.NoError(t, service.Refresh(ctx))
+
+	snapshot, err := service.Collect(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, map[domain.SessionState]int{
+		domain.StateExited:  0,
+		domain.StateIdle:    0,
+		domain.StatePaused:  0,
+		domain.StateWaiting: 1,
+		domain.StateWorking: 2,
+	}, snapshot.SessionsByState)
+	assert.Equal(t, map[ports.StateTransition]int{
+		{From: domain.StateWorking, To: domain.StateIdle}:    1,
+		{From: "", To: domain.StateWorking}:                  1,
+		{From: domain.StateIdle, To: domain.StateWorking}:    1,
+		{From: domain.StateWaiting, To: domain.StateWorking}: 1,
+	}, snapshot.Transitions)
+	assert.Equal(t, 10, snapshot.TodayTokens.InputTokens)
+	assert.Equal(t, 5, snapshot.TodayTokens.OutputTokens)
+}
+
+func TestMetricsService_RefreshFailsWhenSessionsCannotBeListed(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionReader(t)
+	sessionRepo.EXPECT().List(mock.Anything, false).Return(nil, errors.New("database is locked"))
+
+	service := NewMetricsService(sessionRepo, portsmocks.NewMockEventFeed(t), NewTokenStatsService(portsmocks.NewMockTokenUsageReader(t)))
+
+	assert.ErrorContains(t, service.Refresh(context.Background()), "database is locked")
+	_, err := service.Collect(context.Background())
+	assert.Error(t, err, "nothing is collected until a refresh succeeds")
+}
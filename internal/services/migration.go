@@ -6,6 +6,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/renato0307/rocha/internal/config"
@@ -40,10 +41,43 @@ func NewMigrationService(
 // MoveRepositoryBetweenHomesParams contains parameters for moving a repository between ROCHA_HOME directories
 type MoveRepositoryBetweenHomesParams struct {
 	DestRochaHome   string
+	Progress        io.Writer         // Receives progress lines (nil discards them)
+	Renames         map[string]string // Sessions moved under a new name (old name -> new name)
 	RepoInfo        string
+	Skip            []string // Sessions left in the source home
 	SourceRochaHome string
 }
 
+// MovePlan previews a repository move between ROCHA_HOME directories
+type MovePlan struct {
+	DestMainPath   string   // Main repository directory at the destination ("" without one)
+	DestNames      []string // Sessions already in the destination home
+	MainConflict   string   // Why the main repository cannot move ("" when it can)
+	ReuseDestMain  bool     // The destination already has a clone of the same repository
+	RepoInfo       string
+	Sessions       []MovePlanSession
+	SourceMainPath string
+}
+
+// MovePlanSession previews the move of one session
+type MovePlanSession struct {
+	Conflict       string // Why the session cannot move under its name ("" when it can)
+	DestWorktree   string
+	Session        domain.Session
+	SourceWorktree string
+}
+
+// Conflicts returns the sessions that need a new name to move
+func (p *MovePlan) Conflicts() []MovePlanSession {
+	var conflicts []MovePlanSession
+	for _, session := range p.Sessions {
+		if session.Conflict != "" {
+			conflicts = append(conflicts, session)
+		}
+	}
+	return conflicts
+}
+
 // MoveRepositoryBetweenHomesResult contains the result of a repository move operation
 type MoveRepositoryBetweenHomesResult struct {
 	MovedSessionCount int
@@ -77,10 +111,17 @@ func (s *MigrationService) MoveRepositoryBetweenHomes(
 	}
 
 	var sourceSessions []domain.Session
+	var skipped bool
 	for _, sess := range sessions {
-		if sess.RepoInfo == params.RepoInfo {
-			sourceSessions = append(sourceSessions, sess)
+		if sess.RepoInfo != params.RepoInfo {
+			continue
 		}
+		if slices.Contains(params.Skip, sess.Name) {
+			logging.Logger.Info("Leaving session in source", "session", sess.Name)
+			skipped = true
+			continue
+		}
+		sourceSessions = append(sourceSessions, sess)
 	}
 
 	if len(sourceSessions) == 0 {
@@ -88,6 +129,11 @@ func (s *MigrationService) MoveRepositoryBetweenHomes(
 		return nil, fmt.Errorf("no sessions found for repository: %s", params.RepoInfo)
 	}
 
+	out := params.Progress
+	if out == nil {
+		out = io.Discard
+	}
+
 	// Open destination repository
 	destRepo, err := s.repoFactory(params.DestRochaHome)
 	if err != nil {
@@ -97,9 +143,13 @@ func (s *MigrationService) MoveRepositoryBetweenHomes(
 	defer destRepo.Close()
 
 	// Move repository using internal method
+	// Sessions left behind keep using the source main repository, so it is copied instead of moved
 	movedNames, err := s.moveRepository(ctx, moveRepositoryInternalParams{
 		DestRochaHome:   params.DestRochaHome,
 		DestSessionRepo: destRepo,
+		KeepSourceMain:  skipped,
+		Out:             out,
+		Renames:         params.Renames,
 		RepoInfo:        params.RepoInfo,
 		SourceRochaHome: params.SourceRochaHome,
 		SourceSessions:  sourceSessions,
@@ -108,13 +158,13 @@ func (s *MigrationService) MoveRepositoryBetweenHomes(
 		return nil, err
 	}
 
-	// Delete sessions from source
-	fmt.Printf("Cleaning up source database...\n")
-	for _, sessName := range movedNames {
-		logging.Logger.Debug("Deleting session from source", "session", sessName)
-		if err := sourceRepo.Delete(ctx, sessName); err != nil {
-			logging.Logger.Warn("Failed to delete session from source", "session", sessName, "error", err)
-			fmt.Printf("⚠ Warning: Failed to delete session %s from source: %v\n", sessName, err)
+	// Delete sessions from source (under their original names)
+	fmt.Fprintf(out, "Cleaning up source database...\n")
+	for _, sess := range sourceSessions {
+		logging.Logger.Debug("Deleting session from source", "session", sess.Name)
+		if err := sourceRepo.Delete(ctx, sess.Name); err != nil {
+			logging.Logger.Warn("Failed to delete session from source", "session", sess.Name, "error", err)
+			fmt.Fprintf(out, "⚠ Warning: Failed to delete session %s from source: %v\n", sess.Name, err)
 		}
 	}
 
@@ -124,6 +174,112 @@ func (s *MigrationService) MoveRepositoryBetweenHomes(
 	}, nil
 }
 
+// PlanRepositoryMove previews moving a repository's sessions to another ROCHA_HOME without changing anything
+// Sessions whose name or worktree directory is already taken at the destination are reported as conflicts
+func (s *MigrationService) PlanRepositoryMove(
+	ctx context.Context,
+	sourceRochaHome string,
+	destRochaHome string,
+	repoInfo string,
+) (*MovePlan, error) {
+	sessions, err := s.GetSessionsForRepo(ctx, sourceRochaHome, repoInfo)
+	if err != nil {
+		return nil, err
+	}
+	if len(sessions) == 0 {
+		return nil, fmt.Errorf("no sessions found for repository: %s", repoInfo)
+	}
+
+	plan := &MovePlan{
+		RepoInfo:       repoInfo,
+		SourceMainPath: sessions[0].RepoPath,
+	}
+
+	// Only read an existing destination database: planning must not create one
+	if _, err := os.Stat(filepath.Join(destRochaHome, "state.db")); err == nil {
+		destRepo, err := s.repoFactory(destRochaHome)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open destination database: %w", err)
+		}
+		defer destRepo.Close()
+
+		destSessions, err := destRepo.List(ctx, true)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list destination sessions: %w", err)
+		}
+		for _, sess := range destSessions {
+			plan.DestNames = append(plan.DestNames, sess.Name)
+		}
+	}
+
+	if plan.SourceMainPath != "" {
+		plan.DestMainPath = strings.Replace(plan.SourceMainPath, sourceRochaHome, destRochaHome, 1)
+		if _, err := os.Stat(plan.DestMainPath); err == nil {
+			sourceRemote := s.gitRepo.GetRemoteURL(plan.SourceMainPath)
+			destRemote := s.gitRepo.GetRemoteURL(plan.DestMainPath)
+			if sourceRemote != "" && destRemote != "" && s.isSameRepo(sourceRemote, destRemote) {
+				plan.ReuseDestMain = true
+			} else {
+				plan.MainConflict = fmt.Sprintf("%s already exists at the destination and is not a clone of %s", plan.DestMainPath, repoInfo)
+			}
+		}
+	}
+
+	for _, sess := range sessions {
+		planned := MovePlanSession{Session: sess, SourceWorktree: sess.WorktreePath}
+		if sess.WorktreePath != "" {
+			planned.DestWorktree = strings.Replace(sess.WorktreePath, sourceRochaHome, destRochaHome, 1)
+		}
+
+		switch {
+		case slices.Contains(plan.DestNames, sess.Name):
+			planned.Conflict = "a session with this name exists at the destination"
+		case planned.DestWorktree != "" && pathExists(planned.DestWorktree):
+			planned.Conflict = fmt.Sprintf("%s already exists at the destination", planned.DestWorktree)
+		}
+		plan.Sessions = append(plan.Sessions, planned)
+	}
+
+	return plan, nil
+}
+
+// SuggestRenames picks a free "<name>-N" for every colliding session: one no session at the
+// destination or moving with it uses, and whose renamed worktree does not exist yet
+func (p *MovePlan) SuggestRenames() map[string]string {
+	taken := slices.Clone(p.DestNames)
+	for _, planned := range p.Sessions {
+		if planned.Conflict == "" {
+			taken = append(taken, planned.Session.Name)
+		}
+	}
+
+	renames := make(map[string]string)
+	for _, conflict := range p.Conflicts() {
+		newName := domain.NextSessionName(conflict.Session.Name, func(candidate string) bool {
+			return candidate == conflict.Session.Name || slices.Contains(taken, candidate) ||
+				(conflict.DestWorktree != "" && pathExists(RenamedWorktreePath(conflict.DestWorktree, candidate)))
+		})
+		renames[conflict.Session.Name] = newName
+		taken = append(taken, newName)
+	}
+	return renames
+}
+
+// RenamedWorktreePath returns where the worktree of a session moved under newName goes
+// Worktree directories are named after their session
+func RenamedWorktreePath(worktreePath string, newName string) string {
+	if worktreePath == "" {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(worktreePath), newName)
+}
+
+// pathExists reports whether a file or directory exists at path
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
 // GetSessionsForRepo returns sessions for a specific repository from a ROCHA_HOME directory
 func (s *MigrationService) GetSessionsForRepo(
 	ctx context.Context,
@@ -154,6 +310,9 @@ func (s *MigrationService) GetSessionsForRepo(
 type moveRepositoryInternalParams struct {
 	DestRochaHome   string
 	DestSessionRepo ports.SessionRepository
+	KeepSourceMain  bool      // Copy the main repository instead of moving it
+	Out             io.Writer // Progress output
+	Renames         map[string]string
 	RepoInfo        string
 	SourceRochaHome string
 	SourceSessions  []domain.Session
@@ -179,6 +338,7 @@ func (s *MigrationService) MoveRepository(
 	return s.moveRepository(ctx, moveRepositoryInternalParams{
 		DestRochaHome:   params.DestRochaHome,
 		DestSessionRepo: params.DestSessionRepo,
+		Out:             os.Stdout,
 		RepoInfo:        params.RepoInfo,
 		SourceRochaHome: params.SourceRochaHome,
 		SourceSessions:  params.SourceSessions,
@@ -195,7 +355,8 @@ func (s *MigrationService) moveRepository(
 		"from", params.SourceRochaHome,
 		"to", params.DestRochaHome)
 
-	repoSessions := params.SourceSessions
+	// Copy: paths and names are rewritten below, while callers still need the source names
+	repoSessions := slices.Clone(params.SourceSessions)
 	if len(repoSessions) == 0 {
 		logging.Logger.Error("No sessions found for repository", "repo", params.RepoInfo)
 		return nil, fmt.Errorf("no sessions found for repository: %s", params.RepoInfo)
@@ -207,7 +368,7 @@ func (s *MigrationService) moveRepository(
 	mainRepoPath := repoSessions[0].RepoPath
 	if mainRepoPath == "" {
 		logging.Logger.Warn("No RepoPath found for repository sessions", "repo", params.RepoInfo)
-		fmt.Printf("⚠ Warning: No %s directory found for repository %s\n", config.MainRepoDir, params.RepoInfo)
+		fmt.Fprintf(params.Out, "⚠ Warning: No %s directory found for repository %s\n", config.MainRepoDir, params.RepoInfo)
 	}
 
 	// Validate all sessions share the same main repository path
@@ -224,10 +385,10 @@ func (s *MigrationService) moveRepository(
 	// Kill all tmux sessions first
 	logging.Logger.Debug("Killing tmux sessions for repository", "repo", params.RepoInfo)
 	for _, sess := range repoSessions {
-		fmt.Printf("Killing tmux session '%s'...\n", sess.Name)
+		fmt.Fprintf(params.Out, "Killing tmux session '%s'...\n", sess.Name)
 		if err := s.tmuxClient.KillSession(sess.Name); err != nil {
 			logging.Logger.Warn("Failed to kill tmux session", "session", sess.Name, "error", err)
-			fmt.Printf("⚠ Warning: Failed to kill tmux session %s: %v\n", sess.Name, err)
+			fmt.Fprintf(params.Out, "⚠ Warning: Failed to kill tmux session %s: %v\n", sess.Name, err)
 		}
 
 		// Kill shell session if exists
@@ -236,7 +397,7 @@ func (s *MigrationService) moveRepository(
 			logging.Logger.Debug("Killing shell session", "session", shellName)
 			if err := s.tmuxClient.KillSession(shellName); err != nil {
 				logging.Logger.Warn("Failed to kill shell session", "session", shellName, "error", err)
-				fmt.Printf("⚠ Warning: Failed to kill shell session %s: %v\n", shellName, err)
+				fmt.Fprintf(params.Out, "⚠ Warning: Failed to kill shell session %s: %v\n", shellName, err)
 			}
 		}
 	}
@@ -247,13 +408,13 @@ func (s *MigrationService) moveRepository(
 		destMainPath := strings.Replace(mainRepoPath, params.SourceRochaHome, params.DestRochaHome, 1)
 
 		logging.Logger.Info("Moving main repository directory", "from", sourceMainPath, "to", destMainPath)
-		fmt.Printf("Moving main repository directory...\n")
+		fmt.Fprintf(params.Out, "Moving main repository directory...\n")
 
-		if err := s.moveMainDirectory(sourceMainPath, destMainPath); err != nil {
+		if err := s.moveMainDirectory(params.Out, sourceMainPath, destMainPath, params.KeepSourceMain); err != nil {
 			logging.Logger.Error("Failed to move main repository directory", "error", err)
 			return nil, fmt.Errorf("failed to move main repository directory: %w", err)
 		}
-		fmt.Printf("✓ Moved main repository directory\n")
+		fmt.Fprintf(params.Out, "✓ Moved main repository directory\n")
 
 		// Update mainRepoPath to point to new location
 		mainRepoPath = destMainPath
@@ -262,23 +423,27 @@ func (s *MigrationService) moveRepository(
 	// Move all session worktrees and collect paths for repair
 	var movedWorktreePaths []string
 	for i := range repoSessions {
+		sourceWorktree := repoSessions[i].WorktreePath
+
 		// Update session paths
 		s.updateSessionPaths(&repoSessions[i], params.SourceRochaHome, params.DestRochaHome)
+		if newName, ok := params.Renames[repoSessions[i].Name]; ok {
+			s.renameMovedSession(&repoSessions[i], newName)
+		}
 
 		// Move worktree if exists
 		if repoSessions[i].WorktreePath != "" {
-			sourceWorktree := strings.Replace(repoSessions[i].WorktreePath, params.DestRochaHome, params.SourceRochaHome, 1)
 			destWorktree := repoSessions[i].WorktreePath
 
-			fmt.Printf("Moving worktree '%s'...\n", repoSessions[i].Name)
+			fmt.Fprintf(params.Out, "Moving worktree '%s'...\n", repoSessions[i].Name)
 			logging.Logger.Info("Moving worktree", "session", repoSessions[i].Name, "from", sourceWorktree, "to", destWorktree)
 
 			if err := s.moveWorktree(sourceWorktree, destWorktree); err != nil {
 				logging.Logger.Warn("Failed to move worktree", "session", repoSessions[i].Name, "error", err)
-				fmt.Printf("⚠ Warning: Failed to move worktree for %s: %v\n", repoSessions[i].Name, err)
+				fmt.Fprintf(params.Out, "⚠ Warning: Failed to move worktree for %s: %v\n", repoSessions[i].Name, err)
 			} else {
 				movedWorktreePaths = append(movedWorktreePaths, destWorktree)
-				fmt.Printf("✓ Moved worktree '%s'\n", repoSessions[i].Name)
+				fmt.Fprintf(params.Out, "✓ Moved worktree '%s'\n", repoSessions[i].Name)
 			}
 		}
 
@@ -292,14 +457,14 @@ func (s *MigrationService) moveRepository(
 
 	// Repair git worktree references if we moved .main and worktrees
 	if mainRepoPath != "" && len(movedWorktreePaths) > 0 {
-		fmt.Printf("Repairing git worktree references...\n")
+		fmt.Fprintf(params.Out, "Repairing git worktree references...\n")
 		logging.Logger.Info("Repairing worktree references", "mainRepo", mainRepoPath, "worktreeCount", len(movedWorktreePaths))
 
 		if err := s.gitRepo.RepairWorktrees(mainRepoPath, movedWorktreePaths); err != nil {
 			logging.Logger.Error("Failed to repair worktrees", "error", err)
 			return nil, fmt.Errorf("failed to repair worktrees: %w", err)
 		}
-		fmt.Printf("✓ Repaired worktree references\n")
+		fmt.Fprintf(params.Out, "✓ Repaired worktree references\n")
 	}
 
 	// Collect moved session names
@@ -412,15 +577,24 @@ func (s *MigrationService) updateSessionPaths(sess *domain.Session, sourceRochaH
 	if sess.ClaudeDir != "" && strings.Contains(sess.ClaudeDir, sourceRochaHome) {
 		sess.ClaudeDir = strings.Replace(sess.ClaudeDir, sourceRochaHome, destRochaHome, 1)
 	}
+}
 
-	// Update shell session paths if exists
+// renameMovedSession gives a moving session a new name, along with its shell session and worktree directory
+func (s *MigrationService) renameMovedSession(sess *domain.Session, newName string) {
+	logging.Logger.Info("Renaming moved session", "session", sess.Name, "newName", newName)
+	if sess.DisplayName == sess.Name {
+		sess.DisplayName = newName
+	}
+	sess.Name = newName
+	sess.WorktreePath = RenamedWorktreePath(sess.WorktreePath, newName)
 	if sess.ShellSession != nil {
-		s.updateSessionPaths(sess.ShellSession, sourceRochaHome, destRochaHome)
+		sess.ShellSession = &domain.ShellSession{Name: domain.ShellSessionName(newName), ParentName: newName}
 	}
 }
 
 // moveMainDirectory moves a main repository directory from source to destination
-func (s *MigrationService) moveMainDirectory(sourcePath, destPath string) error {
+// With keepSource the directory is copied and the source stays in place
+func (s *MigrationService) moveMainDirectory(out io.Writer, sourcePath, destPath string, keepSource bool) error {
 	logging.Logger.Info("Moving main repository directory", "from", sourcePath, "to", destPath)
 
 	// Check if source exists
@@ -450,7 +624,7 @@ func (s *MigrationService) moveMainDirectory(sourcePath, destPath string) error
 
 		// Same repo - use existing main repository, don't move
 		logging.Logger.Info("Destination main repository is same repository, using existing", "path", destPath)
-		fmt.Printf("✓ Using existing main repository at destination (same repository)\n")
+		fmt.Fprintf(out, "✓ Using existing main repository at destination (same repository)\n")
 		return nil
 	}
 
@@ -459,6 +633,14 @@ func (s *MigrationService) moveMainDirectory(sourcePath, destPath string) error
 		return fmt.Errorf("failed to create destination directory: %w", err)
 	}
 
+	if keepSource {
+		if err := s.copyDirectory(sourcePath, destPath); err != nil {
+			return fmt.Errorf("failed to copy main repository directory: %w", err)
+		}
+		logging.Logger.Info("Main repository directory copied", "from", sourcePath, "to", destPath)
+		return nil
+	}
+
 	// Try atomic rename first (works if same filesystem)
 	err := os.Rename(sourcePath, destPath)
 	if err == nil {
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+// newPlanTestService returns a MigrationService reading the given sessions per ROCHA_HOME
+func newPlanTestService(t *testing.T, gitRepo ports.GitRepository, sessionsByHome map[string][]domain.Session) *MigrationService {
+	factory := func(rochaHome string) (ports.SessionRepository, error) {
+		repo := portsmocks.NewMockSessionRepository(t)
+		repo.EXPECT().List(context.Background(), true).Return(sessionsByHome[rochaHome], nil).Maybe()
+		repo.EXPECT().List(context.Background(), false).Return(sessionsByHome[rochaHome], nil).Maybe()
+		repo.EXPECT().Close().Return(nil)
+		return repo, nil
+	}
+	return NewMigrationService(gitRepo, portsmocks.NewMockTmuxSessionLifecycle(t), factory)
+}
+
+func TestPlanRepositoryMove_DetectsNameAndWorktreeConflicts(t *testing.T) {
+	source := t.TempDir()
+	dest := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dest, "state.db"), nil, 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(dest, "worktrees", "owner", "repo", "taken-dir"), 0755))
+
+	worktree := func(home, name string) string { return filepath.Join(home, "worktrees", "owner", "repo", name) }
+	service := newPlanTestService(t, portsmocks.NewMockGitRepository(t), map[string][]domain.Session{
+		source: {
+			{Name: "clean", RepoInfo: "owner/repo", WorktreePath: worktree(source, "clean")},
+			{Name: "dup", RepoInfo: "owner/repo", WorktreePath: worktree(source, "dup")},
+			{Name: "taken-dir", RepoInfo: "owner/repo", WorktreePath: worktree(source, "taken-dir")},
+			{Name: "other", RepoInfo: "owner/other"},
+		},
+		dest: {{Name: "dup", RepoInfo: "owner/elsewhere"}},
+	})
+
+	plan, err := service.PlanRepositoryMove(context.Background(), source, dest, "owner/repo")
+
+	require.NoError(t, err)
+	assert.Len(t, plan.Sessions, 3)
+	assert.Equal(t, []string{"dup"}, plan.DestNames)
+	assert.Equal(t, worktree(dest, "clean"), plan.Sessions[0].DestWorktree)
+
+	var conflicting []string
+	for _, conflict := range plan.Conflicts() {
+		conflicting = append(conflicting, conflict.Session.Name)
+	}
+	assert.Equal(t, []string{"dup", "taken-dir"}, conflicting)
+}
+
+func TestMovePlan_SuggestRenames(t *testing.T) {
+	dest := t.TempDir()
+	worktree := func(name string) string { return filepath.Join(dest, "worktrees", "owner", "repo", name) }
+	require.NoError(t, os.MkdirAll(worktree("wt-2"), 0755))
+
+	plan := &MovePlan{
+		DestNames: []string{"dup", "dup-2"},
+		Sessions: []MovePlanSession{
+			{Session: domain.Session{Name: "dup-3"}},
+			{Conflict: "name", Session: domain.Session{Name: "dup"}},
+			{Conflict: "worktree", DestWorktree: worktree("wt"), Session: domain.Session{Name: "wt"}},
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		"dup": "dup-4", // dup-2 is at the destination, dup-3 moves with it
+		"wt":  "wt-3",  // wt-2 is taken by a worktree directory
+	}, plan.SuggestRenames())
+}
+
+func TestPlanRepositoryMove_SkipsMissingDestinationDatabase(t *testing.T) {
+	source := t.TempDir()
+	dest := filepath.Join(t.TempDir(), "new-home")
+
+	service := newPlanTestService(t, portsmocks.NewMockGitRepository(t), map[string][]domain.Session{
+		source: {{Name: "one", RepoInfo: "owner/repo"}},
+	})
+
+	plan, err := service.PlanRepositoryMove(context.Background(), source, dest, "owner/repo")
+
+	require.NoError(t, err)
+	assert.Empty(t, plan.Conflicts())
+	assert.Empty(t, plan.DestNames)
+	assert.NoDirExists(t, dest, "planning must not create the destination")
+}
+
+func TestPlanRepositoryMove_MainDirectory(t *testing.T) {
+	tests := []struct {
+		name         string
+		destRemote   string
+		wantConflict bool
+		wantReuse    bool
+	}{
+		{name: "same repository is reused", destRemote: "git@github.com:owner/repo.git", wantReuse: true},
+		{name: "different repository conflicts", destRemote: "https://github.com/someone/else", wantConflict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := t.TempDir()
+			dest := t.TempDir()
+			sourceMain := filepath.Join(source, "worktrees", "owner", "repo", "main")
+			destMain := filepath.Join(dest, "worktrees", "owner", "repo", "main")
+			require.NoError(t, os.MkdirAll(destMain, 0755))
+
+			gitRepo := portsmocks.NewMockGitRepository(t)
+			gitRepo.EXPECT().GetRemoteURL(sourceMain).Return("https://github.com/owner/repo")
+			gitRepo.EXPECT().GetRemoteURL(destMain).Return(tt.destRemote)
+
+			service := newPlanTestService(t, gitRepo, map[string][]domain.Session{
+				source: {{Name: "one", RepoInfo: "owner/repo", RepoPath: sourceMain}},
+			})
+
+			plan, err := service.PlanRepositoryMove(context.Background(), source, dest, "owner/repo")
+
+			require.NoError(t, err)
+			assert.Equal(t, destMain, plan.DestMainPath)
+			assert.Equal(t, tt.wantReuse, plan.ReuseDestMain)
+			assert.Equal(t, tt.wantConflict, plan.MainConflict != "")
+		})
+	}
+}
+
+func TestRenamedWorktreePath(t *testing.T) {
+	assert.Equal(t, "/home/.rocha/worktrees/o/r/new", RenamedWorktreePath("/home/.rocha/worktrees/o/r/old", "new"))
+	assert.Empty(t, RenamedWorktreePath("", "new"))
+}
+
+func TestMoveRepositoryBetweenHomes_RenamesAndSkips(t *testing.T) {
+	ctx := context.Background()
+	sourceRepo := portsmocks.NewMockSessionRepository(t)
+	destRepo := portsmocks.NewMockSessionRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+
+	sourceRepo.EXPECT().List(ctx, false).Return([]domain.Session{
+		{Name: "keep", RepoInfo: "owner/repo"},
+		{Name: "dup", DisplayName: "dup", RepoInfo: "owner/repo"},
+		{Name: "stay", RepoInfo: "owner/repo"},
+	}, nil)
+	sourceRepo.EXPECT().Close().Return(nil)
+	destRepo.EXPECT().Close().Return(nil)
+	tmuxClient.EXPECT().KillSession("keep").Return(nil)
+	tmuxClient.EXPECT().KillSession("dup").Return(nil)
+
+	destRepo.EXPECT().Add(ctx, domain.Session{Name: "keep", RepoInfo: "owner/repo"}).Return(nil)
+	destRepo.EXPECT().Add(ctx, domain.Session{Name: "dup-2", DisplayName: "dup-2", RepoInfo: "owner/repo"}).Return(nil)
+
+	// Sessions are deleted from the source under their original names
+	sourceRepo.EXPECT().Delete(ctx, "keep").Return(nil)
+	sourceRepo.EXPECT().Delete(ctx, "dup").Return(nil)
+
+	service := NewMigrationService(portsmocks.NewMockGitRepository(t), tmuxClient, func(rochaHome string) (ports.SessionRepository, error) {
+		if rochaHome == "/source" {
+			return sourceRepo, nil
+		}
+		return destRepo, nil
+	})
+
+	result, err := service.MoveRepositoryBetweenHomes(ctx, MoveRepositoryBetweenHomesParams{
+		DestRochaHome:   "/dest",
+		Renames:         map[string]string{"dup": "dup-2"},
+		RepoInfo:        "owner/repo",
+		Skip:            []string{"stay"},
+		SourceRochaHome: "/source",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, result.MovedSessionCount)
+}
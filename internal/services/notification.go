@@ -3,17 +3,23 @@ package services
 import (
 	"context"
 	"os"
+	"time"
 
 	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 )
 
+// publishTimeout bounds how long announcing an event can delay the hook that caused it
+const publishTimeout = 2 * time.Second
+
 // NotificationService handles notification events from Claude hooks
 type NotificationService struct {
-	sessionReader ports.SessionReader
-	sessionRepo   ports.SessionStateUpdater
-	soundPlayer   ports.SoundPlayer
+	eventPublisher ports.EventPublisher
+	eventRepo      ports.EventRepository
+	sessionReader  ports.SessionReader
+	sessionRepo    ports.SessionStateUpdater
+	soundPlayer    ports.SoundPlayer
 }
 
 // NewNotificationService creates a new NotificationService
@@ -21,21 +27,27 @@ func NewNotificationService(
 	sessionRepo ports.SessionStateUpdater,
 	sessionReader ports.SessionReader,
 	soundPlayer ports.SoundPlayer,
+	eventPublisher ports.EventPublisher,
+	eventRepo ports.EventRepository,
 ) *NotificationService {
 	return &NotificationService{
-		sessionReader: sessionReader,
-		sessionRepo:   sessionRepo,
-		soundPlayer:   soundPlayer,
+		eventPublisher: eventPublisher,
+		eventRepo:      eventRepo,
+		sessionReader:  sessionReader,
+		sessionRepo:    sessionRepo,
+		soundPlayer:    soundPlayer,
 	}
 }
 
 // HandleEvent processes a notification event and updates session state
+// occurredAt is when the hook fired, which for a replayed journal entry is long before now.
 // Returns the mapped session state for the event type
 func (s *NotificationService) HandleEvent(
 	ctx context.Context,
 	sessionName string,
 	eventType string,
 	executionID string,
+	occurredAt time.Time,
 ) (domain.SessionState, error) {
 	// Map event type to session state and determine if it's an intermediate event
 	var sessionState domain.SessionState
@@ -59,6 +71,9 @@ func (s *NotificationService) HandleEvent(
 	case "tool-failure":
 		sessionState = domain.StateWorking // Tool failed, Claude continues
 		isIntermediateEvent = true
+	case "tool-audit":
+		sessionState = domain.StateWorking // Any tool completed (sessions that skip permission prompts)
+		isIntermediateEvent = true
 	case "subagent-start":
 		sessionState = domain.StateWorking // Spawning subagent
 		isIntermediateEvent = true
@@ -81,14 +96,32 @@ func (s *NotificationService) HandleEvent(
 
 	logging.Logger.Debug("Mapped event to state", "event", eventType, "state", sessionState, "intermediate", isIntermediateEvent)
 
+	// Only a prompt, a restart, or the agent exiting ends a pause: interrupting the agent
+	// may still fire the stop or notification hooks
+	keepsPause := eventType != "prompt" && eventType != "start" && eventType != "end"
+
+	// The current state decides whether the event may change it and whether it is a change
+	// at all. If it can't be read, proceed with the update and announce it (fail open).
+	var previousState domain.SessionState
+	currentSession, err := s.sessionReader.Get(ctx, sessionName)
+	if err == nil {
+		previousState = currentSession.State
+	}
+
 	// For intermediate events, check current state to avoid overwriting terminal states
 	// This prevents race conditions where subagent-stop fires after stop
-	if isIntermediateEvent {
-		currentSession, err := s.sessionReader.Get(ctx, sessionName)
+	if isIntermediateEvent || keepsPause {
 		if err == nil {
 			currentState := currentSession.State
+			if currentState == domain.StatePaused {
+				logging.Logger.Info("Skipping event - session is paused",
+					"session", sessionName,
+					"event", eventType,
+					"would_set", sessionState)
+				return currentState, nil
+			}
 			// Don't overwrite idle or exited states with intermediate events
-			if currentState == domain.StateIdle || currentState == domain.StateExited {
+			if isIntermediateEvent && (currentState == domain.StateIdle || currentState == domain.StateExited) {
 				logging.Logger.Info("Skipping intermediate event - would overwrite terminal state",
 					"session", sessionName,
 					"event", eventType,
@@ -97,12 +130,12 @@ func (s *NotificationService) HandleEvent(
 				return currentState, nil
 			}
 		}
-		// If we can't read current state, proceed with update (fail open)
 	}
 
 	// Update session state in repository
 	if err := s.sessionRepo.UpdateState(ctx, sessionName, sessionState, executionID); err != nil {
 		logging.Logger.Error("Failed to update session state", "error", err)
+		s.publish(ctx, domain.Event{Error: err.Error(), SessionName: sessionName, Timestamp: occurredAt, Type: domain.EventError})
 		return sessionState, err
 	}
 
@@ -111,9 +144,40 @@ func (s *NotificationService) HandleEvent(
 		"state", sessionState,
 		"execution_id", executionID)
 
+	// Intermediate events and events repeating the current state aren't transitions worth announcing
+	if !isIntermediateEvent && previousState != sessionState {
+		s.publish(ctx, domain.Event{SessionName: sessionName, State: sessionState, Timestamp: occurredAt, Type: domain.EventStateChange})
+	}
+
 	return sessionState, nil
 }
 
+// RecordClaudeSessionID stores the Claude conversation ID reported by the SessionStart hook
+// so the session can later be restarted with the same conversation
+func (s *NotificationService) RecordClaudeSessionID(ctx context.Context, sessionName, claudeSessionID string) error {
+	if claudeSessionID == "" {
+		return nil
+	}
+	logging.Logger.Debug("Recording Claude session ID", "session", sessionName, "claude_session_id", claudeSessionID)
+	return s.sessionRepo.UpdateClaudeSessionID(ctx, sessionName, claudeSessionID)
+}
+
+// publish records an event for activity stats and announces it to external integrations
+// Announcing is cut short after publishTimeout. Failures are logged but never fail the hook.
+func (s *NotificationService) publish(ctx context.Context, event domain.Event) {
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+	if err := s.eventRepo.AddEvent(ctx, event); err != nil {
+		logging.Logger.Warn("Failed to record event", "error", err, "event", event.Type, "session", event.SessionName)
+	}
+	ctx, cancel := context.WithTimeout(ctx, publishTimeout)
+	defer cancel()
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		logging.Logger.Warn("Failed to publish event", "error", err, "event", event.Type, "session", event.SessionName)
+	}
+}
+
 // ResolveExecutionID determines execution ID with precedence:
 // flag value > env var > database > "unknown"
 func (s *NotificationService) ResolveExecutionID(
@@ -151,7 +215,7 @@ func (s *NotificationService) ShouldPlaySound(eventType string) bool {
 	switch eventType {
 	case "stop", "start", "notification", "permission-request", "end":
 		return true // User-facing events
-	case "tool-failure", "subagent-start", "subagent-stop", "pre-compact", "setup":
+	case "tool-failure", "tool-audit", "subagent-start", "subagent-stop", "pre-compact", "setup":
 		return false // Internal operations
 	default:
 		return false
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -14,6 +15,20 @@ import (
 	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
 )
 
+// newMockEventRepository returns an event repository mock that accepts any event
+func newMockEventRepository(t *testing.T) *portsmocks.MockEventRepository {
+	eventRepo := portsmocks.NewMockEventRepository(t)
+	eventRepo.EXPECT().AddEvent(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return eventRepo
+}
+
+// newMockEventPublisher returns an event publisher mock that accepts any event
+func newMockEventPublisher(t *testing.T) *portsmocks.MockEventPublisher {
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.Anything).Return(nil).Maybe()
+	return eventPublisher
+}
+
 func TestHandleEvent_EventTypeToStateMapping(t *testing.T) {
 	tests := []struct {
 		eventType     string
@@ -27,6 +42,7 @@ func TestHandleEvent_EventTypeToStateMapping(t *testing.T) {
 		{"working", domain.StateWorking},
 		{"tool-complete", domain.StateWorking},
 		{"tool-failure", domain.StateWorking},
+		{"tool-audit", domain.StateWorking},
 		{"subagent-start", domain.StateWorking},
 		{"subagent-stop", domain.StateWorking},
 		{"pre-compact", domain.StateWorking},
@@ -40,29 +56,16 @@ func TestHandleEvent_EventTypeToStateMapping(t *testing.T) {
 			stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
 			soundPlayer := portsmocks.NewMockSoundPlayer(t)
 
-			// For non-intermediate events, just expect UpdateState
-			// For intermediate events, we need to set up Get first
-			intermediateEvents := map[string]bool{
-				"tool-complete":  true,
-				"tool-failure":   true,
-				"subagent-start": true,
-				"subagent-stop":  true,
-				"pre-compact":    true,
-				"setup":          true,
-			}
-
-			if intermediateEvents[tt.eventType] {
-				// Return a working state so intermediate event proceeds
-				sessionReader.EXPECT().Get(mock.Anything, "test-session").
-					Return(&domain.Session{State: domain.StateWorking}, nil)
-			}
+			// Every event reads the current state first; a working state lets it proceed
+			sessionReader.EXPECT().Get(mock.Anything, "test-session").
+				Return(&domain.Session{State: domain.StateWorking}, nil)
 
 			stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", tt.expectedState, "exec-123").
 				Return(nil)
 
-			service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+			service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
-			state, err := service.HandleEvent(context.Background(), "test-session", tt.eventType, "exec-123")
+			state, err := service.HandleEvent(context.Background(), "test-session", tt.eventType, "exec-123", time.Now())
 
 			require.NoError(t, err)
 			assert.Equal(t, tt.expectedState, state)
@@ -75,9 +78,9 @@ func TestHandleEvent_UnknownEventType(t *testing.T) {
 	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
 	soundPlayer := portsmocks.NewMockSoundPlayer(t)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
-	state, err := service.HandleEvent(context.Background(), "test-session", "unknown-event", "exec-123")
+	state, err := service.HandleEvent(context.Background(), "test-session", "unknown-event", "exec-123", time.Now())
 
 	require.NoError(t, err)
 	assert.Empty(t, state)
@@ -88,17 +91,145 @@ func TestHandleEvent_UpdateStateError(t *testing.T) {
 	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
 	soundPlayer := portsmocks.NewMockSoundPlayer(t)
 
+	sessionReader.EXPECT().Get(mock.Anything, "test-session").
+		Return(&domain.Session{State: domain.StateWorking}, nil)
 	stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", domain.StateIdle, "exec-123").
 		Return(errors.New("database error"))
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
-	state, err := service.HandleEvent(context.Background(), "test-session", "stop", "exec-123")
+	state, err := service.HandleEvent(context.Background(), "test-session", "stop", "exec-123", time.Now())
 
 	require.Error(t, err)
 	assert.Equal(t, domain.StateIdle, state)
 }
 
+func TestHandleEvent_PublishesStateChange(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	eventRepo := portsmocks.NewMockEventRepository(t)
+
+	sessionReader.EXPECT().Get(mock.Anything, "test-session").
+		Return(&domain.Session{State: domain.StateWorking}, nil)
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", domain.StateWaiting, "exec-123").
+		Return(nil)
+	// A replayed journal entry keeps the time the hook fired
+	occurredAt := time.Now().Add(-time.Hour)
+	isWaitingChange := func(e domain.Event) bool {
+		return e.Type == domain.EventStateChange && e.SessionName == "test-session" && e.State == domain.StateWaiting && e.Timestamp.Equal(occurredAt)
+	}
+	eventRepo.EXPECT().AddEvent(mock.Anything, mock.MatchedBy(isWaitingChange)).Return(errors.New("database locked"))
+	hasDeadline := func(ctx context.Context) bool {
+		_, ok := ctx.Deadline()
+		return ok
+	}
+	eventPublisher.EXPECT().Publish(mock.MatchedBy(hasDeadline), mock.MatchedBy(isWaitingChange)).Return(errors.New("webhook down"))
+
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, eventPublisher, eventRepo)
+
+	state, err := service.HandleEvent(context.Background(), "test-session", "notification", "exec-123", occurredAt)
+
+	// Record and publish failures must not fail the hook
+	require.NoError(t, err)
+	assert.Equal(t, domain.StateWaiting, state)
+}
+
+func TestHandleEvent_PublishesErrorOnUpdateFailure(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+
+	sessionReader.EXPECT().Get(mock.Anything, "test-session").
+		Return(&domain.Session{State: domain.StateWorking}, nil)
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", domain.StateIdle, "exec-123").
+		Return(errors.New("database error"))
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(e domain.Event) bool {
+		return e.Type == domain.EventError && e.Error == "database error"
+	})).Return(nil)
+
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, eventPublisher, newMockEventRepository(t))
+
+	_, err := service.HandleEvent(context.Background(), "test-session", "stop", "exec-123", time.Now())
+
+	require.Error(t, err)
+}
+
+func TestHandleEvent_IntermediateEventNotPublished(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+
+	sessionReader.EXPECT().Get(mock.Anything, "test-session").
+		Return(&domain.Session{State: domain.StateWorking}, nil)
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", domain.StateWorking, "exec-123").
+		Return(nil)
+
+	// Note: Publish and AddEvent should NOT be called
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, eventPublisher, portsmocks.NewMockEventRepository(t))
+
+	_, err := service.HandleEvent(context.Background(), "test-session", "tool-complete", "exec-123", time.Now())
+
+	require.NoError(t, err)
+}
+
+func TestHandleEvent_UnchangedStateNotPublished(t *testing.T) {
+	tests := []struct {
+		name      string
+		eventType string
+		current   domain.SessionState
+	}{
+		{name: "stop while idle", eventType: "stop", current: domain.StateIdle},
+		{name: "working while working", eventType: "working", current: domain.StateWorking},
+		{name: "restart while idle", eventType: "start", current: domain.StateIdle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionReader := portsmocks.NewMockSessionReader(t)
+			stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+			soundPlayer := portsmocks.NewMockSoundPlayer(t)
+
+			sessionReader.EXPECT().Get(mock.Anything, "test-session").
+				Return(&domain.Session{State: tt.current}, nil)
+			stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", tt.current, "exec-123").
+				Return(nil)
+
+			// Publish and AddEvent must not be called
+			service := NewNotificationService(stateUpdater, sessionReader, soundPlayer,
+				portsmocks.NewMockEventPublisher(t), portsmocks.NewMockEventRepository(t))
+
+			state, err := service.HandleEvent(context.Background(), "test-session", tt.eventType, "exec-123", time.Now())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.current, state)
+		})
+	}
+}
+
+func TestHandleEvent_StateChangePublishedWhenCurrentStateUnknown(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+
+	sessionReader.EXPECT().Get(mock.Anything, "test-session").Return(nil, errors.New("database locked"))
+	stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", domain.StateWorking, "exec-123").
+		Return(nil)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(e domain.Event) bool {
+		return e.Type == domain.EventStateChange && e.State == domain.StateWorking
+	})).Return(nil)
+
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, eventPublisher, newMockEventRepository(t))
+
+	_, err := service.HandleEvent(context.Background(), "test-session", "prompt", "exec-123", time.Now())
+
+	require.NoError(t, err)
+}
+
 func TestHandleEvent_IntermediateEventSkipsTerminalState(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -122,9 +253,9 @@ func TestHandleEvent_IntermediateEventSkipsTerminalState(t *testing.T) {
 				Return(&domain.Session{State: tt.currentState}, nil)
 
 			// Note: UpdateState should NOT be called
-			service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+			service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
-			state, err := service.HandleEvent(context.Background(), "test-session", tt.eventType, "exec-123")
+			state, err := service.HandleEvent(context.Background(), "test-session", tt.eventType, "exec-123", time.Now())
 
 			require.NoError(t, err)
 			assert.Equal(t, tt.currentState, state)
@@ -143,20 +274,53 @@ func TestHandleEvent_IntermediateEventProceedsOnGetError(t *testing.T) {
 	stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", domain.StateWorking, "exec-123").
 		Return(nil)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
-	state, err := service.HandleEvent(context.Background(), "test-session", "subagent-stop", "exec-123")
+	state, err := service.HandleEvent(context.Background(), "test-session", "subagent-stop", "exec-123", time.Now())
 
 	require.NoError(t, err)
 	assert.Equal(t, domain.StateWorking, state)
 }
 
+func TestHandleEvent_PausedSessionStaysPaused(t *testing.T) {
+	tests := []struct {
+		eventType string
+		wantState domain.SessionState
+	}{
+		{eventType: "stop", wantState: domain.StatePaused},
+		{eventType: "notification", wantState: domain.StatePaused},
+		{eventType: "tool-complete", wantState: domain.StatePaused},
+		{eventType: "prompt", wantState: domain.StateWorking},
+		{eventType: "end", wantState: domain.StateExited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.eventType, func(t *testing.T) {
+			sessionReader := portsmocks.NewMockSessionReader(t)
+			stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+
+			sessionReader.EXPECT().Get(mock.Anything, "test-session").
+				Return(&domain.Session{State: domain.StatePaused}, nil).Maybe()
+			if tt.wantState != domain.StatePaused {
+				stateUpdater.EXPECT().UpdateState(mock.Anything, "test-session", tt.wantState, "exec-123").Return(nil)
+			}
+
+			service := NewNotificationService(stateUpdater, sessionReader, portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t), newMockEventRepository(t))
+
+			state, err := service.HandleEvent(context.Background(), "test-session", tt.eventType, "exec-123", time.Now())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantState, state)
+		})
+	}
+}
+
 func TestResolveExecutionID_FlagValueTakesPrecedence(t *testing.T) {
 	sessionReader := portsmocks.NewMockSessionReader(t)
 	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
 	soundPlayer := portsmocks.NewMockSoundPlayer(t)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	result := service.ResolveExecutionID(context.Background(), "test-session", "flag-value")
 
@@ -171,7 +335,7 @@ func TestResolveExecutionID_EnvVarSecondPriority(t *testing.T) {
 	os.Setenv("ROCHA_EXECUTION_ID", "env-value")
 	defer os.Unsetenv("ROCHA_EXECUTION_ID")
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	result := service.ResolveExecutionID(context.Background(), "test-session", "")
 
@@ -189,7 +353,7 @@ func TestResolveExecutionID_DatabaseThirdPriority(t *testing.T) {
 	sessionReader.EXPECT().Get(mock.Anything, "test-session").
 		Return(&domain.Session{ExecutionID: "db-value"}, nil)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	result := service.ResolveExecutionID(context.Background(), "test-session", "")
 
@@ -207,7 +371,7 @@ func TestResolveExecutionID_FallbackToUnknown(t *testing.T) {
 	sessionReader.EXPECT().Get(mock.Anything, "test-session").
 		Return(nil, errors.New("not found"))
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	result := service.ResolveExecutionID(context.Background(), "test-session", "")
 
@@ -225,7 +389,7 @@ func TestResolveExecutionID_EmptyDbValueFallsBack(t *testing.T) {
 	sessionReader.EXPECT().Get(mock.Anything, "test-session").
 		Return(&domain.Session{ExecutionID: ""}, nil)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	result := service.ResolveExecutionID(context.Background(), "test-session", "")
 
@@ -237,7 +401,7 @@ func TestShouldPlaySound(t *testing.T) {
 	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
 	soundPlayer := portsmocks.NewMockSoundPlayer(t)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	tests := []struct {
 		eventType string
@@ -249,6 +413,7 @@ func TestShouldPlaySound(t *testing.T) {
 		{"permission-request", true},
 		{"end", true},
 		{"tool-failure", false},
+		{"tool-audit", false},
 		{"subagent-start", false},
 		{"subagent-stop", false},
 		{"pre-compact", false},
@@ -271,7 +436,7 @@ func TestPlaySound(t *testing.T) {
 
 	soundPlayer.EXPECT().PlaySound().Return(nil)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	err := service.PlaySound()
 
@@ -285,9 +450,26 @@ func TestPlaySoundForEvent(t *testing.T) {
 
 	soundPlayer.EXPECT().PlaySoundForEvent("stop").Return(nil)
 
-	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer)
+	service := NewNotificationService(stateUpdater, sessionReader, soundPlayer, newMockEventPublisher(t), newMockEventRepository(t))
 
 	err := service.PlaySoundForEvent("stop")
 
 	require.NoError(t, err)
 }
+
+func TestRecordClaudeSessionID(t *testing.T) {
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+	stateUpdater.EXPECT().UpdateClaudeSessionID(mock.Anything, "test-session", "conv-123").Return(nil)
+
+	service := NewNotificationService(stateUpdater, portsmocks.NewMockSessionReader(t), portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t), newMockEventRepository(t))
+
+	require.NoError(t, service.RecordClaudeSessionID(context.Background(), "test-session", "conv-123"))
+}
+
+func TestRecordClaudeSessionID_EmptyIDIsIgnored(t *testing.T) {
+	stateUpdater := portsmocks.NewMockSessionStateUpdater(t)
+
+	service := NewNotificationService(stateUpdater, portsmocks.NewMockSessionReader(t), portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t), newMockEventRepository(t))
+
+	require.NoError(t, service.RecordClaudeSessionID(context.Background(), "test-session", ""))
+}
@@ -0,0 +1,167 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+const (
+	oneShotEventLimit      = 100             // Recent events checked for the end of a one-shot run
+	oneShotPollInterval    = 2 * time.Second // How often a one-shot run checks whether the agent finished
+	oneShotShutdownTimeout = 5 * time.Second // Time the agent gets to exit when the session is torn down
+)
+
+// OneShotService runs a prompt in a temporary session for scripts: it creates the
+// session, waits until the agent finishes or times out, captures the diff and
+// transcript, and tears the session, its worktree, and its branch down again.
+type OneShotService struct {
+	eventRepo          ports.EventRepository
+	gitRepo            ports.GitRepository
+	hookJournalService *HookJournalService
+	pollInterval       time.Duration
+	sessionService     *SessionService
+	transcriptService  *TranscriptService
+}
+
+// NewOneShotService creates a new OneShotService
+func NewOneShotService(
+	sessionService *SessionService,
+	transcriptService *TranscriptService,
+	hookJournalService *HookJournalService,
+	eventRepo ports.EventRepository,
+	gitRepo ports.GitRepository,
+) *OneShotService {
+	return &OneShotService{
+		eventRepo:          eventRepo,
+		gitRepo:            gitRepo,
+		hookJournalService: hookJournalService,
+		pollInterval:       oneShotPollInterval,
+		sessionService:     sessionService,
+		transcriptService:  transcriptService,
+	}
+}
+
+// Run runs the prompt in a temporary session of the repository and returns what the agent did.
+// A run that times out still returns the diff and transcript captured so far. The session is
+// torn down even when the run fails or ctx is cancelled; failures to tear it down are returned
+// along with the result.
+func (s *OneShotService) Run(ctx context.Context, params OneShotParams) (result *OneShotResult, err error) {
+	if strings.TrimSpace(params.Prompt) == "" {
+		return nil, fmt.Errorf("a one-shot run needs a prompt: %w", domain.ErrInvalidInput)
+	}
+	if params.RepoSource == "" {
+		return nil, fmt.Errorf("a one-shot run needs a repository: %w", domain.ErrInvalidInput)
+	}
+	if params.Timeout <= 0 {
+		return nil, fmt.Errorf("timeout must be positive: %w", domain.ErrInvalidInput)
+	}
+
+	name := "oneshot-" + uuid.NewString()[:8]
+	logging.Logger.Info("Starting one-shot run", "session", name, "repo_source", params.RepoSource, "timeout", params.Timeout)
+
+	created, err := s.sessionService.CreateSession(ctx, CreateSessionParams{
+		AllowDangerouslySkipPermissions: params.AllowDangerouslySkipPermissions,
+		BootstrapOutput:                 params.BootstrapOutput,
+		InitialPrompt:                   params.Prompt,
+		RepoSource:                      params.RepoSource,
+		SessionName:                     name,
+		TmuxStatusPosition:              params.TmuxStatusPosition,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create session: %w", err)
+	}
+	session := created.Session
+	defer func() {
+		if teardownErr := s.teardown(session); teardownErr != nil {
+			err = errors.Join(err, teardownErr)
+		}
+	}()
+
+	if session.WorktreePath == "" {
+		return nil, fmt.Errorf("no worktree was created for %s: %w", params.RepoSource, domain.ErrInvalidInput)
+	}
+	start, err := s.gitRepo.GetHeadCommit(session.WorktreePath)
+	if err != nil {
+		logging.Logger.Warn("Failed to resolve the starting commit, diffing against the empty tree", "session", name, "error", err)
+	}
+
+	result = &OneShotResult{SessionName: session.Name}
+	result.State, err = s.waitForAgent(ctx, session.Name, params.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	result.TimedOut = result.State == ""
+
+	if result.Diff, err = s.gitRepo.Diff(ctx, session.WorktreePath, start); err != nil {
+		return nil, fmt.Errorf("failed to capture diff: %w", err)
+	}
+	result.Transcript, err = s.transcriptService.ExportTranscript(ctx, session.Name, params.TranscriptFormat)
+	if err != nil && !errors.Is(err, domain.ErrTranscriptNotFound) {
+		return nil, fmt.Errorf("failed to capture transcript: %w", err)
+	}
+
+	logging.Logger.Info("One-shot run finished", "session", name, "state", result.State, "timed_out", result.TimedOut)
+	return result, nil
+}
+
+// waitForAgent waits until the agent of the session finishes the prompt and returns the state
+// it ended in, or an empty state when it did not finish within timeout
+func (s *OneShotService) waitForAgent(ctx context.Context, name string, timeout time.Duration) (domain.SessionState, error) {
+	deadline := time.After(timeout)
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		// Apply hook events that could not be written while the database was busy
+		if _, err := s.hookJournalService.Drain(ctx); err != nil {
+			logging.Logger.Warn("Failed to drain hook journal", "error", err)
+		}
+
+		events, err := s.eventRepo.ListSessionEvents(ctx, name, oneShotEventLimit)
+		if err != nil {
+			logging.Logger.Warn("Failed to list session events", "session", name, "error", err)
+		} else if state, finished := domain.OneShotFinished(events); finished {
+			return state, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-deadline:
+			logging.Logger.Warn("One-shot run timed out", "session", name, "timeout", timeout)
+			return "", nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// teardown kills the session and removes it, its worktree, and its branch
+// It runs on a fresh context so a cancelled run still cleans up.
+func (s *OneShotService) teardown(session *domain.Session) error {
+	ctx := context.Background()
+	logging.Logger.Info("Tearing down one-shot session", "session", session.Name)
+
+	err := s.sessionService.DeleteSession(ctx, session.Name, DeleteSessionOptions{
+		KillTmux:        true,
+		RemoveWorktree:  true,
+		ShutdownTimeout: oneShotShutdownTimeout,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove session '%s': %w", session.Name, err)
+	}
+	if session.BranchName != "" && session.WorktreePath != "" {
+		if err := s.gitRepo.DeleteBranch(session.RepoPath, session.BranchName); err != nil {
+			return fmt.Errorf("failed to delete branch '%s': %w", session.BranchName, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+// newOneShotWaiter returns a one-shot service with an empty hook journal whose polls of the
+// session events return the given events in turn, repeating the last ones
+func newOneShotWaiter(t *testing.T, events ...[]domain.Event) *OneShotService {
+	journal := portsmocks.NewMockHookJournal(t)
+	journal.EXPECT().Drain(mock.Anything, mock.Anything).Return(nil)
+	eventRepo := portsmocks.NewMockEventRepository(t)
+	for i, polled := range events {
+		call := eventRepo.EXPECT().ListSessionEvents(mock.Anything, "oneshot-1", oneShotEventLimit).Return(polled, nil)
+		if i < len(events)-1 {
+			call.Once()
+		}
+	}
+
+	service := NewOneShotService(nil, nil, NewHookJournalService(journal, nil), eventRepo, portsmocks.NewMockGitRepository(t))
+	service.pollInterval = time.Millisecond
+	return service
+}
+
+func TestOneShotWaitForAgent(t *testing.T) {
+	started := []domain.Event{{State: domain.StateIdle, Type: domain.EventStateChange}}
+	working := append([]domain.Event{{State: domain.StateWorking, Type: domain.EventStateChange}}, started...)
+	stopped := append([]domain.Event{{State: domain.StateIdle, Type: domain.EventStateChange}}, working...)
+
+	t.Run("finishes once the agent worked and stopped", func(t *testing.T) {
+		service := newOneShotWaiter(t, started, working, stopped)
+
+		state, err := service.waitForAgent(context.Background(), "oneshot-1", time.Minute)
+
+		require.NoError(t, err)
+		assert.Equal(t, domain.StateIdle, state)
+	})
+
+	t.Run("times out while the agent works", func(t *testing.T) {
+		service := newOneShotWaiter(t, working)
+
+		state, err := service.waitForAgent(context.Background(), "oneshot-1", 20*time.Millisecond)
+
+		require.NoError(t, err)
+		assert.Empty(t, state)
+	})
+}
+
+func TestOneShotRunValidation(t *testing.T) {
+	service := NewOneShotService(nil, nil, nil, portsmocks.NewMockEventRepository(t), portsmocks.NewMockGitRepository(t))
+
+	_, err := service.Run(context.Background(), OneShotParams{RepoSource: "/src/api", Timeout: time.Minute})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "a prompt is required")
+
+	_, err = service.Run(context.Background(), OneShotParams{Prompt: "Fix the bug", Timeout: time.Minute})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "a repository is required")
+
+	_, err = service.Run(context.Background(), OneShotParams{Prompt: "Fix the bug", RepoSource: "/src/api"})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput, "a timeout is required")
+}
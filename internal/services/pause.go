@@ -0,0 +1,133 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// DefaultResumePrompt is sent to a paused session when no other prompt is given
+const DefaultResumePrompt = "continue"
+
+// PauseService interrupts agents on purpose and resumes them later.
+// A paused session stops consuming tokens and keeps its state until it is resumed.
+type PauseService struct {
+	eventPublisher ports.EventPublisher
+	historyRepo    ports.PromptHistoryRepository
+	scheduler      *SchedulerService
+	sessionReader  ports.SessionReader
+	stateUpdater   ports.SessionStateUpdater
+	tmuxClient     ports.SessionManager
+}
+
+// NewPauseService creates a new PauseService
+func NewPauseService(
+	sessionReader ports.SessionReader,
+	stateUpdater ports.SessionStateUpdater,
+	historyRepo ports.PromptHistoryRepository,
+	tmuxClient ports.SessionManager,
+	scheduler *SchedulerService,
+	eventPublisher ports.EventPublisher,
+) *PauseService {
+	return &PauseService{
+		eventPublisher: eventPublisher,
+		historyRepo:    historyRepo,
+		scheduler:      scheduler,
+		sessionReader:  sessionReader,
+		stateUpdater:   stateUpdater,
+		tmuxClient:     tmuxClient,
+	}
+}
+
+// Pause interrupts the agent of a session, if it is busy, and marks the session paused
+func (s *PauseService) Pause(ctx context.Context, sessionName string) error {
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return err
+	}
+
+	switch session.State {
+	case domain.StatePaused:
+		return fmt.Errorf("%w: session %s is already paused", domain.ErrInvalidInput, sessionName)
+	case domain.StateExited:
+		return fmt.Errorf("%w: session %s has exited", domain.ErrInvalidInput, sessionName)
+	}
+
+	if !s.tmuxClient.SessionExists(sessionName) {
+		return fmt.Errorf("%w: %s", ports.ErrTmuxSessionNotFound, sessionName)
+	}
+
+	// Escape interrupts Claude mid-turn; an idle agent has nothing to interrupt
+	if session.State == domain.StateWorking || session.State == domain.StateWaiting {
+		if err := s.tmuxClient.SendKeys(sessionName, "Escape"); err != nil {
+			return fmt.Errorf("failed to interrupt agent: %w", err)
+		}
+	}
+
+	logging.Logger.Info("Pausing session", "session", sessionName, "previous_state", session.State)
+	return s.setState(ctx, session, domain.StatePaused)
+}
+
+// Resume sends a prompt to a paused session so the agent carries on: opts.Prompt if set,
+// the last prompt sent to the session if opts.ResendLastPrompt, or DefaultResumePrompt.
+// Returns the queued prompt when the concurrency limit holds it back, or nil if it was sent.
+func (s *PauseService) Resume(ctx context.Context, sessionName string, opts ResumeAgentOptions) (*domain.ScheduledPrompt, error) {
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+	if session.State != domain.StatePaused {
+		return nil, fmt.Errorf("%w: session %s is not paused", domain.ErrInvalidInput, sessionName)
+	}
+
+	text, err := s.resumePrompt(ctx, sessionName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Info("Resuming session", "session", sessionName)
+	queued, err := s.scheduler.SendOrQueue(ctx, sessionName, text, opts.Confirmed, time.Now())
+	if err != nil || queued != nil {
+		// A queued prompt resumes the session when it is delivered
+		return queued, err
+	}
+
+	return nil, s.setState(ctx, session, domain.StateWorking)
+}
+
+// resumePrompt picks the text that resumes a session
+func (s *PauseService) resumePrompt(ctx context.Context, sessionName string, opts ResumeAgentOptions) (string, error) {
+	if text := strings.TrimSpace(opts.Prompt); text != "" {
+		return text, nil
+	}
+	if !opts.ResendLastPrompt {
+		return DefaultResumePrompt, nil
+	}
+
+	prompts, err := s.historyRepo.ListSentPrompts(ctx, sessionName, 1)
+	if err != nil {
+		return "", fmt.Errorf("failed to read prompt history: %w", err)
+	}
+	if len(prompts) == 0 {
+		return DefaultResumePrompt, nil
+	}
+	return prompts[0].Text, nil
+}
+
+// setState stores the new state of a session and announces it
+func (s *PauseService) setState(ctx context.Context, session *domain.Session, state domain.SessionState) error {
+	if err := s.stateUpdater.UpdateState(ctx, session.Name, state, session.ExecutionID); err != nil {
+		return fmt.Errorf("failed to update session state: %w", err)
+	}
+
+	event := domain.Event{SessionName: session.Name, State: state, Timestamp: time.Now(), Type: domain.EventStateChange}
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		logging.Logger.Warn("Failed to publish event", "error", err, "event", event.Type, "session", session.Name)
+	}
+	return nil
+}
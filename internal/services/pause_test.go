@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+// pauseTestMocks holds the mocks of a PauseService under test
+type pauseTestMocks struct {
+	historyRepo   *portsmocks.MockPromptHistoryRepository
+	sessionReader *portsmocks.MockSessionReader
+	stateUpdater  *portsmocks.MockSessionStateUpdater
+	tmuxClient    *portsmocks.MockSessionManager
+}
+
+func newPauseTestService(t *testing.T) (*PauseService, pauseTestMocks) {
+	m := pauseTestMocks{
+		historyRepo:   portsmocks.NewMockPromptHistoryRepository(t),
+		sessionReader: portsmocks.NewMockSessionReader(t),
+		stateUpdater:  portsmocks.NewMockSessionStateUpdater(t),
+		tmuxClient:    portsmocks.NewMockSessionManager(t),
+	}
+	scheduler := NewSchedulerService(portsmocks.NewMockScheduledPromptRepository(t), m.historyRepo, m.sessionReader, m.tmuxClient, domain.ConcurrencyLimit{})
+	service := NewPauseService(m.sessionReader, m.stateUpdater, m.historyRepo, m.tmuxClient, scheduler, newMockEventPublisher(t))
+	return service, m
+}
+
+func TestPause(t *testing.T) {
+	tests := []struct {
+		name          string
+		state         domain.SessionState
+		wantInterrupt bool
+	}{
+		{name: "working agent is interrupted", state: domain.StateWorking, wantInterrupt: true},
+		{name: "waiting agent is interrupted", state: domain.StateWaiting, wantInterrupt: true},
+		{name: "idle agent is only marked", state: domain.StateIdle},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			service, m := newPauseTestService(t)
+
+			m.sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{ExecutionID: "exec-1", Name: "s1", State: tt.state}, nil)
+			m.tmuxClient.EXPECT().SessionExists("s1").Return(true)
+			if tt.wantInterrupt {
+				m.tmuxClient.EXPECT().SendKeys("s1", "Escape").Return(nil)
+			}
+			m.stateUpdater.EXPECT().UpdateState(ctx, "s1", domain.StatePaused, "exec-1").Return(nil)
+
+			require.NoError(t, service.Pause(ctx, "s1"))
+		})
+	}
+}
+
+func TestPause_Refused(t *testing.T) {
+	for _, state := range []domain.SessionState{domain.StatePaused, domain.StateExited} {
+		t.Run(string(state), func(t *testing.T) {
+			ctx := context.Background()
+			service, m := newPauseTestService(t)
+
+			m.sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{Name: "s1", State: state}, nil)
+
+			assert.ErrorIs(t, service.Pause(ctx, "s1"), domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestResume(t *testing.T) {
+	tests := []struct {
+		name        string
+		opts        ResumeAgentOptions
+		lastPrompts []domain.SentPrompt
+		wantText    string
+	}{
+		{name: "default prompt", wantText: DefaultResumePrompt},
+		{name: "given prompt", opts: ResumeAgentOptions{Prompt: "skip the migration"}, wantText: "skip the migration"},
+		{
+			name:        "last prompt",
+			opts:        ResumeAgentOptions{ResendLastPrompt: true},
+			lastPrompts: []domain.SentPrompt{{Text: "fix the flaky test"}},
+			wantText:    "fix the flaky test",
+		},
+		{name: "no last prompt falls back to the default", opts: ResumeAgentOptions{ResendLastPrompt: true}, wantText: DefaultResumePrompt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			service, m := newPauseTestService(t)
+
+			m.sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{ExecutionID: "exec-1", Name: "s1", State: domain.StatePaused}, nil)
+			if tt.opts.ResendLastPrompt {
+				m.historyRepo.EXPECT().ListSentPrompts(ctx, "s1", 1).Return(tt.lastPrompts, nil)
+			}
+			m.tmuxClient.EXPECT().SessionExists("s1").Return(true)
+			m.tmuxClient.EXPECT().SendKeys("s1", tt.wantText).Return(nil)
+			m.tmuxClient.EXPECT().SendKeys("s1", "C-m").Return(nil)
+			m.historyRepo.EXPECT().AddSentPrompt(ctx, mock.Anything).Return(nil)
+			m.stateUpdater.EXPECT().UpdateState(ctx, "s1", domain.StateWorking, "exec-1").Return(nil)
+
+			queued, err := service.Resume(ctx, "s1", tt.opts)
+
+			require.NoError(t, err)
+			assert.Nil(t, queued)
+		})
+	}
+}
+
+func TestResume_RefusesSessionNotPaused(t *testing.T) {
+	ctx := context.Background()
+	service, m := newPauseTestService(t)
+
+	m.sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{Name: "s1", State: domain.StateIdle}, nil)
+
+	_, err := service.Resume(ctx, "s1", ResumeAgentOptions{})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -0,0 +1,72 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// RemoteFetchService fetches the remotes of the repositories sessions work in on an interval,
+// so ahead/behind counts stay current without fetching by hand. The worktrees of a repository
+// share its refs, so each repository is fetched once however many sessions use it.
+type RemoteFetchService struct {
+	gitRepo       ports.BranchSyncer
+	interval      time.Duration // 0 turns background fetches off
+	lastRun       time.Time
+	mu            sync.Mutex
+	sessionReader ports.SessionReader
+}
+
+// NewRemoteFetchService creates a new RemoteFetchService fetching every interval (0 = never)
+func NewRemoteFetchService(sessionReader ports.SessionReader, gitRepo ports.BranchSyncer, interval time.Duration) *RemoteFetchService {
+	return &RemoteFetchService{
+		gitRepo:       gitRepo,
+		interval:      interval,
+		sessionReader: sessionReader,
+	}
+}
+
+// Due returns true if background fetches are on and the last round is older than the interval
+func (s *RemoteFetchService) Due(now time.Time) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.interval > 0 && now.Sub(s.lastRun) >= s.interval
+}
+
+// Run fetches origin in each repository that active sessions have worktrees of, and returns
+// the repositories fetched. A repository that fails to fetch does not stop the others;
+// its error is part of the returned one. Directories used as-is are left alone.
+func (s *RemoteFetchService) Run(ctx context.Context, now time.Time) ([]string, error) {
+	s.mu.Lock()
+	s.lastRun = now
+	s.mu.Unlock()
+
+	sessions, err := s.sessionReader.List(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var fetched []string
+	var errs []error
+	seen := make(map[string]bool)
+	for _, session := range sessions {
+		if session.IsExternal || session.WorktreePath == "" || session.RepoPath == "" || seen[session.RepoPath] {
+			continue
+		}
+		seen[session.RepoPath] = true
+
+		if err := s.gitRepo.FetchRemote(ctx, session.RepoPath); err != nil {
+			logging.Logger.Warn("Background fetch failed", "repo", session.RepoPath, "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		fetched = append(fetched, session.RepoPath)
+	}
+	logging.Logger.Debug("Background fetch done", "fetched", len(fetched), "failed", len(errs))
+	return fetched, errors.Join(errs...)
+}
@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestRemoteFetchService_Run(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().List(mock.Anything, false).Return([]domain.Session{
+		{Name: "api-login", RepoPath: "/src/api", WorktreePath: "/wt/api-login"},
+		{Name: "api-cart", RepoPath: "/src/api", WorktreePath: "/wt/api-cart"},
+		{Name: "web", RepoPath: "/src/web", WorktreePath: "/wt/web"},
+		{Name: "docs", IsExternal: true, RepoPath: "/src/docs"},
+		{Name: "scratch"},
+	}, nil)
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	gitRepo.EXPECT().FetchRemote(mock.Anything, "/src/api").Return(nil).Once()
+	gitRepo.EXPECT().FetchRemote(mock.Anything, "/src/web").Return(errors.New("could not resolve host"))
+
+	service := NewRemoteFetchService(sessionRepo, gitRepo, 10*time.Minute)
+	now := time.Now()
+	require.True(t, service.Due(now))
+
+	fetched, err := service.Run(context.Background(), now)
+
+	assert.Equal(t, []string{"/src/api"}, fetched, "worktrees of a repository fetch it once")
+	assert.ErrorContains(t, err, "could not resolve host")
+	assert.False(t, service.Due(now.Add(5*time.Minute)))
+	assert.True(t, service.Due(now.Add(10*time.Minute)))
+}
+
+func TestRemoteFetchService_DisabledByDefault(t *testing.T) {
+	service := NewRemoteFetchService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockGitRepository(t), 0)
+
+	assert.False(t, service.Due(time.Now()))
+}
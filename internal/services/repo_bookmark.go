@@ -0,0 +1,120 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// RepoBookmarkService keeps the repository sources offered when creating sessions
+type RepoBookmarkService struct {
+	bookmarkRepo ports.RepoBookmarkRepository
+	gitRepo      ports.GitRepository
+}
+
+// NewRepoBookmarkService creates a new RepoBookmarkService
+func NewRepoBookmarkService(bookmarkRepo ports.RepoBookmarkRepository, gitRepo ports.GitRepository) *RepoBookmarkService {
+	return &RepoBookmarkService{
+		bookmarkRepo: bookmarkRepo,
+		gitRepo:      gitRepo,
+	}
+}
+
+// AddBookmark saves a repository source under a name; an empty name is derived from the source
+func (s *RepoBookmarkService) AddBookmark(ctx context.Context, name, source string) (*domain.RepoBookmark, error) {
+	source = strings.TrimSpace(source)
+	url, _, _ := strings.Cut(source, "#")
+	if !s.gitRepo.IsGitURL(url) {
+		return nil, fmt.Errorf("%w: %q is not a git URL (e.g., https://github.com/owner/repo or git@github.com:owner/repo)", domain.ErrInvalidInput, source)
+	}
+	if name == "" {
+		name = domain.RepoBookmarkName(source)
+	}
+	name, err := domain.NormalizeRepoBookmarkName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	bookmark := domain.RepoBookmark{Name: name, Source: source}
+	logging.Logger.Info("Adding repository bookmark", "name", name, "source", source)
+	if err := s.bookmarkRepo.AddRepoBookmark(ctx, bookmark); err != nil {
+		return nil, err
+	}
+	return &bookmark, nil
+}
+
+// DeleteBookmark removes a bookmark
+func (s *RepoBookmarkService) DeleteBookmark(ctx context.Context, name string) error {
+	name, err := domain.NormalizeRepoBookmarkName(name)
+	if err != nil {
+		return err
+	}
+
+	logging.Logger.Info("Deleting repository bookmark", "name", name)
+	return s.bookmarkRepo.DeleteRepoBookmark(ctx, name)
+}
+
+// ListBookmarks returns all bookmarks, most used first
+func (s *RepoBookmarkService) ListBookmarks(ctx context.Context) ([]domain.RepoBookmark, error) {
+	return s.bookmarkRepo.ListRepoBookmarks(ctx)
+}
+
+// ResolveSource returns the source of the bookmark named by value ("api" or "api#branch"),
+// or value unchanged when it names no bookmark
+func (s *RepoBookmarkService) ResolveSource(ctx context.Context, value string) string {
+	if value == "" {
+		return ""
+	}
+	bookmarks, err := s.bookmarkRepo.ListRepoBookmarks(ctx)
+	if err != nil {
+		logging.Logger.Warn("Failed to list repository bookmarks", "error", err)
+		return value
+	}
+	return domain.ResolveRepoBookmark(bookmarks, value)
+}
+
+// RecordUse counts a session created from source, bookmarking the repository
+// (without its #branch) under a free name when it is not bookmarked yet
+func (s *RepoBookmarkService) RecordUse(ctx context.Context, source string) error {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return nil
+	}
+
+	bookmarks, err := s.bookmarkRepo.ListRepoBookmarks(ctx)
+	if err != nil {
+		return err
+	}
+
+	url, _, _ := strings.Cut(source, "#")
+	taken := make(map[string]bool, len(bookmarks))
+	for _, bookmark := range bookmarks {
+		if bookmark.Source == source || bookmark.Source == url {
+			return s.bookmarkRepo.MarkRepoBookmarkUsed(ctx, bookmark.Name, time.Now())
+		}
+		taken[bookmark.Name] = true
+	}
+
+	base := domain.RepoBookmarkName(url)
+	if base == "" {
+		return nil
+	}
+	name := base
+	for i := 2; taken[name]; i++ {
+		name = fmt.Sprintf("%s-%d", base, i)
+	}
+
+	now := time.Now()
+	logging.Logger.Info("Bookmarking repository", "name", name, "source", url)
+	err = s.bookmarkRepo.AddRepoBookmark(ctx, domain.RepoBookmark{LastUsedAt: &now, Name: name, Source: url, UseCount: 1})
+	if errors.Is(err, domain.ErrRepoBookmarkExists) {
+		return nil // Bookmarked by another rocha process meanwhile
+	}
+	return err
+}
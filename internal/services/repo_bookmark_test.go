@@ -0,0 +1,75 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestAddBookmark(t *testing.T) {
+	bookmarkRepo := portsmocks.NewMockRepoBookmarkRepository(t)
+	gitRepo := portsmocks.NewMockGitRepository(t)
+
+	gitRepo.EXPECT().IsGitURL("git@github.com:acme/api.git").Return(true)
+	bookmarkRepo.EXPECT().AddRepoBookmark(mock.Anything, domain.RepoBookmark{Name: "api", Source: "git@github.com:acme/api.git#main"}).Return(nil)
+
+	service := NewRepoBookmarkService(bookmarkRepo, gitRepo)
+	bookmark, err := service.AddBookmark(context.Background(), "", "git@github.com:acme/api.git#main")
+
+	require.NoError(t, err)
+	assert.Equal(t, "api", bookmark.Name)
+}
+
+func TestAddBookmark_NotGitURL(t *testing.T) {
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	gitRepo.EXPECT().IsGitURL("/src/api").Return(false)
+
+	service := NewRepoBookmarkService(portsmocks.NewMockRepoBookmarkRepository(t), gitRepo)
+	_, err := service.AddBookmark(context.Background(), "api", "/src/api")
+
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestRecordUse(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+		expect func(repo *portsmocks.MockRepoBookmarkRepository)
+	}{
+		{
+			name:   "bookmarked source is counted",
+			source: "https://github.com/acme/api#fix/login",
+			expect: func(repo *portsmocks.MockRepoBookmarkRepository) {
+				repo.EXPECT().MarkRepoBookmarkUsed(mock.Anything, "api", mock.Anything).Return(nil)
+			},
+		},
+		{
+			name:   "new source is bookmarked under a free name",
+			source: "https://github.com/other/api#main",
+			expect: func(repo *portsmocks.MockRepoBookmarkRepository) {
+				repo.EXPECT().AddRepoBookmark(mock.Anything, mock.MatchedBy(func(b domain.RepoBookmark) bool {
+					return b.Name == "api-2" && b.Source == "https://github.com/other/api" && b.UseCount == 1 && b.LastUsedAt != nil
+				})).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bookmarkRepo := portsmocks.NewMockRepoBookmarkRepository(t)
+			bookmarkRepo.EXPECT().ListRepoBookmarks(mock.Anything).Return([]domain.RepoBookmark{
+				{Name: "api", Source: "https://github.com/acme/api"},
+			}, nil)
+			tt.expect(bookmarkRepo)
+
+			service := NewRepoBookmarkService(bookmarkRepo, portsmocks.NewMockGitRepository(t))
+			require.NoError(t, service.RecordUse(context.Background(), tt.source))
+		})
+	}
+}
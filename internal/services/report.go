@@ -0,0 +1,177 @@
+package services
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// ReportService summarizes what happened to sessions over a period, for standup notes
+type ReportService struct {
+	eventRepo     ports.EventRepository
+	gitStats      ports.GitStatsProvider
+	reportsDir    string // Where scheduled reports are saved
+	sessionReader ports.SessionReader
+}
+
+// NewReportService creates a new ReportService
+func NewReportService(sessionReader ports.SessionReader, eventRepo ports.EventRepository, gitStats ports.GitStatsProvider, reportsDir string) *ReportService {
+	return &ReportService{
+		eventRepo:     eventRepo,
+		gitStats:      gitStats,
+		reportsDir:    reportsDir,
+		sessionReader: sessionReader,
+	}
+}
+
+// BuildReport collects the sessions worked on between since and now: the time they
+// spent in each state, the commits made on their branches, and the statuses they were set to.
+// A session counts as worked on when it changed state, got commits or status changes,
+// or was already working at since.
+func (s *ReportService) BuildReport(ctx context.Context, since, now time.Time) (*domain.ActivityReport, error) {
+	logging.Logger.Debug("Building activity report", "since", since)
+
+	sessions, err := s.sessionReader.List(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	initialEvents, err := s.eventRepo.ListLatestEventsBefore(ctx, domain.EventStateChange, since)
+	if err != nil {
+		return nil, err
+	}
+	initialStates := make(map[string]domain.SessionState, len(initialEvents))
+	for _, event := range initialEvents {
+		initialStates[event.SessionName] = event.State
+	}
+
+	stateEvents, err := s.listEventsBySession(ctx, domain.EventStateChange, since)
+	if err != nil {
+		return nil, err
+	}
+	statusEvents, err := s.listEventsBySession(ctx, domain.EventStatusChange, since)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &domain.ActivityReport{Sessions: []domain.SessionActivity{}, Since: since, Until: now}
+	for _, session := range sessions {
+		initial := initialStates[session.Name]
+		activity := domain.SessionActivity{
+			Branch:       session.BranchName,
+			Commits:      s.listCommits(ctx, session, since),
+			DisplayName:  session.DisplayName,
+			Name:         session.Name,
+			StateSeconds: make(map[domain.SessionState]int64),
+		}
+		if activity.DisplayName == "" {
+			activity.DisplayName = session.Name
+		}
+		if session.Status != nil {
+			activity.Status = *session.Status
+		}
+		for _, event := range statusEvents[session.Name] {
+			activity.StatusChanges = append(activity.StatusChanges, domain.StatusChange{At: event.Timestamp, Status: event.Status})
+		}
+
+		events := stateEvents[session.Name]
+		if len(events) == 0 && len(activity.Commits) == 0 && len(activity.StatusChanges) == 0 && initial != domain.StateWorking {
+			continue
+		}
+		for state, d := range domain.StateDurations(initial, events, since, now) {
+			activity.StateSeconds[state] = int64(d / time.Second)
+		}
+		report.Sessions = append(report.Sessions, activity)
+	}
+
+	slices.SortFunc(report.Sessions, func(a, b domain.SessionActivity) int {
+		return cmp.Or(
+			cmp.Compare(b.StateSeconds[domain.StateWorking], a.StateSeconds[domain.StateWorking]),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+	return report, nil
+}
+
+// ExportReport builds the report of the period starting at since and renders it in the given format
+func (s *ReportService) ExportReport(ctx context.Context, since, now time.Time, format domain.ReportFormat) ([]byte, error) {
+	report, err := s.BuildReport(ctx, since, now)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case domain.ReportMarkdown:
+		return []byte(report.Markdown()), nil
+	case domain.ReportJSON:
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		return append(data, '\n'), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown report format '%s' (use md or json)", domain.ErrInvalidInput, format)
+	}
+}
+
+// SaveReport writes the markdown report of the period starting at since into the
+// reports directory, named after the day of now. Returns the path of the written file.
+func (s *ReportService) SaveReport(ctx context.Context, since, now time.Time) (string, error) {
+	data, err := s.ExportReport(ctx, since, now, domain.ReportMarkdown)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(s.reportsDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create reports directory: %w", err)
+	}
+	path := filepath.Join(s.reportsDir, now.Format("2006-01-02")+"."+string(domain.ReportMarkdown))
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write report: %w", err)
+	}
+
+	logging.Logger.Info("Saved activity report", "path", path)
+	return path, nil
+}
+
+// listEventsBySession lists the events of a type since the given time, grouped by session
+func (s *ReportService) listEventsBySession(ctx context.Context, eventType domain.EventType, since time.Time) (map[string][]domain.Event, error) {
+	events, err := s.eventRepo.ListEvents(ctx, eventType, since)
+	if err != nil {
+		return nil, err
+	}
+
+	bySession := make(map[string][]domain.Event)
+	for _, event := range events {
+		bySession[event.SessionName] = append(bySession[event.SessionName], event)
+	}
+	return bySession, nil
+}
+
+// listCommits lists the commits made on the session branch since the given time.
+// Sessions without a git checkout, or whose worktree was removed, have none.
+func (s *ReportService) listCommits(ctx context.Context, session domain.Session, since time.Time) []domain.Commit {
+	dir := session.WorkingDir()
+	if dir == "" {
+		return nil
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil
+	}
+
+	commits, err := s.gitStats.ListCommits(ctx, dir, session.BaseBranch, since)
+	if err != nil {
+		logging.Logger.Debug("Failed to list session commits", "session", session.Name, "error", err)
+		return nil
+	}
+	return commits
+}
@@ -0,0 +1,59 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestReportService_BuildReport(t *testing.T) {
+	since := time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)
+	now := since.Add(10 * time.Hour)
+	review := "review"
+
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	sessionReader.EXPECT().List(mock.Anything, true).Return([]domain.Session{
+		{Name: "idle-all-day"},
+		{Name: "overnight"},
+		{DisplayName: "API cart", Name: "api", Status: &review},
+	}, nil)
+
+	eventRepo := portsmocks.NewMockEventRepository(t)
+	eventRepo.EXPECT().ListLatestEventsBefore(mock.Anything, domain.EventStateChange, since).Return([]domain.Event{
+		{SessionName: "idle-all-day", State: domain.StateIdle},
+		{SessionName: "overnight", State: domain.StateWorking},
+	}, nil)
+	eventRepo.EXPECT().ListEvents(mock.Anything, domain.EventStateChange, since).Return([]domain.Event{
+		{SessionName: "api", State: domain.StateWorking, Timestamp: since.Add(4 * time.Hour), Type: domain.EventStateChange},
+		{SessionName: "overnight", State: domain.StateIdle, Timestamp: since.Add(time.Hour), Type: domain.EventStateChange},
+		{SessionName: "api", State: domain.StateWaiting, Timestamp: since.Add(8 * time.Hour), Type: domain.EventStateChange},
+	}, nil)
+	eventRepo.EXPECT().ListEvents(mock.Anything, domain.EventStatusChange, since).Return([]domain.Event{
+		{SessionName: "api", Status: "review", Timestamp: since.Add(8 * time.Hour), Type: domain.EventStatusChange},
+	}, nil)
+
+	service := NewReportService(sessionReader, eventRepo, portsmocks.NewMockGitStatsProvider(t), t.TempDir())
+
+	report, err := service.BuildReport(context.Background(), since, now)
+
+	require.NoError(t, err)
+	require.Len(t, report.Sessions, 2, "a session idle all period was not worked on")
+
+	api := report.Sessions[0]
+	assert.Equal(t, "API cart", api.DisplayName)
+	assert.Equal(t, 4*time.Hour, api.StateTime(domain.StateWorking))
+	assert.Equal(t, 2*time.Hour, api.StateTime(domain.StateWaiting))
+	assert.Equal(t, []domain.StatusChange{{At: since.Add(8 * time.Hour), Status: "review"}}, api.StatusChanges)
+
+	overnight := report.Sessions[1]
+	assert.Equal(t, "overnight", overnight.Name)
+	assert.Equal(t, time.Hour, overnight.StateTime(domain.StateWorking), "working before the period counts from its start")
+	assert.Equal(t, 9*time.Hour, overnight.StateTime(domain.StateIdle))
+}
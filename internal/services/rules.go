@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// ruleSound is the sound event played when a notify rule matches (the bell)
+const ruleSound = "rule"
+
+// RuleService applies the workflow rules of the settings to the sessions they match
+type RuleService struct {
+	applied        map[string]time.Time // Rule and session name -> LastUpdated of the session when applied
+	eventPublisher ports.EventPublisher
+	rules          []domain.Rule
+	sessionService *SessionService
+	soundPlayer    ports.SoundPlayer
+}
+
+// NewRuleService creates a new RuleService
+func NewRuleService(rules []domain.Rule, sessionService *SessionService, soundPlayer ports.SoundPlayer, eventPublisher ports.EventPublisher) *RuleService {
+	return &RuleService{
+		applied:        make(map[string]time.Time),
+		eventPublisher: eventPublisher,
+		rules:          rules,
+		sessionService: sessionService,
+		soundPlayer:    soundPlayer,
+	}
+}
+
+// IsEnabled returns true if any rule is configured
+func (s *RuleService) IsEnabled() bool {
+	return len(s.rules) > 0
+}
+
+// Match returns every session of the collection matched by a rule, whether or not
+// the rule was already applied to it. It changes nothing, for dry runs.
+func (s *RuleService) Match(state *domain.SessionCollection, now time.Time) []domain.RuleMatch {
+	return domain.MatchRules(s.rules, state, now)
+}
+
+// Due returns the matches of the collection not applied yet and marks them applied.
+// A rule applies once to a session until the session changes or stops matching.
+func (s *RuleService) Due(state *domain.SessionCollection, now time.Time) []domain.RuleMatch {
+	if !s.IsEnabled() {
+		return nil
+	}
+
+	matched := make(map[string]bool)
+	var due []domain.RuleMatch
+	for _, match := range s.Match(state, now) {
+		key := match.Rule.Name + "\x00" + match.Session.Name
+		matched[key] = true
+		if appliedAt, ok := s.applied[key]; ok && appliedAt.Equal(match.Session.LastUpdated) {
+			continue
+		}
+		s.applied[key] = match.Session.LastUpdated
+		due = append(due, match)
+	}
+
+	for key := range s.applied {
+		if !matched[key] {
+			delete(s.applied, key)
+		}
+	}
+	return due
+}
+
+// Apply runs the action of each match. Flag and archive skip sessions already
+// flagged or archived since they were matched, such as by another rocha instance.
+func (s *RuleService) Apply(ctx context.Context, matches []domain.RuleMatch) error {
+	var errs []error
+	var notified bool
+	for _, match := range matches {
+		session := match.Session
+		logging.Logger.Info("Applying rule", "rule", match.Rule.Name, "action", match.Rule.Action, "session", session.Name)
+
+		switch match.Rule.Action {
+		case domain.RuleActionNotify:
+			notified = true
+			event := domain.Event{Message: match.Rule.Name, SessionName: session.Name, State: session.State, Timestamp: time.Now(), Type: domain.EventRule}
+			if session.Status != nil {
+				event.Status = *session.Status
+			}
+			if err := s.eventPublisher.Publish(ctx, event); err != nil {
+				errs = append(errs, fmt.Errorf("failed to publish rule '%s' for '%s': %w", match.Rule.Name, session.Name, err))
+			}
+
+		case domain.RuleActionFlag:
+			current, err := s.sessionService.GetSession(ctx, session.Name)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if current.IsFlagged {
+				continue
+			}
+			if err := s.sessionService.ToggleFlag(ctx, session.Name); err != nil {
+				errs = append(errs, fmt.Errorf("failed to flag '%s': %w", session.Name, err))
+			}
+
+		case domain.RuleActionArchive:
+			current, err := s.sessionService.GetSession(ctx, session.Name)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if current.IsArchived {
+				continue
+			}
+			if err := s.sessionService.ArchiveSession(ctx, session.Name, false); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	}
+
+	if notified {
+		if err := s.soundPlayer.PlaySoundForEvent(ruleSound); err != nil {
+			errs = append(errs, fmt.Errorf("failed to play rule sound: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Run applies the rules due for the sessions not archived and returns the matches applied
+func (s *RuleService) Run(ctx context.Context, now time.Time) ([]domain.RuleMatch, error) {
+	if !s.IsEnabled() {
+		return nil, nil
+	}
+
+	state, err := s.sessionService.LoadState(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load sessions: %w", err)
+	}
+	due := s.Due(state, now)
+	return due, s.Apply(ctx, due)
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+	servicesmocks "github.com/renato0307/rocha/internal/services/mocks"
+)
+
+func newTestRule(t *testing.T, name, when, action string) domain.Rule {
+	t.Helper()
+	rule, err := domain.NewRule(name, when, action)
+	require.NoError(t, err)
+	return rule
+}
+
+func TestRuleService_DueAppliesEachMatchOnce(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	state := &domain.SessionCollection{
+		OrderedNames: []string{"api"},
+		Sessions:     map[string]domain.Session{"api": {Name: "api", State: domain.StateWaiting, LastUpdated: now}},
+	}
+	rule := newTestRule(t, "waiting", "state:waiting", "notify")
+	service := NewRuleService([]domain.Rule{rule}, nil, portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t))
+
+	due := service.Due(state, now)
+	require.Len(t, due, 1)
+	assert.Equal(t, "api", due[0].Session.Name)
+
+	// Still matching on the next poll, but already applied
+	assert.Empty(t, service.Due(state, now.Add(2*time.Second)))
+	assert.Len(t, service.Match(state, now), 1, "dry runs list applied matches too")
+
+	// Matching again after the session changed
+	state.Sessions["api"] = domain.Session{Name: "api", State: domain.StateWaiting, LastUpdated: now.Add(time.Minute)}
+	assert.Len(t, service.Due(state, now.Add(time.Minute)), 1)
+
+	// Matching again after it stopped matching
+	state.Sessions["api"] = domain.Session{Name: "api", State: domain.StateWorking, LastUpdated: now.Add(time.Minute)}
+	assert.Empty(t, service.Due(state, now.Add(time.Minute)))
+	state.Sessions["api"] = domain.Session{Name: "api", State: domain.StateWaiting, LastUpdated: now.Add(time.Minute)}
+	assert.Len(t, service.Due(state, now.Add(time.Minute)), 1)
+}
+
+func TestRuleService_Apply(t *testing.T) {
+	review := "review"
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	sessionService := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), eventPublisher, servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	notify := newTestRule(t, "review ready", "state:waiting status:review", "notify")
+	flag := newTestRule(t, "stuck", "state:waiting", "flag")
+	archive := newTestRule(t, "clean up", "state:exited older:1h", "archive")
+	matches := []domain.RuleMatch{
+		{Rule: notify, Session: domain.Session{Name: "api", State: domain.StateWaiting, Status: &review}},
+		{Rule: flag, Session: domain.Session{Name: "api", State: domain.StateWaiting, Status: &review}},
+		{Rule: flag, Session: domain.Session{Name: "web", State: domain.StateWaiting}},
+		{Rule: archive, Session: domain.Session{Name: "docs", State: domain.StateExited}},
+		{Rule: archive, Session: domain.Session{Name: "old", State: domain.StateExited}},
+	}
+
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(e domain.Event) bool {
+		return e.Type == domain.EventRule && e.SessionName == "api" && e.Message == "review ready" &&
+			e.State == domain.StateWaiting && e.Status == "review"
+	})).Return(nil).Once()
+	soundPlayer.EXPECT().PlaySoundForEvent("rule").Return(nil).Once()
+
+	sessionRepo.EXPECT().Get(mock.Anything, "api").Return(&domain.Session{Name: "api"}, nil)
+	sessionRepo.EXPECT().ToggleFlag(mock.Anything, "api").Return(nil).Once()
+	// Flagged elsewhere since it was matched
+	sessionRepo.EXPECT().Get(mock.Anything, "web").Return(&domain.Session{Name: "web", IsFlagged: true}, nil)
+
+	sessionRepo.EXPECT().Get(mock.Anything, "docs").Return(&domain.Session{Name: "docs"}, nil)
+	sessionRepo.EXPECT().ToggleArchive(mock.Anything, "docs").Return(nil).Once()
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(e domain.Event) bool {
+		return e.Type == domain.EventArchive && e.SessionName == "docs"
+	})).Return(nil).Once()
+	// Archived elsewhere since it was matched
+	sessionRepo.EXPECT().Get(mock.Anything, "old").Return(&domain.Session{Name: "old", IsArchived: true}, nil)
+
+	service := NewRuleService([]domain.Rule{notify, flag, archive}, sessionService, soundPlayer, eventPublisher)
+	require.NoError(t, service.Apply(context.Background(), matches))
+}
@@ -0,0 +1,315 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// SchedulerService queues text to be sent to sessions later and delivers it when due.
+// Prompts are held back while the concurrency limit on working sessions is reached.
+// Text matching the prompt review patterns is only sent to skip-permissions sessions,
+// or queued for them, once confirmed. Every text sent is kept in the prompt history of its session.
+type SchedulerService struct {
+	historyRepo   ports.PromptHistoryRepository
+	limit         domain.ConcurrencyLimit
+	promptRepo    ports.ScheduledPromptRepository
+	review        *domain.PromptReview // Nil when prompts are not reviewed
+	sessionReader ports.SessionReader
+	tmuxClient    ports.SessionManager
+}
+
+// NewSchedulerService creates a new SchedulerService
+func NewSchedulerService(
+	promptRepo ports.ScheduledPromptRepository,
+	historyRepo ports.PromptHistoryRepository,
+	sessionReader ports.SessionReader,
+	tmuxClient ports.SessionManager,
+	limit domain.ConcurrencyLimit,
+) *SchedulerService {
+	return &SchedulerService{
+		historyRepo:   historyRepo,
+		limit:         limit,
+		promptRepo:    promptRepo,
+		sessionReader: sessionReader,
+		tmuxClient:    tmuxClient,
+	}
+}
+
+// SetPromptReview sets the patterns prompts to skip-permissions sessions are reviewed against;
+// nil turns the review off
+func (s *SchedulerService) SetPromptReview(review *domain.PromptReview) {
+	s.review = review
+}
+
+// ReviewPrompt returns what text matches of the prompt review patterns when it goes to a session
+// running with skip-permissions, so it is sent only once confirmed. Nil means it can be sent.
+func (s *SchedulerService) ReviewPrompt(ctx context.Context, sessionName, text string) ([]string, error) {
+	if s.review == nil {
+		return nil, nil
+	}
+
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+	return s.reviewMatches(session, text), nil
+}
+
+// reviewMatches returns what text matches of the prompt review patterns when session runs with skip-permissions
+func (s *SchedulerService) reviewMatches(session *domain.Session, text string) []string {
+	if s.review == nil || !session.AllowDangerouslySkipPermissions {
+		return nil
+	}
+	return s.review.Match(text)
+}
+
+// checkReview fails with ErrConfirmationRequired when text that was not confirmed
+// matches the prompt review patterns and goes to a session running with skip-permissions
+func (s *SchedulerService) checkReview(session *domain.Session, text string, confirmed bool) error {
+	if confirmed {
+		return nil
+	}
+	if matches := s.reviewMatches(session, text); len(matches) > 0 {
+		return fmt.Errorf("%w: session %s runs with skip-permissions and the text contains %s",
+			domain.ErrConfirmationRequired, session.Name, strings.Join(matches, ", "))
+	}
+	return nil
+}
+
+// Schedule queues text to be sent to a session at sendAt.
+// confirmed tells the text was confirmed against the prompt review patterns.
+func (s *SchedulerService) Schedule(ctx context.Context, sessionName, text string, confirmed bool, sendAt time.Time) (*domain.ScheduledPrompt, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("%w: text cannot be empty", domain.ErrInvalidInput)
+	}
+
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkReview(session, text, confirmed); err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Info("Scheduling prompt", "session", sessionName, "send_at", sendAt)
+	return s.promptRepo.AddScheduledPrompt(ctx, domain.ScheduledPrompt{
+		Confirmed:   confirmed,
+		SendAt:      sendAt,
+		SessionName: sessionName,
+		Text:        text,
+	})
+}
+
+// ListPending returns prompts waiting to be sent, for one session or all if sessionName is empty
+func (s *SchedulerService) ListPending(ctx context.Context, sessionName string) ([]domain.ScheduledPrompt, error) {
+	return s.promptRepo.ListScheduledPrompts(ctx, sessionName)
+}
+
+// Cancel removes a pending prompt
+func (s *SchedulerService) Cancel(ctx context.Context, id uint) error {
+	logging.Logger.Info("Cancelling scheduled prompt", "id", id)
+	return s.promptRepo.DeleteScheduledPrompt(ctx, id)
+}
+
+// ListPromptHistory returns the most recent prompts sent to a session, newest first
+func (s *SchedulerService) ListPromptHistory(ctx context.Context, sessionName string, limit int) ([]domain.SentPrompt, error) {
+	if limit < 1 {
+		return nil, fmt.Errorf("limit must be at least 1: %w", domain.ErrInvalidInput)
+	}
+	if _, err := s.sessionReader.Get(ctx, sessionName); err != nil {
+		return nil, err
+	}
+	return s.historyRepo.ListSentPrompts(ctx, sessionName, limit)
+}
+
+// SendText types text into a session, submits it, and adds it to the session's prompt history.
+// confirmed tells the text was confirmed against the prompt review patterns.
+func (s *SchedulerService) SendText(ctx context.Context, sessionName, text string, confirmed bool) error {
+	if strings.TrimSpace(text) == "" {
+		return fmt.Errorf("%w: text cannot be empty", domain.ErrInvalidInput)
+	}
+
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return err
+	}
+	if err := s.checkReview(session, text, confirmed); err != nil {
+		return err
+	}
+
+	if !s.tmuxClient.SessionExists(sessionName) {
+		return fmt.Errorf("%w: %s", ports.ErrTmuxSessionNotFound, sessionName)
+	}
+
+	if err := s.tmuxClient.SendKeys(sessionName, text); err != nil {
+		return fmt.Errorf("failed to send text: %w", err)
+	}
+	if err := s.tmuxClient.SendKeys(sessionName, "C-m"); err != nil {
+		return fmt.Errorf("failed to send enter key: %w", err)
+	}
+
+	// The text was delivered, so a failure to record it must not be reported as a failed send
+	if err := s.historyRepo.AddSentPrompt(ctx, domain.SentPrompt{
+		SentAt:      time.Now(),
+		SessionName: sessionName,
+		Text:        text,
+	}); err != nil {
+		logging.Logger.Warn("Failed to record sent prompt", "session", sessionName, "error", err)
+	}
+
+	return nil
+}
+
+// SendOrQueue sends text now, or queues it as a prompt due at now when the concurrency limit is reached.
+// confirmed tells the text was confirmed against the prompt review patterns.
+// Returns the queued prompt, or nil if the text was sent.
+func (s *SchedulerService) SendOrQueue(ctx context.Context, sessionName, text string, confirmed bool, now time.Time) (*domain.ScheduledPrompt, error) {
+	if !s.limit.IsUnlimited() {
+		sessions, err := s.sessionReader.List(ctx, false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+		if target, ok := findSession(sessions, sessionName); ok && !s.limit.Allows(sessions, target) {
+			logging.Logger.Info("Concurrency limit reached, queueing prompt", "session", sessionName)
+			return s.Schedule(ctx, sessionName, text, confirmed, now)
+		}
+	}
+
+	return nil, s.SendText(ctx, sessionName, text, confirmed)
+}
+
+// DispatchDue sends every prompt that is due at now.
+// Each prompt is claimed before it is sent, so when several dispatchers run (the TUI and
+// rocha scheduler) only the one holding the claim sends it.
+// Prompts for sessions whose tmux session is not running stay queued until it is back,
+// and prompts that would exceed the concurrency limit stay queued until a working session frees up.
+func (s *SchedulerService) DispatchDue(ctx context.Context, now time.Time) (*DispatchResult, error) {
+	due, err := s.promptRepo.ListDuePrompts(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DispatchResult{}
+	if len(due) == 0 {
+		return result, nil
+	}
+
+	var sessions []domain.Session
+	if !s.limit.IsUnlimited() {
+		if sessions, err = s.sessionReader.List(ctx, false); err != nil {
+			return nil, fmt.Errorf("failed to list sessions: %w", err)
+		}
+	}
+
+	throttled := make(map[string]bool)
+	for _, prompt := range due {
+		if throttled[prompt.SessionName] {
+			continue
+		}
+		if target, ok := findSession(sessions, prompt.SessionName); ok && !s.limit.Allows(sessions, target) {
+			logging.Logger.Debug("Concurrency limit reached, keeping scheduled prompt", "id", prompt.ID, "session", prompt.SessionName)
+			throttled[prompt.SessionName] = true
+			result.Throttled = append(result.Throttled, prompt.SessionName)
+			continue
+		}
+
+		claimed, err := s.promptRepo.ClaimScheduledPrompt(ctx, prompt.ID, now)
+		if err != nil {
+			return result, err
+		}
+		if !claimed {
+			logging.Logger.Debug("Scheduled prompt claimed by another dispatcher", "id", prompt.ID, "session", prompt.SessionName)
+			continue
+		}
+
+		err = s.SendText(ctx, prompt.SessionName, prompt.Text, prompt.Confirmed)
+		switch {
+		case errors.Is(err, domain.ErrSessionNotFound):
+			logging.Logger.Warn("Dropping scheduled prompt for missing session", "id", prompt.ID, "session", prompt.SessionName)
+		case errors.Is(err, domain.ErrConfirmationRequired):
+			// The session switched to skip-permissions after the prompt was queued
+			logging.Logger.Warn("Dropping scheduled prompt that needs confirmation", "id", prompt.ID, "session", prompt.SessionName, "error", err)
+		case errors.Is(err, ports.ErrTmuxSessionNotFound):
+			logging.Logger.Debug("Session not running, keeping scheduled prompt", "id", prompt.ID, "session", prompt.SessionName)
+			s.releasePrompt(ctx, prompt)
+			continue
+		case err != nil:
+			logging.Logger.Warn("Failed to send scheduled prompt", "id", prompt.ID, "session", prompt.SessionName, "error", err)
+			s.releasePrompt(ctx, prompt)
+			continue
+		default:
+			logging.Logger.Info("Sent scheduled prompt", "id", prompt.ID, "session", prompt.SessionName)
+			result.Sent = append(result.Sent, prompt)
+			// The session is about to start working, so it counts towards the limit for later prompts
+			markWorking(sessions, prompt.SessionName)
+		}
+
+		if err := s.promptRepo.DeleteScheduledPrompt(ctx, prompt.ID); err != nil && !errors.Is(err, domain.ErrScheduledPromptNotFound) {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+// releasePrompt drops the claim on a prompt kept queued, so the next dispatch retries it.
+// A claim left behind only delays the retry until it times out.
+func (s *SchedulerService) releasePrompt(ctx context.Context, prompt domain.ScheduledPrompt) {
+	if err := s.promptRepo.ReleaseScheduledPrompt(ctx, prompt.ID); err != nil {
+		logging.Logger.Warn("Failed to release scheduled prompt", "id", prompt.ID, "session", prompt.SessionName, "error", err)
+	}
+}
+
+// findSession returns the session with the given name from sessions
+func findSession(sessions []domain.Session, name string) (domain.Session, bool) {
+	for _, session := range sessions {
+		if session.Name == name {
+			return session, true
+		}
+	}
+	return domain.Session{}, false
+}
+
+// markWorking sets the state of the named session to working
+func markWorking(sessions []domain.Session, name string) {
+	for i := range sessions {
+		if sessions[i].Name == name {
+			sessions[i].State = domain.StateWorking
+		}
+	}
+}
+
+// ResolveSendTime turns a --at value or a delay into an absolute send time.
+// at accepts "HH:MM" (next occurrence in local time) or RFC3339; it takes precedence over delay.
+func ResolveSendTime(at string, delay time.Duration, now time.Time) (time.Time, error) {
+	if at == "" {
+		if delay <= 0 {
+			return time.Time{}, fmt.Errorf("%w: delay must be positive", domain.ErrInvalidInput)
+		}
+		return now.Add(delay), nil
+	}
+
+	if clock, err := time.ParseInLocation("15:04", at, now.Location()); err == nil {
+		sendAt := time.Date(now.Year(), now.Month(), now.Day(), clock.Hour(), clock.Minute(), 0, 0, now.Location())
+		if !sendAt.After(now) {
+			sendAt = sendAt.AddDate(0, 0, 1)
+		}
+		return sendAt, nil
+	}
+
+	sendAt, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: time %q must be HH:MM or RFC3339", domain.ErrInvalidInput, at)
+	}
+	if !sendAt.After(now) {
+		return time.Time{}, fmt.Errorf("%w: time %q is in the past", domain.ErrInvalidInput, at)
+	}
+	return sendAt, nil
+}
@@ -0,0 +1,353 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestResolveSendTime(t *testing.T) {
+	now := time.Date(2026, 3, 10, 14, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		at      string
+		delay   time.Duration
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "delay", delay: 90 * time.Minute, want: now.Add(90 * time.Minute)},
+		{name: "clock later today", at: "16:00", want: time.Date(2026, 3, 10, 16, 0, 0, 0, time.UTC)},
+		{name: "clock already passed rolls to tomorrow", at: "09:00", want: time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)},
+		{name: "clock equal to now rolls to tomorrow", at: "14:30", want: time.Date(2026, 3, 11, 14, 30, 0, 0, time.UTC)},
+		{name: "rfc3339", at: "2026-03-12T08:00:00Z", want: time.Date(2026, 3, 12, 8, 0, 0, 0, time.UTC)},
+		{name: "rfc3339 in the past", at: "2026-03-09T08:00:00Z", wantErr: true},
+		{name: "invalid time", at: "tomorrow", wantErr: true},
+		{name: "no time and no delay", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveSendTime(tt.at, tt.delay, now)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, domain.ErrInvalidInput)
+				return
+			}
+			require.NoError(t, err)
+			assert.True(t, tt.want.Equal(got), "want %v, got %v", tt.want, got)
+		})
+	}
+}
+
+func TestSchedule_RejectsEmptyText(t *testing.T) {
+	service := NewSchedulerService(
+		portsmocks.NewMockScheduledPromptRepository(t),
+		portsmocks.NewMockPromptHistoryRepository(t),
+		portsmocks.NewMockSessionReader(t),
+		portsmocks.NewMockSessionManager(t),
+		domain.ConcurrencyLimit{},
+	)
+
+	_, err := service.Schedule(context.Background(), "s1", "   ", false, time.Now().Add(time.Hour))
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestDispatchDue(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+
+	promptRepo.EXPECT().ListDuePrompts(ctx, now).Return([]domain.ScheduledPrompt{
+		{ID: 1, SessionName: "running", Text: "continue"},
+		{ID: 2, SessionName: "stopped", Text: "wake up"},
+		{ID: 3, SessionName: "deleted", Text: "gone"},
+	}, nil)
+
+	sessionReader.EXPECT().Get(ctx, "running").Return(&domain.Session{Name: "running"}, nil)
+	sessionReader.EXPECT().Get(ctx, "stopped").Return(&domain.Session{Name: "stopped"}, nil)
+	sessionReader.EXPECT().Get(ctx, "deleted").Return(nil, domain.ErrSessionNotFound)
+
+	for _, id := range []uint{1, 2, 3} {
+		promptRepo.EXPECT().ClaimScheduledPrompt(ctx, id, now).Return(true, nil)
+	}
+	promptRepo.EXPECT().ReleaseScheduledPrompt(ctx, uint(2)).Return(nil)
+
+	tmuxClient.EXPECT().SessionExists("running").Return(true)
+	tmuxClient.EXPECT().SessionExists("stopped").Return(false)
+	tmuxClient.EXPECT().SendKeys("running", "continue").Return(nil)
+	tmuxClient.EXPECT().SendKeys("running", "C-m").Return(nil)
+
+	// Sent and orphaned prompts are removed; the stopped session keeps its prompt
+	promptRepo.EXPECT().DeleteScheduledPrompt(ctx, uint(1)).Return(nil)
+	promptRepo.EXPECT().DeleteScheduledPrompt(ctx, uint(3)).Return(nil)
+
+	// Delivered prompts join the session's prompt history
+	historyRepo := portsmocks.NewMockPromptHistoryRepository(t)
+	historyRepo.EXPECT().AddSentPrompt(ctx, mock.MatchedBy(func(p domain.SentPrompt) bool {
+		return p.SessionName == "running" && p.Text == "continue"
+	})).Return(nil)
+
+	service := NewSchedulerService(promptRepo, historyRepo, sessionReader, tmuxClient, domain.ConcurrencyLimit{})
+	result, err := service.DispatchDue(ctx, now)
+
+	require.NoError(t, err)
+	require.Len(t, result.Sent, 1)
+	assert.Equal(t, uint(1), result.Sent[0].ID)
+	assert.Empty(t, result.Throttled)
+}
+
+func TestDispatchDue_ConcurrencyLimit(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+
+	promptRepo.EXPECT().ListDuePrompts(ctx, now).Return([]domain.ScheduledPrompt{
+		{ID: 1, SessionName: "a2", Text: "first"},
+		{ID: 2, SessionName: "a3", Text: "second"},
+		{ID: 3, SessionName: "b1", Text: "other repo"},
+	}, nil)
+
+	// One slot per repo: a1 is idle, so a2 gets the slot and a3 waits for it
+	sessionReader.EXPECT().List(ctx, false).Return([]domain.Session{
+		{Name: "a1", RepoInfo: "owner/a", State: domain.StateIdle},
+		{Name: "a2", RepoInfo: "owner/a", State: domain.StateIdle},
+		{Name: "a3", RepoInfo: "owner/a", State: domain.StateIdle},
+		{Name: "b1", RepoInfo: "owner/b", State: domain.StateIdle},
+	}, nil)
+
+	promptRepo.EXPECT().ClaimScheduledPrompt(ctx, uint(1), now).Return(true, nil)
+	promptRepo.EXPECT().ClaimScheduledPrompt(ctx, uint(3), now).Return(true, nil)
+	for _, name := range []string{"a2", "b1"} {
+		sessionReader.EXPECT().Get(ctx, name).Return(&domain.Session{Name: name}, nil)
+		tmuxClient.EXPECT().SessionExists(name).Return(true)
+		tmuxClient.EXPECT().SendKeys(name, mock.Anything).Return(nil)
+		tmuxClient.EXPECT().SendKeys(name, "C-m").Return(nil)
+	}
+	promptRepo.EXPECT().DeleteScheduledPrompt(ctx, uint(1)).Return(nil)
+	promptRepo.EXPECT().DeleteScheduledPrompt(ctx, uint(3)).Return(nil)
+
+	historyRepo := portsmocks.NewMockPromptHistoryRepository(t)
+	historyRepo.EXPECT().AddSentPrompt(ctx, mock.Anything).Return(nil).Times(2)
+
+	service := NewSchedulerService(promptRepo, historyRepo, sessionReader, tmuxClient, domain.ConcurrencyLimit{PerRepo: 1})
+	result, err := service.DispatchDue(ctx, now)
+
+	require.NoError(t, err)
+	require.Len(t, result.Sent, 2)
+	assert.Equal(t, []string{"a3"}, result.Throttled)
+}
+
+func TestDispatchDue_SkipsPromptsClaimedElsewhere(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	promptRepo.EXPECT().ListDuePrompts(ctx, now).Return([]domain.ScheduledPrompt{
+		{ID: 1, SessionName: "running", Text: "continue"},
+	}, nil)
+	// Another dispatcher won the claim, so this one neither sends nor deletes the prompt
+	promptRepo.EXPECT().ClaimScheduledPrompt(ctx, uint(1), now).Return(false, nil)
+
+	service := NewSchedulerService(promptRepo, portsmocks.NewMockPromptHistoryRepository(t),
+		portsmocks.NewMockSessionReader(t), portsmocks.NewMockSessionManager(t), domain.ConcurrencyLimit{})
+	result, err := service.DispatchDue(ctx, now)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Sent)
+}
+
+func TestSendOrQueue_QueuesWhenLimitReached(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	sessionReader := portsmocks.NewMockSessionReader(t)
+
+	sessionReader.EXPECT().List(ctx, false).Return([]domain.Session{
+		{Name: "busy", State: domain.StateWorking},
+		{Name: "target", State: domain.StateIdle},
+	}, nil)
+	sessionReader.EXPECT().Get(ctx, "target").Return(&domain.Session{Name: "target"}, nil)
+	promptRepo.EXPECT().AddScheduledPrompt(ctx, domain.ScheduledPrompt{SendAt: now, SessionName: "target", Text: "go"}).
+		Return(&domain.ScheduledPrompt{ID: 7, SendAt: now, SessionName: "target", Text: "go"}, nil)
+
+	service := NewSchedulerService(promptRepo, portsmocks.NewMockPromptHistoryRepository(t), sessionReader, portsmocks.NewMockSessionManager(t), domain.ConcurrencyLimit{Global: 1})
+	queued, err := service.SendOrQueue(ctx, "target", "go", false, now)
+
+	require.NoError(t, err)
+	require.NotNil(t, queued)
+	assert.Equal(t, uint(7), queued.ID)
+}
+
+func TestSendText_RecordsHistoryAndIgnoresRecordFailure(t *testing.T) {
+	ctx := context.Background()
+
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+	historyRepo := portsmocks.NewMockPromptHistoryRepository(t)
+
+	sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{Name: "s1"}, nil)
+	tmuxClient.EXPECT().SessionExists("s1").Return(true)
+	tmuxClient.EXPECT().SendKeys("s1", "run the tests").Return(nil)
+	tmuxClient.EXPECT().SendKeys("s1", "C-m").Return(nil)
+	historyRepo.EXPECT().AddSentPrompt(ctx, mock.MatchedBy(func(p domain.SentPrompt) bool {
+		return p.SessionName == "s1" && p.Text == "run the tests" && !p.SentAt.IsZero()
+	})).Return(errors.New("database is locked"))
+
+	service := NewSchedulerService(portsmocks.NewMockScheduledPromptRepository(t), historyRepo, sessionReader, tmuxClient, domain.ConcurrencyLimit{})
+
+	assert.NoError(t, service.SendText(ctx, "s1", "run the tests", false))
+}
+
+func TestListPromptHistory_RejectsInvalidLimit(t *testing.T) {
+	service := NewSchedulerService(
+		portsmocks.NewMockScheduledPromptRepository(t),
+		portsmocks.NewMockPromptHistoryRepository(t),
+		portsmocks.NewMockSessionReader(t),
+		portsmocks.NewMockSessionManager(t),
+		domain.ConcurrencyLimit{},
+	)
+
+	_, err := service.ListPromptHistory(context.Background(), "s1", 0)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestReviewPrompt(t *testing.T) {
+	ctx := context.Background()
+	review, err := domain.NewPromptReview(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		skipPermissions bool
+		text            string
+		want            []string
+	}{
+		{name: "dangerous text to skip-permissions session", skipPermissions: true, text: "now rm -rf dist", want: []string{"rm -rf"}},
+		{name: "safe text to skip-permissions session", skipPermissions: true, text: "run the tests", want: nil},
+		{name: "dangerous text to session asking for permissions", skipPermissions: false, text: "now rm -rf dist", want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionReader := portsmocks.NewMockSessionReader(t)
+			sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{Name: "s1", AllowDangerouslySkipPermissions: tt.skipPermissions}, nil)
+
+			service := NewSchedulerService(portsmocks.NewMockScheduledPromptRepository(t), portsmocks.NewMockPromptHistoryRepository(t), sessionReader, portsmocks.NewMockSessionManager(t), domain.ConcurrencyLimit{})
+			service.SetPromptReview(review)
+
+			matches, err := service.ReviewPrompt(ctx, "s1", tt.text)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, matches)
+		})
+	}
+}
+
+func TestSendText_EnforcesPromptReview(t *testing.T) {
+	ctx := context.Background()
+	review, err := domain.NewPromptReview(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		confirmed bool
+		wantErr   error
+	}{
+		{name: "unconfirmed dangerous text is refused", confirmed: false, wantErr: domain.ErrConfirmationRequired},
+		{name: "confirmed dangerous text is sent", confirmed: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionReader := portsmocks.NewMockSessionReader(t)
+			tmuxClient := portsmocks.NewMockSessionManager(t)
+			historyRepo := portsmocks.NewMockPromptHistoryRepository(t)
+
+			sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{Name: "s1", AllowDangerouslySkipPermissions: true}, nil)
+			if tt.wantErr == nil {
+				tmuxClient.EXPECT().SessionExists("s1").Return(true)
+				tmuxClient.EXPECT().SendKeys("s1", "now rm -rf dist").Return(nil)
+				tmuxClient.EXPECT().SendKeys("s1", "C-m").Return(nil)
+				historyRepo.EXPECT().AddSentPrompt(ctx, mock.Anything).Return(nil)
+			}
+
+			service := NewSchedulerService(portsmocks.NewMockScheduledPromptRepository(t), historyRepo, sessionReader, tmuxClient, domain.ConcurrencyLimit{})
+			service.SetPromptReview(review)
+
+			err := service.SendText(ctx, "s1", "now rm -rf dist", tt.confirmed)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSchedule_EnforcesPromptReview(t *testing.T) {
+	ctx := context.Background()
+	sendAt := time.Now().Add(time.Hour)
+	review, err := domain.NewPromptReview(nil)
+	require.NoError(t, err)
+
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{Name: "s1", AllowDangerouslySkipPermissions: true}, nil)
+
+	service := NewSchedulerService(promptRepo, portsmocks.NewMockPromptHistoryRepository(t), sessionReader, portsmocks.NewMockSessionManager(t), domain.ConcurrencyLimit{})
+	service.SetPromptReview(review)
+
+	_, err = service.Schedule(ctx, "s1", "now rm -rf dist", false, sendAt)
+	assert.ErrorIs(t, err, domain.ErrConfirmationRequired)
+
+	// A confirmed prompt is stored with its confirmation so dispatch can send it later
+	promptRepo.EXPECT().AddScheduledPrompt(ctx, domain.ScheduledPrompt{Confirmed: true, SendAt: sendAt, SessionName: "s1", Text: "now rm -rf dist"}).
+		Return(&domain.ScheduledPrompt{ID: 1, Confirmed: true, SendAt: sendAt, SessionName: "s1", Text: "now rm -rf dist"}, nil)
+
+	prompt, err := service.Schedule(ctx, "s1", "now rm -rf dist", true, sendAt)
+	require.NoError(t, err)
+	assert.True(t, prompt.Confirmed)
+}
+
+func TestDispatchDue_DropsUnconfirmedReviewedPrompt(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	review, err := domain.NewPromptReview(nil)
+	require.NoError(t, err)
+
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+
+	promptRepo.EXPECT().ListDuePrompts(ctx, now).Return([]domain.ScheduledPrompt{
+		{ID: 1, SessionName: "s1", Text: "now rm -rf dist"},
+	}, nil)
+	sessionReader.EXPECT().Get(ctx, "s1").Return(&domain.Session{Name: "s1", AllowDangerouslySkipPermissions: true}, nil)
+	promptRepo.EXPECT().ClaimScheduledPrompt(ctx, uint(1), now).Return(true, nil)
+	tmuxClient.EXPECT().SessionExists("s1").Return(true).Maybe()
+	promptRepo.EXPECT().DeleteScheduledPrompt(ctx, uint(1)).Return(nil)
+
+	service := NewSchedulerService(promptRepo, portsmocks.NewMockPromptHistoryRepository(t), sessionReader, tmuxClient, domain.ConcurrencyLimit{})
+	service.SetPromptReview(review)
+
+	result, err := service.DispatchDue(ctx, now)
+
+	require.NoError(t, err)
+	assert.Empty(t, result.Sent)
+}
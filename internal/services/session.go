@@ -1,11 +1,15 @@
 package services
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/renato0307/rocha/internal/config"
@@ -16,11 +20,26 @@ import (
 
 // SessionService handles session lifecycle operations
 type SessionService struct {
-	claudeDirResolver ClaudeDirResolver
-	gitRepo           ports.GitRepository
-	processInspector  ports.ProcessInspector
-	sessionRepo       ports.SessionRepository
-	tmuxClient        ports.TmuxSessionLifecycle
+	claudeDirResolver    ClaudeDirResolver
+	displayNameTemplate  *template.Template // Names sessions created with AutoDisplayName
+	eventPublisher       ports.EventPublisher
+	gitCredentials       map[string]domain.GitCredentials // Per repository (owner/repo)
+	gitIdentities        map[string]domain.GitIdentity    // Per repository (owner/repo)
+	gitRepo              ports.GitRepository
+	guardrails           map[string]domain.Guardrails // Per repository (owner/repo), or domain.GuardrailsAllRepos
+	issueFinder          IssueFinder                  // Nil when issue titles are not looked up
+	processInspector     ports.ProcessInspector
+	sessionRepo          ports.SessionRepository
+	tmuxClient           ports.TmuxSessionLifecycle
+	tracer               ports.Tracer
+	worktreeBootstrapper WorktreeBootstrapper
+	worktreePaths        map[string]*template.Template // Per repository (owner/repo); others go under ROCHA_HOME
+}
+
+// IssueFinder finds the issue a branch links to, to name sessions after it
+type IssueFinder interface {
+	// FindIssue returns the key and title of the issue, both empty when there is none
+	FindIssue(ctx context.Context, repoInfo, branch string) (string, string, error)
 }
 
 // NewSessionService creates a new SessionService
@@ -30,25 +49,122 @@ func NewSessionService(
 	tmuxClient ports.TmuxSessionLifecycle,
 	claudeDirResolver ClaudeDirResolver,
 	processInspector ports.ProcessInspector,
+	eventPublisher ports.EventPublisher,
+	worktreeBootstrapper WorktreeBootstrapper,
 ) *SessionService {
 	return &SessionService{
-		claudeDirResolver: claudeDirResolver,
-		gitRepo:           gitRepo,
-		processInspector:  processInspector,
-		sessionRepo:       sessionRepo,
-		tmuxClient:        tmuxClient,
+		claudeDirResolver:    claudeDirResolver,
+		eventPublisher:       eventPublisher,
+		gitRepo:              gitRepo,
+		processInspector:     processInspector,
+		sessionRepo:          sessionRepo,
+		tmuxClient:           tmuxClient,
+		tracer:               noopTracer{},
+		worktreeBootstrapper: worktreeBootstrapper,
 	}
 }
 
+// SetTracer traces session lifecycle operations and their git, tmux, and bootstrap steps from now on
+func (s *SessionService) SetTracer(tracer ports.Tracer) {
+	s.tracer = tracer
+}
+
+// SetGitCredentials sets how git authenticates to the remote of each repository (owner/repo)
+func (s *SessionService) SetGitCredentials(credentials map[string]domain.GitCredentials) {
+	s.gitCredentials = credentials
+}
+
+// SetGitIdentities sets the git identity new worktrees of each repository (owner/repo) commit with
+func (s *SessionService) SetGitIdentities(identities map[string]domain.GitIdentity) {
+	s.gitIdentities = identities
+}
+
+// SetGuardrails sets the protected branches and blocked paths of each repository (owner/repo);
+// those under domain.GuardrailsAllRepos apply to every repository
+func (s *SessionService) SetGuardrails(guardrails map[string]domain.Guardrails) {
+	s.guardrails = guardrails
+}
+
+// SetWorktreePaths sets the templates of where new worktrees of each repository (owner/repo) go
+func (s *SessionService) SetWorktreePaths(templates map[string]*template.Template) {
+	s.worktreePaths = templates
+}
+
+// SetAutoNaming sets the template naming sessions created with AutoDisplayName and where
+// the titles of their linked issues are looked up (nil names them after the branch only)
+func (s *SessionService) SetAutoNaming(tmpl *template.Template, issueFinder IssueFinder) {
+	s.displayNameTemplate = tmpl
+	s.issueFinder = issueFinder
+}
+
+// SuggestDisplayName names a session on a branch after the issue the branch links to, or
+// else the branch itself, using the display name template. Failing to look the issue up
+// only costs its title.
+func (s *SessionService) SuggestDisplayName(ctx context.Context, repoInfo, branch string) string {
+	fields := domain.DisplayNameFields{
+		Branch:      branch,
+		BranchTitle: domain.HumanizeBranch(branch),
+		Repo:        repoInfo,
+	}
+	if s.issueFinder != nil && repoInfo != "" {
+		key, title, err := s.issueFinder.FindIssue(ctx, repoInfo, branch)
+		if err != nil {
+			logging.Logger.Warn("Failed to look up the issue linked to the branch", "branch", branch, "error", err)
+		}
+		fields.IssueKey = key
+		fields.IssueTitle = title
+	}
+
+	tmpl := s.displayNameTemplate
+	if tmpl == nil {
+		tmpl, _ = domain.ParseDisplayNameTemplate("")
+	}
+	return domain.RenderDisplayName(tmpl, fields)
+}
+
 // CreateSession orchestrates session creation with optional worktree
 func (s *SessionService) CreateSession(
 	ctx context.Context,
 	params CreateSessionParams,
+) (*CreateSessionResult, error) {
+	ctx, span := s.tracer.Start(ctx, "session.create")
+	span.SetAttribute("session.name", params.SessionName)
+	result, err := s.createSession(ctx, params)
+	span.End(err)
+	return result, err
+}
+
+// createSession creates a session in a new or reused worktree, or in an existing directory
+func (s *SessionService) createSession(
+	ctx context.Context,
+	params CreateSessionParams,
 ) (*CreateSessionResult, error) {
 	sessionName := params.SessionName
 	branchName := params.BranchNameOverride
 	repoSource := params.RepoSource
 
+	if err := params.GitIdentity.Validate(); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateAgentModel(params.AgentModel); err != nil {
+		return nil, err
+	}
+	if err := domain.ValidateAgentArgs(params.AgentArgs); err != nil {
+		return nil, err
+	}
+	if !params.GitIdentity.IsZero() && (params.DirectoryPath != "" || repoSource == "") {
+		return nil, fmt.Errorf("%w: a git identity is only set in new worktrees, which need a repository", domain.ErrInvalidInput)
+	}
+	subdir, err := domain.NormalizeSubdir(params.Subdir)
+	if err != nil {
+		return nil, err
+	}
+	params.Subdir = subdir
+
+	if params.DirectoryPath != "" {
+		return s.createExternalSession(ctx, params)
+	}
+
 	// Automatically create worktree if repo is provided
 	createWorktree := repoSource != ""
 
@@ -60,7 +176,11 @@ func (s *SessionService) CreateSession(
 
 	// Generate tmux-compatible name
 	tmuxName := domain.SanitizeSessionName(sessionName)
+	if err := s.ensureNameAvailable(ctx, tmuxName); err != nil {
+		return nil, err
+	}
 
+	var baseBranch string
 	var claudeDir string
 	var repoInfo string
 	var repoPath string
@@ -73,7 +193,9 @@ func (s *SessionService) CreateSession(
 
 		worktreeBase := config.GetWorktreePath()
 
-		localPath, src, err := s.gitRepo.GetOrCloneRepository(repoSource, worktreeBase)
+		_, span := s.tracer.Start(ctx, "git.get_or_clone")
+		localPath, src, err := s.gitRepo.GetOrCloneRepository(repoSource, worktreeBase, s.gitCredentialsFor(repoSource))
+		span.End(err)
 		if err != nil {
 			return nil, fmt.Errorf("failed to get repository: %w", err)
 		}
@@ -104,18 +226,7 @@ func (s *SessionService) CreateSession(
 	}
 
 	// 2. Resolve ClaudeDir
-	claudeDir = s.claudeDirResolver.Resolve(repoInfo, params.ClaudeDirOverride)
-	logging.Logger.Info("Resolved ClaudeDir", "path", claudeDir)
-
-	// If ClaudeDir is system default, don't set custom override
-	homeDir, err := os.UserHomeDir()
-	if err == nil {
-		systemDefault := filepath.Join(homeDir, ".claude")
-		if claudeDir == systemDefault {
-			logging.Logger.Info("ClaudeDir is system default, not setting custom override", "default", systemDefault)
-			claudeDir = ""
-		}
-	}
+	claudeDir = s.resolveSessionClaudeDir(repoInfo, params.ClaudeDirOverride)
 
 	// 3. Create worktree if requested
 	if createWorktree && repoPath != "" {
@@ -128,6 +239,12 @@ func (s *SessionService) CreateSession(
 			logging.Logger.Info("Auto-generated branch name from session name", "branch", branchName)
 		}
 
+		if err := s.checkGuardrails(repoInfo, branchName, ""); err != nil {
+			return nil, err
+		}
+
+		baseBranch = s.gitRepo.GetDefaultBranch(repoPath)
+
 		// Check if a worktree already exists for this branch
 		existingWorktree, err := s.gitRepo.GetWorktreeForBranch(repoPath, branchName)
 		if err != nil {
@@ -138,17 +255,48 @@ func (s *SessionService) CreateSession(
 		if existingWorktree != "" {
 			// Reuse existing worktree
 			worktreePath = existingWorktree
+			if err := s.checkGuardrails(repoInfo, "", worktreePath); err != nil {
+				return nil, err
+			}
 			logging.Logger.Info("Reusing existing worktree for branch",
 				"branch", branchName, "path", worktreePath)
+			if err := s.applyGitIdentity(ctx, repoInfo, worktreePath, params.GitIdentity); err != nil {
+				return nil, err
+			}
 		} else {
 			// Create new worktree
-			worktreeBase := config.GetWorktreePath()
-			worktreePath = s.gitRepo.BuildWorktreePath(worktreeBase, repoInfo, tmuxName)
+			worktreePath, err = s.newWorktreePath(repoInfo, repoPath, branchName, tmuxName)
+			if err != nil {
+				return nil, err
+			}
+			if err := s.checkGuardrails(repoInfo, "", worktreePath); err != nil {
+				return nil, err
+			}
 			logging.Logger.Info("Creating worktree", "path", worktreePath, "branch", branchName)
 
-			if err := s.gitRepo.CreateWorktree(repoPath, worktreePath, branchName); err != nil {
+			_, span := s.tracer.Start(ctx, "git.create_worktree")
+			err := s.gitRepo.CreateWorktree(repoPath, worktreePath, branchName)
+			span.End(err)
+			if err != nil {
 				return nil, fmt.Errorf("failed to create worktree: %w", err)
 			}
+
+			// Prepare the new worktree before the agent starts; remove it on failure so a retry starts clean
+			if err := s.applyGitIdentity(ctx, repoInfo, worktreePath, params.GitIdentity); err != nil {
+				if removeErr := s.gitRepo.RemoveWorktree(repoPath, worktreePath); removeErr != nil {
+					logging.Logger.Warn("Failed to remove worktree after git identity failure", "path", worktreePath, "error", removeErr)
+				}
+				return nil, err
+			}
+			bootstrapCtx, span := s.tracer.Start(ctx, "worktree.bootstrap")
+			err = s.worktreeBootstrapper.Bootstrap(bootstrapCtx, repoInfo, repoPath, worktreePath, params.BootstrapOutput)
+			span.End(err)
+			if err != nil {
+				if removeErr := s.gitRepo.RemoveWorktree(repoPath, worktreePath); removeErr != nil {
+					logging.Logger.Warn("Failed to remove worktree after bootstrap failure", "path", worktreePath, "error", removeErr)
+				}
+				return nil, fmt.Errorf("failed to bootstrap worktree: %w", err)
+			}
 		}
 	} else if createWorktree && repoPath == "" {
 		logging.Logger.Warn("Cannot create worktree: not in a git repository")
@@ -157,20 +305,25 @@ func (s *SessionService) CreateSession(
 		logging.Logger.Info("Using branch from URL (no worktree)", "branch", branchName)
 	}
 
-	// 4. Create tmux session
-	tmuxSession, err := s.tmuxClient.CreateSession(tmuxName, worktreePath, claudeDir, params.TmuxStatusPosition, params.InitialPrompt)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create session: %w", err)
-	}
-
-	// 5. Build domain session and save
+	// 4. Build domain session, save it, and start the agent
 	executionID := os.Getenv("ROCHA_EXECUTION_ID")
 
+	displayName := displayNameOrDefault(params)
+	if params.AutoDisplayName && params.DisplayName == "" && branchName != "" {
+		if suggested := s.SuggestDisplayName(ctx, repoInfo, branchName); suggested != "" {
+			displayName = suggested
+			logging.Logger.Info("Named session after its branch", "branch", branchName, "display_name", displayName)
+		}
+	}
+
 	session := domain.Session{
+		AgentArgs:                       params.AgentArgs,
+		AgentModel:                      params.AgentModel,
 		AllowDangerouslySkipPermissions: params.AllowDangerouslySkipPermissions,
+		BaseBranch:                      baseBranch,
 		BranchName:                      branchName,
 		ClaudeDir:                       claudeDir,
-		DisplayName:                     sessionName,
+		DisplayName:                     displayName,
 		ExecutionID:                     executionID,
 		InitialPrompt:                   params.InitialPrompt,
 		LastUpdated:                     time.Now().UTC(),
@@ -179,16 +332,16 @@ func (s *SessionService) CreateSession(
 		RepoPath:                        repoPath,
 		RepoSource:                      repoSource,
 		State:                           domain.StateWaiting,
+		Subdir:                          params.Subdir,
 		WorktreePath:                    worktreePath,
 	}
 
-	if err := s.sessionRepo.Add(ctx, session); err != nil {
-		logging.Logger.Error("Failed to add session to database", "error", err)
+	if err := s.saveAndLaunch(ctx, session, params.TmuxStatusPosition); err != nil {
 		return nil, err
 	}
 
 	logging.Logger.Info("Session created successfully",
-		"name", tmuxSession.Name,
+		"name", session.Name,
 		"claude_dir", claudeDir,
 		"repo_source", repoSource)
 
@@ -198,12 +351,244 @@ func (s *SessionService) CreateSession(
 	}, nil
 }
 
-// KillSession kills a session and removes it from state
+// guardrailsFor returns the guardrails of a repository, those of all repositories included
+func (s *SessionService) guardrailsFor(repoInfo string) domain.Guardrails {
+	guardrails := s.guardrails[domain.GuardrailsAllRepos]
+	if repoInfo != "" {
+		guardrails = guardrails.Merge(s.guardrails[repoInfo])
+	}
+	return guardrails
+}
+
+// checkGuardrails fails with domain.ErrGuardrail if a session of repoInfo may not work
+// on branch or in dir; empty values are not checked
+func (s *SessionService) checkGuardrails(repoInfo, branch, dir string) error {
+	guardrails := s.guardrailsFor(repoInfo)
+	if branch != "" {
+		if err := guardrails.CheckBranch(branch); err != nil {
+			return err
+		}
+	}
+	if dir != "" {
+		if err := guardrails.CheckPath(dir); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// gitCredentialsFor returns the credentials set for the repository of a remote source, if any
+func (s *SessionService) gitCredentialsFor(source string) domain.GitCredentials {
+	if len(s.gitCredentials) == 0 {
+		return domain.GitCredentials{}
+	}
+	src, err := s.gitRepo.ParseRepoSource(source)
+	if err != nil || !src.IsRemote {
+		return domain.GitCredentials{}
+	}
+	return s.gitCredentials[src.Owner+"/"+src.Repo]
+}
+
+// applyGitIdentity makes a worktree commit with the identity of its repository, with the
+// fields set in override replacing it. Nothing is changed when neither sets anything.
+func (s *SessionService) applyGitIdentity(ctx context.Context, repoInfo, worktreePath string, override domain.GitIdentity) error {
+	identity := s.gitIdentities[repoInfo].Merge(override)
+	if identity.IsZero() {
+		return nil
+	}
+	if err := s.gitRepo.SetWorktreeIdentity(ctx, worktreePath, identity); err != nil {
+		return fmt.Errorf("failed to set git identity: %w", err)
+	}
+	return nil
+}
+
+// createExternalSession creates a session that runs in an existing directory as-is.
+// No repository is cloned and no branch or worktree is created.
+func (s *SessionService) createExternalSession(
+	ctx context.Context,
+	params CreateSessionParams,
+) (*CreateSessionResult, error) {
+	dirPath, err := config.ResolveDirectory(params.DirectoryPath)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Info("Creating external session", "name", params.SessionName, "path", dirPath)
+
+	tmuxName := domain.SanitizeSessionName(params.SessionName)
+	if err := s.ensureNameAvailable(ctx, tmuxName); err != nil {
+		return nil, err
+	}
+
+	// Record git metadata for display, but never touch the checkout
+	var branchName, repoInfo, repoSource string
+	if isGit, repo := s.gitRepo.IsGitRepo(dirPath); isGit {
+		branchName = s.gitRepo.GetBranchName(dirPath)
+		repoInfo = s.gitRepo.GetRepoInfo(repo)
+		repoSource = s.gitRepo.GetRemoteURL(repo)
+	}
+	if err := s.checkGuardrails(repoInfo, branchName, dirPath); err != nil {
+		return nil, err
+	}
+
+	claudeDir := s.resolveSessionClaudeDir(repoInfo, params.ClaudeDirOverride)
+
+	session := domain.Session{
+		AgentArgs:                       params.AgentArgs,
+		AgentModel:                      params.AgentModel,
+		AllowDangerouslySkipPermissions: params.AllowDangerouslySkipPermissions,
+		BranchName:                      branchName,
+		ClaudeDir:                       claudeDir,
+		DisplayName:                     displayNameOrDefault(params),
+		ExecutionID:                     os.Getenv("ROCHA_EXECUTION_ID"),
+		InitialPrompt:                   params.InitialPrompt,
+		IsExternal:                      true,
+		LastUpdated:                     time.Now().UTC(),
+		Name:                            tmuxName,
+		RepoInfo:                        repoInfo,
+		RepoPath:                        dirPath,
+		RepoSource:                      repoSource,
+		State:                           domain.StateWaiting,
+		Subdir:                          params.Subdir,
+	}
+
+	if err := s.saveAndLaunch(ctx, session, params.TmuxStatusPosition); err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Info("External session created successfully", "name", session.Name, "path", dirPath)
+
+	return &CreateSessionResult{Session: &session}, nil
+}
+
+// ensureNameAvailable fails with domain.ErrSessionExists if a session or a tmux session
+// already uses name, before anything is cloned or created for the new session
+func (s *SessionService) ensureNameAvailable(ctx context.Context, name string) error {
+	taken, err := s.nameTaken(ctx, name)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return fmt.Errorf("%w: %s", domain.ErrSessionExists, name)
+	}
+	if s.tmuxClient.SessionExists(name) {
+		return fmt.Errorf("%w: tmux session %s", domain.ErrSessionExists, name)
+	}
+	return nil
+}
+
+// AvailableName returns name if no session uses it, or else the first free "<name>-N".
+// Archived sessions keep their names. name is checked as given, so sanitize it first
+// when the session is created from a display name.
+func (s *SessionService) AvailableName(ctx context.Context, name string) (string, error) {
+	var lookupErr error
+	available := domain.NextSessionName(name, func(candidate string) bool {
+		taken, err := s.nameTaken(ctx, candidate)
+		if err != nil {
+			lookupErr = err
+			return false // Stop looking
+		}
+		return taken
+	})
+	if lookupErr != nil {
+		return "", lookupErr
+	}
+	return available, nil
+}
+
+// nameTaken reports whether a session, archived or not, uses name
+func (s *SessionService) nameTaken(ctx context.Context, name string) (bool, error) {
+	_, err := s.sessionRepo.Get(ctx, name)
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.Is(err, domain.ErrSessionNotFound):
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to check session name: %w", err)
+	}
+}
+
+// saveAndLaunch stores a new session and then starts its agent.
+// The session is stored first because start-claude reads the agent CLI flags from it;
+// it is removed again if the agent cannot be started.
+func (s *SessionService) saveAndLaunch(ctx context.Context, session domain.Session, tmuxStatusPosition string) error {
+	// A reused or new worktree is kept, so a retry with a corrected subdirectory reuses it
+	if session.Subdir != "" && !dirExists(session.StartDir()) {
+		return fmt.Errorf("%w: subdirectory %s does not exist in %s", domain.ErrInvalidInput, session.Subdir, session.WorkingDir())
+	}
+
+	if err := s.sessionRepo.Add(ctx, session); err != nil {
+		logging.Logger.Error("Failed to add session to database", "error", err)
+		return err
+	}
+
+	_, span := s.tracer.Start(ctx, "tmux.create_session")
+	_, err := s.tmuxClient.CreateSession(session.Name, session.StartDir(), session.ClaudeDir, tmuxStatusPosition, session.InitialPrompt)
+	span.End(err)
+	if err != nil {
+		if deleteErr := s.sessionRepo.Delete(ctx, session.Name); deleteErr != nil {
+			logging.Logger.Warn("Failed to remove session after start failure", "name", session.Name, "error", deleteErr)
+		}
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+	return nil
+}
+
+// killTmuxSession kills the multiplexer session of a session, traced as a step of ctx
+func (s *SessionService) killTmuxSession(ctx context.Context, name string) error {
+	_, span := s.tracer.Start(ctx, "tmux.kill_session")
+	err := s.tmuxClient.KillSession(name)
+	span.End(err)
+	return err
+}
+
+// removeWorktree removes a worktree from its repository, traced as a step of ctx
+func (s *SessionService) removeWorktree(ctx context.Context, repoPath, worktreePath string) error {
+	_, span := s.tracer.Start(ctx, "git.remove_worktree")
+	err := s.gitRepo.RemoveWorktree(repoPath, worktreePath)
+	span.End(err)
+	return err
+}
+
+// displayNameOrDefault returns the display name requested for a new session, or its name
+func displayNameOrDefault(params CreateSessionParams) string {
+	if params.DisplayName != "" {
+		return params.DisplayName
+	}
+	return params.SessionName
+}
+
+// resolveSessionClaudeDir resolves the ClaudeDir for a new session
+// Returns empty string when the result is the system default (no override needed)
+func (s *SessionService) resolveSessionClaudeDir(repoInfo, override string) string {
+	claudeDir := s.claudeDirResolver.Resolve(repoInfo, override)
+	logging.Logger.Info("Resolved ClaudeDir", "path", claudeDir)
+
+	// If ClaudeDir is system default, don't set custom override
+	homeDir, err := os.UserHomeDir()
+	if err == nil {
+		systemDefault := filepath.Join(homeDir, ".claude")
+		if claudeDir == systemDefault {
+			logging.Logger.Info("ClaudeDir is system default, not setting custom override", "default", systemDefault)
+			return ""
+		}
+	}
+	return claudeDir
+}
+
+// KillSession kills a session and removes it from state.
+// With a positive shutdownTimeout the agent is first asked to exit (see ShutdownAgent).
 func (s *SessionService) KillSession(
 	ctx context.Context,
 	sessionName string,
+	shutdownTimeout time.Duration,
 ) error {
-	logging.Logger.Info("Killing session", "name", sessionName)
+	logging.Logger.Info("Killing session", "name", sessionName, "shutdownTimeout", shutdownTimeout)
+
+	ctx, span := s.tracer.Start(ctx, "session.kill")
+	span.SetAttribute("session.name", sessionName)
+	defer span.End(nil)
 
 	// Get session info to check for shell session
 	session, err := s.sessionRepo.Get(ctx, sessionName)
@@ -211,20 +596,21 @@ func (s *SessionService) KillSession(
 		logging.Logger.Warn("Could not get session info", "name", sessionName, "error", err)
 	}
 
+	// Let the agent save its conversation before tmux goes away
+	if shutdownTimeout > 0 {
+		s.ShutdownAgent(ctx, sessionName, shutdownTimeout)
+	}
+
 	// Kill shell session if it exists
 	if session != nil && session.ShellSession != nil {
 		logging.Logger.Info("Killing shell session", "name", session.ShellSession.Name)
 		if err := s.tmuxClient.KillSession(session.ShellSession.Name); err != nil {
 			logging.Logger.Warn("Failed to kill shell session", "error", err)
 		}
-		// Delete shell session from DB
-		if err := s.sessionRepo.Delete(ctx, session.ShellSession.Name); err != nil {
-			logging.Logger.Warn("Failed to delete shell session from DB", "error", err)
-		}
 	}
 
 	// Kill main Claude session
-	if err := s.tmuxClient.KillSession(sessionName); err != nil {
+	if err := s.killTmuxSession(ctx, sessionName); err != nil {
 		logging.Logger.Warn("Failed to kill session (may already be exited)", "name", sessionName, "error", err)
 	}
 
@@ -237,10 +623,60 @@ func (s *SessionService) KillSession(
 	return nil
 }
 
+// DefaultShutdownTimeout is how long the agent gets to exit on its own before its session is killed
+const DefaultShutdownTimeout = 10 * time.Second
+
+// shutdownPollInterval is how often the session state is checked while waiting for the agent to exit
+var shutdownPollInterval = 250 * time.Millisecond
+
+// ShutdownAgent asks the agent to stop and exit, then waits up to timeout for the session to
+// reach the exited state. Killing tmux while the agent is writing can corrupt the conversation.
+// Returns true if the agent exited (or was not running), false if it had to be given up on.
+func (s *SessionService) ShutdownAgent(ctx context.Context, sessionName string, timeout time.Duration) bool {
+	session, err := s.sessionRepo.Get(ctx, sessionName)
+	if err != nil || session.State == domain.StateExited || !s.tmuxClient.SessionExists(sessionName) {
+		return true
+	}
+
+	logging.Logger.Info("Asking agent to exit", "session", sessionName, "timeout", timeout)
+
+	ctx, span := s.tracer.Start(ctx, "agent.shutdown")
+	exited := false
+	defer func() {
+		span.SetAttribute("agent.exited", exited)
+		span.End(nil)
+	}()
+
+	if err := s.tmuxClient.RequestAgentExit(sessionName); err != nil {
+		logging.Logger.Warn("Failed to ask agent to exit", "session", sessionName, "error", err)
+		return false
+	}
+
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(shutdownPollInterval):
+		}
+
+		session, err := s.sessionRepo.Get(ctx, sessionName)
+		if err == nil && session.State == domain.StateExited {
+			logging.Logger.Info("Agent exited", "session", sessionName)
+			exited = true
+			return true
+		}
+	}
+
+	logging.Logger.Warn("Agent did not exit in time, killing session", "session", sessionName, "timeout", timeout)
+	return false
+}
+
 // DeleteSessionOptions configures session deletion behavior
 type DeleteSessionOptions struct {
-	KillTmux       bool // Kill tmux sessions before deleting
-	RemoveWorktree bool // Remove worktree from filesystem
+	KillTmux        bool          // Kill tmux sessions before deleting
+	RemoveWorktree  bool          // Remove worktree from filesystem
+	ShutdownTimeout time.Duration // Time the agent gets to exit before tmux is killed (0 kills right away)
 }
 
 // DeleteSession removes a session from database with optional tmux kill and worktree removal
@@ -248,12 +684,16 @@ func (s *SessionService) DeleteSession(
 	ctx context.Context,
 	sessionName string,
 	opts DeleteSessionOptions,
-) error {
+) (err error) {
 	logging.Logger.Info("Deleting session",
 		"session", sessionName,
 		"killTmux", opts.KillTmux,
 		"removeWorktree", opts.RemoveWorktree)
 
+	ctx, span := s.tracer.Start(ctx, "session.delete")
+	span.SetAttribute("session.name", sessionName)
+	defer func() { span.End(err) }()
+
 	// Get session info before deleting (to get worktree path and shell session)
 	session, err := s.sessionRepo.Get(ctx, sessionName)
 	if err != nil {
@@ -263,6 +703,10 @@ func (s *SessionService) DeleteSession(
 
 	// Kill tmux sessions if requested
 	if opts.KillTmux {
+		if opts.ShutdownTimeout > 0 {
+			s.ShutdownAgent(ctx, sessionName, opts.ShutdownTimeout)
+		}
+
 		logging.Logger.Debug("Killing tmux sessions", "session", sessionName)
 		// Kill shell session if exists
 		if session.ShellSession != nil {
@@ -274,7 +718,7 @@ func (s *SessionService) DeleteSession(
 		}
 
 		// Kill main session
-		if err := s.tmuxClient.KillSession(sessionName); err != nil {
+		if err := s.killTmuxSession(ctx, sessionName); err != nil {
 			logging.Logger.Warn("Failed to kill tmux session", "session", sessionName, "error", err)
 			fmt.Printf("⚠ Warning: Failed to kill tmux session %s: %v\n", sessionName, err)
 		}
@@ -290,7 +734,7 @@ func (s *SessionService) DeleteSession(
 	// Remove worktree if requested and exists
 	if opts.RemoveWorktree && session.WorktreePath != "" && session.RepoPath != "" {
 		logging.Logger.Info("Removing worktree", "session", sessionName, "path", session.WorktreePath)
-		if err := s.gitRepo.RemoveWorktree(session.RepoPath, session.WorktreePath); err != nil {
+		if err := s.removeWorktree(ctx, session.RepoPath, session.WorktreePath); err != nil {
 			logging.Logger.Warn("Failed to remove worktree", "session", sessionName, "path", session.WorktreePath, "error", err)
 			fmt.Printf("⚠ Warning: Failed to remove worktree for %s: %v\n", sessionName, err)
 		} else {
@@ -307,9 +751,13 @@ func (s *SessionService) ArchiveSession(
 	ctx context.Context,
 	sessionName string,
 	removeWorktree bool,
-) error {
+) (err error) {
 	logging.Logger.Info("Archiving session", "name", sessionName, "removeWorktree", removeWorktree)
 
+	ctx, span := s.tracer.Start(ctx, "session.archive")
+	span.SetAttribute("session.name", sessionName)
+	defer func() { span.End(err) }()
+
 	// Get session info
 	session, err := s.sessionRepo.Get(ctx, sessionName)
 	if err != nil {
@@ -319,7 +767,7 @@ func (s *SessionService) ArchiveSession(
 	// Remove worktree if requested
 	if removeWorktree && session.WorktreePath != "" {
 		logging.Logger.Info("Removing worktree", "path", session.WorktreePath, "repo", session.RepoPath)
-		if err := s.gitRepo.RemoveWorktree(session.RepoPath, session.WorktreePath); err != nil {
+		if err := s.removeWorktree(ctx, session.RepoPath, session.WorktreePath); err != nil {
 			logging.Logger.Error("Failed to remove worktree", "error", err, "path", session.WorktreePath)
 			// Continue with archive even if worktree removal fails
 		} else {
@@ -333,36 +781,19 @@ func (s *SessionService) ArchiveSession(
 	}
 
 	logging.Logger.Info("Session archived", "name", sessionName)
+
+	event := domain.Event{SessionName: sessionName, Timestamp: time.Now(), Type: domain.EventArchive}
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		logging.Logger.Warn("Failed to publish archive event", "error", err, "session", sessionName)
+	}
+
 	return nil
 }
 
 // LoadState loads the session state from the repository
 func (s *SessionService) LoadState(ctx context.Context, includeArchived bool) (*domain.SessionCollection, error) {
 	logging.Logger.Debug("Loading session state", "includeArchived", includeArchived)
-	state, err := s.sessionRepo.LoadState(ctx, includeArchived)
-	if err != nil {
-		return nil, err
-	}
-
-	// Filter out shell sessions (names ending with "-shell") from the main list
-	// Shell sessions are nested under their parent session's ShellSession field
-	filteredSessions := make(map[string]domain.Session)
-	var filteredOrderedNames []string
-	for name, session := range state.Sessions {
-		if strings.HasSuffix(name, "-shell") {
-			continue
-		}
-		filteredSessions[name] = session
-	}
-	for _, name := range state.OrderedNames {
-		if !strings.HasSuffix(name, "-shell") {
-			filteredOrderedNames = append(filteredOrderedNames, name)
-		}
-	}
-	state.Sessions = filteredSessions
-	state.OrderedNames = filteredOrderedNames
-
-	return state, nil
+	return s.sessionRepo.LoadState(ctx, includeArchived)
 }
 
 // SaveState saves the session state to the repository
@@ -398,6 +829,12 @@ func (s *SessionService) ListSessions(ctx context.Context, includeArchived bool)
 	return sessions, nil
 }
 
+// ListSummaries returns the state of the non-archived sessions, without loading them.
+// It is a cheap read for callers polled often, such as the tmux status bar.
+func (s *SessionService) ListSummaries(ctx context.Context) ([]domain.SessionSummary, error) {
+	return s.sessionRepo.ListSummaries(ctx)
+}
+
 // AddSession adds a new session to the repository
 func (s *SessionService) AddSession(ctx context.Context, session domain.Session) error {
 	logging.Logger.Debug("Adding session", "name", session.Name)
@@ -416,10 +853,134 @@ func (s *SessionService) UpdateDisplayName(ctx context.Context, name, displayNam
 	return s.sessionRepo.UpdateDisplayName(ctx, name, displayName)
 }
 
+// UpdateNote updates the markdown note for a session
+func (s *SessionService) UpdateNote(ctx context.Context, name, note string) error {
+	logging.Logger.Debug("Updating session note", "name", name, "note_length", len(note))
+	return s.sessionRepo.UpdateNote(ctx, name, note)
+}
+
 // UpdateStatus updates the status for a session
 func (s *SessionService) UpdateStatus(ctx context.Context, name string, status *string) error {
 	logging.Logger.Debug("Updating session status", "name", name)
-	return s.sessionRepo.UpdateStatus(ctx, name, status)
+	if err := s.sessionRepo.UpdateStatus(ctx, name, status); err != nil {
+		return err
+	}
+
+	event := domain.Event{SessionName: name, Timestamp: time.Now(), Type: domain.EventStatusChange}
+	if status != nil {
+		event.Status = *status
+	}
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		logging.Logger.Warn("Failed to publish status change event", "error", err, "session", name)
+	}
+
+	return nil
+}
+
+// RecordHandoff records a note on where the user left off with a session, shown in the
+// detail pane when they come back
+func (s *SessionService) RecordHandoff(ctx context.Context, name, note string) error {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return fmt.Errorf("handoff note is empty: %w", domain.ErrInvalidInput)
+	}
+	logging.Logger.Debug("Recording handoff note", "name", name, "note_length", len(note))
+
+	event := domain.Event{Message: note, SessionName: name, Timestamp: time.Now(), Type: domain.EventHandoff}
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		logging.Logger.Warn("Failed to publish handoff event", "error", err, "session", name)
+	}
+
+	return nil
+}
+
+// SetTags replaces the tags of a session; an empty list clears them.
+// Returns the stored tags after normalization.
+func (s *SessionService) SetTags(ctx context.Context, name string, tags []string) ([]string, error) {
+	return s.updateTags(ctx, name, func([]string) []string { return tags })
+}
+
+// AddTags adds tags to a session, keeping the ones it already has
+func (s *SessionService) AddTags(ctx context.Context, name string, tags []string) ([]string, error) {
+	return s.updateTags(ctx, name, func(current []string) []string { return append(current, tags...) })
+}
+
+// RemoveTags removes tags from a session; tags it does not have are ignored
+func (s *SessionService) RemoveTags(ctx context.Context, name string, tags []string) ([]string, error) {
+	removed, err := domain.NormalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+	return s.updateTags(ctx, name, func(current []string) []string {
+		return slices.DeleteFunc(current, func(tag string) bool { return slices.Contains(removed, tag) })
+	})
+}
+
+// updateTags applies change to the current tags of a session and stores the normalized result
+func (s *SessionService) updateTags(ctx context.Context, name string, change func(current []string) []string) ([]string, error) {
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := domain.NormalizeTags(change(slices.Clone(session.Tags)))
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Debug("Updating session tags", "name", name, "tags", tags)
+	if err := s.sessionRepo.UpdateTags(ctx, name, tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// UpdateMetadata replaces the hand-edited fields of a session with metadata, writing only the
+// fields that changed. Tags are normalized and an empty status clears it. Returns the changed fields.
+func (s *SessionService) UpdateMetadata(ctx context.Context, name string, metadata domain.SessionMetadata) ([]string, error) {
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata.DisplayName = strings.TrimSpace(metadata.DisplayName)
+	metadata.Comment = strings.TrimSpace(metadata.Comment)
+	if metadata.Status != nil {
+		status := strings.TrimSpace(*metadata.Status)
+		metadata.Status = nil
+		if status != "" {
+			metadata.Status = &status
+		}
+	}
+	if metadata.Priority, err = domain.ParsePriority(string(metadata.Priority)); err != nil {
+		return nil, err
+	}
+	if metadata.Tags, err = domain.NormalizeTags(metadata.Tags); err != nil {
+		return nil, err
+	}
+
+	changes := metadata.Changes(session.Metadata())
+	logging.Logger.Debug("Updating session metadata", "name", name, "changes", changes)
+	for _, field := range changes {
+		switch field {
+		case domain.MetadataComment:
+			err = s.UpdateComment(ctx, name, metadata.Comment)
+		case domain.MetadataDisplayName:
+			err = s.UpdateDisplayName(ctx, name, metadata.DisplayName)
+		case domain.MetadataFlagged:
+			err = s.ToggleFlag(ctx, name)
+		case domain.MetadataPriority:
+			err = s.UpdatePriority(ctx, name, metadata.Priority)
+		case domain.MetadataStatus:
+			err = s.UpdateStatus(ctx, name, metadata.Status)
+		case domain.MetadataTags:
+			err = s.sessionRepo.UpdateTags(ctx, name, metadata.Tags)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to update %s: %w", field, err)
+		}
+	}
+	return changes, nil
 }
 
 // UpdatePRInfo updates the PR info for a session
@@ -432,12 +993,39 @@ func (s *SessionService) UpdatePRInfo(ctx context.Context, name string, prInfo *
 	return s.sessionRepo.UpdatePRInfo(ctx, name, prInfo)
 }
 
+// SetFlag flags or unflags a session
+func (s *SessionService) SetFlag(ctx context.Context, name string, flagged bool) error {
+	logging.Logger.Debug("Setting session flag", "name", name, "flagged", flagged)
+	return s.sessionRepo.SetFlag(ctx, name, flagged)
+}
+
 // ToggleFlag toggles the flag for a session
 func (s *SessionService) ToggleFlag(ctx context.Context, name string) error {
 	logging.Logger.Debug("Toggling session flag", "name", name)
 	return s.sessionRepo.ToggleFlag(ctx, name)
 }
 
+// UpdatePriority sets or clears (PriorityNone) the priority of a session
+func (s *SessionService) UpdatePriority(ctx context.Context, name string, priority domain.Priority) error {
+	logging.Logger.Debug("Updating session priority", "name", name, "priority", priority)
+	return s.sessionRepo.UpdatePriority(ctx, name, priority)
+}
+
+// CyclePriority moves a session to the next priority level (none, P0, P1, P2, P3, none)
+// and returns the new level
+func (s *SessionService) CyclePriority(ctx context.Context, name string) (domain.Priority, error) {
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return domain.PriorityNone, err
+	}
+
+	next := session.Priority.Next()
+	if err := s.UpdatePriority(ctx, name, next); err != nil {
+		return domain.PriorityNone, err
+	}
+	return next, nil
+}
+
 // SwapPositions swaps the positions of two sessions
 func (s *SessionService) SwapPositions(ctx context.Context, name1, name2 string) error {
 	logging.Logger.Debug("Swapping session positions", "name1", name1, "name2", name2)
@@ -450,34 +1038,228 @@ func (s *SessionService) UpdateRepoSource(ctx context.Context, name, repoSource
 	return s.sessionRepo.UpdateRepoSource(ctx, name, repoSource)
 }
 
+// SwitchBranch checks out another branch in the worktree of a session and records it
+// as the session branch. The worktree path, and so the agent's directory, stays the same.
+// Refuses with domain.ErrWorktreeDirty while the worktree has uncommitted changes,
+// which the switch could carry over or lose, and with domain.ErrGuardrail for protected
+// branches. Returns the previous branch.
+func (s *SessionService) SwitchBranch(ctx context.Context, name, branch string) (string, error) {
+	logging.Logger.Info("Switching session branch", "name", name, "branch", branch)
+
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	worktreePath, err := switchableWorktree(session)
+	if err != nil {
+		return "", err
+	}
+
+	previous := s.gitRepo.GetBranchName(worktreePath)
+	if branch == previous {
+		return "", fmt.Errorf("session '%s' is already on branch '%s': %w", name, branch, domain.ErrInvalidInput)
+	}
+	if err := s.checkGuardrails(session.RepoInfo, branch, ""); err != nil {
+		return "", err
+	}
+
+	branches, err := s.gitRepo.ListBranches(ctx, worktreePath)
+	if err != nil {
+		return "", err
+	}
+	index := slices.IndexFunc(branches, func(b domain.Branch) bool { return b.Name == branch })
+	if index < 0 {
+		return "", fmt.Errorf("branch '%s' not found: %w", branch, domain.ErrInvalidInput)
+	}
+	if other := branches[index].Worktree; other != "" {
+		return "", fmt.Errorf("branch '%s' is checked out in %s: %w", branch, other, domain.ErrInvalidInput)
+	}
+
+	status, err := s.gitRepo.GetWorktreeStatus(ctx, worktreePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to check worktree for changes: %w", err)
+	}
+	if len(status.UncommittedFiles) > 0 {
+		return "", fmt.Errorf("%w: %d uncommitted files, commit or stash them first", domain.ErrWorktreeDirty, len(status.UncommittedFiles))
+	}
+
+	if err := s.gitRepo.SwitchBranch(ctx, worktreePath, branch); err != nil {
+		return "", err
+	}
+	if err := s.sessionRepo.UpdateBranchName(ctx, name, branch); err != nil {
+		return "", fmt.Errorf("switched to '%s' but failed to save the session branch: %w", branch, err)
+	}
+	return previous, nil
+}
+
+// switchableWorktree returns the worktree of a session whose branch can be switched
+// Sessions running in a directory as-is are left alone, as their checkout is the user's.
+func switchableWorktree(session *domain.Session) (string, error) {
+	if session.IsExternal || session.WorktreePath == "" {
+		return "", fmt.Errorf("session '%s' has no worktree: %w", session.Name, domain.ErrInvalidInput)
+	}
+	return session.WorktreePath, nil
+}
+
 // RenameTmuxSession renames only the tmux session (not the database entry)
 func (s *SessionService) RenameTmuxSession(oldName, newName string) error {
 	logging.Logger.Debug("Renaming tmux session", "oldName", oldName, "newName", newName)
 	return s.tmuxClient.RenameSession(oldName, newName)
 }
 
-// RenameSession renames a session in both tmux and database, preserving position
-func (s *SessionService) RenameSession(ctx context.Context, oldName, newName, newDisplayName string) error {
-	logging.Logger.Debug("Renaming session", "oldName", oldName, "newName", newName, "displayName", newDisplayName)
+// RenameSession renames a session everywhere its name is used: the tmux session and its
+// shell session, the database rows that refer to it, and optionally its branch and worktree
+// directory. The new name must be free in tmux and in the database, archived sessions
+// included. Steps already done are undone if a later one fails. Position is preserved.
+func (s *SessionService) RenameSession(ctx context.Context, oldName, newName string, opts RenameOptions) error {
+	logging.Logger.Debug("Renaming session", "oldName", oldName, "newName", newName, "displayName", opts.DisplayName,
+		"branch", opts.BranchName, "moveWorktree", opts.MoveWorktree)
 
-	// Rename in tmux first
-	if err := s.tmuxClient.RenameSession(oldName, newName); err != nil {
-		return fmt.Errorf("failed to rename tmux session: %w", err)
+	session, err := s.sessionRepo.Get(ctx, oldName)
+	if err != nil {
+		return err
+	}
+	rename, err := s.planRename(ctx, session, newName, opts)
+	if err != nil {
+		return err
 	}
 
-	// Rename in database (preserves position)
-	if err := s.sessionRepo.Rename(ctx, oldName, newName, newDisplayName); err != nil {
-		// Try to rollback tmux rename
-		if rollbackErr := s.tmuxClient.RenameSession(newName, oldName); rollbackErr != nil {
-			logging.Logger.Error("Failed to rollback tmux rename after database error",
-				"oldName", oldName, "newName", newName, "rollbackError", rollbackErr)
+	var undo []func()
+	rollback := func() {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+	}
+
+	if rename.BranchName != "" {
+		if err := s.gitRepo.RenameBranch(ctx, session.WorktreePath, session.BranchName, rename.BranchName); err != nil {
+			return err
 		}
+		undo = append(undo, func() {
+			if err := s.gitRepo.RenameBranch(ctx, session.WorktreePath, rename.BranchName, session.BranchName); err != nil {
+				logging.Logger.Error("Failed to rollback branch rename", "branch", rename.BranchName, "error", err)
+			}
+		})
+	}
+
+	if rename.WorktreePath != "" {
+		if err := s.gitRepo.MoveWorktree(ctx, session.RepoPath, session.WorktreePath, rename.WorktreePath); err != nil {
+			rollback()
+			return err
+		}
+		undo = append(undo, func() {
+			if err := s.gitRepo.MoveWorktree(ctx, session.RepoPath, rename.WorktreePath, session.WorktreePath); err != nil {
+				logging.Logger.Error("Failed to rollback worktree move", "path", rename.WorktreePath, "error", err)
+			}
+		})
+	}
+
+	tmuxRenames := [][2]string{{oldName, newName}, {domain.ShellSessionName(oldName), domain.ShellSessionName(newName)}}
+	for _, names := range tmuxRenames {
+		if names[0] == names[1] || !s.tmuxClient.SessionExists(names[0]) {
+			continue
+		}
+		if err := s.tmuxClient.RenameSession(names[0], names[1]); err != nil {
+			rollback()
+			return fmt.Errorf("failed to rename tmux session: %w", err)
+		}
+		undo = append(undo, func() {
+			if err := s.tmuxClient.RenameSession(names[1], names[0]); err != nil {
+				logging.Logger.Error("Failed to rollback tmux rename after database error",
+					"oldName", names[0], "newName", names[1], "rollbackError", err)
+			}
+		})
+	}
+
+	// Rename in database (preserves position)
+	if err := s.sessionRepo.Rename(ctx, rename); err != nil {
+		rollback()
 		return fmt.Errorf("failed to rename in database: %w", err)
 	}
 
 	return nil
 }
 
+// planRename checks a rename before anything changes and returns what to store
+func (s *SessionService) planRename(ctx context.Context, session *domain.Session, newName string, opts RenameOptions) (domain.SessionRename, error) {
+	rename := domain.SessionRename{
+		DisplayName: opts.DisplayName,
+		NewName:     newName,
+		OldName:     session.Name,
+	}
+	if rename.DisplayName == "" {
+		rename.DisplayName = session.DisplayName
+	}
+	if newName == "" {
+		return rename, fmt.Errorf("%w: the new session name is empty", domain.ErrInvalidInput)
+	}
+
+	if newName != session.Name {
+		if err := s.ensureNameAvailable(ctx, newName); err != nil {
+			return rename, err
+		}
+		if shellName := domain.ShellSessionName(newName); s.tmuxClient.SessionExists(shellName) {
+			return rename, fmt.Errorf("%w: tmux session %s", domain.ErrSessionExists, shellName)
+		}
+	}
+
+	if opts.BranchName != "" && opts.BranchName != session.BranchName {
+		if _, err := switchableWorktree(session); err != nil {
+			return rename, err
+		}
+		if err := s.gitRepo.ValidateBranchName(opts.BranchName); err != nil {
+			return rename, fmt.Errorf("%w: %v", domain.ErrInvalidInput, err)
+		}
+		if err := s.checkGuardrails(session.RepoInfo, opts.BranchName, ""); err != nil {
+			return rename, err
+		}
+		rename.BranchName = opts.BranchName
+	}
+
+	if opts.MoveWorktree {
+		if _, err := switchableWorktree(session); err != nil {
+			return rename, err
+		}
+		newPath, err := s.renamedWorktreePath(session, newName, cmp.Or(rename.BranchName, session.BranchName))
+		if err != nil {
+			return rename, err
+		}
+		if newPath != session.WorktreePath {
+			if pathExists(newPath) {
+				return rename, fmt.Errorf("%w: %s already exists", domain.ErrInvalidInput, newPath)
+			}
+			if err := s.checkGuardrails(session.RepoInfo, "", newPath); err != nil {
+				return rename, err
+			}
+			// The agent and shell would keep working in a directory that is gone
+			if s.tmuxClient.SessionExists(session.Name) || s.tmuxClient.SessionExists(domain.ShellSessionName(session.Name)) {
+				return rename, fmt.Errorf("%w: stop session '%s' before moving its worktree", domain.ErrInvalidInput, session.Name)
+			}
+			rename.WorktreePath = newPath
+		}
+	}
+
+	return rename, nil
+}
+
+// newWorktreePath returns where a new worktree goes: where the template of its repository says,
+// or under ROCHA_HOME
+func (s *SessionService) newWorktreePath(repoInfo, repoPath, branchName, sessionName string) (string, error) {
+	if tmpl := s.worktreePaths[repoInfo]; tmpl != nil {
+		return domain.RenderWorktreePath(tmpl, domain.NewWorktreePathFields(repoInfo, repoPath, branchName, sessionName))
+	}
+	return s.gitRepo.BuildWorktreePath(config.GetWorktreePath(), repoInfo, sessionName), nil
+}
+
+// renamedWorktreePath returns where the worktree of a renamed session moves to, following
+// the template of its repository when there is one
+func (s *SessionService) renamedWorktreePath(session *domain.Session, newName, newBranch string) (string, error) {
+	if tmpl := s.worktreePaths[session.RepoInfo]; tmpl != nil {
+		return domain.RenderWorktreePath(tmpl, domain.NewWorktreePathFields(session.RepoInfo, session.RepoPath, newBranch, newName))
+	}
+	return RenamedWorktreePath(session.WorktreePath, newName), nil
+}
+
 // SessionExists checks if a tmux session exists
 func (s *SessionService) SessionExists(name string) bool {
 	return s.tmuxClient.SessionExists(name)
@@ -493,6 +1275,103 @@ func (s *SessionService) RecreateSession(name, worktreePath, claudeDir, tmuxStat
 	return err
 }
 
+// RestartSession brings back a session whose Claude process has exited or whose tmux session is gone.
+// Resume continues the recorded Claude conversation, fresh starts a new one, and shell starts no Claude.
+// If the tmux session still exists (Claude exited to the shell), it is replaced, except in shell mode
+// where the existing shell is kept as-is.
+func (s *SessionService) RestartSession(ctx context.Context, name string, mode domain.RestartMode, tmuxStatusPosition string) error {
+	logging.Logger.Info("Restarting session", "name", name, "mode", mode)
+
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+
+	if mode == domain.RestartResume && !session.CanResume() {
+		return fmt.Errorf("%w: no Claude conversation recorded for session %s", domain.ErrInvalidInput, name)
+	}
+	if session.Subdir != "" && !dirExists(session.StartDir()) {
+		return fmt.Errorf("%w: subdirectory %s does not exist in %s", domain.ErrInvalidInput, session.Subdir, session.WorkingDir())
+	}
+
+	if s.tmuxClient.SessionExists(name) {
+		if session.State != domain.StateExited {
+			return fmt.Errorf("%w: %s is still running", ports.ErrTmuxSessionExists, name)
+		}
+		if mode == domain.RestartShell {
+			return nil
+		}
+		if err := s.tmuxClient.KillSession(name); err != nil {
+			return fmt.Errorf("failed to stop exited session: %w", err)
+		}
+	}
+
+	switch mode {
+	case domain.RestartResume:
+		_, err = s.tmuxClient.ResumeSession(name, session.StartDir(), session.ClaudeDir, tmuxStatusPosition, session.ClaudeSessionID)
+	case domain.RestartFresh:
+		_, err = s.tmuxClient.CreateSession(name, session.StartDir(), session.ClaudeDir, tmuxStatusPosition, "")
+	case domain.RestartShell:
+		_, err = s.tmuxClient.CreateShellSession(name, session.StartDir(), tmuxStatusPosition)
+	default:
+		return fmt.Errorf("%w: unknown restart mode %q", domain.ErrInvalidInput, mode)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restart session: %w", err)
+	}
+
+	return nil
+}
+
+// ResumeSessions recreates the tmux sessions that are gone, e.g. after a reboot.
+// Sessions with a recorded Claude conversation resume it; the others start a fresh one.
+// Running sessions are left as is. Failures are reported per session and do not stop the others.
+func (s *SessionService) ResumeSessions(ctx context.Context, sessions []domain.Session, tmuxStatusPosition string) []ResumeResult {
+	logging.Logger.Info("Resuming sessions", "count", len(sessions))
+
+	results := make([]ResumeResult, 0, len(sessions))
+	for _, session := range sessions {
+		result := ResumeResult{Name: session.Name}
+
+		switch {
+		case s.tmuxClient.SessionExists(session.Name):
+			result.Outcome = ResumeOutcomeRunning
+		case session.WorkingDir() == "":
+			result.Outcome = ResumeOutcomeFailed
+			result.Err = fmt.Errorf("%w: session has no working directory", domain.ErrInvalidInput)
+		case !dirExists(session.StartDir()):
+			result.Outcome = ResumeOutcomeFailed
+			result.Err = fmt.Errorf("%w: working directory %s no longer exists", domain.ErrInvalidInput, session.StartDir())
+		default:
+			mode, outcome := domain.RestartFresh, ResumeOutcomeFresh
+			if session.CanResume() {
+				mode, outcome = domain.RestartResume, ResumeOutcomeResumed
+			}
+			if err := s.RestartSession(ctx, session.Name, mode, tmuxStatusPosition); err != nil {
+				result.Outcome = ResumeOutcomeFailed
+				result.Err = err
+			} else {
+				result.Outcome = outcome
+			}
+		}
+
+		if result.Err != nil {
+			logging.Logger.Warn("Failed to resume session", "name", session.Name, "error", result.Err)
+		} else {
+			logging.Logger.Debug("Session resume processed", "name", session.Name, "outcome", result.Outcome)
+		}
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// dirExists returns true if path is an existing directory
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
 // ToggleArchive toggles the archive status of a session
 func (s *SessionService) ToggleArchive(ctx context.Context, name string) error {
 	logging.Logger.Debug("Toggling archive status", "name", name)
@@ -550,3 +1429,28 @@ func (s *SessionService) GetAgentSettings(ctx context.Context, sessionName strin
 
 	return settingsJSON, nil
 }
+
+// SampleResourceUsage samples CPU and memory of the agent process of each session
+func (s *SessionService) SampleResourceUsage(sessionNames []string) (map[string]*domain.ResourceUsage, error) {
+	usage, err := s.processInspector.GetResourceUsage(sessionNames)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sample resource usage: %w", err)
+	}
+	return usage, nil
+}
+
+// KillAgentProcess terminates the agent process of a session, keeping the session itself
+func (s *SessionService) KillAgentProcess(ctx context.Context, sessionName string) error {
+	logging.Logger.Info("Killing agent process", "session", sessionName)
+
+	// Verify session exists
+	if _, err := s.sessionRepo.Get(ctx, sessionName); err != nil {
+		return fmt.Errorf("session not found: %w", err)
+	}
+
+	if err := s.processInspector.KillAgentProcess(sessionName); err != nil {
+		return fmt.Errorf("failed to kill agent process: %w", err)
+	}
+
+	return nil
+}
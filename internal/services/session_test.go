@@ -3,7 +3,11 @@ package services
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
+	"text/template"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -25,8 +29,9 @@ func TestCreateSession_ReusesExistingWorktree(t *testing.T) {
 	processInspector := portsmocks.NewMockProcessInspector(t)
 
 	// Setup expectations
-	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything).
+	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
 		Return("/path/to/repo", &domain.RepoSource{Owner: "test", Repo: "repo"}, nil)
+	gitRepo.EXPECT().GetDefaultBranch("/path/to/repo").Return("main")
 	gitRepo.EXPECT().GetWorktreeForBranch("/path/to/repo", "feature-branch").
 		Return(existingWorktreePath, nil)
 
@@ -35,9 +40,11 @@ func TestCreateSession_ReusesExistingWorktree(t *testing.T) {
 	tmuxClient.EXPECT().CreateSession(mock.Anything, existingWorktreePath, mock.Anything, mock.Anything, mock.Anything).
 		Return(&ports.TmuxSession{Name: "test-session"}, nil)
 
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("test-session").Return(false)
 	sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 	result, err := service.CreateSession(context.Background(), CreateSessionParams{
 		SessionName:        "test-session",
@@ -47,6 +54,7 @@ func TestCreateSession_ReusesExistingWorktree(t *testing.T) {
 
 	require.NoError(t, err)
 	assert.Equal(t, existingWorktreePath, result.WorktreePath, "should use existing worktree path")
+	assert.Equal(t, "main", result.Session.BaseBranch, "should record the base branch")
 }
 
 func TestCreateSession_CreatesNewWorktreeWhenNoneExists(t *testing.T) {
@@ -57,10 +65,12 @@ func TestCreateSession_CreatesNewWorktreeWhenNoneExists(t *testing.T) {
 	sessionRepo := portsmocks.NewMockSessionRepository(t)
 	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
 	processInspector := portsmocks.NewMockProcessInspector(t)
+	bootstrapper := servicesmocks.NewMockWorktreeBootstrapper(t)
 
 	// Setup expectations
-	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything).
+	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
 		Return("/path/to/repo", &domain.RepoSource{Owner: "test", Repo: "repo"}, nil)
+	gitRepo.EXPECT().GetDefaultBranch("/path/to/repo").Return("main")
 	gitRepo.EXPECT().GetWorktreeForBranch("/path/to/repo", "feature-branch").
 		Return("", nil) // No existing worktree
 	gitRepo.EXPECT().BuildWorktreePath(mock.Anything, "test/repo", mock.Anything).
@@ -68,14 +78,19 @@ func TestCreateSession_CreatesNewWorktreeWhenNoneExists(t *testing.T) {
 	gitRepo.EXPECT().CreateWorktree("/path/to/repo", newWorktreePath, "feature-branch").
 		Return(nil)
 
+	bootstrapper.EXPECT().Bootstrap(mock.Anything, "test/repo", "/path/to/repo", newWorktreePath, mock.Anything).
+		Return(nil)
+
 	claudeDirResolver.EXPECT().Resolve("test/repo", mock.Anything).Return("/tmp/claude")
 
 	tmuxClient.EXPECT().CreateSession(mock.Anything, newWorktreePath, mock.Anything, mock.Anything, mock.Anything).
 		Return(&ports.TmuxSession{Name: "test-session"}, nil)
 
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("test-session").Return(false)
 	sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), bootstrapper)
 
 	result, err := service.CreateSession(context.Background(), CreateSessionParams{
 		SessionName:        "test-session",
@@ -87,6 +102,158 @@ func TestCreateSession_CreatesNewWorktreeWhenNoneExists(t *testing.T) {
 	assert.Equal(t, newWorktreePath, result.WorktreePath, "should use newly created worktree path")
 }
 
+func TestCreateSession_WorktreePathTemplate(t *testing.T) {
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+	bootstrapper := servicesmocks.NewMockWorktreeBootstrapper(t)
+
+	// The worktree goes next to the main checkout instead of under ROCHA_HOME
+	wantPath := "/src/app-feature-login"
+	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
+		Return("/src/app", &domain.RepoSource{Owner: "acme", Repo: "app"}, nil)
+	gitRepo.EXPECT().GetDefaultBranch("/src/app").Return("main")
+	gitRepo.EXPECT().GetWorktreeForBranch("/src/app", "feature/login").Return("", nil)
+	gitRepo.EXPECT().CreateWorktree("/src/app", wantPath, "feature/login").Return(nil)
+	bootstrapper.EXPECT().Bootstrap(mock.Anything, "acme/app", "/src/app", wantPath, mock.Anything).Return(nil)
+	claudeDirResolver.EXPECT().Resolve("acme/app", mock.Anything).Return("/tmp/claude")
+	tmuxClient.EXPECT().CreateSession(mock.Anything, wantPath, mock.Anything, mock.Anything, mock.Anything).
+		Return(&ports.TmuxSession{Name: "login"}, nil)
+	sessionRepo.EXPECT().Get(mock.Anything, "login").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("login").Return(false)
+	sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+
+	tmpl, err := domain.ParseWorktreePathTemplate("../{{.RepoDir}}-{{.Branch}}")
+	require.NoError(t, err)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), bootstrapper)
+	service.SetWorktreePaths(map[string]*template.Template{"acme/app": tmpl})
+
+	result, err := service.CreateSession(context.Background(), CreateSessionParams{
+		BranchNameOverride: "feature/login",
+		RepoSource:         "https://github.com/acme/app",
+		SessionName:        "login",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, wantPath, result.WorktreePath)
+}
+
+func TestCreateSession_Guardrails(t *testing.T) {
+	tests := []struct {
+		name       string
+		branch     string
+		guardrails map[string]domain.Guardrails
+	}{
+		{
+			name:       "protected branch of every repository",
+			branch:     "main",
+			guardrails: map[string]domain.Guardrails{domain.GuardrailsAllRepos: {ProtectedBranches: []string{"main"}}},
+		},
+		{
+			name:       "protected branch of the repository",
+			branch:     "release/2.0",
+			guardrails: map[string]domain.Guardrails{"acme/app": {ProtectedBranches: []string{"release/*"}}},
+		},
+		{
+			name:       "blocked worktree path",
+			branch:     "feature/login",
+			guardrails: map[string]domain.Guardrails{"acme/app": {BlockedPaths: []string{"/src"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitRepo := portsmocks.NewMockGitRepository(t)
+			sessionRepo := portsmocks.NewMockSessionRepository(t)
+			tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+			claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+
+			// Nothing is created: no CreateWorktree, bootstrap, tmux session, or Add
+			gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
+				Return("/src/app", &domain.RepoSource{Owner: "acme", Repo: "app"}, nil)
+			gitRepo.EXPECT().GetDefaultBranch("/src/app").Return("main").Maybe()
+			gitRepo.EXPECT().GetWorktreeForBranch("/src/app", tt.branch).Return("", nil).Maybe()
+			claudeDirResolver.EXPECT().Resolve("acme/app", mock.Anything).Return("/tmp/claude")
+			sessionRepo.EXPECT().Get(mock.Anything, "login").Return(nil, domain.ErrSessionNotFound)
+			tmuxClient.EXPECT().SessionExists("login").Return(false)
+
+			tmpl, err := domain.ParseWorktreePathTemplate("../{{.RepoDir}}-{{.Session}}")
+			require.NoError(t, err)
+			service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver,
+				portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+			service.SetWorktreePaths(map[string]*template.Template{"acme/app": tmpl})
+			service.SetGuardrails(tt.guardrails)
+
+			_, err = service.CreateSession(context.Background(), CreateSessionParams{
+				BranchNameOverride: tt.branch,
+				RepoSource:         "https://github.com/acme/app",
+				SessionName:        "login",
+			})
+
+			require.ErrorIs(t, err, domain.ErrGuardrail)
+		})
+	}
+}
+
+// fakeIssueFinder returns a fixed issue for every branch
+type fakeIssueFinder struct {
+	err   error
+	key   string
+	title string
+}
+
+func (f fakeIssueFinder) FindIssue(ctx context.Context, repoInfo, branch string) (string, string, error) {
+	return f.key, f.title, f.err
+}
+
+func TestCreateSession_AutoDisplayName(t *testing.T) {
+	tests := []struct {
+		name   string
+		finder IssueFinder
+		want   string
+	}{
+		{name: "from the branch", want: "ENG-7 Fix login"},
+		{name: "from the linked issue", finder: fakeIssueFinder{key: "ENG-7", title: "Users cannot log in"}, want: "ENG-7 Users cannot log in"},
+		{name: "lookup failure falls back to the branch", finder: fakeIssueFinder{err: errors.New("offline"), key: "ENG-7"}, want: "ENG-7 Fix login"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitRepo := portsmocks.NewMockGitRepository(t)
+			tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+			sessionRepo := portsmocks.NewMockSessionRepository(t)
+			claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+
+			gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
+				Return("/path/to/repo", &domain.RepoSource{Owner: "test", Repo: "repo"}, nil)
+			gitRepo.EXPECT().GetDefaultBranch("/path/to/repo").Return("main")
+			gitRepo.EXPECT().GetWorktreeForBranch("/path/to/repo", "feature/eng-7-fix-login").Return("/path/to/worktree", nil)
+			claudeDirResolver.EXPECT().Resolve("test/repo", mock.Anything).Return("/tmp/claude")
+			tmuxClient.EXPECT().CreateSession(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+				Return(&ports.TmuxSession{Name: "feature_eng-7-fix-login"}, nil)
+			sessionRepo.EXPECT().Get(mock.Anything, "feature_eng-7-fix-login").Return(nil, domain.ErrSessionNotFound)
+			tmuxClient.EXPECT().SessionExists("feature_eng-7-fix-login").Return(false)
+			sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+
+			service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+			tmpl, err := domain.ParseDisplayNameTemplate("")
+			require.NoError(t, err)
+			service.SetAutoNaming(tmpl, tt.finder)
+
+			result, err := service.CreateSession(context.Background(), CreateSessionParams{
+				AutoDisplayName:    true,
+				BranchNameOverride: "feature/eng-7-fix-login",
+				RepoSource:         "https://github.com/test/repo",
+				SessionName:        "feature_eng-7-fix-login",
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, result.Session.DisplayName)
+		})
+	}
+}
+
 func TestCreateSession_ContinuesOnWorktreeLookupError(t *testing.T) {
 	newWorktreePath := "/path/to/new/worktree"
 
@@ -95,10 +262,12 @@ func TestCreateSession_ContinuesOnWorktreeLookupError(t *testing.T) {
 	sessionRepo := portsmocks.NewMockSessionRepository(t)
 	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
 	processInspector := portsmocks.NewMockProcessInspector(t)
+	bootstrapper := servicesmocks.NewMockWorktreeBootstrapper(t)
 
 	// Setup expectations - GetWorktreeForBranch returns error
-	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything).
+	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
 		Return("/path/to/repo", &domain.RepoSource{Owner: "test", Repo: "repo"}, nil)
+	gitRepo.EXPECT().GetDefaultBranch("/path/to/repo").Return("main")
 	gitRepo.EXPECT().GetWorktreeForBranch("/path/to/repo", "feature-branch").
 		Return("", errors.New("lookup failed"))
 	gitRepo.EXPECT().BuildWorktreePath(mock.Anything, "test/repo", mock.Anything).
@@ -106,14 +275,19 @@ func TestCreateSession_ContinuesOnWorktreeLookupError(t *testing.T) {
 	gitRepo.EXPECT().CreateWorktree("/path/to/repo", newWorktreePath, "feature-branch").
 		Return(nil)
 
+	bootstrapper.EXPECT().Bootstrap(mock.Anything, "test/repo", "/path/to/repo", newWorktreePath, mock.Anything).
+		Return(nil)
+
 	claudeDirResolver.EXPECT().Resolve("test/repo", mock.Anything).Return("/tmp/claude")
 
 	tmuxClient.EXPECT().CreateSession(mock.Anything, newWorktreePath, mock.Anything, mock.Anything, mock.Anything).
 		Return(&ports.TmuxSession{Name: "test-session"}, nil)
 
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("test-session").Return(false)
 	sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), bootstrapper)
 
 	result, err := service.CreateSession(context.Background(), CreateSessionParams{
 		SessionName:        "test-session",
@@ -125,6 +299,395 @@ func TestCreateSession_ContinuesOnWorktreeLookupError(t *testing.T) {
 	assert.Equal(t, newWorktreePath, result.WorktreePath)
 }
 
+func TestCreateSession_BootstrapFailureRemovesWorktree(t *testing.T) {
+	newWorktreePath := "/path/to/new/worktree"
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+	processInspector := portsmocks.NewMockProcessInspector(t)
+	bootstrapper := servicesmocks.NewMockWorktreeBootstrapper(t)
+
+	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
+		Return("/path/to/repo", &domain.RepoSource{Owner: "test", Repo: "repo"}, nil)
+	gitRepo.EXPECT().GetDefaultBranch("/path/to/repo").Return("main")
+	gitRepo.EXPECT().GetWorktreeForBranch("/path/to/repo", "feature-branch").
+		Return("", nil)
+	gitRepo.EXPECT().BuildWorktreePath(mock.Anything, "test/repo", mock.Anything).
+		Return(newWorktreePath)
+	gitRepo.EXPECT().CreateWorktree("/path/to/repo", newWorktreePath, "feature-branch").
+		Return(nil)
+	gitRepo.EXPECT().RemoveWorktree("/path/to/repo", newWorktreePath).
+		Return(nil)
+
+	bootstrapper.EXPECT().Bootstrap(mock.Anything, "test/repo", "/path/to/repo", newWorktreePath, mock.Anything).
+		Return(errors.New("make deps failed"))
+
+	claudeDirResolver.EXPECT().Resolve("test/repo", mock.Anything).Return("/tmp/claude")
+
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("test-session").Return(false)
+
+	// No tmux session is created and nothing is saved: the agent never starts
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), bootstrapper)
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		SessionName:        "test-session",
+		BranchNameOverride: "feature-branch",
+		RepoSource:         "https://github.com/test/repo",
+	})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "make deps failed")
+}
+
+func TestCreateSession_AppliesGitIdentityToNewWorktree(t *testing.T) {
+	newWorktreePath := "/path/to/new/worktree"
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+	bootstrapper := servicesmocks.NewMockWorktreeBootstrapper(t)
+
+	gitRepo.EXPECT().GetOrCloneRepository(mock.Anything, mock.Anything, mock.Anything).
+		Return("/path/to/repo", &domain.RepoSource{Owner: "client", Repo: "app"}, nil)
+	gitRepo.EXPECT().GetDefaultBranch("/path/to/repo").Return("main")
+	gitRepo.EXPECT().GetWorktreeForBranch("/path/to/repo", "feature-branch").Return("", nil)
+	gitRepo.EXPECT().BuildWorktreePath(mock.Anything, "client/app", mock.Anything).Return(newWorktreePath)
+	gitRepo.EXPECT().CreateWorktree("/path/to/repo", newWorktreePath, "feature-branch").Return(nil)
+	// The session's email replaces the repository's, which keeps its name and signing key
+	gitRepo.EXPECT().SetWorktreeIdentity(mock.Anything, newWorktreePath, domain.GitIdentity{
+		Email: "jane@other-client.com", Name: "Jane", SigningKey: "ABC123",
+	}).Return(nil)
+	bootstrapper.EXPECT().Bootstrap(mock.Anything, "client/app", "/path/to/repo", newWorktreePath, mock.Anything).Return(nil)
+	claudeDirResolver.EXPECT().Resolve("client/app", mock.Anything).Return("/tmp/claude")
+	tmuxClient.EXPECT().CreateSession(mock.Anything, newWorktreePath, mock.Anything, mock.Anything, mock.Anything).
+		Return(&ports.TmuxSession{Name: "test-session"}, nil)
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("test-session").Return(false)
+	sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), bootstrapper)
+	service.SetGitIdentities(map[string]domain.GitIdentity{
+		"client/app": {Email: "jane@client.com", Name: "Jane", SigningKey: "ABC123"},
+	})
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		BranchNameOverride: "feature-branch",
+		GitIdentity:        domain.GitIdentity{Email: "jane@other-client.com"},
+		RepoSource:         "https://github.com/client/app",
+		SessionName:        "test-session",
+	})
+
+	require.NoError(t, err)
+}
+
+func TestCreateSession_GitIdentityNeedsWorktree(t *testing.T) {
+	service := NewSessionService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		DirectoryPath: t.TempDir(),
+		GitIdentity:   domain.GitIdentity{Email: "jane@client.com"},
+		SessionName:   "test-session",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = service.CreateSession(context.Background(), CreateSessionParams{
+		GitIdentity: domain.GitIdentity{Email: "not-an-email"},
+		RepoSource:  "https://github.com/client/app",
+		SessionName: "test-session",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestCreateSession_RejectsInvalidAgentConfig(t *testing.T) {
+	service := NewSessionService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		AgentModel:    "two words",
+		DirectoryPath: t.TempDir(),
+		SessionName:   "test-session",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+
+	_, err = service.CreateSession(context.Background(), CreateSessionParams{
+		AgentArgs:     []string{"--model", "opus"},
+		DirectoryPath: t.TempDir(),
+		SessionName:   "test-session",
+	})
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestCreateSession_ExternalDirectorySkipsWorktree(t *testing.T) {
+	dir := t.TempDir()
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+	processInspector := portsmocks.NewMockProcessInspector(t)
+
+	// Git metadata is read, but no clone or worktree calls are expected
+	gitRepo.EXPECT().IsGitRepo(dir).Return(true, dir)
+	gitRepo.EXPECT().GetBranchName(dir).Return("main")
+	gitRepo.EXPECT().GetRepoInfo(dir).Return("test/repo")
+	gitRepo.EXPECT().GetRemoteURL(dir).Return("https://github.com/test/repo")
+
+	claudeDirResolver.EXPECT().Resolve("test/repo", mock.Anything).Return("/tmp/claude")
+
+	tmuxClient.EXPECT().CreateSession("external-session", dir, mock.Anything, mock.Anything, mock.Anything).
+		Return(&ports.TmuxSession{Name: "external-session"}, nil)
+
+	sessionRepo.EXPECT().Get(mock.Anything, "external-session").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("external-session").Return(false)
+	sessionRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(s domain.Session) bool {
+		return s.IsExternal && s.RepoPath == dir && s.WorktreePath == "" && s.BranchName == "main" &&
+			s.AgentModel == "sonnet" && len(s.AgentArgs) == 2
+	})).Return(nil)
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	result, err := service.CreateSession(context.Background(), CreateSessionParams{
+		AgentArgs:     []string{"--add-dir", "../shared"},
+		AgentModel:    "sonnet",
+		DirectoryPath: dir,
+		RepoSource:    "https://github.com/ignored/repo",
+		SessionName:   "external-session",
+	})
+
+	require.NoError(t, err)
+	assert.Empty(t, result.WorktreePath)
+	assert.Equal(t, dir, result.Session.WorkingDir())
+}
+
+func TestCreateSession_StartsInSubdir(t *testing.T) {
+	dir := t.TempDir()
+	apiDir := filepath.Join(dir, "packages", "api")
+	require.NoError(t, os.MkdirAll(apiDir, 0755))
+
+	newService := func(t *testing.T) (*SessionService, *portsmocks.MockSessionRepository, *portsmocks.MockTmuxSessionLifecycle) {
+		gitRepo := portsmocks.NewMockGitRepository(t)
+		gitRepo.EXPECT().IsGitRepo(dir).Return(false, "")
+		claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+		claudeDirResolver.EXPECT().Resolve("", mock.Anything).Return("/tmp/claude")
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "mono").Return(nil, domain.ErrSessionNotFound)
+		tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+		tmuxClient.EXPECT().SessionExists("mono").Return(false)
+		service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver,
+			portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+		return service, sessionRepo, tmuxClient
+	}
+
+	t.Run("starts the agent in the subdirectory", func(t *testing.T) {
+		service, sessionRepo, tmuxClient := newService(t)
+		sessionRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(s domain.Session) bool {
+			return s.RepoPath == dir && s.Subdir == "packages/api"
+		})).Return(nil)
+		tmuxClient.EXPECT().CreateSession("mono", apiDir, mock.Anything, mock.Anything, mock.Anything).
+			Return(&ports.TmuxSession{Name: "mono"}, nil)
+
+		result, err := service.CreateSession(context.Background(), CreateSessionParams{
+			DirectoryPath: dir,
+			SessionName:   "mono",
+			Subdir:        "./packages/api/",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, apiDir, result.Session.StartDir())
+	})
+
+	t.Run("fails before saving when the subdirectory is missing", func(t *testing.T) {
+		service, _, _ := newService(t)
+
+		_, err := service.CreateSession(context.Background(), CreateSessionParams{
+			DirectoryPath: dir,
+			SessionName:   "mono",
+			Subdir:        "packages/web",
+		})
+
+		assert.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestCreateSession_ExternalDirectoryMustExist(t *testing.T) {
+	service := NewSessionService(
+		portsmocks.NewMockSessionRepository(t),
+		portsmocks.NewMockGitRepository(t),
+		portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t),
+		portsmocks.NewMockProcessInspector(t),
+		newMockEventPublisher(t),
+		servicesmocks.NewMockWorktreeBootstrapper(t),
+	)
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		DirectoryPath: "/does/not/exist",
+		SessionName:   "external-session",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestCreateSession_RejectsExistingName(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(&domain.Session{Name: "test-session"}, nil)
+
+	// Nothing is cloned or created for a name that is already taken
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		SessionName: "test-session",
+		RepoSource:  "https://github.com/test/repo",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrSessionExists)
+}
+
+func TestCreateSession_RejectsNameOfRunningTmuxSession(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "app").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	tmuxClient.EXPECT().SessionExists("app").Return(true)
+
+	// A tmux session rocha does not track is left alone: nothing is read, stored, or created
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), tmuxClient,
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		DirectoryPath: t.TempDir(),
+		SessionName:   "app",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrSessionExists)
+}
+
+func TestCreateSession_ClonesWithRepositoryCredentials(t *testing.T) {
+	credentials := domain.GitCredentials{SSHCommand: "ssh -i ~/.ssh/client"}
+	accessErr := &domain.RemoteAccessError{Kind: domain.RemoteAccessSSHKey, URL: "git@github.com:test/repo.git"}
+
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	tmuxClient.EXPECT().SessionExists("test-session").Return(false)
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	gitRepo.EXPECT().ParseRepoSource("git@github.com:test/repo.git").
+		Return(&domain.RepoSource{IsRemote: true, Owner: "test", Repo: "repo"}, nil)
+	gitRepo.EXPECT().GetOrCloneRepository("git@github.com:test/repo.git", mock.Anything, credentials).
+		Return("", nil, accessErr)
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient,
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+	service.SetGitCredentials(map[string]domain.GitCredentials{"test/repo": credentials})
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		SessionName: "test-session",
+		RepoSource:  "git@github.com:test/repo.git",
+	})
+
+	assert.ErrorIs(t, err, domain.ErrRemoteUnreachable)
+	assert.ErrorAs(t, err, &accessErr)
+}
+
+func TestAvailableName(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "api").Return(&domain.Session{Name: "api"}, nil)
+	sessionRepo.EXPECT().Get(mock.Anything, "api-2").Return(&domain.Session{Name: "api-2", IsArchived: true}, nil)
+	sessionRepo.EXPECT().Get(mock.Anything, "api-3").Return(nil, domain.ErrSessionNotFound)
+	sessionRepo.EXPECT().Get(mock.Anything, "web").Return(nil, domain.ErrSessionNotFound)
+	sessionRepo.EXPECT().Get(mock.Anything, "db").Return(nil, errors.New("database is locked"))
+
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	name, err := service.AvailableName(context.Background(), "api")
+	require.NoError(t, err)
+	assert.Equal(t, "api-3", name, "archived sessions keep their names")
+
+	name, err = service.AvailableName(context.Background(), "web")
+	require.NoError(t, err)
+	assert.Equal(t, "web", name)
+
+	_, err = service.AvailableName(context.Background(), "db")
+	assert.ErrorContains(t, err, "database is locked")
+}
+
+func TestCreateSession_SavesBeforeStartingAgent(t *testing.T) {
+	dir := t.TempDir()
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+
+	gitRepo.EXPECT().IsGitRepo(dir).Return(false, "")
+	claudeDirResolver.EXPECT().Resolve("", mock.Anything).Return("/tmp/claude")
+
+	var saved bool
+	sessionRepo.EXPECT().Get(mock.Anything, "scripted").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("scripted").Return(false)
+	sessionRepo.EXPECT().Add(mock.Anything, mock.MatchedBy(func(s domain.Session) bool {
+		return s.DisplayName == "Scripted Session" && s.AllowDangerouslySkipPermissions
+	})).RunAndReturn(func(context.Context, domain.Session) error {
+		saved = true
+		return nil
+	})
+	// start-claude reads the stored flags, so the row must exist when the agent starts
+	tmuxClient.EXPECT().CreateSession("scripted", dir, "/tmp/claude", mock.Anything, "do the thing").
+		RunAndReturn(func(name, _, _, _, _ string) (*ports.TmuxSession, error) {
+			assert.True(t, saved, "session should be saved before the agent starts")
+			return &ports.TmuxSession{Name: name}, nil
+		})
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	result, err := service.CreateSession(context.Background(), CreateSessionParams{
+		AllowDangerouslySkipPermissions: true,
+		DirectoryPath:                   dir,
+		DisplayName:                     "Scripted Session",
+		InitialPrompt:                   "do the thing",
+		SessionName:                     "scripted",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "Scripted Session", result.Session.DisplayName)
+}
+
+func TestCreateSession_RemovesSessionWhenAgentFailsToStart(t *testing.T) {
+	dir := t.TempDir()
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+
+	gitRepo.EXPECT().IsGitRepo(dir).Return(false, "")
+	claudeDirResolver.EXPECT().Resolve("", mock.Anything).Return("/tmp/claude")
+
+	sessionRepo.EXPECT().Get(mock.Anything, "scripted").Return(nil, domain.ErrSessionNotFound)
+	tmuxClient.EXPECT().SessionExists("scripted").Return(false)
+	sessionRepo.EXPECT().Add(mock.Anything, mock.Anything).Return(nil)
+	tmuxClient.EXPECT().CreateSession("scripted", dir, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, ports.ErrTmuxSessionExists)
+	sessionRepo.EXPECT().Delete(mock.Anything, "scripted").Return(nil)
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	_, err := service.CreateSession(context.Background(), CreateSessionParams{
+		DirectoryPath: dir,
+		SessionName:   "scripted",
+	})
+
+	assert.ErrorIs(t, err, ports.ErrTmuxSessionExists)
+}
+
 func TestDeleteSession_HappyPath(t *testing.T) {
 	gitRepo := portsmocks.NewMockGitRepository(t)
 	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
@@ -143,7 +706,7 @@ func TestDeleteSession_HappyPath(t *testing.T) {
 	sessionRepo.EXPECT().Delete(mock.Anything, "test-session").Return(nil)
 	gitRepo.EXPECT().RemoveWorktree("/path/to/repo", "/path/to/worktree").Return(nil)
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 	err := service.DeleteSession(context.Background(), "test-session", DeleteSessionOptions{
 		KillTmux:       true,
@@ -160,7 +723,7 @@ func TestDeleteSession_WithShellSession(t *testing.T) {
 	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
 	processInspector := portsmocks.NewMockProcessInspector(t)
 
-	shellSession := &domain.Session{Name: "test-session-shell"}
+	shellSession := &domain.ShellSession{Name: "test-session-shell", ParentName: "test-session"}
 	session := &domain.Session{
 		Name:         "test-session",
 		ShellSession: shellSession,
@@ -174,7 +737,7 @@ func TestDeleteSession_WithShellSession(t *testing.T) {
 	sessionRepo.EXPECT().Delete(mock.Anything, "test-session").Return(nil)
 	gitRepo.EXPECT().RemoveWorktree("/path/to/repo", "/path/to/worktree").Return(nil)
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 	err := service.DeleteSession(context.Background(), "test-session", DeleteSessionOptions{
 		KillTmux:       true,
@@ -201,7 +764,7 @@ func TestDeleteSession_NoWorktree(t *testing.T) {
 	sessionRepo.EXPECT().Delete(mock.Anything, "test-session").Return(nil)
 	// RemoveWorktree should NOT be called since paths are empty
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 	err := service.DeleteSession(context.Background(), "test-session", DeleteSessionOptions{
 		KillTmux:       false,
@@ -220,7 +783,7 @@ func TestDeleteSession_GetSessionError(t *testing.T) {
 
 	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(nil, errors.New("not found"))
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 	err := service.DeleteSession(context.Background(), "test-session", DeleteSessionOptions{})
 
@@ -247,7 +810,7 @@ func TestDeleteSession_TmuxKillFailureContinues(t *testing.T) {
 	sessionRepo.EXPECT().Delete(mock.Anything, "test-session").Return(nil)
 	gitRepo.EXPECT().RemoveWorktree("/path/to/repo", "/path/to/worktree").Return(nil)
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 	err := service.DeleteSession(context.Background(), "test-session", DeleteSessionOptions{
 		KillTmux:       true,
@@ -273,7 +836,7 @@ func TestDeleteSession_DatabaseDeleteError(t *testing.T) {
 	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(session, nil)
 	sessionRepo.EXPECT().Delete(mock.Anything, "test-session").Return(errors.New("db error"))
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 	err := service.DeleteSession(context.Background(), "test-session", DeleteSessionOptions{
 		KillTmux:       false,
@@ -284,85 +847,279 @@ func TestDeleteSession_DatabaseDeleteError(t *testing.T) {
 	assert.Contains(t, err.Error(), "failed to delete session")
 }
 
-func TestRenameSession_HappyPath(t *testing.T) {
+// newRenameTestService returns a service renaming session, where newName is free
+func newRenameTestService(t *testing.T, session *domain.Session, newName string) (*SessionService, *portsmocks.MockGitRepository, *portsmocks.MockTmuxSessionLifecycle, *portsmocks.MockSessionRepository) {
 	gitRepo := portsmocks.NewMockGitRepository(t)
 	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
 	sessionRepo := portsmocks.NewMockSessionRepository(t)
-	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
-	processInspector := portsmocks.NewMockProcessInspector(t)
 
+	sessionRepo.EXPECT().Get(mock.Anything, session.Name).Return(session, nil)
+	sessionRepo.EXPECT().Get(mock.Anything, newName).Return(nil, domain.ErrSessionNotFound).Maybe()
+	tmuxClient.EXPECT().SessionExists(newName).Return(false).Maybe()
+	tmuxClient.EXPECT().SessionExists(newName + "-shell").Return(false).Maybe()
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, servicesmocks.NewMockClaudeDirResolver(t),
+		portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+	return service, gitRepo, tmuxClient, sessionRepo
+}
+
+func TestRenameSession_HappyPath(t *testing.T) {
+	session := &domain.Session{DisplayName: "Old Session", Name: "old-session"}
+	service, _, tmuxClient, sessionRepo := newRenameTestService(t, session, "new-session")
+
+	tmuxClient.EXPECT().SessionExists("old-session").Return(true)
+	tmuxClient.EXPECT().SessionExists("old-session-shell").Return(true)
 	tmuxClient.EXPECT().RenameSession("old-session", "new-session").Return(nil)
-	sessionRepo.EXPECT().Rename(mock.Anything, "old-session", "new-session", "New Session").Return(nil)
+	tmuxClient.EXPECT().RenameSession("old-session-shell", "new-session-shell").Return(nil)
+	sessionRepo.EXPECT().Rename(mock.Anything, domain.SessionRename{
+		DisplayName: "New Session",
+		NewName:     "new-session",
+		OldName:     "old-session",
+	}).Return(nil)
+
+	err := service.RenameSession(context.Background(), "old-session", "new-session", RenameOptions{DisplayName: "New Session"})
+
+	require.NoError(t, err)
+}
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+func TestRenameSession_SkipsStoppedTmuxSessions(t *testing.T) {
+	session := &domain.Session{DisplayName: "Old Session", Name: "old-session"}
+	service, _, tmuxClient, sessionRepo := newRenameTestService(t, session, "new-session")
 
-	err := service.RenameSession(context.Background(), "old-session", "new-session", "New Session")
+	tmuxClient.EXPECT().SessionExists("old-session").Return(false)
+	tmuxClient.EXPECT().SessionExists("old-session-shell").Return(false)
+	sessionRepo.EXPECT().Rename(mock.Anything, domain.SessionRename{
+		DisplayName: "Old Session",
+		NewName:     "new-session",
+		OldName:     "old-session",
+	}).Return(nil)
+
+	err := service.RenameSession(context.Background(), "old-session", "new-session", RenameOptions{})
 
 	require.NoError(t, err)
 }
 
-func TestRenameSession_TmuxRenameError(t *testing.T) {
-	gitRepo := portsmocks.NewMockGitRepository(t)
-	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+func TestRenameSession_NameTakenByArchivedSession(t *testing.T) {
+	session := &domain.Session{Name: "old-session"}
 	sessionRepo := portsmocks.NewMockSessionRepository(t)
-	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
-	processInspector := portsmocks.NewMockProcessInspector(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "old-session").Return(session, nil)
+	sessionRepo.EXPECT().Get(mock.Anything, "new-session").Return(&domain.Session{IsArchived: true, Name: "new-session"}, nil)
 
-	tmuxClient.EXPECT().RenameSession("old-session", "new-session").Return(errors.New("tmux error"))
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	err := service.RenameSession(context.Background(), "old-session", "new-session", RenameOptions{})
 
-	err := service.RenameSession(context.Background(), "old-session", "new-session", "New Session")
+	assert.ErrorIs(t, err, domain.ErrSessionExists)
+}
+
+func TestRenameSession_TmuxRenameError(t *testing.T) {
+	session := &domain.Session{Name: "old-session"}
+	service, _, tmuxClient, _ := newRenameTestService(t, session, "new-session")
+
+	tmuxClient.EXPECT().SessionExists("old-session").Return(true)
+	tmuxClient.EXPECT().RenameSession("old-session", "new-session").Return(errors.New("tmux error"))
+
+	err := service.RenameSession(context.Background(), "old-session", "new-session", RenameOptions{DisplayName: "New Session"})
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to rename tmux session")
 }
 
 func TestRenameSession_DatabaseRenameErrorWithRollback(t *testing.T) {
-	gitRepo := portsmocks.NewMockGitRepository(t)
-	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
-	sessionRepo := portsmocks.NewMockSessionRepository(t)
-	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
-	processInspector := portsmocks.NewMockProcessInspector(t)
+	session := &domain.Session{
+		BranchName:   "old-branch",
+		Name:         "old-session",
+		RepoPath:     "/repo",
+		WorktreePath: "/worktrees/old-session",
+	}
+	service, gitRepo, tmuxClient, sessionRepo := newRenameTestService(t, session, "new-session")
 
-	// Tmux rename succeeds
-	tmuxClient.EXPECT().RenameSession("old-session", "new-session").Return(nil)
+	gitRepo.EXPECT().ValidateBranchName("new-branch").Return(nil)
+	tmuxClient.EXPECT().SessionExists("old-session").Return(false)
+	tmuxClient.EXPECT().SessionExists("old-session-shell").Return(false)
+	gitRepo.EXPECT().RenameBranch(mock.Anything, "/worktrees/old-session", "old-branch", "new-branch").Return(nil)
+	gitRepo.EXPECT().MoveWorktree(mock.Anything, "/repo", "/worktrees/old-session", "/worktrees/new-session").Return(nil)
 	// Database rename fails
-	sessionRepo.EXPECT().Rename(mock.Anything, "old-session", "new-session", "New Session").Return(errors.New("db error"))
-	// Rollback tmux rename
-	tmuxClient.EXPECT().RenameSession("new-session", "old-session").Return(nil)
-
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+	sessionRepo.EXPECT().Rename(mock.Anything, mock.Anything).Return(errors.New("db error"))
+	// Rollback in reverse order
+	moveBack := gitRepo.EXPECT().MoveWorktree(mock.Anything, "/repo", "/worktrees/new-session", "/worktrees/old-session").Return(nil)
+	gitRepo.EXPECT().RenameBranch(mock.Anything, "/worktrees/old-session", "new-branch", "old-branch").Return(nil).NotBefore(moveBack.Call)
 
-	err := service.RenameSession(context.Background(), "old-session", "new-session", "New Session")
+	err := service.RenameSession(context.Background(), "old-session", "new-session", RenameOptions{BranchName: "new-branch", MoveWorktree: true})
 
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to rename in database")
 }
 
 func TestRenameSession_DatabaseRenameErrorRollbackFails(t *testing.T) {
-	gitRepo := portsmocks.NewMockGitRepository(t)
-	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
-	sessionRepo := portsmocks.NewMockSessionRepository(t)
-	claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
-	processInspector := portsmocks.NewMockProcessInspector(t)
+	session := &domain.Session{Name: "old-session"}
+	service, _, tmuxClient, sessionRepo := newRenameTestService(t, session, "new-session")
 
 	// Tmux rename succeeds
+	tmuxClient.EXPECT().SessionExists("old-session").Return(true)
+	tmuxClient.EXPECT().SessionExists("old-session-shell").Return(false)
 	tmuxClient.EXPECT().RenameSession("old-session", "new-session").Return(nil)
 	// Database rename fails
-	sessionRepo.EXPECT().Rename(mock.Anything, "old-session", "new-session", "New Session").Return(errors.New("db error"))
+	sessionRepo.EXPECT().Rename(mock.Anything, mock.Anything).Return(errors.New("db error"))
 	// Rollback fails too (but error is logged, not returned)
 	tmuxClient.EXPECT().RenameSession("new-session", "old-session").Return(errors.New("rollback failed"))
 
-	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
-
-	err := service.RenameSession(context.Background(), "old-session", "new-session", "New Session")
+	err := service.RenameSession(context.Background(), "old-session", "new-session", RenameOptions{DisplayName: "New Session"})
 
 	// Should still return the database error, not the rollback error
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "failed to rename in database")
 }
 
+func TestRenameSession_MoveWorktreeNeedsStoppedSession(t *testing.T) {
+	session := &domain.Session{Name: "old-session", RepoPath: "/repo", WorktreePath: "/worktrees/old-session"}
+	service, _, tmuxClient, _ := newRenameTestService(t, session, "new-session")
+
+	tmuxClient.EXPECT().SessionExists("old-session").Return(true)
+
+	err := service.RenameSession(context.Background(), "old-session", "new-session", RenameOptions{MoveWorktree: true})
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestRestartSession(t *testing.T) {
+	exited := &domain.Session{
+		ClaudeDir:       "/tmp/claude",
+		ClaudeSessionID: "conv-123",
+		Name:            "test-session",
+		State:           domain.StateExited,
+		WorktreePath:    "/path/to/worktree",
+	}
+	withoutConversation := *exited
+	withoutConversation.ClaudeSessionID = ""
+	working := *exited
+	working.State = domain.StateWorking
+
+	tests := []struct {
+		name       string
+		mode       domain.RestartMode
+		session    *domain.Session
+		tmuxExists bool
+		setup      func(tmuxClient *portsmocks.MockTmuxSessionLifecycle)
+		wantErr    error
+	}{
+		{
+			name:    "resume recreates with recorded conversation",
+			mode:    domain.RestartResume,
+			session: exited,
+			setup: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle) {
+				tmuxClient.EXPECT().ResumeSession("test-session", "/path/to/worktree", "/tmp/claude", "bottom", "conv-123").
+					Return(&ports.TmuxSession{Name: "test-session"}, nil)
+			},
+		},
+		{
+			name:    "resume without recorded conversation",
+			mode:    domain.RestartResume,
+			session: &withoutConversation,
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:       "fresh replaces exited tmux session",
+			mode:       domain.RestartFresh,
+			session:    exited,
+			tmuxExists: true,
+			setup: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle) {
+				tmuxClient.EXPECT().KillSession("test-session").Return(nil)
+				tmuxClient.EXPECT().CreateSession("test-session", "/path/to/worktree", "/tmp/claude", "bottom", "").
+					Return(&ports.TmuxSession{Name: "test-session"}, nil)
+			},
+		},
+		{
+			name:    "shell only starts no claude",
+			mode:    domain.RestartShell,
+			session: exited,
+			setup: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle) {
+				tmuxClient.EXPECT().CreateShellSession("test-session", "/path/to/worktree", "bottom").
+					Return(&ports.TmuxSession{Name: "test-session"}, nil)
+			},
+		},
+		{
+			name:       "shell only keeps existing shell",
+			mode:       domain.RestartShell,
+			session:    exited,
+			tmuxExists: true,
+		},
+		{
+			name:       "running session is not restarted",
+			mode:       domain.RestartFresh,
+			session:    &working,
+			tmuxExists: true,
+			wantErr:    ports.ErrTmuxSessionExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitRepo := portsmocks.NewMockGitRepository(t)
+			tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+			sessionRepo := portsmocks.NewMockSessionRepository(t)
+			claudeDirResolver := servicesmocks.NewMockClaudeDirResolver(t)
+			processInspector := portsmocks.NewMockProcessInspector(t)
+
+			sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(tt.session, nil)
+			tmuxClient.EXPECT().SessionExists("test-session").Return(tt.tmuxExists).Maybe()
+			if tt.setup != nil {
+				tt.setup(tmuxClient)
+			}
+
+			service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+			err := service.RestartSession(context.Background(), "test-session", tt.mode, "bottom")
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestResumeSessions(t *testing.T) {
+	worktree := t.TempDir()
+	sessions := []domain.Session{
+		{Name: "running", WorktreePath: worktree},
+		{Name: "with-conversation", ClaudeSessionID: "conv-123", State: domain.StateWorking, WorktreePath: worktree},
+		{Name: "without-conversation", State: domain.StateIdle, WorktreePath: worktree},
+		{Name: "worktree-gone", WorktreePath: filepath.Join(worktree, "missing")},
+	}
+
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+
+	tmuxClient.EXPECT().SessionExists("running").Return(true)
+	tmuxClient.EXPECT().SessionExists("with-conversation").Return(false)
+	tmuxClient.EXPECT().SessionExists("without-conversation").Return(false)
+	tmuxClient.EXPECT().SessionExists("worktree-gone").Return(false)
+
+	sessionRepo.EXPECT().Get(mock.Anything, "with-conversation").Return(&sessions[1], nil)
+	tmuxClient.EXPECT().ResumeSession("with-conversation", worktree, "", "bottom", "conv-123").
+		Return(&ports.TmuxSession{Name: "with-conversation"}, nil)
+
+	sessionRepo.EXPECT().Get(mock.Anything, "without-conversation").Return(&sessions[2], nil)
+	tmuxClient.EXPECT().CreateSession("without-conversation", worktree, "", "bottom", "").
+		Return(nil, errors.New("tmux failed"))
+
+	service := NewSessionService(sessionRepo, gitRepo, tmuxClient, servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	results := service.ResumeSessions(context.Background(), sessions, "bottom")
+
+	require.Len(t, results, 4)
+	assert.Equal(t, ResumeResult{Name: "running", Outcome: ResumeOutcomeRunning}, results[0])
+	assert.Equal(t, ResumeResult{Name: "with-conversation", Outcome: ResumeOutcomeResumed}, results[1])
+	assert.Equal(t, ResumeOutcomeFailed, results[2].Outcome)
+	assert.ErrorContains(t, results[2].Err, "tmux failed")
+	assert.Equal(t, ResumeOutcomeFailed, results[3].Outcome)
+	assert.ErrorIs(t, results[3].Err, domain.ErrInvalidInput)
+}
+
 func TestUpdatePRInfo(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -413,7 +1170,7 @@ func TestUpdatePRInfo(t *testing.T) {
 
 			sessionRepo.EXPECT().UpdatePRInfo(mock.Anything, tt.sessionName, tt.prInfo).Return(tt.repoErr)
 
-			service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector)
+			service := NewSessionService(sessionRepo, gitRepo, tmuxClient, claudeDirResolver, processInspector, newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
 
 			err := service.UpdatePRInfo(context.Background(), tt.sessionName, tt.prInfo)
 
@@ -426,3 +1183,336 @@ func TestUpdatePRInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestShutdownAgent(t *testing.T) {
+	shutdownPollInterval = time.Millisecond
+	t.Cleanup(func() { shutdownPollInterval = 250 * time.Millisecond })
+
+	tests := []struct {
+		name       string
+		setupMocks func(tmuxClient *portsmocks.MockTmuxSessionLifecycle, sessionRepo *portsmocks.MockSessionRepository)
+		wantExited bool
+	}{
+		{
+			name: "agent exits before timeout",
+			setupMocks: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle, sessionRepo *portsmocks.MockSessionRepository) {
+				sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+					Return(&domain.Session{Name: "test-session", State: domain.StateWorking}, nil).Twice()
+				sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+					Return(&domain.Session{Name: "test-session", State: domain.StateExited}, nil)
+				tmuxClient.EXPECT().SessionExists("test-session").Return(true)
+				tmuxClient.EXPECT().RequestAgentExit("test-session").Return(nil)
+			},
+			wantExited: true,
+		},
+		{
+			name: "agent does not exit in time",
+			setupMocks: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle, sessionRepo *portsmocks.MockSessionRepository) {
+				sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+					Return(&domain.Session{Name: "test-session", State: domain.StateWorking}, nil)
+				tmuxClient.EXPECT().SessionExists("test-session").Return(true)
+				tmuxClient.EXPECT().RequestAgentExit("test-session").Return(nil)
+			},
+			wantExited: false,
+		},
+		{
+			name: "already exited skips shutdown",
+			setupMocks: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle, sessionRepo *portsmocks.MockSessionRepository) {
+				sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+					Return(&domain.Session{Name: "test-session", State: domain.StateExited}, nil)
+			},
+			wantExited: true,
+		},
+		{
+			name: "missing tmux session skips shutdown",
+			setupMocks: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle, sessionRepo *portsmocks.MockSessionRepository) {
+				sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+					Return(&domain.Session{Name: "test-session", State: domain.StateIdle}, nil)
+				tmuxClient.EXPECT().SessionExists("test-session").Return(false)
+			},
+			wantExited: true,
+		},
+		{
+			name: "send failure gives up",
+			setupMocks: func(tmuxClient *portsmocks.MockTmuxSessionLifecycle, sessionRepo *portsmocks.MockSessionRepository) {
+				sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+					Return(&domain.Session{Name: "test-session", State: domain.StateIdle}, nil)
+				tmuxClient.EXPECT().SessionExists("test-session").Return(true)
+				tmuxClient.EXPECT().RequestAgentExit("test-session").Return(errors.New("tmux error"))
+			},
+			wantExited: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+			sessionRepo := portsmocks.NewMockSessionRepository(t)
+			tt.setupMocks(tmuxClient, sessionRepo)
+
+			service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), tmuxClient,
+				servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+			exited := service.ShutdownAgent(context.Background(), "test-session", 20*time.Millisecond)
+
+			assert.Equal(t, tt.wantExited, exited)
+		})
+	}
+}
+
+func TestDeleteSession_ShutsDownAgentBeforeKill(t *testing.T) {
+	shutdownPollInterval = time.Millisecond
+	t.Cleanup(func() { shutdownPollInterval = 250 * time.Millisecond })
+
+	tmuxClient := portsmocks.NewMockTmuxSessionLifecycle(t)
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+		Return(&domain.Session{Name: "test-session", State: domain.StateWorking}, nil).Twice()
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").
+		Return(&domain.Session{Name: "test-session", State: domain.StateExited}, nil)
+	tmuxClient.EXPECT().SessionExists("test-session").Return(true)
+	exitRequested := tmuxClient.EXPECT().RequestAgentExit("test-session").Return(nil).Call
+	tmuxClient.EXPECT().KillSession("test-session").Return(nil).NotBefore(exitRequested)
+	sessionRepo.EXPECT().Delete(mock.Anything, "test-session").Return(nil)
+
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), tmuxClient,
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	err := service.DeleteSession(context.Background(), "test-session", DeleteSessionOptions{
+		KillTmux:        true,
+		ShutdownTimeout: time.Second,
+	})
+
+	require.NoError(t, err)
+}
+
+func TestUpdateStatus_PublishesStatusChange(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	status := "review"
+
+	sessionRepo.EXPECT().UpdateStatus(mock.Anything, "test-session", &status).Return(nil)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Type == domain.EventStatusChange && event.SessionName == "test-session" && event.Status == "review"
+	})).Return(errors.New("webhook down"))
+
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), eventPublisher, servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	// Publish failures are logged, not returned
+	err := service.UpdateStatus(context.Background(), "test-session", &status)
+
+	require.NoError(t, err)
+}
+
+func TestUpdateTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		current  []string
+		update   func(s *SessionService) ([]string, error)
+		wantTags []string
+		wantErr  error
+	}{
+		{
+			name:    "add keeps existing tags",
+			current: []string{"backend"},
+			update: func(s *SessionService) ([]string, error) {
+				return s.AddTags(context.Background(), "test-session", []string{"Urgent", "backend"})
+			},
+			wantTags: []string{"backend", "urgent"},
+		},
+		{
+			name:    "remove ignores missing tags",
+			current: []string{"backend", "urgent"},
+			update: func(s *SessionService) ([]string, error) {
+				return s.RemoveTags(context.Background(), "test-session", []string{"URGENT", "client-x"})
+			},
+			wantTags: []string{"backend"},
+		},
+		{
+			name:    "set replaces tags",
+			current: []string{"backend"},
+			update: func(s *SessionService) ([]string, error) {
+				return s.SetTags(context.Background(), "test-session", []string{"frontend"})
+			},
+			wantTags: []string{"frontend"},
+		},
+		{
+			name:    "invalid tag is rejected",
+			current: nil,
+			update: func(s *SessionService) ([]string, error) {
+				return s.AddTags(context.Background(), "test-session", []string{"two words"})
+			},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionRepo := portsmocks.NewMockSessionRepository(t)
+			sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(&domain.Session{Name: "test-session", Tags: tt.current}, nil)
+			if tt.wantErr == nil {
+				sessionRepo.EXPECT().UpdateTags(mock.Anything, "test-session", tt.wantTags).Return(nil)
+			}
+
+			service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+				servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+			tags, err := tt.update(service)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantTags, tags)
+		})
+	}
+}
+
+func TestCyclePriority(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1", Priority: domain.PriorityP3}, nil)
+	sessionRepo.EXPECT().UpdatePriority(mock.Anything, "s1", domain.PriorityNone).Return(nil)
+
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t), servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	next, err := service.CyclePriority(context.Background(), "s1")
+
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityNone, next)
+}
+
+func TestSwitchBranch(t *testing.T) {
+	session := &domain.Session{BranchName: "feature", Name: "api", WorktreePath: "/wt/api"}
+	branches := []domain.Branch{
+		{Name: "feature", Worktree: "/wt/api"},
+		{Name: "main", Worktree: "/src/api"},
+		{Name: "fix", Remote: "origin"},
+	}
+
+	newService := func(t *testing.T, gitRepo *portsmocks.MockGitRepository, sessionRepo *portsmocks.MockSessionRepository) *SessionService {
+		return NewSessionService(sessionRepo, gitRepo, portsmocks.NewMockTmuxSessionLifecycle(t), servicesmocks.NewMockClaudeDirResolver(t),
+			portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+	}
+
+	t.Run("switches and records the branch", func(t *testing.T) {
+		gitRepo := portsmocks.NewMockGitRepository(t)
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "api").Return(session, nil)
+		gitRepo.EXPECT().GetBranchName("/wt/api").Return("feature")
+		gitRepo.EXPECT().ListBranches(mock.Anything, "/wt/api").Return(branches, nil)
+		gitRepo.EXPECT().GetWorktreeStatus(mock.Anything, "/wt/api").Return(&domain.WorktreeStatus{UnpushedCommits: []string{"abc WIP"}}, nil)
+		gitRepo.EXPECT().SwitchBranch(mock.Anything, "/wt/api", "fix").Return(nil)
+		sessionRepo.EXPECT().UpdateBranchName(mock.Anything, "api", "fix").Return(nil)
+
+		previous, err := newService(t, gitRepo, sessionRepo).SwitchBranch(context.Background(), "api", "fix")
+
+		require.NoError(t, err)
+		assert.Equal(t, "feature", previous)
+	})
+
+	t.Run("refuses uncommitted changes", func(t *testing.T) {
+		gitRepo := portsmocks.NewMockGitRepository(t)
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "api").Return(session, nil)
+		gitRepo.EXPECT().GetBranchName("/wt/api").Return("feature")
+		gitRepo.EXPECT().ListBranches(mock.Anything, "/wt/api").Return(branches, nil)
+		gitRepo.EXPECT().GetWorktreeStatus(mock.Anything, "/wt/api").Return(&domain.WorktreeStatus{UncommittedFiles: []string{" M main.go"}}, nil)
+
+		_, err := newService(t, gitRepo, sessionRepo).SwitchBranch(context.Background(), "api", "fix")
+
+		require.ErrorIs(t, err, domain.ErrWorktreeDirty)
+	})
+
+	t.Run("refuses a branch checked out elsewhere", func(t *testing.T) {
+		gitRepo := portsmocks.NewMockGitRepository(t)
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "api").Return(session, nil)
+		gitRepo.EXPECT().GetBranchName("/wt/api").Return("feature")
+		gitRepo.EXPECT().ListBranches(mock.Anything, "/wt/api").Return(branches, nil)
+
+		_, err := newService(t, gitRepo, sessionRepo).SwitchBranch(context.Background(), "api", "main")
+
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+		assert.Contains(t, err.Error(), "/src/api")
+	})
+
+	t.Run("refuses a protected branch", func(t *testing.T) {
+		gitRepo := portsmocks.NewMockGitRepository(t)
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "api").Return(session, nil)
+		gitRepo.EXPECT().GetBranchName("/wt/api").Return("feature")
+
+		service := newService(t, gitRepo, sessionRepo)
+		service.SetGuardrails(map[string]domain.Guardrails{domain.GuardrailsAllRepos: {ProtectedBranches: []string{"fix"}}})
+		_, err := service.SwitchBranch(context.Background(), "api", "fix")
+
+		require.ErrorIs(t, err, domain.ErrGuardrail)
+	})
+
+	t.Run("refuses sessions without a worktree", func(t *testing.T) {
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		sessionRepo.EXPECT().Get(mock.Anything, "ext").Return(&domain.Session{IsExternal: true, Name: "ext", RepoPath: "/src/app"}, nil)
+
+		_, err := newService(t, portsmocks.NewMockGitRepository(t), sessionRepo).SwitchBranch(context.Background(), "ext", "fix")
+
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
+
+func TestRecordHandoff_PublishesTrimmedNote(t *testing.T) {
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Type == domain.EventHandoff && event.SessionName == "test-session" && event.Message == "tests left to fix"
+	})).Return(nil)
+
+	service := NewSessionService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), eventPublisher, servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	require.NoError(t, service.RecordHandoff(context.Background(), "test-session", "  tests left to fix\n"))
+
+	err := service.RecordHandoff(context.Background(), "test-session", "   ")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestUpdateMetadata_WritesOnlyChangedFields(t *testing.T) {
+	status := "review"
+	current := &domain.Session{Comment: "ask design", DisplayName: "Cart API", Name: "test-session", Status: &status, Tags: []string{"backend"}}
+
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(current, nil)
+	sessionRepo.EXPECT().ToggleFlag(mock.Anything, "test-session").Return(nil)
+	sessionRepo.EXPECT().UpdatePriority(mock.Anything, "test-session", domain.PriorityP1).Return(nil)
+	sessionRepo.EXPECT().UpdateStatus(mock.Anything, "test-session", (*string)(nil)).Return(nil)
+	sessionRepo.EXPECT().UpdateTags(mock.Anything, "test-session", []string{"backend", "urgent"}).Return(nil)
+
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	empty := " "
+	changes, err := service.UpdateMetadata(context.Background(), "test-session", domain.SessionMetadata{
+		Comment:     "ask design ",
+		DisplayName: "Cart API",
+		Flagged:     true,
+		Priority:    "p1",
+		Status:      &empty,
+		Tags:        []string{"Urgent", "backend"},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{domain.MetadataFlagged, domain.MetadataPriority, domain.MetadataStatus, domain.MetadataTags}, changes)
+}
+
+func TestUpdateMetadata_InvalidPriority(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "test-session").Return(&domain.Session{Name: "test-session"}, nil)
+
+	service := NewSessionService(sessionRepo, portsmocks.NewMockGitRepository(t), portsmocks.NewMockTmuxSessionLifecycle(t),
+		servicesmocks.NewMockClaudeDirResolver(t), portsmocks.NewMockProcessInspector(t), newMockEventPublisher(t), servicesmocks.NewMockWorktreeBootstrapper(t))
+
+	_, err := service.UpdateMetadata(context.Background(), "test-session", domain.SessionMetadata{Priority: "urgent"})
+
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -3,8 +3,10 @@ package services
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 )
@@ -43,6 +45,28 @@ func (s *SettingsService) SetClaudeDir(
 	return nil
 }
 
+// SetEditor updates the editor integration for a session ("default" or empty clears it)
+func (s *SettingsService) SetEditor(
+	ctx context.Context,
+	sessionName string,
+	editor string,
+) error {
+	logging.Logger.Info("Setting editor integration for session", "session", sessionName, "editor", editor)
+
+	integration, err := domain.ParseEditorIntegration(editor)
+	if err != nil {
+		return err
+	}
+
+	if err := s.sessionRepo.UpdateEditor(ctx, sessionName, integration); err != nil {
+		logging.Logger.Error("Failed to update editor integration", "session", sessionName, "error", err)
+		return fmt.Errorf("failed to update editor integration: %w", err)
+	}
+
+	logging.Logger.Info("Editor integration updated successfully", "session", sessionName, "editor", integration)
+	return nil
+}
+
 // SetSkipPermissions updates AllowDangerouslySkipPermissions flag for a session
 func (s *SettingsService) SetSkipPermissions(
 	ctx context.Context,
@@ -67,6 +91,64 @@ func (s *SettingsService) SetSkipPermissions(
 	return nil
 }
 
+// SetAgentModel updates the model the agent of a session runs with (empty uses the agent default).
+// It applies the next time the agent starts.
+func (s *SettingsService) SetAgentModel(ctx context.Context, sessionName, model string) error {
+	model = strings.TrimSpace(model)
+	if err := domain.ValidateAgentModel(model); err != nil {
+		return err
+	}
+
+	logging.Logger.Info("Setting agent model for session", "session", sessionName, "model", model)
+	if err := s.sessionRepo.UpdateAgentModel(ctx, sessionName, model); err != nil {
+		return fmt.Errorf("failed to update agent model: %w", err)
+	}
+	return nil
+}
+
+// SetAgentArgs updates the extra agent CLI args of a session, given as a shell-like line
+// (empty clears them). They apply the next time the agent starts.
+func (s *SettingsService) SetAgentArgs(ctx context.Context, sessionName, line string) error {
+	args, err := domain.ParseAgentArgs(line)
+	if err != nil {
+		return err
+	}
+	if err := domain.ValidateAgentArgs(args); err != nil {
+		return err
+	}
+
+	logging.Logger.Info("Setting agent args for session", "session", sessionName, "args", args)
+	if err := s.sessionRepo.UpdateAgentArgs(ctx, sessionName, args); err != nil {
+		return fmt.Errorf("failed to update agent args: %w", err)
+	}
+	return nil
+}
+
+// SetKeepWorktree opts a session out of (or back into) the removal of its worktree
+// once it has been archived longer than the worktree retention
+func (s *SettingsService) SetKeepWorktree(ctx context.Context, sessionName string, keep bool) error {
+	logging.Logger.Info("Setting keep worktree for session", "session", sessionName, "keep", keep)
+	if err := s.sessionRepo.UpdateKeepWorktree(ctx, sessionName, keep); err != nil {
+		return fmt.Errorf("failed to update keep worktree: %w", err)
+	}
+	return nil
+}
+
+// SetSubdir scopes a session to a directory inside its checkout (empty scopes it to the
+// whole checkout). The agent starts there the next time it starts.
+func (s *SettingsService) SetSubdir(ctx context.Context, sessionName, subdir string) error {
+	normalized, err := domain.NormalizeSubdir(subdir)
+	if err != nil {
+		return err
+	}
+
+	logging.Logger.Info("Setting subdir for session", "session", sessionName, "subdir", normalized)
+	if err := s.sessionRepo.UpdateSubdir(ctx, sessionName, normalized); err != nil {
+		return fmt.Errorf("failed to update subdir: %w", err)
+	}
+	return nil
+}
+
 // GetAvailableStatuses returns the list of configured session statuses
 func (s *SettingsService) GetAvailableStatuses() ([]string, error) {
 	logging.Logger.Debug("Getting available statuses")
@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// shareCheckInterval is how often a joined client re-checks that its share is still active
+var shareCheckInterval = 5 * time.Second
+
+// ShareService creates and enforces links that let teammates attach to sessions for pairing
+type ShareService struct {
+	sessionReader ports.SessionReader
+	shareRepo     ports.ShareRepository
+	tmuxClient    ports.SessionManager
+}
+
+// NewShareService creates a new ShareService
+func NewShareService(
+	sessionReader ports.SessionReader,
+	shareRepo ports.ShareRepository,
+	tmuxClient ports.SessionManager,
+) *ShareService {
+	return &ShareService{
+		sessionReader: sessionReader,
+		shareRepo:     shareRepo,
+		tmuxClient:    tmuxClient,
+	}
+}
+
+// CreateShare creates a share of a session for the account guest that expires after ttl,
+// and starts the tmux server the teammate attaches through. tmux cannot restrict the account
+// that owns the server, so read-only shares need the teammate's own account.
+func (s *ShareService) CreateShare(
+	ctx context.Context,
+	sessionName string,
+	guest string,
+	access domain.ShareAccess,
+	ttl time.Duration,
+	now time.Time,
+) (*domain.SessionShare, error) {
+	if ttl <= 0 {
+		return nil, fmt.Errorf("%w: expiry must be positive", domain.ErrInvalidInput)
+	}
+	if access != domain.ShareReadOnly && access != domain.ShareReadWrite {
+		return nil, fmt.Errorf("%w: unknown access '%s'", domain.ErrInvalidInput, access)
+	}
+	if access == domain.ShareReadOnly && guest == "" {
+		return nil, fmt.Errorf("%w: read-only shares need the teammate's account, as tmux cannot restrict your own", domain.ErrInvalidInput)
+	}
+
+	if _, err := s.sessionReader.Get(ctx, sessionName); err != nil {
+		return nil, err
+	}
+	if !s.tmuxClient.SessionExists(sessionName) {
+		return nil, fmt.Errorf("%w: %s", ports.ErrTmuxSessionNotFound, sessionName)
+	}
+
+	share := domain.SessionShare{
+		Access:      access,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+		Guest:       guest,
+		SessionName: sessionName,
+		Token:       uuid.NewString(),
+	}
+
+	logging.Logger.Info("Creating session share", "session", sessionName, "guest", guest, "access", access, "expires_at", share.ExpiresAt)
+	socket, err := s.tmuxClient.StartGuestServer(share.Token[:8], sessionName, guest, access == domain.ShareReadOnly, share.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	share.Socket = socket
+
+	if err := s.shareRepo.AddShare(ctx, share); err != nil {
+		if stopErr := s.stopGuestServer(share); stopErr != nil {
+			logging.Logger.Warn("Failed to stop share server", "session", sessionName, "error", stopErr)
+		}
+		return nil, err
+	}
+	return &share, nil
+}
+
+// ListActiveShares returns the shares of a session that can still be used
+func (s *ShareService) ListActiveShares(ctx context.Context, sessionName string, now time.Time) ([]domain.SessionShare, error) {
+	shares, err := s.shareRepo.ListShares(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	active := make([]domain.SessionShare, 0, len(shares))
+	for _, share := range shares {
+		if share.IsActive(now) {
+			active = append(active, share)
+		}
+	}
+	return active, nil
+}
+
+// RevokeShare revokes a single share of a session
+func (s *ShareService) RevokeShare(ctx context.Context, sessionName, token string, now time.Time) error {
+	share, err := s.shareRepo.GetShare(ctx, token)
+	if err != nil {
+		return err
+	}
+	if share.SessionName != sessionName {
+		return fmt.Errorf("%w: share does not belong to session '%s'", domain.ErrShareNotFound, sessionName)
+	}
+
+	logging.Logger.Info("Revoking session share", "session", sessionName)
+	if err := s.shareRepo.RevokeShare(ctx, token, now); err != nil {
+		return err
+	}
+	return s.stopGuestServer(*share)
+}
+
+// RevokeAllShares revokes every active share of a session and returns how many were revoked
+func (s *ShareService) RevokeAllShares(ctx context.Context, sessionName string, now time.Time) (int, error) {
+	active, err := s.ListActiveShares(ctx, sessionName, now)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, share := range active {
+		if err := s.shareRepo.RevokeShare(ctx, share.Token, now); err != nil {
+			return 0, err
+		}
+		if err := s.stopGuestServer(share); err != nil {
+			return 0, err
+		}
+	}
+
+	logging.Logger.Info("Revoked session shares", "session", sessionName, "count", len(active))
+	return len(active), nil
+}
+
+// ValidateShare returns the share for token if it is still active.
+// Expired and revoked shares are reported as domain.ErrShareNotFound.
+func (s *ShareService) ValidateShare(ctx context.Context, token string, now time.Time) (*domain.SessionShare, error) {
+	share, err := s.shareRepo.GetShare(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if share.RevokedAt != nil {
+		return nil, fmt.Errorf("%w: share was revoked", domain.ErrShareNotFound)
+	}
+	if !share.IsActive(now) {
+		return nil, fmt.Errorf("%w: share expired at %s", domain.ErrShareNotFound, share.ExpiresAt.Local().Format(time.DateTime))
+	}
+	return share, nil
+}
+
+// GetJoinCommand returns the command that attaches a teammate to the shared session
+// through the tmux server of the share
+func (s *ShareService) GetJoinCommand(share *domain.SessionShare) (*exec.Cmd, error) {
+	if share.Socket == "" {
+		return nil, fmt.Errorf("%w: share was created before shares had servers of their own; share the session again", domain.ErrShareNotFound)
+	}
+	return s.tmuxClient.GetGuestAttachCommand(share.Socket, share.Access == domain.ShareReadOnly)
+}
+
+// stopGuestServer stops the tmux server of a share, detaching whoever joined it
+func (s *ShareService) stopGuestServer(share domain.SessionShare) error {
+	if share.Socket == "" {
+		return nil
+	}
+	if err := s.tmuxClient.StopGuestServer(share.Socket); err != nil {
+		return fmt.Errorf("share revoked, but its server is still running: %w", err)
+	}
+	return nil
+}
+
+// WaitUntilInactive blocks until the share expires or is revoked, or ctx is done.
+// Returns the reason the share stopped being usable, or ctx.Err().
+func (s *ShareService) WaitUntilInactive(ctx context.Context, token string) error {
+	ticker := time.NewTicker(shareCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		_, err := s.ValidateShare(ctx, token, time.Now())
+		if errors.Is(err, domain.ErrShareNotFound) {
+			return err
+		}
+		if err != nil {
+			logging.Logger.Warn("Failed to check share", "error", err)
+		}
+	}
+}
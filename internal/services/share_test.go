@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestCreateShare(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	shareRepo := portsmocks.NewMockShareRepository(t)
+
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+
+	sessionReader.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1"}, nil)
+	tmuxClient.EXPECT().SessionExists("s1").Return(true)
+	tmuxClient.EXPECT().StartGuestServer(mock.Anything, "s1", "alex", true, now.Add(time.Hour)).Return("/tmp/share.sock", nil)
+	shareRepo.EXPECT().AddShare(mock.Anything, mock.MatchedBy(func(share domain.SessionShare) bool {
+		return share.SessionName == "s1" && share.Access == domain.ShareReadOnly && share.Token != "" &&
+			share.Guest == "alex" && share.Socket == "/tmp/share.sock"
+	})).Return(nil)
+
+	service := NewShareService(sessionReader, shareRepo, tmuxClient)
+	share, err := service.CreateShare(context.Background(), "s1", "alex", domain.ShareReadOnly, time.Hour, now)
+
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(time.Hour), share.ExpiresAt)
+}
+
+func TestCreateShare_InvalidInput(t *testing.T) {
+	tests := []struct {
+		name   string
+		guest  string
+		access domain.ShareAccess
+		ttl    time.Duration
+	}{
+		{name: "zero expiry", guest: "alex", access: domain.ShareReadOnly, ttl: 0},
+		{name: "unknown access", guest: "alex", access: domain.ShareAccess("admin"), ttl: time.Hour},
+		{name: "read-only without a teammate account", access: domain.ShareReadOnly, ttl: time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service := NewShareService(portsmocks.NewMockSessionReader(t), portsmocks.NewMockShareRepository(t), portsmocks.NewMockSessionManager(t))
+			_, err := service.CreateShare(context.Background(), "s1", tt.guest, tt.access, tt.ttl, time.Now())
+
+			require.ErrorIs(t, err, domain.ErrInvalidInput)
+		})
+	}
+}
+
+func TestValidateShare(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	revokedAt := now.Add(-time.Minute)
+
+	tests := []struct {
+		name    string
+		share   *domain.SessionShare
+		wantErr error
+	}{
+		{
+			name:  "active share",
+			share: &domain.SessionShare{Token: "t1", SessionName: "s1", ExpiresAt: now.Add(time.Hour)},
+		},
+		{
+			name:    "expired share",
+			share:   &domain.SessionShare{Token: "t1", SessionName: "s1", ExpiresAt: now.Add(-time.Hour)},
+			wantErr: domain.ErrShareNotFound,
+		},
+		{
+			name:    "revoked share",
+			share:   &domain.SessionShare{Token: "t1", SessionName: "s1", ExpiresAt: now.Add(time.Hour), RevokedAt: &revokedAt},
+			wantErr: domain.ErrShareNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shareRepo := portsmocks.NewMockShareRepository(t)
+			shareRepo.EXPECT().GetShare(mock.Anything, "t1").Return(tt.share, nil)
+
+			service := NewShareService(portsmocks.NewMockSessionReader(t), shareRepo, portsmocks.NewMockSessionManager(t))
+			share, err := service.ValidateShare(context.Background(), "t1", now)
+
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "s1", share.SessionName)
+		})
+	}
+}
+
+func TestRevokeShare_OtherSession(t *testing.T) {
+	shareRepo := portsmocks.NewMockShareRepository(t)
+	shareRepo.EXPECT().GetShare(mock.Anything, "t1").Return(&domain.SessionShare{Token: "t1", SessionName: "other"}, nil)
+
+	service := NewShareService(portsmocks.NewMockSessionReader(t), shareRepo, portsmocks.NewMockSessionManager(t))
+	err := service.RevokeShare(context.Background(), "s1", "t1", time.Now())
+
+	require.ErrorIs(t, err, domain.ErrShareNotFound)
+}
+
+func TestRevokeShare_StopsGuestServer(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	shareRepo := portsmocks.NewMockShareRepository(t)
+	shareRepo.EXPECT().GetShare(mock.Anything, "t1").Return(&domain.SessionShare{Token: "t1", SessionName: "s1", Socket: "/tmp/t1.sock"}, nil)
+	shareRepo.EXPECT().RevokeShare(mock.Anything, "t1", now).Return(nil)
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+	tmuxClient.EXPECT().StopGuestServer("/tmp/t1.sock").Return(nil)
+
+	service := NewShareService(portsmocks.NewMockSessionReader(t), shareRepo, tmuxClient)
+	err := service.RevokeShare(context.Background(), "s1", "t1", now)
+
+	require.NoError(t, err)
+}
+
+func TestRevokeAllShares_SkipsInactive(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	shareRepo := portsmocks.NewMockShareRepository(t)
+	shareRepo.EXPECT().ListShares(mock.Anything, "s1").Return([]domain.SessionShare{
+		{Token: "active", SessionName: "s1", ExpiresAt: now.Add(time.Hour), Socket: "/tmp/active.sock"},
+		{Token: "expired", SessionName: "s1", ExpiresAt: now.Add(-time.Hour), Socket: "/tmp/expired.sock"},
+	}, nil)
+	shareRepo.EXPECT().RevokeShare(mock.Anything, "active", now).Return(nil)
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+	tmuxClient.EXPECT().StopGuestServer("/tmp/active.sock").Return(nil)
+
+	service := NewShareService(portsmocks.NewMockSessionReader(t), shareRepo, tmuxClient)
+	count, err := service.RevokeAllShares(context.Background(), "s1", now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestWaitUntilInactive_ReturnsWhenRevoked(t *testing.T) {
+	shareCheckInterval = time.Millisecond
+	t.Cleanup(func() { shareCheckInterval = 5 * time.Second })
+
+	revokedAt := time.Now()
+	shareRepo := portsmocks.NewMockShareRepository(t)
+	shareRepo.EXPECT().GetShare(mock.Anything, "t1").
+		Return(&domain.SessionShare{Token: "t1", SessionName: "s1", ExpiresAt: time.Now().Add(time.Hour)}, nil).Once()
+	shareRepo.EXPECT().GetShare(mock.Anything, "t1").
+		Return(&domain.SessionShare{Token: "t1", SessionName: "s1", ExpiresAt: time.Now().Add(time.Hour), RevokedAt: &revokedAt}, nil)
+
+	service := NewShareService(portsmocks.NewMockSessionReader(t), shareRepo, portsmocks.NewMockSessionManager(t))
+	err := service.WaitUntilInactive(context.Background(), "t1")
+
+	require.ErrorIs(t, err, domain.ErrShareNotFound)
+}
@@ -2,7 +2,9 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
 	"os/exec"
 	"time"
 
@@ -11,30 +13,58 @@ import (
 	"github.com/renato0307/rocha/internal/ports"
 )
 
+// OrphanShellCleanupInterval is how often the TUI cleans up shell sessions out of step with their
+// parent, when automatic cleanup is on
+const OrphanShellCleanupInterval = time.Minute
+
+// OrphanShellGracePeriod is how long a shell runs before it can be killed as an orphan, so one
+// opened while its parent restarts is left alone
+const OrphanShellGracePeriod = 10 * time.Minute
+
 // ShellService handles shell session management and tmux pane operations
 type ShellService struct {
+	autoCleanup   bool // Clean up orphan shells while the TUI runs
+	defaultEditor domain.EditorIntegration
 	editorOpener  ports.EditorOpener
+	gitStats      ports.GitStatsProvider
 	sessionReader ports.SessionReader
 	sessionWriter ports.SessionWriter
-	tmuxClient    ports.TmuxClient
+	tmuxClient    ports.SessionManager
 }
 
 // NewShellService creates a new ShellService
+// defaultEditor is the integration used by sessions that do not set one
 func NewShellService(
 	sessionReader ports.SessionReader,
 	sessionWriter ports.SessionWriter,
-	tmuxClient ports.TmuxClient,
+	tmuxClient ports.SessionManager,
 	editorOpener ports.EditorOpener,
+	gitStats ports.GitStatsProvider,
+	defaultEditor domain.EditorIntegration,
 ) *ShellService {
 	return &ShellService{
+		defaultEditor: defaultEditor,
 		editorOpener:  editorOpener,
+		gitStats:      gitStats,
 		sessionReader: sessionReader,
 		sessionWriter: sessionWriter,
 		tmuxClient:    tmuxClient,
 	}
 }
 
-// GetOrCreateShellSession returns shell session name, creating if needed
+// SetAutoCleanup turns the cleanup of orphan shells while the TUI runs on or off
+func (s *ShellService) SetAutoCleanup(enabled bool) {
+	s.autoCleanup = enabled
+}
+
+// AutoCleanup reports whether orphan shells are cleaned up while the TUI runs
+func (s *ShellService) AutoCleanup() bool {
+	return s.autoCleanup
+}
+
+// GetOrCreateShellSession returns shell session name, creating if needed.
+// The shell opens in the parent's working directory as it is now, and only its name
+// and parent are stored, so it needs no update when the parent is renamed or moved.
 // Returns empty string and error if operation fails
 func (s *ShellService) GetOrCreateShellSession(
 	ctx context.Context,
@@ -47,50 +77,23 @@ func (s *ShellService) GetOrCreateShellSession(
 		return "", fmt.Errorf("session info not found: %s: %w", parentSessionName, err)
 	}
 
-	// Check if shell session already exists
+	shellSessionName := domain.ShellSessionName(parentSessionName)
 	if session.ShellSession != nil {
-		// Check if tmux session exists
-		if s.tmuxClient.SessionExists(session.ShellSession.Name) {
-			return session.ShellSession.Name, nil
-		}
+		shellSessionName = session.ShellSession.Name
 	}
-
-	// Create shell session name
-	shellSessionName := fmt.Sprintf("%s-shell", parentSessionName)
-
-	// Determine working directory
-	workingDir := session.WorktreePath
-	if workingDir == "" {
-		workingDir = session.RepoPath
+	if s.tmuxClient.SessionExists(shellSessionName) {
+		return shellSessionName, nil
 	}
 
 	// Create shell session in tmux
-	_, err = s.tmuxClient.CreateShellSession(shellSessionName, workingDir, tmuxStatusPosition)
+	_, err = s.tmuxClient.CreateShellSession(shellSessionName, session.StartDir(), tmuxStatusPosition)
 	if err != nil {
 		return "", fmt.Errorf("failed to create shell session: %w", err)
 	}
 
-	// Create domain session for shell
-	shellSession := domain.Session{
-		BranchName:   session.BranchName,
-		DisplayName:  "", // No display name for shell sessions
-		ExecutionID:  session.ExecutionID,
-		LastUpdated:  time.Now().UTC(),
-		Name:         shellSessionName,
-		RepoInfo:     session.RepoInfo,
-		RepoPath:     session.RepoPath,
-		ShellSession: nil, // Shell sessions don't have their own shells
-		State:        domain.StateIdle,
-		WorktreePath: session.WorktreePath,
-	}
-
-	// Add shell session to repository
-	if err := s.sessionWriter.Add(ctx, shellSession); err != nil {
-		logging.Logger.Warn("Failed to save shell session to state", "error", err)
-		// Don't return error - tmux session was created successfully
-	} else {
-		// Link shell session to parent
+	if session.ShellSession == nil {
 		if err := s.sessionWriter.LinkShellSession(ctx, parentSessionName, shellSessionName); err != nil {
+			// Don't return error - tmux session was created successfully
 			logging.Logger.Warn("Failed to link shell session to parent", "error", err)
 		}
 	}
@@ -99,6 +102,66 @@ func (s *ShellService) GetOrCreateShellSession(
 	return shellSessionName, nil
 }
 
+// FindOrphanShells returns the recorded shell sessions running without their parent session,
+// and the ones that no longer run
+func (s *ShellService) FindOrphanShells(ctx context.Context) ([]domain.OrphanShell, error) {
+	orphans, _, err := s.findOrphanShells(ctx)
+	return orphans, err
+}
+
+// findOrphanShells returns the orphan shells and the tmux sessions running, by name
+func (s *ShellService) findOrphanShells(ctx context.Context) ([]domain.OrphanShell, map[string]*ports.TmuxSession, error) {
+	sessions, err := s.sessionReader.List(ctx, true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	tmuxSessions, err := s.tmuxClient.ListSessions()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to list tmux sessions: %w", err)
+	}
+	byName := make(map[string]*ports.TmuxSession, len(tmuxSessions))
+	running := make(map[string]bool, len(tmuxSessions))
+	for _, session := range tmuxSessions {
+		byName[session.Name] = session
+		running[session.Name] = true
+	}
+	return domain.FindOrphanShells(sessions, running), byName, nil
+}
+
+// CleanOrphanShells kills the recorded shell sessions running without their parent and forgets
+// the ones that no longer run. Shells with a client attached, or running for less than
+// OrphanShellGracePeriod, are left alone. Returns the shells cleaned up; one that failed is
+// left for the next run and reported in the error.
+func (s *ShellService) CleanOrphanShells(ctx context.Context) ([]domain.OrphanShell, error) {
+	orphans, tmuxSessions, err := s.findOrphanShells(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var cleaned []domain.OrphanShell
+	var errs []error
+	for _, orphan := range orphans {
+		if orphan.Running {
+			if session := tmuxSessions[orphan.Name]; session.Attached || time.Since(session.CreatedAt) < OrphanShellGracePeriod {
+				logging.Logger.Debug("Leaving orphan shell session in use", "name", orphan.Name, "attached", session.Attached, "created_at", session.CreatedAt)
+				continue
+			}
+			logging.Logger.Info("Killing orphan shell session", "name", orphan.Name, "parent", orphan.ParentName)
+			if err := s.tmuxClient.KillSession(orphan.Name); err != nil {
+				errs = append(errs, fmt.Errorf("failed to kill shell session %s: %w", orphan.Name, err))
+				continue
+			}
+		}
+		if err := s.sessionWriter.UnlinkShellSession(ctx, orphan.ParentName); err != nil {
+			errs = append(errs, fmt.Errorf("failed to forget shell session %s: %w", orphan.Name, err))
+			continue
+		}
+		cleaned = append(cleaned, orphan)
+	}
+	return cleaned, errors.Join(errs...)
+}
+
 // GetRunningTmuxSessions returns a map of session names that are currently running in tmux
 func (s *ShellService) GetRunningTmuxSessions(ctx context.Context) (map[string]bool, error) {
 	logging.Logger.Debug("Getting running tmux sessions")
@@ -130,7 +193,50 @@ func (s *ShellService) SendKeys(sessionName string, keys ...string) error {
 // OpenEditor opens the specified path in the configured editor
 func (s *ShellService) OpenEditor(path, editor string) error {
 	logging.Logger.Debug("Opening editor", "path", path, "editor", editor)
-	return s.editorOpener.Open(path, editor)
+	return s.editorOpener.Open(domain.EditorTarget{Path: path}, editor)
+}
+
+// OpenSessionInEditor opens the session's start directory with its editor integration
+// When changedFiles is true, the files changed on the branch are opened alongside it
+func (s *ShellService) OpenSessionInEditor(ctx context.Context, sessionName, editor string, changedFiles bool) error {
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return fmt.Errorf("failed to get session: %w", err)
+	}
+	if session.WorkingDir() == "" {
+		return fmt.Errorf("%w: no worktree associated with session '%s'", domain.ErrInvalidInput, sessionName)
+	}
+
+	target := domain.EditorTarget{
+		Integration: s.EditorIntegration(session),
+		Path:        session.StartDir(),
+	}
+
+	if changedFiles {
+		files, err := s.gitStats.ListChangedFiles(ctx, session.WorkingDir())
+		if err != nil {
+			return fmt.Errorf("failed to list changed files: %w", err)
+		}
+		if len(files) == 0 {
+			return fmt.Errorf("%w: session '%s' has no changed files", domain.ErrInvalidInput, sessionName)
+		}
+		target.Files = files
+	}
+
+	logging.Logger.Debug("Opening session in editor",
+		"session", sessionName,
+		"integration", target.Integration,
+		"path", target.Path,
+		"files", len(target.Files))
+	return s.editorOpener.Open(target, editor)
+}
+
+// EditorIntegration returns the integration a session opens with: its own, or the default
+func (s *ShellService) EditorIntegration(session *domain.Session) domain.EditorIntegration {
+	if session.Editor != domain.EditorIntegrationDefault {
+		return session.Editor
+	}
+	return s.defaultEditor
 }
 
 // SourceFile reloads tmux configuration from the specified file
@@ -145,9 +251,44 @@ func (s *ShellService) GetAttachCommand(sessionName string) *exec.Cmd {
 	return s.tmuxClient.GetAttachCommand(sessionName)
 }
 
+// IsInsideTmux returns true if rocha is running inside a tmux client
+func (s *ShellService) IsInsideTmux() bool {
+	return os.Getenv("TMUX") != ""
+}
+
+// OpenInWindow shows a tmux session as a window of the current tmux session
+func (s *ShellService) OpenInWindow(sessionName string) error {
+	logging.Logger.Info("Opening session in new tmux window", "session", sessionName)
+	return s.tmuxClient.OpenInWindow(sessionName)
+}
+
+// SwitchClient switches the current tmux client to a session
+func (s *ShellService) SwitchClient(sessionName string) error {
+	logging.Logger.Info("Switching tmux client to session", "session", sessionName)
+	return s.tmuxClient.SwitchClient(sessionName)
+}
+
 // CapturePane captures the content of a tmux session pane
 // lines specifies how many lines to capture (negative means from end of scrollback)
 func (s *ShellService) CapturePane(sessionName string, lines int) (string, error) {
 	logging.Logger.Debug("Capturing pane content", "session", sessionName, "lines", lines)
 	return s.tmuxClient.CapturePane(sessionName, -lines)
 }
+
+// DetectAgentErrors captures the visible pane of each session and returns the usage limit or
+// authentication errors the agent printed, by session name; sessions without one are left out
+func (s *ShellService) DetectAgentErrors(sessionNames []string) map[string]*domain.AgentError {
+	agentErrors := make(map[string]*domain.AgentError)
+	for _, name := range sessionNames {
+		content, err := s.tmuxClient.CapturePane(name, 0)
+		if err != nil {
+			logging.Logger.Debug("Failed to capture pane for agent errors", "session", name, "error", err)
+			continue
+		}
+		if agentErr := domain.DetectAgentError(content); agentErr != nil {
+			logging.Logger.Info("Detected agent error", "session", name, "kind", agentErr.Kind, "line", agentErr.Line)
+			agentErrors[name] = agentErr
+		}
+	}
+	return agentErrors
+}
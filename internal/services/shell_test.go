@@ -0,0 +1,194 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestOpenSessionInEditor(t *testing.T) {
+	changed := []string{"/wt/login/main.go", "/wt/login/notes.txt"}
+
+	tests := []struct {
+		name            string
+		session         *domain.Session
+		defaultEditor   domain.EditorIntegration
+		changedFiles    bool
+		wantIntegration domain.EditorIntegration
+		wantFiles       []string
+	}{
+		{
+			name:            "session integration wins over the default",
+			session:         &domain.Session{Name: "login", Editor: domain.EditorIntegrationZed, WorktreePath: "/wt/login"},
+			defaultEditor:   domain.EditorIntegrationVSCode,
+			wantIntegration: domain.EditorIntegrationZed,
+		},
+		{
+			name:            "session without integration uses the default",
+			session:         &domain.Session{Name: "login", WorktreePath: "/wt/login"},
+			defaultEditor:   domain.EditorIntegrationJetBrains,
+			wantIntegration: domain.EditorIntegrationJetBrains,
+		},
+		{
+			name:            "changed files are opened alongside the worktree",
+			session:         &domain.Session{Name: "login", Editor: domain.EditorIntegrationVSCode, WorktreePath: "/wt/login"},
+			changedFiles:    true,
+			wantIntegration: domain.EditorIntegrationVSCode,
+			wantFiles:       changed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			reader := portsmocks.NewMockSessionReader(t)
+			opener := portsmocks.NewMockEditorOpener(t)
+			gitStats := portsmocks.NewMockGitStatsProvider(t)
+
+			reader.EXPECT().Get(ctx, "login").Return(tt.session, nil)
+			if tt.changedFiles {
+				gitStats.EXPECT().ListChangedFiles(ctx, "/wt/login").Return(changed, nil)
+			}
+			opener.EXPECT().Open(domain.EditorTarget{
+				Files:       tt.wantFiles,
+				Integration: tt.wantIntegration,
+				Path:        "/wt/login",
+			}, "code").Return(nil)
+
+			service := NewShellService(reader, nil, nil, opener, gitStats, tt.defaultEditor)
+			require.NoError(t, service.OpenSessionInEditor(ctx, "login", "code", tt.changedFiles))
+		})
+	}
+}
+
+func TestOpenSessionInEditor_NoChangedFiles(t *testing.T) {
+	ctx := context.Background()
+	reader := portsmocks.NewMockSessionReader(t)
+	gitStats := portsmocks.NewMockGitStatsProvider(t)
+
+	reader.EXPECT().Get(ctx, "login").Return(&domain.Session{Name: "login", WorktreePath: "/wt/login"}, nil)
+	gitStats.EXPECT().ListChangedFiles(ctx, "/wt/login").Return(nil, nil)
+
+	service := NewShellService(reader, nil, nil, portsmocks.NewMockEditorOpener(t), gitStats, domain.EditorIntegrationDefault)
+	err := service.OpenSessionInEditor(ctx, "login", "", true)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestOpenSessionInEditor_NoWorkingDir(t *testing.T) {
+	ctx := context.Background()
+	reader := portsmocks.NewMockSessionReader(t)
+	reader.EXPECT().Get(ctx, "bare").Return(&domain.Session{Name: "bare"}, nil)
+
+	service := NewShellService(reader, nil, nil, portsmocks.NewMockEditorOpener(t), portsmocks.NewMockGitStatsProvider(t), domain.EditorIntegrationDefault)
+	err := service.OpenSessionInEditor(ctx, "bare", "", false)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestDetectAgentErrors(t *testing.T) {
+	tmux := portsmocks.NewMockSessionManager(t)
+	tmux.EXPECT().CapturePane("limited", 0).Return("Claude usage limit reached. Your limit will reset at 3pm\n> ", nil)
+	tmux.EXPECT().CapturePane("fine", 0).Return("● Editing main.go\n> ", nil)
+	tmux.EXPECT().CapturePane("gone", 0).Return("", errors.New("no such session"))
+	service := NewShellService(nil, nil, tmux, nil, nil, "")
+
+	agentErrors := service.DetectAgentErrors([]string{"limited", "fine", "gone"})
+
+	assert.Equal(t, map[string]*domain.AgentError{
+		"limited": {Kind: domain.AgentErrorRateLimit, Line: "Claude usage limit reached. Your limit will reset at 3pm"},
+	}, agentErrors)
+}
+
+func TestGetOrCreateShellSession(t *testing.T) {
+	tests := []struct {
+		name       string
+		session    *domain.Session
+		running    bool
+		wantCreate bool
+		wantLink   bool
+	}{
+		{
+			name:       "first shell is created in the worktree and linked",
+			session:    &domain.Session{Name: "login", RepoPath: "/repo", WorktreePath: "/wt/login"},
+			wantCreate: true,
+			wantLink:   true,
+		},
+		{
+			name:    "running shell is reused",
+			session: &domain.Session{Name: "login", ShellSession: &domain.ShellSession{Name: "login-shell", ParentName: "login"}, WorktreePath: "/wt/login"},
+			running: true,
+		},
+		{
+			name:       "stopped shell is recreated in the current worktree",
+			session:    &domain.Session{Name: "login", ShellSession: &domain.ShellSession{Name: "login-shell", ParentName: "login"}, WorktreePath: "/wt/login"},
+			wantCreate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			reader := portsmocks.NewMockSessionReader(t)
+			writer := portsmocks.NewMockSessionWriter(t)
+			tmuxClient := portsmocks.NewMockSessionManager(t)
+
+			reader.EXPECT().Get(ctx, "login").Return(tt.session, nil)
+			tmuxClient.EXPECT().SessionExists("login-shell").Return(tt.running)
+			if tt.wantCreate {
+				tmuxClient.EXPECT().CreateShellSession("login-shell", "/wt/login", "bottom").Return(nil, nil)
+			}
+			if tt.wantLink {
+				writer.EXPECT().LinkShellSession(ctx, "login", "login-shell").Return(nil)
+			}
+
+			service := NewShellService(reader, writer, tmuxClient, portsmocks.NewMockEditorOpener(t), portsmocks.NewMockGitStatsProvider(t), domain.EditorIntegrationDefault)
+			name, err := service.GetOrCreateShellSession(ctx, "login", "bottom")
+
+			require.NoError(t, err)
+			assert.Equal(t, "login-shell", name)
+		})
+	}
+}
+
+func TestCleanOrphanShells(t *testing.T) {
+	ctx := context.Background()
+	reader := portsmocks.NewMockSessionReader(t)
+	writer := portsmocks.NewMockSessionWriter(t)
+	tmuxClient := portsmocks.NewMockSessionManager(t)
+
+	reader.EXPECT().List(ctx, true).Return([]domain.Session{
+		{Name: "api", ShellSession: &domain.ShellSession{Name: "api-shell", ParentName: "api"}},
+		{Name: "web", ShellSession: &domain.ShellSession{Name: "web-shell", ParentName: "web"}},
+		{Name: "docs", ShellSession: &domain.ShellSession{Name: "docs-shell", ParentName: "docs"}},
+		{Name: "cli", ShellSession: &domain.ShellSession{Name: "cli-shell", ParentName: "cli"}},
+		{Name: "ops", ShellSession: &domain.ShellSession{Name: "ops-shell", ParentName: "ops"}},
+		{Name: "lib"},
+	}, nil)
+	old := time.Now().Add(-OrphanShellGracePeriod - time.Minute)
+	tmuxClient.EXPECT().ListSessions().Return([]*ports.TmuxSession{
+		{Name: "api-shell", CreatedAt: old},
+		{Name: "docs", CreatedAt: old},
+		{Name: "docs-shell", CreatedAt: old},
+		{Name: "cli-shell", CreatedAt: old, Attached: true}, // In use, so left alone
+		{Name: "ops-shell", CreatedAt: time.Now()},          // Within the grace period
+		{Name: "lib-shell", CreatedAt: old},                 // Never recorded, so not rocha's to kill
+	}, nil)
+	tmuxClient.EXPECT().KillSession("api-shell").Return(nil)
+	writer.EXPECT().UnlinkShellSession(ctx, "api").Return(nil)
+	writer.EXPECT().UnlinkShellSession(ctx, "web").Return(errors.New("database is locked"))
+
+	service := NewShellService(reader, writer, tmuxClient, portsmocks.NewMockEditorOpener(t), portsmocks.NewMockGitStatsProvider(t), domain.EditorIntegrationDefault)
+	cleaned, err := service.CleanOrphanShells(ctx)
+
+	assert.ErrorContains(t, err, "web-shell")
+	assert.Equal(t, []domain.OrphanShell{{Name: "api-shell", ParentName: "api", Running: true}}, cleaned)
+}
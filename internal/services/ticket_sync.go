@@ -0,0 +1,230 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// DefaultTicketAccount is the keychain account used when a rule does not name one
+const DefaultTicketAccount = "default"
+
+// maxTicketSyncAttempts is how many drains may fail to sync a status change before it is dropped
+const maxTicketSyncAttempts = 5
+
+// TicketTrackerFactory creates a tracker client for a sync rule and its API token
+type TicketTrackerFactory func(rule domain.TicketSyncRule, token string) ports.TicketTracker
+
+// TicketSyncResult describes what a ticket sync did
+type TicketSyncResult struct {
+	Key     string // Ticket the session is linked to
+	Skipped string // Why nothing was sent (empty when the ticket was updated)
+	State   string // State the ticket was moved to (empty if the status is not mapped)
+}
+
+// TicketSyncService mirrors session status changes to linked Jira or Linear tickets.
+// Rules are configured per repository; API tokens are kept in the OS keychain.
+// Status changes are queued in an outbox and synced by drains from the TUI or the scheduler,
+// so changing a status never waits on the tracker.
+type TicketSyncService struct {
+	newTracker    TicketTrackerFactory
+	outbox        ports.TicketSyncOutbox
+	rules         map[string]domain.TicketSyncRule // Keyed by repo info (owner/repo)
+	secretStore   ports.SecretStore
+	sessionReader ports.SessionReader
+}
+
+// Verify interface compliance at compile time
+var _ ports.EventPublisher = (*TicketSyncService)(nil)
+
+// NewTicketSyncService creates a new TicketSyncService
+func NewTicketSyncService(
+	sessionReader ports.SessionReader,
+	outbox ports.TicketSyncOutbox,
+	secretStore ports.SecretStore,
+	rules map[string]domain.TicketSyncRule,
+	newTracker TicketTrackerFactory,
+) *TicketSyncService {
+	return &TicketSyncService{
+		newTracker:    newTracker,
+		outbox:        outbox,
+		rules:         rules,
+		secretStore:   secretStore,
+		sessionReader: sessionReader,
+	}
+}
+
+// Publish implements ports.EventPublisher so status changes are queued for the next drain
+// as they are published. Nothing is queued when no repository has a rule.
+func (s *TicketSyncService) Publish(ctx context.Context, event domain.Event) error {
+	if event.Type != domain.EventStatusChange || len(s.rules) == 0 {
+		return nil
+	}
+
+	queuedAt := event.Timestamp
+	if queuedAt.IsZero() {
+		queuedAt = time.Now()
+	}
+	if err := s.outbox.QueueTicketSync(ctx, event.SessionName, queuedAt); err != nil {
+		return fmt.Errorf("failed to queue ticket sync: %w", err)
+	}
+	return nil
+}
+
+// Drain syncs the tickets of the status changes queued so far, oldest first.
+// A change that keeps failing is dropped after maxTicketSyncAttempts drains.
+// Returns how many tickets were synced.
+func (s *TicketSyncService) Drain(ctx context.Context) (int, error) {
+	requests, err := s.outbox.ListTicketSyncs(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	synced := 0
+	for _, request := range requests {
+		if err := ctx.Err(); err != nil {
+			return synced, err
+		}
+		claimed, err := s.outbox.ClaimTicketSync(ctx, request.SessionName, time.Now())
+		if err != nil {
+			return synced, err
+		}
+		if !claimed {
+			continue
+		}
+
+		ok, err := s.syncQueued(ctx, request)
+		if err != nil {
+			return synced, err
+		}
+		if ok {
+			synced++
+		}
+	}
+	return synced, nil
+}
+
+// syncQueued syncs the ticket of one claimed request and settles it in the outbox.
+// Reports whether the ticket was updated.
+func (s *TicketSyncService) syncQueued(ctx context.Context, request domain.TicketSyncRequest) (bool, error) {
+	result, err := s.SyncSession(ctx, request.SessionName)
+	switch {
+	case errors.Is(err, domain.ErrSessionNotFound):
+		logging.Logger.Debug("Dropping ticket sync of removed session", "session", request.SessionName)
+		return false, s.outbox.CompleteTicketSync(ctx, request.SessionName, request.Revision)
+	case err != nil && request.Attempts+1 >= maxTicketSyncAttempts:
+		logging.Logger.Warn("Giving up on ticket sync", "session", request.SessionName, "attempts", request.Attempts+1, "error", err)
+		return false, s.outbox.CompleteTicketSync(ctx, request.SessionName, request.Revision)
+	case err != nil:
+		logging.Logger.Warn("Failed to sync ticket", "session", request.SessionName, "error", err)
+		return false, s.outbox.FailTicketSync(ctx, request.SessionName)
+	}
+
+	if result.Skipped != "" {
+		logging.Logger.Debug("Ticket sync skipped", "session", request.SessionName, "reason", result.Skipped)
+	}
+	return result.Skipped == "", s.outbox.CompleteTicketSync(ctx, request.SessionName, request.Revision)
+}
+
+// SyncSession moves the session's linked ticket to the state mapped from its status
+// and posts the session comment and PR link on the ticket
+func (s *TicketSyncService) SyncSession(ctx context.Context, sessionName string) (*TicketSyncResult, error) {
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, ok := s.rules[session.RepoInfo]
+	if !ok {
+		return &TicketSyncResult{Skipped: fmt.Sprintf("no ticket sync configured for repository '%s'", session.RepoInfo)}, nil
+	}
+
+	result := &TicketSyncResult{Key: rule.FindTicketKey(*session)}
+	if result.Key == "" {
+		result.Skipped = "no ticket key found in branch or display name"
+		return result, nil
+	}
+	if session.Status == nil {
+		result.Skipped = "session has no status"
+		return result, nil
+	}
+
+	token, err := s.secretStore.GetSecret(TicketSecretService(rule.Provider), rule.Account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s token for '%s' (run 'rocha tickets login %s'): %w", rule.Provider, rule.Account, rule.Provider, err)
+	}
+	tracker := s.newTracker(rule, token)
+
+	if state, ok := rule.TicketState(*session.Status); ok {
+		logging.Logger.Info("Moving ticket", "session", sessionName, "key", result.Key, "state", state)
+		if err := tracker.TransitionTicket(ctx, result.Key, state); err != nil {
+			return nil, err
+		}
+		result.State = state
+	}
+
+	if err := tracker.AddComment(ctx, result.Key, ticketComment(session)); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindIssue returns the key and title of the ticket a branch of a repository links to.
+// Both are empty when the repository has no rule or the branch has no key; the title is
+// empty when the tracker cannot be asked for it.
+func (s *TicketSyncService) FindIssue(ctx context.Context, repoInfo, branch string) (string, string, error) {
+	rule, ok := s.rules[repoInfo]
+	if !ok {
+		return "", "", nil
+	}
+	key := rule.FindTicketKey(domain.Session{BranchName: branch})
+	if key == "" {
+		return "", "", nil
+	}
+
+	token, err := s.secretStore.GetSecret(TicketSecretService(rule.Provider), rule.Account)
+	if err != nil {
+		return key, "", fmt.Errorf("failed to get %s token for '%s' (run 'rocha tickets login %s'): %w", rule.Provider, rule.Account, rule.Provider, err)
+	}
+	title, err := s.newTracker(rule, token).TicketTitle(ctx, key)
+	if err != nil {
+		return key, "", err
+	}
+	return key, strings.TrimSpace(title), nil
+}
+
+// StoreToken saves a tracker API token in the OS keychain
+func (s *TicketSyncService) StoreToken(provider domain.TicketProvider, account, token string) error {
+	if strings.TrimSpace(token) == "" {
+		return fmt.Errorf("%w: token cannot be empty", domain.ErrInvalidInput)
+	}
+	return s.secretStore.SetSecret(TicketSecretService(provider), account, strings.TrimSpace(token))
+}
+
+// DeleteToken removes a tracker API token from the OS keychain
+func (s *TicketSyncService) DeleteToken(provider domain.TicketProvider, account string) error {
+	return s.secretStore.DeleteSecret(TicketSecretService(provider), account)
+}
+
+// TicketSecretService is the keychain service name under which a provider's tokens are stored
+func TicketSecretService(provider domain.TicketProvider) string {
+	return "rocha-" + string(provider)
+}
+
+// ticketComment describes the session's status, comment, and PR for the ticket
+func ticketComment(session *domain.Session) string {
+	lines := []string{fmt.Sprintf("Rocha session '%s' is now %s.", session.Name, *session.Status)}
+	if session.Comment != "" {
+		lines = append(lines, "Comment: "+session.Comment)
+	}
+	if session.PRInfo != nil && session.PRInfo.URL != "" {
+		lines = append(lines, "PR: "+session.PRInfo.URL)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func newTestTicketSyncService(t *testing.T, session *domain.Session, tracker ports.TicketTracker) (*TicketSyncService, *portsmocks.MockSecretStore, *portsmocks.MockTicketSyncOutbox) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	sessionReader.EXPECT().Get(mock.Anything, session.Name).Return(session, nil).Maybe()
+	outbox := portsmocks.NewMockTicketSyncOutbox(t)
+	secretStore := portsmocks.NewMockSecretStore(t)
+
+	rules := map[string]domain.TicketSyncRule{
+		"owner/repo": {
+			Account:   "me@example.com",
+			Provider:  domain.TicketProviderJira,
+			StatusMap: map[string]string{"review": "In Review"},
+		},
+	}
+	newTracker := func(rule domain.TicketSyncRule, token string) ports.TicketTracker {
+		assert.Equal(t, "secret", token)
+		return tracker
+	}
+	return NewTicketSyncService(sessionReader, outbox, secretStore, rules, newTracker), secretStore, outbox
+}
+
+func TestSyncSession_TransitionsAndComments(t *testing.T) {
+	status := "review"
+	session := &domain.Session{
+		BranchName: "feature/ABC-12-login",
+		Comment:    "ready for a look",
+		Name:       "s1",
+		PRInfo:     &domain.PRInfo{URL: "https://github.com/owner/repo/pull/7"},
+		RepoInfo:   "owner/repo",
+		Status:     &status,
+	}
+	tracker := portsmocks.NewMockTicketTracker(t)
+	service, secretStore, _ := newTestTicketSyncService(t, session, tracker)
+
+	secretStore.EXPECT().GetSecret("rocha-jira", "me@example.com").Return("secret", nil)
+	tracker.EXPECT().TransitionTicket(mock.Anything, "ABC-12", "In Review").Return(nil)
+	tracker.EXPECT().AddComment(mock.Anything, "ABC-12", mock.MatchedBy(func(body string) bool {
+		return assert.Contains(t, body, "ready for a look") && assert.Contains(t, body, "pull/7")
+	})).Return(nil)
+
+	result, err := service.SyncSession(context.Background(), "s1")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ABC-12", result.Key)
+	assert.Equal(t, "In Review", result.State)
+	assert.Empty(t, result.Skipped)
+}
+
+func TestSyncSession_UnmappedStatusOnlyComments(t *testing.T) {
+	status := "spec"
+	session := &domain.Session{BranchName: "abc-12", Name: "s1", RepoInfo: "owner/repo", Status: &status}
+	tracker := portsmocks.NewMockTicketTracker(t)
+	service, secretStore, _ := newTestTicketSyncService(t, session, tracker)
+
+	secretStore.EXPECT().GetSecret("rocha-jira", "me@example.com").Return("secret", nil)
+	tracker.EXPECT().AddComment(mock.Anything, "ABC-12", mock.Anything).Return(nil)
+
+	result, err := service.SyncSession(context.Background(), "s1")
+
+	require.NoError(t, err)
+	assert.Empty(t, result.State)
+}
+
+func TestSyncSession_Skipped(t *testing.T) {
+	status := "review"
+	tests := []struct {
+		name    string
+		session *domain.Session
+	}{
+		{name: "repository without rule", session: &domain.Session{Name: "s1", RepoInfo: "other/repo", BranchName: "ABC-1", Status: &status}},
+		{name: "no ticket key", session: &domain.Session{Name: "s1", RepoInfo: "owner/repo", BranchName: "main", Status: &status}},
+		{name: "no status", session: &domain.Session{Name: "s1", RepoInfo: "owner/repo", BranchName: "ABC-1"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			service, _, _ := newTestTicketSyncService(t, tt.session, portsmocks.NewMockTicketTracker(t))
+
+			result, err := service.SyncSession(context.Background(), "s1")
+
+			require.NoError(t, err)
+			assert.NotEmpty(t, result.Skipped)
+		})
+	}
+}
+
+func TestSyncSession_MissingToken(t *testing.T) {
+	status := "review"
+	session := &domain.Session{Name: "s1", RepoInfo: "owner/repo", BranchName: "ABC-1", Status: &status}
+	service, secretStore, _ := newTestTicketSyncService(t, session, portsmocks.NewMockTicketTracker(t))
+
+	secretStore.EXPECT().GetSecret("rocha-jira", "me@example.com").Return("", ports.ErrSecretNotFound)
+
+	_, err := service.SyncSession(context.Background(), "s1")
+
+	require.ErrorIs(t, err, ports.ErrSecretNotFound)
+}
+
+func TestTicketSyncPublish_IgnoresOtherEvents(t *testing.T) {
+	session := &domain.Session{Name: "s1", RepoInfo: "owner/repo"}
+	service, _, _ := newTestTicketSyncService(t, session, portsmocks.NewMockTicketTracker(t))
+
+	err := service.Publish(context.Background(), domain.Event{Type: domain.EventStateChange, SessionName: "s1"})
+
+	require.NoError(t, err)
+}
+
+func TestTicketSyncPublish_QueuesWithoutSyncing(t *testing.T) {
+	session := &domain.Session{Name: "s1", RepoInfo: "owner/repo", BranchName: "ABC-1"}
+	service, _, outbox := newTestTicketSyncService(t, session, portsmocks.NewMockTicketTracker(t))
+	changedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	outbox.EXPECT().QueueTicketSync(mock.Anything, "s1", changedAt).Return(nil)
+
+	err := service.Publish(context.Background(), domain.Event{Type: domain.EventStatusChange, SessionName: "s1", Timestamp: changedAt})
+
+	require.NoError(t, err)
+}
+
+func TestTicketSyncDrain(t *testing.T) {
+	status := "review"
+	request := domain.TicketSyncRequest{Attempts: 0, Revision: 2, SessionName: "s1"}
+
+	tests := []struct {
+		name       string
+		attempts   int
+		syncErr    error
+		settle     func(outbox *portsmocks.MockTicketSyncOutbox)
+		wantSynced int
+	}{
+		{
+			name: "synced request is completed",
+			settle: func(outbox *portsmocks.MockTicketSyncOutbox) {
+				outbox.EXPECT().CompleteTicketSync(mock.Anything, "s1", 2).Return(nil)
+			},
+			wantSynced: 1,
+		},
+		{
+			name:    "failed request stays queued",
+			syncErr: errors.New("boom"),
+			settle: func(outbox *portsmocks.MockTicketSyncOutbox) {
+				outbox.EXPECT().FailTicketSync(mock.Anything, "s1").Return(nil)
+			},
+		},
+		{
+			name:     "request failing too often is dropped",
+			attempts: maxTicketSyncAttempts - 1,
+			syncErr:  errors.New("boom"),
+			settle: func(outbox *portsmocks.MockTicketSyncOutbox) {
+				outbox.EXPECT().CompleteTicketSync(mock.Anything, "s1", 2).Return(nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := &domain.Session{Name: "s1", RepoInfo: "owner/repo", BranchName: "ABC-1", Status: &status}
+			tracker := portsmocks.NewMockTicketTracker(t)
+			service, secretStore, outbox := newTestTicketSyncService(t, session, tracker)
+
+			queued := request
+			queued.Attempts = tt.attempts
+			outbox.EXPECT().ListTicketSyncs(mock.Anything, mock.Anything).Return([]domain.TicketSyncRequest{queued}, nil)
+			outbox.EXPECT().ClaimTicketSync(mock.Anything, "s1", mock.Anything).Return(true, nil)
+			secretStore.EXPECT().GetSecret("rocha-jira", "me@example.com").Return("secret", nil)
+			tracker.EXPECT().TransitionTicket(mock.Anything, "ABC-1", "In Review").Return(tt.syncErr)
+			if tt.syncErr == nil {
+				tracker.EXPECT().AddComment(mock.Anything, "ABC-1", mock.Anything).Return(nil)
+			}
+			tt.settle(outbox)
+
+			synced, err := service.Drain(context.Background())
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSynced, synced)
+		})
+	}
+}
+
+func TestTicketSyncDrain_SkipsRequestsClaimedElsewhere(t *testing.T) {
+	service, _, outbox := newTestTicketSyncService(t, &domain.Session{Name: "s1"}, portsmocks.NewMockTicketTracker(t))
+
+	outbox.EXPECT().ListTicketSyncs(mock.Anything, mock.Anything).Return([]domain.TicketSyncRequest{{Revision: 1, SessionName: "s1"}}, nil)
+	outbox.EXPECT().ClaimTicketSync(mock.Anything, "s1", mock.Anything).Return(false, nil)
+
+	synced, err := service.Drain(context.Background())
+
+	require.NoError(t, err)
+	assert.Zero(t, synced)
+}
+
+func TestFindIssue(t *testing.T) {
+	tracker := portsmocks.NewMockTicketTracker(t)
+	service, secretStore, _ := newTestTicketSyncService(t, &domain.Session{Name: "s1"}, tracker)
+
+	secretStore.EXPECT().GetSecret("rocha-jira", "me@example.com").Return("secret", nil)
+	tracker.EXPECT().TicketTitle(mock.Anything, "ABC-12").Return(" Users cannot log in ", nil)
+
+	key, title, err := service.FindIssue(context.Background(), "owner/repo", "feature/abc-12-login")
+
+	require.NoError(t, err)
+	assert.Equal(t, "ABC-12", key)
+	assert.Equal(t, "Users cannot log in", title)
+}
+
+func TestFindIssue_NoRuleOrKey(t *testing.T) {
+	service, _, _ := newTestTicketSyncService(t, &domain.Session{Name: "s1"}, portsmocks.NewMockTicketTracker(t))
+
+	key, title, err := service.FindIssue(context.Background(), "other/repo", "ABC-12-login")
+	require.NoError(t, err)
+	assert.Empty(t, key)
+	assert.Empty(t, title)
+
+	key, _, err = service.FindIssue(context.Background(), "owner/repo", "fix-login")
+	require.NoError(t, err)
+	assert.Empty(t, key)
+}
+
+func TestStoreToken_RejectsEmptyToken(t *testing.T) {
+	service, _, _ := newTestTicketSyncService(t, &domain.Session{Name: "s1"}, portsmocks.NewMockTicketTracker(t))
+
+	err := service.StoreToken(domain.TicketProviderLinear, DefaultTicketAccount, "  ")
+
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// timerSound is the sound event played when a session timer elapses (the bell)
+const timerSound = "timer"
+
+// TimerService sets countdown timers on sessions and announces them when they elapse
+type TimerService struct {
+	eventPublisher ports.EventPublisher
+	sessionRepo    ports.SessionRepository
+	soundPlayer    ports.SoundPlayer
+}
+
+// NewTimerService creates a new TimerService
+func NewTimerService(sessionRepo ports.SessionRepository, soundPlayer ports.SoundPlayer, eventPublisher ports.EventPublisher) *TimerService {
+	return &TimerService{
+		eventPublisher: eventPublisher,
+		sessionRepo:    sessionRepo,
+		soundPlayer:    soundPlayer,
+	}
+}
+
+// SetTimer starts a timer that elapses after d, replacing the session's current timer
+func (s *TimerService) SetTimer(ctx context.Context, name string, d time.Duration, label string) (*domain.SessionTimer, error) {
+	logging.Logger.Debug("Setting session timer", "name", name, "duration", d)
+
+	timer, err := domain.NewSessionTimer(d, label, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.sessionRepo.Get(ctx, name); err != nil {
+		return nil, err
+	}
+	if err := s.sessionRepo.UpdateTimer(ctx, name, timer); err != nil {
+		return nil, fmt.Errorf("failed to save timer: %w", err)
+	}
+	return timer, nil
+}
+
+// ClearTimer removes the session's timer, if it has one
+func (s *TimerService) ClearTimer(ctx context.Context, name string) error {
+	logging.Logger.Debug("Clearing session timer", "name", name)
+
+	if _, err := s.sessionRepo.Get(ctx, name); err != nil {
+		return err
+	}
+	if err := s.sessionRepo.UpdateTimer(ctx, name, nil); err != nil {
+		return fmt.Errorf("failed to clear timer: %w", err)
+	}
+	return nil
+}
+
+// Elapsed returns the sessions of the collection whose timer is due and not yet announced
+func (s *TimerService) Elapsed(state *domain.SessionCollection, now time.Time) []domain.Session {
+	var elapsed []domain.Session
+	for _, name := range state.OrderedNames {
+		session := state.Sessions[name]
+		if session.Timer != nil && !session.Timer.Notified && session.Timer.Elapsed(now) {
+			elapsed = append(elapsed, session)
+		}
+	}
+	return elapsed
+}
+
+// Alert plays the bell and sends a timer event to the webhook for each elapsed timer.
+// A timer is announced once, even when several TUIs are open or the TUI was closed when it elapsed.
+func (s *TimerService) Alert(ctx context.Context, sessions []domain.Session) error {
+	var errs []error
+	var announced int
+	for _, session := range sessions {
+		marked, err := s.sessionRepo.MarkTimerNotified(ctx, session.Name, session.Timer.DueAt)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !marked {
+			continue // Announced elsewhere, or replaced since it was loaded
+		}
+		announced++
+
+		logging.Logger.Info("Session timer elapsed", "session", session.Name, "label", session.Timer.Label)
+		event := domain.Event{Message: session.Timer.Label, SessionName: session.Name, Timestamp: time.Now(), Type: domain.EventTimer}
+		if err := s.eventPublisher.Publish(ctx, event); err != nil {
+			errs = append(errs, fmt.Errorf("failed to publish timer of '%s': %w", session.Name, err))
+		}
+	}
+
+	if announced > 0 {
+		if err := s.soundPlayer.PlaySoundForEvent(timerSound); err != nil {
+			errs = append(errs, fmt.Errorf("failed to play timer sound: %w", err))
+		}
+	}
+	return errors.Join(errs...)
+}
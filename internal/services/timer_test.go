@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestTimerService_SetTimer(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1"}, nil)
+	sessionRepo.EXPECT().UpdateTimer(mock.Anything, "s1", mock.MatchedBy(func(timer *domain.SessionTimer) bool {
+		return timer.Label == "check CI" && time.Until(timer.DueAt) > 19*time.Minute
+	})).Return(nil)
+	service := NewTimerService(sessionRepo, portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t))
+
+	timer, err := service.SetTimer(context.Background(), "s1", 20*time.Minute, "check CI")
+	require.NoError(t, err)
+	assert.Equal(t, "check CI", timer.Label)
+
+	_, err = service.SetTimer(context.Background(), "s1", -time.Minute, "")
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestTimerService_Elapsed(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	state := &domain.SessionCollection{
+		OrderedNames: []string{"due", "running", "announced", "none"},
+		Sessions: map[string]domain.Session{
+			"due":       {Name: "due", Timer: &domain.SessionTimer{DueAt: now.Add(-time.Minute)}},
+			"running":   {Name: "running", Timer: &domain.SessionTimer{DueAt: now.Add(time.Minute)}},
+			"announced": {Name: "announced", Timer: &domain.SessionTimer{DueAt: now.Add(-time.Hour), Notified: true}},
+			"none":      {Name: "none"},
+		},
+	}
+	service := NewTimerService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t))
+
+	elapsed := service.Elapsed(state, now)
+	require.Len(t, elapsed, 1)
+	assert.Equal(t, "due", elapsed[0].Name)
+}
+
+func TestTimerService_AlertAnnouncesEachTimerOnce(t *testing.T) {
+	dueAt := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	sessions := []domain.Session{
+		{Name: "mine", Timer: &domain.SessionTimer{DueAt: dueAt, Label: "check CI"}},
+		{Name: "theirs", Timer: &domain.SessionTimer{DueAt: dueAt}},
+	}
+
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().MarkTimerNotified(mock.Anything, "mine", dueAt).Return(true, nil)
+	sessionRepo.EXPECT().MarkTimerNotified(mock.Anything, "theirs", dueAt).Return(false, nil) // Another TUI got there first
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	soundPlayer.EXPECT().PlaySoundForEvent("timer").Return(nil).Once()
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Type == domain.EventTimer && event.SessionName == "mine" && event.Message == "check CI"
+	})).Return(nil).Once()
+
+	service := NewTimerService(sessionRepo, soundPlayer, eventPublisher)
+
+	require.NoError(t, service.Alert(context.Background(), sessions))
+}
@@ -0,0 +1,197 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// tokenBudgetSound is the sound event played when a session exceeds its token budget (the bell)
+const tokenBudgetSound = "token_budget"
+
+// DefaultWrapUpPrompt is sent to agents that exceed a token budget with wrap-up enabled
+const DefaultWrapUpPrompt = "You have used the token budget of this session. Please wrap up: " +
+	"stop starting new work, then summarize what you did, what is left, and any open questions."
+
+// TokenBudgetService caps the tokens a session may use. When a session exceeds its
+// budget it is flagged and announced, and its agent is optionally asked to wrap up.
+type TokenBudgetService struct {
+	eventPublisher   ports.EventPublisher
+	schedulerService *SchedulerService
+	sessionRepo      ports.SessionRepository
+	soundPlayer      ports.SoundPlayer
+	usageReader      ports.TokenUsageReader
+	wrapUpPrompt     string
+}
+
+// NewTokenBudgetService creates a new TokenBudgetService
+// An empty wrapUpPrompt uses DefaultWrapUpPrompt.
+func NewTokenBudgetService(
+	sessionRepo ports.SessionRepository,
+	usageReader ports.TokenUsageReader,
+	schedulerService *SchedulerService,
+	soundPlayer ports.SoundPlayer,
+	eventPublisher ports.EventPublisher,
+	wrapUpPrompt string,
+) *TokenBudgetService {
+	if wrapUpPrompt == "" {
+		wrapUpPrompt = DefaultWrapUpPrompt
+	}
+	return &TokenBudgetService{
+		eventPublisher:   eventPublisher,
+		schedulerService: schedulerService,
+		sessionRepo:      sessionRepo,
+		soundPlayer:      soundPlayer,
+		usageReader:      usageReader,
+		wrapUpPrompt:     wrapUpPrompt,
+	}
+}
+
+// SetBudget caps the session at limit tokens, replacing its current budget.
+// The tokens already used count against the new budget, which is enforced at the next check.
+func (s *TokenBudgetService) SetBudget(ctx context.Context, name string, limit int, wrapUp bool) (*domain.SessionTokenBudget, error) {
+	logging.Logger.Debug("Setting session token budget", "name", name, "limit", limit, "wrap_up", wrapUp)
+
+	budget, err := domain.NewSessionTokenBudget(limit, wrapUp)
+	if err != nil {
+		return nil, err
+	}
+	session, err := s.sessionRepo.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	budget.Used = s.sessionUsage(*session)
+	if err := s.sessionRepo.UpdateTokenBudget(ctx, name, budget); err != nil {
+		return nil, fmt.Errorf("failed to save token budget: %w", err)
+	}
+	return budget, nil
+}
+
+// ClearBudget removes the session's token budget, if it has one
+func (s *TokenBudgetService) ClearBudget(ctx context.Context, name string) error {
+	logging.Logger.Debug("Clearing session token budget", "name", name)
+
+	if _, err := s.sessionRepo.Get(ctx, name); err != nil {
+		return err
+	}
+	if err := s.sessionRepo.UpdateTokenBudget(ctx, name, nil); err != nil {
+		return fmt.Errorf("failed to clear token budget: %w", err)
+	}
+	return nil
+}
+
+// HasPending reports whether a session of the collection has a budget still to enforce
+func (s *TokenBudgetService) HasPending(state *domain.SessionCollection) bool {
+	for _, session := range state.Sessions {
+		if session.TokenBudget != nil && !session.TokenBudget.Exceeded {
+			return true
+		}
+	}
+	return false
+}
+
+// Check refreshes the token usage of the sessions with a budget and enforces the
+// budgets just exceeded. Returns the names of the sessions whose budget was enforced.
+// A budget is enforced once, even when several TUIs and the scheduler check it.
+func (s *TokenBudgetService) Check(ctx context.Context) ([]string, error) {
+	sessions, err := s.sessionRepo.List(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var errs []error
+	var enforced []string
+	for _, session := range sessions {
+		budget := session.TokenBudget
+		if budget == nil || budget.Exceeded {
+			continue
+		}
+
+		used := s.sessionUsage(session)
+		if used != budget.Used {
+			if err := s.sessionRepo.UpdateTokenBudgetUsage(ctx, session.Name, used); err != nil {
+				errs = append(errs, fmt.Errorf("failed to save token usage of '%s': %w", session.Name, err))
+				continue
+			}
+			budget.Used = used
+		}
+		if !budget.Reached() {
+			continue
+		}
+
+		marked, err := s.sessionRepo.MarkTokenBudgetExceeded(ctx, session.Name, budget.Limit)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !marked {
+			continue // Enforced elsewhere, or replaced since it was loaded
+		}
+		enforced = append(enforced, session.Name)
+
+		if err := s.enforce(ctx, session); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(enforced) > 0 {
+		if err := s.soundPlayer.PlaySoundForEvent(tokenBudgetSound); err != nil {
+			errs = append(errs, fmt.Errorf("failed to play token budget sound: %w", err))
+		}
+	}
+	return enforced, errors.Join(errs...)
+}
+
+// enforce flags a session that exceeded its budget, announces it, and queues the
+// wrap-up prompt when the budget asks for it
+func (s *TokenBudgetService) enforce(ctx context.Context, session domain.Session) error {
+	budget := session.TokenBudget
+	message := fmt.Sprintf("Used %s of %s tokens", domain.FormatTokenCount(budget.Used), domain.FormatTokenCount(budget.Limit))
+	logging.Logger.Info("Session exceeded its token budget", "session", session.Name, "used", budget.Used, "limit", budget.Limit)
+
+	var errs []error
+	if !session.IsFlagged {
+		if err := s.sessionRepo.ToggleFlag(ctx, session.Name); err != nil {
+			errs = append(errs, fmt.Errorf("failed to flag '%s': %w", session.Name, err))
+		}
+	}
+
+	event := domain.Event{Message: message, SessionName: session.Name, Timestamp: time.Now(), Type: domain.EventTokenBudget}
+	if err := s.eventPublisher.Publish(ctx, event); err != nil {
+		errs = append(errs, fmt.Errorf("failed to publish token budget of '%s': %w", session.Name, err))
+	}
+
+	// Queued rather than sent, so it waits for the multiplexer session and the concurrency limit
+	if budget.WrapUp {
+		if _, err := s.schedulerService.Schedule(ctx, session.Name, s.wrapUpPrompt, false, time.Now()); err != nil {
+			errs = append(errs, fmt.Errorf("failed to queue wrap-up prompt for '%s': %w", session.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sessionUsage returns the input and output tokens used by the agent of a session.
+// Conversations that cannot be read count as no usage.
+func (s *TokenBudgetService) sessionUsage(session domain.Session) int {
+	if session.WorkingDir() == "" && session.ClaudeSessionID == "" {
+		return 0
+	}
+
+	claudeDir := config.DefaultClaudeDir()
+	if session.ClaudeDir != "" {
+		claudeDir = config.ExpandPath(session.ClaudeDir)
+	}
+
+	totals, err := s.usageReader.GetSessionUsage(claudeDir, session.StartDir(), session.ClaudeSessionID)
+	if err != nil {
+		logging.Logger.Debug("Failed to read session token usage", "session", session.Name, "error", err)
+		return 0
+	}
+	return totals.InputTokens + totals.OutputTokens
+}
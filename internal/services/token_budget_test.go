@@ -0,0 +1,77 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestTokenBudgetService_SetBudget(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1", WorktreePath: "/src/api"}, nil)
+	sessionRepo.EXPECT().UpdateTokenBudget(mock.Anything, "s1", &domain.SessionTokenBudget{Limit: 200_000, Used: 1_500, WrapUp: true}).Return(nil)
+	usageReader := portsmocks.NewMockTokenUsageReader(t)
+	usageReader.EXPECT().GetSessionUsage(mock.Anything, "/src/api", "").Return(ports.TokenTotals{CacheRead: 90_000, InputTokens: 500, OutputTokens: 1_000}, nil)
+	service := NewTokenBudgetService(sessionRepo, usageReader, nil, portsmocks.NewMockSoundPlayer(t), newMockEventPublisher(t), "")
+
+	budget, err := service.SetBudget(context.Background(), "s1", 200_000, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1_500, budget.Used, "cache reads do not count")
+
+	_, err = service.SetBudget(context.Background(), "s1", 0, false)
+	assert.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestTokenBudgetService_CheckEnforcesEachBudgetOnce(t *testing.T) {
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().List(mock.Anything, false).Return([]domain.Session{
+		{Name: "over", WorktreePath: "/src/over", TokenBudget: &domain.SessionTokenBudget{Limit: 100_000, Used: 90_000, WrapUp: true}},
+		{Name: "flagged", IsFlagged: true, WorktreePath: "/src/flagged", TokenBudget: &domain.SessionTokenBudget{Limit: 100_000, Used: 100_000}},
+		{Name: "theirs", WorktreePath: "/src/theirs", TokenBudget: &domain.SessionTokenBudget{Limit: 100_000, Used: 100_000}},
+		{Name: "under", WorktreePath: "/src/under", TokenBudget: &domain.SessionTokenBudget{Limit: 100_000, Used: 10_000}},
+		{Name: "enforced", TokenBudget: &domain.SessionTokenBudget{Exceeded: true, Limit: 100_000, Used: 150_000}},
+		{Name: "none"},
+	}, nil)
+	usageReader := portsmocks.NewMockTokenUsageReader(t)
+	usageReader.EXPECT().GetSessionUsage(mock.Anything, "/src/over", "").Return(ports.TokenTotals{InputTokens: 20_000, OutputTokens: 85_000}, nil)
+	usageReader.EXPECT().GetSessionUsage(mock.Anything, "/src/flagged", "").Return(ports.TokenTotals{OutputTokens: 100_000}, nil)
+	usageReader.EXPECT().GetSessionUsage(mock.Anything, "/src/theirs", "").Return(ports.TokenTotals{OutputTokens: 100_000}, nil)
+	usageReader.EXPECT().GetSessionUsage(mock.Anything, "/src/under", "").Return(ports.TokenTotals{OutputTokens: 20_000}, nil)
+
+	sessionRepo.EXPECT().UpdateTokenBudgetUsage(mock.Anything, "over", 105_000).Return(nil)
+	sessionRepo.EXPECT().UpdateTokenBudgetUsage(mock.Anything, "under", 20_000).Return(nil)
+	sessionRepo.EXPECT().MarkTokenBudgetExceeded(mock.Anything, "over", 100_000).Return(true, nil)
+	sessionRepo.EXPECT().MarkTokenBudgetExceeded(mock.Anything, "flagged", 100_000).Return(true, nil)
+	sessionRepo.EXPECT().MarkTokenBudgetExceeded(mock.Anything, "theirs", 100_000).Return(false, nil) // The scheduler got there first
+	sessionRepo.EXPECT().ToggleFlag(mock.Anything, "over").Return(nil).Once()
+
+	eventPublisher := portsmocks.NewMockEventPublisher(t)
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Type == domain.EventTokenBudget && event.SessionName == "over" && event.Message == "Used 105K of 100K tokens"
+	})).Return(nil).Once()
+	eventPublisher.EXPECT().Publish(mock.Anything, mock.MatchedBy(func(event domain.Event) bool {
+		return event.Type == domain.EventTokenBudget && event.SessionName == "flagged"
+	})).Return(nil).Once()
+	soundPlayer := portsmocks.NewMockSoundPlayer(t)
+	soundPlayer.EXPECT().PlaySoundForEvent("token_budget").Return(nil).Once()
+
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	promptRepo.EXPECT().AddScheduledPrompt(mock.Anything, mock.MatchedBy(func(prompt domain.ScheduledPrompt) bool {
+		return prompt.SessionName == "over" && prompt.Text == "Wrap it up"
+	})).Return(&domain.ScheduledPrompt{ID: 1}, nil).Once()
+	sessionRepo.EXPECT().Get(mock.Anything, "over").Return(&domain.Session{Name: "over"}, nil)
+	scheduler := NewSchedulerService(promptRepo, portsmocks.NewMockPromptHistoryRepository(t), sessionRepo, portsmocks.NewMockSessionManager(t), domain.ConcurrencyLimit{})
+
+	service := NewTokenBudgetService(sessionRepo, usageReader, scheduler, soundPlayer, eventPublisher, "Wrap it up")
+
+	enforced, err := service.Check(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"over", "flagged"}, enforced)
+}
@@ -102,13 +102,14 @@ func (s *TokenStatsService) refreshCache() error {
 	for _, u := range usage {
 		hour := u.Timestamp.Hour()
 		if _, exists := hourlyMap[hour]; !exists {
-			hourlyMap[hour] = &ports.HourlyTokenUsage{Hour: hour}
+			hourlyMap[hour] = &ports.HourlyTokenUsage{Hour: hour, Models: make(map[string]ports.TokenTotals)}
 		}
 
 		hourlyMap[hour].CacheCreation += u.CacheCreation
 		hourlyMap[hour].CacheRead += u.CacheRead
 		hourlyMap[hour].InputTokens += u.InputTokens
 		hourlyMap[hour].OutputTokens += u.OutputTokens
+		addModelUsage(hourlyMap[hour].Models, u)
 
 		totals.CacheCreation += u.CacheCreation
 		totals.CacheRead += u.CacheRead
@@ -137,3 +138,19 @@ func (s *TokenStatsService) refreshCache() error {
 
 	return nil
 }
+
+// addModelUsage adds a message's tokens to the totals of its model.
+// Messages without a model are counted under "unknown".
+func addModelUsage(models map[string]ports.TokenTotals, u ports.TokenUsage) {
+	model := u.Model
+	if model == "" {
+		model = "unknown"
+	}
+
+	totals := models[model]
+	totals.CacheCreation += u.CacheCreation
+	totals.CacheRead += u.CacheRead
+	totals.InputTokens += u.InputTokens
+	totals.OutputTokens += u.OutputTokens
+	models[model] = totals
+}
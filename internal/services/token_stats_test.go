@@ -99,6 +99,31 @@ func TestGetTodayHourlyUsage_HourlyAggregation(t *testing.T) {
 	assert.Equal(t, 75, hourly[1].OutputTokens)
 }
 
+func TestGetTodayHourlyUsage_ModelBreakdown(t *testing.T) {
+	reader := portsmocks.NewMockTokenUsageReader(t)
+
+	baseTime := time.Date(2025, 1, 15, 9, 0, 0, 0, time.Local)
+	usage := []ports.TokenUsage{
+		{InputTokens: 100, OutputTokens: 50, CacheRead: 1000, Model: "claude-opus-4-1-20250805", Timestamp: baseTime},
+		{InputTokens: 200, OutputTokens: 100, CacheRead: 2000, Model: "claude-opus-4-1-20250805", Timestamp: baseTime.Add(10 * time.Minute)},
+		{InputTokens: 10, OutputTokens: 5, Model: "claude-haiku-4-5-20251001", Timestamp: baseTime.Add(20 * time.Minute)},
+		{InputTokens: 1, Timestamp: baseTime.Add(30 * time.Minute)},
+	}
+	reader.EXPECT().GetTodayUsage().Return(usage, nil)
+
+	service := NewTokenStatsService(reader)
+
+	hourly, err := service.GetTodayHourlyUsage()
+
+	require.NoError(t, err)
+	require.Len(t, hourly, 1)
+	assert.Equal(t, map[string]ports.TokenTotals{
+		"claude-opus-4-1-20250805":  {InputTokens: 300, OutputTokens: 150, CacheRead: 3000},
+		"claude-haiku-4-5-20251001": {InputTokens: 10, OutputTokens: 5},
+		"unknown":                   {InputTokens: 1},
+	}, hourly[0].Models)
+}
+
 func TestGetTodayHourlyUsage_SortedByHour(t *testing.T) {
 	reader := portsmocks.NewMockTokenUsageReader(t)
 
@@ -0,0 +1,64 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// DefaultToolUseLimit is how many tool uses are shown when no limit is given
+const DefaultToolUseLimit = 200
+
+// ToolAuditService keeps an audit trail of the tools run by sessions that skip permission prompts
+type ToolAuditService struct {
+	sessionReader ports.SessionReader
+	toolUseRepo   ports.ToolUseRepository
+}
+
+// NewToolAuditService creates a new ToolAuditService
+func NewToolAuditService(sessionReader ports.SessionReader, toolUseRepo ports.ToolUseRepository) *ToolAuditService {
+	return &ToolAuditService{
+		sessionReader: sessionReader,
+		toolUseRepo:   toolUseRepo,
+	}
+}
+
+// RecordToolUse stores a tool call reported by a hook.
+// Calls from sessions that still ask for permission are not recorded.
+func (s *ToolAuditService) RecordToolUse(ctx context.Context, sessionName, toolName string, toolInput json.RawMessage, failed bool) error {
+	if toolName == "" {
+		return nil
+	}
+
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return err
+	}
+	if !session.AllowDangerouslySkipPermissions {
+		logging.Logger.Debug("Skipping tool audit for session that asks for permissions", "session", sessionName, "tool", toolName)
+		return nil
+	}
+
+	toolUse := domain.NewToolUse(sessionName, toolName, toolInput, failed, time.Now())
+	logging.Logger.Info("Auditing tool use", "session", sessionName, "tool", toolName, "summary", toolUse.Summary, "failed", failed)
+	if err := s.toolUseRepo.AddToolUse(ctx, toolUse); err != nil {
+		return fmt.Errorf("failed to record tool use: %w", err)
+	}
+	return nil
+}
+
+// ListToolUses returns the most recent audited tool uses of a session, newest first
+func (s *ToolAuditService) ListToolUses(ctx context.Context, sessionName string, limit int) ([]domain.ToolUse, error) {
+	if limit < 1 {
+		return nil, fmt.Errorf("limit must be at least 1: %w", domain.ErrInvalidInput)
+	}
+	if _, err := s.sessionReader.Get(ctx, sessionName); err != nil {
+		return nil, err
+	}
+	return s.toolUseRepo.ListToolUses(ctx, sessionName, limit)
+}
@@ -0,0 +1,90 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestRecordToolUse(t *testing.T) {
+	tests := []struct {
+		name       string
+		session    *domain.Session
+		toolName   string
+		wantRecord bool
+	}{
+		{
+			name:       "records tools of sessions that skip permissions",
+			session:    &domain.Session{AllowDangerouslySkipPermissions: true, Name: "s1"},
+			toolName:   "Bash",
+			wantRecord: true,
+		},
+		{
+			name:       "ignores sessions that ask for permissions",
+			session:    &domain.Session{Name: "s1"},
+			toolName:   "Bash",
+			wantRecord: false,
+		},
+		{
+			name:       "ignores payloads without a tool",
+			toolName:   "",
+			wantRecord: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionReader := portsmocks.NewMockSessionReader(t)
+			toolUseRepo := portsmocks.NewMockToolUseRepository(t)
+			if tt.session != nil {
+				sessionReader.EXPECT().Get(mock.Anything, "s1").Return(tt.session, nil)
+			}
+			if tt.wantRecord {
+				toolUseRepo.EXPECT().AddToolUse(mock.Anything, mock.MatchedBy(func(toolUse domain.ToolUse) bool {
+					return toolUse.SessionName == "s1" && toolUse.ToolName == "Bash" && toolUse.Summary == "rm -rf build" && toolUse.Failed
+				})).Return(nil)
+			}
+
+			service := NewToolAuditService(sessionReader, toolUseRepo)
+			err := service.RecordToolUse(context.Background(), "s1", tt.toolName, json.RawMessage(`{"command":"rm -rf build"}`), true)
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestListToolUses(t *testing.T) {
+	t.Run("returns tool uses of an existing session", func(t *testing.T) {
+		sessionReader := portsmocks.NewMockSessionReader(t)
+		toolUseRepo := portsmocks.NewMockToolUseRepository(t)
+		sessionReader.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1"}, nil)
+		toolUseRepo.EXPECT().ListToolUses(mock.Anything, "s1", 10).Return([]domain.ToolUse{{ToolName: "Bash"}}, nil)
+
+		toolUses, err := NewToolAuditService(sessionReader, toolUseRepo).ListToolUses(context.Background(), "s1", 10)
+
+		require.NoError(t, err)
+		assert.Len(t, toolUses, 1)
+	})
+
+	t.Run("unknown session", func(t *testing.T) {
+		sessionReader := portsmocks.NewMockSessionReader(t)
+		sessionReader.EXPECT().Get(mock.Anything, "nope").Return(nil, domain.ErrSessionNotFound)
+
+		_, err := NewToolAuditService(sessionReader, portsmocks.NewMockToolUseRepository(t)).ListToolUses(context.Background(), "nope", 10)
+
+		require.ErrorIs(t, err, domain.ErrSessionNotFound)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		_, err := NewToolAuditService(portsmocks.NewMockSessionReader(t), portsmocks.NewMockToolUseRepository(t)).ListToolUses(context.Background(), "s1", 0)
+
+		require.ErrorIs(t, err, domain.ErrInvalidInput)
+	})
+}
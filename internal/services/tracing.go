@@ -0,0 +1,24 @@
+package services
+
+import (
+	"context"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// noopTracer is the tracer of services until tracing is enabled
+type noopTracer struct{}
+
+// Start implements ports.Tracer.Start
+func (noopTracer) Start(ctx context.Context, _ string) (context.Context, ports.Span) {
+	return ctx, noopSpan{}
+}
+
+// noopSpan is the span of noopTracer
+type noopSpan struct{}
+
+// SetAttribute implements ports.Span.SetAttribute
+func (noopSpan) SetAttribute(string, any) {}
+
+// End implements ports.Span.End
+func (noopSpan) End(error) {}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// TranscriptService exports the Claude conversations of a session as a readable transcript
+type TranscriptService struct {
+	sessionReader    ports.SessionReader
+	transcriptReader ports.TranscriptReader
+	transcriptsDir   string // Where saved transcripts are kept next to the session archive
+}
+
+// NewTranscriptService creates a new TranscriptService
+func NewTranscriptService(sessionReader ports.SessionReader, transcriptReader ports.TranscriptReader, transcriptsDir string) *TranscriptService {
+	return &TranscriptService{
+		sessionReader:    sessionReader,
+		transcriptReader: transcriptReader,
+		transcriptsDir:   transcriptsDir,
+	}
+}
+
+// GetTranscript reads the conversations Claude recorded for the session's working directory
+// and its ClaudeDir. Returns ErrTranscriptNotFound if Claude has not recorded any.
+func (s *TranscriptService) GetTranscript(ctx context.Context, sessionName string) (*domain.Transcript, error) {
+	session, err := s.sessionReader.Get(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	claudeDir := config.DefaultClaudeDir()
+	if session.ClaudeDir != "" {
+		claudeDir = config.ExpandPath(session.ClaudeDir)
+	}
+
+	logging.Logger.Debug("Reading transcript", "session", sessionName, "claude_dir", claudeDir, "working_dir", session.StartDir())
+	entries, err := s.transcriptReader.ReadTranscript(claudeDir, session.StartDir(), session.ClaudeSessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read conversation files: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: no Claude conversations recorded for session '%s' in %s", domain.ErrTranscriptNotFound, sessionName, claudeDir)
+	}
+
+	return &domain.Transcript{Entries: entries, SessionName: sessionName}, nil
+}
+
+// ExportTranscript renders the session transcript in the given format
+func (s *TranscriptService) ExportTranscript(ctx context.Context, sessionName string, format domain.TranscriptFormat) ([]byte, error) {
+	transcript, err := s.GetTranscript(ctx, sessionName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case domain.TranscriptMarkdown:
+		return []byte(transcript.Markdown()), nil
+	case domain.TranscriptJSON:
+		data, err := json.MarshalIndent(transcript, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		return append(data, '\n'), nil
+	default:
+		return nil, fmt.Errorf("%w: unknown transcript format '%s' (use md or json)", domain.ErrInvalidInput, format)
+	}
+}
+
+// SaveTranscript exports the session transcript into the transcripts directory so it
+// stays available after the session is archived or its worktree is removed.
+// Returns the path of the written file.
+func (s *TranscriptService) SaveTranscript(ctx context.Context, sessionName string, format domain.TranscriptFormat) (string, error) {
+	data, err := s.ExportTranscript(ctx, sessionName, format)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(s.transcriptsDir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create transcripts directory: %w", err)
+	}
+	path := filepath.Join(s.transcriptsDir, sessionName+"."+string(format))
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("failed to write transcript: %w", err)
+	}
+
+	logging.Logger.Info("Saved transcript", "session", sessionName, "path", path)
+	return path, nil
+}
@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestExportTranscript(t *testing.T) {
+	session := &domain.Session{ClaudeDir: "/claude", ClaudeSessionID: "c1", Name: "s1", WorktreePath: "/wt/s1"}
+	entries := []domain.TranscriptEntry{
+		{ConversationID: "c1", Role: domain.TranscriptUser, Text: "Fix the bug", Timestamp: time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)},
+		{ConversationID: "c1", Role: domain.TranscriptTool, Text: "Bash: go test ./...", Timestamp: time.Date(2026, 1, 2, 10, 0, 5, 0, time.UTC)},
+	}
+
+	tests := []struct {
+		name     string
+		format   domain.TranscriptFormat
+		validate func(t *testing.T, data []byte)
+	}{
+		{
+			name:   "markdown",
+			format: domain.TranscriptMarkdown,
+			validate: func(t *testing.T, data []byte) {
+				assert.Contains(t, string(data), "# Transcript: s1")
+				assert.Contains(t, string(data), "Fix the bug")
+				assert.Contains(t, string(data), "Bash: go test ./...")
+			},
+		},
+		{
+			name:   "json",
+			format: domain.TranscriptJSON,
+			validate: func(t *testing.T, data []byte) {
+				var transcript domain.Transcript
+				require.NoError(t, json.Unmarshal(data, &transcript))
+				assert.Equal(t, "s1", transcript.SessionName)
+				assert.Len(t, transcript.Entries, 2)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sessionReader := portsmocks.NewMockSessionReader(t)
+			transcriptReader := portsmocks.NewMockTranscriptReader(t)
+			sessionReader.EXPECT().Get(context.Background(), "s1").Return(session, nil)
+			transcriptReader.EXPECT().ReadTranscript("/claude", "/wt/s1", "c1").Return(entries, nil)
+
+			service := NewTranscriptService(sessionReader, transcriptReader, t.TempDir())
+			data, err := service.ExportTranscript(context.Background(), "s1", tt.format)
+
+			require.NoError(t, err)
+			tt.validate(t, data)
+		})
+	}
+}
+
+func TestExportTranscript_NoConversations(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	transcriptReader := portsmocks.NewMockTranscriptReader(t)
+	sessionReader.EXPECT().Get(context.Background(), "s1").Return(&domain.Session{ClaudeDir: "/claude", IsExternal: true, Name: "s1", RepoPath: "/repo"}, nil)
+	transcriptReader.EXPECT().ReadTranscript("/claude", "/repo", "").Return(nil, nil)
+
+	service := NewTranscriptService(sessionReader, transcriptReader, t.TempDir())
+	_, err := service.ExportTranscript(context.Background(), "s1", domain.TranscriptMarkdown)
+
+	require.ErrorIs(t, err, domain.ErrTranscriptNotFound)
+}
+
+func TestSaveTranscript(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	transcriptReader := portsmocks.NewMockTranscriptReader(t)
+	sessionReader.EXPECT().Get(context.Background(), "s1").Return(&domain.Session{ClaudeDir: "/claude", Name: "s1", WorktreePath: "/wt/s1"}, nil)
+	transcriptReader.EXPECT().ReadTranscript("/claude", "/wt/s1", "").
+		Return([]domain.TranscriptEntry{{ConversationID: "c1", Role: domain.TranscriptUser, Text: "hi"}}, nil)
+
+	dir := filepath.Join(t.TempDir(), "transcripts")
+	service := NewTranscriptService(sessionReader, transcriptReader, dir)
+	path, err := service.SaveTranscript(context.Background(), "s1", domain.TranscriptMarkdown)
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "s1.md"), path)
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "hi")
+}
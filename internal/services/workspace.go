@@ -0,0 +1,158 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// WorkspaceService groups sessions into named workspaces
+type WorkspaceService struct {
+	sessionReader ports.SessionReader
+	workspaceRepo ports.WorkspaceRepository
+}
+
+// NewWorkspaceService creates a new WorkspaceService
+func NewWorkspaceService(
+	workspaceRepo ports.WorkspaceRepository,
+	sessionReader ports.SessionReader,
+) *WorkspaceService {
+	return &WorkspaceService{
+		sessionReader: sessionReader,
+		workspaceRepo: workspaceRepo,
+	}
+}
+
+// CreateWorkspace creates a workspace, optionally with its first sessions
+func (s *WorkspaceService) CreateWorkspace(ctx context.Context, name string, sessionNames []string) (*domain.Workspace, error) {
+	name, err := domain.NormalizeWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkSessionsExist(ctx, sessionNames); err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Info("Creating workspace", "workspace", name, "sessions", sessionNames)
+	if err := s.workspaceRepo.CreateWorkspace(ctx, name); err != nil {
+		return nil, err
+	}
+	if err := s.workspaceRepo.AddWorkspaceSessions(ctx, name, sessionNames); err != nil {
+		return nil, err
+	}
+	return s.workspaceRepo.GetWorkspace(ctx, name)
+}
+
+// DeleteWorkspace deletes a workspace; its sessions are kept
+func (s *WorkspaceService) DeleteWorkspace(ctx context.Context, name string) error {
+	name, err := domain.NormalizeWorkspaceName(name)
+	if err != nil {
+		return err
+	}
+
+	logging.Logger.Info("Deleting workspace", "workspace", name)
+	return s.workspaceRepo.DeleteWorkspace(ctx, name)
+}
+
+// GetWorkspace returns a workspace with its sessions
+func (s *WorkspaceService) GetWorkspace(ctx context.Context, name string) (*domain.Workspace, error) {
+	name, err := domain.NormalizeWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+	return s.workspaceRepo.GetWorkspace(ctx, name)
+}
+
+// ListWorkspaces returns all workspaces, by name
+func (s *WorkspaceService) ListWorkspaces(ctx context.Context) ([]domain.Workspace, error) {
+	return s.workspaceRepo.ListWorkspaces(ctx)
+}
+
+// AddSessions adds sessions to a workspace
+func (s *WorkspaceService) AddSessions(ctx context.Context, name string, sessionNames []string) (*domain.Workspace, error) {
+	name, err := domain.NormalizeWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkSessionsExist(ctx, sessionNames); err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Info("Adding sessions to workspace", "workspace", name, "sessions", sessionNames)
+	if err := s.workspaceRepo.AddWorkspaceSessions(ctx, name, sessionNames); err != nil {
+		return nil, err
+	}
+	return s.workspaceRepo.GetWorkspace(ctx, name)
+}
+
+// RemoveSessions removes sessions from a workspace; the sessions themselves are kept
+func (s *WorkspaceService) RemoveSessions(ctx context.Context, name string, sessionNames []string) (*domain.Workspace, error) {
+	name, err := domain.NormalizeWorkspaceName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	logging.Logger.Info("Removing sessions from workspace", "workspace", name, "sessions", sessionNames)
+	if err := s.workspaceRepo.RemoveWorkspaceSessions(ctx, name, sessionNames); err != nil {
+		return nil, err
+	}
+	return s.workspaceRepo.GetWorkspace(ctx, name)
+}
+
+// Report summarizes the state and status of every session in a workspace
+func (s *WorkspaceService) Report(ctx context.Context, name string) (*WorkspaceReport, error) {
+	workspace, err := s.GetWorkspace(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions, err := s.sessionReader.List(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	report := &WorkspaceReport{
+		ByState: map[domain.SessionState]int{
+			domain.StateExited:  0,
+			domain.StateIdle:    0,
+			domain.StatePaused:  0,
+			domain.StateWaiting: 0,
+			domain.StateWorking: 0,
+		},
+		ByStatus:  make(map[string]int),
+		Workspace: *workspace,
+	}
+	for _, sess := range (domain.SessionFilter{Workspace: workspace.Name}).Apply(sessions, time.Now()) {
+		if sess.IsArchived {
+			report.Archived++
+			continue
+		}
+		report.ByState[sess.State]++
+		status := ""
+		if sess.Status != nil {
+			status = *sess.Status
+		}
+		report.ByStatus[status]++
+		report.Sessions = append(report.Sessions, sess)
+	}
+	slices.SortFunc(report.Sessions, func(a, b domain.Session) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return report, nil
+}
+
+// checkSessionsExist returns domain.ErrSessionNotFound for the first unknown session
+func (s *WorkspaceService) checkSessionsExist(ctx context.Context, sessionNames []string) error {
+	for _, sessionName := range sessionNames {
+		if _, err := s.sessionReader.Get(ctx, sessionName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package services
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestCreateWorkspace(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	workspaceRepo := portsmocks.NewMockWorkspaceRepository(t)
+
+	sessionReader.EXPECT().Get(mock.Anything, "s1").Return(&domain.Session{Name: "s1"}, nil)
+	workspaceRepo.EXPECT().CreateWorkspace(mock.Anything, "release-1.4").Return(nil)
+	workspaceRepo.EXPECT().AddWorkspaceSessions(mock.Anything, "release-1.4", []string{"s1"}).Return(nil)
+	workspaceRepo.EXPECT().GetWorkspace(mock.Anything, "release-1.4").
+		Return(&domain.Workspace{Name: "release-1.4", Sessions: []string{"s1"}}, nil)
+
+	service := NewWorkspaceService(workspaceRepo, sessionReader)
+	workspace, err := service.CreateWorkspace(context.Background(), "Release-1.4", []string{"s1"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"s1"}, workspace.Sessions)
+}
+
+func TestCreateWorkspace_UnknownSession(t *testing.T) {
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	sessionReader.EXPECT().Get(mock.Anything, "missing").Return(nil, domain.ErrSessionNotFound)
+
+	// Nothing is created when a session does not exist
+	service := NewWorkspaceService(portsmocks.NewMockWorkspaceRepository(t), sessionReader)
+	_, err := service.CreateWorkspace(context.Background(), "release-1.4", []string{"missing"})
+
+	require.ErrorIs(t, err, domain.ErrSessionNotFound)
+}
+
+func TestCreateWorkspace_InvalidName(t *testing.T) {
+	service := NewWorkspaceService(portsmocks.NewMockWorkspaceRepository(t), portsmocks.NewMockSessionReader(t))
+	_, err := service.CreateWorkspace(context.Background(), "client acme", nil)
+
+	require.ErrorIs(t, err, domain.ErrInvalidInput)
+}
+
+func TestWorkspaceReport(t *testing.T) {
+	review := "review"
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	workspaceRepo := portsmocks.NewMockWorkspaceRepository(t)
+
+	workspaceRepo.EXPECT().GetWorkspace(mock.Anything, "release-1.4").
+		Return(&domain.Workspace{Name: "release-1.4", Sessions: []string{"a", "b", "c"}}, nil)
+	sessionReader.EXPECT().List(mock.Anything, true).Return([]domain.Session{
+		{Name: "c", State: domain.StateWaiting, Status: &review, Workspaces: []string{"release-1.4"}},
+		{Name: "other", State: domain.StateWorking},
+		{Name: "a", State: domain.StateIdle, Workspaces: []string{"client-acme", "release-1.4"}},
+		{Name: "b", IsArchived: true, State: domain.StateExited, Workspaces: []string{"release-1.4"}},
+	}, nil)
+
+	service := NewWorkspaceService(workspaceRepo, sessionReader)
+	report, err := service.Report(context.Background(), "release-1.4")
+
+	require.NoError(t, err)
+	require.Len(t, report.Sessions, 2)
+	assert.Equal(t, "a", report.Sessions[0].Name)
+	assert.Equal(t, "c", report.Sessions[1].Name)
+	assert.Equal(t, 1, report.Archived)
+	assert.Equal(t, 1, report.ByState[domain.StateIdle])
+	assert.Equal(t, 1, report.ByState[domain.StateWaiting])
+	assert.Equal(t, 0, report.ByState[domain.StateWorking])
+	assert.Equal(t, map[string]int{"": 1, "review": 1}, report.ByStatus)
+}
@@ -0,0 +1,143 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"maps"
+	"path/filepath"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// BootstrapStepTimeout bounds how long a single bootstrap step may run
+const BootstrapStepTimeout = 10 * time.Minute
+
+// envExportTimeout bounds how long an environment tool may take to print its environment
+const envExportTimeout = 30 * time.Second
+
+// WorktreeBootstrapService prepares new worktrees with the steps configured for their repository,
+// such as copying .env from the main checkout or running make deps.
+// It also trusts and loads the direnv and mise config of worktrees, when turned on.
+type WorktreeBootstrapService struct {
+	envTools bool // Trust new worktrees with direnv and mise, and load their environment
+	runner   ports.BootstrapRunner
+	steps    map[string][]domain.BootstrapStep // Keyed by repo info (owner/repo)
+}
+
+// Verify interface compliance at compile time
+var _ WorktreeBootstrapper = (*WorktreeBootstrapService)(nil)
+
+// NewWorktreeBootstrapService creates a new WorktreeBootstrapService
+func NewWorktreeBootstrapService(runner ports.BootstrapRunner, steps map[string][]domain.BootstrapStep) *WorktreeBootstrapService {
+	return &WorktreeBootstrapService{
+		runner: runner,
+		steps:  steps,
+	}
+}
+
+// SetEnvTools turns the direnv and mise integration on or off
+func (s *WorktreeBootstrapService) SetEnvTools(enabled bool) {
+	s.envTools = enabled
+}
+
+// Bootstrap runs the steps of a repository in order inside a new worktree, followed by the
+// trust and install commands of the environment tools it uses, writing progress and command
+// output to output (nil discards it). It stops at the first failing step.
+func (s *WorktreeBootstrapService) Bootstrap(ctx context.Context, repoInfo, repoPath, worktreePath string, output io.Writer) error {
+	if output == nil {
+		output = io.Discard
+	}
+
+	steps := s.steps[repoInfo]
+	if len(steps) > 0 {
+		logging.Logger.Info("Bootstrapping worktree", "repo", repoInfo, "worktree", worktreePath, "steps", len(steps))
+		if err := s.runSteps(ctx, repoInfo, steps, 0, repoPath, worktreePath, output); err != nil {
+			return err
+		}
+	}
+
+	// Environment tools go last so their config can come from a copy step, such as a git-ignored .envrc
+	envSteps := s.envSteps(worktreePath)
+	if err := s.runSteps(ctx, repoInfo, envSteps, len(steps), repoPath, worktreePath, output); err != nil {
+		return err
+	}
+
+	if total := len(steps) + len(envSteps); total > 0 {
+		fmt.Fprintf(output, "✓ worktree bootstrapped (%d steps)\n", total)
+	}
+	return nil
+}
+
+// LoadEnv returns the environment changes the direnv and mise config of dir make, such as
+// tool versions on PATH. A tool that fails is logged and skipped so the agent still starts.
+func (s *WorktreeBootstrapService) LoadEnv(ctx context.Context, dir string) domain.EnvChanges {
+	changes := domain.EnvChanges{}
+	if !s.envTools {
+		return changes
+	}
+
+	for _, tool := range s.runner.DetectEnvTools(dir) {
+		exportCtx, cancel := context.WithTimeout(ctx, envExportTimeout)
+		output, err := s.runner.CommandOutput(exportCtx, dir, tool.ExportCommand())
+		cancel()
+		if err != nil {
+			logging.Logger.Warn("Failed to load environment", "tool", tool, "dir", dir, "error", err)
+			continue
+		}
+		toolChanges, err := domain.ParseEnvExport(output)
+		if err != nil {
+			logging.Logger.Warn("Failed to load environment", "tool", tool, "dir", dir, "error", err)
+			continue
+		}
+		logging.Logger.Info("Loaded environment", "tool", tool, "dir", dir, "variables", len(toolChanges))
+		maps.Copy(changes, toolChanges)
+	}
+	return changes
+}
+
+// Steps returns the bootstrap steps configured for a repository
+func (s *WorktreeBootstrapService) Steps(repoInfo string) []domain.BootstrapStep {
+	return s.steps[repoInfo]
+}
+
+// envSteps returns the commands trusting the environment tools configured in a worktree
+func (s *WorktreeBootstrapService) envSteps(worktreePath string) []domain.BootstrapStep {
+	if !s.envTools {
+		return nil
+	}
+
+	var steps []domain.BootstrapStep
+	for _, tool := range s.runner.DetectEnvTools(worktreePath) {
+		for _, command := range tool.SetupCommands() {
+			steps = append(steps, domain.BootstrapStep{Run: command})
+		}
+	}
+	return steps
+}
+
+// runSteps runs steps in order, numbering them in errors after the first already run
+func (s *WorktreeBootstrapService) runSteps(ctx context.Context, repoInfo string, steps []domain.BootstrapStep, first int, repoPath, worktreePath string, output io.Writer) error {
+	for i, step := range steps {
+		fmt.Fprintf(output, "▸ %s\n", step)
+		if err := s.runStep(ctx, step, repoPath, worktreePath, output); err != nil {
+			logging.Logger.Warn("Bootstrap step failed", "repo", repoInfo, "step", step.String(), "error", err)
+			fmt.Fprintf(output, "✗ %s: %v\n", step, err)
+			return fmt.Errorf("bootstrap step %d (%s) failed: %w", first+i+1, step, err)
+		}
+	}
+	return nil
+}
+
+func (s *WorktreeBootstrapService) runStep(ctx context.Context, step domain.BootstrapStep, repoPath, worktreePath string, output io.Writer) error {
+	if step.Copy != "" {
+		return s.runner.CopyPath(filepath.Join(repoPath, step.Copy), filepath.Join(worktreePath, step.Copy))
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, BootstrapStepTimeout)
+	defer cancel()
+	return s.runner.RunCommand(ctx, worktreePath, step.Run, output)
+}
@@ -0,0 +1,118 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestWorktreeBootstrap(t *testing.T) {
+	steps := map[string][]domain.BootstrapStep{
+		"acme/api": {
+			{Copy: ".env"},
+			{Run: "make deps"},
+			{Run: "direnv allow"},
+		},
+	}
+
+	t.Run("runs steps in order", func(t *testing.T) {
+		runner := portsmocks.NewMockBootstrapRunner(t)
+		runner.EXPECT().CopyPath("/repo/.env", "/wt/.env").Return(nil)
+		runner.EXPECT().RunCommand(mock.Anything, "/wt", "make deps", mock.Anything).Return(nil)
+		runner.EXPECT().RunCommand(mock.Anything, "/wt", "direnv allow", mock.Anything).Return(nil)
+
+		var output bytes.Buffer
+		service := NewWorktreeBootstrapService(runner, steps)
+		err := service.Bootstrap(context.Background(), "acme/api", "/repo", "/wt", &output)
+
+		require.NoError(t, err)
+		assert.Contains(t, output.String(), "▸ copy .env\n▸ run make deps\n▸ run direnv allow\n")
+		assert.Contains(t, output.String(), "✓ worktree bootstrapped (3 steps)")
+	})
+
+	t.Run("stops at the first failing step", func(t *testing.T) {
+		runner := portsmocks.NewMockBootstrapRunner(t)
+		runner.EXPECT().CopyPath("/repo/.env", "/wt/.env").Return(nil)
+		runner.EXPECT().RunCommand(mock.Anything, "/wt", "make deps", mock.Anything).Return(errors.New("exit status 2"))
+
+		var output bytes.Buffer
+		service := NewWorktreeBootstrapService(runner, steps)
+		err := service.Bootstrap(context.Background(), "acme/api", "/repo", "/wt", &output)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bootstrap step 2 (run make deps) failed")
+		assert.Contains(t, output.String(), "✗ run make deps: exit status 2")
+		assert.NotContains(t, output.String(), "direnv allow")
+	})
+
+	t.Run("repositories without steps are skipped", func(t *testing.T) {
+		service := NewWorktreeBootstrapService(portsmocks.NewMockBootstrapRunner(t), steps)
+		err := service.Bootstrap(context.Background(), "acme/web", "/repo", "/wt", nil)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestWorktreeBootstrap_EnvTools(t *testing.T) {
+	steps := map[string][]domain.BootstrapStep{"acme/api": {{Copy: ".envrc"}}}
+
+	t.Run("trusts the tools after the configured steps", func(t *testing.T) {
+		runner := portsmocks.NewMockBootstrapRunner(t)
+		copied := runner.EXPECT().CopyPath("/repo/.envrc", "/wt/.envrc").Return(nil).Call
+		runner.EXPECT().DetectEnvTools("/wt").Return([]domain.EnvTool{domain.EnvToolDirenv, domain.EnvToolMise}).NotBefore(copied)
+		runner.EXPECT().RunCommand(mock.Anything, "/wt", "direnv allow", mock.Anything).Return(nil)
+		runner.EXPECT().RunCommand(mock.Anything, "/wt", "mise trust --yes", mock.Anything).Return(nil)
+		runner.EXPECT().RunCommand(mock.Anything, "/wt", "mise install --yes", mock.Anything).Return(errors.New("exit status 1"))
+
+		service := NewWorktreeBootstrapService(runner, steps)
+		service.SetEnvTools(true)
+		err := service.Bootstrap(context.Background(), "acme/api", "/repo", "/wt", nil)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "bootstrap step 4 (run mise install --yes) failed")
+	})
+
+	t.Run("repositories without steps still get their tools trusted", func(t *testing.T) {
+		runner := portsmocks.NewMockBootstrapRunner(t)
+		runner.EXPECT().DetectEnvTools("/wt").Return([]domain.EnvTool{domain.EnvToolDirenv})
+		runner.EXPECT().RunCommand(mock.Anything, "/wt", "direnv allow", mock.Anything).Return(nil)
+
+		var output bytes.Buffer
+		service := NewWorktreeBootstrapService(runner, steps)
+		service.SetEnvTools(true)
+		err := service.Bootstrap(context.Background(), "acme/web", "/repo", "/wt", &output)
+
+		require.NoError(t, err)
+		assert.Contains(t, output.String(), "✓ worktree bootstrapped (1 steps)")
+	})
+}
+
+func TestWorktreeBootstrap_LoadEnv(t *testing.T) {
+	t.Run("merges the environment of every tool, skipping failures", func(t *testing.T) {
+		runner := portsmocks.NewMockBootstrapRunner(t)
+		runner.EXPECT().DetectEnvTools("/wt").Return([]domain.EnvTool{domain.EnvToolDirenv, domain.EnvToolMise})
+		runner.EXPECT().CommandOutput(mock.Anything, "/wt", "direnv export json").Return(nil, errors.New(".envrc is blocked"))
+		runner.EXPECT().CommandOutput(mock.Anything, "/wt", "mise env --json").Return([]byte(`{"PATH":"/mise/node/bin:/usr/bin"}`), nil)
+
+		service := NewWorktreeBootstrapService(runner, nil)
+		service.SetEnvTools(true)
+		changes := service.LoadEnv(context.Background(), "/wt")
+
+		require.Contains(t, changes, "PATH")
+		assert.Equal(t, "/mise/node/bin:/usr/bin", *changes["PATH"])
+	})
+
+	t.Run("turned off loads nothing", func(t *testing.T) {
+		service := NewWorktreeBootstrapService(portsmocks.NewMockBootstrapRunner(t), nil)
+
+		assert.Empty(t, service.LoadEnv(context.Background(), "/wt"))
+	})
+}
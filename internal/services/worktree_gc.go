@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// WorktreeGCInterval is how often the TUI and the scheduler remove worktrees past retention
+const WorktreeGCInterval = 24 * time.Hour
+
+// WorktreeGCService removes the worktrees of sessions archived longer than the retention
+// policy allows. Only worktrees that are clean and pushed are removed, so no work is lost;
+// sessions opt out with KeepWorktree.
+type WorktreeGCService struct {
+	gitRepo       ports.WorktreeManager
+	retention     domain.WorktreeRetention
+	sessionReader ports.SessionReader
+}
+
+// NewWorktreeGCService creates a new WorktreeGCService with the configured retention
+func NewWorktreeGCService(sessionReader ports.SessionReader, gitRepo ports.WorktreeManager, retention domain.WorktreeRetention) *WorktreeGCService {
+	return &WorktreeGCService{
+		gitRepo:       gitRepo,
+		retention:     retention,
+		sessionReader: sessionReader,
+	}
+}
+
+// Retention returns the configured retention policy
+func (s *WorktreeGCService) Retention() domain.WorktreeRetention {
+	return s.retention
+}
+
+// Plan lists the worktrees past retention at now and checks each for local work, without
+// removing anything. Worktrees already gone from disk are left out.
+func (s *WorktreeGCService) Plan(ctx context.Context, retention domain.WorktreeRetention, now time.Time) ([]domain.WorktreeGCCandidate, error) {
+	if !retention.IsEnabled() {
+		return nil, nil
+	}
+
+	sessions, err := s.sessionReader.List(ctx, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	var candidates []domain.WorktreeGCCandidate
+	for _, session := range sessions {
+		if !retention.Expired(session, now) {
+			continue
+		}
+		if _, err := os.Stat(session.WorktreePath); os.IsNotExist(err) {
+			continue
+		}
+
+		candidate := domain.WorktreeGCCandidate{Session: session}
+		candidate.Status, candidate.Err = s.gitRepo.GetWorktreeStatus(ctx, session.WorktreePath)
+		if candidate.Err != nil {
+			logging.Logger.Warn("Failed to check archived worktree", "session", session.Name, "path", session.WorktreePath, "error", candidate.Err)
+		}
+		candidates = append(candidates, candidate)
+	}
+	return candidates, nil
+}
+
+// Remove removes the worktrees of the removable candidates and returns the candidates
+// with the outcome of each removal. Candidates with local work are kept.
+func (s *WorktreeGCService) Remove(candidates []domain.WorktreeGCCandidate) []domain.WorktreeGCCandidate {
+	result := make([]domain.WorktreeGCCandidate, len(candidates))
+	for i, candidate := range candidates {
+		result[i] = candidate
+		if !candidate.Removable() {
+			continue
+		}
+
+		session := candidate.Session
+		logging.Logger.Info("Removing archived worktree", "session", session.Name, "path", session.WorktreePath)
+		if err := s.gitRepo.RemoveWorktree(session.RepoPath, session.WorktreePath); err != nil {
+			logging.Logger.Error("Failed to remove archived worktree", "session", session.Name, "path", session.WorktreePath, "error", err)
+			result[i].Err = fmt.Errorf("failed to remove worktree: %w", err)
+			continue
+		}
+		result[i].Removed = true
+	}
+	return result
+}
+
+// Run plans with the configured retention and removes the removable worktrees
+// It does nothing when the retention policy is disabled.
+func (s *WorktreeGCService) Run(ctx context.Context, now time.Time) ([]domain.WorktreeGCCandidate, error) {
+	candidates, err := s.Plan(ctx, s.retention, now)
+	if err != nil {
+		return nil, err
+	}
+	return s.Remove(candidates), nil
+}
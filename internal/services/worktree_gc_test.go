@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+)
+
+func TestWorktreeGCService_Run(t *testing.T) {
+	now := time.Date(2026, 3, 20, 12, 0, 0, 0, time.UTC)
+	archivedAt := now.Add(-30 * 24 * time.Hour)
+	dir := t.TempDir()
+	clean, dirty, broken := filepath.Join(dir, "clean"), filepath.Join(dir, "dirty"), filepath.Join(dir, "broken")
+	for _, path := range []string{clean, dirty, broken} {
+		require.NoError(t, os.MkdirAll(path, 0755))
+	}
+
+	sessionRepo := portsmocks.NewMockSessionRepository(t)
+	sessionRepo.EXPECT().List(mock.Anything, true).Return([]domain.Session{
+		{Name: "clean", ArchivedAt: &archivedAt, IsArchived: true, RepoPath: "/repo", WorktreePath: clean},
+		{Name: "dirty", ArchivedAt: &archivedAt, IsArchived: true, RepoPath: "/repo", WorktreePath: dirty},
+		{Name: "broken", ArchivedAt: &archivedAt, IsArchived: true, RepoPath: "/repo", WorktreePath: broken},
+		{Name: "gone", ArchivedAt: &archivedAt, IsArchived: true, RepoPath: "/repo", WorktreePath: filepath.Join(dir, "gone")},
+		{Name: "kept", ArchivedAt: &archivedAt, IsArchived: true, KeepWorktree: true, RepoPath: "/repo", WorktreePath: clean},
+		{Name: "active", RepoPath: "/repo", WorktreePath: clean},
+	}, nil)
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	gitRepo.EXPECT().GetWorktreeStatus(mock.Anything, clean).Return(&domain.WorktreeStatus{}, nil)
+	gitRepo.EXPECT().GetWorktreeStatus(mock.Anything, dirty).Return(&domain.WorktreeStatus{UnpushedCommits: []string{"abc wip"}}, nil)
+	gitRepo.EXPECT().GetWorktreeStatus(mock.Anything, broken).Return(nil, errors.New("not a git repository"))
+	gitRepo.EXPECT().RemoveWorktree("/repo", clean).Return(nil)
+
+	service := NewWorktreeGCService(sessionRepo, gitRepo, domain.WorktreeRetention{After: 7 * 24 * time.Hour})
+	candidates, err := service.Run(context.Background(), now)
+	require.NoError(t, err)
+
+	require.Len(t, candidates, 3, "missing, kept, and active worktrees are not candidates")
+	assert.True(t, candidates[0].Removed)
+	assert.Equal(t, "kept: unpushed commits", candidates[1].Reason())
+	assert.False(t, candidates[2].Removed)
+	assert.Error(t, candidates[2].Err)
+}
+
+func TestWorktreeGCService_RunDisabled(t *testing.T) {
+	service := NewWorktreeGCService(portsmocks.NewMockSessionRepository(t), portsmocks.NewMockGitRepository(t), domain.WorktreeRetention{})
+
+	candidates, err := service.Run(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, candidates)
+}
+
+func TestWorktreeGCService_RemoveReportsFailures(t *testing.T) {
+	gitRepo := portsmocks.NewMockGitRepository(t)
+	gitRepo.EXPECT().RemoveWorktree("/repo", "/wt").Return(errors.New("locked"))
+	service := NewWorktreeGCService(portsmocks.NewMockSessionRepository(t), gitRepo, domain.WorktreeRetention{})
+
+	result := service.Remove([]domain.WorktreeGCCandidate{
+		{Session: domain.Session{Name: "s1", RepoPath: "/repo", WorktreePath: "/wt"}, Status: &domain.WorktreeStatus{}},
+	})
+	require.Len(t, result, 1)
+	assert.False(t, result[0].Removed)
+	assert.Contains(t, result[0].Reason(), "failed to remove worktree: locked")
+}
@@ -1,6 +1,9 @@
 package theme
 
-import "github.com/charmbracelet/lipgloss"
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
 
 // Color is an alias for lipgloss.Color for convenience
 type Color = lipgloss.Color
@@ -15,6 +18,7 @@ const (
 const (
 	ColorExited  Color = "8" // Gray - exited
 	ColorIdle    Color = "3" // Yellow - idle
+	ColorPaused  Color = "4" // Blue - paused by the user
 	ColorWaiting Color = "1" // Red - waiting for user
 	ColorWorking Color = "2" // Green - working
 )
@@ -51,9 +55,67 @@ const (
 
 // Token chart colors
 const (
+	ColorTokenCache  Color = "22" // Dark green - cache reads and writes
 	ColorTokenInput  Color = "2"  // Green - input tokens
 	ColorTokenOutput Color = "33" // Blue - output tokens
 )
 
+// Token chart colors per model, picked in order of usage; the last one groups the other models
+var ColorTokenModelPalette = []Color{"141", "33", "43", "214"}
+
+// Token chart colors for the cache tokens of each model, darker shades of ColorTokenModelPalette
+var ColorTokenModelCachePalette = []Color{"97", "25", "30", "136"}
+
+// Activity heatmap colors, from no activity to the busiest hour
+var ColorHeatmapLevels = []Color{"237", "22", "28", "34", "46"}
+
+// Resource usage colors
+const (
+	ColorRunaway Color = "208" // Orange - runaway agent process
+)
+
+// Concurrency limit colors
+const (
+	ColorThrottled Color = "214" // Amber - prompts held back by the concurrency limit
+)
+
+// Session priority colors, from P0 to P3
+var ColorPriorityLevels = []Color{"196", "208", "226", "245"}
+
+// Tool audit colors
+const (
+	ColorSkipPermissions Color = "196" // Red - session skips permission prompts
+)
+
+// Session timer colors
+const (
+	ColorTimer    Color = "81"  // Cyan - timer counting down
+	ColorTimerDue Color = "201" // Magenta - timer elapsed
+)
+
+// CI result colors
+const (
+	ColorCIFailed  Color = "196" // Red - CI failed on the branch
+	ColorCIPassed  Color = "2"   // Green - CI passed
+	ColorCIPending Color = "245" // Gray - CI queued or running
+)
+
+// Session token budget colors
+const (
+	ColorTokenBudget         Color = "245" // Gray - tokens used within the budget
+	ColorTokenBudgetExceeded Color = "196" // Red - budget exceeded
+)
+
+// Tag chip colors, picked per tag by hashing its name
+var ColorTagPalette = []Color{"33", "141", "214", "43", "204", "112", "75", "180"}
+
+// ColorTagText is the text color drawn on top of tag chips
+const ColorTagText Color = "16" // Black
+
 // DefaultStatusColors is the default color palette for implementation statuses
 var DefaultStatusColors = []string{"141", "33", "214", "226", "46"}
+
+// DisableColors renders every style without colors, such as in accessibility mode
+func DisableColors() {
+	lipgloss.SetColorProfile(termenv.Ascii)
+}
@@ -0,0 +1,53 @@
+package theme
+
+// Palette overrides the main colors of the TUI, such as from a preset; empty colors keep their default
+type Palette struct {
+	Accent    Color // Shortcut keys and hints
+	Exited    Color
+	Idle      Color
+	Muted     Color // Branches and secondary text
+	Paused    Color
+	Primary   Color // App name and titles
+	Secondary Color // Subtitles
+	Waiting   Color
+	Working   Color
+}
+
+// ApplyPalette restyles the TUI with the colors set in p
+func ApplyPalette(p Palette) {
+	if p.Accent != "" {
+		HelpKeyStyle = HelpKeyStyle.Foreground(p.Accent)
+		HelpShortcutStyle = HelpShortcutStyle.Foreground(p.Accent)
+		HintKeyStyle = HintKeyStyle.Foreground(p.Accent)
+		QuickJumpHintStyle = QuickJumpHintStyle.Foreground(p.Accent)
+		TipKeyStyle = TipKeyStyle.Foreground(p.Accent)
+	}
+	if p.Muted != "" {
+		BranchStyle = BranchStyle.Foreground(p.Muted)
+		HelpStyle = HelpStyle.Foreground(p.Muted)
+	}
+	if p.Primary != "" {
+		AppNameStyle = AppNameStyle.Foreground(p.Primary)
+		TitleStyle = TitleStyle.Foreground(p.Primary)
+	}
+	if p.Secondary != "" {
+		SubtitleStyle = SubtitleStyle.Foreground(p.Secondary)
+	}
+
+	// Session states
+	if p.Exited != "" {
+		ExitedIconStyle = ExitedIconStyle.Foreground(p.Exited)
+	}
+	if p.Idle != "" {
+		IdleIconStyle = IdleIconStyle.Foreground(p.Idle)
+	}
+	if p.Paused != "" {
+		PausedIconStyle = PausedIconStyle.Foreground(p.Paused)
+	}
+	if p.Waiting != "" {
+		WaitingIconStyle = WaitingIconStyle.Foreground(p.Waiting)
+	}
+	if p.Working != "" {
+		WorkingIconStyle = WorkingIconStyle.Foreground(p.Working)
+	}
+}
@@ -21,6 +21,10 @@ var (
 	NormalStyle = lipgloss.NewStyle().
 			Foreground(ColorNormal)
 
+	QuickJumpHintStyle = lipgloss.NewStyle().
+				Foreground(ColorHintKey).
+				Bold(true)
+
 	TitleStyle = lipgloss.NewStyle().
 			Bold(true).
 			Foreground(ColorPrimary).
@@ -35,6 +39,9 @@ var (
 	IdleIconStyle = lipgloss.NewStyle().
 			Foreground(ColorIdle)
 
+	PausedIconStyle = lipgloss.NewStyle().
+			Foreground(ColorPaused)
+
 	WaitingIconStyle = lipgloss.NewStyle().
 				Foreground(ColorWaiting)
 
@@ -122,11 +129,29 @@ var ErrorStyle = lipgloss.NewStyle().
 	Foreground(ColorError).
 	Bold(true)
 
+// BadgeStyle returns a style for a custom badge color (empty uses the muted text color)
+func BadgeStyle(color string) lipgloss.Style {
+	if color == "" {
+		return lipgloss.NewStyle().Foreground(ColorMuted)
+	}
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(color))
+}
+
 // StatusStyle returns a style for a given status color string
 func StatusStyle(color string) lipgloss.Style {
 	return lipgloss.NewStyle().Foreground(lipgloss.Color(color))
 }
 
+// PriorityStyle returns a style for a priority color (see ColorPriorityLevels)
+func PriorityStyle(color Color) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(color).Bold(true)
+}
+
+// TagChipStyle returns a chip style for a tag with the given background color
+func TagChipStyle(color Color) lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(ColorTagText).Background(color).Padding(0, 1)
+}
+
 // TimestampStyle returns a style for a given timestamp color string
 func TimestampStyle(color string) lipgloss.Style {
 	return lipgloss.NewStyle().Foreground(lipgloss.Color(color))
@@ -134,6 +159,9 @@ func TimestampStyle(color string) lipgloss.Style {
 
 // Token chart styles
 var (
+	TokenCacheStyle = lipgloss.NewStyle().
+			Foreground(ColorTokenCache)
+
 	TokenInputStyle = lipgloss.NewStyle().
 			Foreground(ColorTokenInput)
 
@@ -144,6 +172,102 @@ var (
 				Foreground(ColorSubtle)
 )
 
+// Note pane styles
+var (
+	NotePaneEmptyStyle = lipgloss.NewStyle().
+				Foreground(ColorSubtle).
+				Italic(true)
+
+	NotePaneTitleStyle = lipgloss.NewStyle().
+				Foreground(ColorPrimary).
+				Bold(true)
+)
+
+// Detail pane styles
+var (
+	DetailPaneBorderStyle = lipgloss.NewStyle().
+				Border(lipgloss.NormalBorder(), false, false, false, true).
+				BorderForeground(ColorMuted).
+				PaddingLeft(1)
+
+	DetailPaneLabelStyle = lipgloss.NewStyle().
+				Foreground(ColorSubtle)
+
+	DetailPaneSectionStyle = lipgloss.NewStyle().
+				Foreground(ColorPrimary).
+				Bold(true)
+)
+
+// Agent error styles
+var (
+	AgentErrorStyle = lipgloss.NewStyle().
+		Foreground(ColorError).
+		Bold(true)
+)
+
+// Resource usage styles
+var (
+	ResourceUsageStyle = lipgloss.NewStyle().
+				Foreground(ColorMuted)
+
+	RunawayWarningStyle = lipgloss.NewStyle().
+				Foreground(ColorRunaway).
+				Bold(true)
+)
+
+// Activity sparkline styles
+var (
+	ActivitySparklineStyle = lipgloss.NewStyle().
+		Foreground(ColorWorking)
+)
+
+// Concurrency limit styles
+var (
+	ThrottledStyle = lipgloss.NewStyle().
+		Foreground(ColorThrottled).
+		Italic(true)
+)
+
+// Tool audit styles
+var (
+	SkipPermissionsStyle = lipgloss.NewStyle().
+		Foreground(ColorSkipPermissions).
+		Bold(true)
+)
+
+// Session timer styles
+var (
+	TimerStyle = lipgloss.NewStyle().
+			Foreground(ColorTimer)
+
+	TimerDueStyle = lipgloss.NewStyle().
+			Foreground(ColorTimerDue).
+			Bold(true)
+)
+
+// CI result styles
+var (
+	CIFailedStyle = lipgloss.NewStyle().
+			Foreground(ColorCIFailed).
+			Bold(true)
+
+	CIPassedStyle = lipgloss.NewStyle().
+			Foreground(ColorCIPassed)
+
+	CIPendingStyle = lipgloss.NewStyle().
+			Foreground(ColorCIPending)
+)
+
+// Session token budget styles
+var (
+	TokenBudgetStyle = lipgloss.NewStyle().
+				Foreground(ColorTokenBudget)
+
+	TokenBudgetExceededStyle = lipgloss.NewStyle().
+					Foreground(ColorTokenBudgetExceeded).
+					Bold(true)
+)
+
 // Command palette styles
 var (
 	DimmedStyle = lipgloss.NewStyle().
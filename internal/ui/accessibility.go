@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// stateLabelWidth pads state labels so session names line up in accessibility mode
+const stateLabelWidth = len(domain.StateWaiting)
+
+// aheadBehindPattern matches the ahead/behind arrows of a git ref, such as "↑2 ↓0"
+var aheadBehindPattern = regexp.MustCompile(`↑(\d+) ↓(\d+)`)
+
+// indicatorText returns the symbol of a session indicator, or its text label in
+// accessibility mode, where symbols may not be announced by screen readers
+func indicatorText(accessible bool, symbol, label string) string {
+	if accessible {
+		return label
+	}
+	return symbol
+}
+
+// stateLabel returns the text label replacing a state icon in accessibility mode
+func stateLabel(state domain.SessionState) string {
+	return fmt.Sprintf("%-*s", stateLabelWidth, state)
+}
+
+// accessibleGitRef spells out the arrows and separators of a git ref
+func accessibleGitRef(gitRef string) string {
+	gitRef = aheadBehindPattern.ReplaceAllString(gitRef, "$1 ahead $2 behind")
+	gitRef = strings.ReplaceAll(gitRef, "› ", "in ")
+	return strings.ReplaceAll(gitRef, " · ", ", ")
+}
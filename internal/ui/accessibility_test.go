@@ -0,0 +1,65 @@
+package ui
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+func TestAccessibleGitRef(t *testing.T) {
+	gitRef := "owner/api:feature/cart › packages/api · PR #12 · ↑2 ↓0 · main ↑3 ↓1 · 4 files +10 -2"
+
+	assert.Equal(t, "owner/api:feature/cart in packages/api, PR #12, 2 ahead 0 behind, main 3 ahead 1 behind, 4 files +10 -2", accessibleGitRef(gitRef))
+}
+
+func TestSessionDelegate_RenderAccessible(t *testing.T) {
+	item := SessionItem{
+		Comment:     "ask design",
+		DisplayName: "api-cart",
+		GitRef:      "owner/api:feature/cart · ↑2 ↓0",
+		IsFlagged:   true,
+		Session:     &ports.TmuxSession{Name: "api-cart"},
+		State:       string(domain.StateIdle),
+	}
+	statusConfig := config.NewStatusConfig("", "", "")
+	delegate := newSessionDelegate(&domain.SessionCollection{}, NewInlineEdit(), NewQuickJump(), statusConfig, &config.TimestampColorConfig{}, TimestampHidden, DensityNormal, true)
+	l := list.New([]list.Item{item}, delegate, 120, 10)
+
+	var out bytes.Buffer
+	delegate.Render(&out, l, 0, item)
+
+	assert.Equal(t, 1, delegate.Height())
+	assert.Equal(t, "> 01. idle    api-cart flagged comment, owner/api:feature/cart, 2 ahead 0 behind", out.String())
+}
+
+func TestCIChip(t *testing.T) {
+	tests := []struct {
+		state          domain.CIState
+		want           string
+		wantAccessible string
+	}{
+		{state: domain.CIStateSuccess, want: "CI ✓", wantAccessible: "ci passed"},
+		{state: domain.CIStateFailure, want: "CI ✗", wantAccessible: "ci failed"},
+		{state: domain.CIStatePending, want: "CI …", wantAccessible: "ci running"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.state), func(t *testing.T) {
+			assert.Equal(t, tt.want, ciChip(tt.state, false))
+			assert.Equal(t, tt.wantAccessible, ciChip(tt.state, true))
+		})
+	}
+}
+
+func TestActivitySparkline(t *testing.T) {
+	activity := domain.ActivitySparkline{0, 0.5, 1, 0.25}
+
+	assert.Equal(t, "▁▅█▃", activitySparkline(activity, false))
+	assert.Equal(t, "busy 44%", activitySparkline(activity, true))
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+const (
+	heatmapCell       = "■ " // One cell per hour
+	heatmapLabelWidth = 11   // "Mon 01-02  "
+)
+
+// RenderActivityHeatmap renders a GitHub-style grid of state transitions with one row per day
+// and one column per hour, followed by a legend and the busiest hour.
+func RenderActivityHeatmap(heatmap domain.ActivityHeatmap) string {
+	var sb strings.Builder
+
+	levels := make([]lipgloss.Style, len(theme.ColorHeatmapLevels))
+	for i, color := range theme.ColorHeatmapLevels {
+		levels[i] = lipgloss.NewStyle().Foreground(color)
+	}
+
+	// Hour axis, labelled every 3 hours
+	sb.WriteString(strings.Repeat(" ", heatmapLabelWidth))
+	for hour := 0; hour < 24; hour += 3 {
+		sb.WriteString(theme.TokenChartLegendStyle.Render(fmt.Sprintf("%-6s", fmt.Sprintf("%02d", hour))))
+	}
+	sb.WriteString("\n")
+
+	for i, day := range heatmap.Days {
+		sb.WriteString(theme.TokenChartLegendStyle.Render(fmt.Sprintf("%-*s", heatmapLabelWidth, day.Format("Mon 01-02"))))
+		for hour := 0; hour < 24; hour++ {
+			sb.WriteString(levels[heatmap.Level(heatmap.Counts[i][hour], len(levels))].Render(heatmapCell))
+		}
+		sb.WriteString("\n")
+	}
+
+	// Legend
+	sb.WriteString("\n")
+	sb.WriteString(strings.Repeat(" ", heatmapLabelWidth))
+	sb.WriteString(theme.TokenChartLegendStyle.Render("Less "))
+	for _, style := range levels {
+		sb.WriteString(style.Render(heatmapCell))
+	}
+	sb.WriteString(theme.TokenChartLegendStyle.Render("More"))
+	sb.WriteString("\n\n")
+
+	summary := fmt.Sprintf("Transitions: %d", heatmap.Total)
+	if hour, count := busiestHour(heatmap); count > 0 {
+		summary += fmt.Sprintf(" • busiest hour: %02d:00 (%d)", hour, count)
+	}
+	sb.WriteString(theme.TokenChartLegendStyle.Render(summary))
+
+	return sb.String()
+}
+
+// busiestHour returns the hour of day with the most transitions across all days
+func busiestHour(heatmap domain.ActivityHeatmap) (int, int) {
+	var totals [24]int
+	for _, row := range heatmap.Counts {
+		for hour, count := range row {
+			totals[hour] += count
+		}
+	}
+
+	best, bestCount := 0, 0
+	for hour, count := range totals {
+		if count > bestCount {
+			best, bestCount = hour, count
+		}
+	}
+	return best, bestCount
+}
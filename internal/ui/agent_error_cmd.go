@@ -0,0 +1,25 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// AgentErrorsScannedMsg is sent when the panes of possibly stuck sessions were scanned for agent errors
+type AgentErrorsScannedMsg struct {
+	Errors  map[string]*domain.AgentError // By session name; scanned sessions without an error are left out
+	Scanned []string
+}
+
+// StartAgentErrorScan scans the panes of sessions for usage limit and authentication errors
+// Returns a tea.Cmd that will send AgentErrorsScannedMsg
+func StartAgentErrorScan(shellService *services.ShellService, sessionNames []string) tea.Cmd {
+	return func() tea.Msg {
+		return AgentErrorsScannedMsg{
+			Errors:  shellService.DetectAgentErrors(sessionNames),
+			Scanned: sessionNames,
+		}
+	}
+}
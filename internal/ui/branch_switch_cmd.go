@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// BranchesReadyMsg is sent when the branches a session worktree can switch to were listed
+type BranchesReadyMsg struct {
+	Branches     []domain.Branch
+	SessionName  string
+	WorktreePath string
+}
+
+// BranchSwitchErrorMsg is sent when listing or switching the branches of a session failed
+type BranchSwitchErrorMsg struct {
+	Err         error
+	SessionName string
+}
+
+// StartBranchListing lists the branches a session worktree can switch to
+// Returns a tea.Cmd that will send BranchesReadyMsg or BranchSwitchErrorMsg
+func StartBranchListing(gitService *services.GitService, sessionName, worktreePath string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		branches, err := gitService.ListBranches(ctx, worktreePath)
+		if err != nil {
+			logging.Logger.Warn("Failed to list session branches",
+				"session", sessionName,
+				"error", err)
+			return BranchSwitchErrorMsg{
+				Err:         err,
+				SessionName: sessionName,
+			}
+		}
+
+		return BranchesReadyMsg{
+			Branches:     branches,
+			SessionName:  sessionName,
+			WorktreePath: worktreePath,
+		}
+	}
+}
+
+// StartBranchSwitch checks out another branch in a session worktree, optionally tells the
+// agent, then refreshes the session's git stats against the new branch
+// Returns a tea.Cmd that will send GitStatsReadyMsg, GitStatsErrorMsg, or BranchSwitchErrorMsg
+func StartBranchSwitch(
+	sessionService *services.SessionService,
+	schedulerService *services.SchedulerService,
+	gitService *services.GitService,
+	request GitStatsRequest,
+	branch string,
+	tellAgent bool,
+) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		previous, err := sessionService.SwitchBranch(ctx, request.SessionName, branch)
+		if err != nil {
+			logging.Logger.Warn("Failed to switch session branch",
+				"session", request.SessionName,
+				"branch", branch,
+				"error", err)
+			return BranchSwitchErrorMsg{
+				Err:         err,
+				SessionName: request.SessionName,
+			}
+		}
+		logging.Logger.Info("Session branch switched", "session", request.SessionName, "from", previous, "to", branch)
+
+		if tellAgent {
+			if _, err := schedulerService.Schedule(ctx, request.SessionName, domain.BranchSwitchPrompt(previous, branch), false, time.Now()); err != nil {
+				logging.Logger.Warn("Failed to tell the agent about the branch switch", "session", request.SessionName, "error", err)
+			}
+		}
+
+		return StartGitStatsFetcher(gitService, request)()
+	}
+}
@@ -0,0 +1,112 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+// CheckOutputScreen shows the output of the last run of the repository checks of a session
+type CheckOutputScreen struct {
+	Completed   bool
+	content     string         // Pre-built output content
+	initialized bool           // Track if viewport has been sized
+	keys        *KeyMap        // Key bindings for closing
+	viewport    viewport.Model // Scrollable viewport
+}
+
+// NewCheckOutputScreen creates an output screen for a check run (nil when the checks never ran)
+func NewCheckOutputScreen(run *domain.CheckRun, output string, truncated bool, loadErr error, keys *KeyMap) *CheckOutputScreen {
+	return &CheckOutputScreen{
+		content:  buildCheckOutputContent(run, output, truncated, loadErr, keys, time.Now()),
+		keys:     keys,
+		viewport: viewport.New(0, 0),
+	}
+}
+
+// buildCheckOutputContent renders the command and outcome of a check run above its output
+func buildCheckOutputContent(run *domain.CheckRun, output string, truncated bool, loadErr error, keys *KeyMap, now time.Time) string {
+	switch {
+	case loadErr != nil:
+		return theme.HelpDescStyle.Render("failed to load the last checks: "+loadErr.Error()) + "\n"
+	case run == nil:
+		runKey := keys.SessionActions.RunChecks.Binding.Help().Key
+		return theme.HelpDescStyle.Render(fmt.Sprintf("no checks ran yet; press %s to run the checks command of the repository", runKey)) + "\n"
+	}
+
+	content := theme.HelpGroupStyle.Render("$ "+run.Command) + "\n"
+	content += checksChip(run.State(), false) + " " + theme.HelpDescStyle.Render(describeCheckRun(run, now)) + "\n"
+	if truncated {
+		content += theme.HelpDescStyle.Render("(showing the end of the output)") + "\n"
+	}
+	content += "\n" + strings.TrimRight(output, "\n") + "\n"
+	return content
+}
+
+// describeCheckRun tells how a check run went and how long it took, such as "exit code 2 after 41s"
+func describeCheckRun(run *domain.CheckRun, now time.Time) string {
+	if run.FinishedAt == nil {
+		return "running for " + now.Sub(run.StartedAt).Round(time.Second).String()
+	}
+	return fmt.Sprintf("exit code %d after %s", run.ExitCode, run.FinishedAt.Sub(run.StartedAt).Round(time.Second))
+}
+
+// Init implements tea.Model
+func (c *CheckOutputScreen) Init() tea.Cmd {
+	c.viewport.KeyMap.Up.SetKeys("up", "k")
+	c.viewport.KeyMap.Down.SetKeys("down", "j")
+	return nil
+}
+
+// Update implements tea.Model
+func (c *CheckOutputScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Footer: 2 lines (the dialog passes the height below its header)
+		viewportHeight := msg.Height - 2
+		if viewportHeight < 5 {
+			viewportHeight = 5
+		}
+
+		c.viewport.Width = msg.Width
+		c.viewport.Height = viewportHeight
+		c.viewport.SetContent(c.content)
+		if !c.initialized {
+			c.viewport.GotoBottom() // Failures are usually at the end
+		}
+		c.initialized = true
+		return c, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "esc" || key.Matches(msg, c.keys.Application.Quit.Binding, c.keys.SessionActions.CheckOutput.Binding) {
+			c.Completed = true
+			return c, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	c.viewport, cmd = c.viewport.Update(msg)
+	return c, cmd
+}
+
+// Done reports whether the screen was closed
+func (c *CheckOutputScreen) Done() bool {
+	return c.Completed
+}
+
+// View implements tea.Model
+func (c *CheckOutputScreen) View() string {
+	if !c.initialized {
+		return "Loading checks output..."
+	}
+
+	footer := theme.HelpStyle.Render("Press esc or q to close • ↑↓/jk/PgUp/PgDn to scroll")
+	return c.viewport.View() + "\n\n" + footer
+}
@@ -0,0 +1,29 @@
+package ui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// ChecksStartedMsg is sent when the checks of a session started in their window, or failed to
+type ChecksStartedMsg struct {
+	Err         error
+	SessionName string
+}
+
+// StartChecks runs the repository checks of a session in a temporary tmux window
+// Returns a tea.Cmd that will send ChecksStartedMsg
+func StartChecks(checkService *services.CheckService, session domain.Session) tea.Cmd {
+	return func() tea.Msg {
+		if _, err := checkService.Run(context.Background(), session); err != nil {
+			logging.Logger.Warn("Failed to start session checks", "session", session.Name, "error", err)
+			return ChecksStartedMsg{Err: err, SessionName: session.Name}
+		}
+		return ChecksStartedMsg{SessionName: session.Name}
+	}
+}
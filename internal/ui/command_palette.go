@@ -2,24 +2,28 @@ package ui
 
 import (
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/ports"
 	"github.com/renato0307/rocha/internal/theme"
 )
 
 // CommandPalette is a searchable action palette overlay.
 type CommandPalette struct {
-	actions       []KeyDefinition    // Filtered actions
-	allActions    []KeyDefinition    // All available actions for context
+	actions       []KeyDefinition // Filtered actions
+	allActions    []KeyDefinition // All available actions for context
 	Completed     bool
 	filterInput   textinput.Model
 	height        int
-	keys          KeyMap             // Key bindings for navigation
-	lastQuery     string             // Previous filter query (to detect changes)
+	keys          KeyMap          // Key bindings for navigation
+	lastQuery     string          // Previous filter query (to detect changes)
+	recent        map[string]bool // Names of recently used actions (shown first)
 	Result        CommandPaletteResult
 	selectedIndex int
 	session       *ports.TmuxSession // Selected session (can be nil)
@@ -34,17 +38,18 @@ type CommandPaletteResult struct {
 }
 
 // NewCommandPalette creates a new command palette.
-// session can be nil if no session is selected.
+// session can be nil if no session is selected (only global actions are offered).
 // sessionName is the display name to show in the header.
 // keys provides the key bindings for navigation.
-func NewCommandPalette(session *ports.TmuxSession, sessionName string, keys KeyMap) *CommandPalette {
-	actions := GetPaletteActions()
+// recentActions lists recently used action names, most recent first.
+func NewCommandPalette(session *ports.TmuxSession, sessionName string, keys KeyMap, recentActions []string) *CommandPalette {
+	actions, recent := orderPaletteActions(GetPaletteActions(), session != nil, recentActions)
 
 	ti := textinput.New()
-	ti.Prompt = "Filter: "
+	ti.Prompt = i18n.T("palette.prompt")
 	ti.PromptStyle = theme.FilterPromptStyle
 	ti.Cursor.Style = theme.FilterCursorStyle
-	ti.Placeholder = "type to filter"
+	ti.Placeholder = i18n.T("palette.placeholder")
 	ti.PlaceholderStyle = theme.DimmedStyle
 	ti.Focus()
 	ti.CharLimit = 50
@@ -55,6 +60,7 @@ func NewCommandPalette(session *ports.TmuxSession, sessionName string, keys KeyM
 		allActions:    actions,
 		filterInput:   ti,
 		keys:          keys,
+		recent:        recent,
 		selectedIndex: 0,
 		session:       session,
 		sessionName:   sessionName,
@@ -119,9 +125,9 @@ func (cp *CommandPalette) View() string {
 
 	// Header with session name (use inline styles to keep on same line)
 	var header string
-	titlePart := theme.PaletteTitleStyle.Render("⌘ Command Palette")
+	titlePart := theme.PaletteTitleStyle.Render("⌘ " + i18n.T("palette.title"))
 	if cp.sessionName != "" {
-		header = titlePart + " " + theme.DimmedStyle.Render("(selected session: "+cp.sessionName+")")
+		header = titlePart + " " + theme.DimmedStyle.Render("("+i18n.Tf("palette.selected_session", cp.sessionName)+")")
 	} else {
 		header = titlePart
 	}
@@ -136,7 +142,7 @@ func (cp *CommandPalette) View() string {
 
 	for i := start; i < end; i++ {
 		def := cp.actions[i]
-		helpText := padRight(capitalizeFirst(def.Help), maxHelpLen)
+		helpText := padRight(capitalizeFirst(def.HelpText()), maxHelpLen)
 		shortcut := def.Defaults[0]
 
 		// Determine prefix: selection indicator or scroll arrow
@@ -154,16 +160,19 @@ func (cp *CommandPalette) View() string {
 		line := prefix +
 			theme.PaletteItemStyle.Render(helpText) +
 			theme.PaletteShortcutStyle.Render("  "+shortcut)
+		if cp.recent[def.Name] && cp.lastQuery == "" {
+			line += theme.DimmedStyle.Render("  recent")
+		}
 		items = append(items, line)
 	}
 
 	// If no matches
 	if len(items) == 0 {
-		items = append(items, theme.PaletteDescStyle.Render("  No matching actions"))
+		items = append(items, theme.PaletteDescStyle.Render("  "+i18n.T("palette.empty")))
 	}
 
 	// Pad to fixed height
-	for len(items) < maxVisibleItems {
+	for len(items) < cp.visibleItems() {
 		items = append(items, "")
 	}
 
@@ -203,7 +212,7 @@ func (cp *CommandPalette) filterActions() {
 
 	var filtered []KeyDefinition
 	for _, def := range cp.allActions {
-		if fuzzyMatch(query, def.Help) {
+		if fuzzyMatch(query, def.HelpText()) || fuzzyMatch(query, strings.ReplaceAll(def.Name, "_", " ")) {
 			filtered = append(filtered, def)
 		}
 	}
@@ -216,6 +225,37 @@ func (cp *CommandPalette) filterActions() {
 	}
 }
 
+// orderPaletteActions puts recently used actions first, followed by the rest in definition order.
+// Session actions are dropped when no session is selected since they cannot be dispatched.
+// Returns the ordered actions and the set of names that came from the recent list.
+func orderPaletteActions(actions []KeyDefinition, hasSession bool, recentActions []string) ([]KeyDefinition, map[string]bool) {
+	available := make(map[string]KeyDefinition, len(actions))
+	var names []string
+	for _, def := range actions {
+		if _, isSessionAction := def.Msg.(SessionAwareMsg); isSessionAction && !hasSession {
+			continue
+		}
+		available[def.Name] = def
+		names = append(names, def.Name)
+	}
+
+	ordered := make([]KeyDefinition, 0, len(names))
+	recent := make(map[string]bool)
+	for _, name := range recentActions {
+		if def, ok := available[name]; ok && !recent[name] {
+			ordered = append(ordered, def)
+			recent[name] = true
+		}
+	}
+	for _, name := range names {
+		if !recent[name] {
+			ordered = append(ordered, available[name])
+		}
+	}
+
+	return ordered, recent
+}
+
 // fuzzyMatch checks if all characters in query appear in order in target.
 func fuzzyMatch(query, target string) bool {
 	target = strings.ToLower(target)
@@ -234,8 +274,8 @@ func fuzzyMatch(query, target string) bool {
 func (cp *CommandPalette) maxHelpLen() int {
 	maxLen := 0
 	for _, def := range cp.allActions {
-		if len(def.Help) > maxLen {
-			maxLen = len(def.Help)
+		if n := utf8.RuneCountInString(def.HelpText()); n > maxLen {
+			maxLen = n
 		}
 	}
 	return maxLen
@@ -252,32 +292,46 @@ func (cp *CommandPalette) paletteWidth() int {
 // maxVisibleItems returns the maximum number of items to show at once.
 const maxVisibleItems = 6
 
+// paletteChromeLines is the height of the palette without its items:
+// border (2) + header (1) + spacing (1) + filter (1) + spacing (1)
+const paletteChromeLines = 6
+
+// visibleItems returns how many items fit, showing fewer on short terminals
+func (cp *CommandPalette) visibleItems() int {
+	if cp.height == 0 {
+		return maxVisibleItems
+	}
+	return max(min(maxVisibleItems, cp.height-paletteChromeLines), 1)
+}
+
 // visibleRange returns the start and end indices for visible items.
 func (cp *CommandPalette) visibleRange() (int, int) {
+	visible := cp.visibleItems()
 	total := len(cp.actions)
-	if total <= maxVisibleItems {
+	if total <= visible {
 		return 0, total
 	}
 
 	// Keep selected item visible with some context
-	start := cp.selectedIndex - maxVisibleItems/2
+	start := cp.selectedIndex - visible/2
 	if start < 0 {
 		start = 0
 	}
-	end := start + maxVisibleItems
+	end := start + visible
 	if end > total {
 		end = total
-		start = end - maxVisibleItems
+		start = end - visible
 	}
 	return start, end
 }
 
 // padRight pads a string to the given width.
 func padRight(s string, width int) string {
-	if len(s) >= width {
+	n := utf8.RuneCountInString(s)
+	if n >= width {
 		return s
 	}
-	return s + strings.Repeat(" ", width-len(s))
+	return s + strings.Repeat(" ", width-n)
 }
 
 // capitalizeFirst returns the string with the first letter uppercased.
@@ -285,5 +339,6 @@ func capitalizeFirst(s string) string {
 	if s == "" {
 		return s
 	}
-	return strings.ToUpper(s[:1]) + s[1:]
+	r, size := utf8.DecodeRuneInString(s)
+	return string(unicode.ToUpper(r)) + s[size:]
 }
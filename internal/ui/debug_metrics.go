@@ -0,0 +1,95 @@
+package ui
+
+import (
+	"sort"
+	"time"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// maxDebugSamples is how many poll and reflection samples are kept for the debug screen
+const maxDebugSamples = 50
+
+// PollSample records one state poll of the session list
+type PollSample struct {
+	Duration  time.Duration // Time spent loading state from the database
+	Interval  time.Duration // Time since the previous poll started (0 for the first poll)
+	StartedAt time.Time
+}
+
+// ReflectionSample records when the UI noticed a state change written by a hook
+type ReflectionSample struct {
+	ObservedAt  time.Time
+	SessionName string
+	State       domain.SessionState
+	WrittenAt   time.Time // Session LastUpdated, set when the hook wrote the state
+}
+
+// Latency returns the delay between the hook writing the state and the UI showing it
+func (r ReflectionSample) Latency() time.Duration {
+	return r.ObservedAt.Sub(r.WrittenAt)
+}
+
+// DebugMetrics keeps recent UI-side timings for the debug screen
+type DebugMetrics struct {
+	polls       []PollSample
+	reflections []ReflectionSample
+}
+
+// NewDebugMetrics creates an empty DebugMetrics
+func NewDebugMetrics() *DebugMetrics {
+	return &DebugMetrics{}
+}
+
+// RecordPoll records a state poll that started at startedAt and took duration
+func (d *DebugMetrics) RecordPoll(startedAt time.Time, duration time.Duration) {
+	sample := PollSample{Duration: duration, StartedAt: startedAt}
+	if n := len(d.polls); n > 0 {
+		sample.Interval = startedAt.Sub(d.polls[n-1].StartedAt)
+	}
+	d.polls = appendBounded(d.polls, sample)
+}
+
+// RecordStateChanges records a reflection sample for every session whose state differs between states
+func (d *DebugMetrics) RecordStateChanges(oldState, newState *domain.SessionCollection, observedAt time.Time) {
+	if oldState == nil || newState == nil {
+		return
+	}
+
+	var names []string
+	for name, newInfo := range newState.Sessions {
+		if oldInfo, ok := oldState.Sessions[name]; ok && oldInfo.State != newInfo.State {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		info := newState.Sessions[name]
+		d.reflections = appendBounded(d.reflections, ReflectionSample{
+			ObservedAt:  observedAt,
+			SessionName: name,
+			State:       info.State,
+			WrittenAt:   info.LastUpdated,
+		})
+	}
+}
+
+// Polls returns recent poll samples, oldest first
+func (d *DebugMetrics) Polls() []PollSample {
+	return d.polls
+}
+
+// Reflections returns recent reflection samples, oldest first
+func (d *DebugMetrics) Reflections() []ReflectionSample {
+	return d.reflections
+}
+
+// appendBounded appends item, dropping the oldest entries beyond maxDebugSamples
+func appendBounded[T any](items []T, item T) []T {
+	items = append(items, item)
+	if len(items) > maxDebugSamples {
+		items = items[len(items)-maxDebugSamples:]
+	}
+	return items
+}
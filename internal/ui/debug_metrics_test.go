@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestDebugMetrics_RecordPoll(t *testing.T) {
+	metrics := NewDebugMetrics()
+	start := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	for i := 0; i < maxDebugSamples+5; i++ {
+		metrics.RecordPoll(start.Add(time.Duration(i)*2*time.Second), 5*time.Millisecond)
+	}
+
+	polls := metrics.Polls()
+	require.Len(t, polls, maxDebugSamples)
+	assert.Equal(t, 2*time.Second, polls[len(polls)-1].Interval)
+	assert.Equal(t, start.Add(5*2*time.Second), polls[0].StartedAt, "oldest samples should be dropped")
+}
+
+func TestDebugMetrics_RecordStateChanges(t *testing.T) {
+	metrics := NewDebugMetrics()
+	written := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	observed := written.Add(1500 * time.Millisecond)
+
+	oldState := &domain.SessionCollection{Sessions: map[string]domain.Session{
+		"changed":   {Name: "changed", State: domain.StateWorking},
+		"unchanged": {Name: "unchanged", State: domain.StateIdle},
+	}}
+	newState := &domain.SessionCollection{Sessions: map[string]domain.Session{
+		"added":     {Name: "added", State: domain.StateWorking},
+		"changed":   {Name: "changed", State: domain.StateIdle, LastUpdated: written},
+		"unchanged": {Name: "unchanged", State: domain.StateIdle},
+	}}
+
+	metrics.RecordStateChanges(oldState, newState, observed)
+
+	reflections := metrics.Reflections()
+	require.Len(t, reflections, 1)
+	assert.Equal(t, "changed", reflections[0].SessionName)
+	assert.Equal(t, domain.StateIdle, reflections[0].State)
+	assert.Equal(t, 1500*time.Millisecond, reflections[0].Latency())
+}
@@ -0,0 +1,170 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+// Number of rows shown per section of the debug screen
+const (
+	debugHookRows       = 20
+	debugPollRows       = 10
+	debugReflectionRows = 15
+)
+
+// DebugScreen displays state detection timings to diagnose stale session states
+type DebugScreen struct {
+	Completed   bool
+	content     string         // Pre-built debug content
+	initialized bool           // Track if viewport has been sized
+	keys        *KeyMap        // Key bindings for closing
+	viewport    viewport.Model // Scrollable viewport
+}
+
+// NewDebugScreen creates a debug screen from UI metrics and recent hook events (most recent first).
+// hookRecording tells whether hooks record their timings.
+func NewDebugScreen(metrics *DebugMetrics, hookEvents []domain.HookMetric, hookErr error, hookRecording bool, keys *KeyMap) *DebugScreen {
+	return &DebugScreen{
+		content:  buildDebugContent(metrics, hookEvents, hookErr, hookRecording),
+		keys:     keys,
+		viewport: viewport.New(0, 0),
+	}
+}
+
+// buildDebugContent renders the poll, reflection, and hook sections
+func buildDebugContent(metrics *DebugMetrics, hookEvents []domain.HookMetric, hookErr error, hookRecording bool) string {
+	var content string
+
+	// Poll timings
+	polls := metrics.Polls()
+	content += theme.HelpGroupStyle.Render("Poll Timings") + "\n"
+	if len(polls) == 0 {
+		content += theme.HelpDescStyle.Render("no polls yet") + "\n"
+	} else {
+		var totalLoad, maxLoad, totalInterval time.Duration
+		intervals := 0
+		for _, p := range polls {
+			totalLoad += p.Duration
+			maxLoad = max(maxLoad, p.Duration)
+			if p.Interval > 0 {
+				totalInterval += p.Interval
+				intervals++
+			}
+		}
+		summary := fmt.Sprintf("%d polls · load avg %s max %s", len(polls), formatLatency(totalLoad/time.Duration(len(polls))), formatLatency(maxLoad))
+		if intervals > 0 {
+			summary += fmt.Sprintf(" · interval avg %s", formatLatency(totalInterval/time.Duration(intervals)))
+		}
+		content += theme.HelpDescStyle.Render(summary) + "\n"
+		for _, p := range lastN(polls, debugPollRows) {
+			content += renderShortcut(p.StartedAt.Format("15:04:05.000"), fmt.Sprintf("load %s · interval %s", formatLatency(p.Duration), formatLatency(p.Interval)))
+		}
+	}
+
+	// State reflection latency
+	reflections := metrics.Reflections()
+	content += "\n" + theme.HelpGroupStyle.Render("State Reflection (hook write → list)") + "\n"
+	if len(reflections) == 0 {
+		content += theme.HelpDescStyle.Render("no state changes observed yet") + "\n"
+	} else {
+		for _, r := range lastN(reflections, debugReflectionRows) {
+			content += renderShortcut(r.ObservedAt.Format("15:04:05.000"), fmt.Sprintf("%s → %s after %s", r.SessionName, r.State, formatLatency(r.Latency())))
+		}
+	}
+
+	// Recent hook events
+	content += "\n" + theme.HelpGroupStyle.Render("Recent Hook Events") + "\n"
+	if !hookRecording {
+		content += theme.HelpDescStyle.Render("hook timings are not recorded; set hook_metrics in settings.json or ROCHA_HOOK_METRICS=1") + "\n"
+	}
+	switch {
+	case hookErr != nil:
+		content += theme.HelpDescStyle.Render("failed to load hook events: "+hookErr.Error()) + "\n"
+	case len(hookEvents) == 0:
+		content += theme.HelpDescStyle.Render("no hook events recorded yet") + "\n"
+	default:
+		for _, h := range hookEvents[:min(len(hookEvents), debugHookRows)] {
+			state := string(h.State)
+			if state == "" {
+				state = "-"
+			}
+			content += renderShortcut(h.InvokedAt.Local().Format("15:04:05.000"), fmt.Sprintf("%s %s → %s · db %s · total %s",
+				h.SessionName, h.EventType, state, formatLatency(h.HandleDuration), formatLatency(h.HandledAt.Sub(h.InvokedAt))))
+		}
+	}
+
+	return content
+}
+
+// Init implements tea.Model
+func (d *DebugScreen) Init() tea.Cmd {
+	d.viewport.KeyMap.Up.SetKeys("up", "k")
+	d.viewport.KeyMap.Down.SetKeys("down", "j")
+	return nil
+}
+
+// Update implements tea.Model
+func (d *DebugScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Footer: 2 lines (the dialog passes the height below its header)
+		viewportHeight := msg.Height - 2
+		if viewportHeight < 5 {
+			viewportHeight = 5
+		}
+
+		d.viewport.Width = msg.Width
+		d.viewport.Height = viewportHeight
+		d.viewport.SetContent(d.content)
+		d.initialized = true
+		return d, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "esc" || key.Matches(msg, d.keys.Application.Quit.Binding, d.keys.Application.DebugScreen.Binding) {
+			d.Completed = true
+			return d, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	d.viewport, cmd = d.viewport.Update(msg)
+	return d, cmd
+}
+
+// Done reports whether the screen was closed
+func (d *DebugScreen) Done() bool {
+	return d.Completed
+}
+
+// View implements tea.Model
+func (d *DebugScreen) View() string {
+	if !d.initialized {
+		return "Loading debug info..."
+	}
+
+	footer := theme.HelpStyle.Render("Press esc or q to close • ↑↓/jk/PgUp/PgDn to scroll")
+	return d.viewport.View() + "\n\n" + footer
+}
+
+// formatLatency formats a duration as milliseconds, or seconds above one second
+func formatLatency(d time.Duration) string {
+	if d >= time.Second {
+		return fmt.Sprintf("%.2fs", d.Seconds())
+	}
+	return fmt.Sprintf("%dms", d.Milliseconds())
+}
+
+// lastN returns the last n items of a slice
+func lastN[T any](items []T, n int) []T {
+	if len(items) <= n {
+		return items
+	}
+	return items[len(items)-n:]
+}
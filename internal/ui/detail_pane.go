@@ -0,0 +1,298 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+const (
+	detailPaneEventLimit       = 8   // Recent events listed for the selected session
+	detailPaneMinTerminalWidth = 110 // Narrower terminals fall back to the list alone
+	detailPaneWidthPercent     = 40  // Share of the terminal width taken by the pane
+)
+
+// checklistItem is a markdown task list entry ("- [ ] ..." or "- [x] ...") from a session note
+type checklistItem struct {
+	done bool
+	text string
+}
+
+// DetailPane shows everything known about the selected session next to the list
+type DetailPane struct {
+	activityStatsService *services.ActivityStatsService
+	events               []domain.Event // Most recent first
+	eventsErr            error
+	handoff              *domain.Event // Latest note on where the user left off (nil = none)
+	session              *domain.Session
+	visible              bool
+}
+
+// NewDetailPane creates a new DetailPane component
+func NewDetailPane(activityStatsService *services.ActivityStatsService) *DetailPane {
+	return &DetailPane{
+		activityStatsService: activityStatsService,
+	}
+}
+
+// IsVisible returns whether the pane was toggled on
+func (dp *DetailPane) IsVisible() bool {
+	return dp.visible
+}
+
+// Toggle toggles the visibility of the pane
+func (dp *DetailPane) Toggle() {
+	dp.visible = !dp.visible
+	dp.Refresh() // Events are not loaded while hidden
+}
+
+// Shown reports whether the pane is drawn at the given terminal width
+// The pane stays toggled on but hidden while the terminal is too narrow
+func (dp *DetailPane) Shown(terminalWidth int) bool {
+	return dp.visible && terminalWidth >= detailPaneMinTerminalWidth
+}
+
+// Width returns the pane width (including its border) for the given terminal width
+func (dp *DetailPane) Width(terminalWidth int) int {
+	return terminalWidth * detailPaneWidthPercent / 100
+}
+
+// SetSession sets the session whose details are displayed
+// Events are only reloaded when the selection changes (see Refresh)
+func (dp *DetailPane) SetSession(session *domain.Session) {
+	changed := session == nil || dp.session == nil || session.Name != dp.session.Name
+	dp.session = session
+	if changed {
+		dp.Refresh()
+	}
+}
+
+// Refresh reloads the recent events and the handoff note of the displayed session
+func (dp *DetailPane) Refresh() {
+	dp.events, dp.eventsErr, dp.handoff = nil, nil, nil
+	if dp.session == nil || !dp.visible {
+		return
+	}
+
+	ctx := context.Background()
+	dp.events, dp.eventsErr = dp.activityStatsService.RecentSessionEvents(ctx, dp.session.Name, detailPaneEventLimit)
+	if dp.eventsErr != nil {
+		logging.Logger.Warn("Failed to load session events", "session", dp.session.Name, "error", dp.eventsErr)
+	}
+
+	handoff, err := dp.activityStatsService.LatestHandoff(ctx, dp.session.Name)
+	if err != nil {
+		logging.Logger.Warn("Failed to load handoff note", "session", dp.session.Name, "error", err)
+	}
+	dp.handoff = handoff
+}
+
+// View renders the pane with the given outer size
+func (dp *DetailPane) View(width, height int) string {
+	contentWidth := width - 2 // Border and padding
+	lines := dp.contentLines()
+
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	for len(lines) < height {
+		lines = append(lines, "")
+	}
+
+	// Clip lines so long values never wrap into the next row
+	clip := lipgloss.NewStyle().MaxWidth(contentWidth)
+	for i, line := range lines {
+		lines[i] = clip.Render(line)
+	}
+
+	return theme.DetailPaneBorderStyle.Width(contentWidth).Render(strings.Join(lines, "\n"))
+}
+
+// contentLines builds the unclipped pane content
+func (dp *DetailPane) contentLines() []string {
+	if dp.session == nil {
+		return []string{theme.NotePaneEmptyStyle.Render("No session selected")}
+	}
+	s := dp.session
+
+	displayName := s.DisplayName
+	if displayName == "" {
+		displayName = s.Name
+	}
+	lines := []string{theme.NotePaneTitleStyle.Render(displayName), ""}
+
+	// Where the user left off comes first, as it is what they need when coming back
+	if dp.handoff != nil {
+		lines = append(lines,
+			theme.DetailPaneSectionStyle.Render("Left off")+" "+theme.DetailPaneLabelStyle.Render(formatRelativeTime(dp.handoff.Timestamp)),
+			dp.handoff.Message,
+			"")
+	}
+
+	// Metadata
+	field := func(label, value string) {
+		if value != "" {
+			lines = append(lines, theme.DetailPaneLabelStyle.Render(fmt.Sprintf("%-8s", label))+" "+value)
+		}
+	}
+	field("Session", s.Name)
+	field("State", string(s.State))
+	field("Priority", priorityChip(s.Priority))
+	if s.Status != nil {
+		field("Status", *s.Status)
+	}
+	field("Branch", s.BranchName)
+	field("Base", s.BaseBranch)
+	field("Repo", s.RepoInfo)
+	field("Path", s.WorkingDir())
+	field("Subdir", s.Subdir)
+	field("Updated", formatHybridTime(s.LastUpdated))
+	field("Tags", strings.Join(s.Tags, ", "))
+	field("Comment", strings.ReplaceAll(s.Comment, "\n", " "))
+	if s.IsFlagged {
+		field("Flagged", "yes")
+	}
+	field("Model", s.AgentModel)
+	field("Args", domain.FormatAgentArgs(s.AgentArgs))
+	if s.AllowDangerouslySkipPermissions {
+		field("Perms", theme.SkipPermissionsStyle.Render("⛨ prompts skipped"))
+	}
+	if s.AgentError != nil {
+		field("Error", theme.AgentErrorStyle.Render(s.AgentError.Label())+" "+s.AgentError.Line)
+		field("Fix", s.AgentError.Guidance())
+	}
+	if s.ResourceUsage != nil {
+		field("Usage", fmt.Sprintf("%.0f%% CPU, %s", s.ResourceUsage.CPUPercent, formatMemory(s.ResourceUsage.MemoryBytes)))
+	}
+
+	// Git stats
+	lines = append(lines, "", theme.DetailPaneSectionStyle.Render("Git"))
+	switch {
+	case s.GitStats == nil:
+		lines = append(lines, theme.NotePaneEmptyStyle.Render("Not fetched yet"))
+	case s.GitStats.Error != nil:
+		lines = append(lines, theme.NotePaneEmptyStyle.Render(s.GitStats.Error.Error()))
+	default:
+		lines = append(lines, fmt.Sprintf("%s %s in %d files",
+			theme.AdditionsStyle.Render(fmt.Sprintf("+%d", s.GitStats.Additions)),
+			theme.DeletionsStyle.Render(fmt.Sprintf("-%d", s.GitStats.Deletions)),
+			s.GitStats.ChangedFiles))
+		lines = append(lines, fmt.Sprintf("↑%d ahead, ↓%d behind", s.GitStats.Ahead, s.GitStats.Behind))
+		if s.GitStats.BaseRef != "" {
+			base := fmt.Sprintf("↑%d ahead, ↓%d behind %s", s.GitStats.BaseAhead, s.GitStats.BaseBehind, s.GitStats.BaseRef)
+			if !s.GitStats.BaseSyncedAt.IsZero() {
+				base += ", synced " + formatRelativeTime(s.GitStats.BaseSyncedAt)
+			}
+			lines = append(lines, base)
+		}
+		if s.GitStats.Stashes > 0 {
+			lines = append(lines, fmt.Sprintf("%d stashed", s.GitStats.Stashes))
+		}
+	}
+	if s.PRInfo != nil && s.PRInfo.Number > 0 {
+		lines = append(lines, fmt.Sprintf("PR #%d (%s)", s.PRInfo.Number, strings.ToLower(s.PRInfo.State)))
+	}
+	if s.CI != nil {
+		lines = append(lines, fmt.Sprintf("CI %s %s", s.CI.State, formatRelativeTime(s.CI.ReportedAt)))
+	}
+	if s.Checkpoint != nil {
+		checkpoint := "Checkpoints " + s.Checkpoint.String()
+		if s.Checkpoint.LastAt != nil {
+			checkpoint += ", last " + formatRelativeTime(*s.Checkpoint.LastAt)
+		}
+		lines = append(lines, checkpoint)
+	}
+
+	// Checklist and note
+	checklist, noteLines := parseChecklist(s.Note)
+	if len(checklist) > 0 {
+		done := 0
+		for _, item := range checklist {
+			if item.done {
+				done++
+			}
+		}
+		lines = append(lines, "", theme.DetailPaneSectionStyle.Render(fmt.Sprintf("Checklist %d/%d", done, len(checklist))))
+		for _, item := range checklist {
+			box := "☐"
+			if item.done {
+				box = "☑"
+			}
+			lines = append(lines, box+" "+item.text)
+		}
+	}
+	if len(noteLines) > 0 {
+		lines = append(lines, "", theme.DetailPaneSectionStyle.Render("Note"))
+		lines = append(lines, noteLines...)
+	}
+
+	// Recent events
+	lines = append(lines, "", theme.DetailPaneSectionStyle.Render("Recent events"))
+	switch {
+	case dp.eventsErr != nil:
+		lines = append(lines, theme.NotePaneEmptyStyle.Render("Failed to load events"))
+	case len(dp.events) == 0:
+		lines = append(lines, theme.NotePaneEmptyStyle.Render("No events recorded"))
+	}
+	for _, event := range dp.events {
+		lines = append(lines, theme.DetailPaneLabelStyle.Render(formatAbsoluteTime(event.Timestamp))+" "+describeEvent(event))
+	}
+
+	return lines
+}
+
+// describeEvent returns a short description of an event
+func describeEvent(event domain.Event) string {
+	switch event.Type {
+	case domain.EventArchive:
+		return "archived"
+	case domain.EventError:
+		return "error: " + event.Error
+	case domain.EventHandoff:
+		return "left off: " + event.Message
+	case domain.EventStateChange:
+		return "→ " + string(event.State)
+	case domain.EventStatusChange:
+		if event.Status == "" {
+			return "status cleared"
+		}
+		return "status → " + event.Status
+	default:
+		return string(event.Type)
+	}
+}
+
+// parseChecklist splits a note into its task list items and the remaining non-blank lines
+func parseChecklist(note string) ([]checklistItem, []string) {
+	var items []checklistItem
+	var rest []string
+
+	for _, line := range strings.Split(note, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+
+		marker := strings.TrimLeft(trimmed, "-*+ ")
+		if len(marker) < len(trimmed) && len(marker) >= 3 && marker[0] == '[' && marker[2] == ']' {
+			switch marker[1] {
+			case ' ':
+				items = append(items, checklistItem{text: strings.TrimSpace(marker[3:])})
+				continue
+			case 'x', 'X':
+				items = append(items, checklistItem{done: true, text: strings.TrimSpace(marker[3:])})
+				continue
+			}
+		}
+		rest = append(rest, trimmed)
+	}
+
+	return items, rest
+}
@@ -0,0 +1,68 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+func TestParseChecklist(t *testing.T) {
+	note := "# Plan\n\n- [x] write tests\n* [ ] update docs\n  - [X] nested done\nRemember [x] inline\n"
+
+	items, rest := parseChecklist(note)
+
+	assert.Equal(t, []checklistItem{
+		{done: true, text: "write tests"},
+		{text: "update docs"},
+		{done: true, text: "nested done"},
+	}, items)
+	assert.Equal(t, []string{"# Plan", "Remember [x] inline"}, rest)
+}
+
+func TestDescribeEvent(t *testing.T) {
+	tests := []struct {
+		event domain.Event
+		want  string
+	}{
+		{event: domain.Event{Type: domain.EventStateChange, State: domain.StateWaiting}, want: "→ waiting"},
+		{event: domain.Event{Type: domain.EventError, Error: "hook failed"}, want: "error: hook failed"},
+		{event: domain.Event{Type: domain.EventArchive}, want: "archived"},
+		{event: domain.Event{Type: domain.EventHandoff, Message: "tests left to fix"}, want: "left off: tests left to fix"},
+		{event: domain.Event{Type: domain.EventStatusChange}, want: "status cleared"},
+		{event: domain.Event{Type: domain.EventStatusChange, Status: "review"}, want: "status → review"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			assert.Equal(t, tt.want, describeEvent(tt.event))
+		})
+	}
+}
+
+func TestDetailPane_ShownOnlyOnWideTerminals(t *testing.T) {
+	dp := NewDetailPane(nil)
+	assert.False(t, dp.Shown(200))
+
+	dp.Toggle() // No session selected yet, so no events are loaded
+	assert.True(t, dp.Shown(detailPaneMinTerminalWidth))
+	assert.False(t, dp.Shown(detailPaneMinTerminalWidth-1))
+}
+
+func TestDetailPane_ViewFillsHeight(t *testing.T) {
+	dp := NewDetailPane(nil)
+	dp.session = &domain.Session{
+		GitStats: &domain.GitStats{Error: errors.New("not a git repository")},
+		Name:     "s1",
+		State:    domain.StateIdle,
+	}
+
+	view := dp.View(40, 30)
+
+	assert.Contains(t, view, "not a git repository")
+	assert.Contains(t, view, "No events recorded")
+	assert.Equal(t, 30, lipgloss.Height(view))
+}
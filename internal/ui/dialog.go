@@ -1,17 +1,21 @@
 package ui
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
 
 // Dialog wraps any tea.Model content and automatically adds a header with title.
 // This enforces consistent dialog headers across the application "by design" -
 // you cannot create a dialog without getting a header.
 //
 // Usage:
-//   contentForm := NewSessionForm(...)
-//   dialog := NewDialog("Create Session", contentForm, devMode)
-//   dialog.Init()  // Delegates to contentForm.Init()
-//   dialog.Update(msg)  // Delegates to contentForm.Update(msg)
-//   dialog.View()  // Returns header + contentForm.View()
+//
+//	contentForm := NewSessionForm(...)
+//	dialog := NewDialog("Create Session", contentForm, devMode)
+//	dialog.Init()  // Delegates to contentForm.Init()
+//	dialog.Update(msg)  // Delegates to contentForm.Update(msg)
+//	dialog.View()  // Returns header + contentForm.View()
 //
 // Design principles:
 // - Composition: Dialog wraps content via delegation (not inheritance)
@@ -35,13 +39,21 @@ func NewDialog(title string, content tea.Model, devMode bool) *Dialog {
 }
 
 // Init delegates to wrapped content's Init method.
+// It also asks for the terminal size, so the content is laid out to fit from the start.
 func (d *Dialog) Init() tea.Cmd {
-	return d.content.Init()
+	return tea.Batch(d.content.Init(), tea.WindowSize())
 }
 
 // Update delegates to wrapped content's Update method.
 // The returned tea.Model is the Dialog itself with updated content.
+// Window sizes are passed on without the header lines, so huh forms scroll
+// inside the space left instead of being clipped on small terminals.
+// One more line is kept free: huh leaves the blank line above its help out of its height.
 func (d *Dialog) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		msg = tea.WindowSizeMsg{Width: size.Width, Height: max(size.Height-d.headerHeight()-1, 1)}
+	}
+
 	updatedContent, cmd := d.content.Update(msg)
 	d.content = updatedContent
 	return d, cmd
@@ -53,15 +65,22 @@ func (d *Dialog) View() string {
 	return renderDialogHeader(d.devMode, d.title) + d.content.View()
 }
 
+// headerHeight returns the number of lines the header takes above the content
+func (d *Dialog) headerHeight() int {
+	// The header ends with a newline, so the content starts on its last line
+	return lipgloss.Height(renderDialogHeader(d.devMode, d.title)) - 1
+}
+
 // Content returns the wrapped content for type assertion.
 // This allows callers to access content-specific fields after Update().
 //
 // Example:
-//   if content, ok := dialog.Content().(*SessionForm); ok {
-//       if content.Completed {
-//           result := content.Result()
-//       }
-//   }
+//
+//	if content, ok := dialog.Content().(*SessionForm); ok {
+//	    if content.Completed {
+//	        result := content.Result()
+//	    }
+//	}
 func (d *Dialog) Content() tea.Model {
 	return d.content
 }
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DialogContent is the content of a dialog opened through the DialogRouter.
+// Done reports whether the user finished with it, confirming or cancelling;
+// what it holds then is read by the result handler the dialog was opened with.
+type DialogContent interface {
+	tea.Model
+	Done() bool
+}
+
+// dialogRoute is an open dialog and the handler of its result
+type dialogRoute struct {
+	dialog   *Dialog
+	onResult func(content tea.Model) tea.Cmd // Runs once the content is done and the dialog closed; nil only closes it
+}
+
+// DialogRouter keeps the dialogs open over the session list as a stack.
+// The dialog on top gets every message and is the one shown; once its content
+// is done it is popped, and the result handler it was pushed with runs.
+type DialogRouter struct {
+	routes []dialogRoute
+}
+
+// Empty reports whether no dialog is open
+func (r *DialogRouter) Empty() bool {
+	return len(r.routes) == 0
+}
+
+// Push opens a dialog on top of the others. onResult gets the content once it is done.
+func (r *DialogRouter) Push(dialog *Dialog, onResult func(content tea.Model) tea.Cmd) {
+	r.routes = append(r.routes, dialogRoute{dialog: dialog, onResult: onResult})
+}
+
+// Update sends msg to the dialog on top. When that leaves its content done, the dialog
+// is popped and the command of its result handler is returned instead, with closed true.
+func (r *DialogRouter) Update(msg tea.Msg) (cmd tea.Cmd, closed bool) {
+	if r.Empty() {
+		return nil, false
+	}
+
+	top := &r.routes[len(r.routes)-1]
+	updated, cmd := top.dialog.Update(msg)
+	if d, ok := updated.(*Dialog); ok {
+		top.dialog = d
+	}
+
+	content, ok := top.dialog.Content().(DialogContent)
+	if !ok || !content.Done() {
+		return cmd, false
+	}
+
+	route := *top
+	r.routes = r.routes[:len(r.routes)-1]
+	if route.onResult == nil {
+		return nil, true
+	}
+	return route.onResult(content), true
+}
+
+// View renders the dialog on top, empty when none is open
+func (r *DialogRouter) View() string {
+	if r.Empty() {
+		return ""
+	}
+	return r.routes[len(r.routes)-1].dialog.View()
+}
+
+// openDialog wraps content in a dialog titled title and pushes it on the router of m,
+// sized to the terminal right away. onResult runs with the content once it is done.
+func openDialog[T DialogContent](m *Model, title string, content T, onResult func(content T) tea.Cmd) tea.Cmd {
+	dialog := NewDialog(title, content, m.devMode)
+	var handler func(tea.Model) tea.Cmd
+	if onResult != nil {
+		handler = func(done tea.Model) tea.Cmd {
+			return onResult(done.(T))
+		}
+	}
+	m.dialogs.Push(dialog, handler)
+	m.state = stateDialog
+
+	// Send the initial WindowSizeMsg so viewports can lay out before the first frame
+	initCmd := dialog.Init()
+	_, sizeCmd := dialog.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+	return tea.Batch(initCmd, sizeCmd)
+}
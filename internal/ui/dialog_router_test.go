@@ -0,0 +1,73 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// doneOnEnter is dialog content that is done once enter is pressed
+type doneOnEnter struct {
+	name string
+	done bool
+}
+
+func (d *doneOnEnter) Init() tea.Cmd { return nil }
+
+func (d *doneOnEnter) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.Type == tea.KeyEnter {
+		d.done = true
+	}
+	return d, nil
+}
+
+func (d *doneOnEnter) View() string { return d.name }
+
+func (d *doneOnEnter) Done() bool { return d.done }
+
+func TestDialogRouterClosesTopDialogFirst(t *testing.T) {
+	var router DialogRouter
+	var results []string
+	onResult := func(content tea.Model) tea.Cmd {
+		results = append(results, content.(*doneOnEnter).name)
+		return nil
+	}
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	first := &doneOnEnter{name: "first"}
+	second := &doneOnEnter{name: "second"}
+	router.Push(NewDialog("First", first, false), onResult)
+	router.Push(NewDialog("Second", second, false), onResult)
+	assert.Contains(t, router.View(), "second")
+
+	_, closed := router.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	assert.False(t, closed)
+
+	_, closed = router.Update(enter)
+	require.True(t, closed)
+	assert.Equal(t, []string{"second"}, results)
+	assert.False(t, first.done, "only the dialog on top gets messages")
+	assert.Contains(t, router.View(), "first")
+
+	_, closed = router.Update(enter)
+	require.True(t, closed)
+	assert.Equal(t, []string{"second", "first"}, results)
+	assert.True(t, router.Empty())
+	assert.Empty(t, router.View())
+}
+
+func TestDialogRouterWithoutResultHandler(t *testing.T) {
+	var router DialogRouter
+	router.Push(NewDialog("Debug", &doneOnEnter{}, false), nil)
+
+	cmd, closed := router.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.True(t, closed)
+	assert.Nil(t, cmd)
+	assert.True(t, router.Empty())
+
+	cmd, closed = router.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, closed)
+	assert.Nil(t, cmd)
+}
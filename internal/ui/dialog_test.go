@@ -0,0 +1,55 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+// sizeRecorder is dialog content that remembers the last window size it got
+type sizeRecorder struct {
+	size tea.WindowSizeMsg
+}
+
+func (s *sizeRecorder) Init() tea.Cmd { return nil }
+
+func (s *sizeRecorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if size, ok := msg.(tea.WindowSizeMsg); ok {
+		s.size = size
+	}
+	return s, nil
+}
+
+func (s *sizeRecorder) View() string { return "" }
+
+func TestDialogPassesHeightBelowHeader(t *testing.T) {
+	content := &sizeRecorder{}
+	dialog := NewDialog("Create Session", content, false)
+
+	dialog.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	assert.Equal(t, tea.WindowSizeMsg{Width: 100, Height: 25}, content.size)
+
+	dialog.Update(tea.WindowSizeMsg{Width: 20, Height: 3})
+	assert.Equal(t, tea.WindowSizeMsg{Width: 20, Height: 1}, content.size)
+}
+
+func TestTerminalTooSmall(t *testing.T) {
+	tests := []struct {
+		name   string
+		width  int
+		height int
+		want   bool
+	}{
+		{name: "size not known yet", want: false},
+		{name: "minimum size", width: minTerminalWidth, height: minTerminalHeight, want: false},
+		{name: "too narrow", width: minTerminalWidth - 1, height: 40, want: true},
+		{name: "too short", width: 120, height: minTerminalHeight - 1, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, terminalTooSmall(tt.width, tt.height))
+		})
+	}
+}
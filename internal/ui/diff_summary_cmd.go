@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// diffSummaryTimeout bounds a summary; agents in print mode can take a while on large diffs
+const diffSummaryTimeout = 3 * time.Minute
+
+// DiffSummaryReadyMsg is sent when the summary of a session diff was stored as its note, or failed
+type DiffSummaryReadyMsg struct {
+	Err         error
+	SessionName string
+}
+
+// StartDiffSummary summarizes the diff of a session into its note in the background
+// Returns a tea.Cmd that will send DiffSummaryReadyMsg
+func StartDiffSummary(diffSummaryService *services.DiffSummaryService, sessionName string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), diffSummaryTimeout)
+		defer cancel()
+
+		if _, err := diffSummaryService.SummarizeDiff(ctx, sessionName); err != nil {
+			logging.Logger.Warn("Failed to summarize session diff", "session", sessionName, "error", err)
+			return DiffSummaryReadyMsg{Err: err, SessionName: sessionName}
+		}
+		return DiffSummaryReadyMsg{SessionName: sessionName}
+	}
+}
@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+// filterChips splits a filter query into its chips, one per token
+func filterChips(query string) []string {
+	return strings.Fields(query)
+}
+
+// withoutLastFilterChip returns the query without its last chip
+func withoutLastFilterChip(query string) string {
+	chips := filterChips(query)
+	if len(chips) == 0 {
+		return ""
+	}
+	return strings.Join(chips[:len(chips)-1], " ")
+}
+
+// renderFilterChips renders the chips of an applied filter on a single line of at most width
+// columns. Tokens share a color per key (state:, repo:, ...) so the same kind of filter looks
+// the same; in accessibility mode chips are bracketed text instead.
+func renderFilterChips(query, removeKey string, width int, accessible bool) string {
+	line := theme.HelpLabelStyle.Render(i18n.T("filter.label"))
+	for _, chip := range filterChips(query) {
+		if accessible {
+			line += " [" + chip + "]"
+			continue
+		}
+		key, _, _ := strings.Cut(chip, ":")
+		line += " " + theme.TagChipStyle(tagColor("filter:"+strings.ToLower(key))).Render(chip)
+	}
+	line += "  " + theme.HelpShortcutStyle.Render(removeKey) + theme.HelpLabelStyle.Render(" "+i18n.T("filter.remove_last"))
+	return lipgloss.NewStyle().MaxWidth(width).Render(line)
+}
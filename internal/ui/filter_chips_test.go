@@ -0,0 +1,17 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithoutLastFilterChip(t *testing.T) {
+	assert.Equal(t, "state:waiting repo:acme", withoutLastFilterChip("state:waiting  repo:acme flag:yes"))
+	assert.Equal(t, "", withoutLastFilterChip("login"))
+	assert.Equal(t, "", withoutLastFilterChip(""))
+}
+
+func TestRenderFilterChips_Accessible(t *testing.T) {
+	assert.Equal(t, "filter: [state:waiting] [login]  backspace remove last", renderFilterChips("state:waiting login", "backspace", 80, true))
+}
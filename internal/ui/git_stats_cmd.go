@@ -6,14 +6,15 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
-	"github.com/renato0307/rocha/internal/services"
 	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
 )
 
 // GitStatsRequest represents a request to fetch git stats
 type GitStatsRequest struct {
-	Priority     int // Higher priority = fetched first
+	BaseBranch   string // Empty compares against origin's default branch
+	Priority     int    // Higher priority = fetched first
 	SessionName  string
 	WorktreePath string
 }
@@ -30,6 +31,12 @@ type GitStatsErrorMsg struct {
 	SessionName string
 }
 
+// BaseFetchErrorMsg is sent when fetching a session's base branch fails
+type BaseFetchErrorMsg struct {
+	Err         error
+	SessionName string
+}
+
 // StartGitStatsFetcher starts an async worker that fetches git stats
 // Returns a tea.Cmd that will send GitStatsReadyMsg or GitStatsErrorMsg
 func StartGitStatsFetcher(gitService *services.GitService, request GitStatsRequest) tea.Cmd {
@@ -39,7 +46,7 @@ func StartGitStatsFetcher(gitService *services.GitService, request GitStatsReque
 		defer cancel()
 
 		// Fetch stats
-		stats, err := gitService.FetchGitStats(ctx, request.WorktreePath)
+		stats, err := gitService.FetchGitStats(ctx, request.WorktreePath, request.BaseBranch)
 		if err != nil {
 			logging.Logger.Warn("Failed to fetch git stats",
 				"session", request.SessionName,
@@ -56,3 +63,26 @@ func StartGitStatsFetcher(gitService *services.GitService, request GitStatsReque
 		}
 	}
 }
+
+// StartBaseFetch fetches a session's base branch from origin, then refreshes its git stats
+// so the list shows how far behind the base the session is
+// Returns a tea.Cmd that will send GitStatsReadyMsg, GitStatsErrorMsg, or BaseFetchErrorMsg
+func StartBaseFetch(gitService *services.GitService, request GitStatsRequest) tea.Cmd {
+	return func() tea.Msg {
+		// Fetch can be slow on large repos - allow more time than stats
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		if err := gitService.FetchBase(ctx, request.WorktreePath, request.BaseBranch); err != nil {
+			logging.Logger.Warn("Failed to fetch base branch",
+				"session", request.SessionName,
+				"error", err)
+			return BaseFetchErrorMsg{
+				Err:         err,
+				SessionName: request.SessionName,
+			}
+		}
+
+		return StartGitStatsFetcher(gitService, request)()
+	}
+}
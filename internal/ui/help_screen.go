@@ -1,22 +1,105 @@
 package ui
 
 import (
+	"strings"
+
 	"github.com/charmbracelet/bubbles/key"
-	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/theme"
 )
 
-// HelpScreen displays keyboard shortcuts organized by category
+// helpChromeLines is the height of the search line, the details of the selected entry,
+// and the footer, around the scrolling entries
+const helpChromeLines = 6
+
+// helpSettings maps key names to the settings.json key that changes how the action works
+var helpSettings = map[string]string{
+	"apply_view":          "views",
+	"clean_orphan_shells": "clean_orphan_shells",
+	"cycle_sort":          "sort_presets",
+	"cycle_status":        "statuses",
+	"fetch_base":          "background_fetch_minutes",
+	"handoff":             "handoff_prompt",
+	"help":                "tips_enabled",
+	"open_changed_files":  "editor_integration",
+	"open_editor":         "editor_integration",
+	"run_checks":          "checks",
+	"send_text":           "prompt_review",
+	"set_status":          "statuses",
+	"summarize_diff":      "summary_command",
+	"switch_profile":      "profiles",
+	"timestamps":          "timestamp_mode",
+	"token_chart":         "show_token_chart",
+	"tool_audit":          "allow_dangerously_skip_permissions",
+	"views":               "views",
+}
+
+// worktreeActions are the actions that only work on sessions with a working directory
+var worktreeActions = map[string]bool{
+	"copy_path":          true,
+	"fetch_base":         true,
+	"open_changed_files": true,
+	"open_editor":        true,
+	"rebase":             true,
+	"run_checks":         true,
+	"stash":              true,
+	"summarize_diff":     true,
+	"switch_branch":      true,
+	"unstash":            true,
+}
+
+// helpEntry is one line of the help browser
+type helpEntry struct {
+	applies bool // False when the action does nothing for the selected session
+	desc    string
+	key     string
+	name    string // Key definition name ("" for entries that are not key bindings)
+	section string
+	setting string // settings.json key that changes how the action works ("" = none)
+}
+
+// runnable returns the key definition run with enter, or nil
+func (e helpEntry) runnable() *KeyDefinition {
+	if e.name == "" || !e.applies {
+		return nil
+	}
+	if def := GetKeyDefinition(e.name); def != nil && def.Msg != nil {
+		return def
+	}
+	return nil
+}
+
+// matches returns true if the entry matches a lowercase search query
+func (e helpEntry) matches(query string) bool {
+	return fuzzyMatch(query, e.desc) ||
+		strings.Contains(strings.ToLower(e.key), query) ||
+		strings.Contains(strings.ToLower(e.section), query) ||
+		(e.setting != "" && strings.Contains(e.setting, query))
+}
+
+// HelpScreenResult contains what to do after the help browser closes
+type HelpScreenResult struct {
+	Action       *KeyDefinition // Action to run on the selected session
+	OpenSettings bool           // Open settings.json in the editor
+}
+
+// HelpScreen is a searchable browser of the keyboard shortcuts, by category.
+// It shows the configured keys, dims the actions that do nothing for the selected
+// session, runs the selected action with enter, and names the setting behind it.
 type HelpScreen struct {
 	Completed   bool
-	content     string         // Pre-built help content
-	height      int            // Terminal height
-	initialized bool           // Track if viewport has been sized
-	keys        *KeyMap        // Key bindings to display
-	viewport    viewport.Model // Scrollable viewport
-	width       int            // Terminal width
+	entries     []helpEntry // All entries, in display order
+	height      int         // Terminal height
+	Result      HelpScreenResult
+	scroll      int // First row shown
+	searchInput textinput.Model
+	selected    int         // Index in visible of the selected entry
+	visible     []helpEntry // Entries matching the search
+	width       int         // Terminal width
 }
 
 // renderShortcut renders a single shortcut line with key and description
@@ -24,93 +107,169 @@ func renderShortcut(key, description string) string {
 	return theme.HelpKeyStyle.Render(key) + theme.HelpDescStyle.Render(description) + "\n"
 }
 
-// buildHelpContent builds the complete help text content using key bindings
-func buildHelpContent(keys *KeyMap) string {
-	var content string
-
-	// Navigation
-	content += theme.HelpGroupStyle.Render("Navigation") + "\n"
-	content += renderBinding(keys.Navigation.Up.Binding)
-	content += renderBinding(keys.Navigation.Down.Binding)
-	content += renderBinding(keys.Navigation.MoveUp.Binding)
-	content += renderBinding(keys.Navigation.MoveDown.Binding)
-	content += renderBinding(keys.Navigation.Filter.Binding)
-	content += renderBinding(keys.Navigation.ClearFilter.Binding)
-
-	// Session Management
-	content += "\n" + theme.HelpGroupStyle.Render("Session Management") + "\n"
-	content += renderBinding(keys.SessionManagement.New.Binding)
-	content += renderBinding(keys.SessionManagement.NewFromRepo.Binding)
-	content += renderBinding(keys.SessionManagement.Rename.Binding)
-	content += renderBinding(keys.SessionManagement.Archive.Binding)
-	content += renderBinding(keys.SessionManagement.Kill.Binding)
-
-	// Session Metadata
-	content += "\n" + theme.HelpGroupStyle.Render("Session Metadata") + "\n"
-	content += renderBinding(keys.SessionMetadata.Comment.Binding)
-	content += renderBinding(keys.SessionMetadata.Flag.Binding)
-	content += renderBinding(keys.SessionMetadata.StatusCycle.Binding)
-	content += renderBinding(keys.SessionMetadata.StatusSetForm.Binding)
-
-	// Experimental Features
-	content += "\n" + theme.HelpGroupStyle.Render("Experimental Features") + "\n"
-	content += renderShortcut(keys.SessionMetadata.SendText.Binding.Help().Key, keys.SessionMetadata.SendText.Binding.Help().Desc + " (experimental)")
-
-	// Session Actions
-	content += "\n" + theme.HelpGroupStyle.Render("Session Actions") + "\n"
-	content += renderBinding(keys.SessionActions.Open.Binding)
-	content += renderBinding(keys.SessionActions.Detach.Binding)
-	content += renderBinding(keys.SessionActions.QuickOpen.Binding)
-	content += renderBinding(keys.SessionActions.OpenShell.Binding)
-	content += renderBinding(keys.SessionActions.OpenEditor.Binding)
-	content += renderBinding(keys.SessionActions.OpenPR.Binding)
+// buildHelpEntries lists the help entries by category, using the configured keys.
+// session is the selected session (nil if none), used to tell which actions apply.
+// In accessibility mode the state indicators are listed by their text labels.
+func buildHelpEntries(keys *KeyMap, accessible bool, session *domain.Session) []helpEntry {
+	var entries []helpEntry
+	section := ""
+	add := func(name string, binding key.Binding) {
+		help := binding.Help()
+		entries = append(entries, helpEntry{
+			applies: helpEntryApplies(name, session),
+			desc:    help.Desc,
+			key:     help.Key,
+			name:    name,
+			section: section,
+			setting: helpSettings[name],
+		})
+	}
+	addStatic := func(key, desc string) {
+		entries = append(entries, helpEntry{applies: true, desc: desc, key: key, section: section})
+	}
+
+	section = i18n.T("help.group.navigation")
+	add("up", keys.Navigation.Up.Binding)
+	add("down", keys.Navigation.Down.Binding)
+	add("move_up", keys.Navigation.MoveUp.Binding)
+	add("move_down", keys.Navigation.MoveDown.Binding)
+	add("filter", keys.Navigation.Filter.Binding)
+	add("clear_filter", keys.Navigation.ClearFilter.Binding)
+	add("remove_filter_chip", keys.Navigation.RemoveFilterChip.Binding)
+	add("cycle_sort", keys.Navigation.CycleSort.Binding)
+	add("cycle_density", keys.Navigation.CycleDensity.Binding)
+	add("switch_workspace", keys.Navigation.SwitchWorkspace.Binding)
+	add("views", keys.Navigation.Views.Binding)
+	add("apply_view", keys.Navigation.ApplyView.Binding)
+
+	section = i18n.T("help.group.session_management")
+	add("new_session", keys.SessionManagement.New.Binding)
+	add("new_from_repo", keys.SessionManagement.NewFromRepo.Binding)
+	add("new_from_clipboard", keys.SessionManagement.NewFromClip.Binding)
+	add("rename", keys.SessionManagement.Rename.Binding)
+	add("archive", keys.SessionManagement.Archive.Binding)
+	add("kill", keys.SessionManagement.Kill.Binding)
+	add("kill_process", keys.SessionManagement.KillProcess.Binding)
+	add("move_sessions", keys.SessionManagement.Move.Binding)
+
+	section = i18n.T("help.group.session_metadata")
+	add("comment", keys.SessionMetadata.Comment.Binding)
+	add("note", keys.SessionMetadata.Note.Binding)
+	add("summarize_diff", keys.SessionMetadata.SummarizeDiff.Binding)
+	add("handoff", keys.SessionMetadata.Handoff.Binding)
+	add("tags", keys.SessionMetadata.Tags.Binding)
+	add("attachments", keys.SessionMetadata.Attachments.Binding)
+	add("timer", keys.SessionMetadata.Timer.Binding)
+	add("flag", keys.SessionMetadata.Flag.Binding)
+	add("cycle_priority", keys.SessionMetadata.PriorityCycle.Binding)
+	add("cycle_status", keys.SessionMetadata.StatusCycle.Binding)
+	add("set_status", keys.SessionMetadata.StatusSetForm.Binding)
+
+	section = i18n.T("help.group.experimental")
+	add("send_text", keys.SessionMetadata.SendText.Binding)
+	entries[len(entries)-1].desc = i18n.Tf("help.experimental", entries[len(entries)-1].desc)
+
+	section = i18n.T("help.group.session_actions")
+	add("open", keys.SessionActions.Open.Binding)
+	add("detach", keys.SessionActions.Detach.Binding)
+	add("quick_open", keys.SessionActions.QuickOpen.Binding)
+	add("quick_jump", keys.SessionActions.QuickJump.Binding)
+	add("pause", keys.SessionActions.Pause.Binding)
+	add("open_shell", keys.SessionActions.OpenShell.Binding)
+	add("open_editor", keys.SessionActions.OpenEditor.Binding)
+	add("open_changed_files", keys.SessionActions.OpenChangedFiles.Binding)
+	add("open_pr", keys.SessionActions.OpenPR.Binding)
+	add("fetch_base", keys.SessionActions.FetchBase.Binding)
+	add("rebase", keys.SessionActions.Rebase.Binding)
+	add("stash", keys.SessionActions.Stash.Binding)
+	add("unstash", keys.SessionActions.Unstash.Binding)
+	add("switch_branch", keys.SessionActions.SwitchBranch.Binding)
+	add("run_checks", keys.SessionActions.RunChecks.Binding)
+	add("check_output", keys.SessionActions.CheckOutput.Binding)
+	add("tool_audit", keys.SessionActions.ToolAudit.Binding)
+	add("copy_summary", keys.SessionActions.CopySummary.Binding)
+	add("copy_branch", keys.SessionActions.CopyBranch.Binding)
+	add("copy_path", keys.SessionActions.CopyPath.Binding)
+	add("copy_pr_url", keys.SessionActions.CopyPRURL.Binding)
 
 	// Inside Session Shortcuts (tmux-level)
-	content += "\n" + theme.HelpGroupStyle.Render("Inside Session Shortcuts") + "\n"
-	content += renderShortcut(keys.SessionActions.Detach.Binding.Help().Key, "quick return to list")
-	content += renderShortcut("ctrl+]", "swap between claude and shell sessions")
-	content += renderShortcut("ctrl+b then d", "standard tmux detach (also works)")
-
-	// Application
-	content += "\n" + theme.HelpGroupStyle.Render("Application") + "\n"
-	content += renderBinding(keys.Application.CommandPalette.Binding)
-	content += renderBinding(keys.Application.Timestamps.Binding)
-	content += renderBinding(keys.Application.TokenChart.Binding)
-	content += renderBinding(keys.Application.Help.Binding)
-	content += renderBinding(keys.Application.Quit.Binding)
-	content += renderBinding(keys.Application.ForceQuit.Binding)
-
-	// State Indicators
-	content += "\n" + theme.HelpGroupStyle.Render("State Indicators (read-only)") + "\n"
-	content += renderShortcut("●", "session is working")
-	content += renderShortcut("○", "session is idle")
-	content += renderShortcut("◐", "session is waiting")
-	content += renderShortcut("■", "session has exited")
-	content += renderShortcut("⚑", "session has flag set")
-	content += renderShortcut("⌨", "session has comment")
-	content += renderShortcut(">_", "shell session active")
-	content += renderShortcut("[spec], [plan], etc.", "implementation status")
-
-	return content
-}
-
-// NewHelpScreen creates a new help screen component
-func NewHelpScreen(keys *KeyMap) *HelpScreen {
-	content := buildHelpContent(keys)
+	section = i18n.T("help.group.inside_session")
+	addStatic(keys.SessionActions.Detach.Binding.Help().Key, i18n.T("help.inside.detach"))
+	addStatic("ctrl+]", i18n.T("help.inside.swap"))
+	addStatic(i18n.T("help.inside.tmux_detach_key"), i18n.T("help.inside.tmux_detach"))
+
+	section = i18n.T("help.group.application")
+	add("command_palette", keys.Application.CommandPalette.Binding)
+	add("timestamps", keys.Application.Timestamps.Binding)
+	add("token_chart", keys.Application.TokenChart.Binding)
+	add("token_chart_by_model", keys.Application.TokenChartByModel.Binding)
+	add("token_chart_cache", keys.Application.TokenChartCache.Binding)
+	add("note_pane", keys.Application.NotePane.Binding)
+	add("detail_pane", keys.Application.DetailPane.Binding)
+	add("open_settings", keys.Application.OpenSettings.Binding)
+	add("switch_profile", keys.Application.SwitchProfile.Binding)
+	add("clean_orphan_shells", keys.Application.CleanOrphanShells.Binding)
+	add("help", keys.Application.Help.Binding)
+	add("quit", keys.Application.Quit.Binding)
+	add("force_quit", keys.Application.ForceQuit.Binding)
+
+	section = i18n.T("help.group.indicators")
+	addStatic(indicatorText(accessible, domain.SymbolWorking, "working"), i18n.T("help.indicator.working"))
+	addStatic(indicatorText(accessible, domain.SymbolIdle, "idle"), i18n.T("help.indicator.idle"))
+	addStatic(indicatorText(accessible, domain.SymbolWaiting, "waiting"), i18n.T("help.indicator.waiting"))
+	addStatic(indicatorText(accessible, domain.SymbolPaused, "paused"), i18n.T("help.indicator.paused"))
+	addStatic(indicatorText(accessible, domain.SymbolExited, "exited"), i18n.T("help.indicator.exited"))
+	addStatic(indicatorText(accessible, "⚑", "flagged"), i18n.T("help.indicator.flagged"))
+	addStatic(indicatorText(accessible, "⌨", "comment"), i18n.T("help.indicator.comment"))
+	addStatic(indicatorText(accessible, "⌂", "no worktree"), i18n.T("help.indicator.no_worktree"))
+	addStatic(indicatorText(accessible, "⛨", "skips permissions"), i18n.T("help.indicator.skips_permissions"))
+	addStatic(indicatorText(accessible, ">_", "shell"), i18n.T("help.indicator.shell"))
+	addStatic("throttled", i18n.T("help.indicator.throttled"))
+	addStatic("[spec], [plan], etc.", i18n.T("help.indicator.status"))
+
+	return entries
+}
+
+// helpEntryApplies returns false for session actions when no session is selected,
+// and for git actions when the selected session has no working directory
+func helpEntryApplies(name string, session *domain.Session) bool {
+	def := GetKeyDefinition(name)
+	if def == nil {
+		return true
+	}
+	if _, isSessionAction := def.Msg.(SessionAwareMsg); isSessionAction && session == nil {
+		return false
+	}
+	if worktreeActions[name] && (session == nil || session.WorkingDir() == "") {
+		return false
+	}
+	return true
+}
+
+// NewHelpScreen creates a new help browser.
+// session is the selected session, or nil if none is selected.
+func NewHelpScreen(keys *KeyMap, accessible bool, session *domain.Session) *HelpScreen {
+	ti := textinput.New()
+	ti.Prompt = i18n.T("help.search_prompt")
+	ti.PromptStyle = theme.FilterPromptStyle
+	ti.Cursor.Style = theme.FilterCursorStyle
+	ti.Placeholder = i18n.T("help.search_placeholder")
+	ti.PlaceholderStyle = theme.DimmedStyle
+	ti.Focus()
+	ti.CharLimit = 50
+	ti.Width = 40
+
+	entries := buildHelpEntries(keys, accessible, session)
 	return &HelpScreen{
-		Completed:   false,
-		content:     content,
-		initialized: false,
-		keys:        keys,
-		viewport:    viewport.New(0, 0),
+		entries:     entries,
+		searchInput: ti,
+		visible:     entries,
 	}
 }
 
 // Init implements tea.Model
 func (h *HelpScreen) Init() tea.Cmd {
-	h.viewport.KeyMap.Up.SetKeys("up", "k")
-	h.viewport.KeyMap.Down.SetKeys("down", "j")
-	return nil
+	return textinput.Blink
 }
 
 // Update implements tea.Model
@@ -119,39 +278,189 @@ func (h *HelpScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		h.width = msg.Width
 		h.height = msg.Height
-
-		// Dialog header: 4 lines, Footer: 2 lines
-		viewportHeight := msg.Height - 6
-		if viewportHeight < 5 {
-			viewportHeight = 5
-		}
-
-		h.viewport.Width = msg.Width
-		h.viewport.Height = viewportHeight
-		h.viewport.SetContent(h.content)
-		h.initialized = true
+		h.scrollToSelected()
 		return h, nil
 
 	case tea.KeyMsg:
-		if msg.String() == "esc" || key.Matches(msg, h.keys.Application.Quit.Binding, h.keys.Application.Help.Binding) {
+		switch msg.String() {
+		case "esc":
+			// The first esc clears the search
+			if h.searchInput.Value() != "" {
+				h.searchInput.SetValue("")
+				h.search()
+				return h, nil
+			}
+			h.Completed = true
+			return h, nil
+		case "ctrl+c":
 			h.Completed = true
 			return h, nil
+		case "enter":
+			if h.selected < len(h.visible) {
+				if def := h.visible[h.selected].runnable(); def != nil {
+					h.Result.Action = def
+					h.Completed = true
+				}
+			}
+			return h, nil
+		case "ctrl+e":
+			h.Result.OpenSettings = true
+			h.Completed = true
+			return h, nil
+		case "up", "ctrl+k":
+			h.moveSelection(-1)
+			return h, nil
+		case "down", "ctrl+j":
+			h.moveSelection(1)
+			return h, nil
+		case "pgup":
+			h.moveSelection(-h.pageSize())
+			return h, nil
+		case "pgdown":
+			h.moveSelection(h.pageSize())
+			return h, nil
 		}
 	}
 
 	var cmd tea.Cmd
-	h.viewport, cmd = h.viewport.Update(msg)
+	h.searchInput, cmd = h.searchInput.Update(msg)
+	h.search()
 	return h, cmd
 }
 
+// search keeps the entries matching the search input, keeping the selected entry when it
+// still matches
+func (h *HelpScreen) search() {
+	var current helpEntry
+	if h.selected < len(h.visible) {
+		current = h.visible[h.selected]
+	}
+
+	query := strings.ToLower(strings.TrimSpace(h.searchInput.Value()))
+	if query == "" {
+		h.visible = h.entries
+	} else {
+		h.visible = nil
+		for _, entry := range h.entries {
+			if entry.matches(query) {
+				h.visible = append(h.visible, entry)
+			}
+		}
+	}
+
+	h.selected = 0
+	for i, entry := range h.visible {
+		if entry == current {
+			h.selected = i
+			break
+		}
+	}
+	h.scrollToSelected()
+}
+
+// moveSelection moves the selection by delta entries, stopping at the ends
+func (h *HelpScreen) moveSelection(delta int) {
+	h.selected = max(min(h.selected+delta, len(h.visible)-1), 0)
+	h.scrollToSelected()
+}
+
+// pageSize returns how many rows of entries fit on the screen
+func (h *HelpScreen) pageSize() int {
+	if h.height == 0 {
+		return 20
+	}
+	return max(h.height-helpChromeLines, 3)
+}
+
+// rows renders the visible entries under their section titles, and returns the
+// row of the selected entry
+func (h *HelpScreen) rows() ([]string, int) {
+	var rows []string
+	selectedRow := 0
+	section := ""
+	for i, entry := range h.visible {
+		if entry.section != section {
+			if section != "" {
+				rows = append(rows, "")
+			}
+			section = entry.section
+			rows = append(rows, theme.HelpGroupStyle.MarginTop(0).Render(section))
+		}
+
+		prefix := "  "
+		if i == h.selected {
+			prefix = "> "
+			selectedRow = len(rows)
+		}
+		line := theme.HelpKeyStyle.Render(entry.key) + theme.HelpDescStyle.Render(entry.desc)
+		if !entry.applies {
+			line = theme.HelpKeyStyle.Foreground(theme.ColorDimmed).Render(entry.key) +
+				theme.DimmedStyle.Render(entry.desc+"  "+i18n.T("help.not_applicable"))
+		}
+		rows = append(rows, prefix+line)
+	}
+	return rows, selectedRow
+}
+
+// scrollToSelected scrolls so the selected entry, and its section title when it is the
+// first of its section, are shown
+func (h *HelpScreen) scrollToSelected() {
+	_, selectedRow := h.rows()
+	page := h.pageSize()
+	top := selectedRow
+	if top > 0 && (h.selected == 0 || h.visible[h.selected-1].section != h.visible[h.selected].section) {
+		top-- // The section title
+	}
+	if top < h.scroll {
+		h.scroll = top
+	}
+	if selectedRow >= h.scroll+page {
+		h.scroll = selectedRow - page + 1
+	}
+}
+
+// details describes what enter and ctrl+e do for the selected entry
+func (h *HelpScreen) details() string {
+	if h.selected >= len(h.visible) {
+		return ""
+	}
+	entry := h.visible[h.selected]
+
+	var parts []string
+	if entry.runnable() != nil {
+		parts = append(parts, theme.HelpShortcutStyle.Render("enter")+theme.HelpLabelStyle.Render(" "+i18n.T("help.run")))
+	}
+	if entry.setting != "" {
+		parts = append(parts, theme.HelpShortcutStyle.Render("ctrl+e")+theme.HelpLabelStyle.Render(" "+i18n.Tf("help.setting", entry.setting)))
+	}
+	if entry.name != "" {
+		parts = append(parts, theme.HelpLabelStyle.Render(i18n.Tf("help.remap", entry.name)))
+	}
+	return strings.Join(parts, theme.HelpLabelStyle.Render(" • "))
+}
+
+// Done reports whether the screen was closed, with or without picking an action
+func (h *HelpScreen) Done() bool {
+	return h.Completed
+}
+
 // View implements tea.Model
 func (h *HelpScreen) View() string {
-	if !h.initialized {
-		return "Loading help..."
+	rows, _ := h.rows()
+	if len(rows) == 0 {
+		rows = []string{theme.DimmedStyle.Render("  " + i18n.T("help.empty"))}
+	}
+
+	page := h.pageSize()
+	start := min(h.scroll, max(len(rows)-page, 0))
+	end := min(start+page, len(rows))
+	shown := rows[start:end]
+	for len(shown) < page {
+		shown = append(shown, "")
 	}
 
-	footer := theme.HelpStyle.Render("Press esc, q, h, or ? to close • ↑↓/jk/PgUp/PgDn to scroll")
-	return h.viewport.View() + "\n\n" + footer
+	footer := theme.HelpStyle.Render(i18n.T("help.footer"))
+	return h.searchInput.View() + "\n\n" + strings.Join(shown, "\n") + "\n\n" + h.details() + "\n" + footer
 }
 
 // renderBinding renders a single shortcut line from a key binding
@@ -0,0 +1,102 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+)
+
+// findHelpEntry returns the entry of a key name
+func findHelpEntry(t *testing.T, entries []helpEntry, name string) helpEntry {
+	t.Helper()
+	for _, entry := range entries {
+		if entry.name == name {
+			return entry
+		}
+	}
+	t.Fatalf("no help entry for %s", name)
+	return helpEntry{}
+}
+
+func TestBuildHelpEntries(t *testing.T) {
+	keys := NewKeyMap(config.KeyBindingsConfig{"rebase": {"ctrl+r"}})
+
+	tests := []struct {
+		name    string
+		session *domain.Session
+		action  string
+		applies bool
+	}{
+		{name: "global action without session", action: "cycle_sort", applies: true},
+		{name: "session action without session", action: "flag", applies: false},
+		{name: "session action with session", session: &domain.Session{Name: "api"}, action: "flag", applies: true},
+		{name: "git action without worktree", session: &domain.Session{Name: "api"}, action: "rebase", applies: false},
+		{name: "git action with worktree", session: &domain.Session{Name: "api", WorktreePath: "/tmp/api"}, action: "rebase", applies: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry := findHelpEntry(t, buildHelpEntries(&keys, false, tt.session), tt.action)
+			assert.Equal(t, tt.applies, entry.applies)
+			assert.Equal(t, tt.applies, entry.runnable() != nil)
+		})
+	}
+
+	t.Run("shows remapped keys and settings", func(t *testing.T) {
+		entries := buildHelpEntries(&keys, false, nil)
+		assert.Equal(t, "ctrl+r", findHelpEntry(t, entries, "rebase").key)
+		assert.Equal(t, "sort_presets", findHelpEntry(t, entries, "cycle_sort").setting)
+	})
+}
+
+func TestHelpScreen(t *testing.T) {
+	keys := NewKeyMap(nil)
+	session := &domain.Session{Name: "api", WorktreePath: "/tmp/api"}
+
+	typeText := func(h *HelpScreen, text string) {
+		for _, r := range text {
+			h.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		}
+	}
+
+	t.Run("searches by description and runs the selected action", func(t *testing.T) {
+		h := NewHelpScreen(&keys, false, session)
+		typeText(h, "rebase")
+
+		require.NotEmpty(t, h.visible)
+		assert.Equal(t, "rebase", h.visible[0].name)
+
+		h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		assert.True(t, h.Completed)
+		require.NotNil(t, h.Result.Action)
+		assert.Equal(t, "rebase", h.Result.Action.Name)
+	})
+
+	t.Run("esc clears the search before closing", func(t *testing.T) {
+		h := NewHelpScreen(&keys, false, session)
+		typeText(h, "zzzz")
+		assert.Empty(t, h.visible)
+
+		h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		assert.False(t, h.Completed)
+		assert.Len(t, h.visible, len(h.entries))
+
+		h.Update(tea.KeyMsg{Type: tea.KeyEsc})
+		assert.True(t, h.Completed)
+		assert.Nil(t, h.Result.Action)
+	})
+
+	t.Run("enter does nothing on entries that are not actions", func(t *testing.T) {
+		h := NewHelpScreen(&keys, false, nil)
+		typeText(h, "flag")
+		require.NotEmpty(t, h.visible)
+
+		h.Update(tea.KeyMsg{Type: tea.KeyEnter})
+		assert.False(t, h.Completed)
+	})
+}
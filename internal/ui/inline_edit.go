@@ -0,0 +1,97 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+// InlineEditField identifies which session field is edited on the list row
+type InlineEditField string
+
+// Inline edit fields
+const (
+	InlineEditComment InlineEditField = "comment" // Replaces the git ref line
+	InlineEditHandoff InlineEditField = "handoff" // Replaces the git ref line
+	InlineEditRename  InlineEditField = "rename"  // Replaces the session name
+)
+
+// InlineEdit is a single-line text input drawn over a session list row.
+// It is shared by the list and its delegate so rendering follows the edit.
+type InlineEdit struct {
+	active      bool
+	field       InlineEditField
+	input       textinput.Model
+	sessionName string
+}
+
+// NewInlineEdit creates an inactive inline edit
+func NewInlineEdit() *InlineEdit {
+	return &InlineEdit{}
+}
+
+// Start begins editing field of a session, pre-filled with value
+func (ie *InlineEdit) Start(field InlineEditField, sessionName, value string, width int) tea.Cmd {
+	ti := textinput.New()
+	ti.Prompt = ""
+	ti.Cursor.Style = theme.FilterCursorStyle
+	ti.SetValue(value)
+	ti.CursorEnd()
+	ti.Width = width
+	switch field {
+	case InlineEditComment:
+		ti.CharLimit = 500 // Same limit as the comment dialog
+	case InlineEditHandoff:
+		ti.CharLimit = 500
+		ti.Placeholder = i18n.T("list.handoff_placeholder")
+	}
+	ti.Focus()
+
+	ie.active = true
+	ie.field = field
+	ie.input = ti
+	ie.sessionName = sessionName
+	return textinput.Blink
+}
+
+// Stop ends the edit without committing
+func (ie *InlineEdit) Stop() {
+	ie.active = false
+	ie.input.Blur()
+}
+
+// Active reports whether an edit is in progress
+func (ie *InlineEdit) Active() bool {
+	return ie.active
+}
+
+// Editing reports whether field of the given session is being edited
+func (ie *InlineEdit) Editing(sessionName string, field InlineEditField) bool {
+	return ie.active && ie.sessionName == sessionName && ie.field == field
+}
+
+// Update forwards a message to the text input
+func (ie *InlineEdit) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+	ie.input, cmd = ie.input.Update(msg)
+	return cmd
+}
+
+// Commit ends the edit and returns the message carrying the entered value
+func (ie *InlineEdit) Commit() InlineEditMsg {
+	ie.Stop()
+	return InlineEditMsg{
+		Field:       ie.field,
+		SessionName: ie.sessionName,
+		Value:       strings.TrimSpace(ie.input.Value()),
+	}
+}
+
+// View renders the text input
+func (ie *InlineEdit) View() string {
+	return ie.input.View()
+}
@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+func TestInlineEdit_CommitReturnsTypedValue(t *testing.T) {
+	ie := NewInlineEdit()
+	assert.False(t, ie.Active())
+
+	ie.Start(InlineEditRename, "my-session", "old", 40)
+	assert.True(t, ie.Active())
+	assert.True(t, ie.Editing("my-session", InlineEditRename))
+	assert.False(t, ie.Editing("my-session", InlineEditComment))
+	assert.False(t, ie.Editing("other", InlineEditRename))
+
+	ie.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	ie.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	ie.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	ie.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("new name ")})
+
+	msg := ie.Commit()
+	assert.False(t, ie.Active())
+	assert.Equal(t, InlineEditMsg{
+		Field:       InlineEditRename,
+		SessionName: "my-session",
+		Value:       "new name",
+	}, msg)
+}
+
+func TestInlineEdit_StopCancels(t *testing.T) {
+	ie := NewInlineEdit()
+	ie.Start(InlineEditComment, "my-session", "note", 40)
+
+	ie.Stop()
+
+	assert.False(t, ie.Active())
+	assert.False(t, ie.Editing("my-session", InlineEditComment))
+}
+
+func TestSessionList_UpdateInlineEdit(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        tea.KeyMsg
+		wantActive bool
+		wantMsg    bool
+	}{
+		{name: "enter commits", key: tea.KeyMsg{Type: tea.KeyEnter}, wantMsg: true},
+		{name: "esc cancels", key: tea.KeyMsg{Type: tea.KeyEsc}},
+		{name: "ctrl+c cancels", key: tea.KeyMsg{Type: tea.KeyCtrlC}},
+		{name: "runes are typed", key: tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")}, wantActive: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sl := &SessionList{inlineEdit: NewInlineEdit()}
+			sl.inlineEdit.Start(InlineEditComment, "my-session", "wip", 40)
+
+			cmd := sl.updateInlineEdit(tt.key)
+
+			assert.Equal(t, tt.wantActive, sl.inlineEdit.Active())
+			if tt.wantMsg {
+				assert.Equal(t, InlineEditMsg{Field: InlineEditComment, SessionName: "my-session", Value: "wip"}, cmd())
+			}
+			if tt.wantActive {
+				assert.Equal(t, "wipq", sl.inlineEdit.input.Value())
+			}
+		})
+	}
+}
+
+func TestSessionList_StartHandoff(t *testing.T) {
+	items := []list.Item{
+		SessionItem{Session: &ports.TmuxSession{Name: "api"}},
+		SessionItem{Session: &ports.TmuxSession{Name: "web"}},
+	}
+	sl := &SessionList{
+		inlineEdit: NewInlineEdit(),
+		list:       list.New(items, list.NewDefaultDelegate(), 80, 10),
+	}
+
+	assert.Nil(t, sl.StartHandoff("gone"))
+	assert.False(t, sl.inlineEdit.Active())
+
+	assert.NotNil(t, sl.StartHandoff("web"))
+	assert.True(t, sl.inlineEdit.Editing("web", InlineEditHandoff))
+	assert.Equal(t, 1, sl.list.Index())
+
+	// An edit in progress is not replaced
+	assert.Nil(t, sl.StartHandoff("api"))
+	assert.True(t, sl.inlineEdit.Editing("web", InlineEditHandoff))
+}
@@ -10,23 +10,39 @@ import (
 
 // ApplicationKeys defines key bindings for application-level actions
 type ApplicationKeys struct {
-	CommandPalette KeyWithTip
-	ForceQuit      KeyWithTip
-	Help           KeyWithTip
-	Quit           KeyWithTip
-	Timestamps     KeyWithTip
-	TokenChart     KeyWithTip
+	CleanOrphanShells KeyWithTip
+	CommandPalette    KeyWithTip
+	DebugScreen       KeyWithTip
+	DetailPane        KeyWithTip
+	ForceQuit         KeyWithTip
+	Help              KeyWithTip
+	NotePane          KeyWithTip
+	OpenSettings      KeyWithTip
+	Quit              KeyWithTip
+	SwitchProfile     KeyWithTip
+	Timestamps        KeyWithTip
+	TokenChart        KeyWithTip
+	TokenChartByModel KeyWithTip
+	TokenChartCache   KeyWithTip
 }
 
 // newApplicationKeys creates application key bindings
 func newApplicationKeys(defaults map[string][]string, customKeys config.KeyBindingsConfig) ApplicationKeys {
 	return ApplicationKeys{
-		CommandPalette: buildBinding("command_palette", defaults, customKeys),
-		ForceQuit:      buildBinding("force_quit", defaults, customKeys),
-		Help:           buildBinding("help", defaults, customKeys),
-		Quit:           buildBinding("quit", defaults, customKeys),
-		Timestamps:     buildBinding("timestamps", defaults, customKeys),
-		TokenChart:     buildBinding("token_chart", defaults, customKeys),
+		CleanOrphanShells: buildBinding("clean_orphan_shells", defaults, customKeys),
+		CommandPalette:    buildBinding("command_palette", defaults, customKeys),
+		DebugScreen:       buildBinding("debug_screen", defaults, customKeys),
+		DetailPane:        buildBinding("detail_pane", defaults, customKeys),
+		ForceQuit:         buildBinding("force_quit", defaults, customKeys),
+		Help:              buildBinding("help", defaults, customKeys),
+		NotePane:          buildBinding("note_pane", defaults, customKeys),
+		OpenSettings:      buildBinding("open_settings", defaults, customKeys),
+		Quit:              buildBinding("quit", defaults, customKeys),
+		SwitchProfile:     buildBinding("switch_profile", defaults, customKeys),
+		Timestamps:        buildBinding("timestamps", defaults, customKeys),
+		TokenChart:        buildBinding("token_chart", defaults, customKeys),
+		TokenChartByModel: buildBinding("token_chart_by_model", defaults, customKeys),
+		TokenChartCache:   buildBinding("token_chart_cache", defaults, customKeys),
 	}
 }
 
@@ -46,12 +62,12 @@ func buildBinding(name string, defaults map[string][]string, customKeys config.K
 	result := KeyWithTip{
 		Binding: key.NewBinding(
 			key.WithKeys(keys...),
-			key.WithHelp(helpKeys, def.Help),
+			key.WithHelp(helpKeys, def.HelpText()),
 		),
 	}
 
-	if def.TipFormat != "" && len(keys) > 0 {
-		result.Tip = newTip(def.TipFormat, keys[0])
+	if tipFormat := def.TipFormat(); tipFormat != "" && len(keys) > 0 {
+		result.Tip = newTip(name, tipFormat, keys[0])
 	}
 
 	return result
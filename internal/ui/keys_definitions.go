@@ -1,65 +1,108 @@
 package ui
 
 import (
+	"fmt"
 	"sort"
 	"sync"
 
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/services"
 )
 
 // KeyDefinition defines the metadata for a configurable key binding.
 // All key bindings are defined here as the single source of truth.
 type KeyDefinition struct {
-	Defaults          []string
-	Help              string
-	IsPaletteAction   bool    // If true, this key appears in command palette
-	Msg               tea.Msg // Prototype message for dispatch (nil if not dispatchable)
-	Name              string
-	TipFormat         string
+	Defaults        []string
+	IsPaletteAction bool    // If true, this key appears in command palette
+	Msg             tea.Msg // Prototype message for dispatch (nil if not dispatchable)
+	Name            string
 }
 
 // AllKeyDefinitions contains all configurable key bindings.
-// This is the single source of truth for key names, defaults, help text, and tips.
+// This is the single source of truth for key names and defaults; help text and tips
+// live in the message catalogs of package i18n.
 // If IsPaletteAction is true, the key appears in the command palette.
 // If Msg is set, the action can be dispatched via the command palette.
 var AllKeyDefinitions = []KeyDefinition{
 	// Application keys
-	{Name: "command_palette", Defaults: []string{"/"}, Help: "command palette", TipFormat: "press %s to open the command palette"},
-	{Name: "force_quit", Defaults: []string{"ctrl+c"}, Help: "force quit"},
-	{Name: "help", Defaults: []string{"h", "?"}, Help: "show keyboard shortcuts", IsPaletteAction: true, Msg: ShowHelpMsg{}, TipFormat: "press %s to see all shortcuts"},
-	{Name: "quit", Defaults: []string{"q"}, Help: "exit application", IsPaletteAction: true, Msg: QuitMsg{}},
-	{Name: "timestamps", Defaults: []string{"t"}, Help: "toggle timestamps", IsPaletteAction: true, Msg: ToggleTimestampsMsg{}, TipFormat: "press %s to toggle timestamp display"},
-	{Name: "token_chart", Defaults: []string{"T"}, Help: "toggle token chart", IsPaletteAction: true, Msg: ToggleTokenChartMsg{}, TipFormat: "press %s to toggle token usage chart"},
+	{Name: "clean_orphan_shells", Defaults: []string{"ctrl+x"}, IsPaletteAction: true, Msg: CleanOrphanShellsMsg{}},
+	{Name: "command_palette", Defaults: []string{"/"}},
+	{Name: "debug_screen", Defaults: []string{"ctrl+g"}, Msg: ShowDebugScreenMsg{}},
+	{Name: "detail_pane", Defaults: []string{"d"}, IsPaletteAction: true, Msg: ToggleDetailPaneMsg{}},
+	{Name: "force_quit", Defaults: []string{"ctrl+c"}},
+	{Name: "help", Defaults: []string{"h", "?"}, IsPaletteAction: true, Msg: ShowHelpMsg{}},
+	{Name: "note_pane", Defaults: []string{"v"}, IsPaletteAction: true, Msg: ToggleNotePaneMsg{}},
+	{Name: "open_settings", Defaults: []string{","}, IsPaletteAction: true, Msg: OpenSettingsMsg{}},
+	{Name: "quit", Defaults: []string{"q"}, IsPaletteAction: true, Msg: QuitMsg{}},
+	{Name: "switch_profile", Defaults: []string{"ctrl+w"}, IsPaletteAction: true, Msg: SwitchProfileMsg{}},
+	{Name: "timestamps", Defaults: []string{"t"}, IsPaletteAction: true, Msg: ToggleTimestampsMsg{}},
+	{Name: "token_chart", Defaults: []string{"T"}, IsPaletteAction: true, Msg: ToggleTokenChartMsg{}},
+	{Name: "token_chart_by_model", Defaults: []string{"ctrl+t"}, IsPaletteAction: true, Msg: ToggleTokenChartByModelMsg{}},
+	{Name: "token_chart_cache", Defaults: []string{"ctrl+o"}, IsPaletteAction: true, Msg: ToggleTokenChartCacheMsg{}},
 
 	// Navigation keys
-	{Name: "clear_filter", Defaults: []string{"esc"}, Help: "clear filter (press twice within 500ms)", TipFormat: "press %s twice to clear the filter"},
-	{Name: "down", Defaults: []string{"down", "j"}, Help: "select next session"},
-	{Name: "filter", Defaults: []string{"ctrl+f"}, Help: "filter session list", TipFormat: "press %s to filter sessions by name or branch"},
-	{Name: "move_down", Defaults: []string{"J", "shift+down"}, Help: "move session down"},
-	{Name: "move_up", Defaults: []string{"K", "shift+up"}, Help: "move session up", TipFormat: "press %s to reorder sessions in the list"},
-	{Name: "up", Defaults: []string{"up", "k"}, Help: "select previous session"},
+	{Name: "apply_view", Defaults: []string{"alt+1", "alt+2", "alt+3", "alt+4", "alt+5", "alt+6", "alt+7", "alt+8", "alt+9"}},
+	{Name: "clear_filter", Defaults: []string{"esc"}},
+	{Name: "cycle_density", Defaults: []string{"L"}, IsPaletteAction: true, Msg: CycleDensityMsg{}},
+	{Name: "cycle_sort", Defaults: []string{"O"}, IsPaletteAction: true, Msg: CycleSortMsg{}},
+	{Name: "down", Defaults: []string{"down", "j"}},
+	{Name: "filter", Defaults: []string{"ctrl+f"}},
+	{Name: "move_down", Defaults: []string{"J", "shift+down"}},
+	{Name: "move_up", Defaults: []string{"K", "shift+up"}},
+	{Name: "remove_filter_chip", Defaults: []string{"backspace"}},
+	{Name: "switch_workspace", Defaults: []string{"w"}, IsPaletteAction: true, Msg: SwitchWorkspaceMsg{}},
+	{Name: "up", Defaults: []string{"up", "k"}},
+	{Name: "views", Defaults: []string{"m"}, IsPaletteAction: true, Msg: ShowViewsMsg{}},
 
 	// Session management keys
-	{Name: "archive", Defaults: []string{"a"}, Help: "archive session", IsPaletteAction: true, Msg: ArchiveSessionMsg{}, TipFormat: "press %s to archive a session (hidden from list)"},
-	{Name: "kill", Defaults: []string{"x"}, Help: "kill session and worktree", IsPaletteAction: true, Msg: KillSessionMsg{}, TipFormat: "press %s to kill a session and optionally remove its worktree"},
-	{Name: "new_session", Defaults: []string{"n"}, Help: "create new session", IsPaletteAction: true, Msg: NewSessionMsg{}, TipFormat: "press %s to create a new session"},
-	{Name: "new_from_repo", Defaults: []string{"N"}, Help: "create new session from same repo", IsPaletteAction: true, Msg: NewSessionFromTemplateMsg{}, TipFormat: "press %s to create a new session based on the selected session"},
-	{Name: "rename", Defaults: []string{"r"}, Help: "rename session", IsPaletteAction: true, Msg: RenameSessionMsg{}, TipFormat: "press %s to rename a session"},
+	{Name: "archive", Defaults: []string{"a"}, IsPaletteAction: true, Msg: ArchiveSessionMsg{}},
+	{Name: "kill", Defaults: []string{"x"}, IsPaletteAction: true, Msg: KillSessionMsg{}},
+	{Name: "kill_process", Defaults: []string{"X"}, IsPaletteAction: true, Msg: KillProcessSessionMsg{}},
+	{Name: "move_sessions", Defaults: []string{"M"}, IsPaletteAction: true, Msg: MoveSessionsMsg{}},
+	{Name: "new_session", Defaults: []string{"n"}, IsPaletteAction: true, Msg: NewSessionMsg{}},
+	{Name: "new_from_repo", Defaults: []string{"N"}, IsPaletteAction: true, Msg: NewSessionFromTemplateMsg{}},
+	{Name: "new_from_clipboard", Defaults: []string{"V"}, IsPaletteAction: true, Msg: NewSessionFromClipboardMsg{}},
+	{Name: "rename", Defaults: []string{"r"}, IsPaletteAction: true, Msg: RenameSessionMsg{}},
 
 	// Session metadata keys
-	{Name: "comment", Defaults: []string{"c"}, Help: "add/edit comment", IsPaletteAction: true, Msg: CommentSessionMsg{}, TipFormat: "press %s to add a comment to a session"},
-	{Name: "cycle_status", Defaults: []string{"s"}, Help: "cycle status", Msg: CycleStatusMsg{}, TipFormat: "press %s to cycle through implementation statuses"},
-	{Name: "flag", Defaults: []string{"f"}, Help: "toggle flag", IsPaletteAction: true, Msg: ToggleFlagSessionMsg{}, TipFormat: "press %s to flag a session for attention"},
-	{Name: "send_text", Defaults: []string{"p"}, Help: "send text (prompt)", IsPaletteAction: true, Msg: SendTextSessionMsg{}, TipFormat: "press %s to send text to a session (experimental)"},
-	{Name: "set_status", Defaults: []string{"S"}, Help: "choose status", IsPaletteAction: true, Msg: SetStatusSessionMsg{}, TipFormat: "press %s to pick a specific status"},
+	{Name: "attachments", Defaults: []string{"A"}, IsPaletteAction: true, Msg: AttachmentsSessionMsg{}},
+	{Name: "comment", Defaults: []string{"c"}, IsPaletteAction: true, Msg: CommentSessionMsg{}},
+	{Name: "cycle_priority", Defaults: []string{"P"}, IsPaletteAction: true, Msg: CyclePriorityMsg{}},
+	{Name: "cycle_status", Defaults: []string{"s"}, Msg: CycleStatusMsg{}},
+	{Name: "handoff", Defaults: []string{"H"}},
+	{Name: "flag", Defaults: []string{"f"}, IsPaletteAction: true, Msg: ToggleFlagSessionMsg{}},
+	{Name: "note", Defaults: []string{"e"}, IsPaletteAction: true, Msg: NoteSessionMsg{}},
+	{Name: "send_text", Defaults: []string{"p"}, IsPaletteAction: true, Msg: SendTextSessionMsg{}},
+	{Name: "set_status", Defaults: []string{"S"}, IsPaletteAction: true, Msg: SetStatusSessionMsg{}},
+	{Name: "summarize_diff", Defaults: []string{"E"}, IsPaletteAction: true, Msg: SummarizeDiffSessionMsg{}},
+	{Name: "tags", Defaults: []string{"l"}, IsPaletteAction: true, Msg: TagsSessionMsg{}},
+	{Name: "timer", Defaults: []string{"z"}, IsPaletteAction: true, Msg: TimerSessionMsg{}},
 
 	// Session action keys
-	{Name: "detach", Defaults: []string{"ctrl+q"}, Help: "detach from session (return to list)", TipFormat: "press %s inside a session to return to the list"},
-	{Name: "open", Defaults: []string{"enter"}, Help: "attach to session", IsPaletteAction: true, Msg: AttachSessionMsg{}},
-	{Name: "open_editor", Defaults: []string{"o"}, Help: "open session in editor", IsPaletteAction: true, Msg: OpenEditorSessionMsg{}, TipFormat: "press %s to open the session's folder in your editor"},
-	{Name: "open_pr", Defaults: []string{"ctrl+p"}, Help: "open PR in browser", IsPaletteAction: true, Msg: OpenPRMsg{}, TipFormat: "press %s to open the session's PR in browser"},
-	{Name: "open_shell", Defaults: []string{"ctrl+s"}, Help: "open shell session", IsPaletteAction: true, Msg: AttachShellSessionMsg{}, TipFormat: "press %s to open a shell session alongside claude"},
-	{Name: "quick_open", Defaults: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0"}, Help: "quick open (0=10th)", TipFormat: "press %s to quickly open sessions by their number"},
+	{Name: "check_output", Defaults: []string{"I"}, IsPaletteAction: true, Msg: CheckOutputSessionMsg{}},
+	{Name: "copy_branch", Defaults: []string{"B"}, IsPaletteAction: true, Msg: CopySessionInfoMsg{Field: services.CopyBranch}},
+	{Name: "copy_path", Defaults: []string{"W"}, IsPaletteAction: true, Msg: CopySessionInfoMsg{Field: services.CopyPath}},
+	{Name: "copy_pr_url", Defaults: []string{"U"}, IsPaletteAction: true, Msg: CopySessionInfoMsg{Field: services.CopyPRURL}},
+	{Name: "copy_summary", Defaults: []string{"y"}, IsPaletteAction: true, Msg: CopySessionInfoMsg{Field: services.CopySummary}},
+	{Name: "detach", Defaults: []string{"ctrl+q"}},
+	{Name: "fetch_base", Defaults: []string{"F"}, IsPaletteAction: true, Msg: FetchBaseSessionMsg{}},
+	{Name: "open", Defaults: []string{"enter"}, IsPaletteAction: true, Msg: AttachSessionMsg{}},
+	{Name: "open_changed_files", Defaults: []string{"D"}, IsPaletteAction: true, Msg: OpenEditorSessionMsg{ChangedFiles: true}},
+	{Name: "open_editor", Defaults: []string{"o"}, IsPaletteAction: true, Msg: OpenEditorSessionMsg{}},
+	{Name: "open_pr", Defaults: []string{"ctrl+p"}, IsPaletteAction: true, Msg: OpenPRMsg{}},
+	{Name: "open_shell", Defaults: []string{"ctrl+s"}, IsPaletteAction: true, Msg: AttachShellSessionMsg{}},
+	{Name: "pause", Defaults: []string{"Z"}, IsPaletteAction: true, Msg: TogglePauseSessionMsg{}},
+	{Name: "quick_jump", Defaults: []string{"'"}},
+	{Name: "quick_open", Defaults: []string{"1", "2", "3", "4", "5", "6", "7", "8", "9", "0"}},
+	{Name: "rebase", Defaults: []string{"R"}, IsPaletteAction: true, Msg: RebaseSessionMsg{}},
+	{Name: "run_checks", Defaults: []string{"C"}, IsPaletteAction: true, Msg: RunChecksSessionMsg{}},
+	{Name: "stash", Defaults: []string{"g"}, IsPaletteAction: true, Msg: StashSessionMsg{}},
+	{Name: "switch_branch", Defaults: []string{"b"}, IsPaletteAction: true, Msg: SwitchBranchSessionMsg{}},
+	{Name: "tool_audit", Defaults: []string{"i"}, IsPaletteAction: true, Msg: ToolAuditSessionMsg{}},
+	{Name: "unstash", Defaults: []string{"G"}, IsPaletteAction: true, Msg: UnstashSessionMsg{}},
 }
 
 var (
@@ -118,6 +161,41 @@ func IsValidKeyName(name string) bool {
 	return GetKeyDefinition(name) != nil
 }
 
+// ValidateKeyBindings checks custom bindings like KeyBindingsConfig.Validate, and that no key is
+// left bound to two actions once they replace the defaults, such as by a preset
+func ValidateKeyBindings(customKeys config.KeyBindingsConfig) error {
+	if err := customKeys.Validate(GetValidKeyNames()); err != nil {
+		return err
+	}
+
+	keyToAction := make(map[string]string)
+	for _, name := range GetValidKeyNames() {
+		keys := GetDefaultKeyBindings()[name]
+		if custom := customKeys[name]; len(custom) > 0 {
+			keys = custom
+		}
+		for _, key := range keys {
+			if existing, found := keyToAction[key]; found {
+				return fmt.Errorf("key '%s' is bound to both '%s' and '%s'; rebind one of them", key, existing, name)
+			}
+			keyToAction[key] = name
+		}
+	}
+	return nil
+}
+
+// HelpText returns the help text of the key in the configured language
+func (d KeyDefinition) HelpText() string {
+	return i18n.T("key." + d.Name + ".help")
+}
+
+// TipFormat returns the tip of the key in the configured language, with a %s for the key
+// Returns "" if the key has no tip.
+func (d KeyDefinition) TipFormat() string {
+	tip, _ := i18n.Lookup("key." + d.Name + ".tip")
+	return tip
+}
+
 // GetPaletteActions returns key definitions that should appear in the command palette.
 func GetPaletteActions() []KeyDefinition {
 	var actions []KeyDefinition
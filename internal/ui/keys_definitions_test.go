@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/i18n"
+)
+
+func TestKeyDefinitions_HaveHelpText(t *testing.T) {
+	for _, def := range AllKeyDefinitions {
+		_, ok := i18n.Lookup("key." + def.Name + ".help")
+		assert.True(t, ok, "key %s has no help text in the message catalog", def.Name)
+	}
+}
+
+func TestValidateKeyBindings(t *testing.T) {
+	presets, err := config.BuiltinPresets()
+	require.NoError(t, err)
+	for _, preset := range presets {
+		assert.NoError(t, ValidateKeyBindings(preset.Keys), preset.Name)
+	}
+
+	// "x" still kills sessions, since kill keeps its default
+	err = ValidateKeyBindings(config.KeyBindingsConfig{"archive": {"x"}})
+	assert.ErrorContains(t, err, "'x' is bound to both")
+
+	assert.NoError(t, ValidateKeyBindings(config.KeyBindingsConfig{"archive": {"x"}, "kill": {"ctrl+k"}}))
+	assert.Error(t, ValidateKeyBindings(config.KeyBindingsConfig{"no_such_action": {"x"}}))
+}
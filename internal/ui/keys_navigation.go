@@ -6,22 +6,34 @@ import (
 
 // NavigationKeys defines key bindings for navigating the session list
 type NavigationKeys struct {
-	ClearFilter KeyWithTip
-	Down        KeyWithTip
-	Filter      KeyWithTip
-	MoveDown    KeyWithTip
-	MoveUp      KeyWithTip
-	Up          KeyWithTip
+	ApplyView        KeyWithTip
+	ClearFilter      KeyWithTip
+	CycleDensity     KeyWithTip
+	CycleSort        KeyWithTip
+	Down             KeyWithTip
+	Filter           KeyWithTip
+	MoveDown         KeyWithTip
+	MoveUp           KeyWithTip
+	RemoveFilterChip KeyWithTip
+	SwitchWorkspace  KeyWithTip
+	Up               KeyWithTip
+	Views            KeyWithTip
 }
 
 // newNavigationKeys creates navigation key bindings
 func newNavigationKeys(defaults map[string][]string, customKeys config.KeyBindingsConfig) NavigationKeys {
 	return NavigationKeys{
-		ClearFilter: buildBinding("clear_filter", defaults, customKeys),
-		Down:        buildBinding("down", defaults, customKeys),
-		Filter:      buildBinding("filter", defaults, customKeys),
-		MoveDown:    buildBinding("move_down", defaults, customKeys),
-		MoveUp:      buildBinding("move_up", defaults, customKeys),
-		Up:          buildBinding("up", defaults, customKeys),
+		ApplyView:        buildBinding("apply_view", defaults, customKeys),
+		ClearFilter:      buildBinding("clear_filter", defaults, customKeys),
+		CycleDensity:     buildBinding("cycle_density", defaults, customKeys),
+		CycleSort:        buildBinding("cycle_sort", defaults, customKeys),
+		Down:             buildBinding("down", defaults, customKeys),
+		Filter:           buildBinding("filter", defaults, customKeys),
+		MoveDown:         buildBinding("move_down", defaults, customKeys),
+		MoveUp:           buildBinding("move_up", defaults, customKeys),
+		RemoveFilterChip: buildBinding("remove_filter_chip", defaults, customKeys),
+		SwitchWorkspace:  buildBinding("switch_workspace", defaults, customKeys),
+		Up:               buildBinding("up", defaults, customKeys),
+		Views:            buildBinding("views", defaults, customKeys),
 	}
 }
@@ -4,32 +4,57 @@ import (
 	"github.com/renato0307/rocha/internal/config"
 )
 
-// SessionManagementKeys defines key bindings for managing sessions (create, rename, archive, kill)
+// SessionManagementKeys defines key bindings for managing sessions (create, rename, archive, kill, move)
 type SessionManagementKeys struct {
 	Archive     KeyWithTip
 	Kill        KeyWithTip
+	KillProcess KeyWithTip
+	Move        KeyWithTip
 	New         KeyWithTip
+	NewFromClip KeyWithTip
 	NewFromRepo KeyWithTip
 	Rename      KeyWithTip
 }
 
-// SessionMetadataKeys defines key bindings for session metadata (comment, flag, status)
+// SessionMetadataKeys defines key bindings for session metadata (attachments, comment, handoff, note, diff summary, flag, priority, status, tags, timer)
 type SessionMetadataKeys struct {
+	Attachments   KeyWithTip
 	Comment       KeyWithTip
 	Flag          KeyWithTip
+	Handoff       KeyWithTip
+	Note          KeyWithTip
+	PriorityCycle KeyWithTip
 	SendText      KeyWithTip
 	StatusCycle   KeyWithTip
 	StatusSetForm KeyWithTip
+	SummarizeDiff KeyWithTip
+	Tags          KeyWithTip
+	Timer         KeyWithTip
 }
 
-// SessionActionsKeys defines key bindings for session actions (open, shell, editor, quick open)
+// SessionActionsKeys defines key bindings for session actions (open, shell, editor, pause, quick open, quick jump, fetch base, rebase, checks, stash, tool audit)
 type SessionActionsKeys struct {
-	Detach     KeyWithTip
-	Open       KeyWithTip
-	OpenEditor KeyWithTip
-	OpenPR     KeyWithTip
-	OpenShell  KeyWithTip
-	QuickOpen  KeyWithTip
+	CheckOutput      KeyWithTip
+	CopyBranch       KeyWithTip
+	CopyPath         KeyWithTip
+	CopyPRURL        KeyWithTip
+	CopySummary      KeyWithTip
+	Detach           KeyWithTip
+	FetchBase        KeyWithTip
+	Open             KeyWithTip
+	OpenChangedFiles KeyWithTip
+	OpenEditor       KeyWithTip
+	OpenPR           KeyWithTip
+	OpenShell        KeyWithTip
+	Pause            KeyWithTip
+	QuickJump        KeyWithTip
+	QuickOpen        KeyWithTip
+	Rebase           KeyWithTip
+	RunChecks        KeyWithTip
+	Stash            KeyWithTip
+	SwitchBranch     KeyWithTip
+	ToolAudit        KeyWithTip
+	Unstash          KeyWithTip
 }
 
 // newSessionManagementKeys creates session management key bindings
@@ -37,7 +62,10 @@ func newSessionManagementKeys(defaults map[string][]string, customKeys config.Ke
 	return SessionManagementKeys{
 		Archive:     buildBinding("archive", defaults, customKeys),
 		Kill:        buildBinding("kill", defaults, customKeys),
+		KillProcess: buildBinding("kill_process", defaults, customKeys),
+		Move:        buildBinding("move_sessions", defaults, customKeys),
 		New:         buildBinding("new_session", defaults, customKeys),
+		NewFromClip: buildBinding("new_from_clipboard", defaults, customKeys),
 		NewFromRepo: buildBinding("new_from_repo", defaults, customKeys),
 		Rename:      buildBinding("rename", defaults, customKeys),
 	}
@@ -46,22 +74,44 @@ func newSessionManagementKeys(defaults map[string][]string, customKeys config.Ke
 // newSessionMetadataKeys creates session metadata key bindings
 func newSessionMetadataKeys(defaults map[string][]string, customKeys config.KeyBindingsConfig) SessionMetadataKeys {
 	return SessionMetadataKeys{
+		Attachments:   buildBinding("attachments", defaults, customKeys),
 		Comment:       buildBinding("comment", defaults, customKeys),
 		Flag:          buildBinding("flag", defaults, customKeys),
+		Handoff:       buildBinding("handoff", defaults, customKeys),
+		Note:          buildBinding("note", defaults, customKeys),
+		PriorityCycle: buildBinding("cycle_priority", defaults, customKeys),
 		SendText:      buildBinding("send_text", defaults, customKeys),
 		StatusCycle:   buildBinding("cycle_status", defaults, customKeys),
 		StatusSetForm: buildBinding("set_status", defaults, customKeys),
+		SummarizeDiff: buildBinding("summarize_diff", defaults, customKeys),
+		Tags:          buildBinding("tags", defaults, customKeys),
+		Timer:         buildBinding("timer", defaults, customKeys),
 	}
 }
 
 // newSessionActionsKeys creates session action key bindings
 func newSessionActionsKeys(defaults map[string][]string, customKeys config.KeyBindingsConfig) SessionActionsKeys {
 	return SessionActionsKeys{
-		Detach:     buildBinding("detach", defaults, customKeys),
-		Open:       buildBinding("open", defaults, customKeys),
-		OpenEditor: buildBinding("open_editor", defaults, customKeys),
-		OpenPR:     buildBinding("open_pr", defaults, customKeys),
-		OpenShell:  buildBinding("open_shell", defaults, customKeys),
-		QuickOpen:  buildBinding("quick_open", defaults, customKeys),
+		CheckOutput:      buildBinding("check_output", defaults, customKeys),
+		CopyBranch:       buildBinding("copy_branch", defaults, customKeys),
+		CopyPath:         buildBinding("copy_path", defaults, customKeys),
+		CopyPRURL:        buildBinding("copy_pr_url", defaults, customKeys),
+		CopySummary:      buildBinding("copy_summary", defaults, customKeys),
+		Detach:           buildBinding("detach", defaults, customKeys),
+		FetchBase:        buildBinding("fetch_base", defaults, customKeys),
+		Open:             buildBinding("open", defaults, customKeys),
+		OpenChangedFiles: buildBinding("open_changed_files", defaults, customKeys),
+		OpenEditor:       buildBinding("open_editor", defaults, customKeys),
+		OpenPR:           buildBinding("open_pr", defaults, customKeys),
+		OpenShell:        buildBinding("open_shell", defaults, customKeys),
+		Pause:            buildBinding("pause", defaults, customKeys),
+		QuickJump:        buildBinding("quick_jump", defaults, customKeys),
+		QuickOpen:        buildBinding("quick_open", defaults, customKeys),
+		Rebase:           buildBinding("rebase", defaults, customKeys),
+		RunChecks:        buildBinding("run_checks", defaults, customKeys),
+		Stash:            buildBinding("stash", defaults, customKeys),
+		SwitchBranch:     buildBinding("switch_branch", defaults, customKeys),
+		ToolAudit:        buildBinding("tool_audit", defaults, customKeys),
+		Unstash:          buildBinding("unstash", defaults, customKeys),
 	}
 }
@@ -2,8 +2,11 @@ package ui
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/theme"
 
 	"github.com/charmbracelet/bubbles/key"
@@ -13,15 +16,22 @@ import (
 type Tip struct {
 	Format string
 	Keys   []string
+	Name   string // Key binding the tip is about, empty for tips of your own
 }
 
 // tips is the private collection of all tips, populated by newTip()
 var tips []Tip
 
-// newTip registers a tip with format string and keys to highlight
-// Format uses %s placeholders for keys, e.g. newTip("press %s to filter", "/")
-func newTip(format string, keys ...string) string {
-	tips = append(tips, Tip{Format: format, Keys: keys})
+// newTip registers the tip of a key binding with format string and keys to highlight,
+// replacing the one registered for it before
+// Format uses %s placeholders for keys, e.g. newTip("filter", "press %s to filter", "/")
+func newTip(name, format string, keys ...string) string {
+	tip := Tip{Format: format, Keys: keys, Name: name}
+	if i := slices.IndexFunc(tips, func(t Tip) bool { return t.Name == name }); i >= 0 {
+		tips[i] = tip
+	} else {
+		tips = append(tips, tip)
+	}
 	// Return plain text for Tip field (used for filtering, etc.)
 	args := make([]any, len(keys))
 	for i, k := range keys {
@@ -35,12 +45,37 @@ func GetTips() []Tip {
 	return tips
 }
 
+// ParseTip turns a tip of your own into a Tip, highlighting the keys in backticks,
+// e.g. "run `make lint` before asking for a review"
+func ParseTip(text string) Tip {
+	parts := strings.Split(text, "`")
+	if len(parts)%2 == 0 {
+		// Unbalanced backtick: show the text as it is
+		return Tip{Format: text}
+	}
+
+	tip := Tip{Format: parts[0]}
+	for i := 1; i < len(parts); i += 2 {
+		tip.Format += "%s" + parts[i+1]
+		tip.Keys = append(tip.Keys, parts[i])
+	}
+	return tip
+}
+
+// id identifies a tip among the shown ones: its key binding, or the text of tips of your own
+func (t Tip) id() string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return t.Format
+}
+
 // RenderTip formats a tip with highlighted keys and gray text
 func RenderTip(tip Tip) string {
 	// Split format by %s to get text segments
 	parts := strings.Split(tip.Format, "%s")
 	var result string
-	result += theme.TipTextStyle.Render("ℹ  tip: ")
+	result += theme.TipTextStyle.Render("ℹ  " + i18n.T("tip.prefix") + " ")
 	for i, part := range parts {
 		result += theme.TipTextStyle.Render(part)
 		if i < len(tip.Keys) {
@@ -56,8 +91,22 @@ type KeyWithTip struct {
 	Tip     string
 }
 
+// SortConfig holds the sort presets the session list cycles through
+type SortConfig struct {
+	Active  string // Preset active at startup (empty = manual order)
+	Presets []domain.SortPreset
+}
+
+// ViewConfig holds the named views of the session list
+type ViewConfig struct {
+	Default string // View applied at startup (empty = last filter and sort_preset)
+	Views   []domain.View
+}
+
 // TipsConfig holds configuration for the tips feature
 type TipsConfig struct {
+	Custom                 []string // Tips of your own, from settings and the tips file
+	Disabled               []string // Key bindings whose tips are not shown
 	DisplayDurationSeconds int
 	Enabled                bool
 	ShowIntervalSeconds    int
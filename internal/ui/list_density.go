@@ -0,0 +1,44 @@
+package ui
+
+// ListDensity is how many lines each session takes in the list
+type ListDensity string
+
+const (
+	DensityCompact  ListDensity = "compact"  // One line: git ref follows the name
+	DensityNormal   ListDensity = "normal"   // Two lines: name, then git ref
+	DensityDetailed ListDensity = "detailed" // Three lines: name, git ref, then comment and status
+)
+
+// parseListDensity returns the density with the given name, or normal for unknown names
+func parseListDensity(name string) ListDensity {
+	switch density := ListDensity(name); density {
+	case DensityCompact, DensityDetailed:
+		return density
+	default:
+		return DensityNormal
+	}
+}
+
+// Next returns the density the cycle key switches to: compact -> normal -> detailed -> compact
+func (d ListDensity) Next() ListDensity {
+	switch d {
+	case DensityCompact:
+		return DensityNormal
+	case DensityNormal:
+		return DensityDetailed
+	default:
+		return DensityCompact
+	}
+}
+
+// Lines returns the number of lines each session takes
+func (d ListDensity) Lines() int {
+	switch d {
+	case DensityCompact:
+		return 1
+	case DensityDetailed:
+		return 3
+	default:
+		return 2
+	}
+}
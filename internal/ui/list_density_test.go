@@ -0,0 +1,58 @@
+package ui
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+func TestListDensity_Next(t *testing.T) {
+	assert.Equal(t, DensityNormal, DensityCompact.Next())
+	assert.Equal(t, DensityDetailed, DensityNormal.Next())
+	assert.Equal(t, DensityCompact, DensityDetailed.Next())
+	assert.Equal(t, DensityNormal, parseListDensity(""))
+	assert.Equal(t, DensityNormal, parseListDensity("roomy"))
+	assert.Equal(t, DensityDetailed, parseListDensity("detailed"))
+}
+
+func TestSessionDelegate_RenderDensity(t *testing.T) {
+	status := "review"
+	item := SessionItem{
+		Comment:     "ask design",
+		DisplayName: "api-cart",
+		GitRef:      "owner/api:feature/cart",
+		Session:     &ports.TmuxSession{Name: "api-cart"},
+		State:       string(domain.StateIdle),
+		Status:      &status,
+	}
+	statusConfig := config.NewStatusConfig("", "", "")
+
+	tests := []struct {
+		density ListDensity
+		lines   []string
+	}{
+		{density: DensityCompact, lines: []string{"> 01. ○ api-cart ⌨ [review]  owner/api:feature/cart"}},
+		{density: DensityNormal, lines: []string{"> 01. ○ api-cart ⌨ [review]", "        owner/api:feature/cart"}},
+		{density: DensityDetailed, lines: []string{"> 01. ○ api-cart ⌨ [review]", "        owner/api:feature/cart", "        ⌨ ask design · status: review"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.density), func(t *testing.T) {
+			delegate := newSessionDelegate(&domain.SessionCollection{}, NewInlineEdit(), NewQuickJump(), statusConfig, &config.TimestampColorConfig{}, TimestampHidden, tt.density, false)
+			l := list.New([]list.Item{item}, delegate, 120, 10)
+
+			var out bytes.Buffer
+			delegate.Render(&out, l, 0, item)
+
+			assert.Equal(t, len(tt.lines), delegate.Height())
+			assert.Equal(t, tt.lines, strings.Split(out.String(), "\n"))
+		})
+	}
+}
@@ -3,7 +3,9 @@ package ui
 import (
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/ports"
+	"github.com/renato0307/rocha/internal/services"
 )
 
 // SessionAwareMsg is implemented by messages that need session context.
@@ -33,6 +35,9 @@ type QuitMsg struct{}
 // ShowHelpMsg requests showing the help screen
 type ShowHelpMsg struct{}
 
+// ShowDebugScreenMsg requests showing the state detection debug screen (not listed in help)
+type ShowDebugScreenMsg struct{}
+
 // Phase 2: Dialog action messages
 
 // CommentSessionMsg requests showing the comment dialog for a session
@@ -44,6 +49,85 @@ func (m CommentSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
 	return CommentSessionMsg{SessionName: s.Name}
 }
 
+// InlineEditMsg is sent when an inline edit on a list row is committed with Enter
+type InlineEditMsg struct {
+	Field       InlineEditField
+	SessionName string
+	Value       string
+}
+
+// NoteSessionMsg requests showing the note dialog for a session
+type NoteSessionMsg struct {
+	SessionName string
+}
+
+func (m NoteSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return NoteSessionMsg{SessionName: s.Name}
+}
+
+// SummarizeDiffSessionMsg requests replacing the note of a session with a summary of its diff
+type SummarizeDiffSessionMsg struct {
+	SessionName string
+}
+
+func (m SummarizeDiffSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return SummarizeDiffSessionMsg{SessionName: s.Name}
+}
+
+// AttachmentsSessionMsg requests showing the attachments dialog for a session
+type AttachmentsSessionMsg struct {
+	SessionName string
+}
+
+func (m AttachmentsSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return AttachmentsSessionMsg{SessionName: s.Name}
+}
+
+// TagsSessionMsg requests showing the tags dialog for a session
+type TagsSessionMsg struct {
+	SessionName string
+}
+
+func (m TagsSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return TagsSessionMsg{SessionName: s.Name}
+}
+
+// TimerSessionMsg requests showing the timer dialog for a session
+type TimerSessionMsg struct {
+	SessionName string
+}
+
+func (m TimerSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return TimerSessionMsg{SessionName: s.Name}
+}
+
+// RunChecksSessionMsg requests running the repository checks of a session
+type RunChecksSessionMsg struct {
+	SessionName string
+}
+
+func (m RunChecksSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return RunChecksSessionMsg{SessionName: s.Name}
+}
+
+// CheckOutputSessionMsg requests showing the output of the last checks of a session
+type CheckOutputSessionMsg struct {
+	SessionName string
+}
+
+func (m CheckOutputSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return CheckOutputSessionMsg{SessionName: s.Name}
+}
+
+// ToolAuditSessionMsg requests showing the tool audit screen for a session
+type ToolAuditSessionMsg struct {
+	SessionName string
+}
+
+func (m ToolAuditSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return ToolAuditSessionMsg{SessionName: s.Name}
+}
+
 // NewSessionFromTemplateMsg requests creating a new session from a template
 type NewSessionFromTemplateMsg struct {
 	TemplateSessionName string
@@ -53,18 +137,23 @@ func (m NewSessionFromTemplateMsg) WithSession(s *ports.TmuxSession) tea.Msg {
 	return NewSessionFromTemplateMsg{TemplateSessionName: s.Name}
 }
 
+// NewSessionFromClipboardMsg requests showing the new session dialog pre-filled from the clipboard
+type NewSessionFromClipboardMsg struct{}
+
 // NewSessionMsg requests showing the new session dialog
 type NewSessionMsg struct {
-	DefaultRepoSource string
+	Draft domain.SessionDraft // Pre-filled fields; an empty repo source uses the current directory's
+	Title string              // Dialog title; empty for "Create Session"
 }
 
 // OpenEditorSessionMsg requests opening the editor for a session's worktree
 type OpenEditorSessionMsg struct {
-	SessionName string
+	ChangedFiles bool // Also open the files changed on the session branch
+	SessionName  string
 }
 
 func (m OpenEditorSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
-	return OpenEditorSessionMsg{SessionName: s.Name}
+	return OpenEditorSessionMsg{ChangedFiles: m.ChangedFiles, SessionName: s.Name}
 }
 
 // RenameSessionMsg requests showing the rename dialog for a session
@@ -114,6 +203,12 @@ func (m AttachShellSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
 	return AttachShellSessionMsg{Session: s}
 }
 
+// RestartSessionMsg requests choosing how to restart an exited session before attaching
+type RestartSessionMsg struct {
+	AttachShell bool // Attach to the shell session after restarting instead of Claude
+	Session     *ports.TmuxSession
+}
+
 // KillSessionMsg requests killing a session
 type KillSessionMsg struct {
 	SessionName string
@@ -123,6 +218,24 @@ func (m KillSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
 	return KillSessionMsg{SessionName: s.Name}
 }
 
+// KillProcessSessionMsg requests killing only the agent process of a session
+type KillProcessSessionMsg struct {
+	SessionName string
+}
+
+func (m KillProcessSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return KillProcessSessionMsg{SessionName: s.Name}
+}
+
+// MoveSessionsMsg requests the wizard that moves a session's repository to another ROCHA_HOME
+type MoveSessionsMsg struct {
+	SessionName string
+}
+
+func (m MoveSessionsMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return MoveSessionsMsg{SessionName: s.Name}
+}
+
 // TestErrorMsg requests generating a test error (hidden debug feature, triggered by alt+e)
 type TestErrorMsg struct{}
 
@@ -157,12 +270,64 @@ func (m CycleStatusMsg) WithSession(s *ports.TmuxSession) tea.Msg {
 	return CycleStatusMsg{SessionName: s.Name}
 }
 
+// CyclePriorityMsg requests cycling the priority of a session
+type CyclePriorityMsg struct {
+	SessionName string
+}
+
+func (m CyclePriorityMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return CyclePriorityMsg{SessionName: s.Name}
+}
+
+// CycleDensityMsg requests switching to the next session list density
+type CycleDensityMsg struct{}
+
+// CycleSortMsg requests switching to the next session sort preset
+type CycleSortMsg struct{}
+
+// ShowViewsMsg requests the dialog that applies and saves named views
+type ShowViewsMsg struct{}
+
+// SwitchWorkspaceMsg requests choosing the workspace whose sessions are listed
+type SwitchWorkspaceMsg struct{}
+
 // ToggleTimestampsMsg requests toggling timestamp display
 type ToggleTimestampsMsg struct{}
 
+// CleanOrphanShellsMsg requests the dialog that cleans up shells out of step with their session
+type CleanOrphanShellsMsg struct{}
+
+// OpenSettingsMsg requests opening settings.json in the editor
+type OpenSettingsMsg struct{}
+
+// SwitchProfileMsg requests choosing the settings profile rocha restarts with
+type SwitchProfileMsg struct{}
+
+// ToggleDetailPaneMsg requests toggling the session detail pane
+type ToggleDetailPaneMsg struct{}
+
+// ToggleNotePaneMsg requests toggling the note pane
+type ToggleNotePaneMsg struct{}
+
 // ToggleTokenChartMsg requests toggling the token chart
 type ToggleTokenChartMsg struct{}
 
+// ToggleTokenChartByModelMsg requests switching the token chart between input/output and per-model bars
+type ToggleTokenChartByModelMsg struct{}
+
+// ToggleTokenChartCacheMsg requests including or excluding cache tokens in the token chart
+type ToggleTokenChartCacheMsg struct{}
+
+// CopySessionInfoMsg requests copying a piece of session info to the clipboard
+type CopySessionInfoMsg struct {
+	Field       services.CopyField
+	SessionName string
+}
+
+func (m CopySessionInfoMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return CopySessionInfoMsg{Field: m.Field, SessionName: s.Name}
+}
+
 // OpenPRMsg requests opening the PR in browser for a session
 type OpenPRMsg struct {
 	SessionName string
@@ -171,3 +336,57 @@ type OpenPRMsg struct {
 func (m OpenPRMsg) WithSession(s *ports.TmuxSession) tea.Msg {
 	return OpenPRMsg{SessionName: s.Name}
 }
+
+// FetchBaseSessionMsg requests fetching a session's base branch to refresh how stale it is
+type FetchBaseSessionMsg struct {
+	SessionName string
+}
+
+func (m FetchBaseSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return FetchBaseSessionMsg{SessionName: s.Name}
+}
+
+// RebaseSessionMsg requests fetching and rebasing a session onto its base branch
+type RebaseSessionMsg struct {
+	SessionName string
+}
+
+func (m RebaseSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return RebaseSessionMsg{SessionName: s.Name}
+}
+
+// TogglePauseSessionMsg requests pausing the agent of a session, or resuming it when paused
+type TogglePauseSessionMsg struct {
+	SessionName string
+}
+
+func (m TogglePauseSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return TogglePauseSessionMsg{SessionName: s.Name}
+}
+
+// StashSessionMsg requests stashing the uncommitted changes of a session worktree
+type StashSessionMsg struct {
+	SessionName string
+}
+
+func (m StashSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return StashSessionMsg{SessionName: s.Name}
+}
+
+// SwitchBranchSessionMsg requests the branch switcher of a session worktree
+type SwitchBranchSessionMsg struct {
+	SessionName string
+}
+
+func (m SwitchBranchSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return SwitchBranchSessionMsg{SessionName: s.Name}
+}
+
+// UnstashSessionMsg requests popping the latest stash of a session worktree
+type UnstashSessionMsg struct {
+	SessionName string
+}
+
+func (m UnstashSessionMsg) WithSession(s *ports.TmuxSession) tea.Msg {
+	return UnstashSessionMsg{SessionName: s.Name}
+}
@@ -2,90 +2,131 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
 
 	"github.com/renato0307/rocha/internal/config"
 	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/services/actions"
 	"github.com/renato0307/rocha/internal/theme"
 )
 
 type uiState int
 
+// worktreeLossLimit is how many files and commits the worktree removal forms list
+const worktreeLossLimit = 8
+
 const (
 	stateList uiState = iota
 	stateCommandPalette
-	stateCommentingSession
-	stateConfirmingArchive
-	stateConfirmingWorktreeRemoval
-	stateCreatingSession
-	stateHelp
-	stateRenamingSession
-	stateSendingText
-	stateSettingStatus
+	stateDialog // Dialogs open on the dialog router
 )
 
 type Model struct {
-	allowDangerouslySkipPermissionsDefault bool                         // Default value from settings for new sessions
-	commandPalette                         *CommandPalette              // Command palette overlay
-	devMode                                bool                         // Development mode (shows version info in dialogs)
-	editor                                 string                       // Editor to open sessions in
-	errorManager                           *ErrorManager                // Error display and auto-clearing
-	formRemoveWorktree                     *bool                        // Worktree removal decision (pointer to persist across updates)
-	formRemoveWorktreeArchive              *bool                        // Worktree removal decision for archive (pointer to persist across updates)
-	gitService                             *services.GitService         // Git operations service
+	accessible                             bool                          // Text labels instead of icons, no colors
+	actionsService                         *actions.Service              // Kill and archive shared with the CLI
+	allowDangerouslySkipPermissionsDefault bool                          // Default value from settings for new sessions
+	attachmentService                      *services.AttachmentService   // Files attached to sessions
+	checkService                           *services.CheckService        // Repository checks run in session worktrees
+	clipboardService                       *services.ClipboardService    // Copies session info to the clipboard
+	commandPalette                         *CommandPalette               // Command palette overlay
+	debugMetricsService                    *services.DebugMetricsService // Hook timing metrics for the debug screen
+	detailPane                             *DetailPane                   // Session details shown next to the list
+	devMode                                bool                          // Development mode (shows version info in dialogs)
+	dialogs                                DialogRouter                  // Dialogs open over the session list
+	diffSummaryService                     *services.DiffSummaryService  // Describes session diffs in their notes
+	editor                                 string                        // Editor to open sessions in
+	errorManager                           *ErrorManager                 // Error display and auto-clearing
+	gitService                             *services.GitService          // Git operations service
+	handoffPrompt                          bool                          // Ask for a handoff note after detaching from a session
 	height                                 int
-	helpScreen                             *Dialog                      // Help screen dialog
-	keys                                   KeyMap                       // Keyboard shortcuts
-	sendTextForm                           *Dialog                      // Send text to tmux dialog
-	sessionCommentForm                     *Dialog                      // Session comment dialog
-	sessionForm                            *Dialog                      // Session creation dialog
-	sessionList                            *SessionList                 // Session list component
-	sessionOps                             *SessionOperations           // Session lifecycle operations
-	sessionRenameForm                      *Dialog                      // Session rename dialog
-	sessionService                         *services.SessionService     // Session lifecycle service
-	sessionState                           *domain.SessionCollection    // State data for git metadata and status
-	sessionStatusForm                      *Dialog                      // Session status dialog
-	sessionToArchive                       *ports.TmuxSession           // Session being archived (for worktree removal)
-	sessionToKill                          *ports.TmuxSession           // Session being killed (for worktree removal)
-	shellService                           *services.ShellService       // Shell session service
-	showPRNumber                           bool                         // Whether to show PR numbers in session list
+	keys                                   KeyMap                        // Keyboard shortcuts
+	migrationService                       *services.MigrationService    // Moves sessions between ROCHA_HOME directories
+	notePane                               *NotePane                     // Markdown note pane for the selected session
+	pauseService                           *services.PauseService        // Pauses agents and resumes them
+	profileSwitch                          *string                       // Settings profile to restart with once the TUI quits (nil = none)
+	recentActions                          []string                      // Recently used palette actions (most recent first)
+	repoBookmarkService                    *services.RepoBookmarkService // Repositories offered when creating sessions
+	schedulerService                       *services.SchedulerService    // Sends text to sessions and keeps their prompt history
+	sessionList                            *SessionList                  // Session list component
+	sessionOps                             *SessionOperations            // Session lifecycle operations
+	sessionService                         *services.SessionService      // Session lifecycle service
+	sessionState                           *domain.SessionCollection     // State data for git metadata and status
+	shellService                           *services.ShellService        // Shell session service
+	showPRNumber                           bool                          // Whether to show PR numbers in session list
 	state                                  uiState
 	statusConfig                           *config.StatusConfig         // Status configuration for implementation statuses
+	timerService                           *services.TimerService       // Countdown timers of sessions
 	timestampConfig                        *config.TimestampColorConfig // Timestamp color configuration
 	timestampMode                          TimestampMode
 	tmuxStatusPosition                     string
-	tokenChart                             *TokenChart                  // Token usage chart component
+	tokenChart                             *TokenChart                // Token usage chart component
+	toolAuditService                       *services.ToolAuditService // Tool uses of sessions that skip permission prompts
 	width                                  int
-	worktreeRemovalForm                    *Dialog                      // Worktree removal dialog
+	workspaceService                       *services.WorkspaceService // Groups sessions into workspaces
 }
 
 func NewModel(
+	attachMode string,
 	editor string,
 	errorClearDelay time.Duration,
 	statusConfig *config.StatusConfig,
 	timestampConfig *config.TimestampColorConfig,
 	devMode bool,
-	showTimestamps bool,
+	timestampMode TimestampMode,
 	showTokenChart bool,
 	showPRNumber bool,
+	accessible bool,
+	handoffPrompt bool,
 	tmuxStatusPosition string,
 	allowDangerouslySkipPermissionsDefault bool,
 	tipsConfig TipsConfig,
+	sortConfig SortConfig,
+	viewConfig ViewConfig,
 	keysConfig config.KeyBindingsConfig,
+	actionsService *actions.Service,
+	activityStatsService *services.ActivityStatsService,
+	attachmentService *services.AttachmentService,
+	badgeService *services.BadgeService,
+	checkService *services.CheckService,
+	checkpointService *services.CheckpointService,
+	ciService *services.CIService,
+	clipboardService *services.ClipboardService,
+	debugMetricsService *services.DebugMetricsService,
+	diffSummaryService *services.DiffSummaryService,
+	escalationService *services.EscalationService,
 	gitService *services.GitService,
+	historyPruneService *services.HistoryPruneService,
+	hookJournalService *services.HookJournalService,
+	instanceService *services.InstanceService,
+	migrationService *services.MigrationService,
+	pauseService *services.PauseService,
+	remoteFetchService *services.RemoteFetchService,
+	repoBookmarkService *services.RepoBookmarkService,
+	ruleService *services.RuleService,
+	schedulerService *services.SchedulerService,
 	sessionService *services.SessionService,
 	shellService *services.ShellService,
+	ticketSyncService *services.TicketSyncService,
+	timerService *services.TimerService,
+	tokenBudgetService *services.TokenBudgetService,
 	tokenStatsService *services.TokenStatsService,
+	toolAuditService *services.ToolAuditService,
+	workspaceService *services.WorkspaceService,
+	worktreeGCService *services.WorktreeGCService,
 ) *Model {
 	// Load session state - this is the source of truth
 	sessionState, stateErr := sessionService.LoadState(context.Background(), false)
@@ -96,22 +137,17 @@ func NewModel(
 		sessionState = &domain.SessionCollection{Sessions: make(map[string]domain.Session)}
 	}
 
-	// Convert showTimestamps flag to TimestampMode
-	var initialMode TimestampMode
-	if showTimestamps {
-		initialMode = TimestampRelative
-	} else {
-		initialMode = TimestampHidden
-	}
-
 	// Create shared key map
 	keys := NewKeyMap(keysConfig)
 
+	// Share one tmux session cache so existence checks don't fork tmux per session
+	tmuxCache := NewTmuxSessionCache(sessionService, statePollInterval)
+
 	// Create session operations component
-	sessionOps := NewSessionOperations(errorManager, tmuxStatusPosition, sessionService, shellService)
+	sessionOps := NewSessionOperations(attachMode, errorManager, tmuxStatusPosition, actionsService, sessionService, shellService, tmuxCache)
 
 	// Create session list component
-	sessionList := NewSessionList(sessionService, gitService, editor, statusConfig, timestampConfig, devMode, initialMode, keys, tmuxStatusPosition, tipsConfig)
+	sessionList := NewSessionList(sessionService, tmuxCache, gitService, schedulerService, escalationService, ruleService, timerService, tokenBudgetService, worktreeGCService, historyPruneService, hookJournalService, ticketSyncService, instanceService, remoteFetchService, badgeService, shellService, checkService, checkpointService, ciService, activityStatsService, editor, statusConfig, timestampConfig, devMode, timestampMode, keys, tmuxStatusPosition, tipsConfig, sortConfig, viewConfig, accessible)
 
 	// Create token chart component
 	tokenChart := NewTokenChart(tokenStatsService)
@@ -119,13 +155,34 @@ func NewModel(
 		tokenChart.SetVisible(true)
 	}
 
+	// Load recently used palette actions
+	recentActions, err := config.LoadRecentActions()
+	if err != nil {
+		logging.Logger.Warn("Failed to load recent palette actions", "error", err)
+	}
+
 	return &Model{
+		accessible:                             accessible,
+		actionsService:                         actionsService,
 		allowDangerouslySkipPermissionsDefault: allowDangerouslySkipPermissionsDefault,
+		attachmentService:                      attachmentService,
+		checkService:                           checkService,
+		clipboardService:                       clipboardService,
+		debugMetricsService:                    debugMetricsService,
+		diffSummaryService:                     diffSummaryService,
+		detailPane:                             NewDetailPane(activityStatsService),
 		devMode:                                devMode,
 		editor:                                 editor,
 		errorManager:                           errorManager,
 		gitService:                             gitService,
+		handoffPrompt:                          handoffPrompt,
 		keys:                                   keys,
+		migrationService:                       migrationService,
+		notePane:                               NewNotePane(),
+		pauseService:                           pauseService,
+		repoBookmarkService:                    repoBookmarkService,
+		recentActions:                          recentActions,
+		schedulerService:                       schedulerService,
 		sessionList:                            sessionList,
 		sessionOps:                             sessionOps,
 		sessionService:                         sessionService,
@@ -134,10 +191,13 @@ func NewModel(
 		showPRNumber:                           showPRNumber,
 		state:                                  stateList,
 		statusConfig:                           statusConfig,
+		timerService:                           timerService,
 		timestampConfig:                        timestampConfig,
-		timestampMode:                          initialMode,
+		timestampMode:                          timestampMode,
 		tmuxStatusPosition:                     tmuxStatusPosition,
 		tokenChart:                             tokenChart,
+		toolAuditService:                       toolAuditService,
+		workspaceService:                       workspaceService,
 	}
 }
 
@@ -157,29 +217,42 @@ func (m *Model) Init() tea.Cmd {
 }
 
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Dialogs and the palette get resized by their own handler; keep the list
+	// behind them sized too, so it is laid out right when they close
+	if size, ok := msg.(tea.WindowSizeMsg); ok && m.state != stateList {
+		m.width = size.Width
+		m.height = size.Height
+		m.recalculateListHeight()
+	}
+
+	// A copy started from the list may finish while a dialog or the palette is open
+	if copied, ok := msg.(sessionInfoCopiedMsg); ok {
+		if copied.err == nil {
+			return m, nil
+		}
+		m.errorManager.SetError(copied.err)
+		return m, m.errorManager.ClearAfterDelay()
+	}
+
+	var model tea.Model = m
+	var cmd tea.Cmd
 	switch m.state {
 	case stateList:
-		return m.updateList(msg)
+		model, cmd = m.updateList(msg)
 	case stateCommandPalette:
-		return m.updateCommandPalette(msg)
-	case stateCommentingSession:
-		return m.updateCommentingSession(msg)
-	case stateConfirmingArchive:
-		return m.updateConfirmingArchive(msg)
-	case stateConfirmingWorktreeRemoval:
-		return m.updateConfirmingWorktreeRemoval(msg)
-	case stateCreatingSession:
-		return m.updateCreatingSession(msg)
-	case stateHelp:
-		return m.updateHelp(msg)
-	case stateRenamingSession:
-		return m.updateRenamingSession(msg)
-	case stateSendingText:
-		return m.updateSendingText(msg)
-	case stateSettingStatus:
-		return m.updateSettingStatus(msg)
-	}
-	return m, nil
+		model, cmd = m.updateCommandPalette(msg)
+	case stateDialog:
+		model, cmd = m.updateDialog(msg)
+	}
+
+	// The panes follow the selected session; synced here so View only renders
+	if m.notePane.IsVisible() {
+		m.syncNotePane()
+	}
+	if m.detailPane.Shown(m.width) {
+		m.syncDetailPane()
+	}
+	return model, cmd
 }
 
 func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -189,18 +262,27 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case QuitMsg:
 		return m, tea.Quit
 	case ShowHelpMsg:
-		contentForm := NewHelpScreen(&m.keys)
-		m.helpScreen = NewDialog("Help", contentForm, m.devMode)
-		m.state = stateHelp
-		// Send initial WindowSizeMsg so viewport can initialize
-		initCmd := m.helpScreen.Init()
-		updatedDialog, sizeCmd := m.helpScreen.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
-		if d, ok := updatedDialog.(*Dialog); ok {
-			m.helpScreen = d
+		var session *domain.Session
+		if item, ok := m.sessionList.list.SelectedItem().(SessionItem); ok {
+			if s, exists := m.sessionList.sessionState.Sessions[item.Session.Name]; exists {
+				session = &s
+			}
 		}
-		return m, tea.Batch(initCmd, sizeCmd)
+		return m, openDialog(m, i18n.T("dialog.help"), NewHelpScreen(&m.keys, m.accessible, session), m.helpClosed)
+	case ShowDebugScreenMsg:
+		hookEvents, err := m.debugMetricsService.RecentHookEvents(context.Background(), debugHookRows)
+		return m, openDialog(m, i18n.T("dialog.debug"), NewDebugScreen(m.sessionList.DebugMetrics(), hookEvents, err, m.debugMetricsService.Enabled(), &m.keys), nil)
+	case ToolAuditSessionMsg:
+		skipsPermissions := m.sessionState.Sessions[msg.SessionName].AllowDangerouslySkipPermissions
+		toolUses, err := m.toolAuditService.ListToolUses(context.Background(), msg.SessionName, services.DefaultToolUseLimit)
+		contentForm := NewToolAuditScreen(skipsPermissions, toolUses, err, &m.keys)
+		return m, openDialog(m, i18n.Tf("dialog.tool_audit", msg.SessionName), contentForm, nil)
 	case AttachSessionMsg:
 		return m, m.sessionOps.AttachToSession(msg.Session.Name)
+	case RestartSessionMsg:
+		sessionInfo := m.sessionState.Sessions[msg.Session.Name]
+		contentForm := NewSessionRestartForm(m.sessionService, msg.Session, sessionInfo, msg.AttachShell, m.tmuxStatusPosition)
+		return m, openDialog(m, i18n.T("dialog.restart"), contentForm, m.sessionRestarted)
 
 	// Phase 2: Dialog action messages
 	case RenameSessionMsg:
@@ -210,9 +292,10 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 			currentDisplayName = sessionInfo.DisplayName
 		}
 		contentForm := NewSessionRenameForm(m.sessionService, m.sessionState, msg.SessionName, currentDisplayName)
-		m.sessionRenameForm = NewDialog("Rename Session", contentForm, m.devMode)
-		m.state = stateRenamingSession
-		return m, m.sessionRenameForm.Init()
+		return m, openDialog(m, i18n.T("dialog.rename"), contentForm, func(form *SessionRenameForm) tea.Cmd {
+			result := form.Result()
+			return m.sessionEdited("rename session", result.Error, result.Cancelled)
+		})
 
 	case CommentSessionMsg:
 		// Get current comment
@@ -221,9 +304,116 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 			currentComment = sessionInfo.Comment
 		}
 		contentForm := NewSessionCommentForm(m.sessionService, msg.SessionName, currentComment)
-		m.sessionCommentForm = NewDialog("Edit Session Comment", contentForm, m.devMode)
-		m.state = stateCommentingSession
-		return m, m.sessionCommentForm.Init()
+		return m, openDialog(m, i18n.T("dialog.comment"), contentForm, func(form *SessionCommentForm) tea.Cmd {
+			result := form.Result()
+			return m.sessionEdited("update comment", result.Error, result.Cancelled)
+		})
+
+	case InlineEditMsg:
+		if err := m.applyInlineEdit(msg); err != nil {
+			m.errorManager.SetError(err)
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		refreshCmd, err := m.reloadSessionStateAfterDialog()
+		if err != nil {
+			m.errorManager.SetError(err)
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		return m, refreshCmd
+
+	case NoteSessionMsg:
+		// Get current note
+		currentNote := ""
+		if sessionInfo, ok := m.sessionState.Sessions[msg.SessionName]; ok {
+			currentNote = sessionInfo.Note
+		}
+		contentForm := NewSessionNoteForm(m.sessionService, msg.SessionName, currentNote)
+		return m, openDialog(m, i18n.T("dialog.note"), contentForm, func(form *SessionNoteForm) tea.Cmd {
+			result := form.Result()
+			return m.sessionEdited("update note", result.Error, result.Cancelled)
+		})
+
+	case SummarizeDiffSessionMsg:
+		sessionInfo, exists := m.getFreshSessionInfo(msg.SessionName)
+		if !exists || sessionInfo.WorkingDir() == "" {
+			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		logging.Logger.Info("Summarizing session diff", "session", msg.SessionName)
+		return m, StartDiffSummary(m.diffSummaryService, msg.SessionName)
+
+	case DiffSummaryReadyMsg:
+		if msg.Err != nil {
+			m.errorManager.SetError(fmt.Errorf("failed to summarize diff of session '%s': %w", msg.SessionName, msg.Err))
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		// Show the new note where it is read
+		if !m.notePane.IsVisible() {
+			m.notePane.Toggle()
+			m.recalculateListHeight()
+		}
+		return m, m.sessionList.Init()
+
+	case RunChecksSessionMsg:
+		sessionInfo, exists := m.getFreshSessionInfo(msg.SessionName)
+		if !exists {
+			m.errorManager.SetError(fmt.Errorf("session '%s' not found", msg.SessionName))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		logging.Logger.Info("Running session checks", "session", msg.SessionName)
+		return m, StartChecks(m.checkService, sessionInfo)
+
+	case ChecksStartedMsg:
+		if msg.Err != nil {
+			m.errorManager.SetError(fmt.Errorf("failed to run checks of session '%s': %w", msg.SessionName, msg.Err))
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		return m, m.sessionList.Init()
+
+	case CheckOutputSessionMsg:
+		run, err := m.checkService.Last(msg.SessionName)
+		var output string
+		var truncated bool
+		if err == nil && run != nil {
+			output, truncated, err = m.checkService.Output(run)
+		}
+		contentForm := NewCheckOutputScreen(run, output, truncated, err, &m.keys)
+		return m, openDialog(m, i18n.Tf("dialog.checks", msg.SessionName), contentForm, nil)
+
+	case TagsSessionMsg:
+		// Get current tags
+		var currentTags []string
+		if sessionInfo, ok := m.sessionState.Sessions[msg.SessionName]; ok {
+			currentTags = sessionInfo.Tags
+		}
+		contentForm := NewSessionTagsForm(m.sessionService, msg.SessionName, currentTags)
+		return m, openDialog(m, i18n.T("dialog.tags"), contentForm, func(form *SessionTagsForm) tea.Cmd {
+			result := form.Result()
+			return m.sessionEdited("update tags", result.Error, result.Cancelled)
+		})
+
+	case AttachmentsSessionMsg:
+		attachments, err := m.attachmentService.List(context.Background(), msg.SessionName)
+		if err != nil {
+			m.errorManager.SetError(fmt.Errorf("failed to list attachments: %w", err))
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		contentForm := NewSessionAttachmentsForm(m.attachmentService, msg.SessionName, attachments)
+		return m, openDialog(m, i18n.T("dialog.attachments"), contentForm, func(form *SessionAttachmentsForm) tea.Cmd {
+			return m.dialogFailed("update attachments", form.Result().Error)
+		})
+
+	case TimerSessionMsg:
+		// Get current timer
+		var currentTimer *domain.SessionTimer
+		if sessionInfo, ok := m.sessionState.Sessions[msg.SessionName]; ok {
+			currentTimer = sessionInfo.Timer
+		}
+		contentForm := NewSessionTimerForm(m.timerService, msg.SessionName, currentTimer)
+		return m, openDialog(m, i18n.T("dialog.timer"), contentForm, func(form *SessionTimerForm) tea.Cmd {
+			result := form.Result()
+			return m.sessionEdited("update timer", result.Error, result.Cancelled)
+		})
 
 	case SetStatusSessionMsg:
 		// Get current status
@@ -232,23 +422,24 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 			currentStatus = sessionInfo.Status
 		}
 		contentForm := NewSessionStatusForm(m.sessionService, msg.SessionName, currentStatus, m.statusConfig)
-		m.sessionStatusForm = NewDialog("Set Status", contentForm, m.devMode)
-		m.state = stateSettingStatus
-		return m, m.sessionStatusForm.Init()
+		return m, openDialog(m, i18n.T("dialog.status"), contentForm, func(form *SessionStatusForm) tea.Cmd {
+			result := form.Result()
+			return m.sessionEdited("update status", result.Error, result.Cancelled)
+		})
 
 	case SendTextSessionMsg:
-		contentForm := NewSendTextForm(m.shellService, msg.SessionName)
-		m.sendTextForm = NewDialog("Send Text to Claude", contentForm, m.devMode)
-		m.state = stateSendingText
-		return m, m.sendTextForm.Init()
+		contentForm := NewSendTextForm(m.schedulerService, msg.SessionName)
+		return m, openDialog(m, i18n.T("dialog.send_text"), contentForm, func(form *SendTextForm) tea.Cmd {
+			return m.dialogFailed("send text", form.Result().Error)
+		})
 
 	case OpenEditorSessionMsg:
 		sessionInfo, exists := m.sessionState.Sessions[msg.SessionName]
-		if !exists || sessionInfo.WorktreePath == "" {
+		if !exists || sessionInfo.WorkingDir() == "" {
 			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
 			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 		}
-		if err := m.shellService.OpenEditor(sessionInfo.WorktreePath, m.editor); err != nil {
+		if err := m.shellService.OpenSessionInEditor(context.Background(), msg.SessionName, m.editor, msg.ChangedFiles); err != nil {
 			m.errorManager.SetError(fmt.Errorf("failed to open editor: %w", err))
 			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 		}
@@ -256,15 +447,15 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case NewSessionMsg:
 		// Pre-fill repo field if starting in a git folder
-		defaultRepoSource := msg.DefaultRepoSource
-		if defaultRepoSource == "" {
+		draft := msg.Draft
+		if draft.RepoSource == "" {
 			cwd, err := os.Getwd()
 			if err != nil {
 				logging.Logger.Debug("Failed to get current working directory", "error", err)
 			}
 			if isGit, repoPath := m.gitService.IsGitRepo(cwd); isGit {
 				if remoteURL := m.gitService.GetRemoteURL(repoPath); remoteURL != "" {
-					defaultRepoSource = remoteURL
+					draft.RepoSource = remoteURL
 					logging.Logger.Info("Pre-filling repository field with remote URL", "remote_url", remoteURL)
 				} else {
 					logging.Logger.Warn("Git repository has no remote configured, leaving repo field empty")
@@ -273,11 +464,23 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		logging.Logger.Debug("Creating new session dialog",
 			"allow_dangerously_skip_permissions_default", m.allowDangerouslySkipPermissionsDefault,
-			"default_repo_source", defaultRepoSource)
-		contentForm := NewSessionForm(m.gitService, m.sessionService, m.sessionState, m.tmuxStatusPosition, m.allowDangerouslySkipPermissionsDefault, defaultRepoSource)
-		m.sessionForm = NewDialog("Create Session", contentForm, m.devMode)
-		m.state = stateCreatingSession
-		return m, m.sessionForm.Init()
+			"default_repo_source", draft.RepoSource)
+		title := msg.Title
+		if title == "" {
+			title = i18n.T("dialog.new")
+		}
+		contentForm := NewSessionForm(m.gitService, m.sessionService, m.repoBookmarkService, m.sessionState, m.tmuxStatusPosition, m.allowDangerouslySkipPermissionsDefault, draft)
+		return m, openDialog(m, title, contentForm, m.sessionCreated)
+
+	case NewSessionFromClipboardMsg:
+		draft, err := m.clipboardService.DraftSessionFromClipboard()
+		if err != nil {
+			m.errorManager.SetError(err)
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		return m, func() tea.Msg {
+			return NewSessionMsg{Draft: draft, Title: i18n.T("dialog.new_from_clip")}
+		}
 
 	case NewSessionFromTemplateMsg:
 		// Get the repo source from the template session
@@ -316,20 +519,31 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 		logging.Logger.Debug("Creating new session from template dialog",
 			"allow_dangerously_skip_permissions_default", m.allowDangerouslySkipPermissionsDefault,
 			"default_repo_source", repoSource)
-		contentForm := NewSessionForm(m.gitService, m.sessionService, m.sessionState, m.tmuxStatusPosition, m.allowDangerouslySkipPermissionsDefault, repoSource)
-		m.sessionForm = NewDialog("Create Session (from same repo)", contentForm, m.devMode)
-		m.state = stateCreatingSession
-		return m, m.sessionForm.Init()
+		contentForm := NewSessionForm(m.gitService, m.sessionService, m.repoBookmarkService, m.sessionState, m.tmuxStatusPosition, m.allowDangerouslySkipPermissionsDefault, domain.SessionDraft{RepoSource: repoSource})
+		return m, openDialog(m, i18n.T("dialog.new_from_repo"), contentForm, m.sessionCreated)
 
 	// Phase 3: Complex action messages
 	case KillSessionMsg:
 		return m.handleKillSession(msg.SessionName)
 
+	case KillProcessSessionMsg:
+		return m.handleKillProcess(msg.SessionName)
+
+	case MoveSessionsMsg:
+		return m.handleMoveSessions(msg.SessionName)
+
+	case SessionKilledMsg:
+		logging.Logger.Info("Session killed", "name", msg.SessionName)
+		return m, m.sessionOps.ReloadAfterKill(msg, m.sessionState, m.sessionList)
+
 	case ArchiveSessionMsg:
 		return m.handleArchiveSession(msg.SessionName)
 
 	case ToggleFlagSessionMsg:
-		return m.handleToggleFlag(msg.SessionName)
+		return m, m.sessionList.toggleSessionFlag(msg.SessionName)
+
+	case TogglePauseSessionMsg:
+		return m.handleTogglePause(msg.SessionName)
 
 	case AttachShellSessionMsg:
 		shellSessionName := m.sessionOps.GetOrCreateShellSession(msg.Session, m.sessionState)
@@ -352,7 +566,7 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 			sessionName = item.DisplayName
 		}
 
-		m.commandPalette = NewCommandPalette(session, sessionName, m.keys)
+		m.commandPalette = NewCommandPalette(session, sessionName, m.keys, m.recentActions)
 		m.state = stateCommandPalette
 
 		// Send initial window size
@@ -362,27 +576,107 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case ToggleTimestampsMsg:
 		// Cycle timestamps (same logic as existing key handler)
-		switch m.timestampMode {
-		case TimestampRelative:
-			m.timestampMode = TimestampAbsolute
-		case TimestampAbsolute:
-			m.timestampMode = TimestampHidden
-		case TimestampHidden:
-			m.timestampMode = TimestampRelative
-		}
+		m.timestampMode = nextTimestampMode(m.timestampMode)
 		m.sessionList.timestampMode = m.timestampMode
 		refreshCmd := m.sessionList.RefreshFromState()
 		return m, tea.Batch(refreshCmd, m.sessionList.Init())
 
+	case CycleDensityMsg:
+		return m, m.sessionList.cycleDensity()
+
+	case CycleSortMsg:
+		return m, m.sessionList.cycleSort()
+
+	case SwitchWorkspaceMsg:
+		contentForm := NewWorkspaceForm(m.workspaceService, m.sessionList.Workspace())
+		if contentForm.Completed {
+			m.errorManager.SetError(fmt.Errorf("failed to list workspaces: %w", contentForm.Result().Error))
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		return m, openDialog(m, i18n.T("dialog.workspace"), contentForm, func(form *WorkspaceForm) tea.Cmd {
+			if result := form.Result(); !result.Cancelled {
+				return m.sessionList.SetWorkspace(result.Workspace)
+			}
+			return nil
+		})
+
+	case SwitchProfileMsg:
+		contentForm := NewProfileForm(config.ActiveProfile())
+		if contentForm.Completed {
+			m.errorManager.SetError(fmt.Errorf("failed to list profiles: %w", contentForm.Result().Error))
+			return m, m.errorManager.ClearAfterDelay()
+		}
+		return m, openDialog(m, i18n.T("dialog.profile"), contentForm, func(form *ProfileForm) tea.Cmd {
+			result := form.Result()
+			if result.Cancelled || result.Profile == config.ActiveProfile() {
+				return nil
+			}
+			m.profileSwitch = &result.Profile
+			return tea.Quit
+		})
+
+	case ShowViewsMsg:
+		contentForm := NewViewForm(m.sessionList.Views(), m.sessionList.CurrentView())
+		return m, openDialog(m, i18n.T("dialog.views"), contentForm, m.viewPicked)
+
 	case ToggleTokenChartMsg:
 		m.tokenChart.Toggle()
 		m.recalculateListHeight()
 		return m, m.sessionList.Init()
 
+	case ToggleTokenChartByModelMsg:
+		m.tokenChart.ToggleByModel()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+
+	case ToggleTokenChartCacheMsg:
+		m.tokenChart.ToggleCache()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+
+	case ToggleNotePaneMsg:
+		m.notePane.Toggle()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+
+	case ToggleDetailPaneMsg:
+		m.detailPane.Toggle()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+
+	case OpenSettingsMsg:
+		return m.handleOpenSettings()
+
+	case CleanOrphanShellsMsg:
+		return m, findOrphanShells(m.shellService)
+
+	case orphanShellsFoundMsg:
+		if msg.Err != nil {
+			m.errorManager.SetError(fmt.Errorf("failed to find orphan shells: %w", msg.Err))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		return m, openDialog(m, i18n.T("dialog.orphan_shells"), NewOrphanShellsForm(msg.Orphans), func(form *OrphanShellsForm) tea.Cmd {
+			if form.Cancelled || !form.Clean {
+				return nil
+			}
+			return cleanOrphanShells(m.shellService)
+		})
+
+	case orphanShellsCleanupDoneMsg:
+		refreshCmd, err := m.reloadSessionStateAfterDialog()
+		if msg.Err != nil || err != nil {
+			m.errorManager.SetError(fmt.Errorf("failed to clean up orphan shells: %w", errors.Join(msg.Err, err)))
+			return m, tea.Batch(refreshCmd, m.errorManager.ClearAfterDelay())
+		}
+		return m, refreshCmd
+
 	case CycleStatusMsg:
 		// Delegate to session list's cycleSessionStatus
 		return m, m.sessionList.cycleSessionStatus(msg.SessionName)
 
+	case CyclePriorityMsg:
+		return m, m.sessionList.cycleSessionPriority(msg.SessionName)
+
 	case PRInfoReadyMsg:
 		// PR info fetched - update in-memory state and persist to database
 		if sessionInfo, exists := m.sessionState.Sessions[msg.SessionName]; exists {
@@ -425,18 +719,125 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 		refreshCmd := m.sessionList.RefreshFromState()
 		return m, tea.Batch(refreshCmd, m.sessionList.Init())
 
+	case CopySessionInfoMsg:
+		// Clipboard tools can be slow to start, so copy without blocking the UI
+		clipboardService := m.clipboardService
+		copyCmd := func() tea.Msg {
+			_, err := clipboardService.CopySessionInfo(context.Background(), msg.SessionName, msg.Field)
+			return sessionInfoCopiedMsg{err: err}
+		}
+		return m, tea.Batch(m.sessionList.Init(), copyCmd)
+
 	case OpenPRMsg:
 		// Open PR in browser for session
 		sessionInfo, exists := m.sessionState.Sessions[msg.SessionName]
-		if !exists || sessionInfo.WorktreePath == "" {
+		if !exists || sessionInfo.WorkingDir() == "" {
 			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
 			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 		}
-		if err := m.gitService.OpenPRInBrowser(sessionInfo.WorktreePath); err != nil {
+		if err := m.gitService.OpenPRInBrowser(sessionInfo.WorkingDir()); err != nil {
 			m.errorManager.SetError(fmt.Errorf("failed to open PR: %w", err))
 			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 		}
 		return m, m.sessionList.Init()
+
+	case FetchBaseSessionMsg:
+		sessionInfo, exists := m.getFreshSessionInfo(msg.SessionName)
+		if !exists || sessionInfo.WorkingDir() == "" || sessionInfo.IsExternal {
+			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		logging.Logger.Info("Fetching base branch", "session", msg.SessionName, "base", sessionInfo.BaseBranch)
+		return m, StartBaseFetch(m.gitService, GitStatsRequest{
+			BaseBranch:   sessionInfo.BaseBranch,
+			SessionName:  msg.SessionName,
+			WorktreePath: sessionInfo.WorkingDir(),
+		})
+
+	case BaseFetchErrorMsg:
+		m.errorManager.SetError(fmt.Errorf("failed to fetch base branch for session '%s': %w", msg.SessionName, msg.Err))
+		return m, m.errorManager.ClearAfterDelay()
+
+	case RebaseSessionMsg:
+		sessionInfo, exists := m.getFreshSessionInfo(msg.SessionName)
+		if !exists || sessionInfo.WorktreePath == "" {
+			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		logging.Logger.Info("Starting rebase", "session", msg.SessionName, "worktree", sessionInfo.WorktreePath)
+		return m, StartRebase(m.gitService, msg.SessionName, sessionInfo.WorktreePath, sessionInfo.BaseBranch)
+
+	case RebaseReadyMsg:
+		if msg.Result.HasConflicts() {
+			contentForm := NewRebaseConflictForm(m.gitService, m.shellService, m.editor, msg.SessionName, msg.WorktreePath, msg.Result)
+			return m, openDialog(m, i18n.T("dialog.rebase_conflicts"), contentForm, func(form *RebaseConflictForm) tea.Cmd {
+				return m.dialogFailed("handle rebase conflicts", form.Result().Error)
+			})
+		}
+		logging.Logger.Info("Session rebased", "session", msg.SessionName, "base", msg.Result.BaseBranch)
+		return m, nil
+
+	case RebaseErrorMsg:
+		m.errorManager.SetError(fmt.Errorf("failed to rebase session '%s': %w", msg.SessionName, msg.Err))
+		return m, m.errorManager.ClearAfterDelay()
+
+	case StashSessionMsg:
+		sessionInfo, exists := m.getFreshSessionInfo(msg.SessionName)
+		if !exists || sessionInfo.WorkingDir() == "" {
+			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		logging.Logger.Info("Stashing session changes", "session", msg.SessionName)
+		return m, StartStash(m.gitService, GitStatsRequest{
+			BaseBranch:   sessionInfo.BaseBranch,
+			SessionName:  msg.SessionName,
+			WorktreePath: sessionInfo.WorkingDir(),
+		})
+
+	case UnstashSessionMsg:
+		sessionInfo, exists := m.getFreshSessionInfo(msg.SessionName)
+		if !exists || sessionInfo.WorkingDir() == "" {
+			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		logging.Logger.Info("Popping session stash", "session", msg.SessionName)
+		return m, StartUnstash(m.gitService, GitStatsRequest{
+			BaseBranch:   sessionInfo.BaseBranch,
+			SessionName:  msg.SessionName,
+			WorktreePath: sessionInfo.WorkingDir(),
+		})
+
+	case StashErrorMsg:
+		action := "stash changes of"
+		if msg.Pop {
+			action = "pop stash of"
+		}
+		m.errorManager.SetError(fmt.Errorf("failed to %s session '%s': %w", action, msg.SessionName, msg.Err))
+		return m, m.errorManager.ClearAfterDelay()
+
+	case SwitchBranchSessionMsg:
+		sessionInfo, exists := m.getFreshSessionInfo(msg.SessionName)
+		if !exists || sessionInfo.IsExternal || sessionInfo.WorktreePath == "" {
+			m.errorManager.SetError(fmt.Errorf("no worktree associated with session '%s'", msg.SessionName))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+		}
+		return m, StartBranchListing(m.gitService, msg.SessionName, sessionInfo.WorktreePath)
+
+	case BranchesReadyMsg:
+		contentForm := NewSessionBranchForm(msg.SessionName, msg.WorktreePath, msg.Branches)
+		return m, openDialog(m, i18n.T("dialog.switch_branch"), contentForm, m.branchPicked)
+
+	case optimisticUpdateDoneMsg:
+		cmd, err := m.sessionList.finishOptimisticUpdate(msg)
+		if err != nil {
+			m.errorManager.SetError(err)
+			return m, tea.Batch(cmd, m.errorManager.ClearAfterDelay())
+		}
+		return m, cmd
+
+	case BranchSwitchErrorMsg:
+		m.errorManager.SetError(fmt.Errorf("failed to switch branch of session '%s': %w", msg.SessionName, msg.Err))
+		return m, m.errorManager.ClearAfterDelay()
 	}
 
 	// Handle clear error message
@@ -450,6 +851,11 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.tokenChart.Refresh()
 	}
 
+	// Pick up new events of the selected session on poll cycle (when visible)
+	if _, ok := msg.(checkStateMsg); ok && m.detailPane.IsVisible() {
+		m.detailPane.Refresh()
+	}
+
 	// Handle window size updates
 	if msg, ok := msg.(tea.WindowSizeMsg); ok {
 		m.width = msg.Width
@@ -460,10 +866,15 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	// Handle detach message - session list auto-refreshes via polling
-	if _, ok := msg.(detachedMsg); ok {
+	if msg, ok := msg.(detachedMsg); ok {
 		m.state = stateList
 		refreshCmd := m.sessionList.RefreshFromState()
 
+		// Ask where the user left off while it is fresh in their mind
+		if m.handoffPrompt {
+			refreshCmd = tea.Batch(refreshCmd, m.sessionList.StartHandoff(msg.SessionName))
+		}
+
 		// Trigger batch PR fetch for all sessions if enabled
 		var prFetchCmd tea.Cmd
 		if m.showPRNumber {
@@ -486,6 +897,13 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 	}
 
+	// While a row is edited inline, every key is text for the input
+	_, isKey := msg.(tea.KeyMsg)
+	if isKey && m.sessionList.inlineEdit.Active() {
+		_, cmd := m.sessionList.Update(msg)
+		return m, cmd
+	}
+
 	// Hidden test command: alt+shift+e generates Model-level error (persists 5 seconds)
 	if keyMsg, ok := msg.(tea.KeyMsg); ok && keyMsg.String() == "alt+E" {
 		m.errorManager.SetError(fmt.Errorf("this is a persistent Model-level test error that demonstrates the error display functionality with automatic height adjustment and will clear after five seconds to verify that the list height properly expands back to normal and ensures all session items remain visible throughout the entire error lifecycle"))
@@ -493,16 +911,9 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	// Toggle timestamps display mode
-	// Cycle: Relative -> Absolute -> Hidden -> Relative -> ...
+	// Cycle: Relative -> Absolute -> Hybrid -> Hidden -> Relative -> ...
 	if keyMsg, ok := msg.(tea.KeyMsg); ok && key.Matches(keyMsg, m.keys.Application.Timestamps.Binding) {
-		switch m.timestampMode {
-		case TimestampRelative:
-			m.timestampMode = TimestampAbsolute
-		case TimestampAbsolute:
-			m.timestampMode = TimestampHidden
-		case TimestampHidden:
-			m.timestampMode = TimestampRelative
-		}
+		m.timestampMode = nextTimestampMode(m.timestampMode)
 		m.sessionList.timestampMode = m.timestampMode
 		refreshCmd := m.sessionList.RefreshFromState()
 		return m, tea.Batch(refreshCmd, m.sessionList.Init())
@@ -516,6 +927,34 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.sessionList.Init()
 	}
 
+	// Switch the token chart between input/output and per-model bars
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && key.Matches(keyMsg, m.keys.Application.TokenChartByModel.Binding) {
+		m.tokenChart.ToggleByModel()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+	}
+
+	// Include or exclude cache tokens in the token chart
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && key.Matches(keyMsg, m.keys.Application.TokenChartCache.Binding) {
+		m.tokenChart.ToggleCache()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+	}
+
+	// Toggle note pane
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && key.Matches(keyMsg, m.keys.Application.NotePane.Binding) {
+		m.notePane.Toggle()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+	}
+
+	// Toggle detail pane
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && key.Matches(keyMsg, m.keys.Application.DetailPane.Binding) {
+		m.detailPane.Toggle()
+		m.recalculateListHeight()
+		return m, m.sessionList.Init()
+	}
+
 	// Delegate to SessionList component
 	newList, cmd := m.sessionList.Update(msg)
 	if sl, ok := newList.(*SessionList); ok {
@@ -526,12 +965,6 @@ func (m *Model) updateList(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m *Model) updateCommandPalette(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Forward window size to palette
-	if sizeMsg, ok := msg.(tea.WindowSizeMsg); ok {
-		m.width = sizeMsg.Width
-		m.height = sizeMsg.Height
-	}
-
 	// Delegate to palette
 	updated, cmd := m.commandPalette.Update(msg)
 	if cp, ok := updated.(*CommandPalette); ok {
@@ -547,189 +980,239 @@ func (m *Model) updateCommandPalette(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if result.Cancelled || result.Action == nil {
 			return m, m.sessionList.Init()
 		}
-
-		// Get selected session for dispatcher
-		var session *ports.TmuxSession
-		if item, ok := m.sessionList.list.SelectedItem().(SessionItem); ok {
-			session = item.Session
-		}
-
-		// Dispatch the action
-		dispatcher := NewActionDispatcher(session)
-		actionMsg := dispatcher.Dispatch(*result.Action)
-
-		if actionMsg != nil {
-			// Process the action message through updateList
-			return m.updateList(actionMsg)
-		}
-
-		return m, m.sessionList.Init()
+		return m.runAction(*result.Action)
 	}
 
 	return m, cmd
 }
 
-func (m *Model) updateCreatingSession(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Delegate to dialog (it handles cancel internally)
-	updated, cmd := m.sessionForm.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.sessionForm = d
+// runAction runs an action picked in the command palette or the help screen on the
+// selected session, as if its key was pressed
+func (m *Model) runAction(def KeyDefinition) (tea.Model, tea.Cmd) {
+	// Get selected session for dispatcher
+	var session *ports.TmuxSession
+	if item, ok := m.sessionList.list.SelectedItem().(SessionItem); ok {
+		session = item.Session
 	}
 
-	// Check if dialog completed
-	if content, ok := m.sessionForm.Content().(*SessionForm); ok && content.Completed {
-		result := content.Result()
-		m.state = stateList
-		m.sessionForm = nil
+	// Dispatch the action
+	dispatcher := NewActionDispatcher(session)
+	actionMsg := dispatcher.Dispatch(def)
 
-		if result.Error != nil {
-			m.errorManager.SetError(fmt.Errorf("failed to create session: %w", result.Error))
-			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
-		}
+	if actionMsg != nil {
+		m.recordRecentAction(def.Name)
+		// Process the action message through updateList
+		return m.updateList(actionMsg)
+	}
 
-		if !result.Cancelled {
-			// Use helper - eliminates duplication
-			refreshCmd, err := m.reloadSessionStateAfterDialog()
-			if err != nil {
-				m.errorManager.SetError(err)
-				logging.Logger.Warn("Failed to reload session state", "error", err)
-				return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
-			}
-			// Select the newly added session (always at position 0)
-			m.sessionList.list.Select(0)
-			return m, tea.Batch(refreshCmd, m.sessionList.Init())
-		}
+	return m, m.sessionList.Init()
+}
 
-		return m, m.sessionList.Init()
+// updateDialog routes a message to the dialog on top. Once the last dialog closes, the
+// list resumes polling, unless the result handler went back to the list by itself.
+func (m *Model) updateDialog(msg tea.Msg) (tea.Model, tea.Cmd) {
+	cmd, closed := m.dialogs.Update(msg)
+	if closed && m.dialogs.Empty() && m.state == stateDialog {
+		m.state = stateList
+		cmd = tea.Batch(cmd, m.sessionList.Init())
 	}
-
 	return m, cmd
 }
 
-func (m *Model) updateRenamingSession(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Delegate to dialog (it handles cancel internally)
-	updated, cmd := m.sessionRenameForm.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.sessionRenameForm = d
+// dialogFailed shows the error a dialog ended with, prefixed with what failed
+func (m *Model) dialogFailed(action string, err error) tea.Cmd {
+	if err == nil {
+		return nil
 	}
+	m.errorManager.SetError(fmt.Errorf("failed to %s: %w", action, err))
+	return m.errorManager.ClearAfterDelay()
+}
 
-	// Check if dialog completed
-	if content, ok := m.sessionRenameForm.Content().(*SessionRenameForm); ok && content.Completed {
-		result := content.Result()
-		m.state = stateList
-		m.sessionRenameForm = nil
-
-		if result.Error != nil {
-			m.errorManager.SetError(fmt.Errorf("failed to rename session: %w", result.Error))
-			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
-		}
+// sessionEdited handles the result of a dialog that saves a session: it shows the
+// error the dialog ended with, or reloads the session state unless it was cancelled
+func (m *Model) sessionEdited(action string, err error, cancelled bool) tea.Cmd {
+	if err != nil || cancelled {
+		return m.dialogFailed(action, err)
+	}
+	refreshCmd, err := m.reloadSessionStateAfterDialog()
+	if err != nil {
+		m.errorManager.SetError(err)
+		return m.errorManager.ClearAfterDelay()
+	}
+	return refreshCmd
+}
 
-		if !result.Cancelled {
-			// Use helper - eliminates duplication
-			refreshCmd, err := m.reloadSessionStateAfterDialog()
-			if err != nil {
-				m.errorManager.SetError(err)
-				return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
-			}
-			return m, tea.Batch(refreshCmd, m.sessionList.Init())
-		}
+// sessionCreated lists and selects the session the new session form created or reused
+func (m *Model) sessionCreated(form *SessionForm) tea.Cmd {
+	result := form.Result()
+	if result.Error != nil || result.Cancelled {
+		return m.dialogFailed("create session", result.Error)
+	}
 
-		return m, m.sessionList.Init()
+	// Open the existing session when one was reused: list it in this workspace and select it
+	sessionName := result.ReusedSession
+	if sessionName == "" {
+		sessionName = domain.SanitizeSessionName(result.SessionName)
 	}
+	m.addToActiveWorkspace(sessionName)
 
-	return m, cmd
+	refreshCmd, err := m.reloadSessionStateAfterDialog()
+	if err != nil {
+		m.errorManager.SetError(err)
+		logging.Logger.Warn("Failed to reload session state", "error", err)
+		return m.errorManager.ClearAfterDelay()
+	}
+	if result.ReusedSession != "" {
+		m.sessionList.SelectSession(result.ReusedSession)
+	} else {
+		// Select the newly added session (always at position 0)
+		m.sessionList.list.Select(0)
+	}
+	return refreshCmd
 }
 
-func (m *Model) updateSettingStatus(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Delegate to dialog (it handles cancel internally)
-	updated, cmd := m.sessionStatusForm.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.sessionStatusForm = d
+// sessionsMoved reloads the sessions after the move wizard, which may have moved some
+// of them even when it failed part way through
+func (m *Model) sessionsMoved(form *SessionMoveForm) tea.Cmd {
+	result := form.Result()
+	if result.Cancelled && result.Error == nil {
+		return nil
 	}
 
-	// Check if dialog completed
-	if content, ok := m.sessionStatusForm.Content().(*SessionStatusForm); ok && content.Completed {
-		result := content.Result()
-		m.state = stateList
-		m.sessionStatusForm = nil
-
-		if result.Error != nil {
-			m.errorManager.SetError(fmt.Errorf("failed to update status: %w", result.Error))
-			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
-		}
+	refreshCmd, err := m.reloadSessionStateAfterDialog()
+	if err == nil {
+		err = result.Error
+	}
+	if err != nil {
+		m.errorManager.SetError(fmt.Errorf("failed to move sessions: %w", err))
+		return tea.Batch(refreshCmd, m.errorManager.ClearAfterDelay())
+	}
 
-		if !result.Cancelled {
-			// Use helper - eliminates duplication
-			refreshCmd, err := m.reloadSessionStateAfterDialog()
-			if err != nil {
-				m.errorManager.SetError(err)
-				return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
-			}
-			return m, tea.Batch(refreshCmd, m.sessionList.Init())
-		}
+	logging.Logger.Info("Moved sessions", "repo", result.RepoInfo, "dest", result.DestHome, "count", result.MovedCount, "skipped", result.Skipped)
+	return refreshCmd
+}
 
-		return m, m.sessionList.Init()
+// sessionRestarted attaches to a restarted session, like opening a running session
+func (m *Model) sessionRestarted(form *SessionRestartForm) tea.Cmd {
+	result := form.Result()
+	if result.Error != nil || result.Cancelled {
+		return m.dialogFailed("restart session", result.Error)
 	}
 
-	return m, cmd
+	// Detaching resumes the list
+	m.state = stateList
+	if result.AttachShell {
+		return func() tea.Msg { return AttachShellSessionMsg{Session: result.Session} }
+	}
+	return func() tea.Msg { return AttachSessionMsg{Session: result.Session} }
 }
 
-func (m *Model) updateCommentingSession(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Delegate to dialog (it handles cancel internally)
-	updated, cmd := m.sessionCommentForm.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.sessionCommentForm = d
+// branchPicked switches a session to the branch picked in the branch form
+func (m *Model) branchPicked(form *SessionBranchForm) tea.Cmd {
+	result := form.Result()
+	if result.Cancelled {
+		return nil
 	}
 
-	// Check if dialog completed
-	if content, ok := m.sessionCommentForm.Content().(*SessionCommentForm); ok && content.Completed {
-		result := content.Result()
-		m.state = stateList
-		m.sessionCommentForm = nil
+	sessionInfo, _ := m.getFreshSessionInfo(result.SessionName)
+	logging.Logger.Info("Switching session branch", "session", result.SessionName, "branch", result.Branch)
+	return StartBranchSwitch(m.sessionService, m.schedulerService, m.gitService, GitStatsRequest{
+		BaseBranch:   sessionInfo.BaseBranch,
+		SessionName:  result.SessionName,
+		WorktreePath: result.WorktreePath,
+	}, result.Branch, result.TellAgent)
+}
 
-		if result.Error != nil {
-			m.errorManager.SetError(fmt.Errorf("failed to update comment: %w", result.Error))
-			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+// viewPicked applies the view picked in the views form, or saves the current one
+func (m *Model) viewPicked(form *ViewForm) tea.Cmd {
+	result := form.Result()
+	switch {
+	case result.Cancelled:
+		return nil
+	case result.Index == saveViewOption:
+		if err := m.sessionList.SaveView(result.SaveName); err != nil {
+			m.errorManager.SetError(err)
+			return m.errorManager.ClearAfterDelay()
 		}
+		return nil
+	}
+	return m.sessionList.ApplyView(result.Index)
+}
 
-		if !result.Cancelled {
-			// Use helper - eliminates duplication
-			refreshCmd, err := m.reloadSessionStateAfterDialog()
-			if err != nil {
-				m.errorManager.SetError(err)
-				return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
-			}
-			return m, tea.Batch(refreshCmd, m.sessionList.Init())
-		}
+// helpClosed runs the action or opens the settings picked in the help screen
+func (m *Model) helpClosed(screen *HelpScreen) tea.Cmd {
+	if screen.Result.Action == nil && !screen.Result.OpenSettings {
+		return nil
+	}
 
-		return m, m.sessionList.Init()
+	// Both resume the list by themselves
+	m.state = stateList
+	var cmd tea.Cmd
+	if screen.Result.Action != nil {
+		_, cmd = m.runAction(*screen.Result.Action)
+	} else {
+		_, cmd = m.handleOpenSettings()
 	}
+	return cmd
+}
 
-	return m, cmd
+// addToActiveWorkspace adds a new session to the listed workspace so it stays visible
+func (m *Model) addToActiveWorkspace(sessionName string) {
+	workspace := m.sessionList.Workspace()
+	if workspace == "" {
+		return
+	}
+	if _, err := m.workspaceService.AddSessions(context.Background(), workspace, []string{sessionName}); err != nil {
+		logging.Logger.Warn("Failed to add new session to workspace", "session", sessionName, "workspace", workspace, "error", err)
+	}
 }
 
-func (m *Model) updateSendingText(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Delegate to dialog (it handles cancel internally)
-	updated, cmd := m.sendTextForm.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.sendTextForm = d
+// applyInlineEdit saves a rename or comment typed directly on a list row
+func (m *Model) applyInlineEdit(msg InlineEditMsg) error {
+	ctx := context.Background()
+	sessionInfo, ok := m.sessionState.Sessions[msg.SessionName]
+	if !ok {
+		return fmt.Errorf("session %s no longer exists", msg.SessionName)
 	}
 
-	// Check if dialog completed
-	if content, ok := m.sendTextForm.Content().(*SendTextForm); ok && content.Completed {
-		result := content.Result()
-		m.state = stateList
-		m.sendTextForm = nil
+	switch msg.Field {
+	case InlineEditRename:
+		if msg.Value == sessionInfo.DisplayName {
+			return nil
+		}
+		if err := validateSessionRename(m.sessionService, msg.SessionName, msg.Value); err != nil {
+			return fmt.Errorf("failed to rename session: %w", err)
+		}
+		newTmuxName := domain.SanitizeSessionName(msg.Value)
+		logging.Logger.Info("Renaming session inline",
+			"old_name", msg.SessionName,
+			"new_tmux_name", newTmuxName,
+			"new_display_name", msg.Value)
+		if err := m.sessionService.RenameSession(ctx, msg.SessionName, newTmuxName, services.RenameOptions{DisplayName: msg.Value}); err != nil {
+			return fmt.Errorf("failed to rename session: %w", err)
+		}
 
-		if result.Error != nil {
-			m.errorManager.SetError(fmt.Errorf("failed to send text: %w", result.Error))
-			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+	case InlineEditComment:
+		if msg.Value == sessionInfo.Comment {
+			return nil
+		}
+		// Empty comment deletes it, as in the dialog
+		if err := m.sessionService.UpdateComment(ctx, msg.SessionName, msg.Value); err != nil {
+			return fmt.Errorf("failed to update session comment: %w", err)
 		}
 
-		return m, m.sessionList.Init()
+	case InlineEditHandoff:
+		// An empty note skips the handoff
+		if msg.Value == "" {
+			return nil
+		}
+		if err := m.sessionService.RecordHandoff(ctx, msg.SessionName, msg.Value); err != nil {
+			return fmt.Errorf("failed to record handoff note: %w", err)
+		}
+		m.detailPane.Refresh()
 	}
 
-	return m, cmd
+	return nil
 }
 
 // reloadSessionStateAfterDialog reloads session state and refreshes the list.
@@ -762,14 +1245,18 @@ func (m *Model) handleKillSession(sessionName string) (tea.Model, tea.Cmd) {
 
 	// Use fresh state to avoid race condition with polling
 	if sessionInfo, ok := m.getFreshSessionInfo(sessionName); ok && sessionInfo.WorktreePath != "" {
-		m.sessionToKill = session
-		removeWorktree := false
-		m.formRemoveWorktree = &removeWorktree
-		m.worktreeRemovalForm = m.createWorktreeRemovalDialog(sessionInfo.WorktreePath)
-		m.state = stateConfirmingWorktreeRemoval
-		return m, m.worktreeRemovalForm.Init()
-	}
-	return m, m.sessionOps.KillSession(session, m.sessionState, m.sessionList)
+		form := newWorktreeRemovalForm(sessionName, sessionInfo.WorktreePath, i18n.T("remove_worktree.kill_desc"), m.getWorktreeStatus(&sessionInfo), &m.keys)
+		return m, openDialog(m, i18n.T("dialog.remove_worktree"), form, func(form *worktreeRemovalForm) tea.Cmd {
+			if form.Cancelled {
+				return nil
+			}
+			logging.Logger.Info("Worktree removal decision", "remove", form.Remove, "session", session.Name)
+			// Kill the session, then remove the worktree if requested; reloading after the kill resumes the list
+			m.state = stateList
+			return m.sessionOps.KillSession(session, confirmedWorktreeRemoval(form.Remove))
+		})
+	}
+	return m, m.sessionOps.KillSession(session, actions.WorktreeRemoval{})
 }
 
 // handleArchiveSession handles the archive session action
@@ -778,26 +1265,45 @@ func (m *Model) handleArchiveSession(sessionName string) (tea.Model, tea.Cmd) {
 
 	// Use fresh state to avoid race condition with polling
 	if sessionInfo, ok := m.getFreshSessionInfo(sessionName); ok && sessionInfo.WorktreePath != "" {
-		m.sessionToArchive = session
-		removeWorktree := false
-		m.formRemoveWorktreeArchive = &removeWorktree
-		form := m.createArchiveWorktreeRemovalForm(sessionInfo.WorktreePath)
-		m.worktreeRemovalForm = NewDialog("Archive Session", form, m.devMode)
-		m.state = stateConfirmingArchive
-		return m, m.worktreeRemovalForm.Init()
-	}
-	return m, m.sessionOps.ArchiveSession(session, false, m.sessionState, m.sessionList)
+		form := newWorktreeRemovalForm(sessionName, sessionInfo.WorktreePath, i18n.T("remove_worktree.archive_desc"), m.getWorktreeStatus(&sessionInfo), &m.keys)
+		return m, openDialog(m, i18n.T("dialog.archive"), form, func(form *worktreeRemovalForm) tea.Cmd {
+			if form.Cancelled {
+				return nil
+			}
+			logging.Logger.Info("Archive worktree removal decision", "remove", form.Remove, "session", session.Name)
+			m.state = stateList
+			return m.sessionOps.ArchiveSession(session, confirmedWorktreeRemoval(form.Remove), m.sessionState, m.sessionList)
+		})
+	}
+	return m, m.sessionOps.ArchiveSession(session, actions.WorktreeRemoval{}, m.sessionState, m.sessionList)
 }
 
-// handleToggleFlag handles the toggle flag action
-func (m *Model) handleToggleFlag(sessionName string) (tea.Model, tea.Cmd) {
-	if err := m.sessionService.ToggleFlag(context.Background(), sessionName); err != nil {
-		m.errorManager.SetError(fmt.Errorf("failed to toggle flag: %w", err))
+// handleTogglePause pauses the agent of a session, or resumes it with "continue" when paused
+func (m *Model) handleTogglePause(sessionName string) (tea.Model, tea.Cmd) {
+	ctx := context.Background()
+	sessionInfo, exists := m.getFreshSessionInfo(sessionName)
+	if !exists {
+		m.errorManager.SetError(fmt.Errorf("session '%s' not found", sessionName))
+		return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
+	}
+
+	var err error
+	if sessionInfo.State == domain.StatePaused {
+		var queued *domain.ScheduledPrompt
+		queued, err = m.pauseService.Resume(ctx, sessionName, services.ResumeAgentOptions{})
+		if err == nil && queued != nil {
+			logging.Logger.Info("Resume prompt queued by the concurrency limit", "session", sessionName, "prompt_id", queued.ID)
+		}
+	} else {
+		err = m.pauseService.Pause(ctx, sessionName)
+	}
+	if err != nil {
+		m.errorManager.SetError(fmt.Errorf("failed to pause or resume session: %w", err))
 		return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 	}
 
 	// Reload session state
-	newSessionState, err := m.sessionService.LoadState(context.Background(), false)
+	newSessionState, err := m.sessionService.LoadState(ctx, false)
 	if err != nil {
 		m.errorManager.SetError(fmt.Errorf("failed to refresh sessions: %w", err))
 		refreshCmd := m.sessionList.RefreshFromState()
@@ -805,212 +1311,262 @@ func (m *Model) handleToggleFlag(sessionName string) (tea.Model, tea.Cmd) {
 	}
 	*m.sessionState = *newSessionState
 
-	// Refresh UI
 	refreshCmd := m.sessionList.RefreshFromState()
 	return m, tea.Batch(refreshCmd, m.sessionList.Init())
 }
 
-// recalculateListHeight calculates and sets the list height based on current state
-func (m *Model) recalculateListHeight() {
-	// Layout breakdown:
-	// - Header (2 lines) + Legend (1 line) + spacing (1) = 4 lines from SessionList fixed content
-	// - Bottom section: separator (1) + tip/error (2) = 3 lines
-	// - With chart: chart height (includes its leading newline)
-	overhead := 7 // header + legend + spacing + bottom section
-	if m.tokenChart.IsVisible() {
-		overhead += m.tokenChart.Height() // chart (includes leading newline)
+// handleKillProcess handles the kill agent process action
+func (m *Model) handleKillProcess(sessionName string) (tea.Model, tea.Cmd) {
+	if err := m.sessionService.KillAgentProcess(context.Background(), sessionName); err != nil {
+		m.errorManager.SetError(fmt.Errorf("failed to kill agent process: %w", err))
+		return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 	}
 
-	listHeight := m.height - overhead
-	if listHeight < 1 {
-		listHeight = 1
-	}
-	m.sessionList.SetSize(m.width, m.height, listHeight)
+	// The agent's exit hook updates the state; refresh to pick it up
+	refreshCmd := m.sessionList.RefreshFromState()
+	return m, tea.Batch(refreshCmd, m.sessionList.Init())
 }
 
-func (m *Model) updateHelp(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Delegate to dialog (it handles cancel internally)
-	updated, cmd := m.helpScreen.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.helpScreen = d
+// handleMoveSessions opens the wizard that moves the selected session's repository to another ROCHA_HOME
+func (m *Model) handleMoveSessions(sessionName string) (tea.Model, tea.Cmd) {
+	var repos []string
+	for _, sess := range m.sessionState.Sessions {
+		if sess.RepoInfo != "" && !slices.Contains(repos, sess.RepoInfo) {
+			repos = append(repos, sess.RepoInfo)
+		}
+	}
+	if len(repos) == 0 {
+		m.errorManager.SetError(fmt.Errorf("no sessions with a repository to move"))
+		return m, m.errorManager.ClearAfterDelay()
 	}
+	slices.Sort(repos)
 
-	// Check if dialog completed
-	if content, ok := m.helpScreen.Content().(*HelpScreen); ok && content.Completed {
-		m.state = stateList
-		m.helpScreen = nil
-		return m, m.sessionList.Init()
+	selectedRepo := m.sessionState.Sessions[sessionName].RepoInfo
+	if selectedRepo == "" {
+		selectedRepo = repos[0]
 	}
 
-	return m, cmd
+	contentForm := NewSessionMoveForm(m.migrationService, repos, selectedRepo, config.GetRochaHome(), config.FindRochaHomes())
+	return m, openDialog(m, i18n.T("dialog.move"), contentForm, m.sessionsMoved)
 }
 
-type detachedMsg struct {
-	SessionName string // Session that was detached from
+// ProfileSwitch returns the settings profile chosen to restart rocha with ("" = none),
+// and whether one was chosen before the TUI quit
+func (m *Model) ProfileSwitch() (string, bool) {
+	if m.profileSwitch == nil {
+		return "", false
+	}
+	return *m.profileSwitch, true
 }
 
-func (m *Model) updateConfirmingArchive(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Handle Escape or Ctrl+C to cancel
-	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		if key.Matches(keyMsg, m.keys.Navigation.ClearFilter.Binding, m.keys.Application.ForceQuit.Binding) {
-			m.state = stateList
-			m.worktreeRemovalForm = nil
-			m.sessionToArchive = nil
-			m.formRemoveWorktreeArchive = nil
-			return m, nil
+// handleOpenSettings opens settings.json in the editor, creating it if missing
+func (m *Model) handleOpenSettings() (tea.Model, tea.Cmd) {
+	if !config.SettingsExist() {
+		if err := config.SaveSettings(&config.Settings{}); err != nil {
+			m.errorManager.SetError(fmt.Errorf("failed to create settings: %w", err))
+			return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 		}
 	}
 
-	// Safety check for nil form
-	if m.worktreeRemovalForm == nil {
-		m.state = stateList
-		m.sessionToArchive = nil
-		return m, nil
+	if err := m.shellService.OpenEditor(config.GetSettingsPath(), m.editor); err != nil {
+		m.errorManager.SetError(fmt.Errorf("failed to open editor: %w", err))
+		return m, tea.Batch(m.sessionList.Init(), m.errorManager.ClearAfterDelay())
 	}
+	return m, m.sessionList.Init()
+}
 
-	// Forward message to Dialog
-	updated, cmd := m.worktreeRemovalForm.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.worktreeRemovalForm = d
+// recordRecentAction remembers a palette action so it is offered first next time
+func (m *Model) recordRecentAction(name string) {
+	m.recentActions = config.PushRecentAction(m.recentActions, name)
+	if err := config.SaveRecentActions(m.recentActions); err != nil {
+		logging.Logger.Warn("Failed to save recent palette actions", "error", err)
 	}
+}
 
-	// Access wrapped huh.Form to check completion
-	if form, ok := m.worktreeRemovalForm.Content().(*huh.Form); ok {
-		// Check if form completed
-		if form.State == huh.StateCompleted {
-			removeWorktree := *m.formRemoveWorktreeArchive // Dereference pointer
-			session := m.sessionToArchive
-
-			logging.Logger.Info("Archive worktree removal decision", "remove", removeWorktree, "session", session.Name)
-
-			// Reset state
-			m.state = stateList
-			m.worktreeRemovalForm = nil
-			m.sessionToArchive = nil
-			m.formRemoveWorktreeArchive = nil
+// syncNotePane points the note pane at the currently selected session
+func (m *Model) syncNotePane() {
+	if item, ok := m.sessionList.list.SelectedItem().(SessionItem); ok {
+		m.notePane.SetNote(item.DisplayName, item.Note)
+		return
+	}
+	m.notePane.SetNote("", "")
+}
 
-			// Archive with worktree removal decision
-			return m, m.sessionOps.ArchiveSession(session, removeWorktree, m.sessionState, m.sessionList)
+// syncDetailPane points the detail pane at the currently selected session
+func (m *Model) syncDetailPane() {
+	if item, ok := m.sessionList.list.SelectedItem().(SessionItem); ok {
+		if session, exists := m.sessionList.sessionState.Sessions[item.Session.Name]; exists {
+			m.detailPane.SetSession(&session)
+			return
 		}
 	}
-
-	return m, cmd
+	m.detailPane.SetSession(nil)
 }
 
-func (m *Model) updateConfirmingWorktreeRemoval(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Handle Escape or Ctrl+C to cancel
-	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		if key.Matches(keyMsg, m.keys.Navigation.ClearFilter.Binding, m.keys.Application.ForceQuit.Binding) {
-			m.state = stateList
-			m.worktreeRemovalForm = nil
-			m.sessionToKill = nil
-			m.formRemoveWorktree = nil
-			return m, nil
-		}
+// recalculateListHeight calculates and sets the list height based on current state
+func (m *Model) recalculateListHeight() {
+	// Layout breakdown:
+	// - Header (2 lines) + Legend (1 line) + spacing (1) = 4 lines from SessionList fixed content
+	// - Bottom section: separator (1) + tip/error (2) = 3 lines
+	// - With chart: chart height (includes its leading newline)
+	// - With note pane: pane height (includes its leading newline)
+	overhead := 7 // header + legend + spacing + bottom section
+	if m.tokenChart.IsVisible() {
+		overhead += m.tokenChart.Height() // chart (includes leading newline)
 	}
+	if m.notePane.IsVisible() {
+		overhead += m.notePane.Height() // pane (includes leading newline)
+	}
+	m.notePane.SetWidth(m.width)
 
-	// Safety check for nil form
-	if m.worktreeRemovalForm == nil {
-		m.state = stateList
-		m.sessionToKill = nil
-		return m, nil
+	listHeight := m.height - overhead
+	if listHeight < 1 {
+		listHeight = 1
 	}
 
-	// Forward message to Dialog
-	updated, cmd := m.worktreeRemovalForm.Update(msg)
-	if d, ok := updated.(*Dialog); ok {
-		m.worktreeRemovalForm = d
+	// The detail pane takes the right side of wide terminals
+	listWidth := m.width
+	if m.detailPane.Shown(m.width) {
+		listWidth -= m.detailPane.Width(m.width)
 	}
+	m.sessionList.SetSize(listWidth, m.height, listHeight)
+}
 
-	// Access wrapped huh.Form to check completion
-	if form, ok := m.worktreeRemovalForm.Content().(*huh.Form); ok {
-		// Check if form completed
-		if form.State == huh.StateCompleted {
-			removeWorktree := *m.formRemoveWorktree // Dereference pointer
-			session := m.sessionToKill
+type detachedMsg struct {
+	SessionName string // Session that was detached from
+}
 
-			logging.Logger.Info("Worktree removal decision", "remove", removeWorktree, "session", session.Name)
+// sessionInfoCopiedMsg reports the end of a copy to the clipboard
+type sessionInfoCopiedMsg struct {
+	err error
+}
 
-			// Get worktree path and repo path from session info
-			sessionInfo := m.sessionState.Sessions[session.Name]
-			worktreePath := sessionInfo.WorktreePath
-			repoPath := sessionInfo.RepoPath
+// getWorktreeStatus checks a session's worktree for work that removing it would lose.
+// Returns nil if the check fails, which the removal forms treat as possible data loss.
+func (m *Model) getWorktreeStatus(session *domain.Session) *domain.WorktreeStatus {
+	return m.actionsService.CheckWorktree(context.Background(), session).Status
+}
 
-			// Remove worktree if requested
-			var worktreeErr bool
-			if removeWorktree {
-				logging.Logger.Info("Removing worktree", "path", worktreePath, "repo", repoPath)
-				if err := m.gitService.RemoveWorktree(repoPath, worktreePath); err != nil {
-					m.errorManager.SetError(fmt.Errorf("failed to remove worktree: %w", err))
-					logging.Logger.Error("Failed to remove worktree", "error", err, "path", worktreePath)
-					worktreeErr = true
-				} else {
-					logging.Logger.Info("Worktree removed successfully", "path", worktreePath)
-				}
-			} else {
-				logging.Logger.Info("Keeping worktree", "path", worktreePath)
-			}
+// confirmedWorktreeRemoval converts the removal forms' answer into the action's decision.
+// The forms make the user type the session name before removing a worktree with local work,
+// so choosing removal also accepts discarding that work.
+func confirmedWorktreeRemoval(remove bool) actions.WorktreeRemoval {
+	return actions.WorktreeRemoval{Discard: remove, Remove: remove}
+}
 
-			// Kill the session
-			killCmd := m.sessionOps.KillSession(session, m.sessionState, m.sessionList)
+// worktreeRemovalForm asks whether to remove the worktree of a session being killed or archived.
+// Esc and ctrl+c cancel it, leaving the session as it is.
+type worktreeRemovalForm struct {
+	Cancelled bool
+	Remove    bool // Answer of the form, set once it completed
+	form      *huh.Form
+	keys      *KeyMap
+}
 
-			// Reset state
-			m.state = stateList
-			m.worktreeRemovalForm = nil
-			m.sessionToKill = nil
-			m.formRemoveWorktree = nil
+// newWorktreeRemovalForm creates the removal form for the worktree of a session, with
+// description explaining what happens to the session
+func newWorktreeRemovalForm(sessionName, worktreePath, description string, status *domain.WorktreeStatus, keys *KeyMap) *worktreeRemovalForm {
+	f := &worktreeRemovalForm{keys: keys}
+	f.form = huh.NewForm(worktreeRemovalGroups(sessionName, worktreePath, description, status, &f.Remove)...)
+	return f
+}
 
-			// If there was a worktree error, add clearErrorAfterDelay to the batch
-			if worktreeErr {
-				return m, tea.Batch(killCmd, m.errorManager.ClearAfterDelay())
-			}
-			return m, killCmd
+func (f *worktreeRemovalForm) Init() tea.Cmd {
+	return f.form.Init()
+}
+
+func (f *worktreeRemovalForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if key.Matches(keyMsg, f.keys.Navigation.ClearFilter.Binding, f.keys.Application.ForceQuit.Binding) {
+			f.Cancelled = true
+			return f, nil
 		}
 	}
 
-	return m, cmd
+	updated, cmd := f.form.Update(msg)
+	if form, ok := updated.(*huh.Form); ok {
+		f.form = form
+	}
+	if f.form.State == huh.StateAborted {
+		f.Cancelled = true
+	}
+	return f, cmd
+}
+
+func (f *worktreeRemovalForm) View() string {
+	return f.form.View()
 }
 
-// createArchiveWorktreeRemovalForm creates a confirmation form for removing a worktree when archiving
-func (m *Model) createArchiveWorktreeRemovalForm(worktreePath string) *huh.Form {
-	return huh.NewForm(
-		huh.NewGroup(
-			huh.NewConfirm().
-				Title(fmt.Sprintf("Remove worktree at %s?", worktreePath)).
-				Description("Archive will hide the session. Remove the worktree too?").
-				Value(m.formRemoveWorktreeArchive).
-				Affirmative("Remove").
-				Negative("Keep"),
-		),
-	)
+// Done reports whether the removal was decided or the form cancelled
+func (f *worktreeRemovalForm) Done() bool {
+	return f.Cancelled || f.form.State == huh.StateCompleted
 }
 
-// createWorktreeRemovalForm creates a confirmation form for removing a worktree
-func (m *Model) createWorktreeRemovalDialog(worktreePath string) *Dialog {
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewConfirm().
-				Title(fmt.Sprintf("Remove worktree at %s?", worktreePath)).
-				Description("This will delete the working tree but preserve commits.").
-				Value(m.formRemoveWorktree). // Already a pointer, don't take address again
-				Affirmative("Remove").
-				Negative("Keep"),
-		),
-	)
-
-	return NewDialog("Remove Worktree", form, m.devMode)
+// worktreeRemovalGroups builds the form groups asking whether to remove a worktree.
+// A worktree with uncommitted changes or unpushed commits (or an unknown status) lists what
+// would be lost and, if removal is chosen, requires typing the session name to confirm.
+func worktreeRemovalGroups(sessionName, worktreePath, description string, status *domain.WorktreeStatus, remove *bool) []*huh.Group {
+	confirm := huh.NewConfirm().
+		Title(i18n.Tf("remove_worktree.title", worktreePath)).
+		Description(description).
+		Value(remove).
+		Affirmative(i18n.T("remove_worktree.remove")).
+		Negative(i18n.T("remove_worktree.keep"))
+
+	if status != nil && !status.HasLocalWork() {
+		return []*huh.Group{huh.NewGroup(confirm)}
+	}
+
+	warning := i18n.T("remove_worktree.unknown")
+	if status != nil {
+		warning = i18n.T("remove_worktree.local_work") + "\n" + status.Describe(worktreeLossLimit)
+	}
+	confirm.Description(description + "\n\n" + warning)
+
+	typed := huh.NewInput().
+		Title(i18n.Tf("remove_worktree.type_name", sessionName)).
+		Description(i18n.T("form.esc_cancel")).
+		Validate(func(s string) error {
+			if strings.TrimSpace(s) != sessionName {
+				return errors.New(i18n.Tf("remove_worktree.type_to_confirm", sessionName))
+			}
+			return nil
+		})
+
+	return []*huh.Group{
+		huh.NewGroup(confirm),
+		huh.NewGroup(typed).WithHideFunc(func() bool { return !*remove }),
+	}
 }
 
 func (m *Model) View() string {
+	if terminalTooSmall(m.width, m.height) {
+		return renderTerminalTooSmall(m.width, m.height)
+	}
+
 	switch m.state {
 	case stateList:
 		view := m.sessionList.View()
 
+		// Detail pane (if visible and the terminal is wide enough) - follows the selected session
+		if m.detailPane.Shown(m.width) {
+			paneWidth := m.detailPane.Width(m.width)
+			pane := m.detailPane.View(paneWidth, lipgloss.Height(view))
+			listWidth := m.width - paneWidth
+			view = lipgloss.NewStyle().MaxWidth(listWidth).Render(view) // Long header lines would push the pane
+			view = lipgloss.JoinHorizontal(lipgloss.Top, lipgloss.PlaceHorizontal(listWidth, lipgloss.Left, view), pane)
+		}
+
 		// Token chart (if visible)
 		if m.tokenChart.IsVisible() {
 			view += "\n" + m.tokenChart.View() + "\n"
 		}
 
+		// Note pane (if visible) - follows the selected session
+		if m.notePane.IsVisible() {
+			view += "\n" + m.notePane.View() + "\n"
+		}
+
 		// Bottom section - fixed 2 lines (error or tip or empty)
 		// Error takes priority over tip (tip is hidden while error displays)
 		view += "\n"
@@ -1037,38 +1593,8 @@ func (m *Model) View() string {
 			palette := m.commandPalette.View()
 			return compositeOverlay(dimmed, palette, m.height)
 		}
-	case stateCommentingSession:
-		if m.sessionCommentForm != nil {
-			return m.sessionCommentForm.View()
-		}
-	case stateConfirmingArchive:
-		if m.worktreeRemovalForm != nil {
-			return m.worktreeRemovalForm.View()
-		}
-	case stateConfirmingWorktreeRemoval:
-		if m.worktreeRemovalForm != nil {
-			return m.worktreeRemovalForm.View()
-		}
-	case stateCreatingSession:
-		if m.sessionForm != nil {
-			return m.sessionForm.View()
-		}
-	case stateHelp:
-		if m.helpScreen != nil {
-			return m.helpScreen.View()
-		}
-	case stateRenamingSession:
-		if m.sessionRenameForm != nil {
-			return m.sessionRenameForm.View()
-		}
-	case stateSendingText:
-		if m.sendTextForm != nil {
-			return m.sendTextForm.View()
-		}
-	case stateSettingStatus:
-		if m.sessionStatusForm != nil {
-			return m.sessionStatusForm.View()
-		}
+	case stateDialog:
+		return m.dialogs.View()
 	}
 	return ""
 }
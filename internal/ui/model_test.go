@@ -0,0 +1,24 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/huh"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/i18n"
+)
+
+func TestWorktreeRemovalGroups_UseCurrentLanguage(t *testing.T) {
+	defer i18n.SetLanguage(i18n.CurrentLanguage())
+	i18n.SetLanguage(i18n.Portuguese)
+
+	var remove bool
+	form := huh.NewForm(worktreeRemovalGroups("api", "/tmp/api", i18n.T("remove_worktree.kill_desc"), nil, &remove)...)
+	form.Init()
+	view := form.View()
+
+	assert.Contains(t, view, "Remover a worktree em /tmp/api?")
+	assert.Contains(t, view, "Isto apaga a worktree mas preserva os commits.")
+	assert.Contains(t, view, "Manter")
+}
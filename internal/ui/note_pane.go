@@ -0,0 +1,124 @@
+package ui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+const (
+	notePaneHeight   = 12 // Fixed height (title + content) so the list layout doesn't jump between sessions
+	notePaneMinWidth = 40 // Minimum word wrap width for the rendered markdown
+)
+
+// NotePane displays the selected session's markdown note below the list
+type NotePane struct {
+	note        string
+	rendered    string
+	sessionName string
+	visible     bool
+	width       int
+}
+
+// NewNotePane creates a new NotePane component
+func NewNotePane() *NotePane {
+	return &NotePane{}
+}
+
+// IsVisible returns whether the pane is visible
+func (np *NotePane) IsVisible() bool {
+	return np.visible
+}
+
+// Toggle toggles the visibility of the pane
+func (np *NotePane) Toggle() {
+	np.visible = !np.visible
+}
+
+// Height returns the total height of the pane (including spacing after)
+func (np *NotePane) Height() int {
+	if !np.visible {
+		return 0
+	}
+	return notePaneHeight + 1 // +1 for blank row after pane
+}
+
+// SetWidth sets the width used to wrap the rendered note
+func (np *NotePane) SetWidth(width int) {
+	if width == np.width {
+		return
+	}
+	np.width = width
+	np.render()
+}
+
+// SetNote sets the session whose note is displayed
+// The markdown is only re-rendered when the note changes
+func (np *NotePane) SetNote(sessionName, note string) {
+	if sessionName == np.sessionName && note == np.note {
+		return
+	}
+	np.sessionName = sessionName
+	np.note = note
+	np.render()
+}
+
+// View renders the note pane
+func (np *NotePane) View() string {
+	if !np.visible {
+		return ""
+	}
+
+	title := theme.NotePaneTitleStyle.Render("Note: " + np.sessionName)
+	body := np.rendered
+	if np.note == "" {
+		body = theme.NotePaneEmptyStyle.Render("No note for this session")
+	}
+
+	lines := strings.Split(body, "\n")
+	maxLines := notePaneHeight - 1 // Reserve one line for the title
+	if len(lines) > maxLines {
+		lines = lines[:maxLines]
+		lines[maxLines-1] = theme.NotePaneEmptyStyle.Render("…")
+	}
+	for len(lines) < maxLines {
+		lines = append(lines, "")
+	}
+
+	return title + "\n" + strings.Join(lines, "\n")
+}
+
+// render converts the note markdown into styled terminal output
+func (np *NotePane) render() {
+	if np.note == "" {
+		np.rendered = ""
+		return
+	}
+
+	wrap := np.width - 4
+	if wrap < notePaneMinWidth {
+		wrap = notePaneMinWidth
+	}
+
+	// Use a fixed style - auto-detection queries the terminal, which conflicts with Bubble Tea
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithStandardStyle("dark"),
+		glamour.WithWordWrap(wrap),
+	)
+	if err != nil {
+		logging.Logger.Warn("Failed to create markdown renderer", "error", err)
+		np.rendered = np.note
+		return
+	}
+
+	rendered, err := renderer.Render(np.note)
+	if err != nil {
+		logging.Logger.Warn("Failed to render note markdown", "error", err)
+		np.rendered = np.note
+		return
+	}
+	np.rendered = strings.Trim(rendered, "\n")
+}
@@ -0,0 +1,125 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// optimisticUpdate is a change to a session shown before the database write that makes it
+// lands, so the list reacts at once even while SQLite is busy
+type optimisticUpdate struct {
+	action string                // What is changed, for the error shown when the write fails
+	apply  func(*domain.Session) // Sets the new value; applying it twice changes nothing
+	id     int
+	revert func(*domain.Session) // Restores the value the change replaced
+	write  func(ctx context.Context) error
+}
+
+// optimisticUpdateDoneMsg reports the outcome of the write behind an optimistic update
+type optimisticUpdateDoneMsg struct {
+	Action      string // What was changed, for the error shown when the write fails
+	Err         error
+	ID          int
+	SessionName string
+}
+
+// applyOptimisticUpdate shows a change to a session right away and writes it in the
+// background. The change is kept over state reloads until the write finishes, and undone
+// if it fails (see finishOptimisticUpdate). The writes of a session run one at a time in
+// the order of the changes, so the last change is the one saved however fast they come.
+func (sl *SessionList) applyOptimisticUpdate(sessionName, action string, update optimisticUpdate, write func(ctx context.Context) error) tea.Cmd {
+	if _, exists := sl.sessionState.Sessions[sessionName]; !exists {
+		return nil
+	}
+
+	sl.nextOptimisticID++
+	update.action = action
+	update.id = sl.nextOptimisticID
+	update.write = write
+	queued := sl.pendingUpdates[sessionName]
+	sl.pendingUpdates[sessionName] = append(queued, update)
+	sl.applyPendingUpdates(sl.sessionState)
+
+	// A write in flight starts this one when it finishes
+	if len(queued) > 0 {
+		return sl.rebuildItems()
+	}
+	return tea.Batch(sl.rebuildItems(), writeOptimisticUpdate(sessionName, update))
+}
+
+// writeOptimisticUpdate returns a command that runs the write of an update
+func writeOptimisticUpdate(sessionName string, update optimisticUpdate) tea.Cmd {
+	return func() tea.Msg {
+		return optimisticUpdateDoneMsg{
+			Action:      update.action,
+			Err:         update.write(context.Background()),
+			ID:          update.id,
+			SessionName: sessionName,
+		}
+	}
+}
+
+// finishOptimisticUpdate forgets an update whose write finished, undoing it when the write
+// failed, and starts the write of the next change of the session. Returns the error to show, if any.
+func (sl *SessionList) finishOptimisticUpdate(msg optimisticUpdateDoneMsg) (tea.Cmd, error) {
+	pending := sl.pendingUpdates[msg.SessionName]
+	var finished *optimisticUpdate
+	for i := range pending {
+		if pending[i].id == msg.ID {
+			finished = &pending[i]
+			pending = append(pending[:i:i], pending[i+1:]...)
+			break
+		}
+	}
+	var next tea.Cmd
+	if len(pending) == 0 {
+		delete(sl.pendingUpdates, msg.SessionName)
+	} else {
+		sl.pendingUpdates[msg.SessionName] = pending
+		next = writeOptimisticUpdate(msg.SessionName, pending[0])
+	}
+
+	if msg.Err == nil || finished == nil {
+		return next, nil
+	}
+
+	logging.Logger.Error("Failed to save session change, undoing it", "session", msg.SessionName, "action", msg.Action, "error", msg.Err)
+	if session, exists := sl.sessionState.Sessions[msg.SessionName]; exists {
+		finished.revert(&session)
+		sl.sessionState.Sessions[msg.SessionName] = session
+	}
+	// Later changes of the same session build on the undone one; keep them on top
+	sl.applyPendingUpdates(sl.sessionState)
+	return tea.Batch(sl.rebuildItems(), next), fmt.Errorf("failed to %s of session '%s': %w", msg.Action, msg.SessionName, msg.Err)
+}
+
+// applyPendingUpdates applies the changes whose writes have not finished to a state,
+// such as one just loaded from the database
+func (sl *SessionList) applyPendingUpdates(state *domain.SessionCollection) {
+	for name, updates := range sl.pendingUpdates {
+		session, exists := state.Sessions[name]
+		if !exists {
+			continue
+		}
+		for _, update := range updates {
+			update.apply(&session)
+		}
+		state.Sessions[name] = session
+	}
+}
+
+// toggleSessionFlag flags or unflags a session
+func (sl *SessionList) toggleSessionFlag(sessionName string) tea.Cmd {
+	flagged := !sl.sessionState.Sessions[sessionName].IsFlagged
+	return sl.applyOptimisticUpdate(sessionName, "toggle flag", optimisticUpdate{
+		apply:  func(s *domain.Session) { s.IsFlagged = flagged },
+		revert: func(s *domain.Session) { s.IsFlagged = !flagged },
+	}, func(ctx context.Context) error {
+		return sl.sessionService.SetFlag(ctx, sessionName, flagged)
+	})
+}
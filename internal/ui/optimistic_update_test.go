@@ -0,0 +1,165 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/ports"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// newOptimisticTestList returns a session list holding one unflagged session, api
+func newOptimisticTestList() *SessionList {
+	return &SessionList{
+		inlineEdit:     NewInlineEdit(),
+		list:           list.New(nil, list.NewDefaultDelegate(), 80, 10),
+		pendingUpdates: make(map[string][]optimisticUpdate),
+		quickJump:      NewQuickJump(),
+		sessionState: &domain.SessionCollection{
+			OrderedNames: []string{"api"},
+			Sessions:     map[string]domain.Session{"api": {Name: "api"}},
+		},
+		sortIndex:       -1,
+		statusConfig:    config.NewStatusConfig("", "", ""),
+		timestampConfig: &config.TimestampColorConfig{},
+		tmuxCache:       newTmuxSessionCache(func() ([]*ports.TmuxSession, error) { return nil, nil }, time.Minute),
+	}
+}
+
+// flagUpdate flags a session, undone by unflagging it
+var flagUpdate = optimisticUpdate{
+	apply:  func(s *domain.Session) { s.IsFlagged = true },
+	revert: func(s *domain.Session) { s.IsFlagged = false },
+}
+
+func TestSessionList_OptimisticUpdate(t *testing.T) {
+	t.Run("shows the change before the write and keeps it over reloads", func(t *testing.T) {
+		sl := newOptimisticTestList()
+		written := false
+
+		cmd := sl.applyOptimisticUpdate("api", "toggle flag", flagUpdate, func(context.Context) error {
+			written = true
+			return nil
+		})
+
+		require.NotNil(t, cmd)
+		assert.False(t, written, "the write runs in the returned command")
+		assert.True(t, sl.sessionState.Sessions["api"].IsFlagged)
+
+		// A reload before the write lands still shows the change
+		reloaded := &domain.SessionCollection{Sessions: map[string]domain.Session{"api": {Name: "api"}}}
+		sl.applyPendingUpdates(reloaded)
+		assert.True(t, reloaded.Sessions["api"].IsFlagged)
+
+		refresh, err := sl.finishOptimisticUpdate(optimisticUpdateDoneMsg{Action: "toggle flag", ID: 1, SessionName: "api"})
+		assert.Nil(t, refresh)
+		assert.NoError(t, err)
+		assert.Empty(t, sl.pendingUpdates)
+	})
+
+	t.Run("undoes the change when the write fails", func(t *testing.T) {
+		sl := newOptimisticTestList()
+		sl.applyOptimisticUpdate("api", "toggle flag", flagUpdate, nil)
+
+		writeErr := errors.New("database is locked")
+		_, err := sl.finishOptimisticUpdate(optimisticUpdateDoneMsg{Action: "toggle flag", Err: writeErr, ID: 1, SessionName: "api"})
+
+		require.ErrorIs(t, err, writeErr)
+		assert.Contains(t, err.Error(), "failed to toggle flag of session 'api'")
+		assert.False(t, sl.sessionState.Sessions["api"].IsFlagged)
+		assert.Empty(t, sl.pendingUpdates)
+	})
+
+	t.Run("writes the changes of a session one at a time, in order", func(t *testing.T) {
+		sl := newOptimisticTestList()
+		var written []string
+		write := func(value string) func(context.Context) error {
+			return func(context.Context) error {
+				written = append(written, value)
+				return nil
+			}
+		}
+
+		first := sl.applyOptimisticUpdate("api", "cycle status", flagUpdate, write("first"))
+		second := sl.applyOptimisticUpdate("api", "cycle status", flagUpdate, write("second"))
+
+		assert.Empty(t, doneMsgs(second), "the second write waits for the first")
+		done := doneMsgs(first)
+		require.Len(t, done, 1)
+		assert.Equal(t, []string{"first"}, written)
+
+		next, err := sl.finishOptimisticUpdate(done[0])
+		require.NoError(t, err)
+		done = doneMsgs(next)
+		require.Len(t, done, 1, "finishing the first write starts the second")
+		assert.Equal(t, []string{"first", "second"}, written)
+
+		next, err = sl.finishOptimisticUpdate(done[0])
+		require.NoError(t, err)
+		assert.Empty(t, doneMsgs(next))
+		assert.Empty(t, sl.pendingUpdates)
+	})
+
+	t.Run("flag toggles write the value shown, even after a failed write", func(t *testing.T) {
+		sessionRepo := portsmocks.NewMockSessionRepository(t)
+		writeErr := errors.New("database is locked")
+		first := sessionRepo.EXPECT().SetFlag(mock.Anything, "api", true).Return(writeErr).Once()
+		sessionRepo.EXPECT().SetFlag(mock.Anything, "api", false).Return(nil).Once().NotBefore(first)
+
+		sl := newOptimisticTestList()
+		sl.sessionService = services.NewSessionService(sessionRepo, nil, nil, nil, nil, nil, nil)
+
+		firstCmd := sl.toggleSessionFlag("api")
+		secondCmd := sl.toggleSessionFlag("api")
+		assert.False(t, sl.sessionState.Sessions["api"].IsFlagged, "flagged, then unflagged")
+		assert.Empty(t, doneMsgs(secondCmd))
+
+		done := doneMsgs(firstCmd)
+		require.Len(t, done, 1)
+		next, err := sl.finishOptimisticUpdate(done[0])
+		require.ErrorIs(t, err, writeErr)
+		done = doneMsgs(next)
+		require.Len(t, done, 1)
+		_, err = sl.finishOptimisticUpdate(done[0])
+		require.NoError(t, err)
+
+		assert.False(t, sl.sessionState.Sessions["api"].IsFlagged, "the list shows what was stored")
+		assert.Empty(t, sl.pendingUpdates)
+	})
+
+	t.Run("ignores unknown sessions", func(t *testing.T) {
+		sl := newOptimisticTestList()
+
+		assert.Nil(t, sl.applyOptimisticUpdate("gone", "toggle flag", flagUpdate, nil))
+		assert.Empty(t, sl.pendingUpdates)
+	})
+}
+
+// doneMsgs runs cmd, and the commands it batches, and returns the write outcomes they report
+func doneMsgs(cmd tea.Cmd) []optimisticUpdateDoneMsg {
+	if cmd == nil {
+		return nil
+	}
+	switch msg := cmd().(type) {
+	case optimisticUpdateDoneMsg:
+		return []optimisticUpdateDoneMsg{msg}
+	case tea.BatchMsg:
+		var done []optimisticUpdateDoneMsg
+		for _, c := range msg {
+			done = append(done, doneMsgs(c)...)
+		}
+		return done
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// orphanShellsFoundMsg carries the orphan shells found for the cleanup dialog
+type orphanShellsFoundMsg struct {
+	Err     error
+	Orphans []domain.OrphanShell
+}
+
+// orphanShellsCleanupDoneMsg is sent when a cleanup confirmed in the dialog finished
+type orphanShellsCleanupDoneMsg struct {
+	Cleaned []domain.OrphanShell
+	Err     error
+}
+
+// findOrphanShells returns a command that looks for orphan shells to offer for cleanup
+func findOrphanShells(shellService *services.ShellService) tea.Cmd {
+	return func() tea.Msg {
+		orphans, err := shellService.FindOrphanShells(context.Background())
+		return orphanShellsFoundMsg{Err: err, Orphans: orphans}
+	}
+}
+
+// cleanOrphanShells returns a command that cleans up the orphan shells
+func cleanOrphanShells(shellService *services.ShellService) tea.Cmd {
+	return func() tea.Msg {
+		cleaned, err := shellService.CleanOrphanShells(context.Background())
+		for _, orphan := range cleaned {
+			logging.Logger.Info("Cleaned up orphan shell session", "name", orphan.Name, "parent", orphan.ParentName, "killed", orphan.Running)
+		}
+		return orphanShellsCleanupDoneMsg{Cleaned: cleaned, Err: err}
+	}
+}
+
+// OrphanShellsForm asks to confirm the cleanup of the orphan shells it lists
+type OrphanShellsForm struct {
+	Cancelled bool
+	Clean     bool // Answer of the form, set once it completed
+	form      *huh.Form
+}
+
+// NewOrphanShellsForm creates the confirmation of the cleanup of orphans
+// With no orphans it only says so.
+func NewOrphanShellsForm(orphans []domain.OrphanShell) *OrphanShellsForm {
+	f := &OrphanShellsForm{}
+	if len(orphans) == 0 {
+		f.form = huh.NewForm(huh.NewGroup(
+			huh.NewNote().
+				Title(i18n.T("orphan_shells.none")).
+				Next(true).
+				NextLabel(i18n.T("form.ok")),
+		))
+		return f
+	}
+
+	lines := make([]string, 0, len(orphans))
+	for _, orphan := range orphans {
+		if orphan.Running {
+			lines = append(lines, fmt.Sprintf(i18n.T("orphan_shells.running"), orphan.Name, orphan.ParentName))
+		} else {
+			lines = append(lines, fmt.Sprintf(i18n.T("orphan_shells.gone"), orphan.Name, orphan.ParentName))
+		}
+	}
+	f.form = huh.NewForm(huh.NewGroup(
+		huh.NewConfirm().
+			Title(fmt.Sprintf(i18n.T("orphan_shells.confirm"), len(orphans))).
+			Description(strings.Join(lines, "\n") + "\n\n" + i18n.T("orphan_shells.in_use")).
+			Affirmative(i18n.T("orphan_shells.clean")).
+			Negative(i18n.T("form.cancel")).
+			Value(&f.Clean),
+	))
+	return f
+}
+
+func (f *OrphanShellsForm) Init() tea.Cmd {
+	return f.form.Init()
+}
+
+func (f *OrphanShellsForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			f.Cancelled = true
+			return f, nil
+		}
+	}
+
+	updated, cmd := f.form.Update(msg)
+	if form, ok := updated.(*huh.Form); ok {
+		f.form = form
+	}
+	if f.form.State == huh.StateAborted {
+		f.Cancelled = true
+	}
+	return f, cmd
+}
+
+func (f *OrphanShellsForm) View() string {
+	return f.form.View()
+}
+
+// Done reports whether the cleanup was decided or the form cancelled
+func (f *OrphanShellsForm) Done() bool {
+	return f.Cancelled || f.form.State == huh.StateCompleted
+}
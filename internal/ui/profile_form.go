@@ -0,0 +1,115 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/i18n"
+)
+
+// noProfileOption is the select value that runs with the top-level settings only
+const noProfileOption = ""
+
+// ProfileFormResult contains the settings profile chosen in the switcher
+type ProfileFormResult struct {
+	Cancelled bool
+	Error     error
+	Profile   string // "" runs without a profile
+}
+
+// ProfileForm is a Bubble Tea component for choosing the settings profile rocha runs with
+type ProfileForm struct {
+	Completed bool
+	form      *huh.Form
+	result    ProfileFormResult
+}
+
+// NewProfileForm creates a new profile switcher with the active profile selected
+func NewProfileForm(activeProfile string) *ProfileForm {
+	pf := &ProfileForm{
+		result: ProfileFormResult{Profile: activeProfile},
+	}
+
+	profiles, err := config.ListProfiles()
+	if err != nil {
+		pf.Completed = true
+		pf.result.Error = err
+		return pf
+	}
+
+	options := make([]huh.Option[string], 0, len(profiles)+1)
+	options = append(options, huh.NewOption(i18n.T("profile.none"), noProfileOption))
+	for _, profile := range profiles {
+		options = append(options, huh.NewOption(profile, profile))
+	}
+
+	description := i18n.T("profile.desc")
+	if len(profiles) == 0 {
+		description = i18n.T("profile.empty")
+	}
+
+	pf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.T("profile.title")).
+				Description(description).
+				Options(options...).
+				Value(&pf.result.Profile),
+		),
+	)
+
+	return pf
+}
+
+func (pf *ProfileForm) Init() tea.Cmd {
+	if pf.form == nil {
+		return nil
+	}
+	return pf.form.Init()
+}
+
+func (pf *ProfileForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if pf.form == nil {
+		return pf, nil
+	}
+
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			pf.result.Cancelled = true
+			pf.Completed = true
+			return pf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := pf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		pf.form = f
+	}
+
+	if pf.form.State == huh.StateCompleted {
+		pf.Completed = true
+		return pf, nil
+	}
+
+	return pf, cmd
+}
+
+// Done reports whether a profile was picked or the form cancelled
+func (pf *ProfileForm) Done() bool {
+	return pf.Completed
+}
+
+func (pf *ProfileForm) View() string {
+	if pf.form != nil {
+		return pf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (pf *ProfileForm) Result() ProfileFormResult {
+	return pf.result
+}
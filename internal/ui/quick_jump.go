@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"strings"
+)
+
+// quickJumpAlphabet holds the hint characters, home row first so most hints stay under the fingers
+const quickJumpAlphabet = "asdfghjkl"
+
+// QuickJump labels every visible session row with a two-character hint, as in vimium:
+// after the quick jump key, typing a row's hint attaches to it.
+// It is shared by the list and its delegate so rendering follows the typed characters.
+type QuickJump struct {
+	active bool
+	rows   int    // Visible rows; rows past the last hint get none
+	typed  string // First hint character, once typed
+}
+
+// NewQuickJump creates an inactive quick jump
+func NewQuickJump() *QuickJump {
+	return &QuickJump{}
+}
+
+// Start shows hints on the first rows of the list
+func (qj *QuickJump) Start(rows int) {
+	qj.active = true
+	qj.rows = min(rows, len(quickJumpAlphabet)*len(quickJumpAlphabet))
+	qj.typed = ""
+}
+
+// Stop hides the hints
+func (qj *QuickJump) Stop() {
+	qj.active = false
+	qj.typed = ""
+}
+
+// Active reports whether hints are shown and waiting for a character
+func (qj *QuickJump) Active() bool {
+	return qj.active
+}
+
+// Hint returns the hint of a row, or "" when the row has none or no longer matches what was typed
+func (qj *QuickJump) Hint(index int) string {
+	if !qj.active || index < 0 || index >= qj.rows {
+		return ""
+	}
+	n := len(quickJumpAlphabet)
+	hint := string(quickJumpAlphabet[index/n]) + string(quickJumpAlphabet[index%n])
+	if !strings.HasPrefix(hint, qj.typed) {
+		return ""
+	}
+	return hint
+}
+
+// Type takes the next hint character and returns the chosen row once both are typed.
+// It returns -1 while waiting for the second character or when no hint matches, which ends the jump.
+func (qj *QuickJump) Type(r rune) int {
+	pos := strings.IndexRune(quickJumpAlphabet, r)
+	if pos < 0 {
+		qj.Stop()
+		return -1
+	}
+
+	n := len(quickJumpAlphabet)
+	if qj.typed == "" {
+		if pos*n >= qj.rows {
+			qj.Stop()
+		} else {
+			qj.typed = string(r)
+		}
+		return -1
+	}
+
+	index := strings.IndexByte(quickJumpAlphabet, qj.typed[0])*n + pos
+	qj.Stop()
+	if index >= qj.rows {
+		return -1
+	}
+	return index
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuickJump_Hint(t *testing.T) {
+	qj := NewQuickJump()
+	assert.Empty(t, qj.Hint(0), "no hints before starting")
+
+	qj.Start(12)
+
+	assert.Equal(t, "aa", qj.Hint(0))
+	assert.Equal(t, "al", qj.Hint(8))
+	assert.Equal(t, "sa", qj.Hint(9))
+	assert.Equal(t, "sd", qj.Hint(11))
+	assert.Empty(t, qj.Hint(12), "rows past the last one get no hint")
+
+	qj.Type('s')
+	assert.Empty(t, qj.Hint(0), "hints not starting with the typed character are hidden")
+	assert.Equal(t, "sa", qj.Hint(9))
+}
+
+func TestQuickJump_HintsAreCappedAtTwoCharacters(t *testing.T) {
+	qj := NewQuickJump()
+	qj.Start(100)
+
+	assert.Equal(t, "ll", qj.Hint(80))
+	assert.Empty(t, qj.Hint(81))
+}
+
+func TestQuickJump_Type(t *testing.T) {
+	tests := []struct {
+		name       string
+		rows       int
+		keys       string
+		wantIndex  int
+		wantActive bool
+	}{
+		{name: "first character waits", rows: 12, keys: "s", wantIndex: -1, wantActive: true},
+		{name: "full hint picks the row", rows: 12, keys: "sd", wantIndex: 11},
+		{name: "first row", rows: 12, keys: "aa", wantIndex: 0},
+		{name: "not a hint character cancels", rows: 12, keys: "x", wantIndex: -1},
+		{name: "first character without rows cancels", rows: 12, keys: "d", wantIndex: -1},
+		{name: "hint past the last row cancels", rows: 12, keys: "sf", wantIndex: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qj := NewQuickJump()
+			qj.Start(tt.rows)
+
+			index := -1
+			for _, r := range tt.keys {
+				index = qj.Type(r)
+			}
+
+			assert.Equal(t, tt.wantIndex, index)
+			assert.Equal(t, tt.wantActive, qj.Active())
+		})
+	}
+}
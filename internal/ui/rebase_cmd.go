@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// RebaseReadyMsg is sent when a rebase finished (successfully or stopped on conflicts)
+type RebaseReadyMsg struct {
+	Result       *domain.RebaseResult
+	SessionName  string
+	WorktreePath string
+}
+
+// RebaseErrorMsg is sent when a rebase could not be performed
+type RebaseErrorMsg struct {
+	Err         error
+	SessionName string
+}
+
+// StartRebase starts an async worker that fetches and rebases a session worktree
+// An empty baseBranch rebases onto the remote's default branch
+// Returns a tea.Cmd that will send RebaseReadyMsg or RebaseErrorMsg
+func StartRebase(gitService *services.GitService, sessionName, worktreePath, baseBranch string) tea.Cmd {
+	return func() tea.Msg {
+		// Fetch can be slow on large repos - allow more time than stats
+		ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+		defer cancel()
+
+		result, err := gitService.RebaseOntoBase(ctx, worktreePath, baseBranch)
+		if err != nil {
+			logging.Logger.Warn("Failed to rebase session",
+				"session", sessionName,
+				"error", err)
+			return RebaseErrorMsg{
+				Err:         err,
+				SessionName: sessionName,
+			}
+		}
+
+		return RebaseReadyMsg{
+			Result:       result,
+			SessionName:  sessionName,
+			WorktreePath: worktreePath,
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// Conflict resolution options
+const (
+	rebaseActionAbort      = "abort"
+	rebaseActionAskClaude  = "ask_claude"
+	rebaseActionOpenEditor = "open_editor"
+)
+
+// maxConflictsShown limits how many conflicted files are listed in the dialog
+const maxConflictsShown = 10
+
+// RebaseConflictFormResult contains the result of the conflict resolution choice
+type RebaseConflictFormResult struct {
+	Action      string
+	Cancelled   bool
+	Error       error
+	SessionName string
+}
+
+// RebaseConflictForm is a Bubble Tea component for handling rebase conflicts
+type RebaseConflictForm struct {
+	Completed    bool
+	editor       string
+	form         *huh.Form
+	gitService   *services.GitService
+	rebase       *domain.RebaseResult
+	result       RebaseConflictFormResult
+	shellService *services.ShellService
+	worktreePath string
+}
+
+// NewRebaseConflictForm creates a new rebase conflict form
+func NewRebaseConflictForm(gitService *services.GitService, shellService *services.ShellService, editor, sessionName, worktreePath string, rebase *domain.RebaseResult) *RebaseConflictForm {
+	rf := &RebaseConflictForm{
+		editor:       editor,
+		gitService:   gitService,
+		rebase:       rebase,
+		shellService: shellService,
+		worktreePath: worktreePath,
+		result: RebaseConflictFormResult{
+			Action:      rebaseActionAskClaude,
+			SessionName: sessionName,
+		},
+	}
+
+	rf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.Tf("rebase_conflicts.title", rebase.BaseBranch)).
+				Description(formatConflictList(rebase.Conflicts)).
+				Options(
+					huh.NewOption(i18n.T("rebase_conflicts.ask_claude"), rebaseActionAskClaude),
+					huh.NewOption(i18n.T("rebase_conflicts.open_editor"), rebaseActionOpenEditor),
+					huh.NewOption(i18n.T("rebase_conflicts.abort"), rebaseActionAbort),
+				).
+				Value(&rf.result.Action),
+		),
+	)
+
+	return rf
+}
+
+func (rf *RebaseConflictForm) Init() tea.Cmd {
+	return rf.form.Init()
+}
+
+func (rf *RebaseConflictForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel (rebase stays in progress)
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			rf.result.Cancelled = true
+			rf.Completed = true
+			return rf, nil
+		}
+	}
+
+	form, cmd := rf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		rf.form = f
+	}
+
+	if rf.form.State == huh.StateCompleted {
+		rf.Completed = true
+		if err := rf.applyAction(); err != nil {
+			logging.Logger.Error("Failed to handle rebase conflicts", "error", err, "action", rf.result.Action)
+			rf.result.Error = err
+		}
+		return rf, nil
+	}
+
+	return rf, cmd
+}
+
+// Done reports whether the conflicts were handled or the form closed
+func (rf *RebaseConflictForm) Done() bool {
+	return rf.Completed
+}
+
+func (rf *RebaseConflictForm) View() string {
+	if rf.form != nil {
+		return rf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (rf *RebaseConflictForm) Result() RebaseConflictFormResult {
+	return rf.result
+}
+
+// applyAction executes the selected conflict resolution action
+func (rf *RebaseConflictForm) applyAction() error {
+	logging.Logger.Info("Handling rebase conflicts", "session", rf.result.SessionName, "action", rf.result.Action)
+
+	switch rf.result.Action {
+	case rebaseActionAbort:
+		return rf.gitService.AbortRebase(context.Background(), rf.worktreePath)
+	case rebaseActionOpenEditor:
+		return rf.shellService.OpenEditor(rf.worktreePath, rf.editor)
+	case rebaseActionAskClaude:
+		if err := rf.shellService.SendKeys(rf.result.SessionName, buildResolveConflictsPrompt(rf.rebase)); err != nil {
+			return fmt.Errorf("failed to send prompt to tmux: %w", err)
+		}
+		if err := rf.shellService.SendKeys(rf.result.SessionName, "C-m"); err != nil {
+			return fmt.Errorf("failed to send enter key to tmux: %w", err)
+		}
+	}
+	return nil
+}
+
+// buildResolveConflictsPrompt builds the prompt asking Claude to finish the rebase
+func buildResolveConflictsPrompt(rebase *domain.RebaseResult) string {
+	return fmt.Sprintf(
+		"A rebase onto %s is in progress and stopped with conflicts in: %s. "+
+			"Resolve the conflicts keeping the intent of both sides, stage the files and run `git rebase --continue` until the rebase completes.",
+		rebase.BaseBranch, strings.Join(rebase.Conflicts, ", "))
+}
+
+// formatConflictList renders the conflicted files for the dialog description
+func formatConflictList(conflicts []string) string {
+	shown := conflicts
+	if len(shown) > maxConflictsShown {
+		shown = shown[:maxConflictsShown]
+	}
+	text := i18n.T("rebase_conflicts.files") + "\n  " + strings.Join(shown, "\n  ")
+	if len(conflicts) > maxConflictsShown {
+		text += "\n  " + i18n.Tf("rebase_conflicts.more", len(conflicts)-maxConflictsShown)
+	}
+	return text
+}
@@ -0,0 +1,209 @@
+package ui
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// recordFrameInterval limits how often frames rendered after background messages
+// (ticks, polls) are recorded; frames after input are always recorded
+const recordFrameInterval = time.Second
+
+// Recording entry kinds
+const (
+	RecordingInit  = "init"  // Frame rendered before the first message
+	RecordingKey   = "key"   // tea.KeyMsg
+	RecordingMouse = "mouse" // tea.MouseMsg
+	RecordingMsg   = "msg"   // Any other message, recorded by type only
+	RecordingSize  = "size"  // tea.WindowSizeMsg
+)
+
+// RecordingEntry is one message a recorded TUI received, with the frame it rendered.
+// Input messages are kept whole so they can be replayed; other messages only by type.
+type RecordingEntry struct {
+	At    time.Duration      `json:"at"`              // Time since the recording started
+	Frame string             `json:"frame,omitempty"` // View after the message (always set for input)
+	Key   *tea.Key           `json:"key,omitempty"`
+	Kind  string             `json:"kind"`
+	Mouse *tea.MouseEvent    `json:"mouse,omitempty"`
+	Size  *tea.WindowSizeMsg `json:"size,omitempty"`
+	Type  string             `json:"type,omitempty"` // Go type of the message, such as ui.checkStateMsg
+}
+
+// IsInput returns true if the entry holds a message that can be replayed
+func (e RecordingEntry) IsInput() bool {
+	return e.Kind == RecordingKey || e.Kind == RecordingMouse || e.Kind == RecordingSize
+}
+
+// Msg returns the recorded input message, or nil for entries that are not input
+func (e RecordingEntry) Msg() tea.Msg {
+	switch {
+	case e.Kind == RecordingKey && e.Key != nil:
+		return tea.KeyMsg(*e.Key)
+	case e.Kind == RecordingMouse && e.Mouse != nil:
+		return tea.MouseMsg(*e.Mouse)
+	case e.Kind == RecordingSize && e.Size != nil:
+		return *e.Size
+	default:
+		return nil
+	}
+}
+
+// Describe returns a short description of the entry, such as "key ctrl+f" or "size 120x40"
+func (e RecordingEntry) Describe() string {
+	switch msg := e.Msg().(type) {
+	case tea.KeyMsg:
+		return "key " + msg.String()
+	case tea.MouseMsg:
+		return fmt.Sprintf("mouse %s at %d,%d", msg.String(), msg.X, msg.Y)
+	case tea.WindowSizeMsg:
+		return fmt.Sprintf("size %dx%d", msg.Width, msg.Height)
+	}
+	if e.Type != "" {
+		return e.Kind + " " + e.Type
+	}
+	return e.Kind
+}
+
+// Recorder wraps the TUI model and writes every message it receives, and the frames it
+// renders, to a JSON lines file that rocha replay plays back and tests replay against
+type Recorder struct {
+	enc         *json.Encoder
+	err         error // First write error; recording stops after it
+	lastFrame   string
+	lastFrameAt time.Duration
+	model       tea.Model
+	started     time.Time
+}
+
+// NewRecorder creates a Recorder writing the recording of model to w
+func NewRecorder(model tea.Model, w io.Writer) *Recorder {
+	return &Recorder{
+		enc:     json.NewEncoder(w),
+		model:   model,
+		started: time.Now(),
+	}
+}
+
+// Err returns the error that stopped the recording, if any
+func (r *Recorder) Err() error {
+	return r.err
+}
+
+func (r *Recorder) Init() tea.Cmd {
+	cmd := r.model.Init()
+	r.record(RecordingEntry{Kind: RecordingInit}, true)
+	return cmd
+}
+
+func (r *Recorder) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	model, cmd := r.model.Update(msg)
+	r.model = model
+
+	entry := recordingEntryFor(msg)
+	r.record(entry, entry.IsInput())
+	return r, cmd
+}
+
+func (r *Recorder) View() string {
+	return r.model.View()
+}
+
+// record writes an entry with the current frame when forced, or when the frame changed
+// and no frame was recorded within recordFrameInterval
+func (r *Recorder) record(entry RecordingEntry, forceFrame bool) {
+	if r.err != nil {
+		return
+	}
+
+	entry.At = time.Since(r.started)
+	frame := r.model.View()
+	if forceFrame || (frame != r.lastFrame && entry.At-r.lastFrameAt >= recordFrameInterval) {
+		entry.Frame = frame
+		r.lastFrame = frame
+		r.lastFrameAt = entry.At
+	}
+
+	if err := r.enc.Encode(entry); err != nil {
+		r.err = fmt.Errorf("failed to write recording: %w", err)
+	}
+}
+
+// recordingEntryFor builds the entry of a message, keeping input messages whole
+func recordingEntryFor(msg tea.Msg) RecordingEntry {
+	entry := RecordingEntry{Type: fmt.Sprintf("%T", msg)}
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		key := tea.Key(msg)
+		entry.Key = &key
+		entry.Kind = RecordingKey
+	case tea.MouseMsg:
+		mouse := tea.MouseEvent(msg)
+		entry.Kind = RecordingMouse
+		entry.Mouse = &mouse
+	case tea.WindowSizeMsg:
+		entry.Kind = RecordingSize
+		entry.Size = &msg
+	default:
+		entry.Kind = RecordingMsg
+	}
+	return entry
+}
+
+// ReadRecording reads the entries of a recording written by a Recorder
+func ReadRecording(r io.Reader) ([]RecordingEntry, error) {
+	var entries []RecordingEntry
+	dec := json.NewDecoder(r)
+	for {
+		var entry RecordingEntry
+		err := dec.Decode(&entry)
+		if errors.Is(err, io.EOF) {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid recording (entry %d): %w", len(entries)+1, err)
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// ReplayInputs initializes model and feeds it the key, mouse, and window size messages of a
+// recording in order, without running any command, and returns the frame rendered after each one
+func ReplayInputs(model tea.Model, entries []RecordingEntry) []string {
+	model.Init()
+
+	var frames []string
+	for _, entry := range entries {
+		if !entry.IsInput() {
+			continue
+		}
+		model, _ = model.Update(entry.Msg())
+		frames = append(frames, model.View())
+	}
+	return frames
+}
+
+// VerifyReplay replays the input of a recording on model and returns an error naming
+// the first input whose frame differs from the recorded one. Golden-frame tests record
+// a component once and check that it still renders the same frames.
+func VerifyReplay(model tea.Model, entries []RecordingEntry) error {
+	var inputs []RecordingEntry
+	for _, entry := range entries {
+		if entry.IsInput() {
+			inputs = append(inputs, entry)
+		}
+	}
+
+	frames := ReplayInputs(model, inputs)
+	for i, entry := range inputs {
+		if frames[i] != entry.Frame {
+			return fmt.Errorf("frame after input %d (%s) differs:\n--- recorded\n%s\n--- replayed\n%s", i+1, entry.Describe(), entry.Frame, frames[i])
+		}
+	}
+	return nil
+}
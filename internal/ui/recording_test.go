@@ -0,0 +1,98 @@
+package ui
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden recordings in testdata")
+
+// tickMsg stands for the background messages a recording keeps by type only
+type tickMsg struct{}
+
+// typedText is a model that shows the runes typed so far
+type typedText struct {
+	text string
+}
+
+func (m *typedText) Init() tea.Cmd { return nil }
+
+func (m *typedText) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.Type == tea.KeyRunes {
+		m.text += string(key.Runes)
+	}
+	return m, nil
+}
+
+func (m *typedText) View() string { return "> " + m.text }
+
+func TestRecorder(t *testing.T) {
+	var out bytes.Buffer
+	recorder := NewRecorder(&typedText{}, &out)
+
+	recorder.Init()
+	recorder.Update(tea.WindowSizeMsg{Width: 80, Height: 24})
+	recorder.Update(tickMsg{})
+	recorder.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	recorder.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	require.NoError(t, recorder.Err())
+
+	entries, err := ReadRecording(&out)
+	require.NoError(t, err)
+	require.Len(t, entries, 5)
+
+	assert.Equal(t, RecordingInit, entries[0].Kind)
+	assert.Equal(t, "> ", entries[0].Frame)
+	assert.Equal(t, "size 80x24", entries[1].Describe())
+	assert.Equal(t, "msg ui.tickMsg", entries[2].Describe())
+	assert.Empty(t, entries[2].Frame, "frames that did not change are not recorded again")
+	assert.Equal(t, "key a", entries[3].Describe())
+	assert.Equal(t, "> a", entries[3].Frame)
+	assert.Equal(t, "key ctrl+f", entries[4].Describe())
+
+	assert.NoError(t, VerifyReplay(&typedText{}, entries))
+	assert.ErrorContains(t, VerifyReplay(&typedText{text: "x"}, entries), "input 1 (size 80x24) differs")
+}
+
+func TestReadRecording_Invalid(t *testing.T) {
+	_, err := ReadRecording(bytes.NewBufferString("{\"kind\":\"init\"}\nnot json\n"))
+
+	assert.ErrorContains(t, err, "entry 2")
+}
+
+// TestSessionCommentForm_GoldenFrames replays a recording of the comment form and checks it
+// still renders the recorded frames. Run with -update to record it again after a deliberate change.
+func TestSessionCommentForm_GoldenFrames(t *testing.T) {
+	path := filepath.Join("testdata", "session_comment_form.jsonl")
+	newForm := func() tea.Model { return NewSessionCommentForm(nil, "api", "") }
+
+	if *updateGolden {
+		var out bytes.Buffer
+		recorder := NewRecorder(newForm(), &out)
+		recorder.Init()
+		for _, msg := range []tea.Msg{
+			tea.WindowSizeMsg{Width: 60, Height: 20},
+			tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("flaky")},
+			tea.KeyMsg{Type: tea.KeyBackspace},
+			tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e login test")},
+		} {
+			recorder.Update(msg)
+		}
+		require.NoError(t, recorder.Err())
+		require.NoError(t, os.WriteFile(path, out.Bytes(), 0644))
+	}
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	entries, err := ReadRecording(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	assert.NoError(t, VerifyReplay(newForm(), entries))
+}
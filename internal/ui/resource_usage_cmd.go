@@ -0,0 +1,43 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// ResourceUsageReadyMsg is sent when agent resource usage is sampled
+type ResourceUsageReadyMsg struct {
+	Usage map[string]*domain.ResourceUsage
+}
+
+// ResourceUsageErrorMsg is sent when resource usage sampling fails
+type ResourceUsageErrorMsg struct {
+	Err error
+}
+
+// StartResourceSampler samples CPU and memory of the agent process of each session
+// Returns a tea.Cmd that will send ResourceUsageReadyMsg or ResourceUsageErrorMsg
+func StartResourceSampler(sessionService *services.SessionService, sessionNames []string) tea.Cmd {
+	return func() tea.Msg {
+		usage, err := sessionService.SampleResourceUsage(sessionNames)
+		if err != nil {
+			logging.Logger.Warn("Failed to sample resource usage", "error", err)
+			return ResourceUsageErrorMsg{Err: err}
+		}
+		return ResourceUsageReadyMsg{Usage: usage}
+	}
+}
+
+// formatMemory formats a byte count with M/G suffixes
+func formatMemory(bytes int64) string {
+	const mib = 1024 * 1024
+	if bytes >= 1024*mib {
+		return fmt.Sprintf("%.1fG", float64(bytes)/(1024*mib))
+	}
+	return fmt.Sprintf("%dM", bytes/mib)
+}
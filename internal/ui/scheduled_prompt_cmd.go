@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// ScheduledPromptsSentMsg is sent after due scheduled prompts were delivered
+type ScheduledPromptsSentMsg struct {
+	Sent      []domain.ScheduledPrompt
+	Throttled []string // Sessions whose due prompts are held back by the concurrency limit
+}
+
+// ScheduledPromptsErrorMsg is sent when dispatching scheduled prompts fails
+type ScheduledPromptsErrorMsg struct {
+	Err error
+}
+
+// StartPromptDispatcher delivers scheduled prompts that are due
+// Returns a tea.Cmd that will send ScheduledPromptsSentMsg or ScheduledPromptsErrorMsg
+func StartPromptDispatcher(schedulerService *services.SchedulerService) tea.Cmd {
+	return func() tea.Msg {
+		result, err := schedulerService.DispatchDue(context.Background(), time.Now())
+		if err != nil {
+			logging.Logger.Warn("Failed to dispatch scheduled prompts", "error", err)
+			return ScheduledPromptsErrorMsg{Err: err}
+		}
+		return ScheduledPromptsSentMsg{Sent: result.Sent, Throttled: result.Throttled}
+	}
+}
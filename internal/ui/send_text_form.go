@@ -1,15 +1,24 @@
 package ui
 
 import (
-	"fmt"
+	"context"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
 )
 
+// promptHistoryRecallLimit is how many previously sent prompts the send dialog can recall
+const promptHistoryRecallLimit = 100
+
+// promptHistoryOptionWidth caps the length of a prompt in the history browser
+const promptHistoryOptionWidth = 80
+
 // SendTextFormResult contains the result of the send text operation
 type SendTextFormResult struct {
 	Cancelled   bool
@@ -18,53 +27,116 @@ type SendTextFormResult struct {
 	Text        string
 }
 
-// SendTextForm is a Bubble Tea component for sending text to a tmux session
+// SendTextForm is a Bubble Tea component for sending text to a tmux session.
+// Up and down recall prompts sent to the session before, and ctrl+r browses them.
+// Text matching the prompt review patterns is only sent to skip-permissions sessions once confirmed.
 type SendTextForm struct {
-	Completed    bool
-	cancelled    bool
-	form         *huh.Form
-	result       SendTextFormResult
-	sessionName  string
-	shellService *services.ShellService
+	Completed        bool
+	browseChoice     string
+	browseForm       *huh.Form // Prompt history browser, nil unless browsing
+	cancelled        bool
+	draft            string // Text being edited before history was recalled
+	form             *huh.Form
+	history          []string // Previously sent prompts, newest first
+	historyIndex     int      // Recalled history entry, -1 while editing the draft
+	result           SendTextFormResult
+	reviewConfirmed  bool
+	reviewForm       *huh.Form // Confirmation of a dangerous prompt, nil unless reviewing
+	schedulerService *services.SchedulerService
+	sessionName      string
+	shown            string // Text last put in the field; history is only walked while it is unedited
+	textField        *huh.Text
 }
 
 // NewSendTextForm creates a new send text form
-func NewSendTextForm(shellService *services.ShellService, sessionName string) *SendTextForm {
+func NewSendTextForm(schedulerService *services.SchedulerService, sessionName string) *SendTextForm {
 	sf := &SendTextForm{
-		sessionName:  sessionName,
-		shellService: shellService,
+		history:          loadPromptHistory(schedulerService, sessionName),
+		historyIndex:     -1,
+		schedulerService: schedulerService,
+		sessionName:      sessionName,
 		result: SendTextFormResult{
 			SessionName: sessionName,
 			Text:        "rebase with origin/main",
 		},
 	}
+	sf.shown = sf.result.Text
+
+	description := i18n.Tf("send_text.desc", sessionName)
+	if len(sf.history) > 0 {
+		description += "\n" + i18n.T("send_text.history_hint")
+	}
 
 	// Build form with text input
-	sf.form = huh.NewForm(
-		huh.NewGroup(
-			huh.NewText().
-				Title("Send text to Claude").
-				Description(fmt.Sprintf("Text will be sent to session: %s", sessionName)).
-				Value(&sf.result.Text).
-				CharLimit(1000),
-		),
-	)
+	sf.textField = huh.NewText().
+		Title(i18n.T("send_text.title")).
+		Description(description).
+		Value(&sf.result.Text).
+		CharLimit(1000)
+	sf.form = huh.NewForm(huh.NewGroup(sf.textField))
 
 	return sf
 }
 
+// loadPromptHistory returns the distinct prompts sent to a session, newest first
+func loadPromptHistory(schedulerService *services.SchedulerService, sessionName string) []string {
+	prompts, err := schedulerService.ListPromptHistory(context.Background(), sessionName, promptHistoryRecallLimit)
+	if err != nil {
+		logging.Logger.Warn("Failed to load prompt history", "session", sessionName, "error", err)
+		return nil
+	}
+
+	seen := make(map[string]bool, len(prompts))
+	history := make([]string, 0, len(prompts))
+	for _, prompt := range prompts {
+		if !seen[prompt.Text] {
+			seen[prompt.Text] = true
+			history = append(history, prompt.Text)
+		}
+	}
+	return history
+}
+
 func (sf *SendTextForm) Init() tea.Cmd {
 	return sf.form.Init()
 }
 
 func (sf *SendTextForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	// Handle Escape or Ctrl+C to cancel
+	if sf.browseForm != nil {
+		return sf.updateBrowsing(msg)
+	}
+	if sf.reviewForm != nil {
+		return sf.updateReviewing(msg)
+	}
+
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
-		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+		switch keyMsg.String() {
+		case "esc", "ctrl+c":
+			// Cancel the dialog
 			sf.cancelled = true
 			sf.result.Cancelled = true
 			sf.Completed = true
 			return sf, nil
+		case "up":
+			if sf.result.Text == sf.shown && sf.historyIndex < len(sf.history)-1 {
+				if sf.historyIndex == -1 {
+					sf.draft = sf.result.Text
+				}
+				sf.historyIndex++
+				return sf, sf.setText(sf.history[sf.historyIndex])
+			}
+		case "down":
+			if sf.result.Text == sf.shown && sf.historyIndex >= 0 {
+				sf.historyIndex--
+				if sf.historyIndex == -1 {
+					return sf, sf.setText(sf.draft)
+				}
+				return sf, sf.setText(sf.history[sf.historyIndex])
+			}
+		case "ctrl+r":
+			if len(sf.history) > 0 {
+				return sf, sf.startBrowsing()
+			}
 		}
 	}
 
@@ -76,19 +148,158 @@ func (sf *SendTextForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Check if form completed
 	if sf.form.State == huh.StateCompleted {
-		sf.Completed = true
-		// Execute the send text operation
-		if err := sf.sendText(); err != nil {
-			logging.Logger.Error("Failed to send text to tmux", "error", err)
-			sf.result.Error = err
+		if matches := sf.reviewMatches(); len(matches) > 0 {
+			return sf, sf.startReviewing(matches)
+		}
+		sf.complete()
+		return sf, nil
+	}
+
+	return sf, cmd
+}
+
+// complete sends the text and closes the form
+func (sf *SendTextForm) complete() {
+	sf.Completed = true
+	if err := sf.sendText(); err != nil {
+		logging.Logger.Error("Failed to send text to tmux", "error", err)
+		sf.result.Error = err
+	}
+}
+
+// reviewMatches returns what the text matches of the prompt review patterns, nil if it can be sent as is
+func (sf *SendTextForm) reviewMatches() []string {
+	matches, err := sf.schedulerService.ReviewPrompt(context.Background(), sf.sessionName, sf.result.Text)
+	if err != nil {
+		// Sending reports the same failure, such as a session deleted meanwhile
+		logging.Logger.Warn("Failed to review prompt", "session", sf.sessionName, "error", err)
+		return nil
+	}
+	return matches
+}
+
+// startReviewing asks to confirm sending text that matched the prompt review patterns
+func (sf *SendTextForm) startReviewing(matches []string) tea.Cmd {
+	sf.reviewForm = huh.NewForm(
+		huh.NewGroup(
+			huh.NewConfirm().
+				Title(i18n.T("send_text.dangerous")).
+				Description(i18n.Tf("send_text.dangerous_desc",
+					sf.sessionName, strings.Join(matches, ", "))).
+				Affirmative(i18n.T("send_text.send")).
+				Negative(i18n.T("form.cancel")).
+				Value(&sf.reviewConfirmed),
+		),
+	)
+	return tea.Batch(sf.reviewForm.Init(), tea.WindowSize())
+}
+
+// updateReviewing forwards messages to the confirmation until the text is sent or the send is cancelled
+func (sf *SendTextForm) updateReviewing(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && (keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c") {
+		sf.reviewConfirmed = false
+		sf.reviewForm.State = huh.StateCompleted
+	} else {
+		form, cmd := sf.reviewForm.Update(msg)
+		if f, ok := form.(*huh.Form); ok {
+			sf.reviewForm = f
+		}
+		if sf.reviewForm.State != huh.StateCompleted {
+			return sf, cmd
 		}
+	}
+
+	sf.reviewForm = nil
+	if !sf.reviewConfirmed {
+		logging.Logger.Info("Dangerous prompt not confirmed, not sending", "session", sf.sessionName)
+		sf.cancelled = true
+		sf.result.Cancelled = true
+		sf.Completed = true
 		return sf, nil
 	}
+	sf.complete()
+	return sf, nil
+}
+
+// updateBrowsing forwards messages to the history browser until a prompt is picked or browsing is cancelled
+func (sf *SendTextForm) updateBrowsing(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if keyMsg, ok := msg.(tea.KeyMsg); ok && (keyMsg.String() == "esc" || keyMsg.String() == "ctrl+r") {
+		sf.browseForm = nil
+		return sf, tea.WindowSize() // The text form missed resizes while browsing
+	}
+
+	form, cmd := sf.browseForm.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.browseForm = f
+	}
+
+	if sf.browseForm.State == huh.StateCompleted {
+		sf.browseForm = nil
+		sf.historyIndex = -1
+		return sf, tea.Batch(sf.setText(sf.browseChoice), tea.WindowSize())
+	}
 
 	return sf, cmd
 }
 
+// startBrowsing opens the history browser with every recalled prompt
+func (sf *SendTextForm) startBrowsing() tea.Cmd {
+	options := make([]huh.Option[string], 0, len(sf.history))
+	for _, text := range sf.history {
+		options = append(options, huh.NewOption(oneLinePrompt(text), text))
+	}
+
+	sf.browseForm = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.T("send_text.history")).
+				Description(i18n.Tf("send_text.history_desc", sf.sessionName)).
+				Options(options...).
+				Height(12).
+				Value(&sf.browseChoice),
+		),
+	)
+	return tea.Batch(sf.browseForm.Init(), tea.WindowSize())
+}
+
+// promptRecalledMsg makes the form render a recalled prompt
+type promptRecalledMsg struct{}
+
+// setText replaces the text being edited
+func (sf *SendTextForm) setText(text string) tea.Cmd {
+	sf.result.Text = text
+	sf.shown = text
+	sf.textField.Value(&sf.result.Text)
+
+	// The form caches its rendered view until it handles a message
+	form, cmd := sf.form.Update(promptRecalledMsg{})
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+	return cmd
+}
+
+// oneLinePrompt collapses a prompt to a single line that fits the history browser
+func oneLinePrompt(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+	if runes := []rune(text); len(runes) > promptHistoryOptionWidth {
+		return string(runes[:promptHistoryOptionWidth-1]) + "…"
+	}
+	return text
+}
+
+// Done reports whether the text was sent or the form cancelled
+func (sf *SendTextForm) Done() bool {
+	return sf.Completed
+}
+
 func (sf *SendTextForm) View() string {
+	if sf.browseForm != nil {
+		return sf.browseForm.View()
+	}
+	if sf.reviewForm != nil {
+		return sf.reviewForm.View()
+	}
 	if sf.form != nil {
 		return sf.form.View()
 	}
@@ -100,7 +311,8 @@ func (sf *SendTextForm) Result() SendTextFormResult {
 	return sf.result
 }
 
-// sendText sends the text to the tmux session
+// sendText sends the text to the tmux session, adding it to the session's prompt history.
+// While the concurrency limit is reached it is queued instead, and the list shows the session throttled.
 func (sf *SendTextForm) sendText() error {
 	if sf.result.Text == "" {
 		logging.Logger.Info("No text to send, skipping")
@@ -111,14 +323,13 @@ func (sf *SendTextForm) sendText() error {
 		"session_name", sf.sessionName,
 		"text_length", len(sf.result.Text))
 
-	// Send the text to tmux first
-	if err := sf.shellService.SendKeys(sf.sessionName, sf.result.Text); err != nil {
-		return fmt.Errorf("failed to send text to tmux: %w", err)
+	queued, err := sf.schedulerService.SendOrQueue(context.Background(), sf.sessionName, sf.result.Text, sf.reviewConfirmed, time.Now())
+	if err != nil {
+		return err
 	}
-
-	// Then send Enter key separately to submit
-	if err := sf.shellService.SendKeys(sf.sessionName, "C-m"); err != nil {
-		return fmt.Errorf("failed to send enter key to tmux: %w", err)
+	if queued != nil {
+		logging.Logger.Info("Text queued by the concurrency limit", "session_name", sf.sessionName, "prompt_id", queued.ID)
+		return nil
 	}
 
 	logging.Logger.Info("Text sent and submitted successfully", "session_name", sf.sessionName)
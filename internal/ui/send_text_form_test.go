@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/internal/domain"
+	portsmocks "github.com/renato0307/rocha/internal/ports/mocks"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+func TestSendTextForm_QueuesWhenLimitReached(t *testing.T) {
+	promptRepo := portsmocks.NewMockScheduledPromptRepository(t)
+	historyRepo := portsmocks.NewMockPromptHistoryRepository(t)
+	sessionReader := portsmocks.NewMockSessionReader(t)
+	tmuxClient := portsmocks.NewMockSessionManager(t) // No keys are sent
+
+	sessionReader.EXPECT().Get(mock.Anything, "target").Return(&domain.Session{Name: "target"}, nil)
+	historyRepo.EXPECT().ListSentPrompts(mock.Anything, "target", promptHistoryRecallLimit).Return(nil, nil)
+	sessionReader.EXPECT().List(mock.Anything, false).Return([]domain.Session{
+		{Name: "busy", State: domain.StateWorking},
+		{Name: "target", State: domain.StateIdle},
+	}, nil)
+	promptRepo.EXPECT().AddScheduledPrompt(mock.Anything, mock.MatchedBy(func(prompt domain.ScheduledPrompt) bool {
+		return prompt.SessionName == "target" && prompt.Text == "run the tests"
+	})).Return(&domain.ScheduledPrompt{ID: 3, SessionName: "target", Text: "run the tests"}, nil)
+
+	scheduler := services.NewSchedulerService(promptRepo, historyRepo, sessionReader, tmuxClient, domain.ConcurrencyLimit{Global: 1})
+	form := NewSendTextForm(scheduler, "target")
+	form.result.Text = "run the tests"
+	form.complete()
+
+	require.True(t, form.Completed)
+	assert.NoError(t, form.Result().Error)
+}
@@ -0,0 +1,164 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// Actions on an existing attachment
+const (
+	attachmentActionOpen   = "open"
+	attachmentActionRemove = "remove"
+	attachmentActionSend   = "send"
+)
+
+// SessionAttachmentsFormResult contains the result of the attachments dialog
+type SessionAttachmentsFormResult struct {
+	Action      string // One of the attachmentAction constants, empty when a file was attached
+	Cancelled   bool
+	Error       error
+	NewFile     string // File typed in to attach
+	Path        string // Attachment acted on, empty to attach a new file
+	SessionName string
+}
+
+// SessionAttachmentsForm is a Bubble Tea component for attaching files to a session
+// and opening, removing, or sending its attachments to the agent
+type SessionAttachmentsForm struct {
+	Completed         bool
+	attachmentService *services.AttachmentService
+	form              *huh.Form
+	result            SessionAttachmentsFormResult
+}
+
+// NewSessionAttachmentsForm creates a new attachments form listing the current attachments
+func NewSessionAttachmentsForm(attachmentService *services.AttachmentService, sessionName string, attachments []domain.SessionAttachment) *SessionAttachmentsForm {
+	sf := &SessionAttachmentsForm{
+		attachmentService: attachmentService,
+		result: SessionAttachmentsFormResult{
+			Action:      attachmentActionOpen,
+			SessionName: sessionName,
+		},
+	}
+
+	options := []huh.Option[string]{huh.NewOption(i18n.T("attachments.add"), "")}
+	for _, attachment := range attachments {
+		options = append(options, huh.NewOption(fmt.Sprintf("%s (%s)", attachment.Name, attachment.MediaType), attachment.Path))
+	}
+
+	sf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.T("attachments.title")).
+				Description(i18n.Tf("form.session", sessionName)).
+				Options(options...).
+				Value(&sf.result.Path),
+		),
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.T("attachments.action")).
+				Options(
+					huh.NewOption(i18n.T("attachments.open"), attachmentActionOpen),
+					huh.NewOption(i18n.T("attachments.send"), attachmentActionSend),
+					huh.NewOption(i18n.T("attachments.remove"), attachmentActionRemove),
+				).
+				Value(&sf.result.Action),
+		).WithHideFunc(func() bool { return sf.result.Path == "" }),
+		huh.NewGroup(
+			huh.NewInput().
+				Title(i18n.T("attachments.file")).
+				Description(i18n.T("attachments.file_desc")).
+				Value(&sf.result.NewFile).
+				Validate(func(path string) error {
+					if path == "" {
+						return errors.New(i18n.T("attachments.file_required"))
+					}
+					return nil
+				}),
+		).WithHideFunc(func() bool { return sf.result.Path != "" }),
+	)
+
+	return sf
+}
+
+func (sf *SessionAttachmentsForm) Init() tea.Cmd {
+	return sf.form.Init()
+}
+
+func (sf *SessionAttachmentsForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			sf.result.Cancelled = true
+			sf.Completed = true
+			return sf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := sf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+
+	if sf.form.State == huh.StateCompleted {
+		sf.Completed = true
+		if err := sf.apply(); err != nil {
+			logging.Logger.Error("Failed to update attachments", "error", err)
+			sf.result.Error = err
+		}
+		return sf, nil
+	}
+
+	return sf, cmd
+}
+
+// Done reports whether the form was closed
+func (sf *SessionAttachmentsForm) Done() bool {
+	return sf.Completed
+}
+
+func (sf *SessionAttachmentsForm) View() string {
+	if sf.form != nil {
+		return sf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (sf *SessionAttachmentsForm) Result() SessionAttachmentsFormResult {
+	return sf.result
+}
+
+// apply attaches the new file or runs the chosen action on the selected attachment
+func (sf *SessionAttachmentsForm) apply() error {
+	ctx := context.Background()
+	sessionName := sf.result.SessionName
+
+	if sf.result.Path == "" {
+		sf.result.Action = ""
+		_, err := sf.attachmentService.Attach(ctx, sessionName, []string{sf.result.NewFile}, time.Now())
+		return err
+	}
+
+	switch sf.result.Action {
+	case attachmentActionOpen:
+		return sf.attachmentService.Open(ctx, sessionName, sf.result.Path)
+	case attachmentActionRemove:
+		_, err := sf.attachmentService.Remove(ctx, sessionName, sf.result.Path)
+		return err
+	default:
+		_, err := sf.attachmentService.SendToAgent(ctx, sessionName, []string{sf.result.Path}, time.Now())
+		return err
+	}
+}
@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"errors"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+)
+
+// SessionBranchFormResult contains the branch chosen for a session worktree
+type SessionBranchFormResult struct {
+	Branch       string
+	Cancelled    bool
+	SessionName  string
+	TellAgent    bool // Tell the agent that the files it saw may have changed
+	WorktreePath string
+}
+
+// SessionBranchForm is a Bubble Tea component for choosing the branch to check out in a session worktree
+type SessionBranchForm struct {
+	Completed bool
+	form      *huh.Form
+	result    SessionBranchFormResult
+}
+
+// NewSessionBranchForm creates a new branch switcher form
+// The current branch and branches checked out in other worktrees are listed but cannot be picked.
+func NewSessionBranchForm(sessionName, worktreePath string, branches []domain.Branch) *SessionBranchForm {
+	sf := &SessionBranchForm{
+		result: SessionBranchFormResult{
+			SessionName:  sessionName,
+			TellAgent:    true,
+			WorktreePath: worktreePath,
+		},
+	}
+
+	options := make([]huh.Option[string], 0, len(branches))
+	for _, branch := range branches {
+		label := branch.DisplayName()
+		switch branch.Worktree {
+		case "":
+		case worktreePath:
+			label += " " + i18n.T("switch_branch.current")
+		default:
+			label += " " + i18n.Tf("switch_branch.checked_out", branch.Worktree)
+		}
+		options = append(options, huh.NewOption(label, branch.Name))
+	}
+
+	sf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.T("switch_branch.title")).
+				Description(i18n.Tf("form.session", sessionName)).
+				Options(options...).
+				Validate(func(name string) error {
+					for _, branch := range branches {
+						if branch.Name == name && branch.Worktree != "" {
+							return errors.New(i18n.Tf("switch_branch.in_use", name))
+						}
+					}
+					return nil
+				}).
+				Value(&sf.result.Branch),
+			huh.NewConfirm().
+				Title(i18n.T("switch_branch.tell_agent")).
+				Affirmative(i18n.T("form.yes")).
+				Negative(i18n.T("form.no")).
+				Value(&sf.result.TellAgent),
+		),
+	)
+
+	return sf
+}
+
+func (sf *SessionBranchForm) Init() tea.Cmd {
+	return sf.form.Init()
+}
+
+func (sf *SessionBranchForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			sf.result.Cancelled = true
+			sf.Completed = true
+			return sf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := sf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+
+	if sf.form.State == huh.StateCompleted {
+		sf.Completed = true
+		return sf, nil
+	}
+
+	return sf, cmd
+}
+
+// Done reports whether a branch was picked or the form cancelled
+func (sf *SessionBranchForm) Done() bool {
+	return sf.Completed
+}
+
+func (sf *SessionBranchForm) View() string {
+	if sf.form != nil {
+		return sf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (sf *SessionBranchForm) Result() SessionBranchFormResult {
+	return sf.result
+}
@@ -8,6 +8,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
 )
@@ -47,8 +48,8 @@ func NewSessionCommentForm(sessionService *services.SessionService, sessionName,
 	sf.form = huh.NewForm(
 		huh.NewGroup(
 			huh.NewText().
-				Title("Session comment").
-				Description(fmt.Sprintf("Comment for: %s (empty to delete)", sessionName)).
+				Title(i18n.T("comment.title")).
+				Description(i18n.Tf("comment.desc", sessionName)).
 				Value(&sf.result.NewComment).
 				CharLimit(500),
 		),
@@ -92,6 +93,11 @@ func (sf *SessionCommentForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return sf, cmd
 }
 
+// Done reports whether the comment was saved or the form cancelled
+func (sf *SessionCommentForm) Done() bool {
+	return sf.Completed
+}
+
 func (sf *SessionCommentForm) View() string {
 	if sf.form != nil {
 		return sf.form.View()
@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+func TestSessionFilterFunc(t *testing.T) {
+	review := "review"
+	items := []list.Item{
+		SessionItem{DisplayName: "login", GitRef: "feature/login", IsFlagged: true, LastUpdated: time.Now(), RepoInfo: "acme/web", Session: &ports.TmuxSession{Name: "login"}, State: "idle", Status: &review},
+		SessionItem{DisplayName: "logout", GitRef: "feature/logout", LastUpdated: time.Now(), RepoInfo: "acme/web", Session: &ports.TmuxSession{Name: "logout"}, State: "working"},
+		SessionItem{DisplayName: "api", GitRef: "main", LastUpdated: time.Now().Add(-10 * 24 * time.Hour), RepoInfo: "acme/api", Session: &ports.TmuxSession{Name: "api"}, State: "idle", Tags: []string{"backend", "urgent"}},
+	}
+	targets := make([]string, len(items))
+	for i, item := range items {
+		targets[i] = item.FilterValue()
+	}
+
+	tests := []struct {
+		name string
+		term string
+		want []int
+	}{
+		{name: "plain text is fuzzy", term: "log", want: []int{0, 1}},
+		{name: "state token", term: "state:idle", want: []int{0, 2}},
+		{name: "flagged token", term: "flagged", want: []int{0}},
+		{name: "flag:no token", term: "flag:no", want: []int{1, 2}},
+		{name: "repo and state", term: "repo:web state:working", want: []int{1}},
+		{name: "status token", term: "status:review", want: []int{0}},
+		{name: "tag token", term: "tag:urgent", want: []int{2}},
+		{name: "older token", term: "older:7d", want: []int{2}},
+		{name: "token with text keeps original indexes", term: "state:idle api", want: []int{2}},
+		{name: "invalid token matches nothing", term: "older:soon", want: nil},
+	}
+
+	filterFunc := newSessionFilterFunc(items)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []int
+			for _, rank := range filterFunc(tt.term, targets) {
+				got = append(got, rank.Index)
+			}
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package ui
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -13,34 +15,94 @@ import (
 
 	"github.com/renato0307/rocha/internal/config"
 	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
 	"github.com/renato0307/rocha/internal/theme"
 )
 
-// sessionCreatedMsg is sent when session creation completes
+// bootstrapOutputLines is how many lines of worktree bootstrap output the dialog shows
+const bootstrapOutputLines = 12
+
+// Ways to resolve a session name that is already taken
+const (
+	nameConflictReuse  = "reuse"  // Open the existing session instead
+	nameConflictSuffix = "suffix" // Create the session under a free "<name>-N"
+)
+
+// sessionCreatedMsg is sent when session creation completes, after its bootstrap output
 type sessionCreatedMsg struct {
 	err error
 }
 
+// bootstrapOutputMsg carries one line of worktree bootstrap output
+type bootstrapOutputMsg struct {
+	line string
+}
+
+// bootstrapLineWriter splits bootstrap output into lines and sends them to the dialog
+type bootstrapLineWriter struct {
+	msgs    chan<- tea.Msg
+	partial string
+}
+
+// Write implements io.Writer
+func (w *bootstrapLineWriter) Write(p []byte) (int, error) {
+	w.partial += string(p)
+	for {
+		idx := strings.IndexByte(w.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		line := w.partial[:idx]
+		// Keep only the last redraw of progress bars that use carriage returns
+		if cr := strings.LastIndexByte(line, '\r'); cr >= 0 {
+			line = line[cr+1:]
+		}
+		w.msgs <- bootstrapOutputMsg{line: line}
+		w.partial = w.partial[idx+1:]
+	}
+	return len(p), nil
+}
+
+// flush sends output left without a trailing newline
+func (w *bootstrapLineWriter) flush() {
+	if w.partial != "" {
+		w.msgs <- bootstrapOutputMsg{line: w.partial}
+		w.partial = ""
+	}
+}
+
 // SessionFormResult contains the result of the session creation form
 type SessionFormResult struct {
+	AgentArgs                       string // Extra Claude args as a shell-like line
+	AgentModel                      string // Model alias or ID (empty uses Claude's default)
 	AllowDangerouslySkipPermissions bool
 	BranchName                      string
 	Cancelled                       bool
 	ClaudeDir                       string // User-provided CLAUDE_CONFIG_DIR override
 	CreateWorktree                  bool
+	DirectoryPath                   string // Existing directory to use as-is (no branch or worktree)
 	Error                           error  // Error that occurred during session creation
 	InitialPrompt                   string // Initial prompt to send to Claude on session start
 	RepoSource                      string // User-provided repo path or URL
+	ReusedSession                   string // Existing session chosen instead of creating one with its name
 	SessionName                     string
+	Subdir                          string // Directory inside the checkout to start in (monorepos)
 }
 
 // SessionForm is a Bubble Tea component for creating sessions
 type SessionForm struct {
+	bookmarkService    *services.RepoBookmarkService
+	bookmarks          []domain.RepoBookmark // Offered in the repository field, most used first
+	bootstrapLines     []string              // Last lines of worktree bootstrap output
+	bootstrapOutput    chan tea.Msg          // Bootstrap output lines, then the sessionCreatedMsg, while the session is being created
 	cancelled          bool
 	Completed          bool // Exported so Model can check completion
-	creating           bool // True when session creation is in progress
+	conflictChoice     string
+	conflictName       string // Free name offered when the chosen one is taken ("" until asked)
+	creating           bool   // True when session creation is in progress
+	failed             bool   // Creation failed after bootstrap output; kept open so the output can be read
 	form               *huh.Form
 	gitService         *services.GitService
 	result             SessionFormResult
@@ -54,20 +116,24 @@ type SessionForm struct {
 func NewSessionForm(
 	gitService *services.GitService,
 	sessionService *services.SessionService,
+	bookmarkService *services.RepoBookmarkService,
 	sessionState *domain.SessionCollection,
 	tmuxStatusPosition string,
 	allowDangerouslySkipPermissionsDefault bool,
-	defaultRepoSource string,
+	draft domain.SessionDraft,
 ) *SessionForm {
 	s := spinner.New()
 	s.Spinner = spinner.Dot
 	s.Style = theme.SpinnerStyle
 
 	sf := &SessionForm{
-		gitService: gitService,
+		bookmarkService: bookmarkService,
+		gitService:      gitService,
 		result: SessionFormResult{
 			AllowDangerouslySkipPermissions: allowDangerouslySkipPermissionsDefault,
-			RepoSource:                      defaultRepoSource,
+			InitialPrompt:                   draft.InitialPrompt,
+			RepoSource:                      draft.RepoSource,
+			SessionName:                     draft.SessionName,
 		},
 		sessionService:     sessionService,
 		sessionState:       sessionState,
@@ -77,7 +143,7 @@ func NewSessionForm(
 
 	logging.Logger.Debug("Creating session form with default values",
 		"allow_dangerously_skip_permissions_default", allowDangerouslySkipPermissionsDefault,
-		"default_repo_source", defaultRepoSource)
+		"default_repo_source", draft.RepoSource)
 
 	// Check if we're in a git repository
 	cwd, _ := os.Getwd()
@@ -95,21 +161,32 @@ func NewSessionForm(
 
 	logging.Logger.Debug("Creating session form", "is_git_repo", isGit, "cwd", cwd)
 
+	bookmarks, err := bookmarkService.ListBookmarks(context.Background())
+	if err != nil {
+		logging.Logger.Warn("Failed to list repository bookmarks", "error", err)
+	}
+	sf.bookmarks = bookmarks
+
 	// Build form fields
 	sessionNameField := huh.NewInput().
-		Title("Session name").
+		Title(i18n.T("new_session.name")).
 		Value(&sf.result.SessionName).
 		DescriptionFunc(func() string {
-			if sf.result.SessionName != "" {
-				if sanitized, err := sf.gitService.SanitizeBranchName(sf.result.SessionName); err == nil {
-					return fmt.Sprintf("Suggested branch name: %s", sanitized)
-				}
+			if sf.result.SessionName == "" {
+				return ""
+			}
+			var lines []string
+			if name := domain.SanitizeSessionName(sf.result.SessionName); sf.nameTaken(name) {
+				lines = append(lines, i18n.Tf("new_session.name_taken", name))
+			}
+			if sanitized, err := sf.gitService.SanitizeBranchName(sf.result.SessionName); err == nil {
+				lines = append(lines, i18n.Tf("new_session.suggested_branch", sanitized))
 			}
-			return ""
+			return strings.Join(lines, "\n")
 		}, &sf.result.SessionName).
 		Validate(func(s string) error {
 			if s == "" {
-				return fmt.Errorf("session name required")
+				return errors.New(i18n.T("new_session.name_required"))
 			}
 			return nil
 		})
@@ -117,37 +194,44 @@ func NewSessionForm(
 	fields := []huh.Field{
 		sessionNameField,
 		huh.NewInput().
-			Title("Repository (optional)").
-			DescriptionFunc(func() string {
-				if sf.result.RepoSource == "" {
-					return "Git remote URL. Leave empty for current directory."
-				}
-				if repoSource, err := sf.gitService.ParseRepoSource(sf.result.RepoSource); err == nil && repoSource.Branch != "" {
-					return fmt.Sprintf("Detected branch: %s", repoSource.Branch)
-				}
-				return "Tip: Add #branch-name to specify a remote branch (e.g., https://github.com/owner/repo#main)"
-			}, &sf.result.RepoSource).
+			Title(i18n.T("new_session.repo")).
+			DescriptionFunc(sf.repoSourceDescription, &sf.result.RepoSource).
 			Placeholder("https://github.com/owner/repo#branch-name").
+			Suggestions(repoBookmarkSuggestions(bookmarks)).
 			Value(&sf.result.RepoSource).
 			Validate(func(s string) error {
 				if s == "" {
 					return nil
 				}
-				checkPath := s
-				if idx := strings.Index(s, "#"); idx >= 0 {
-					checkPath = s[:idx]
+				checkPath := domain.ResolveRepoBookmark(sf.bookmarks, s)
+				if idx := strings.Index(checkPath, "#"); idx >= 0 {
+					checkPath = checkPath[:idx]
 				}
 				if sf.gitService.IsGitURL(checkPath) {
 					return nil
 				}
-				return fmt.Errorf("must be a git URL (e.g., https://github.com/owner/repo or git@github.com:owner/repo)")
+				return errors.New(i18n.T("new_session.repo_invalid"))
+			}),
+		huh.NewInput().
+			Title(i18n.T("new_session.directory")).
+			Description(i18n.T("new_session.directory_desc")).
+			Placeholder(cwd).
+			Value(&sf.result.DirectoryPath).
+			Validate(func(s string) error {
+				if s == "" {
+					return nil
+				}
+				if _, err := config.ResolveDirectory(s); err != nil {
+					return errors.New(i18n.T("new_session.directory_missing"))
+				}
+				return nil
 			}),
 	}
 
 	fields = append(fields,
 		huh.NewInput().
-			Title("Override branch name (optional)").
-			Description("Leave empty to use suggested name above. Must match git naming rules.").
+			Title(i18n.T("new_session.branch")).
+			Description(i18n.T("new_session.branch_desc")).
 			Value(&sf.result.BranchName).
 			Validate(func(s string) error {
 				if s == "" {
@@ -156,9 +240,9 @@ func NewSessionForm(
 				if err := sf.gitService.ValidateBranchName(s); err != nil {
 					sanitized, sanitizeErr := sf.gitService.SanitizeBranchName(s)
 					if sanitizeErr == nil {
-						return fmt.Errorf("invalid branch name: %v (suggestion: %s)", err, sanitized)
+						return errors.New(i18n.Tf("new_session.branch_invalid_suggestion", err, sanitized))
 					}
-					return fmt.Errorf("invalid branch name: %v", err)
+					return errors.New(i18n.Tf("new_session.branch_invalid", err))
 				}
 				return nil
 			}),
@@ -166,8 +250,19 @@ func NewSessionForm(
 
 	fields = append(fields,
 		huh.NewInput().
-			Title("Claude directory (optional)").
-			Description(fmt.Sprintf("Leave empty to use default: %s", defaultClaudeDir)).
+			Title(i18n.T("new_session.subdir")).
+			Description(i18n.T("new_session.subdir_desc")).
+			Value(&sf.result.Subdir).
+			Validate(func(s string) error {
+				_, err := domain.NormalizeSubdir(s)
+				return err
+			}),
+	)
+
+	fields = append(fields,
+		huh.NewInput().
+			Title(i18n.T("new_session.claude_dir")).
+			Description(i18n.Tf("new_session.claude_dir_desc", defaultClaudeDir)).
 			Placeholder(defaultClaudeDir).
 			Value(&sf.result.ClaudeDir).
 			Validate(func(s string) error {
@@ -175,7 +270,7 @@ func NewSessionForm(
 					return nil
 				}
 				if !filepath.IsAbs(s) && !strings.HasPrefix(s, "~") {
-					return fmt.Errorf("path must be absolute or start with ~")
+					return errors.New(i18n.T("new_session.claude_dir_invalid"))
 				}
 				return nil
 			}),
@@ -183,23 +278,42 @@ func NewSessionForm(
 
 	fields = append(fields,
 		huh.NewText().
-			Title("Initial prompt (optional)").
+			Title(i18n.T("new_session.prompt")).
 			DescriptionFunc(func() string {
-				return fmt.Sprintf("Send this prompt to Claude when session starts. (%d/2000)", len(sf.result.InitialPrompt))
+				return i18n.Tf("new_session.prompt_desc", len(sf.result.InitialPrompt))
 			}, &sf.result.InitialPrompt).
 			CharLimit(2000).
 			Value(&sf.result.InitialPrompt),
+		huh.NewInput().
+			Title(i18n.T("new_session.model")).
+			Description(i18n.Tf("new_session.model_desc", strings.Join(domain.AgentModelAliases, ", "))).
+			Suggestions(domain.AgentModelAliases).
+			Value(&sf.result.AgentModel).
+			Validate(func(model string) error {
+				return domain.ValidateAgentModel(strings.TrimSpace(model))
+			}),
+		huh.NewInput().
+			Title(i18n.T("new_session.agent_args")).
+			Description(i18n.T("new_session.agent_args_desc")).
+			Value(&sf.result.AgentArgs).
+			Validate(func(line string) error {
+				args, err := domain.ParseAgentArgs(line)
+				if err != nil {
+					return err
+				}
+				return domain.ValidateAgentArgs(args)
+			}),
 	)
 
 	logging.Logger.Debug("Creating skip permissions field",
 		"current_value", sf.result.AllowDangerouslySkipPermissions)
 	fields = append(fields,
 		huh.NewConfirm().
-			Title("Skip permission prompts? (DANGEROUS)").
-			Description("Allows Claude to execute commands without asking. Use with caution!").
+			Title(i18n.T("new_session.skip_permissions")).
+			Description(i18n.T("new_session.skip_permissions_desc")).
 			Value(&sf.result.AllowDangerouslySkipPermissions).
-			Affirmative("Yes").
-			Negative("No"),
+			Affirmative(i18n.T("form.yes")).
+			Negative(i18n.T("form.no")),
 	)
 
 	sf.form = huh.NewForm(huh.NewGroup(fields...))
@@ -212,12 +326,33 @@ func (sf *SessionForm) Init() tea.Cmd {
 }
 
 func (sf *SessionForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	if msg, ok := msg.(sessionCreatedMsg); ok {
+	switch msg := msg.(type) {
+	case sessionCreatedMsg:
 		sf.creating = false
-		sf.Completed = true
 		if msg.err != nil {
 			logging.Logger.Error("Failed to create session", "error", msg.err)
 			sf.result.Error = msg.err
+			// Keep the dialog open so the failing bootstrap step, or how to reach
+			// the repository, can be read
+			var remoteErr *domain.RemoteAccessError
+			if len(sf.bootstrapLines) > 0 || errors.As(msg.err, &remoteErr) {
+				sf.failed = true
+				return sf, nil
+			}
+		}
+		sf.Completed = true
+		return sf, nil
+	case bootstrapOutputMsg:
+		sf.bootstrapLines = append(sf.bootstrapLines, msg.line)
+		if len(sf.bootstrapLines) > bootstrapOutputLines {
+			sf.bootstrapLines = sf.bootstrapLines[len(sf.bootstrapLines)-bootstrapOutputLines:]
+		}
+		return sf, sf.waitForBootstrapOutput()
+	}
+
+	if sf.failed {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok && (keyMsg.String() == "esc" || keyMsg.String() == "enter") {
+			sf.Completed = true
 		}
 		return sf, nil
 	}
@@ -243,16 +378,51 @@ func (sf *SessionForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 
 	if sf.form.State == huh.StateCompleted && !sf.creating {
+		if sf.conflictName == "" {
+			if form := sf.buildConflictForm(); form != nil {
+				sf.form = form
+				// Size the new form like the first one
+				return sf, tea.Batch(sf.form.Init(), tea.WindowSize())
+			}
+		} else if sf.conflictChoice == nameConflictReuse {
+			sf.result.ReusedSession = domain.SanitizeSessionName(sf.result.SessionName)
+			sf.Completed = true
+			return sf, nil
+		} else {
+			sf.result.SessionName = sf.conflictName
+		}
+
 		sf.creating = true
-		return sf, tea.Batch(sf.createSessionCmd(), sf.spinner.Tick)
+		sf.bootstrapOutput = make(chan tea.Msg, bootstrapOutputLines)
+		return sf, tea.Batch(sf.createSessionCmd(), sf.waitForBootstrapOutput(), sf.spinner.Tick)
 	}
 
 	return sf, cmd
 }
 
+// Done reports whether the session was created or the form cancelled
+func (sf *SessionForm) Done() bool {
+	return sf.Completed
+}
+
 func (sf *SessionForm) View() string {
+	if sf.failed {
+		details := sf.renderBootstrapOutput()
+		var remoteErr *domain.RemoteAccessError
+		if errors.As(sf.result.Error, &remoteErr) {
+			details = renderRemoteAccessHints(remoteErr)
+		}
+		return fmt.Sprintf("\n%s\n\n%s\n\n%s\n",
+			theme.ErrorStyle.Render(i18n.Tf("new_session.failed", sf.result.Error.Error())),
+			details,
+			theme.HelpStyle.Render(i18n.T("new_session.close")))
+	}
 	if sf.creating {
-		return fmt.Sprintf("\n%s Creating session...\n", sf.spinner.View())
+		view := fmt.Sprintf("\n%s %s\n", sf.spinner.View(), i18n.T("new_session.creating"))
+		if len(sf.bootstrapLines) > 0 {
+			view += "\n" + sf.renderBootstrapOutput() + "\n"
+		}
+		return view
 	}
 	if sf.form != nil {
 		return sf.form.View()
@@ -265,23 +435,169 @@ func (sf *SessionForm) Result() SessionFormResult {
 	return sf.result
 }
 
+// repoSourceDescription describes the repository field: the bookmark a name stands for,
+// the bookmarks matching what was typed, or the branch detected in a URL
+func (sf *SessionForm) repoSourceDescription() string {
+	value := strings.TrimSpace(sf.result.RepoSource)
+	if value == "" {
+		description := i18n.T("new_session.repo_desc")
+		if names := repoBookmarkNames(sf.bookmarks, ""); names != "" {
+			description += "\n" + i18n.Tf("new_session.bookmarks", names)
+		}
+		return description
+	}
+
+	if source := domain.ResolveRepoBookmark(sf.bookmarks, value); source != value {
+		return i18n.Tf("new_session.bookmark", source)
+	}
+	if repoSource, err := sf.gitService.ParseRepoSource(value); err == nil && repoSource.Branch != "" {
+		return i18n.Tf("new_session.detected_branch", repoSource.Branch)
+	}
+	if names := repoBookmarkNames(sf.bookmarks, value); names != "" {
+		return i18n.Tf("new_session.matching_bookmarks", names)
+	}
+	return i18n.T("new_session.repo_tip")
+}
+
+// maxListedBookmarks is how many bookmarks the repository field description names
+const maxListedBookmarks = 5
+
+// repoBookmarkNames lists the names of the most used bookmarks whose name or source contains query
+func repoBookmarkNames(bookmarks []domain.RepoBookmark, query string) string {
+	query = strings.ToLower(query)
+	var names []string
+	for _, bookmark := range bookmarks {
+		if len(names) == maxListedBookmarks {
+			names = append(names, "…")
+			break
+		}
+		if strings.Contains(bookmark.Name, query) || strings.Contains(strings.ToLower(bookmark.Source), query) {
+			names = append(names, bookmark.Name)
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// repoBookmarkSuggestions returns the bookmark names and sources the repository field completes
+func repoBookmarkSuggestions(bookmarks []domain.RepoBookmark) []string {
+	suggestions := make([]string, 0, 2*len(bookmarks))
+	for _, bookmark := range bookmarks {
+		suggestions = append(suggestions, bookmark.Name)
+	}
+	for _, bookmark := range bookmarks {
+		suggestions = append(suggestions, bookmark.Source)
+	}
+	return suggestions
+}
+
+// nameTaken reports whether a session, archived or not, already uses name
+func (sf *SessionForm) nameTaken(name string) bool {
+	_, err := sf.sessionService.GetSession(context.Background(), name)
+	return err == nil
+}
+
+// buildConflictForm asks how to go on when the session name is taken: create the session
+// under a free "<name>-N" or open the existing one. Returns nil when the name is free.
+func (sf *SessionForm) buildConflictForm() *huh.Form {
+	ctx := context.Background()
+	name := domain.SanitizeSessionName(sf.result.SessionName)
+	existing, err := sf.sessionService.GetSession(ctx, name)
+	if err != nil {
+		return nil // Free, or creation reports why it cannot check
+	}
+	available, err := sf.sessionService.AvailableName(ctx, name)
+	if err != nil {
+		logging.Logger.Warn("Failed to find a free session name", "name", name, "error", err)
+		return nil
+	}
+	sf.conflictName = available
+	sf.conflictChoice = nameConflictSuffix
+
+	options := []huh.Option[string]{
+		huh.NewOption(i18n.Tf("new_session.conflict_suffix", available), nameConflictSuffix),
+	}
+	// Archived sessions are not in the list to open
+	if !existing.IsArchived {
+		options = append(options, huh.NewOption(i18n.Tf("new_session.conflict_reuse", name), nameConflictReuse))
+	}
+
+	description := i18n.T("form.esc_cancel")
+	if existing.IsArchived {
+		description = i18n.T("new_session.conflict_archived")
+	}
+	return huh.NewForm(huh.NewGroup(
+		huh.NewSelect[string]().
+			Title(i18n.Tf("new_session.conflict", name)).
+			Description(description).
+			Options(options...).
+			Value(&sf.conflictChoice),
+	))
+}
+
+// renderBootstrapOutput renders the last lines of worktree bootstrap output
+func (sf *SessionForm) renderBootstrapOutput() string {
+	return theme.HelpDescStyle.Render(strings.Join(sf.bootstrapLines, "\n"))
+}
+
+// renderRemoteAccessHints renders what git said and how to fix access to the repository
+func renderRemoteAccessHints(err *domain.RemoteAccessError) string {
+	var lines []string
+	if err.Kind != domain.RemoteAccessUnknown {
+		lines = append(lines, theme.HelpDescStyle.Render("git: "+err.Detail))
+	}
+	for _, hint := range err.Hints {
+		lines = append(lines, theme.HelpLabelStyle.Render("• "+hint))
+	}
+	return strings.Join(lines, "\n")
+}
+
 // createSessionCmd returns a command that creates the session asynchronously
+// The sessionCreatedMsg goes through the bootstrap output channel, so it is delivered
+// only after every line of output.
 func (sf *SessionForm) createSessionCmd() tea.Cmd {
+	output := sf.bootstrapOutput
 	return func() tea.Msg {
-		err := sf.createSession()
-		return sessionCreatedMsg{err: err}
+		writer := &bootstrapLineWriter{msgs: output}
+		err := sf.createSession(writer)
+		writer.flush()
+		output <- sessionCreatedMsg{err: err}
+		close(output)
+		return nil
 	}
 }
 
-// createSession creates the tmux session with optional worktree
-func (sf *SessionForm) createSession() error {
+// waitForBootstrapOutput returns a command that delivers the next bootstrap output line,
+// or the sessionCreatedMsg once the output is done
+func (sf *SessionForm) waitForBootstrapOutput() tea.Cmd {
+	output := sf.bootstrapOutput
+	return func() tea.Msg {
+		msg, ok := <-output
+		if !ok {
+			return nil
+		}
+		return msg
+	}
+}
+
+// createSession creates the tmux session with optional worktree, streaming bootstrap output to output
+func (sf *SessionForm) createSession(output io.Writer) error {
+	agentArgs, err := domain.ParseAgentArgs(sf.result.AgentArgs)
+	if err != nil {
+		return err
+	}
+
 	params := services.CreateSessionParams{
+		AgentArgs:                       agentArgs,
+		AgentModel:                      strings.TrimSpace(sf.result.AgentModel),
 		AllowDangerouslySkipPermissions: sf.result.AllowDangerouslySkipPermissions,
+		BootstrapOutput:                 output,
 		BranchNameOverride:              sf.result.BranchName,
 		ClaudeDirOverride:               sf.result.ClaudeDir,
+		DirectoryPath:                   sf.result.DirectoryPath,
 		InitialPrompt:                   sf.result.InitialPrompt,
-		RepoSource:                      sf.result.RepoSource,
+		RepoSource:                      domain.ResolveRepoBookmark(sf.bookmarks, sf.result.RepoSource),
 		SessionName:                     sf.result.SessionName,
+		Subdir:                          sf.result.Subdir,
 		TmuxStatusPosition:              sf.tmuxStatusPosition,
 	}
 
@@ -290,6 +606,13 @@ func (sf *SessionForm) createSession() error {
 		return err
 	}
 
+	// Offer the repository first the next time a session is created
+	if params.RepoSource != "" && params.DirectoryPath == "" {
+		if err := sf.bookmarkService.RecordUse(context.Background(), params.RepoSource); err != nil {
+			logging.Logger.Warn("Failed to record repository bookmark use", "error", err)
+		}
+	}
+
 	// Update sessionState with the new session (for UI refresh)
 	if result.Session != nil {
 		sf.sessionState.Sessions[result.Session.Name] = *result.Session
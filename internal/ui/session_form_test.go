@@ -0,0 +1,24 @@
+package ui
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBootstrapLineWriter(t *testing.T) {
+	msgs := make(chan tea.Msg, 10)
+	writer := &bootstrapLineWriter{msgs: msgs}
+
+	writer.Write([]byte("▸ run make deps\ndownloading 10%\rdownloading 100%\nins"))
+	writer.Write([]byte("talled\nno newline"))
+	writer.flush()
+	close(msgs)
+
+	var got []string
+	for msg := range msgs {
+		got = append(got, msg.(bootstrapOutputMsg).line)
+	}
+	assert.Equal(t, []string{"▸ run make deps", "downloading 100%", "installed", "no newline"}, got)
+}
@@ -3,9 +3,10 @@ package ui
 import (
 	"context"
 	"fmt"
+	"hash/fnv"
 	"io"
-	"math/rand"
 	"os"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -17,6 +18,7 @@ import (
 
 	"github.com/renato0307/rocha/internal/config"
 	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 	"github.com/renato0307/rocha/internal/services"
@@ -25,24 +27,67 @@ import (
 
 const escTimeout = 500 * time.Millisecond
 
+// badgesReadyMsg carries the badges the badge command printed, by session name
+type badgesReadyMsg struct {
+	Badges map[string][]domain.Badge
+	Err    error
+}
+
+// checkRunsReadyMsg carries the last check run of the sessions that ran checks, by session name
+type checkRunsReadyMsg struct {
+	Runs map[string]*domain.CheckRun
+}
+
+// activityReadyMsg carries the activity sparklines of the sessions that recently worked, by session name
+type activityReadyMsg struct {
+	Activity map[string]domain.ActivitySparkline
+	Err      error
+}
+
 // Messages for SessionList (exported for Model integration)
 type checkStateMsg struct{}            // Triggers periodic state file check; also used by Model for token chart refresh
+type tokenBudgetsCheckedMsg struct{}   // Token budget check finished
+type hookJournalDrainedMsg struct{}    // Hook journal drain finished
+type ticketSyncsDrainedMsg struct{}    // Ticket sync outbox drain finished
+type worktreesCollectedMsg struct{}    // Removal of worktrees past retention finished
+type historyPrunedMsg struct{}         // Pruning of old history finished
+type orphanShellsCleanedMsg struct{}   // Cleanup of shells out of step with their parent finished
+type checkpointsSavedMsg struct{}      // Checkpoints of sessions that were due finished
+type remotesFetchedMsg struct{}        // Background fetch of session repositories finished
 type clearSessionListErrorMsg struct{} // Clear transient error after display period
 type hideTipMsg struct{}               // Time to hide the current tip
 type showTipMsg struct{}               // Time to show a new random tip
 
 // SessionItem implements list.Item and list.DefaultItem
+
 type SessionItem struct {
-	Comment         string
-	DisplayName     string
-	GitRef          string
-	HasShellSession bool // Track if shell session exists
-	IsFlagged       bool
-	LastUpdated     time.Time
-	PRState         string // PR state: OPEN, MERGED, CLOSED
-	Session         *ports.TmuxSession
-	State           string
-	Status          *string // Implementation status
+	Activity         domain.ActivitySparkline // Recent working time, shown before the timestamp (nil = none)
+	AgentError       *domain.AgentError       // Usage limit or authentication error the agent is stuck on
+	Badges           []domain.Badge           // Printed by the badge command, shown after the built-in indicators
+	CI               *domain.CIStatus         // Last CI result of the branch, shown after the status (nil = none)
+	Checks           *domain.CheckRun         // Last run of the repository checks, shown after the CI result (nil = none)
+	Comment          string
+	DisplayName      string
+	GitRef           string
+	HasShellSession  bool // Track if shell session exists
+	IsEscalated      bool // Waiting for input longer than the escalation threshold
+	IsExternal       bool // Runs in an existing directory without a worktree
+	IsFlagged        bool
+	IsThrottled      bool // Due prompts are held back by the concurrency limit
+	LastUpdated      time.Time
+	Note             string // Markdown note (shown in the note pane)
+	PRState          string // PR state: OPEN, MERGED, CLOSED
+	Priority         domain.Priority
+	RepoInfo         string                // owner/repo (used by the repo: filter)
+	RepoPath         string                // Repository path (used by the repo: filter)
+	Resources        *domain.ResourceUsage // Agent CPU/memory (nil when not sampled)
+	Session          *ports.TmuxSession
+	SkipsPermissions bool // Tool uses are audited since permission prompts are skipped
+	State            string
+	Status           *string                    // Implementation status
+	Tags             []string                   // Rendered as colored chips after the name
+	Timer            *domain.SessionTimer       // Countdown shown after the status (nil = none)
+	TokenBudget      *domain.SessionTokenBudget // Token usage shown after the timer (nil = none)
 }
 
 // FilterValue implements list.Item
@@ -50,6 +95,21 @@ func (i SessionItem) FilterValue() string {
 	return i.DisplayName + " " + i.GitRef
 }
 
+// filterSession returns the fields matched by session filter tokens
+func (i SessionItem) filterSession() domain.Session {
+	return domain.Session{
+		IsFlagged:   i.IsFlagged,
+		LastUpdated: i.LastUpdated,
+		Name:        i.Session.Name,
+		Priority:    i.Priority,
+		RepoInfo:    i.RepoInfo,
+		RepoPath:    i.RepoPath,
+		State:       domain.SessionState(i.State),
+		Status:      i.Status,
+		Tags:        i.Tags,
+	}
+}
+
 // Title implements list.DefaultItem
 func (i SessionItem) Title() string {
 	return i.DisplayName
@@ -62,14 +122,22 @@ func (i SessionItem) Description() string {
 
 // SessionDelegate is a custom delegate for rendering session items
 type SessionDelegate struct {
+	accessible      bool        // Text labels instead of icons, one line per session
+	density         ListDensity // Lines per session (ignored in accessibility mode)
+	inlineEdit      *InlineEdit // Edit in progress on a row, drawn over its name or git ref
+	quickJump       *QuickJump  // Hints drawn over the row numbers while jumping
 	sessionState    *domain.SessionCollection
 	statusConfig    *config.StatusConfig
 	timestampConfig *config.TimestampColorConfig
 	timestampMode   TimestampMode
 }
 
-func newSessionDelegate(sessionState *domain.SessionCollection, statusConfig *config.StatusConfig, timestampConfig *config.TimestampColorConfig, timestampMode TimestampMode) SessionDelegate {
+func newSessionDelegate(sessionState *domain.SessionCollection, inlineEdit *InlineEdit, quickJump *QuickJump, statusConfig *config.StatusConfig, timestampConfig *config.TimestampColorConfig, timestampMode TimestampMode, density ListDensity, accessible bool) SessionDelegate {
 	return SessionDelegate{
+		accessible:      accessible,
+		density:         density,
+		inlineEdit:      inlineEdit,
+		quickJump:       quickJump,
 		sessionState:    sessionState,
 		statusConfig:    statusConfig,
 		timestampConfig: timestampConfig,
@@ -79,7 +147,23 @@ func newSessionDelegate(sessionState *domain.SessionCollection, statusConfig *co
 
 // Height implements list.ItemDelegate
 func (d SessionDelegate) Height() int {
-	return 2 // Two lines per item (name + git ref)
+	if d.accessible {
+		return 1 // Git ref follows the name
+	}
+	return d.density.Lines()
+}
+
+// singleLine returns true when the git ref and inline edits go on the name line
+func (d SessionDelegate) singleLine() bool {
+	return d.accessible || d.density == DensityCompact
+}
+
+// rowNumber returns the zero-padded row number, or the row's hint while quick jumping
+func (d SessionDelegate) rowNumber(index int) string {
+	if d.quickJump.Active() {
+		return theme.QuickJumpHintStyle.Render(fmt.Sprintf("%-2s", d.quickJump.Hint(index)))
+	}
+	return fmt.Sprintf("%02d", index+1)
 }
 
 // Spacing implements list.ItemDelegate
@@ -118,27 +202,52 @@ func (d SessionDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 		statusIcon = theme.IdleIconStyle.Render(domain.SymbolIdle)
 	case domain.StateWaiting:
 		statusIcon = theme.WaitingIconStyle.Render(domain.SymbolWaiting)
+	case domain.StatePaused:
+		statusIcon = theme.PausedIconStyle.Render(domain.SymbolPaused)
 	case domain.StateExited:
 		statusIcon = theme.ExitedIconStyle.Render(domain.SymbolExited)
 	}
+	if d.accessible {
+		statusIcon = stateLabel(sessionState)
+	}
 
 	// Build first line: cursor + zero-padded number + status + name
-	line1 := fmt.Sprintf("%s %02d. %s %s", cursor, index+1, statusIcon, item.DisplayName)
+	line1 := fmt.Sprintf("%s %s. %s %s", cursor, d.rowNumber(index), statusIcon, item.DisplayName)
 	line1 = theme.NormalStyle.Render(line1)
 
+	// Add color-coded priority right after the name
+	if chip := priorityChip(item.Priority); chip != "" {
+		line1 += " " + chip
+	}
+
+	// Add tag chips right after the name
+	for _, tag := range item.Tags {
+		line1 += " " + theme.TagChipStyle(tagColor(tag)).Render(tag)
+	}
+
 	// Add flag indicator if flagged
 	if item.IsFlagged {
-		line1 += " ⚑"
+		line1 += " " + indicatorText(d.accessible, "⚑", "flagged")
 	}
 
 	// Add comment indicator if there's a comment
 	if item.Comment != "" {
-		line1 += " ⌨"
+		line1 += " " + indicatorText(d.accessible, "⌨", "comment")
+	}
+
+	// Add external directory indicator
+	if item.IsExternal {
+		line1 += " " + indicatorText(d.accessible, "⌂", "no worktree")
+	}
+
+	// Add shield when permission prompts are skipped
+	if item.SkipsPermissions {
+		line1 += " " + theme.SkipPermissionsStyle.Render(indicatorText(d.accessible, "⛨", "skips permissions"))
 	}
 
 	// Add shell session indicator at the end
 	if item.HasShellSession {
-		line1 += " >_"
+		line1 += " " + indicatorText(d.accessible, ">_", "shell")
 	}
 
 	// Add implementation status if set (with color-coded brackets)
@@ -147,6 +256,54 @@ func (d SessionDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 		line1 += " " + theme.StatusStyle(statusColor).Render("["+*item.Status+"]")
 	}
 
+	// Add the last CI result reported for the branch
+	if item.CI != nil {
+		line1 += " " + ciChip(item.CI.State, d.accessible)
+	}
+
+	// Add the outcome of the last run of the repository checks
+	if item.Checks != nil {
+		line1 += " " + checksChip(item.Checks.State(), d.accessible)
+	}
+
+	// Add countdown of the session timer, highlighted once it is due
+	if item.Timer != nil {
+		line1 += " " + timerChip(item.Timer, time.Now(), indicatorText(d.accessible, "⏰", "timer"))
+	}
+
+	// Add token usage against the session budget, highlighted once exceeded
+	if item.TokenBudget != nil {
+		line1 += " " + tokenBudgetChip(item.TokenBudget, indicatorText(d.accessible, "Σ", "tokens"))
+	}
+
+	// Add throttled badge when queued prompts wait for the concurrency limit
+	if item.IsThrottled {
+		line1 += " " + theme.ThrottledStyle.Render("throttled")
+	}
+
+	// Add a badge when the agent is stuck on a usage limit or authentication error
+	if item.AgentError != nil {
+		line1 += " " + theme.AgentErrorStyle.Render(indicatorText(d.accessible, "⛔ ", "")+item.AgentError.Label())
+	}
+
+	// Add agent CPU/memory usage, with a warning icon for runaway processes
+	if item.Resources != nil {
+		line1 += " " + theme.ResourceUsageStyle.Render(fmt.Sprintf("%.0f%% %s", item.Resources.CPUPercent, formatMemory(item.Resources.MemoryBytes)))
+		if item.Resources.IsRunaway() {
+			line1 += " " + theme.RunawayWarningStyle.Render(indicatorText(d.accessible, "⚠", "runaway"))
+		}
+	}
+
+	// Add the badges of the badge command after the built-in indicators
+	for _, badge := range item.Badges {
+		line1 += " " + theme.BadgeStyle(badge.Color).Render("["+badge.Text+"]")
+	}
+
+	// Add how busy the agent was recently right before the timestamp
+	if item.Activity != nil {
+		line1 += " " + activitySparkline(item.Activity, d.accessible)
+	}
+
 	// Add timestamp at the end with color based on age
 	if !item.LastUpdated.IsZero() {
 		var timeStr string
@@ -155,19 +312,32 @@ func (d SessionDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 			timeStr = formatRelativeTime(item.LastUpdated)
 		case TimestampAbsolute:
 			timeStr = formatAbsoluteTime(item.LastUpdated)
+		case TimestampHybrid:
+			timeStr = formatHybridTime(item.LastUpdated)
 		case TimestampHidden:
-			// Don't show timestamp
+			// Don't show timestamp, except how long escalated sessions have been waiting
+			if item.IsEscalated {
+				timeStr = formatRelativeTime(item.LastUpdated)
+			}
 		}
 
 		if timeStr != "" {
 			color := getTimestampColor(item.LastUpdated, d.timestampConfig)
+			if item.IsEscalated {
+				color = d.timestampConfig.EscalatedColor
+			}
 			line1 += " " + theme.TimestampStyle(color).Render("["+timeStr+"]")
 		}
 	}
 
+	// In accessibility mode the git ref ends the only line, spelled out
+	if d.accessible && item.GitRef != "" {
+		line1 += theme.BranchStyle.Render(", " + accessibleGitRef(item.GitRef))
+	}
+
 	// Build second line: git ref (indented to align with session name)
 	var line2 string
-	if item.GitRef != "" {
+	if item.GitRef != "" && !d.accessible {
 		indent := "        " // 8 spaces to align with session name (> 01. ● name)
 
 		// Apply colors to +N and -N in the git ref
@@ -221,39 +391,134 @@ func (d SessionDelegate) Render(w io.Writer, m list.Model, index int, listItem l
 		}
 		styledGitRef = strings.Join(parts, theme.BranchStyle.Render(" · "))
 
-		line2 = theme.BranchStyle.Render(indent) + styledGitRef
+		if d.density == DensityCompact {
+			line1 += "  " + styledGitRef
+		} else {
+			line2 = theme.BranchStyle.Render(indent) + styledGitRef
+		}
+	}
+
+	// Build third line in detailed density: comment and status text
+	var line3 string
+	if d.density == DensityDetailed && !d.accessible {
+		line3 = theme.BranchStyle.Render("        " + detailLine(item))
+	}
+
+	// An inline edit is drawn over the name (and the indicators after it) or the git ref
+	switch {
+	case d.inlineEdit.Editing(item.Session.Name, InlineEditRename):
+		line1 = theme.NormalStyle.Render(fmt.Sprintf("%s %s. %s ", cursor, d.rowNumber(index), statusIcon)) + d.inlineEdit.View()
+	case d.inlineEdit.Editing(item.Session.Name, InlineEditComment) && d.singleLine():
+		line1 = theme.NormalStyle.Render(fmt.Sprintf("%s %s. comment: ", cursor, d.rowNumber(index))) + d.inlineEdit.View()
+	case d.inlineEdit.Editing(item.Session.Name, InlineEditComment):
+		line2 = theme.BranchStyle.Render("        ⌨ ") + d.inlineEdit.View()
+	case d.inlineEdit.Editing(item.Session.Name, InlineEditHandoff) && d.singleLine():
+		line1 = theme.NormalStyle.Render(fmt.Sprintf("%s %s. left off: ", cursor, d.rowNumber(index))) + d.inlineEdit.View()
+	case d.inlineEdit.Editing(item.Session.Name, InlineEditHandoff):
+		line2 = theme.BranchStyle.Render("        ↳ ") + d.inlineEdit.View()
+	}
+
+	switch {
+	case d.singleLine():
+		fmt.Fprint(w, line1)
+	case d.density == DensityDetailed:
+		fmt.Fprint(w, line1+"\n"+line2+"\n"+line3)
+	default:
+		fmt.Fprint(w, line1+"\n"+line2)
 	}
+}
 
-	// Write both lines
-	fmt.Fprint(w, line1+"\n"+line2)
+// detailLine returns the comment and status text shown on the third line of detailed rows
+func detailLine(item SessionItem) string {
+	var parts []string
+	if item.Comment != "" {
+		parts = append(parts, "⌨ "+item.Comment)
+	}
+	if item.Status != nil && *item.Status != "" {
+		parts = append(parts, "status: "+*item.Status)
+	}
+	return strings.Join(parts, " · ")
 }
 
 // SessionList is a Bubble Tea component for displaying and managing sessions
 type SessionList struct {
-	currentTip         *Tip                         // Currently displayed tip (nil = hidden)
+	accessible         bool                           // Text labels instead of icons, one line per session
+	activityService    *services.ActivityStatsService // Builds the activity sparklines from recent events
+	badgeErrShown      bool                           // The last badge command failure was shown; later ones are only logged
+	badgeService       *services.BadgeService         // Runs the badge command
+	checkService       *services.CheckService         // Reads the last check run of each session
+	checkingBudgets    bool                           // Prevent concurrent token budget checks
+	checkpointing      bool                           // Prevent concurrent checkpoint runs
+	checkpointService  *services.CheckpointService    // Saves the worktrees of sessions on their checkpoint schedule
+	ciService          *services.CIService            // Alerts when CI fails on the branch of an idle session
+	cleaningShells     bool                           // Prevent concurrent orphan shell cleanups
+	currentTip         *Tip                           // Currently displayed tip (nil = hidden)
+	debugMetrics       *DebugMetrics                  // Poll timings and state reflection latency
+	density            ListDensity                    // Lines per session, persisted across runs
 	devMode            bool
-	editor             string                       // Editor to open sessions in
+	dispatchingPrompts bool   // Prevent concurrent scheduled prompt dispatch
+	drainingJournal    bool   // Prevent concurrent hook journal drains
+	editor             string // Editor to open sessions in
 	err                error
-	escPressCount      int                          // Escape handling for filter clearing
+	escalationService  *services.EscalationService // Escalates sessions left waiting for input
+	escPressCount      int                         // Escape handling for filter clearing
 	escPressTime       time.Time
-	fetchingGitStats   bool                         // Prevent concurrent fetches
-	gitService         *services.GitService         // Git operations service
+	fetchingActivity   bool                 // Prevent concurrent activity sparkline builds
+	fetchingBadges     bool                 // Prevent concurrent badge command runs
+	fetchingRemotes    bool                 // Prevent concurrent background fetches
+	fetchingGitStats   bool                 // Prevent concurrent fetches
+	filterChipsShown   bool                 // The applied filter is shown as chips above the list
+	gitService         *services.GitService // Git operations service
 	height             int
+	hookJournalService *services.HookJournalService // Applies hook events the database could not take in time
+	inlineEdit         *InlineEdit                  // Rename or comment typed directly on the selected row
+	instanceService    *services.InstanceService    // Tells whether this TUI runs the background work
 	keys               KeyMap
+	lastActivityFetch  time.Time // Activity sparklines are rebuilt every activityRefreshInterval
+	lastAgentErrorScan time.Time // Stuck sessions are scanned every agentErrorScanInterval
+	lastBudgetCheck    time.Time // Token budgets are checked every tokenBudgetCheckInterval
+	lastHistoryPrune   time.Time // Old history is discarded every services.HistoryPruneInterval
+	lastShellCleanup   time.Time // Orphan shells are cleaned up every services.OrphanShellCleanupInterval
+	lastWorktreeGC     time.Time // Worktrees past retention are removed every services.WorktreeGCInterval
 	list               list.Model
-	listHeight         int                          // Height available for the list component
-	sessionService     *services.SessionService     // Session service
+	listHeight         int                           // Height available for the list component
+	nextOptimisticID   int                           // Identifies the writes of optimistic updates
+	pendingUpdates     map[string][]optimisticUpdate // Per session: changes shown before their writes finished
+	pruneService       *services.HistoryPruneService // Discards old history written by hooks
+	pruningHistory     bool                          // Prevent concurrent history pruning
+	quickJump          *QuickJump                    // Row hints typed to attach to any visible session
+	readingChecks      bool                          // Prevent concurrent reads of the last check runs
+	remoteFetchService *services.RemoteFetchService  // Fetches session repositories on an interval
+	ruleService        *services.RuleService         // Applies the workflow rules of the settings
+	runningWorktreeGC  bool                          // Prevent concurrent worktree removals
+	samplingResources  bool                          // Prevent concurrent resource sampling
+	savedFilter        string                        // Filter remembered for the next start
+	scanningAgentErrs  bool                          // Prevent concurrent agent error scans
+	schedulerService   *services.SchedulerService    // Delivers scheduled prompts
+	sessionService     *services.SessionService      // Session service
 	sessionState       *domain.SessionCollection
+	shellService       *services.ShellService // Scans panes for agent errors, cleans up orphan shells
+	sortIndex          int                    // Active sort preset (-1 = manual order)
+	sortPresets        []domain.SortPreset    // Presets cycled with the cycle_sort key
 	statusConfig       *config.StatusConfig
+	syncingTickets     bool                        // Prevent concurrent ticket sync drains
+	ticketSyncService  *services.TicketSyncService // Syncs the tickets of queued status changes
+	timerService       *services.TimerService      // Alerts when session timers elapse
 	timestampConfig    *config.TimestampColorConfig
 	timestampMode      TimestampMode
+	tipRotation        *tipRotation                 // Picks the next tip, unseen ones first
 	tipsConfig         TipsConfig                   // Tips display configuration
+	tokenBudgetService *services.TokenBudgetService // Enforces session token budgets
+	tmuxCache          *TmuxSessionCache            // Running tmux sessions, refreshed once per poll
 	tmuxStatusPosition string
+	views              []domain.View // Named filters and sorts, applied with apply_view or the views dialog
 	width              int
+	workspace          string                      // Only sessions of this workspace are listed ("" = all)
+	worktreeGCService  *services.WorktreeGCService // Removes worktrees of sessions archived past retention
 }
 
 // NewSessionList creates a new session list component
-func NewSessionList(sessionService *services.SessionService, gitService *services.GitService, editor string, statusConfig *config.StatusConfig, timestampConfig *config.TimestampColorConfig, devMode bool, timestampMode TimestampMode, keys KeyMap, tmuxStatusPosition string, tipsConfig TipsConfig) *SessionList {
+func NewSessionList(sessionService *services.SessionService, tmuxCache *TmuxSessionCache, gitService *services.GitService, schedulerService *services.SchedulerService, escalationService *services.EscalationService, ruleService *services.RuleService, timerService *services.TimerService, tokenBudgetService *services.TokenBudgetService, worktreeGCService *services.WorktreeGCService, historyPruneService *services.HistoryPruneService, hookJournalService *services.HookJournalService, ticketSyncService *services.TicketSyncService, instanceService *services.InstanceService, remoteFetchService *services.RemoteFetchService, badgeService *services.BadgeService, shellService *services.ShellService, checkService *services.CheckService, checkpointService *services.CheckpointService, ciService *services.CIService, activityService *services.ActivityStatsService, editor string, statusConfig *config.StatusConfig, timestampConfig *config.TimestampColorConfig, devMode bool, timestampMode TimestampMode, keys KeyMap, tmuxStatusPosition string, tipsConfig TipsConfig, sortConfig SortConfig, viewConfig ViewConfig, accessible bool) *SessionList {
 	// Load session state (showArchived=false - TUI never shows archived sessions)
 	sessionState, err := sessionService.LoadState(context.Background(), false)
 	if err != nil {
@@ -261,11 +526,42 @@ func NewSessionList(sessionService *services.SessionService, gitService *service
 		sessionState = &domain.SessionCollection{Sessions: make(map[string]domain.Session)}
 	}
 
+	// Rank statuses in the configured order and select the startup preset
+	sortIndex := -1
+	sortPresets := make([]domain.SortPreset, len(sortConfig.Presets))
+	for i, preset := range sortConfig.Presets {
+		preset.Sort.StatusOrder = statusConfig.Statuses
+		sortPresets[i] = preset
+		if preset.Name == sortConfig.Active {
+			sortIndex = i
+		}
+	}
+
+	// The default view replaces the startup preset and, below, the last filter
+	var defaultView *domain.View
+	if i := domain.FindView(viewConfig.Views, viewConfig.Default); i >= 0 {
+		defaultView = &viewConfig.Views[i]
+		sortIndex = sortPresetIndex(sortPresets, defaultView.SortPreset)
+	}
+
 	// Build items from state
-	items := buildListItems(sessionState, sessionService, statusConfig)
+	var sessionSort domain.SessionSort
+	if sortIndex >= 0 {
+		sessionSort = sortPresets[sortIndex].Sort
+	}
+	items := buildListItems(sessionState, tmuxCache, statusConfig, sessionSort, "")
+
+	// Restore the density chosen when the TUI last ran
+	densityName, err := config.LoadListDensity()
+	if err != nil {
+		logging.Logger.Warn("Failed to load list density", "error", err)
+	}
+	density := parseListDensity(densityName)
 
 	// Create delegate
-	delegate := newSessionDelegate(sessionState, statusConfig, timestampConfig, timestampMode)
+	inlineEdit := NewInlineEdit()
+	quickJump := NewQuickJump()
+	delegate := newSessionDelegate(sessionState, inlineEdit, quickJump, statusConfig, timestampConfig, timestampMode, density, accessible)
 
 	// Create list with reasonable default size (will be resized on WindowSizeMsg)
 	// Initial height: assume 40 line terminal - 12 lines for header/help = 28
@@ -274,33 +570,77 @@ func NewSessionList(sessionService *services.SessionService, gitService *service
 	l.SetShowStatusBar(false)  // No status bar
 	l.SetShowPagination(false) // No pagination dots
 	l.SetFilteringEnabled(true)
+	l.Filter = newSessionFilterFunc(items)
 	l.SetShowHelp(false) // We'll render our own help
 
 	// Sync the bubbles list's internal filter key with our custom binding
 	l.KeyMap.Filter.SetKeys(keys.Navigation.Filter.Binding.Keys()...)
 
+	// Restore the filter applied when the TUI last ran
+	lastFilter, err := config.LoadLastFilter()
+	if err != nil {
+		logging.Logger.Warn("Failed to load last filter", "error", err)
+	}
+	filter := lastFilter
+	if defaultView != nil {
+		filter = defaultView.Filter
+	}
+	if filter != "" {
+		l.SetFilterText(filter)
+	}
+
 	// Show a tip immediately at startup if tips are enabled
 	var initialTip *Tip
-	allTips := GetTips()
-	if tipsConfig.Enabled && len(allTips) > 0 {
-		initialTip = &allTips[rand.Intn(len(allTips))]
+	tips := newTipRotation(tipsConfig)
+	if tipsConfig.Enabled {
+		initialTip = tips.Next(time.Now())
 	}
 
 	return &SessionList{
+		accessible:         accessible,
+		activityService:    activityService,
+		badgeService:       badgeService,
+		checkService:       checkService,
+		checkpointService:  checkpointService,
+		ciService:          ciService,
 		currentTip:         initialTip,
+		debugMetrics:       NewDebugMetrics(),
+		density:            density,
 		devMode:            devMode,
 		editor:             editor,
 		err:                err,
+		escalationService:  escalationService,
+		filterChipsShown:   filter != "",
 		gitService:         gitService,
+		hookJournalService: hookJournalService,
+		inlineEdit:         inlineEdit,
+		instanceService:    instanceService,
 		keys:               keys,
 		list:               l,
+		pendingUpdates:     make(map[string][]optimisticUpdate),
+		pruneService:       historyPruneService,
+		quickJump:          quickJump,
+		ruleService:        ruleService,
+		remoteFetchService: remoteFetchService,
+		savedFilter:        lastFilter,
+		schedulerService:   schedulerService,
 		sessionService:     sessionService,
 		sessionState:       sessionState,
+		shellService:       shellService,
+		sortIndex:          sortIndex,
+		sortPresets:        sortPresets,
 		statusConfig:       statusConfig,
+		ticketSyncService:  ticketSyncService,
+		timerService:       timerService,
 		timestampConfig:    timestampConfig,
 		timestampMode:      timestampMode,
+		tipRotation:        tips,
 		tipsConfig:         tipsConfig,
+		tokenBudgetService: tokenBudgetService,
+		tmuxCache:          tmuxCache,
 		tmuxStatusPosition: tmuxStatusPosition,
+		views:              viewConfig.Views,
+		worktreeGCService:  worktreeGCService,
 	}
 }
 
@@ -320,6 +660,8 @@ func (sl *SessionList) Init() tea.Cmd {
 
 // Update handles messages for the session list component
 func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	defer sl.syncFilterChips()
+
 	switch msg := msg.(type) {
 	case GitStatsReadyMsg:
 		// Git stats successfully fetched - convert to domain type
@@ -328,11 +670,16 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				info.GitStats = &domain.GitStats{
 					Additions:    msg.Stats.Additions,
 					Ahead:        msg.Stats.Ahead,
+					BaseAhead:    msg.Stats.BaseAhead,
+					BaseBehind:   msg.Stats.BaseBehind,
+					BaseRef:      msg.Stats.BaseRef,
+					BaseSyncedAt: msg.Stats.BaseSyncedAt,
 					Behind:       msg.Stats.Behind,
 					ChangedFiles: msg.Stats.ChangedFiles,
 					Deletions:    msg.Stats.Deletions,
 					Error:        msg.Stats.Error,
 					FetchedAt:    msg.Stats.FetchedAt,
+					Stashes:      msg.Stats.Stashes,
 				}
 			}
 			sl.sessionState.Sessions[msg.SessionName] = info
@@ -348,10 +695,10 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		// Rebuild items with updated stats
-		delegate := newSessionDelegate(sl.sessionState, sl.statusConfig, sl.timestampConfig, sl.timestampMode)
+		delegate := newSessionDelegate(sl.sessionState, sl.inlineEdit, sl.quickJump, sl.statusConfig, sl.timestampConfig, sl.timestampMode, sl.density, sl.accessible)
 		sl.list.SetDelegate(delegate)
-		items := buildListItems(sl.sessionState, sl.sessionService, sl.statusConfig)
-		cmd := sl.list.SetItems(items)
+		items := buildListItems(sl.sessionState, sl.tmuxCache, sl.statusConfig, sl.activeSort(), sl.workspace)
+		cmd := sl.setItems(items)
 
 		// Don't schedule new poll - one is already running
 		return sl, cmd
@@ -364,46 +711,295 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Don't schedule new poll - one is already running
 		return sl, nil
 
+	case ResourceUsageReadyMsg:
+		// Attach fresh samples; sessions without a running agent lose their previous sample
+		for name, info := range sl.sessionState.Sessions {
+			info.ResourceUsage = msg.Usage[name]
+			sl.sessionState.Sessions[name] = info
+		}
+		sl.samplingResources = false
+
+		// Skip list rebuild when user is actively filtering to prevent flickering
+		if sl.list.FilterState() == list.Filtering {
+			return sl, nil
+		}
+
+		delegate := newSessionDelegate(sl.sessionState, sl.inlineEdit, sl.quickJump, sl.statusConfig, sl.timestampConfig, sl.timestampMode, sl.density, sl.accessible)
+		sl.list.SetDelegate(delegate)
+		items := buildListItems(sl.sessionState, sl.tmuxCache, sl.statusConfig, sl.activeSort(), sl.workspace)
+		cmd := sl.setItems(items)
+
+		// Don't schedule new poll - one is already running
+		return sl, cmd
+
+	case AgentErrorsScannedMsg:
+		sl.scanningAgentErrs = false
+		changed := false
+		for _, name := range msg.Scanned {
+			info, exists := sl.sessionState.Sessions[name]
+			if !exists || (info.AgentError == nil) == (msg.Errors[name] == nil) {
+				continue
+			}
+			info.AgentError = msg.Errors[name]
+			sl.sessionState.Sessions[name] = info
+			changed = true
+		}
+
+		// Skip list rebuild when nothing changed or the user is actively filtering
+		if !changed || sl.list.FilterState() == list.Filtering {
+			return sl, nil
+		}
+
+		delegate := newSessionDelegate(sl.sessionState, sl.inlineEdit, sl.quickJump, sl.statusConfig, sl.timestampConfig, sl.timestampMode, sl.density, sl.accessible)
+		sl.list.SetDelegate(delegate)
+		items := buildListItems(sl.sessionState, sl.tmuxCache, sl.statusConfig, sl.activeSort(), sl.workspace)
+
+		// Don't schedule new poll - one is already running
+		return sl, sl.setItems(items)
+
+	case tokenBudgetsCheckedMsg:
+		sl.checkingBudgets = false
+		return sl, nil
+
+	case hookJournalDrainedMsg:
+		sl.drainingJournal = false
+		return sl, nil
+
+	case ticketSyncsDrainedMsg:
+		sl.syncingTickets = false
+		return sl, nil
+
+	case worktreesCollectedMsg:
+		sl.runningWorktreeGC = false
+		return sl, nil
+
+	case historyPrunedMsg:
+		sl.pruningHistory = false
+		return sl, nil
+
+	case orphanShellsCleanedMsg:
+		sl.cleaningShells = false
+		return sl, nil
+
+	case checkpointsSavedMsg:
+		sl.checkpointing = false
+		return sl, nil
+
+	case remotesFetchedMsg:
+		sl.fetchingRemotes = false
+		return sl, nil
+
+	case activityReadyMsg:
+		sl.fetchingActivity = false
+		if msg.Err != nil {
+			// Keep the last sparklines; they are rebuilt again on the next interval
+			logging.Logger.Warn("Failed to build activity sparklines", "error", msg.Err)
+			return sl, nil
+		}
+
+		for name, info := range sl.sessionState.Sessions {
+			info.Activity = msg.Activity[name]
+			sl.sessionState.Sessions[name] = info
+		}
+
+		// Skip list rebuild when user is actively filtering to prevent flickering
+		if sl.list.FilterState() == list.Filtering {
+			return sl, nil
+		}
+		return sl, sl.rebuildItems()
+
+	case checkRunsReadyMsg:
+		sl.readingChecks = false
+		for name, info := range sl.sessionState.Sessions {
+			info.Checks = msg.Runs[name]
+			sl.sessionState.Sessions[name] = info
+		}
+
+		// Skip list rebuild when user is actively filtering to prevent flickering
+		if sl.list.FilterState() == list.Filtering {
+			return sl, nil
+		}
+		return sl, sl.rebuildItems()
+
+	case badgesReadyMsg:
+		sl.fetchingBadges = false
+		if msg.Err != nil {
+			// Keep the last badges; show the first failure only, so a broken script does not nag
+			logging.Logger.Warn("Badge command failed", "error", msg.Err)
+			if sl.badgeErrShown {
+				return sl, nil
+			}
+			sl.badgeErrShown = true
+			return sl, func() tea.Msg { return msg.Err }
+		}
+		sl.badgeErrShown = false
+
+		for name, info := range sl.sessionState.Sessions {
+			info.Badges = msg.Badges[name]
+			sl.sessionState.Sessions[name] = info
+		}
+
+		// Skip list rebuild when user is actively filtering to prevent flickering
+		if sl.list.FilterState() == list.Filtering {
+			return sl, nil
+		}
+		return sl, sl.rebuildItems()
+
+	case ResourceUsageErrorMsg:
+		// Sampling failed (already logged) - try again on the next poll
+		sl.samplingResources = false
+		return sl, nil
+
+	case ScheduledPromptsSentMsg:
+		for _, prompt := range msg.Sent {
+			logging.Logger.Info("Delivered scheduled prompt", "id", prompt.ID, "session", prompt.SessionName)
+		}
+		sl.dispatchingPrompts = false
+
+		// Mark sessions with prompts held back by the concurrency limit
+		throttled := make(map[string]bool, len(msg.Throttled))
+		for _, name := range msg.Throttled {
+			throttled[name] = true
+		}
+		changed := false
+		for name, info := range sl.sessionState.Sessions {
+			if info.IsThrottled != throttled[name] {
+				info.IsThrottled = throttled[name]
+				sl.sessionState.Sessions[name] = info
+				changed = true
+			}
+		}
+
+		// Skip list rebuild when nothing changed or the user is actively filtering
+		if !changed || sl.list.FilterState() == list.Filtering {
+			return sl, nil
+		}
+
+		delegate := newSessionDelegate(sl.sessionState, sl.inlineEdit, sl.quickJump, sl.statusConfig, sl.timestampConfig, sl.timestampMode, sl.density, sl.accessible)
+		sl.list.SetDelegate(delegate)
+		items := buildListItems(sl.sessionState, sl.tmuxCache, sl.statusConfig, sl.activeSort(), sl.workspace)
+
+		// Don't schedule new poll - one is already running
+		return sl, sl.setItems(items)
+
+	case ScheduledPromptsErrorMsg:
+		// Dispatch failed (already logged) - try again on the next poll
+		sl.dispatchingPrompts = false
+		return sl, nil
+
 	case checkStateMsg:
 		// This message is sent by the poll timer every 2 seconds
 		// We schedule exactly ONE new poll at the end to maintain the loop
 
-		// Skip refresh when user is actively filtering to prevent flickering
-		if sl.list.FilterState() == list.Filtering {
+		// Skip refresh when user is actively filtering or editing a row to prevent flickering
+		// (a rebuild could also re-sort the row being edited away from the cursor)
+		if sl.list.FilterState() == list.Filtering || sl.inlineEdit.Active() {
 			// Still schedule next poll to maintain the loop
 			return sl, pollStateCmd()
 		}
 
 		// Auto-refresh: Check if state has changed (showArchived=false - TUI never shows archived)
+		pollStart := time.Now()
 		newState, err := sl.sessionService.LoadState(context.Background(), false)
+		sl.debugMetrics.RecordPoll(pollStart, time.Since(pollStart))
 		if err != nil {
 			// Continue polling even on error
 			return sl, pollStateCmd()
 		}
 
-		// Preserve GitStats cache from old state
+		// One list-sessions call per poll serves every existence check until the next one
+		sl.tmuxCache.Refresh()
+
+		// Preserve GitStats and resource usage cache from old state
 		for name, newInfo := range newState.Sessions {
 			if oldInfo, exists := sl.sessionState.Sessions[name]; exists {
+				newInfo.Activity = oldInfo.Activity
+				newInfo.AgentError = keptAgentError(oldInfo, newInfo)
+				newInfo.Badges = oldInfo.Badges
+				newInfo.Checks = oldInfo.Checks
 				newInfo.GitStats = oldInfo.GitStats
+				newInfo.IsThrottled = oldInfo.IsThrottled
+				newInfo.ResourceUsage = oldInfo.ResourceUsage
 				newState.Sessions[name] = newInfo
 			}
 		}
 
+		// Alerts and writes are left to the primary instance when another TUI runs them
+		primary := sl.isPrimary()
+
+		// Flag sessions waiting too long before building the rows that show it
+		escalationCmd := sl.requestEscalationAlerts(newState, primary)
+
+		var timerCmd, ciCmd, ruleCmd, budgetCmd tea.Cmd
+		if primary {
+			// Announce timers that elapsed since the last poll
+			timerCmd = sl.requestTimerAlerts(newState)
+
+			// Announce CI failures on the branches of idle sessions
+			ciCmd = sl.requestCIAlerts(newState)
+
+			// Apply workflow rules to the sessions that newly match them
+			ruleCmd = sl.requestRuleActions(newState)
+
+			// Refresh token usage of sessions with a budget, enforcing the ones exceeded
+			budgetCmd = sl.requestTokenBudgetCheck(newState)
+		}
+
+		sl.debugMetrics.RecordStateChanges(sl.sessionState, newState, time.Now())
 		sl.sessionState = newState
 
-		// Update delegate with new state
-		delegate := newSessionDelegate(newState, sl.statusConfig, sl.timestampConfig, sl.timestampMode)
-		sl.list.SetDelegate(delegate)
+		// The database may not have the changes still being written yet
+		sl.applyPendingUpdates(newState)
 
-		// Rebuild items
-		items := buildListItems(newState, sl.sessionService, sl.statusConfig)
-		cmd := sl.list.SetItems(items)
+		cmd := sl.rebuildItems()
 
 		// Request git stats for visible sessions
 		gitStatsCmd := sl.requestGitStatsForVisible()
 
+		// Sample agent CPU/memory for running sessions
+		resourceCmd := sl.requestResourceUsage()
+
+		// Look for usage limit and authentication errors in sessions stuck working
+		agentErrorCmd := sl.requestAgentErrorScan()
+
+		// Run the badge command when its interval elapsed
+		badgeCmd := sl.requestBadges()
+
+		// Rebuild the activity sparklines when their interval elapsed
+		activityCmd := sl.requestActivity()
+
+		// Pick up checks that started or finished since the last poll
+		checksCmd := sl.requestCheckRuns()
+
+		var promptCmd, journalCmd, ticketSyncCmd, worktreeGCCmd, pruneCmd, fetchCmd, shellCleanupCmd, checkpointCmd tea.Cmd
+		if primary {
+			// Deliver scheduled prompts that are due
+			promptCmd = sl.requestPromptDispatch()
+
+			// Apply hook events left in the journal while the database was busy
+			journalCmd = sl.requestHookJournalDrain()
+
+			// Sync the tickets of status changes queued since the last poll
+			ticketSyncCmd = sl.requestTicketSyncDrain()
+
+			// Remove worktrees of sessions archived longer than the retention
+			worktreeGCCmd = sl.requestWorktreeGC()
+
+			// Discard old history, which hooks only ever add to
+			pruneCmd = sl.requestHistoryPrune()
+
+			// Fetch the repositories of sessions when the background fetch interval elapsed
+			fetchCmd = sl.requestRemoteFetch()
+
+			// Kill shells left running by killed sessions, and forget the ones gone, when turned on
+			shellCleanupCmd = sl.requestOrphanShellCleanup()
+
+			// Save the worktrees of sessions whose checkpoint is due
+			checkpointCmd = sl.requestCheckpoints(newState)
+		}
+
 		// Schedule next poll to maintain the 2-second loop (exactly one poll)
-		return sl, tea.Batch(cmd, pollStateCmd(), gitStatsCmd)
+		return sl, tea.Batch(cmd, pollStateCmd(), gitStatsCmd, resourceCmd, agentErrorCmd, badgeCmd, activityCmd, checksCmd, promptCmd, escalationCmd, timerCmd, ciCmd, ruleCmd, budgetCmd, journalCmd, ticketSyncCmd, worktreeGCCmd, pruneCmd, fetchCmd, shellCleanupCmd, checkpointCmd)
 
 	case showTipMsg:
 		// Don't show tip if there's an error - reschedule for later
@@ -412,10 +1008,9 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return showTipMsg{}
 			})
 		}
-		// Time to show a new random tip
-		allTips := GetTips()
-		if len(allTips) > 0 {
-			sl.currentTip = &allTips[rand.Intn(len(allTips))]
+		// Time to show the next tip
+		if tip := sl.tipRotation.Next(time.Now()); tip != nil {
+			sl.currentTip = tip
 			return sl, tea.Tick(time.Duration(sl.tipsConfig.DisplayDurationSeconds)*time.Second, func(time.Time) tea.Msg {
 				return hideTipMsg{}
 			})
@@ -447,6 +1042,16 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Each time you add something here, don't forget to add it to the help screen
 	case tea.KeyMsg:
+		// Guard clause: While editing a row inline, keys go to the text input
+		if sl.inlineEdit.Active() {
+			return sl, sl.updateInlineEdit(msg)
+		}
+
+		// Guard clause: While quick jump hints are shown, keys pick a hint
+		if sl.quickJump.Active() {
+			return sl, sl.updateQuickJump(msg)
+		}
+
 		// Guard clause: When actively filtering, bypass shortcuts to allow typing
 		if sl.list.FilterState() == list.Filtering {
 			// ESC is the only key we handle specially during filtering
@@ -479,12 +1084,27 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, sl.keys.Application.Help.Binding):
 			return sl, func() tea.Msg { return ShowHelpMsg{} }
 
+		case key.Matches(msg, sl.keys.Application.DebugScreen.Binding):
+			return sl, func() tea.Msg { return ShowDebugScreenMsg{} }
+
 		case key.Matches(msg, sl.keys.Application.CommandPalette.Binding):
 			return sl, func() tea.Msg { return ShowCommandPaletteMsg{} }
 
+		case key.Matches(msg, sl.keys.Application.OpenSettings.Binding):
+			return sl, func() tea.Msg { return OpenSettingsMsg{} }
+
+		case key.Matches(msg, sl.keys.Application.SwitchProfile.Binding):
+			return sl, func() tea.Msg { return SwitchProfileMsg{} }
+
+		case key.Matches(msg, sl.keys.Application.CleanOrphanShells.Binding):
+			return sl, func() tea.Msg { return CleanOrphanShellsMsg{} }
+
 		case key.Matches(msg, sl.keys.SessionManagement.New.Binding):
 			return sl, func() tea.Msg { return NewSessionMsg{} }
 
+		case key.Matches(msg, sl.keys.SessionManagement.NewFromClip.Binding):
+			return sl, func() tea.Msg { return NewSessionFromClipboardMsg{} }
+
 		case key.Matches(msg, sl.keys.SessionManagement.NewFromRepo.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
 				return sl, func() tea.Msg { return NewSessionFromTemplateMsg{TemplateSessionName: item.Session.Name} }
@@ -492,12 +1112,8 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case key.Matches(msg, sl.keys.SessionActions.Open.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				// Ensure session exists
-				if !sl.ensureSessionExists(item.Session) {
-					// Don't schedule new poll - one is already running
-					return sl, nil
-				}
-				return sl, func() tea.Msg { return AttachSessionMsg{Session: item.Session} }
+				// Don't schedule new poll - one is already running
+				return sl, sl.attachOrRestart(item.Session, false)
 			}
 
 		case key.Matches(msg, sl.keys.SessionManagement.Kill.Binding):
@@ -505,94 +1121,248 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return sl, func() tea.Msg { return KillSessionMsg{SessionName: item.Session.Name} }
 			}
 
+		case key.Matches(msg, sl.keys.SessionManagement.KillProcess.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return KillProcessSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionManagement.Move.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return MoveSessionsMsg{SessionName: item.Session.Name} }
+			}
+
 		case key.Matches(msg, sl.keys.SessionManagement.Rename.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return RenameSessionMsg{SessionName: item.Session.Name} }
+				return sl, sl.inlineEdit.Start(InlineEditRename, item.Session.Name, item.DisplayName, sl.inlineEditWidth())
 			}
 
 		case key.Matches(msg, sl.keys.SessionMetadata.Comment.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return CommentSessionMsg{SessionName: item.Session.Name} }
+				// A single-line input can't hold multi-line comments: edit those in the dialog
+				if strings.Contains(item.Comment, "\n") {
+					return sl, func() tea.Msg { return CommentSessionMsg{SessionName: item.Session.Name} }
+				}
+				return sl, sl.inlineEdit.Start(InlineEditComment, item.Session.Name, item.Comment, sl.inlineEditWidth())
 			}
 
-		case key.Matches(msg, sl.keys.SessionMetadata.SendText.Binding):
+		case key.Matches(msg, sl.keys.SessionMetadata.Handoff.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return SendTextSessionMsg{SessionName: item.Session.Name} }
+				return sl, sl.inlineEdit.Start(InlineEditHandoff, item.Session.Name, "", sl.inlineEditWidth())
 			}
 
-		case key.Matches(msg, sl.keys.SessionActions.OpenEditor.Binding):
+		case key.Matches(msg, sl.keys.SessionMetadata.Note.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return OpenEditorSessionMsg{SessionName: item.Session.Name} }
+				return sl, func() tea.Msg { return NoteSessionMsg{SessionName: item.Session.Name} }
 			}
 
-		case key.Matches(msg, sl.keys.SessionActions.OpenPR.Binding):
+		case key.Matches(msg, sl.keys.SessionMetadata.SummarizeDiff.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return OpenPRMsg{SessionName: item.Session.Name} }
+				return sl, func() tea.Msg { return SummarizeDiffSessionMsg{SessionName: item.Session.Name} }
 			}
 
-		case key.Matches(msg, sl.keys.SessionMetadata.Flag.Binding):
+		case key.Matches(msg, sl.keys.SessionMetadata.Tags.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return ToggleFlagSessionMsg{SessionName: item.Session.Name} }
+				return sl, func() tea.Msg { return TagsSessionMsg{SessionName: item.Session.Name} }
 			}
 
-		case key.Matches(msg, sl.keys.SessionManagement.Archive.Binding):
+		case key.Matches(msg, sl.keys.SessionMetadata.Attachments.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return ArchiveSessionMsg{SessionName: item.Session.Name} }
+				return sl, func() tea.Msg { return AttachmentsSessionMsg{SessionName: item.Session.Name} }
 			}
 
-		case key.Matches(msg, sl.keys.SessionMetadata.StatusCycle.Binding):
-			// s: Cycle through statuses (default/quick action)
+		case key.Matches(msg, sl.keys.SessionMetadata.Timer.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, sl.cycleSessionStatus(item.Session.Name)
+				return sl, func() tea.Msg { return TimerSessionMsg{SessionName: item.Session.Name} }
 			}
 
-		case key.Matches(msg, sl.keys.SessionMetadata.StatusSetForm.Binding):
-			// Shift+S: Open status form (edit action)
+		case key.Matches(msg, sl.keys.SessionActions.RunChecks.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				return sl, func() tea.Msg { return SetStatusSessionMsg{SessionName: item.Session.Name} }
+				return sl, func() tea.Msg { return RunChecksSessionMsg{SessionName: item.Session.Name} }
 			}
 
-		case key.Matches(msg, sl.keys.Navigation.MoveUp.Binding):
-			return sl, sl.moveSelectedUp()
+		case key.Matches(msg, sl.keys.SessionActions.CheckOutput.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return CheckOutputSessionMsg{SessionName: item.Session.Name} }
+			}
 
-		case key.Matches(msg, sl.keys.Navigation.MoveDown.Binding):
-			return sl, sl.moveSelectedDown()
+		case key.Matches(msg, sl.keys.SessionActions.ToolAudit.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return ToolAuditSessionMsg{SessionName: item.Session.Name} }
+			}
 
-		case key.Matches(msg, sl.keys.SessionActions.QuickOpen.Binding):
-			// Quick attach to session by number
-			numStr := msg.String()
-			num := int(numStr[0] - '0')
-			index := num - 1
+		case key.Matches(msg, sl.keys.SessionMetadata.SendText.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return SendTextSessionMsg{SessionName: item.Session.Name} }
+			}
 
-			items := sl.list.VisibleItems()
-			if index >= 0 && index < len(items) {
-				if item, ok := items[index].(SessionItem); ok {
-					// Update list's internal selection state
-					sl.list.Select(index)
+		case key.Matches(msg, sl.keys.SessionActions.OpenEditor.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return OpenEditorSessionMsg{SessionName: item.Session.Name} }
+			}
 
-					if !sl.ensureSessionExists(item.Session) {
-						// Don't schedule new poll - one is already running
-						return sl, nil
-					}
-					return sl, func() tea.Msg { return AttachSessionMsg{Session: item.Session} }
-				}
+		case key.Matches(msg, sl.keys.SessionActions.OpenChangedFiles.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return OpenEditorSessionMsg{ChangedFiles: true, SessionName: item.Session.Name} }
 			}
 
-		case key.Matches(msg, sl.keys.SessionActions.OpenShell.Binding):
+		case key.Matches(msg, sl.keys.SessionActions.OpenPR.Binding):
 			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
-				// Ensure session exists
-				if !sl.ensureSessionExists(item.Session) {
-					// Don't schedule new poll - one is already running
-					return sl, nil
-				}
-				return sl, func() tea.Msg { return AttachShellSessionMsg{Session: item.Session} }
+				return sl, func() tea.Msg { return OpenPRMsg{SessionName: item.Session.Name} }
 			}
 
-		case msg.String() == "alt+e":
-			// Hidden test command: Request Model to generate test error
-			return sl, func() tea.Msg { return TestErrorMsg{} }
+		case key.Matches(msg, sl.keys.SessionActions.CopyBranch.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return CopySessionInfoMsg{Field: services.CopyBranch, SessionName: item.Session.Name} }
+			}
 
-		case key.Matches(msg, sl.keys.Navigation.ClearFilter.Binding):
+		case key.Matches(msg, sl.keys.SessionActions.CopyPath.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return CopySessionInfoMsg{Field: services.CopyPath, SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.CopyPRURL.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return CopySessionInfoMsg{Field: services.CopyPRURL, SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.CopySummary.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return CopySessionInfoMsg{Field: services.CopySummary, SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.FetchBase.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return FetchBaseSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.Rebase.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return RebaseSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.Pause.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return TogglePauseSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.Stash.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return StashSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.Unstash.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return UnstashSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.SwitchBranch.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return SwitchBranchSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionMetadata.Flag.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return ToggleFlagSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionManagement.Archive.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return ArchiveSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.SessionMetadata.StatusCycle.Binding):
+			// s: Cycle through statuses (default/quick action)
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, sl.cycleSessionStatus(item.Session.Name)
+			}
+
+		case key.Matches(msg, sl.keys.SessionMetadata.PriorityCycle.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, sl.cycleSessionPriority(item.Session.Name)
+			}
+
+		case key.Matches(msg, sl.keys.SessionMetadata.StatusSetForm.Binding):
+			// Shift+S: Open status form (edit action)
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				return sl, func() tea.Msg { return SetStatusSessionMsg{SessionName: item.Session.Name} }
+			}
+
+		case key.Matches(msg, sl.keys.Navigation.MoveUp.Binding, sl.keys.Navigation.MoveDown.Binding) && sl.sortIndex >= 0:
+			sortKey := sl.keys.Navigation.CycleSort.Binding.Help().Key
+			return sl, func() tea.Msg {
+				return fmt.Errorf("list is sorted by '%s': press %s until manual order to reorder sessions", sl.sortPresets[sl.sortIndex].Name, sortKey)
+			}
+
+		case key.Matches(msg, sl.keys.Navigation.MoveUp.Binding):
+			return sl, sl.moveSelectedUp()
+
+		case key.Matches(msg, sl.keys.Navigation.MoveDown.Binding):
+			return sl, sl.moveSelectedDown()
+
+		case key.Matches(msg, sl.keys.Navigation.CycleSort.Binding):
+			return sl, sl.cycleSort()
+
+		case key.Matches(msg, sl.keys.Navigation.CycleDensity.Binding):
+			return sl, sl.cycleDensity()
+
+		case key.Matches(msg, sl.keys.Navigation.SwitchWorkspace.Binding):
+			return sl, func() tea.Msg { return SwitchWorkspaceMsg{} }
+
+		case key.Matches(msg, sl.keys.Navigation.Views.Binding):
+			return sl, func() tea.Msg { return ShowViewsMsg{} }
+
+		case key.Matches(msg, sl.keys.Navigation.ApplyView.Binding):
+			// The nth key of the binding applies the nth view
+			index := slices.Index(sl.keys.Navigation.ApplyView.Binding.Keys(), msg.String())
+			if index >= len(sl.views) {
+				return sl, func() tea.Msg {
+					return fmt.Errorf("no view number %d: add views to settings.json or save one from the views dialog", index+1)
+				}
+			}
+			return sl, sl.ApplyView(index)
+
+		case key.Matches(msg, sl.keys.SessionActions.QuickOpen.Binding):
+			// Quick attach to session by number
+			numStr := msg.String()
+			num := int(numStr[0] - '0')
+			index := num - 1
+
+			items := sl.list.VisibleItems()
+			if index >= 0 && index < len(items) {
+				if item, ok := items[index].(SessionItem); ok {
+					// Update list's internal selection state
+					sl.list.Select(index)
+
+					// Don't schedule new poll - one is already running
+					return sl, sl.attachOrRestart(item.Session, false)
+				}
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.QuickJump.Binding):
+			// Show hints over the row numbers; typing one attaches like quick open
+			if len(sl.list.VisibleItems()) > 0 {
+				sl.quickJump.Start(len(sl.list.VisibleItems()))
+			}
+
+		case key.Matches(msg, sl.keys.SessionActions.OpenShell.Binding):
+			if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+				// Don't schedule new poll - one is already running
+				return sl, sl.attachOrRestart(item.Session, true)
+			}
+
+		case msg.String() == "alt+e":
+			// Hidden test command: Request Model to generate test error
+			return sl, func() tea.Msg { return TestErrorMsg{} }
+
+		case key.Matches(msg, sl.keys.Navigation.RemoveFilterChip.Binding) && sl.list.IsFiltered():
+			if query := withoutLastFilterChip(sl.list.FilterValue()); query != "" {
+				sl.list.SetFilterText(query)
+			} else {
+				sl.list.ResetFilter()
+			}
+			return sl, nil
+
+		case key.Matches(msg, sl.keys.Navigation.ClearFilter.Binding):
 			// Handle double-ESC for filter clearing (only when filtering)
 			if sl.list.FilterState() != list.Unfiltered {
 				now := time.Now()
@@ -623,15 +1393,19 @@ func (sl *SessionList) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.WindowSizeMsg:
-		// Store dimensions - actual sizing is done by Model via SetSize()
-		sl.width = msg.Width
-		sl.height = msg.Height
+		// Nothing to store - sizing is done by Model via SetSize(), which
+		// leaves room for the detail pane when it is shown
 	}
 
 	// Delegate to list for normal handling
 	var cmd tea.Cmd
 	sl.list, cmd = sl.list.Update(msg)
 
+	// Keep the inline edit cursor blinking
+	if sl.inlineEdit.Active() {
+		cmd = tea.Batch(cmd, sl.inlineEdit.Update(msg))
+	}
+
 	// IMPORTANT: Don't schedule new polls here!
 	// The poll loop is maintained by checkStateMsg scheduling exactly one new poll.
 	// Scheduling polls here would cause exponential accumulation.
@@ -646,18 +1420,41 @@ func (sl *SessionList) View() string {
 	s += renderHeader(sl.devMode, "", "")
 
 	// Legend + Shortcuts (moved to top, below header)
-	helpText := sl.renderStatusLegend() + "  " + theme.HelpShortcutStyle.Render("?") + theme.HelpLabelStyle.Render(" shortcuts")
+	helpText := sl.renderStatusLegend() + "  " + theme.HelpShortcutStyle.Render("?") + theme.HelpLabelStyle.Render(" "+i18n.T("list.shortcuts"))
+	if sl.sortIndex >= 0 {
+		helpText += "  " + theme.HelpLabelStyle.Render(i18n.Tf("list.sort", sl.sortPresets[sl.sortIndex].Name))
+	}
+	if view := sl.CurrentView(); view != "" {
+		helpText += "  " + theme.HelpLabelStyle.Render(i18n.Tf("list.view", view))
+	}
+	if sl.workspace != "" {
+		helpText += "  " + theme.HelpLabelStyle.Render(i18n.Tf("list.workspace", sl.workspace))
+	}
+	if sl.instanceService != nil && !sl.instanceService.IsPrimary() {
+		if holder := sl.instanceService.Holder(); holder != nil {
+			helpText += "  " + theme.HelpLabelStyle.Render(i18n.Tf("list.following", holder.PID))
+		}
+	}
 
 	// Add first-session hint when there's exactly 1 session (highlighted for first-timers)
 	if len(sl.list.Items()) == 1 {
-		helpText += "  " + theme.HintKeyStyle.Render(sl.keys.SessionActions.Open.Binding.Help().Key) + theme.HintLabelStyle.Render(" open Claude ") +
-			theme.HintKeyStyle.Render(sl.keys.SessionActions.Detach.Binding.Help().Key) + theme.HintLabelStyle.Render(" return here")
+		helpText += "  " + theme.HintKeyStyle.Render(sl.keys.SessionActions.Open.Binding.Help().Key) + theme.HintLabelStyle.Render(" "+i18n.T("list.hint_open")+" ") +
+			theme.HintKeyStyle.Render(sl.keys.SessionActions.Detach.Binding.Help().Key) + theme.HintLabelStyle.Render(" "+i18n.T("list.hint_return"))
 	}
 
 	s += theme.HelpStyle.Render(helpText) + "\n"
 
+	// Chips of the applied filter (the list is one line shorter while they show)
+	if sl.filterChipsShown {
+		removeKey := sl.keys.Navigation.RemoveFilterChip.Binding.Help().Key
+		s += renderFilterChips(sl.list.FilterValue(), removeKey, sl.width, sl.accessible) + "\n"
+	}
+
 	// Session List
-	if len(sl.list.Items()) == 0 {
+	if len(sl.list.Items()) == 0 && sl.workspace != "" {
+		workspaceKey := sl.keys.Navigation.SwitchWorkspace.Binding.Help().Key
+		s += theme.HelpLabelStyle.Render("No sessions in workspace "+sl.workspace+". Press ") + theme.HelpShortcutStyle.Render(workspaceKey) + theme.HelpLabelStyle.Render(" to switch workspace.") + "\n"
+	} else if len(sl.list.Items()) == 0 {
 		s += theme.HelpLabelStyle.Render("No sessions. Press ") + theme.HelpShortcutStyle.Render("n") + theme.HelpLabelStyle.Render(" to create a session.") + "\n"
 	} else {
 		s += sl.list.View()
@@ -700,7 +1497,40 @@ func (sl *SessionList) SetSize(width, height, listHeight int) {
 	sl.width = width
 	sl.height = height
 	sl.listHeight = listHeight
-	sl.list.SetSize(width, listHeight)
+	sl.list.SetSize(width, sl.listComponentHeight())
+}
+
+// listComponentHeight returns the height of the list component, leaving a line for the filter chips
+func (sl *SessionList) listComponentHeight() int {
+	if sl.filterChipsShown && sl.listHeight > 1 {
+		return sl.listHeight - 1
+	}
+	return sl.listHeight
+}
+
+// syncFilterChips shows the chips while a filter is applied and remembers the filter for
+// the next start. A filter being typed is neither shown as chips nor saved.
+func (sl *SessionList) syncFilterChips() {
+	shown := sl.list.IsFiltered() && sl.list.FilterValue() != ""
+	if shown != sl.filterChipsShown {
+		sl.filterChipsShown = shown
+		sl.list.SetHeight(sl.listComponentHeight())
+	}
+
+	if sl.list.SettingFilter() {
+		return
+	}
+	filter := ""
+	if shown {
+		filter = sl.list.FilterValue()
+	}
+	if filter == sl.savedFilter {
+		return
+	}
+	sl.savedFilter = filter
+	if err := config.SaveLastFilter(filter); err != nil {
+		logging.Logger.Warn("Failed to save last filter", "error", err)
+	}
 }
 
 // RefreshFromState reloads the session list from state.
@@ -713,39 +1543,347 @@ func (sl *SessionList) RefreshFromState() tea.Cmd {
 		return nil
 	}
 
-	// Preserve GitStats cache from old state
+	// Preserve GitStats and resource usage cache from old state
 	for name, newInfo := range sessionState.Sessions {
 		if oldInfo, exists := sl.sessionState.Sessions[name]; exists {
+			newInfo.Activity = oldInfo.Activity
+			newInfo.AgentError = keptAgentError(oldInfo, newInfo)
+			newInfo.Badges = oldInfo.Badges
+			newInfo.Checks = oldInfo.Checks
 			newInfo.GitStats = oldInfo.GitStats
+			newInfo.IsEscalated = oldInfo.IsEscalated // Re-evaluated on the next poll
+			newInfo.IsThrottled = oldInfo.IsThrottled
+			newInfo.ResourceUsage = oldInfo.ResourceUsage
 			sessionState.Sessions[name] = newInfo
 		}
 	}
 
 	sl.sessionState = sessionState
+	sl.applyPendingUpdates(sessionState)
+
+	// Drop an inline edit whose session is gone (e.g., killed from the palette)
+	if sl.inlineEdit.Active() {
+		if _, exists := sessionState.Sessions[sl.inlineEdit.sessionName]; !exists {
+			sl.inlineEdit.Stop()
+		}
+	}
+
+	// Refreshes follow user actions that may have created, renamed, or killed tmux sessions
+	sl.tmuxCache.Invalidate()
+
+	return sl.rebuildItems()
+}
+
+// rebuildItems renders the rows again from the session state in memory, without loading it
+// Returns the command from SetItems for pagination updates.
+func (sl *SessionList) rebuildItems() tea.Cmd {
+	delegate := newSessionDelegate(sl.sessionState, sl.inlineEdit, sl.quickJump, sl.statusConfig, sl.timestampConfig, sl.timestampMode, sl.density, sl.accessible)
+	sl.list.SetDelegate(delegate)
+
+	items := buildListItems(sl.sessionState, sl.tmuxCache, sl.statusConfig, sl.activeSort(), sl.workspace)
+	return sl.setItems(items)
+}
+
+// updateInlineEdit handles keys while a row is edited inline:
+// Enter commits, Escape or Ctrl+C cancels, anything else is typed into the input
+func (sl *SessionList) updateInlineEdit(msg tea.KeyMsg) tea.Cmd {
+	switch msg.String() {
+	case "enter":
+		editMsg := sl.inlineEdit.Commit()
+		return func() tea.Msg { return editMsg }
+	case "esc", "ctrl+c":
+		sl.inlineEdit.Stop()
+		return nil
+	}
+	return sl.inlineEdit.Update(msg)
+}
+
+// inlineEditWidth returns the input width that fits a row after the "> 01. ● " prefix
+func (sl *SessionList) inlineEditWidth() int {
+	return max(sl.width-12, 20)
+}
+
+// updateQuickJump handles keys while quick jump hints are shown: hint characters narrow the
+// hints down and attach once a hint is complete; any other key hides them
+func (sl *SessionList) updateQuickJump(msg tea.KeyMsg) tea.Cmd {
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 {
+		sl.quickJump.Stop()
+		return nil
+	}
+
+	index := sl.quickJump.Type(msg.Runes[0])
+	items := sl.list.VisibleItems()
+	if index < 0 || index >= len(items) {
+		return nil
+	}
+	item, ok := items[index].(SessionItem)
+	if !ok {
+		return nil
+	}
+	sl.list.Select(index)
+	return sl.attachOrRestart(item.Session, false)
+}
+
+// activeSort returns the sort of the active preset (empty for manual order)
+func (sl *SessionList) activeSort() domain.SessionSort {
+	if sl.sortIndex < 0 {
+		return domain.SessionSort{}
+	}
+	return sl.sortPresets[sl.sortIndex].Sort
+}
+
+// cycleSort switches to the next sort preset, going back to manual order after the last one.
+// The selected session stays selected.
+func (sl *SessionList) cycleSort() tea.Cmd {
+	if len(sl.sortPresets) == 0 {
+		return func() tea.Msg {
+			return fmt.Errorf("no sort presets configured: add sort_presets to settings.json")
+		}
+	}
+
+	sl.sortIndex++
+	if sl.sortIndex >= len(sl.sortPresets) {
+		sl.sortIndex = -1
+	}
+	return sl.rebuildKeepingSelection()
+}
+
+// sortPresetIndex returns the index of the named preset, or -1 (manual order) for "" or
+// an unknown name
+func sortPresetIndex(presets []domain.SortPreset, name string) int {
+	if name == "" {
+		return -1
+	}
+	return slices.IndexFunc(presets, func(preset domain.SortPreset) bool { return preset.Name == name })
+}
+
+// Views returns the named views of the session list
+func (sl *SessionList) Views() []domain.View {
+	return sl.views
+}
+
+// ApplyView sets the filter and sort preset of a view. The selected session stays
+// selected if the view lists it.
+func (sl *SessionList) ApplyView(index int) tea.Cmd {
+	if index < 0 || index >= len(sl.views) {
+		return nil
+	}
+	view := sl.views[index]
+
+	sl.sortIndex = sortPresetIndex(sl.sortPresets, view.SortPreset)
+	cmd := sl.rebuildKeepingSelection()
+	if view.Filter != "" {
+		sl.list.SetFilterText(view.Filter)
+	} else {
+		sl.list.ResetFilter()
+	}
+	sl.syncFilterChips()
+	return cmd
+}
+
+// CurrentView returns the name of the view whose filter and sort are applied, or ""
+func (sl *SessionList) CurrentView() string {
+	filter := ""
+	if sl.list.IsFiltered() {
+		filter = sl.list.FilterValue()
+	}
+	preset := ""
+	if sl.sortIndex >= 0 {
+		preset = sl.sortPresets[sl.sortIndex].Name
+	}
+	for _, view := range sl.views {
+		if view.Filter == filter && view.SortPreset == preset {
+			return view.Name
+		}
+	}
+	return ""
+}
+
+// SaveView saves the applied filter and sort preset as a view, replacing the view
+// of the same name
+func (sl *SessionList) SaveView(name string) error {
+	view := domain.View{Name: strings.TrimSpace(name)}
+	if sl.list.IsFiltered() {
+		view.Filter = sl.list.FilterValue()
+	}
+	if sl.sortIndex >= 0 {
+		view.SortPreset = sl.sortPresets[sl.sortIndex].Name
+	}
+	if view.Name == "" {
+		return fmt.Errorf("%w: view name is required", domain.ErrInvalidInput)
+	}
 
-	// Update delegate
-	delegate := newSessionDelegate(sessionState, sl.statusConfig, sl.timestampConfig, sl.timestampMode)
+	if err := config.SaveView(config.ViewSettings{Filter: view.Filter, Name: view.Name, SortPreset: view.SortPreset}); err != nil {
+		return fmt.Errorf("failed to save view '%s': %w", view.Name, err)
+	}
+	if i := domain.FindView(sl.views, view.Name); i >= 0 {
+		sl.views[i] = view
+	} else {
+		sl.views = append(sl.views, view)
+	}
+	logging.Logger.Info("View saved", "name", view.Name, "filter", view.Filter, "sort_preset", view.SortPreset)
+	return nil
+}
+
+// cycleDensity switches to the next list density and remembers it for the next run
+func (sl *SessionList) cycleDensity() tea.Cmd {
+	sl.density = sl.density.Next()
+	if err := config.SaveListDensity(string(sl.density)); err != nil {
+		logging.Logger.Warn("Failed to save list density", "error", err)
+	}
+
+	delegate := newSessionDelegate(sl.sessionState, sl.inlineEdit, sl.quickJump, sl.statusConfig, sl.timestampConfig, sl.timestampMode, sl.density, sl.accessible)
 	sl.list.SetDelegate(delegate)
+	return nil
+}
+
+// SetWorkspace lists only the sessions of a workspace ("" lists all sessions).
+// The selected session stays selected if it is still listed.
+func (sl *SessionList) SetWorkspace(workspace string) tea.Cmd {
+	sl.workspace = workspace
+	return sl.rebuildKeepingSelection()
+}
+
+// Workspace returns the workspace whose sessions are listed ("" = all)
+func (sl *SessionList) Workspace() string {
+	return sl.workspace
+}
+
+// rebuildKeepingSelection rebuilds the list items, keeping the selected session selected
+func (sl *SessionList) rebuildKeepingSelection() tea.Cmd {
+	var selected string
+	if item, ok := sl.list.SelectedItem().(SessionItem); ok {
+		selected = item.Session.Name
+	}
+
+	cmd := sl.setItems(buildListItems(sl.sessionState, sl.tmuxCache, sl.statusConfig, sl.activeSort(), sl.workspace))
+	for i, it := range sl.list.Items() {
+		if item, ok := it.(SessionItem); ok && item.Session.Name == selected {
+			sl.list.Select(i)
+			break
+		}
+	}
+	return cmd
+}
+
+// StartHandoff selects the named session and asks, inline on its row, where the user left off
+// Returns nil when the session is not listed or another inline edit is in progress.
+func (sl *SessionList) StartHandoff(name string) tea.Cmd {
+	if sl.inlineEdit.Active() || !sl.SelectSession(name) {
+		return nil
+	}
+	return sl.inlineEdit.Start(InlineEditHandoff, name, "", sl.inlineEditWidth())
+}
 
-	// Rebuild items - return the command from SetItems for pagination updates
-	items := buildListItems(sessionState, sl.sessionService, sl.statusConfig)
+// SelectSession moves the cursor to the named session; it reports false when the session is not listed
+func (sl *SessionList) SelectSession(name string) bool {
+	for i, it := range sl.list.Items() {
+		if item, ok := it.(SessionItem); ok && item.Session.Name == name {
+			sl.list.Select(i)
+			return true
+		}
+	}
+	return false
+}
+
+// setItems replaces the list items together with the filter that indexes them
+func (sl *SessionList) setItems(items []list.Item) tea.Cmd {
+	sl.list.Filter = newSessionFilterFunc(items)
 	return sl.list.SetItems(items)
 }
 
-// pollStateCmd returns a command that waits 2 seconds then sends checkStateMsg
+// newSessionFilterFunc returns a list filter that understands the same tokens as
+// `rocha sessions list` (state:, status:, tag:, repo:, older:, flagged) and fuzzy-matches
+// the remaining text against name and branch.
+// items must be the slice given to the list so target indexes line up.
+func newSessionFilterFunc(items []list.Item) list.FilterFunc {
+	return func(term string, targets []string) []list.Rank {
+		filter, text, err := domain.ParseSessionFilter(term)
+		if err != nil {
+			return nil
+		}
+		if filter.IsEmpty() {
+			return list.DefaultFilter(text, targets)
+		}
+
+		now := time.Now()
+		var indexes []int
+		var matched []string
+		for i, target := range targets {
+			if i >= len(items) {
+				break
+			}
+			item, ok := items[i].(SessionItem)
+			if !ok || !filter.Matches(item.filterSession(), now) {
+				continue
+			}
+			indexes = append(indexes, i)
+			matched = append(matched, target)
+		}
+
+		if text == "" {
+			ranks := make([]list.Rank, len(indexes))
+			for i, index := range indexes {
+				ranks[i] = list.Rank{Index: index}
+			}
+			return ranks
+		}
+
+		ranks := list.DefaultFilter(text, matched)
+		for i := range ranks {
+			ranks[i].Index = indexes[ranks[i].Index]
+		}
+		return ranks
+	}
+}
+
+// tagColor picks a stable chip color for a tag, so a tag looks the same on every session
+func tagColor(tag string) theme.Color {
+	h := fnv.New32a()
+	h.Write([]byte(tag))
+	return theme.ColorTagPalette[h.Sum32()%uint32(len(theme.ColorTagPalette))]
+}
+
+// statePollInterval is how often the session list reloads state from the database
+const statePollInterval = 2 * time.Second
+
+// hookJournalDrainTimeout bounds how long a drain waits for another process draining the hook journal
+const hookJournalDrainTimeout = 5 * time.Second
+
+// ticketSyncDrainTimeout bounds how long a drain waits on the trackers of queued ticket syncs
+const ticketSyncDrainTimeout = time.Minute
+
+// agentErrorScanInterval is how often the panes of possibly stuck sessions are scanned for agent errors
+const agentErrorScanInterval = 15 * time.Second
+
+// agentErrorStaleAfter is how long a working session goes without updates before its pane is scanned
+const agentErrorStaleAfter = 30 * time.Second
+
+// activityRefreshInterval is how often the activity sparklines of the session list are rebuilt
+const activityRefreshInterval = time.Minute
+
+// tokenBudgetCheckInterval is how often token usage is read for sessions with a budget
+const tokenBudgetCheckInterval = 30 * time.Second
+
+// pollStateCmd returns a command that waits statePollInterval then sends checkStateMsg
 func pollStateCmd() tea.Cmd {
-	return tea.Tick(2*time.Second, func(time.Time) tea.Msg {
+	return tea.Tick(statePollInterval, func(time.Time) tea.Msg {
 		return checkStateMsg{}
 	})
 }
 
 // buildListItems converts SessionCollection to list items
-func buildListItems(sessionState *domain.SessionCollection, sessionService *services.SessionService, statusConfig *config.StatusConfig) []list.Item {
+// sessionSort is applied on top of the manual order (empty keeps the manual order)
+// and workspace limits the items to its sessions (empty lists every session)
+func buildListItems(sessionState *domain.SessionCollection, tmuxCache *TmuxSessionCache, statusConfig *config.StatusConfig, sessionSort domain.SessionSort, workspace string) []list.Item {
 	var items []list.Item
 
-	// Build sessions from state
+	// Build sessions from state, keeping only the active workspace
 	sessionsMap := make(map[string]*ports.TmuxSession)
 	for name, info := range sessionState.Sessions {
+		if workspace != "" && !info.InWorkspace(workspace) {
+			continue
+		}
 		sessionsMap[name] = &ports.TmuxSession{
 			Name:      name,
 			CreatedAt: info.LastUpdated,
@@ -776,6 +1914,13 @@ func buildListItems(sessionState *domain.SessionCollection, sessionService *serv
 		sessions = append(sessions, sessionsMap[name])
 	}
 
+	// Apply the active sort preset; ties keep the manual order
+	if len(sessionSort.Criteria) > 0 {
+		slices.SortStableFunc(sessions, func(a, b *ports.TmuxSession) int {
+			return sessionSort.Compare(sessionState.Sessions[a.Name], sessionState.Sessions[b.Name])
+		})
+	}
+
 	// Convert to list items
 	for _, session := range sessions {
 		info := sessionState.Sessions[session.Name]
@@ -792,10 +1937,18 @@ func buildListItems(sessionState *domain.SessionCollection, sessionService *serv
 			gitRef = info.BranchName
 		}
 
+		// Scoped monorepo sessions show where in the checkout they run
+		if info.Subdir != "" {
+			if gitRef != "" {
+				gitRef += " "
+			}
+			gitRef += "› " + info.Subdir
+		}
+
 		// Check if shell session exists (check nested object)
 		hasShell := false
 		if info.ShellSession != nil {
-			hasShell = sessionService.SessionExists(info.ShellSession.Name)
+			hasShell = tmuxCache.Exists(info.ShellSession.Name)
 		}
 
 		// Add PR number if available (between branch and git stats)
@@ -821,45 +1974,153 @@ func buildListItems(sessionState *domain.SessionCollection, sessionService *serv
 					gitRef += fmt.Sprintf(" · ↑%d ↓%d", stats.Ahead, stats.Behind)
 				}
 
+				// Add ahead/behind the base branch (if non-zero)
+				if stats.BaseAhead > 0 || stats.BaseBehind > 0 {
+					base := strings.TrimPrefix(stats.BaseRef, "origin/")
+					gitRef += fmt.Sprintf(" · %s ↑%d ↓%d", base, stats.BaseAhead, stats.BaseBehind)
+				}
+
 				// Add file stats (if non-zero)
 				if stats.ChangedFiles > 0 || stats.Additions > 0 || stats.Deletions > 0 {
 					gitRef += fmt.Sprintf(" · %d files +%d -%d", stats.ChangedFiles, stats.Additions, stats.Deletions)
 				}
+
+				// Add stash count (if non-zero)
+				if stats.Stashes > 0 {
+					gitRef += fmt.Sprintf(" · %d stashed", stats.Stashes)
+				}
 			}
 		}
 
 		items = append(items, SessionItem{
-			Comment:         info.Comment,
-			DisplayName:     displayName,
-			GitRef:          gitRef,
-			HasShellSession: hasShell,
-			IsFlagged:       info.IsFlagged,
-			LastUpdated:     info.LastUpdated,
-			PRState:         prState,
-			Session:         session,
-			State:           string(info.State),
-			Status:          info.Status,
+			Activity:         info.Activity,
+			AgentError:       info.AgentError,
+			Badges:           info.Badges,
+			CI:               info.CI,
+			Checks:           info.Checks,
+			Comment:          info.Comment,
+			DisplayName:      displayName,
+			GitRef:           gitRef,
+			HasShellSession:  hasShell,
+			IsEscalated:      info.IsEscalated,
+			IsExternal:       info.IsExternal,
+			IsFlagged:        info.IsFlagged,
+			IsThrottled:      info.IsThrottled,
+			LastUpdated:      info.LastUpdated,
+			Note:             info.Note,
+			PRState:          prState,
+			Priority:         info.Priority,
+			RepoInfo:         info.RepoInfo,
+			RepoPath:         info.RepoPath,
+			Resources:        info.ResourceUsage,
+			Session:          session,
+			SkipsPermissions: info.AllowDangerouslySkipPermissions,
+			State:            string(info.State),
+			Status:           info.Status,
+			Tags:             info.Tags,
+			Timer:            info.Timer,
+			TokenBudget:      info.TokenBudget,
 		})
 	}
 
 	return items
 }
 
+// sparklineBars are the bars of activity sparklines, from no work to working all the time
+var sparklineBars = []rune("▁▂▃▄▅▆▇█")
+
+// activitySparkline renders the share of time a session spent working in each period as a bar,
+// or as the overall share in accessibility mode
+func activitySparkline(activity domain.ActivitySparkline, accessible bool) string {
+	if accessible {
+		return theme.ActivitySparklineStyle.Render(fmt.Sprintf("busy %.0f%%", activity.Busy()*100))
+	}
+
+	bars := make([]rune, len(activity))
+	for i, share := range activity {
+		level := int(share*float64(len(sparklineBars)-1) + 0.5)
+		bars[i] = sparklineBars[max(0, min(level, len(sparklineBars)-1))]
+	}
+	return theme.ActivitySparklineStyle.Render(string(bars))
+}
+
+// ciChip renders a CI result as "CI ✓", "CI ✗", or "CI …" in its color, spelled out in accessibility mode
+func ciChip(state domain.CIState, accessible bool) string {
+	switch state {
+	case domain.CIStateSuccess:
+		return theme.CIPassedStyle.Render(indicatorText(accessible, "CI ✓", "ci passed"))
+	case domain.CIStateFailure:
+		return theme.CIFailedStyle.Render(indicatorText(accessible, "CI ✗", "ci failed"))
+	default:
+		return theme.CIPendingStyle.Render(indicatorText(accessible, "CI …", "ci running"))
+	}
+}
+
+// checksChip renders the outcome of a check run as "checks ✓", "checks ✗", or "checks …", colored
+// like CI results and spelled out in accessibility mode
+func checksChip(state domain.CheckState, accessible bool) string {
+	switch state {
+	case domain.CheckStatePassed:
+		return theme.CIPassedStyle.Render(indicatorText(accessible, "checks ✓", "checks passed"))
+	case domain.CheckStateFailed:
+		return theme.CIFailedStyle.Render(indicatorText(accessible, "checks ✗", "checks failed"))
+	default:
+		return theme.CIPendingStyle.Render(indicatorText(accessible, "checks …", "checks running"))
+	}
+}
+
+// priorityChip renders a priority as a color-coded "P0" to "P3", empty without priority
+func priorityChip(priority domain.Priority) string {
+	level := slices.Index(domain.Priorities, priority)
+	if level < 0 {
+		return ""
+	}
+	return theme.PriorityStyle(theme.ColorPriorityLevels[level]).Render(string(priority))
+}
+
 // renderStatusLegend renders the status legend with counts
 func (sl *SessionList) renderStatusLegend() string {
-	workingCount, idleCount, waitingCount, exitedCount := sl.countSessionsByState()
+	workingCount, idleCount, waitingCount, pausedCount, exitedCount := sl.countSessionsByState()
+
+	// Paused sessions are only counted once there are some
+	if sl.accessible {
+		counts := []string{
+			i18n.Tf("list.working", workingCount),
+			i18n.Tf("list.idle", idleCount),
+			i18n.Tf("list.waiting", waitingCount),
+		}
+		if pausedCount > 0 {
+			counts = append(counts, i18n.Tf("list.paused", pausedCount))
+		}
+		legend := strings.Join(append(counts, i18n.Tf("list.exited", exitedCount)), ", ")
+		if escalatedCount := sl.countEscalatedSessions(); escalatedCount > 0 {
+			legend += ", " + i18n.Tf("list.escalated", escalatedCount)
+		}
+		return legend
+	}
+
+	legend := theme.WorkingIconStyle.Render(domain.SymbolWorking) + " " + i18n.Tf("list.working", workingCount) + " • "
+	legend += theme.IdleIconStyle.Render(domain.SymbolIdle) + " " + i18n.Tf("list.idle", idleCount) + " • "
+	legend += theme.WaitingIconStyle.Render(domain.SymbolWaiting) + " " + i18n.Tf("list.waiting", waitingCount) + " • "
+	if pausedCount > 0 {
+		legend += theme.PausedIconStyle.Render(domain.SymbolPaused) + " " + i18n.Tf("list.paused", pausedCount) + " • "
+	}
+	legend += theme.ExitedIconStyle.Render(domain.SymbolExited) + " " + i18n.Tf("list.exited", exitedCount)
 
-	legend := theme.WorkingIconStyle.Render(domain.SymbolWorking) + fmt.Sprintf(" %d working • ", workingCount)
-	legend += theme.IdleIconStyle.Render(domain.SymbolIdle) + fmt.Sprintf(" %d idle • ", idleCount)
-	legend += theme.WaitingIconStyle.Render(domain.SymbolWaiting) + fmt.Sprintf(" %d waiting • ", waitingCount)
-	legend += theme.ExitedIconStyle.Render(domain.SymbolExited) + fmt.Sprintf(" %d exited", exitedCount)
+	// Escalated sessions are waiting ones that need attention first
+	if escalatedCount := sl.countEscalatedSessions(); escalatedCount > 0 {
+		legend += " • " + theme.TimestampStyle(sl.timestampConfig.EscalatedColor).Bold(true).Render("⚠ "+i18n.Tf("list.escalated", escalatedCount))
+	}
 
 	return legend
 }
 
-// countSessionsByState counts sessions by their state
-func (sl *SessionList) countSessionsByState() (working, idle, waiting, exited int) {
+// countSessionsByState counts the listed sessions by their state
+func (sl *SessionList) countSessionsByState() (working, idle, waiting, paused, exited int) {
 	for _, sessionInfo := range sl.sessionState.Sessions {
+		if sl.workspace != "" && !sessionInfo.InWorkspace(sl.workspace) {
+			continue
+		}
 		switch sessionInfo.State {
 		case domain.StateWorking:
 			working++
@@ -867,6 +2128,8 @@ func (sl *SessionList) countSessionsByState() (working, idle, waiting, exited in
 			idle++
 		case domain.StateWaiting:
 			waiting++
+		case domain.StatePaused:
+			paused++
 		case domain.StateExited:
 			exited++
 		}
@@ -874,6 +2137,17 @@ func (sl *SessionList) countSessionsByState() (working, idle, waiting, exited in
 	return
 }
 
+// countEscalatedSessions counts sessions waiting longer than their escalation threshold
+func (sl *SessionList) countEscalatedSessions() int {
+	count := 0
+	for _, sessionInfo := range sl.sessionState.Sessions {
+		if sessionInfo.IsEscalated {
+			count++
+		}
+	}
+	return count
+}
+
 // formatHelpLine formats a help line with styled shortcuts and labels
 func formatHelpLine(line string) string {
 	// Split by bullet separator
@@ -903,30 +2177,43 @@ func formatHelpLine(line string) string {
 	return strings.Join(formatted, " • ")
 }
 
-// ensureSessionExists checks if a session exists and recreates it if needed
-func (sl *SessionList) ensureSessionExists(session *ports.TmuxSession) bool {
-	if sl.sessionService.SessionExists(session.Name) {
-		return true
+// attachOrRestart returns the command to attach to a session (or its shell session when attachShell is set).
+// Sessions whose tmux session is gone, or whose Claude process exited, go through the restart dialog
+// so the user can choose to resume the conversation, start fresh, or open a shell only.
+func (sl *SessionList) attachOrRestart(session *ports.TmuxSession, attachShell bool) tea.Cmd {
+	sessionInfo, hasInfo := sl.sessionState.Sessions[session.Name]
+	exists := sl.tmuxCache.ExistsConfirmed(session.Name)
+
+	if hasInfo && (!exists || (!attachShell && sessionInfo.State == domain.StateExited)) {
+		logging.Logger.Info("Session needs restart", "name", session.Name, "tmux_exists", exists, "state", sessionInfo.State)
+		return func() tea.Msg { return RestartSessionMsg{AttachShell: attachShell, Session: session} }
 	}
 
-	logging.Logger.Info("Session no longer exists, recreating", "name", session.Name)
+	if !exists && !sl.ensureSessionExists(session) {
+		return nil
+	}
 
-	// Try to get stored metadata to recreate with same worktree and ClaudeDir
-	var claudeDir string
-	var worktreePath string
-	if sessionInfo, ok := sl.sessionState.Sessions[session.Name]; ok {
-		claudeDir = sessionInfo.ClaudeDir
-		worktreePath = sessionInfo.WorktreePath
-		logging.Logger.Info("Recreating session with stored worktree", "name", session.Name, "worktree", worktreePath, "claude_dir", claudeDir)
-	} else {
-		logging.Logger.Warn("No stored metadata for session, creating without worktree", "name", session.Name)
+	if attachShell {
+		return func() tea.Msg { return AttachShellSessionMsg{Session: session} }
+	}
+	return func() tea.Msg { return AttachSessionMsg{Session: session} }
+}
+
+// ensureSessionExists recreates a tmux session that has no stored metadata
+// Sessions with metadata are restarted through the restart dialog instead (see attachOrRestart)
+func (sl *SessionList) ensureSessionExists(session *ports.TmuxSession) bool {
+	if sl.tmuxCache.ExistsConfirmed(session.Name) {
+		return true
 	}
 
+	logging.Logger.Warn("No stored metadata for session, creating without worktree", "name", session.Name)
+
 	// Recreate the session
-	if err := sl.sessionService.RecreateSession(session.Name, worktreePath, claudeDir, sl.tmuxStatusPosition); err != nil {
+	if err := sl.sessionService.RecreateSession(session.Name, "", "", sl.tmuxStatusPosition); err != nil {
 		sl.err = fmt.Errorf("failed to recreate session: %w", err)
 		return false
 	}
+	sl.tmuxCache.Invalidate()
 
 	return true
 }
@@ -1142,6 +2429,7 @@ func (sl *SessionList) requestGitStatsForVisible() tea.Cmd {
 		}
 
 		requests = append(requests, GitStatsRequest{
+			BaseBranch:   info.BaseBranch,
 			SessionName:  sessionItem.Session.Name,
 			WorktreePath: gitPath, // Use gitPath which can be either worktree or repo path
 			Priority:     priority,
@@ -1180,36 +2468,394 @@ func (sl *SessionList) requestGitStatsForVisible() tea.Cmd {
 	return tea.Batch(cmds...)
 }
 
-// cycleSessionStatus cycles the status of a session to the next value
-func (sl *SessionList) cycleSessionStatus(sessionName string) tea.Cmd {
-	// Get current status from session state
-	var currentStatus *string
-	if sessionInfo, ok := sl.sessionState.Sessions[sessionName]; ok {
-		currentStatus = sessionInfo.Status
+// requestResourceUsage samples agent CPU/memory for all non-exited sessions
+// Returns a tea.Cmd that will sample asynchronously
+func (sl *SessionList) requestResourceUsage() tea.Cmd {
+	// Don't start a new sample if one is already in progress
+	if sl.samplingResources {
+		return nil
 	}
 
-	// Get next status in cycle
-	nextStatus := sl.statusConfig.GetNextStatus(currentStatus)
+	var names []string
+	for name, info := range sl.sessionState.Sessions {
+		if info.State != domain.StateExited {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	sl.samplingResources = true
+	return StartResourceSampler(sl.sessionService, names)
+}
 
-	// Update in database
-	if err := sl.sessionService.UpdateStatus(context.Background(), sessionName, nextStatus); err != nil {
-		logging.Logger.Error("Failed to cycle session status", "error", err, "session", sessionName)
+// requestBadges runs the badge command for the listed sessions when its interval elapsed
+func (sl *SessionList) requestBadges() tea.Cmd {
+	if sl.fetchingBadges || sl.badgeService == nil || !sl.badgeService.Due(time.Now()) {
 		return nil
 	}
 
-	// Log the change
-	currentStr := "nil"
-	if currentStatus != nil {
-		currentStr = *currentStatus
+	sessions := make([]domain.Session, 0, len(sl.sessionState.OrderedNames))
+	for _, name := range sl.sessionState.OrderedNames {
+		if info, exists := sl.sessionState.Sessions[name]; exists {
+			sessions = append(sessions, info)
+		}
 	}
-	nextStr := "nil"
-	if nextStatus != nil {
-		nextStr = *nextStatus
+	if len(sessions) == 0 {
+		return nil
 	}
-	logging.Logger.Info("Cycled session status", "session", sessionName, "from", currentStr, "to", nextStr)
 
-	// Refresh list immediately to show new status
+	sl.fetchingBadges = true
 	return func() tea.Msg {
-		return checkStateMsg{}
+		badges, err := sl.badgeService.Refresh(context.Background(), sessions, time.Now())
+		return badgesReadyMsg{Badges: badges, Err: err}
+	}
+}
+
+// requestActivity rebuilds the activity sparklines of the last services.ActivitySparklineWindow,
+// at most every activityRefreshInterval
+func (sl *SessionList) requestActivity() tea.Cmd {
+	if sl.fetchingActivity || sl.activityService == nil || time.Since(sl.lastActivityFetch) < activityRefreshInterval {
+		return nil
+	}
+
+	sl.fetchingActivity = true
+	sl.lastActivityFetch = time.Now()
+	return func() tea.Msg {
+		activity, err := sl.activityService.GetActivitySparklines(context.Background(),
+			services.ActivitySparklineWindow, services.ActivitySparklineBuckets, time.Now())
+		return activityReadyMsg{Activity: activity, Err: err}
+	}
+}
+
+// requestCheckRuns returns a command that reads the last check run of each session
+func (sl *SessionList) requestCheckRuns() tea.Cmd {
+	if sl.readingChecks || sl.checkService == nil {
+		return nil
+	}
+
+	names := slices.Clone(sl.sessionState.OrderedNames)
+	sl.readingChecks = true
+	return func() tea.Msg {
+		return checkRunsReadyMsg{Runs: sl.checkService.LastRuns(names)}
+	}
+}
+
+// requestAgentErrorScan scans the panes of sessions working without updates for longer than
+// agentErrorStaleAfter, at most every agentErrorScanInterval: a usage limit or authentication
+// error fires no hook, so such sessions would otherwise look busy forever
+func (sl *SessionList) requestAgentErrorScan() tea.Cmd {
+	if sl.scanningAgentErrs || sl.shellService == nil || time.Since(sl.lastAgentErrorScan) < agentErrorScanInterval {
+		return nil
+	}
+
+	var names []string
+	for name, info := range sl.sessionState.Sessions {
+		if info.State == domain.StateWorking && time.Since(info.LastUpdated) >= agentErrorStaleAfter {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	sl.scanningAgentErrs = true
+	sl.lastAgentErrorScan = time.Now()
+	return StartAgentErrorScan(sl.shellService, names)
+}
+
+// keptAgentError carries a detected agent error over to reloaded state until the session
+// moves on: any update means the agent is running again
+func keptAgentError(oldInfo, newInfo domain.Session) *domain.AgentError {
+	if newInfo.State != oldInfo.State || !newInfo.LastUpdated.Equal(oldInfo.LastUpdated) {
+		return nil
+	}
+	return oldInfo.AgentError
+}
+
+// isPrimary reports whether this TUI runs the background work, taking it over when the
+// primary instance exited
+func (sl *SessionList) isPrimary() bool {
+	return sl.instanceService == nil || sl.instanceService.TakeOver()
+}
+
+// DebugMetrics returns the poll and state reflection timings collected by the list
+func (sl *SessionList) DebugMetrics() *DebugMetrics {
+	return sl.debugMetrics
+}
+
+// requestEscalationAlerts flags the sessions of state waiting longer than their escalation
+// threshold and returns a command that alerts the ones that just escalated (when alert is set)
+func (sl *SessionList) requestEscalationAlerts(state *domain.SessionCollection, alert bool) tea.Cmd {
+	escalated := sl.escalationService.Mark(state, time.Now())
+	if len(escalated) == 0 || !alert {
+		return nil
+	}
+
+	return func() tea.Msg {
+		if err := sl.escalationService.Alert(context.Background(), escalated); err != nil {
+			logging.Logger.Warn("Failed to alert escalated sessions", "error", err)
+		}
+		return nil
+	}
+}
+
+// requestTimerAlerts returns a command that announces the session timers of state that elapsed
+func (sl *SessionList) requestTimerAlerts(state *domain.SessionCollection) tea.Cmd {
+	elapsed := sl.timerService.Elapsed(state, time.Now())
+	if len(elapsed) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		if err := sl.timerService.Alert(context.Background(), elapsed); err != nil {
+			logging.Logger.Warn("Failed to alert elapsed timers", "error", err)
+		}
+		return nil
 	}
 }
+
+// requestCIAlerts returns a command that announces the CI failures of idle sessions of state
+func (sl *SessionList) requestCIAlerts(state *domain.SessionCollection) tea.Cmd {
+	if sl.ciService == nil {
+		return nil
+	}
+	failed := sl.ciService.Failed(state)
+	if len(failed) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		if err := sl.ciService.Alert(context.Background(), failed); err != nil {
+			logging.Logger.Warn("Failed to alert CI failures", "error", err)
+		}
+		return nil
+	}
+}
+
+// requestRuleActions returns a command that applies the workflow rules due for the sessions of state
+func (sl *SessionList) requestRuleActions(state *domain.SessionCollection) tea.Cmd {
+	due := sl.ruleService.Due(state, time.Now())
+	if len(due) == 0 {
+		return nil
+	}
+
+	return func() tea.Msg {
+		if err := sl.ruleService.Apply(context.Background(), due); err != nil {
+			logging.Logger.Warn("Failed to apply rules", "error", err)
+		}
+		return nil
+	}
+}
+
+// requestTokenBudgetCheck returns a command that refreshes token usage and enforces
+// exceeded budgets, at most every tokenBudgetCheckInterval
+func (sl *SessionList) requestTokenBudgetCheck(state *domain.SessionCollection) tea.Cmd {
+	// Don't start a new check if one is already in progress
+	if sl.checkingBudgets || time.Since(sl.lastBudgetCheck) < tokenBudgetCheckInterval {
+		return nil
+	}
+	if !sl.tokenBudgetService.HasPending(state) {
+		return nil
+	}
+
+	sl.checkingBudgets = true
+	sl.lastBudgetCheck = time.Now()
+	return func() tea.Msg {
+		if _, err := sl.tokenBudgetService.Check(context.Background()); err != nil {
+			logging.Logger.Warn("Failed to check token budgets", "error", err)
+		}
+		return tokenBudgetsCheckedMsg{}
+	}
+}
+
+// requestWorktreeGC returns a command that removes the clean, pushed worktrees of sessions
+// archived longer than the retention, at most every services.WorktreeGCInterval
+func (sl *SessionList) requestWorktreeGC() tea.Cmd {
+	if sl.runningWorktreeGC || sl.worktreeGCService == nil || !sl.worktreeGCService.Retention().IsEnabled() {
+		return nil
+	}
+	if time.Since(sl.lastWorktreeGC) < services.WorktreeGCInterval {
+		return nil
+	}
+
+	sl.runningWorktreeGC = true
+	sl.lastWorktreeGC = time.Now()
+	return func() tea.Msg {
+		candidates, err := sl.worktreeGCService.Run(context.Background(), time.Now())
+		if err != nil {
+			logging.Logger.Warn("Failed to remove worktrees past retention", "error", err)
+		}
+		for _, candidate := range candidates {
+			logging.Logger.Info("Archived worktree past retention", "session", candidate.Session.Name, "action", candidate.Reason())
+		}
+		return worktreesCollectedMsg{}
+	}
+}
+
+// requestHistoryPrune returns a command that discards old history written by hooks,
+// at most every services.HistoryPruneInterval
+func (sl *SessionList) requestHistoryPrune() tea.Cmd {
+	if sl.pruningHistory || sl.pruneService == nil {
+		return nil
+	}
+	if time.Since(sl.lastHistoryPrune) < services.HistoryPruneInterval {
+		return nil
+	}
+
+	sl.pruningHistory = true
+	sl.lastHistoryPrune = time.Now()
+	return func() tea.Msg {
+		if err := sl.pruneService.Prune(context.Background(), time.Now()); err != nil {
+			logging.Logger.Warn("Failed to prune history", "error", err)
+		}
+		return historyPrunedMsg{}
+	}
+}
+
+// requestOrphanShellCleanup returns a command that kills the shells running without their
+// parent session and forgets the ones no longer running, at most every services.OrphanShellCleanupInterval.
+// It only runs when clean_orphan_shells is on; otherwise the palette action does it on request.
+func (sl *SessionList) requestOrphanShellCleanup() tea.Cmd {
+	if sl.cleaningShells || sl.shellService == nil || !sl.shellService.AutoCleanup() {
+		return nil
+	}
+	if time.Since(sl.lastShellCleanup) < services.OrphanShellCleanupInterval {
+		return nil
+	}
+
+	sl.cleaningShells = true
+	sl.lastShellCleanup = time.Now()
+	return func() tea.Msg {
+		cleaned, err := sl.shellService.CleanOrphanShells(context.Background())
+		if err != nil {
+			logging.Logger.Warn("Failed to clean up some orphan shell sessions", "error", err)
+		}
+		for _, orphan := range cleaned {
+			logging.Logger.Info("Cleaned up orphan shell session", "name", orphan.Name, "parent", orphan.ParentName, "killed", orphan.Running)
+		}
+		return orphanShellsCleanedMsg{}
+	}
+}
+
+// requestCheckpoints returns a command that saves the worktrees of the sessions whose
+// checkpoint is due
+func (sl *SessionList) requestCheckpoints(state *domain.SessionCollection) tea.Cmd {
+	if sl.checkpointing || sl.checkpointService == nil {
+		return nil
+	}
+	due := sl.checkpointService.Due(state, time.Now())
+	if len(due) == 0 {
+		return nil
+	}
+
+	sl.checkpointing = true
+	return func() tea.Msg {
+		if err := sl.checkpointService.Run(context.Background(), due); err != nil {
+			logging.Logger.Warn("Failed to checkpoint some sessions", "error", err)
+		}
+		return checkpointsSavedMsg{}
+	}
+}
+
+// requestRemoteFetch returns a command that fetches origin in each repository sessions
+// work in, once the background fetch interval elapsed
+func (sl *SessionList) requestRemoteFetch() tea.Cmd {
+	if sl.fetchingRemotes || sl.remoteFetchService == nil || !sl.remoteFetchService.Due(time.Now()) {
+		return nil
+	}
+
+	sl.fetchingRemotes = true
+	return func() tea.Msg {
+		if _, err := sl.remoteFetchService.Run(context.Background(), time.Now()); err != nil {
+			logging.Logger.Warn("Failed to fetch some session repositories", "error", err)
+		}
+		return remotesFetchedMsg{}
+	}
+}
+
+// requestHookJournalDrain returns a command that applies the journaled hook events
+// hooks could not apply themselves; they show in the list on the next poll
+func (sl *SessionList) requestHookJournalDrain() tea.Cmd {
+	// Don't start a new drain if one is already in progress
+	if sl.drainingJournal || sl.hookJournalService == nil {
+		return nil
+	}
+
+	sl.drainingJournal = true
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), hookJournalDrainTimeout)
+		defer cancel()
+		if _, err := sl.hookJournalService.Drain(ctx); err != nil {
+			logging.Logger.Warn("Failed to drain hook journal", "error", err)
+		}
+		return hookJournalDrainedMsg{}
+	}
+}
+
+// requestTicketSyncDrain returns a command that syncs the tickets of queued status changes
+func (sl *SessionList) requestTicketSyncDrain() tea.Cmd {
+	// Don't start a new drain if one is already in progress
+	if sl.syncingTickets || sl.ticketSyncService == nil {
+		return nil
+	}
+
+	sl.syncingTickets = true
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), ticketSyncDrainTimeout)
+		defer cancel()
+		if _, err := sl.ticketSyncService.Drain(ctx); err != nil {
+			logging.Logger.Warn("Failed to drain ticket syncs", "error", err)
+		}
+		return ticketSyncsDrainedMsg{}
+	}
+}
+
+// requestPromptDispatch delivers due scheduled prompts
+// Returns a tea.Cmd that will dispatch asynchronously
+func (sl *SessionList) requestPromptDispatch() tea.Cmd {
+	// Don't start a new dispatch if one is already in progress
+	if sl.dispatchingPrompts || sl.schedulerService == nil {
+		return nil
+	}
+
+	sl.dispatchingPrompts = true
+	return StartPromptDispatcher(sl.schedulerService)
+}
+
+// cycleSessionStatus cycles the status of a session to the next value
+func (sl *SessionList) cycleSessionStatus(sessionName string) tea.Cmd {
+	currentStatus := sl.sessionState.Sessions[sessionName].Status
+	nextStatus := sl.statusConfig.GetNextStatus(currentStatus)
+	logging.Logger.Info("Cycling session status", "session", sessionName, "from", statusOrNil(currentStatus), "to", statusOrNil(nextStatus))
+
+	return sl.applyOptimisticUpdate(sessionName, "cycle status", optimisticUpdate{
+		apply:  func(s *domain.Session) { s.Status = nextStatus },
+		revert: func(s *domain.Session) { s.Status = currentStatus },
+	}, func(ctx context.Context) error {
+		return sl.sessionService.UpdateStatus(ctx, sessionName, nextStatus)
+	})
+}
+
+// statusOrNil returns a status for logs, "nil" when unset
+func statusOrNil(status *string) string {
+	if status == nil {
+		return "nil"
+	}
+	return *status
+}
+
+// cycleSessionPriority moves a session to its next priority
+func (sl *SessionList) cycleSessionPriority(sessionName string) tea.Cmd {
+	current := sl.sessionState.Sessions[sessionName].Priority
+	next := current.Next()
+	logging.Logger.Info("Cycling session priority", "session", sessionName, "to", next)
+
+	return sl.applyOptimisticUpdate(sessionName, "cycle priority", optimisticUpdate{
+		apply:  func(s *domain.Session) { s.Priority = next },
+		revert: func(s *domain.Session) { s.Priority = current },
+	}, func(ctx context.Context) error {
+		return sl.sessionService.UpdatePriority(ctx, sessionName, next)
+	})
+}
@@ -0,0 +1,327 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// Session move wizard steps
+type moveStep int
+
+const (
+	moveStepDestination moveStep = iota // Pick the repository and the destination ROCHA_HOME
+	moveStepReview                      // Preview the move and resolve name collisions
+)
+
+// SessionMoveFormResult contains the result of the move wizard
+type SessionMoveFormResult struct {
+	Cancelled  bool
+	DestHome   string
+	Error      error
+	MovedCount int
+	RepoInfo   string
+	Skipped    []string // Sessions left in this home
+}
+
+// SessionMoveForm is a Bubble Tea wizard that moves a repository's sessions to another ROCHA_HOME
+// It previews what moves and lets the user rename or leave behind sessions whose names collide
+type SessionMoveForm struct {
+	Completed        bool
+	confirmed        bool
+	destInput        string
+	form             *huh.Form
+	migrationService *services.MigrationService
+	newNames         map[string]*string // Conflicting session -> new name at the destination ("" stays here)
+	plan             *services.MovePlan
+	result           SessionMoveFormResult
+	sourceHome       string
+	step             moveStep
+}
+
+// NewSessionMoveForm creates the move wizard
+// repos lists the repositories of the current home; selectedRepo is preselected
+func NewSessionMoveForm(migrationService *services.MigrationService, repos []string, selectedRepo string, sourceHome string, knownHomes []string) *SessionMoveForm {
+	sf := &SessionMoveForm{
+		migrationService: migrationService,
+		result:           SessionMoveFormResult{RepoInfo: selectedRepo},
+		sourceHome:       sourceHome,
+		step:             moveStepDestination,
+	}
+	if len(knownHomes) > 0 {
+		sf.destInput = knownHomes[0]
+	}
+
+	options := make([]huh.Option[string], 0, len(repos))
+	for _, repo := range repos {
+		options = append(options, huh.NewOption(repo, repo))
+	}
+
+	sf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.T("move.repo")).
+				Description(i18n.Tf("move.repo_desc", sourceHome)).
+				Options(options...).
+				Value(&sf.result.RepoInfo),
+			huh.NewInput().
+				Title(i18n.T("move.dest")).
+				Description(i18n.T("move.dest_desc")).
+				Suggestions(knownHomes).
+				Value(&sf.destInput).
+				Validate(func(s string) error {
+					dest := config.ExpandPath(strings.TrimSpace(s))
+					switch {
+					case dest == "":
+						return errors.New(i18n.T("move.dest_required"))
+					case dest == sourceHome:
+						return errors.New(i18n.T("move.dest_current"))
+					}
+					return nil
+				}),
+		),
+	)
+
+	return sf
+}
+
+func (sf *SessionMoveForm) Init() tea.Cmd {
+	return sf.form.Init()
+}
+
+func (sf *SessionMoveForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			sf.result.Cancelled = true
+			sf.Completed = true
+			return sf, nil
+		}
+	}
+
+	form, cmd := sf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+
+	if sf.form.State != huh.StateCompleted {
+		return sf, cmd
+	}
+
+	if sf.step == moveStepDestination {
+		if err := sf.preparePlan(); err != nil {
+			logging.Logger.Error("Failed to plan session move", "repo", sf.result.RepoInfo, "error", err)
+			sf.result.Error = err
+			sf.Completed = true
+			return sf, nil
+		}
+		sf.step = moveStepReview
+		sf.form = sf.buildReviewForm()
+		// Size the new form like the first one
+		return sf, tea.Batch(sf.form.Init(), tea.WindowSize())
+	}
+
+	sf.Completed = true
+	if !sf.confirmed {
+		sf.result.Cancelled = true
+		return sf, nil
+	}
+	if err := sf.move(); err != nil {
+		logging.Logger.Error("Failed to move sessions", "repo", sf.result.RepoInfo, "error", err)
+		sf.result.Error = err
+	}
+	return sf, nil
+}
+
+// Done reports whether the wizard moved the sessions or was cancelled
+func (sf *SessionMoveForm) Done() bool {
+	return sf.Completed
+}
+
+func (sf *SessionMoveForm) View() string {
+	if sf.form != nil {
+		return sf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (sf *SessionMoveForm) Result() SessionMoveFormResult {
+	return sf.result
+}
+
+// preparePlan previews the move to the chosen destination
+// A main repository directory that cannot be reused blocks the whole move
+func (sf *SessionMoveForm) preparePlan() error {
+	sf.result.DestHome = config.ExpandPath(strings.TrimSpace(sf.destInput))
+
+	plan, err := sf.migrationService.PlanRepositoryMove(context.Background(), sf.sourceHome, sf.result.DestHome, sf.result.RepoInfo)
+	if err != nil {
+		return err
+	}
+	if plan.MainConflict != "" {
+		return errors.New(plan.MainConflict)
+	}
+
+	sf.plan = plan
+	sf.newNames = make(map[string]*string)
+	for name, suggested := range plan.SuggestRenames() {
+		sf.newNames[name] = &suggested
+	}
+	return nil
+}
+
+// buildReviewForm shows what moves and asks for a new name for every colliding session
+func (sf *SessionMoveForm) buildReviewForm() *huh.Form {
+	fields := []huh.Field{
+		huh.NewNote().
+			Title(i18n.Tf("move.title", sf.plan.RepoInfo, sf.result.DestHome)).
+			Description(sf.describePlan()),
+	}
+
+	for _, conflict := range sf.plan.Conflicts() {
+		name := conflict.Session.Name
+		fields = append(fields, huh.NewInput().
+			Title(fmt.Sprintf("'%s': %s", name, conflict.Conflict)).
+			Description(i18n.T("move.rename_desc")).
+			Value(sf.newNames[name]).
+			Validate(func(s string) error { return sf.validateNewName(name, s) }))
+	}
+
+	fields = append(fields, huh.NewConfirm().
+		Title(i18n.T("move.confirm")).
+		Description(i18n.T("move.confirm_desc")).
+		Affirmative(i18n.T("move.move")).
+		Negative(i18n.T("form.cancel")).
+		Value(&sf.confirmed))
+
+	return huh.NewForm(huh.NewGroup(fields...))
+}
+
+// describePlan lists the database rows, main repository, and worktrees the move touches
+func (sf *SessionMoveForm) describePlan() string {
+	var lines []string
+	lines = append(lines, i18n.Tf("move.plan_sessions", len(sf.plan.Sessions), sf.sourceHome))
+
+	switch {
+	case sf.plan.SourceMainPath == "":
+		lines = append(lines, i18n.T("move.plan_no_main"))
+	case sf.plan.ReuseDestMain:
+		lines = append(lines, i18n.T("move.plan_main_reused"))
+	default:
+		lines = append(lines, i18n.Tf("move.plan_main", shortenHome(sf.plan.DestMainPath)))
+	}
+
+	for _, planned := range sf.plan.Sessions {
+		line := "  " + planned.Session.Name
+		if planned.DestWorktree != "" {
+			line += " → " + shortenHome(planned.DestWorktree)
+		}
+		if planned.Conflict != "" {
+			line += " " + i18n.T("move.plan_conflict")
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// validateNewName checks a new name for a colliding session against everything at the destination
+func (sf *SessionMoveForm) validateNewName(oldName string, value string) error {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil // The session stays in this home
+	}
+
+	newName := domain.SanitizeSessionName(value)
+	if newName == "" {
+		return errors.New(i18n.T("move.name_invalid"))
+	}
+	if slices.Contains(sf.takenNames(oldName), newName) {
+		return errors.New(i18n.Tf("move.name_taken", newName))
+	}
+	for _, planned := range sf.plan.Sessions {
+		if planned.Session.Name != oldName || planned.DestWorktree == "" {
+			continue
+		}
+		if worktree := services.RenamedWorktreePath(planned.DestWorktree, newName); pathExists(worktree) {
+			return errors.New(i18n.Tf("move.worktree_exists", shortenHome(worktree)))
+		}
+	}
+	return nil
+}
+
+// takenNames returns the names a renamed session cannot use: sessions already at the destination,
+// sessions moving under their own name, and the new names chosen for the other collisions
+func (sf *SessionMoveForm) takenNames(except string) []string {
+	taken := slices.Clone(sf.plan.DestNames)
+	for _, planned := range sf.plan.Sessions {
+		name := planned.Session.Name
+		switch {
+		case planned.Conflict == "":
+			taken = append(taken, name)
+		case name != except && strings.TrimSpace(*sf.newNames[name]) != "":
+			taken = append(taken, domain.SanitizeSessionName(*sf.newNames[name]))
+		}
+	}
+	return taken
+}
+
+// move runs the move with the chosen renames
+func (sf *SessionMoveForm) move() error {
+	renames := make(map[string]string)
+	for name, newName := range sf.newNames {
+		if value := strings.TrimSpace(*newName); value != "" {
+			renames[name] = domain.SanitizeSessionName(value)
+		} else {
+			sf.result.Skipped = append(sf.result.Skipped, name)
+		}
+	}
+	slices.Sort(sf.result.Skipped)
+	if len(sf.result.Skipped) == len(sf.plan.Sessions) {
+		return errors.New(i18n.T("move.nothing_left"))
+	}
+
+	if err := os.MkdirAll(sf.result.DestHome, 0755); err != nil {
+		return fmt.Errorf("failed to create destination ROCHA_HOME: %w", err)
+	}
+
+	logging.Logger.Info("Moving sessions from the TUI", "repo", sf.result.RepoInfo, "dest", sf.result.DestHome, "renames", len(renames), "skipped", len(sf.result.Skipped))
+	result, err := sf.migrationService.MoveRepositoryBetweenHomes(context.Background(), services.MoveRepositoryBetweenHomesParams{
+		DestRochaHome:   sf.result.DestHome,
+		Renames:         renames,
+		RepoInfo:        sf.result.RepoInfo,
+		Skip:            sf.result.Skipped,
+		SourceRochaHome: sf.sourceHome,
+	})
+	if err != nil {
+		return err
+	}
+	sf.result.MovedCount = result.MovedSessionCount
+	return nil
+}
+
+// shortenHome replaces the user's home directory with ~
+func shortenHome(path string) string {
+	if homeDir, err := os.UserHomeDir(); err == nil && strings.HasPrefix(path, homeDir+"/") {
+		return "~" + strings.TrimPrefix(path, homeDir)
+	}
+	return path
+}
+
+// pathExists reports whether a file or directory exists at path
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -0,0 +1,133 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// noteCharLimit caps the note size so it stays readable in the note pane
+const noteCharLimit = 10000
+
+// SessionNoteFormResult contains the result of the note operation
+type SessionNoteFormResult struct {
+	Cancelled   bool
+	Error       error
+	NewNote     string
+	SessionName string
+}
+
+// SessionNoteForm is a Bubble Tea component for editing session markdown notes
+type SessionNoteForm struct {
+	Completed      bool
+	cancelled      bool
+	currentNote    string
+	form           *huh.Form
+	result         SessionNoteFormResult
+	sessionName    string
+	sessionService *services.SessionService
+}
+
+// NewSessionNoteForm creates a new session note form
+func NewSessionNoteForm(sessionService *services.SessionService, sessionName, currentNote string) *SessionNoteForm {
+	sf := &SessionNoteForm{
+		currentNote:    currentNote,
+		sessionName:    sessionName,
+		sessionService: sessionService,
+		result: SessionNoteFormResult{
+			SessionName: sessionName,
+			NewNote:     currentNote, // Preload the current note for editing
+		},
+	}
+
+	// Build form with multi-line markdown input (ctrl+e opens $EDITOR for longer notes)
+	sf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewText().
+				Title(i18n.T("note.title")).
+				Description(i18n.Tf("note.desc", sessionName)).
+				Value(&sf.result.NewNote).
+				Lines(12).
+				CharLimit(noteCharLimit),
+		),
+	)
+
+	return sf
+}
+
+func (sf *SessionNoteForm) Init() tea.Cmd {
+	return sf.form.Init()
+}
+
+func (sf *SessionNoteForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			sf.cancelled = true
+			sf.result.Cancelled = true
+			sf.Completed = true
+			return sf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := sf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+
+	// Check if form completed
+	if sf.form.State == huh.StateCompleted {
+		sf.Completed = true
+		// Execute the note update
+		if err := sf.updateNote(); err != nil {
+			logging.Logger.Error("Failed to update note", "error", err)
+			sf.result.Error = err
+		}
+		return sf, nil
+	}
+
+	return sf, cmd
+}
+
+// Done reports whether the note was saved or the form cancelled
+func (sf *SessionNoteForm) Done() bool {
+	return sf.Completed
+}
+
+func (sf *SessionNoteForm) View() string {
+	if sf.form != nil {
+		return sf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (sf *SessionNoteForm) Result() SessionNoteFormResult {
+	return sf.result
+}
+
+// updateNote performs the actual note update operation
+func (sf *SessionNoteForm) updateNote() error {
+	// Trim whitespace - empty after trim means delete
+	newNote := strings.TrimSpace(sf.result.NewNote)
+
+	logging.Logger.Info("Updating session note",
+		"session_name", sf.sessionName,
+		"note_length", len(newNote))
+
+	// Update via service (empty string = delete note)
+	if err := sf.sessionService.UpdateNote(context.Background(), sf.sessionName, newNote); err != nil {
+		return fmt.Errorf("failed to update session note: %w", err)
+	}
+
+	logging.Logger.Info("Session note updated successfully", "session_name", sf.sessionName)
+	return nil
+}
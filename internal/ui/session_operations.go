@@ -7,39 +7,56 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 
+	"github.com/renato0307/rocha/internal/config"
 	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/ports"
 	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/services/actions"
 )
 
 // SessionOperations handles session lifecycle operations.
 // Responsible for kill, archive, attach, and shell session management.
 type SessionOperations struct {
+	actionsService     *actions.Service
+	attachMode         string
 	errorManager       *ErrorManager
 	sessionService     *services.SessionService
 	shellService       *services.ShellService
+	tmuxCache          *TmuxSessionCache
 	tmuxStatusPosition string
 }
 
 // NewSessionOperations creates a new SessionOperations component.
 func NewSessionOperations(
+	attachMode string,
 	errorManager *ErrorManager,
 	tmuxStatusPosition string,
+	actionsService *actions.Service,
 	sessionService *services.SessionService,
 	shellService *services.ShellService,
+	tmuxCache *TmuxSessionCache,
 ) *SessionOperations {
 	return &SessionOperations{
+		actionsService:     actionsService,
+		attachMode:         attachMode,
 		errorManager:       errorManager,
 		sessionService:     sessionService,
 		shellService:       shellService,
+		tmuxCache:          tmuxCache,
 		tmuxStatusPosition: tmuxStatusPosition,
 	}
 }
 
 // AttachToSession suspends Bubble Tea, attaches to a tmux session via the abstraction layer,
 // and returns a detachedMsg when the user detaches.
+// When running inside tmux with a switch/window attach mode, the session is opened
+// through the current tmux client instead and the list keeps running.
 func (so *SessionOperations) AttachToSession(sessionName string) tea.Cmd {
+	if so.attachMode != config.AttachModeAttach && so.attachMode != "" && so.shellService.IsInsideTmux() {
+		return so.attachInsideTmux(sessionName)
+	}
+
 	logging.Logger.Info("Attaching to session via abstraction layer", "name", sessionName)
 
 	cmd := so.shellService.GetAttachCommand(sessionName)
@@ -59,6 +76,28 @@ func (so *SessionOperations) AttachToSession(sessionName string) tea.Cmd {
 	})
 }
 
+// attachInsideTmux opens a session through the current tmux client (switch-client or new window)
+func (so *SessionOperations) attachInsideTmux(sessionName string) tea.Cmd {
+	logging.Logger.Info("Attaching to session inside tmux", "name", sessionName, "mode", so.attachMode)
+
+	var err error
+	switch so.attachMode {
+	case config.AttachModeSwitch:
+		err = so.shellService.SwitchClient(sessionName)
+	case config.AttachModeWindow:
+		err = so.shellService.OpenInWindow(sessionName)
+	default:
+		err = fmt.Errorf("unknown attach mode: %s", so.attachMode)
+	}
+
+	if err != nil {
+		logging.Logger.Error("Failed to attach to session inside tmux", "error", err, "name", sessionName)
+		so.errorManager.SetError(fmt.Errorf("failed to attach to session: %w", err))
+		return so.errorManager.ClearAfterDelay()
+	}
+	return nil
+}
+
 // GetOrCreateShellSession returns shell session name, creating if needed.
 // Returns empty string on error (error stored in errorManager).
 func (so *SessionOperations) GetOrCreateShellSession(
@@ -74,6 +113,7 @@ func (so *SessionOperations) GetOrCreateShellSession(
 		so.errorManager.SetError(err)
 		return ""
 	}
+	so.tmuxCache.Invalidate()
 
 	// Reload session state to get updated shell info
 	newState, err := so.sessionService.LoadState(context.Background(), false)
@@ -86,20 +126,47 @@ func (so *SessionOperations) GetOrCreateShellSession(
 	return shellName
 }
 
-// KillSession kills a session and removes it from state.
+// SessionKilledMsg is sent when a session was stopped and removed in the background
+type SessionKilledMsg struct {
+	Err         error // Killing failed, or the worktree could not be removed
+	SessionName string
+}
+
+// KillSession asks the agent to exit, kills the session, removes it from state, and then
+// removes its worktree as decided by the user.
+// The shutdown can take several seconds, so it runs in the background and sends SessionKilledMsg.
+func (so *SessionOperations) KillSession(session *ports.TmuxSession, worktree actions.WorktreeRemoval) tea.Cmd {
+	logging.Logger.Info("Killing session", "name", session.Name, "removeWorktree", worktree.Remove)
+
+	return func() tea.Msg {
+		outcome, err := so.actionsService.Kill(context.Background(), session.Name, actions.KillOptions{
+			ShutdownTimeout: services.DefaultShutdownTimeout,
+			Worktree:        worktree,
+		})
+		so.tmuxCache.Invalidate()
+		if err != nil {
+			logging.Logger.Error("Failed to kill session", "error", err)
+			return SessionKilledMsg{Err: fmt.Errorf("failed to kill session: %w", err), SessionName: session.Name}
+		}
+		return SessionKilledMsg{Err: worktreeOutcomeError(outcome), SessionName: session.Name}
+	}
+}
+
+// worktreeOutcomeError turns a worktree that could not be removed into an error for the list
+func worktreeOutcomeError(outcome actions.Outcome) error {
+	if outcome.WorktreeKept {
+		return fmt.Errorf("kept worktree at %s: it has local work", outcome.WorktreePath)
+	}
+	return outcome.WorktreeErr
+}
+
+// ReloadAfterKill reloads state after a session was killed and shows any kill error.
 // Updates sessionState and sessionList, returns tea.Cmd.
-func (so *SessionOperations) KillSession(
-	session *ports.TmuxSession,
+func (so *SessionOperations) ReloadAfterKill(
+	msg SessionKilledMsg,
 	sessionState *domain.SessionCollection,
 	sessionList *SessionList,
 ) tea.Cmd {
-	logging.Logger.Info("Killing session", "name", session.Name)
-
-	if err := so.sessionService.KillSession(context.Background(), session.Name); err != nil {
-		logging.Logger.Error("Failed to kill session", "error", err)
-	}
-
-	// Reload session state
 	newState, err := so.sessionService.LoadState(context.Background(), false)
 	if err != nil {
 		log.Printf("Warning: failed to load state: %v", err)
@@ -108,23 +175,29 @@ func (so *SessionOperations) KillSession(
 	}
 
 	refreshCmd := sessionList.RefreshFromState()
+	if msg.Err != nil {
+		so.errorManager.SetError(msg.Err)
+		return tea.Batch(refreshCmd, sessionList.Init(), so.errorManager.ClearAfterDelay())
+	}
 	return tea.Batch(refreshCmd, sessionList.Init())
 }
 
-// ArchiveSession archives a session and optionally removes its worktree.
+// ArchiveSession archives a session and removes its worktree as decided by the user.
 // Updates sessionState and sessionList, returns tea.Cmd.
 func (so *SessionOperations) ArchiveSession(
 	session *ports.TmuxSession,
-	removeWorktree bool,
+	worktree actions.WorktreeRemoval,
 	sessionState *domain.SessionCollection,
 	sessionList *SessionList,
 ) tea.Cmd {
-	logging.Logger.Info("Archiving session", "name", session.Name, "removeWorktree", removeWorktree)
+	logging.Logger.Info("Archiving session", "name", session.Name, "removeWorktree", worktree.Remove)
 
-	if err := so.sessionService.ArchiveSession(context.Background(), session.Name, removeWorktree); err != nil {
+	outcome, err := so.actionsService.Archive(context.Background(), session.Name, worktree)
+	if err != nil {
 		so.errorManager.SetError(fmt.Errorf("failed to archive session: %w", err))
 		return tea.Batch(sessionList.Init(), so.errorManager.ClearAfterDelay())
 	}
+	so.tmuxCache.Invalidate()
 
 	// Reload session state
 	newState, err := so.sessionService.LoadState(context.Background(), false)
@@ -136,5 +209,9 @@ func (so *SessionOperations) ArchiveSession(
 	*sessionState = *newState
 
 	refreshCmd := sessionList.RefreshFromState()
+	if err := worktreeOutcomeError(outcome); err != nil {
+		so.errorManager.SetError(err)
+		return tea.Batch(refreshCmd, sessionList.Init(), so.errorManager.ClearAfterDelay())
+	}
 	return tea.Batch(refreshCmd, sessionList.Init())
 }
@@ -2,17 +2,17 @@ package ui
 
 import (
 	"context"
-	"fmt"
+	"errors"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/huh"
 
 	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
 )
 
-
 // SessionRenameFormResult contains the result of the rename operation
 type SessionRenameFormResult struct {
 	OldTmuxName    string // Original tmux session name
@@ -50,20 +50,12 @@ func NewSessionRenameForm(sessionService *services.SessionService, sessionState
 	sf.form = huh.NewForm(
 		huh.NewGroup(
 			huh.NewInput().
-				Title("New session name").
-				Description(fmt.Sprintf("Renaming: %s", currentDisplayName)).
+				Title(i18n.T("rename.title")).
+				Description(i18n.Tf("rename.desc", currentDisplayName)).
 				Value(&sf.result.NewDisplayName).
 				Placeholder(currentDisplayName).
 				Validate(func(s string) error {
-					if s == "" {
-						return fmt.Errorf("session name required")
-					}
-					// Sanitize for tmux name check
-					tmuxName := domain.SanitizeSessionName(s)
-					if sessionService.SessionExists(tmuxName) && tmuxName != oldTmuxName {
-						return fmt.Errorf("session %s already exists", tmuxName)
-					}
-					return nil
+					return validateSessionRename(sessionService, oldTmuxName, s)
 				}),
 		),
 	)
@@ -106,6 +98,11 @@ func (sf *SessionRenameForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return sf, cmd
 }
 
+// Done reports whether the session was renamed or the form cancelled
+func (sf *SessionRenameForm) Done() bool {
+	return sf.Completed
+}
+
 func (sf *SessionRenameForm) View() string {
 	if sf.form != nil {
 		return sf.form.View()
@@ -118,6 +115,27 @@ func (sf *SessionRenameForm) Result() SessionRenameFormResult {
 	return sf.result
 }
 
+// validateSessionRename checks that newDisplayName maps to a name no other session uses,
+// in tmux or in the database, archived sessions included
+// (renaming to a name that sanitizes to the current one is allowed)
+func validateSessionRename(sessionService *services.SessionService, oldTmuxName, newDisplayName string) error {
+	if newDisplayName == "" {
+		return errors.New(i18n.T("new_session.name_required"))
+	}
+	// Sanitize for tmux name check
+	tmuxName := domain.SanitizeSessionName(newDisplayName)
+	if tmuxName == oldTmuxName {
+		return nil
+	}
+	if sessionService.SessionExists(tmuxName) {
+		return errors.New(i18n.Tf("rename.taken", tmuxName))
+	}
+	if available, err := sessionService.AvailableName(context.Background(), tmuxName); err == nil && available != tmuxName {
+		return errors.New(i18n.Tf("rename.taken", tmuxName))
+	}
+	return nil
+}
+
 // renameSession performs the actual rename operation
 func (sf *SessionRenameForm) renameSession() error {
 	newDisplayName := sf.result.NewDisplayName
@@ -132,7 +150,7 @@ func (sf *SessionRenameForm) renameSession() error {
 		"new_display_name", newDisplayName)
 
 	// Rename in both tmux and database (preserves position)
-	if err := sf.sessionService.RenameSession(context.Background(), sf.oldTmuxName, newTmuxName, newDisplayName); err != nil {
+	if err := sf.sessionService.RenameSession(context.Background(), sf.oldTmuxName, newTmuxName, services.RenameOptions{DisplayName: newDisplayName}); err != nil {
 		return err
 	}
 
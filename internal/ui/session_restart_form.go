@@ -0,0 +1,129 @@
+package ui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// SessionRestartFormResult contains the result of the restart operation
+type SessionRestartFormResult struct {
+	AttachShell bool // Attach to the shell session after restarting
+	Cancelled   bool
+	Error       error
+	Mode        domain.RestartMode
+	Session     *ports.TmuxSession
+}
+
+// SessionRestartForm is a Bubble Tea component for choosing how to restart an exited session
+type SessionRestartForm struct {
+	Completed          bool
+	form               *huh.Form
+	result             SessionRestartFormResult
+	sessionService     *services.SessionService
+	tmuxStatusPosition string
+}
+
+// NewSessionRestartForm creates a new session restart form
+// The resume option is only offered when a Claude conversation was recorded for the session
+func NewSessionRestartForm(sessionService *services.SessionService, session *ports.TmuxSession, sessionInfo domain.Session, attachShell bool, tmuxStatusPosition string) *SessionRestartForm {
+	sf := &SessionRestartForm{
+		result: SessionRestartFormResult{
+			AttachShell: attachShell,
+			Mode:        domain.RestartFresh,
+			Session:     session,
+		},
+		sessionService:     sessionService,
+		tmuxStatusPosition: tmuxStatusPosition,
+	}
+
+	options := make([]huh.Option[domain.RestartMode], 0, 3)
+	if sessionInfo.CanResume() {
+		options = append(options, huh.NewOption(i18n.T("restart.resume"), domain.RestartResume))
+		sf.result.Mode = domain.RestartResume
+	}
+	options = append(options,
+		huh.NewOption(i18n.T("restart.fresh"), domain.RestartFresh),
+		huh.NewOption(i18n.T("restart.shell"), domain.RestartShell),
+	)
+
+	displayName := sessionInfo.DisplayName
+	if displayName == "" {
+		displayName = session.Name
+	}
+
+	sf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[domain.RestartMode]().
+				Title(i18n.T("restart.title")).
+				Description(i18n.Tf("form.session", displayName)).
+				Options(options...).
+				Value(&sf.result.Mode),
+		),
+	)
+
+	return sf
+}
+
+func (sf *SessionRestartForm) Init() tea.Cmd {
+	return sf.form.Init()
+}
+
+func (sf *SessionRestartForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			sf.result.Cancelled = true
+			sf.Completed = true
+			return sf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := sf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+
+	// Check if form completed
+	if sf.form.State == huh.StateCompleted {
+		sf.Completed = true
+		if err := sf.restart(); err != nil {
+			logging.Logger.Error("Failed to restart session", "error", err)
+			sf.result.Error = err
+		}
+		return sf, nil
+	}
+
+	return sf, cmd
+}
+
+// Done reports whether the session was restarted or the form cancelled
+func (sf *SessionRestartForm) Done() bool {
+	return sf.Completed
+}
+
+func (sf *SessionRestartForm) View() string {
+	if sf.form != nil {
+		return sf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (sf *SessionRestartForm) Result() SessionRestartFormResult {
+	return sf.result
+}
+
+// restart performs the restart with the selected mode
+func (sf *SessionRestartForm) restart() error {
+	logging.Logger.Info("Restarting session", "session", sf.result.Session.Name, "mode", sf.result.Mode)
+	return sf.sessionService.RestartSession(context.Background(), sf.result.Session.Name, sf.result.Mode, sf.tmuxStatusPosition)
+}
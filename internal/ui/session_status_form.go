@@ -8,15 +8,16 @@ import (
 	"github.com/charmbracelet/huh"
 
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/i18n"
 	"github.com/renato0307/rocha/internal/logging"
 	"github.com/renato0307/rocha/internal/services"
 )
 
 // SessionStatusFormResult contains the result of the status update operation
 type SessionStatusFormResult struct {
-	Cancelled bool
-	Error     error
-	SessionName string // Session being updated
+	Cancelled   bool
+	Error       error
+	SessionName string  // Session being updated
 	Status      *string // New status (nil = cleared)
 }
 
@@ -47,7 +48,7 @@ func NewSessionStatusForm(sessionService *services.SessionService, sessionName s
 	options := make([]huh.Option[string], 0, len(statusConfig.Statuses)+1)
 
 	// Add clear option at the top
-	options = append(options, huh.NewOption("<clear>", "<clear>"))
+	options = append(options, huh.NewOption(i18n.T("status.clear"), "<clear>"))
 
 	// Add all configured statuses (no icons, colors will be shown in the list)
 	for _, status := range statusConfig.Statuses {
@@ -65,8 +66,8 @@ func NewSessionStatusForm(sessionService *services.SessionService, sessionName s
 	sf.form = huh.NewForm(
 		huh.NewGroup(
 			huh.NewSelect[string]().
-				Title("Set implementation status").
-				Description(fmt.Sprintf("Session: %s", sessionName)).
+				Title(i18n.T("status.title")).
+				Description(i18n.Tf("form.session", sessionName)).
 				Options(options...).
 				Value(&sf.selectedItem),
 		),
@@ -110,6 +111,11 @@ func (sf *SessionStatusForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return sf, cmd
 }
 
+// Done reports whether the status was saved or the form cancelled
+func (sf *SessionStatusForm) Done() bool {
+	return sf.Completed
+}
+
 func (sf *SessionStatusForm) View() string {
 	if sf.form != nil {
 		return sf.form.View()
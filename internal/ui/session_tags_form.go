@@ -0,0 +1,127 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// SessionTagsFormResult contains the result of the tags operation
+type SessionTagsFormResult struct {
+	Cancelled   bool
+	Error       error
+	NewTags     string
+	SessionName string
+}
+
+// SessionTagsForm is a Bubble Tea component for editing session tags
+type SessionTagsForm struct {
+	Completed      bool
+	cancelled      bool
+	form           *huh.Form
+	result         SessionTagsFormResult
+	sessionName    string
+	sessionService *services.SessionService
+}
+
+// NewSessionTagsForm creates a new session tags form
+func NewSessionTagsForm(sessionService *services.SessionService, sessionName string, currentTags []string) *SessionTagsForm {
+	sf := &SessionTagsForm{
+		sessionName:    sessionName,
+		sessionService: sessionService,
+		result: SessionTagsFormResult{
+			SessionName: sessionName,
+			NewTags:     strings.Join(currentTags, " "), // Preload the current tags for editing
+		},
+	}
+
+	sf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title(i18n.T("tags.title")).
+				Description(i18n.Tf("tags.desc", sessionName)).
+				Value(&sf.result.NewTags).
+				Validate(func(s string) error {
+					_, err := domain.NormalizeTags(splitTags(s))
+					return err
+				}),
+		),
+	)
+
+	return sf
+}
+
+func (sf *SessionTagsForm) Init() tea.Cmd {
+	return sf.form.Init()
+}
+
+func (sf *SessionTagsForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			sf.cancelled = true
+			sf.result.Cancelled = true
+			sf.Completed = true
+			return sf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := sf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+
+	// Check if form completed
+	if sf.form.State == huh.StateCompleted {
+		sf.Completed = true
+		if err := sf.updateTags(); err != nil {
+			logging.Logger.Error("Failed to update tags", "error", err)
+			sf.result.Error = err
+		}
+		return sf, nil
+	}
+
+	return sf, cmd
+}
+
+// Done reports whether the tags were saved or the form cancelled
+func (sf *SessionTagsForm) Done() bool {
+	return sf.Completed
+}
+
+func (sf *SessionTagsForm) View() string {
+	if sf.form != nil {
+		return sf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (sf *SessionTagsForm) Result() SessionTagsFormResult {
+	return sf.result
+}
+
+// updateTags replaces the session tags with the ones typed in the form
+func (sf *SessionTagsForm) updateTags() error {
+	tags, err := sf.sessionService.SetTags(context.Background(), sf.sessionName, splitTags(sf.result.NewTags))
+	if err != nil {
+		return fmt.Errorf("failed to update session tags: %w", err)
+	}
+
+	logging.Logger.Info("Session tags updated successfully", "session_name", sf.sessionName, "tags", tags)
+	return nil
+}
+
+// splitTags splits the form input on spaces and commas
+func splitTags(input string) []string {
+	return strings.FieldsFunc(input, func(r rune) bool { return r == ' ' || r == ',' })
+}
@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+// defaultTimerDuration is offered when a session has no running timer
+const defaultTimerDuration = "20m"
+
+// SessionTimerFormResult contains the result of the timer operation
+type SessionTimerFormResult struct {
+	Cancelled   bool
+	Duration    string
+	Error       error
+	Label       string
+	SessionName string
+}
+
+// SessionTimerForm is a Bubble Tea component for setting or clearing a session timer
+type SessionTimerForm struct {
+	Completed    bool
+	cancelled    bool
+	form         *huh.Form
+	result       SessionTimerFormResult
+	sessionName  string
+	timerService *services.TimerService
+}
+
+// NewSessionTimerForm creates a new session timer form
+// A running timer is preloaded with its remaining time, so it can be extended or cleared.
+func NewSessionTimerForm(timerService *services.TimerService, sessionName string, current *domain.SessionTimer) *SessionTimerForm {
+	sf := &SessionTimerForm{
+		sessionName:  sessionName,
+		timerService: timerService,
+		result: SessionTimerFormResult{
+			Duration:    defaultTimerDuration,
+			SessionName: sessionName,
+		},
+	}
+	if current != nil && !current.Elapsed(time.Now()) {
+		remaining := max(current.Remaining(time.Now()).Round(time.Minute), time.Minute)
+		sf.result.Duration = strings.TrimSuffix(remaining.String(), "0s") // "1h5m0s" reads as "1h5m"
+		sf.result.Label = current.Label
+	}
+
+	sf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewInput().
+				Title(i18n.T("timer.title")).
+				Description(i18n.Tf("timer.desc", sessionName)).
+				Value(&sf.result.Duration).
+				Validate(func(s string) error {
+					_, err := parseTimerDuration(s)
+					return err
+				}),
+			huh.NewInput().
+				Title(i18n.T("timer.label")).
+				Value(&sf.result.Label).
+				CharLimit(domain.MaxTimerLabelLength),
+		),
+	)
+
+	return sf
+}
+
+func (sf *SessionTimerForm) Init() tea.Cmd {
+	return sf.form.Init()
+}
+
+func (sf *SessionTimerForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			sf.cancelled = true
+			sf.result.Cancelled = true
+			sf.Completed = true
+			return sf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := sf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		sf.form = f
+	}
+
+	// Check if form completed
+	if sf.form.State == huh.StateCompleted {
+		sf.Completed = true
+		if err := sf.updateTimer(); err != nil {
+			logging.Logger.Error("Failed to update timer", "error", err)
+			sf.result.Error = err
+		}
+		return sf, nil
+	}
+
+	return sf, cmd
+}
+
+// Done reports whether the timer was saved or the form cancelled
+func (sf *SessionTimerForm) Done() bool {
+	return sf.Completed
+}
+
+func (sf *SessionTimerForm) View() string {
+	if sf.form != nil {
+		return sf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (sf *SessionTimerForm) Result() SessionTimerFormResult {
+	return sf.result
+}
+
+// updateTimer starts the timer typed in the form, or clears it when no duration was given
+func (sf *SessionTimerForm) updateTimer() error {
+	d, err := parseTimerDuration(sf.result.Duration)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	if d == 0 {
+		return sf.timerService.ClearTimer(ctx, sf.sessionName)
+	}
+	if _, err := sf.timerService.SetTimer(ctx, sf.sessionName, d, sf.result.Label); err != nil {
+		return err
+	}
+
+	logging.Logger.Info("Session timer set", "session_name", sf.sessionName, "duration", d)
+	return nil
+}
+
+// parseTimerDuration parses the duration typed in the form; empty means no timer
+func parseTimerDuration(input string) (time.Duration, error) {
+	input = strings.TrimSpace(input)
+	if input == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(input)
+	if err != nil || d <= 0 {
+		return 0, errors.New(i18n.T("timer.invalid"))
+	}
+	return d, nil
+}
+
+// timerChip renders the countdown shown after the session name, highlighted once it is due
+func timerChip(timer *domain.SessionTimer, now time.Time, icon string) string {
+	if timer.Elapsed(now) {
+		return theme.TimerDueStyle.Render(icon + " due")
+	}
+	return theme.TimerStyle.Render(icon + " " + domain.FormatTimerRemaining(timer.Remaining(now)))
+}
@@ -0,0 +1,70 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// StashErrorMsg is sent when stashing or unstashing a session worktree failed
+type StashErrorMsg struct {
+	Err         error
+	Pop         bool // The stash was being popped
+	SessionName string
+}
+
+// StartStash stashes the uncommitted changes of a session worktree, then refreshes its
+// git stats so the list shows the new stash count
+// Returns a tea.Cmd that will send GitStatsReadyMsg, GitStatsErrorMsg, or StashErrorMsg
+func StartStash(gitService *services.GitService, request GitStatsRequest) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		stashed, err := gitService.StashChanges(ctx, request.WorktreePath, request.SessionName)
+		if err == nil && !stashed {
+			err = errors.New("no changes to stash")
+		}
+		if err != nil {
+			logging.Logger.Warn("Failed to stash session changes",
+				"session", request.SessionName,
+				"error", err)
+			return StashErrorMsg{
+				Err:         err,
+				SessionName: request.SessionName,
+			}
+		}
+
+		logging.Logger.Info("Session changes stashed", "session", request.SessionName)
+		return StartGitStatsFetcher(gitService, request)()
+	}
+}
+
+// StartUnstash pops the latest stash of a session worktree, then refreshes its git stats
+// Returns a tea.Cmd that will send GitStatsReadyMsg, GitStatsErrorMsg, or StashErrorMsg
+func StartUnstash(gitService *services.GitService, request GitStatsRequest) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		stash, err := gitService.PopStash(ctx, request.WorktreePath)
+		if err != nil {
+			logging.Logger.Warn("Failed to pop session stash",
+				"session", request.SessionName,
+				"error", err)
+			return StashErrorMsg{
+				Err:         err,
+				Pop:         true,
+				SessionName: request.SessionName,
+			}
+		}
+
+		logging.Logger.Info("Session stash popped", "session", request.SessionName, "stash", stash.Message)
+		return StartGitStatsFetcher(gitService, request)()
+	}
+}
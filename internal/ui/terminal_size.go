@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+// Smallest terminal the list, dialogs, and palette can be laid out in
+const (
+	minTerminalHeight = 12
+	minTerminalWidth  = 40
+)
+
+// terminalTooSmall reports whether the terminal is below the minimum size.
+// A zero size means no WindowSizeMsg arrived yet, which is not a warning.
+func terminalTooSmall(width, height int) bool {
+	if width == 0 && height == 0 {
+		return false
+	}
+	return width < minTerminalWidth || height < minTerminalHeight
+}
+
+// renderTerminalTooSmall asks for a bigger terminal, centered in the space there is
+func renderTerminalTooSmall(width, height int) string {
+	message := theme.ErrorStyle.Render("Terminal too small") + "\n" +
+		fmt.Sprintf("%dx%d, needs %dx%d", width, height, minTerminalWidth, minTerminalHeight)
+	return lipgloss.Place(width, height, lipgloss.Center, lipgloss.Center, message)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/domain"
 )
 
 // TimestampMode represents the display mode for timestamps
@@ -13,9 +14,47 @@ type TimestampMode int
 const (
 	TimestampHidden   TimestampMode = 0 // Don't show timestamps
 	TimestampRelative TimestampMode = 1 // Show relative time (e.g., "5m ago")
-	TimestampAbsolute TimestampMode = 2 // Show absolute time (e.g., "Jan 19 14:30")
+	TimestampAbsolute TimestampMode = 2 // Show absolute time (e.g., "2024-01-19 14:30")
+	TimestampHybrid   TimestampMode = 3 // Show both (e.g., "5m ago · 2024-01-19 14:30")
 )
 
+// absoluteTimeFormat is the layout and time zone of absolute timestamps, set from settings
+var absoluteTimeFormat domain.TimeFormat
+
+// SetTimeFormat sets the layout and time zone absolute timestamps are shown in
+func SetTimeFormat(format domain.TimeFormat) {
+	absoluteTimeFormat = format
+}
+
+// ParseTimestampMode returns the mode named relative, absolute, or hybrid
+func ParseTimestampMode(name string) (TimestampMode, error) {
+	switch name {
+	case "", "relative":
+		return TimestampRelative, nil
+	case "absolute":
+		return TimestampAbsolute, nil
+	case "hybrid":
+		return TimestampHybrid, nil
+	default:
+		return TimestampRelative, fmt.Errorf("%w: timestamp mode %q must be relative, absolute, or hybrid", domain.ErrInvalidInput, name)
+	}
+}
+
+// nextTimestampMode returns the mode after mode in the cycle
+// Relative -> Absolute -> Hybrid -> Hidden -> Relative
+func nextTimestampMode(mode TimestampMode) TimestampMode {
+	switch mode {
+	case TimestampRelative:
+		return TimestampAbsolute
+	case TimestampAbsolute:
+		return TimestampHybrid
+	case TimestampHybrid:
+		return TimestampHidden
+	default:
+		return TimestampRelative
+	}
+}
+
 // formatRelativeTime converts a timestamp to a human-readable relative time string.
 // Returns empty string for zero times.
 //
@@ -79,16 +118,21 @@ func formatWithUnit(value int, unit string) string {
 	return fmt.Sprintf("%d%s ago", value, unit)
 }
 
-// formatAbsoluteTime converts a timestamp to absolute date/time format.
-// Returns empty string for zero times.
+// formatAbsoluteTime converts a timestamp to absolute date/time format, in the layout
+// and time zone set with SetTimeFormat. Returns empty string for zero times.
 //
-// Format: "YYYY-MM-DD HH:MM" (e.g., "2024-01-19 14:30")
+// Default format: "YYYY-MM-DD HH:MM" in local time (e.g., "2024-01-19 14:30")
 func formatAbsoluteTime(t time.Time) string {
+	return absoluteTimeFormat.Format(t)
+}
+
+// formatHybridTime shows a timestamp both relative and absolute (e.g., "5m ago · 2024-01-19 14:30")
+// Returns empty string for zero times.
+func formatHybridTime(t time.Time) string {
 	if t.IsZero() {
 		return ""
 	}
-
-	return t.Format("2006-01-02 15:04")
+	return formatRelativeTime(t) + " · " + formatAbsoluteTime(t)
 }
 
 // getTimestampColor determines the color code based on how long ago the timestamp was.
@@ -0,0 +1,74 @@
+package ui
+
+import (
+	"math/rand"
+	"slices"
+	"time"
+
+	"github.com/renato0307/rocha/internal/config"
+	"github.com/renato0307/rocha/internal/logging"
+)
+
+// tipRotation picks the tips shown below the session list. Tips never shown before come
+// first, so new ones are seen soon; after that, the tip shown longest ago is next.
+type tipRotation struct {
+	shown map[string]time.Time // When each tip was last shown, by tip id
+	tips  []Tip
+}
+
+// newTipRotation builds the rotation of the registered tips, without the disabled ones,
+// and the tips of your own
+func newTipRotation(tipsConfig TipsConfig) *tipRotation {
+	for _, name := range tipsConfig.Disabled {
+		if GetKeyDefinition(name) == nil {
+			logging.Logger.Warn("Unknown key binding in tips_disabled", "name", name)
+		}
+	}
+
+	var available []Tip
+	for _, tip := range GetTips() {
+		if !slices.Contains(tipsConfig.Disabled, tip.Name) {
+			available = append(available, tip)
+		}
+	}
+	for _, text := range tipsConfig.Custom {
+		available = append(available, ParseTip(text))
+	}
+
+	shown, err := config.LoadShownTips()
+	if err != nil {
+		logging.Logger.Warn("Failed to load shown tips", "error", err)
+		shown = map[string]time.Time{}
+	}
+	return &tipRotation{shown: shown, tips: available}
+}
+
+// Next picks the tip to show at now and remembers it was shown. Returns nil without tips.
+func (r *tipRotation) Next(now time.Time) *Tip {
+	if len(r.tips) == 0 {
+		return nil
+	}
+
+	var unseen []int
+	oldest := -1
+	for i, tip := range r.tips {
+		last, ok := r.shown[tip.id()]
+		if !ok {
+			unseen = append(unseen, i)
+		} else if oldest < 0 || last.Before(r.shown[r.tips[oldest].id()]) {
+			oldest = i
+		}
+	}
+
+	pick := oldest
+	if len(unseen) > 0 {
+		pick = unseen[rand.Intn(len(unseen))]
+	}
+	tip := r.tips[pick]
+
+	r.shown[tip.id()] = now
+	if err := config.SaveShownTips(r.shown); err != nil {
+		logging.Logger.Warn("Failed to save shown tips", "error", err)
+	}
+	return &tip
+}
@@ -0,0 +1,66 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTip(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want Tip
+	}{
+		{name: "plain text", text: "ask in #platform", want: Tip{Format: "ask in #platform"}},
+		{name: "keys in backticks", text: "run `make lint` or `make fmt` first", want: Tip{Format: "run %s or %s first", Keys: []string{"make lint", "make fmt"}}},
+		{name: "unbalanced backtick", text: "use ` with care", want: Tip{Format: "use ` with care"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseTip(tt.text))
+		})
+	}
+}
+
+func TestTipRotationShowsUnseenTipsFirst(t *testing.T) {
+	t.Setenv("ROCHA_HOME", t.TempDir())
+	start := time.Date(2026, 1, 5, 9, 0, 0, 0, time.UTC)
+
+	rotation := &tipRotation{
+		shown: map[string]time.Time{"old": start.Add(-2 * time.Hour), "older": start.Add(-3 * time.Hour)},
+		tips:  []Tip{{Format: "old"}, {Format: "new"}, {Format: "older"}},
+	}
+
+	var picked []string
+	for i := range 4 {
+		tip := rotation.Next(start.Add(time.Duration(i) * time.Minute))
+		require.NotNil(t, tip)
+		picked = append(picked, tip.Format)
+	}
+	assert.Equal(t, []string{"new", "older", "old", "new"}, picked)
+
+	// What was shown outlives the TUI
+	reloaded := newTipRotation(TipsConfig{Custom: []string{"new"}})
+	assert.Equal(t, start.Add(3*time.Minute), reloaded.shown["new"])
+}
+
+func TestTipRotationSkipsDisabledTips(t *testing.T) {
+	t.Setenv("ROCHA_HOME", t.TempDir())
+	NewKeyMap(nil)
+
+	rotation := newTipRotation(TipsConfig{Custom: []string{"team tip"}, Disabled: []string{"filter"}})
+
+	var names []string
+	for _, tip := range rotation.tips {
+		names = append(names, tip.id())
+	}
+	assert.NotContains(t, names, "filter")
+	assert.Contains(t, names, "timestamps")
+	assert.Contains(t, names, "team tip")
+
+	assert.Nil(t, (&tipRotation{}).Next(time.Now()))
+}
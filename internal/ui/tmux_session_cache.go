@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"sync"
+	"time"
+
+	"github.com/renato0307/rocha/internal/logging"
+	"github.com/renato0307/rocha/internal/ports"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// TmuxSessionCache remembers which tmux sessions are running so list rebuilds
+// don't fork tmux once per session. It is refreshed with a single list-sessions
+// call per poll and invalidated whenever rocha creates or kills sessions.
+type TmuxSessionCache struct {
+	listSessions func() ([]*ports.TmuxSession, error)
+	maxAge       time.Duration
+	mu           sync.Mutex
+	names        map[string]bool
+	refreshedAt  time.Time // Zero when the cache was never loaded or was invalidated
+}
+
+// NewTmuxSessionCache creates a cache backed by the session service
+// Entries older than maxAge are reloaded on the next lookup
+func NewTmuxSessionCache(sessionService *services.SessionService, maxAge time.Duration) *TmuxSessionCache {
+	return newTmuxSessionCache(sessionService.ListTmuxSessions, maxAge)
+}
+
+func newTmuxSessionCache(listSessions func() ([]*ports.TmuxSession, error), maxAge time.Duration) *TmuxSessionCache {
+	return &TmuxSessionCache{
+		listSessions: listSessions,
+		maxAge:       maxAge,
+		names:        make(map[string]bool),
+	}
+}
+
+// Refresh reloads the running sessions from tmux
+func (c *TmuxSessionCache) Refresh() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshLocked()
+}
+
+// Exists reports whether a tmux session is running, as of the last refresh
+func (c *TmuxSessionCache) Exists(name string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.refreshedAt.IsZero() || time.Since(c.refreshedAt) > c.maxAge {
+		c.refreshLocked()
+	}
+	return c.names[name]
+}
+
+// ExistsConfirmed is like Exists, but asks tmux again before reporting a session as missing.
+// Use it before acting on a missing session (e.g., offering a restart).
+func (c *TmuxSessionCache) ExistsConfirmed(name string) bool {
+	if c.Exists(name) {
+		return true
+	}
+
+	c.Refresh()
+	return c.Exists(name)
+}
+
+// Invalidate forces the next lookup to reload from tmux
+func (c *TmuxSessionCache) Invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.refreshedAt = time.Time{}
+}
+
+func (c *TmuxSessionCache) refreshLocked() {
+	names := make(map[string]bool)
+
+	sessions, err := c.listSessions()
+	if err != nil {
+		// tmux reports an error when no server is running: no sessions exist
+		logging.Logger.Debug("No tmux sessions running or tmux error", "error", err)
+	}
+	for _, session := range sessions {
+		names[session.Name] = true
+	}
+
+	c.names = names
+	c.refreshedAt = time.Now()
+}
@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/renato0307/rocha/internal/ports"
+)
+
+// fakeTmuxLister returns the configured sessions and counts list-sessions calls
+type fakeTmuxLister struct {
+	calls    int
+	err      error
+	sessions []string
+}
+
+func (f *fakeTmuxLister) list() ([]*ports.TmuxSession, error) {
+	f.calls++
+	sessions := make([]*ports.TmuxSession, len(f.sessions))
+	for i, name := range f.sessions {
+		sessions[i] = &ports.TmuxSession{Name: name}
+	}
+	return sessions, f.err
+}
+
+func TestTmuxSessionCache_ExistsUsesOneCallPerRefresh(t *testing.T) {
+	lister := &fakeTmuxLister{sessions: []string{"api", "api-shell"}}
+	cache := newTmuxSessionCache(lister.list, time.Minute)
+
+	cache.Refresh()
+	assert.True(t, cache.Exists("api"))
+	assert.True(t, cache.Exists("api-shell"))
+	assert.False(t, cache.Exists("web"))
+
+	assert.Equal(t, 1, lister.calls)
+}
+
+func TestTmuxSessionCache_LoadsLazilyAndWhenStale(t *testing.T) {
+	lister := &fakeTmuxLister{sessions: []string{"api"}}
+	cache := newTmuxSessionCache(lister.list, time.Minute)
+
+	assert.True(t, cache.Exists("api"))
+	assert.Equal(t, 1, lister.calls)
+
+	cache.refreshedAt = time.Now().Add(-2 * time.Minute)
+	lister.sessions = nil
+	assert.False(t, cache.Exists("api"))
+	assert.Equal(t, 2, lister.calls)
+}
+
+func TestTmuxSessionCache_Invalidate(t *testing.T) {
+	lister := &fakeTmuxLister{}
+	cache := newTmuxSessionCache(lister.list, time.Minute)
+
+	assert.False(t, cache.Exists("api"))
+	lister.sessions = []string{"api"}
+	assert.False(t, cache.Exists("api"), "cached until invalidated")
+
+	cache.Invalidate()
+	assert.True(t, cache.Exists("api"))
+	assert.Equal(t, 2, lister.calls)
+}
+
+func TestTmuxSessionCache_ExistsConfirmedRechecksMisses(t *testing.T) {
+	lister := &fakeTmuxLister{}
+	cache := newTmuxSessionCache(lister.list, time.Minute)
+	cache.Refresh()
+
+	// Created after the last refresh
+	lister.sessions = []string{"api"}
+	assert.True(t, cache.ExistsConfirmed("api"))
+	assert.Equal(t, 2, lister.calls)
+
+	// Hits don't ask tmux again
+	assert.True(t, cache.ExistsConfirmed("api"))
+	assert.Equal(t, 2, lister.calls)
+}
+
+func TestTmuxSessionCache_ListErrorMeansNoSessions(t *testing.T) {
+	lister := &fakeTmuxLister{err: errors.New("no server running")}
+	cache := newTmuxSessionCache(lister.list, time.Minute)
+
+	assert.False(t, cache.Exists("api"))
+}
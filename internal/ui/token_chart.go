@@ -2,26 +2,52 @@ package ui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/NimbleMarkets/ntcharts/barchart"
 	"github.com/charmbracelet/lipgloss"
 
+	"github.com/renato0307/rocha/internal/domain"
 	"github.com/renato0307/rocha/internal/ports"
 	"github.com/renato0307/rocha/internal/services"
 	"github.com/renato0307/rocha/internal/theme"
 )
 
 const (
-	tokenChartHeight   = 6   // Height of the chart area
-	tokenChartWidth    = 120 // Fixed width for 24 hours with 2 bars each
-	tokenChartBarWidth = 2   // Bar width
-	tokenChartBarGap   = 0   // No gap between in/out bars, gap added between hours
+	tokenChartHeight        = 6   // Height of the chart area
+	tokenChartWidth         = 120 // Fixed width for 24 hours with 2 bars each
+	tokenChartBarWidth      = 2   // Bar width
+	tokenChartBarGap        = 0   // No gap between in/out bars, gap added between hours
+	tokenChartModelBarWidth = 4   // Bar width of the per-model chart, one stacked bar per hour
+	tokenChartModelBarGap   = 1   // Gap between the hours of the per-model chart
 )
 
+// TokenChartOptions selects how the token chart splits the usage of each hour
+type TokenChartOptions struct {
+	ByModel   bool // Stack each hour's tokens per model instead of input beside output
+	ShowCache bool // Include cache reads and writes, which dwarf fresh tokens when included
+}
+
+// tokenModelSeries is the stacked series of a model in the per-model token chart
+type tokenModelSeries struct {
+	cache int
+	fresh int
+	name  string
+}
+
 // RenderTokenChart renders a token usage chart with the given data.
 // This is used by both the TUI and CLI to ensure consistent formatting.
-func RenderTokenChart(hourly []ports.HourlyTokenUsage, totals ports.TokenTotals) string {
+func RenderTokenChart(hourly []ports.HourlyTokenUsage, totals ports.TokenTotals, opts TokenChartOptions) string {
+	if opts.ByModel {
+		return renderModelTokenChart(hourly, opts.ShowCache)
+	}
+	return renderDirectionTokenChart(hourly, totals, opts.ShowCache)
+}
+
+// renderDirectionTokenChart renders input and output bars side by side for each hour,
+// stacking the cache tokens on top of the input bar when showCache is set
+func renderDirectionTokenChart(hourly []ports.HourlyTokenUsage, totals ports.TokenTotals, showCache bool) string {
 	var sb strings.Builder
 
 	// Build a map of hourly data for quick lookup
@@ -32,20 +58,13 @@ func RenderTokenChart(hourly []ports.HourlyTokenUsage, totals ports.TokenTotals)
 
 	// Find max values for scaling and display
 	var maxVal float64
-	var maxInput, maxOutput int
+	var maxInput, maxOutput, maxCache int
 	for _, h := range hourly {
-		if h.InputTokens > maxInput {
-			maxInput = h.InputTokens
-		}
-		if h.OutputTokens > maxOutput {
-			maxOutput = h.OutputTokens
-		}
-		if float64(h.InputTokens) > maxVal {
-			maxVal = float64(h.InputTokens)
-		}
-		if float64(h.OutputTokens) > maxVal {
-			maxVal = float64(h.OutputTokens)
-		}
+		cache := hourCacheTokens(h, showCache)
+		maxInput = max(maxInput, h.InputTokens)
+		maxOutput = max(maxOutput, h.OutputTokens)
+		maxCache = max(maxCache, cache)
+		maxVal = max(maxVal, float64(h.InputTokens+cache), float64(h.OutputTokens))
 	}
 
 	if maxVal == 0 {
@@ -53,44 +72,47 @@ func RenderTokenChart(hourly []ports.HourlyTokenUsage, totals ports.TokenTotals)
 	}
 
 	// Legend with arrows, totals, and max values
-	inputTotal := formatTokenCount(totals.InputTokens)
-	inputMaxStr := formatTokenCount(maxInput)
-	outputTotal := formatTokenCount(totals.OutputTokens)
-	outputMaxStr := formatTokenCount(maxOutput)
+	inputTotal := domain.FormatTokenCount(totals.InputTokens)
+	inputMaxStr := domain.FormatTokenCount(maxInput)
+	outputTotal := domain.FormatTokenCount(totals.OutputTokens)
+	outputMaxStr := domain.FormatTokenCount(maxOutput)
 
 	legend := theme.TokenChartLegendStyle.Render("Usage: ") +
 		theme.TokenInputStyle.Render("↑") +
 		theme.TokenChartLegendStyle.Render(" input: "+inputTotal+" (max: "+inputMaxStr+")  ") +
 		theme.TokenOutputStyle.Render("↓") +
 		theme.TokenChartLegendStyle.Render(" output: "+outputTotal+" (max: "+outputMaxStr+")")
+	if showCache {
+		cacheTotal := domain.FormatTokenCount(totals.CacheCreation + totals.CacheRead)
+		legend += theme.TokenChartLegendStyle.Render("  ") +
+			theme.TokenCacheStyle.Render("■") +
+			theme.TokenChartLegendStyle.Render(" cache: "+cacheTotal+" (max: "+domain.FormatTokenCount(maxCache)+")")
+	}
 
 	sb.WriteString(legend)
 	sb.WriteString("\n\n")
 
-	// Create bar chart
-	axisStyle := lipgloss.NewStyle().Foreground(theme.ColorMuted)
-	labelStyle := lipgloss.NewStyle().Foreground(theme.ColorSubtle)
-	chart := barchart.New(tokenChartWidth, tokenChartHeight,
-		barchart.WithStyles(axisStyle, labelStyle),
-	)
-	chart.SetBarWidth(tokenChartBarWidth)
-	chart.SetBarGap(tokenChartBarGap)
-	chart.SetMax(maxVal)
+	chart := newTokenBarChart(tokenChartBarWidth, tokenChartBarGap, maxVal)
 
-	// Create styles for input (green) and output (blue)
+	// Create styles for input (green), output (blue), and cache (dark green)
 	inputStyle := lipgloss.NewStyle().Foreground(theme.ColorTokenInput)
 	outputStyle := lipgloss.NewStyle().Foreground(theme.ColorTokenOutput)
+	cacheStyle := lipgloss.NewStyle().Foreground(theme.ColorTokenCache)
 
 	// Push bar data for all 24 hours (input + output side by side)
 	for hour := 0; hour < 24; hour++ {
 		h := hourlyMap[hour] // Will be zero struct if not present
 
-		// Input bar with hour label
+		// Input bar with hour label, cache tokens stacked on top
+		inputValues := []barchart.BarValue{
+			{Name: "in", Value: float64(h.InputTokens), Style: inputStyle},
+		}
+		if showCache {
+			inputValues = append(inputValues, barchart.BarValue{Name: "cache", Value: float64(hourCacheTokens(h, true)), Style: cacheStyle})
+		}
 		chart.Push(barchart.BarData{
-			Label: fmt.Sprintf("%02d", hour),
-			Values: []barchart.BarValue{
-				{Name: "in", Value: float64(h.InputTokens), Style: inputStyle},
-			},
+			Label:  fmt.Sprintf("%02d", hour),
+			Values: inputValues,
 		})
 		// Output bar (no label, pairs with input)
 		chart.Push(barchart.BarData{
@@ -107,9 +129,184 @@ func RenderTokenChart(hourly []ports.HourlyTokenUsage, totals ports.TokenTotals)
 	return sb.String()
 }
 
+// renderModelTokenChart renders one bar per hour stacking the fresh tokens of each model,
+// followed by its cache tokens when showCache is set
+func renderModelTokenChart(hourly []ports.HourlyTokenUsage, showCache bool) string {
+	var sb strings.Builder
+
+	series, seriesIndex := buildTokenModelSeries(hourly, showCache)
+
+	hourlyMap := make(map[int]ports.HourlyTokenUsage)
+	for _, h := range hourly {
+		hourlyMap[h.Hour] = h
+	}
+
+	// Build the stacked values first since the chart scale needs the busiest hour
+	var bars [24][]barchart.BarValue
+	var maxVal float64
+	for hour := 0; hour < 24; hour++ {
+		var total float64
+		bars[hour], total = modelBarValues(hourlyMap[hour], series, seriesIndex, showCache)
+		maxVal = max(maxVal, total)
+	}
+
+	if maxVal == 0 {
+		maxVal = 1 // Avoid division by zero
+	}
+
+	sb.WriteString(modelTokenChartLegend(series, showCache))
+	sb.WriteString("\n\n")
+
+	chart := newTokenBarChart(tokenChartModelBarWidth, tokenChartModelBarGap, maxVal)
+	for hour, values := range bars {
+		chart.Push(barchart.BarData{
+			Label:  fmt.Sprintf("%02d", hour),
+			Values: values,
+		})
+	}
+
+	chart.Draw()
+	sb.WriteString(chart.View())
+
+	return sb.String()
+}
+
+// newTokenBarChart creates the bar chart both token chart layouts draw on
+func newTokenBarChart(barWidth, barGap int, maxVal float64) barchart.Model {
+	axisStyle := lipgloss.NewStyle().Foreground(theme.ColorMuted)
+	labelStyle := lipgloss.NewStyle().Foreground(theme.ColorSubtle)
+	chart := barchart.New(tokenChartWidth, tokenChartHeight,
+		barchart.WithStyles(axisStyle, labelStyle),
+	)
+	chart.SetBarWidth(barWidth)
+	chart.SetBarGap(barGap)
+	chart.SetMax(maxVal)
+	return chart
+}
+
+// buildTokenModelSeries ranks the models by their usage of the day, folding the models
+// the palette has no color for into "other", and returns the series index of each model
+func buildTokenModelSeries(hourly []ports.HourlyTokenUsage, showCache bool) ([]*tokenModelSeries, map[string]int) {
+	dayTotals := make(map[string]ports.TokenTotals)
+	for _, h := range hourly {
+		for model, usage := range h.Models {
+			totals := dayTotals[model]
+			totals.CacheCreation += usage.CacheCreation
+			totals.CacheRead += usage.CacheRead
+			totals.InputTokens += usage.InputTokens
+			totals.OutputTokens += usage.OutputTokens
+			dayTotals[model] = totals
+		}
+	}
+
+	models := make([]string, 0, len(dayTotals))
+	for model := range dayTotals {
+		models = append(models, model)
+	}
+	sort.Slice(models, func(i, j int) bool {
+		sizeI := modelTokens(dayTotals[models[i]], showCache)
+		sizeJ := modelTokens(dayTotals[models[j]], showCache)
+		if sizeI != sizeJ {
+			return sizeI > sizeJ
+		}
+		return models[i] < models[j]
+	})
+
+	colors := len(theme.ColorTokenModelPalette)
+	var series []*tokenModelSeries
+	seriesIndex := make(map[string]int, len(models))
+	for i, model := range models {
+		switch {
+		case len(models) <= colors || i < colors-1:
+			seriesIndex[model] = len(series)
+			series = append(series, &tokenModelSeries{name: domain.ShortModelName(model)})
+		case i == colors-1:
+			seriesIndex[model] = len(series)
+			series = append(series, &tokenModelSeries{name: "other"})
+		default:
+			seriesIndex[model] = colors - 1
+		}
+
+		totals := dayTotals[model]
+		series[seriesIndex[model]].fresh += totals.InputTokens + totals.OutputTokens
+		series[seriesIndex[model]].cache += totals.CacheCreation + totals.CacheRead
+	}
+
+	return series, seriesIndex
+}
+
+// modelBarValues returns the stacked values of an hour in series order and their sum
+func modelBarValues(h ports.HourlyTokenUsage, series []*tokenModelSeries, seriesIndex map[string]int, showCache bool) ([]barchart.BarValue, float64) {
+	fresh := make([]int, len(series))
+	cache := make([]int, len(series))
+	for model, usage := range h.Models {
+		fresh[seriesIndex[model]] += usage.InputTokens + usage.OutputTokens
+		cache[seriesIndex[model]] += usage.CacheCreation + usage.CacheRead
+	}
+
+	var values []barchart.BarValue
+	var total float64
+	for i, s := range series {
+		values = append(values, barchart.BarValue{Name: s.name, Value: float64(fresh[i]), Style: tokenModelStyle(i, false)})
+		total += float64(fresh[i])
+		if showCache {
+			values = append(values, barchart.BarValue{Name: s.name + " cache", Value: float64(cache[i]), Style: tokenModelStyle(i, true)})
+			total += float64(cache[i])
+		}
+	}
+	return values, total
+}
+
+// modelTokenChartLegend renders the color and day totals of each model series
+func modelTokenChartLegend(series []*tokenModelSeries, showCache bool) string {
+	legend := theme.TokenChartLegendStyle.Render("Usage by model:")
+	if len(series) == 0 {
+		return legend + theme.TokenChartLegendStyle.Render(" none")
+	}
+
+	for i, s := range series {
+		entry := " " + s.name + ": " + domain.FormatTokenCount(s.fresh)
+		legend += theme.TokenChartLegendStyle.Render("  ") +
+			tokenModelStyle(i, false).Render("■") +
+			theme.TokenChartLegendStyle.Render(entry)
+		if showCache {
+			legend += theme.TokenChartLegendStyle.Render(" + ") +
+				tokenModelStyle(i, true).Render("■") +
+				theme.TokenChartLegendStyle.Render(" "+domain.FormatTokenCount(s.cache)+" cache")
+		}
+	}
+	return legend
+}
+
+// tokenModelStyle returns the style of the i-th model series, or of its cache tokens
+func tokenModelStyle(i int, cache bool) lipgloss.Style {
+	if cache {
+		return lipgloss.NewStyle().Foreground(theme.ColorTokenModelCachePalette[i])
+	}
+	return lipgloss.NewStyle().Foreground(theme.ColorTokenModelPalette[i])
+}
+
+// modelTokens returns the tokens a model used, counting cache tokens only when shown
+func modelTokens(totals ports.TokenTotals, showCache bool) int {
+	tokens := totals.InputTokens + totals.OutputTokens
+	if showCache {
+		tokens += totals.CacheCreation + totals.CacheRead
+	}
+	return tokens
+}
+
+// hourCacheTokens returns the cache reads and writes of an hour, or 0 when not shown
+func hourCacheTokens(h ports.HourlyTokenUsage, showCache bool) int {
+	if !showCache {
+		return 0
+	}
+	return h.CacheCreation + h.CacheRead
+}
+
 // TokenChart displays a grouped bar chart of token usage by hour
 type TokenChart struct {
 	hourlyUsage  []ports.HourlyTokenUsage
+	options      TokenChartOptions
 	statsService *services.TokenStatsService
 	totals       ports.TokenTotals
 	visible      bool
@@ -141,6 +338,23 @@ func (tc *TokenChart) Toggle() {
 	tc.SetVisible(!tc.visible)
 }
 
+// ToggleByModel switches between input/output bars and bars stacked per model,
+// showing the chart when hidden
+func (tc *TokenChart) ToggleByModel() {
+	tc.options.ByModel = !tc.options.ByModel
+	if !tc.visible {
+		tc.SetVisible(true)
+	}
+}
+
+// ToggleCache includes or excludes cache tokens, showing the chart when hidden
+func (tc *TokenChart) ToggleCache() {
+	tc.options.ShowCache = !tc.options.ShowCache
+	if !tc.visible {
+		tc.SetVisible(true)
+	}
+}
+
 // Height returns the total height of the chart component (including spacing after)
 func (tc *TokenChart) Height() int {
 	if !tc.visible {
@@ -176,16 +390,14 @@ func (tc *TokenChart) View() string {
 	if !tc.visible {
 		return ""
 	}
-	return RenderTokenChart(tc.hourlyUsage, tc.totals)
+	return RenderTokenChart(tc.hourlyUsage, tc.totals, tc.options)
 }
 
-// formatTokenCount formats a token count with K/M suffixes
-func formatTokenCount(count int) string {
-	if count >= 1_000_000 {
-		return fmt.Sprintf("%.1fM", float64(count)/1_000_000)
-	}
-	if count >= 1_000 {
-		return fmt.Sprintf("%.0fK", float64(count)/1_000)
+// tokenBudgetChip renders the tokens a session used against its budget, highlighted once exceeded
+func tokenBudgetChip(budget *domain.SessionTokenBudget, icon string) string {
+	usage := icon + " " + domain.FormatTokenCount(budget.Used) + "/" + domain.FormatTokenCount(budget.Limit)
+	if budget.Exceeded {
+		return theme.TokenBudgetExceededStyle.Render(usage)
 	}
-	return fmt.Sprintf("%d", count)
+	return theme.TokenBudgetStyle.Render(usage)
 }
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/theme"
+)
+
+// ToolAuditScreen lists the tools run by a session that skips permission prompts
+type ToolAuditScreen struct {
+	Completed   bool
+	content     string         // Pre-built audit content
+	initialized bool           // Track if viewport has been sized
+	keys        *KeyMap        // Key bindings for closing
+	viewport    viewport.Model // Scrollable viewport
+}
+
+// NewToolAuditScreen creates an audit screen from recorded tool uses (most recent first)
+func NewToolAuditScreen(skipsPermissions bool, toolUses []domain.ToolUse, loadErr error, keys *KeyMap) *ToolAuditScreen {
+	return &ToolAuditScreen{
+		content:  buildToolAuditContent(skipsPermissions, toolUses, loadErr),
+		keys:     keys,
+		viewport: viewport.New(0, 0),
+	}
+}
+
+// buildToolAuditContent renders one line per tool use, marking failed calls
+func buildToolAuditContent(skipsPermissions bool, toolUses []domain.ToolUse, loadErr error) string {
+	var content string
+
+	if skipsPermissions {
+		content += theme.SkipPermissionsStyle.Render("⛨ permission prompts are skipped for this session") + "\n\n"
+	}
+
+	content += theme.HelpGroupStyle.Render("Tool Uses (newest first)") + "\n"
+	switch {
+	case loadErr != nil:
+		content += theme.HelpDescStyle.Render("failed to load tool uses: "+loadErr.Error()) + "\n"
+	case len(toolUses) == 0 && !skipsPermissions:
+		content += theme.HelpDescStyle.Render("tools are only recorded for sessions that skip permission prompts") + "\n"
+	case len(toolUses) == 0:
+		content += theme.HelpDescStyle.Render("no tool uses recorded yet") + "\n"
+	default:
+		for _, toolUse := range toolUses {
+			desc := fmt.Sprintf("%s %s", toolUse.ToolName, toolUse.Summary)
+			if toolUse.Failed {
+				desc = "✗ " + desc
+			}
+			content += renderShortcut(toolUse.OccurredAt.Local().Format("01-02 15:04:05"), desc)
+		}
+	}
+
+	return content
+}
+
+// Init implements tea.Model
+func (a *ToolAuditScreen) Init() tea.Cmd {
+	a.viewport.KeyMap.Up.SetKeys("up", "k")
+	a.viewport.KeyMap.Down.SetKeys("down", "j")
+	return nil
+}
+
+// Update implements tea.Model
+func (a *ToolAuditScreen) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Footer: 2 lines (the dialog passes the height below its header)
+		viewportHeight := msg.Height - 2
+		if viewportHeight < 5 {
+			viewportHeight = 5
+		}
+
+		a.viewport.Width = msg.Width
+		a.viewport.Height = viewportHeight
+		a.viewport.SetContent(a.content)
+		a.initialized = true
+		return a, nil
+
+	case tea.KeyMsg:
+		if msg.String() == "esc" || key.Matches(msg, a.keys.Application.Quit.Binding, a.keys.SessionActions.ToolAudit.Binding) {
+			a.Completed = true
+			return a, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	a.viewport, cmd = a.viewport.Update(msg)
+	return a, cmd
+}
+
+// Done reports whether the screen was closed
+func (a *ToolAuditScreen) Done() bool {
+	return a.Completed
+}
+
+// View implements tea.Model
+func (a *ToolAuditScreen) View() string {
+	if !a.initialized {
+		return "Loading tool audit..."
+	}
+
+	footer := theme.HelpStyle.Render("Press esc or q to close • ↑↓/jk/PgUp/PgDn to scroll")
+	return a.viewport.View() + "\n\n" + footer
+}
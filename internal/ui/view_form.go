@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/domain"
+	"github.com/renato0307/rocha/internal/i18n"
+)
+
+// saveViewOption is the select value that saves the applied filter and sort as a view
+const saveViewOption = -1
+
+// ViewFormResult contains the view chosen in the views dialog
+type ViewFormResult struct {
+	Cancelled bool
+	Index     int    // View to apply, or saveViewOption
+	SaveName  string // Name of the view to save when Index is saveViewOption
+}
+
+// ViewForm is a Bubble Tea component for applying a named view or saving the current one
+type ViewForm struct {
+	Completed bool
+	form      *huh.Form
+	result    ViewFormResult
+}
+
+// NewViewForm creates a new views dialog with the current view selected
+func NewViewForm(views []domain.View, currentView string) *ViewForm {
+	vf := &ViewForm{
+		result: ViewFormResult{Index: saveViewOption, SaveName: currentView},
+	}
+	if i := domain.FindView(views, currentView); i >= 0 {
+		vf.result.Index = i
+	}
+
+	options := make([]huh.Option[int], 0, len(views)+1)
+	for i, view := range views {
+		label := view.Name
+		if i < domain.MaxViewShortcuts {
+			label = fmt.Sprintf("%d. %s", i+1, view.Name)
+		}
+		options = append(options, huh.NewOption(label, i))
+	}
+	options = append(options, huh.NewOption(i18n.T("views.save"), saveViewOption))
+
+	description := i18n.T("views.desc")
+	if len(views) == 0 {
+		description = i18n.T("views.empty")
+	}
+
+	vf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[int]().
+				Title(i18n.T("dialog.views")).
+				Description(description).
+				Options(options...).
+				Value(&vf.result.Index),
+		),
+		huh.NewGroup(
+			huh.NewInput().
+				Title(i18n.T("views.name")).
+				Description(i18n.T("views.name_desc")).
+				Value(&vf.result.SaveName).
+				Validate(func(name string) error {
+					if strings.TrimSpace(name) == "" {
+						return errors.New(i18n.T("views.name_required"))
+					}
+					return nil
+				}),
+		).WithHideFunc(func() bool { return vf.result.Index != saveViewOption }),
+	)
+
+	return vf
+}
+
+func (vf *ViewForm) Init() tea.Cmd {
+	return vf.form.Init()
+}
+
+func (vf *ViewForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			vf.result.Cancelled = true
+			vf.Completed = true
+			return vf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := vf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		vf.form = f
+	}
+
+	if vf.form.State == huh.StateCompleted {
+		vf.Completed = true
+		return vf, nil
+	}
+
+	return vf, cmd
+}
+
+// Done reports whether a view was picked or saved, or the form cancelled
+func (vf *ViewForm) Done() bool {
+	return vf.Completed
+}
+
+func (vf *ViewForm) View() string {
+	return vf.form.View()
+}
+
+// Result returns the form result
+func (vf *ViewForm) Result() ViewFormResult {
+	return vf.result
+}
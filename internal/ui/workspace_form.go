@@ -0,0 +1,117 @@
+package ui
+
+import (
+	"context"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+
+	"github.com/renato0307/rocha/internal/i18n"
+	"github.com/renato0307/rocha/internal/services"
+)
+
+// allWorkspacesOption is the select value that lists every session
+const allWorkspacesOption = ""
+
+// WorkspaceFormResult contains the workspace chosen in the switcher
+type WorkspaceFormResult struct {
+	Cancelled bool
+	Error     error
+	Workspace string // "" lists every session
+}
+
+// WorkspaceForm is a Bubble Tea component for choosing the workspace shown in the list
+type WorkspaceForm struct {
+	Completed bool
+	form      *huh.Form
+	result    WorkspaceFormResult
+}
+
+// NewWorkspaceForm creates a new workspace switcher with the active workspace selected
+func NewWorkspaceForm(workspaceService *services.WorkspaceService, activeWorkspace string) *WorkspaceForm {
+	wf := &WorkspaceForm{
+		result: WorkspaceFormResult{Workspace: activeWorkspace},
+	}
+
+	workspaces, err := workspaceService.ListWorkspaces(context.Background())
+	if err != nil {
+		wf.Completed = true
+		wf.result.Error = err
+		return wf
+	}
+
+	options := make([]huh.Option[string], 0, len(workspaces)+1)
+	options = append(options, huh.NewOption(i18n.T("workspace.all"), allWorkspacesOption))
+	for _, workspace := range workspaces {
+		options = append(options, huh.NewOption(i18n.Tf("workspace.option", workspace.Name, len(workspace.Sessions)), workspace.Name))
+	}
+
+	description := i18n.T("workspace.desc")
+	if len(workspaces) == 0 {
+		description = i18n.T("workspace.empty")
+	}
+
+	wf.form = huh.NewForm(
+		huh.NewGroup(
+			huh.NewSelect[string]().
+				Title(i18n.T("workspace.title")).
+				Description(description).
+				Options(options...).
+				Value(&wf.result.Workspace),
+		),
+	)
+
+	return wf
+}
+
+func (wf *WorkspaceForm) Init() tea.Cmd {
+	if wf.form == nil {
+		return nil
+	}
+	return wf.form.Init()
+}
+
+func (wf *WorkspaceForm) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if wf.form == nil {
+		return wf, nil
+	}
+
+	// Handle Escape or Ctrl+C to cancel
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		if keyMsg.String() == "esc" || keyMsg.String() == "ctrl+c" {
+			wf.result.Cancelled = true
+			wf.Completed = true
+			return wf, nil
+		}
+	}
+
+	// Forward message to form
+	form, cmd := wf.form.Update(msg)
+	if f, ok := form.(*huh.Form); ok {
+		wf.form = f
+	}
+
+	if wf.form.State == huh.StateCompleted {
+		wf.Completed = true
+		return wf, nil
+	}
+
+	return wf, cmd
+}
+
+// Done reports whether a workspace was picked or the form cancelled
+func (wf *WorkspaceForm) Done() bool {
+	return wf.Completed
+}
+
+func (wf *WorkspaceForm) View() string {
+	if wf.form != nil {
+		return wf.form.View()
+	}
+	return ""
+}
+
+// Result returns the form result
+func (wf *WorkspaceForm) Result() WorkspaceFormResult {
+	return wf.result
+}
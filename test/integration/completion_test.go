@@ -0,0 +1,92 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestCompletion(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name:         "bash script",
+			args:         []string{"completion", "bash"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "complete -o default -F _rocha_complete rocha")
+			},
+		},
+		{
+			name:         "unknown shell fails",
+			args:         []string{"completion", "tcsh"},
+			wantExitCode: 1,
+		},
+		{
+			name:         "subcommands",
+			args:         []string{"__complete", "--", "sessions", "d"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "del\n")
+				harness.AssertStdoutContains(t, result, "duplicate\n")
+				harness.AssertStdoutNotContains(t, result, "list")
+			},
+		},
+		{
+			name: "session names",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				harness.AssertSuccess(t, harness.RunCommand(t, env, "sessions", "add", "rocha-fix"))
+				harness.AssertSuccess(t, harness.RunCommand(t, env, "sessions", "add", "other"))
+			},
+			args:         []string{"__complete", "--", "sessions", "del", "ro"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "rocha-fix")
+				harness.AssertStdoutNotContains(t, result, "other")
+			},
+		},
+		{
+			name:         "status values",
+			args:         []string{"__complete", "--", "sessions", "list", "--status", "re"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "review")
+			},
+		},
+		{
+			name:         "flags",
+			args:         []string{"__complete", "--", "sessions", "list", "--st"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "--status")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+
+			if tt.wantExitCode == 0 {
+				harness.AssertSuccess(t, result)
+			} else {
+				harness.AssertFailure(t, result)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestExitCodes(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name:         "missing session exits with not-found code",
+			args:         []string{"sessions", "view", "does-not-exist"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "Error: ")
+				harness.AssertStderrContains(t, result, "session not found")
+			},
+		},
+		{
+			name: "duplicate session exits with conflict code",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "dup-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "add", "dup-session"},
+			wantExitCode: 4,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "session already exists")
+			},
+		},
+		{
+			name:         "unknown key exits with invalid-input code",
+			args:         []string{"settings", "keys", "set", "not_a_key", "x"},
+			wantExitCode: 6,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "invalid input")
+			},
+		},
+		{
+			name:         "json format prints error envelope",
+			args:         []string{"sessions", "view", "does-not-exist", "--format", "json"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				var envelope struct {
+					Error struct {
+						Category string `json:"category"`
+						Code     int    `json:"code"`
+						Message  string `json:"message"`
+					} `json:"error"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(result.Stderr), &envelope), "stderr: %s", result.Stderr)
+				assert.Equal(t, "not_found", envelope.Error.Category)
+				assert.Equal(t, 3, envelope.Error.Code)
+				assert.Contains(t, envelope.Error.Message, "does-not-exist")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+			harness.AssertExitCode(t, result, tt.wantExitCode)
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
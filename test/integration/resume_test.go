@@ -0,0 +1,76 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestResume(t *testing.T) {
+	tests := []struct {
+		name     string
+		setup    func(t *testing.T, env *harness.TestEnvironment)
+		args     []string
+		wantFail bool
+		validate func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name:     "requires --all or --filter",
+			args:     []string{"resume"},
+			wantFail: true,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "must specify either --all or --filter")
+			},
+		},
+		{
+			name:     "rejects --all with --filter",
+			args:     []string{"resume", "--all", "--filter", "state:idle"},
+			wantFail: true,
+		},
+		{
+			name: "filter without matches resumes nothing",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "resume-session", "--state", "exited")
+				harness.AssertSuccess(t, result)
+			},
+			args: []string{"resume", "--filter", "state:working"},
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "No sessions to resume")
+			},
+		},
+		{
+			name: "session without working directory is reported as failed",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "resume-session", "--state", "exited")
+				harness.AssertSuccess(t, result)
+			},
+			args:     []string{"resume", "--filter", "resume-session"},
+			wantFail: true,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "✗ resume-session: invalid input: session has no working directory")
+				harness.AssertStdoutContains(t, result, "failed 1")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+			if tt.wantFail {
+				harness.AssertFailure(t, result)
+			} else {
+				harness.AssertSuccess(t, result)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
@@ -66,6 +66,35 @@ func TestSessionsAdd(t *testing.T) {
 				harness.AssertFailure(t, result)
 			},
 		},
+		{
+			name:         "add external session with existing directory",
+			args:         []string{"sessions", "add", "external-session", "--path", "/tmp"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Session 'external-session' added successfully")
+
+				view := harness.RunCommand(t, env, "sessions", "view", "external-session")
+				harness.AssertSuccess(t, view)
+				harness.AssertStdoutContains(t, view, "Repo Path: /tmp")
+				harness.AssertStdoutContains(t, view, "External: true")
+			},
+		},
+		{
+			name:         "add external session with missing directory fails",
+			args:         []string{"sessions", "add", "external-missing", "--path", "/does/not/exist"},
+			wantExitCode: 6,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "is not a directory")
+			},
+		},
+		{
+			name:         "add external session with repo source fails",
+			args:         []string{"sessions", "add", "external-conflict", "--path", "/tmp", "--repo-source", "https://github.com/owner/repo"},
+			wantExitCode: 6,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "--path cannot be combined")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,82 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsAudit(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name: "audited session without tool uses",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session", "--allow-dangerously-skip-permissions")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "audit", "test-session"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "No tool uses recorded for session 'test-session'")
+			},
+		},
+		{
+			name: "session that asks for permissions is not audited",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "audit", "test-session"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "its tools are not audited")
+			},
+		},
+		{
+			name: "json output is an empty list",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session", "--allow-dangerously-skip-permissions")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "audit", "test-session", "--format", "json"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				var toolUses []map[string]any
+				harness.AssertValidJSON(t, result, &toolUses)
+			},
+		},
+		{
+			name:         "audit nonexistent session fails",
+			args:         []string{"sessions", "audit", "nonexistent"},
+			wantExitCode: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+
+			if tt.wantExitCode == 0 {
+				harness.AssertSuccess(t, result)
+			} else {
+				harness.AssertExitCode(t, result, tt.wantExitCode)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package integration_test
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"github.com/renato0307/rocha/test/integration/harness"
 )
 
@@ -126,3 +131,46 @@ func TestSessionsDelAndVerify(t *testing.T) {
 	harness.AssertStdoutNotContains(t, listResult, "session-2")
 	harness.AssertStdoutContains(t, listResult, "session-3")
 }
+
+func TestSessionsDelWorktreeWithLocalWork(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		wantWorktree bool
+		wantStdout   string
+	}{
+		{
+			name:         "force keeps worktree with uncommitted changes",
+			args:         []string{"sessions", "del", "-f", "-k", "dirty-session"},
+			wantWorktree: true,
+			wantStdout:   "Keeping worktree",
+		},
+		{
+			name:         "discard local work removes worktree",
+			args:         []string{"sessions", "del", "-f", "-k", "--discard-local-work", "dirty-session"},
+			wantWorktree: false,
+			wantStdout:   "deleted successfully",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+			gitSetup := harness.NewTestGitSetup(t)
+
+			worktreePath := gitSetup.CreateWorktree(filepath.Join(env.TempDir(), "dirty-worktree"), "dirty-branch")
+			require.NoError(t, os.WriteFile(filepath.Join(worktreePath, "notes.md"), []byte("draft"), 0644))
+
+			result := harness.RunCommand(t, env, "sessions", "add", "dirty-session",
+				"--repo-path", gitSetup.ClonePath, "--worktree-path", worktreePath)
+			harness.AssertSuccess(t, result)
+
+			result = harness.RunCommand(t, env, tt.args...)
+			harness.AssertSuccess(t, result)
+			harness.AssertStdoutContains(t, result, tt.wantStdout)
+
+			_, err := os.Stat(worktreePath)
+			assert.Equal(t, tt.wantWorktree, err == nil, "worktree existence")
+		})
+	}
+}
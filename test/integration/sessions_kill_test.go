@@ -0,0 +1,75 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsKill(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name: "force kill removes session",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "kill", "test-session", "--force"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Session 'test-session' killed")
+
+				viewResult := harness.RunCommand(t, env, "sessions", "view", "test-session")
+				harness.AssertFailure(t, viewResult)
+			},
+		},
+		{
+			name: "exited session is killed without waiting",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session", "--state", "exited")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "kill", "test-session", "--timeout", "1s"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Session 'test-session' killed")
+			},
+		},
+		{
+			name:         "kill nonexistent session fails",
+			args:         []string{"sessions", "kill", "nonexistent"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertFailure(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+
+			if tt.wantExitCode == 0 {
+				harness.AssertSuccess(t, result)
+			} else {
+				harness.AssertFailure(t, result)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
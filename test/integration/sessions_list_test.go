@@ -126,6 +126,93 @@ func TestSessionsList(t *testing.T) {
 				harness.AssertStdoutContains(t, result, "Total: 2 sessions")
 			},
 		},
+		{
+			name: "list filters by state and flag",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "busy", "--state", "working")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "add", "busy-flagged", "--state", "working")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "flag", "busy-flagged")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "add", "resting", "--state", "idle")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "list", "--state", "working", "--flagged"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "busy-flagged")
+				harness.AssertStdoutContains(t, result, "Total: 1 sessions")
+			},
+		},
+		{
+			name: "list filters by status",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "in-review")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "status", "in-review", "--status", "review")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "add", "no-status")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "list", "--status", "review"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "in-review")
+				harness.AssertStdoutContains(t, result, "Total: 1 sessions")
+			},
+		},
+		{
+			name: "list apply set-status updates matching sessions",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "done-a", "--state", "exited")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "add", "still-going", "--state", "working")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "list", "--state", "exited", "--apply", "set-status", "--to", "done", "--force"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Applied 'set-status' to 1 of 1 sessions")
+
+				result = harness.RunCommand(t, env, "sessions", "list", "--status", "done")
+				harness.AssertSuccess(t, result)
+				harness.AssertStdoutContains(t, result, "done-a")
+				harness.AssertStdoutContains(t, result, "Total: 1 sessions")
+			},
+		},
+		{
+			name: "list apply archive archives matching sessions",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "stale", "--state", "exited")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "add", "fresh", "--state", "idle")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "list", "--state", "exited", "--apply", "archive", "-f"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				result = harness.RunCommand(t, env, "sessions", "list")
+				harness.AssertSuccess(t, result)
+				harness.AssertStdoutContains(t, result, "fresh")
+				harness.AssertStdoutContains(t, result, "Total: 1 sessions")
+			},
+		},
+		{
+			name:         "list with invalid state fails",
+			args:         []string{"sessions", "list", "--state", "sleeping"},
+			wantExitCode: 6,
+		},
+		{
+			name:         "list with invalid older-than fails",
+			args:         []string{"sessions", "list", "--older-than", "soon"},
+			wantExitCode: 6,
+		},
+		{
+			name:         "list apply set-status without --to fails",
+			args:         []string{"sessions", "list", "--apply", "set-status", "-f"},
+			wantExitCode: 6,
+		},
 	}
 
 	for _, tt := range tests {
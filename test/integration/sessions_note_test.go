@@ -0,0 +1,99 @@
+package integration_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsNote(t *testing.T) {
+	notePath := filepath.Join(t.TempDir(), "note.md")
+	require.NoError(t, os.WriteFile(notePath, []byte("# Plan\n\n- step one\n- step two\n"), 0644))
+
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name: "set note",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "note", "test-session", "--note", "**Remember** to update docs"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Note updated for session 'test-session'")
+
+				viewResult := harness.RunCommand(t, env, "sessions", "view", "test-session")
+				harness.AssertSuccess(t, viewResult)
+				harness.AssertStdoutContains(t, viewResult, "**Remember** to update docs")
+			},
+		},
+		{
+			name: "set note from file",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "note", "test-session", "--file", notePath},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				viewResult := harness.RunCommand(t, env, "sessions", "view", "test-session")
+				harness.AssertSuccess(t, viewResult)
+				harness.AssertStdoutContains(t, viewResult, "- step two")
+			},
+		},
+		{
+			name: "clear note",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "note", "test-session", "--note", "Initial note")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "note", "test-session", "--note", ""},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Note cleared for session 'test-session'")
+			},
+		},
+		{
+			name:         "note nonexistent session fails",
+			args:         []string{"sessions", "note", "nonexistent", "--note", "Test"},
+			wantExitCode: 1,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertFailure(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+
+			if tt.wantExitCode == 0 {
+				harness.AssertSuccess(t, result)
+			} else {
+				harness.AssertFailure(t, result)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsPriority(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name: "set priority",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "priority", "test-session", "p1"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Priority set to 'P1' for session 'test-session'")
+
+				viewResult := harness.RunCommand(t, env, "sessions", "view", "test-session")
+				harness.AssertSuccess(t, viewResult)
+				harness.AssertStdoutContains(t, viewResult, "Priority: P1")
+				harness.AssertStdoutContains(t, viewResult, "Flagged: false")
+			},
+		},
+		{
+			name: "cycle priority without a level",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "priority", "test-session"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Priority set to 'P0' for session 'test-session'")
+			},
+		},
+		{
+			name: "clear priority",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "priority", "test-session", "P2")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "priority", "test-session", "none"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Priority cleared for session 'test-session'")
+
+				viewResult := harness.RunCommand(t, env, "sessions", "view", "test-session")
+				harness.AssertSuccess(t, viewResult)
+				harness.AssertStdoutContains(t, viewResult, "Priority: none")
+			},
+		},
+		{
+			name: "unknown priority fails",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "priority", "test-session", "P9"},
+			wantExitCode: 1,
+		},
+		{
+			name:         "priority of nonexistent session fails",
+			args:         []string{"sessions", "priority", "nonexistent", "P0"},
+			wantExitCode: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+
+			if tt.wantExitCode == 0 {
+				harness.AssertSuccess(t, result)
+			} else {
+				harness.AssertFailure(t, result)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
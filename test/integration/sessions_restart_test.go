@@ -0,0 +1,60 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsRestart(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name: "resume without recorded conversation exits with invalid-input code",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "restart-session", "--state", "exited")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "restart", "restart-session"},
+			wantExitCode: 6,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "no Claude conversation recorded")
+			},
+		},
+		{
+			name:         "restart missing session exits with not-found code",
+			args:         []string{"sessions", "restart", "does-not-exist", "--mode", "fresh"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "session not found")
+			},
+		},
+		{
+			name:         "unknown mode is rejected",
+			args:         []string{"sessions", "restart", "any-session", "--mode", "reboot"},
+			wantExitCode: 80,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+			harness.AssertExitCode(t, result, tt.wantExitCode)
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
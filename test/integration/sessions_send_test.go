@@ -0,0 +1,122 @@
+package integration_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsSend(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name: "schedule with delay is listed in session view",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "send-session")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "send", "send-session", "continue later", "--in", "30m")
+				harness.AssertSuccess(t, result)
+				harness.AssertStdoutContains(t, result, "Scheduled prompt #1")
+			},
+			args:         []string{"sessions", "view", "send-session"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Scheduled Prompts:")
+				harness.AssertStdoutContains(t, result, "#1 at ")
+				harness.AssertStdoutContains(t, result, "continue later")
+			},
+		},
+		{
+			name: "cancel removes scheduled prompt",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "cancel-session")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "send", "cancel-session", "morning", "--at", "09:00")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "cancel-send", "1"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Scheduled prompt #1 cancelled")
+
+				view := harness.RunCommand(t, env, "sessions", "view", "cancel-session")
+				harness.AssertSuccess(t, view)
+				harness.AssertStdoutNotContains(t, view, "Scheduled Prompts:")
+			},
+		},
+		{
+			name:         "cancel unknown prompt exits with not-found code",
+			args:         []string{"sessions", "cancel-send", "42"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "scheduled prompt not found")
+			},
+		},
+		{
+			name: "invalid time exits with invalid-input code",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "bad-time-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "send", "bad-time-session", "text", "--at", "noon"},
+			wantExitCode: 6,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "must be HH:MM or RFC3339")
+			},
+		},
+		{
+			name: "send is queued when concurrency limit is reached",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				settings := []byte(`{"max_working_sessions": 1}`)
+				if err := os.WriteFile(filepath.Join(env.RochaHome, "settings.json"), settings, 0o644); err != nil {
+					t.Fatalf("failed to write settings: %v", err)
+				}
+				result := harness.RunCommand(t, env, "sessions", "add", "busy-session", "--state", "working")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "add", "throttled-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "send", "throttled-session", "start the review"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Concurrency limit reached: queued prompt #1")
+
+				view := harness.RunCommand(t, env, "sessions", "view", "throttled-session")
+				harness.AssertSuccess(t, view)
+				harness.AssertStdoutContains(t, view, "start the review")
+			},
+		},
+		{
+			name:         "schedule for missing session exits with not-found code",
+			args:         []string{"sessions", "send", "does-not-exist", "text", "--in", "5m"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "session not found")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+			harness.AssertExitCode(t, result, tt.wantExitCode)
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
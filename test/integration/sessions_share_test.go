@@ -0,0 +1,189 @@
+package integration_test
+
+import (
+	"os/exec"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsShare(t *testing.T) {
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name: "share prints join commands",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+				startTmuxSession(t, env, "test-session")
+			},
+			args:         []string{"sessions", "share", "test-session", "--expires", "2h", "--host", "devbox", "--user", "nobody"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "shared read-only with the account 'nobody'")
+				harness.AssertStdoutContains(t, result, "attach-session -t =share -r")
+				harness.AssertStdoutContains(t, result, "ssh -t nobody@devbox 'tmux -S ")
+				harness.AssertStdoutContains(t, result, "ROCHA_HOME="+env.RochaHome+" rocha sessions join ")
+
+				listResult := harness.RunCommand(t, env, "sessions", "share", "test-session", "--list")
+				harness.AssertSuccess(t, listResult)
+				harness.AssertStdoutContains(t, listResult, "read-only")
+			},
+		},
+		{
+			name: "revoked share cannot be joined",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+				startTmuxSession(t, env, "test-session")
+				result = harness.RunCommand(t, env, "sessions", "share", "test-session", "--read-write")
+				harness.AssertSuccess(t, result)
+				result = harness.RunCommand(t, env, "sessions", "share", "test-session", "--revoke", "all")
+				harness.AssertSuccess(t, result)
+				harness.AssertStdoutContains(t, result, "Revoked 1 shares")
+
+				sockets, err := filepath.Glob(filepath.Join(env.TempDir(), "rocha-share-*.sock"))
+				require.NoError(t, err)
+				require.Empty(t, sockets, "revoking stops the share server")
+			},
+			args:         []string{"sessions", "share", "test-session", "--list"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "No active shares")
+			},
+		},
+		{
+			name:         "join with unknown token fails",
+			args:         []string{"sessions", "join", "bogus"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertFailure(t, result)
+			},
+		},
+		{
+			name: "non-positive expiry fails",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "share", "test-session", "--expires", "0s"},
+			wantExitCode: 6,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertFailure(t, result)
+			},
+		},
+		{
+			name: "read-only share without a teammate account fails",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+				harness.AssertSuccess(t, result)
+			},
+			args:         []string{"sessions", "share", "test-session"},
+			wantExitCode: 6,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStderrContains(t, result, "teammate's account")
+			},
+		},
+		{
+			name:         "share nonexistent session fails",
+			args:         []string{"sessions", "share", "nonexistent"},
+			wantExitCode: 3,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertFailure(t, result)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+
+			if tt.wantExitCode == 0 {
+				harness.AssertSuccess(t, result)
+			} else {
+				harness.AssertFailure(t, result)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
+
+func TestSessionsJoinRevokedShare(t *testing.T) {
+	env := harness.NewTestEnvironment(t)
+
+	result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+	harness.AssertSuccess(t, result)
+	startTmuxSession(t, env, "test-session")
+	result = harness.RunCommand(t, env, "sessions", "share", "test-session", "--user", "nobody")
+	harness.AssertSuccess(t, result)
+
+	token := shareToken(t, result.Stdout)
+	result = harness.RunCommand(t, env, "sessions", "share", "test-session", "--revoke", token)
+	harness.AssertSuccess(t, result)
+
+	result = harness.RunCommand(t, env, "sessions", "join", token)
+	harness.AssertExitCode(t, result, 3)
+	harness.AssertStderrContains(t, result, "revoked")
+}
+
+// startTmuxSession starts a tmux session in a server of the test's own, which rocha is pointed at.
+// Share servers go to the test's temporary directory and are stopped with it.
+func startTmuxSession(t *testing.T, env *harness.TestEnvironment, name string) {
+	t.Helper()
+	if _, err := exec.LookPath("tmux"); err != nil {
+		t.Skip("tmux is not installed")
+	}
+	if _, err := user.Lookup("nobody"); err != nil {
+		t.Skip("no 'nobody' account to share with")
+	}
+
+	env.SetEnv("TMPDIR", env.TempDir())
+	env.SetEnv("TMUX", "")
+	env.SetEnv("TMUX_TMPDIR", env.TempDir())
+	tmux := func(args ...string) *exec.Cmd {
+		cmd := exec.Command("tmux", args...)
+		cmd.Env = env.Environ()
+		return cmd
+	}
+
+	output, err := tmux("new-session", "-d", "-s", name, "sleep 600").CombinedOutput()
+	require.NoError(t, err, string(output))
+	t.Cleanup(func() {
+		sockets, _ := filepath.Glob(filepath.Join(env.TempDir(), "rocha-share-*.sock"))
+		for _, socket := range sockets {
+			_ = tmux("-S", socket, "kill-server").Run()
+		}
+		_ = tmux("kill-server").Run()
+	})
+}
+
+// shareToken extracts the token from the "Revoke with:" line of the share output
+func shareToken(t *testing.T, stdout string) string {
+	t.Helper()
+	for _, line := range strings.Split(stdout, "\n") {
+		if _, token, found := strings.Cut(line, "--revoke "); found {
+			return strings.TrimSpace(token)
+		}
+	}
+	t.Fatalf("no share token in output: %s", stdout)
+	return ""
+}
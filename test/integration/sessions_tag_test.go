@@ -0,0 +1,102 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/renato0307/rocha/test/integration/harness"
+)
+
+func TestSessionsTag(t *testing.T) {
+	addSession := func(t *testing.T, env *harness.TestEnvironment) {
+		result := harness.RunCommand(t, env, "sessions", "add", "test-session")
+		harness.AssertSuccess(t, result)
+		result = harness.RunCommand(t, env, "sessions", "tag", "test-session", "backend", "Urgent")
+		harness.AssertSuccess(t, result)
+	}
+
+	tests := []struct {
+		name         string
+		setup        func(t *testing.T, env *harness.TestEnvironment)
+		args         []string
+		wantExitCode int
+		validate     func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult)
+	}{
+		{
+			name:         "add tags",
+			setup:        addSession,
+			args:         []string{"sessions", "tag", "test-session", "client-x"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "backend, client-x, urgent")
+
+				viewResult := harness.RunCommand(t, env, "sessions", "view", "test-session")
+				harness.AssertSuccess(t, viewResult)
+				harness.AssertStdoutContains(t, viewResult, "Tags: backend, client-x, urgent")
+			},
+		},
+		{
+			name:         "remove tag",
+			setup:        addSession,
+			args:         []string{"sessions", "tag", "test-session", "urgent", "--remove"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Tags for session 'test-session': backend")
+			},
+		},
+		{
+			name:         "clear tags",
+			setup:        addSession,
+			args:         []string{"sessions", "tag", "test-session", "--clear"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Session 'test-session' has no tags")
+			},
+		},
+		{
+			name:         "filter list by tag",
+			setup:        addSession,
+			args:         []string{"sessions", "list", "--tag", "urgent"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "test-session")
+
+				otherResult := harness.RunCommand(t, env, "sessions", "list", "--tag", "frontend")
+				harness.AssertSuccess(t, otherResult)
+				harness.AssertStdoutContains(t, otherResult, "Total: 0 sessions")
+			},
+		},
+		{
+			name:         "invalid tag fails",
+			setup:        addSession,
+			args:         []string{"sessions", "tag", "test-session", "two words"},
+			wantExitCode: 6,
+		},
+		{
+			name:         "tag nonexistent session fails",
+			args:         []string{"sessions", "tag", "nonexistent", "backend"},
+			wantExitCode: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env := harness.NewTestEnvironment(t)
+
+			if tt.setup != nil {
+				tt.setup(t, env)
+			}
+
+			result := harness.RunCommand(t, env, tt.args...)
+
+			if tt.wantExitCode == 0 {
+				harness.AssertSuccess(t, result)
+			} else {
+				harness.AssertExitCode(t, result, tt.wantExitCode)
+			}
+
+			if tt.validate != nil {
+				tt.validate(t, env, result)
+			}
+		})
+	}
+}
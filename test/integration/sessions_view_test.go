@@ -18,7 +18,7 @@ func TestSessionsView(t *testing.T) {
 		{
 			name:         "view nonexistent session fails",
 			args:         []string{"sessions", "view", "does-not-exist"},
-			wantExitCode: 1,
+			wantExitCode: 3,
 			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
 				harness.AssertFailure(t, result)
 			},
@@ -96,6 +96,54 @@ func TestStats(t *testing.T) {
 				harness.AssertStdoutContains(t, result, "Total")
 			},
 		},
+		{
+			name:         "stats heatmap with no activity shows placeholder",
+			args:         []string{"stats", "--format=heatmap"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Session Activity - last 7 days")
+				harness.AssertStdoutContains(t, result, "No session activity yet")
+			},
+		},
+		{
+			name: "stats heatmap counts state transitions",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "busy-session")
+				harness.AssertSuccess(t, result)
+				for _, event := range []string{"prompt", "notification", "stop"} {
+					result = harness.RunCommand(t, env, "notify", "handle", "busy-session", event)
+					harness.AssertSuccess(t, result)
+				}
+			},
+			args:         []string{"stats", "--format=heatmap", "--days=3"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "Session Activity - last 3 days")
+				harness.AssertStdoutContains(t, result, "Transitions: 3")
+			},
+		},
+		{
+			name: "stats heatmap filters by state",
+			setup: func(t *testing.T, env *harness.TestEnvironment) {
+				result := harness.RunCommand(t, env, "sessions", "add", "waiting-session")
+				harness.AssertSuccess(t, result)
+				for _, event := range []string{"prompt", "notification", "stop"} {
+					result = harness.RunCommand(t, env, "notify", "handle", "waiting-session", event)
+					harness.AssertSuccess(t, result)
+				}
+			},
+			args:         []string{"stats", "--format=heatmap", "--state=waiting"},
+			wantExitCode: 0,
+			validate: func(t *testing.T, env *harness.TestEnvironment, result harness.CommandResult) {
+				harness.AssertStdoutContains(t, result, "transitions to waiting")
+				harness.AssertStdoutContains(t, result, "Transitions: 1")
+			},
+		},
+		{
+			name:         "stats heatmap with invalid days fails",
+			args:         []string{"stats", "--format=heatmap", "--days=0"},
+			wantExitCode: 6,
+		},
 	}
 
 	for _, tt := range tests {